@@ -17,6 +17,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"time"
@@ -161,7 +162,12 @@ func doGetLatestBillDate(sess *session.Session) {
 }
 
 func handleError(err error) {
-	apiErr := err.(sl.Error)
+	var apiErr sl.Error
+	if !errors.As(err, &apiErr) {
+		fmt.Println("Error:", err)
+		return
+	}
+
 	fmt.Printf(
 		"Exception: %s\nMessage: %s\nHTTP Status Code: %d\n",
 		apiErr.Exception,
@@ -0,0 +1,293 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package access completes the setup of newly provisioned servers: it waits
+// for the passwords generated by the API, optionally installs SSH keys and
+// disables password logins over SSH, and optionally deletes the passwords
+// from the portal once they are no longer needed.
+//
+// This module has no SSH client; commands are run on the servers through a
+// RemoteRunner supplied by the caller (e.g., built on golang.org/x/crypto/ssh).
+package access
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// ServerMask is the object mask of the servers polled for their passwords
+const ServerMask = "id,primaryIpAddress,primaryBackendIpAddress,operatingSystem[passwords[id,username,password]]"
+
+// DefaultPasswordTimeout is how long WaitForPasswords waits by default
+const DefaultPasswordTimeout = 30 * time.Minute
+
+// PasswordPollInterval is the interval at which WaitForPasswords checks for
+// the passwords of a server. Passwords are only set once the operating
+// system has been installed, some time after the server is active.
+var PasswordPollInterval = 30 * time.Second
+
+// Server is a server, and the passwords generated for it
+type Server struct {
+	// Exactly one of GuestId and HardwareId is set
+	GuestId    int
+	HardwareId int
+
+	PublicIp  string
+	PrivateIp string
+	Passwords []datatypes.Software_Component_Password
+}
+
+// Password returns the password of the named user (e.g., "root"), or false if
+// there is none
+func (s Server) Password(username string) (string, bool) {
+	for _, p := range s.Passwords {
+		if sl.Get(p.Username, "").(string) == username && sl.Get(p.Password, "").(string) != "" {
+			return *p.Password, true
+		}
+	}
+
+	return "", false
+}
+
+// RemoteRunner runs shell commands, in order, on the host over SSH, logging in
+// as username with password, and returns an error if any fails
+type RemoteRunner func(host string, username string, password string, commands []string) error
+
+// Request describes the setup of a new server
+type Request struct {
+	// Exactly one of GuestId and HardwareId must be set
+	GuestId    int
+	HardwareId int
+
+	// Timeout bounds the wait for the passwords. Defaults to
+	// DefaultPasswordTimeout.
+	Timeout time.Duration
+
+	// Username is the user whose password is used to log in. Defaults to
+	// "root".
+	Username string
+
+	// AuthorizedKeys are the public SSH keys (e.g., "ssh-ed25519 AAAA...")
+	// added to the authorized keys of Username
+	AuthorizedKeys []string
+
+	// DisablePasswordLogin disables password authentication in the SSH server
+	// configuration, once the keys are installed
+	DisablePasswordLogin bool
+
+	// PrivateNetwork connects to the private (backend) address of the server
+	// instead of its public address
+	PrivateNetwork bool
+
+	// Run runs the commands on the server. It is required to install keys or
+	// disable password logins.
+	Run RemoteRunner
+
+	// ScrubPasswords deletes the passwords of the server from the portal
+	// (and the API) once the server is set up
+	ScrubPasswords bool
+}
+
+// Result reports what Secure did
+type Result struct {
+	Server                Server
+	KeysInstalled         bool
+	PasswordLoginDisabled bool
+	PasswordsScrubbed     bool
+}
+
+// WaitForPasswords waits, until timeout elapses, for the API to report the
+// passwords of a virtual guest (if guestId is set) or of a hardware server
+func WaitForPasswords(sess *session.Session, guestId int, hardwareId int, timeout time.Duration) (Server, error) {
+	if (guestId == 0) == (hardwareId == 0) {
+		return Server{}, fmt.Errorf("Exactly one of a guest id and a hardware id is required")
+	}
+
+	if timeout <= 0 {
+		timeout = DefaultPasswordTimeout
+	}
+	deadline := time.Now().Add(timeout)
+
+	for {
+		server, err := getServer(sess, guestId, hardwareId)
+		if err != nil {
+			return Server{}, err
+		}
+
+		for _, p := range server.Passwords {
+			if sl.Get(p.Password, "").(string) != "" {
+				return server, nil
+			}
+		}
+
+		if time.Now().Add(PasswordPollInterval).After(deadline) {
+			return server, fmt.Errorf("Timed out waiting for the passwords of %s", describe(guestId, hardwareId))
+		}
+
+		time.Sleep(PasswordPollInterval)
+	}
+}
+
+// Secure waits for the passwords of a new server, then installs SSH keys on
+// it, disables password logins and scrubs its passwords, as requested. The
+// passwords are not scrubbed if setting up the server failed, so that it can
+// still be logged into.
+func Secure(sess *session.Session, request Request) (Result, error) {
+	var result Result
+	if (len(request.AuthorizedKeys) > 0 || request.DisablePasswordLogin) && request.Run == nil {
+		return result, fmt.Errorf("A RemoteRunner is required to install keys or disable password logins")
+	}
+
+	if request.DisablePasswordLogin && len(request.AuthorizedKeys) == 0 {
+		return result, fmt.Errorf("Refusing to disable password logins without installing keys")
+	}
+
+	commands, err := Commands(request.AuthorizedKeys, request.DisablePasswordLogin)
+	if err != nil {
+		return result, err
+	}
+
+	server, err := WaitForPasswords(sess, request.GuestId, request.HardwareId, request.Timeout)
+	result.Server = server
+	if err != nil {
+		return result, err
+	}
+
+	if len(commands) > 0 {
+		username := request.Username
+		if username == "" {
+			username = "root"
+		}
+
+		password, ok := server.Password(username)
+		if !ok {
+			return result, fmt.Errorf("No password for %s on %s", username, describe(request.GuestId, request.HardwareId))
+		}
+
+		host := server.PublicIp
+		if request.PrivateNetwork || host == "" {
+			host = server.PrivateIp
+		}
+
+		if err = request.Run(host, username, password, commands); err != nil {
+			return result, fmt.Errorf("Error setting up %s: %s", describe(request.GuestId, request.HardwareId), err)
+		}
+
+		result.KeysInstalled = len(request.AuthorizedKeys) > 0
+		result.PasswordLoginDisabled = request.DisablePasswordLogin
+	}
+
+	if request.ScrubPasswords {
+		if err = ScrubPasswords(sess, server.Passwords); err != nil {
+			return result, err
+		}
+		result.PasswordsScrubbed = true
+	}
+
+	return result, nil
+}
+
+// Commands returns the shell commands adding the keys to the authorized keys
+// of the user they are run as, and disabling password authentication in the
+// configuration of the SSH server (which is then reloaded)
+func Commands(authorizedKeys []string, disablePasswordLogin bool) ([]string, error) {
+	commands := []string{}
+	if len(authorizedKeys) > 0 {
+		commands = append(commands, "mkdir -p ~/.ssh && chmod 700 ~/.ssh && touch ~/.ssh/authorized_keys")
+	}
+
+	for _, key := range authorizedKeys {
+		key = strings.TrimSpace(key)
+		if key == "" || strings.ContainsAny(key, "'\n\r") || !strings.HasPrefix(strings.Fields(key)[0], "ssh-") &&
+			!strings.HasPrefix(strings.Fields(key)[0], "ecdsa-") {
+			return nil, fmt.Errorf("Invalid public key %q", key)
+		}
+
+		commands = append(commands, fmt.Sprintf(
+			"grep -qxF '%[1]s' ~/.ssh/authorized_keys || echo '%[1]s' >> ~/.ssh/authorized_keys", key))
+	}
+
+	if len(authorizedKeys) > 0 {
+		commands = append(commands, "chmod 600 ~/.ssh/authorized_keys")
+	}
+
+	if disablePasswordLogin {
+		commands = append(commands,
+			"sed -i -E 's/^#?[[:space:]]*PasswordAuthentication[[:space:]].*/PasswordAuthentication no/' /etc/ssh/sshd_config",
+			"grep -q '^PasswordAuthentication no' /etc/ssh/sshd_config || echo 'PasswordAuthentication no' >> /etc/ssh/sshd_config",
+			"systemctl reload sshd 2>/dev/null || systemctl reload ssh 2>/dev/null || service ssh reload")
+	}
+
+	return commands, nil
+}
+
+// ScrubPasswords deletes passwords from the portal (and the API)
+func ScrubPasswords(sess *session.Session, passwords []datatypes.Software_Component_Password) error {
+	service := services.GetSoftwareComponentPasswordService(sess)
+	for _, p := range passwords {
+		if p.Id == nil {
+			continue
+		}
+
+		if _, err := service.Id(*p.Id).DeleteObject(); err != nil {
+			return fmt.Errorf("Error deleting password %d: %s", *p.Id, err)
+		}
+	}
+
+	return nil
+}
+
+func getServer(sess *session.Session, guestId int, hardwareId int) (Server, error) {
+	server := Server{GuestId: guestId, HardwareId: hardwareId}
+
+	var publicIp, privateIp *string
+	var os *datatypes.Software_Component_OperatingSystem
+	if guestId != 0 {
+		guest, err := services.GetVirtualGuestService(sess).Id(guestId).Mask(ServerMask).GetObject()
+		if err != nil {
+			return server, fmt.Errorf("Error retrieving virtual guest %d: %s", guestId, err)
+		}
+		publicIp, privateIp, os = guest.PrimaryIpAddress, guest.PrimaryBackendIpAddress, guest.OperatingSystem
+	} else {
+		hardware, err := services.GetHardwareServerService(sess).Id(hardwareId).Mask(ServerMask).GetObject()
+		if err != nil {
+			return server, fmt.Errorf("Error retrieving hardware server %d: %s", hardwareId, err)
+		}
+		publicIp, privateIp, os = hardware.PrimaryIpAddress, hardware.PrimaryBackendIpAddress, hardware.OperatingSystem
+	}
+
+	server.PublicIp = sl.Get(publicIp, "").(string)
+	server.PrivateIp = sl.Get(privateIp, "").(string)
+	if os != nil {
+		server.Passwords = os.Passwords
+	}
+
+	return server, nil
+}
+
+func describe(guestId int, hardwareId int) string {
+	if guestId != 0 {
+		return fmt.Sprintf("virtual guest %d", guestId)
+	}
+
+	return fmt.Sprintf("hardware server %d", hardwareId)
+}
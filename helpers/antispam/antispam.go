@@ -0,0 +1,222 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package antispam retrieves the abuse reports (e.g., spam and blacklisting
+// complaints) raised against an account, and the account IP addresses they
+// concern, so delisting workflows can be automated.
+package antispam
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Report is an abuse ticket of the account
+type Report struct {
+	TicketId int
+	Title    string
+	Status   string
+	Created  time.Time
+	Open     bool
+
+	// Entry is the content of the first update of the ticket, usually the
+	// abuse complaint itself
+	Entry string
+
+	// IpAddresses are the account IP addresses mentioned in the ticket
+	IpAddresses []string
+
+	// HardwareIds and VirtualGuestIds are the servers attached to the
+	// ticket, or bound to one of its IP addresses
+	HardwareIds     []int
+	VirtualGuestIds []int
+}
+
+// TicketMask is the object mask used by GetReports
+const TicketMask = "id,title,createDate,status[name],firstUpdate[entry],attachedHardware[id],attachedVirtualGuests[id]"
+
+// IpAddressMask is the object mask used by GetReports to find the devices of
+// the IP addresses reported
+const IpAddressMask = "id,ipAddress,hardware[id],virtualGuest[id]"
+
+var ipv4Pattern = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+
+// GetReports returns the open abuse tickets of the account and, if
+// includeClosed is true, the last five closed ones
+func GetReports(sess *session.Session, includeClosed bool) ([]Report, error) {
+	service := services.GetAccountService(sess).Mask(TicketMask).Unlimited()
+
+	open, err := service.GetOpenAbuseTickets()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving open abuse tickets: %s", err)
+	}
+
+	reports := []Report{}
+	for _, ticket := range open {
+		report, err := newReport(sess, ticket, true)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+
+	if !includeClosed {
+		return reports, nil
+	}
+
+	closed, err := service.GetLastFiveClosedAbuseTickets()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving closed abuse tickets: %s", err)
+	}
+
+	for _, ticket := range closed {
+		report, err := newReport(sess, ticket, false)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+func newReport(sess *session.Session, ticket datatypes.Ticket, open bool) (Report, error) {
+	report := Report{
+		TicketId: sl.Get(ticket.Id, 0).(int),
+		Title:    sl.Get(ticket.Title, "").(string),
+		Open:     open,
+	}
+
+	if ticket.CreateDate != nil {
+		report.Created = ticket.CreateDate.Time
+	}
+	if ticket.Status != nil {
+		report.Status = sl.Get(ticket.Status.Name, "").(string)
+	}
+	if ticket.FirstUpdate != nil {
+		report.Entry = sl.Get(ticket.FirstUpdate.Entry, "").(string)
+	}
+
+	hardware := map[int]bool{}
+	for _, hw := range ticket.AttachedHardware {
+		hardware[sl.Get(hw.Id, 0).(int)] = true
+	}
+
+	guests := map[int]bool{}
+	for _, guest := range ticket.AttachedVirtualGuests {
+		guests[sl.Get(guest.Id, 0).(int)] = true
+	}
+
+	for _, address := range ExtractIpAddresses(report.Title + "\n" + report.Entry) {
+		ip, err := services.GetNetworkSubnetIpAddressService(sess).
+			Mask(IpAddressMask).
+			GetByIpAddress(sl.String(address))
+		var apiErr sl.Error
+		if errors.As(err, &apiErr) && apiErr.StatusCode == 404 {
+			continue
+		}
+		if err != nil {
+			return report, fmt.Errorf("Error looking up %s of abuse ticket %d: %s", address, report.TicketId, err)
+		}
+
+		// Addresses outside of the account are not returned
+		if ip.Id == nil {
+			continue
+		}
+
+		report.IpAddresses = append(report.IpAddresses, address)
+		if ip.Hardware != nil && ip.Hardware.Id != nil {
+			hardware[*ip.Hardware.Id] = true
+		}
+		if ip.VirtualGuest != nil && ip.VirtualGuest.Id != nil {
+			guests[*ip.VirtualGuest.Id] = true
+		}
+	}
+
+	for id := range hardware {
+		report.HardwareIds = append(report.HardwareIds, id)
+	}
+	for id := range guests {
+		report.VirtualGuestIds = append(report.VirtualGuestIds, id)
+	}
+
+	return report, nil
+}
+
+// ExtractIpAddresses returns the distinct IPv4 addresses found in text, in
+// the order they first appear
+func ExtractIpAddresses(text string) []string {
+	addresses := []string{}
+	seen := map[string]bool{}
+
+	for _, match := range ipv4Pattern.FindAllString(text, -1) {
+		if net.ParseIP(match) == nil || seen[match] {
+			continue
+		}
+
+		seen[match] = true
+		addresses = append(addresses, match)
+	}
+
+	return addresses
+}
+
+// Reply adds an update to an abuse ticket, e.g. to report that the cause of
+// the complaint was addressed and delisting can be requested
+func Reply(sess *session.Session, ticketId int, entry string) error {
+	_, err := services.GetTicketService(sess).
+		Id(ticketId).
+		AddUpdate(&datatypes.Ticket_Update{Entry: sl.String(entry)}, nil)
+	if err != nil {
+		return fmt.Errorf("Error updating abuse ticket %d: %s", ticketId, err)
+	}
+
+	return nil
+}
+
+// GetAbuseEmails returns the email addresses abuse complaints of the account
+// are sent to
+func GetAbuseEmails(sess *session.Session) ([]string, error) {
+	emails, err := services.GetAccountService(sess).Mask("email").Unlimited().GetAbuseEmails()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving abuse emails: %s", err)
+	}
+
+	addresses := []string{}
+	for _, email := range emails {
+		addresses = append(addresses, sl.Get(email.Email, "").(string))
+	}
+
+	return addresses, nil
+}
+
+// SetAbuseEmails replaces the email addresses abuse complaints of the account
+// are sent to
+func SetAbuseEmails(sess *session.Session, emails []string) error {
+	if _, err := services.GetAccountService(sess).SetAbuseEmails(emails); err != nil {
+		return fmt.Errorf("Error setting abuse emails: %s", err)
+	}
+
+	return nil
+}
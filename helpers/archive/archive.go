@@ -0,0 +1,189 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package archive snapshots the full configuration of resources before they
+// are decommissioned, so it remains available for audits, or to recreate the
+// resource, once it has been cancelled.
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/helpers/billing"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Masks are the object masks used to snapshot resources of each service.
+// Passwords, and the user data of servers (which often holds bootstrap
+// credentials), are deliberately not included: pass a mask to Snapshot to
+// archive them.
+var Masks = map[string]string{
+	"SoftLayer_Virtual_Guest": "mask[id,globalIdentifier,hostname,domain,fullyQualifiedDomainName," +
+		"startCpus,maxMemory,hourlyBillingFlag,localDiskFlag,dedicatedAccountHostOnlyFlag,privateNetworkOnlyFlag," +
+		"createDate,notes,datacenter[name],operatingSystemReferenceCode," +
+		"operatingSystem[softwareLicense[softwareDescription]],blockDevices[device,diskImage[capacity,units]]," +
+		"networkComponents[name,port,speed,maxSpeed,primaryIpAddress,macAddress,securityGroupBindings[securityGroup[id,name]]]," +
+		"networkVlans[id,vlanNumber,networkSpace,primaryRouter[hostname]],sshKeys[id,label,fingerprint]," +
+		"tagReferences[tag[name]],billingItem[id,categoryCode,description,recurringFee,hourlyRecurringFee," +
+		"children[categoryCode,description,recurringFee,hourlyRecurringFee]]]",
+	"SoftLayer_Hardware_Server": "mask[id,globalIdentifier,hostname,domain,fullyQualifiedDomainName," +
+		"processorPhysicalCoreAmount,memoryCapacity,hourlyBillingFlag,provisionDate,notes," +
+		"datacenter[name],operatingSystem[softwareLicense[softwareDescription]]," +
+		"hardDrives[capacity,hardwareComponentModel[hardwareGenericComponentModel[hardwareComponentType]]]," +
+		"networkComponents[name,port,speed,maxSpeed,primaryIpAddress,macAddress]," +
+		"networkVlans[id,vlanNumber,networkSpace,primaryRouter[hostname]],tagReferences[tag[name]]," +
+		"billingItem[id,categoryCode,description,recurringFee,hourlyRecurringFee," +
+		"children[categoryCode,description,recurringFee,hourlyRecurringFee]]]",
+	"SoftLayer_Network_Storage": "mask[id,username,nasType,capacityGb,storageType[keyName],storageTierLevel," +
+		"serviceResourceBackendIpAddress,notes,createDate,allowedVirtualGuests[id,fullyQualifiedDomainName]," +
+		"allowedHardware[id,fullyQualifiedDomainName],allowedSubnets[networkIdentifier,cidr]," +
+		"schedules[type[keyname],retentionCount,minute,hour,dayOfWeek],billingItem[id,categoryCode,description,recurringFee]]",
+	"SoftLayer_Network_Vlan": "mask[id,vlanNumber,name,networkSpace,note,primaryRouter[hostname,datacenter[name]]," +
+		"subnets[id,networkIdentifier,cidr,subnetType],tagReferences[tag[name]]," +
+		"billingItem[id,categoryCode,description,recurringFee]]",
+	"SoftLayer_Network_Subnet": "mask[id,networkIdentifier,cidr,gateway,subnetType,note,networkVlan[id,vlanNumber]," +
+		"ipAddresses[ipAddress,note,isReserved],tagReferences[tag[name]],billingItem[id,categoryCode,description,recurringFee]]",
+}
+
+// Record is the archived configuration of a resource
+type Record struct {
+	Service    string          `json:"service"`
+	Id         int             `json:"id"`
+	ArchivedAt time.Time       `json:"archivedAt"`
+	Mask       string          `json:"mask"`
+	Object     json.RawMessage `json:"object"`
+}
+
+// Snapshot fetches the configuration of resource, using the mask of Masks
+// for its service, or mask if provided
+func Snapshot(sess *session.Session, resource billing.Resource, mask ...string) (Record, error) {
+	record := Record{Service: resource.Service, Id: resource.Id, ArchivedAt: time.Now().UTC()}
+
+	record.Mask = Masks[resource.Service]
+	if len(mask) > 0 {
+		record.Mask = mask[0]
+	}
+
+	object := map[string]interface{}{}
+	err := sess.DoRequest(resource.Service, "getObject", nil,
+		&sl.Options{Id: sl.Int(resource.Id), Mask: record.Mask}, &object)
+	if err != nil {
+		return record, fmt.Errorf("Error retrieving %s for archiving: %s", resource, err)
+	}
+
+	record.Object, err = json.Marshal(object)
+	if err != nil {
+		return record, fmt.Errorf("Error encoding %s for archiving: %s", resource, err)
+	}
+
+	return record, nil
+}
+
+// Decode decodes the archived object into v, typically a pointer to the
+// datatype of the service (e.g., *datatypes.Virtual_Guest)
+func (r Record) Decode(v interface{}) error {
+	return json.Unmarshal(r.Object, v)
+}
+
+// Write writes the record as a JSON bundle
+func (r Record) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// Save writes the record as a JSON bundle in dir, returning the path of the
+// file
+func Save(dir string, record Record) (string, error) {
+	name := fmt.Sprintf("%s-%d-%s.json",
+		strings.TrimPrefix(record.Service, "SoftLayer_"), record.Id, record.ArchivedAt.Format("20060102T150405Z"))
+	path := filepath.Join(dir, name)
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return "", fmt.Errorf("Error creating archive: %s", err)
+	}
+	defer f.Close()
+
+	if err = record.Write(f); err != nil {
+		return "", fmt.Errorf("Error writing archive %s: %s", path, err)
+	}
+
+	return path, nil
+}
+
+// Load reads a record saved by Save
+func Load(path string) (Record, error) {
+	record := Record{}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return record, err
+	}
+
+	if err = json.Unmarshal(data, &record); err != nil {
+		return record, fmt.Errorf("Error reading archive %s: %s", path, err)
+	}
+
+	return record, nil
+}
+
+// Cancel archives resource into dir, then cancels it with billing.Cancel.
+// The resource is not cancelled if it cannot be archived.
+func Cancel(
+	sess *session.Session, dir string, resource billing.Resource,
+	reason string, note string, immediate bool) (string, billing.Cancellation, error) {
+
+	return cancel(sess, dir, resource, func() (billing.Cancellation, error) {
+		return billing.Cancel(sess, resource, reason, note, immediate)
+	})
+}
+
+// ForceCancel is like Cancel, but cancels with billing.ForceCancel
+func ForceCancel(
+	sess *session.Session, dir string, resource billing.Resource,
+	reason string, note string, immediate bool) (string, billing.Cancellation, error) {
+
+	return cancel(sess, dir, resource, func() (billing.Cancellation, error) {
+		return billing.ForceCancel(sess, resource, reason, note, immediate)
+	})
+}
+
+func cancel(
+	sess *session.Session, dir string, resource billing.Resource,
+	cancelFunc func() (billing.Cancellation, error)) (string, billing.Cancellation, error) {
+
+	record, err := Snapshot(sess, resource)
+	if err != nil {
+		return "", billing.Cancellation{Resource: resource}, err
+	}
+
+	path, err := Save(dir, record)
+	if err != nil {
+		return "", billing.Cancellation{Resource: resource}, err
+	}
+
+	cancellation, err := cancelFunc()
+	return path, cancellation, err
+}
@@ -0,0 +1,285 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package audit exports the login history of the users of an account and its
+// event log as normalized records (e.g., for ingestion by a SIEM), picking up
+// where the previous export left off.
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Record kinds
+const (
+	LoginSucceeded = "login.succeeded"
+	LoginFailed    = "login.failed"
+	Event          = "event"
+)
+
+// DateFormat is the format of dates in object filters
+const DateFormat = "01/02/2006 15:04:05"
+
+// PageSize is the number of event log entries fetched per request
+const PageSize = 500
+
+// LoginMask is the object mask used by Logins to retrieve login attempts
+const LoginMask = "createDate,ipAddress,successFlag,userId,username"
+
+// EventMask is the object mask used by Events to retrieve event log entries
+const EventMask = "accountId,eventCreateDate,eventName,ipAddress,label,metaData," +
+	"objectId,objectName,traceId,userId,openIdConnectUserName,user[username]"
+
+// Record is a login attempt or an event log entry. Action, Object*, Label,
+// TraceId and Details are only set for events.
+type Record struct {
+	Time       time.Time `json:"time"`
+	Kind       string    `json:"kind"`
+	UserId     int       `json:"userId,omitempty"`
+	Username   string    `json:"username,omitempty"`
+	IpAddress  string    `json:"ipAddress,omitempty"`
+	Action     string    `json:"action,omitempty"`
+	ObjectName string    `json:"objectName,omitempty"`
+	ObjectId   int       `json:"objectId,omitempty"`
+	Label      string    `json:"label,omitempty"`
+	TraceId    string    `json:"traceId,omitempty"`
+	Details    string    `json:"details,omitempty"`
+}
+
+// Checkpoint is the time of the latest login attempt and event exported
+type Checkpoint struct {
+	Logins time.Time `json:"logins"`
+	Events time.Time `json:"events"`
+}
+
+// LoginFilter returns the object filter used by Logins to retrieve the login
+// attempts of a user made since the passed time, which is empty if it is zero
+func LoginFilter(since time.Time) string {
+	if since.IsZero() {
+		return ""
+	}
+
+	return filter.New(sinceFilter("loginAttempts.createDate", since)).Build()
+}
+
+// EventFilter returns the object filter used by Events, which is empty if
+// since is zero and no objectNames are passed
+func EventFilter(since time.Time, objectNames ...string) string {
+	filters := filter.New()
+	if !since.IsZero() {
+		filters = append(filters, sinceFilter("eventCreateDate", since))
+	}
+
+	if len(objectNames) > 0 {
+		names := make([]interface{}, len(objectNames))
+		for i, name := range objectNames {
+			names[i] = name
+		}
+		filters = append(filters, filter.Path("objectName").In(names...))
+	}
+
+	if len(filters) == 0 {
+		return ""
+	}
+
+	return filters.Build()
+}
+
+// sinceFilter selects the objects whose date property is not before since.
+// Dates are compared to the second, so it starts a second earlier.
+func sinceFilter(property string, since time.Time) filter.Filter {
+	return filter.Path(property).DateAfter(since.Add(-time.Second).Format(DateFormat))
+}
+
+// Logins returns the login attempts of all the users of the account made
+// since the passed time (or all of them if it is zero), oldest first
+func Logins(sess *session.Session, since time.Time) ([]Record, error) {
+	users, err := services.GetAccountService(sess).Mask("id,username").Unlimited().GetUsers()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving account users: %s", err)
+	}
+
+	records := []Record{}
+	for _, user := range users {
+		attempts, err := services.GetUserCustomerService(sess).
+			Id(*user.Id).
+			Mask(LoginMask).
+			Filter(LoginFilter(since)).
+			Unlimited().
+			GetLoginAttempts()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving login attempts of user %d: %s", *user.Id, err)
+		}
+
+		for _, attempt := range attempts {
+			record := loginRecord(attempt)
+			if record.UserId == 0 {
+				record.UserId = *user.Id
+			}
+			if record.Username == "" {
+				record.Username = sl.Get(user.Username, "").(string)
+			}
+			if !record.Time.Before(since) {
+				records = append(records, record)
+			}
+		}
+	}
+
+	sortRecords(records)
+	return records, nil
+}
+
+// Events returns the event log entries of the account created since the
+// passed time (or all of them if it is zero), oldest first. If objectNames are
+// passed, only events on these kinds of objects (e.g., "User") are returned.
+func Events(sess *session.Session, since time.Time, objectNames ...string) ([]Record, error) {
+	service := services.GetEventLogService(sess).Mask(EventMask).Filter(EventFilter(since, objectNames...))
+
+	records := []Record{}
+	for offset := 0; ; offset += PageSize {
+		events, err := service.Limit(PageSize).Offset(offset).GetAllObjects()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving event log: %s", err)
+		}
+
+		for _, event := range events {
+			if record := eventRecord(event); !record.Time.Before(since) {
+				records = append(records, record)
+			}
+		}
+
+		if len(events) < PageSize {
+			break
+		}
+	}
+
+	sortRecords(records)
+	return records, nil
+}
+
+// Export returns the login attempts and events (restricted to objectNames, if
+// any) recorded since the checkpoint, oldest first, and the checkpoint to pass
+// to the next export. Records at the checkpoint time itself are exported
+// again, so that none is missed; consumers should discard duplicates.
+func Export(sess *session.Session, checkpoint Checkpoint, objectNames ...string) ([]Record, Checkpoint, error) {
+	logins, err := Logins(sess, checkpoint.Logins)
+	if err != nil {
+		return nil, checkpoint, err
+	}
+
+	events, err := Events(sess, checkpoint.Events, objectNames...)
+	if err != nil {
+		return nil, checkpoint, err
+	}
+
+	next := checkpoint
+	if len(logins) > 0 {
+		next.Logins = logins[len(logins)-1].Time
+	}
+	if len(events) > 0 {
+		next.Events = events[len(events)-1].Time
+	}
+
+	records := append(logins, events...)
+	sortRecords(records)
+
+	return records, next, nil
+}
+
+func loginRecord(attempt datatypes.User_Customer_Access_Authentication) Record {
+	record := Record{
+		Kind:      LoginFailed,
+		UserId:    sl.Get(attempt.UserId, 0).(int),
+		Username:  sl.Get(attempt.Username, "").(string),
+		IpAddress: sl.Get(attempt.IpAddress, "").(string),
+	}
+
+	if attempt.CreateDate != nil {
+		record.Time = attempt.CreateDate.UTC()
+	}
+
+	if sl.Get(attempt.SuccessFlag, false).(bool) {
+		record.Kind = LoginSucceeded
+	}
+
+	return record
+}
+
+func eventRecord(event datatypes.Event_Log) Record {
+	record := Record{
+		Kind:       Event,
+		UserId:     sl.Get(event.UserId, 0).(int),
+		Username:   sl.Get(event.OpenIdConnectUserName, "").(string),
+		IpAddress:  sl.Get(event.IpAddress, "").(string),
+		Action:     sl.Get(event.EventName, "").(string),
+		ObjectName: sl.Get(event.ObjectName, "").(string),
+		ObjectId:   sl.Get(event.ObjectId, 0).(int),
+		Label:      sl.Get(event.Label, "").(string),
+		TraceId:    sl.Get(event.TraceId, "").(string),
+		Details:    sl.Get(event.MetaData, "").(string),
+	}
+
+	if event.EventCreateDate != nil {
+		record.Time = event.EventCreateDate.UTC()
+	}
+
+	if event.User != nil && event.User.Username != nil {
+		record.Username = *event.User.Username
+	}
+
+	return record
+}
+
+func sortRecords(records []Record) {
+	sort.SliceStable(records, func(i, j int) bool { return records[i].Time.Before(records[j].Time) })
+}
+
+// Write writes the records as JSON lines, one record per line
+func Write(w io.Writer, records []Record) error {
+	encoder := json.NewEncoder(w)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Write writes the checkpoint as JSON
+func (c Checkpoint) Write(w io.Writer) error {
+	return json.NewEncoder(w).Encode(c)
+}
+
+// ReadCheckpoint reads a checkpoint written by Write
+func ReadCheckpoint(r io.Reader) (Checkpoint, error) {
+	checkpoint := Checkpoint{}
+	if err := json.NewDecoder(r).Decode(&checkpoint); err != nil {
+		return checkpoint, fmt.Errorf("Error reading checkpoint: %s", err)
+	}
+
+	return checkpoint, nil
+}
@@ -0,0 +1,243 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package bandwidth manages bandwidth pools (SoftLayer_Network_Bandwidth_Version1_Allotment,
+// also known as virtual dedicated racks), which share the public bandwidth
+// allocation of their member devices.
+package bandwidth
+
+import (
+	"fmt"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// PoolAllotmentTypeId is the allotment type of bandwidth pools
+const PoolAllotmentTypeId = 2
+
+// Member kinds
+const (
+	Hardware     = "hardware"
+	VirtualGuest = "virtualGuest"
+	Controller   = "applicationDeliveryController"
+)
+
+// PoolMask is the default object mask used by GetPools and GetPool
+const PoolMask = "mask[id,name,createDate,locationGroupId,locationGroup[name]," +
+	"bandwidthAllotmentType[keyName],totalBandwidthAllocated,projectedPublicBandwidthUsage," +
+	"outboundPublicBandwidthUsage,billingCyclePublicUsageTotal,billingItem[id,nextBillDate]," +
+	"hardware[id,fullyQualifiedDomainName],virtualGuests[id,fullyQualifiedDomainName]," +
+	"applicationDeliveryControllers[id,name]]"
+
+// PoolFilter is the object filter used by GetPools to select the bandwidth
+// allotments that are pools
+var PoolFilter = filter.Path("bandwidthAllotments.bandwidthAllotmentTypeId").Eq(PoolAllotmentTypeId).Build()
+
+// Member is a device in a bandwidth pool
+type Member struct {
+	Kind string
+	Id   int
+	Name string
+}
+
+// Pool is a bandwidth pool with its member devices. Usage figures are in GB
+// for the current billing cycle.
+type Pool struct {
+	datatypes.Network_Bandwidth_Version1_Allotment
+
+	Members []Member
+}
+
+// Allocated returns the total bandwidth allocated to the pool by its members
+func (p Pool) Allocated() float64 {
+	return float64(sl.Get(p.TotalBandwidthAllocated, uint(0)).(uint))
+}
+
+// Projected returns the outbound public bandwidth usage projected for the
+// end of the billing cycle
+func (p Pool) Projected() float64 {
+	return float64(sl.Get(p.ProjectedPublicBandwidthUsage, datatypes.Float64(0)).(datatypes.Float64))
+}
+
+// ProjectedOverage returns the usage projected over the pool allocation, or
+// zero if the pool is projected to stay within its allocation
+func (p Pool) ProjectedOverage() float64 {
+	if overage := p.Projected() - p.Allocated(); overage > 0 {
+		return overage
+	}
+
+	return 0
+}
+
+// GetPools returns the bandwidth pools on the account, with their members
+func GetPools(sess *session.Session, mask ...string) ([]Pool, error) {
+	poolMask := PoolMask
+	if len(mask) > 0 {
+		poolMask = mask[0]
+	}
+
+	allotments, err := services.GetAccountService(sess).
+		Mask(poolMask).
+		Filter(PoolFilter).
+		Unlimited().
+		GetBandwidthAllotments()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving bandwidth pools: %s", err)
+	}
+
+	pools := make([]Pool, len(allotments))
+	for i, allotment := range allotments {
+		pools[i] = newPool(allotment)
+	}
+
+	return pools, nil
+}
+
+// GetPool returns the bandwidth pool with the given id
+func GetPool(sess *session.Session, poolId int, mask ...string) (Pool, error) {
+	poolMask := PoolMask
+	if len(mask) > 0 {
+		poolMask = mask[0]
+	}
+
+	allotment, err := services.GetNetworkBandwidthVersion1AllotmentService(sess).
+		Id(poolId).
+		Mask(poolMask).
+		GetObject()
+	if err != nil {
+		return Pool{}, fmt.Errorf("Error retrieving bandwidth pool %d: %s", poolId, err)
+	}
+
+	return newPool(allotment), nil
+}
+
+// GetPoolByName returns the bandwidth pool with the given name
+func GetPoolByName(sess *session.Session, name string, mask ...string) (Pool, error) {
+	pools, err := GetPools(sess, mask...)
+	if err != nil {
+		return Pool{}, err
+	}
+
+	for _, pool := range pools {
+		if sl.Get(pool.Name, "") == name {
+			return pool, nil
+		}
+	}
+
+	return Pool{}, fmt.Errorf("No bandwidth pool found with name of %s", name)
+}
+
+func newPool(allotment datatypes.Network_Bandwidth_Version1_Allotment) Pool {
+	pool := Pool{Network_Bandwidth_Version1_Allotment: allotment}
+
+	for _, hw := range allotment.Hardware {
+		pool.Members = append(pool.Members,
+			Member{Kind: Hardware, Id: sl.Get(hw.Id, 0).(int), Name: sl.Get(hw.FullyQualifiedDomainName, "").(string)})
+	}
+
+	for _, guest := range allotment.VirtualGuests {
+		pool.Members = append(pool.Members,
+			Member{Kind: VirtualGuest, Id: sl.Get(guest.Id, 0).(int), Name: sl.Get(guest.FullyQualifiedDomainName, "").(string)})
+	}
+
+	for _, adc := range allotment.ApplicationDeliveryControllers {
+		pool.Members = append(pool.Members,
+			Member{Kind: Controller, Id: sl.Get(adc.Id, 0).(int), Name: sl.Get(adc.Name, "").(string)})
+	}
+
+	return pool
+}
+
+// CreatePool creates an empty bandwidth pool in the region of the given
+// location group, as found in the locationGroup of existing pools
+func CreatePool(sess *session.Session, name string, locationGroupId int) (Pool, error) {
+	account, err := services.GetAccountService(sess).Mask("id").GetObject()
+	if err != nil {
+		return Pool{}, fmt.Errorf("Error retrieving account: %s", err)
+	}
+
+	allotment, err := services.GetNetworkBandwidthVersion1AllotmentService(sess).
+		CreateObject(&datatypes.Network_Bandwidth_Version1_Allotment{
+			AccountId:                account.Id,
+			BandwidthAllotmentTypeId: sl.Int(PoolAllotmentTypeId),
+			LocationGroupId:          sl.Int(locationGroupId),
+			Name:                     sl.String(name),
+		})
+	if err != nil {
+		return Pool{}, fmt.Errorf("Error creating bandwidth pool %s: %s", name, err)
+	}
+
+	return newPool(allotment), nil
+}
+
+// Move moves members into the pool with the given id. A device can only be in
+// one pool, so members are removed from their current pool.
+func Move(sess *session.Session, poolId int, members ...Member) error {
+	hardware, guests, adcs, err := split(members)
+	if err != nil {
+		return err
+	}
+
+	_, err = services.GetNetworkBandwidthVersion1AllotmentService(sess).
+		Id(poolId).
+		RequestVdrContentUpdates(hardware, nil, guests, nil, nil, adcs, nil)
+	if err != nil {
+		return fmt.Errorf("Error moving devices to bandwidth pool %d: %s", poolId, err)
+	}
+
+	return nil
+}
+
+// Remove removes members from the pool with the given id
+func Remove(sess *session.Session, poolId int, members ...Member) error {
+	hardware, guests, adcs, err := split(members)
+	if err != nil {
+		return err
+	}
+
+	_, err = services.GetNetworkBandwidthVersion1AllotmentService(sess).
+		Id(poolId).
+		RequestVdrContentUpdates(nil, hardware, nil, guests, nil, nil, adcs)
+	if err != nil {
+		return fmt.Errorf("Error removing devices from bandwidth pool %d: %s", poolId, err)
+	}
+
+	return nil
+}
+
+func split(members []Member) (
+	hardware []datatypes.Hardware, guests []datatypes.Virtual_Guest,
+	adcs []datatypes.Network_Application_Delivery_Controller, err error) {
+
+	for _, member := range members {
+		switch member.Kind {
+		case Hardware:
+			hardware = append(hardware, datatypes.Hardware{Id: sl.Int(member.Id)})
+		case VirtualGuest:
+			guests = append(guests, datatypes.Virtual_Guest{Id: sl.Int(member.Id)})
+		case Controller:
+			adcs = append(adcs, datatypes.Network_Application_Delivery_Controller{Id: sl.Int(member.Id)})
+		default:
+			return nil, nil, nil, fmt.Errorf("Unknown bandwidth pool member kind %q", member.Kind)
+		}
+	}
+
+	return hardware, guests, adcs, nil
+}
@@ -0,0 +1,154 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package billing
+
+import (
+	"strings"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// HoursPerMonth converts monthly fees into hourly run rates
+const HoursPerMonth = 730
+
+// Anomaly kinds
+const (
+	AnomalyNewItem   = "new_item"
+	AnomalyIncrease  = "increase"
+	AnomalyBandwidth = "bandwidth"
+)
+
+// RunRate is the hourly cost of a top level billing item (including its
+// children) at a point in time
+type RunRate struct {
+	BillingItemId int     `json:"billingItemId"`
+	Category      string  `json:"category"`
+	Description   string  `json:"description"`
+	Hourly        float64 `json:"hourly"`
+}
+
+// Anomaly is a billing item whose run rate deviates from its baseline
+type Anomaly struct {
+	Kind     string
+	Current  RunRate
+	Baseline float64
+}
+
+// AnomalyDetector compares current run rates against historical baselines.
+// Baselines are typically run rates saved (e.g., as JSON) by a previous run.
+type AnomalyDetector struct {
+	// Threshold is the ratio of current to baseline run rate above which an
+	// item is reported.  Defaults to 1.5.
+	Threshold float64
+
+	// MinNewItemRate is the hourly run rate from which items missing from the
+	// baseline are reported.  Defaults to 0 (every new item).
+	MinNewItemRate float64
+
+	// OnAnomaly is called for each anomaly found
+	OnAnomaly func(Anomaly)
+}
+
+// Detect reports, through OnAnomaly, the items of current that are new or
+// have grown beyond the threshold compared to baseline, and returns how many
+// were reported.  Increases of bandwidth items are reported even when they
+// had no baseline charge.
+func (d AnomalyDetector) Detect(baseline []RunRate, current []RunRate) int {
+	threshold := d.Threshold
+	if threshold == 0 {
+		threshold = 1.5
+	}
+
+	previous := map[int]float64{}
+	for _, rate := range baseline {
+		previous[rate.BillingItemId] = rate.Hourly
+	}
+
+	count := 0
+	report := func(anomaly Anomaly) {
+		count++
+		if d.OnAnomaly != nil {
+			d.OnAnomaly(anomaly)
+		}
+	}
+
+	for _, rate := range current {
+		before, known := previous[rate.BillingItemId]
+		bandwidth := strings.Contains(rate.Category, "bandwidth")
+
+		switch {
+		case !known && rate.Hourly >= d.MinNewItemRate && rate.Hourly > 0:
+			report(Anomaly{Kind: AnomalyNewItem, Current: rate})
+		case known && bandwidth && rate.Hourly > before*threshold:
+			report(Anomaly{Kind: AnomalyBandwidth, Current: rate, Baseline: before})
+		case known && before > 0 && rate.Hourly > before*threshold:
+			report(Anomaly{Kind: AnomalyIncrease, Current: rate, Baseline: before})
+		}
+	}
+
+	return count
+}
+
+// RunRateMask is the object mask used by GetRunRates
+const RunRateMask = "id,categoryCode,description,hourlyFlag,hourlyRecurringFee,recurringFee," +
+	"children[hourlyRecurringFee,recurringFee]"
+
+// GetRunRates returns the current hourly run rate of each top level billing
+// item of the account
+func GetRunRates(sess *session.Session) ([]RunRate, error) {
+	items, err := services.GetAccountService(sess).
+		Mask(RunRateMask).
+		Unlimited().
+		GetAllRecurringTopLevelBillingItems()
+	if err != nil {
+		return nil, err
+	}
+
+	return RunRates(items), nil
+}
+
+// RunRates computes the hourly run rates of billing items.  Monthly fees are
+// converted using HoursPerMonth.
+func RunRates(items []datatypes.Billing_Item) []RunRate {
+	rates := make([]RunRate, 0, len(items))
+	for _, item := range items {
+		hourly := itemRate(item)
+		for _, child := range item.Children {
+			hourly += itemRate(child)
+		}
+
+		rates = append(rates, RunRate{
+			BillingItemId: sl.Get(item.Id).(int),
+			Category:      sl.Get(item.CategoryCode, "").(string),
+			Description:   sl.Get(item.Description, "").(string),
+			Hourly:        hourly,
+		})
+	}
+
+	return rates
+}
+
+func itemRate(item datatypes.Billing_Item) float64 {
+	if item.HourlyRecurringFee != nil {
+		return float64(*item.HourlyRecurringFee)
+	}
+
+	return float64(sl.Get(item.RecurringFee).(datatypes.Float64)) / HoursPerMonth
+}
@@ -0,0 +1,219 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package billing
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Resource identifies a billed resource by the API service that manages it
+// (e.g., SoftLayer_Virtual_Guest) and its id
+type Resource struct {
+	Service string
+	Id      int
+}
+
+func (r Resource) String() string {
+	return fmt.Sprintf("%s %d", r.Service, r.Id)
+}
+
+// Cancellation holds the details of a submitted cancellation
+type Cancellation struct {
+	Resource    Resource
+	BillingItem datatypes.Billing_Item
+	Reason      datatypes.Billing_Item_Cancellation_Reason
+	Immediate   bool
+
+	// Request is the cancellation request created for the billing item.  Its
+	// TicketId identifies the ticket opened to track the cancellation.
+	Request datatypes.Billing_Item_Cancellation_Request
+}
+
+// dependents lists, for each service, the relational properties holding the
+// resources that stop working when an object of that service is cancelled
+var dependents = map[string][]string{
+	"SoftLayer_Network_Vlan":          {"virtualGuests", "hardware"},
+	"SoftLayer_Network_Subnet":        {"virtualGuests", "hardware"},
+	"SoftLayer_Network_Storage":       {"allowedVirtualGuests", "allowedHardware", "allowedSubnets"},
+	"SoftLayer_Network_Gateway":       {"insideVlans"},
+	"SoftLayer_Network_SecurityGroup": {"networkComponentBindings"},
+}
+
+// CancelBillingItemMask is the object mask used by Cancel to retrieve the
+// billing item of a resource
+const CancelBillingItemMask = "mask[id,description,cancellationDate]"
+
+// CancellationReasonMask is the object mask used by GetCancellationReason
+const CancellationReasonMask = "id,keyName,reason"
+
+// DependentsMask returns the object mask used by GetDependents for resources of
+// a service, or an empty mask for services with no known dependents
+func DependentsMask(service string) string {
+	properties, ok := dependents[service]
+	if !ok {
+		return ""
+	}
+
+	masks := []string{}
+	for _, property := range properties {
+		masks = append(masks, property+"[id]")
+	}
+
+	return "mask[" + strings.Join(masks, ",") + "]"
+}
+
+// Cancel cancels the billing item of resource.  reason is the key name (or
+// text) of one of the reasons listed by
+// SoftLayer_Billing_Item_Cancellation_Reason::getAllCancellationReasons, and
+// note is recorded on the cancellation.  If immediate is false, the resource is
+// cancelled on its next billing anniversary date.
+//
+// Resources that still have attached dependents (e.g., a VLAN with guests on
+// it, or a storage volume authorized to hosts) are not cancelled; use
+// ForceCancel to cancel them regardless.
+func Cancel(sess *session.Session, resource Resource, reason string, note string, immediate bool) (Cancellation, error) {
+	return cancel(sess, resource, reason, note, immediate, false)
+}
+
+// ForceCancel is like Cancel, but also cancels resources with attached
+// dependents.
+func ForceCancel(sess *session.Session, resource Resource, reason string, note string, immediate bool) (Cancellation, error) {
+	return cancel(sess, resource, reason, note, immediate, true)
+}
+
+func cancel(sess *session.Session, resource Resource, reason string, note string, immediate bool, force bool) (Cancellation, error) {
+	result := Cancellation{Resource: resource, Immediate: immediate}
+
+	cancelReason, err := GetCancellationReason(sess, reason)
+	if err != nil {
+		return result, err
+	}
+	result.Reason = cancelReason
+
+	err = sess.DoRequest(resource.Service, "getBillingItem", nil,
+		&sl.Options{Id: sl.Int(resource.Id), Mask: CancelBillingItemMask}, &result.BillingItem)
+	if err != nil {
+		return result, fmt.Errorf("Error retrieving billing item of %s: %s", resource, err)
+	}
+
+	if result.BillingItem.Id == nil {
+		return result, fmt.Errorf("No billing item found for %s", resource)
+	}
+
+	if result.BillingItem.CancellationDate != nil {
+		return result, fmt.Errorf("%s is already scheduled for cancellation", resource)
+	}
+
+	if !force {
+		attached, err := GetDependents(sess, resource)
+		if err != nil {
+			return result, err
+		}
+
+		if len(attached) > 0 {
+			return result, fmt.Errorf(
+				"%s has attached dependents (%s); detach them or use ForceCancel",
+				resource, describeDependents(attached))
+		}
+	}
+
+	template := datatypes.Billing_Item_Cancellation_Request{
+		BillingCancelReasonId: cancelReason.Id,
+		Notes:                 sl.String(note),
+		Items: []datatypes.Billing_Item_Cancellation_Request_Item{
+			{
+				BillingItemId:             result.BillingItem.Id,
+				ImmediateCancellationFlag: sl.Bool(immediate),
+			},
+		},
+	}
+
+	result.Request, err = services.GetBillingItemCancellationRequestService(sess).CreateObject(&template)
+	if err != nil {
+		return result, fmt.Errorf("Error cancelling %s: %s", resource, err)
+	}
+
+	return result, nil
+}
+
+// GetCancellationReason returns the cancellation reason with the provided key
+// name or reason text.
+func GetCancellationReason(sess *session.Session, reason string) (datatypes.Billing_Item_Cancellation_Reason, error) {
+	reasons, err := services.GetBillingItemCancellationReasonService(sess).
+		Mask(CancellationReasonMask).
+		GetAllCancellationReasons()
+	if err != nil {
+		return datatypes.Billing_Item_Cancellation_Reason{}, fmt.Errorf("Error retrieving cancellation reasons: %s", err)
+	}
+
+	keyNames := []string{}
+	for _, r := range reasons {
+		keyName := sl.Get(r.KeyName, "").(string)
+		if strings.EqualFold(keyName, reason) || strings.EqualFold(sl.Get(r.Reason, "").(string), reason) {
+			return r, nil
+		}
+		keyNames = append(keyNames, keyName)
+	}
+	sort.Strings(keyNames)
+
+	return datatypes.Billing_Item_Cancellation_Reason{}, fmt.Errorf(
+		"Unknown cancellation reason %q; valid reasons are %s", reason, strings.Join(keyNames, ", "))
+}
+
+// GetDependents returns the number of attached dependents of resource, by the
+// name of the property that holds them.  Resources of services with no known
+// dependents always return an empty map.
+func GetDependents(sess *session.Session, resource Resource) (map[string]int, error) {
+	attached := map[string]int{}
+
+	properties, ok := dependents[resource.Service]
+	if !ok {
+		return attached, nil
+	}
+
+	object := map[string]interface{}{}
+	err := sess.DoRequest(resource.Service, "getObject", nil,
+		&sl.Options{Id: sl.Int(resource.Id), Mask: DependentsMask(resource.Service)}, &object)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving dependents of %s: %s", resource, err)
+	}
+
+	for _, property := range properties {
+		if items, ok := object[property].([]interface{}); ok && len(items) > 0 {
+			attached[property] = len(items)
+		}
+	}
+
+	return attached, nil
+}
+
+func describeDependents(attached map[string]int) string {
+	descriptions := []string{}
+	for property, count := range attached {
+		descriptions = append(descriptions, fmt.Sprintf("%d %s", count, property))
+	}
+	sort.Strings(descriptions)
+
+	return strings.Join(descriptions, ", ")
+}
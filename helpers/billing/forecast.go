@@ -0,0 +1,152 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package billing
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// ForecastMask is the object mask used by GetInvoiceForecast
+const ForecastMask = "id,categoryCode,hourlyFlag,hourlyRecurringFee,nextBillDate," +
+	"nextInvoiceTotalRecurringAmount,nextInvoiceTotalOneTimeAmount,children[hourlyRecurringFee]"
+
+// CategoryForecast is the projected next invoice amount of the billing items
+// of one category (e.g., guest_core or server)
+type CategoryForecast struct {
+	Category string
+	Items    int
+
+	// Recurring and OneTime are the amounts of the monthly billing items
+	Recurring float64
+	OneTime   float64
+
+	// UsageToDate is the amount of hourly billing items accrued so far this
+	// billing cycle, and ProjectedUsage the amount they will have accrued by the
+	// end of the cycle, if they keep running
+	UsageToDate    float64
+	ProjectedUsage float64
+}
+
+// Total returns the projected amount of the category
+func (c CategoryForecast) Total() float64 {
+	return round(c.Recurring + c.OneTime + c.ProjectedUsage)
+}
+
+// Forecast is the projection of the next invoice of an account, before taxes
+// and credits
+type Forecast struct {
+	AsOf       time.Time
+	Categories map[string]CategoryForecast
+}
+
+// Total returns the projected amount of the invoice
+func (f Forecast) Total() float64 {
+	total := 0.0
+	for _, category := range f.Categories {
+		total += category.Total()
+	}
+
+	return round(total)
+}
+
+// Sorted returns the category forecasts, largest first
+func (f Forecast) Sorted() []CategoryForecast {
+	sorted := make([]CategoryForecast, 0, len(f.Categories))
+	for _, category := range f.Categories {
+		sorted = append(sorted, category)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Total() != sorted[j].Total() {
+			return sorted[i].Total() > sorted[j].Total()
+		}
+		return sorted[i].Category < sorted[j].Category
+	})
+
+	return sorted
+}
+
+// GetInvoiceForecast projects the next invoice of the account from its
+// current recurring billing items and the usage charges of its hourly billing
+// items so far this billing cycle
+func GetInvoiceForecast(sess *session.Session) (Forecast, error) {
+	items, err := services.GetAccountService(sess).
+		Mask(ForecastMask).
+		Unlimited().
+		GetNextInvoiceTopLevelBillingItems()
+	if err != nil {
+		return Forecast{}, err
+	}
+
+	return ProjectInvoice(items, time.Now()), nil
+}
+
+// ProjectInvoice projects the next invoice from billing items retrieved with
+// at least the properties of the mask used by GetInvoiceForecast, as of now.
+// Hourly items are assumed to keep running until their next bill date (or the
+// start of the next month, if they have none) at their current hourly rate.
+func ProjectInvoice(items []datatypes.Billing_Item, now time.Time) Forecast {
+	forecast := Forecast{AsOf: now, Categories: map[string]CategoryForecast{}}
+
+	for _, item := range items {
+		code := sl.Get(item.CategoryCode, "other").(string)
+		category := forecast.Categories[code]
+		category.Category = code
+		category.Items++
+
+		amount := float64(sl.Get(item.NextInvoiceTotalRecurringAmount).(datatypes.Float64))
+		category.OneTime += float64(sl.Get(item.NextInvoiceTotalOneTimeAmount).(datatypes.Float64))
+
+		if sl.Get(item.HourlyFlag).(bool) {
+			rate := float64(sl.Get(item.HourlyRecurringFee).(datatypes.Float64))
+			for _, child := range item.Children {
+				rate += float64(sl.Get(child.HourlyRecurringFee).(datatypes.Float64))
+			}
+
+			category.UsageToDate += amount
+			category.ProjectedUsage += amount + rate*remainingHours(item, now)
+		} else {
+			category.Recurring += amount
+		}
+
+		forecast.Categories[code] = category
+	}
+
+	return forecast
+}
+
+// remainingHours returns the number of (started) hours left in the billing
+// cycle of the item
+func remainingHours(item datatypes.Billing_Item, now time.Time) float64 {
+	end := time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, now.Location())
+	if item.NextBillDate != nil && item.NextBillDate.After(now) {
+		end = item.NextBillDate.Time
+	}
+
+	return math.Ceil(end.Sub(now).Hours())
+}
+
+func round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,502 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package blueprint provisions reusable sets of resources ("blueprints"):
+// virtual guests, hardware and storage, with their tags, DNS records and
+// security group, defined in Go or in a YAML (or JSON) spec file:
+//
+//	name: web
+//	guests:
+//	  - hostname: web1
+//	    domain: example.com
+//	    startCpus: 2
+//	    maxMemory: 4096
+//	    datacenter:
+//	      name: dal10
+//	tags: [web, production]
+//	dns:
+//	  zoneId: 12345
+//	securityGroup:
+//	  name: web
+//	  rules:
+//	    - direction: ingress
+//	      protocol: tcp
+//	      portRangeMin: 443
+//	      portRangeMax: 443
+//
+// Blueprints are provisioned as a saga, so the resources already created are
+// rolled back if a later step fails.
+package blueprint
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/helpers/order"
+	"github.com/softlayer/softlayer-go/helpers/saga"
+	"github.com/softlayer/softlayer-go/helpers/spec"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// DefaultProvisionTimeout is how long ProvisionBlueprint waits by default for
+// each server to be provisioned, before creating its DNS record or attaching
+// it to the security group
+const DefaultProvisionTimeout = 2 * time.Hour
+
+// DefaultTTL is the TTL of the DNS records created for the servers
+const DefaultTTL = 900
+
+// ServerMask is the object mask used to wait for servers to be provisioned
+const ServerMask = "id,provisionDate,primaryIpAddress,primaryBackendIpAddress," +
+	"primaryNetworkComponent[id],primaryBackendNetworkComponent[id]"
+
+// ProvisionPollInterval is the interval at which servers are checked while
+// waiting for them to be provisioned
+var ProvisionPollInterval = 30 * time.Second
+
+// Blueprint is a reusable set of resources
+type Blueprint struct {
+	Name     string                    `json:"name"`
+	Guests   []datatypes.Virtual_Guest `json:"guests,omitempty"`
+	Hardware []datatypes.Hardware      `json:"hardware,omitempty"`
+
+	// Storage are the orders of block and file storage volumes
+	Storage []datatypes.Container_Product_Order_Network_Storage_AsAService `json:"storage,omitempty"`
+
+	// Tags are set on every guest and hardware server
+	Tags []string `json:"tags,omitempty"`
+
+	// Dns, when set, creates an A record for every guest and hardware server
+	Dns *Dns `json:"dns,omitempty"`
+
+	// SecurityGroup, when set, is created and the primary network components
+	// of every guest and hardware server are attached to it
+	SecurityGroup *spec.SecurityGroup `json:"securityGroup,omitempty"`
+
+	// Timeout bounds the wait for each server to be provisioned. Defaults to
+	// DefaultProvisionTimeout.
+	Timeout time.Duration `json:"-"`
+}
+
+// Dns describes the A records created for the servers of a blueprint, named
+// after their hostname
+type Dns struct {
+	ZoneId int `json:"zoneId"`
+
+	// Ttl defaults to DefaultTTL
+	Ttl int `json:"ttl,omitempty"`
+
+	// Private uses the backend IP addresses of the servers
+	Private bool `json:"private,omitempty"`
+}
+
+// server is the address and network components of a provisioned server, as
+// recorded in the saga
+type server struct {
+	PublicIp            string `json:"publicIp,omitempty"`
+	PrivateIp           string `json:"privateIp,omitempty"`
+	NetworkComponentIds []int  `json:"networkComponentIds,omitempty"`
+}
+
+// Load reads a blueprint from the spec file at path
+func Load(path string) (Blueprint, error) {
+	blueprint := Blueprint{}
+	err := spec.Load(path, &blueprint)
+
+	return blueprint, err
+}
+
+// Validate checks that the servers of the blueprint have distinct hostnames,
+// which name the values recorded by the saga, and the DNS records
+func (b Blueprint) Validate() error {
+	if len(b.Guests)+len(b.Hardware)+len(b.Storage) == 0 {
+		return fmt.Errorf("Blueprint %s has no resources", b.Name)
+	}
+
+	seen := map[string]bool{}
+	for _, hostname := range b.hostnames() {
+		if hostname == "" {
+			return fmt.Errorf("Blueprint %s has a server without a hostname", b.Name)
+		}
+
+		if seen[hostname] {
+			return fmt.Errorf("Blueprint %s has several servers named %s", b.Name, hostname)
+		}
+		seen[hostname] = true
+	}
+
+	if b.Dns != nil && b.Dns.ZoneId == 0 {
+		return fmt.Errorf("Blueprint %s has no DNS zone id", b.Name)
+	}
+
+	if b.SecurityGroup != nil && b.SecurityGroup.Name == "" {
+		return fmt.Errorf("Blueprint %s has a security group without a name", b.Name)
+	}
+
+	return nil
+}
+
+// Saga returns the saga provisioning the blueprint, e.g. to record its progress
+// in a journal before running it. The ids of the servers are recorded under
+// their hostname, and those of the other resources under "record <hostname>",
+// "storage order <index>" (the id of the order item) and "security group".
+func Saga(sess *session.Session, blueprint Blueprint) (*saga.Saga, error) {
+	if err := blueprint.Validate(); err != nil {
+		return nil, err
+	}
+
+	steps := []saga.Step{}
+	guests := map[string]bool{}
+	for _, guest := range blueprint.Guests {
+		hostname := sl.Get(guest.Hostname, "").(string)
+		guests[hostname] = true
+		steps = append(steps, saga.CreateVirtualGuest(sess, hostname, guest))
+		if len(blueprint.Tags) > 0 {
+			steps = append(steps, saga.TagVirtualGuest(sess, hostname, blueprint.Tags...))
+		}
+	}
+
+	for _, hardware := range blueprint.Hardware {
+		steps = append(steps, createHardware(sess, sl.Get(hardware.Hostname, "").(string), hardware, blueprint.Tags))
+	}
+
+	for i, storage := range blueprint.Storage {
+		steps = append(steps, orderStorage(sess, fmt.Sprintf("storage order %d", i), storage))
+	}
+
+	if blueprint.Dns != nil || blueprint.SecurityGroup != nil {
+		for _, hostname := range blueprint.hostnames() {
+			steps = append(steps, waitForServer(sess, hostname, guests[hostname], blueprint.Timeout))
+		}
+	}
+
+	if blueprint.Dns != nil {
+		for _, hostname := range blueprint.hostnames() {
+			steps = append(steps, saga.CreateDnsRecord(sess, "record "+hostname, dnsRecord(hostname, *blueprint.Dns)))
+		}
+	}
+
+	if blueprint.SecurityGroup != nil {
+		steps = append(steps,
+			createSecurityGroup(sess, "security group", *blueprint.SecurityGroup),
+			attachSecurityGroup(sess, "security group", blueprint.hostnames()))
+	}
+
+	return saga.New(steps...), nil
+}
+
+// ProvisionBlueprint provisions the resources of the blueprint, and returns
+// the saga holding their ids. If a step fails, the resources created are
+// rolled back, and the error is a *saga.Error.
+func ProvisionBlueprint(ctx context.Context, sess *session.Session, blueprint Blueprint) (*saga.Saga, error) {
+	s, err := Saga(sess, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, s.Run(ctx)
+}
+
+func (b Blueprint) hostnames() []string {
+	hostnames := []string{}
+	for _, guest := range b.Guests {
+		hostnames = append(hostnames, sl.Get(guest.Hostname, "").(string))
+	}
+
+	for _, hardware := range b.Hardware {
+		hostnames = append(hostnames, sl.Get(hardware.Hostname, "").(string))
+	}
+
+	return hostnames
+}
+
+// createHardware is a step ordering a hardware server from template and setting
+// its tags, and recording its id under key. It is compensated by cancelling the
+// server.
+func createHardware(sess *session.Session, key string, template datatypes.Hardware, tags []string) saga.Step {
+	return saga.Step{
+		Name: "create hardware " + key,
+		Do: func(ctx context.Context, s *saga.Saga) error {
+			hardware, err := services.GetHardwareService(sess).CreateObject(&template)
+			if err != nil {
+				return err
+			}
+
+			if err = s.Set(key, sl.Get(hardware.Id)); err != nil {
+				return err
+			}
+
+			if len(tags) == 0 {
+				return nil
+			}
+
+			_, err = services.GetHardwareService(sess).Id(*hardware.Id).SetTags(sl.String(strings.Join(tags, ",")))
+			return err
+		},
+		Compensate: func(s *saga.Saga) error {
+			hardwareId, err := s.Int(key)
+			if err != nil {
+				return err
+			}
+
+			_, err = services.GetHardwareService(sess).Id(hardwareId).DeleteObject()
+			return err
+		},
+	}
+}
+
+// orderStorage is a step placing a storage order, and recording the id of its
+// first item under key. It is compensated by cancelling the billing item of
+// the order.
+func orderStorage(
+	sess *session.Session, key string,
+	storage datatypes.Container_Product_Order_Network_Storage_AsAService) saga.Step {
+
+	return saga.Step{
+		Name: "order " + key,
+		Do: func(ctx context.Context, s *saga.Saga) error {
+			receipt, err := order.Place(sess, &storage, false)
+			if err != nil {
+				return err
+			}
+
+			if receipt.PlacedOrder == nil || len(receipt.PlacedOrder.Items) == 0 {
+				return fmt.Errorf("Order %d has no items", sl.Get(receipt.OrderId, 0).(int))
+			}
+
+			return s.Set(key, sl.Get(receipt.PlacedOrder.Items[0].Id))
+		},
+		Compensate: func(s *saga.Saga) error {
+			itemId, err := s.Int(key)
+			if err != nil {
+				return err
+			}
+
+			item, err := services.GetBillingOrderItemService(sess).Id(itemId).Mask("billingItem[id]").GetObject()
+			if err != nil {
+				return err
+			}
+
+			if item.BillingItem == nil || item.BillingItem.Id == nil {
+				return fmt.Errorf("Order item %d has no billing item to cancel", itemId)
+			}
+
+			_, err = services.GetBillingItemService(sess).Id(*item.BillingItem.Id).CancelService()
+			return err
+		},
+	}
+}
+
+// waitForServer is a step waiting for the server (a virtual guest if guest is
+// true) whose id was recorded under key to be provisioned, and recording its
+// addresses and network components under "server <key>"
+func waitForServer(sess *session.Session, key string, guest bool, timeout time.Duration) saga.Step {
+	if timeout <= 0 {
+		timeout = DefaultProvisionTimeout
+	}
+
+	return saga.Step{
+		Name: "wait for " + key,
+		Do: func(ctx context.Context, s *saga.Saga) error {
+			id, err := s.Int(key)
+			if err != nil {
+				return err
+			}
+
+			deadline := time.Now().Add(timeout)
+			for {
+				provisioned, found, err := getServer(sess, guest, id)
+				if err != nil {
+					return err
+				}
+
+				if provisioned {
+					return s.Set("server "+key, found)
+				}
+
+				if time.Now().Add(ProvisionPollInterval).After(deadline) {
+					return fmt.Errorf("Timed out waiting for %s to be provisioned", key)
+				}
+
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(ProvisionPollInterval):
+				}
+			}
+		},
+	}
+}
+
+func getServer(sess *session.Session, guest bool, id int) (bool, server, error) {
+	var provisionDate *datatypes.Time
+	var publicIp, privateIp *string
+	componentIds := []*int{}
+	if guest {
+		g, err := services.GetVirtualGuestService(sess).Id(id).Mask(ServerMask).GetObject()
+		if err != nil {
+			return false, server{}, err
+		}
+		provisionDate, publicIp, privateIp = g.ProvisionDate, g.PrimaryIpAddress, g.PrimaryBackendIpAddress
+		if g.PrimaryNetworkComponent != nil {
+			componentIds = append(componentIds, g.PrimaryNetworkComponent.Id)
+		}
+		if g.PrimaryBackendNetworkComponent != nil {
+			componentIds = append(componentIds, g.PrimaryBackendNetworkComponent.Id)
+		}
+	} else {
+		hardware, err := services.GetHardwareService(sess).Id(id).Mask(ServerMask).GetObject()
+		if err != nil {
+			return false, server{}, err
+		}
+		provisionDate, publicIp, privateIp = hardware.ProvisionDate, hardware.PrimaryIpAddress, hardware.PrimaryBackendIpAddress
+		if hardware.PrimaryNetworkComponent != nil {
+			componentIds = append(componentIds, hardware.PrimaryNetworkComponent.Id)
+		}
+		if hardware.PrimaryBackendNetworkComponent != nil {
+			componentIds = append(componentIds, hardware.PrimaryBackendNetworkComponent.Id)
+		}
+	}
+
+	found := server{
+		PublicIp:  sl.Get(publicIp, "").(string),
+		PrivateIp: sl.Get(privateIp, "").(string),
+	}
+	for _, componentId := range componentIds {
+		if componentId != nil {
+			found.NetworkComponentIds = append(found.NetworkComponentIds, *componentId)
+		}
+	}
+
+	return provisionDate != nil, found, nil
+}
+
+// dnsRecord returns the A record of the server named hostname
+func dnsRecord(hostname string, dns Dns) func(s *saga.Saga) (datatypes.Dns_Domain_ResourceRecord, error) {
+	return func(s *saga.Saga) (datatypes.Dns_Domain_ResourceRecord, error) {
+		var found server
+		if err := s.Get("server "+hostname, &found); err != nil {
+			return datatypes.Dns_Domain_ResourceRecord{}, err
+		}
+
+		address := found.PublicIp
+		if dns.Private || address == "" {
+			address = found.PrivateIp
+		}
+
+		ttl := dns.Ttl
+		if ttl == 0 {
+			ttl = DefaultTTL
+		}
+
+		return datatypes.Dns_Domain_ResourceRecord{
+			DomainId: sl.Int(dns.ZoneId),
+			Type:     sl.String("a"),
+			Host:     sl.String(hostname),
+			Data:     sl.String(address),
+			Ttl:      sl.Int(ttl),
+		}, nil
+	}
+}
+
+// createSecurityGroup is a step creating the security group and its rules, and
+// recording its id under key. It is compensated by deleting the group.
+func createSecurityGroup(sess *session.Session, key string, group spec.SecurityGroup) saga.Step {
+	return saga.Step{
+		Name: "create " + key,
+		Do: func(ctx context.Context, s *saga.Saga) error {
+			template := datatypes.Network_SecurityGroup{Name: sl.String(group.Name)}
+			if group.Description != "" {
+				template.Description = sl.String(group.Description)
+			}
+
+			created, err := services.GetNetworkSecurityGroupService(sess).CreateObject(&template)
+			if err != nil {
+				return err
+			}
+
+			if err = s.Set(key, sl.Get(created.Id)); err != nil {
+				return err
+			}
+
+			if len(group.Rules) == 0 {
+				return nil
+			}
+
+			_, err = services.GetNetworkSecurityGroupService(sess).Id(*created.Id).AddRules(group.Rules)
+			return err
+		},
+		Compensate: func(s *saga.Saga) error {
+			groupId, err := s.Int(key)
+			if err != nil {
+				return err
+			}
+
+			_, err = services.GetNetworkSecurityGroupService(sess).Id(groupId).DeleteObject()
+			return err
+		},
+	}
+}
+
+// attachSecurityGroup is a step attaching the network components of the
+// servers (named by their hostname) to the security group whose id was recorded under key. It is
+// compensated by detaching them.
+func attachSecurityGroup(sess *session.Session, key string, servers []string) saga.Step {
+	components := func(s *saga.Saga) (int, []int, error) {
+		groupId, err := s.Int(key)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		ids := []int{}
+		for _, hostname := range servers {
+			var found server
+			if err = s.Get("server "+hostname, &found); err != nil {
+				return 0, nil, err
+			}
+			ids = append(ids, found.NetworkComponentIds...)
+		}
+
+		return groupId, ids, nil
+	}
+
+	return saga.Step{
+		Name: "attach servers to " + key,
+		Do: func(ctx context.Context, s *saga.Saga) error {
+			groupId, ids, err := components(s)
+			if err != nil || len(ids) == 0 {
+				return err
+			}
+
+			_, err = services.GetNetworkSecurityGroupService(sess).Id(groupId).AttachNetworkComponents(ids)
+			return err
+		},
+		Compensate: func(s *saga.Saga) error {
+			groupId, ids, err := components(s)
+			if err != nil || len(ids) == 0 {
+				return err
+			}
+
+			_, err = services.GetNetworkSecurityGroupService(sess).Id(groupId).DetachNetworkComponents(ids)
+			return err
+		},
+	}
+}
@@ -0,0 +1,140 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package brand provides helpers for brand (reseller) accounts, to report on
+// the accounts they own and aggregate their billing.
+package brand
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// OwnedAccount is an account owned by a brand, with its resource counts and
+// billing totals
+type OwnedAccount struct {
+	Id                int
+	CompanyName       string
+	Status            string
+	HardwareCount     int
+	VirtualGuestCount int
+	StorageCount      int
+	VlanCount         int
+	Balance           float64
+	NextInvoiceTotal  float64
+}
+
+// Summary aggregates the billing of the accounts owned by a brand
+type Summary struct {
+	Accounts         int
+	Balance          float64
+	NextInvoiceTotal float64
+
+	// ByStatus is the number of accounts of each status (e.g., "Active")
+	ByStatus map[string]int
+}
+
+// OwnedAccountMask is the object mask used to retrieve the accounts owned by a
+// brand
+const OwnedAccountMask = "id,companyName,accountStatus[name],hardwareCount,virtualGuestCount," +
+	"networkStorageCount,networkVlanCount,balance,nextInvoiceTotalAmount"
+
+// GetBrandId returns the id of the brand of the account of the session user
+func GetBrandId(sess *session.Session) (int, error) {
+	account, err := services.GetAccountService(sess).Mask("id,brandId").GetObject()
+	if err != nil {
+		return 0, fmt.Errorf("Error retrieving account brand: %s", err)
+	}
+
+	if account.BrandId == nil {
+		return 0, fmt.Errorf("Account %d has no brand", sl.Get(account.Id, 0))
+	}
+
+	return *account.BrandId, nil
+}
+
+// GetOwnedAccounts returns the accounts owned by the brand, ordered by id.
+// Only active accounts are returned, unless all is true.
+func GetOwnedAccounts(sess *session.Session, brandId int, all bool) ([]OwnedAccount, error) {
+	service := services.GetBrandService(sess).Id(brandId).Mask(OwnedAccountMask).Unlimited()
+
+	var accounts []datatypes.Account
+	var err error
+	if all {
+		accounts, err = service.GetAllOwnedAccounts()
+	} else {
+		accounts, err = service.GetOwnedAccounts()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving accounts of brand %d: %s", brandId, err)
+	}
+
+	owned := make([]OwnedAccount, len(accounts))
+	for i, account := range accounts {
+		owned[i] = OwnedAccount{
+			Id:                sl.Get(account.Id, 0).(int),
+			CompanyName:       sl.Get(account.CompanyName, "").(string),
+			HardwareCount:     int(sl.Get(account.HardwareCount, uint(0)).(uint)),
+			VirtualGuestCount: int(sl.Get(account.VirtualGuestCount, uint(0)).(uint)),
+			StorageCount:      int(sl.Get(account.NetworkStorageCount, uint(0)).(uint)),
+			VlanCount:         int(sl.Get(account.NetworkVlanCount, uint(0)).(uint)),
+			Balance:           float64(sl.Get(account.Balance, datatypes.Float64(0)).(datatypes.Float64)),
+			NextInvoiceTotal:  float64(sl.Get(account.NextInvoiceTotalAmount, datatypes.Float64(0)).(datatypes.Float64)),
+		}
+
+		if account.AccountStatus != nil {
+			owned[i].Status = sl.Get(account.AccountStatus.Name, "").(string)
+		}
+	}
+
+	sort.Slice(owned, func(i, j int) bool { return owned[i].Id < owned[j].Id })
+	return owned, nil
+}
+
+// Summarize aggregates the billing of owned accounts
+func Summarize(accounts []OwnedAccount) Summary {
+	summary := Summary{Accounts: len(accounts), ByStatus: map[string]int{}}
+
+	for _, account := range accounts {
+		summary.Balance += account.Balance
+		summary.NextInvoiceTotal += account.NextInvoiceTotal
+		summary.ByStatus[account.Status]++
+	}
+
+	return summary
+}
+
+// CatalogMask is the object mask used by GetCatalogPrices
+const CatalogMask = "id,prices[id,recurringFee,hourlyRecurringFee,setupFee,oneTimeFee,item[id,keyName,description]]"
+
+// GetCatalogPrices returns the item prices of the catalog of the brand, with
+// their items and fees. Brand catalogs are read only through the API.
+func GetCatalogPrices(sess *session.Session, brandId int) ([]datatypes.Product_Item_Price, error) {
+	catalog, err := services.GetBrandService(sess).
+		Id(brandId).
+		Mask(CatalogMask).
+		GetCatalog()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving catalog of brand %d: %s", brandId, err)
+	}
+
+	return catalog.Prices, nil
+}
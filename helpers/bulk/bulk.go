@@ -0,0 +1,343 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package bulk runs long operations over many items (e.g., tagging 5,000
+// guests, or cancelling 300 volumes) with bounded concurrency, recording the
+// outcome of each item in a Store so that an interrupted job can be resumed
+// where it stopped.
+package bulk
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Item statuses
+const (
+	Succeeded = "succeeded"
+	Failed    = "failed"
+	Skipped   = "skipped"
+)
+
+// Result is the outcome of the operation for one item
+type Result struct {
+	Key      string    `json:"key"`
+	Status   string    `json:"status"`
+	Error    string    `json:"error,omitempty"`
+	Attempts int       `json:"attempts"`
+	Finished time.Time `json:"finished"`
+}
+
+// Store persists the results of a job
+type Store interface {
+	// Load returns the results saved so far, keyed by item key
+	Load() (map[string]Result, error)
+
+	// Save records the result of an item, replacing any previous result for
+	// the same key. It is called from a single goroutine.
+	Save(result Result) error
+}
+
+// MemoryStore keeps results in memory, e.g. for jobs which need a report but
+// no resumption
+type MemoryStore struct {
+	results map[string]Result
+}
+
+// Load returns the results saved so far
+func (m *MemoryStore) Load() (map[string]Result, error) {
+	results := map[string]Result{}
+	for key, result := range m.results {
+		results[key] = result
+	}
+
+	return results, nil
+}
+
+// Save records the result of an item
+func (m *MemoryStore) Save(result Result) error {
+	if m.results == nil {
+		m.results = map[string]Result{}
+	}
+
+	m.results[result.Key] = result
+	return nil
+}
+
+// FileStore appends results to a file as JSON lines, so progress survives a
+// crash of the process. The last line for a key wins.
+type FileStore struct {
+	Path string
+}
+
+// Load reads the results saved in the file, if it exists
+func (f FileStore) Load() (map[string]Result, error) {
+	results := map[string]Result{}
+
+	file, err := os.Open(f.Path)
+	if os.IsNotExist(err) {
+		return results, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		result := Result{}
+		if err := json.Unmarshal(scanner.Bytes(), &result); err != nil {
+			// A partially written last line is expected after a crash
+			if !scanner.Scan() {
+				break
+			}
+			return nil, fmt.Errorf("Error reading %s, line %d: %s", f.Path, line, err)
+		}
+
+		results[result.Key] = result
+	}
+
+	return results, scanner.Err()
+}
+
+// Save appends the result to the file
+func (f FileStore) Save(result Result) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(f.Path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err = file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// Job applies Do to each item of a bulk operation
+type Job struct {
+	// Do performs the operation on the item with the given key
+	Do func(ctx context.Context, key string) error
+
+	// Store records progress. Defaults to a MemoryStore.
+	Store Store
+
+	// Concurrency is the number of items processed at once. Defaults to 4.
+	Concurrency int
+
+	// Attempts is the number of times Do is tried for an item before it is
+	// recorded as failed. Defaults to 1.
+	Attempts int
+
+	// RetryFailed causes items recorded as failed by a previous run to be
+	// tried again. Otherwise, they are skipped.
+	RetryFailed bool
+
+	// OnResult, when set, is called with the result of each item as it is
+	// saved (e.g., to log progress)
+	OnResult func(Result)
+}
+
+// Report is the outcome of a job for each item
+type Report struct {
+	Results   []Result
+	Succeeded int
+	Failed    int
+	Skipped   int
+}
+
+// Run applies the operation to the items with the given keys, skipping those
+// completed by a previous run recorded in the Store. Cancelling ctx stops the
+// job once the items in progress complete; the items which were not started
+// are not recorded, and will be processed when the job is run again.
+func (j *Job) Run(ctx context.Context, keys []string) (Report, error) {
+	if j.Store == nil {
+		j.Store = &MemoryStore{}
+	}
+
+	previous, err := j.Store.Load()
+	if err != nil {
+		return Report{}, fmt.Errorf("Error loading bulk job progress: %s", err)
+	}
+
+	concurrency := j.Concurrency
+	if concurrency < 1 {
+		concurrency = 4
+	}
+
+	pending := make(chan string)
+	results := make(chan Result)
+
+	var workers sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for key := range pending {
+				results <- j.process(ctx, key)
+			}
+		}()
+	}
+
+	todo := []string{}
+	for _, key := range keys {
+		if result, ok := previous[key]; ok {
+			if result.Status == Succeeded || (result.Status == Failed && !j.RetryFailed) {
+				continue
+			}
+		}
+		todo = append(todo, key)
+	}
+
+	go func() {
+		defer close(pending)
+		for _, key := range todo {
+			select {
+			case pending <- key:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+
+	var saveErr error
+	for result := range results {
+		if saveErr != nil {
+			continue
+		}
+
+		if saveErr = j.Store.Save(result); saveErr != nil {
+			saveErr = fmt.Errorf("Error saving bulk job progress: %s", saveErr)
+			continue
+		}
+
+		previous[result.Key] = result
+		if j.OnResult != nil {
+			j.OnResult(result)
+		}
+	}
+
+	if saveErr != nil {
+		return Report{}, saveErr
+	}
+
+	return newReport(keys, previous), ctx.Err()
+}
+
+func (j *Job) process(ctx context.Context, key string) Result {
+	attempts := j.Attempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	result := Result{Key: key}
+	for result.Attempts < attempts {
+		result.Attempts++
+
+		err := j.Do(ctx, key)
+		if err == nil {
+			result.Status = Succeeded
+			result.Error = ""
+			break
+		}
+
+		result.Status = Failed
+		result.Error = err.Error()
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	result.Finished = time.Now().UTC()
+	return result
+}
+
+// newReport reports on keys, in order. Keys without a result were not started.
+func newReport(keys []string, results map[string]Result) Report {
+	report := Report{}
+
+	for _, key := range keys {
+		result, ok := results[key]
+		if !ok {
+			result = Result{Key: key, Status: Skipped}
+		}
+
+		switch result.Status {
+		case Succeeded:
+			report.Succeeded++
+		case Failed:
+			report.Failed++
+		default:
+			report.Skipped++
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	return report
+}
+
+// Failures returns the results of the items which failed
+func (r Report) Failures() []Result {
+	failures := []Result{}
+	for _, result := range r.Results {
+		if result.Status == Failed {
+			failures = append(failures, result)
+		}
+	}
+
+	sort.Slice(failures, func(i, j int) bool { return failures[i].Key < failures[j].Key })
+	return failures
+}
+
+// Write writes the report as a table, with a summary line
+func (r Report) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tSTATUS\tATTEMPTS\tERROR")
+	for _, result := range r.Results {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\n", result.Key, result.Status, result.Attempts, result.Error)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "%d succeeded, %d failed, %d skipped\n", r.Succeeded, r.Failed, r.Skipped)
+	return err
+}
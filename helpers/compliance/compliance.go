@@ -0,0 +1,278 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package compliance exports the account contacts, users and VPN access of an
+// account as a consolidated snapshot (e.g., for SOC2 evidence), and reports
+// the changes between periodic snapshots.
+package compliance
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Contact is an account contact
+type Contact struct {
+	Id      int    `json:"id"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Company string `json:"company,omitempty"`
+	Email   string `json:"email"`
+	Phone   string `json:"phone,omitempty"`
+}
+
+// User is an authorized user of the account, with its VPN access
+type User struct {
+	Id              int      `json:"id"`
+	Username        string   `json:"username"`
+	Name            string   `json:"name"`
+	Email           string   `json:"email"`
+	Status          string   `json:"status"`
+	ParentUsername  string   `json:"parentUsername,omitempty"`
+	SecondaryLogin  bool     `json:"secondaryLogin"`
+	SslVpnAllowed   bool     `json:"sslVpnAllowed"`
+	PptpVpnAllowed  bool     `json:"pptpVpnAllowed"`
+	VpnManualConfig bool     `json:"vpnManualConfig"`
+	VpnSubnets      []string `json:"vpnSubnets,omitempty"`
+}
+
+// Snapshot is the contacts and users of an account at a point in time
+type Snapshot struct {
+	AccountId int       `json:"accountId"`
+	TakenAt   time.Time `json:"takenAt"`
+	Contacts  []Contact `json:"contacts"`
+	Users     []User    `json:"users"`
+}
+
+// UserMask is the object mask used by Export to retrieve users
+const UserMask = "id,username,firstName,lastName,email,userStatus[name],parent[username]," +
+	"secondaryLoginRequiredFlag,sslVpnAllowedFlag,pptpVpnAllowedFlag,vpnManualConfig," +
+	"overrides[subnet[networkIdentifier,cidr]]"
+
+// ContactMask is the object mask used by Export to retrieve contacts
+const ContactMask = "id,type[keyName],firstName,lastName,companyName,email,officePhone"
+
+// Export takes a snapshot of the contacts and users of the account
+func Export(sess *session.Session) (Snapshot, error) {
+	service := services.GetAccountService(sess)
+
+	account, err := service.Mask("id").GetObject()
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("Error retrieving account: %s", err)
+	}
+
+	snapshot := Snapshot{AccountId: sl.Get(account.Id, 0).(int), TakenAt: time.Now().UTC()}
+
+	contacts, err := service.Mask(ContactMask).Unlimited().GetAccountContacts()
+	if err != nil {
+		return snapshot, fmt.Errorf("Error retrieving account contacts: %s", err)
+	}
+
+	for _, c := range contacts {
+		contact := Contact{
+			Id:      sl.Get(c.Id, 0).(int),
+			Name:    fullName(c.FirstName, c.LastName),
+			Company: sl.Get(c.CompanyName, "").(string),
+			Email:   sl.Get(c.Email, "").(string),
+			Phone:   sl.Get(c.OfficePhone, "").(string),
+		}
+		if c.Type != nil {
+			contact.Type = sl.Get(c.Type.KeyName, "").(string)
+		}
+		snapshot.Contacts = append(snapshot.Contacts, contact)
+	}
+
+	users, err := service.Mask(UserMask).Unlimited().GetUsers()
+	if err != nil {
+		return snapshot, fmt.Errorf("Error retrieving account users: %s", err)
+	}
+
+	for _, u := range users {
+		user := User{
+			Id:              sl.Get(u.Id, 0).(int),
+			Username:        sl.Get(u.Username, "").(string),
+			Name:            fullName(u.FirstName, u.LastName),
+			Email:           sl.Get(u.Email, "").(string),
+			SecondaryLogin:  sl.Get(u.SecondaryLoginRequiredFlag, false).(bool),
+			SslVpnAllowed:   sl.Get(u.SslVpnAllowedFlag, false).(bool),
+			PptpVpnAllowed:  sl.Get(u.PptpVpnAllowedFlag, false).(bool),
+			VpnManualConfig: sl.Get(u.VpnManualConfig, false).(bool),
+		}
+		if u.UserStatus != nil {
+			user.Status = sl.Get(u.UserStatus.Name, "").(string)
+		}
+		if u.Parent != nil {
+			user.ParentUsername = sl.Get(u.Parent.Username, "").(string)
+		}
+		for _, override := range u.Overrides {
+			if override.Subnet != nil {
+				user.VpnSubnets = append(user.VpnSubnets, fmt.Sprintf("%s/%d",
+					sl.Get(override.Subnet.NetworkIdentifier, ""), sl.Get(override.Subnet.Cidr, 0)))
+			}
+		}
+		sort.Strings(user.VpnSubnets)
+		snapshot.Users = append(snapshot.Users, user)
+	}
+
+	sort.Slice(snapshot.Contacts, func(i, j int) bool { return snapshot.Contacts[i].Id < snapshot.Contacts[j].Id })
+	sort.Slice(snapshot.Users, func(i, j int) bool { return snapshot.Users[i].Username < snapshot.Users[j].Username })
+
+	return snapshot, nil
+}
+
+func fullName(first *string, last *string) string {
+	return strings.TrimSpace(sl.Get(first, "").(string) + " " + sl.Get(last, "").(string))
+}
+
+// Write writes the snapshot as JSON
+func (s Snapshot) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(s)
+}
+
+// Read reads a snapshot written by Write
+func Read(r io.Reader) (Snapshot, error) {
+	snapshot := Snapshot{}
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return snapshot, fmt.Errorf("Error reading snapshot: %s", err)
+	}
+
+	return snapshot, nil
+}
+
+// Change kinds
+const (
+	Added    = "added"
+	Removed  = "removed"
+	Modified = "modified"
+)
+
+// Change is a difference between two snapshots. Field, Old and New are only
+// set for modifications.
+type Change struct {
+	Kind    string `json:"kind"`
+	Subject string `json:"subject"`
+	Key     string `json:"key"`
+	Field   string `json:"field,omitempty"`
+	Old     string `json:"old,omitempty"`
+	New     string `json:"new,omitempty"`
+}
+
+func (c Change) String() string {
+	if c.Kind == Modified {
+		return fmt.Sprintf("%s %s: %s changed from %q to %q", c.Subject, c.Key, c.Field, c.Old, c.New)
+	}
+
+	return fmt.Sprintf("%s %s %s", c.Subject, c.Key, c.Kind)
+}
+
+// Diff returns the changes from the old to the new snapshot. Contacts are
+// matched by id, and users by username.
+func Diff(old Snapshot, new Snapshot) []Change {
+	oldRecords := records(old)
+	newRecords := records(new)
+
+	keys := []string{}
+	for key := range oldRecords {
+		keys = append(keys, key)
+	}
+	for key := range newRecords {
+		if _, ok := oldRecords[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	changes := []Change{}
+	for _, key := range keys {
+		before, inOld := oldRecords[key]
+		after, inNew := newRecords[key]
+		subject, name := splitKey(key)
+
+		switch {
+		case !inOld:
+			changes = append(changes, Change{Kind: Added, Subject: subject, Key: name})
+		case !inNew:
+			changes = append(changes, Change{Kind: Removed, Subject: subject, Key: name})
+		default:
+			for _, field := range before.fields {
+				if before.values[field] != after.values[field] {
+					changes = append(changes, Change{
+						Kind: Modified, Subject: subject, Key: name,
+						Field: field, Old: before.values[field], New: after.values[field],
+					})
+				}
+			}
+		}
+	}
+
+	return changes
+}
+
+type record struct {
+	fields []string
+	values map[string]string
+}
+
+func (r *record) set(field string, value string) {
+	r.fields = append(r.fields, field)
+	r.values[field] = value
+}
+
+func records(s Snapshot) map[string]record {
+	result := map[string]record{}
+
+	for _, c := range s.Contacts {
+		r := record{values: map[string]string{}}
+		r.set("type", c.Type)
+		r.set("name", c.Name)
+		r.set("company", c.Company)
+		r.set("email", c.Email)
+		r.set("phone", c.Phone)
+		result["contact|"+strconv.Itoa(c.Id)] = r
+	}
+
+	for _, u := range s.Users {
+		r := record{values: map[string]string{}}
+		r.set("name", u.Name)
+		r.set("email", u.Email)
+		r.set("status", u.Status)
+		r.set("parentUsername", u.ParentUsername)
+		r.set("secondaryLogin", strconv.FormatBool(u.SecondaryLogin))
+		r.set("sslVpnAllowed", strconv.FormatBool(u.SslVpnAllowed))
+		r.set("pptpVpnAllowed", strconv.FormatBool(u.PptpVpnAllowed))
+		r.set("vpnManualConfig", strconv.FormatBool(u.VpnManualConfig))
+		r.set("vpnSubnets", strings.Join(u.VpnSubnets, ","))
+		result["user|"+u.Username] = r
+	}
+
+	return result
+}
+
+func splitKey(key string) (string, string) {
+	parts := strings.SplitN(key, "|", 2)
+	return parts[0], parts[1]
+}
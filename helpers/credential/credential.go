@@ -0,0 +1,212 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package credential issues short-lived API keys for automated jobs, such as
+// CI pipelines. The API has no expiring or scoped keys, so keys are issued to
+// a dedicated user holding only the permissions the job needs (optionally
+// restricted to the addresses of the job runners), and revoked after use or,
+// failing that, by Sweep once expired.
+package credential
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// KeyMask is the object mask used to retrieve the API keys of a user
+const KeyMask = "id,authenticationKey,ipAddressRestriction,timestampKey"
+
+// Request describes the credential to issue
+type Request struct {
+	// UserId is the user the key is issued to. Each user can hold a single
+	// API key, so a user is needed per concurrent job.
+	UserId int
+
+	// TTL is how long the credential is valid
+	TTL time.Duration
+
+	// IpAddressRestriction restricts the use of the key to the passed IP
+	// addresses and subnets (in CIDR format, separated by commas), if set
+	IpAddressRestriction string
+
+	// Permissions are the key names of the permissions the user may hold. If
+	// set, no key is issued to a user holding any other permission.
+	Permissions []string
+}
+
+// Credential is an API key issued by Issue
+type Credential struct {
+	UserId   int       `json:"userId"`
+	Username string    `json:"username"`
+	KeyId    int       `json:"keyId"`
+	APIKey   string    `json:"apiKey"`
+	Issued   time.Time `json:"issued"`
+	Expires  time.Time `json:"expires"`
+}
+
+// Expired returns true if the credential expired at the passed time
+func (c Credential) Expired(now time.Time) bool {
+	return !now.Before(c.Expires)
+}
+
+// Session returns a copy of the session authenticated with the credential
+func (c Credential) Session(sess *session.Session) *session.Session {
+	s := *sess
+	s.UserName = c.Username
+	s.APIKey = c.APIKey
+	s.AuthToken = ""
+	s.IAM = nil
+
+	return &s
+}
+
+// ExcessPermissions returns the key names of the permissions of the user which
+// are not in allowed, sorted
+func ExcessPermissions(sess *session.Session, userId int, allowed []string) ([]string, error) {
+	permissions, err := services.GetUserCustomerService(sess).Id(userId).Mask("keyName").Unlimited().GetPermissions()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving permissions of user %d: %s", userId, err)
+	}
+
+	isAllowed := map[string]bool{}
+	for _, name := range allowed {
+		isAllowed[name] = true
+	}
+
+	excess := []string{}
+	for _, permission := range permissions {
+		name := sl.Get(permission.KeyName, "").(string)
+		if !isAllowed[name] {
+			excess = append(excess, name)
+		}
+	}
+	sort.Strings(excess)
+
+	return excess, nil
+}
+
+// Issue issues an API key to the user of the request. It fails if the user
+// already holds a key, or holds permissions not in the request.
+func Issue(sess *session.Session, request Request) (Credential, error) {
+	service := services.GetUserCustomerService(sess).Id(request.UserId)
+
+	if len(request.Permissions) > 0 {
+		excess, err := ExcessPermissions(sess, request.UserId, request.Permissions)
+		if err != nil {
+			return Credential{}, err
+		}
+		if len(excess) > 0 {
+			return Credential{}, fmt.Errorf("User %d holds permissions beyond those requested: %v", request.UserId, excess)
+		}
+	}
+
+	user, err := service.Mask("id,username,apiAuthenticationKeys[id]").GetObject()
+	if err != nil {
+		return Credential{}, fmt.Errorf("Error retrieving user %d: %s", request.UserId, err)
+	}
+
+	if len(user.ApiAuthenticationKeys) > 0 {
+		return Credential{}, fmt.Errorf("User %d already holds an API key", request.UserId)
+	}
+
+	apiKey, err := service.AddApiAuthenticationKey()
+	if err != nil {
+		return Credential{}, fmt.Errorf("Error issuing API key to user %d: %s", request.UserId, err)
+	}
+
+	now := time.Now().UTC()
+	credential := Credential{
+		UserId:   request.UserId,
+		Username: sl.Get(user.Username, "").(string),
+		APIKey:   apiKey,
+		Issued:   now,
+		Expires:  now.Add(request.TTL),
+	}
+
+	keys, err := service.Mask(KeyMask).GetApiAuthenticationKeys()
+	if err != nil {
+		return credential, fmt.Errorf("Error retrieving API keys of user %d: %s", request.UserId, err)
+	}
+
+	for _, key := range keys {
+		if sl.Get(key.AuthenticationKey, "").(string) == apiKey {
+			credential.KeyId = sl.Get(key.Id, 0).(int)
+		}
+	}
+
+	if credential.KeyId == 0 {
+		return credential, fmt.Errorf("Could not find the API key issued to user %d", request.UserId)
+	}
+
+	if request.IpAddressRestriction != "" {
+		template := datatypes.User_Customer_ApiAuthentication{IpAddressRestriction: sl.String(request.IpAddressRestriction)}
+		_, err = services.GetUserCustomerApiAuthenticationService(sess).Id(credential.KeyId).EditObject(&template)
+		if err != nil {
+			// Do not leave an unrestricted key behind
+			if revokeErr := Revoke(sess, credential); revokeErr != nil {
+				return Credential{}, fmt.Errorf("Error restricting API key of user %d: %s (%s)", request.UserId, err, revokeErr)
+			}
+			return Credential{}, fmt.Errorf("Error restricting API key of user %d: %s", request.UserId, err)
+		}
+	}
+
+	return credential, nil
+}
+
+// Revoke removes the API key of the credential
+func Revoke(sess *session.Session, credential Credential) error {
+	_, err := services.GetUserCustomerService(sess).Id(credential.UserId).RemoveApiAuthenticationKey(&credential.KeyId)
+	if err != nil {
+		return fmt.Errorf("Error revoking API key %d of user %d: %s", credential.KeyId, credential.UserId, err)
+	}
+
+	return nil
+}
+
+// Sweep revokes the API keys of the users last modified more than maxAge ago,
+// e.g. those left behind by jobs which did not complete. It returns the ids of
+// the keys revoked.
+func Sweep(sess *session.Session, userIds []int, maxAge time.Duration) ([]int, error) {
+	revoked := []int{}
+	cutoff := time.Now().Add(-maxAge)
+
+	for _, userId := range userIds {
+		keys, err := services.GetUserCustomerService(sess).Id(userId).Mask(KeyMask).GetApiAuthenticationKeys()
+		if err != nil {
+			return revoked, fmt.Errorf("Error retrieving API keys of user %d: %s", userId, err)
+		}
+
+		for _, key := range keys {
+			modified := time.Unix(int64(sl.Get(key.TimestampKey, 0).(int)), 0)
+			if key.Id == nil || modified.After(cutoff) {
+				continue
+			}
+
+			if err := Revoke(sess, Credential{UserId: userId, KeyId: *key.Id}); err != nil {
+				return revoked, err
+			}
+			revoked = append(revoked, *key.Id)
+		}
+	}
+
+	return revoked, nil
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
 	"github.com/softlayer/softlayer-go/helpers/location"
 	"github.com/softlayer/softlayer-go/services"
 	"github.com/softlayer/softlayer-go/session"
@@ -59,3 +60,33 @@ func GetRouterByName(sess *session.Session, hostname string, args ...interface{}
 
 	return datatypes.Hardware{}, fmt.Errorf("No routers found with hostname of %s", hostname)
 }
+
+// GlobalIdentifierFilter returns the object filter used by
+// GetHardwareByGlobalIdentifier
+func GlobalIdentifierFilter(globalIdentifier string) string {
+	return filter.Path("hardware.globalIdentifier").Eq(globalIdentifier).Build()
+}
+
+// GetHardwareByGlobalIdentifier returns the Hardware of the account with the
+// provided global identifier (UUID), or an error if none can be found.
+func GetHardwareByGlobalIdentifier(sess *session.Session, globalIdentifier string, args ...interface{}) (datatypes.Hardware, error) {
+	var mask string
+	if len(args) > 0 {
+		mask = args[0].(string)
+	}
+
+	hardware, err := services.GetAccountService(sess).
+		Mask(mask).
+		Filter(GlobalIdentifierFilter(globalIdentifier)).
+		GetHardware()
+	if err != nil {
+		return datatypes.Hardware{}, err
+	}
+
+	// An empty filtered result set does not raise an error
+	if len(hardware) == 0 {
+		return datatypes.Hardware{}, fmt.Errorf("No hardware found with global identifier of %s", globalIdentifier)
+	}
+
+	return hardware[0], nil
+}
@@ -0,0 +1,182 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package inventory maintains a local snapshot of selected resource types of
+// an account, updated incrementally from their modifyDate, so inventory
+// queries can be answered locally without repeated full fetches from the API.
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// DateFormat is the format of dates in object filters
+const DateFormat = "01/02/2006 15:04:05"
+
+// Resource is a type of resource listed by a relational property of a service
+// (e.g., the virtualGuests of SoftLayer_Account)
+type Resource struct {
+	// Name identifies the resource in the store
+	Name string
+
+	// Service and Property locate the list of resources
+	// (e.g., "SoftLayer_Account" and "virtualGuests")
+	Service  string
+	Property string
+
+	// Mask selects the properties kept in the snapshot. It must include id
+	// and modifyDate (and createDate, to tell created objects apart when
+	// watching).
+	Mask string
+}
+
+// Common resources of an account
+var (
+	VirtualGuests = Resource{
+		Name: "virtualGuests", Service: "SoftLayer_Account", Property: "virtualGuests",
+		Mask: "mask[id,createDate,modifyDate,hostname,domain,startCpus,maxMemory,primaryIpAddress,primaryBackendIpAddress," +
+			"status[keyName],powerState[keyName],datacenter[name],tagReferences[tag[name]]]",
+	}
+	Hardware = Resource{
+		Name: "hardware", Service: "SoftLayer_Account", Property: "hardware",
+		Mask: "mask[id,createDate,modifyDate,hostname,domain,processorPhysicalCoreAmount,memoryCapacity,primaryIpAddress," +
+			"primaryBackendIpAddress,hardwareStatus[status],datacenter[name],tagReferences[tag[name]]]",
+	}
+	NetworkVlans = Resource{
+		Name: "networkVlans", Service: "SoftLayer_Account", Property: "networkVlans",
+		Mask: "mask[id,createDate,modifyDate,vlanNumber,name,networkSpace,primaryRouter[hostname]]",
+	}
+	NetworkStorage = Resource{
+		Name: "networkStorage", Service: "SoftLayer_Account", Property: "networkStorage",
+		Mask: "mask[id,createDate,modifyDate,username,nasType,capacityGb,serviceResourceBackendIpAddress]",
+	}
+)
+
+// Object is a resource object in the snapshot
+type Object struct {
+	Id         int             `json:"id"`
+	CreateDate time.Time       `json:"createDate"`
+	ModifyDate time.Time       `json:"modifyDate"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Decode decodes the object into v, e.g. a *datatypes.Virtual_Guest
+func (o Object) Decode(v interface{}) error {
+	return json.Unmarshal(o.Data, v)
+}
+
+// ChangedFilter returns the object filter used by Changed, which is empty if
+// since is zero
+func (r Resource) ChangedFilter(since time.Time) string {
+	if since.IsZero() {
+		return ""
+	}
+
+	return filter.Path(r.Property + ".modifyDate").DateAfter(since.Format(DateFormat)).Build()
+}
+
+// Changed returns the objects of the resource modified after since, or all of
+// them if since is zero. Results are fetched in pages of pageSize objects.
+func (r Resource) Changed(sess *session.Session, since time.Time, pageSize int) ([]Object, error) {
+	options := sl.Options{Mask: r.Mask, Filter: r.ChangedFilter(since)}
+
+	objects := []Object{}
+	err := r.each(sess, options, pageSize, func(item map[string]interface{}) error {
+		object, err := newObject(item)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, object)
+		return nil
+	})
+
+	return objects, err
+}
+
+// IDs returns the ids of all the objects of the resource
+func (r Resource) IDs(sess *session.Session, pageSize int) (map[int]bool, error) {
+	ids := map[int]bool{}
+	err := r.each(sess, sl.Options{Mask: "id"}, pageSize, func(item map[string]interface{}) error {
+		object, err := newObject(item)
+		if err != nil {
+			return err
+		}
+		ids[object.Id] = true
+		return nil
+	})
+
+	return ids, err
+}
+
+func (r Resource) each(sess *session.Session, options sl.Options, pageSize int, fn func(map[string]interface{}) error) error {
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+
+	method := "get" + string(r.Property[0]-'a'+'A') + r.Property[1:]
+	for offset := 0; ; offset += pageSize {
+		page := []map[string]interface{}{}
+		options.Limit = sl.Int(pageSize)
+		options.Offset = sl.Int(offset)
+
+		if err := sess.DoRequest(r.Service, method, nil, &options, &page); err != nil {
+			return fmt.Errorf("Error retrieving %s: %s", r.Name, err)
+		}
+
+		for _, item := range page {
+			if err := fn(item); err != nil {
+				return fmt.Errorf("Error reading %s: %s", r.Name, err)
+			}
+		}
+
+		if len(page) < pageSize {
+			return nil
+		}
+	}
+}
+
+func newObject(item map[string]interface{}) (Object, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return Object{}, err
+	}
+
+	meta := struct {
+		Id         int             `json:"id"`
+		CreateDate *datatypes.Time `json:"createDate"`
+		ModifyDate *datatypes.Time `json:"modifyDate"`
+	}{}
+	if err = json.Unmarshal(data, &meta); err != nil {
+		return Object{}, err
+	}
+
+	object := Object{Id: meta.Id, Data: data}
+	if meta.CreateDate != nil {
+		object.CreateDate = meta.CreateDate.Time
+	}
+	if meta.ModifyDate != nil {
+		object.ModifyDate = meta.ModifyDate.Time
+	}
+
+	return object, nil
+}
@@ -0,0 +1,159 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Store holds the snapshot of the synced resources. Implementations backed by
+// a database (e.g., SQLite) should apply each call in a single transaction.
+type Store interface {
+	// Checkpoint returns the modifyDate up to which the resource was synced,
+	// or the zero time if it never was
+	Checkpoint(resource string) (time.Time, error)
+
+	// Apply upserts the changed objects of the resource, and sets its
+	// checkpoint. If present is not nil, objects whose id is not in present
+	// are deleted. Apply returns the number of objects deleted.
+	Apply(resource string, changed []Object, present map[int]bool, checkpoint time.Time) (int, error)
+
+	// Objects returns the objects of the resource, ordered by id
+	Objects(resource string) ([]Object, error)
+}
+
+// MemoryStore keeps the snapshot in memory
+type MemoryStore struct {
+	mu          sync.RWMutex
+	Resources   map[string]map[int]Object `json:"resources"`
+	Checkpoints map[string]time.Time      `json:"checkpoints"`
+}
+
+// Checkpoint returns the checkpoint of the resource
+func (m *MemoryStore) Checkpoint(resource string) (time.Time, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.Checkpoints[resource], nil
+}
+
+// Apply applies changes to the resource
+func (m *MemoryStore) Apply(resource string, changed []Object, present map[int]bool, checkpoint time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.Resources == nil {
+		m.Resources = map[string]map[int]Object{}
+		m.Checkpoints = map[string]time.Time{}
+	}
+
+	objects := m.Resources[resource]
+	if objects == nil {
+		objects = map[int]Object{}
+		m.Resources[resource] = objects
+	}
+
+	for _, object := range changed {
+		objects[object.Id] = object
+	}
+
+	deleted := 0
+	if present != nil {
+		for id := range objects {
+			if !present[id] {
+				delete(objects, id)
+				deleted++
+			}
+		}
+	}
+
+	m.Checkpoints[resource] = checkpoint
+	return deleted, nil
+}
+
+// Objects returns the objects of the resource
+func (m *MemoryStore) Objects(resource string) ([]Object, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	objects := make([]Object, 0, len(m.Resources[resource]))
+	for _, object := range m.Resources[resource] {
+		objects = append(objects, object)
+	}
+
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Id < objects[j].Id })
+	return objects, nil
+}
+
+// FileStore is a MemoryStore saved to a JSON file after each change
+type FileStore struct {
+	MemoryStore
+	Path string
+}
+
+// OpenFileStore opens the store saved at path, or an empty one if the file
+// does not exist
+func OpenFileStore(path string) (*FileStore, error) {
+	store := &FileStore{Path: path}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, &store.MemoryStore); err != nil {
+		return nil, fmt.Errorf("Error reading inventory %s: %s", path, err)
+	}
+
+	return store, nil
+}
+
+// Apply applies changes to the resource, and saves the store
+func (f *FileStore) Apply(resource string, changed []Object, present map[int]bool, checkpoint time.Time) (int, error) {
+	deleted, err := f.MemoryStore.Apply(resource, changed, present, checkpoint)
+	if err != nil {
+		return deleted, err
+	}
+
+	f.mu.RLock()
+	data, err := json.Marshal(&f.MemoryStore)
+	f.mu.RUnlock()
+	if err != nil {
+		return deleted, err
+	}
+
+	// Replace the file atomically, so a crash never leaves a partial snapshot
+	tmp := f.Path + ".tmp"
+	if err = ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return deleted, fmt.Errorf("Error saving inventory %s: %s", f.Path, err)
+	}
+
+	if err = os.Rename(tmp, f.Path); err != nil {
+		return deleted, fmt.Errorf("Error saving inventory %s: %s", f.Path, err)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,113 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventory
+
+import (
+	"time"
+
+	"github.com/softlayer/softlayer-go/session"
+)
+
+// DefaultSkew is subtracted from checkpoints when fetching changes, so that
+// objects modified as a sync ran are fetched again by the next one
+const DefaultSkew = time.Minute
+
+// Syncer updates a Store with the changes to a set of resources
+type Syncer struct {
+	Session   *session.Session
+	Store     Store
+	Resources []Resource
+
+	// PageSize is the number of objects fetched per call. Defaults to 100.
+	PageSize int
+
+	// Skew defaults to DefaultSkew
+	Skew time.Duration
+
+	// DetectDeletions causes the ids of all objects to be listed on each sync,
+	// to remove deleted objects from the store. The first sync of a resource
+	// always does.
+	DetectDeletions bool
+}
+
+// Stats reports what a sync changed for a resource
+type Stats struct {
+	Resource string
+	Full     bool
+	Updated  int
+	Deleted  int
+}
+
+// Sync fetches the changes to each resource since its last sync, and applies
+// them to the store
+func (s Syncer) Sync() ([]Stats, error) {
+	skew := s.Skew
+	if skew == 0 {
+		skew = DefaultSkew
+	}
+
+	stats := []Stats{}
+	for _, resource := range s.Resources {
+		checkpoint, err := s.Store.Checkpoint(resource.Name)
+		if err != nil {
+			return stats, err
+		}
+
+		since := time.Time{}
+		if !checkpoint.IsZero() {
+			since = checkpoint.Add(-skew)
+		}
+
+		changed, err := resource.Changed(s.Session, since, s.PageSize)
+		if err != nil {
+			return stats, err
+		}
+
+		var present map[int]bool
+		if checkpoint.IsZero() {
+			present = map[int]bool{}
+			for _, object := range changed {
+				present[object.Id] = true
+			}
+		} else if s.DetectDeletions {
+			if present, err = resource.IDs(s.Session, s.PageSize); err != nil {
+				return stats, err
+			}
+		}
+
+		next := checkpoint
+		for _, object := range changed {
+			if object.ModifyDate.After(next) {
+				next = object.ModifyDate
+			}
+		}
+
+		deleted, err := s.Store.Apply(resource.Name, changed, present, next)
+		if err != nil {
+			return stats, err
+		}
+
+		stats = append(stats, Stats{
+			Resource: resource.Name,
+			Full:     checkpoint.IsZero(),
+			Updated:  len(changed),
+			Deleted:  deleted,
+		})
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,219 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/session"
+)
+
+// Event kinds
+const (
+	Created = "created"
+	Updated = "updated"
+)
+
+// Event reports an object created or updated since the previous poll
+type Event struct {
+	Kind     string
+	Resource string
+	Object   Object
+}
+
+// Watcher polls a resource for objects modified since its checkpoint, and
+// emits them as events. It is a lightweight event stream for resources the
+// event log does not cover well. Deletions are not reported.
+type Watcher struct {
+	Session  *session.Session
+	Resource Resource
+
+	// Interval is the time between polls. Defaults to one minute.
+	Interval time.Duration
+
+	// PageSize is the number of objects fetched per call. Defaults to 100.
+	PageSize int
+
+	// Skew defaults to DefaultSkew
+	Skew time.Duration
+
+	// Since is the checkpoint to watch from. If zero, only changes made after
+	// Watch is called are emitted.
+	Since time.Time
+
+	// CheckpointFile, when set, persists the checkpoint after each poll, and
+	// takes precedence over Since when it exists, so a restarted watcher
+	// resumes where it stopped
+	CheckpointFile string
+
+	// OnError is called with the errors of failed polls, which are retried at
+	// the next interval. Defaults to logging them with session.Logger.
+	OnError func(error)
+}
+
+// Watch polls the resource until ctx is done, emitting events on the returned
+// channel, which is closed when the watcher stops
+func (w *Watcher) Watch(ctx context.Context) <-chan Event {
+	events := make(chan Event)
+
+	go func() {
+		defer close(events)
+
+		checkpoint, fresh, err := w.loadCheckpoint()
+		if err != nil {
+			w.report(err)
+		}
+
+		interval := w.Interval
+		if interval <= 0 {
+			interval = time.Minute
+		}
+
+		// Objects already emitted at their modifyDate, as the skew fetches
+		// recent changes again
+		emitted := map[int]time.Time{}
+
+		for {
+			checkpoint, err = w.poll(ctx, checkpoint, fresh, emitted, events)
+			if err != nil {
+				w.report(err)
+			} else {
+				fresh = false
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+		}
+	}()
+
+	return events
+}
+
+func (w *Watcher) poll(
+	ctx context.Context, checkpoint time.Time, seed bool,
+	emitted map[int]time.Time, events chan<- Event) (time.Time, error) {
+
+	skew := w.Skew
+	if skew == 0 {
+		skew = DefaultSkew
+	}
+
+	changed, err := w.Resource.Changed(w.Session, checkpoint.Add(-skew), w.PageSize)
+	if err != nil {
+		return checkpoint, err
+	}
+
+	next := checkpoint
+	for _, object := range changed {
+		if !object.ModifyDate.After(checkpoint.Add(-skew)) {
+			continue
+		}
+
+		if seen, ok := emitted[object.Id]; ok && !object.ModifyDate.After(seen) {
+			continue
+		}
+
+		// A fresh watcher only reports changes made after it started
+		if seed && !object.ModifyDate.After(checkpoint) {
+			emitted[object.Id] = object.ModifyDate
+			continue
+		}
+
+		kind := Updated
+		if !object.CreateDate.IsZero() && object.CreateDate.After(checkpoint.Add(-skew)) {
+			kind = Created
+		}
+
+		select {
+		case events <- Event{Kind: kind, Resource: w.Resource.Name, Object: object}:
+		case <-ctx.Done():
+			return next, nil
+		}
+
+		emitted[object.Id] = object.ModifyDate
+		if object.ModifyDate.After(next) {
+			next = object.ModifyDate
+		}
+	}
+
+	// Forget what falls out of the skew window
+	for id, modified := range emitted {
+		if modified.Before(next.Add(-skew)) {
+			delete(emitted, id)
+		}
+	}
+
+	return next, w.saveCheckpoint(next)
+}
+
+// loadCheckpoint returns the checkpoint to watch from, and whether the
+// watcher starts fresh from the current time
+func (w *Watcher) loadCheckpoint() (time.Time, bool, error) {
+	checkpoint, fresh := w.Since, w.Since.IsZero()
+	if fresh {
+		checkpoint = time.Now()
+	}
+
+	if w.CheckpointFile == "" {
+		return checkpoint, fresh, nil
+	}
+
+	data, err := ioutil.ReadFile(w.CheckpointFile)
+	if os.IsNotExist(err) {
+		return checkpoint, fresh, nil
+	}
+	if err != nil {
+		return checkpoint, fresh, err
+	}
+
+	saved, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(string(data)))
+	if err != nil {
+		return checkpoint, fresh, fmt.Errorf("Error reading watcher checkpoint %s: %s", w.CheckpointFile, err)
+	}
+
+	return saved, false, nil
+}
+
+func (w *Watcher) saveCheckpoint(checkpoint time.Time) error {
+	if w.CheckpointFile == "" {
+		return nil
+	}
+
+	err := ioutil.WriteFile(w.CheckpointFile, []byte(checkpoint.Format(time.RFC3339Nano)+"\n"), 0600)
+	if err != nil {
+		return fmt.Errorf("Error saving watcher checkpoint %s: %s", w.CheckpointFile, err)
+	}
+
+	return nil
+}
+
+func (w *Watcher) report(err error) {
+	if w.OnError != nil {
+		w.OnError(err)
+		return
+	}
+
+	session.Logger.Println("[WARN] inventory:", err)
+}
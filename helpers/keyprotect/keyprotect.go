@@ -0,0 +1,228 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package keyprotect requests the erasure of the drives of bare metal servers
+// being decommissioned, and collects evidence of it (the drives by serial
+// number, and the erase transactions run against the server) for security
+// teams to keep as a record of data destruction.
+package keyprotect
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Drive is a drive installed in a server
+type Drive struct {
+	Id           int     `json:"id"`
+	SerialNumber string  `json:"serialNumber"`
+	Manufacturer string  `json:"manufacturer"`
+	Model        string  `json:"model"`
+	Capacity     float64 `json:"capacity"`
+}
+
+// Transaction is a provisioning transaction run against a server
+type Transaction struct {
+	Id         int        `json:"id"`
+	Group      string     `json:"group"`
+	Status     string     `json:"status"`
+	StartDate  *time.Time `json:"startDate,omitempty"`
+	FinishDate *time.Time `json:"finishDate,omitempty"`
+}
+
+// Evidence is the record of the drives of a server, and of the erase
+// transactions run against it
+type Evidence struct {
+	HardwareId       int           `json:"hardwareId"`
+	Hostname         string        `json:"hostname"`
+	SerialNumber     string        `json:"serialNumber"`
+	GlobalIdentifier string        `json:"globalIdentifier,omitempty"`
+	Datacenter       string        `json:"datacenter,omitempty"`
+	CollectedAt      time.Time     `json:"collectedAt"`
+	Drives           []Drive       `json:"drives"`
+	Erasures         []Transaction `json:"erasures"`
+}
+
+// ErasePatterns are the (upper case) fragments of transaction group and status
+// names identifying drive erasure
+var ErasePatterns = []string{"ERASE", "WIPE", "RECLAIM"}
+
+// HardwareMask is the object mask used by Collect to retrieve a server
+const HardwareMask = "id,hostname,serialNumber,globalIdentifier,datacenter[name]"
+
+// DriveMask is the object mask used by Collect to retrieve drives
+const DriveMask = "id,serialNumber,hardwareComponentModel[manufacturer,name,capacity]"
+
+// TransactionMask is the object mask used by Collect to retrieve the
+// transaction history
+const TransactionMask = "id,startDate,finishDate,transactionStatus[name]," +
+	"transaction[transactionGroup[name],transactionStatus[name]]"
+
+// Erase reloads the operating system of a server, erasing all of its drives.
+// The reload starts without confirmation, and the data on the drives is lost.
+func Erase(sess *session.Session, hardwareId int) error {
+	config := datatypes.Container_Hardware_Server_Configuration{EraseHardDrives: sl.Int(1)}
+
+	_, err := services.GetHardwareServerService(sess).
+		Id(hardwareId).
+		ReloadOperatingSystem(sl.String("FORCE"), &config)
+	if err != nil {
+		return fmt.Errorf("Error erasing the drives of hardware %d: %s", hardwareId, err)
+	}
+
+	return nil
+}
+
+// Collect gathers the erasure evidence of a server. It should be collected
+// before the server is cancelled, while its drives and history are available.
+func Collect(sess *session.Session, hardwareId int) (Evidence, error) {
+	service := services.GetHardwareServerService(sess).Id(hardwareId)
+
+	hardware, err := service.Mask(HardwareMask).GetObject()
+	if err != nil {
+		return Evidence{}, fmt.Errorf("Error getting hardware %d: %s", hardwareId, err)
+	}
+
+	evidence := Evidence{
+		HardwareId:       hardwareId,
+		Hostname:         sl.Get(hardware.Hostname, "").(string),
+		SerialNumber:     sl.Get(hardware.SerialNumber, "").(string),
+		GlobalIdentifier: sl.Get(hardware.GlobalIdentifier, "").(string),
+		CollectedAt:      time.Now().UTC(),
+		Drives:           []Drive{},
+		Erasures:         []Transaction{},
+	}
+	if hardware.Datacenter != nil {
+		evidence.Datacenter = sl.Get(hardware.Datacenter.Name, "").(string)
+	}
+
+	drives, err := service.Mask(DriveMask).GetHardDrives()
+	if err != nil {
+		return evidence, fmt.Errorf("Error getting the drives of hardware %d: %s", hardwareId, err)
+	}
+
+	for _, d := range drives {
+		drive := Drive{
+			Id:           sl.Get(d.Id, 0).(int),
+			SerialNumber: sl.Get(d.SerialNumber, "").(string),
+		}
+		if m := d.HardwareComponentModel; m != nil {
+			drive.Manufacturer = sl.Get(m.Manufacturer, "").(string)
+			drive.Model = sl.Get(m.Name, "").(string)
+			drive.Capacity = float64(sl.Get(m.Capacity, datatypes.Float64(0)).(datatypes.Float64))
+		}
+		evidence.Drives = append(evidence.Drives, drive)
+	}
+
+	history, err := service.Mask(TransactionMask).GetTransactionHistory()
+	if err != nil {
+		return evidence, fmt.Errorf("Error getting the transactions of hardware %d: %s", hardwareId, err)
+	}
+
+	for _, h := range history {
+		transaction := Transaction{
+			Id:         sl.Get(h.Id, 0).(int),
+			StartDate:  timeOf(h.StartDate),
+			FinishDate: timeOf(h.FinishDate),
+		}
+		if h.TransactionStatus != nil {
+			transaction.Status = sl.Get(h.TransactionStatus.Name, "").(string)
+		}
+		if t := h.Transaction; t != nil {
+			if t.TransactionGroup != nil {
+				transaction.Group = sl.Get(t.TransactionGroup.Name, "").(string)
+			}
+			if transaction.Status == "" && t.TransactionStatus != nil {
+				transaction.Status = sl.Get(t.TransactionStatus.Name, "").(string)
+			}
+		}
+
+		if isErasure(transaction) {
+			evidence.Erasures = append(evidence.Erasures, transaction)
+		}
+	}
+
+	return evidence, nil
+}
+
+func timeOf(t *datatypes.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	utc := t.Time.UTC()
+	return &utc
+}
+
+func isErasure(t Transaction) bool {
+	names := strings.ToUpper(t.Group + " " + t.Status)
+	for _, pattern := range ErasePatterns {
+		if strings.Contains(names, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Erased returns true if an erase transaction finished after the given time
+func (e Evidence) Erased(since time.Time) bool {
+	for _, t := range e.Erasures {
+		if t.FinishDate != nil && t.FinishDate.After(since) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Write writes the evidence as JSON
+func (e Evidence) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(e)
+}
+
+// Digest returns the SHA-256 digest (in hex) of the evidence as written by
+// Write, to be recorded alongside it so later changes can be detected
+func (e Evidence) Digest() (string, error) {
+	hash := sha256.New()
+	if err := e.Write(hash); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Read reads evidence written by Write
+func Read(r io.Reader) (Evidence, error) {
+	evidence := Evidence{}
+	if err := json.NewDecoder(r).Decode(&evidence); err != nil {
+		return evidence, fmt.Errorf("Error reading evidence: %s", err)
+	}
+
+	return evidence, nil
+}
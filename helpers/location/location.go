@@ -25,6 +25,11 @@ import (
 	"github.com/softlayer/softlayer-go/session"
 )
 
+// NameFilter returns the object filter used by GetLocationByName
+func NameFilter(name string) string {
+	return filter.New(filter.Path("name").Eq(name)).Build()
+}
+
 // GetLocationByName returns a Location that matches the provided name, or an
 // error if no matching Location can be found.
 //
@@ -38,7 +43,7 @@ func GetLocationByName(sess *session.Session, name string, args ...interface{})
 
 	locs, err := services.GetLocationService(sess).
 		Mask(mask).
-		Filter(filter.New(filter.Path("name").Eq(name)).Build()).
+		Filter(NameFilter(name)).
 		GetDatacenters()
 
 	if err != nil {
@@ -0,0 +1,213 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package maintenance
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// EventMask is the object mask of the events retrieved by GetUpcomingEvents
+const EventMask = "id,subject,summary,startDate,endDate,modifyDate,updateCount,systemTicketId," +
+	"notificationOccurrenceEventType[keyName],statusCode[keyName,name],impactedResources[resourceName,filterLabel]"
+
+// calendarTimeFormat is the format of UTC date-times in iCalendar
+const calendarTimeFormat = "20060102T150405Z"
+
+// GetUpcomingEvents returns the planned and ongoing events (maintenance,
+// incidents, ...) of the account, those that have not ended, in the order
+// they begin
+func GetUpcomingEvents(sess *session.Session) ([]datatypes.Notification_Occurrence_Event, error) {
+	service := services.GetAccountService(sess).Mask(EventMask).Unlimited()
+
+	pending, err := service.GetPendingEvents()
+	if err != nil {
+		return nil, fmt.Errorf("Error getting the pending events of the account: %s", err)
+	}
+
+	recent, err := service.GetRecentEvents()
+	if err != nil {
+		return nil, fmt.Errorf("Error getting the recent events of the account: %s", err)
+	}
+
+	now := time.Now()
+	seen := map[int]bool{}
+	events := []datatypes.Notification_Occurrence_Event{}
+	for _, event := range append(pending, recent...) {
+		if event.Id == nil || seen[*event.Id] || (event.EndDate != nil && event.EndDate.Before(now)) {
+			continue
+		}
+		seen[*event.Id] = true
+		events = append(events, event)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventStart(events[i]).Before(eventStart(events[j]))
+	})
+
+	return events, nil
+}
+
+// ExportCalendar returns the upcoming events of the account as an iCalendar
+// feed with the given name. See GetUpcomingEvents and Calendar.
+func ExportCalendar(sess *session.Session, name string) ([]byte, error) {
+	events, err := GetUpcomingEvents(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	return Calendar(name, events), nil
+}
+
+// Calendar returns the events as an iCalendar (RFC 5545) feed with the given
+// name, which calendars can subscribe to. Each event keeps its UID across
+// feeds, and its SEQUENCE grows with its updates, so that calendars update
+// rescheduled events instead of duplicating them. Cancelled events are
+// included with a CANCELLED status.
+func Calendar(name string, events []datatypes.Notification_Occurrence_Event) []byte {
+	var buf bytes.Buffer
+	line := func(name string, value string) {
+		writeCalendarLine(&buf, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//SoftLayer//softlayer-go//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	if name != "" {
+		line("X-WR-CALNAME", escapeCalendarText(name))
+	}
+
+	for _, event := range events {
+		start := eventStart(event)
+		end := start.Add(time.Hour)
+		if event.EndDate != nil && event.EndDate.After(start) {
+			end = event.EndDate.Time
+		}
+
+		stamp := start
+		if event.ModifyDate != nil {
+			stamp = event.ModifyDate.Time
+		}
+
+		status := "CONFIRMED"
+		if event.StatusCode != nil && strings.Contains(strings.ToUpper(sl.Get(event.StatusCode.KeyName, "").(string)), "CANCEL") {
+			status = "CANCELLED"
+		}
+
+		line("BEGIN", "VEVENT")
+		line("UID", fmt.Sprintf("notification-occurrence-event-%d@softlayer.com", sl.Get(event.Id, 0).(int)))
+		line("SEQUENCE", fmt.Sprintf("%d", sl.Get(event.UpdateCount, uint(0)).(uint)))
+		line("DTSTAMP", stamp.UTC().Format(calendarTimeFormat))
+		line("DTSTART", start.UTC().Format(calendarTimeFormat))
+		line("DTEND", end.UTC().Format(calendarTimeFormat))
+		line("SUMMARY", escapeCalendarText(sl.Get(event.Subject, "").(string)))
+		line("DESCRIPTION", escapeCalendarText(eventDescription(event)))
+		if event.NotificationOccurrenceEventType != nil && event.NotificationOccurrenceEventType.KeyName != nil {
+			line("CATEGORIES", escapeCalendarText(*event.NotificationOccurrenceEventType.KeyName))
+		}
+		line("STATUS", status)
+		line("END", "VEVENT")
+	}
+
+	line("END", "VCALENDAR")
+	return buf.Bytes()
+}
+
+// eventStart returns the start of an event, or its last modification if it
+// has no start date
+func eventStart(event datatypes.Notification_Occurrence_Event) time.Time {
+	if event.StartDate != nil {
+		return event.StartDate.Time
+	}
+
+	if event.ModifyDate != nil {
+		return event.ModifyDate.Time
+	}
+
+	return time.Time{}
+}
+
+// eventDescription returns the summary of an event, followed by its ticket
+// and the resources of the account it impacts
+func eventDescription(event datatypes.Notification_Occurrence_Event) string {
+	parts := []string{}
+	if summary := strings.TrimSpace(sl.Get(event.Summary, "").(string)); summary != "" {
+		parts = append(parts, summary)
+	}
+
+	if event.SystemTicketId != nil {
+		parts = append(parts, fmt.Sprintf("Ticket: %d", *event.SystemTicketId))
+	}
+
+	resources := []string{}
+	for _, resource := range event.ImpactedResources {
+		name := sl.Get(resource.ResourceName, "").(string)
+		if label := sl.Get(resource.FilterLabel, "").(string); label != "" && name != "" {
+			name = fmt.Sprintf("%s (%s)", name, label)
+		}
+		if name != "" {
+			resources = append(resources, name)
+		}
+	}
+	if len(resources) > 0 {
+		parts = append(parts, "Impacted resources: "+strings.Join(resources, ", "))
+	}
+
+	return strings.Join(parts, "\n\n")
+}
+
+// escapeCalendarText escapes an iCalendar TEXT value
+func escapeCalendarText(text string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", "",
+	).Replace(text)
+}
+
+// writeCalendarLine writes a content line, folded into lines of at most 75
+// octets (without breaking UTF-8 sequences) and terminated by CRLF
+func writeCalendarLine(buf *bytes.Buffer, content string) {
+	limit := 75
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && content[cut]&0xC0 == 0x80 {
+			cut--
+		}
+
+		buf.WriteString(content[:cut])
+		buf.WriteString("\r\n ")
+		content = content[cut:]
+		limit = 74 // continuation lines begin with a space
+	}
+
+	buf.WriteString(content)
+	buf.WriteString("\r\n")
+}
@@ -0,0 +1,174 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package maintenance lists the maintenance windows available in a data
+// center, selects one by preference, and books it for upgrade orders, so
+// upgrades can be scheduled without picking a window by hand.
+package maintenance
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/helpers/location"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// PropertyName is the name of the order property booking a maintenance window
+const PropertyName = "MAINTENANCE_WINDOW"
+
+// Window is a maintenance window of a data center. Begin and End are in the
+// time zone of the data center, as returned by the API.
+type Window struct {
+	Id         int
+	LocationId int
+	Begin      time.Time
+	End        time.Time
+}
+
+// Criteria select maintenance windows. Zero fields match any window.
+type Criteria struct {
+	// NotBefore excludes windows beginning before this time
+	NotBefore time.Time
+
+	// Days are the days of the week windows may begin on
+	Days []time.Weekday
+
+	// FromHour and ToHour are the range of hours of the day (0 to 23, in the
+	// time zone of the data center) windows may begin in. The range wraps
+	// around midnight when FromHour is greater than ToHour.
+	FromHour int
+	ToHour   int
+}
+
+// GetWindows returns the maintenance windows of a location (data center)
+// between begin and end, with room for the number of slots needed, in the
+// order they begin
+func GetWindows(sess *session.Session, locationId int, begin time.Time, end time.Time, slots int) ([]Window, error) {
+	found, err := services.GetProvisioningMaintenanceWindowService(sess).GetMaintenanceWindows(
+		&datatypes.Time{Time: begin}, &datatypes.Time{Time: end}, sl.Int(locationId), sl.Int(slots))
+	if err != nil {
+		return nil, fmt.Errorf("Error getting the maintenance windows of location %d: %s", locationId, err)
+	}
+
+	windows := make([]Window, 0, len(found))
+	for _, w := range found {
+		window := Window{
+			Id:         sl.Get(w.Id, 0).(int),
+			LocationId: sl.Get(w.LocationId, locationId).(int),
+		}
+		if w.BeginDate != nil {
+			window.Begin = w.BeginDate.Time
+		}
+		if w.EndDate != nil {
+			window.End = w.EndDate.Time
+		}
+		windows = append(windows, window)
+	}
+
+	sort.SliceStable(windows, func(i, j int) bool {
+		return windows[i].Begin.Before(windows[j].Begin)
+	})
+
+	return windows, nil
+}
+
+// GetDatacenterWindows returns the maintenance windows of the data center with
+// the given name (e.g., "dal13"). See GetWindows.
+func GetDatacenterWindows(sess *session.Session, datacenter string, begin time.Time, end time.Time, slots int) ([]Window, error) {
+	dc, err := location.GetDatacenterByName(sess, datacenter, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	return GetWindows(sess, *dc.Id, begin, end, slots)
+}
+
+// Matches returns true if the window meets the criteria
+func (c Criteria) Matches(w Window) bool {
+	if !c.NotBefore.IsZero() && w.Begin.Before(c.NotBefore) {
+		return false
+	}
+
+	if len(c.Days) > 0 {
+		found := false
+		for _, day := range c.Days {
+			if w.Begin.Weekday() == day {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if c.FromHour == 0 && c.ToHour == 0 {
+		return true
+	}
+
+	hour := w.Begin.Hour()
+	if c.FromHour <= c.ToHour {
+		return hour >= c.FromHour && hour <= c.ToHour
+	}
+
+	return hour >= c.FromHour || hour <= c.ToHour
+}
+
+// Select returns the earliest window meeting the criteria, or an error if none
+// does
+func Select(windows []Window, criteria Criteria) (Window, error) {
+	var selected *Window
+	for i, w := range windows {
+		if criteria.Matches(w) && (selected == nil || w.Begin.Before(selected.Begin)) {
+			selected = &windows[i]
+		}
+	}
+
+	if selected == nil {
+		return Window{}, fmt.Errorf("No maintenance window matches the criteria")
+	}
+
+	return *selected, nil
+}
+
+// Property returns the order property booking the window
+func (w Window) Property() datatypes.Container_Product_Order_Property {
+	return datatypes.Container_Product_Order_Property{
+		Name:  sl.String(PropertyName),
+		Value: sl.String(w.Begin.UTC().Format(time.RFC3339)),
+	}
+}
+
+// Book sets the window as the maintenance window of an upgrade order (e.g.,
+// the Container_Product_Order of a
+// Container_Product_Order_Virtual_Guest_Upgrade or
+// Container_Product_Order_Hardware_Server_Upgrade), replacing any window
+// already set
+func Book(order *datatypes.Container_Product_Order, w Window) {
+	properties := []datatypes.Container_Product_Order_Property{}
+	for _, p := range order.Properties {
+		if sl.Get(p.Name, "").(string) != PropertyName {
+			properties = append(properties, p)
+		}
+	}
+
+	order.Properties = append(properties, w.Property())
+}
@@ -0,0 +1,134 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package masks
+
+import (
+	"reflect"
+	"strings"
+)
+
+// For returns the object mask selecting the fields set in a datatype value,
+// so that masks stay in sync with the fields code actually reads. Any set
+// field (a non-nil pointer, slice or map) is selected; relational properties
+// set to a value with fields set themselves are selected with those fields,
+// and the first element of a slice describes its elements:
+//
+//	mask := masks.For(datatypes.Virtual_Guest{
+//		Hostname:      sl.String(""),
+//		Datacenter:    &datatypes.Location{Name: sl.String("")},
+//		TagReferences: []datatypes.Tag_Reference{{Tag: &datatypes.Tag{Name: sl.String("")}}},
+//	})
+//	// mask[datacenter[name],hostname,tagReferences[tag[name]]]
+//
+// The values of the fields are ignored. For returns an empty string if v is
+// not a struct (or pointer to one), or has no field set.
+func For(v interface{}) string {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return ""
+	}
+
+	properties := structProperties(value)
+	if len(properties) == 0 {
+		return ""
+	}
+
+	return "mask[" + strings.Join(properties, ",") + "]"
+}
+
+// structProperties returns the mask properties of the fields set in a struct,
+// in the order of the fields
+func structProperties(value reflect.Value) []string {
+	properties := []string{}
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue // unexported
+		}
+
+		fieldValue := value.Field(i)
+		if field.Anonymous {
+			// e.g., the Entity embedded in every datatype
+			for fieldValue.Kind() == reflect.Ptr && !fieldValue.IsNil() {
+				fieldValue = fieldValue.Elem()
+			}
+			if fieldValue.Kind() == reflect.Struct {
+				properties = append(properties, structProperties(fieldValue)...)
+			}
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		selected, nested := fieldProperties(fieldValue)
+		if !selected {
+			continue
+		}
+
+		if len(nested) > 0 {
+			name = name + "[" + strings.Join(nested, ",") + "]"
+		}
+		properties = append(properties, name)
+	}
+
+	return properties
+}
+
+// fieldProperties returns whether a field is set, and the properties selected
+// within it, if it holds a struct
+func fieldProperties(value reflect.Value) (bool, []string) {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return false, nil
+		}
+		_, nested := fieldProperties(value.Elem())
+		return true, nested
+
+	case reflect.Slice:
+		if value.IsNil() {
+			return false, nil
+		}
+		if value.Len() > 0 {
+			_, nested := fieldProperties(value.Index(0))
+			return true, nested
+		}
+		return true, nil
+
+	case reflect.Map:
+		return !value.IsNil(), nil
+
+	case reflect.Struct:
+		return true, structProperties(value)
+
+	default:
+		return !value.IsZero(), nil
+	}
+}
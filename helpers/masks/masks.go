@@ -0,0 +1,102 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package masks provides curated object masks for the most used datatypes, so
+// that calls fetch what is commonly needed in a single, reasonably fast
+// request.  Summary masks suit listings of many objects; Detail masks suit
+// displaying a single object.
+//
+//	guest, err := services.GetVirtualGuestService(sess).
+//		Id(guestId).Mask(masks.VirtualGuestDetail).GetObject()
+//
+// Masks can also be derived, with For, from the fields set in a datatype value.
+package masks
+
+// SoftLayer_Virtual_Guest
+const (
+	VirtualGuestSummary = "mask[id,hostname,domain,fullyQualifiedDomainName,globalIdentifier,maxCpu,maxMemory," +
+		"primaryIpAddress,primaryBackendIpAddress,hourlyBillingFlag,createDate," +
+		"status[keyName],powerState[keyName],datacenter[name]]"
+
+	VirtualGuestDetail = "mask[id,hostname,domain,fullyQualifiedDomainName,globalIdentifier,maxCpu,maxMemory," +
+		"primaryIpAddress,primaryBackendIpAddress,hourlyBillingFlag,dedicatedAccountHostOnlyFlag," +
+		"privateNetworkOnlyFlag,localDiskFlag,createDate,modifyDate,provisionDate,notes," +
+		"status[keyName],powerState[keyName],datacenter[name],activeTransaction[transactionStatus[name]]," +
+		"operatingSystem[softwareLicense[softwareDescription[referenceCode,name,version]]]," +
+		"blockDevices[device,diskImage[capacity,units]]," +
+		"networkVlans[id,vlanNumber,networkSpace],networkComponents[port,maxSpeed,status]," +
+		"billingItem[id,recurringFee,hourlyRecurringFee,nextBillDate],tagReferences[tag[name]]]"
+)
+
+// SoftLayer_Hardware_Server
+const (
+	HardwareServerSummary = "mask[id,hostname,domain,fullyQualifiedDomainName,globalIdentifier," +
+		"processorPhysicalCoreAmount,memoryCapacity,primaryIpAddress,primaryBackendIpAddress," +
+		"hourlyBillingFlag,provisionDate,hardwareStatus[status],datacenter[name]]"
+
+	HardwareServerDetail = "mask[id,hostname,domain,fullyQualifiedDomainName,globalIdentifier," +
+		"processorPhysicalCoreAmount,memoryCapacity,primaryIpAddress,primaryBackendIpAddress," +
+		"hourlyBillingFlag,privateNetworkOnlyFlag,provisionDate,notes," +
+		"hardwareStatus[status],datacenter[name],activeTransaction[transactionStatus[name]]," +
+		"operatingSystem[softwareLicense[softwareDescription[referenceCode,name,version]]]," +
+		"hardDrives[capacity,hardwareComponentModel[hardwareGenericComponentModel[capacity,units]]]," +
+		"networkVlans[id,vlanNumber,networkSpace],networkComponents[port,name,maxSpeed,status]," +
+		"billingItem[id,recurringFee,hourlyRecurringFee,nextBillDate],tagReferences[tag[name]]]"
+)
+
+// SoftLayer_Network_Storage
+const (
+	NetworkStorageSummary = "mask[id,username,capacityGb,bytesUsed,nasType,storageTierLevel,createDate," +
+		"storageType[keyName],serviceResource[datacenter[name]]]"
+
+	NetworkStorageDetail = "mask[id,username,capacityGb,bytesUsed,nasType,storageTierLevel,provisionedIops," +
+		"snapshotCapacityGb,serviceResourceBackendIpAddress,fileNetworkMountAddress,lunId,createDate,notes," +
+		"storageType[keyName],osType[keyName],serviceResource[datacenter[name]]," +
+		"allowedVirtualGuests[id,fullyQualifiedDomainName],allowedHardware[id,fullyQualifiedDomainName]," +
+		"allowedSubnets[id,networkIdentifier,cidr],allowedIpAddresses[id,ipAddress]," +
+		"billingItem[id,recurringFee,nextBillDate]]"
+)
+
+// SoftLayer_Ticket
+const (
+	TicketSummary = "mask[id,title,createDate,lastEditDate,priority,status[name],group[name]," +
+		"assignedUser[username]]"
+
+	TicketDetail = "mask[id,title,createDate,modifyDate,lastEditDate,priority,serviceProviderResourceId," +
+		"status[name],group[name],subject[name],assignedUser[username]," +
+		"attachedHardware[id,fullyQualifiedDomainName],attachedVirtualGuests[id,fullyQualifiedDomainName]," +
+		"updates[id,entry,createDate,editorType]]"
+)
+
+// Preset names
+const (
+	Summary = "summary"
+	Detail  = "detail"
+)
+
+var presets = map[string]map[string]string{
+	"SoftLayer_Virtual_Guest":   {Summary: VirtualGuestSummary, Detail: VirtualGuestDetail},
+	"SoftLayer_Hardware_Server": {Summary: HardwareServerSummary, Detail: HardwareServerDetail},
+	"SoftLayer_Network_Storage": {Summary: NetworkStorageSummary, Detail: NetworkStorageDetail},
+	"SoftLayer_Ticket":          {Summary: TicketSummary, Detail: TicketDetail},
+}
+
+// Lookup returns the named preset (Summary or Detail) of a service, for tools
+// selecting masks at runtime
+func Lookup(service string, preset string) (string, bool) {
+	mask, ok := presets[service][preset]
+	return mask, ok
+}
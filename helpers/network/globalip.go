@@ -0,0 +1,271 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/helpers/product"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// AdditionalServicesPackageKeyName Key name of the package global and static IPs are ordered from
+const AdditionalServicesPackageKeyName = "ADDITIONAL_SERVICES"
+
+// GlobalIPv4CategoryCode Category code for global IPv4 addresses
+const GlobalIPv4CategoryCode = "global_ipv4"
+
+// GlobalIPv6CategoryCode Category code for global IPv6 addresses
+const GlobalIPv6CategoryCode = "global_ipv6"
+
+// StaticIPv4CategoryCode Category code for static (secondary) public IPv4 subnets
+const StaticIPv4CategoryCode = "static_sec_ip_addresses"
+
+// StaticIPv6CategoryCode Category code for static public IPv6 subnets
+const StaticIPv6CategoryCode = "static_ipv6_addresses"
+
+// GlobalIpMask is the default object mask used to retrieve global IPs
+const GlobalIpMask = "id,ipAddress[ipAddress],destinationIpAddress[ipAddress],billingItem[id,orderItem[order[id]]]"
+
+// OrderGlobalIp orders a global IP address, which can then be routed to
+// any IP address on the account with RouteGlobalIp. Once provisioned, the
+// global IP can be retrieved with GetGlobalIpByOrder.
+func OrderGlobalIp(sess *session.Session, ipv6 bool) (datatypes.Container_Product_Order_Receipt, error) {
+	category := GlobalIPv4CategoryCode
+	if ipv6 {
+		category = GlobalIPv6CategoryCode
+	}
+
+	order, err := subnetOrder(sess, category, 0)
+	if err != nil {
+		return datatypes.Container_Product_Order_Receipt{}, err
+	}
+
+	return services.GetProductOrderService(sess).PlaceOrder(&order, sl.Bool(false))
+}
+
+// OrderStaticIps orders a static subnet of quantity addresses, routed to the
+// IP address with id endPointIpAddressId
+func OrderStaticIps(
+	sess *session.Session, endPointIpAddressId int, quantity int, ipv6 bool,
+) (datatypes.Container_Product_Order_Receipt, error) {
+
+	category := StaticIPv4CategoryCode
+	if ipv6 {
+		category = StaticIPv6CategoryCode
+	}
+
+	order, err := subnetOrder(sess, category, quantity)
+	if err != nil {
+		return datatypes.Container_Product_Order_Receipt{}, err
+	}
+
+	order.EndPointIpAddressId = sl.Int(endPointIpAddressId)
+
+	return services.GetProductOrderService(sess).PlaceOrder(&order, sl.Bool(false))
+}
+
+// subnetOrder returns an order for the standard price of an item in category,
+// with the given capacity (or any capacity, if zero)
+func subnetOrder(sess *session.Session, category string, capacity int) (datatypes.Container_Product_Order_Network_Subnet, error) {
+	pkg, err := product.GetPackageByKeyName(sess, AdditionalServicesPackageKeyName)
+	if err != nil {
+		return datatypes.Container_Product_Order_Network_Subnet{}, err
+	}
+
+	items, err := product.GetPackageProducts(sess, *pkg.Id,
+		"id,capacity,description,keyName,prices[id,locationGroupId,categories[categoryCode]]")
+	if err != nil {
+		return datatypes.Container_Product_Order_Network_Subnet{}, err
+	}
+
+	for _, item := range items {
+		if capacity != 0 && float64(sl.Get(item.Capacity, datatypes.Float64(0)).(datatypes.Float64)) != float64(capacity) {
+			continue
+		}
+
+		for _, price := range item.Prices {
+			if price.LocationGroupId != nil || !hasCategory(price, category) {
+				continue
+			}
+
+			return datatypes.Container_Product_Order_Network_Subnet{
+				Container_Product_Order: datatypes.Container_Product_Order{
+					PackageId: pkg.Id,
+					Quantity:  sl.Int(1),
+					Prices:    []datatypes.Product_Item_Price{{Id: price.Id}},
+				},
+			}, nil
+		}
+	}
+
+	if capacity != 0 {
+		return datatypes.Container_Product_Order_Network_Subnet{},
+			fmt.Errorf("No price found for %s with capacity %d", category, capacity)
+	}
+
+	return datatypes.Container_Product_Order_Network_Subnet{}, fmt.Errorf("No price found for %s", category)
+}
+
+func hasCategory(price datatypes.Product_Item_Price, categoryCode string) bool {
+	for _, category := range price.Categories {
+		if sl.Get(category.CategoryCode, "") == categoryCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetGlobalIps returns the global IPs of the account, with the addresses they
+// are routed to
+func GetGlobalIps(sess *session.Session, mask ...string) ([]datatypes.Network_Subnet_IpAddress_Global, error) {
+	objectMask := GlobalIpMask
+	if len(mask) > 0 {
+		objectMask = mask[0]
+	}
+
+	globalIps, err := services.GetAccountService(sess).
+		Mask(objectMask).
+		Unlimited().
+		GetGlobalIpRecords()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving global IPs: %s", err)
+	}
+
+	return globalIps, nil
+}
+
+// GlobalIpAddressFilter returns the object filter used by GetGlobalIpByAddress
+func GlobalIpAddressFilter(address string) string {
+	return filter.Path("globalIpRecords.ipAddress.ipAddress").Eq(address).Build()
+}
+
+// GlobalIpOrderFilter returns the object filter used by GetGlobalIpByOrder
+func GlobalIpOrderFilter(orderId int) string {
+	return filter.Path("globalIpRecords.billingItem.orderItem.order.id").Eq(orderId).Build()
+}
+
+// GetGlobalIpByAddress returns the global IP record of address
+func GetGlobalIpByAddress(sess *session.Session, address string, mask ...string) (datatypes.Network_Subnet_IpAddress_Global, error) {
+	objectMask := GlobalIpMask
+	if len(mask) > 0 {
+		objectMask = mask[0]
+	}
+
+	globalIps, err := services.GetAccountService(sess).
+		Mask(objectMask).
+		Filter(GlobalIpAddressFilter(address)).
+		GetGlobalIpRecords()
+	if err != nil {
+		return datatypes.Network_Subnet_IpAddress_Global{}, fmt.Errorf("Error retrieving global IPs: %s", err)
+	}
+
+	if len(globalIps) == 0 {
+		return datatypes.Network_Subnet_IpAddress_Global{}, fmt.Errorf("No global IP found with address of %s", address)
+	}
+
+	return globalIps[0], nil
+}
+
+// GetGlobalIpByOrder returns the global IP provisioned for the order with the
+// given id (the OrderId of the receipt of OrderGlobalIp)
+func GetGlobalIpByOrder(sess *session.Session, orderId int, mask ...string) (datatypes.Network_Subnet_IpAddress_Global, error) {
+	objectMask := GlobalIpMask
+	if len(mask) > 0 {
+		objectMask = mask[0]
+	}
+
+	globalIps, err := services.GetAccountService(sess).
+		Mask(objectMask).
+		Filter(GlobalIpOrderFilter(orderId)).
+		Unlimited().
+		GetGlobalIpRecords()
+	if err != nil {
+		return datatypes.Network_Subnet_IpAddress_Global{}, fmt.Errorf("Error retrieving global IPs: %s", err)
+	}
+
+	if len(globalIps) == 0 {
+		return datatypes.Network_Subnet_IpAddress_Global{}, fmt.Errorf("No global IP provisioned for order %d", orderId)
+	}
+
+	return globalIps[0], nil
+}
+
+// GetGlobalIpRoutes returns the addresses the global IPs of the account are
+// routed to, keyed by global IP address. Unrouted global IPs map to "".
+func GetGlobalIpRoutes(sess *session.Session) (map[string]string, error) {
+	globalIps, err := GetGlobalIps(sess, "id,ipAddress[ipAddress],destinationIpAddress[ipAddress]")
+	if err != nil {
+		return nil, err
+	}
+
+	routes := map[string]string{}
+	for _, globalIp := range globalIps {
+		if globalIp.IpAddress == nil {
+			continue
+		}
+
+		destination := ""
+		if globalIp.DestinationIpAddress != nil {
+			destination = sl.Get(globalIp.DestinationIpAddress.IpAddress, "").(string)
+		}
+
+		routes[sl.Get(globalIp.IpAddress.IpAddress, "").(string)] = destination
+	}
+
+	return routes, nil
+}
+
+// RouteGlobalIp routes the global IP address to destination, an IP address
+// on the account. The route is updated within a few minutes.
+func RouteGlobalIp(sess *session.Session, address string, destination string) (datatypes.Provisioning_Version1_Transaction, error) {
+	globalIp, err := GetGlobalIpByAddress(sess, address, "id")
+	if err != nil {
+		return datatypes.Provisioning_Version1_Transaction{}, err
+	}
+
+	transaction, err := services.GetNetworkSubnetIpAddressGlobalService(sess).
+		Id(*globalIp.Id).
+		Route(sl.String(destination))
+	if err != nil {
+		return transaction, fmt.Errorf("Error routing global IP %s to %s: %s", address, destination, err)
+	}
+
+	return transaction, nil
+}
+
+// UnrouteGlobalIp removes the route of the global IP address
+func UnrouteGlobalIp(sess *session.Session, address string) (datatypes.Provisioning_Version1_Transaction, error) {
+	globalIp, err := GetGlobalIpByAddress(sess, address, "id")
+	if err != nil {
+		return datatypes.Provisioning_Version1_Transaction{}, err
+	}
+
+	transaction, err := services.GetNetworkSubnetIpAddressGlobalService(sess).
+		Id(*globalIp.Id).
+		Unroute()
+	if err != nil {
+		return transaction, fmt.Errorf("Error unrouting global IP %s: %s", address, err)
+	}
+
+	return transaction, nil
+}
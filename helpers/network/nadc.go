@@ -0,0 +1,149 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// NadcMask is the default object mask used by GetNadcs
+const NadcMask = "id,name,description,type[name],datacenter[name],managementIpAddress," +
+	"primaryIpAddress,licenseExpirationDate,networkVlan[id,vlanNumber]"
+
+// NadcCredentials are the credentials used to manage a load balancer appliance
+// like the Netscaler VPX directly
+type NadcCredentials struct {
+	ManagementIpAddress string
+	Username            string
+	Password            string
+}
+
+// GetNadcs returns the load balancer appliances (e.g., Netscaler VPX) of the
+// account
+func GetNadcs(sess *session.Session, mask ...string) ([]datatypes.Network_Application_Delivery_Controller, error) {
+	objectMask := NadcMask
+	if len(mask) > 0 {
+		objectMask = mask[0]
+	}
+
+	nadcs, err := services.GetAccountService(sess).
+		Mask(objectMask).
+		Unlimited().
+		GetApplicationDeliveryControllers()
+	if err != nil {
+		return nil, fmt.Errorf("Error getting NADCs: %s", err)
+	}
+
+	return nadcs, nil
+}
+
+// NadcCredentialsMask is the object mask used by GetNadcCredentials
+const NadcCredentialsMask = "id,managementIpAddress,password[username,password]"
+
+// GetNadcCredentials returns the management address and credentials of a load
+// balancer appliance
+func GetNadcCredentials(sess *session.Session, nadcId int) (NadcCredentials, error) {
+	nadc, err := services.GetNetworkApplicationDeliveryControllerService(sess).
+		Id(nadcId).
+		Mask(NadcCredentialsMask).
+		GetObject()
+	if err != nil {
+		return NadcCredentials{}, fmt.Errorf("Error getting credentials of NADC %d: %s", nadcId, err)
+	}
+
+	if nadc.Password == nil {
+		return NadcCredentials{}, fmt.Errorf("No credentials found for NADC %d", nadcId)
+	}
+
+	return NadcCredentials{
+		ManagementIpAddress: sl.Get(nadc.ManagementIpAddress, "").(string),
+		Username:            sl.Get(nadc.Password.Username, "").(string),
+		Password:            sl.Get(nadc.Password.Password, "").(string),
+	}, nil
+}
+
+// CreateNadcLbVip creates a virtual ip address on a load balancer appliance
+func CreateNadcLbVip(sess *session.Session, nadcId int, vip datatypes.Network_LoadBalancer_VirtualIpAddress) error {
+	_, err := services.GetNetworkApplicationDeliveryControllerService(sess).
+		Id(nadcId).
+		CreateLiveLoadBalancer(&vip)
+	if err != nil {
+		return fmt.Errorf("Error creating VIP %s for NADC %d: %s", sl.Get(vip.Name, ""), nadcId, err)
+	}
+
+	return nil
+}
+
+// UpdateNadcLbVip updates the virtual ip address of a load balancer appliance
+// with the name of vip. Services of vip are added to, or updated on, the VIP.
+func UpdateNadcLbVip(sess *session.Session, nadcId int, vip datatypes.Network_LoadBalancer_VirtualIpAddress) error {
+	_, err := services.GetNetworkApplicationDeliveryControllerService(sess).
+		Id(nadcId).
+		UpdateLiveLoadBalancer(&vip)
+	if err != nil {
+		return fmt.Errorf("Error updating VIP %s for NADC %d: %s", sl.Get(vip.Name, ""), nadcId, err)
+	}
+
+	return nil
+}
+
+// DeleteNadcLbVip deletes the virtual ip address named vipName from a load
+// balancer appliance
+func DeleteNadcLbVip(sess *session.Session, nadcId int, vipName string) error {
+	_, err := services.GetNetworkApplicationDeliveryControllerService(sess).
+		Id(nadcId).
+		DeleteLiveLoadBalancer(&datatypes.Network_LoadBalancer_VirtualIpAddress{Name: sl.String(vipName)})
+	if err != nil {
+		return fmt.Errorf("Error deleting VIP %s for NADC %d: %s", vipName, nadcId, err)
+	}
+
+	return nil
+}
+
+// AddNadcLbVipService adds a service to the virtual ip address named vipName
+// of a load balancer appliance
+func AddNadcLbVipService(
+	sess *session.Session, nadcId int, vipName string, service datatypes.Network_LoadBalancer_Service,
+) error {
+	return UpdateNadcLbVip(sess, nadcId, datatypes.Network_LoadBalancer_VirtualIpAddress{
+		Name:     sl.String(vipName),
+		Services: []datatypes.Network_LoadBalancer_Service{service},
+	})
+}
+
+// DeleteNadcLbVipService deletes the service named serviceName from the
+// virtual ip address named vipName of a load balancer appliance
+func DeleteNadcLbVipService(sess *session.Session, nadcId int, vipName string, serviceName string) error {
+	service, err := GetNadcLbVipServiceByName(sess, nadcId, vipName, serviceName)
+	if err != nil {
+		return err
+	}
+
+	err = services.GetNetworkApplicationDeliveryControllerService(sess).
+		Id(nadcId).
+		DeleteLiveLoadBalancerService(service)
+	if err != nil {
+		return fmt.Errorf("Error deleting service %s of VIP %s for NADC %d: %s", serviceName, vipName, nadcId, err)
+	}
+
+	return nil
+}
@@ -83,6 +83,11 @@ func GetNadcLbVipServiceByName(
 		serviceName, vipName, nadcId)
 }
 
+// NameFilter returns the object filter used by GetOsTypeByName
+func NameFilter(name string) string {
+	return filter.New(filter.Path("name").Eq(name)).Build()
+}
+
 // GetOsTypeByName retrieves an object of type SoftLayer_Network_Storage_Iscsi_OS_Type.
 // To order block storage, OS type is required as a mandatory input.
 // GetOsTypeByName helps in getting the OS id and keyName
@@ -99,7 +104,7 @@ func GetOsTypeByName(sess *session.Session, name string, args ...interface{}) (d
 
 	osTypes, err := services.GetNetworkStorageIscsiOSTypeService(sess).
 		Mask(mask).
-		Filter(filter.New(filter.Path("name").Eq(name)).Build()).
+		Filter(NameFilter(name)).
 		GetAllObjects()
 
 	if err != nil {
@@ -0,0 +1,186 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package network
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// PingResult is the parsed output of a ping issued from the SoftLayer network
+type PingResult struct {
+	Transmitted int
+	Received    int
+	Loss        float64
+	Min         time.Duration
+	Avg         time.Duration
+	Max         time.Duration
+	Raw         string
+}
+
+// Reachable returns true if any ping reply was received
+func (p PingResult) Reachable() bool {
+	return p.Received > 0
+}
+
+// WhoisResult is the parsed output of a WHOIS lookup
+type WhoisResult struct {
+	// Fields maps each field name (e.g., "OrgName", "Registrar") to its
+	// values, in the order they appear
+	Fields map[string][]string
+	Raw    string
+}
+
+// Get returns the first value of a field, or "" if it is not present
+func (w WhoisResult) Get(field string) string {
+	if values := w.Fields[field]; len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+var (
+	pingPackets = regexp.MustCompile(`(\d+) packets transmitted, (\d+) (?:packets )?received.*?([\d.]+)% packet loss`)
+	pingTimes   = regexp.MustCompile(`= ([\d.]+)/([\d.]+)/([\d.]+)`)
+)
+
+// PingHardware pings a bare metal server from the SoftLayer network
+func PingHardware(sess *session.Session, hardwareId int) (PingResult, error) {
+	output, err := services.GetHardwareServerService(sess).Id(hardwareId).Ping()
+	if err != nil {
+		return PingResult{}, fmt.Errorf("Error pinging hardware %d: %s", hardwareId, err)
+	}
+
+	return ParsePing(output), nil
+}
+
+// ParsePing parses the summary of the output of ping
+func ParsePing(output string) PingResult {
+	result := PingResult{Raw: output}
+
+	if m := pingPackets.FindStringSubmatch(output); m != nil {
+		result.Transmitted, _ = strconv.Atoi(m[1])
+		result.Received, _ = strconv.Atoi(m[2])
+		result.Loss, _ = strconv.ParseFloat(m[3], 64)
+	}
+
+	if m := pingTimes.FindStringSubmatch(output); m != nil {
+		result.Min = milliseconds(m[1])
+		result.Avg = milliseconds(m[2])
+		result.Max = milliseconds(m[3])
+	}
+
+	return result
+}
+
+func milliseconds(value string) time.Duration {
+	ms, _ := strconv.ParseFloat(value, 64)
+	return time.Duration(ms * float64(time.Millisecond))
+}
+
+// IsReachable reports whether the public (or, if backend is true, private)
+// address of a server responds to ping from the SoftLayer network. Kind is
+// "hardware" or "virtualGuest".
+func IsReachable(sess *session.Session, kind string, id int, backend bool) (bool, error) {
+	var reachable bool
+	var err error
+
+	switch kind {
+	case "hardware":
+		service := services.GetHardwareServerService(sess).Id(id)
+		if backend {
+			reachable, err = service.IsBackendPingable()
+		} else {
+			reachable, err = service.IsPingable()
+		}
+	case "virtualGuest":
+		service := services.GetVirtualGuestService(sess).Id(id)
+		if backend {
+			reachable, err = service.IsBackendPingable()
+		} else {
+			reachable, err = service.IsPingable()
+		}
+	default:
+		return false, fmt.Errorf("Unknown server kind %q", kind)
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("Error pinging %s %d: %s", kind, id, err)
+	}
+
+	return reachable, nil
+}
+
+// Whois runs a WHOIS lookup of an IP address or host name from the SoftLayer
+// network
+func Whois(sess *session.Session, address string) (WhoisResult, error) {
+	output, err := services.GetUtilityNetworkService(sess).Whois(sl.String(address))
+	if err != nil {
+		return WhoisResult{}, fmt.Errorf("Error looking up %s: %s", address, err)
+	}
+
+	return ParseWhois(output), nil
+}
+
+// ParseWhois parses the "Field: value" lines of WHOIS output. Comments and
+// lines without a value are ignored.
+func ParseWhois(output string) WhoisResult {
+	result := WhoisResult{Fields: map[string][]string{}, Raw: output}
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "%") {
+			continue
+		}
+
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		field, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		// Skip bare URLs, which are split on their scheme
+		if field == "" || value == "" || strings.HasPrefix(value, "//") {
+			continue
+		}
+
+		result.Fields[field] = append(result.Fields[field], value)
+	}
+
+	return result
+}
+
+// NsLookup resolves address from the SoftLayer network, returning the raw
+// output of the lookup. Typ is the record type (e.g., "A", "MX", "PTR").
+func NsLookup(sess *session.Session, address string, typ string) (string, error) {
+	output, err := services.GetUtilityNetworkService(sess).NsLookup(sl.String(address), sl.String(typ))
+	if err != nil {
+		return "", fmt.Errorf("Error looking up %s records of %s: %s", typ, address, err)
+	}
+
+	return output, nil
+}
@@ -23,6 +23,9 @@ import (
 	"github.com/softlayer/softlayer-go/sl"
 )
 
+// BillingOrderItemMask is the object mask used by CheckBillingOrderStatus
+const BillingOrderItemMask = "mask[id,billingItem[id,provisionTransaction[id,transactionStatus[name]]]]"
+
 // CheckBillingOrderStatus returns true if the status of the billing order for
 // the provided product order receipt is in the list of provided statuses.
 // Returns false otherwise, along with the billing order item used to check the statuses,
@@ -32,7 +35,7 @@ func CheckBillingOrderStatus(sess *session.Session, receipt *datatypes.Container
 
 	item, err := service.
 		Id(*receipt.PlacedOrder.Items[0].Id).
-		Mask("mask[id,billingItem[id,provisionTransaction[id,transactionStatus[name]]]]").
+		Mask(BillingOrderItemMask).
 		GetObject()
 
 	if err != nil {
@@ -0,0 +1,158 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package order
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Problem is one problem reported by an order verification. Item and PriceId
+// are set when the message names them.
+type Problem struct {
+	Item    string
+	PriceId int
+	Reason  string
+}
+
+// ValidationError is returned by Verify and Place when the API rejects an
+// order. Problems lists the individual problems found in the API message.
+//
+// The original API error can be retrieved with errors.As or Unwrap.
+type ValidationError struct {
+	Problems []Problem
+	Err      sl.Error
+}
+
+func (r ValidationError) Error() string {
+	return r.Err.Error()
+}
+
+// Unwrap returns the original API error
+func (r ValidationError) Unwrap() error {
+	return r.Err
+}
+
+var (
+	problemSeparatorRegex = regexp.MustCompile(`(?i)\s*(?:<br\s*/?>|\n|;\s+)\s*`)
+	sentenceEndRegex      = regexp.MustCompile(`\.\s+[A-Z]`)
+	priceIdRegex          = regexp.MustCompile(`(?i)(?:price(?:\s+id)?\s*(?:#\s*)?|#\s*)(\d+)`)
+	describedItemRegex    = regexp.MustCompile(`(?i)\b(?:price|item)(?:\s+(?:of|for))?\s+(.+?)\s*\(#\s*\d+\)`)
+	categoryRegex         = regexp.MustCompile(`(?i)\bcategor(?:y|ies):\s*(.+?)\.?$`)
+	quotedItemRegex       = regexp.MustCompile(`['"]([^'"]+)['"]`)
+	keyNameRegex          = regexp.MustCompile(`\b[A-Z][A-Z0-9]*(?:_[A-Z0-9]+)+\b`)
+)
+
+// ParseProblems splits an order verification message into its problems,
+// extracting the item and price id each one names, if any
+func ParseProblems(message string) []Problem {
+	problems := []Problem{}
+	for _, part := range problemSeparatorRegex.Split(message, -1) {
+		for _, sentence := range splitSentences(part) {
+			sentence = strings.TrimSpace(sentence)
+			if sentence == "" {
+				continue
+			}
+
+			problem := Problem{Reason: sentence}
+			if match := priceIdRegex.FindStringSubmatch(sentence); match != nil {
+				problem.PriceId, _ = strconv.Atoi(match[1])
+			}
+
+			if match := quotedItemRegex.FindStringSubmatch(sentence); match != nil {
+				problem.Item = match[1]
+			} else if match := keyNameRegex.FindString(sentence); match != "" {
+				problem.Item = match
+			} else if match := describedItemRegex.FindStringSubmatch(sentence); match != nil {
+				problem.Item = match[1]
+			} else if match := categoryRegex.FindStringSubmatch(sentence); match != nil {
+				problem.Item = match[1]
+			}
+
+			problems = append(problems, problem)
+		}
+	}
+
+	return problems
+}
+
+// splitSentences splits s after each period that is followed by a new sentence,
+// leaving periods within item descriptions (e.g., "Ubuntu 20.04") alone
+func splitSentences(s string) []string {
+	sentences := []string{}
+	for {
+		loc := sentenceEndRegex.FindStringIndex(s)
+		if loc == nil {
+			return append(sentences, s)
+		}
+
+		sentences = append(sentences, s[:loc[0]+1])
+		s = s[loc[1]-1:]
+	}
+}
+
+// Verify checks orderData with SoftLayer_Product_Order::verifyOrder. If the
+// order is rejected, the error is a ValidationError.
+func Verify(sess *session.Session, orderData interface{}) (datatypes.Container_Product_Order, error) {
+	order, err := services.GetProductOrderService(sess).VerifyOrder(orderData)
+	return order, validationError(err)
+}
+
+// Place places orderData with SoftLayer_Product_Order::placeOrder. If the
+// order is rejected, the error is a ValidationError.
+func Place(sess *session.Session, orderData interface{}, saveAsQuote bool) (datatypes.Container_Product_Order_Receipt, error) {
+	receipt, err := services.GetProductOrderService(sess).PlaceOrder(orderData, &saveAsQuote)
+	return receipt, validationError(err)
+}
+
+// validationError converts the exceptions rejecting an order, returned by
+// verifyOrder or placeOrder, into a ValidationError. Any other error (e.g., a
+// network error, rejected credentials or throttling) is returned unchanged.
+func validationError(err error) error {
+	var rateLimitErr sl.RateLimitError
+	var deprecationErr sl.DeprecationError
+	if errors.As(err, &rateLimitErr) || errors.As(err, &deprecationErr) {
+		return err
+	}
+
+	var slErr sl.Error
+	if !errors.As(err, &slErr) || slErr.Message == "" || !isOrderException(slErr) {
+		return err
+	}
+
+	return ValidationError{Problems: ParseProblems(slErr.Message), Err: slErr}
+}
+
+// isOrderException returns true for the exceptions the API rejects orders
+// with: those of the SoftLayer_Exception_Order family, and the generic
+// SoftLayer_Exception_Public, unless the call was not authorized or throttled
+func isOrderException(err sl.Error) bool {
+	switch err.StatusCode {
+	case 401, 403, 429:
+		return false
+	}
+
+	return strings.HasPrefix(err.Exception, "SoftLayer_Exception_Order") ||
+		err.Exception == "SoftLayer_Exception_Public"
+}
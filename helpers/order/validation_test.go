@@ -0,0 +1,117 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package order
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestParseProblems(t *testing.T) {
+	tests := []struct {
+		message  string
+		problems []Problem
+	}{
+		{
+			message: `Invalid price Ubuntu 20.04 (#12345) provided on the order container.`,
+			problems: []Problem{
+				{Item: "Ubuntu 20.04", PriceId: 12345, Reason: `Invalid price Ubuntu 20.04 (#12345) provided on the order container.`},
+			},
+		},
+		{
+			message: `The item 'GUEST_CORES_4' is not available in dal13.<br/>Price # 987 is not valid.`,
+			problems: []Problem{
+				{Item: "GUEST_CORES_4", Reason: `The item 'GUEST_CORES_4' is not available in dal13.`},
+				{PriceId: 987, Reason: `Price # 987 is not valid.`},
+			},
+		},
+		{
+			message: `Item RAM_16_GB requires another item. Price id 55 conflicts with it`,
+			problems: []Problem{
+				{Item: "RAM_16_GB", Reason: `Item RAM_16_GB requires another item.`},
+				{PriceId: 55, Reason: `Price id 55 conflicts with it`},
+			},
+		},
+		{
+			message: `Order is missing the following category: Operating System.`,
+			problems: []Problem{
+				{Item: "Operating System", Reason: `Order is missing the following category: Operating System.`},
+			},
+		},
+		{
+			message: `Unable to place the order; please try again later`,
+			problems: []Problem{
+				{Reason: `Unable to place the order`},
+				{Reason: `please try again later`},
+			},
+		},
+		{
+			message:  " ",
+			problems: []Problem{},
+		},
+	}
+
+	for _, test := range tests {
+		if problems := ParseProblems(test.message); !reflect.DeepEqual(problems, test.problems) {
+			t.Errorf("ParseProblems(%q):\n got %+v\nwant %+v", test.message, problems, test.problems)
+		}
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	tests := []struct {
+		err        error
+		validation bool
+	}{
+		{sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_Order_InvalidLocation", Message: "Invalid location"}, true},
+		{sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_Public", Message: "Price # 987 is not valid."}, true},
+		{sl.Error{StatusCode: 401, Exception: "SoftLayer_Exception_Public", Message: "Access Denied."}, false},
+		{sl.Error{StatusCode: 429, Exception: "SoftLayer_Exception_WebService_RateLimitExceeded", Message: "Rate limit exceeded"}, false},
+		{sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_InvalidLegacyToken", Message: "Invalid token"}, false},
+		{errors.New("connection refused"), false},
+	}
+
+	for _, test := range tests {
+		var validation ValidationError
+		if isValidation := errors.As(validationError(test.err), &validation); isValidation != test.validation {
+			t.Errorf("Expected %v to be a ValidationError: %v", test.err, test.validation)
+		}
+	}
+}
+
+func TestValidationErrorWrapped(t *testing.T) {
+	orderErr := sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_Order_InvalidLocation", Message: "Invalid location"}
+
+	var validation ValidationError
+	if !errors.As(validationError(fmt.Errorf("placing: %w", orderErr)), &validation) {
+		t.Errorf("Expected a wrapped order exception to be a ValidationError")
+	}
+
+	throttled := sl.RateLimitError{Err: sl.Error{StatusCode: 429, Exception: "SoftLayer_Exception_Public", Message: "Slow down"}}
+	if errors.As(validationError(throttled), &validation) {
+		t.Errorf("Expected a RateLimitError to be returned unchanged")
+	}
+
+	deprecated := sl.DeprecationError{Err: sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_Public", Message: "This method is deprecated"}}
+	if errors.As(validationError(deprecated), &validation) {
+		t.Errorf("Expected a DeprecationError to be returned unchanged")
+	}
+}
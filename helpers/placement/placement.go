@@ -0,0 +1,213 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package placement reports the CPU topology of dedicated hosts and bare metal
+// servers, and the placement of guests on dedicated hosts, so the pinning and
+// placement of latency-sensitive workloads can be audited.
+//
+// The API does not publish NUMA node or vCPU pinning details; the reports are
+// limited to the processor, core and thread counts it does publish.
+package placement
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Guest is a virtual guest placed on a dedicated host
+type Guest struct {
+	Id       int
+	Hostname string
+	Cpus     int // vCPUs the guest runs with
+	MaxCpu   int
+	CpuUnits string // e.g., "CORE"
+	Memory   int    // in MB
+}
+
+// Host is the CPU and memory allocation of a dedicated host
+type Host struct {
+	Id         int
+	Name       string
+	Datacenter string
+
+	CpuCount     int
+	CpuAllocated int
+	CpuAvailable int
+
+	MemoryCapacity  int // in GB
+	MemoryAllocated int
+	MemoryAvailable int
+
+	Guests []Guest
+}
+
+// Processor is a processor installed in a server
+type Processor struct {
+	Manufacturer string
+	Model        string
+	Speed        float64 // as published for the model (e.g., GHz)
+}
+
+// Server is the CPU topology of a bare metal server
+type Server struct {
+	Id         int
+	Hostname   string
+	Datacenter string
+
+	Sockets       int // number of processors
+	Cores         int // logical cores (threads), over all processors
+	PhysicalCores int
+	MemoryGB      int
+
+	Processors []Processor
+}
+
+// ThreadsPerCore returns the number of threads per physical core (e.g., 2 with
+// hyper-threading enabled), or 0 if unknown
+func (s Server) ThreadsPerCore() int {
+	if s.PhysicalCores == 0 {
+		return 0
+	}
+
+	return s.Cores / s.PhysicalCores
+}
+
+// CoresPerSocket returns the number of physical cores per processor, or 0 if
+// unknown
+func (s Server) CoresPerSocket() int {
+	if s.Sockets == 0 {
+		return 0
+	}
+
+	return s.PhysicalCores / s.Sockets
+}
+
+// HostMask is the object mask used by GetHost and GetHosts
+const HostMask = "id,name,cpuCount,memoryCapacity,datacenter[name]," +
+	"allocationStatus[cpuAllocated,cpuAvailable,memoryAllocated,memoryAvailable]," +
+	"guests[id,hostname,startCpus,maxCpu,maxCpuUnits,maxMemory]"
+
+// ServerMask is the object mask used by GetServer
+const ServerMask = "id,hostname,datacenter[name],memoryCapacity,processorCount," +
+	"processorCoreAmount,processorPhysicalCoreAmount," +
+	"processors[hardwareComponentModel[manufacturer,name,capacity]]"
+
+// GetHost returns the allocation of a dedicated host, with the guests placed on
+// it ordered by hostname
+func GetHost(sess *session.Session, hostId int) (Host, error) {
+	host, err := services.GetVirtualDedicatedHostService(sess).Id(hostId).Mask(HostMask).GetObject()
+	if err != nil {
+		return Host{}, fmt.Errorf("Error retrieving dedicated host %d: %s", hostId, err)
+	}
+
+	return newHost(host), nil
+}
+
+// GetHosts returns the allocation of all the dedicated hosts of the account
+func GetHosts(sess *session.Session) ([]Host, error) {
+	found, err := services.GetAccountService(sess).Mask(HostMask).Unlimited().GetDedicatedHosts()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving dedicated hosts: %s", err)
+	}
+
+	hosts := make([]Host, 0, len(found))
+	for _, host := range found {
+		hosts = append(hosts, newHost(host))
+	}
+
+	return hosts, nil
+}
+
+func newHost(host datatypes.Virtual_DedicatedHost) Host {
+	result := Host{
+		Id:             sl.Get(host.Id, 0).(int),
+		Name:           sl.Get(host.Name, "").(string),
+		CpuCount:       sl.Get(host.CpuCount, 0).(int),
+		MemoryCapacity: sl.Get(host.MemoryCapacity, 0).(int),
+		Guests:         []Guest{},
+	}
+
+	if host.Datacenter != nil {
+		result.Datacenter = sl.Get(host.Datacenter.Name, "").(string)
+	}
+
+	if status := host.AllocationStatus; status != nil {
+		result.CpuAllocated = sl.Get(status.CpuAllocated, 0).(int)
+		result.CpuAvailable = sl.Get(status.CpuAvailable, 0).(int)
+		result.MemoryAllocated = sl.Get(status.MemoryAllocated, 0).(int)
+		result.MemoryAvailable = sl.Get(status.MemoryAvailable, 0).(int)
+	}
+
+	for _, guest := range host.Guests {
+		result.Guests = append(result.Guests, Guest{
+			Id:       sl.Get(guest.Id, 0).(int),
+			Hostname: sl.Get(guest.Hostname, "").(string),
+			Cpus:     sl.Get(guest.StartCpus, 0).(int),
+			MaxCpu:   sl.Get(guest.MaxCpu, 0).(int),
+			CpuUnits: sl.Get(guest.MaxCpuUnits, "").(string),
+			Memory:   sl.Get(guest.MaxMemory, 0).(int),
+		})
+	}
+
+	sort.Slice(result.Guests, func(i, j int) bool {
+		return result.Guests[i].Hostname < result.Guests[j].Hostname
+	})
+
+	return result
+}
+
+// GetServer returns the CPU topology of a bare metal server
+func GetServer(sess *session.Session, hardwareId int) (Server, error) {
+	hardware, err := services.GetHardwareServerService(sess).Id(hardwareId).Mask(ServerMask).GetObject()
+	if err != nil {
+		return Server{}, fmt.Errorf("Error retrieving hardware %d: %s", hardwareId, err)
+	}
+
+	server := Server{
+		Id:            sl.Get(hardware.Id, 0).(int),
+		Hostname:      sl.Get(hardware.Hostname, "").(string),
+		Sockets:       int(sl.Get(hardware.ProcessorCount, uint(0)).(uint)),
+		Cores:         int(sl.Get(hardware.ProcessorCoreAmount, uint(0)).(uint)),
+		PhysicalCores: int(sl.Get(hardware.ProcessorPhysicalCoreAmount, uint(0)).(uint)),
+		MemoryGB:      int(sl.Get(hardware.MemoryCapacity, uint(0)).(uint)),
+		Processors:    []Processor{},
+	}
+
+	if hardware.Datacenter != nil {
+		server.Datacenter = sl.Get(hardware.Datacenter.Name, "").(string)
+	}
+
+	for _, component := range hardware.Processors {
+		processor := Processor{}
+		if model := component.HardwareComponentModel; model != nil {
+			processor.Manufacturer = sl.Get(model.Manufacturer, "").(string)
+			processor.Model = sl.Get(model.Name, "").(string)
+			processor.Speed = float64(sl.Get(model.Capacity, datatypes.Float64(0)).(datatypes.Float64))
+		}
+		server.Processors = append(server.Processors, processor)
+	}
+
+	if server.Sockets == 0 {
+		server.Sockets = len(server.Processors)
+	}
+
+	return server, nil
+}
@@ -0,0 +1,116 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// unmanagedRecordTypes are never deleted from a zone, since they are
+// maintained by SoftLayer
+var unmanagedRecordTypes = map[string]bool{"soa": true, "ns": true}
+
+// DNSRecordMask is the object mask used by DNSRecords
+const DNSRecordMask = "id,type,host,data,ttl,mxPriority"
+
+// DNSRecords plans the changes to the resource records of the zone needed to
+// match desired.  Records are matched on type, host and data; a matched record
+// is updated when its TTL or priority differs.  Existing records that are not
+// desired are deleted, except for SOA and NS records.
+func DNSRecords(sess *session.Session, zoneId int, desired []datatypes.Dns_Domain_ResourceRecord) (*Plan, error) {
+	existing, err := services.GetDnsDomainService(sess).
+		Id(zoneId).
+		Mask(DNSRecordMask).
+		Unlimited().
+		GetResourceRecords()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving resource records of zone %d: %s", zoneId, err)
+	}
+
+	current := map[string]datatypes.Dns_Domain_ResourceRecord{}
+	for _, record := range existing {
+		current[recordKey(record)] = record
+	}
+
+	p := &Plan{}
+	service := services.GetDnsDomainResourceRecordService(sess)
+	seen := map[string]bool{}
+	for _, record := range desired {
+		record := record
+		record.DomainId = sl.Int(zoneId)
+		key := recordKey(record)
+		seen[key] = true
+
+		found, ok := current[key]
+		if !ok {
+			p.add(Create, "dns record "+describeRecord(record), nil, func() error {
+				_, err := service.CreateObject(&record)
+				return err
+			})
+			continue
+		}
+
+		details := []string{}
+		if record.Ttl != nil && sl.Get(found.Ttl) != *record.Ttl {
+			details = append(details, fmt.Sprintf("ttl: %d => %d", sl.Get(found.Ttl), *record.Ttl))
+		}
+		if record.MxPriority != nil && sl.Get(found.MxPriority) != *record.MxPriority {
+			details = append(details, fmt.Sprintf("mxPriority: %d => %d", sl.Get(found.MxPriority), *record.MxPriority))
+		}
+
+		if len(details) > 0 {
+			record.Id = found.Id
+			p.add(Update, "dns record "+describeRecord(record), details, func() error {
+				_, err := service.Id(*record.Id).EditObject(&record)
+				return err
+			})
+		}
+	}
+
+	for _, record := range existing {
+		if seen[recordKey(record)] || unmanagedRecordTypes[strings.ToLower(sl.Get(record.Type, "").(string))] {
+			continue
+		}
+
+		id := sl.Get(record.Id).(int)
+		p.add(Delete, "dns record "+describeRecord(record), nil, func() error {
+			_, err := service.Id(id).DeleteObject()
+			return err
+		})
+	}
+
+	return p, nil
+}
+
+func recordKey(record datatypes.Dns_Domain_ResourceRecord) string {
+	return strings.Join([]string{
+		strings.ToLower(sl.Get(record.Type, "").(string)),
+		strings.ToLower(sl.Get(record.Host, "").(string)),
+		sl.Get(record.Data, "").(string),
+	}, "|")
+}
+
+func describeRecord(record datatypes.Dns_Domain_ResourceRecord) string {
+	return fmt.Sprintf("%s %s %s",
+		sl.Get(record.Host, ""), strings.ToUpper(sl.Get(record.Type, "").(string)), sl.Get(record.Data, ""))
+}
@@ -0,0 +1,112 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package plan computes the changes needed to bring managed resources (DNS
+// records, security group rules, tags and users) to a desired state.  A Plan
+// can be printed for review without touching the account, and then applied,
+// which makes it safe to run the helpers from CI pipelines.  The existing state
+// is always read in full, whatever the default result limit of the session.
+package plan
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Action is the kind of change made to a resource
+type Action string
+
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+var symbols = map[Action]string{
+	Create: "+",
+	Update: "~",
+	Delete: "-",
+}
+
+// Change is a single intended change to a resource
+type Change struct {
+	Action   Action
+	Resource string
+	Details  []string
+
+	apply func() error
+}
+
+// Plan is an ordered list of intended changes
+type Plan struct {
+	Changes []Change
+}
+
+func (p *Plan) add(action Action, resource string, details []string, apply func() error) {
+	p.Changes = append(p.Changes, Change{
+		Action:   action,
+		Resource: resource,
+		Details:  details,
+		apply:    apply,
+	})
+}
+
+// Empty returns true if the resources are already in the desired state
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Summary returns a one line count of the changes in the plan
+func (p *Plan) Summary() string {
+	counts := map[Action]int{}
+	for _, change := range p.Changes {
+		counts[change.Action]++
+	}
+
+	return fmt.Sprintf("%d to create, %d to update, %d to delete",
+		counts[Create], counts[Update], counts[Delete])
+}
+
+// Write prints the intended changes, without applying them
+func (p *Plan) Write(w io.Writer) error {
+	lines := []string{}
+	for _, change := range p.Changes {
+		lines = append(lines, fmt.Sprintf("%s %s %s", symbols[change.Action], change.Action, change.Resource))
+		for _, detail := range change.Details {
+			lines = append(lines, "    "+detail)
+		}
+	}
+	lines = append(lines, "Plan: "+p.Summary())
+
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+// Apply executes the changes in order, stopping at the first failure.  Applied
+// changes are removed from the plan, so a failed Apply can be retried.
+func (p *Plan) Apply() error {
+	for len(p.Changes) > 0 {
+		change := p.Changes[0]
+		if err := change.apply(); err != nil {
+			return fmt.Errorf("Error applying %s of %s: %s", change.Action, change.Resource, err)
+		}
+
+		p.Changes = p.Changes[1:]
+	}
+
+	return nil
+}
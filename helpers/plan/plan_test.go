@@ -0,0 +1,95 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session/mock"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestDNSRecordsReadsAllRecords(t *testing.T) {
+	records := []datatypes.Dns_Domain_ResourceRecord{
+		{Id: sl.Int(1), Type: sl.String("a"), Host: sl.String("web1"), Data: sl.String("10.0.0.1")},
+		{Id: sl.Int(2), Type: sl.String("a"), Host: sl.String("web2"), Data: sl.String("10.0.0.2")},
+	}
+
+	handler := mock.New()
+	handler.On("SoftLayer_Dns_Domain", "getResourceRecords").Stub(func(call mock.Call) (interface{}, error) {
+		if call.Options.Limit != nil {
+			return records[:*call.Options.Limit], nil
+		}
+		return records, nil
+	})
+
+	p, err := DNSRecords(handler.Session().SetDefaultLimit(1), 1, records)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !p.Empty() {
+		t.Errorf("Expected no changes, got %+v", p.Changes)
+	}
+}
+
+func TestUsers(t *testing.T) {
+	handler := mock.New()
+	handler.On("SoftLayer_Account", "getUsers").Returns([]datatypes.User_Customer{
+		{Id: sl.Int(1), Username: sl.String("master")},
+		{Id: sl.Int(2), Username: sl.String("ops"), ParentId: sl.Int(1), Email: sl.String("old@example.com"),
+			Permissions: []datatypes.User_Customer_CustomerPermission_Permission{{KeyName: sl.String("TICKET_VIEW")}}},
+		{Id: sl.Int(3), Username: sl.String("former"), ParentId: sl.Int(1)},
+	})
+	handler.On("SoftLayer_User_Customer", "createObject").Returns(datatypes.User_Customer{Id: sl.Int(4)})
+	handler.On("SoftLayer_User_Customer", "editObject").Returns(true)
+	handler.On("SoftLayer_User_Customer", "addBulkPortalPermission").Returns(true)
+	handler.On("SoftLayer_User_Customer", "removeBulkPortalPermission").Returns(true)
+
+	desired := []datatypes.User_Customer{
+		{Username: sl.String("ops"), Email: sl.String("ops@example.com"),
+			Permissions: []datatypes.User_Customer_CustomerPermission_Permission{{KeyName: sl.String("SERVER_ADD")}}},
+		{Username: sl.String("new"), Email: sl.String("new@example.com"),
+			Permissions: []datatypes.User_Customer_CustomerPermission_Permission{{KeyName: sl.String("TICKET_VIEW")}}},
+	}
+
+	p, err := Users(handler.Session(), desired, []string{"former", "master"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Summary() != "1 to create, 1 to update, 1 to delete" {
+		t.Fatalf("Unexpected plan: %s", p.Summary())
+	}
+	if handler.CallCount("SoftLayer_User_Customer", "") != 0 {
+		t.Fatalf("Expected no change before Apply")
+	}
+
+	if err = p.Apply(); err != nil {
+		t.Fatal(err)
+	}
+
+	edits := handler.Calls("SoftLayer_User_Customer", "editObject")
+	if len(edits) != 2 || handler.CallCount("SoftLayer_User_Customer", "addBulkPortalPermission") != 2 ||
+		handler.CallCount("SoftLayer_User_Customer", "removeBulkPortalPermission") != 1 {
+		t.Errorf("Unexpected calls: %+v", handler.Calls("SoftLayer_User_Customer", ""))
+	}
+	if len(edits) == 2 && sl.Get(edits[1].Options.Id) != 3 {
+		t.Errorf("Expected the former user to be cancelled, got %+v", edits[1])
+	}
+}
@@ -0,0 +1,96 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// SecurityGroupRules plans the changes to the rules of the security group
+// needed to match desired.  Rules are identical when their direction, ether
+// type, protocol, port range and remote match; rules are added and removed,
+// never edited in place.
+func SecurityGroupRules(sess *session.Session, groupId int, desired []datatypes.Network_SecurityGroup_Rule) (*Plan, error) {
+	service := services.GetNetworkSecurityGroupService(sess).Id(groupId)
+
+	existing, err := service.Unlimited().GetRules()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving rules of security group %d: %s", groupId, err)
+	}
+
+	current := map[string]bool{}
+	for _, rule := range existing {
+		current[describeRule(rule)] = true
+	}
+
+	p := &Plan{}
+	wanted := map[string]bool{}
+	for _, rule := range desired {
+		rule := rule
+		key := describeRule(rule)
+		if wanted[key] {
+			continue
+		}
+		wanted[key] = true
+
+		if !current[key] {
+			p.add(Create, fmt.Sprintf("security group %d rule %s", groupId, key), nil, func() error {
+				_, err := service.AddRules([]datatypes.Network_SecurityGroup_Rule{rule})
+				return err
+			})
+		}
+	}
+
+	for _, rule := range existing {
+		key := describeRule(rule)
+		if wanted[key] {
+			continue
+		}
+
+		id := sl.Get(rule.Id).(int)
+		p.add(Delete, fmt.Sprintf("security group %d rule %s", groupId, key), nil, func() error {
+			_, err := service.RemoveRules([]int{id})
+			return err
+		})
+	}
+
+	return p, nil
+}
+
+// describeRule returns a readable form of the rule that also serves to
+// compare rules
+func describeRule(rule datatypes.Network_SecurityGroup_Rule) string {
+	description := fmt.Sprintf("%s %s %s",
+		sl.Get(rule.Direction, ""), sl.Get(rule.Ethertype, "IPv4"), sl.Get(rule.Protocol, "all"))
+
+	if rule.PortRangeMin != nil || rule.PortRangeMax != nil {
+		description += fmt.Sprintf(" %d-%d", sl.Get(rule.PortRangeMin), sl.Get(rule.PortRangeMax))
+	}
+
+	if rule.RemoteIp != nil {
+		description += " remote " + *rule.RemoteIp
+	} else if rule.RemoteGroupId != nil {
+		description += fmt.Sprintf(" remote group %d", *rule.RemoteGroupId)
+	}
+
+	return description
+}
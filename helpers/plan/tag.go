@@ -0,0 +1,102 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package plan
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// TagMask is the object mask used by Tags
+const TagMask = "name,references[resourceTableId,tagType[keyName]]"
+
+// TagFilter returns the object filter used by Tags
+func TagFilter(keyName string, resourceId int) string {
+	return filter.Build(
+		filter.Path("tags.references.resourceTableId").Eq(resourceId),
+		filter.Path("tags.references.tagType.keyName").Eq(keyName),
+	)
+}
+
+// Tags plans the change to the tags of a resource needed to match desired.
+// keyName is the tag type of the resource (e.g., GUEST or HARDWARE; see
+// SoftLayer_Tag::getAllTagTypes) and resourceId its id.
+func Tags(sess *session.Session, keyName string, resourceId int, desired []string) (*Plan, error) {
+	tags, err := services.GetAccountService(sess).
+		Mask(TagMask).
+		Filter(TagFilter(keyName, resourceId)).
+		Unlimited().
+		GetTags()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving tags of %s %d: %s", keyName, resourceId, err)
+	}
+
+	current := map[string]bool{}
+	for _, tag := range tags {
+		for _, ref := range tag.References {
+			if sl.Get(ref.ResourceTableId) == resourceId && ref.TagType != nil && sl.Get(ref.TagType.KeyName) == keyName {
+				current[sl.Get(tag.Name, "").(string)] = true
+			}
+		}
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range desired {
+		wanted[strings.TrimSpace(name)] = true
+	}
+
+	details := []string{}
+	for _, name := range sortedNames(wanted) {
+		if !current[name] {
+			details = append(details, "+ "+name)
+		}
+	}
+	for _, name := range sortedNames(current) {
+		if !wanted[name] {
+			details = append(details, "- "+name)
+		}
+	}
+
+	p := &Plan{}
+	if len(details) > 0 {
+		tagList := strings.Join(sortedNames(wanted), ",")
+		p.add(Update, fmt.Sprintf("tags of %s %d", keyName, resourceId), details, func() error {
+			_, err := services.GetTagService(sess).SetTags(sl.String(tagList), sl.String(keyName), sl.Int(resourceId))
+			return err
+		})
+	}
+
+	return p, nil
+}
+
+func sortedNames(names map[string]bool) []string {
+	sorted := []string{}
+	for name := range names {
+		if name != "" {
+			sorted = append(sorted, name)
+		}
+	}
+	sort.Strings(sorted)
+
+	return sorted
+}
@@ -0,0 +1,188 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package plan
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// UserMask is the object mask used by Users
+const UserMask = "id,username,firstName,lastName,email,companyName,userStatusId,parentId,permissions[keyName]"
+
+// CancelPendingUserStatusId is the status (CANCEL_PENDING) users are removed
+// with, since they can not be deleted
+const CancelPendingUserStatusId = 1021
+
+// Users plans the changes to the users of the account needed to match desired.
+// Users are matched on username.  A matched user is updated when its first
+// name, last name, email or company name differs from a desired one that is
+// set, and its portal permissions are changed to match the key names of its
+// Permissions, if any.  New users are created from the desired users, which
+// must then hold the properties required by SoftLayer_User_Customer::createObject.
+//
+// Other users are left alone, except those whose username is in remove, which
+// are cancelled.  The master user of the account is never cancelled.
+func Users(sess *session.Session, desired []datatypes.User_Customer, remove []string) (*Plan, error) {
+	existing, err := services.GetAccountService(sess).
+		Mask(UserMask).
+		Unlimited().
+		GetUsers()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving users: %s", err)
+	}
+
+	current := map[string]datatypes.User_Customer{}
+	for _, user := range existing {
+		current[sl.Get(user.Username, "").(string)] = user
+	}
+
+	p := &Plan{}
+	service := services.GetUserCustomerService(sess)
+	for _, user := range desired {
+		user := user
+		username := sl.Get(user.Username, "").(string)
+		wanted := permissionNames(user.Permissions)
+		user.Permissions = nil
+
+		found, ok := current[username]
+		if !ok {
+			details := []string{}
+			for _, name := range sortedNames(wanted) {
+				details = append(details, "+ permission "+name)
+			}
+
+			p.add(Create, "user "+username, details, func() error {
+				created, err := service.CreateObject(&user, nil, nil)
+				if err != nil || len(wanted) == 0 {
+					return err
+				}
+
+				_, err = service.Id(sl.Get(created.Id).(int)).AddBulkPortalPermission(permissions(sortedNames(wanted)))
+				return err
+			})
+			continue
+		}
+
+		id := sl.Get(found.Id).(int)
+		edit := datatypes.User_Customer{}
+		details := []string{}
+		for _, field := range []struct {
+			name           string
+			desired, found *string
+			set            func(*string)
+		}{
+			{"firstName", user.FirstName, found.FirstName, func(v *string) { edit.FirstName = v }},
+			{"lastName", user.LastName, found.LastName, func(v *string) { edit.LastName = v }},
+			{"email", user.Email, found.Email, func(v *string) { edit.Email = v }},
+			{"companyName", user.CompanyName, found.CompanyName, func(v *string) { edit.CompanyName = v }},
+		} {
+			if field.desired != nil && sl.Get(field.found, "").(string) != *field.desired {
+				details = append(details, fmt.Sprintf("%s: %s => %s", field.name, sl.Get(field.found, ""), *field.desired))
+				field.set(field.desired)
+			}
+		}
+		edited := len(details) > 0
+
+		added, removed := []string{}, []string{}
+		if wanted != nil {
+			has := permissionNames(found.Permissions)
+			for _, name := range sortedNames(wanted) {
+				if !has[name] {
+					added = append(added, name)
+					details = append(details, "+ permission "+name)
+				}
+			}
+			for _, name := range sortedNames(has) {
+				if !wanted[name] {
+					removed = append(removed, name)
+					details = append(details, "- permission "+name)
+				}
+			}
+		}
+
+		if len(details) == 0 {
+			continue
+		}
+
+		p.add(Update, "user "+username, details, func() error {
+			user := service.Id(id)
+			if edited {
+				if _, err := user.EditObject(&edit); err != nil {
+					return err
+				}
+			}
+			if len(added) > 0 {
+				if _, err := user.AddBulkPortalPermission(permissions(added)); err != nil {
+					return err
+				}
+			}
+			if len(removed) > 0 {
+				if _, err := user.RemoveBulkPortalPermission(permissions(removed), sl.Bool(false)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+	sort.Strings(remove)
+	for _, username := range remove {
+		found, ok := current[username]
+		if !ok || found.ParentId == nil || sl.Get(found.UserStatusId) == CancelPendingUserStatusId {
+			continue
+		}
+
+		id := sl.Get(found.Id).(int)
+		p.add(Delete, "user "+username, nil, func() error {
+			_, err := service.Id(id).EditObject(&datatypes.User_Customer{UserStatusId: sl.Int(CancelPendingUserStatusId)})
+			return err
+		})
+	}
+
+	return p, nil
+}
+
+// permissionNames returns the key names of permissions, or nil if there are
+// none
+func permissionNames(permissions []datatypes.User_Customer_CustomerPermission_Permission) map[string]bool {
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	names := map[string]bool{}
+	for _, permission := range permissions {
+		names[strings.TrimSpace(sl.Get(permission.KeyName, "").(string))] = true
+	}
+
+	return names
+}
+
+func permissions(names []string) []datatypes.User_Customer_CustomerPermission_Permission {
+	list := []datatypes.User_Customer_CustomerPermission_Permission{}
+	for _, name := range names {
+		list = append(list, datatypes.User_Customer_CustomerPermission_Permission{KeyName: sl.String(name)})
+	}
+
+	return list
+}
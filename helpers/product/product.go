@@ -43,6 +43,42 @@ const DedicatedLoadBalancerCategoryCode = "dedicated_load_balancer"
 // ProxyLoadBalancerCategoryCode Category code for Shared local load balancer (proxy load balancer)
 const ProxyLoadBalancerCategoryCode = "proxy_load_balancer"
 
+// Default object masks of the package, product and preset lookups
+const (
+	PackageTypeMask     = "id,name,description,isActive,type[keyName]"
+	PackageKeyNameMask  = "id,name,description,isActive,keyName"
+	PackageProductsMask = "id,capacity,description,units,keyName,prices[id,categories[id,name,categoryCode]]"
+	PresetMask          = "id, name, keyName, description"
+	AddonPriceMask      = "description,prices.locationGroupId,prices.id"
+)
+
+// PackageTypeFilter returns the object filter used by GetPackageByType
+func PackageTypeFilter(packageType string) string {
+	return filter.Build(
+		filter.Path("type.keyName").Eq(packageType),
+	)
+}
+
+// PackageKeyNameFilter returns the object filter used by GetPackageByKeyName
+func PackageKeyNameFilter(packageKeyName string) string {
+	return filter.Build(
+		filter.Path("keyName").Eq(packageKeyName),
+	)
+}
+
+// PresetFilter returns the object filter used by GetPresetByKeyName
+func PresetFilter(presetKeyName string) string {
+	return filter.Build(
+		filter.Path("activePresets.keyName").Eq(presetKeyName),
+	)
+}
+
+// AddonPriceFilter returns the object filter used by
+// GetPriceIDByPackageIdandLocationGroups
+func AddonPriceFilter(addon string) string {
+	return strings.Replace(`{"items":{"description":{"operation":"appliance"}}}`, "appliance", addon, -1)
+}
+
 // GetPackageByType Get the Product_Package which matches the specified
 // package type
 func GetPackageByType(
@@ -51,7 +87,7 @@ func GetPackageByType(
 	mask ...string,
 ) (datatypes.Product_Package, error) {
 
-	objectMask := "id,name,description,isActive,type[keyName]"
+	objectMask := PackageTypeMask
 	if len(mask) > 0 {
 		objectMask = mask[0]
 	}
@@ -61,11 +97,7 @@ func GetPackageByType(
 	// Get package id
 	packages, err := service.
 		Mask(objectMask).
-		Filter(
-			filter.Build(
-				filter.Path("type.keyName").Eq(packageType),
-			),
-		).
+		Filter(PackageTypeFilter(packageType)).
 		Limit(1).
 		GetAllObjects()
 	if err != nil {
@@ -105,7 +137,7 @@ func GetPackageProducts(
 	mask ...string,
 ) ([]datatypes.Product_Item, error) {
 
-	objectMask := "id,capacity,description,units,keyName,prices[id,categories[id,name,categoryCode]]"
+	objectMask := PackageProductsMask
 	if len(mask) > 0 {
 		objectMask = mask[0]
 	}
@@ -221,7 +253,7 @@ func GetPresetByKeyName(
 	mask ...string,
 ) (datatypes.Product_Package_Preset, error) {
 
-	objectMask := "id, name, keyName, description"
+	objectMask := PresetMask
 	if len(mask) > 0 {
 		objectMask = mask[0]
 	}
@@ -232,11 +264,7 @@ func GetPresetByKeyName(
 	preset, err := service.
 		Id(pkgID).
 		Mask(objectMask).
-		Filter(
-			filter.Build(
-				filter.Path("activePresets.keyName").Eq(presetKeyName),
-			),
-		).
+		Filter(PresetFilter(presetKeyName)).
 		Limit(1).
 		GetActivePresets()
 	if err != nil {
@@ -258,7 +286,7 @@ func GetPackageByKeyName(
 	mask ...string,
 ) (datatypes.Product_Package, error) {
 
-	objectMask := "id,name,description,isActive,keyName"
+	objectMask := PackageKeyNameMask
 	if len(mask) > 0 {
 		objectMask = mask[0]
 	}
@@ -268,11 +296,7 @@ func GetPackageByKeyName(
 	// Get package id
 	packages, err := service.
 		Mask(objectMask).
-		Filter(
-			filter.Build(
-				filter.Path("keyName").Eq(packageKeyName),
-			),
-		).
+		Filter(PackageKeyNameFilter(packageKeyName)).
 		Limit(1).
 		GetAllObjects()
 	if err != nil {
@@ -292,9 +316,7 @@ func GetPackageByKeyName(
 // addon is the description of the item for which you want to get its priceId
 func GetPriceIDByPackageIdandLocationGroups(sess *session.Session, locationGroupIds []int, packageid int, addon string) (int, error) {
 	productpackageservice := services.GetProductPackageService(sess)
-	productpackageservicefilter := strings.Replace(`{"items":{"description":{"operation":"appliance"}}}`, "appliance", addon, -1)
-	productpackageservicemask := "description,prices.locationGroupId,prices.id"
-	resp, err := productpackageservice.Mask(productpackageservicemask).Filter(productpackageservicefilter).Id(packageid).GetItems()
+	resp, err := productpackageservice.Mask(AddonPriceMask).Filter(AddonPriceFilter(addon)).Id(packageid).GetItems()
 	if err != nil {
 		return 0, err
 	}
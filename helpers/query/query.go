@@ -0,0 +1,182 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package query keeps a registry of named queries (e.g., "expiring-certs" or
+// "idle-guests"), each a call to a service method with its mask, filter and
+// other options, so that applications and reporting jobs can run them by name,
+// and load them from configuration files.
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Query is a named call to a service method
+type Query struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	// Service and Method are the API names of the method called, e.g.
+	// "SoftLayer_Account" and "getVirtualGuests"
+	Service string `json:"service"`
+	Method  string `json:"method"`
+
+	// Args are the parameters of the method, if any
+	Args []interface{} `json:"args,omitempty"`
+
+	// Options are the mask, filter, limit, ... of the call (see sl.Options
+	// for their JSON representation)
+	Options sl.Options `json:"options"`
+}
+
+// Registry holds named queries. It is safe for concurrent use.
+type Registry struct {
+	mu      sync.RWMutex
+	queries map[string]Query
+}
+
+// DefaultRegistry is the registry used by the package-level functions
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry returns an empty registry
+func NewRegistry() *Registry {
+	return &Registry{queries: map[string]Query{}}
+}
+
+// Validate checks that the query has a name, a service and a method, and that
+// its options are valid
+func (q Query) Validate() error {
+	if q.Name == "" {
+		return fmt.Errorf("Query has no name")
+	}
+
+	if q.Service == "" || q.Method == "" {
+		return fmt.Errorf("Query %s has no service or method", q.Name)
+	}
+
+	if err := q.Options.Validate(); err != nil {
+		return fmt.Errorf("Query %s: %s", q.Name, err)
+	}
+
+	return nil
+}
+
+// Register adds queries to the registry, replacing those of the same name
+func (r *Registry) Register(queries ...Query) error {
+	for _, q := range queries {
+		if err := q.Validate(); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, q := range queries {
+		r.queries[q.Name] = q
+	}
+
+	return nil
+}
+
+// Get returns the named query
+func (r *Registry) Get(name string) (Query, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	q, ok := r.queries[name]
+	return q, ok
+}
+
+// Names returns the names of the queries, sorted
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.queries))
+	for name := range r.queries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Load registers the queries of a JSON array, e.g. read from a configuration
+// file
+func (r *Registry) Load(reader io.Reader) error {
+	var queries []Query
+	if err := json.NewDecoder(reader).Decode(&queries); err != nil {
+		return fmt.Errorf("Error reading queries: %s", err)
+	}
+
+	return r.Register(queries...)
+}
+
+// Save writes the queries as a JSON array, in the order of their names
+func (r *Registry) Save(writer io.Writer) error {
+	queries := []Query{}
+	for _, name := range r.Names() {
+		if q, ok := r.Get(name); ok {
+			queries = append(queries, q)
+		}
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(queries)
+}
+
+// ExecuteInto runs the named query, decoding its result into pResult (e.g., a
+// *[]datatypes.Virtual_Guest)
+func (r *Registry) ExecuteInto(sess *session.Session, name string, pResult interface{}) error {
+	q, ok := r.Get(name)
+	if !ok {
+		return fmt.Errorf("No query named %s", name)
+	}
+
+	options := q.Options
+	if err := sess.DoRequest(q.Service, q.Method, q.Args, &options, pResult); err != nil {
+		return fmt.Errorf("Error running query %s: %s", name, err)
+	}
+
+	return nil
+}
+
+// Execute runs the named query, and returns its result as generic JSON values
+// (maps, slices, strings, float64s, ...), e.g. to be printed or exported
+func (r *Registry) Execute(sess *session.Session, name string) (interface{}, error) {
+	var result interface{}
+	err := r.ExecuteInto(sess, name, &result)
+	return result, err
+}
+
+// Register adds queries to the DefaultRegistry
+func Register(queries ...Query) error {
+	return DefaultRegistry.Register(queries...)
+}
+
+// Execute runs the named query of the DefaultRegistry
+func Execute(sess *session.Session, name string) (interface{}, error) {
+	return DefaultRegistry.Execute(sess, name)
+}
@@ -0,0 +1,187 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package quota
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Resource names used as keys in a Report
+const (
+	VirtualGuests          = "virtual_guests"
+	Hardware               = "hardware"
+	DedicatedHosts         = "dedicated_hosts"
+	PlacementGroups        = "placement_groups"
+	Vlans                  = "vlans"
+	Subnets                = "subnets"
+	GlobalIps              = "global_ips"
+	SecurityGroups         = "security_groups"
+	NetworkStorage         = "network_storage"
+	PortableStorageVolumes = "portable_storage_volumes"
+	ImageTemplates         = "image_templates"
+	SshKeys                = "ssh_keys"
+	Users                  = "users"
+)
+
+// usageProperties maps each resource to the account count property holding its
+// current usage
+var usageProperties = []struct {
+	resource string
+	property string
+}{
+	{VirtualGuests, "virtualGuestCount"},
+	{Hardware, "hardwareCount"},
+	{DedicatedHosts, "dedicatedHostCount"},
+	{PlacementGroups, "placementGroupCount"},
+	{Vlans, "networkVlanCount"},
+	{Subnets, "subnetCount"},
+	{GlobalIps, "globalIpRecordCount"},
+	{SecurityGroups, "securityGroupCount"},
+	{NetworkStorage, "networkStorageCount"},
+	{PortableStorageVolumes, "portableStorageVolumeCount"},
+	{ImageTemplates, "blockDeviceTemplateGroupCount"},
+	{SshKeys, "sshKeyCount"},
+	{Users, "userCount"},
+}
+
+// Unlimited is the Limit of a Usage whose limit is not known
+const Unlimited = -1
+
+// Usage is the current usage of an account resource, and its limit where known
+type Usage struct {
+	Resource string
+	Used     int
+
+	// Limit is the maximum number of the resource allowed on the account, or
+	// Unlimited if not known
+	Limit int
+}
+
+// Remaining returns the number of the resource that can still be provisioned,
+// or Unlimited if the limit is not known
+func (u Usage) Remaining() int {
+	if u.Limit == Unlimited {
+		return Unlimited
+	}
+
+	if u.Used >= u.Limit {
+		return 0
+	}
+
+	return u.Limit - u.Used
+}
+
+// Allows returns true if count more of the resource can be provisioned
+func (u Usage) Allows(count int) bool {
+	return u.Limit == Unlimited || u.Used+count <= u.Limit
+}
+
+// Report holds the usage of the account resources, keyed by resource name
+type Report struct {
+	Usage map[string]Usage
+
+	// APILimits holds the raw limits reported by the API that are not
+	// associated to a resource usage (e.g., security group rule limits),
+	// keyed by their type key
+	APILimits map[string]int
+}
+
+// Check returns an error describing every resource for which the requested
+// count cannot be provisioned without exceeding the account limit.  Resources
+// absent from the report are not checked.
+func (r Report) Check(request map[string]int) error {
+	exceeded := []string{}
+	for resource, count := range request {
+		usage, ok := r.Usage[resource]
+		if !ok || usage.Allows(count) {
+			continue
+		}
+
+		exceeded = append(exceeded, fmt.Sprintf(
+			"%s (requested %d, used %d of %d)", resource, count, usage.Used, usage.Limit))
+	}
+
+	if len(exceeded) == 0 {
+		return nil
+	}
+
+	sort.Strings(exceeded)
+	return fmt.Errorf("Account limits would be exceeded: %s", strings.Join(exceeded, ", "))
+}
+
+// GetReport collects the current usage of the account resources, along with
+// the limits exposed by the API.  Since most limits are set per contract and
+// are not exposed by the API, known limits can also be provided (keyed by
+// resource name), which take precedence over those reported by the API.
+func GetReport(sess *session.Session, limits ...map[string]int) (Report, error) {
+	report := Report{Usage: map[string]Usage{}, APILimits: map[string]int{}}
+
+	properties := []string{"allowedPptpVpnQuantity"}
+	for _, usage := range usageProperties {
+		properties = append(properties, usage.property)
+	}
+
+	account, err := services.GetAccountService(sess).
+		Mask(strings.Join(properties, ";")).
+		GetObject()
+	if err != nil {
+		return report, err
+	}
+
+	for _, usage := range usageProperties {
+		fieldName := strings.ToUpper(usage.property[0:1]) + usage.property[1:]
+		report.Usage[usage.resource] = Usage{
+			Resource: usage.resource,
+			Used:     int(sl.Grab(account, fieldName).(uint)),
+			Limit:    Unlimited,
+		}
+	}
+
+	if account.AllowedPptpVpnQuantity != nil {
+		report.APILimits["ALLOWED_PPTP_VPN_QUANTITY"] = *account.AllowedPptpVpnQuantity
+	}
+
+	sgLimits, err := services.GetNetworkSecurityGroupService(sess).GetLimits()
+	if err != nil {
+		return report, err
+	}
+
+	for _, limit := range sgLimits {
+		if limit.TypeKey != nil && limit.Value != nil {
+			report.APILimits[*limit.TypeKey] = *limit.Value
+		}
+	}
+
+	for _, known := range limits {
+		for resource, limit := range known {
+			usage, ok := report.Usage[resource]
+			if !ok {
+				usage = Usage{Resource: resource}
+			}
+			usage.Limit = limit
+			report.Usage[resource] = usage
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,284 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package saga runs multi-call workflows (e.g., ordering a guest, then adding
+// its DNS record and tags) as a sequence of steps, each with a compensation
+// that undoes it.  When a step fails, the completed steps are compensated in
+// reverse order, so a failed workflow does not leave orphaned billable
+// resources behind.
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// Step is a single call (or group of calls) of a workflow
+type Step struct {
+	Name string
+
+	// Do performs the step. Values needed by later steps or by compensations
+	// (e.g., the id of a created object) are recorded with Saga.Set.
+	Do func(ctx context.Context, s *Saga) error
+
+	// Compensate undoes the step after a later step failed. Optional.
+	Compensate func(s *Saga) error
+}
+
+// Saga is a workflow of steps. Its progress can be recorded in a journal file,
+// so a workflow interrupted by a crash can be resumed or compensated later.
+type Saga struct {
+	Steps []Step
+
+	// Journal, when set, is the file recording the completed steps and the
+	// values they set. It is loaded by Run and Compensate if it exists.
+	Journal string
+
+	state journal
+}
+
+type journal struct {
+	Completed []string                   `json:"completed"`
+	Failed    string                     `json:"failed,omitempty"`
+	Stopped   bool                       `json:"stopped"`
+	Values    map[string]json.RawMessage `json:"values"`
+}
+
+// Error is returned by Run when a step fails. Compensated lists the steps that
+// were undone, and CompensationErrors the compensations that failed, whose
+// resources may need to be cleaned up by hand.
+type Error struct {
+	Step               string
+	Err                error
+	Compensated        []string
+	CompensationErrors map[string]error
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("Step %s failed: %s", e.Step, e.Err)
+	if len(e.CompensationErrors) == 0 {
+		return msg
+	}
+
+	failures := []string{}
+	for _, step := range e.Compensated {
+		if err, ok := e.CompensationErrors[step]; ok {
+			failures = append(failures, fmt.Sprintf("%s: %s", step, err))
+		}
+	}
+
+	return msg + "; compensation failed for " + strings.Join(failures, ", ")
+}
+
+// Unwrap returns the error of the failed step
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// New returns a saga running steps in order
+func New(steps ...Step) *Saga {
+	return &Saga{Steps: steps}
+}
+
+// Set records a value (e.g., the id of a created object) for later steps and
+// compensations. Values must be JSON encodable.
+func (s *Saga) Set(key string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("Error recording saga value %s: %s", key, err)
+	}
+
+	if s.state.Values == nil {
+		s.state.Values = map[string]json.RawMessage{}
+	}
+	s.state.Values[key] = data
+	return s.save()
+}
+
+// Get decodes the value recorded for key into value
+func (s *Saga) Get(key string, value interface{}) error {
+	data, ok := s.state.Values[key]
+	if !ok {
+		return fmt.Errorf("No saga value recorded for %s", key)
+	}
+
+	return json.Unmarshal(data, value)
+}
+
+// Int returns the integer value recorded for key (e.g., an object id)
+func (s *Saga) Int(key string) (int, error) {
+	var value int
+	err := s.Get(key, &value)
+	return value, err
+}
+
+// Completed returns the names of the steps completed so far
+func (s *Saga) Completed() []string {
+	return append([]string{}, s.state.Completed...)
+}
+
+// Run performs the steps not completed yet, in order. If one fails, the
+// completed steps are compensated, and the error is an *Error. A failed saga
+// cannot be run again; its remaining compensations can be retried with
+// Compensate.
+func (s *Saga) Run(ctx context.Context) error {
+	if err := s.load(); err != nil {
+		return err
+	}
+
+	if s.state.Stopped {
+		return fmt.Errorf("Saga was stopped (failed step: %q), and can only be compensated", s.state.Failed)
+	}
+
+	for _, step := range s.Steps[len(s.state.Completed):] {
+		err := ctx.Err()
+		if err == nil {
+			err = step.Do(ctx, s)
+		}
+
+		if err != nil {
+			s.state.Failed = step.Name
+			sagaErr := &Error{Step: step.Name, Err: err}
+			sagaErr.Compensated, sagaErr.CompensationErrors = s.compensate()
+			return sagaErr
+		}
+
+		s.state.Completed = append(s.state.Completed, step.Name)
+		if err = s.save(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Compensate undoes the completed steps in reverse order (e.g., to roll back
+// a workflow interrupted by a crash, from its journal). Steps whose
+// compensation fails remain completed, so it can be retried.
+func (s *Saga) Compensate() error {
+	if err := s.load(); err != nil {
+		return err
+	}
+
+	compensated, errs := s.compensate()
+	if len(errs) == 0 {
+		return nil
+	}
+
+	failures := []string{}
+	for _, step := range compensated {
+		if err, ok := errs[step]; ok {
+			failures = append(failures, fmt.Sprintf("%s: %s", step, err))
+		}
+	}
+
+	return fmt.Errorf("Error compensating saga steps %s", strings.Join(failures, ", "))
+}
+
+// compensate undoes the completed steps in reverse order, returning the steps
+// attempted and the errors of those that failed
+func (s *Saga) compensate() ([]string, map[string]error) {
+	steps := map[string]Step{}
+	for _, step := range s.Steps {
+		steps[step.Name] = step
+	}
+
+	attempted := []string{}
+	errs := map[string]error{}
+	remaining := []string{}
+
+	for i := len(s.state.Completed) - 1; i >= 0; i-- {
+		step := steps[s.state.Completed[i]]
+		attempted = append(attempted, step.Name)
+		if step.Compensate == nil {
+			continue
+		}
+
+		if err := step.Compensate(s); err != nil {
+			errs[step.Name] = err
+			remaining = append([]string{step.Name}, remaining...)
+		}
+	}
+
+	// A compensated saga cannot be resumed
+	s.state.Completed = remaining
+	s.state.Stopped = true
+	if err := s.save(); err != nil {
+		errs["journal"] = err
+	}
+
+	return attempted, errs
+}
+
+func (s *Saga) load() error {
+	if s.Journal == "" || s.state.Completed != nil {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(s.Journal)
+	if os.IsNotExist(err) {
+		s.state.Completed = []string{}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = json.Unmarshal(data, &s.state); err != nil {
+		return fmt.Errorf("Error reading saga journal %s: %s", s.Journal, err)
+	}
+
+	names := map[string]bool{}
+	for _, step := range s.Steps {
+		names[step.Name] = true
+	}
+
+	// Steps complete in order, but compensations may fail in any order
+	for i, name := range s.state.Completed {
+		if !names[name] || (!s.state.Stopped && (i >= len(s.Steps) || s.Steps[i].Name != name)) {
+			return fmt.Errorf("Saga journal %s does not match the steps (found %s)", s.Journal, name)
+		}
+	}
+
+	return nil
+}
+
+func (s *Saga) save() error {
+	if s.Journal == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(&s.state)
+	if err != nil {
+		return err
+	}
+
+	// Replace the file atomically, so a crash never leaves a partial journal
+	tmp := s.Journal + ".tmp"
+	if err = ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("Error saving saga journal %s: %s", s.Journal, err)
+	}
+
+	if err = os.Rename(tmp, s.Journal); err != nil {
+		return fmt.Errorf("Error saving saga journal %s: %s", s.Journal, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package saga
+
+import (
+	"context"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// CreateVirtualGuest is a step ordering a virtual guest from template, and
+// recording its id under key. It is compensated by cancelling the guest
+// immediately.
+func CreateVirtualGuest(sess *session.Session, key string, template datatypes.Virtual_Guest) Step {
+	return Step{
+		Name: "create virtual guest " + key,
+		Do: func(ctx context.Context, s *Saga) error {
+			guest, err := services.GetVirtualGuestService(sess).CreateObject(&template)
+			if err != nil {
+				return err
+			}
+
+			return s.Set(key, sl.Get(guest.Id))
+		},
+		Compensate: func(s *Saga) error {
+			guestId, err := s.Int(key)
+			if err != nil {
+				return err
+			}
+
+			_, err = services.GetVirtualGuestService(sess).Id(guestId).DeleteObject()
+			return err
+		},
+	}
+}
+
+// CreateDnsRecord is a step creating the resource record returned by record,
+// which can use the values of earlier steps (e.g., the address of a guest),
+// and recording its id under key. It is compensated by deleting the record.
+func CreateDnsRecord(
+	sess *session.Session, key string,
+	record func(s *Saga) (datatypes.Dns_Domain_ResourceRecord, error)) Step {
+
+	return Step{
+		Name: "create DNS record " + key,
+		Do: func(ctx context.Context, s *Saga) error {
+			template, err := record(s)
+			if err != nil {
+				return err
+			}
+
+			created, err := services.GetDnsDomainResourceRecordService(sess).CreateObject(&template)
+			if err != nil {
+				return err
+			}
+
+			return s.Set(key, sl.Get(created.Id))
+		},
+		Compensate: func(s *Saga) error {
+			recordId, err := s.Int(key)
+			if err != nil {
+				return err
+			}
+
+			_, err = services.GetDnsDomainResourceRecordService(sess).Id(recordId).DeleteObject()
+			return err
+		},
+	}
+}
+
+// TagVirtualGuest is a step setting the tags of the guest whose id was
+// recorded under guestKey. It is compensated by restoring the previous tags.
+func TagVirtualGuest(sess *session.Session, guestKey string, tags ...string) Step {
+	previousKey := guestKey + " tags"
+
+	return Step{
+		Name: "tag virtual guest " + guestKey,
+		Do: func(ctx context.Context, s *Saga) error {
+			guestId, err := s.Int(guestKey)
+			if err != nil {
+				return err
+			}
+
+			service := services.GetVirtualGuestService(sess).Id(guestId)
+			references, err := service.Mask("tag[name]").GetTagReferences()
+			if err != nil {
+				return err
+			}
+
+			previous := []string{}
+			for _, reference := range references {
+				if reference.Tag != nil && reference.Tag.Name != nil {
+					previous = append(previous, *reference.Tag.Name)
+				}
+			}
+
+			if err = s.Set(previousKey, strings.Join(previous, ",")); err != nil {
+				return err
+			}
+
+			_, err = service.SetTags(sl.String(strings.Join(tags, ",")))
+			return err
+		},
+		Compensate: func(s *Saga) error {
+			guestId, err := s.Int(guestKey)
+			if err != nil {
+				return err
+			}
+
+			var previous string
+			if err = s.Get(previousKey, &previous); err != nil {
+				return err
+			}
+
+			_, err = services.GetVirtualGuestService(sess).Id(guestId).SetTags(&previous)
+			return err
+		},
+	}
+}
@@ -0,0 +1,167 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package schedule provides typed builders for the cron-like schedules used by
+// storage snapshot schedules and autoscale repeating policy triggers.
+// Schedules are validated before they are sent to the API, and can be
+// converted to and from a human-readable form.
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Any matches every value of a cron field
+const Any = "*"
+
+// fieldBounds are the valid ranges of the cron fields, in order
+var fieldBounds = []struct {
+	name string
+	min  int
+	max  int
+}{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 6},
+}
+
+// Cron is a five field cron schedule (minute, hour, day of month, month and
+// day of week), evaluated in UTC.
+type Cron struct {
+	Minute     string
+	Hour       string
+	DayOfMonth string
+	Month      string
+	DayOfWeek  string
+}
+
+// Hourly returns a schedule that runs every hour at the given minute
+func Hourly(minute int) Cron {
+	return Cron{strconv.Itoa(minute), Any, Any, Any, Any}
+}
+
+// Daily returns a schedule that runs every day at the given time
+func Daily(hour int, minute int) Cron {
+	return Cron{strconv.Itoa(minute), strconv.Itoa(hour), Any, Any, Any}
+}
+
+// Weekly returns a schedule that runs every week on the given day and time
+func Weekly(day time.Weekday, hour int, minute int) Cron {
+	return Cron{strconv.Itoa(minute), strconv.Itoa(hour), Any, Any, strconv.Itoa(int(day))}
+}
+
+// ParseCron parses a five field cron expression
+func ParseCron(expression string) (Cron, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return Cron{}, fmt.Errorf("Invalid cron expression %q: expected 5 fields, got %d", expression, len(fields))
+	}
+
+	c := Cron{fields[0], fields[1], fields[2], fields[3], fields[4]}
+	return c, c.Validate()
+}
+
+func (c Cron) fields() []string {
+	return []string{c.Minute, c.Hour, c.DayOfMonth, c.Month, c.DayOfWeek}
+}
+
+// Validate checks that every field is well formed and within its range.
+// Fields may be *, a value, a range (a-b), a step (*/n or a-b/n), or a comma
+// separated list of those.
+func (c Cron) Validate() error {
+	for i, field := range c.fields() {
+		bounds := fieldBounds[i]
+		if field == "" {
+			return fmt.Errorf("Invalid schedule: the %s field is empty", bounds.name)
+		}
+
+		for _, part := range strings.Split(field, ",") {
+			if err := validatePart(part, bounds.min, bounds.max); err != nil {
+				return fmt.Errorf("Invalid schedule %s field %q: %s", bounds.name, field, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validatePart(part string, min int, max int) error {
+	if pieces := strings.SplitN(part, "/", 2); len(pieces) == 2 {
+		step, err := strconv.Atoi(pieces[1])
+		if err != nil || step < 1 || step > max-min+1 {
+			return fmt.Errorf("step must be between 1 and %d", max-min+1)
+		}
+		part = pieces[0]
+	}
+
+	if part == Any {
+		return nil
+	}
+
+	bounds := strings.SplitN(part, "-", 2)
+	values := make([]int, len(bounds))
+	for i, bound := range bounds {
+		value, err := strconv.Atoi(bound)
+		if err != nil || value < min || value > max {
+			return fmt.Errorf("values must be between %d and %d", min, max)
+		}
+		values[i] = value
+	}
+
+	if len(values) == 2 && values[0] > values[1] {
+		return fmt.Errorf("range %s is reversed", part)
+	}
+
+	return nil
+}
+
+// String returns the cron expression of the schedule
+func (c Cron) String() string {
+	return strings.Join(c.fields(), " ")
+}
+
+// Describe returns a human-readable description of the schedule, for the
+// hourly, daily and weekly forms; other schedules are described by their cron
+// expression.
+func (c Cron) Describe() string {
+	if s, err := SnapshotFromCron(c, 0); err == nil {
+		return s.String()
+	}
+
+	return "cron " + c.String()
+}
+
+// Repeating returns an autoscale repeating trigger running on the schedule
+func (c Cron) Repeating() (datatypes.Scale_Policy_Trigger_Repeating, error) {
+	if err := c.Validate(); err != nil {
+		return datatypes.Scale_Policy_Trigger_Repeating{}, err
+	}
+
+	return datatypes.Scale_Policy_Trigger_Repeating{Schedule: sl.String(c.String())}, nil
+}
+
+// FromRepeating returns the schedule of an autoscale repeating trigger
+func FromRepeating(trigger datatypes.Scale_Policy_Trigger_Repeating) (Cron, error) {
+	return ParseCron(sl.Get(trigger.Schedule, "").(string))
+}
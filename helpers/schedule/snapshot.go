@@ -0,0 +1,256 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Snapshot schedule types
+const (
+	SnapshotHourly = "HOURLY"
+	SnapshotDaily  = "DAILY"
+	SnapshotWeekly = "WEEKLY"
+)
+
+// Snapshot is a storage snapshot schedule, as accepted by
+// SoftLayer_Network_Storage::enableSnapshots.  Hourly schedules only use
+// Minute, and daily schedules Minute and Hour.
+type Snapshot struct {
+	Type           string
+	RetentionCount int
+	Minute         int
+	Hour           int
+	DayOfWeek      time.Weekday
+}
+
+// HourlySnapshots returns a schedule taking a snapshot every hour at the given
+// minute, keeping the last retention snapshots
+func HourlySnapshots(retention int, minute int) Snapshot {
+	return Snapshot{Type: SnapshotHourly, RetentionCount: retention, Minute: minute}
+}
+
+// DailySnapshots returns a schedule taking a snapshot every day at the given
+// time, keeping the last retention snapshots
+func DailySnapshots(retention int, hour int, minute int) Snapshot {
+	return Snapshot{Type: SnapshotDaily, RetentionCount: retention, Minute: minute, Hour: hour}
+}
+
+// WeeklySnapshots returns a schedule taking a snapshot every week on the given
+// day and time, keeping the last retention snapshots
+func WeeklySnapshots(retention int, day time.Weekday, hour int, minute int) Snapshot {
+	return Snapshot{Type: SnapshotWeekly, RetentionCount: retention, Minute: minute, Hour: hour, DayOfWeek: day}
+}
+
+// Validate checks the schedule type, retention count and time
+func (s Snapshot) Validate() error {
+	switch s.Type {
+	case SnapshotHourly, SnapshotDaily, SnapshotWeekly:
+	default:
+		return fmt.Errorf("Invalid snapshot schedule type %q", s.Type)
+	}
+
+	if s.RetentionCount < 1 {
+		return fmt.Errorf("Invalid snapshot retention count %d: at least one snapshot must be kept", s.RetentionCount)
+	}
+
+	if s.Minute < 0 || s.Minute > 59 {
+		return fmt.Errorf("Invalid snapshot minute %d: must be between 0 and 59", s.Minute)
+	}
+
+	if s.Hour < 0 || s.Hour > 23 {
+		return fmt.Errorf("Invalid snapshot hour %d: must be between 0 and 23", s.Hour)
+	}
+
+	if s.DayOfWeek < time.Sunday || s.DayOfWeek > time.Saturday {
+		return fmt.Errorf("Invalid snapshot day of week %d", s.DayOfWeek)
+	}
+
+	return nil
+}
+
+// Cron returns the schedule as a cron schedule
+func (s Snapshot) Cron() Cron {
+	switch s.Type {
+	case SnapshotHourly:
+		return Hourly(s.Minute)
+	case SnapshotDaily:
+		return Daily(s.Hour, s.Minute)
+	default:
+		return Weekly(s.DayOfWeek, s.Hour, s.Minute)
+	}
+}
+
+// SnapshotFromCron returns the snapshot schedule equivalent to c, which must be
+// of the hourly, daily or weekly form
+func SnapshotFromCron(c Cron, retention int) (Snapshot, error) {
+	values := make([]int, 5)
+	for i, field := range c.fields() {
+		value, err := strconv.Atoi(field)
+		if field == Any {
+			value = -1
+		} else if err != nil {
+			return Snapshot{}, fmt.Errorf("Schedule %q cannot be used for snapshots", c)
+		}
+		values[i] = value
+	}
+
+	minute, hour, dayOfMonth, month, dayOfWeek := values[0], values[1], values[2], values[3], values[4]
+
+	var s Snapshot
+	switch {
+	case minute == -1 || dayOfMonth != -1 || month != -1:
+		return Snapshot{}, fmt.Errorf("Schedule %q cannot be used for snapshots", c)
+	case hour == -1 && dayOfWeek == -1:
+		s = HourlySnapshots(retention, minute)
+	case hour != -1 && dayOfWeek == -1:
+		s = DailySnapshots(retention, hour, minute)
+	case hour != -1:
+		s = WeeklySnapshots(retention, time.Weekday(dayOfWeek), hour, minute)
+	default:
+		return Snapshot{}, fmt.Errorf("Schedule %q cannot be used for snapshots", c)
+	}
+
+	return s, c.Validate()
+}
+
+// String returns a human-readable form of the schedule, e.g. "every hour at
+// :15", "every day at 02:30" or "every Sunday at 04:00", which can be read back
+// with ParseSnapshot
+func (s Snapshot) String() string {
+	switch s.Type {
+	case SnapshotHourly:
+		return fmt.Sprintf("every hour at :%02d", s.Minute)
+	case SnapshotDaily:
+		return fmt.Sprintf("every day at %02d:%02d", s.Hour, s.Minute)
+	default:
+		return fmt.Sprintf("every %s at %02d:%02d", s.DayOfWeek, s.Hour, s.Minute)
+	}
+}
+
+// ParseSnapshot parses the human-readable form of a snapshot schedule, as
+// returned by Snapshot.String
+func ParseSnapshot(description string, retention int) (Snapshot, error) {
+	fields := strings.Fields(strings.ToLower(description))
+	if len(fields) != 4 || fields[0] != "every" || fields[2] != "at" {
+		return Snapshot{}, fmt.Errorf("Invalid snapshot schedule %q", description)
+	}
+
+	var s Snapshot
+	var err error
+	switch fields[1] {
+	case "hour":
+		s = HourlySnapshots(retention, 0)
+		_, err = fmt.Sscanf(fields[3], ":%d", &s.Minute)
+	case "day":
+		s = DailySnapshots(retention, 0, 0)
+		_, err = fmt.Sscanf(fields[3], "%d:%d", &s.Hour, &s.Minute)
+	default:
+		day, ok := weekdays[fields[1]]
+		if !ok {
+			return Snapshot{}, fmt.Errorf("Invalid snapshot schedule %q: unknown day %s", description, fields[1])
+		}
+		s = WeeklySnapshots(retention, day, 0, 0)
+		_, err = fmt.Sscanf(fields[3], "%d:%d", &s.Hour, &s.Minute)
+	}
+
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("Invalid snapshot schedule %q: %s", description, err)
+	}
+
+	return s, s.Validate()
+}
+
+var weekdays = map[string]time.Weekday{}
+
+func init() {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		weekdays[strings.ToLower(day.String())] = day
+	}
+}
+
+// FromStorageSchedule returns the snapshot schedule of a storage volume
+// schedule, as returned by SoftLayer_Network_Storage::getSchedules (with a
+// mask including type[keyname])
+func FromStorageSchedule(schedule datatypes.Network_Storage_Schedule) (Snapshot, error) {
+	s := Snapshot{}
+	if schedule.Type != nil {
+		s.Type = strings.TrimPrefix(sl.Get(schedule.Type.Keyname, "").(string), "SNAPSHOT_")
+	}
+
+	var err error
+	number := func(value *string) int {
+		n, convErr := strconv.Atoi(sl.Get(value, "0").(string))
+		if convErr != nil && err == nil {
+			err = convErr
+		}
+		return n
+	}
+
+	s.RetentionCount = number(schedule.RetentionCount)
+	s.Minute = number(schedule.Minute)
+	if s.Type != SnapshotHourly {
+		s.Hour = number(schedule.Hour)
+	}
+
+	if s.Type == SnapshotWeekly {
+		day, ok := weekdays[strings.ToLower(sl.Get(schedule.DayOfWeek, "").(string))]
+		if !ok {
+			day = time.Weekday(number(schedule.DayOfWeek))
+		}
+		s.DayOfWeek = day
+	}
+
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("Invalid storage schedule: %s", err)
+	}
+
+	return s, s.Validate()
+}
+
+// Enable enables the snapshot schedule on the storage volume
+func (s Snapshot) Enable(sess *session.Session, volumeId int) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	var hour *int
+	var dayOfWeek *string
+	if s.Type != SnapshotHourly {
+		hour = sl.Int(s.Hour)
+	}
+	if s.Type == SnapshotWeekly {
+		dayOfWeek = sl.String(strings.ToUpper(s.DayOfWeek.String()))
+	}
+
+	_, err := services.GetNetworkStorageService(sess).
+		Id(volumeId).
+		EnableSnapshots(sl.String(s.Type), sl.Int(s.RetentionCount), sl.Int(s.Minute), hour, dayOfWeek)
+	if err != nil {
+		return fmt.Errorf("Error enabling %s snapshots on volume %d: %s", strings.ToLower(s.Type), volumeId, err)
+	}
+
+	return nil
+}
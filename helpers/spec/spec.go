@@ -0,0 +1,232 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package spec reads declarative resource specs, such as guest templates, DNS
+// zone definitions and security group rules, from YAML (or JSON) files.
+//
+// Specs use the field names of the JSON representation of the datatypes
+// (e.g., startCpus or maxMemory), so no separate schema has to be maintained:
+//
+//	hostname: web1
+//	domain: example.com
+//	startCpus: 2
+//	maxMemory: 4096
+//	datacenter:
+//	  name: dal10
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"gopkg.in/yaml.v3"
+)
+
+// Zone is the definition of the resource records of a DNS zone
+type Zone struct {
+	Name    string                                `json:"name"`
+	Records []datatypes.Dns_Domain_ResourceRecord `json:"records"`
+}
+
+// SecurityGroup is the definition of a security group and its rules
+type SecurityGroup struct {
+	Name        string                                 `json:"name"`
+	Description string                                 `json:"description,omitempty"`
+	Rules       []datatypes.Network_SecurityGroup_Rule `json:"rules"`
+}
+
+// Unmarshal validates and decodes a YAML (or JSON, which is a subset of YAML)
+// spec into v.  Invalid specs return a ValidationError.
+func Unmarshal(data []byte, v interface{}) error {
+	return unmarshal("", data, v)
+}
+
+func unmarshal(file string, data []byte, v interface{}) error {
+	if err := validate(file, data, reflect.TypeOf(v)); err != nil {
+		return err
+	}
+
+	value, err := decode(data)
+	if err != nil {
+		return err
+	}
+
+	return convert(value, v)
+}
+
+// Load reads the YAML (or JSON) spec file at path into v
+func Load(path string, v interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return unmarshal(path, data, v)
+}
+
+// Marshal encodes v, typically a datatype or a spec, as YAML.  Nil fields are
+// omitted.
+func Marshal(v interface{}) ([]byte, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if err = json.Unmarshal(encoded, &value); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(value)
+}
+
+// LoadGuests reads virtual guest templates from the spec file at path, which
+// holds either a single template or a list of templates
+func LoadGuests(path string) ([]datatypes.Virtual_Guest, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+
+	guests := []datatypes.Virtual_Guest{}
+	if _, single := value.(map[string]interface{}); single {
+		guest := datatypes.Virtual_Guest{}
+		err = unmarshal(path, data, &guest)
+		guests = append(guests, guest)
+	} else {
+		err = unmarshal(path, data, &guests)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return guests, nil
+}
+
+// LoadZone reads a DNS zone definition from the spec file at path
+func LoadZone(path string) (Zone, error) {
+	zone := Zone{}
+	err := Load(path, &zone)
+
+	return zone, err
+}
+
+// LoadSecurityGroup reads a security group definition from the spec file at
+// path
+func LoadSecurityGroup(path string) (SecurityGroup, error) {
+	group := SecurityGroup{}
+	err := Load(path, &group)
+
+	return group, err
+}
+
+// decode parses YAML into the generic values produced by encoding/json
+func decode(data []byte) (interface{}, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("Error parsing spec: %s", err)
+	}
+
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+
+	return nodeValue(doc.Content[0])
+}
+
+func convert(value interface{}, v interface{}) error {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	if err = json.Unmarshal(encoded, v); err != nil {
+		return fmt.Errorf("Error decoding spec: %s", err)
+	}
+
+	return nil
+}
+
+func nodeValue(node *yaml.Node) (interface{}, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return nodeValue(node.Content[0])
+
+	case yaml.AliasNode:
+		return nodeValue(node.Alias)
+
+	case yaml.MappingNode:
+		value := map[string]interface{}{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			item, err := nodeValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			value[node.Content[i].Value] = item
+		}
+		return value, nil
+
+	case yaml.SequenceNode:
+		value := make([]interface{}, 0, len(node.Content))
+		for _, child := range node.Content {
+			item, err := nodeValue(child)
+			if err != nil {
+				return nil, err
+			}
+			value = append(value, item)
+		}
+		return value, nil
+	}
+
+	return scalarValue(node)
+}
+
+func scalarValue(node *yaml.Node) (interface{}, error) {
+	switch node.Tag {
+	case "!!null":
+		return nil, nil
+	case "!!bool":
+		return strconv.ParseBool(strings.ToLower(node.Value))
+	case "!!int":
+		n, err := strconv.ParseInt(strings.Replace(node.Value, "_", "", -1), 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid integer %q", node.Line, node.Value)
+		}
+		return n, nil
+	case "!!float":
+		f, err := strconv.ParseFloat(strings.Replace(node.Value, "_", "", -1), 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid number %q", node.Line, node.Value)
+		}
+		return f, nil
+	}
+
+	return node.Value, nil
+}
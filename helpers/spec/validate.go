@@ -0,0 +1,287 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spec
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"gopkg.in/yaml.v3"
+)
+
+// Problem is an error found in a spec, located by line and column, and by the
+// path of the offending field (e.g., guests[1].datacenter.name)
+type Problem struct {
+	File    string
+	Line    int
+	Column  int
+	Path    string
+	Message string
+}
+
+func (p Problem) String() string {
+	location := fmt.Sprintf("line %d, column %d", p.Line, p.Column)
+	if p.File != "" {
+		location = fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+	}
+
+	if p.Path == "" {
+		return location + ": " + p.Message
+	}
+
+	return fmt.Sprintf("%s: %s: %s", location, p.Path, p.Message)
+}
+
+// ValidationError is returned when a spec does not match the type it is
+// loaded into.  Every problem found is listed, not only the first.
+type ValidationError struct {
+	Problems []Problem
+}
+
+func (e ValidationError) Error() string {
+	lines := make([]string, len(e.Problems))
+	for i, problem := range e.Problems {
+		lines[i] = problem.String()
+	}
+
+	return "Invalid spec:\n" + strings.Join(lines, "\n")
+}
+
+var (
+	timeType    = reflect.TypeOf(datatypes.Time{})
+	float64Type = reflect.TypeOf(datatypes.Float64(0))
+)
+
+// Validate checks a YAML (or JSON) spec against the type of v, before it is
+// decoded.  Unknown field names (with a suggestion, when only the case is
+// wrong) and values of the wrong type are reported as a ValidationError.
+func Validate(data []byte, v interface{}) error {
+	return validate("", data, reflect.TypeOf(v))
+}
+
+func validate(file string, data []byte, t reflect.Type) error {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		if file != "" {
+			return fmt.Errorf("Error parsing spec %s: %s", file, err)
+		}
+		return fmt.Errorf("Error parsing spec: %s", err)
+	}
+
+	if len(doc.Content) == 0 {
+		return nil
+	}
+
+	c := checker{file: file}
+	c.check(doc.Content[0], t, "")
+
+	if len(c.problems) > 0 {
+		return ValidationError{Problems: c.problems}
+	}
+
+	return nil
+}
+
+type checker struct {
+	file     string
+	problems []Problem
+}
+
+func (c *checker) report(node *yaml.Node, path string, format string, args ...interface{}) {
+	c.problems = append(c.problems, Problem{
+		File:    c.file,
+		Line:    node.Line,
+		Column:  node.Column,
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *checker) check(node *yaml.Node, t reflect.Type, path string) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		return
+	}
+
+	switch {
+	case t == timeType:
+		if node.Kind != yaml.ScalarNode {
+			c.report(node, path, "expected a date and time")
+		} else if _, err := time.Parse(time.RFC3339, node.Value); err != nil {
+			c.report(node, path, "expected an RFC 3339 date and time (e.g., 2006-01-02T15:04:05Z), got %q", node.Value)
+		}
+		return
+	case t == float64Type:
+		c.checkScalar(node, path, "a number", "!!int", "!!float")
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		c.checkStruct(node, t, path)
+
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			c.checkScalar(node, path, "a base64 string", "!!str", "!!binary")
+			return
+		}
+
+		if node.Kind != yaml.SequenceNode {
+			c.report(node, path, "expected a list, got %s", describe(node))
+			return
+		}
+
+		for i, item := range node.Content {
+			c.check(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i))
+		}
+
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			c.report(node, path, "expected a mapping, got %s", describe(node))
+			return
+		}
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			c.check(node.Content[i+1], t.Elem(), join(path, node.Content[i].Value))
+		}
+
+	case reflect.String:
+		c.checkScalar(node, path, "a string", "!!str", "!!timestamp")
+
+	case reflect.Bool:
+		c.checkScalar(node, path, "true or false", "!!bool")
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		c.checkScalar(node, path, "an integer", "!!int")
+		if t.Kind() >= reflect.Uint && strings.HasPrefix(node.Value, "-") {
+			c.report(node, path, "expected a positive integer, got %s", node.Value)
+		}
+
+	case reflect.Float32, reflect.Float64:
+		c.checkScalar(node, path, "a number", "!!int", "!!float")
+	}
+}
+
+func (c *checker) checkScalar(node *yaml.Node, path string, expected string, tags ...string) {
+	if node.Kind == yaml.ScalarNode {
+		for _, tag := range tags {
+			if node.Tag == tag {
+				return
+			}
+		}
+	}
+
+	c.report(node, path, "expected %s, got %s", expected, describe(node))
+}
+
+func (c *checker) checkStruct(node *yaml.Node, t reflect.Type, path string) {
+	if node.Kind != yaml.MappingNode {
+		c.report(node, path, "expected a mapping, got %s", describe(node))
+		return
+	}
+
+	fields := jsonFields(t)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i]
+		fieldPath := join(path, key.Value)
+
+		field, ok := fields[key.Value]
+		if !ok {
+			if suggestion := suggest(fields, key.Value); suggestion != "" {
+				c.report(key, fieldPath, "unknown field %q (did you mean %q?)", key.Value, suggestion)
+			} else {
+				c.report(key, fieldPath, "unknown field %q", key.Value)
+			}
+			continue
+		}
+
+		c.check(node.Content[i+1], field, fieldPath)
+	}
+}
+
+// jsonFields returns the types of the fields of a struct by their JSON name,
+// including the fields of embedded (base) types
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			for name, fieldType := range jsonFields(field.Type) {
+				if _, ok := fields[name]; !ok {
+					fields[name] = fieldType
+				}
+			}
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+
+	return fields
+}
+
+func suggest(fields map[string]reflect.Type, key string) string {
+	normalized := strings.ToLower(strings.Replace(strings.Replace(key, "_", "", -1), "-", "", -1))
+	for name := range fields {
+		if strings.ToLower(name) == normalized {
+			return name
+		}
+	}
+
+	return ""
+}
+
+func describe(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "a mapping"
+	case yaml.SequenceNode:
+		return "a list"
+	}
+
+	return fmt.Sprintf("%q", node.Value)
+}
+
+func join(path string, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
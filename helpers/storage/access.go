@@ -0,0 +1,212 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package storage audits which hosts, IP addresses and subnets are authorized
+// to the block and file storage volumes of an account, and revokes access in
+// bulk, e.g. for decommissioned hosts.
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Kinds of authorized hosts
+const (
+	Hardware     = "hardware"
+	VirtualGuest = "virtualGuest"
+	IpAddress    = "ipAddress"
+	Subnet       = "subnet"
+)
+
+// AccessMask is the object mask used by GetAccessGrants to retrieve volumes
+// and the hosts authorized to them
+const AccessMask = "id,username,nasType," +
+	"allowedHardware[id,fullyQualifiedDomainName,primaryBackendIpAddress]," +
+	"allowedVirtualGuests[id,fullyQualifiedDomainName,primaryBackendIpAddress]," +
+	"allowedIpAddresses[id,ipAddress]," +
+	"allowedSubnets[id,networkIdentifier,cidr]"
+
+// Grant is the authorization of a host, IP address or subnet to a volume
+type Grant struct {
+	VolumeId   int    `json:"volumeId"`
+	VolumeName string `json:"volumeName"`
+	NasType    string `json:"nasType,omitempty"`
+	Kind       string `json:"kind"`
+	Id         int    `json:"id"`
+
+	// Name is the fully qualified domain name of hardware and virtual guests
+	Name string `json:"name,omitempty"`
+
+	// Address is the (backend) IP address, or the subnet in CIDR notation
+	Address string `json:"address,omitempty"`
+}
+
+// GetAccessGrants returns all the authorizations to the volumes of the account,
+// ordered by volume. The volumes are all retrieved, whatever the default result
+// limit of the session.
+func GetAccessGrants(sess *session.Session) ([]Grant, error) {
+	volumes, err := services.GetAccountService(sess).Mask(AccessMask).Unlimited().GetNetworkStorage()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving network storage: %s", err)
+	}
+
+	grants := []Grant{}
+	for _, volume := range volumes {
+		grants = append(grants, VolumeGrants(volume)...)
+	}
+
+	sort.SliceStable(grants, func(i, j int) bool {
+		return grants[i].VolumeId < grants[j].VolumeId
+	})
+
+	return grants, nil
+}
+
+// VolumeGrants returns the authorizations to a volume retrieved with
+// AccessMask
+func VolumeGrants(volume datatypes.Network_Storage) []Grant {
+	grants := []Grant{}
+	grant := func(kind string, id *int, name string, address string) {
+		if id == nil {
+			return
+		}
+
+		grants = append(grants, Grant{
+			VolumeId:   sl.Get(volume.Id, 0).(int),
+			VolumeName: sl.Get(volume.Username, "").(string),
+			NasType:    sl.Get(volume.NasType, "").(string),
+			Kind:       kind,
+			Id:         *id,
+			Name:       name,
+			Address:    address,
+		})
+	}
+
+	for _, h := range volume.AllowedHardware {
+		grant(Hardware, h.Id,
+			sl.Get(h.FullyQualifiedDomainName, "").(string), sl.Get(h.PrimaryBackendIpAddress, "").(string))
+	}
+
+	for _, g := range volume.AllowedVirtualGuests {
+		grant(VirtualGuest, g.Id,
+			sl.Get(g.FullyQualifiedDomainName, "").(string), sl.Get(g.PrimaryBackendIpAddress, "").(string))
+	}
+
+	for _, ip := range volume.AllowedIpAddresses {
+		grant(IpAddress, ip.Id, "", sl.Get(ip.IpAddress, "").(string))
+	}
+
+	for _, s := range volume.AllowedSubnets {
+		address := sl.Get(s.NetworkIdentifier, "").(string)
+		if s.Cidr != nil {
+			address = fmt.Sprintf("%s/%d", address, *s.Cidr)
+		}
+		grant(Subnet, s.Id, "", address)
+	}
+
+	return grants
+}
+
+// ForHosts returns the grants whose name or address is one of hosts (fully
+// qualified domain names, IP addresses or subnets in CIDR notation), ignoring
+// case
+func ForHosts(grants []Grant, hosts ...string) []Grant {
+	wanted := map[string]bool{}
+	for _, host := range hosts {
+		wanted[strings.ToLower(host)] = true
+	}
+
+	matched := []Grant{}
+	for _, g := range grants {
+		if (g.Name != "" && wanted[strings.ToLower(g.Name)]) || (g.Address != "" && wanted[strings.ToLower(g.Address)]) {
+			matched = append(matched, g)
+		}
+	}
+
+	return matched
+}
+
+// Revoke removes the grants, with one request per volume and kind of host. It
+// returns the grants revoked, which are those before the first error.
+func Revoke(sess *session.Session, grants []Grant) ([]Grant, error) {
+	type batch struct {
+		volumeId int
+		kind     string
+	}
+
+	batches := map[batch][]Grant{}
+	order := []batch{}
+	for _, g := range grants {
+		b := batch{volumeId: g.VolumeId, kind: g.Kind}
+		if _, ok := batches[b]; !ok {
+			order = append(order, b)
+		}
+		batches[b] = append(batches[b], g)
+	}
+
+	revoked := []Grant{}
+	for _, b := range order {
+		if err := revokeBatch(sess, b.volumeId, b.kind, batches[b]); err != nil {
+			return revoked, fmt.Errorf("Error revoking %s access to volume %d: %s", b.kind, b.volumeId, err)
+		}
+		revoked = append(revoked, batches[b]...)
+	}
+
+	return revoked, nil
+}
+
+func revokeBatch(sess *session.Session, volumeId int, kind string, grants []Grant) error {
+	service := services.GetNetworkStorageService(sess).Id(volumeId)
+
+	var err error
+	switch kind {
+	case Hardware:
+		templates := make([]datatypes.Hardware, len(grants))
+		for i, g := range grants {
+			templates[i].Id = sl.Int(g.Id)
+		}
+		_, err = service.RemoveAccessFromHardwareList(templates)
+	case VirtualGuest:
+		templates := make([]datatypes.Virtual_Guest, len(grants))
+		for i, g := range grants {
+			templates[i].Id = sl.Int(g.Id)
+		}
+		_, err = service.RemoveAccessFromVirtualGuestList(templates)
+	case IpAddress:
+		templates := make([]datatypes.Network_Subnet_IpAddress, len(grants))
+		for i, g := range grants {
+			templates[i].Id = sl.Int(g.Id)
+		}
+		_, err = service.RemoveAccessFromIpAddressList(templates)
+	case Subnet:
+		templates := make([]datatypes.Network_Subnet, len(grants))
+		for i, g := range grants {
+			templates[i].Id = sl.Int(g.Id)
+		}
+		_, err = service.RemoveAccessFromSubnetList(templates)
+	default:
+		err = fmt.Errorf("Unknown kind of host %q", kind)
+	}
+
+	return err
+}
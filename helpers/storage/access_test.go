@@ -0,0 +1,52 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session/mock"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestGetAccessGrantsIgnoresDefaultLimit(t *testing.T) {
+	volumes := []datatypes.Network_Storage{}
+	for id := 1; id <= 3; id++ {
+		volumes = append(volumes, datatypes.Network_Storage{
+			Id:                   sl.Int(id),
+			AllowedVirtualGuests: []datatypes.Virtual_Guest{{Id: sl.Int(10 + id)}},
+		})
+	}
+
+	handler := mock.New()
+	handler.On("SoftLayer_Account", "getNetworkStorage").Stub(func(call mock.Call) (interface{}, error) {
+		if call.Options.Limit != nil && *call.Options.Limit < len(volumes) {
+			return volumes[:*call.Options.Limit], nil
+		}
+		return volumes, nil
+	})
+
+	grants, err := GetAccessGrants(handler.Session().SetDefaultLimit(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(grants) != 3 {
+		t.Errorf("Expected the grants of all 3 volumes, got %+v", grants)
+	}
+}
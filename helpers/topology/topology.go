@@ -0,0 +1,323 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package topology
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Node kinds
+const (
+	KindGuest    = "guest"
+	KindHardware = "hardware"
+	KindVlan     = "vlan"
+	KindSubnet   = "subnet"
+	KindGateway  = "gateway"
+	KindStorage  = "storage"
+	KindRouter   = "router"
+)
+
+// Edge kinds
+const (
+	EdgeAttached = "attached" // a device is attached to a VLAN
+	EdgeRoutes   = "routes"   // a subnet is routed on a VLAN, or a VLAN through a router or gateway
+	EdgeMember   = "member"   // a hardware device is a member of a gateway
+	EdgeStorage  = "storage"  // a device is authorized to a storage volume
+)
+
+// Node is a resource of the account
+type Node struct {
+	ID         string            `json:"id"`
+	Kind       string            `json:"kind"`
+	Name       string            `json:"name"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Edge is a relationship between two resources
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Kind string `json:"kind"`
+}
+
+// Graph is the topology of an account's network resources
+type Graph struct {
+	Nodes map[string]Node `json:"nodes"`
+	Edges []Edge          `json:"edges"`
+}
+
+// New returns an empty Graph
+func New() *Graph {
+	return &Graph{Nodes: map[string]Node{}}
+}
+
+// NodeID returns the identifier of the node of the given kind and id
+func NodeID(kind string, id interface{}) string {
+	return fmt.Sprintf("%s:%v", kind, id)
+}
+
+// AddNode adds (or replaces) a node in the graph
+func (g *Graph) AddNode(node Node) {
+	g.Nodes[node.ID] = node
+}
+
+// AddEdge adds an edge between two nodes. Nodes not yet in the graph are
+// added with only their identifier populated.
+func (g *Graph) AddEdge(from string, to string, kind string) {
+	for _, id := range []string{from, to} {
+		if _, ok := g.Nodes[id]; !ok {
+			g.Nodes[id] = Node{ID: id, Kind: strings.SplitN(id, ":", 2)[0]}
+		}
+	}
+
+	g.Edges = append(g.Edges, Edge{From: from, To: to, Kind: kind})
+}
+
+// Neighbors returns the identifiers of the nodes directly connected to id,
+// regardless of edge direction
+func (g *Graph) Neighbors(id string) []string {
+	seen := map[string]bool{}
+	for _, edge := range g.Edges {
+		if edge.From == id {
+			seen[edge.To] = true
+		} else if edge.To == id {
+			seen[edge.From] = true
+		}
+	}
+
+	return sortedKeys(seen)
+}
+
+// Reachable returns the identifiers of every node connected to id through any
+// path, e.g. to estimate the blast radius of an outage of that resource. Paths
+// are not followed through nodes of the kinds listed in stopAt (for example,
+// KindRouter, since most resources share a router).
+func (g *Graph) Reachable(id string, stopAt ...string) []string {
+	stop := map[string]bool{}
+	for _, kind := range stopAt {
+		stop[kind] = true
+	}
+
+	adjacency := map[string][]string{}
+	for _, edge := range g.Edges {
+		adjacency[edge.From] = append(adjacency[edge.From], edge.To)
+		adjacency[edge.To] = append(adjacency[edge.To], edge.From)
+	}
+
+	seen := map[string]bool{id: true}
+	queue := []string{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current != id && stop[g.Nodes[current].Kind] {
+			continue
+		}
+
+		for _, next := range adjacency[current] {
+			if !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	delete(seen, id)
+	return sortedKeys(seen)
+}
+
+// WriteJSON writes the graph as JSON
+func (g *Graph) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(g)
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language
+func (g *Graph) WriteDOT(w io.Writer) error {
+	ids := make([]string, 0, len(g.Nodes))
+	for id := range g.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	lines := []string{"graph topology {"}
+	for _, id := range ids {
+		node := g.Nodes[id]
+		label := node.Name
+		if label == "" {
+			label = id
+		}
+		lines = append(lines, fmt.Sprintf("\t%q [label=%q, shape=%s];", id, label, shapes[node.Kind]))
+	}
+
+	for _, edge := range g.Edges {
+		lines = append(lines, fmt.Sprintf("\t%q -- %q [label=%q];", edge.From, edge.To, edge.Kind))
+	}
+	lines = append(lines, "}")
+
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+var shapes = map[string]string{
+	KindGuest:    "box",
+	KindHardware: "box3d",
+	KindVlan:     "ellipse",
+	KindSubnet:   "note",
+	KindGateway:  "hexagon",
+	KindStorage:  "cylinder",
+	KindRouter:   "diamond",
+	"":           "ellipse",
+}
+
+// Object masks used by Load
+const (
+	VlanMask = "id,vlanNumber,name,networkSpace,primaryRouter[id,hostname]," +
+		"subnets[id,networkIdentifier,cidr],virtualGuests[id],hardware[id]"
+	DeviceMask  = "id,fullyQualifiedDomainName,allowedNetworkStorage[id]"
+	GatewayMask = "id,name,members[hardwareId],insideVlans[networkVlanId]"
+	StorageMask = "id,username,nasType,capacityGb"
+)
+
+// Load builds the graph of the guests, hardware, VLANs, subnets, gateways and
+// storage volumes of the account. All of them are retrieved, whatever the
+// default result limit of the session.
+func Load(sess *session.Session) (*Graph, error) {
+	g := New()
+	account := services.GetAccountService(sess).Unlimited()
+
+	vlans, err := account.Mask(VlanMask).GetNetworkVlans()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vlan := range vlans {
+		vlanID := NodeID(KindVlan, sl.Get(vlan.Id))
+		g.AddNode(Node{
+			ID:   vlanID,
+			Kind: KindVlan,
+			Name: fmt.Sprintf("%s %d", sl.Get(vlan.Name, "vlan"), sl.Get(vlan.VlanNumber)),
+			Attributes: map[string]string{
+				"networkSpace": sl.Get(vlan.NetworkSpace, "").(string),
+			},
+		})
+
+		if vlan.PrimaryRouter != nil && vlan.PrimaryRouter.Id != nil {
+			routerID := NodeID(KindRouter, *vlan.PrimaryRouter.Id)
+			g.AddNode(Node{ID: routerID, Kind: KindRouter, Name: sl.Get(vlan.PrimaryRouter.Hostname, "").(string)})
+			g.AddEdge(vlanID, routerID, EdgeRoutes)
+		}
+
+		for _, subnet := range vlan.Subnets {
+			subnetID := NodeID(KindSubnet, sl.Get(subnet.Id))
+			g.AddNode(Node{
+				ID:   subnetID,
+				Kind: KindSubnet,
+				Name: fmt.Sprintf("%s/%d", sl.Get(subnet.NetworkIdentifier), sl.Get(subnet.Cidr)),
+			})
+			g.AddEdge(subnetID, vlanID, EdgeRoutes)
+		}
+
+		for _, guest := range vlan.VirtualGuests {
+			g.AddEdge(NodeID(KindGuest, sl.Get(guest.Id)), vlanID, EdgeAttached)
+		}
+
+		for _, hardware := range vlan.Hardware {
+			g.AddEdge(NodeID(KindHardware, sl.Get(hardware.Id)), vlanID, EdgeAttached)
+		}
+	}
+
+	guests, err := account.Mask(DeviceMask).GetVirtualGuests()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, guest := range guests {
+		guestID := NodeID(KindGuest, sl.Get(guest.Id))
+		g.AddNode(Node{ID: guestID, Kind: KindGuest, Name: sl.Get(guest.FullyQualifiedDomainName, "").(string)})
+		for _, storage := range guest.AllowedNetworkStorage {
+			g.AddEdge(guestID, NodeID(KindStorage, sl.Get(storage.Id)), EdgeStorage)
+		}
+	}
+
+	hardware, err := account.Mask(DeviceMask).GetHardware()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hw := range hardware {
+		hwID := NodeID(KindHardware, sl.Get(hw.Id))
+		g.AddNode(Node{ID: hwID, Kind: KindHardware, Name: sl.Get(hw.FullyQualifiedDomainName, "").(string)})
+		for _, storage := range hw.AllowedNetworkStorage {
+			g.AddEdge(hwID, NodeID(KindStorage, sl.Get(storage.Id)), EdgeStorage)
+		}
+	}
+
+	gateways, err := account.Mask(GatewayMask).GetNetworkGateways()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, gateway := range gateways {
+		gatewayID := NodeID(KindGateway, sl.Get(gateway.Id))
+		g.AddNode(Node{ID: gatewayID, Kind: KindGateway, Name: sl.Get(gateway.Name, "").(string)})
+		for _, member := range gateway.Members {
+			g.AddEdge(NodeID(KindHardware, sl.Get(member.HardwareId)), gatewayID, EdgeMember)
+		}
+		for _, vlan := range gateway.InsideVlans {
+			g.AddEdge(NodeID(KindVlan, sl.Get(vlan.NetworkVlanId)), gatewayID, EdgeRoutes)
+		}
+	}
+
+	storage, err := account.Mask(StorageMask).GetNetworkStorage()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, volume := range storage {
+		g.AddNode(Node{
+			ID:   NodeID(KindStorage, sl.Get(volume.Id)),
+			Kind: KindStorage,
+			Name: sl.Get(volume.Username, "").(string),
+			Attributes: map[string]string{
+				"nasType":    sl.Get(volume.NasType, "").(string),
+				"capacityGb": fmt.Sprintf("%d", sl.Get(volume.CapacityGb)),
+			},
+		})
+	}
+
+	return g, nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,57 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package topology
+
+import (
+	"testing"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session/mock"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestLoadIgnoresDefaultLimit(t *testing.T) {
+	handler := mock.New()
+	handler.On("SoftLayer_Account", "getNetworkVlans").Returns([]datatypes.Network_Vlan{})
+	handler.On("SoftLayer_Account", "getVirtualGuests").Returns([]datatypes.Virtual_Guest{})
+	handler.On("SoftLayer_Account", "getHardware").Returns([]datatypes.Hardware{})
+	handler.On("SoftLayer_Account", "getNetworkGateways").Returns([]datatypes.Network_Gateway{})
+	handler.On("SoftLayer_Account", "getNetworkStorage").Stub(func(call mock.Call) (interface{}, error) {
+		volumes := []datatypes.Network_Storage{{Id: sl.Int(1)}, {Id: sl.Int(2)}, {Id: sl.Int(3)}}
+		if call.Options.Limit != nil && *call.Options.Limit < len(volumes) {
+			return volumes[:*call.Options.Limit], nil
+		}
+		return volumes, nil
+	})
+
+	g, err := Load(handler.Session().SetDefaultLimit(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(g.Nodes) != 3 {
+		t.Errorf("Expected the 3 storage volumes, got %+v", g.Nodes)
+	}
+
+	for _, method := range []string{"getNetworkVlans", "getVirtualGuests", "getHardware", "getNetworkGateways"} {
+		for _, call := range handler.Calls("SoftLayer_Account", method) {
+			if call.Options.Limit != nil {
+				t.Errorf("Expected %s to retrieve every result, got a limit of %d", method, *call.Options.Limit)
+			}
+		}
+	}
+}
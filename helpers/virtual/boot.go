@@ -0,0 +1,85 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package virtual
+
+import (
+	"fmt"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Guest boot modes
+const (
+	BootModePV  = "PV"
+	BootModeHVM = "HVM"
+)
+
+// SetBootMode sets the mode the guest of a creation template boots in
+func SetBootMode(template *datatypes.Virtual_Guest, mode string) {
+	if template.SupplementalCreateObjectOptions == nil {
+		template.SupplementalCreateObjectOptions = &datatypes.Virtual_Guest_SupplementalCreateObjectOptions{}
+	}
+
+	template.SupplementalCreateObjectOptions.BootMode = sl.String(mode)
+}
+
+// GetImageBootModes returns the boot modes supported by an image template,
+// identified by its global identifier
+func GetImageBootModes(sess *session.Session, globalIdentifier string) ([]string, error) {
+	modes, err := services.GetVirtualGuestBlockDeviceTemplateGroupService(sess).
+		GlobalID(globalIdentifier).
+		GetSupportedBootModes()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving boot modes of image %s: %s", globalIdentifier, err)
+	}
+
+	return modes, nil
+}
+
+// CheckBootMode verifies that the image of a creation template, if any,
+// supports the boot mode set with SetBootMode, so that the guest is not
+// provisioned with an image it cannot boot
+func CheckBootMode(sess *session.Session, template datatypes.Virtual_Guest) error {
+	if template.SupplementalCreateObjectOptions == nil || template.SupplementalCreateObjectOptions.BootMode == nil ||
+		template.BlockDeviceTemplateGroup == nil || template.BlockDeviceTemplateGroup.GlobalIdentifier == nil {
+		return nil
+	}
+
+	mode := *template.SupplementalCreateObjectOptions.BootMode
+	image := *template.BlockDeviceTemplateGroup.GlobalIdentifier
+
+	modes, err := GetImageBootModes(sess, image)
+	if err != nil {
+		return err
+	}
+
+	// Images which do not report their modes are left to the API to check
+	if len(modes) == 0 {
+		return nil
+	}
+
+	for _, supported := range modes {
+		if supported == mode {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Image %s does not support boot mode %s (supported: %v)", image, mode, modes)
+}
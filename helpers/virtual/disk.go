@@ -0,0 +1,194 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package virtual
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// TransactionPollInterval is the interval at which WaitForTransactions checks
+// the active transactions of a guest
+var TransactionPollInterval = 10 * time.Second
+
+// Disk is a normalized view of a block device of a virtual guest
+type Disk struct {
+	// BlockDeviceId is the id of the SoftLayer_Virtual_Guest_Block_Device
+	BlockDeviceId int
+
+	// DiskImageId is the id of the SoftLayer_Virtual_Disk_Image attached to
+	// the block device
+	DiskImageId int
+
+	// Device is the device number of the block device (swap is always "1")
+	Device string
+
+	// Number is the index of the disk in the guest_disk<Number> price
+	// categories used to order and upgrade it. It is -1 for swap.
+	Number int
+
+	Name     string
+	Capacity int
+	Units    string
+	Local    bool
+	Swap     bool
+	Bootable bool
+}
+
+// DiskCategoryCode returns the price category code of the disk
+// (e.g., guest_disk0)
+func (d Disk) DiskCategoryCode() string {
+	return "guest_disk" + strconv.Itoa(d.Number)
+}
+
+// DiskMask is the object mask used by GetDisks
+const DiskMask = "id,device,mountType,bootableFlag," +
+	"diskImage[id,name,description,capacity,units,localDiskFlag,type[keyName]]"
+
+// GetDisks returns the disks of a virtual guest, ordered by device number.
+// CD-ROM devices are not included.
+func GetDisks(sess *session.Session, guestId int) ([]Disk, error) {
+	devices, err := services.GetVirtualGuestService(sess).
+		Id(guestId).
+		Mask(DiskMask).
+		GetBlockDevices()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving block devices of guest %d: %s", guestId, err)
+	}
+
+	disks := []Disk{}
+	for _, device := range devices {
+		if device.DiskImage == nil || sl.Get(device.MountType, "") == "CD" {
+			continue
+		}
+
+		image := device.DiskImage
+		disk := Disk{
+			BlockDeviceId: sl.Get(device.Id, 0).(int),
+			DiskImageId:   sl.Get(image.Id, 0).(int),
+			Device:        sl.Get(device.Device, "").(string),
+			Name:          sl.Get(image.Name, "").(string),
+			Capacity:      sl.Get(image.Capacity, 0).(int),
+			Units:         sl.Get(image.Units, "").(string),
+			Local:         sl.Get(image.LocalDiskFlag, false).(bool),
+			Bootable:      sl.Get(device.BootableFlag, 0).(int) == 1,
+		}
+
+		if image.Type != nil && sl.Get(image.Type.KeyName, "") == "SWAP" {
+			disk.Swap = true
+		} else if strings.Contains(strings.ToLower(sl.Get(image.Description, "").(string)), "swap") {
+			disk.Swap = true
+		}
+
+		disks = append(disks, disk)
+	}
+
+	sort.Slice(disks, func(i, j int) bool {
+		a, _ := strconv.Atoi(disks[i].Device)
+		b, _ := strconv.Atoi(disks[j].Device)
+		return a < b
+	})
+
+	number := 0
+	for i := range disks {
+		if disks[i].Swap {
+			disks[i].Number = -1
+			continue
+		}
+		disks[i].Number = number
+		number++
+	}
+
+	return disks, nil
+}
+
+// AttachDisk attaches the disk image with the given id to a virtual guest
+func AttachDisk(sess *session.Session, guestId int, diskImageId int) (datatypes.Provisioning_Version1_Transaction, error) {
+	transaction, err := services.GetVirtualGuestService(sess).
+		Id(guestId).
+		AttachDiskImage(sl.Int(diskImageId))
+	if err != nil {
+		return transaction, fmt.Errorf("Error attaching disk image %d to guest %d: %s", diskImageId, guestId, err)
+	}
+
+	return transaction, nil
+}
+
+// DetachDisk detaches the disk image with the given id from a virtual guest
+func DetachDisk(sess *session.Session, guestId int, diskImageId int) (datatypes.Provisioning_Version1_Transaction, error) {
+	transaction, err := services.GetVirtualGuestService(sess).
+		Id(guestId).
+		DetachDiskImage(sl.Int(diskImageId))
+	if err != nil {
+		return transaction, fmt.Errorf("Error detaching disk image %d from guest %d: %s", diskImageId, guestId, err)
+	}
+
+	return transaction, nil
+}
+
+// ResizeDisk orders an upgrade of a SAN disk of a virtual guest to capacity
+// (in GB). Disks can only grow, and local disks cannot be resized.
+func ResizeDisk(sess *session.Session, guestId int, disk Disk, capacity int) (datatypes.Container_Product_Order_Receipt, error) {
+	if disk.Swap || disk.Local {
+		return datatypes.Container_Product_Order_Receipt{},
+			fmt.Errorf("Disk %s of guest %d cannot be resized", disk.Device, guestId)
+	}
+
+	if capacity <= disk.Capacity {
+		return datatypes.Container_Product_Order_Receipt{},
+			fmt.Errorf("Disk %s of guest %d can only be resized to more than %d GB", disk.Device, guestId, disk.Capacity)
+	}
+
+	guest := datatypes.Virtual_Guest{Id: sl.Int(guestId)}
+	return UpgradeVirtualGuest(sess, &guest, map[string]float64{disk.DiskCategoryCode(): float64(capacity)})
+}
+
+// TransactionMask is the object mask used by WaitForTransactions
+const TransactionMask = "id,transactionStatus[name]"
+
+// WaitForTransactions waits until a virtual guest has no active transactions
+// (e.g., those created by attaching, detaching or resizing disks), or until
+// timeout elapses
+func WaitForTransactions(sess *session.Session, guestId int, timeout time.Duration) error {
+	service := services.GetVirtualGuestService(sess).Id(guestId).Mask(TransactionMask)
+	deadline := time.Now().Add(timeout)
+
+	for {
+		transactions, err := service.GetActiveTransactions()
+		if err != nil {
+			return fmt.Errorf("Error retrieving active transactions of guest %d: %s", guestId, err)
+		}
+
+		if len(transactions) == 0 {
+			return nil
+		}
+
+		if time.Now().Add(TransactionPollInterval).After(deadline) {
+			return fmt.Errorf("Timed out waiting for %d active transactions of guest %d", len(transactions), guestId)
+		}
+
+		time.Sleep(TransactionPollInterval)
+	}
+}
@@ -0,0 +1,369 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package virtual
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/helpers/network"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Steps of a SAN migration, in the order they are run
+const (
+	MigrationStarted     = ""
+	MigrationCaptured    = "captured"
+	MigrationOrdered     = "ordered"
+	MigrationProvisioned = "provisioned"
+	MigrationRerouted    = "rerouted"
+)
+
+var migrationSteps = []string{MigrationCaptured, MigrationOrdered, MigrationProvisioned, MigrationRerouted}
+
+// MigrationSourceMask is the object mask used by a SanMigration to retrieve the
+// configuration of the source guest
+const MigrationSourceMask = "id,hostname,domain,startCpus,maxMemory,hourlyBillingFlag,privateNetworkOnlyFlag," +
+	"dedicatedAccountHostOnlyFlag,datacenter[name],primaryNetworkComponent[maxSpeed,networkVlan[id]]," +
+	"primaryBackendNetworkComponent[networkVlan[id]]"
+
+// MigrationImageMask is the object mask used by a SanMigration to find the
+// captured image
+const MigrationImageMask = "id,globalIdentifier,name"
+
+// MigrationImageFilter returns the object filter used by a SanMigration to
+// find the captured image
+func MigrationImageFilter(imageName string) string {
+	return filter.Path("blockDeviceTemplateGroups.name").Eq(imageName).Build()
+}
+
+// StaticSubnetFilter returns the object filter used by a SanMigration to find
+// the static subnets routed to the source guest
+func StaticSubnetFilter(ipAddress string) string {
+	return filter.Path("subnets.endPointIpAddress.ipAddress").Eq(ipAddress).Build()
+}
+
+// SanMigration replaces a local disk virtual guest with a SAN backed copy. The
+// disks of the source guest are captured to an image, a SAN guest with the
+// same configuration is provisioned from it, and the global IPs routed to the
+// source guest are routed to the new one. Primary IP addresses cannot move
+// between guests; static subnets routed to the source guest are reported in
+// StaticSubnets, for the route to be updated with support.
+//
+// The migration records its progress in the Checkpoint file after each step,
+// so a failed or interrupted migration can be resumed with LoadSanMigration.
+// The source guest is never cancelled.
+type SanMigration struct {
+	SourceId  int    `json:"sourceId"`
+	ImageName string `json:"imageName"`
+
+	// Step is the last completed step
+	Step string `json:"step"`
+
+	ImageGlobalIdentifier string   `json:"imageGlobalIdentifier,omitempty"`
+	TargetId              int      `json:"targetId,omitempty"`
+	ReroutedGlobalIps     []string `json:"reroutedGlobalIps,omitempty"`
+	StaticSubnets         []string `json:"staticSubnets,omitempty"`
+
+	// Checkpoint is the file the migration progress is saved to
+	Checkpoint string `json:"-"`
+
+	// Timeout bounds each wait for the capture and provisioning transactions.
+	// Defaults to two hours.
+	Timeout time.Duration `json:"-"`
+}
+
+// NewSanMigration returns a migration of the guest with the given id, saving
+// its progress to checkpoint
+func NewSanMigration(sourceId int, checkpoint string) *SanMigration {
+	return &SanMigration{
+		SourceId:   sourceId,
+		ImageName:  fmt.Sprintf("san-migration-%d", sourceId),
+		Checkpoint: checkpoint,
+	}
+}
+
+// LoadSanMigration loads a migration from its checkpoint file, to be resumed
+// with Run
+func LoadSanMigration(checkpoint string) (*SanMigration, error) {
+	data, err := ioutil.ReadFile(checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &SanMigration{Checkpoint: checkpoint}
+	if err = json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("Error reading migration checkpoint %s: %s", checkpoint, err)
+	}
+
+	return m, nil
+}
+
+// Done returns true once all the steps of the migration completed
+func (m *SanMigration) Done() bool {
+	return m.Step == MigrationRerouted
+}
+
+// Run runs the remaining steps of the migration
+func (m *SanMigration) Run(sess *session.Session) error {
+	steps := map[string]func(*session.Session) error{
+		MigrationCaptured:    m.capture,
+		MigrationOrdered:     m.order,
+		MigrationProvisioned: m.waitForTarget,
+		MigrationRerouted:    m.reroute,
+	}
+
+	pending := m.Step == MigrationStarted
+	for _, step := range migrationSteps {
+		if !pending {
+			pending = step == m.Step
+			continue
+		}
+
+		if err := steps[step](sess); err != nil {
+			return fmt.Errorf("Error migrating guest %d to SAN (step %s): %s", m.SourceId, step, err)
+		}
+
+		m.Step = step
+		if err := m.save(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *SanMigration) save() error {
+	if m.Checkpoint == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = ioutil.WriteFile(m.Checkpoint, data, 0600); err != nil {
+		return fmt.Errorf("Error saving migration checkpoint %s: %s", m.Checkpoint, err)
+	}
+
+	return nil
+}
+
+func (m *SanMigration) timeout() time.Duration {
+	if m.Timeout == 0 {
+		return 2 * time.Hour
+	}
+
+	return m.Timeout
+}
+
+// capture images the disks (but swap) of the source guest
+func (m *SanMigration) capture(sess *session.Session) error {
+	image, err := m.findImage(sess)
+	if err != nil {
+		return err
+	}
+
+	// The image exists if the capture was interrupted after the transaction
+	if image.GlobalIdentifier == nil {
+		disks, err := GetDisks(sess, m.SourceId)
+		if err != nil {
+			return err
+		}
+
+		devices := []datatypes.Virtual_Guest_Block_Device{}
+		for _, disk := range disks {
+			if !disk.Swap {
+				devices = append(devices, datatypes.Virtual_Guest_Block_Device{Id: sl.Int(disk.BlockDeviceId)})
+			}
+		}
+
+		_, err = services.GetVirtualGuestService(sess).
+			Id(m.SourceId).
+			CreateArchiveTransaction(sl.String(m.ImageName), devices, sl.String("SAN migration"))
+		if err != nil {
+			return err
+		}
+
+		if err = WaitForTransactions(sess, m.SourceId, m.timeout()); err != nil {
+			return err
+		}
+
+		if image, err = m.findImage(sess); err != nil {
+			return err
+		}
+
+		if image.GlobalIdentifier == nil {
+			return fmt.Errorf("Image %s not found after capture", m.ImageName)
+		}
+	}
+
+	m.ImageGlobalIdentifier = *image.GlobalIdentifier
+	return nil
+}
+
+func (m *SanMigration) findImage(sess *session.Session) (datatypes.Virtual_Guest_Block_Device_Template_Group, error) {
+	images, err := services.GetAccountService(sess).
+		Mask(MigrationImageMask).
+		Filter(MigrationImageFilter(m.ImageName)).
+		GetBlockDeviceTemplateGroups()
+	if err != nil || len(images) == 0 {
+		return datatypes.Virtual_Guest_Block_Device_Template_Group{}, err
+	}
+
+	return images[0], nil
+}
+
+// order orders a SAN guest with the configuration of the source guest, from
+// the captured image
+func (m *SanMigration) order(sess *session.Session) error {
+	source, err := services.GetVirtualGuestService(sess).
+		Id(m.SourceId).
+		Mask(MigrationSourceMask).
+		GetObject()
+	if err != nil {
+		return err
+	}
+
+	template := datatypes.Virtual_Guest{
+		Hostname:                     source.Hostname,
+		Domain:                       source.Domain,
+		StartCpus:                    source.StartCpus,
+		MaxMemory:                    source.MaxMemory,
+		HourlyBillingFlag:            source.HourlyBillingFlag,
+		PrivateNetworkOnlyFlag:       source.PrivateNetworkOnlyFlag,
+		DedicatedAccountHostOnlyFlag: source.DedicatedAccountHostOnlyFlag,
+		LocalDiskFlag:                sl.Bool(false),
+		BlockDeviceTemplateGroup: &datatypes.Virtual_Guest_Block_Device_Template_Group{
+			GlobalIdentifier: sl.String(m.ImageGlobalIdentifier),
+		},
+	}
+
+	if source.Datacenter != nil {
+		template.Datacenter = &datatypes.Location{Name: source.Datacenter.Name}
+	}
+
+	if c := source.PrimaryNetworkComponent; c != nil {
+		template.NetworkComponents = []datatypes.Virtual_Guest_Network_Component{{MaxSpeed: c.MaxSpeed}}
+		if c.NetworkVlan != nil {
+			template.PrimaryNetworkComponent = &datatypes.Virtual_Guest_Network_Component{
+				NetworkVlan: &datatypes.Network_Vlan{Id: c.NetworkVlan.Id},
+			}
+		}
+	}
+
+	if c := source.PrimaryBackendNetworkComponent; c != nil && c.NetworkVlan != nil {
+		template.PrimaryBackendNetworkComponent = &datatypes.Virtual_Guest_Network_Component{
+			NetworkVlan: &datatypes.Network_Vlan{Id: c.NetworkVlan.Id},
+		}
+	}
+
+	target, err := services.GetVirtualGuestService(sess).CreateObject(&template)
+	if err != nil {
+		return err
+	}
+
+	m.TargetId = *target.Id
+	return nil
+}
+
+// waitForTarget waits for the new guest to be provisioned
+func (m *SanMigration) waitForTarget(sess *session.Session) error {
+	service := services.GetVirtualGuestService(sess).Id(m.TargetId).Mask("id,provisionDate")
+	deadline := time.Now().Add(m.timeout())
+
+	for {
+		target, err := service.GetObject()
+		if err != nil {
+			return err
+		}
+
+		if target.ProvisionDate != nil {
+			return WaitForTransactions(sess, m.TargetId, time.Until(deadline))
+		}
+
+		if time.Now().Add(TransactionPollInterval).After(deadline) {
+			return fmt.Errorf("Timed out waiting for guest %d to be provisioned", m.TargetId)
+		}
+
+		time.Sleep(TransactionPollInterval)
+	}
+}
+
+// reroute routes the global IPs of the source guest to the new guest, and
+// records the static subnets routed to the source guest
+func (m *SanMigration) reroute(sess *session.Session) error {
+	mask := "id,primaryIpAddress"
+	source, err := services.GetVirtualGuestService(sess).Id(m.SourceId).Mask(mask).GetObject()
+	if err != nil {
+		return err
+	}
+
+	target, err := services.GetVirtualGuestService(sess).Id(m.TargetId).Mask(mask).GetObject()
+	if err != nil {
+		return err
+	}
+
+	sourceIp := sl.Get(source.PrimaryIpAddress, "").(string)
+	targetIp := sl.Get(target.PrimaryIpAddress, "").(string)
+	if sourceIp == "" || targetIp == "" {
+		return nil
+	}
+
+	routes, err := network.GetGlobalIpRoutes(sess)
+	if err != nil {
+		return err
+	}
+
+	for globalIp, destination := range routes {
+		if destination != sourceIp {
+			continue
+		}
+
+		if _, err = network.RouteGlobalIp(sess, globalIp, targetIp); err != nil {
+			return err
+		}
+
+		m.ReroutedGlobalIps = append(m.ReroutedGlobalIps, globalIp)
+		if err = m.save(); err != nil {
+			return err
+		}
+	}
+
+	subnets, err := services.GetAccountService(sess).
+		Mask("id,networkIdentifier,cidr").
+		Filter(StaticSubnetFilter(sourceIp)).
+		GetSubnets()
+	if err != nil {
+		return err
+	}
+
+	m.StaticSubnets = nil
+	for _, subnet := range subnets {
+		m.StaticSubnets = append(m.StaticSubnets,
+			fmt.Sprintf("%s/%d", sl.Get(subnet.NetworkIdentifier, ""), sl.Get(subnet.Cidr, 0)))
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package virtual
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/helpers/product"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// PortableStoragePackage is the key name of the product package portable
+// storage volumes are ordered from
+const PortableStoragePackage = "PORTABLE_STORAGE"
+
+// PortableStorageMask is the object mask used to retrieve portable storage
+// volumes
+const PortableStorageMask = "id,name,description,capacity,units,createDate," +
+	"blockDevices[id,guestId],billingItem[id,cancellationDate]"
+
+// PortableStorageOrderFilter returns the object filter used by
+// WaitForPortableStorage
+func PortableStorageOrderFilter(orderId int) string {
+	return filter.Path("portableStorageVolumes.billingItem.orderItem.order.id").Eq(orderId).Build()
+}
+
+// OrderPortableStorage orders a portable storage volume of capacity (in GB) in
+// a data center (e.g., "dal13"). The volume is provisioned asynchronously; use
+// WaitForPortableStorage with the id of the placed order to get it.
+func OrderPortableStorage(
+	sess *session.Session, datacenter string, capacity int, description string,
+) (datatypes.Container_Product_Order_Receipt, error) {
+
+	pkg, err := product.GetPackageByKeyName(sess, PortableStoragePackage)
+	if err != nil {
+		return datatypes.Container_Product_Order_Receipt{}, err
+	}
+
+	items, err := product.GetPackageProducts(sess, *pkg.Id, "id,capacity,description,prices[id,locationGroupId]")
+	if err != nil {
+		return datatypes.Container_Product_Order_Receipt{}, err
+	}
+
+	var price *datatypes.Product_Item_Price
+	for _, item := range items {
+		if int(sl.Get(item.Capacity, datatypes.Float64(0)).(datatypes.Float64)) != capacity {
+			continue
+		}
+
+		for i := range item.Prices {
+			// Standard prices are not specific to a location group
+			if item.Prices[i].LocationGroupId == nil {
+				price = &item.Prices[i]
+				break
+			}
+		}
+
+		if price != nil {
+			break
+		}
+	}
+
+	if price == nil {
+		return datatypes.Container_Product_Order_Receipt{},
+			fmt.Errorf("No portable storage price found for %d GB", capacity)
+	}
+
+	order := datatypes.Container_Product_Order_Virtual_Disk_Image{
+		Container_Product_Order: datatypes.Container_Product_Order{
+			PackageId: pkg.Id,
+			Location:  sl.String(datacenter),
+			Quantity:  sl.Int(1),
+			Prices:    []datatypes.Product_Item_Price{{Id: price.Id}},
+		},
+		DiskDescription: sl.String(description),
+	}
+
+	receipt, err := services.GetProductOrderService(sess).PlaceOrder(&order, sl.Bool(false))
+	if err != nil {
+		return receipt, fmt.Errorf("Error ordering a %d GB portable storage volume: %s", capacity, err)
+	}
+
+	return receipt, nil
+}
+
+// WaitForPortableStorage waits until the portable storage volume of an order is
+// provisioned, or until timeout elapses, and returns it
+func WaitForPortableStorage(sess *session.Session, orderId int, timeout time.Duration) (datatypes.Virtual_Disk_Image, error) {
+	service := services.GetAccountService(sess).
+		Mask(PortableStorageMask).
+		Filter(PortableStorageOrderFilter(orderId)).
+		Unlimited()
+	deadline := time.Now().Add(timeout)
+
+	for {
+		volumes, err := service.GetPortableStorageVolumes()
+		if err != nil {
+			return datatypes.Virtual_Disk_Image{}, fmt.Errorf("Error retrieving portable storage volumes: %s", err)
+		}
+
+		if len(volumes) > 0 {
+			return volumes[0], nil
+		}
+
+		if time.Now().Add(TransactionPollInterval).After(deadline) {
+			return datatypes.Virtual_Disk_Image{},
+				fmt.Errorf("Timed out waiting for the portable storage volume of order %d", orderId)
+		}
+
+		time.Sleep(TransactionPollInterval)
+	}
+}
+
+// GetPortableStorage returns the portable storage volumes of the account, with
+// the guests they are attached to
+func GetPortableStorage(sess *session.Session) ([]datatypes.Virtual_Disk_Image, error) {
+	volumes, err := services.GetAccountService(sess).Mask(PortableStorageMask).Unlimited().GetPortableStorageVolumes()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving portable storage volumes: %s", err)
+	}
+
+	return volumes, nil
+}
+
+// AttachPortableStorage attaches a portable storage volume to a virtual guest,
+// and waits until the transactions of the guest complete, or until timeout
+// elapses. The guest is rebooted by the attachment.
+func AttachPortableStorage(sess *session.Session, guestId int, volumeId int, timeout time.Duration) error {
+	if _, err := AttachDisk(sess, guestId, volumeId); err != nil {
+		return err
+	}
+
+	return WaitForTransactions(sess, guestId, timeout)
+}
+
+// DetachPortableStorage detaches a portable storage volume from a virtual
+// guest, and waits until the transactions of the guest complete, or until
+// timeout elapses. The guest is rebooted by the detachment.
+func DetachPortableStorage(sess *session.Session, guestId int, volumeId int, timeout time.Duration) error {
+	if _, err := DetachDisk(sess, guestId, volumeId); err != nil {
+		return err
+	}
+
+	return WaitForTransactions(sess, guestId, timeout)
+}
+
+// CancelPortableStorage cancels a portable storage volume, immediately or on
+// its next billing anniversary date. Volumes still attached to a guest are not
+// cancelled.
+func CancelPortableStorage(sess *session.Session, volumeId int, immediate bool) error {
+	volume, err := services.GetVirtualDiskImageService(sess).
+		Id(volumeId).
+		Mask(PortableStorageMask).
+		GetObject()
+	if err != nil {
+		return fmt.Errorf("Error retrieving portable storage volume %d: %s", volumeId, err)
+	}
+
+	for _, device := range volume.BlockDevices {
+		if device.GuestId != nil {
+			return fmt.Errorf("Portable storage volume %d is attached to guest %d; detach it first", volumeId, *device.GuestId)
+		}
+	}
+
+	if volume.BillingItem == nil || volume.BillingItem.Id == nil {
+		return fmt.Errorf("No billing item found for portable storage volume %d", volumeId)
+	}
+
+	if volume.BillingItem.CancellationDate != nil {
+		return fmt.Errorf("Portable storage volume %d is already scheduled for cancellation", volumeId)
+	}
+
+	service := services.GetBillingItemService(sess).Id(*volume.BillingItem.Id)
+	if immediate {
+		_, err = service.CancelService()
+	} else {
+		_, err = service.CancelServiceOnAnniversaryDate()
+	}
+	if err != nil {
+		return fmt.Errorf("Error cancelling portable storage volume %d: %s", volumeId, err)
+	}
+
+	return nil
+}
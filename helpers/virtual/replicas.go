@@ -0,0 +1,200 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package virtual
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// PodMask is the object mask used by ProvisionReplicas to retrieve pods
+const PodMask = "name,datacenterName,backendRouterId,capabilities"
+
+// ReplicaRequest describes guests to spread across datacenters
+type ReplicaRequest struct {
+	// Template is the guest to replicate (e.g., loaded with spec.LoadGuests).
+	// Its hostname is suffixed with the number of each replica.
+	Template datatypes.Virtual_Guest
+
+	// Count is the number of replicas
+	Count int
+
+	// Datacenters are the names of the candidate datacenters. Replicas are
+	// spread evenly across those eligible, in order.
+	Datacenters []string
+
+	// Capabilities are the pod capabilities the replicas need (e.g.,
+	// "SUPPORTS_SECURITY_GROUP"). Datacenters without a pod offering all of
+	// them are not eligible, and replicas are placed on the backend router of
+	// such a pod, unless the template or a placement group sets their network.
+	Capabilities []string
+
+	// PlacementGroups maps datacenter names to the placement group of the
+	// replicas provisioned there, if any
+	PlacementGroups map[string]int
+
+	// Rollback deletes the replicas provisioned if any datacenter fails
+	Rollback bool
+}
+
+// ReplicaResult is the outcome of provisioning in one datacenter
+type ReplicaResult struct {
+	Datacenter string
+	Guests     []datatypes.Virtual_Guest
+	Err        error
+
+	// RolledBack is true if the guests were deleted after another datacenter
+	// failed
+	RolledBack bool
+}
+
+// ProvisionReplicas orders the replicas of the request, spread across its
+// eligible datacenters, and returns the result in each of them. An error is
+// returned if any datacenter failed (after rolling back, if requested).
+func ProvisionReplicas(sess *session.Session, request ReplicaRequest) ([]ReplicaResult, error) {
+	if request.Count < 1 {
+		return nil, fmt.Errorf("At least one replica is required")
+	}
+
+	pods, err := eligiblePods(sess, request)
+	if err != nil {
+		return nil, err
+	}
+
+	datacenters := []string{}
+	for _, name := range request.Datacenters {
+		if _, ok := pods[name]; ok {
+			datacenters = append(datacenters, name)
+		}
+	}
+
+	if len(datacenters) == 0 {
+		return nil, fmt.Errorf("None of the datacenters %v has a pod with capabilities %v",
+			request.Datacenters, request.Capabilities)
+	}
+
+	templates := map[string][]datatypes.Virtual_Guest{}
+	for i := 0; i < request.Count; i++ {
+		name := datacenters[i%len(datacenters)]
+		templates[name] = append(templates[name], replicaTemplate(request, name, pods[name], i+1))
+	}
+
+	results := []ReplicaResult{}
+	failed := []string{}
+	for _, name := range datacenters {
+		if len(templates[name]) == 0 {
+			continue
+		}
+
+		result := ReplicaResult{Datacenter: name}
+		result.Guests, result.Err = services.GetVirtualGuestService(sess).CreateObjects(templates[name])
+		if result.Err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %s", name, result.Err))
+		}
+		results = append(results, result)
+	}
+
+	if len(failed) == 0 {
+		return results, nil
+	}
+
+	if request.Rollback {
+		for i := range results {
+			if err := rollbackReplicas(sess, results[i].Guests); err != nil {
+				failed = append(failed, fmt.Sprintf("rollback of %s: %s", results[i].Datacenter, err))
+			} else {
+				results[i].RolledBack = results[i].Err == nil
+			}
+		}
+	}
+
+	return results, fmt.Errorf("Error provisioning replicas (%s)", strings.Join(failed, "; "))
+}
+
+// eligiblePods returns, for each datacenter, the first pod offering the
+// capabilities of the request
+func eligiblePods(sess *session.Session, request ReplicaRequest) (map[string]datatypes.Network_Pod, error) {
+	pods, err := services.GetNetworkPodService(sess).Mask(PodMask).Unlimited().GetAllObjects()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving pods: %s", err)
+	}
+
+	eligible := map[string]datatypes.Network_Pod{}
+	for _, pod := range pods {
+		name := sl.Get(pod.DatacenterName, "").(string)
+		if _, ok := eligible[name]; ok || !hasCapabilities(pod, request.Capabilities) {
+			continue
+		}
+		eligible[name] = pod
+	}
+
+	return eligible, nil
+}
+
+func hasCapabilities(pod datatypes.Network_Pod, capabilities []string) bool {
+	offered := map[string]bool{}
+	for _, capability := range pod.Capabilities {
+		offered[capability] = true
+	}
+
+	for _, capability := range capabilities {
+		if !offered[capability] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// replicaTemplate returns the template of the nth replica, in datacenter name
+func replicaTemplate(request ReplicaRequest, name string, pod datatypes.Network_Pod, n int) datatypes.Virtual_Guest {
+	guest := request.Template
+	guest.Hostname = sl.String(fmt.Sprintf("%s-%d", sl.Get(request.Template.Hostname, "replica"), n))
+	guest.Datacenter = &datatypes.Location{Name: sl.String(name)}
+
+	if groupId, ok := request.PlacementGroups[name]; ok {
+		guest.PlacementGroupId = sl.Int(groupId)
+	} else if len(request.Capabilities) > 0 && guest.PrimaryBackendNetworkComponent == nil && pod.BackendRouterId != nil {
+		guest.PrimaryBackendNetworkComponent = &datatypes.Virtual_Guest_Network_Component{
+			Router: &datatypes.Hardware_Router{Hardware_Switch: datatypes.Hardware_Switch{
+				Hardware: datatypes.Hardware{Id: pod.BackendRouterId},
+			}},
+		}
+	}
+
+	return guest
+}
+
+// rollbackReplicas deletes the guests provisioned
+func rollbackReplicas(sess *session.Session, guests []datatypes.Virtual_Guest) error {
+	for _, guest := range guests {
+		if guest.Id == nil {
+			continue
+		}
+
+		if _, err := services.GetVirtualGuestService(sess).Id(*guest.Id).DeleteObject(); err != nil {
+			return fmt.Errorf("Error deleting guest %d: %s", *guest.Id, err)
+		}
+	}
+
+	return nil
+}
@@ -17,15 +17,27 @@
 package virtual
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
 	"github.com/softlayer/softlayer-go/helpers/product"
 	"github.com/softlayer/softlayer-go/services"
 	"github.com/softlayer/softlayer-go/session"
 	"github.com/softlayer/softlayer-go/sl"
 )
 
+// UpgradeFlagsMask is the object mask used by UpgradeVirtualGuest and
+// UpgradeVirtualGuestWithPreset to retrieve the flags of a guest not provided
+const UpgradeFlagsMask = "privateNetworkOnlyFlag,dedicatedAccountHostOnlyFlag"
+
+// GlobalIdentifierFilter returns the object filter used by
+// GetVirtualGuestByGlobalIdentifier
+func GlobalIdentifierFilter(globalIdentifier string) string {
+	return filter.Path("virtualGuests.globalIdentifier").Eq(globalIdentifier).Build()
+}
+
 // Upgrade a virtual guest to a specified set of features (e.g. cpu, ram).
 // When the upgrade takes place can also be specified (`when`), but
 // this is optional. The time set will be 'now' if left as nil.
@@ -40,7 +52,7 @@ func UpgradeVirtualGuest(
 
 	if guest.PrivateNetworkOnlyFlag == nil || guest.DedicatedAccountHostOnlyFlag == nil {
 		service := services.GetVirtualGuestService(sess)
-		guestForFlag, err := service.Id(*guest.Id).Mask("privateNetworkOnlyFlag,dedicatedAccountHostOnlyFlag").GetObject()
+		guestForFlag, err := service.Id(*guest.Id).Mask(UpgradeFlagsMask).GetObject()
 		if err != nil {
 			return datatypes.Container_Product_Order_Receipt{}, err
 		}
@@ -105,7 +117,7 @@ func UpgradeVirtualGuestWithPreset(
 
 	if guest.PrivateNetworkOnlyFlag == nil || guest.DedicatedAccountHostOnlyFlag == nil {
 		service := services.GetVirtualGuestService(sess)
-		guestForFlag, err := service.Id(*guest.Id).Mask("privateNetworkOnlyFlag,dedicatedAccountHostOnlyFlag").GetObject()
+		guestForFlag, err := service.Id(*guest.Id).Mask(UpgradeFlagsMask).GetObject()
 		if err != nil {
 			return datatypes.Container_Product_Order_Receipt{}, err
 		}
@@ -157,3 +169,27 @@ func UpgradeVirtualGuestWithPreset(
 	orderService := services.GetProductOrderService(sess)
 	return orderService.PlaceOrder(&order, sl.Bool(false))
 }
+
+// GetVirtualGuestByGlobalIdentifier returns the virtual guest of the account
+// with the provided global identifier (UUID), or an error if none can be found.
+func GetVirtualGuestByGlobalIdentifier(sess *session.Session, globalIdentifier string, args ...interface{}) (datatypes.Virtual_Guest, error) {
+	var mask string
+	if len(args) > 0 {
+		mask = args[0].(string)
+	}
+
+	guests, err := services.GetAccountService(sess).
+		Mask(mask).
+		Filter(GlobalIdentifierFilter(globalIdentifier)).
+		GetVirtualGuests()
+	if err != nil {
+		return datatypes.Virtual_Guest{}, err
+	}
+
+	// An empty filtered result set does not raise an error
+	if len(guests) == 0 {
+		return datatypes.Virtual_Guest{}, fmt.Errorf("No virtual guest found with global identifier of %s", globalIdentifier)
+	}
+
+	return guests[0], nil
+}
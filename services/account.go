@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -47,6 +49,11 @@ func (r Account) Id(id int) Account {
 	return r
 }
 
+func (r Account) InitParameter(name string, value interface{}) Account {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account) Mask(mask string) Account {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -66,11 +73,26 @@ func (r Account) Limit(limit int) Account {
 	return r
 }
 
+func (r Account) Unlimited() Account {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account) Offset(offset int) Account {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account) Timeout(timeout time.Duration) Account {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account) WithContext(ctx context.Context) Account {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account) ActivatePartner(accountId *string, hashCode *string) (resp datatypes.Account, err error) {
 	params := []interface{}{
@@ -167,12 +189,28 @@ func (r Account) GetAbuseEmail() (resp string, err error) {
 	return
 }
 
+// GetAbuseEmailWith is GetAbuseEmail, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAbuseEmailWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAbuseEmail()
+}
+
 // Retrieve Email addresses that are responsible for abuse and legal inquiries on behalf of an account. For instance, new legal and abuse tickets are sent to these addresses.
 func (r Account) GetAbuseEmails() (resp []datatypes.Account_AbuseEmail, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAbuseEmails", nil, &r.Options, &resp)
 	return
 }
 
+// GetAbuseEmailsWith is GetAbuseEmails, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAbuseEmailsWith(options sl.Options) (resp []datatypes.Account_AbuseEmail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAbuseEmails()
+}
+
 // This method returns an array of SoftLayer_Container_Network_Storage_Evault_WebCc_JobDetails objects for the given start and end dates. Start and end dates should be be valid ISO 8601 dates. The backupStatus can be one of null, 'success', 'failed', or 'conflict'. The 'success' backupStatus returns jobs with a status of 'COMPLETED', the 'failed' backupStatus returns jobs with a status of 'FAILED', while the 'conflict' backupStatus will return jobs that are not 'COMPLETED' or 'FAILED'.
 func (r Account) GetAccountBackupHistory(startDate *datatypes.Time, endDate *datatypes.Time, backupStatus *string) (resp []datatypes.Container_Network_Storage_Evault_WebCc_JobDetails, err error) {
 	params := []interface{}{
@@ -190,12 +228,28 @@ func (r Account) GetAccountContacts() (resp []datatypes.Account_Contact, err err
 	return
 }
 
+// GetAccountContactsWith is GetAccountContacts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAccountContactsWith(options sl.Options) (resp []datatypes.Account_Contact, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountContacts()
+}
+
 // Retrieve The account software licenses owned by an account
 func (r Account) GetAccountLicenses() (resp []datatypes.Software_AccountLicense, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAccountLicenses", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountLicensesWith is GetAccountLicenses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAccountLicensesWith(options sl.Options) (resp []datatypes.Software_AccountLicense, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountLicenses()
+}
+
 // Retrieve
 func (r Account) GetAccountLinks() (resp []datatypes.Account_Link, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAccountLinks", nil, &r.Options, &resp)
@@ -208,6 +262,14 @@ func (r Account) GetAccountStatus() (resp datatypes.Account_Status, err error) {
 	return
 }
 
+// GetAccountStatusWith is GetAccountStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAccountStatusWith(options sl.Options) (resp datatypes.Account_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountStatus()
+}
+
 // This method pulls an account trait by its key.
 func (r Account) GetAccountTraitValue(keyName *string) (resp string, err error) {
 	params := []interface{}{
@@ -223,24 +285,56 @@ func (r Account) GetActiveAccountDiscountBillingItem() (resp datatypes.Billing_I
 	return
 }
 
+// GetActiveAccountDiscountBillingItemWith is GetActiveAccountDiscountBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetActiveAccountDiscountBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveAccountDiscountBillingItem()
+}
+
 // Retrieve The active account software licenses owned by an account
 func (r Account) GetActiveAccountLicenses() (resp []datatypes.Software_AccountLicense, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getActiveAccountLicenses", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveAccountLicensesWith is GetActiveAccountLicenses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetActiveAccountLicensesWith(options sl.Options) (resp []datatypes.Software_AccountLicense, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveAccountLicenses()
+}
+
 // Retrieve The active address(es) that belong to an account.
 func (r Account) GetActiveAddresses() (resp []datatypes.Account_Address, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getActiveAddresses", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveAddressesWith is GetActiveAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetActiveAddressesWith(options sl.Options) (resp []datatypes.Account_Address, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveAddresses()
+}
+
 // Retrieve All active agreements for an account
 func (r Account) GetActiveAgreements() (resp []datatypes.Account_Agreement, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getActiveAgreements", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveAgreementsWith is GetActiveAgreements, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetActiveAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveAgreements()
+}
+
 // Return all currently active alarms on this account.  Only alarms on hardware and virtual servers accessible to the current user will be returned.
 func (r Account) GetActiveAlarms() (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getActiveAlarms", nil, &r.Options, &resp)
@@ -253,6 +347,14 @@ func (r Account) GetActiveBillingAgreements() (resp []datatypes.Account_Agreemen
 	return
 }
 
+// GetActiveBillingAgreementsWith is GetActiveBillingAgreements, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetActiveBillingAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveBillingAgreements()
+}
+
 // Retrieve
 func (r Account) GetActiveCatalystEnrollment() (resp datatypes.Catalyst_Enrollment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getActiveCatalystEnrollment", nil, &r.Options, &resp)
@@ -265,12 +367,28 @@ func (r Account) GetActiveColocationContainers() (resp []datatypes.Billing_Item,
 	return
 }
 
+// GetActiveColocationContainersWith is GetActiveColocationContainers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetActiveColocationContainersWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveColocationContainers()
+}
+
 // Retrieve Account's currently active Flexible Credit enrollment.
 func (r Account) GetActiveFlexibleCreditEnrollment() (resp datatypes.FlexibleCredit_Enrollment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getActiveFlexibleCreditEnrollment", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveFlexibleCreditEnrollmentWith is GetActiveFlexibleCreditEnrollment, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetActiveFlexibleCreditEnrollmentWith(options sl.Options) (resp datatypes.FlexibleCredit_Enrollment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveFlexibleCreditEnrollment()
+}
+
 // Retrieve
 func (r Account) GetActiveNotificationSubscribers() (resp []datatypes.Notification_Subscriber, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getActiveNotificationSubscribers", nil, &r.Options, &resp)
@@ -314,36 +432,84 @@ func (r Account) GetActiveQuotes() (resp []datatypes.Billing_Order_Quote, err er
 	return
 }
 
+// GetActiveQuotesWith is GetActiveQuotes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetActiveQuotesWith(options sl.Options) (resp []datatypes.Billing_Order_Quote, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveQuotes()
+}
+
 // Retrieve Active reserved capacity agreements for an account
 func (r Account) GetActiveReservedCapacityAgreements() (resp []datatypes.Account_Agreement, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getActiveReservedCapacityAgreements", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveReservedCapacityAgreementsWith is GetActiveReservedCapacityAgreements, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetActiveReservedCapacityAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveReservedCapacityAgreements()
+}
+
 // Retrieve The virtual software licenses controlled by an account
 func (r Account) GetActiveVirtualLicenses() (resp []datatypes.Software_VirtualLicense, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getActiveVirtualLicenses", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveVirtualLicensesWith is GetActiveVirtualLicenses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetActiveVirtualLicensesWith(options sl.Options) (resp []datatypes.Software_VirtualLicense, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveVirtualLicenses()
+}
+
 // Retrieve An account's associated load balancers.
 func (r Account) GetAdcLoadBalancers() (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAdcLoadBalancers", nil, &r.Options, &resp)
 	return
 }
 
+// GetAdcLoadBalancersWith is GetAdcLoadBalancers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAdcLoadBalancersWith(options sl.Options) (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAdcLoadBalancers()
+}
+
 // Retrieve All the address(es) that belong to an account.
 func (r Account) GetAddresses() (resp []datatypes.Account_Address, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAddresses", nil, &r.Options, &resp)
 	return
 }
 
+// GetAddressesWith is GetAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAddressesWith(options sl.Options) (resp []datatypes.Account_Address, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAddresses()
+}
+
 // Retrieve An affiliate identifier associated with the customer account.
 func (r Account) GetAffiliateId() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAffiliateId", nil, &r.Options, &resp)
 	return
 }
 
+// GetAffiliateIdWith is GetAffiliateId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAffiliateIdWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAffiliateId()
+}
+
 // Returns URL uptime data for your account
 func (r Account) GetAggregatedUptimeGraph(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Container_Graph, err error) {
 	params := []interface{}{
@@ -360,54 +526,126 @@ func (r Account) GetAllBillingItems() (resp []datatypes.Billing_Item, err error)
 	return
 }
 
+// GetAllBillingItemsWith is GetAllBillingItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAllBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllBillingItems()
+}
+
 // Retrieve The billing items that will be on an account's next invoice.
 func (r Account) GetAllCommissionBillingItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAllCommissionBillingItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllCommissionBillingItemsWith is GetAllCommissionBillingItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAllCommissionBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllCommissionBillingItems()
+}
+
 // Retrieve The billing items that will be on an account's next invoice.
 func (r Account) GetAllRecurringTopLevelBillingItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAllRecurringTopLevelBillingItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllRecurringTopLevelBillingItemsWith is GetAllRecurringTopLevelBillingItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAllRecurringTopLevelBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllRecurringTopLevelBillingItems()
+}
+
 // Retrieve The billing items that will be on an account's next invoice. Does not consider associated items.
 func (r Account) GetAllRecurringTopLevelBillingItemsUnfiltered() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAllRecurringTopLevelBillingItemsUnfiltered", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllRecurringTopLevelBillingItemsUnfilteredWith is GetAllRecurringTopLevelBillingItemsUnfiltered, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAllRecurringTopLevelBillingItemsUnfilteredWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllRecurringTopLevelBillingItemsUnfiltered()
+}
+
 // Retrieve The billing items that will be on an account's next invoice.
 func (r Account) GetAllSubnetBillingItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAllSubnetBillingItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllSubnetBillingItemsWith is GetAllSubnetBillingItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAllSubnetBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllSubnetBillingItems()
+}
+
 // Retrieve All billing items of an account.
 func (r Account) GetAllTopLevelBillingItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAllTopLevelBillingItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllTopLevelBillingItemsWith is GetAllTopLevelBillingItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAllTopLevelBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllTopLevelBillingItems()
+}
+
 // Retrieve The billing items that will be on an account's next invoice. Does not consider associated items.
 func (r Account) GetAllTopLevelBillingItemsUnfiltered() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAllTopLevelBillingItemsUnfiltered", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllTopLevelBillingItemsUnfilteredWith is GetAllTopLevelBillingItemsUnfiltered, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAllTopLevelBillingItemsUnfilteredWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllTopLevelBillingItemsUnfiltered()
+}
+
 // Retrieve Indicates whether this account is allowed to silently migrate to use IBMid Authentication.
 func (r Account) GetAllowIbmIdSilentMigrationFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAllowIbmIdSilentMigrationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowIbmIdSilentMigrationFlagWith is GetAllowIbmIdSilentMigrationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAllowIbmIdSilentMigrationFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowIbmIdSilentMigrationFlag()
+}
+
 // Retrieve Flag indicating if this account can be linked with Bluemix.
 func (r Account) GetAllowsBluemixAccountLinkingFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAllowsBluemixAccountLinkingFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowsBluemixAccountLinkingFlagWith is GetAllowsBluemixAccountLinkingFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAllowsBluemixAccountLinkingFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowsBluemixAccountLinkingFlag()
+}
+
 // no documentation yet
 func (r Account) GetAlternateCreditCardData() (resp datatypes.Container_Account_Payment_Method_CreditCard, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAlternateCreditCardData", nil, &r.Options, &resp)
@@ -420,6 +658,14 @@ func (r Account) GetApplicationDeliveryControllers() (resp []datatypes.Network_A
 	return
 }
 
+// GetApplicationDeliveryControllersWith is GetApplicationDeliveryControllers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetApplicationDeliveryControllersWith(options sl.Options) (resp []datatypes.Network_Application_Delivery_Controller, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetApplicationDeliveryControllers()
+}
+
 // Retrieve a single [[SoftLayer_Account_Attribute]] record by its [[SoftLayer_Account_Attribute_Type|types's]] key name.
 func (r Account) GetAttributeByType(attributeType *string) (resp datatypes.Account_Attribute, err error) {
 	params := []interface{}{
@@ -435,6 +681,14 @@ func (r Account) GetAttributes() (resp []datatypes.Account_Attribute, err error)
 	return
 }
 
+// GetAttributesWith is GetAttributes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAttributesWith(options sl.Options) (resp []datatypes.Account_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+}
+
 // no documentation yet
 func (r Account) GetAuxiliaryNotifications() (resp []datatypes.Container_Utility_Message, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getAuxiliaryNotifications", nil, &r.Options, &resp)
@@ -447,6 +701,14 @@ func (r Account) GetAvailablePublicNetworkVlans() (resp []datatypes.Network_Vlan
 	return
 }
 
+// GetAvailablePublicNetworkVlansWith is GetAvailablePublicNetworkVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetAvailablePublicNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAvailablePublicNetworkVlans()
+}
+
 // Returns the average disk space usage for all archive repositories.
 func (r Account) GetAverageArchiveUsageMetricDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -473,60 +735,140 @@ func (r Account) GetBalance() (resp datatypes.Float64, err error) {
 	return
 }
 
+// GetBalanceWith is GetBalance, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetBalanceWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBalance()
+}
+
 // Retrieve The bandwidth allotments for an account.
 func (r Account) GetBandwidthAllotments() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getBandwidthAllotments", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllotmentsWith is GetBandwidthAllotments, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetBandwidthAllotmentsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotments()
+}
+
 // Retrieve The bandwidth allotments for an account currently over allocation.
 func (r Account) GetBandwidthAllotmentsOverAllocation() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getBandwidthAllotmentsOverAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllotmentsOverAllocationWith is GetBandwidthAllotmentsOverAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetBandwidthAllotmentsOverAllocationWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotmentsOverAllocation()
+}
+
 // Retrieve The bandwidth allotments for an account projected to go over allocation.
 func (r Account) GetBandwidthAllotmentsProjectedOverAllocation() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getBandwidthAllotmentsProjectedOverAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllotmentsProjectedOverAllocationWith is GetBandwidthAllotmentsProjectedOverAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetBandwidthAllotmentsProjectedOverAllocationWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotmentsProjectedOverAllocation()
+}
+
 // Retrieve An account's associated bare metal server objects.
 func (r Account) GetBareMetalInstances() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getBareMetalInstances", nil, &r.Options, &resp)
 	return
 }
 
+// GetBareMetalInstancesWith is GetBareMetalInstances, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetBareMetalInstancesWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBareMetalInstances()
+}
+
 // Retrieve All billing agreements for an account
 func (r Account) GetBillingAgreements() (resp []datatypes.Account_Agreement, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getBillingAgreements", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingAgreementsWith is GetBillingAgreements, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetBillingAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingAgreements()
+}
+
 // Retrieve An account's billing information.
 func (r Account) GetBillingInfo() (resp datatypes.Billing_Info, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getBillingInfo", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingInfoWith is GetBillingInfo, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetBillingInfoWith(options sl.Options) (resp datatypes.Billing_Info, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingInfo()
+}
+
 // Retrieve Private template group objects (parent and children) and the shared template group objects (parent only) for an account.
 func (r Account) GetBlockDeviceTemplateGroups() (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getBlockDeviceTemplateGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetBlockDeviceTemplateGroupsWith is GetBlockDeviceTemplateGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetBlockDeviceTemplateGroupsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockDeviceTemplateGroups()
+}
+
 // Retrieve The Bluemix account link associated with this SoftLayer account, if one exists.
 func (r Account) GetBluemixAccountLink() (resp datatypes.Account_Link_Bluemix, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getBluemixAccountLink", nil, &r.Options, &resp)
 	return
 }
 
+// GetBluemixAccountLinkWith is GetBluemixAccountLink, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetBluemixAccountLinkWith(options sl.Options) (resp datatypes.Account_Link_Bluemix, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBluemixAccountLink()
+}
+
 // Retrieve Returns true if this account is linked to IBM Bluemix, false if not.
 func (r Account) GetBluemixLinkedFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getBluemixLinkedFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBluemixLinkedFlagWith is GetBluemixLinkedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetBluemixLinkedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBluemixLinkedFlag()
+}
+
 // Retrieve
 func (r Account) GetBrand() (resp datatypes.Brand, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getBrand", nil, &r.Options, &resp)
@@ -545,24 +887,56 @@ func (r Account) GetBrandKeyName() (resp string, err error) {
 	return
 }
 
+// GetBrandKeyNameWith is GetBrandKeyName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetBrandKeyNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBrandKeyName()
+}
+
 // Retrieve The Business Partner details for the account. Country Enterprise Code, Channel, Segment, Reseller Level.
 func (r Account) GetBusinessPartner() (resp datatypes.Account_Business_Partner, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getBusinessPartner", nil, &r.Options, &resp)
 	return
 }
 
+// GetBusinessPartnerWith is GetBusinessPartner, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetBusinessPartnerWith(options sl.Options) (resp datatypes.Account_Business_Partner, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBusinessPartner()
+}
+
 // Retrieve Indicating whether this account can order additional Vlans.
 func (r Account) GetCanOrderAdditionalVlansFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getCanOrderAdditionalVlansFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetCanOrderAdditionalVlansFlagWith is GetCanOrderAdditionalVlansFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetCanOrderAdditionalVlansFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCanOrderAdditionalVlansFlag()
+}
+
 // Retrieve An account's active carts.
 func (r Account) GetCarts() (resp []datatypes.Billing_Order_Quote, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getCarts", nil, &r.Options, &resp)
 	return
 }
 
+// GetCartsWith is GetCarts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetCartsWith(options sl.Options) (resp []datatypes.Billing_Order_Quote, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCarts()
+}
+
 // Retrieve
 func (r Account) GetCatalystEnrollments() (resp []datatypes.Catalyst_Enrollment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getCatalystEnrollments", nil, &r.Options, &resp)
@@ -575,12 +949,28 @@ func (r Account) GetCdnAccounts() (resp []datatypes.Network_ContentDelivery_Acco
 	return
 }
 
+// GetCdnAccountsWith is GetCdnAccounts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetCdnAccountsWith(options sl.Options) (resp []datatypes.Network_ContentDelivery_Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCdnAccounts()
+}
+
 // Retrieve All closed tickets associated with an account.
 func (r Account) GetClosedTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getClosedTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetClosedTicketsWith is GetClosedTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetClosedTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetClosedTickets()
+}
+
 // This method returns a SoftLayer_Container_Account_Graph_Outputs containing a base64 string PNG image. The optional parameter, detailedGraph, can be passed to get a more detailed graph.
 func (r Account) GetCurrentBackupStatisticsGraph(detailedGraph *bool) (resp datatypes.Container_Account_Graph_Outputs, err error) {
 	params := []interface{}{
@@ -611,12 +1001,28 @@ func (r Account) GetDatacentersWithSubnetAllocations() (resp []datatypes.Locatio
 	return
 }
 
+// GetDatacentersWithSubnetAllocationsWith is GetDatacentersWithSubnetAllocations, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetDatacentersWithSubnetAllocationsWith(options sl.Options) (resp []datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacentersWithSubnetAllocations()
+}
+
 // Retrieve An account's associated virtual dedicated host objects.
 func (r Account) GetDedicatedHosts() (resp []datatypes.Virtual_DedicatedHost, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getDedicatedHosts", nil, &r.Options, &resp)
 	return
 }
 
+// GetDedicatedHostsWith is GetDedicatedHosts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetDedicatedHostsWith(options sl.Options) (resp []datatypes.Virtual_DedicatedHost, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDedicatedHosts()
+}
+
 // This returns a collection of dedicated hosts that are valid for a given image template.
 func (r Account) GetDedicatedHostsForImageTemplate(imageTemplateId *int) (resp []datatypes.Virtual_DedicatedHost, err error) {
 	params := []interface{}{
@@ -632,6 +1038,14 @@ func (r Account) GetDisablePaymentProcessingFlag() (resp bool, err error) {
 	return
 }
 
+// GetDisablePaymentProcessingFlagWith is GetDisablePaymentProcessingFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetDisablePaymentProcessingFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDisablePaymentProcessingFlag()
+}
+
 // Retrieve disk usage data on a [[SoftLayer_Virtual_Guest|Cloud Computing Instance]] image for the time range you provide from the Metric Tracking Object System and Legacy Data Warehouse. Each data entry objects contain ''dateTime'' and ''counter'' properties. ''dateTime'' property indicates the time that the disk usage data was measured and ''counter'' property holds the disk usage in bytes.
 func (r Account) GetDiskUsageMetricDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -678,6 +1092,14 @@ func (r Account) GetDisplaySupportRepresentativeAssignments() (resp []datatypes.
 	return
 }
 
+// GetDisplaySupportRepresentativeAssignmentsWith is GetDisplaySupportRepresentativeAssignments, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetDisplaySupportRepresentativeAssignmentsWith(options sl.Options) (resp []datatypes.Account_Attachment_Employee, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDisplaySupportRepresentativeAssignments()
+}
+
 // Retrieve
 func (r Account) GetDomainRegistrations() (resp []datatypes.Dns_Domain_Registration, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getDomainRegistrations", nil, &r.Options, &resp)
@@ -690,36 +1112,84 @@ func (r Account) GetDomains() (resp []datatypes.Dns_Domain, err error) {
 	return
 }
 
+// GetDomainsWith is GetDomains, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetDomainsWith(options sl.Options) (resp []datatypes.Dns_Domain, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDomains()
+}
+
 // Retrieve The DNS domains associated with an account that were not created as a result of a secondary DNS zone transfer.
 func (r Account) GetDomainsWithoutSecondaryDnsRecords() (resp []datatypes.Dns_Domain, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getDomainsWithoutSecondaryDnsRecords", nil, &r.Options, &resp)
 	return
 }
 
+// GetDomainsWithoutSecondaryDnsRecordsWith is GetDomainsWithoutSecondaryDnsRecords, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetDomainsWithoutSecondaryDnsRecordsWith(options sl.Options) (resp []datatypes.Dns_Domain, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDomainsWithoutSecondaryDnsRecords()
+}
+
 // Retrieve Boolean flag dictating whether or not this account has the EU Supported flag. This flag indicates that this account uses IBM Cloud services to process EU citizen's personal data.
 func (r Account) GetEuSupportedFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getEuSupportedFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetEuSupportedFlagWith is GetEuSupportedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetEuSupportedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEuSupportedFlag()
+}
+
 // Retrieve The total capacity of Legacy EVault Volumes on an account, in GB.
 func (r Account) GetEvaultCapacityGB() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getEvaultCapacityGB", nil, &r.Options, &resp)
 	return
 }
 
+// GetEvaultCapacityGBWith is GetEvaultCapacityGB, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetEvaultCapacityGBWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvaultCapacityGB()
+}
+
 // Retrieve An account's master EVault user. This is only used when an account has EVault service.
 func (r Account) GetEvaultMasterUsers() (resp []datatypes.Account_Password, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getEvaultMasterUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetEvaultMasterUsersWith is GetEvaultMasterUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetEvaultMasterUsersWith(options sl.Options) (resp []datatypes.Account_Password, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvaultMasterUsers()
+}
+
 // Retrieve An account's associated EVault storage volumes.
 func (r Account) GetEvaultNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getEvaultNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetEvaultNetworkStorageWith is GetEvaultNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetEvaultNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvaultNetworkStorage()
+}
+
 // This method will return a PDF of the specified report, with the specified period within the start and end dates. The pdfType must be one of 'snapshot', or 'historical'. Possible historicalType parameters are 'monthly', 'yearly', and 'quarterly'. Start and end dates should be in ISO 8601 date format.
 func (r Account) GetExecutiveSummaryPdf(pdfType *string, historicalType *string, startDate *string, endDate *string) (resp []byte, err error) {
 	params := []interface{}{
@@ -738,18 +1208,42 @@ func (r Account) GetExpiredSecurityCertificates() (resp []datatypes.Security_Cer
 	return
 }
 
+// GetExpiredSecurityCertificatesWith is GetExpiredSecurityCertificates, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetExpiredSecurityCertificatesWith(options sl.Options) (resp []datatypes.Security_Certificate, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetExpiredSecurityCertificates()
+}
+
 // Retrieve Logs of who entered a colocation area which is assigned to this account, or when a user under this account enters a datacenter.
 func (r Account) GetFacilityLogs() (resp []datatypes.User_Access_Facility_Log, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getFacilityLogs", nil, &r.Options, &resp)
 	return
 }
 
+// GetFacilityLogsWith is GetFacilityLogs, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetFacilityLogsWith(options sl.Options) (resp []datatypes.User_Access_Facility_Log, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFacilityLogs()
+}
+
 // Retrieve All of the account's current and former Flexible Credit enrollments.
 func (r Account) GetFlexibleCreditEnrollments() (resp []datatypes.FlexibleCredit_Enrollment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getFlexibleCreditEnrollments", nil, &r.Options, &resp)
 	return
 }
 
+// GetFlexibleCreditEnrollmentsWith is GetFlexibleCreditEnrollments, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetFlexibleCreditEnrollmentsWith(options sl.Options) (resp []datatypes.FlexibleCredit_Enrollment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFlexibleCreditEnrollments()
+}
+
 // This method will return a [[SoftLayer_Container_Account_Discount_Program]] object containing the Flexible Credit Program information for this account. To be considered an active participant, the account must have an enrollment record with a monthly credit amount set and the current date must be within the range defined by the enrollment and graduation date. The forNextBillCycle parameter can be set to true to return a SoftLayer_Container_Account_Discount_Program object with information with relation to the next bill cycle. The forNextBillCycle parameter defaults to false. Please note that all discount amount entries are reported as pre-tax amounts and the legacy tax fields in the [[SoftLayer_Container_Account_Discount_Program]] are deprecated.
 func (r Account) GetFlexibleCreditProgramInfo(forNextBillCycle *bool) (resp datatypes.Container_Account_Discount_Program, err error) {
 	params := []interface{}{
@@ -765,6 +1259,14 @@ func (r Account) GetForcePaasAccountLinkDate() (resp string, err error) {
 	return
 }
 
+// GetForcePaasAccountLinkDateWith is GetForcePaasAccountLinkDate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetForcePaasAccountLinkDateWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetForcePaasAccountLinkDate()
+}
+
 // Retrieve
 func (r Account) GetGlobalIpRecords() (resp []datatypes.Network_Subnet_IpAddress_Global, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getGlobalIpRecords", nil, &r.Options, &resp)
@@ -789,18 +1291,42 @@ func (r Account) GetGlobalLoadBalancerAccounts() (resp []datatypes.Network_LoadB
 	return
 }
 
+// GetGlobalLoadBalancerAccountsWith is GetGlobalLoadBalancerAccounts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetGlobalLoadBalancerAccountsWith(options sl.Options) (resp []datatypes.Network_LoadBalancer_Global_Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGlobalLoadBalancerAccounts()
+}
+
 // Retrieve An account's associated hardware objects.
 func (r Account) GetHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve An account's associated hardware objects currently over bandwidth allocation.
 func (r Account) GetHardwareOverBandwidthAllocation() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHardwareOverBandwidthAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareOverBandwidthAllocationWith is GetHardwareOverBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHardwareOverBandwidthAllocationWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareOverBandwidthAllocation()
+}
+
 // Return a collection of managed hardware pools.
 func (r Account) GetHardwarePools() (resp []datatypes.Container_Hardware_Pool_Details, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHardwarePools", nil, &r.Options, &resp)
@@ -813,90 +1339,210 @@ func (r Account) GetHardwareProjectedOverBandwidthAllocation() (resp []datatypes
 	return
 }
 
+// GetHardwareProjectedOverBandwidthAllocationWith is GetHardwareProjectedOverBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHardwareProjectedOverBandwidthAllocationWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareProjectedOverBandwidthAllocation()
+}
+
 // Retrieve All hardware associated with an account that has the cPanel web hosting control panel installed.
 func (r Account) GetHardwareWithCpanel() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHardwareWithCpanel", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWithCpanelWith is GetHardwareWithCpanel, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHardwareWithCpanelWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithCpanel()
+}
+
 // Retrieve All hardware associated with an account that has the Helm web hosting control panel installed.
 func (r Account) GetHardwareWithHelm() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHardwareWithHelm", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWithHelmWith is GetHardwareWithHelm, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHardwareWithHelmWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithHelm()
+}
+
 // Retrieve All hardware associated with an account that has McAfee Secure software components.
 func (r Account) GetHardwareWithMcafee() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHardwareWithMcafee", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWithMcafeeWith is GetHardwareWithMcafee, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHardwareWithMcafeeWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithMcafee()
+}
+
 // Retrieve All hardware associated with an account that has McAfee Secure AntiVirus for Redhat software components.
 func (r Account) GetHardwareWithMcafeeAntivirusRedhat() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHardwareWithMcafeeAntivirusRedhat", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWithMcafeeAntivirusRedhatWith is GetHardwareWithMcafeeAntivirusRedhat, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHardwareWithMcafeeAntivirusRedhatWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithMcafeeAntivirusRedhat()
+}
+
 // Retrieve All hardware associated with an account that has McAfee Secure AntiVirus for Windows software components.
 func (r Account) GetHardwareWithMcafeeAntivirusWindows() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHardwareWithMcafeeAntivirusWindows", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWithMcafeeAntivirusWindowsWith is GetHardwareWithMcafeeAntivirusWindows, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHardwareWithMcafeeAntivirusWindowsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithMcafeeAntivirusWindows()
+}
+
 // Retrieve All hardware associated with an account that has McAfee Secure Intrusion Detection System software components.
 func (r Account) GetHardwareWithMcafeeIntrusionDetectionSystem() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHardwareWithMcafeeIntrusionDetectionSystem", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWithMcafeeIntrusionDetectionSystemWith is GetHardwareWithMcafeeIntrusionDetectionSystem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHardwareWithMcafeeIntrusionDetectionSystemWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithMcafeeIntrusionDetectionSystem()
+}
+
 // Retrieve All hardware associated with an account that has the Plesk web hosting control panel installed.
 func (r Account) GetHardwareWithPlesk() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHardwareWithPlesk", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWithPleskWith is GetHardwareWithPlesk, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHardwareWithPleskWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithPlesk()
+}
+
 // Retrieve All hardware associated with an account that has the QuantaStor storage system installed.
 func (r Account) GetHardwareWithQuantastor() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHardwareWithQuantastor", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWithQuantastorWith is GetHardwareWithQuantastor, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHardwareWithQuantastorWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithQuantastor()
+}
+
 // Retrieve All hardware associated with an account that has the Urchin web traffic analytics package installed.
 func (r Account) GetHardwareWithUrchin() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHardwareWithUrchin", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWithUrchinWith is GetHardwareWithUrchin, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHardwareWithUrchinWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithUrchin()
+}
+
 // Retrieve All hardware associated with an account that is running a version of the Microsoft Windows operating system.
 func (r Account) GetHardwareWithWindows() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHardwareWithWindows", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWithWindowsWith is GetHardwareWithWindows, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHardwareWithWindowsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithWindows()
+}
+
 // Retrieve Return 1 if one of the account's hardware has the EVault Bare Metal Server Restore Plugin otherwise 0.
 func (r Account) GetHasEvaultBareMetalRestorePluginFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHasEvaultBareMetalRestorePluginFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasEvaultBareMetalRestorePluginFlagWith is GetHasEvaultBareMetalRestorePluginFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHasEvaultBareMetalRestorePluginFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasEvaultBareMetalRestorePluginFlag()
+}
+
 // Retrieve Return 1 if one of the account's hardware has an installation of Idera Server Backup otherwise 0.
 func (r Account) GetHasIderaBareMetalRestorePluginFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHasIderaBareMetalRestorePluginFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasIderaBareMetalRestorePluginFlagWith is GetHasIderaBareMetalRestorePluginFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHasIderaBareMetalRestorePluginFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasIderaBareMetalRestorePluginFlag()
+}
+
 // Retrieve The number of orders in a PENDING status for a SoftLayer customer account.
 func (r Account) GetHasPendingOrder() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHasPendingOrder", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasPendingOrderWith is GetHasPendingOrder, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHasPendingOrderWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasPendingOrder()
+}
+
 // Retrieve Return 1 if one of the account's hardware has an installation of R1Soft CDP otherwise 0.
 func (r Account) GetHasR1softBareMetalRestorePluginFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHasR1softBareMetalRestorePluginFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasR1softBareMetalRestorePluginFlagWith is GetHasR1softBareMetalRestorePluginFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHasR1softBareMetalRestorePluginFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasR1softBareMetalRestorePluginFlag()
+}
+
 // no documentation yet
 func (r Account) GetHistoricalBackupGraph(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Container_Account_Graph_Outputs, err error) {
 	params := []interface{}{
@@ -943,48 +1589,112 @@ func (r Account) GetHourlyBareMetalInstances() (resp []datatypes.Hardware, err e
 	return
 }
 
+// GetHourlyBareMetalInstancesWith is GetHourlyBareMetalInstances, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHourlyBareMetalInstancesWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyBareMetalInstances()
+}
+
 // Retrieve Hourly service billing items that will be on an account's next invoice.
 func (r Account) GetHourlyServiceBillingItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHourlyServiceBillingItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetHourlyServiceBillingItemsWith is GetHourlyServiceBillingItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHourlyServiceBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyServiceBillingItems()
+}
+
 // Retrieve An account's associated hourly virtual guest objects.
 func (r Account) GetHourlyVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHourlyVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetHourlyVirtualGuestsWith is GetHourlyVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHourlyVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyVirtualGuests()
+}
+
 // Retrieve An account's associated Virtual Storage volumes.
 func (r Account) GetHubNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getHubNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetHubNetworkStorageWith is GetHubNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetHubNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHubNetworkStorage()
+}
+
 // Retrieve Unique identifier for a customer used throughout IBM.
 func (r Account) GetIbmCustomerNumber() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getIbmCustomerNumber", nil, &r.Options, &resp)
 	return
 }
 
+// GetIbmCustomerNumberWith is GetIbmCustomerNumber, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetIbmCustomerNumberWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIbmCustomerNumber()
+}
+
 // Retrieve Indicates whether this account requires IBMid authentication.
 func (r Account) GetIbmIdAuthenticationRequiredFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getIbmIdAuthenticationRequiredFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetIbmIdAuthenticationRequiredFlagWith is GetIbmIdAuthenticationRequiredFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetIbmIdAuthenticationRequiredFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIbmIdAuthenticationRequiredFlag()
+}
+
 // Retrieve Timestamp representing the point in time when an account is required to use IBMid authentication.
 func (r Account) GetIbmIdMigrationExpirationTimestamp() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getIbmIdMigrationExpirationTimestamp", nil, &r.Options, &resp)
 	return
 }
 
+// GetIbmIdMigrationExpirationTimestampWith is GetIbmIdMigrationExpirationTimestamp, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetIbmIdMigrationExpirationTimestampWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIbmIdMigrationExpirationTimestamp()
+}
+
 // Retrieve An in progress request to switch billing systems.
 func (r Account) GetInProgressExternalAccountSetup() (resp datatypes.Account_External_Setup, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getInProgressExternalAccountSetup", nil, &r.Options, &resp)
 	return
 }
 
+// GetInProgressExternalAccountSetupWith is GetInProgressExternalAccountSetup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetInProgressExternalAccountSetupWith(options sl.Options) (resp datatypes.Account_External_Setup, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInProgressExternalAccountSetup()
+}
+
 // Retrieve
 func (r Account) GetInternalNotes() (resp []datatypes.Account_Note, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getInternalNotes", nil, &r.Options, &resp)
@@ -997,6 +1707,14 @@ func (r Account) GetInvoices() (resp []datatypes.Billing_Invoice, err error) {
 	return
 }
 
+// GetInvoicesWith is GetInvoices, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetInvoicesWith(options sl.Options) (resp []datatypes.Billing_Invoice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoices()
+}
+
 // Retrieve
 func (r Account) GetIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getIpAddresses", nil, &r.Options, &resp)
@@ -1009,6 +1727,14 @@ func (r Account) GetIscsiNetworkStorage() (resp []datatypes.Network_Storage, err
 	return
 }
 
+// GetIscsiNetworkStorageWith is GetIscsiNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetIscsiNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIscsiNetworkStorage()
+}
+
 // Computes the number of available public secondary IP addresses, aligned to a subnet size.
 func (r Account) GetLargestAllowedSubnetCidr(numberOfHosts *int, locationId *int) (resp int, err error) {
 	params := []interface{}{
@@ -1025,96 +1751,224 @@ func (r Account) GetLastCanceledBillingItem() (resp datatypes.Billing_Item, err
 	return
 }
 
+// GetLastCanceledBillingItemWith is GetLastCanceledBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLastCanceledBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastCanceledBillingItem()
+}
+
 // Retrieve The most recent cancelled server billing item.
 func (r Account) GetLastCancelledServerBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLastCancelledServerBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastCancelledServerBillingItemWith is GetLastCancelledServerBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLastCancelledServerBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastCancelledServerBillingItem()
+}
+
 // Retrieve The five most recently closed abuse tickets associated with an account.
 func (r Account) GetLastFiveClosedAbuseTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLastFiveClosedAbuseTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastFiveClosedAbuseTicketsWith is GetLastFiveClosedAbuseTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLastFiveClosedAbuseTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastFiveClosedAbuseTickets()
+}
+
 // Retrieve The five most recently closed accounting tickets associated with an account.
 func (r Account) GetLastFiveClosedAccountingTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLastFiveClosedAccountingTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastFiveClosedAccountingTicketsWith is GetLastFiveClosedAccountingTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLastFiveClosedAccountingTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastFiveClosedAccountingTickets()
+}
+
 // Retrieve The five most recently closed tickets that do not belong to the abuse, accounting, sales, or support groups associated with an account.
 func (r Account) GetLastFiveClosedOtherTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLastFiveClosedOtherTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastFiveClosedOtherTicketsWith is GetLastFiveClosedOtherTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLastFiveClosedOtherTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastFiveClosedOtherTickets()
+}
+
 // Retrieve The five most recently closed sales tickets associated with an account.
 func (r Account) GetLastFiveClosedSalesTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLastFiveClosedSalesTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastFiveClosedSalesTicketsWith is GetLastFiveClosedSalesTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLastFiveClosedSalesTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastFiveClosedSalesTickets()
+}
+
 // Retrieve The five most recently closed support tickets associated with an account.
 func (r Account) GetLastFiveClosedSupportTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLastFiveClosedSupportTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastFiveClosedSupportTicketsWith is GetLastFiveClosedSupportTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLastFiveClosedSupportTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastFiveClosedSupportTickets()
+}
+
 // Retrieve The five most recently closed tickets associated with an account.
 func (r Account) GetLastFiveClosedTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLastFiveClosedTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastFiveClosedTicketsWith is GetLastFiveClosedTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLastFiveClosedTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastFiveClosedTickets()
+}
+
 // Retrieve An account's most recent billing date.
 func (r Account) GetLatestBillDate() (resp datatypes.Time, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLatestBillDate", nil, &r.Options, &resp)
 	return
 }
 
+// GetLatestBillDateWith is GetLatestBillDate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLatestBillDateWith(options sl.Options) (resp datatypes.Time, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestBillDate()
+}
+
 // Retrieve An account's latest recurring invoice.
 func (r Account) GetLatestRecurringInvoice() (resp datatypes.Billing_Invoice, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLatestRecurringInvoice", nil, &r.Options, &resp)
 	return
 }
 
+// GetLatestRecurringInvoiceWith is GetLatestRecurringInvoice, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLatestRecurringInvoiceWith(options sl.Options) (resp datatypes.Billing_Invoice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestRecurringInvoice()
+}
+
 // Retrieve An account's latest recurring pending invoice.
 func (r Account) GetLatestRecurringPendingInvoice() (resp datatypes.Billing_Invoice, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLatestRecurringPendingInvoice", nil, &r.Options, &resp)
 	return
 }
 
+// GetLatestRecurringPendingInvoiceWith is GetLatestRecurringPendingInvoice, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLatestRecurringPendingInvoiceWith(options sl.Options) (resp datatypes.Billing_Invoice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestRecurringPendingInvoice()
+}
+
 // Retrieve The legacy bandwidth allotments for an account.
 func (r Account) GetLegacyBandwidthAllotments() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLegacyBandwidthAllotments", nil, &r.Options, &resp)
 	return
 }
 
+// GetLegacyBandwidthAllotmentsWith is GetLegacyBandwidthAllotments, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLegacyBandwidthAllotmentsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLegacyBandwidthAllotments()
+}
+
 // Retrieve The total capacity of Legacy iSCSI Volumes on an account, in GB.
 func (r Account) GetLegacyIscsiCapacityGB() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLegacyIscsiCapacityGB", nil, &r.Options, &resp)
 	return
 }
 
+// GetLegacyIscsiCapacityGBWith is GetLegacyIscsiCapacityGB, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLegacyIscsiCapacityGBWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLegacyIscsiCapacityGB()
+}
+
 // Retrieve An account's associated load balancers.
 func (r Account) GetLoadBalancers() (resp []datatypes.Network_LoadBalancer_VirtualIpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLoadBalancers", nil, &r.Options, &resp)
 	return
 }
 
+// GetLoadBalancersWith is GetLoadBalancers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLoadBalancersWith(options sl.Options) (resp []datatypes.Network_LoadBalancer_VirtualIpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLoadBalancers()
+}
+
 // Retrieve The total capacity of Legacy lockbox Volumes on an account, in GB.
 func (r Account) GetLockboxCapacityGB() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLockboxCapacityGB", nil, &r.Options, &resp)
 	return
 }
 
+// GetLockboxCapacityGBWith is GetLockboxCapacityGB, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLockboxCapacityGBWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLockboxCapacityGB()
+}
+
 // Retrieve An account's associated Lockbox storage volumes.
 func (r Account) GetLockboxNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getLockboxNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetLockboxNetworkStorageWith is GetLockboxNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetLockboxNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLockboxNetworkStorage()
+}
+
 // Retrieve
 func (r Account) GetManualPaymentsUnderReview() (resp []datatypes.Billing_Payment_Card_ManualPayment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getManualPaymentsUnderReview", nil, &r.Options, &resp)
@@ -1127,30 +1981,70 @@ func (r Account) GetMasterUser() (resp datatypes.User_Customer, err error) {
 	return
 }
 
+// GetMasterUserWith is GetMasterUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetMasterUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMasterUser()
+}
+
 // Retrieve An account's media transfer service requests.
 func (r Account) GetMediaDataTransferRequests() (resp []datatypes.Account_Media_Data_Transfer_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getMediaDataTransferRequests", nil, &r.Options, &resp)
 	return
 }
 
+// GetMediaDataTransferRequestsWith is GetMediaDataTransferRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetMediaDataTransferRequestsWith(options sl.Options) (resp []datatypes.Account_Media_Data_Transfer_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMediaDataTransferRequests()
+}
+
 // Retrieve An account's associated monthly bare metal server objects.
 func (r Account) GetMonthlyBareMetalInstances() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getMonthlyBareMetalInstances", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonthlyBareMetalInstancesWith is GetMonthlyBareMetalInstances, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetMonthlyBareMetalInstancesWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonthlyBareMetalInstances()
+}
+
 // Retrieve An account's associated monthly virtual guest objects.
 func (r Account) GetMonthlyVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getMonthlyVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonthlyVirtualGuestsWith is GetMonthlyVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetMonthlyVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonthlyVirtualGuests()
+}
+
 // Retrieve An account's associated NAS storage volumes.
 func (r Account) GetNasNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNasNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetNasNetworkStorageWith is GetNasNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNasNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNasNetworkStorage()
+}
+
 // This returns a collection of active NetApp software account license keys.
 func (r Account) GetNetAppActiveAccountLicenseKeys() (resp []string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNetAppActiveAccountLicenseKeys", nil, &r.Options, &resp)
@@ -1163,18 +2057,42 @@ func (r Account) GetNetworkCreationFlag() (resp bool, err error) {
 	return
 }
 
+// GetNetworkCreationFlagWith is GetNetworkCreationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNetworkCreationFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkCreationFlag()
+}
+
 // Retrieve All network gateway devices on this account.
 func (r Account) GetNetworkGateways() (resp []datatypes.Network_Gateway, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNetworkGateways", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkGatewaysWith is GetNetworkGateways, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNetworkGatewaysWith(options sl.Options) (resp []datatypes.Network_Gateway, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGateways()
+}
+
 // Retrieve An account's associated network hardware.
 func (r Account) GetNetworkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNetworkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkHardwareWith is GetNetworkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkHardware()
+}
+
 // Retrieve
 func (r Account) GetNetworkMessageDeliveryAccounts() (resp []datatypes.Network_Message_Delivery, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNetworkMessageDeliveryAccounts", nil, &r.Options, &resp)
@@ -1187,72 +2105,168 @@ func (r Account) GetNetworkMonitorDownHardware() (resp []datatypes.Hardware, err
 	return
 }
 
+// GetNetworkMonitorDownHardwareWith is GetNetworkMonitorDownHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNetworkMonitorDownHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorDownHardware()
+}
+
 // Retrieve Virtual guest which is currently experiencing a service failure.
 func (r Account) GetNetworkMonitorDownVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNetworkMonitorDownVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorDownVirtualGuestsWith is GetNetworkMonitorDownVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNetworkMonitorDownVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorDownVirtualGuests()
+}
+
 // Retrieve Hardware which is currently recovering from a service failure.
 func (r Account) GetNetworkMonitorRecoveringHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNetworkMonitorRecoveringHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorRecoveringHardwareWith is GetNetworkMonitorRecoveringHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNetworkMonitorRecoveringHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorRecoveringHardware()
+}
+
 // Retrieve Virtual guest which is currently recovering from a service failure.
 func (r Account) GetNetworkMonitorRecoveringVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNetworkMonitorRecoveringVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorRecoveringVirtualGuestsWith is GetNetworkMonitorRecoveringVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNetworkMonitorRecoveringVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorRecoveringVirtualGuests()
+}
+
 // Retrieve Hardware which is currently online.
 func (r Account) GetNetworkMonitorUpHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNetworkMonitorUpHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorUpHardwareWith is GetNetworkMonitorUpHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNetworkMonitorUpHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorUpHardware()
+}
+
 // Retrieve Virtual guest which is currently online.
 func (r Account) GetNetworkMonitorUpVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNetworkMonitorUpVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorUpVirtualGuestsWith is GetNetworkMonitorUpVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNetworkMonitorUpVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorUpVirtualGuests()
+}
+
 // Retrieve An account's associated storage volumes. This includes Lockbox, NAS, EVault, and iSCSI volumes.
 func (r Account) GetNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStorageWith is GetNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStorage()
+}
+
 // Retrieve An account's Network Storage groups.
 func (r Account) GetNetworkStorageGroups() (resp []datatypes.Network_Storage_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNetworkStorageGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStorageGroupsWith is GetNetworkStorageGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNetworkStorageGroupsWith(options sl.Options) (resp []datatypes.Network_Storage_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStorageGroups()
+}
+
 // Retrieve IPSec network tunnels for an account.
 func (r Account) GetNetworkTunnelContexts() (resp []datatypes.Network_Tunnel_Module_Context, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNetworkTunnelContexts", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkTunnelContextsWith is GetNetworkTunnelContexts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNetworkTunnelContextsWith(options sl.Options) (resp []datatypes.Network_Tunnel_Module_Context, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkTunnelContexts()
+}
+
 // Retrieve Whether or not an account has automatic private VLAN spanning enabled.
 func (r Account) GetNetworkVlanSpan() (resp datatypes.Account_Network_Vlan_Span, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNetworkVlanSpan", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlanSpanWith is GetNetworkVlanSpan, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNetworkVlanSpanWith(options sl.Options) (resp datatypes.Account_Network_Vlan_Span, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlanSpan()
+}
+
 // Retrieve All network VLANs assigned to an account.
 func (r Account) GetNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNetworkVlans", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlansWith is GetNetworkVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlans()
+}
+
 // Retrieve DEPRECATED - This information can be pulled directly through tapping keys now - DEPRECATED. The allotments for this account and their servers for the next billing cycle. The public inbound and outbound bandwidth is calculated for each server in addition to the daily average network traffic since the last billing date.
 func (r Account) GetNextBillingPublicAllotmentHardwareBandwidthDetails() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNextBillingPublicAllotmentHardwareBandwidthDetails", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextBillingPublicAllotmentHardwareBandwidthDetailsWith is GetNextBillingPublicAllotmentHardwareBandwidthDetails, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNextBillingPublicAllotmentHardwareBandwidthDetailsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextBillingPublicAllotmentHardwareBandwidthDetails()
+}
+
 // Return an account's next invoice in a Microsoft excel format. The "next invoice" is what a customer will be billed on their next invoice, assuming no changes are made. Currently this does not include Bandwidth Pooling charges.
 func (r Account) GetNextInvoiceExcel(documentCreateDate *datatypes.Time) (resp []byte, err error) {
 	params := []interface{}{
@@ -1268,6 +2282,14 @@ func (r Account) GetNextInvoiceIncubatorExemptTotal() (resp datatypes.Float64, e
 	return
 }
 
+// GetNextInvoiceIncubatorExemptTotalWith is GetNextInvoiceIncubatorExemptTotal, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNextInvoiceIncubatorExemptTotalWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceIncubatorExemptTotal()
+}
+
 // Return an account's next invoice in PDF format. The "next invoice" is what a customer will be billed on their next invoice, assuming no changes are made. Currently this does not include Bandwidth Pooling charges.
 func (r Account) GetNextInvoicePdf(documentCreateDate *datatypes.Time) (resp []byte, err error) {
 	params := []interface{}{
@@ -1292,54 +2314,126 @@ func (r Account) GetNextInvoiceRecurringAmountEligibleForAccountDiscount() (resp
 	return
 }
 
+// GetNextInvoiceRecurringAmountEligibleForAccountDiscountWith is GetNextInvoiceRecurringAmountEligibleForAccountDiscount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNextInvoiceRecurringAmountEligibleForAccountDiscountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceRecurringAmountEligibleForAccountDiscount()
+}
+
 // Retrieve The billing items that will be on an account's next invoice.
 func (r Account) GetNextInvoiceTopLevelBillingItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNextInvoiceTopLevelBillingItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTopLevelBillingItemsWith is GetNextInvoiceTopLevelBillingItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNextInvoiceTopLevelBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTopLevelBillingItems()
+}
+
 // Retrieve The pre-tax total amount of an account's next invoice measured in US Dollars ($USD), assuming no changes or charges occur between now and time of billing.
 func (r Account) GetNextInvoiceTotalAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNextInvoiceTotalAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalAmountWith is GetNextInvoiceTotalAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNextInvoiceTotalAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalAmount()
+}
+
 // Retrieve The total one-time charge amount of an account's next invoice measured in US Dollars ($USD), assuming no changes or charges occur between now and time of billing.
 func (r Account) GetNextInvoiceTotalOneTimeAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNextInvoiceTotalOneTimeAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalOneTimeAmountWith is GetNextInvoiceTotalOneTimeAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNextInvoiceTotalOneTimeAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalOneTimeAmount()
+}
+
 // Retrieve The total one-time tax amount of an account's next invoice measured in US Dollars ($USD), assuming no changes or charges occur between now and time of billing.
 func (r Account) GetNextInvoiceTotalOneTimeTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNextInvoiceTotalOneTimeTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalOneTimeTaxAmountWith is GetNextInvoiceTotalOneTimeTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNextInvoiceTotalOneTimeTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalOneTimeTaxAmount()
+}
+
 // Retrieve The total recurring charge amount of an account's next invoice measured in US Dollars ($USD), assuming no changes or charges occur between now and time of billing.
 func (r Account) GetNextInvoiceTotalRecurringAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNextInvoiceTotalRecurringAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalRecurringAmountWith is GetNextInvoiceTotalRecurringAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNextInvoiceTotalRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalRecurringAmount()
+}
+
 // Retrieve The total recurring charge amount of an account's next invoice measured in US Dollars ($USD), assuming no changes or charges occur between now and time of billing.
 func (r Account) GetNextInvoiceTotalRecurringAmountBeforeAccountDiscount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNextInvoiceTotalRecurringAmountBeforeAccountDiscount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalRecurringAmountBeforeAccountDiscountWith is GetNextInvoiceTotalRecurringAmountBeforeAccountDiscount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNextInvoiceTotalRecurringAmountBeforeAccountDiscountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalRecurringAmountBeforeAccountDiscount()
+}
+
 // Retrieve The total recurring tax amount of an account's next invoice measured in US Dollars ($USD), assuming no changes or charges occur between now and time of billing.
 func (r Account) GetNextInvoiceTotalRecurringTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNextInvoiceTotalRecurringTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalRecurringTaxAmountWith is GetNextInvoiceTotalRecurringTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNextInvoiceTotalRecurringTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalRecurringTaxAmount()
+}
+
 // Retrieve The total recurring charge amount of an account's next invoice measured in US Dollars ($USD), assuming no changes or charges occur between now and time of billing.
 func (r Account) GetNextInvoiceTotalTaxableRecurringAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNextInvoiceTotalTaxableRecurringAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalTaxableRecurringAmountWith is GetNextInvoiceTotalTaxableRecurringAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetNextInvoiceTotalTaxableRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalTaxableRecurringAmount()
+}
+
 // no documentation yet
 func (r Account) GetNextInvoiceZeroFeeItemCounts() (resp []datatypes.Container_Product_Item_Category_ZeroFee_Count, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getNextInvoiceZeroFeeItemCounts", nil, &r.Options, &resp)
@@ -1364,42 +2458,98 @@ func (r Account) GetOpenAbuseTickets() (resp []datatypes.Ticket, err error) {
 	return
 }
 
+// GetOpenAbuseTicketsWith is GetOpenAbuseTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetOpenAbuseTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenAbuseTickets()
+}
+
 // Retrieve The open accounting tickets associated with an account.
 func (r Account) GetOpenAccountingTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getOpenAccountingTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetOpenAccountingTicketsWith is GetOpenAccountingTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetOpenAccountingTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenAccountingTickets()
+}
+
 // Retrieve The open billing tickets associated with an account.
 func (r Account) GetOpenBillingTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getOpenBillingTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetOpenBillingTicketsWith is GetOpenBillingTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetOpenBillingTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenBillingTickets()
+}
+
 // Retrieve An open ticket requesting cancellation of this server, if one exists.
 func (r Account) GetOpenCancellationRequests() (resp []datatypes.Billing_Item_Cancellation_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getOpenCancellationRequests", nil, &r.Options, &resp)
 	return
 }
 
+// GetOpenCancellationRequestsWith is GetOpenCancellationRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetOpenCancellationRequestsWith(options sl.Options) (resp []datatypes.Billing_Item_Cancellation_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenCancellationRequests()
+}
+
 // Retrieve The open tickets that do not belong to the abuse, accounting, sales, or support groups associated with an account.
 func (r Account) GetOpenOtherTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getOpenOtherTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetOpenOtherTicketsWith is GetOpenOtherTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetOpenOtherTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenOtherTickets()
+}
+
 // Retrieve An account's recurring invoices.
 func (r Account) GetOpenRecurringInvoices() (resp []datatypes.Billing_Invoice, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getOpenRecurringInvoices", nil, &r.Options, &resp)
 	return
 }
 
+// GetOpenRecurringInvoicesWith is GetOpenRecurringInvoices, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetOpenRecurringInvoicesWith(options sl.Options) (resp []datatypes.Billing_Invoice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenRecurringInvoices()
+}
+
 // Retrieve The open sales tickets associated with an account.
 func (r Account) GetOpenSalesTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getOpenSalesTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetOpenSalesTicketsWith is GetOpenSalesTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetOpenSalesTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenSalesTickets()
+}
+
 // Retrieve
 func (r Account) GetOpenStackAccountLinks() (resp []datatypes.Account_Link, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getOpenStackAccountLinks", nil, &r.Options, &resp)
@@ -1412,36 +2562,84 @@ func (r Account) GetOpenStackObjectStorage() (resp []datatypes.Network_Storage,
 	return
 }
 
+// GetOpenStackObjectStorageWith is GetOpenStackObjectStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetOpenStackObjectStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenStackObjectStorage()
+}
+
 // Retrieve The open support tickets associated with an account.
 func (r Account) GetOpenSupportTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getOpenSupportTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetOpenSupportTicketsWith is GetOpenSupportTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetOpenSupportTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenSupportTickets()
+}
+
 // Retrieve All open tickets associated with an account.
 func (r Account) GetOpenTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getOpenTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetOpenTicketsWith is GetOpenTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetOpenTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenTickets()
+}
+
 // Retrieve All open tickets associated with an account last edited by an employee.
 func (r Account) GetOpenTicketsWaitingOnCustomer() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getOpenTicketsWaitingOnCustomer", nil, &r.Options, &resp)
 	return
 }
 
+// GetOpenTicketsWaitingOnCustomerWith is GetOpenTicketsWaitingOnCustomer, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetOpenTicketsWaitingOnCustomerWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenTicketsWaitingOnCustomer()
+}
+
 // Retrieve An account's associated billing orders excluding upgrades.
 func (r Account) GetOrders() (resp []datatypes.Billing_Order, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getOrders", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrdersWith is GetOrders, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetOrdersWith(options sl.Options) (resp []datatypes.Billing_Order, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrders()
+}
+
 // Retrieve The billing items that have no parent billing item. These are items that don't necessarily belong to a single server.
 func (r Account) GetOrphanBillingItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getOrphanBillingItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrphanBillingItemsWith is GetOrphanBillingItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetOrphanBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrphanBillingItems()
+}
+
 // Retrieve
 func (r Account) GetOwnedBrands() (resp []datatypes.Brand, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getOwnedBrands", nil, &r.Options, &resp)
@@ -1478,60 +2676,140 @@ func (r Account) GetPendingInvoice() (resp datatypes.Billing_Invoice, err error)
 	return
 }
 
+// GetPendingInvoiceWith is GetPendingInvoice, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPendingInvoiceWith(options sl.Options) (resp datatypes.Billing_Invoice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingInvoice()
+}
+
 // Retrieve A list of top-level invoice items that are on an account's currently pending invoice.
 func (r Account) GetPendingInvoiceTopLevelItems() (resp []datatypes.Billing_Invoice_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPendingInvoiceTopLevelItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetPendingInvoiceTopLevelItemsWith is GetPendingInvoiceTopLevelItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPendingInvoiceTopLevelItemsWith(options sl.Options) (resp []datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingInvoiceTopLevelItems()
+}
+
 // Retrieve The total amount of an account's pending invoice, if one exists.
 func (r Account) GetPendingInvoiceTotalAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPendingInvoiceTotalAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetPendingInvoiceTotalAmountWith is GetPendingInvoiceTotalAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPendingInvoiceTotalAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingInvoiceTotalAmount()
+}
+
 // Retrieve The total one-time charges for an account's pending invoice, if one exists. In other words, it is the sum of one-time charges, setup fees, and labor fees. It does not include taxes.
 func (r Account) GetPendingInvoiceTotalOneTimeAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPendingInvoiceTotalOneTimeAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetPendingInvoiceTotalOneTimeAmountWith is GetPendingInvoiceTotalOneTimeAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPendingInvoiceTotalOneTimeAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingInvoiceTotalOneTimeAmount()
+}
+
 // Retrieve The sum of all the taxes related to one time charges for an account's pending invoice, if one exists.
 func (r Account) GetPendingInvoiceTotalOneTimeTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPendingInvoiceTotalOneTimeTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetPendingInvoiceTotalOneTimeTaxAmountWith is GetPendingInvoiceTotalOneTimeTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPendingInvoiceTotalOneTimeTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingInvoiceTotalOneTimeTaxAmount()
+}
+
 // Retrieve The total recurring amount of an account's pending invoice, if one exists.
 func (r Account) GetPendingInvoiceTotalRecurringAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPendingInvoiceTotalRecurringAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetPendingInvoiceTotalRecurringAmountWith is GetPendingInvoiceTotalRecurringAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPendingInvoiceTotalRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingInvoiceTotalRecurringAmount()
+}
+
 // Retrieve The total amount of the recurring taxes on an account's pending invoice, if one exists.
 func (r Account) GetPendingInvoiceTotalRecurringTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPendingInvoiceTotalRecurringTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetPendingInvoiceTotalRecurringTaxAmountWith is GetPendingInvoiceTotalRecurringTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPendingInvoiceTotalRecurringTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingInvoiceTotalRecurringTaxAmount()
+}
+
 // Retrieve An account's permission groups.
 func (r Account) GetPermissionGroups() (resp []datatypes.User_Permission_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPermissionGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetPermissionGroupsWith is GetPermissionGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPermissionGroupsWith(options sl.Options) (resp []datatypes.User_Permission_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPermissionGroups()
+}
+
 // Retrieve An account's user roles.
 func (r Account) GetPermissionRoles() (resp []datatypes.User_Permission_Role, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPermissionRoles", nil, &r.Options, &resp)
 	return
 }
 
+// GetPermissionRolesWith is GetPermissionRoles, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPermissionRolesWith(options sl.Options) (resp []datatypes.User_Permission_Role, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPermissionRoles()
+}
+
 // Retrieve An account's associated virtual placement groups.
 func (r Account) GetPlacementGroups() (resp []datatypes.Virtual_PlacementGroup, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPlacementGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetPlacementGroupsWith is GetPlacementGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPlacementGroupsWith(options sl.Options) (resp []datatypes.Virtual_PlacementGroup, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPlacementGroups()
+}
+
 // Retrieve
 func (r Account) GetPortableStorageVolumes() (resp []datatypes.Virtual_Disk_Image, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPortableStorageVolumes", nil, &r.Options, &resp)
@@ -1544,48 +2822,112 @@ func (r Account) GetPostProvisioningHooks() (resp []datatypes.Provisioning_Hook,
 	return
 }
 
+// GetPostProvisioningHooksWith is GetPostProvisioningHooks, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPostProvisioningHooksWith(options sl.Options) (resp []datatypes.Provisioning_Hook, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPostProvisioningHooks()
+}
+
 // Retrieve Boolean flag dictating whether or not this account supports PPTP VPN Access.
 func (r Account) GetPptpVpnAllowedFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPptpVpnAllowedFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetPptpVpnAllowedFlagWith is GetPptpVpnAllowedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPptpVpnAllowedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPptpVpnAllowedFlag()
+}
+
 // Retrieve An account's associated portal users with PPTP VPN access.
 func (r Account) GetPptpVpnUsers() (resp []datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPptpVpnUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetPptpVpnUsersWith is GetPptpVpnUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPptpVpnUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPptpVpnUsers()
+}
+
 // Retrieve The total recurring amount for an accounts previous revenue.
 func (r Account) GetPreviousRecurringRevenue() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPreviousRecurringRevenue", nil, &r.Options, &resp)
 	return
 }
 
+// GetPreviousRecurringRevenueWith is GetPreviousRecurringRevenue, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPreviousRecurringRevenueWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPreviousRecurringRevenue()
+}
+
 // Retrieve The item price that an account is restricted to.
 func (r Account) GetPriceRestrictions() (resp []datatypes.Product_Item_Price_Account_Restriction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPriceRestrictions", nil, &r.Options, &resp)
 	return
 }
 
+// GetPriceRestrictionsWith is GetPriceRestrictions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPriceRestrictionsWith(options sl.Options) (resp []datatypes.Product_Item_Price_Account_Restriction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPriceRestrictions()
+}
+
 // Retrieve All priority one tickets associated with an account.
 func (r Account) GetPriorityOneTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPriorityOneTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetPriorityOneTicketsWith is GetPriorityOneTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPriorityOneTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPriorityOneTickets()
+}
+
 // Retrieve DEPRECATED - This information can be pulled directly through tapping keys now - DEPRECATED. The allotments for this account and their servers. The private inbound and outbound bandwidth is calculated for each server in addition to the daily average network traffic since the last billing date.
 func (r Account) GetPrivateAllotmentHardwareBandwidthDetails() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPrivateAllotmentHardwareBandwidthDetails", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrivateAllotmentHardwareBandwidthDetailsWith is GetPrivateAllotmentHardwareBandwidthDetails, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPrivateAllotmentHardwareBandwidthDetailsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateAllotmentHardwareBandwidthDetails()
+}
+
 // Retrieve Private and shared template group objects (parent only) for an account.
 func (r Account) GetPrivateBlockDeviceTemplateGroups() (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPrivateBlockDeviceTemplateGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrivateBlockDeviceTemplateGroupsWith is GetPrivateBlockDeviceTemplateGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPrivateBlockDeviceTemplateGroupsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateBlockDeviceTemplateGroups()
+}
+
 // Retrieve
 func (r Account) GetPrivateIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPrivateIpAddresses", nil, &r.Options, &resp)
@@ -1598,24 +2940,56 @@ func (r Account) GetPrivateNetworkVlans() (resp []datatypes.Network_Vlan, err er
 	return
 }
 
+// GetPrivateNetworkVlansWith is GetPrivateNetworkVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPrivateNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateNetworkVlans()
+}
+
 // Retrieve All private subnets associated with an account.
 func (r Account) GetPrivateSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPrivateSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrivateSubnetsWith is GetPrivateSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPrivateSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateSubnets()
+}
+
 // Retrieve Boolean flag indicating whether or not this account is a Proof of Concept account.
 func (r Account) GetProofOfConceptAccountFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getProofOfConceptAccountFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetProofOfConceptAccountFlagWith is GetProofOfConceptAccountFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetProofOfConceptAccountFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProofOfConceptAccountFlag()
+}
+
 // Retrieve DEPRECATED - This information can be pulled directly through tapping keys now - DEPRECATED. The allotments for this account and their servers. The public inbound and outbound bandwidth is calculated for each server in addition to the daily average network traffic since the last billing date.
 func (r Account) GetPublicAllotmentHardwareBandwidthDetails() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPublicAllotmentHardwareBandwidthDetails", nil, &r.Options, &resp)
 	return
 }
 
+// GetPublicAllotmentHardwareBandwidthDetailsWith is GetPublicAllotmentHardwareBandwidthDetails, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPublicAllotmentHardwareBandwidthDetailsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPublicAllotmentHardwareBandwidthDetails()
+}
+
 // Retrieve
 func (r Account) GetPublicIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPublicIpAddresses", nil, &r.Options, &resp)
@@ -1628,18 +3002,42 @@ func (r Account) GetPublicNetworkVlans() (resp []datatypes.Network_Vlan, err err
 	return
 }
 
+// GetPublicNetworkVlansWith is GetPublicNetworkVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPublicNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPublicNetworkVlans()
+}
+
 // Retrieve All public network subnets associated with an account.
 func (r Account) GetPublicSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getPublicSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetPublicSubnetsWith is GetPublicSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetPublicSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPublicSubnets()
+}
+
 // Retrieve An account's quotes.
 func (r Account) GetQuotes() (resp []datatypes.Billing_Order_Quote, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getQuotes", nil, &r.Options, &resp)
 	return
 }
 
+// GetQuotesWith is GetQuotes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetQuotesWith(options sl.Options) (resp []datatypes.Billing_Order_Quote, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetQuotes()
+}
+
 // Retrieve
 func (r Account) GetRecentEvents() (resp []datatypes.Notification_Occurrence_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getRecentEvents", nil, &r.Options, &resp)
@@ -1652,6 +3050,14 @@ func (r Account) GetReferralPartner() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetReferralPartnerWith is GetReferralPartner, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetReferralPartnerWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReferralPartner()
+}
+
 // no documentation yet
 func (r Account) GetReferralPartnerCommissionForecast() (resp []datatypes.Container_Referral_Partner_Commission, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getReferralPartnerCommissionForecast", nil, &r.Options, &resp)
@@ -1676,6 +3082,14 @@ func (r Account) GetReferredAccounts() (resp []datatypes.Account, err error) {
 	return
 }
 
+// GetReferredAccountsWith is GetReferredAccounts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetReferredAccountsWith(options sl.Options) (resp []datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReferredAccounts()
+}
+
 // Retrieve
 func (r Account) GetRegulatedWorkloads() (resp []datatypes.Legal_RegulatedWorkload, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getRegulatedWorkloads", nil, &r.Options, &resp)
@@ -1688,48 +3102,112 @@ func (r Account) GetRemoteManagementCommandRequests() (resp []datatypes.Hardware
 	return
 }
 
+// GetRemoteManagementCommandRequestsWith is GetRemoteManagementCommandRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetRemoteManagementCommandRequestsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementCommandRequests()
+}
+
 // Retrieve The Replication events for all Network Storage volumes on an account.
 func (r Account) GetReplicationEvents() (resp []datatypes.Network_Storage_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getReplicationEvents", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicationEventsWith is GetReplicationEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetReplicationEventsWith(options sl.Options) (resp []datatypes.Network_Storage_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicationEvents()
+}
+
 // Retrieve Indicates whether newly created users under this account will be associated with IBMid via an email requiring a response, or not.
 func (r Account) GetRequireSilentIBMidUserCreation() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getRequireSilentIBMidUserCreation", nil, &r.Options, &resp)
 	return
 }
 
+// GetRequireSilentIBMidUserCreationWith is GetRequireSilentIBMidUserCreation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetRequireSilentIBMidUserCreationWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRequireSilentIBMidUserCreation()
+}
+
 // Retrieve All reserved capacity agreements for an account
 func (r Account) GetReservedCapacityAgreements() (resp []datatypes.Account_Agreement, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getReservedCapacityAgreements", nil, &r.Options, &resp)
 	return
 }
 
+// GetReservedCapacityAgreementsWith is GetReservedCapacityAgreements, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetReservedCapacityAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReservedCapacityAgreements()
+}
+
 // Retrieve The reserved capacity groups owned by this account.
 func (r Account) GetReservedCapacityGroups() (resp []datatypes.Virtual_ReservedCapacityGroup, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getReservedCapacityGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetReservedCapacityGroupsWith is GetReservedCapacityGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetReservedCapacityGroupsWith(options sl.Options) (resp []datatypes.Virtual_ReservedCapacityGroup, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReservedCapacityGroups()
+}
+
 // Retrieve An account's associated top-level resource groups.
 func (r Account) GetResourceGroups() (resp []datatypes.Resource_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getResourceGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetResourceGroupsWith is GetResourceGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetResourceGroupsWith(options sl.Options) (resp []datatypes.Resource_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResourceGroups()
+}
+
 // Retrieve All Routers that an accounts VLANs reside on
 func (r Account) GetRouters() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getRouters", nil, &r.Options, &resp)
 	return
 }
 
+// GetRoutersWith is GetRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRouters()
+}
+
 // Retrieve An account's reverse WHOIS data. This data is used when making SWIP requests.
 func (r Account) GetRwhoisData() (resp datatypes.Network_Subnet_Rwhois_Data, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getRwhoisData", nil, &r.Options, &resp)
 	return
 }
 
+// GetRwhoisDataWith is GetRwhoisData, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetRwhoisDataWith(options sl.Options) (resp datatypes.Network_Subnet_Rwhois_Data, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRwhoisData()
+}
+
 // Retrieve
 func (r Account) GetSalesforceAccountLink() (resp datatypes.Account_Link, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getSalesforceAccountLink", nil, &r.Options, &resp)
@@ -1742,30 +3220,70 @@ func (r Account) GetSamlAuthentication() (resp datatypes.Account_Authentication_
 	return
 }
 
+// GetSamlAuthenticationWith is GetSamlAuthentication, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetSamlAuthenticationWith(options sl.Options) (resp datatypes.Account_Authentication_Saml, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSamlAuthentication()
+}
+
 // Retrieve All scale groups on this account.
 func (r Account) GetScaleGroups() (resp []datatypes.Scale_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getScaleGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetScaleGroupsWith is GetScaleGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetScaleGroupsWith(options sl.Options) (resp []datatypes.Scale_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleGroups()
+}
+
 // Retrieve The secondary DNS records for a SoftLayer customer account.
 func (r Account) GetSecondaryDomains() (resp []datatypes.Dns_Secondary, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getSecondaryDomains", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecondaryDomainsWith is GetSecondaryDomains, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetSecondaryDomainsWith(options sl.Options) (resp []datatypes.Dns_Secondary, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecondaryDomains()
+}
+
 // Retrieve Stored security certificates (ie. SSL)
 func (r Account) GetSecurityCertificates() (resp []datatypes.Security_Certificate, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getSecurityCertificates", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecurityCertificatesWith is GetSecurityCertificates, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetSecurityCertificatesWith(options sl.Options) (resp []datatypes.Security_Certificate, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityCertificates()
+}
+
 // Retrieve The security groups belonging to this account.
 func (r Account) GetSecurityGroups() (resp []datatypes.Network_SecurityGroup, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getSecurityGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecurityGroupsWith is GetSecurityGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetSecurityGroupsWith(options sl.Options) (resp []datatypes.Network_SecurityGroup, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityGroups()
+}
+
 // Retrieve
 func (r Account) GetSecurityLevel() (resp datatypes.Security_Level, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getSecurityLevel", nil, &r.Options, &resp)
@@ -1778,12 +3296,28 @@ func (r Account) GetSecurityScanRequests() (resp []datatypes.Network_Security_Sc
 	return
 }
 
+// GetSecurityScanRequestsWith is GetSecurityScanRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetSecurityScanRequestsWith(options sl.Options) (resp []datatypes.Network_Security_Scanner_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityScanRequests()
+}
+
 // Retrieve The service billing items that will be on an account's next invoice.
 func (r Account) GetServiceBillingItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getServiceBillingItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetServiceBillingItemsWith is GetServiceBillingItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetServiceBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceBillingItems()
+}
+
 // This method returns the [[SoftLayer_Virtual_Guest_Block_Device_Template_Group]] objects that have been shared with this account
 func (r Account) GetSharedBlockDeviceTemplateGroups() (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getSharedBlockDeviceTemplateGroups", nil, &r.Options, &resp)
@@ -1796,24 +3330,56 @@ func (r Account) GetShipments() (resp []datatypes.Account_Shipment, err error) {
 	return
 }
 
+// GetShipmentsWith is GetShipments, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetShipmentsWith(options sl.Options) (resp []datatypes.Account_Shipment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetShipments()
+}
+
 // Retrieve Customer specified SSH keys that can be implemented onto a newly provisioned or reloaded server.
 func (r Account) GetSshKeys() (resp []datatypes.Security_Ssh_Key, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getSshKeys", nil, &r.Options, &resp)
 	return
 }
 
+// GetSshKeysWith is GetSshKeys, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSshKeys()
+}
+
 // Retrieve An account's associated portal users with SSL VPN access.
 func (r Account) GetSslVpnUsers() (resp []datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getSslVpnUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetSslVpnUsersWith is GetSslVpnUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetSslVpnUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSslVpnUsers()
+}
+
 // Retrieve An account's virtual guest objects that are hosted on a user provisioned hypervisor.
 func (r Account) GetStandardPoolVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getStandardPoolVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetStandardPoolVirtualGuestsWith is GetStandardPoolVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetStandardPoolVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStandardPoolVirtualGuests()
+}
+
 // Retrieve
 func (r Account) GetSubnetRegistrationDetails() (resp []datatypes.Account_Regional_Registry_Detail, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getSubnetRegistrationDetails", nil, &r.Options, &resp)
@@ -1832,18 +3398,42 @@ func (r Account) GetSubnets() (resp []datatypes.Network_Subnet, err error) {
 	return
 }
 
+// GetSubnetsWith is GetSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubnets()
+}
+
 // Retrieve The SoftLayer employees that an account is assigned to.
 func (r Account) GetSupportRepresentatives() (resp []datatypes.User_Employee, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getSupportRepresentatives", nil, &r.Options, &resp)
 	return
 }
 
+// GetSupportRepresentativesWith is GetSupportRepresentatives, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetSupportRepresentativesWith(options sl.Options) (resp []datatypes.User_Employee, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSupportRepresentatives()
+}
+
 // Retrieve The active support subscriptions for this account.
 func (r Account) GetSupportSubscriptions() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getSupportSubscriptions", nil, &r.Options, &resp)
 	return
 }
 
+// GetSupportSubscriptionsWith is GetSupportSubscriptions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetSupportSubscriptionsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSupportSubscriptions()
+}
+
 // Retrieve
 func (r Account) GetSupportTier() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getSupportTier", nil, &r.Options, &resp)
@@ -1856,6 +3446,14 @@ func (r Account) GetSuppressInvoicesFlag() (resp bool, err error) {
 	return
 }
 
+// GetSuppressInvoicesFlagWith is GetSuppressInvoicesFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetSuppressInvoicesFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSuppressInvoicesFlag()
+}
+
 // Retrieve
 func (r Account) GetTags() (resp []datatypes.Tag, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getTags", nil, &r.Options, &resp)
@@ -1883,36 +3481,84 @@ func (r Account) GetTickets() (resp []datatypes.Ticket, err error) {
 	return
 }
 
+// GetTicketsWith is GetTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTickets()
+}
+
 // Retrieve Tickets closed within the last 72 hours or last 10 tickets, whichever is less, associated with an account.
 func (r Account) GetTicketsClosedInTheLastThreeDays() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getTicketsClosedInTheLastThreeDays", nil, &r.Options, &resp)
 	return
 }
 
+// GetTicketsClosedInTheLastThreeDaysWith is GetTicketsClosedInTheLastThreeDays, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetTicketsClosedInTheLastThreeDaysWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTicketsClosedInTheLastThreeDays()
+}
+
 // Retrieve Tickets closed today associated with an account.
 func (r Account) GetTicketsClosedToday() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getTicketsClosedToday", nil, &r.Options, &resp)
 	return
 }
 
+// GetTicketsClosedTodayWith is GetTicketsClosedToday, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetTicketsClosedTodayWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTicketsClosedToday()
+}
+
 // Retrieve An account's associated Transcode account.
 func (r Account) GetTranscodeAccounts() (resp []datatypes.Network_Media_Transcode_Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getTranscodeAccounts", nil, &r.Options, &resp)
 	return
 }
 
+// GetTranscodeAccountsWith is GetTranscodeAccounts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetTranscodeAccountsWith(options sl.Options) (resp []datatypes.Network_Media_Transcode_Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTranscodeAccounts()
+}
+
 // Retrieve An account's associated upgrade requests.
 func (r Account) GetUpgradeRequests() (resp []datatypes.Product_Upgrade_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getUpgradeRequests", nil, &r.Options, &resp)
 	return
 }
 
+// GetUpgradeRequestsWith is GetUpgradeRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetUpgradeRequestsWith(options sl.Options) (resp []datatypes.Product_Upgrade_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeRequests()
+}
+
 // Retrieve An account's portal users.
 func (r Account) GetUsers() (resp []datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetUsersWith is GetUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUsers()
+}
+
 // Retrieve a list of valid (non-expired) security certificates without the sensitive certificate information. This allows non-privileged users to view and select security certificates when configuring associated services.
 func (r Account) GetValidSecurityCertificateEntries() (resp []datatypes.Security_Certificate_Entry, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getValidSecurityCertificateEntries", nil, &r.Options, &resp)
@@ -1925,108 +3571,252 @@ func (r Account) GetValidSecurityCertificates() (resp []datatypes.Security_Certi
 	return
 }
 
+// GetValidSecurityCertificatesWith is GetValidSecurityCertificates, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetValidSecurityCertificatesWith(options sl.Options) (resp []datatypes.Security_Certificate, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetValidSecurityCertificates()
+}
+
 // Retrieve Return 0 if vpn updates are currently in progress on this account otherwise 1.
 func (r Account) GetVdrUpdatesInProgressFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVdrUpdatesInProgressFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetVdrUpdatesInProgressFlagWith is GetVdrUpdatesInProgressFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVdrUpdatesInProgressFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVdrUpdatesInProgressFlag()
+}
+
 // Retrieve The bandwidth pooling for this account.
 func (r Account) GetVirtualDedicatedRacks() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualDedicatedRacks", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualDedicatedRacksWith is GetVirtualDedicatedRacks, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualDedicatedRacksWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualDedicatedRacks()
+}
+
 // Retrieve An account's associated virtual server virtual disk images.
 func (r Account) GetVirtualDiskImages() (resp []datatypes.Virtual_Disk_Image, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualDiskImages", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualDiskImagesWith is GetVirtualDiskImages, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualDiskImagesWith(options sl.Options) (resp []datatypes.Virtual_Disk_Image, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualDiskImages()
+}
+
 // Retrieve An account's associated virtual guest objects.
 func (r Account) GetVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWith is GetVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuests()
+}
+
 // Retrieve An account's associated virtual guest objects currently over bandwidth allocation.
 func (r Account) GetVirtualGuestsOverBandwidthAllocation() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualGuestsOverBandwidthAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsOverBandwidthAllocationWith is GetVirtualGuestsOverBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualGuestsOverBandwidthAllocationWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsOverBandwidthAllocation()
+}
+
 // Retrieve An account's associated virtual guest objects currently over bandwidth allocation.
 func (r Account) GetVirtualGuestsProjectedOverBandwidthAllocation() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualGuestsProjectedOverBandwidthAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsProjectedOverBandwidthAllocationWith is GetVirtualGuestsProjectedOverBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualGuestsProjectedOverBandwidthAllocationWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsProjectedOverBandwidthAllocation()
+}
+
 // Retrieve All virtual guests associated with an account that has the cPanel web hosting control panel installed.
 func (r Account) GetVirtualGuestsWithCpanel() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualGuestsWithCpanel", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWithCpanelWith is GetVirtualGuestsWithCpanel, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualGuestsWithCpanelWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithCpanel()
+}
+
 // Retrieve All virtual guests associated with an account that have McAfee Secure software components.
 func (r Account) GetVirtualGuestsWithMcafee() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualGuestsWithMcafee", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWithMcafeeWith is GetVirtualGuestsWithMcafee, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualGuestsWithMcafeeWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithMcafee()
+}
+
 // Retrieve All virtual guests associated with an account that have McAfee Secure AntiVirus for Redhat software components.
 func (r Account) GetVirtualGuestsWithMcafeeAntivirusRedhat() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualGuestsWithMcafeeAntivirusRedhat", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWithMcafeeAntivirusRedhatWith is GetVirtualGuestsWithMcafeeAntivirusRedhat, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualGuestsWithMcafeeAntivirusRedhatWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithMcafeeAntivirusRedhat()
+}
+
 // Retrieve All virtual guests associated with an account that has McAfee Secure AntiVirus for Windows software components.
 func (r Account) GetVirtualGuestsWithMcafeeAntivirusWindows() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualGuestsWithMcafeeAntivirusWindows", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWithMcafeeAntivirusWindowsWith is GetVirtualGuestsWithMcafeeAntivirusWindows, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualGuestsWithMcafeeAntivirusWindowsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithMcafeeAntivirusWindows()
+}
+
 // Retrieve All virtual guests associated with an account that has McAfee Secure Intrusion Detection System software components.
 func (r Account) GetVirtualGuestsWithMcafeeIntrusionDetectionSystem() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualGuestsWithMcafeeIntrusionDetectionSystem", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWithMcafeeIntrusionDetectionSystemWith is GetVirtualGuestsWithMcafeeIntrusionDetectionSystem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualGuestsWithMcafeeIntrusionDetectionSystemWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithMcafeeIntrusionDetectionSystem()
+}
+
 // Retrieve All virtual guests associated with an account that has the Plesk web hosting control panel installed.
 func (r Account) GetVirtualGuestsWithPlesk() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualGuestsWithPlesk", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWithPleskWith is GetVirtualGuestsWithPlesk, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualGuestsWithPleskWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithPlesk()
+}
+
 // Retrieve All virtual guests associated with an account that have the QuantaStor storage system installed.
 func (r Account) GetVirtualGuestsWithQuantastor() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualGuestsWithQuantastor", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWithQuantastorWith is GetVirtualGuestsWithQuantastor, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualGuestsWithQuantastorWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithQuantastor()
+}
+
 // Retrieve All virtual guests associated with an account that has the Urchin web traffic analytics package installed.
 func (r Account) GetVirtualGuestsWithUrchin() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualGuestsWithUrchin", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWithUrchinWith is GetVirtualGuestsWithUrchin, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualGuestsWithUrchinWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithUrchin()
+}
+
 // Retrieve The bandwidth pooling for this account.
 func (r Account) GetVirtualPrivateRack() (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualPrivateRack", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualPrivateRackWith is GetVirtualPrivateRack, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualPrivateRackWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualPrivateRack()
+}
+
 // Retrieve An account's associated virtual server archived storage repositories.
 func (r Account) GetVirtualStorageArchiveRepositories() (resp []datatypes.Virtual_Storage_Repository, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualStorageArchiveRepositories", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualStorageArchiveRepositoriesWith is GetVirtualStorageArchiveRepositories, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualStorageArchiveRepositoriesWith(options sl.Options) (resp []datatypes.Virtual_Storage_Repository, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualStorageArchiveRepositories()
+}
+
 // Retrieve An account's associated virtual server public storage repositories.
 func (r Account) GetVirtualStoragePublicRepositories() (resp []datatypes.Virtual_Storage_Repository, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVirtualStoragePublicRepositories", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualStoragePublicRepositoriesWith is GetVirtualStoragePublicRepositories, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVirtualStoragePublicRepositoriesWith(options sl.Options) (resp []datatypes.Virtual_Storage_Repository, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualStoragePublicRepositories()
+}
+
 // This returns a collection of active VMware software account license keys.
 func (r Account) GetVmWareActiveAccountLicenseKeys() (resp []string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getVmWareActiveAccountLicenseKeys", nil, &r.Options, &resp)
@@ -2039,6 +3829,14 @@ func (r Account) GetVpcVirtualGuests() (resp []datatypes.Virtual_Guest, err erro
 	return
 }
 
+// GetVpcVirtualGuestsWith is GetVpcVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account) GetVpcVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVpcVirtualGuests()
+}
+
 // Retrieve a list of an account's hardware's Windows Update status. This list includes which servers have available updates, which servers require rebooting due to updates, which servers have failed retrieving updates, and which servers have failed to communicate with the SoftLayer private Windows Software Update Services server.
 func (r Account) GetWindowsUpdateStatus() (resp []datatypes.Container_Utility_Microsoft_Windows_UpdateServices_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account", "getWindowsUpdateStatus", nil, &r.Options, &resp)
@@ -2219,6 +4017,11 @@ func (r Account_Address) Id(id int) Account_Address {
 	return r
 }
 
+func (r Account_Address) InitParameter(name string, value interface{}) Account_Address {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Address) Mask(mask string) Account_Address {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2238,11 +4041,26 @@ func (r Account_Address) Limit(limit int) Account_Address {
 	return r
 }
 
+func (r Account_Address) Unlimited() Account_Address {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Address) Offset(offset int) Account_Address {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Address) Timeout(timeout time.Duration) Account_Address {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Address) WithContext(ctx context.Context) Account_Address {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a new address record. The ''typeId'', ''accountId'', ''description'', ''address1'', ''city'', ''state'', ''country'', and ''postalCode'' properties in the templateObject parameter are required properties and may not be null or empty. Users will be restricted to creating addresses for their account.
 func (r Account_Address) CreateObject(templateObject *datatypes.Account_Address) (resp datatypes.Account_Address, err error) {
 	params := []interface{}{
@@ -2267,6 +4085,14 @@ func (r Account_Address) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Address) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve a list of SoftLayer datacenter addresses.
 func (r Account_Address) GetAllDataCenters() (resp []datatypes.Account_Address, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Address", "getAllDataCenters", nil, &r.Options, &resp)
@@ -2279,24 +4105,56 @@ func (r Account_Address) GetCreateUser() (resp datatypes.User_Customer, err erro
 	return
 }
 
+// GetCreateUserWith is GetCreateUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Address) GetCreateUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCreateUser()
+}
+
 // Retrieve The location of this address.
 func (r Account_Address) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Address", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Address) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve The employee who last modified this address.
 func (r Account_Address) GetModifyEmployee() (resp datatypes.User_Employee, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Address", "getModifyEmployee", nil, &r.Options, &resp)
 	return
 }
 
+// GetModifyEmployeeWith is GetModifyEmployee, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Address) GetModifyEmployeeWith(options sl.Options) (resp datatypes.User_Employee, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetModifyEmployee()
+}
+
 // Retrieve The customer user who last modified this address.
 func (r Account_Address) GetModifyUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Address", "getModifyUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetModifyUserWith is GetModifyUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Address) GetModifyUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetModifyUser()
+}
+
 // Retrieve a list of SoftLayer datacenter addresses.
 func (r Account_Address) GetNetworkAddress(name *string) (resp []datatypes.Account_Address, err error) {
 	params := []interface{}{
@@ -2318,6 +4176,14 @@ func (r Account_Address) GetType() (resp datatypes.Account_Address_Type, err err
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Address) GetTypeWith(options sl.Options) (resp datatypes.Account_Address_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // no documentation yet
 type Account_Address_Type struct {
 	Session *session.Session
@@ -2334,6 +4200,11 @@ func (r Account_Address_Type) Id(id int) Account_Address_Type {
 	return r
 }
 
+func (r Account_Address_Type) InitParameter(name string, value interface{}) Account_Address_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Address_Type) Mask(mask string) Account_Address_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2353,11 +4224,26 @@ func (r Account_Address_Type) Limit(limit int) Account_Address_Type {
 	return r
 }
 
+func (r Account_Address_Type) Unlimited() Account_Address_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Address_Type) Offset(offset int) Account_Address_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Address_Type) Timeout(timeout time.Duration) Account_Address_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Address_Type) WithContext(ctx context.Context) Account_Address_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Address_Type) GetObject() (resp datatypes.Account_Address_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Address_Type", "getObject", nil, &r.Options, &resp)
@@ -2380,6 +4266,11 @@ func (r Account_Affiliation) Id(id int) Account_Affiliation {
 	return r
 }
 
+func (r Account_Affiliation) InitParameter(name string, value interface{}) Account_Affiliation {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Affiliation) Mask(mask string) Account_Affiliation {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2399,11 +4290,26 @@ func (r Account_Affiliation) Limit(limit int) Account_Affiliation {
 	return r
 }
 
+func (r Account_Affiliation) Unlimited() Account_Affiliation {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Affiliation) Offset(offset int) Account_Affiliation {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Affiliation) Timeout(timeout time.Duration) Account_Affiliation {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Affiliation) WithContext(ctx context.Context) Account_Affiliation {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a new affiliation to associate with an existing account.
 func (r Account_Affiliation) CreateObject(templateObject *datatypes.Account_Affiliation) (resp datatypes.Account_Affiliation, err error) {
 	params := []interface{}{
@@ -2434,6 +4340,14 @@ func (r Account_Affiliation) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Affiliation) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Get account affiliation information associated with affiliate id.
 func (r Account_Affiliation) GetAccountAffiliationsByAffiliateId(affiliateId *string) (resp []datatypes.Account_Affiliation, err error) {
 	params := []interface{}{
@@ -2465,6 +4379,11 @@ func (r Account_Agreement) Id(id int) Account_Agreement {
 	return r
 }
 
+func (r Account_Agreement) InitParameter(name string, value interface{}) Account_Agreement {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Agreement) Mask(mask string) Account_Agreement {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2484,11 +4403,26 @@ func (r Account_Agreement) Limit(limit int) Account_Agreement {
 	return r
 }
 
+func (r Account_Agreement) Unlimited() Account_Agreement {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Agreement) Offset(offset int) Account_Agreement {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Agreement) Timeout(timeout time.Duration) Account_Agreement {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Agreement) WithContext(ctx context.Context) Account_Agreement {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Account_Agreement) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Agreement", "getAccount", nil, &r.Options, &resp)
@@ -2501,18 +4435,42 @@ func (r Account_Agreement) GetAgreementType() (resp datatypes.Account_Agreement_
 	return
 }
 
+// GetAgreementTypeWith is GetAgreementType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Agreement) GetAgreementTypeWith(options sl.Options) (resp datatypes.Account_Agreement_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAgreementType()
+}
+
 // Retrieve The files attached to an agreement.
 func (r Account_Agreement) GetAttachedBillingAgreementFiles() (resp []datatypes.Account_MasterServiceAgreement, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Agreement", "getAttachedBillingAgreementFiles", nil, &r.Options, &resp)
 	return
 }
 
+// GetAttachedBillingAgreementFilesWith is GetAttachedBillingAgreementFiles, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Agreement) GetAttachedBillingAgreementFilesWith(options sl.Options) (resp []datatypes.Account_MasterServiceAgreement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttachedBillingAgreementFiles()
+}
+
 // Retrieve The billing items associated with an agreement.
 func (r Account_Agreement) GetBillingItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Agreement", "getBillingItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemsWith is GetBillingItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Agreement) GetBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItems()
+}
+
 // no documentation yet
 func (r Account_Agreement) GetObject() (resp datatypes.Account_Agreement, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Agreement", "getObject", nil, &r.Options, &resp)
@@ -2525,12 +4483,28 @@ func (r Account_Agreement) GetStatus() (resp datatypes.Account_Agreement_Status,
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Agreement) GetStatusWith(options sl.Options) (resp datatypes.Account_Agreement_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // Retrieve The top level billing item associated with an agreement.
 func (r Account_Agreement) GetTopLevelBillingItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Agreement", "getTopLevelBillingItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetTopLevelBillingItemsWith is GetTopLevelBillingItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Agreement) GetTopLevelBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTopLevelBillingItems()
+}
+
 // Account authentication has many different settings that can be set. This class allows the customer or employee to set these settigns.
 type Account_Authentication_Attribute struct {
 	Session *session.Session
@@ -2547,6 +4521,11 @@ func (r Account_Authentication_Attribute) Id(id int) Account_Authentication_Attr
 	return r
 }
 
+func (r Account_Authentication_Attribute) InitParameter(name string, value interface{}) Account_Authentication_Attribute {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Authentication_Attribute) Mask(mask string) Account_Authentication_Attribute {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2566,23 +4545,54 @@ func (r Account_Authentication_Attribute) Limit(limit int) Account_Authenticatio
 	return r
 }
 
+func (r Account_Authentication_Attribute) Unlimited() Account_Authentication_Attribute {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Authentication_Attribute) Offset(offset int) Account_Authentication_Attribute {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Authentication_Attribute) Timeout(timeout time.Duration) Account_Authentication_Attribute {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Authentication_Attribute) WithContext(ctx context.Context) Account_Authentication_Attribute {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The SoftLayer customer account.
 func (r Account_Authentication_Attribute) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Authentication_Attribute", "getAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Authentication_Attribute) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The SoftLayer account authentication that has an attribute.
 func (r Account_Authentication_Attribute) GetAuthenticationRecord() (resp datatypes.Account_Authentication_Saml, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Authentication_Attribute", "getAuthenticationRecord", nil, &r.Options, &resp)
 	return
 }
 
+// GetAuthenticationRecordWith is GetAuthenticationRecord, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Authentication_Attribute) GetAuthenticationRecordWith(options sl.Options) (resp datatypes.Account_Authentication_Saml, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAuthenticationRecord()
+}
+
 // no documentation yet
 func (r Account_Authentication_Attribute) GetObject() (resp datatypes.Account_Authentication_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Authentication_Attribute", "getObject", nil, &r.Options, &resp)
@@ -2595,6 +4605,14 @@ func (r Account_Authentication_Attribute) GetType() (resp datatypes.Account_Auth
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Authentication_Attribute) GetTypeWith(options sl.Options) (resp datatypes.Account_Authentication_Attribute_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // SoftLayer_Account_Authentication_Attribute_Type models the type of attribute that can be assigned to a SoftLayer customer account authentication.
 type Account_Authentication_Attribute_Type struct {
 	Session *session.Session
@@ -2611,6 +4629,11 @@ func (r Account_Authentication_Attribute_Type) Id(id int) Account_Authentication
 	return r
 }
 
+func (r Account_Authentication_Attribute_Type) InitParameter(name string, value interface{}) Account_Authentication_Attribute_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Authentication_Attribute_Type) Mask(mask string) Account_Authentication_Attribute_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2630,11 +4653,26 @@ func (r Account_Authentication_Attribute_Type) Limit(limit int) Account_Authenti
 	return r
 }
 
+func (r Account_Authentication_Attribute_Type) Unlimited() Account_Authentication_Attribute_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Authentication_Attribute_Type) Offset(offset int) Account_Authentication_Attribute_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Authentication_Attribute_Type) Timeout(timeout time.Duration) Account_Authentication_Attribute_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Authentication_Attribute_Type) WithContext(ctx context.Context) Account_Authentication_Attribute_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Authentication_Attribute_Type) GetAllObjects() (resp []datatypes.Account_Attribute_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Authentication_Attribute_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -2663,6 +4701,11 @@ func (r Account_Authentication_Saml) Id(id int) Account_Authentication_Saml {
 	return r
 }
 
+func (r Account_Authentication_Saml) InitParameter(name string, value interface{}) Account_Authentication_Saml {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Authentication_Saml) Mask(mask string) Account_Authentication_Saml {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2682,11 +4725,26 @@ func (r Account_Authentication_Saml) Limit(limit int) Account_Authentication_Sam
 	return r
 }
 
+func (r Account_Authentication_Saml) Unlimited() Account_Authentication_Saml {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Authentication_Saml) Offset(offset int) Account_Authentication_Saml {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Authentication_Saml) Timeout(timeout time.Duration) Account_Authentication_Saml {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Authentication_Saml) WithContext(ctx context.Context) Account_Authentication_Saml {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Authentication_Saml) CreateObject(templateObject *datatypes.Account_Authentication_Saml) (resp datatypes.Account_Authentication_Saml, err error) {
 	params := []interface{}{
@@ -2717,12 +4775,28 @@ func (r Account_Authentication_Saml) GetAccount() (resp datatypes.Account, err e
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Authentication_Saml) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The saml attribute values for a SoftLayer customer account.
 func (r Account_Authentication_Saml) GetAttributes() (resp []datatypes.Account_Authentication_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Authentication_Saml", "getAttributes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAttributesWith is GetAttributes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Authentication_Saml) GetAttributesWith(options sl.Options) (resp []datatypes.Account_Authentication_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+}
+
 // This method will return the service provider metadata in XML format.
 func (r Account_Authentication_Saml) GetMetadata() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Authentication_Saml", "getMetadata", nil, &r.Options, &resp)
@@ -2751,6 +4825,11 @@ func (r Account_Business_Partner) Id(id int) Account_Business_Partner {
 	return r
 }
 
+func (r Account_Business_Partner) InitParameter(name string, value interface{}) Account_Business_Partner {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Business_Partner) Mask(mask string) Account_Business_Partner {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2770,23 +4849,54 @@ func (r Account_Business_Partner) Limit(limit int) Account_Business_Partner {
 	return r
 }
 
+func (r Account_Business_Partner) Unlimited() Account_Business_Partner {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Business_Partner) Offset(offset int) Account_Business_Partner {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Business_Partner) Timeout(timeout time.Duration) Account_Business_Partner {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Business_Partner) WithContext(ctx context.Context) Account_Business_Partner {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve Account associated with the business partner data
 func (r Account_Business_Partner) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Business_Partner", "getAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Business_Partner) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve Channel indicator used to categorize business partner revenue.
 func (r Account_Business_Partner) GetChannel() (resp datatypes.Business_Partner_Channel, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Business_Partner", "getChannel", nil, &r.Options, &resp)
 	return
 }
 
+// GetChannelWith is GetChannel, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Business_Partner) GetChannelWith(options sl.Options) (resp datatypes.Business_Partner_Channel, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChannel()
+}
+
 // no documentation yet
 func (r Account_Business_Partner) GetObject() (resp datatypes.Account_Business_Partner, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Business_Partner", "getObject", nil, &r.Options, &resp)
@@ -2799,6 +4909,14 @@ func (r Account_Business_Partner) GetSegment() (resp datatypes.Business_Partner_
 	return
 }
 
+// GetSegmentWith is GetSegment, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Business_Partner) GetSegmentWith(options sl.Options) (resp datatypes.Business_Partner_Segment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSegment()
+}
+
 // no documentation yet
 type Account_Contact struct {
 	Session *session.Session
@@ -2815,6 +4933,11 @@ func (r Account_Contact) Id(id int) Account_Contact {
 	return r
 }
 
+func (r Account_Contact) InitParameter(name string, value interface{}) Account_Contact {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Contact) Mask(mask string) Account_Contact {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2834,11 +4957,26 @@ func (r Account_Contact) Limit(limit int) Account_Contact {
 	return r
 }
 
+func (r Account_Contact) Unlimited() Account_Contact {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Contact) Offset(offset int) Account_Contact {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Contact) Timeout(timeout time.Duration) Account_Contact {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Contact) WithContext(ctx context.Context) Account_Contact {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method creates an account contact. The accountId is fixed, other properties can be set during creation. The typeId indicates the SoftLayer_Account_Contact_Type for the contact. This method returns the SoftLayer_Account_Contact object that is created.
 func (r Account_Contact) CreateObject(templateObject *datatypes.Account_Contact) (resp datatypes.Account_Contact, err error) {
 	params := []interface{}{
@@ -2903,6 +5041,11 @@ func (r Account_External_Setup) Id(id int) Account_External_Setup {
 	return r
 }
 
+func (r Account_External_Setup) InitParameter(name string, value interface{}) Account_External_Setup {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_External_Setup) Mask(mask string) Account_External_Setup {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2922,11 +5065,26 @@ func (r Account_External_Setup) Limit(limit int) Account_External_Setup {
 	return r
 }
 
+func (r Account_External_Setup) Unlimited() Account_External_Setup {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_External_Setup) Offset(offset int) Account_External_Setup {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_External_Setup) Timeout(timeout time.Duration) Account_External_Setup {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_External_Setup) WithContext(ctx context.Context) Account_External_Setup {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Calling this method signals that the account with the provided account id is ready to be billed by the external billing system.
 func (r Account_External_Setup) FinalizeExternalBillingForAccount(accountId *int) (resp datatypes.Container_Account_External_Setup_ProvisioningHoldLifted, err error) {
 	params := []interface{}{
@@ -2948,6 +5106,14 @@ func (r Account_External_Setup) GetVerifyCardTransaction() (resp datatypes.Billi
 	return
 }
 
+// GetVerifyCardTransactionWith is GetVerifyCardTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_External_Setup) GetVerifyCardTransactionWith(options sl.Options) (resp datatypes.Billing_Payment_Card_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVerifyCardTransaction()
+}
+
 // no documentation yet
 type Account_Historical_Report struct {
 	Session *session.Session
@@ -2964,6 +5130,11 @@ func (r Account_Historical_Report) Id(id int) Account_Historical_Report {
 	return r
 }
 
+func (r Account_Historical_Report) InitParameter(name string, value interface{}) Account_Historical_Report {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Historical_Report) Mask(mask string) Account_Historical_Report {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2983,11 +5154,26 @@ func (r Account_Historical_Report) Limit(limit int) Account_Historical_Report {
 	return r
 }
 
+func (r Account_Historical_Report) Unlimited() Account_Historical_Report {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Historical_Report) Offset(offset int) Account_Historical_Report {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Historical_Report) Timeout(timeout time.Duration) Account_Historical_Report {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Historical_Report) WithContext(ctx context.Context) Account_Historical_Report {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Historical_Report) GetAccountHostUptimeGraphData(startDate *string, endDate *string) (resp datatypes.Container_Graph, err error) {
 	params := []interface{}{
@@ -3088,6 +5274,11 @@ func (r Account_Internal_Ibm) Id(id int) Account_Internal_Ibm {
 	return r
 }
 
+func (r Account_Internal_Ibm) InitParameter(name string, value interface{}) Account_Internal_Ibm {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Internal_Ibm) Mask(mask string) Account_Internal_Ibm {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3107,11 +5298,26 @@ func (r Account_Internal_Ibm) Limit(limit int) Account_Internal_Ibm {
 	return r
 }
 
+func (r Account_Internal_Ibm) Unlimited() Account_Internal_Ibm {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Internal_Ibm) Offset(offset int) Account_Internal_Ibm {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Internal_Ibm) Timeout(timeout time.Duration) Account_Internal_Ibm {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Internal_Ibm) WithContext(ctx context.Context) Account_Internal_Ibm {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Validates request and, if the request is approved, returns a list of allowed uses for an automatically created IBMer IaaS account.
 func (r Account_Internal_Ibm) GetAccountTypes() (resp []string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Internal_Ibm", "getAccountTypes", nil, &r.Options, &resp)
@@ -3210,6 +5416,11 @@ func (r Account_Link_Bluemix) Id(id int) Account_Link_Bluemix {
 	return r
 }
 
+func (r Account_Link_Bluemix) InitParameter(name string, value interface{}) Account_Link_Bluemix {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Link_Bluemix) Mask(mask string) Account_Link_Bluemix {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3229,11 +5440,26 @@ func (r Account_Link_Bluemix) Limit(limit int) Account_Link_Bluemix {
 	return r
 }
 
+func (r Account_Link_Bluemix) Unlimited() Account_Link_Bluemix {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Link_Bluemix) Offset(offset int) Account_Link_Bluemix {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Link_Bluemix) Timeout(timeout time.Duration) Account_Link_Bluemix {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Link_Bluemix) WithContext(ctx context.Context) Account_Link_Bluemix {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Link_Bluemix) GetObject() (resp datatypes.Account_Link_Bluemix, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Link_Bluemix", "getObject", nil, &r.Options, &resp)
@@ -3262,6 +5488,11 @@ func (r Account_Link_OpenStack) Id(id int) Account_Link_OpenStack {
 	return r
 }
 
+func (r Account_Link_OpenStack) InitParameter(name string, value interface{}) Account_Link_OpenStack {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Link_OpenStack) Mask(mask string) Account_Link_OpenStack {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3281,11 +5512,26 @@ func (r Account_Link_OpenStack) Limit(limit int) Account_Link_OpenStack {
 	return r
 }
 
+func (r Account_Link_OpenStack) Unlimited() Account_Link_OpenStack {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Link_OpenStack) Offset(offset int) Account_Link_OpenStack {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Link_OpenStack) Timeout(timeout time.Duration) Account_Link_OpenStack {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Link_OpenStack) WithContext(ctx context.Context) Account_Link_OpenStack {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Link_OpenStack) CreateOSDomain(request *datatypes.Account_Link_OpenStack_LinkRequest) (resp datatypes.Account_Link_OpenStack_DomainCreationDetails, err error) {
 	params := []interface{}{
@@ -3365,6 +5611,11 @@ func (r Account_Lockdown_Request) Id(id int) Account_Lockdown_Request {
 	return r
 }
 
+func (r Account_Lockdown_Request) InitParameter(name string, value interface{}) Account_Lockdown_Request {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Lockdown_Request) Mask(mask string) Account_Lockdown_Request {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3384,11 +5635,26 @@ func (r Account_Lockdown_Request) Limit(limit int) Account_Lockdown_Request {
 	return r
 }
 
+func (r Account_Lockdown_Request) Unlimited() Account_Lockdown_Request {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Lockdown_Request) Offset(offset int) Account_Lockdown_Request {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Lockdown_Request) Timeout(timeout time.Duration) Account_Lockdown_Request {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Lockdown_Request) WithContext(ctx context.Context) Account_Lockdown_Request {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Will cancel a lockdown request scheduled in the future. Once canceled, the lockdown request cannot be reconciled and new requests must be made for subsequent actions on the account.
 func (r Account_Lockdown_Request) CancelRequest() (err error) {
 	var resp datatypes.Void
@@ -3455,6 +5721,11 @@ func (r Account_MasterServiceAgreement) Id(id int) Account_MasterServiceAgreemen
 	return r
 }
 
+func (r Account_MasterServiceAgreement) InitParameter(name string, value interface{}) Account_MasterServiceAgreement {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_MasterServiceAgreement) Mask(mask string) Account_MasterServiceAgreement {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3474,11 +5745,26 @@ func (r Account_MasterServiceAgreement) Limit(limit int) Account_MasterServiceAg
 	return r
 }
 
+func (r Account_MasterServiceAgreement) Unlimited() Account_MasterServiceAgreement {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_MasterServiceAgreement) Offset(offset int) Account_MasterServiceAgreement {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_MasterServiceAgreement) Timeout(timeout time.Duration) Account_MasterServiceAgreement {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_MasterServiceAgreement) WithContext(ctx context.Context) Account_MasterServiceAgreement {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Account_MasterServiceAgreement) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_MasterServiceAgreement", "getAccount", nil, &r.Options, &resp)
@@ -3513,6 +5799,11 @@ func (r Account_Media) Id(id int) Account_Media {
 	return r
 }
 
+func (r Account_Media) InitParameter(name string, value interface{}) Account_Media {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Media) Mask(mask string) Account_Media {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3532,11 +5823,26 @@ func (r Account_Media) Limit(limit int) Account_Media {
 	return r
 }
 
+func (r Account_Media) Unlimited() Account_Media {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Media) Offset(offset int) Account_Media {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Media) Timeout(timeout time.Duration) Account_Media {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Media) WithContext(ctx context.Context) Account_Media {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Edit the properties of a media record by passing in a modified instance of a SoftLayer_Account_Media object.
 func (r Account_Media) EditObject(templateObject *datatypes.Account_Media) (resp bool, err error) {
 	params := []interface{}{
@@ -3552,6 +5858,14 @@ func (r Account_Media) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve a list supported media types for SoftLayer's Data Transfer Service.
 func (r Account_Media) GetAllMediaTypes() (resp []datatypes.Account_Media_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media", "getAllMediaTypes", nil, &r.Options, &resp)
@@ -3564,24 +5878,56 @@ func (r Account_Media) GetCreateUser() (resp datatypes.User_Customer, err error)
 	return
 }
 
+// GetCreateUserWith is GetCreateUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media) GetCreateUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCreateUser()
+}
+
 // Retrieve The datacenter where the media resides.
 func (r Account_Media) GetDatacenter() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media", "getDatacenter", nil, &r.Options, &resp)
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // Retrieve The employee who last modified the media.
 func (r Account_Media) GetModifyEmployee() (resp datatypes.User_Employee, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media", "getModifyEmployee", nil, &r.Options, &resp)
 	return
 }
 
+// GetModifyEmployeeWith is GetModifyEmployee, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media) GetModifyEmployeeWith(options sl.Options) (resp datatypes.User_Employee, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetModifyEmployee()
+}
+
 // Retrieve The customer user who last modified the media.
 func (r Account_Media) GetModifyUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media", "getModifyUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetModifyUserWith is GetModifyUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media) GetModifyUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetModifyUser()
+}
+
 // no documentation yet
 func (r Account_Media) GetObject() (resp datatypes.Account_Media, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media", "getObject", nil, &r.Options, &resp)
@@ -3594,18 +5940,42 @@ func (r Account_Media) GetRequest() (resp datatypes.Account_Media_Data_Transfer_
 	return
 }
 
+// GetRequestWith is GetRequest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media) GetRequestWith(options sl.Options) (resp datatypes.Account_Media_Data_Transfer_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRequest()
+}
+
 // Retrieve The media's type.
 func (r Account_Media) GetType() (resp datatypes.Account_Media_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media) GetTypeWith(options sl.Options) (resp datatypes.Account_Media_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Retrieve A guest's associated EVault network storage service account.
 func (r Account_Media) GetVolume() (resp datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media", "getVolume", nil, &r.Options, &resp)
 	return
 }
 
+// GetVolumeWith is GetVolume, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media) GetVolumeWith(options sl.Options) (resp datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVolume()
+}
+
 // Remove a media from a SoftLayer account's list of media. The media record is not deleted.
 func (r Account_Media) RemoveMediaFromList(mediaTemplate *datatypes.Account_Media) (resp int, err error) {
 	params := []interface{}{
@@ -3631,6 +6001,11 @@ func (r Account_Media_Data_Transfer_Request) Id(id int) Account_Media_Data_Trans
 	return r
 }
 
+func (r Account_Media_Data_Transfer_Request) InitParameter(name string, value interface{}) Account_Media_Data_Transfer_Request {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Media_Data_Transfer_Request) Mask(mask string) Account_Media_Data_Transfer_Request {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3650,11 +6025,26 @@ func (r Account_Media_Data_Transfer_Request) Limit(limit int) Account_Media_Data
 	return r
 }
 
+func (r Account_Media_Data_Transfer_Request) Unlimited() Account_Media_Data_Transfer_Request {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Media_Data_Transfer_Request) Offset(offset int) Account_Media_Data_Transfer_Request {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Media_Data_Transfer_Request) Timeout(timeout time.Duration) Account_Media_Data_Transfer_Request {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Media_Data_Transfer_Request) WithContext(ctx context.Context) Account_Media_Data_Transfer_Request {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Edit the properties of a data transfer request record by passing in a modified instance of a SoftLayer_Account_Media_Data_Transfer_Request object.
 func (r Account_Media_Data_Transfer_Request) EditObject(templateObject *datatypes.Account_Media_Data_Transfer_Request) (resp bool, err error) {
 	params := []interface{}{
@@ -3670,12 +6060,28 @@ func (r Account_Media_Data_Transfer_Request) GetAccount() (resp datatypes.Accoun
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media_Data_Transfer_Request) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The active tickets that are attached to the data transfer request.
 func (r Account_Media_Data_Transfer_Request) GetActiveTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media_Data_Transfer_Request", "getActiveTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveTicketsWith is GetActiveTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media_Data_Transfer_Request) GetActiveTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTickets()
+}
+
 // Retrieves a list of all the possible statuses to which a request may be set.
 func (r Account_Media_Data_Transfer_Request) GetAllRequestStatuses() (resp []datatypes.Account_Media_Data_Transfer_Request_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media_Data_Transfer_Request", "getAllRequestStatuses", nil, &r.Options, &resp)
@@ -3688,30 +6094,70 @@ func (r Account_Media_Data_Transfer_Request) GetBillingItem() (resp datatypes.Bi
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media_Data_Transfer_Request) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The customer user who created the request.
 func (r Account_Media_Data_Transfer_Request) GetCreateUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media_Data_Transfer_Request", "getCreateUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetCreateUserWith is GetCreateUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media_Data_Transfer_Request) GetCreateUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCreateUser()
+}
+
 // Retrieve The media of the request.
 func (r Account_Media_Data_Transfer_Request) GetMedia() (resp datatypes.Account_Media, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media_Data_Transfer_Request", "getMedia", nil, &r.Options, &resp)
 	return
 }
 
+// GetMediaWith is GetMedia, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media_Data_Transfer_Request) GetMediaWith(options sl.Options) (resp datatypes.Account_Media, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMedia()
+}
+
 // Retrieve The employee who last modified the request.
 func (r Account_Media_Data_Transfer_Request) GetModifyEmployee() (resp datatypes.User_Employee, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media_Data_Transfer_Request", "getModifyEmployee", nil, &r.Options, &resp)
 	return
 }
 
+// GetModifyEmployeeWith is GetModifyEmployee, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media_Data_Transfer_Request) GetModifyEmployeeWith(options sl.Options) (resp datatypes.User_Employee, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetModifyEmployee()
+}
+
 // Retrieve The customer user who last modified the request.
 func (r Account_Media_Data_Transfer_Request) GetModifyUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media_Data_Transfer_Request", "getModifyUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetModifyUserWith is GetModifyUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media_Data_Transfer_Request) GetModifyUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetModifyUser()
+}
+
 // no documentation yet
 func (r Account_Media_Data_Transfer_Request) GetObject() (resp datatypes.Account_Media_Data_Transfer_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media_Data_Transfer_Request", "getObject", nil, &r.Options, &resp)
@@ -3724,18 +6170,42 @@ func (r Account_Media_Data_Transfer_Request) GetShipments() (resp []datatypes.Ac
 	return
 }
 
+// GetShipmentsWith is GetShipments, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media_Data_Transfer_Request) GetShipmentsWith(options sl.Options) (resp []datatypes.Account_Shipment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetShipments()
+}
+
 // Retrieve The status of the request.
 func (r Account_Media_Data_Transfer_Request) GetStatus() (resp datatypes.Account_Media_Data_Transfer_Request_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media_Data_Transfer_Request", "getStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media_Data_Transfer_Request) GetStatusWith(options sl.Options) (resp datatypes.Account_Media_Data_Transfer_Request_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // Retrieve All tickets that are attached to the data transfer request.
 func (r Account_Media_Data_Transfer_Request) GetTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Media_Data_Transfer_Request", "getTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetTicketsWith is GetTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Media_Data_Transfer_Request) GetTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTickets()
+}
+
 // no documentation yet
 type Account_Note struct {
 	Session *session.Session
@@ -3752,6 +6222,11 @@ func (r Account_Note) Id(id int) Account_Note {
 	return r
 }
 
+func (r Account_Note) InitParameter(name string, value interface{}) Account_Note {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Note) Mask(mask string) Account_Note {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3771,11 +6246,26 @@ func (r Account_Note) Limit(limit int) Account_Note {
 	return r
 }
 
+func (r Account_Note) Unlimited() Account_Note {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Note) Offset(offset int) Account_Note {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Note) Timeout(timeout time.Duration) Account_Note {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Note) WithContext(ctx context.Context) Account_Note {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Note) CreateObject(templateObject *datatypes.Account_Note) (resp datatypes.Account_Note, err error) {
 	params := []interface{}{
@@ -3846,6 +6336,11 @@ func (r Account_Note_Type) Id(id int) Account_Note_Type {
 	return r
 }
 
+func (r Account_Note_Type) InitParameter(name string, value interface{}) Account_Note_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Note_Type) Mask(mask string) Account_Note_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3865,11 +6360,26 @@ func (r Account_Note_Type) Limit(limit int) Account_Note_Type {
 	return r
 }
 
+func (r Account_Note_Type) Unlimited() Account_Note_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Note_Type) Offset(offset int) Account_Note_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Note_Type) Timeout(timeout time.Duration) Account_Note_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Note_Type) WithContext(ctx context.Context) Account_Note_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Note_Type) CreateObject(templateObject *datatypes.Account_Note_Type) (resp datatypes.Account_Note_Type, err error) {
 	params := []interface{}{
@@ -3922,6 +6432,11 @@ func (r Account_Partner_Referral_Prospect) Id(id int) Account_Partner_Referral_P
 	return r
 }
 
+func (r Account_Partner_Referral_Prospect) InitParameter(name string, value interface{}) Account_Partner_Referral_Prospect {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Partner_Referral_Prospect) Mask(mask string) Account_Partner_Referral_Prospect {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3941,11 +6456,26 @@ func (r Account_Partner_Referral_Prospect) Limit(limit int) Account_Partner_Refe
 	return r
 }
 
+func (r Account_Partner_Referral_Prospect) Unlimited() Account_Partner_Referral_Prospect {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Partner_Referral_Prospect) Offset(offset int) Account_Partner_Referral_Prospect {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Partner_Referral_Prospect) Timeout(timeout time.Duration) Account_Partner_Referral_Prospect {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Partner_Referral_Prospect) WithContext(ctx context.Context) Account_Partner_Referral_Prospect {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a new Referral Partner Prospect
 func (r Account_Partner_Referral_Prospect) CreateProspect(templateObject *datatypes.Container_Referral_Partner_Prospect, commit *bool) (resp datatypes.Account_Partner_Referral_Prospect, err error) {
 	params := []interface{}{
@@ -3984,6 +6514,11 @@ func (r Account_Password) Id(id int) Account_Password {
 	return r
 }
 
+func (r Account_Password) InitParameter(name string, value interface{}) Account_Password {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Password) Mask(mask string) Account_Password {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4003,11 +6538,26 @@ func (r Account_Password) Limit(limit int) Account_Password {
 	return r
 }
 
+func (r Account_Password) Unlimited() Account_Password {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Password) Offset(offset int) Account_Password {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Password) Timeout(timeout time.Duration) Account_Password {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Password) WithContext(ctx context.Context) Account_Password {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // The password and/or notes may be modified.  Modifying the EVault passwords here will also update the password the Webcc interface will use.
 func (r Account_Password) EditObject(templateObject *datatypes.Account_Password) (resp bool, err error) {
 	params := []interface{}{
@@ -4035,6 +6585,14 @@ func (r Account_Password) GetType() (resp datatypes.Account_Password_Type, err e
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Password) GetTypeWith(options sl.Options) (resp datatypes.Account_Password_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // no documentation yet
 type Account_PersonalData_RemoveRequestReview struct {
 	Session *session.Session
@@ -4051,6 +6609,11 @@ func (r Account_PersonalData_RemoveRequestReview) Id(id int) Account_PersonalDat
 	return r
 }
 
+func (r Account_PersonalData_RemoveRequestReview) InitParameter(name string, value interface{}) Account_PersonalData_RemoveRequestReview {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_PersonalData_RemoveRequestReview) Mask(mask string) Account_PersonalData_RemoveRequestReview {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4070,11 +6633,26 @@ func (r Account_PersonalData_RemoveRequestReview) Limit(limit int) Account_Perso
 	return r
 }
 
+func (r Account_PersonalData_RemoveRequestReview) Unlimited() Account_PersonalData_RemoveRequestReview {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_PersonalData_RemoveRequestReview) Offset(offset int) Account_PersonalData_RemoveRequestReview {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_PersonalData_RemoveRequestReview) Timeout(timeout time.Duration) Account_PersonalData_RemoveRequestReview {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_PersonalData_RemoveRequestReview) WithContext(ctx context.Context) Account_PersonalData_RemoveRequestReview {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Approve a personal information removal request.
 func (r Account_PersonalData_RemoveRequestReview) ApproveRequest(requestId *int, accessToken *string) (err error) {
 	var resp datatypes.Void
@@ -4159,6 +6737,11 @@ func (r Account_ProofOfConcept) Id(id int) Account_ProofOfConcept {
 	return r
 }
 
+func (r Account_ProofOfConcept) InitParameter(name string, value interface{}) Account_ProofOfConcept {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_ProofOfConcept) Mask(mask string) Account_ProofOfConcept {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4173,13 +6756,28 @@ func (r Account_ProofOfConcept) Filter(filter string) Account_ProofOfConcept {
 	return r
 }
 
-func (r Account_ProofOfConcept) Limit(limit int) Account_ProofOfConcept {
-	r.Options.Limit = &limit
+func (r Account_ProofOfConcept) Limit(limit int) Account_ProofOfConcept {
+	r.Options.Limit = &limit
+	return r
+}
+
+func (r Account_ProofOfConcept) Unlimited() Account_ProofOfConcept {
+	r.Options.Unlimited = true
+	return r
+}
+
+func (r Account_ProofOfConcept) Offset(offset int) Account_ProofOfConcept {
+	r.Options.Offset = &offset
+	return r
+}
+
+func (r Account_ProofOfConcept) Timeout(timeout time.Duration) Account_ProofOfConcept {
+	r.Options.Timeout = timeout
 	return r
 }
 
-func (r Account_ProofOfConcept) Offset(offset int) Account_ProofOfConcept {
-	r.Options.Offset = &offset
+func (r Account_ProofOfConcept) WithContext(ctx context.Context) Account_ProofOfConcept {
+	r.Session = r.Session.SetContext(ctx)
 	return r
 }
 
@@ -4370,6 +6968,11 @@ func (r Account_ProofOfConcept_Approver) Id(id int) Account_ProofOfConcept_Appro
 	return r
 }
 
+func (r Account_ProofOfConcept_Approver) InitParameter(name string, value interface{}) Account_ProofOfConcept_Approver {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_ProofOfConcept_Approver) Mask(mask string) Account_ProofOfConcept_Approver {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4389,11 +6992,26 @@ func (r Account_ProofOfConcept_Approver) Limit(limit int) Account_ProofOfConcept
 	return r
 }
 
+func (r Account_ProofOfConcept_Approver) Unlimited() Account_ProofOfConcept_Approver {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_ProofOfConcept_Approver) Offset(offset int) Account_ProofOfConcept_Approver {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_ProofOfConcept_Approver) Timeout(timeout time.Duration) Account_ProofOfConcept_Approver {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_ProofOfConcept_Approver) WithContext(ctx context.Context) Account_ProofOfConcept_Approver {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieves a list of reviewers
 func (r Account_ProofOfConcept_Approver) GetAllObjects() (resp []datatypes.Account_ProofOfConcept_Approver, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_ProofOfConcept_Approver", "getAllObjects", nil, &r.Options, &resp)
@@ -4434,6 +7052,11 @@ func (r Account_ProofOfConcept_Approver_Role) Id(id int) Account_ProofOfConcept_
 	return r
 }
 
+func (r Account_ProofOfConcept_Approver_Role) InitParameter(name string, value interface{}) Account_ProofOfConcept_Approver_Role {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_ProofOfConcept_Approver_Role) Mask(mask string) Account_ProofOfConcept_Approver_Role {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4453,11 +7076,26 @@ func (r Account_ProofOfConcept_Approver_Role) Limit(limit int) Account_ProofOfCo
 	return r
 }
 
+func (r Account_ProofOfConcept_Approver_Role) Unlimited() Account_ProofOfConcept_Approver_Role {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_ProofOfConcept_Approver_Role) Offset(offset int) Account_ProofOfConcept_Approver_Role {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_ProofOfConcept_Approver_Role) Timeout(timeout time.Duration) Account_ProofOfConcept_Approver_Role {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_ProofOfConcept_Approver_Role) WithContext(ctx context.Context) Account_ProofOfConcept_Approver_Role {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_ProofOfConcept_Approver_Role) GetObject() (resp datatypes.Account_ProofOfConcept_Approver_Role, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_ProofOfConcept_Approver_Role", "getObject", nil, &r.Options, &resp)
@@ -4480,6 +7118,11 @@ func (r Account_ProofOfConcept_Approver_Type) Id(id int) Account_ProofOfConcept_
 	return r
 }
 
+func (r Account_ProofOfConcept_Approver_Type) InitParameter(name string, value interface{}) Account_ProofOfConcept_Approver_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_ProofOfConcept_Approver_Type) Mask(mask string) Account_ProofOfConcept_Approver_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4499,11 +7142,26 @@ func (r Account_ProofOfConcept_Approver_Type) Limit(limit int) Account_ProofOfCo
 	return r
 }
 
+func (r Account_ProofOfConcept_Approver_Type) Unlimited() Account_ProofOfConcept_Approver_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_ProofOfConcept_Approver_Type) Offset(offset int) Account_ProofOfConcept_Approver_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_ProofOfConcept_Approver_Type) Timeout(timeout time.Duration) Account_ProofOfConcept_Approver_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_ProofOfConcept_Approver_Type) WithContext(ctx context.Context) Account_ProofOfConcept_Approver_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Account_ProofOfConcept_Approver_Type) GetApprovers() (resp []datatypes.Account_ProofOfConcept_Approver, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_ProofOfConcept_Approver_Type", "getApprovers", nil, &r.Options, &resp)
@@ -4532,6 +7190,11 @@ func (r Account_ProofOfConcept_Funding_Type) Id(id int) Account_ProofOfConcept_F
 	return r
 }
 
+func (r Account_ProofOfConcept_Funding_Type) InitParameter(name string, value interface{}) Account_ProofOfConcept_Funding_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_ProofOfConcept_Funding_Type) Mask(mask string) Account_ProofOfConcept_Funding_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4551,11 +7214,26 @@ func (r Account_ProofOfConcept_Funding_Type) Limit(limit int) Account_ProofOfCon
 	return r
 }
 
+func (r Account_ProofOfConcept_Funding_Type) Unlimited() Account_ProofOfConcept_Funding_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_ProofOfConcept_Funding_Type) Offset(offset int) Account_ProofOfConcept_Funding_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_ProofOfConcept_Funding_Type) Timeout(timeout time.Duration) Account_ProofOfConcept_Funding_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_ProofOfConcept_Funding_Type) WithContext(ctx context.Context) Account_ProofOfConcept_Funding_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_ProofOfConcept_Funding_Type) GetAllObjects() (resp []datatypes.Account_ProofOfConcept_Funding_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_ProofOfConcept_Funding_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -4600,6 +7278,11 @@ func (r Account_Regional_Registry_Detail) Id(id int) Account_Regional_Registry_D
 	return r
 }
 
+func (r Account_Regional_Registry_Detail) InitParameter(name string, value interface{}) Account_Regional_Registry_Detail {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Regional_Registry_Detail) Mask(mask string) Account_Regional_Registry_Detail {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4619,11 +7302,26 @@ func (r Account_Regional_Registry_Detail) Limit(limit int) Account_Regional_Regi
 	return r
 }
 
+func (r Account_Regional_Registry_Detail) Unlimited() Account_Regional_Registry_Detail {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Regional_Registry_Detail) Offset(offset int) Account_Regional_Registry_Detail {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Regional_Registry_Detail) Timeout(timeout time.Duration) Account_Regional_Registry_Detail {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Regional_Registry_Detail) WithContext(ctx context.Context) Account_Regional_Registry_Detail {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // <style type="text/css">.create_object > li > div { padding-top: .5em; padding-bottom: .5em}</style> This method will create a new SoftLayer_Account_Regional_Registry_Detail object.
 //
 // <b>Input</b> - [[SoftLayer_Account_Regional_Registry_Detail (type)|SoftLayer_Account_Regional_Registry_Detail]] <ul class="create_object"> <li><code>detailTypeId</code> <div>The [[SoftLayer_Account_Regional_Registry_Detail_Type|type id]] of this detail object</div> <ul> <li><b>Required</b></li> <li><b>Type</b> - integer</li> </ul> </li> <li><code>regionalInternetRegistryHandleId</code> <div> The id of the [[SoftLayer_Account_Rwhois_Handle|RWhois handle]] object. This is only to be used for detailed registrations, where a subnet is registered to an organization. The associated handle will be required to be a valid organization object id at the relevant registry. In this case, the detail object will only be valid for the registry the organization belongs to. </div> <ul> <li><b>Optional</b></li> <li><b>Type</b> - integer</li> </ul> </li> </ul>
@@ -4656,18 +7354,42 @@ func (r Account_Regional_Registry_Detail) GetAccount() (resp datatypes.Account,
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Regional_Registry_Detail) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The associated type of this detail object.
 func (r Account_Regional_Registry_Detail) GetDetailType() (resp datatypes.Account_Regional_Registry_Detail_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Regional_Registry_Detail", "getDetailType", nil, &r.Options, &resp)
 	return
 }
 
+// GetDetailTypeWith is GetDetailType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Regional_Registry_Detail) GetDetailTypeWith(options sl.Options) (resp datatypes.Account_Regional_Registry_Detail_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDetailType()
+}
+
 // Retrieve References to the [[SoftLayer_Network_Subnet_Registration|registration objects]] that consume this detail object.
 func (r Account_Regional_Registry_Detail) GetDetails() (resp []datatypes.Network_Subnet_Registration_Details, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Regional_Registry_Detail", "getDetails", nil, &r.Options, &resp)
 	return
 }
 
+// GetDetailsWith is GetDetails, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Regional_Registry_Detail) GetDetailsWith(options sl.Options) (resp []datatypes.Network_Subnet_Registration_Details, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDetails()
+}
+
 // no documentation yet
 func (r Account_Regional_Registry_Detail) GetObject() (resp datatypes.Account_Regional_Registry_Detail, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Regional_Registry_Detail", "getObject", nil, &r.Options, &resp)
@@ -4680,12 +7402,28 @@ func (r Account_Regional_Registry_Detail) GetProperties() (resp []datatypes.Acco
 	return
 }
 
+// GetPropertiesWith is GetProperties, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Regional_Registry_Detail) GetPropertiesWith(options sl.Options) (resp []datatypes.Account_Regional_Registry_Detail_Property, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProperties()
+}
+
 // Retrieve The associated RWhois handle of this detail object. Used only when detailed reassignments are necessary.
 func (r Account_Regional_Registry_Detail) GetRegionalInternetRegistryHandle() (resp datatypes.Account_Rwhois_Handle, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Regional_Registry_Detail", "getRegionalInternetRegistryHandle", nil, &r.Options, &resp)
 	return
 }
 
+// GetRegionalInternetRegistryHandleWith is GetRegionalInternetRegistryHandle, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Regional_Registry_Detail) GetRegionalInternetRegistryHandleWith(options sl.Options) (resp datatypes.Account_Rwhois_Handle, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRegionalInternetRegistryHandle()
+}
+
 // This method will create a bulk transaction to update any registrations that reference this detail object. It should only be called from a child class such as [[SoftLayer_Account_Regional_Registry_Detail_Person]] or [[SoftLayer_Account_Regional_Registry_Detail_Network]]. The registrations should be in the Open or Registration_Complete status.
 func (r Account_Regional_Registry_Detail) UpdateReferencedRegistrations() (resp datatypes.Container_Network_Subnet_Registration_TransactionDetails, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Regional_Registry_Detail", "updateReferencedRegistrations", nil, &r.Options, &resp)
@@ -4708,6 +7446,11 @@ func (r Account_Regional_Registry_Detail_Property) Id(id int) Account_Regional_R
 	return r
 }
 
+func (r Account_Regional_Registry_Detail_Property) InitParameter(name string, value interface{}) Account_Regional_Registry_Detail_Property {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Regional_Registry_Detail_Property) Mask(mask string) Account_Regional_Registry_Detail_Property {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4727,11 +7470,26 @@ func (r Account_Regional_Registry_Detail_Property) Limit(limit int) Account_Regi
 	return r
 }
 
+func (r Account_Regional_Registry_Detail_Property) Unlimited() Account_Regional_Registry_Detail_Property {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Regional_Registry_Detail_Property) Offset(offset int) Account_Regional_Registry_Detail_Property {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Regional_Registry_Detail_Property) Timeout(timeout time.Duration) Account_Regional_Registry_Detail_Property {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Regional_Registry_Detail_Property) WithContext(ctx context.Context) Account_Regional_Registry_Detail_Property {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // <style type="text/css">.create_object > li > div { padding-top: .5em; padding-bottom: .5em}</style> This method will create a new SoftLayer_Account_Regional_Registry_Detail_Property object.
 //
 // <b>Input</b> - [[SoftLayer_Account_Regional_Registry_Detail_Property (type)|SoftLayer_Account_Regional_Registry_Detail_Property]] <ul class="create_object"> <li><code>registrationDetailId</code> <div>The numeric ID of the [[SoftLayer_Account_Regional_Registry_Detail|detail object]] this property belongs to</div> <ul> <li><b>Required</b></li> <li><b>Type</b> - integer</li> </ul> </li> <li><code>propertyTypeId</code> <div> The numeric ID of the associated [[SoftLayer_Account_Regional_Registry_Detail_Property_Type]] object </div> <ul> <li><b>Required</b></li> <li><b>Type</b> - integer</li> </ul> </li> <li><code>sequencePosition</code> <div> When more than one property of the same type exists on a detail object, this value determines the position in that collection. This can be thought of more as a sort order. </div> <ul> <li><b>Required</b></li> <li><b>Type</b> - integer</li> </ul> </li> <li><code>value</code> <div> The actual value of the property. </div> <ul> <li><b>Required</b></li> <li><b>Type</b> - string</li> </ul> </li> </ul>
@@ -4782,6 +7540,14 @@ func (r Account_Regional_Registry_Detail_Property) GetDetail() (resp datatypes.A
 	return
 }
 
+// GetDetailWith is GetDetail, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Regional_Registry_Detail_Property) GetDetailWith(options sl.Options) (resp datatypes.Account_Regional_Registry_Detail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDetail()
+}
+
 // no documentation yet
 func (r Account_Regional_Registry_Detail_Property) GetObject() (resp datatypes.Account_Regional_Registry_Detail_Property, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Regional_Registry_Detail_Property", "getObject", nil, &r.Options, &resp)
@@ -4794,6 +7560,14 @@ func (r Account_Regional_Registry_Detail_Property) GetPropertyType() (resp datat
 	return
 }
 
+// GetPropertyTypeWith is GetPropertyType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Regional_Registry_Detail_Property) GetPropertyTypeWith(options sl.Options) (resp datatypes.Account_Regional_Registry_Detail_Property_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPropertyType()
+}
+
 // Subnet Registration Detail Property Type objects describe the nature of a [[SoftLayer_Account_Regional_Registry_Detail_Property]] object. These types use [http://php.net/pcre.pattern.php Perl-Compatible Regular Expressions] to validate the value of a property object.
 type Account_Regional_Registry_Detail_Property_Type struct {
 	Session *session.Session
@@ -4810,6 +7584,11 @@ func (r Account_Regional_Registry_Detail_Property_Type) Id(id int) Account_Regio
 	return r
 }
 
+func (r Account_Regional_Registry_Detail_Property_Type) InitParameter(name string, value interface{}) Account_Regional_Registry_Detail_Property_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Regional_Registry_Detail_Property_Type) Mask(mask string) Account_Regional_Registry_Detail_Property_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4829,11 +7608,26 @@ func (r Account_Regional_Registry_Detail_Property_Type) Limit(limit int) Account
 	return r
 }
 
+func (r Account_Regional_Registry_Detail_Property_Type) Unlimited() Account_Regional_Registry_Detail_Property_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Regional_Registry_Detail_Property_Type) Offset(offset int) Account_Regional_Registry_Detail_Property_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Regional_Registry_Detail_Property_Type) Timeout(timeout time.Duration) Account_Regional_Registry_Detail_Property_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Regional_Registry_Detail_Property_Type) WithContext(ctx context.Context) Account_Regional_Registry_Detail_Property_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Regional_Registry_Detail_Property_Type) GetAllObjects() (resp []datatypes.Account_Regional_Registry_Detail_Property_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Regional_Registry_Detail_Property_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -4864,6 +7658,11 @@ func (r Account_Regional_Registry_Detail_Type) Id(id int) Account_Regional_Regis
 	return r
 }
 
+func (r Account_Regional_Registry_Detail_Type) InitParameter(name string, value interface{}) Account_Regional_Registry_Detail_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Regional_Registry_Detail_Type) Mask(mask string) Account_Regional_Registry_Detail_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4883,11 +7682,26 @@ func (r Account_Regional_Registry_Detail_Type) Limit(limit int) Account_Regional
 	return r
 }
 
+func (r Account_Regional_Registry_Detail_Type) Unlimited() Account_Regional_Registry_Detail_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Regional_Registry_Detail_Type) Offset(offset int) Account_Regional_Registry_Detail_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Regional_Registry_Detail_Type) Timeout(timeout time.Duration) Account_Regional_Registry_Detail_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Regional_Registry_Detail_Type) WithContext(ctx context.Context) Account_Regional_Registry_Detail_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Regional_Registry_Detail_Type) GetAllObjects() (resp []datatypes.Account_Regional_Registry_Detail_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Regional_Registry_Detail_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -4916,6 +7730,11 @@ func (r Account_Reports_Request) Id(id int) Account_Reports_Request {
 	return r
 }
 
+func (r Account_Reports_Request) InitParameter(name string, value interface{}) Account_Reports_Request {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Reports_Request) Mask(mask string) Account_Reports_Request {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4935,11 +7754,26 @@ func (r Account_Reports_Request) Limit(limit int) Account_Reports_Request {
 	return r
 }
 
+func (r Account_Reports_Request) Unlimited() Account_Reports_Request {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Reports_Request) Offset(offset int) Account_Reports_Request {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Reports_Request) Timeout(timeout time.Duration) Account_Reports_Request {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Reports_Request) WithContext(ctx context.Context) Account_Reports_Request {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Reports_Request) CreateRequest(contact *datatypes.Account_Contact, reason *string, reportType *string) (resp datatypes.Account_Reports_Request, err error) {
 	params := []interface{}{
@@ -4963,6 +7797,14 @@ func (r Account_Reports_Request) GetAccountContact() (resp datatypes.Account_Con
 	return
 }
 
+// GetAccountContactWith is GetAccountContact, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Reports_Request) GetAccountContactWith(options sl.Options) (resp datatypes.Account_Contact, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountContact()
+}
+
 // no documentation yet
 func (r Account_Reports_Request) GetAllObjects() (resp datatypes.Account_Reports_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Reports_Request", "getAllObjects", nil, &r.Options, &resp)
@@ -4981,6 +7823,14 @@ func (r Account_Reports_Request) GetReportType() (resp datatypes.Compliance_Repo
 	return
 }
 
+// GetReportTypeWith is GetReportType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Reports_Request) GetReportTypeWith(options sl.Options) (resp datatypes.Compliance_Report_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReportType()
+}
+
 // no documentation yet
 func (r Account_Reports_Request) GetRequestByRequestKey(requestKey *string) (resp datatypes.Account_Reports_Request, err error) {
 	params := []interface{}{
@@ -5002,6 +7852,14 @@ func (r Account_Reports_Request) GetUser() (resp datatypes.User_Customer, err er
 	return
 }
 
+// GetUserWith is GetUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Reports_Request) GetUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUser()
+}
+
 // no documentation yet
 func (r Account_Reports_Request) SendReportEmail(request *datatypes.Account_Reports_Request) (resp bool, err error) {
 	params := []interface{}{
@@ -5036,6 +7894,11 @@ func (r Account_Shipment) Id(id int) Account_Shipment {
 	return r
 }
 
+func (r Account_Shipment) InitParameter(name string, value interface{}) Account_Shipment {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Shipment) Mask(mask string) Account_Shipment {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5055,11 +7918,26 @@ func (r Account_Shipment) Limit(limit int) Account_Shipment {
 	return r
 }
 
+func (r Account_Shipment) Unlimited() Account_Shipment {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Shipment) Offset(offset int) Account_Shipment {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Shipment) Timeout(timeout time.Duration) Account_Shipment {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Shipment) WithContext(ctx context.Context) Account_Shipment {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Edit the properties of a shipment record by passing in a modified instance of a SoftLayer_Account_Shipment object.
 func (r Account_Shipment) EditObject(templateObject *datatypes.Account_Shipment) (resp bool, err error) {
 	params := []interface{}{
@@ -5075,6 +7953,14 @@ func (r Account_Shipment) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve a list of available shipping couriers.
 func (r Account_Shipment) GetAllCouriers() (resp []datatypes.Auxiliary_Shipping_Courier, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment", "getAllCouriers", nil, &r.Options, &resp)
@@ -5108,36 +7994,84 @@ func (r Account_Shipment) GetCourier() (resp datatypes.Auxiliary_Shipping_Courie
 	return
 }
 
+// GetCourierWith is GetCourier, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment) GetCourierWith(options sl.Options) (resp datatypes.Auxiliary_Shipping_Courier, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCourier()
+}
+
 // Retrieve The employee who created the shipment.
 func (r Account_Shipment) GetCreateEmployee() (resp datatypes.User_Employee, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment", "getCreateEmployee", nil, &r.Options, &resp)
 	return
 }
 
+// GetCreateEmployeeWith is GetCreateEmployee, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment) GetCreateEmployeeWith(options sl.Options) (resp datatypes.User_Employee, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCreateEmployee()
+}
+
 // Retrieve The customer user who created the shipment.
 func (r Account_Shipment) GetCreateUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment", "getCreateUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetCreateUserWith is GetCreateUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment) GetCreateUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCreateUser()
+}
+
 // Retrieve The address at which the shipment is received.
 func (r Account_Shipment) GetDestinationAddress() (resp datatypes.Account_Address, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment", "getDestinationAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetDestinationAddressWith is GetDestinationAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment) GetDestinationAddressWith(options sl.Options) (resp datatypes.Account_Address, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDestinationAddress()
+}
+
 // Retrieve The employee who last modified the shipment.
 func (r Account_Shipment) GetModifyEmployee() (resp datatypes.User_Employee, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment", "getModifyEmployee", nil, &r.Options, &resp)
 	return
 }
 
+// GetModifyEmployeeWith is GetModifyEmployee, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment) GetModifyEmployeeWith(options sl.Options) (resp datatypes.User_Employee, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetModifyEmployee()
+}
+
 // Retrieve The customer user who last modified the shipment.
 func (r Account_Shipment) GetModifyUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment", "getModifyUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetModifyUserWith is GetModifyUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment) GetModifyUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetModifyUser()
+}
+
 // no documentation yet
 func (r Account_Shipment) GetObject() (resp datatypes.Account_Shipment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment", "getObject", nil, &r.Options, &resp)
@@ -5150,30 +8084,70 @@ func (r Account_Shipment) GetOriginationAddress() (resp datatypes.Account_Addres
 	return
 }
 
+// GetOriginationAddressWith is GetOriginationAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment) GetOriginationAddressWith(options sl.Options) (resp datatypes.Account_Address, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOriginationAddress()
+}
+
 // Retrieve The items in the shipment.
 func (r Account_Shipment) GetShipmentItems() (resp []datatypes.Account_Shipment_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment", "getShipmentItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetShipmentItemsWith is GetShipmentItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment) GetShipmentItemsWith(options sl.Options) (resp []datatypes.Account_Shipment_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetShipmentItems()
+}
+
 // Retrieve The status of the shipment.
 func (r Account_Shipment) GetStatus() (resp datatypes.Account_Shipment_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment", "getStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment) GetStatusWith(options sl.Options) (resp datatypes.Account_Shipment_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // Retrieve The tracking data for the shipment.
 func (r Account_Shipment) GetTrackingData() (resp []datatypes.Account_Shipment_Tracking_Data, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment", "getTrackingData", nil, &r.Options, &resp)
 	return
 }
 
+// GetTrackingDataWith is GetTrackingData, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment) GetTrackingDataWith(options sl.Options) (resp []datatypes.Account_Shipment_Tracking_Data, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTrackingData()
+}
+
 // Retrieve The type of shipment (e.g. for Data Transfer Service or Colocation Service).
 func (r Account_Shipment) GetType() (resp datatypes.Account_Shipment_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment) GetTypeWith(options sl.Options) (resp datatypes.Account_Shipment_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // The SoftLayer_Account_Shipment_Item data type contains information relating to a shipment's item. Basic information such as addresses, the shipment courier, and any tracking information for as shipment is accessible with this data type.
 type Account_Shipment_Item struct {
 	Session *session.Session
@@ -5190,6 +8164,11 @@ func (r Account_Shipment_Item) Id(id int) Account_Shipment_Item {
 	return r
 }
 
+func (r Account_Shipment_Item) InitParameter(name string, value interface{}) Account_Shipment_Item {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Shipment_Item) Mask(mask string) Account_Shipment_Item {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5209,11 +8188,26 @@ func (r Account_Shipment_Item) Limit(limit int) Account_Shipment_Item {
 	return r
 }
 
+func (r Account_Shipment_Item) Unlimited() Account_Shipment_Item {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Shipment_Item) Offset(offset int) Account_Shipment_Item {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Shipment_Item) Timeout(timeout time.Duration) Account_Shipment_Item {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Shipment_Item) WithContext(ctx context.Context) Account_Shipment_Item {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Edit the properties of a shipment record by passing in a modified instance of a SoftLayer_Account_Shipment_Item object.
 func (r Account_Shipment_Item) EditObject(templateObject *datatypes.Account_Shipment_Item) (resp bool, err error) {
 	params := []interface{}{
@@ -5235,12 +8229,28 @@ func (r Account_Shipment_Item) GetShipment() (resp datatypes.Account_Shipment, e
 	return
 }
 
+// GetShipmentWith is GetShipment, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment_Item) GetShipmentWith(options sl.Options) (resp datatypes.Account_Shipment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetShipment()
+}
+
 // Retrieve The type of this shipment item.
 func (r Account_Shipment_Item) GetShipmentItemType() (resp datatypes.Account_Shipment_Item_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment_Item", "getShipmentItemType", nil, &r.Options, &resp)
 	return
 }
 
+// GetShipmentItemTypeWith is GetShipmentItemType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment_Item) GetShipmentItemTypeWith(options sl.Options) (resp datatypes.Account_Shipment_Item_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetShipmentItemType()
+}
+
 // no documentation yet
 type Account_Shipment_Item_Type struct {
 	Session *session.Session
@@ -5257,6 +8267,11 @@ func (r Account_Shipment_Item_Type) Id(id int) Account_Shipment_Item_Type {
 	return r
 }
 
+func (r Account_Shipment_Item_Type) InitParameter(name string, value interface{}) Account_Shipment_Item_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Shipment_Item_Type) Mask(mask string) Account_Shipment_Item_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5276,11 +8291,26 @@ func (r Account_Shipment_Item_Type) Limit(limit int) Account_Shipment_Item_Type
 	return r
 }
 
+func (r Account_Shipment_Item_Type) Unlimited() Account_Shipment_Item_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Shipment_Item_Type) Offset(offset int) Account_Shipment_Item_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Shipment_Item_Type) Timeout(timeout time.Duration) Account_Shipment_Item_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Shipment_Item_Type) WithContext(ctx context.Context) Account_Shipment_Item_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Shipment_Item_Type) GetObject() (resp datatypes.Account_Shipment_Item_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment_Item_Type", "getObject", nil, &r.Options, &resp)
@@ -5303,6 +8333,11 @@ func (r Account_Shipment_Resource_Type) Id(id int) Account_Shipment_Resource_Typ
 	return r
 }
 
+func (r Account_Shipment_Resource_Type) InitParameter(name string, value interface{}) Account_Shipment_Resource_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Shipment_Resource_Type) Mask(mask string) Account_Shipment_Resource_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5322,11 +8357,26 @@ func (r Account_Shipment_Resource_Type) Limit(limit int) Account_Shipment_Resour
 	return r
 }
 
+func (r Account_Shipment_Resource_Type) Unlimited() Account_Shipment_Resource_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Shipment_Resource_Type) Offset(offset int) Account_Shipment_Resource_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Shipment_Resource_Type) Timeout(timeout time.Duration) Account_Shipment_Resource_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Shipment_Resource_Type) WithContext(ctx context.Context) Account_Shipment_Resource_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Shipment_Resource_Type) GetObject() (resp datatypes.Account_Shipment_Resource_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment_Resource_Type", "getObject", nil, &r.Options, &resp)
@@ -5349,6 +8399,11 @@ func (r Account_Shipment_Status) Id(id int) Account_Shipment_Status {
 	return r
 }
 
+func (r Account_Shipment_Status) InitParameter(name string, value interface{}) Account_Shipment_Status {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Shipment_Status) Mask(mask string) Account_Shipment_Status {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5368,11 +8423,26 @@ func (r Account_Shipment_Status) Limit(limit int) Account_Shipment_Status {
 	return r
 }
 
+func (r Account_Shipment_Status) Unlimited() Account_Shipment_Status {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Shipment_Status) Offset(offset int) Account_Shipment_Status {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Shipment_Status) Timeout(timeout time.Duration) Account_Shipment_Status {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Shipment_Status) WithContext(ctx context.Context) Account_Shipment_Status {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Shipment_Status) GetObject() (resp datatypes.Account_Shipment_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment_Status", "getObject", nil, &r.Options, &resp)
@@ -5395,6 +8465,11 @@ func (r Account_Shipment_Tracking_Data) Id(id int) Account_Shipment_Tracking_Dat
 	return r
 }
 
+func (r Account_Shipment_Tracking_Data) InitParameter(name string, value interface{}) Account_Shipment_Tracking_Data {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Shipment_Tracking_Data) Mask(mask string) Account_Shipment_Tracking_Data {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5414,11 +8489,26 @@ func (r Account_Shipment_Tracking_Data) Limit(limit int) Account_Shipment_Tracki
 	return r
 }
 
+func (r Account_Shipment_Tracking_Data) Unlimited() Account_Shipment_Tracking_Data {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Shipment_Tracking_Data) Offset(offset int) Account_Shipment_Tracking_Data {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Shipment_Tracking_Data) Timeout(timeout time.Duration) Account_Shipment_Tracking_Data {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Shipment_Tracking_Data) WithContext(ctx context.Context) Account_Shipment_Tracking_Data {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a new shipment tracking data. The ''shipmentId'', ''sequence'', and ''trackingData'' properties in the templateObject parameter are required parameters to create a tracking data record.
 func (r Account_Shipment_Tracking_Data) CreateObject(templateObject *datatypes.Account_Shipment_Tracking_Data) (resp datatypes.Account_Shipment_Tracking_Data, err error) {
 	params := []interface{}{
@@ -5458,24 +8548,56 @@ func (r Account_Shipment_Tracking_Data) GetCreateEmployee() (resp datatypes.User
 	return
 }
 
+// GetCreateEmployeeWith is GetCreateEmployee, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment_Tracking_Data) GetCreateEmployeeWith(options sl.Options) (resp datatypes.User_Employee, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCreateEmployee()
+}
+
 // Retrieve The customer user who created the tracking datum.
 func (r Account_Shipment_Tracking_Data) GetCreateUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment_Tracking_Data", "getCreateUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetCreateUserWith is GetCreateUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment_Tracking_Data) GetCreateUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCreateUser()
+}
+
 // Retrieve The employee who last modified the tracking datum.
 func (r Account_Shipment_Tracking_Data) GetModifyEmployee() (resp datatypes.User_Employee, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment_Tracking_Data", "getModifyEmployee", nil, &r.Options, &resp)
 	return
 }
 
+// GetModifyEmployeeWith is GetModifyEmployee, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment_Tracking_Data) GetModifyEmployeeWith(options sl.Options) (resp datatypes.User_Employee, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetModifyEmployee()
+}
+
 // Retrieve The customer user who last modified the tracking datum.
 func (r Account_Shipment_Tracking_Data) GetModifyUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment_Tracking_Data", "getModifyUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetModifyUserWith is GetModifyUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment_Tracking_Data) GetModifyUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetModifyUser()
+}
+
 // no documentation yet
 func (r Account_Shipment_Tracking_Data) GetObject() (resp datatypes.Account_Shipment_Tracking_Data, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment_Tracking_Data", "getObject", nil, &r.Options, &resp)
@@ -5488,6 +8610,14 @@ func (r Account_Shipment_Tracking_Data) GetShipment() (resp datatypes.Account_Sh
 	return
 }
 
+// GetShipmentWith is GetShipment, with the mask, filter and result limit of options applied to
+// this call only
+func (r Account_Shipment_Tracking_Data) GetShipmentWith(options sl.Options) (resp datatypes.Account_Shipment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetShipment()
+}
+
 // no documentation yet
 type Account_Shipment_Type struct {
 	Session *session.Session
@@ -5504,6 +8634,11 @@ func (r Account_Shipment_Type) Id(id int) Account_Shipment_Type {
 	return r
 }
 
+func (r Account_Shipment_Type) InitParameter(name string, value interface{}) Account_Shipment_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Account_Shipment_Type) Mask(mask string) Account_Shipment_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5523,11 +8658,26 @@ func (r Account_Shipment_Type) Limit(limit int) Account_Shipment_Type {
 	return r
 }
 
+func (r Account_Shipment_Type) Unlimited() Account_Shipment_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Account_Shipment_Type) Offset(offset int) Account_Shipment_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Account_Shipment_Type) Timeout(timeout time.Duration) Account_Shipment_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Account_Shipment_Type) WithContext(ctx context.Context) Account_Shipment_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Account_Shipment_Type) GetObject() (resp datatypes.Account_Shipment_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Account_Shipment_Type", "getObject", nil, &r.Options, &resp)
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Auxiliary_Marketing_Event) Id(id int) Auxiliary_Marketing_Event {
 	return r
 }
 
+func (r Auxiliary_Marketing_Event) InitParameter(name string, value interface{}) Auxiliary_Marketing_Event {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Auxiliary_Marketing_Event) Mask(mask string) Auxiliary_Marketing_Event {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Auxiliary_Marketing_Event) Limit(limit int) Auxiliary_Marketing_Event {
 	return r
 }
 
+func (r Auxiliary_Marketing_Event) Unlimited() Auxiliary_Marketing_Event {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Auxiliary_Marketing_Event) Offset(offset int) Auxiliary_Marketing_Event {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Auxiliary_Marketing_Event) Timeout(timeout time.Duration) Auxiliary_Marketing_Event {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Auxiliary_Marketing_Event) WithContext(ctx context.Context) Auxiliary_Marketing_Event {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method will return a collection of SoftLayer_Auxiliary_Marketing_Event objects ordered in ascending order by start date.
 func (r Auxiliary_Marketing_Event) GetMarketingEvents() (resp []datatypes.Auxiliary_Marketing_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Auxiliary_Marketing_Event", "getMarketingEvents", nil, &r.Options, &resp)
@@ -97,6 +119,11 @@ func (r Auxiliary_Network_Status) Id(id int) Auxiliary_Network_Status {
 	return r
 }
 
+func (r Auxiliary_Network_Status) InitParameter(name string, value interface{}) Auxiliary_Network_Status {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Auxiliary_Network_Status) Mask(mask string) Auxiliary_Network_Status {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -116,11 +143,26 @@ func (r Auxiliary_Network_Status) Limit(limit int) Auxiliary_Network_Status {
 	return r
 }
 
+func (r Auxiliary_Network_Status) Unlimited() Auxiliary_Network_Status {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Auxiliary_Network_Status) Offset(offset int) Auxiliary_Network_Status {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Auxiliary_Network_Status) Timeout(timeout time.Duration) Auxiliary_Network_Status {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Auxiliary_Network_Status) WithContext(ctx context.Context) Auxiliary_Network_Status {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Return the current network status of and latency information for a given target from numerous points around the world. Valid Targets:
 // * ALL
 // * NETWORK_DALLAS
@@ -157,6 +199,11 @@ func (r Auxiliary_Notification_Emergency) Id(id int) Auxiliary_Notification_Emer
 	return r
 }
 
+func (r Auxiliary_Notification_Emergency) InitParameter(name string, value interface{}) Auxiliary_Notification_Emergency {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Auxiliary_Notification_Emergency) Mask(mask string) Auxiliary_Notification_Emergency {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -176,11 +223,26 @@ func (r Auxiliary_Notification_Emergency) Limit(limit int) Auxiliary_Notificatio
 	return r
 }
 
+func (r Auxiliary_Notification_Emergency) Unlimited() Auxiliary_Notification_Emergency {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Auxiliary_Notification_Emergency) Offset(offset int) Auxiliary_Notification_Emergency {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Auxiliary_Notification_Emergency) Timeout(timeout time.Duration) Auxiliary_Notification_Emergency {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Auxiliary_Notification_Emergency) WithContext(ctx context.Context) Auxiliary_Notification_Emergency {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve an array of SoftLayer_Auxiliary_Notification_Emergency data types, which contain all notification events regardless of status.
 func (r Auxiliary_Notification_Emergency) GetAllObjects() (resp []datatypes.Auxiliary_Notification_Emergency, err error) {
 	err = r.Session.DoRequest("SoftLayer_Auxiliary_Notification_Emergency", "getAllObjects", nil, &r.Options, &resp)
@@ -205,12 +267,28 @@ func (r Auxiliary_Notification_Emergency) GetSignature() (resp datatypes.Auxilia
 	return
 }
 
+// GetSignatureWith is GetSignature, with the mask, filter and result limit of options applied to
+// this call only
+func (r Auxiliary_Notification_Emergency) GetSignatureWith(options sl.Options) (resp datatypes.Auxiliary_Notification_Emergency_Signature, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSignature()
+}
+
 // Retrieve The status of this notification.
 func (r Auxiliary_Notification_Emergency) GetStatus() (resp datatypes.Auxiliary_Notification_Emergency_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Auxiliary_Notification_Emergency", "getStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Auxiliary_Notification_Emergency) GetStatusWith(options sl.Options) (resp datatypes.Auxiliary_Notification_Emergency_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // no documentation yet
 type Auxiliary_Press_Release struct {
 	Session *session.Session
@@ -227,6 +305,11 @@ func (r Auxiliary_Press_Release) Id(id int) Auxiliary_Press_Release {
 	return r
 }
 
+func (r Auxiliary_Press_Release) InitParameter(name string, value interface{}) Auxiliary_Press_Release {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Auxiliary_Press_Release) Mask(mask string) Auxiliary_Press_Release {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -246,11 +329,26 @@ func (r Auxiliary_Press_Release) Limit(limit int) Auxiliary_Press_Release {
 	return r
 }
 
+func (r Auxiliary_Press_Release) Unlimited() Auxiliary_Press_Release {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Auxiliary_Press_Release) Offset(offset int) Auxiliary_Press_Release {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Auxiliary_Press_Release) Timeout(timeout time.Duration) Auxiliary_Press_Release {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Auxiliary_Press_Release) WithContext(ctx context.Context) Auxiliary_Press_Release {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Auxiliary_Press_Release) GetAbout() (resp []datatypes.Auxiliary_Press_Release_About_Press_Release, err error) {
 	err = r.Session.DoRequest("SoftLayer_Auxiliary_Press_Release", "getAbout", nil, &r.Options, &resp)
@@ -325,6 +423,11 @@ func (r Auxiliary_Press_Release_About) Id(id int) Auxiliary_Press_Release_About
 	return r
 }
 
+func (r Auxiliary_Press_Release_About) InitParameter(name string, value interface{}) Auxiliary_Press_Release_About {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Auxiliary_Press_Release_About) Mask(mask string) Auxiliary_Press_Release_About {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -344,11 +447,26 @@ func (r Auxiliary_Press_Release_About) Limit(limit int) Auxiliary_Press_Release_
 	return r
 }
 
+func (r Auxiliary_Press_Release_About) Unlimited() Auxiliary_Press_Release_About {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Auxiliary_Press_Release_About) Offset(offset int) Auxiliary_Press_Release_About {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Auxiliary_Press_Release_About) Timeout(timeout time.Duration) Auxiliary_Press_Release_About {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Auxiliary_Press_Release_About) WithContext(ctx context.Context) Auxiliary_Press_Release_About {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // getObject retrieves the SoftLayer_Auxiliary_Press_Release_About object whose about id number corresponds to the ID number of the init parameter passed to the SoftLayer_Auxiliary_Press_Release service.
 func (r Auxiliary_Press_Release_About) GetObject() (resp datatypes.Auxiliary_Press_Release_About, err error) {
 	err = r.Session.DoRequest("SoftLayer_Auxiliary_Press_Release_About", "getObject", nil, &r.Options, &resp)
@@ -371,6 +489,11 @@ func (r Auxiliary_Press_Release_About_Press_Release) Id(id int) Auxiliary_Press_
 	return r
 }
 
+func (r Auxiliary_Press_Release_About_Press_Release) InitParameter(name string, value interface{}) Auxiliary_Press_Release_About_Press_Release {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Auxiliary_Press_Release_About_Press_Release) Mask(mask string) Auxiliary_Press_Release_About_Press_Release {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -390,11 +513,26 @@ func (r Auxiliary_Press_Release_About_Press_Release) Limit(limit int) Auxiliary_
 	return r
 }
 
+func (r Auxiliary_Press_Release_About_Press_Release) Unlimited() Auxiliary_Press_Release_About_Press_Release {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Auxiliary_Press_Release_About_Press_Release) Offset(offset int) Auxiliary_Press_Release_About_Press_Release {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Auxiliary_Press_Release_About_Press_Release) Timeout(timeout time.Duration) Auxiliary_Press_Release_About_Press_Release {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Auxiliary_Press_Release_About_Press_Release) WithContext(ctx context.Context) Auxiliary_Press_Release_About_Press_Release {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Auxiliary_Press_Release_About_Press_Release) GetAboutParagraphs() (resp []datatypes.Auxiliary_Press_Release_About, err error) {
 	err = r.Session.DoRequest("SoftLayer_Auxiliary_Press_Release_About_Press_Release", "getAboutParagraphs", nil, &r.Options, &resp)
@@ -429,6 +567,11 @@ func (r Auxiliary_Press_Release_Contact) Id(id int) Auxiliary_Press_Release_Cont
 	return r
 }
 
+func (r Auxiliary_Press_Release_Contact) InitParameter(name string, value interface{}) Auxiliary_Press_Release_Contact {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Auxiliary_Press_Release_Contact) Mask(mask string) Auxiliary_Press_Release_Contact {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -448,11 +591,26 @@ func (r Auxiliary_Press_Release_Contact) Limit(limit int) Auxiliary_Press_Releas
 	return r
 }
 
+func (r Auxiliary_Press_Release_Contact) Unlimited() Auxiliary_Press_Release_Contact {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Auxiliary_Press_Release_Contact) Offset(offset int) Auxiliary_Press_Release_Contact {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Auxiliary_Press_Release_Contact) Timeout(timeout time.Duration) Auxiliary_Press_Release_Contact {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Auxiliary_Press_Release_Contact) WithContext(ctx context.Context) Auxiliary_Press_Release_Contact {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // getObject retrieves the SoftLayer_Auxiliary_Press_Release_Contact object whose contact id number corresponds to the ID number of the init parameter passed to the SoftLayer_Auxiliary_Press_Release service.
 func (r Auxiliary_Press_Release_Contact) GetObject() (resp datatypes.Auxiliary_Press_Release_Contact, err error) {
 	err = r.Session.DoRequest("SoftLayer_Auxiliary_Press_Release_Contact", "getObject", nil, &r.Options, &resp)
@@ -475,6 +633,11 @@ func (r Auxiliary_Press_Release_Contact_Press_Release) Id(id int) Auxiliary_Pres
 	return r
 }
 
+func (r Auxiliary_Press_Release_Contact_Press_Release) InitParameter(name string, value interface{}) Auxiliary_Press_Release_Contact_Press_Release {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Auxiliary_Press_Release_Contact_Press_Release) Mask(mask string) Auxiliary_Press_Release_Contact_Press_Release {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -494,11 +657,26 @@ func (r Auxiliary_Press_Release_Contact_Press_Release) Limit(limit int) Auxiliar
 	return r
 }
 
+func (r Auxiliary_Press_Release_Contact_Press_Release) Unlimited() Auxiliary_Press_Release_Contact_Press_Release {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Auxiliary_Press_Release_Contact_Press_Release) Offset(offset int) Auxiliary_Press_Release_Contact_Press_Release {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Auxiliary_Press_Release_Contact_Press_Release) Timeout(timeout time.Duration) Auxiliary_Press_Release_Contact_Press_Release {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Auxiliary_Press_Release_Contact_Press_Release) WithContext(ctx context.Context) Auxiliary_Press_Release_Contact_Press_Release {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Auxiliary_Press_Release_Contact_Press_Release) GetContacts() (resp []datatypes.Auxiliary_Press_Release_Contact, err error) {
 	err = r.Session.DoRequest("SoftLayer_Auxiliary_Press_Release_Contact_Press_Release", "getContacts", nil, &r.Options, &resp)
@@ -533,6 +711,11 @@ func (r Auxiliary_Press_Release_Content) Id(id int) Auxiliary_Press_Release_Cont
 	return r
 }
 
+func (r Auxiliary_Press_Release_Content) InitParameter(name string, value interface{}) Auxiliary_Press_Release_Content {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Auxiliary_Press_Release_Content) Mask(mask string) Auxiliary_Press_Release_Content {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -552,11 +735,26 @@ func (r Auxiliary_Press_Release_Content) Limit(limit int) Auxiliary_Press_Releas
 	return r
 }
 
+func (r Auxiliary_Press_Release_Content) Unlimited() Auxiliary_Press_Release_Content {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Auxiliary_Press_Release_Content) Offset(offset int) Auxiliary_Press_Release_Content {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Auxiliary_Press_Release_Content) Timeout(timeout time.Duration) Auxiliary_Press_Release_Content {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Auxiliary_Press_Release_Content) WithContext(ctx context.Context) Auxiliary_Press_Release_Content {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // getObject retrieves the SoftLayer_Auxiliary_Press_Release_Content object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Auxiliary_Press_Release service.
 func (r Auxiliary_Press_Release_Content) GetObject() (resp datatypes.Auxiliary_Press_Release_Content, err error) {
 	err = r.Session.DoRequest("SoftLayer_Auxiliary_Press_Release_Content", "getObject", nil, &r.Options, &resp)
@@ -579,6 +777,11 @@ func (r Auxiliary_Press_Release_Media_Partner) Id(id int) Auxiliary_Press_Releas
 	return r
 }
 
+func (r Auxiliary_Press_Release_Media_Partner) InitParameter(name string, value interface{}) Auxiliary_Press_Release_Media_Partner {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Auxiliary_Press_Release_Media_Partner) Mask(mask string) Auxiliary_Press_Release_Media_Partner {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -598,11 +801,26 @@ func (r Auxiliary_Press_Release_Media_Partner) Limit(limit int) Auxiliary_Press_
 	return r
 }
 
+func (r Auxiliary_Press_Release_Media_Partner) Unlimited() Auxiliary_Press_Release_Media_Partner {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Auxiliary_Press_Release_Media_Partner) Offset(offset int) Auxiliary_Press_Release_Media_Partner {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Auxiliary_Press_Release_Media_Partner) Timeout(timeout time.Duration) Auxiliary_Press_Release_Media_Partner {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Auxiliary_Press_Release_Media_Partner) WithContext(ctx context.Context) Auxiliary_Press_Release_Media_Partner {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // getObject retrieves the SoftLayer_Auxiliary_Press_Release_Contact object whose contact id number corresponds to the ID number of the init parameter passed to the SoftLayer_Auxiliary_Press_Release service.
 func (r Auxiliary_Press_Release_Media_Partner) GetObject() (resp datatypes.Auxiliary_Press_Release_Media_Partner, err error) {
 	err = r.Session.DoRequest("SoftLayer_Auxiliary_Press_Release_Media_Partner", "getObject", nil, &r.Options, &resp)
@@ -625,6 +843,11 @@ func (r Auxiliary_Press_Release_Media_Partner_Press_Release) Id(id int) Auxiliar
 	return r
 }
 
+func (r Auxiliary_Press_Release_Media_Partner_Press_Release) InitParameter(name string, value interface{}) Auxiliary_Press_Release_Media_Partner_Press_Release {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Auxiliary_Press_Release_Media_Partner_Press_Release) Mask(mask string) Auxiliary_Press_Release_Media_Partner_Press_Release {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -644,11 +867,26 @@ func (r Auxiliary_Press_Release_Media_Partner_Press_Release) Limit(limit int) Au
 	return r
 }
 
+func (r Auxiliary_Press_Release_Media_Partner_Press_Release) Unlimited() Auxiliary_Press_Release_Media_Partner_Press_Release {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Auxiliary_Press_Release_Media_Partner_Press_Release) Offset(offset int) Auxiliary_Press_Release_Media_Partner_Press_Release {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Auxiliary_Press_Release_Media_Partner_Press_Release) Timeout(timeout time.Duration) Auxiliary_Press_Release_Media_Partner_Press_Release {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Auxiliary_Press_Release_Media_Partner_Press_Release) WithContext(ctx context.Context) Auxiliary_Press_Release_Media_Partner_Press_Release {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Auxiliary_Press_Release_Media_Partner_Press_Release) GetMediaPartners() (resp []datatypes.Auxiliary_Press_Release_Media_Partner, err error) {
 	err = r.Session.DoRequest("SoftLayer_Auxiliary_Press_Release_Media_Partner_Press_Release", "getMediaPartners", nil, &r.Options, &resp)
@@ -683,6 +921,11 @@ func (r Auxiliary_Shipping_Courier_Type) Id(id int) Auxiliary_Shipping_Courier_T
 	return r
 }
 
+func (r Auxiliary_Shipping_Courier_Type) InitParameter(name string, value interface{}) Auxiliary_Shipping_Courier_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Auxiliary_Shipping_Courier_Type) Mask(mask string) Auxiliary_Shipping_Courier_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -702,11 +945,26 @@ func (r Auxiliary_Shipping_Courier_Type) Limit(limit int) Auxiliary_Shipping_Cou
 	return r
 }
 
+func (r Auxiliary_Shipping_Courier_Type) Unlimited() Auxiliary_Shipping_Courier_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Auxiliary_Shipping_Courier_Type) Offset(offset int) Auxiliary_Shipping_Courier_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Auxiliary_Shipping_Courier_Type) Timeout(timeout time.Duration) Auxiliary_Shipping_Courier_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Auxiliary_Shipping_Courier_Type) WithContext(ctx context.Context) Auxiliary_Shipping_Courier_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Auxiliary_Shipping_Courier_Type) GetCourier() (resp []datatypes.Auxiliary_Shipping_Courier, err error) {
 	err = r.Session.DoRequest("SoftLayer_Auxiliary_Shipping_Courier_Type", "getCourier", nil, &r.Options, &resp)
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Billing_Currency) Id(id int) Billing_Currency {
 	return r
 }
 
+func (r Billing_Currency) InitParameter(name string, value interface{}) Billing_Currency {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Currency) Mask(mask string) Billing_Currency {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Billing_Currency) Limit(limit int) Billing_Currency {
 	return r
 }
 
+func (r Billing_Currency) Unlimited() Billing_Currency {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Currency) Offset(offset int) Billing_Currency {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Currency) Timeout(timeout time.Duration) Billing_Currency {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Currency) WithContext(ctx context.Context) Billing_Currency {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Billing_Currency) GetAllObjects() (resp []datatypes.Billing_Currency, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Currency", "getAllObjects", nil, &r.Options, &resp)
@@ -81,6 +103,14 @@ func (r Billing_Currency) GetCurrentExchangeRate() (resp datatypes.Billing_Curre
 	return
 }
 
+// GetCurrentExchangeRateWith is GetCurrentExchangeRate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Currency) GetCurrentExchangeRateWith(options sl.Options) (resp datatypes.Billing_Currency_ExchangeRate, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentExchangeRate()
+}
+
 // no documentation yet
 func (r Billing_Currency) GetObject() (resp datatypes.Billing_Currency, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Currency", "getObject", nil, &r.Options, &resp)
@@ -113,6 +143,11 @@ func (r Billing_Currency_Country) Id(id int) Billing_Currency_Country {
 	return r
 }
 
+func (r Billing_Currency_Country) InitParameter(name string, value interface{}) Billing_Currency_Country {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Currency_Country) Mask(mask string) Billing_Currency_Country {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -132,11 +167,26 @@ func (r Billing_Currency_Country) Limit(limit int) Billing_Currency_Country {
 	return r
 }
 
+func (r Billing_Currency_Country) Unlimited() Billing_Currency_Country {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Currency_Country) Offset(offset int) Billing_Currency_Country {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Currency_Country) Timeout(timeout time.Duration) Billing_Currency_Country {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Currency_Country) WithContext(ctx context.Context) Billing_Currency_Country {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Billing_Currency_Country) GetCountriesWithListOfEligibleCurrencies() (resp []datatypes.Container_Billing_Currency_Country, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Currency_Country", "getCountriesWithListOfEligibleCurrencies", nil, &r.Options, &resp)
@@ -165,6 +215,11 @@ func (r Billing_Currency_ExchangeRate) Id(id int) Billing_Currency_ExchangeRate
 	return r
 }
 
+func (r Billing_Currency_ExchangeRate) InitParameter(name string, value interface{}) Billing_Currency_ExchangeRate {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Currency_ExchangeRate) Mask(mask string) Billing_Currency_ExchangeRate {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -184,11 +239,26 @@ func (r Billing_Currency_ExchangeRate) Limit(limit int) Billing_Currency_Exchang
 	return r
 }
 
+func (r Billing_Currency_ExchangeRate) Unlimited() Billing_Currency_ExchangeRate {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Currency_ExchangeRate) Offset(offset int) Billing_Currency_ExchangeRate {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Currency_ExchangeRate) Timeout(timeout time.Duration) Billing_Currency_ExchangeRate {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Currency_ExchangeRate) WithContext(ctx context.Context) Billing_Currency_ExchangeRate {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Billing_Currency_ExchangeRate) GetAllCurrencyExchangeRates(stringDate *string) (resp []datatypes.Billing_Currency_ExchangeRate, err error) {
 	params := []interface{}{
@@ -259,6 +329,11 @@ func (r Billing_Info) Id(id int) Billing_Info {
 	return r
 }
 
+func (r Billing_Info) InitParameter(name string, value interface{}) Billing_Info {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Info) Mask(mask string) Billing_Info {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -278,17 +353,40 @@ func (r Billing_Info) Limit(limit int) Billing_Info {
 	return r
 }
 
+func (r Billing_Info) Unlimited() Billing_Info {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Info) Offset(offset int) Billing_Info {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Info) Timeout(timeout time.Duration) Billing_Info {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Info) WithContext(ctx context.Context) Billing_Info {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The SoftLayer customer account associated with this billing information.
 func (r Billing_Info) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Info", "getAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Info) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve
 func (r Billing_Info) GetAchInformation() (resp []datatypes.Billing_Info_Ach, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Info", "getAchInformation", nil, &r.Options, &resp)
@@ -301,24 +399,56 @@ func (r Billing_Info) GetCurrency() (resp datatypes.Billing_Currency, err error)
 	return
 }
 
+// GetCurrencyWith is GetCurrency, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Info) GetCurrencyWith(options sl.Options) (resp datatypes.Billing_Currency, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrency()
+}
+
 // Retrieve Information related to an account's current and previous billing cycles.
 func (r Billing_Info) GetCurrentBillingCycle() (resp datatypes.Billing_Info_Cycle, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Info", "getCurrentBillingCycle", nil, &r.Options, &resp)
 	return
 }
 
+// GetCurrentBillingCycleWith is GetCurrentBillingCycle, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Info) GetCurrentBillingCycleWith(options sl.Options) (resp datatypes.Billing_Info_Cycle, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentBillingCycle()
+}
+
 // Retrieve The date on which an account was last billed.
 func (r Billing_Info) GetLastBillDate() (resp datatypes.Time, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Info", "getLastBillDate", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastBillDateWith is GetLastBillDate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Info) GetLastBillDateWith(options sl.Options) (resp datatypes.Time, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastBillDate()
+}
+
 // Retrieve The date on which an account will be billed next.
 func (r Billing_Info) GetNextBillDate() (resp datatypes.Time, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Info", "getNextBillDate", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextBillDateWith is GetNextBillDate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Info) GetNextBillDateWith(options sl.Options) (resp datatypes.Time, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextBillDate()
+}
+
 // getObject retrieves the SoftLayer_Billing_Info object whose data corresponds to the account to which your portal user is tied.
 func (r Billing_Info) GetObject() (resp datatypes.Billing_Info, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Info", "getObject", nil, &r.Options, &resp)
@@ -341,6 +471,11 @@ func (r Billing_Invoice) Id(id int) Billing_Invoice {
 	return r
 }
 
+func (r Billing_Invoice) InitParameter(name string, value interface{}) Billing_Invoice {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Invoice) Mask(mask string) Billing_Invoice {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -360,11 +495,26 @@ func (r Billing_Invoice) Limit(limit int) Billing_Invoice {
 	return r
 }
 
+func (r Billing_Invoice) Unlimited() Billing_Invoice {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Invoice) Offset(offset int) Billing_Invoice {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Invoice) Timeout(timeout time.Duration) Billing_Invoice {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Invoice) WithContext(ctx context.Context) Billing_Invoice {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a transaction to email PDF and/or Excel invoice links to the requesting user's email address. You must have a PDF reader installed in order to view these files.
 func (r Billing_Invoice) EmailInvoices(options *datatypes.Container_Billing_Invoice_Email) (err error) {
 	var resp datatypes.Void
@@ -381,12 +531,28 @@ func (r Billing_Invoice) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve This is the amount of this invoice.
 func (r Billing_Invoice) GetAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetAmountWith is GetAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAmount()
+}
+
 // Retrieve
 func (r Billing_Invoice) GetBrandAtInvoiceCreation() (resp datatypes.Brand, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getBrandAtInvoiceCreation", nil, &r.Options, &resp)
@@ -399,6 +565,14 @@ func (r Billing_Invoice) GetDetailedPdfGeneratedFlag() (resp bool, err error) {
 	return
 }
 
+// GetDetailedPdfGeneratedFlagWith is GetDetailedPdfGeneratedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetDetailedPdfGeneratedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDetailedPdfGeneratedFlag()
+}
+
 // Retrieve a Microsoft Excel spreadsheet of a SoftLayer invoice. You must have a Microsoft Excel reader installed in order to view these invoice files.
 func (r Billing_Invoice) GetExcel() (resp []byte, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getExcel", nil, &r.Options, &resp)
@@ -411,54 +585,126 @@ func (r Billing_Invoice) GetInvoiceTopLevelItems() (resp []datatypes.Billing_Inv
 	return
 }
 
+// GetInvoiceTopLevelItemsWith is GetInvoiceTopLevelItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetInvoiceTopLevelItemsWith(options sl.Options) (resp []datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoiceTopLevelItems()
+}
+
 // Retrieve The total amount of this invoice.
 func (r Billing_Invoice) GetInvoiceTotalAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getInvoiceTotalAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetInvoiceTotalAmountWith is GetInvoiceTotalAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetInvoiceTotalAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoiceTotalAmount()
+}
+
 // Retrieve The total one-time charges for this invoice. This is the sum of one-time charges + setup fees + labor fees. This does not include taxes.
 func (r Billing_Invoice) GetInvoiceTotalOneTimeAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getInvoiceTotalOneTimeAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetInvoiceTotalOneTimeAmountWith is GetInvoiceTotalOneTimeAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetInvoiceTotalOneTimeAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoiceTotalOneTimeAmount()
+}
+
 // Retrieve A sum of all the taxes related to one time charges for this invoice.
 func (r Billing_Invoice) GetInvoiceTotalOneTimeTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getInvoiceTotalOneTimeTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetInvoiceTotalOneTimeTaxAmountWith is GetInvoiceTotalOneTimeTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetInvoiceTotalOneTimeTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoiceTotalOneTimeTaxAmount()
+}
+
 // Retrieve The total amount of this invoice. This does not include taxes.
 func (r Billing_Invoice) GetInvoiceTotalPreTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getInvoiceTotalPreTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetInvoiceTotalPreTaxAmountWith is GetInvoiceTotalPreTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetInvoiceTotalPreTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoiceTotalPreTaxAmount()
+}
+
 // Retrieve The total Recurring amount of this invoice. This amount does not include taxes or one time charges.
 func (r Billing_Invoice) GetInvoiceTotalRecurringAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getInvoiceTotalRecurringAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetInvoiceTotalRecurringAmountWith is GetInvoiceTotalRecurringAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetInvoiceTotalRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoiceTotalRecurringAmount()
+}
+
 // Retrieve The total amount of the recurring taxes on this invoice.
 func (r Billing_Invoice) GetInvoiceTotalRecurringTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getInvoiceTotalRecurringTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetInvoiceTotalRecurringTaxAmountWith is GetInvoiceTotalRecurringTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetInvoiceTotalRecurringTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoiceTotalRecurringTaxAmount()
+}
+
 // Retrieve The items that belong to this invoice.
 func (r Billing_Invoice) GetItems() (resp []datatypes.Billing_Invoice_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetItemsWith is GetItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetItemsWith(options sl.Options) (resp []datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItems()
+}
+
 // Retrieve Exchange rate used for billing this invoice.
 func (r Billing_Invoice) GetLocalCurrencyExchangeRate() (resp datatypes.Billing_Currency_ExchangeRate, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getLocalCurrencyExchangeRate", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocalCurrencyExchangeRateWith is GetLocalCurrencyExchangeRate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetLocalCurrencyExchangeRateWith(options sl.Options) (resp datatypes.Billing_Currency_ExchangeRate, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocalCurrencyExchangeRate()
+}
+
 // getObject retrieves the SoftLayer_Billing_Invoice object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Billing_Invoice service. You can only retrieve invoices that are assigned to your portal user's account.
 func (r Billing_Invoice) GetObject() (resp datatypes.Billing_Invoice, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getObject", nil, &r.Options, &resp)
@@ -471,12 +717,28 @@ func (r Billing_Invoice) GetPayment() (resp datatypes.Float64, err error) {
 	return
 }
 
+// GetPaymentWith is GetPayment, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetPaymentWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPayment()
+}
+
 // Retrieve The payments for the invoice.
 func (r Billing_Invoice) GetPayments() (resp []datatypes.Billing_Invoice_Receivable_Payment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getPayments", nil, &r.Options, &resp)
 	return
 }
 
+// GetPaymentsWith is GetPayments, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetPaymentsWith(options sl.Options) (resp []datatypes.Billing_Invoice_Receivable_Payment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPayments()
+}
+
 // Retrieve a PDF record of a SoftLayer invoice. SoftLayer keeps PDF records of all closed invoices for customer retrieval from the portal and API. You must have a PDF reader installed in order to view these invoice files.
 func (r Billing_Invoice) GetPdf() (resp []byte, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getPdf", nil, &r.Options, &resp)
@@ -531,30 +793,70 @@ func (r Billing_Invoice) GetSellerRegistration() (resp string, err error) {
 	return
 }
 
+// GetSellerRegistrationWith is GetSellerRegistration, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetSellerRegistrationWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSellerRegistration()
+}
+
 // Retrieve This is the tax information that applies to tax auditing. This is the official tax record for this invoice.
 func (r Billing_Invoice) GetTaxInfo() (resp datatypes.Billing_Invoice_Tax_Info, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getTaxInfo", nil, &r.Options, &resp)
 	return
 }
 
+// GetTaxInfoWith is GetTaxInfo, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetTaxInfoWith(options sl.Options) (resp datatypes.Billing_Invoice_Tax_Info, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTaxInfo()
+}
+
 // Retrieve This is the set of tax information for any tax calculation for this invoice. Note that not all of these are necessarily official, so use the taxInfo key to get the final information.
 func (r Billing_Invoice) GetTaxInfoHistory() (resp []datatypes.Billing_Invoice_Tax_Info, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getTaxInfoHistory", nil, &r.Options, &resp)
 	return
 }
 
+// GetTaxInfoHistoryWith is GetTaxInfoHistory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetTaxInfoHistoryWith(options sl.Options) (resp []datatypes.Billing_Invoice_Tax_Info, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTaxInfoHistory()
+}
+
 // Retrieve This is a message explaining the tax treatment for this invoice.
 func (r Billing_Invoice) GetTaxMessage() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getTaxMessage", nil, &r.Options, &resp)
 	return
 }
 
+// GetTaxMessageWith is GetTaxMessage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetTaxMessageWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTaxMessage()
+}
+
 // Retrieve This is the strategy used to calculate tax on this invoice.
 func (r Billing_Invoice) GetTaxType() (resp datatypes.Billing_Invoice_Tax_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getTaxType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTaxTypeWith is GetTaxType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice) GetTaxTypeWith(options sl.Options) (resp datatypes.Billing_Invoice_Tax_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTaxType()
+}
+
 // no documentation yet
 func (r Billing_Invoice) GetXlsFilename() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice", "getXlsFilename", nil, &r.Options, &resp)
@@ -583,6 +885,11 @@ func (r Billing_Invoice_Item) Id(id int) Billing_Invoice_Item {
 	return r
 }
 
+func (r Billing_Invoice_Item) InitParameter(name string, value interface{}) Billing_Invoice_Item {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Invoice_Item) Mask(mask string) Billing_Invoice_Item {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -602,71 +909,166 @@ func (r Billing_Invoice_Item) Limit(limit int) Billing_Invoice_Item {
 	return r
 }
 
+func (r Billing_Invoice_Item) Unlimited() Billing_Invoice_Item {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Invoice_Item) Offset(offset int) Billing_Invoice_Item {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Invoice_Item) Timeout(timeout time.Duration) Billing_Invoice_Item {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Invoice_Item) WithContext(ctx context.Context) Billing_Invoice_Item {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve An Invoice Item's associated child invoice items. Only parent invoice items have associated children. For instance, a server invoice item may have associated children.
 func (r Billing_Invoice_Item) GetAssociatedChildren() (resp []datatypes.Billing_Invoice_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getAssociatedChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssociatedChildrenWith is GetAssociatedChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetAssociatedChildrenWith(options sl.Options) (resp []datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssociatedChildren()
+}
+
 // Retrieve An Invoice Item's associated invoice item. If this is populated, it means this is an orphaned invoice item, but logically belongs to the associated invoice item.
 func (r Billing_Invoice_Item) GetAssociatedInvoiceItem() (resp datatypes.Billing_Invoice_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getAssociatedInvoiceItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssociatedInvoiceItemWith is GetAssociatedInvoiceItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetAssociatedInvoiceItemWith(options sl.Options) (resp datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssociatedInvoiceItem()
+}
+
 // Retrieve An Invoice Item's billing item, from which this item was generated.
 func (r Billing_Invoice_Item) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve This invoice item's "item category".
 func (r Billing_Invoice_Item) GetCategory() (resp datatypes.Product_Item_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getCategory", nil, &r.Options, &resp)
 	return
 }
 
+// GetCategoryWith is GetCategory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetCategoryWith(options sl.Options) (resp datatypes.Product_Item_Category, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCategory()
+}
+
 // Retrieve An Invoice Item's child invoice items. Only parent invoice items have children. For instance, a server invoice item will have children.
 func (r Billing_Invoice_Item) GetChildren() (resp []datatypes.Billing_Invoice_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildrenWith is GetChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetChildrenWith(options sl.Options) (resp []datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildren()
+}
+
 // Retrieve An Invoice Item's associated child invoice items, excluding some items with a $0.00 recurring fee. Only parent invoice items have associated children. For instance, a server invoice item may have associated children.
 func (r Billing_Invoice_Item) GetFilteredAssociatedChildren() (resp []datatypes.Billing_Invoice_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getFilteredAssociatedChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetFilteredAssociatedChildrenWith is GetFilteredAssociatedChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetFilteredAssociatedChildrenWith(options sl.Options) (resp []datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFilteredAssociatedChildren()
+}
+
 // Retrieve Indicating whether this invoice item is billed on an hourly basis.
 func (r Billing_Invoice_Item) GetHourlyFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getHourlyFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHourlyFlagWith is GetHourlyFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetHourlyFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyFlag()
+}
+
 // Retrieve The invoice to which this item belongs.
 func (r Billing_Invoice_Item) GetInvoice() (resp datatypes.Billing_Invoice, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getInvoice", nil, &r.Options, &resp)
 	return
 }
 
+// GetInvoiceWith is GetInvoice, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetInvoiceWith(options sl.Options) (resp datatypes.Billing_Invoice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoice()
+}
+
 // Retrieve An invoice item's location, if one exists.'
 func (r Billing_Invoice_Item) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve An Invoice Item's associated child invoice items, excluding ALL items with a $0.00 recurring fee. Only parent invoice items have associated children. For instance, a server invoice item may have associated children.
 func (r Billing_Invoice_Item) GetNonZeroAssociatedChildren() (resp []datatypes.Billing_Invoice_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getNonZeroAssociatedChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetNonZeroAssociatedChildrenWith is GetNonZeroAssociatedChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetNonZeroAssociatedChildrenWith(options sl.Options) (resp []datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNonZeroAssociatedChildren()
+}
+
 // getObject retrieves the SoftLayer_Billing_Invoice_Item object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Billing_Invoice_Item service. You can only retrieve the items tied to the account that your portal user is assigned to.
 func (r Billing_Invoice_Item) GetObject() (resp datatypes.Billing_Invoice_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getObject", nil, &r.Options, &resp)
@@ -679,48 +1081,112 @@ func (r Billing_Invoice_Item) GetParent() (resp datatypes.Billing_Invoice_Item,
 	return
 }
 
+// GetParentWith is GetParent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetParentWith(options sl.Options) (resp datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParent()
+}
+
 // Retrieve The entry in the product catalog that a invoice item is based upon.
 func (r Billing_Invoice_Item) GetProduct() (resp datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getProduct", nil, &r.Options, &resp)
 	return
 }
 
+// GetProductWith is GetProduct, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetProductWith(options sl.Options) (resp datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProduct()
+}
+
 // Retrieve A string representing the name of parent level product group of an invoice item.
 func (r Billing_Invoice_Item) GetTopLevelProductGroupName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getTopLevelProductGroupName", nil, &r.Options, &resp)
 	return
 }
 
+// GetTopLevelProductGroupNameWith is GetTopLevelProductGroupName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetTopLevelProductGroupNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTopLevelProductGroupName()
+}
+
 // Retrieve An invoice Item's total, including any child invoice items if they exist.
 func (r Billing_Invoice_Item) GetTotalOneTimeAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getTotalOneTimeAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetTotalOneTimeAmountWith is GetTotalOneTimeAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetTotalOneTimeAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalOneTimeAmount()
+}
+
 // Retrieve An invoice Item's total, including any child invoice items if they exist.
 func (r Billing_Invoice_Item) GetTotalOneTimeTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getTotalOneTimeTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetTotalOneTimeTaxAmountWith is GetTotalOneTimeTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetTotalOneTimeTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalOneTimeTaxAmount()
+}
+
 // Retrieve An invoice Item's total, including any child invoice items if they exist.
 func (r Billing_Invoice_Item) GetTotalRecurringAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getTotalRecurringAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetTotalRecurringAmountWith is GetTotalRecurringAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetTotalRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalRecurringAmount()
+}
+
 // Retrieve A Billing Item's total, including any child billing items if they exist.'
 func (r Billing_Invoice_Item) GetTotalRecurringTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getTotalRecurringTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetTotalRecurringTaxAmountWith is GetTotalRecurringTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetTotalRecurringTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalRecurringTaxAmount()
+}
+
 // Retrieve Indicating whether this invoice item is for the usage charge.
 func (r Billing_Invoice_Item) GetUsageChargeFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Item", "getUsageChargeFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetUsageChargeFlagWith is GetUsageChargeFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Invoice_Item) GetUsageChargeFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUsageChargeFlag()
+}
+
 // no documentation yet
 type Billing_Invoice_Next struct {
 	Session *session.Session
@@ -737,6 +1203,11 @@ func (r Billing_Invoice_Next) Id(id int) Billing_Invoice_Next {
 	return r
 }
 
+func (r Billing_Invoice_Next) InitParameter(name string, value interface{}) Billing_Invoice_Next {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Invoice_Next) Mask(mask string) Billing_Invoice_Next {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -756,11 +1227,26 @@ func (r Billing_Invoice_Next) Limit(limit int) Billing_Invoice_Next {
 	return r
 }
 
+func (r Billing_Invoice_Next) Unlimited() Billing_Invoice_Next {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Invoice_Next) Offset(offset int) Billing_Invoice_Next {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Invoice_Next) Timeout(timeout time.Duration) Billing_Invoice_Next {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Invoice_Next) WithContext(ctx context.Context) Billing_Invoice_Next {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Return an account's next invoice in a Microsoft excel format.
 func (r Billing_Invoice_Next) GetExcel(documentCreateDate *datatypes.Time) (resp []byte, err error) {
 	params := []interface{}{
@@ -804,6 +1290,11 @@ func (r Billing_Invoice_Tax_Status) Id(id int) Billing_Invoice_Tax_Status {
 	return r
 }
 
+func (r Billing_Invoice_Tax_Status) InitParameter(name string, value interface{}) Billing_Invoice_Tax_Status {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Invoice_Tax_Status) Mask(mask string) Billing_Invoice_Tax_Status {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -823,11 +1314,26 @@ func (r Billing_Invoice_Tax_Status) Limit(limit int) Billing_Invoice_Tax_Status
 	return r
 }
 
+func (r Billing_Invoice_Tax_Status) Unlimited() Billing_Invoice_Tax_Status {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Invoice_Tax_Status) Offset(offset int) Billing_Invoice_Tax_Status {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Invoice_Tax_Status) Timeout(timeout time.Duration) Billing_Invoice_Tax_Status {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Invoice_Tax_Status) WithContext(ctx context.Context) Billing_Invoice_Tax_Status {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Billing_Invoice_Tax_Status) GetAllObjects() (resp []datatypes.Billing_Invoice_Tax_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Tax_Status", "getAllObjects", nil, &r.Options, &resp)
@@ -856,6 +1362,11 @@ func (r Billing_Invoice_Tax_Type) Id(id int) Billing_Invoice_Tax_Type {
 	return r
 }
 
+func (r Billing_Invoice_Tax_Type) InitParameter(name string, value interface{}) Billing_Invoice_Tax_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Invoice_Tax_Type) Mask(mask string) Billing_Invoice_Tax_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -875,11 +1386,26 @@ func (r Billing_Invoice_Tax_Type) Limit(limit int) Billing_Invoice_Tax_Type {
 	return r
 }
 
+func (r Billing_Invoice_Tax_Type) Unlimited() Billing_Invoice_Tax_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Invoice_Tax_Type) Offset(offset int) Billing_Invoice_Tax_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Invoice_Tax_Type) Timeout(timeout time.Duration) Billing_Invoice_Tax_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Invoice_Tax_Type) WithContext(ctx context.Context) Billing_Invoice_Tax_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Billing_Invoice_Tax_Type) GetAllObjects() (resp []datatypes.Billing_Invoice_Tax_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Invoice_Tax_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -910,6 +1436,11 @@ func (r Billing_Item) Id(id int) Billing_Item {
 	return r
 }
 
+func (r Billing_Item) InitParameter(name string, value interface{}) Billing_Item {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Item) Mask(mask string) Billing_Item {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -929,11 +1460,26 @@ func (r Billing_Item) Limit(limit int) Billing_Item {
 	return r
 }
 
+func (r Billing_Item) Unlimited() Billing_Item {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Item) Offset(offset int) Billing_Item {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Item) Timeout(timeout time.Duration) Billing_Item {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Item) WithContext(ctx context.Context) Billing_Item {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Cancel the resource or service for a billing Item. By default the billing item will be canceled on the next bill date and reclaim of the resource will begin shortly after the cancellation. Setting the "cancelImmediately" property to true will start the cancellation immediately if the item is eligible to be canceled immediately.
 //
 // The reason parameter could be from the list below:
@@ -976,6 +1522,14 @@ func (r Billing_Item) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve
 func (r Billing_Item) GetActiveAgreement() (resp datatypes.Account_Agreement, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getActiveAgreement", nil, &r.Options, &resp)
@@ -988,12 +1542,28 @@ func (r Billing_Item) GetActiveAgreementFlag() (resp datatypes.Account_Agreement
 	return
 }
 
+// GetActiveAgreementFlagWith is GetActiveAgreementFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetActiveAgreementFlagWith(options sl.Options) (resp datatypes.Account_Agreement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveAgreementFlag()
+}
+
 // Retrieve A billing item's active associated child billing items. This includes "floating" items that are not necessarily child items of this billing item.
 func (r Billing_Item) GetActiveAssociatedChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getActiveAssociatedChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveAssociatedChildrenWith is GetActiveAssociatedChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetActiveAssociatedChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveAssociatedChildren()
+}
+
 // Retrieve
 func (r Billing_Item) GetActiveAssociatedGuestDiskBillingItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getActiveAssociatedGuestDiskBillingItems", nil, &r.Options, &resp)
@@ -1006,18 +1576,42 @@ func (r Billing_Item) GetActiveBundledItems() (resp []datatypes.Billing_Item, er
 	return
 }
 
+// GetActiveBundledItemsWith is GetActiveBundledItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetActiveBundledItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveBundledItems()
+}
+
 // Retrieve A service cancellation request item that corresponds to the billing item.
 func (r Billing_Item) GetActiveCancellationItem() (resp datatypes.Billing_Item_Cancellation_Request_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getActiveCancellationItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveCancellationItemWith is GetActiveCancellationItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetActiveCancellationItemWith(options sl.Options) (resp datatypes.Billing_Item_Cancellation_Request_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveCancellationItem()
+}
+
 // Retrieve A Billing Item's active child billing items.
 func (r Billing_Item) GetActiveChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getActiveChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveChildrenWith is GetActiveChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetActiveChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveChildren()
+}
+
 // Retrieve
 func (r Billing_Item) GetActiveFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getActiveFlag", nil, &r.Options, &resp)
@@ -1036,30 +1630,70 @@ func (r Billing_Item) GetActiveSparePoolBundledItems() (resp []datatypes.Billing
 	return
 }
 
+// GetActiveSparePoolBundledItemsWith is GetActiveSparePoolBundledItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetActiveSparePoolBundledItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveSparePoolBundledItems()
+}
+
 // Retrieve A billing item's associated parent. This is to be used for billing items that are "floating", and therefore are not child items of any parent billing item. If it is desired to associate an item to another, populate this with the SoftLayer_Billing_Item ID of that associated parent item.
 func (r Billing_Item) GetAssociatedBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getAssociatedBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssociatedBillingItemWith is GetAssociatedBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetAssociatedBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssociatedBillingItem()
+}
+
 // Retrieve A history of billing items which a billing item has been associated with.
 func (r Billing_Item) GetAssociatedBillingItemHistory() (resp []datatypes.Billing_Item_Association_History, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getAssociatedBillingItemHistory", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssociatedBillingItemHistoryWith is GetAssociatedBillingItemHistory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetAssociatedBillingItemHistoryWith(options sl.Options) (resp []datatypes.Billing_Item_Association_History, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssociatedBillingItemHistory()
+}
+
 // Retrieve A Billing Item's associated child billing items. This includes "floating" items that are not necessarily child billing items of this billing item.
 func (r Billing_Item) GetAssociatedChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getAssociatedChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssociatedChildrenWith is GetAssociatedChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetAssociatedChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssociatedChildren()
+}
+
 // Retrieve A billing item's associated parent billing item. This object will be the same as the parent billing item if parentId is set.
 func (r Billing_Item) GetAssociatedParent() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getAssociatedParent", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssociatedParentWith is GetAssociatedParent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetAssociatedParentWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssociatedParent()
+}
+
 // Retrieve
 func (r Billing_Item) GetAvailableMatchingVlans() (resp []datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getAvailableMatchingVlans", nil, &r.Options, &resp)
@@ -1072,138 +1706,322 @@ func (r Billing_Item) GetBandwidthAllocation() (resp datatypes.Network_Bandwidth
 	return
 }
 
+// GetBandwidthAllocationWith is GetBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetBandwidthAllocationWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allocation, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllocation()
+}
+
 // Retrieve A billing item's recurring child items that have once been billed and are scheduled to be billed in the future.
 func (r Billing_Item) GetBillableChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getBillableChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillableChildrenWith is GetBillableChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetBillableChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillableChildren()
+}
+
 // Retrieve A Billing Item's bundled billing items
 func (r Billing_Item) GetBundleItems() (resp []datatypes.Product_Item_Bundles, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getBundleItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetBundleItemsWith is GetBundleItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetBundleItemsWith(options sl.Options) (resp []datatypes.Product_Item_Bundles, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBundleItems()
+}
+
 // Retrieve A Billing Item's bundled billing items'
 func (r Billing_Item) GetBundledItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getBundledItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetBundledItemsWith is GetBundledItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetBundledItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBundledItems()
+}
+
 // Retrieve A Billing Item's active child billing items.
 func (r Billing_Item) GetCanceledChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getCanceledChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetCanceledChildrenWith is GetCanceledChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetCanceledChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCanceledChildren()
+}
+
 // Retrieve The billing item's cancellation reason.
 func (r Billing_Item) GetCancellationReason() (resp datatypes.Billing_Item_Cancellation_Reason, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getCancellationReason", nil, &r.Options, &resp)
 	return
 }
 
+// GetCancellationReasonWith is GetCancellationReason, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetCancellationReasonWith(options sl.Options) (resp datatypes.Billing_Item_Cancellation_Reason, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCancellationReason()
+}
+
 // Retrieve This will return any cancellation requests that are associated with this billing item.
 func (r Billing_Item) GetCancellationRequests() (resp []datatypes.Billing_Item_Cancellation_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getCancellationRequests", nil, &r.Options, &resp)
 	return
 }
 
+// GetCancellationRequestsWith is GetCancellationRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetCancellationRequestsWith(options sl.Options) (resp []datatypes.Billing_Item_Cancellation_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCancellationRequests()
+}
+
 // Retrieve The item category to which the billing item's item belongs.
 func (r Billing_Item) GetCategory() (resp datatypes.Product_Item_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getCategory", nil, &r.Options, &resp)
 	return
 }
 
+// GetCategoryWith is GetCategory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetCategoryWith(options sl.Options) (resp datatypes.Product_Item_Category, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCategory()
+}
+
 // Retrieve A Billing Item's child billing items'
 func (r Billing_Item) GetChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildrenWith is GetChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildren()
+}
+
 // Retrieve A Billing Item's active child billing items.
 func (r Billing_Item) GetChildrenWithActiveAgreement() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getChildrenWithActiveAgreement", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildrenWithActiveAgreementWith is GetChildrenWithActiveAgreement, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetChildrenWithActiveAgreementWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildrenWithActiveAgreement()
+}
+
 // Retrieve For product items which have a downgrade path defined, this will return those product items.
 func (r Billing_Item) GetDowngradeItems() (resp []datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getDowngradeItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetDowngradeItemsWith is GetDowngradeItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetDowngradeItemsWith(options sl.Options) (resp []datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDowngradeItems()
+}
+
 // Retrieve A Billing Item's associated child billing items, excluding some items with a $0.00 recurring fee.
 func (r Billing_Item) GetFilteredNextInvoiceChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getFilteredNextInvoiceChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetFilteredNextInvoiceChildrenWith is GetFilteredNextInvoiceChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetFilteredNextInvoiceChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFilteredNextInvoiceChildren()
+}
+
 // Retrieve A flag that will reflect whether this billing item is billed on an hourly basis or not.
 func (r Billing_Item) GetHourlyFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getHourlyFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHourlyFlagWith is GetHourlyFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetHourlyFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyFlag()
+}
+
 // Retrieve Invoice items associated with this billing item
 func (r Billing_Item) GetInvoiceItem() (resp datatypes.Billing_Invoice_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getInvoiceItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetInvoiceItemWith is GetInvoiceItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetInvoiceItemWith(options sl.Options) (resp datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoiceItem()
+}
+
 // Retrieve All invoice items associated with the billing item
 func (r Billing_Item) GetInvoiceItems() (resp []datatypes.Billing_Invoice_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getInvoiceItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetInvoiceItemsWith is GetInvoiceItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetInvoiceItemsWith(options sl.Options) (resp []datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoiceItems()
+}
+
 // Retrieve The entry in the SoftLayer product catalog that a billing item is based upon.
 func (r Billing_Item) GetItem() (resp datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetItemWith is GetItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetItemWith(options sl.Options) (resp datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItem()
+}
+
 // Retrieve The location of the billing item. Some billing items have physical properties such as the server itself. For items such as these, we provide location information.
 func (r Billing_Item) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve A Billing Item's child billing items and associated items'
 func (r Billing_Item) GetNextInvoiceChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getNextInvoiceChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceChildrenWith is GetNextInvoiceChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetNextInvoiceChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceChildren()
+}
+
 // Retrieve A Billing Item's total, including any child billing items if they exist.'
 func (r Billing_Item) GetNextInvoiceTotalOneTimeAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getNextInvoiceTotalOneTimeAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalOneTimeAmountWith is GetNextInvoiceTotalOneTimeAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetNextInvoiceTotalOneTimeAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalOneTimeAmount()
+}
+
 // Retrieve A Billing Item's total, including any child billing items if they exist.'
 func (r Billing_Item) GetNextInvoiceTotalOneTimeTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getNextInvoiceTotalOneTimeTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalOneTimeTaxAmountWith is GetNextInvoiceTotalOneTimeTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetNextInvoiceTotalOneTimeTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalOneTimeTaxAmount()
+}
+
 // Retrieve A Billing Item's total, including any child billing items and associated billing items if they exist.'
 func (r Billing_Item) GetNextInvoiceTotalRecurringAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getNextInvoiceTotalRecurringAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalRecurringAmountWith is GetNextInvoiceTotalRecurringAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetNextInvoiceTotalRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalRecurringAmount()
+}
+
 // Retrieve This is deprecated and will always be zero. Because tax is calculated in real-time, previewing the next recurring invoice is pre-tax only.
 func (r Billing_Item) GetNextInvoiceTotalRecurringTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getNextInvoiceTotalRecurringTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalRecurringTaxAmountWith is GetNextInvoiceTotalRecurringTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetNextInvoiceTotalRecurringTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalRecurringTaxAmount()
+}
+
 // Retrieve A Billing Item's associated child billing items, excluding ALL items with a $0.00 recurring fee.
 func (r Billing_Item) GetNonZeroNextInvoiceChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getNonZeroNextInvoiceChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetNonZeroNextInvoiceChildrenWith is GetNonZeroNextInvoiceChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetNonZeroNextInvoiceChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNonZeroNextInvoiceChildren()
+}
+
 // getObject retrieves the SoftLayer_Billing_Item object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Billing_Item service. You can only retrieve billing items tied to the account that your portal user is assigned to. Billing items are an account's items of billable items. There are "parent" billing items and "child" billing items. The server billing item is generally referred to as a parent billing item. The items tied to a server, such as ram, harddrives, and operating systems are considered "child" billing items.
 func (r Billing_Item) GetObject() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getObject", nil, &r.Options, &resp)
@@ -1216,48 +2034,112 @@ func (r Billing_Item) GetOrderItem() (resp datatypes.Billing_Order_Item, err err
 	return
 }
 
+// GetOrderItemWith is GetOrderItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetOrderItemWith(options sl.Options) (resp datatypes.Billing_Order_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderItem()
+}
+
 // Retrieve The original physical location for this billing item--may differ from current.
 func (r Billing_Item) GetOriginalLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getOriginalLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetOriginalLocationWith is GetOriginalLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetOriginalLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOriginalLocation()
+}
+
 // Retrieve The package under which this billing item was sold. A Package is the general grouping of products as seen on our order forms.
 func (r Billing_Item) GetPackage() (resp datatypes.Product_Package, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getPackage", nil, &r.Options, &resp)
 	return
 }
 
+// GetPackageWith is GetPackage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetPackageWith(options sl.Options) (resp datatypes.Product_Package, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPackage()
+}
+
 // Retrieve A billing item's parent item. If a billing item has no parent item then this value is null.
 func (r Billing_Item) GetParent() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getParent", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentWith is GetParent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetParentWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParent()
+}
+
 // Retrieve A billing item's parent item. If a billing item has no parent item then this value is null.
 func (r Billing_Item) GetParentVirtualGuestBillingItem() (resp datatypes.Billing_Item_Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getParentVirtualGuestBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentVirtualGuestBillingItemWith is GetParentVirtualGuestBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetParentVirtualGuestBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentVirtualGuestBillingItem()
+}
+
 // Retrieve This flag indicates whether a billing item is scheduled to be canceled or not.
 func (r Billing_Item) GetPendingCancellationFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getPendingCancellationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetPendingCancellationFlagWith is GetPendingCancellationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetPendingCancellationFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingCancellationFlag()
+}
+
 // Retrieve The new order item that will replace this billing item.
 func (r Billing_Item) GetPendingOrderItem() (resp datatypes.Billing_Order_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getPendingOrderItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetPendingOrderItemWith is GetPendingOrderItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetPendingOrderItemWith(options sl.Options) (resp datatypes.Billing_Order_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingOrderItem()
+}
+
 // Retrieve Provisioning transaction for this billing item
 func (r Billing_Item) GetProvisionTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getProvisionTransaction", nil, &r.Options, &resp)
 	return
 }
 
+// GetProvisionTransactionWith is GetProvisionTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetProvisionTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProvisionTransaction()
+}
+
 // This service returns billing items of a specified category code. This service should be used to retrieve billing items that you wish to cancel. Some billing items can be canceled via [[SoftLayer_Security_Certificate_Request|service cancellation]] service.
 //
 // In order to find billing items for cancellation, use [[SoftLayer_Product_Item_Category::getValidCancelableServiceItemCategories|product categories]] service to retrieve category codes that are eligible for cancellation.
@@ -1276,18 +2158,42 @@ func (r Billing_Item) GetSoftwareDescription() (resp datatypes.Software_Descript
 	return
 }
 
+// GetSoftwareDescriptionWith is GetSoftwareDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetSoftwareDescriptionWith(options sl.Options) (resp datatypes.Software_Description, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareDescription()
+}
+
 // Retrieve Billing items whose product item has an upgrade path defined in our system will return the next product item in the upgrade path.
 func (r Billing_Item) GetUpgradeItem() (resp datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getUpgradeItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetUpgradeItemWith is GetUpgradeItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetUpgradeItemWith(options sl.Options) (resp datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeItem()
+}
+
 // Retrieve Billing items whose product item has an upgrade path defined in our system will return all the product items in the upgrade path.
 func (r Billing_Item) GetUpgradeItems() (resp []datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "getUpgradeItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetUpgradeItemsWith is GetUpgradeItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item) GetUpgradeItemsWith(options sl.Options) (resp []datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeItems()
+}
+
 // Remove the association from a billing item.
 func (r Billing_Item) RemoveAssociationId() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item", "removeAssociationId", nil, &r.Options, &resp)
@@ -1325,6 +2231,11 @@ func (r Billing_Item_Cancellation_Reason) Id(id int) Billing_Item_Cancellation_R
 	return r
 }
 
+func (r Billing_Item_Cancellation_Reason) InitParameter(name string, value interface{}) Billing_Item_Cancellation_Reason {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Item_Cancellation_Reason) Mask(mask string) Billing_Item_Cancellation_Reason {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1344,11 +2255,26 @@ func (r Billing_Item_Cancellation_Reason) Limit(limit int) Billing_Item_Cancella
 	return r
 }
 
+func (r Billing_Item_Cancellation_Reason) Unlimited() Billing_Item_Cancellation_Reason {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Item_Cancellation_Reason) Offset(offset int) Billing_Item_Cancellation_Reason {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Item_Cancellation_Reason) Timeout(timeout time.Duration) Billing_Item_Cancellation_Reason {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Item_Cancellation_Reason) WithContext(ctx context.Context) Billing_Item_Cancellation_Reason {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // getAllCancellationReasons() retrieves a list of all cancellation reasons that a server/service may be assigned to.
 func (r Billing_Item_Cancellation_Reason) GetAllCancellationReasons() (resp []datatypes.Billing_Item_Cancellation_Reason, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Cancellation_Reason", "getAllCancellationReasons", nil, &r.Options, &resp)
@@ -1361,12 +2287,28 @@ func (r Billing_Item_Cancellation_Reason) GetBillingCancellationReasonCategory()
 	return
 }
 
+// GetBillingCancellationReasonCategoryWith is GetBillingCancellationReasonCategory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Cancellation_Reason) GetBillingCancellationReasonCategoryWith(options sl.Options) (resp datatypes.Billing_Item_Cancellation_Reason_Category, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCancellationReasonCategory()
+}
+
 // Retrieve The corresponding billing items having the specific cancellation reason.
 func (r Billing_Item_Cancellation_Reason) GetBillingItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Cancellation_Reason", "getBillingItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemsWith is GetBillingItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Cancellation_Reason) GetBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItems()
+}
+
 // no documentation yet
 func (r Billing_Item_Cancellation_Reason) GetObject() (resp datatypes.Billing_Item_Cancellation_Reason, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Cancellation_Reason", "getObject", nil, &r.Options, &resp)
@@ -1395,6 +2337,11 @@ func (r Billing_Item_Cancellation_Reason_Category) Id(id int) Billing_Item_Cance
 	return r
 }
 
+func (r Billing_Item_Cancellation_Reason_Category) InitParameter(name string, value interface{}) Billing_Item_Cancellation_Reason_Category {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Item_Cancellation_Reason_Category) Mask(mask string) Billing_Item_Cancellation_Reason_Category {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1414,11 +2361,26 @@ func (r Billing_Item_Cancellation_Reason_Category) Limit(limit int) Billing_Item
 	return r
 }
 
+func (r Billing_Item_Cancellation_Reason_Category) Unlimited() Billing_Item_Cancellation_Reason_Category {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Item_Cancellation_Reason_Category) Offset(offset int) Billing_Item_Cancellation_Reason_Category {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Item_Cancellation_Reason_Category) Timeout(timeout time.Duration) Billing_Item_Cancellation_Reason_Category {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Item_Cancellation_Reason_Category) WithContext(ctx context.Context) Billing_Item_Cancellation_Reason_Category {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // getAllCancellationReasonCategories() retrieves a list of all cancellation reason categories
 func (r Billing_Item_Cancellation_Reason_Category) GetAllCancellationReasonCategories() (resp []datatypes.Billing_Item_Cancellation_Reason_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Cancellation_Reason_Category", "getAllCancellationReasonCategories", nil, &r.Options, &resp)
@@ -1431,6 +2393,14 @@ func (r Billing_Item_Cancellation_Reason_Category) GetBillingCancellationReasons
 	return
 }
 
+// GetBillingCancellationReasonsWith is GetBillingCancellationReasons, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Cancellation_Reason_Category) GetBillingCancellationReasonsWith(options sl.Options) (resp []datatypes.Billing_Item_Cancellation_Reason, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCancellationReasons()
+}
+
 // no documentation yet
 func (r Billing_Item_Cancellation_Reason_Category) GetObject() (resp datatypes.Billing_Item_Cancellation_Reason_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Cancellation_Reason_Category", "getObject", nil, &r.Options, &resp)
@@ -1453,6 +2423,11 @@ func (r Billing_Item_Cancellation_Request) Id(id int) Billing_Item_Cancellation_
 	return r
 }
 
+func (r Billing_Item_Cancellation_Request) InitParameter(name string, value interface{}) Billing_Item_Cancellation_Request {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Item_Cancellation_Request) Mask(mask string) Billing_Item_Cancellation_Request {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1472,11 +2447,26 @@ func (r Billing_Item_Cancellation_Request) Limit(limit int) Billing_Item_Cancell
 	return r
 }
 
+func (r Billing_Item_Cancellation_Request) Unlimited() Billing_Item_Cancellation_Request {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Item_Cancellation_Request) Offset(offset int) Billing_Item_Cancellation_Request {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Item_Cancellation_Request) Timeout(timeout time.Duration) Billing_Item_Cancellation_Request {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Item_Cancellation_Request) WithContext(ctx context.Context) Billing_Item_Cancellation_Request {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method creates a service cancellation request.
 //
 // You need to have "Cancel Services" privilege to create a cancellation request. You have to provide at least one SoftLayer_Billing_Item_Cancellation_Request_Item in the "items" property. Make sure billing item's category code belongs to the cancelable product codes. You can retrieve the cancelable product category by the [[SoftLayer_Product_Item_Category::getValidCancelableServiceItemCategories|product category]] service.
@@ -1494,6 +2484,14 @@ func (r Billing_Item_Cancellation_Request) GetAccount() (resp datatypes.Account,
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Cancellation_Request) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // This method returns all service cancellation requests.
 //
 // Make sure to include the "resultLimit" in the SOAP request header for quicker response. If there is no result limit header is passed, it will return the latest 25 results by default.
@@ -1520,6 +2518,14 @@ func (r Billing_Item_Cancellation_Request) GetItems() (resp []datatypes.Billing_
 	return
 }
 
+// GetItemsWith is GetItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Cancellation_Request) GetItemsWith(options sl.Options) (resp []datatypes.Billing_Item_Cancellation_Request_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItems()
+}
+
 // getObject retrieves the SoftLayer_Billing_Item_Cancellation_Request object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Billing_Item_Cancellation_Request service. You can only retrieve cancellation request records that are assigned to your SoftLayer account.
 func (r Billing_Item_Cancellation_Request) GetObject() (resp datatypes.Billing_Item_Cancellation_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Cancellation_Request", "getObject", nil, &r.Options, &resp)
@@ -1532,18 +2538,42 @@ func (r Billing_Item_Cancellation_Request) GetStatus() (resp datatypes.Billing_I
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Cancellation_Request) GetStatusWith(options sl.Options) (resp datatypes.Billing_Item_Cancellation_Request_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // Retrieve The ticket that is associated with the service cancellation request.
 func (r Billing_Item_Cancellation_Request) GetTicket() (resp datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Cancellation_Request", "getTicket", nil, &r.Options, &resp)
 	return
 }
 
+// GetTicketWith is GetTicket, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Cancellation_Request) GetTicketWith(options sl.Options) (resp datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTicket()
+}
+
 // Retrieve The user that initiated a service cancellation request.
 func (r Billing_Item_Cancellation_Request) GetUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Cancellation_Request", "getUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserWith is GetUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Cancellation_Request) GetUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUser()
+}
+
 // This method removes a cancellation item from a cancellation request that is in "Pending" or "Approved" status.
 func (r Billing_Item_Cancellation_Request) RemoveCancellationItem(itemId *int) (resp bool, err error) {
 	params := []interface{}{
@@ -1587,6 +2617,11 @@ func (r Billing_Item_Virtual_DedicatedHost) Id(id int) Billing_Item_Virtual_Dedi
 	return r
 }
 
+func (r Billing_Item_Virtual_DedicatedHost) InitParameter(name string, value interface{}) Billing_Item_Virtual_DedicatedHost {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Item_Virtual_DedicatedHost) Mask(mask string) Billing_Item_Virtual_DedicatedHost {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1606,11 +2641,26 @@ func (r Billing_Item_Virtual_DedicatedHost) Limit(limit int) Billing_Item_Virtua
 	return r
 }
 
+func (r Billing_Item_Virtual_DedicatedHost) Unlimited() Billing_Item_Virtual_DedicatedHost {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Item_Virtual_DedicatedHost) Offset(offset int) Billing_Item_Virtual_DedicatedHost {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Item_Virtual_DedicatedHost) Timeout(timeout time.Duration) Billing_Item_Virtual_DedicatedHost {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Item_Virtual_DedicatedHost) WithContext(ctx context.Context) Billing_Item_Virtual_DedicatedHost {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Cancel the resource or service for a billing Item. By default the billing item will be canceled on the next bill date and reclaim of the resource will begin shortly after the cancellation. Setting the "cancelImmediately" property to true will start the cancellation immediately if the item is eligible to be canceled immediately.
 //
 // The reason parameter could be from the list below:
@@ -1653,6 +2703,14 @@ func (r Billing_Item_Virtual_DedicatedHost) GetAccount() (resp datatypes.Account
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve
 func (r Billing_Item_Virtual_DedicatedHost) GetActiveAgreement() (resp datatypes.Account_Agreement, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getActiveAgreement", nil, &r.Options, &resp)
@@ -1665,12 +2723,28 @@ func (r Billing_Item_Virtual_DedicatedHost) GetActiveAgreementFlag() (resp datat
 	return
 }
 
+// GetActiveAgreementFlagWith is GetActiveAgreementFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetActiveAgreementFlagWith(options sl.Options) (resp datatypes.Account_Agreement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveAgreementFlag()
+}
+
 // Retrieve A billing item's active associated child billing items. This includes "floating" items that are not necessarily child items of this billing item.
 func (r Billing_Item_Virtual_DedicatedHost) GetActiveAssociatedChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getActiveAssociatedChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveAssociatedChildrenWith is GetActiveAssociatedChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetActiveAssociatedChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveAssociatedChildren()
+}
+
 // Retrieve
 func (r Billing_Item_Virtual_DedicatedHost) GetActiveAssociatedGuestDiskBillingItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getActiveAssociatedGuestDiskBillingItems", nil, &r.Options, &resp)
@@ -1683,18 +2757,42 @@ func (r Billing_Item_Virtual_DedicatedHost) GetActiveBundledItems() (resp []data
 	return
 }
 
+// GetActiveBundledItemsWith is GetActiveBundledItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetActiveBundledItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveBundledItems()
+}
+
 // Retrieve A service cancellation request item that corresponds to the billing item.
 func (r Billing_Item_Virtual_DedicatedHost) GetActiveCancellationItem() (resp datatypes.Billing_Item_Cancellation_Request_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getActiveCancellationItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveCancellationItemWith is GetActiveCancellationItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetActiveCancellationItemWith(options sl.Options) (resp datatypes.Billing_Item_Cancellation_Request_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveCancellationItem()
+}
+
 // Retrieve A Billing Item's active child billing items.
 func (r Billing_Item_Virtual_DedicatedHost) GetActiveChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getActiveChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveChildrenWith is GetActiveChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetActiveChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveChildren()
+}
+
 // Retrieve
 func (r Billing_Item_Virtual_DedicatedHost) GetActiveFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getActiveFlag", nil, &r.Options, &resp)
@@ -1713,30 +2811,70 @@ func (r Billing_Item_Virtual_DedicatedHost) GetActiveSparePoolBundledItems() (re
 	return
 }
 
+// GetActiveSparePoolBundledItemsWith is GetActiveSparePoolBundledItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetActiveSparePoolBundledItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveSparePoolBundledItems()
+}
+
 // Retrieve A billing item's associated parent. This is to be used for billing items that are "floating", and therefore are not child items of any parent billing item. If it is desired to associate an item to another, populate this with the SoftLayer_Billing_Item ID of that associated parent item.
 func (r Billing_Item_Virtual_DedicatedHost) GetAssociatedBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getAssociatedBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssociatedBillingItemWith is GetAssociatedBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetAssociatedBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssociatedBillingItem()
+}
+
 // Retrieve A history of billing items which a billing item has been associated with.
 func (r Billing_Item_Virtual_DedicatedHost) GetAssociatedBillingItemHistory() (resp []datatypes.Billing_Item_Association_History, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getAssociatedBillingItemHistory", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssociatedBillingItemHistoryWith is GetAssociatedBillingItemHistory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetAssociatedBillingItemHistoryWith(options sl.Options) (resp []datatypes.Billing_Item_Association_History, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssociatedBillingItemHistory()
+}
+
 // Retrieve A Billing Item's associated child billing items. This includes "floating" items that are not necessarily child billing items of this billing item.
 func (r Billing_Item_Virtual_DedicatedHost) GetAssociatedChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getAssociatedChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssociatedChildrenWith is GetAssociatedChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetAssociatedChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssociatedChildren()
+}
+
 // Retrieve A billing item's associated parent billing item. This object will be the same as the parent billing item if parentId is set.
 func (r Billing_Item_Virtual_DedicatedHost) GetAssociatedParent() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getAssociatedParent", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssociatedParentWith is GetAssociatedParent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetAssociatedParentWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssociatedParent()
+}
+
 // Retrieve
 func (r Billing_Item_Virtual_DedicatedHost) GetAvailableMatchingVlans() (resp []datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getAvailableMatchingVlans", nil, &r.Options, &resp)
@@ -1749,138 +2887,322 @@ func (r Billing_Item_Virtual_DedicatedHost) GetBandwidthAllocation() (resp datat
 	return
 }
 
+// GetBandwidthAllocationWith is GetBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetBandwidthAllocationWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allocation, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllocation()
+}
+
 // Retrieve A billing item's recurring child items that have once been billed and are scheduled to be billed in the future.
 func (r Billing_Item_Virtual_DedicatedHost) GetBillableChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getBillableChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillableChildrenWith is GetBillableChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetBillableChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillableChildren()
+}
+
 // Retrieve A Billing Item's bundled billing items
 func (r Billing_Item_Virtual_DedicatedHost) GetBundleItems() (resp []datatypes.Product_Item_Bundles, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getBundleItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetBundleItemsWith is GetBundleItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetBundleItemsWith(options sl.Options) (resp []datatypes.Product_Item_Bundles, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBundleItems()
+}
+
 // Retrieve A Billing Item's bundled billing items'
 func (r Billing_Item_Virtual_DedicatedHost) GetBundledItems() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getBundledItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetBundledItemsWith is GetBundledItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetBundledItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBundledItems()
+}
+
 // Retrieve A Billing Item's active child billing items.
 func (r Billing_Item_Virtual_DedicatedHost) GetCanceledChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getCanceledChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetCanceledChildrenWith is GetCanceledChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetCanceledChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCanceledChildren()
+}
+
 // Retrieve The billing item's cancellation reason.
 func (r Billing_Item_Virtual_DedicatedHost) GetCancellationReason() (resp datatypes.Billing_Item_Cancellation_Reason, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getCancellationReason", nil, &r.Options, &resp)
 	return
 }
 
+// GetCancellationReasonWith is GetCancellationReason, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetCancellationReasonWith(options sl.Options) (resp datatypes.Billing_Item_Cancellation_Reason, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCancellationReason()
+}
+
 // Retrieve This will return any cancellation requests that are associated with this billing item.
 func (r Billing_Item_Virtual_DedicatedHost) GetCancellationRequests() (resp []datatypes.Billing_Item_Cancellation_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getCancellationRequests", nil, &r.Options, &resp)
 	return
 }
 
+// GetCancellationRequestsWith is GetCancellationRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetCancellationRequestsWith(options sl.Options) (resp []datatypes.Billing_Item_Cancellation_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCancellationRequests()
+}
+
 // Retrieve The item category to which the billing item's item belongs.
 func (r Billing_Item_Virtual_DedicatedHost) GetCategory() (resp datatypes.Product_Item_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getCategory", nil, &r.Options, &resp)
 	return
 }
 
+// GetCategoryWith is GetCategory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetCategoryWith(options sl.Options) (resp datatypes.Product_Item_Category, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCategory()
+}
+
 // Retrieve A Billing Item's child billing items'
 func (r Billing_Item_Virtual_DedicatedHost) GetChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildrenWith is GetChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildren()
+}
+
 // Retrieve A Billing Item's active child billing items.
 func (r Billing_Item_Virtual_DedicatedHost) GetChildrenWithActiveAgreement() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getChildrenWithActiveAgreement", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildrenWithActiveAgreementWith is GetChildrenWithActiveAgreement, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetChildrenWithActiveAgreementWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildrenWithActiveAgreement()
+}
+
 // Retrieve For product items which have a downgrade path defined, this will return those product items.
 func (r Billing_Item_Virtual_DedicatedHost) GetDowngradeItems() (resp []datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getDowngradeItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetDowngradeItemsWith is GetDowngradeItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetDowngradeItemsWith(options sl.Options) (resp []datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDowngradeItems()
+}
+
 // Retrieve A Billing Item's associated child billing items, excluding some items with a $0.00 recurring fee.
 func (r Billing_Item_Virtual_DedicatedHost) GetFilteredNextInvoiceChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getFilteredNextInvoiceChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetFilteredNextInvoiceChildrenWith is GetFilteredNextInvoiceChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetFilteredNextInvoiceChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFilteredNextInvoiceChildren()
+}
+
 // Retrieve A flag that will reflect whether this billing item is billed on an hourly basis or not.
 func (r Billing_Item_Virtual_DedicatedHost) GetHourlyFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getHourlyFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHourlyFlagWith is GetHourlyFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetHourlyFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyFlag()
+}
+
 // Retrieve Invoice items associated with this billing item
 func (r Billing_Item_Virtual_DedicatedHost) GetInvoiceItem() (resp datatypes.Billing_Invoice_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getInvoiceItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetInvoiceItemWith is GetInvoiceItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetInvoiceItemWith(options sl.Options) (resp datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoiceItem()
+}
+
 // Retrieve All invoice items associated with the billing item
 func (r Billing_Item_Virtual_DedicatedHost) GetInvoiceItems() (resp []datatypes.Billing_Invoice_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getInvoiceItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetInvoiceItemsWith is GetInvoiceItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetInvoiceItemsWith(options sl.Options) (resp []datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoiceItems()
+}
+
 // Retrieve The entry in the SoftLayer product catalog that a billing item is based upon.
 func (r Billing_Item_Virtual_DedicatedHost) GetItem() (resp datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetItemWith is GetItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetItemWith(options sl.Options) (resp datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItem()
+}
+
 // Retrieve The location of the billing item. Some billing items have physical properties such as the server itself. For items such as these, we provide location information.
 func (r Billing_Item_Virtual_DedicatedHost) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve A Billing Item's child billing items and associated items'
 func (r Billing_Item_Virtual_DedicatedHost) GetNextInvoiceChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getNextInvoiceChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceChildrenWith is GetNextInvoiceChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetNextInvoiceChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceChildren()
+}
+
 // Retrieve A Billing Item's total, including any child billing items if they exist.'
 func (r Billing_Item_Virtual_DedicatedHost) GetNextInvoiceTotalOneTimeAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getNextInvoiceTotalOneTimeAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalOneTimeAmountWith is GetNextInvoiceTotalOneTimeAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetNextInvoiceTotalOneTimeAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalOneTimeAmount()
+}
+
 // Retrieve A Billing Item's total, including any child billing items if they exist.'
 func (r Billing_Item_Virtual_DedicatedHost) GetNextInvoiceTotalOneTimeTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getNextInvoiceTotalOneTimeTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalOneTimeTaxAmountWith is GetNextInvoiceTotalOneTimeTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetNextInvoiceTotalOneTimeTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalOneTimeTaxAmount()
+}
+
 // Retrieve A Billing Item's total, including any child billing items and associated billing items if they exist.'
 func (r Billing_Item_Virtual_DedicatedHost) GetNextInvoiceTotalRecurringAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getNextInvoiceTotalRecurringAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalRecurringAmountWith is GetNextInvoiceTotalRecurringAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetNextInvoiceTotalRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalRecurringAmount()
+}
+
 // Retrieve This is deprecated and will always be zero. Because tax is calculated in real-time, previewing the next recurring invoice is pre-tax only.
 func (r Billing_Item_Virtual_DedicatedHost) GetNextInvoiceTotalRecurringTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getNextInvoiceTotalRecurringTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextInvoiceTotalRecurringTaxAmountWith is GetNextInvoiceTotalRecurringTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetNextInvoiceTotalRecurringTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalRecurringTaxAmount()
+}
+
 // Retrieve A Billing Item's associated child billing items, excluding ALL items with a $0.00 recurring fee.
 func (r Billing_Item_Virtual_DedicatedHost) GetNonZeroNextInvoiceChildren() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getNonZeroNextInvoiceChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetNonZeroNextInvoiceChildrenWith is GetNonZeroNextInvoiceChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetNonZeroNextInvoiceChildrenWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNonZeroNextInvoiceChildren()
+}
+
 // no documentation yet
 func (r Billing_Item_Virtual_DedicatedHost) GetObject() (resp datatypes.Billing_Item_Virtual_DedicatedHost, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getObject", nil, &r.Options, &resp)
@@ -1893,54 +3215,126 @@ func (r Billing_Item_Virtual_DedicatedHost) GetOrderItem() (resp datatypes.Billi
 	return
 }
 
+// GetOrderItemWith is GetOrderItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetOrderItemWith(options sl.Options) (resp datatypes.Billing_Order_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderItem()
+}
+
 // Retrieve The original physical location for this billing item--may differ from current.
 func (r Billing_Item_Virtual_DedicatedHost) GetOriginalLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getOriginalLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetOriginalLocationWith is GetOriginalLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetOriginalLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOriginalLocation()
+}
+
 // Retrieve The package under which this billing item was sold. A Package is the general grouping of products as seen on our order forms.
 func (r Billing_Item_Virtual_DedicatedHost) GetPackage() (resp datatypes.Product_Package, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getPackage", nil, &r.Options, &resp)
 	return
 }
 
+// GetPackageWith is GetPackage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetPackageWith(options sl.Options) (resp datatypes.Product_Package, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPackage()
+}
+
 // Retrieve A billing item's parent item. If a billing item has no parent item then this value is null.
 func (r Billing_Item_Virtual_DedicatedHost) GetParent() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getParent", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentWith is GetParent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetParentWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParent()
+}
+
 // Retrieve A billing item's parent item. If a billing item has no parent item then this value is null.
 func (r Billing_Item_Virtual_DedicatedHost) GetParentVirtualGuestBillingItem() (resp datatypes.Billing_Item_Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getParentVirtualGuestBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentVirtualGuestBillingItemWith is GetParentVirtualGuestBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetParentVirtualGuestBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentVirtualGuestBillingItem()
+}
+
 // Retrieve This flag indicates whether a billing item is scheduled to be canceled or not.
 func (r Billing_Item_Virtual_DedicatedHost) GetPendingCancellationFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getPendingCancellationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetPendingCancellationFlagWith is GetPendingCancellationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetPendingCancellationFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingCancellationFlag()
+}
+
 // Retrieve The new order item that will replace this billing item.
 func (r Billing_Item_Virtual_DedicatedHost) GetPendingOrderItem() (resp datatypes.Billing_Order_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getPendingOrderItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetPendingOrderItemWith is GetPendingOrderItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetPendingOrderItemWith(options sl.Options) (resp datatypes.Billing_Order_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingOrderItem()
+}
+
 // Retrieve Provisioning transaction for this billing item
 func (r Billing_Item_Virtual_DedicatedHost) GetProvisionTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getProvisionTransaction", nil, &r.Options, &resp)
 	return
 }
 
+// GetProvisionTransactionWith is GetProvisionTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetProvisionTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProvisionTransaction()
+}
+
 // Retrieve The resource for a virtual dedicated host billing item.
 func (r Billing_Item_Virtual_DedicatedHost) GetResource() (resp datatypes.Virtual_DedicatedHost, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getResource", nil, &r.Options, &resp)
 	return
 }
 
+// GetResourceWith is GetResource, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetResourceWith(options sl.Options) (resp datatypes.Virtual_DedicatedHost, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResource()
+}
+
 // This service returns billing items of a specified category code. This service should be used to retrieve billing items that you wish to cancel. Some billing items can be canceled via [[SoftLayer_Security_Certificate_Request|service cancellation]] service.
 //
 // In order to find billing items for cancellation, use [[SoftLayer_Product_Item_Category::getValidCancelableServiceItemCategories|product categories]] service to retrieve category codes that are eligible for cancellation.
@@ -1959,18 +3353,42 @@ func (r Billing_Item_Virtual_DedicatedHost) GetSoftwareDescription() (resp datat
 	return
 }
 
+// GetSoftwareDescriptionWith is GetSoftwareDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetSoftwareDescriptionWith(options sl.Options) (resp datatypes.Software_Description, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareDescription()
+}
+
 // Retrieve Billing items whose product item has an upgrade path defined in our system will return the next product item in the upgrade path.
 func (r Billing_Item_Virtual_DedicatedHost) GetUpgradeItem() (resp datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getUpgradeItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetUpgradeItemWith is GetUpgradeItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetUpgradeItemWith(options sl.Options) (resp datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeItem()
+}
+
 // Retrieve Billing items whose product item has an upgrade path defined in our system will return all the product items in the upgrade path.
 func (r Billing_Item_Virtual_DedicatedHost) GetUpgradeItems() (resp []datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "getUpgradeItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetUpgradeItemsWith is GetUpgradeItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Item_Virtual_DedicatedHost) GetUpgradeItemsWith(options sl.Options) (resp []datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeItems()
+}
+
 // Remove the association from a billing item.
 func (r Billing_Item_Virtual_DedicatedHost) RemoveAssociationId() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Item_Virtual_DedicatedHost", "removeAssociationId", nil, &r.Options, &resp)
@@ -2008,6 +3426,11 @@ func (r Billing_Order) Id(id int) Billing_Order {
 	return r
 }
 
+func (r Billing_Order) InitParameter(name string, value interface{}) Billing_Order {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Order) Mask(mask string) Billing_Order {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2027,11 +3450,26 @@ func (r Billing_Order) Limit(limit int) Billing_Order {
 	return r
 }
 
+func (r Billing_Order) Unlimited() Billing_Order {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Order) Offset(offset int) Billing_Order {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Order) Timeout(timeout time.Duration) Billing_Order {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Order) WithContext(ctx context.Context) Billing_Order {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // When an order has been modified, the customer will need to approve the changes. This method will allow the customer to approve the changes.
 func (r Billing_Order) ApproveModifiedOrder() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "approveModifiedOrder", nil, &r.Options, &resp)
@@ -2044,6 +3482,14 @@ func (r Billing_Order) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // This will get all billing orders for your account.
 func (r Billing_Order) GetAllObjects() (resp []datatypes.Billing_Order, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getAllObjects", nil, &r.Options, &resp)
@@ -2062,18 +3508,42 @@ func (r Billing_Order) GetCart() (resp datatypes.Billing_Order_Cart, err error)
 	return
 }
 
+// GetCartWith is GetCart, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetCartWith(options sl.Options) (resp datatypes.Billing_Order_Cart, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCart()
+}
+
 // Retrieve The [[SoftLayer_Billing_Order_Item (type)|order items]] that are core restricted
 func (r Billing_Order) GetCoreRestrictedItems() (resp []datatypes.Billing_Order_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getCoreRestrictedItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetCoreRestrictedItemsWith is GetCoreRestrictedItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetCoreRestrictedItemsWith(options sl.Options) (resp []datatypes.Billing_Order_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCoreRestrictedItems()
+}
+
 // Retrieve All credit card transactions associated with this order. If this order was not placed with a credit card, this will be empty.
 func (r Billing_Order) GetCreditCardTransactions() (resp []datatypes.Billing_Payment_Card_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getCreditCardTransactions", nil, &r.Options, &resp)
 	return
 }
 
+// GetCreditCardTransactionsWith is GetCreditCardTransactions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetCreditCardTransactionsWith(options sl.Options) (resp []datatypes.Billing_Payment_Card_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCreditCardTransactions()
+}
+
 // Retrieve
 func (r Billing_Order) GetExchangeRate() (resp datatypes.Billing_Currency_ExchangeRate, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getExchangeRate", nil, &r.Options, &resp)
@@ -2092,6 +3562,14 @@ func (r Billing_Order) GetItems() (resp []datatypes.Billing_Order_Item, err erro
 	return
 }
 
+// GetItemsWith is GetItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetItemsWith(options sl.Options) (resp []datatypes.Billing_Order_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItems()
+}
+
 // getObject retrieves the SoftLayer_Billing_Order object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Billing_Order service. You can only retrieve orders that are assigned to your portal user's account.
 func (r Billing_Order) GetObject() (resp datatypes.Billing_Order, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getObject", nil, &r.Options, &resp)
@@ -2110,12 +3588,28 @@ func (r Billing_Order) GetOrderNonServerMonthlyAmount() (resp datatypes.Float64,
 	return
 }
 
+// GetOrderNonServerMonthlyAmountWith is GetOrderNonServerMonthlyAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetOrderNonServerMonthlyAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderNonServerMonthlyAmount()
+}
+
 // Retrieve An order's server items total monthly fee.
 func (r Billing_Order) GetOrderServerMonthlyAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getOrderServerMonthlyAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrderServerMonthlyAmountWith is GetOrderServerMonthlyAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetOrderServerMonthlyAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderServerMonthlyAmount()
+}
+
 // Get a list of [[SoftLayer_Container_Billing_Order_Status]] objects.
 func (r Billing_Order) GetOrderStatuses() (resp []datatypes.Container_Billing_Order_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getOrderStatuses", nil, &r.Options, &resp)
@@ -2128,66 +3622,154 @@ func (r Billing_Order) GetOrderTopLevelItems() (resp []datatypes.Billing_Order_I
 	return
 }
 
+// GetOrderTopLevelItemsWith is GetOrderTopLevelItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetOrderTopLevelItemsWith(options sl.Options) (resp []datatypes.Billing_Order_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderTopLevelItems()
+}
+
 // Retrieve This amount represents the order's initial charge including set up fee and taxes.
 func (r Billing_Order) GetOrderTotalAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getOrderTotalAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrderTotalAmountWith is GetOrderTotalAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetOrderTotalAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderTotalAmount()
+}
+
 // Retrieve An order's total one time amount summing all the set up fees, the labor fees and the one time fees. Taxes will be applied for non-tax-exempt. This amount represents the initial fees that will be charged.
 func (r Billing_Order) GetOrderTotalOneTime() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getOrderTotalOneTime", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrderTotalOneTimeWith is GetOrderTotalOneTime, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetOrderTotalOneTimeWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderTotalOneTime()
+}
+
 // Retrieve An order's total one time amount. This amount represents the initial fees before tax.
 func (r Billing_Order) GetOrderTotalOneTimeAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getOrderTotalOneTimeAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrderTotalOneTimeAmountWith is GetOrderTotalOneTimeAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetOrderTotalOneTimeAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderTotalOneTimeAmount()
+}
+
 // Retrieve An order's total one time tax amount. This amount represents the tax that will be applied to the total charge, if the SoftLayer_Account tied to a SoftLayer_Billing_Order is a taxable account.
 func (r Billing_Order) GetOrderTotalOneTimeTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getOrderTotalOneTimeTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrderTotalOneTimeTaxAmountWith is GetOrderTotalOneTimeTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetOrderTotalOneTimeTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderTotalOneTimeTaxAmount()
+}
+
 // Retrieve An order's total recurring amount. Taxes will be applied for non-tax-exempt. This amount represents the fees that will be charged on a recurring (usually monthly) basis.
 func (r Billing_Order) GetOrderTotalRecurring() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getOrderTotalRecurring", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrderTotalRecurringWith is GetOrderTotalRecurring, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetOrderTotalRecurringWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderTotalRecurring()
+}
+
 // Retrieve An order's total recurring amount. This amount represents the fees that will be charged on a recurring (usually monthly) basis.
 func (r Billing_Order) GetOrderTotalRecurringAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getOrderTotalRecurringAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrderTotalRecurringAmountWith is GetOrderTotalRecurringAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetOrderTotalRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderTotalRecurringAmount()
+}
+
 // Retrieve The total tax amount of the recurring fees, if the SoftLayer_Account tied to a SoftLayer_Billing_Order is a taxable account.
 func (r Billing_Order) GetOrderTotalRecurringTaxAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getOrderTotalRecurringTaxAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrderTotalRecurringTaxAmountWith is GetOrderTotalRecurringTaxAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetOrderTotalRecurringTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderTotalRecurringTaxAmount()
+}
+
 // Retrieve An order's total setup fee.
 func (r Billing_Order) GetOrderTotalSetupAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getOrderTotalSetupAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrderTotalSetupAmountWith is GetOrderTotalSetupAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetOrderTotalSetupAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderTotalSetupAmount()
+}
+
 // Retrieve The type of an order. This lets you know where this order was generated from.
 func (r Billing_Order) GetOrderType() (resp datatypes.Billing_Order_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getOrderType", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrderTypeWith is GetOrderType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetOrderTypeWith(options sl.Options) (resp datatypes.Billing_Order_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderType()
+}
+
 // Retrieve All PayPal transactions associated with this order. If this order was not placed with PayPal, this will be empty.
 func (r Billing_Order) GetPaypalTransactions() (resp []datatypes.Billing_Payment_PayPal_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getPaypalTransactions", nil, &r.Options, &resp)
 	return
 }
 
+// GetPaypalTransactionsWith is GetPaypalTransactions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetPaypalTransactionsWith(options sl.Options) (resp []datatypes.Billing_Payment_PayPal_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPaypalTransactions()
+}
+
 // Retrieve a PDF record of a SoftLayer quote. If the order is not a quote, an error will be thrown.
 func (r Billing_Order) GetPdf() (resp []byte, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getPdf", nil, &r.Options, &resp)
@@ -2212,6 +3794,14 @@ func (r Billing_Order) GetQuote() (resp datatypes.Billing_Order_Quote, err error
 	return
 }
 
+// GetQuoteWith is GetQuote, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetQuoteWith(options sl.Options) (resp datatypes.Billing_Order_Quote, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetQuote()
+}
+
 // Generate an [[SoftLayer_Container_Product_Order|order container]] from a billing order. This will take into account promotions, reseller status, estimated taxes and all other standard order verification processes.
 func (r Billing_Order) GetRecalculatedOrderContainer(message *string, ignoreDiscountsFlag *bool) (resp datatypes.Container_Product_Order, err error) {
 	params := []interface{}{
@@ -2234,18 +3824,42 @@ func (r Billing_Order) GetReferralPartner() (resp datatypes.Account, err error)
 	return
 }
 
+// GetReferralPartnerWith is GetReferralPartner, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetReferralPartnerWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReferralPartner()
+}
+
 // Retrieve This flag indicates an order is an upgrade.
 func (r Billing_Order) GetUpgradeRequestFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getUpgradeRequestFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetUpgradeRequestFlagWith is GetUpgradeRequestFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetUpgradeRequestFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeRequestFlag()
+}
+
 // Retrieve The SoftLayer_User_Customer object tied to an order.
 func (r Billing_Order) GetUserRecord() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "getUserRecord", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserRecordWith is GetUserRecord, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order) GetUserRecordWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUserRecord()
+}
+
 // When an order has been modified, it will contain a status indicating so. This method checks that status and also verifies that the active user's account is the same as the account on the order.
 func (r Billing_Order) IsPendingEditApproval() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order", "isPendingEditApproval", nil, &r.Options, &resp)
@@ -2268,6 +3882,11 @@ func (r Billing_Order_Cart) Id(id int) Billing_Order_Cart {
 	return r
 }
 
+func (r Billing_Order_Cart) InitParameter(name string, value interface{}) Billing_Order_Cart {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Order_Cart) Mask(mask string) Billing_Order_Cart {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2287,11 +3906,26 @@ func (r Billing_Order_Cart) Limit(limit int) Billing_Order_Cart {
 	return r
 }
 
+func (r Billing_Order_Cart) Unlimited() Billing_Order_Cart {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Order_Cart) Offset(offset int) Billing_Order_Cart {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Order_Cart) Timeout(timeout time.Duration) Billing_Order_Cart {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Order_Cart) WithContext(ctx context.Context) Billing_Order_Cart {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is used to transfer an anonymous quote to the active user and associated account. An anonymous quote is one that was created by a user without being authenticated. If a quote was created anonymously and then the customer attempts to access that anonymous quote via the API (which requires authentication), the customer will be unable to retrieve the quote due to the security restrictions in place. By providing the ability for a customer to claim a quote, s/he will be able to pull the anonymous quote onto his/her account and successfully view the quote.
 //
 // To claim a quote, both the quote id and the quote key (the 32-character random string) must be provided.
@@ -2333,6 +3967,14 @@ func (r Billing_Order_Cart) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Cart) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve a valid cart record of a SoftLayer order.
 func (r Billing_Order_Cart) GetCartByCartKey(cartKey *string) (resp datatypes.Billing_Order_Cart, err error) {
 	params := []interface{}{
@@ -2348,6 +3990,14 @@ func (r Billing_Order_Cart) GetDoNotContactFlag() (resp bool, err error) {
 	return
 }
 
+// GetDoNotContactFlagWith is GetDoNotContactFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Cart) GetDoNotContactFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDoNotContactFlag()
+}
+
 // no documentation yet
 func (r Billing_Order_Cart) GetObject() (resp datatypes.Billing_Order_Cart, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Cart", "getObject", nil, &r.Options, &resp)
@@ -2360,12 +4010,28 @@ func (r Billing_Order_Cart) GetOrder() (resp datatypes.Billing_Order, err error)
 	return
 }
 
+// GetOrderWith is GetOrder, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Cart) GetOrderWith(options sl.Options) (resp datatypes.Billing_Order, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrder()
+}
+
 // Retrieve These are all the orders that were created from this quote.
 func (r Billing_Order_Cart) GetOrdersFromQuote() (resp []datatypes.Billing_Order, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Cart", "getOrdersFromQuote", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrdersFromQuoteWith is GetOrdersFromQuote, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Cart) GetOrdersFromQuoteWith(options sl.Options) (resp []datatypes.Billing_Order, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrdersFromQuote()
+}
+
 // Retrieve a PDF copy of the cart.
 func (r Billing_Order_Cart) GetPdf() (resp []byte, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Cart", "getPdf", nil, &r.Options, &resp)
@@ -2468,6 +4134,16 @@ func (r Billing_Order_Item) Id(id int) Billing_Order_Item {
 	return r
 }
 
+func (r Billing_Order_Item) InitParameter(name string, value interface{}) Billing_Order_Item {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
+func (r Billing_Order_Item) GlobalID(globalID string) Billing_Order_Item {
+	r.Options.GlobalID = &globalID
+	return r
+}
+
 func (r Billing_Order_Item) Mask(mask string) Billing_Order_Item {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2487,71 +4163,166 @@ func (r Billing_Order_Item) Limit(limit int) Billing_Order_Item {
 	return r
 }
 
+func (r Billing_Order_Item) Unlimited() Billing_Order_Item {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Order_Item) Offset(offset int) Billing_Order_Item {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Order_Item) Timeout(timeout time.Duration) Billing_Order_Item {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Order_Item) WithContext(ctx context.Context) Billing_Order_Item {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The SoftLayer_Billing_Item tied to the order item.
 func (r Billing_Order_Item) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The other items included with an ordered item.
 func (r Billing_Order_Item) GetBundledItems() (resp []datatypes.Billing_Order_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getBundledItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetBundledItemsWith is GetBundledItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetBundledItemsWith(options sl.Options) (resp []datatypes.Billing_Order_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBundledItems()
+}
+
 // Retrieve The item category tied to an order item.
 func (r Billing_Order_Item) GetCategory() (resp datatypes.Product_Item_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getCategory", nil, &r.Options, &resp)
 	return
 }
 
+// GetCategoryWith is GetCategory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetCategoryWith(options sl.Options) (resp datatypes.Product_Item_Category, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCategory()
+}
+
 // Retrieve The child order items for an order item. All server order items should have children. These children are considered a part of the server.
 func (r Billing_Order_Item) GetChildren() (resp []datatypes.Billing_Order_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildrenWith is GetChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetChildrenWith(options sl.Options) (resp []datatypes.Billing_Order_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildren()
+}
+
 // Retrieve A hardware's universally unique identifier.
 func (r Billing_Order_Item) GetGlobalIdentifier() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getGlobalIdentifier", nil, &r.Options, &resp)
 	return
 }
 
+// GetGlobalIdentifierWith is GetGlobalIdentifier, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetGlobalIdentifierWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGlobalIdentifier()
+}
+
 // Retrieve The component type tied to an order item. All hardware-specific items should have a generic hardware component.
 func (r Billing_Order_Item) GetHardwareGenericComponent() (resp datatypes.Hardware_Component_Model_Generic, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getHardwareGenericComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareGenericComponentWith is GetHardwareGenericComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetHardwareGenericComponentWith(options sl.Options) (resp datatypes.Hardware_Component_Model_Generic, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareGenericComponent()
+}
+
 // Retrieve The SoftLayer_Product_Item tied to an order item. The item is the actual definition of the product being sold.
 func (r Billing_Order_Item) GetItem() (resp datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetItemWith is GetItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetItemWith(options sl.Options) (resp datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItem()
+}
+
 // Retrieve This is an item's category answers.
 func (r Billing_Order_Item) GetItemCategoryAnswers() (resp []datatypes.Billing_Order_Item_Category_Answer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getItemCategoryAnswers", nil, &r.Options, &resp)
 	return
 }
 
+// GetItemCategoryAnswersWith is GetItemCategoryAnswers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetItemCategoryAnswersWith(options sl.Options) (resp []datatypes.Billing_Order_Item_Category_Answer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItemCategoryAnswers()
+}
+
 // Retrieve The SoftLayer_Product_Item_Price tied to an order item. The item price object describes the cost of an item.
 func (r Billing_Order_Item) GetItemPrice() (resp datatypes.Product_Item_Price, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getItemPrice", nil, &r.Options, &resp)
 	return
 }
 
+// GetItemPriceWith is GetItemPrice, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetItemPriceWith(options sl.Options) (resp datatypes.Product_Item_Price, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItemPrice()
+}
+
 // Retrieve The location of an ordered item. This is usually the same as the server it is being ordered with. Otherwise it describes the location of the additional service being ordered.
 func (r Billing_Order_Item) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve
 func (r Billing_Order_Item) GetNextOrderChildren() (resp []datatypes.Billing_Order_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getNextOrderChildren", nil, &r.Options, &resp)
@@ -2570,12 +4341,28 @@ func (r Billing_Order_Item) GetOldBillingItem() (resp datatypes.Billing_Item, er
 	return
 }
 
+// GetOldBillingItemWith is GetOldBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetOldBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOldBillingItem()
+}
+
 // Retrieve The order to which this item belongs. The order contains all the information related to the items included in an order
 func (r Billing_Order_Item) GetOrder() (resp datatypes.Billing_Order, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getOrder", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrderWith is GetOrder, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetOrderWith(options sl.Options) (resp datatypes.Billing_Order, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrder()
+}
+
 // Retrieve
 func (r Billing_Order_Item) GetOrderApprovalDate() (resp datatypes.Time, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getOrderApprovalDate", nil, &r.Options, &resp)
@@ -2588,48 +4375,112 @@ func (r Billing_Order_Item) GetPackage() (resp datatypes.Product_Package, err er
 	return
 }
 
+// GetPackageWith is GetPackage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetPackageWith(options sl.Options) (resp datatypes.Product_Package, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPackage()
+}
+
 // Retrieve The parent order item ID for an item. Items that are associated with a server will have a parent. The parent will be the server item itself.
 func (r Billing_Order_Item) GetParent() (resp datatypes.Billing_Order_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getParent", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentWith is GetParent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetParentWith(options sl.Options) (resp datatypes.Billing_Order_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParent()
+}
+
 // Retrieve The SoftLayer_Product_Package_Preset related to this order item.
 func (r Billing_Order_Item) GetPreset() (resp datatypes.Product_Package_Preset, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getPreset", nil, &r.Options, &resp)
 	return
 }
 
+// GetPresetWith is GetPreset, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetPresetWith(options sl.Options) (resp datatypes.Product_Package_Preset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPreset()
+}
+
 // Retrieve A count of power supplies contained within this SoftLayer_Billing_Order
 func (r Billing_Order_Item) GetRedundantPowerSupplyCount() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getRedundantPowerSupplyCount", nil, &r.Options, &resp)
 	return
 }
 
+// GetRedundantPowerSupplyCountWith is GetRedundantPowerSupplyCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetRedundantPowerSupplyCountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRedundantPowerSupplyCount()
+}
+
 // Retrieve For ordered items that are software items, a full description of that software can be found with this property.
 func (r Billing_Order_Item) GetSoftwareDescription() (resp datatypes.Software_Description, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getSoftwareDescription", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareDescriptionWith is GetSoftwareDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetSoftwareDescriptionWith(options sl.Options) (resp datatypes.Software_Description, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareDescription()
+}
+
 // Retrieve The drive storage groups that are attached to this billing order item.
 func (r Billing_Order_Item) GetStorageGroups() (resp []datatypes.Configuration_Storage_Group_Order, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getStorageGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetStorageGroupsWith is GetStorageGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetStorageGroupsWith(options sl.Options) (resp []datatypes.Configuration_Storage_Group_Order, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStorageGroups()
+}
+
 // Retrieve The recurring fee of an ordered item. This amount represents the fees that will be charged on a recurring (usually monthly) basis.
 func (r Billing_Order_Item) GetTotalRecurringAmount() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getTotalRecurringAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetTotalRecurringAmountWith is GetTotalRecurringAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetTotalRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalRecurringAmount()
+}
+
 // Retrieve The next SoftLayer_Product_Item in the upgrade path for this order item.
 func (r Billing_Order_Item) GetUpgradeItem() (resp datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Item", "getUpgradeItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetUpgradeItemWith is GetUpgradeItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Item) GetUpgradeItemWith(options sl.Options) (resp datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeItem()
+}
+
 // The SoftLayer_Billing_Oder_Quote data type contains general information relating to an individual order applied to a SoftLayer customer account or to a new customer. Personal information in this type such as names, addresses, and phone numbers are taken from the account's contact information at the time the quote is generated for existing SoftLayer customer.
 type Billing_Order_Quote struct {
 	Session *session.Session
@@ -2646,6 +4497,11 @@ func (r Billing_Order_Quote) Id(id int) Billing_Order_Quote {
 	return r
 }
 
+func (r Billing_Order_Quote) InitParameter(name string, value interface{}) Billing_Order_Quote {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Billing_Order_Quote) Mask(mask string) Billing_Order_Quote {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2665,11 +4521,26 @@ func (r Billing_Order_Quote) Limit(limit int) Billing_Order_Quote {
 	return r
 }
 
+func (r Billing_Order_Quote) Unlimited() Billing_Order_Quote {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Billing_Order_Quote) Offset(offset int) Billing_Order_Quote {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Billing_Order_Quote) Timeout(timeout time.Duration) Billing_Order_Quote {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Billing_Order_Quote) WithContext(ctx context.Context) Billing_Order_Quote {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is used to transfer an anonymous quote to the active user and associated account. An anonymous quote is one that was created by a user without being authenticated. If a quote was created anonymously and then the customer attempts to access that anonymous quote via the API (which requires authentication), the customer will be unable to retrieve the quote due to the security restrictions in place. By providing the ability for a customer to claim a quote, s/he will be able to pull the anonymous quote onto his/her account and successfully view the quote.
 //
 // To claim a quote, both the quote id and the quote key (the 32-character random string) must be provided.
@@ -2694,12 +4565,28 @@ func (r Billing_Order_Quote) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Quote) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve Indicates whether the owner of the quote chosen to no longer be contacted.
 func (r Billing_Order_Quote) GetDoNotContactFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Quote", "getDoNotContactFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetDoNotContactFlagWith is GetDoNotContactFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Quote) GetDoNotContactFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDoNotContactFlag()
+}
+
 // getObject retrieves the SoftLayer_Billing_Order_Quote object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Billing_Order_Quote service. You can only retrieve quotes that are assigned to your portal user's account.
 func (r Billing_Order_Quote) GetObject() (resp datatypes.Billing_Order_Quote, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Quote", "getObject", nil, &r.Options, &resp)
@@ -2712,12 +4599,28 @@ func (r Billing_Order_Quote) GetOrder() (resp datatypes.Billing_Order, err error
 	return
 }
 
+// GetOrderWith is GetOrder, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Quote) GetOrderWith(options sl.Options) (resp datatypes.Billing_Order, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrder()
+}
+
 // Retrieve These are all the orders that were created from this quote.
 func (r Billing_Order_Quote) GetOrdersFromQuote() (resp []datatypes.Billing_Order, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Quote", "getOrdersFromQuote", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrdersFromQuoteWith is GetOrdersFromQuote, with the mask, filter and result limit of options applied to
+// this call only
+func (r Billing_Order_Quote) GetOrdersFromQuoteWith(options sl.Options) (resp []datatypes.Billing_Order, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrdersFromQuote()
+}
+
 // Retrieve a PDF record of a SoftLayer quoted order. SoftLayer keeps PDF records of all quoted orders for customer retrieval from the portal and API. You must have a PDF reader installed in order to view these quoted order files.
 func (r Billing_Order_Quote) GetPdf() (resp []byte, err error) {
 	err = r.Session.DoRequest("SoftLayer_Billing_Order_Quote", "getPdf", nil, &r.Options, &resp)
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -47,6 +49,11 @@ func (r Brand) Id(id int) Brand {
 	return r
 }
 
+func (r Brand) InitParameter(name string, value interface{}) Brand {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Brand) Mask(mask string) Brand {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -66,11 +73,26 @@ func (r Brand) Limit(limit int) Brand {
 	return r
 }
 
+func (r Brand) Unlimited() Brand {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Brand) Offset(offset int) Brand {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Brand) Timeout(timeout time.Duration) Brand {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Brand) WithContext(ctx context.Context) Brand {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a new customer account record.
 func (r Brand) CreateCustomerAccount(account *datatypes.Account, bypassDuplicateAccountCheck *bool) (resp datatypes.Account, err error) {
 	params := []interface{}{
@@ -102,6 +124,14 @@ func (r Brand) GetAllOwnedAccounts() (resp []datatypes.Account, err error) {
 	return
 }
 
+// GetAllOwnedAccountsWith is GetAllOwnedAccounts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand) GetAllOwnedAccountsWith(options sl.Options) (resp []datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllOwnedAccounts()
+}
+
 // (DEPRECATED) Use [[SoftLayer_Ticket_Subject::getAllObjects]] method.
 func (r Brand) GetAllTicketSubjects(account *datatypes.Account) (resp []datatypes.Ticket_Subject, err error) {
 	params := []interface{}{
@@ -117,24 +147,56 @@ func (r Brand) GetAllowAccountCreationFlag() (resp bool, err error) {
 	return
 }
 
+// GetAllowAccountCreationFlagWith is GetAllowAccountCreationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand) GetAllowAccountCreationFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowAccountCreationFlag()
+}
+
 // Retrieve Business Partner details for the brand. Country Enterprise Code, Channel, Segment, Reseller Level.
 func (r Brand) GetBusinessPartner() (resp datatypes.Brand_Business_Partner, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand", "getBusinessPartner", nil, &r.Options, &resp)
 	return
 }
 
+// GetBusinessPartnerWith is GetBusinessPartner, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand) GetBusinessPartnerWith(options sl.Options) (resp datatypes.Brand_Business_Partner, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBusinessPartner()
+}
+
 // Retrieve Flag indicating if the brand is a business partner.
 func (r Brand) GetBusinessPartnerFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand", "getBusinessPartnerFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBusinessPartnerFlagWith is GetBusinessPartnerFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand) GetBusinessPartnerFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBusinessPartnerFlag()
+}
+
 // Retrieve The Product Catalog for the Brand
 func (r Brand) GetCatalog() (resp datatypes.Product_Catalog, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand", "getCatalog", nil, &r.Options, &resp)
 	return
 }
 
+// GetCatalogWith is GetCatalog, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand) GetCatalogWith(options sl.Options) (resp datatypes.Product_Catalog, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCatalog()
+}
+
 // Retrieve the contact information for the brand such as the corporate or support contact.  This will include the contact name, telephone number, fax number, email address, and mailing address of the contact.
 func (r Brand) GetContactInformation() (resp []datatypes.Brand_Contact, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand", "getContactInformation", nil, &r.Options, &resp)
@@ -147,12 +209,28 @@ func (r Brand) GetContacts() (resp []datatypes.Brand_Contact, err error) {
 	return
 }
 
+// GetContactsWith is GetContacts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand) GetContactsWith(options sl.Options) (resp []datatypes.Brand_Contact, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetContacts()
+}
+
 // Retrieve This references relationship between brands, locations and countries associated with a user's account that are ineligible when ordering products. For example, the India datacenter may not be available on this brand for customers that live in Great Britain.
 func (r Brand) GetCustomerCountryLocationRestrictions() (resp []datatypes.Brand_Restriction_Location_CustomerCountry, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand", "getCustomerCountryLocationRestrictions", nil, &r.Options, &resp)
 	return
 }
 
+// GetCustomerCountryLocationRestrictionsWith is GetCustomerCountryLocationRestrictions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand) GetCustomerCountryLocationRestrictionsWith(options sl.Options) (resp []datatypes.Brand_Restriction_Location_CustomerCountry, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCustomerCountryLocationRestrictions()
+}
+
 // Retrieve
 func (r Brand) GetDistributor() (resp datatypes.Brand, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand", "getDistributor", nil, &r.Options, &resp)
@@ -177,6 +255,14 @@ func (r Brand) GetHardware() (resp []datatypes.Hardware, err error) {
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand) GetHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve
 func (r Brand) GetHasAgentSupportFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand", "getHasAgentSupportFlag", nil, &r.Options, &resp)
@@ -207,6 +293,14 @@ func (r Brand) GetOwnedAccounts() (resp []datatypes.Account, err error) {
 	return
 }
 
+// GetOwnedAccountsWith is GetOwnedAccounts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand) GetOwnedAccountsWith(options sl.Options) (resp []datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOwnedAccounts()
+}
+
 // Retrieve
 func (r Brand) GetSecurityLevel() (resp datatypes.Security_Level, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand", "getSecurityLevel", nil, &r.Options, &resp)
@@ -246,6 +340,14 @@ func (r Brand) GetVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	return
 }
 
+// GetVirtualGuestsWith is GetVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand) GetVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuests()
+}
+
 // Check if the brand is IBM SLIC top level brand or sub brand.
 func (r Brand) IsIbmSlicBrand() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand", "isIbmSlicBrand", nil, &r.Options, &resp)
@@ -274,6 +376,11 @@ func (r Brand_Business_Partner) Id(id int) Brand_Business_Partner {
 	return r
 }
 
+func (r Brand_Business_Partner) InitParameter(name string, value interface{}) Brand_Business_Partner {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Brand_Business_Partner) Mask(mask string) Brand_Business_Partner {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -293,23 +400,54 @@ func (r Brand_Business_Partner) Limit(limit int) Brand_Business_Partner {
 	return r
 }
 
+func (r Brand_Business_Partner) Unlimited() Brand_Business_Partner {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Brand_Business_Partner) Offset(offset int) Brand_Business_Partner {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Brand_Business_Partner) Timeout(timeout time.Duration) Brand_Business_Partner {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Brand_Business_Partner) WithContext(ctx context.Context) Brand_Business_Partner {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve Brand associated with the business partner data
 func (r Brand_Business_Partner) GetBrand() (resp datatypes.Brand, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand_Business_Partner", "getBrand", nil, &r.Options, &resp)
 	return
 }
 
+// GetBrandWith is GetBrand, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand_Business_Partner) GetBrandWith(options sl.Options) (resp datatypes.Brand, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBrand()
+}
+
 // Retrieve Channel indicator used to categorize business partner revenue.
 func (r Brand_Business_Partner) GetChannel() (resp datatypes.Business_Partner_Channel, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand_Business_Partner", "getChannel", nil, &r.Options, &resp)
 	return
 }
 
+// GetChannelWith is GetChannel, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand_Business_Partner) GetChannelWith(options sl.Options) (resp datatypes.Business_Partner_Channel, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChannel()
+}
+
 // no documentation yet
 func (r Brand_Business_Partner) GetObject() (resp datatypes.Brand_Business_Partner, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand_Business_Partner", "getObject", nil, &r.Options, &resp)
@@ -322,6 +460,14 @@ func (r Brand_Business_Partner) GetSegment() (resp datatypes.Business_Partner_Se
 	return
 }
 
+// GetSegmentWith is GetSegment, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand_Business_Partner) GetSegmentWith(options sl.Options) (resp datatypes.Business_Partner_Segment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSegment()
+}
+
 // The [[SoftLayer_Brand_Restriction_Location_CustomerCountry]] data type defines the relationship between brands, locations and countries associated with a user's account that are ineligible when ordering products. For example, the India datacenter may not be available on the SoftLayer US brand for customers that live in Great Britain.
 type Brand_Restriction_Location_CustomerCountry struct {
 	Session *session.Session
@@ -338,6 +484,11 @@ func (r Brand_Restriction_Location_CustomerCountry) Id(id int) Brand_Restriction
 	return r
 }
 
+func (r Brand_Restriction_Location_CustomerCountry) InitParameter(name string, value interface{}) Brand_Restriction_Location_CustomerCountry {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Brand_Restriction_Location_CustomerCountry) Mask(mask string) Brand_Restriction_Location_CustomerCountry {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -357,11 +508,26 @@ func (r Brand_Restriction_Location_CustomerCountry) Limit(limit int) Brand_Restr
 	return r
 }
 
+func (r Brand_Restriction_Location_CustomerCountry) Unlimited() Brand_Restriction_Location_CustomerCountry {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Brand_Restriction_Location_CustomerCountry) Offset(offset int) Brand_Restriction_Location_CustomerCountry {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Brand_Restriction_Location_CustomerCountry) Timeout(timeout time.Duration) Brand_Restriction_Location_CustomerCountry {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Brand_Restriction_Location_CustomerCountry) WithContext(ctx context.Context) Brand_Restriction_Location_CustomerCountry {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Brand_Restriction_Location_CustomerCountry) GetAllObjects() (resp []datatypes.Brand_Restriction_Location_CustomerCountry, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand_Restriction_Location_CustomerCountry", "getAllObjects", nil, &r.Options, &resp)
@@ -374,12 +540,28 @@ func (r Brand_Restriction_Location_CustomerCountry) GetBrand() (resp datatypes.B
 	return
 }
 
+// GetBrandWith is GetBrand, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand_Restriction_Location_CustomerCountry) GetBrandWith(options sl.Options) (resp datatypes.Brand, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBrand()
+}
+
 // Retrieve This references the datacenter that has a brand-location-country restriction setup. For example, if a datacenter is listed with a restriction for Canada, a Canadian customer may not be eligible to order services at that location.
 func (r Brand_Restriction_Location_CustomerCountry) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand_Restriction_Location_CustomerCountry", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Brand_Restriction_Location_CustomerCountry) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // no documentation yet
 func (r Brand_Restriction_Location_CustomerCountry) GetObject() (resp datatypes.Brand_Restriction_Location_CustomerCountry, err error) {
 	err = r.Session.DoRequest("SoftLayer_Brand_Restriction_Location_CustomerCountry", "getObject", nil, &r.Options, &resp)
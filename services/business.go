@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Business_Partner_Channel) Id(id int) Business_Partner_Channel {
 	return r
 }
 
+func (r Business_Partner_Channel) InitParameter(name string, value interface{}) Business_Partner_Channel {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Business_Partner_Channel) Mask(mask string) Business_Partner_Channel {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Business_Partner_Channel) Limit(limit int) Business_Partner_Channel {
 	return r
 }
 
+func (r Business_Partner_Channel) Unlimited() Business_Partner_Channel {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Business_Partner_Channel) Offset(offset int) Business_Partner_Channel {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Business_Partner_Channel) Timeout(timeout time.Duration) Business_Partner_Channel {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Business_Partner_Channel) WithContext(ctx context.Context) Business_Partner_Channel {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Business_Partner_Channel) GetObject() (resp datatypes.Business_Partner_Channel, err error) {
 	err = r.Session.DoRequest("SoftLayer_Business_Partner_Channel", "getObject", nil, &r.Options, &resp)
@@ -91,6 +113,11 @@ func (r Business_Partner_Segment) Id(id int) Business_Partner_Segment {
 	return r
 }
 
+func (r Business_Partner_Segment) InitParameter(name string, value interface{}) Business_Partner_Segment {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Business_Partner_Segment) Mask(mask string) Business_Partner_Segment {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -110,11 +137,26 @@ func (r Business_Partner_Segment) Limit(limit int) Business_Partner_Segment {
 	return r
 }
 
+func (r Business_Partner_Segment) Unlimited() Business_Partner_Segment {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Business_Partner_Segment) Offset(offset int) Business_Partner_Segment {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Business_Partner_Segment) Timeout(timeout time.Duration) Business_Partner_Segment {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Business_Partner_Segment) WithContext(ctx context.Context) Business_Partner_Segment {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Business_Partner_Segment) GetObject() (resp datatypes.Business_Partner_Segment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Business_Partner_Segment", "getObject", nil, &r.Options, &resp)
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Catalyst_Company_Type) Id(id int) Catalyst_Company_Type {
 	return r
 }
 
+func (r Catalyst_Company_Type) InitParameter(name string, value interface{}) Catalyst_Company_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Catalyst_Company_Type) Mask(mask string) Catalyst_Company_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Catalyst_Company_Type) Limit(limit int) Catalyst_Company_Type {
 	return r
 }
 
+func (r Catalyst_Company_Type) Unlimited() Catalyst_Company_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Catalyst_Company_Type) Offset(offset int) Catalyst_Company_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Catalyst_Company_Type) Timeout(timeout time.Duration) Catalyst_Company_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Catalyst_Company_Type) WithContext(ctx context.Context) Catalyst_Company_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // <<<EOT
 func (r Catalyst_Company_Type) GetAllObjects() (resp []datatypes.Catalyst_Company_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Catalyst_Company_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -97,6 +119,11 @@ func (r Catalyst_Enrollment) Id(id int) Catalyst_Enrollment {
 	return r
 }
 
+func (r Catalyst_Enrollment) InitParameter(name string, value interface{}) Catalyst_Enrollment {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Catalyst_Enrollment) Mask(mask string) Catalyst_Enrollment {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -116,11 +143,26 @@ func (r Catalyst_Enrollment) Limit(limit int) Catalyst_Enrollment {
 	return r
 }
 
+func (r Catalyst_Enrollment) Unlimited() Catalyst_Enrollment {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Catalyst_Enrollment) Offset(offset int) Catalyst_Enrollment {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Catalyst_Enrollment) Timeout(timeout time.Duration) Catalyst_Enrollment {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Catalyst_Enrollment) WithContext(ctx context.Context) Catalyst_Enrollment {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Catalyst_Enrollment) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Catalyst_Enrollment", "getAccount", nil, &r.Options, &resp)
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Compliance_Report_Type) Id(id int) Compliance_Report_Type {
 	return r
 }
 
+func (r Compliance_Report_Type) InitParameter(name string, value interface{}) Compliance_Report_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Compliance_Report_Type) Mask(mask string) Compliance_Report_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Compliance_Report_Type) Limit(limit int) Compliance_Report_Type {
 	return r
 }
 
+func (r Compliance_Report_Type) Unlimited() Compliance_Report_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Compliance_Report_Type) Offset(offset int) Compliance_Report_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Compliance_Report_Type) Timeout(timeout time.Duration) Compliance_Report_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Compliance_Report_Type) WithContext(ctx context.Context) Compliance_Report_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Compliance_Report_Type) GetAllObjects() (resp []datatypes.Compliance_Report_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Compliance_Report_Type", "getAllObjects", nil, &r.Options, &resp)
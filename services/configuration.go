@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Configuration_Storage_Group_Array_Type) Id(id int) Configuration_Storage
 	return r
 }
 
+func (r Configuration_Storage_Group_Array_Type) InitParameter(name string, value interface{}) Configuration_Storage_Group_Array_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Configuration_Storage_Group_Array_Type) Mask(mask string) Configuration_Storage_Group_Array_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Configuration_Storage_Group_Array_Type) Limit(limit int) Configuration_S
 	return r
 }
 
+func (r Configuration_Storage_Group_Array_Type) Unlimited() Configuration_Storage_Group_Array_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Configuration_Storage_Group_Array_Type) Offset(offset int) Configuration_Storage_Group_Array_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Configuration_Storage_Group_Array_Type) Timeout(timeout time.Duration) Configuration_Storage_Group_Array_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Configuration_Storage_Group_Array_Type) WithContext(ctx context.Context) Configuration_Storage_Group_Array_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Configuration_Storage_Group_Array_Type) GetAllObjects() (resp []datatypes.Configuration_Storage_Group_Array_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Configuration_Storage_Group_Array_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -103,6 +125,11 @@ func (r Configuration_Template) Id(id int) Configuration_Template {
 	return r
 }
 
+func (r Configuration_Template) InitParameter(name string, value interface{}) Configuration_Template {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Configuration_Template) Mask(mask string) Configuration_Template {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -122,11 +149,26 @@ func (r Configuration_Template) Limit(limit int) Configuration_Template {
 	return r
 }
 
+func (r Configuration_Template) Unlimited() Configuration_Template {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Configuration_Template) Offset(offset int) Configuration_Template {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Configuration_Template) Timeout(timeout time.Duration) Configuration_Template {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Configuration_Template) WithContext(ctx context.Context) Configuration_Template {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Copy a configuration template and returns a newly created template copy
 func (r Configuration_Template) CopyTemplate(templateObject *datatypes.Configuration_Template) (resp datatypes.Configuration_Template, err error) {
 	params := []interface{}{
@@ -244,6 +286,11 @@ func (r Configuration_Template_Section) Id(id int) Configuration_Template_Sectio
 	return r
 }
 
+func (r Configuration_Template_Section) InitParameter(name string, value interface{}) Configuration_Template_Section {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Configuration_Template_Section) Mask(mask string) Configuration_Template_Section {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -263,11 +310,26 @@ func (r Configuration_Template_Section) Limit(limit int) Configuration_Template_
 	return r
 }
 
+func (r Configuration_Template_Section) Unlimited() Configuration_Template_Section {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Configuration_Template_Section) Offset(offset int) Configuration_Template_Section {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Configuration_Template_Section) Timeout(timeout time.Duration) Configuration_Template_Section {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Configuration_Template_Section) WithContext(ctx context.Context) Configuration_Template_Section {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Configuration_Template_Section) GetDefinitions() (resp []datatypes.Configuration_Template_Section_Definition, err error) {
 	err = r.Session.DoRequest("SoftLayer_Configuration_Template_Section", "getDefinitions", nil, &r.Options, &resp)
@@ -354,6 +416,11 @@ func (r Configuration_Template_Section_Definition) Id(id int) Configuration_Temp
 	return r
 }
 
+func (r Configuration_Template_Section_Definition) InitParameter(name string, value interface{}) Configuration_Template_Section_Definition {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Configuration_Template_Section_Definition) Mask(mask string) Configuration_Template_Section_Definition {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -373,11 +440,26 @@ func (r Configuration_Template_Section_Definition) Limit(limit int) Configuratio
 	return r
 }
 
+func (r Configuration_Template_Section_Definition) Unlimited() Configuration_Template_Section_Definition {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Configuration_Template_Section_Definition) Offset(offset int) Configuration_Template_Section_Definition {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Configuration_Template_Section_Definition) Timeout(timeout time.Duration) Configuration_Template_Section_Definition {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Configuration_Template_Section_Definition) WithContext(ctx context.Context) Configuration_Template_Section_Definition {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Configuration_Template_Section_Definition) GetAttributes() (resp []datatypes.Configuration_Template_Section_Definition_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Configuration_Template_Section_Definition", "getAttributes", nil, &r.Options, &resp)
@@ -438,6 +520,11 @@ func (r Configuration_Template_Section_Definition_Group) Id(id int) Configuratio
 	return r
 }
 
+func (r Configuration_Template_Section_Definition_Group) InitParameter(name string, value interface{}) Configuration_Template_Section_Definition_Group {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Configuration_Template_Section_Definition_Group) Mask(mask string) Configuration_Template_Section_Definition_Group {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -457,11 +544,26 @@ func (r Configuration_Template_Section_Definition_Group) Limit(limit int) Config
 	return r
 }
 
+func (r Configuration_Template_Section_Definition_Group) Unlimited() Configuration_Template_Section_Definition_Group {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Configuration_Template_Section_Definition_Group) Offset(offset int) Configuration_Template_Section_Definition_Group {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Configuration_Template_Section_Definition_Group) Timeout(timeout time.Duration) Configuration_Template_Section_Definition_Group {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Configuration_Template_Section_Definition_Group) WithContext(ctx context.Context) Configuration_Template_Section_Definition_Group {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Get all configuration definition group objects.
 //
 // ''getAllGroups'' returns an array of SoftLayer_Configuration_Template_Section_Definition_Group objects upon success.
@@ -498,6 +600,11 @@ func (r Configuration_Template_Section_Definition_Type) Id(id int) Configuration
 	return r
 }
 
+func (r Configuration_Template_Section_Definition_Type) InitParameter(name string, value interface{}) Configuration_Template_Section_Definition_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Configuration_Template_Section_Definition_Type) Mask(mask string) Configuration_Template_Section_Definition_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -517,11 +624,26 @@ func (r Configuration_Template_Section_Definition_Type) Limit(limit int) Configu
 	return r
 }
 
+func (r Configuration_Template_Section_Definition_Type) Unlimited() Configuration_Template_Section_Definition_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Configuration_Template_Section_Definition_Type) Offset(offset int) Configuration_Template_Section_Definition_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Configuration_Template_Section_Definition_Type) Timeout(timeout time.Duration) Configuration_Template_Section_Definition_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Configuration_Template_Section_Definition_Type) WithContext(ctx context.Context) Configuration_Template_Section_Definition_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Configuration_Template_Section_Definition_Type) GetObject() (resp datatypes.Configuration_Template_Section_Definition_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Configuration_Template_Section_Definition_Type", "getObject", nil, &r.Options, &resp)
@@ -544,6 +666,11 @@ func (r Configuration_Template_Section_Definition_Value) Id(id int) Configuratio
 	return r
 }
 
+func (r Configuration_Template_Section_Definition_Value) InitParameter(name string, value interface{}) Configuration_Template_Section_Definition_Value {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Configuration_Template_Section_Definition_Value) Mask(mask string) Configuration_Template_Section_Definition_Value {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -563,11 +690,26 @@ func (r Configuration_Template_Section_Definition_Value) Limit(limit int) Config
 	return r
 }
 
+func (r Configuration_Template_Section_Definition_Value) Unlimited() Configuration_Template_Section_Definition_Value {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Configuration_Template_Section_Definition_Value) Offset(offset int) Configuration_Template_Section_Definition_Value {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Configuration_Template_Section_Definition_Value) Timeout(timeout time.Duration) Configuration_Template_Section_Definition_Value {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Configuration_Template_Section_Definition_Value) WithContext(ctx context.Context) Configuration_Template_Section_Definition_Value {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Configuration_Template_Section_Definition_Value) GetDefinition() (resp datatypes.Configuration_Template_Section_Definition, err error) {
 	err = r.Session.DoRequest("SoftLayer_Configuration_Template_Section_Definition_Value", "getDefinition", nil, &r.Options, &resp)
@@ -604,6 +746,11 @@ func (r Configuration_Template_Section_Profile) Id(id int) Configuration_Templat
 	return r
 }
 
+func (r Configuration_Template_Section_Profile) InitParameter(name string, value interface{}) Configuration_Template_Section_Profile {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Configuration_Template_Section_Profile) Mask(mask string) Configuration_Template_Section_Profile {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -623,11 +770,26 @@ func (r Configuration_Template_Section_Profile) Limit(limit int) Configuration_T
 	return r
 }
 
+func (r Configuration_Template_Section_Profile) Unlimited() Configuration_Template_Section_Profile {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Configuration_Template_Section_Profile) Offset(offset int) Configuration_Template_Section_Profile {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Configuration_Template_Section_Profile) Timeout(timeout time.Duration) Configuration_Template_Section_Profile {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Configuration_Template_Section_Profile) WithContext(ctx context.Context) Configuration_Template_Section_Profile {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Configuration_Template_Section_Profile) GetConfigurationSection() (resp datatypes.Configuration_Template_Section, err error) {
 	err = r.Session.DoRequest("SoftLayer_Configuration_Template_Section_Profile", "getConfigurationSection", nil, &r.Options, &resp)
@@ -662,6 +824,11 @@ func (r Configuration_Template_Section_Reference) Id(id int) Configuration_Templ
 	return r
 }
 
+func (r Configuration_Template_Section_Reference) InitParameter(name string, value interface{}) Configuration_Template_Section_Reference {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Configuration_Template_Section_Reference) Mask(mask string) Configuration_Template_Section_Reference {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -681,11 +848,26 @@ func (r Configuration_Template_Section_Reference) Limit(limit int) Configuration
 	return r
 }
 
+func (r Configuration_Template_Section_Reference) Unlimited() Configuration_Template_Section_Reference {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Configuration_Template_Section_Reference) Offset(offset int) Configuration_Template_Section_Reference {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Configuration_Template_Section_Reference) Timeout(timeout time.Duration) Configuration_Template_Section_Reference {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Configuration_Template_Section_Reference) WithContext(ctx context.Context) Configuration_Template_Section_Reference {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Configuration_Template_Section_Reference) GetObject() (resp datatypes.Configuration_Template_Section_Reference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Configuration_Template_Section_Reference", "getObject", nil, &r.Options, &resp)
@@ -722,6 +904,11 @@ func (r Configuration_Template_Section_Type) Id(id int) Configuration_Template_S
 	return r
 }
 
+func (r Configuration_Template_Section_Type) InitParameter(name string, value interface{}) Configuration_Template_Section_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Configuration_Template_Section_Type) Mask(mask string) Configuration_Template_Section_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -741,11 +928,26 @@ func (r Configuration_Template_Section_Type) Limit(limit int) Configuration_Temp
 	return r
 }
 
+func (r Configuration_Template_Section_Type) Unlimited() Configuration_Template_Section_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Configuration_Template_Section_Type) Offset(offset int) Configuration_Template_Section_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Configuration_Template_Section_Type) Timeout(timeout time.Duration) Configuration_Template_Section_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Configuration_Template_Section_Type) WithContext(ctx context.Context) Configuration_Template_Section_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Configuration_Template_Section_Type) GetObject() (resp datatypes.Configuration_Template_Section_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Configuration_Template_Section_Type", "getObject", nil, &r.Options, &resp)
@@ -768,6 +970,11 @@ func (r Configuration_Template_Type) Id(id int) Configuration_Template_Type {
 	return r
 }
 
+func (r Configuration_Template_Type) InitParameter(name string, value interface{}) Configuration_Template_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Configuration_Template_Type) Mask(mask string) Configuration_Template_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -787,11 +994,26 @@ func (r Configuration_Template_Type) Limit(limit int) Configuration_Template_Typ
 	return r
 }
 
+func (r Configuration_Template_Type) Unlimited() Configuration_Template_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Configuration_Template_Type) Offset(offset int) Configuration_Template_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Configuration_Template_Type) Timeout(timeout time.Duration) Configuration_Template_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Configuration_Template_Type) WithContext(ctx context.Context) Configuration_Template_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Configuration_Template_Type) GetObject() (resp datatypes.Configuration_Template_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Configuration_Template_Type", "getObject", nil, &r.Options, &resp)
@@ -0,0 +1,238 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// CustomMethod describes a method of a custom service.
+type CustomMethod struct {
+	// Name is the API name of the method (e.g., "getObject")
+	Name string
+
+	// Result is a value of the type the method returns (e.g.,
+	// datatypes.Virtual_Guest{} or []datatypes.Tag{}), used to decode its
+	// responses. Nil for methods that return nothing.
+	Result interface{}
+
+	// ResultType is the API name of the result type (e.g.,
+	// "SoftLayer_Virtual_Guest"), used when the service is added to pinned
+	// API metadata. Optional.
+	ResultType string
+}
+
+// CustomServiceDefinition describes a service that is not in the generated set,
+// such as a private or preview API service.
+type CustomServiceDefinition struct {
+	// Name is the API name of the service (e.g., "SoftLayer_Preview_Service")
+	Name    string
+	Methods []CustomMethod
+}
+
+// Method returns the definition of the named method
+func (d CustomServiceDefinition) Method(name string) (CustomMethod, bool) {
+	for _, method := range d.Methods {
+		if method.Name == name {
+			return method, true
+		}
+	}
+
+	return CustomMethod{}, false
+}
+
+// AddTo adds the service to pinned API metadata, so that sessions using it
+// accept calls to the service
+func (d CustomServiceDefinition) AddTo(meta *session.Metadata) {
+	methods := map[string]string{}
+	for _, method := range d.Methods {
+		methods[method.Name] = method.ResultType
+	}
+
+	meta.AddService(d.Name, methods)
+}
+
+var customServices = struct {
+	sync.RWMutex
+	definitions map[string]CustomServiceDefinition
+}{definitions: map[string]CustomServiceDefinition{}}
+
+// RegisterCustomService registers a custom service definition, which can then
+// be used with GetCustomService. Registering a service again replaces its
+// definition.
+func RegisterCustomService(def CustomServiceDefinition) error {
+	if def.Name == "" {
+		return fmt.Errorf("A custom service requires a name")
+	}
+
+	seen := map[string]bool{}
+	for _, method := range def.Methods {
+		if method.Name == "" {
+			return fmt.Errorf("Custom service %s has a method without a name", def.Name)
+		}
+
+		if seen[method.Name] {
+			return fmt.Errorf("Custom service %s defines method %s more than once", def.Name, method.Name)
+		}
+		seen[method.Name] = true
+	}
+
+	customServices.Lock()
+	defer customServices.Unlock()
+	customServices.definitions[def.Name] = def
+	return nil
+}
+
+// LookupCustomService returns the registered definition of the named service
+func LookupCustomService(name string) (CustomServiceDefinition, bool) {
+	customServices.RLock()
+	defer customServices.RUnlock()
+	def, ok := customServices.definitions[name]
+	return def, ok
+}
+
+// CustomServices returns the sorted names of the registered custom services
+func CustomServices() []string {
+	customServices.RLock()
+	defer customServices.RUnlock()
+
+	names := make([]string, 0, len(customServices.definitions))
+	for name := range customServices.definitions {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// Custom is a registered custom service. It supports the same options as the
+// generated services.
+type Custom struct {
+	Session    *session.Session
+	Options    sl.Options
+	Definition CustomServiceDefinition
+}
+
+// GetCustomService returns an instance of the named custom service, which must
+// have been registered with RegisterCustomService
+func GetCustomService(sess *session.Session, name string) (Custom, error) {
+	def, ok := LookupCustomService(name)
+	if !ok {
+		return Custom{}, fmt.Errorf("Custom service %s is not registered", name)
+	}
+
+	return Custom{Session: sess, Definition: def}, nil
+}
+
+func (r Custom) Id(id int) Custom {
+	r.Options.Id = &id
+	return r
+}
+
+func (r Custom) InitParameter(name string, value interface{}) Custom {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
+func (r Custom) GlobalID(globalID string) Custom {
+	r.Options.GlobalID = &globalID
+	return r
+}
+
+func (r Custom) Mask(mask string) Custom {
+	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
+		mask = fmt.Sprintf("mask[%s]", mask)
+	}
+
+	r.Options.Mask = mask
+	return r
+}
+
+func (r Custom) Filter(filter string) Custom {
+	r.Options.Filter = filter
+	return r
+}
+
+func (r Custom) Limit(limit int) Custom {
+	r.Options.Limit = &limit
+	return r
+}
+
+func (r Custom) Unlimited() Custom {
+	r.Options.Unlimited = true
+	return r
+}
+
+func (r Custom) Offset(offset int) Custom {
+	r.Options.Offset = &offset
+	return r
+}
+
+func (r Custom) Timeout(timeout time.Duration) Custom {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Custom) WithContext(ctx context.Context) Custom {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
+// Call invokes a method of the service with the given parameters. The result
+// has the type of the method's Result (e.g., datatypes.Virtual_Guest), or is
+// nil for methods that return nothing.
+func (r Custom) Call(method string, params ...interface{}) (interface{}, error) {
+	m, ok := r.Definition.Method(method)
+	if !ok {
+		return nil, fmt.Errorf("Method %s is not defined for custom service %s", method, r.Definition.Name)
+	}
+
+	if m.Result == nil {
+		var resp datatypes.Void
+		return nil, r.Session.DoRequest(r.Definition.Name, method, params, &r.Options, &resp)
+	}
+
+	resp := reflect.New(reflect.TypeOf(m.Result))
+	err := r.Session.DoRequest(r.Definition.Name, method, params, &r.Options, resp.Interface())
+	return resp.Elem().Interface(), err
+}
+
+// CallInto is Call, decoding the result into pResult, which must be a pointer
+// to a value of the method's Result type (or any type, if Result is nil)
+func (r Custom) CallInto(pResult interface{}, method string, params ...interface{}) error {
+	m, ok := r.Definition.Method(method)
+	if !ok {
+		return fmt.Errorf("Method %s is not defined for custom service %s", method, r.Definition.Name)
+	}
+
+	if m.Result != nil && reflect.TypeOf(pResult) != reflect.PtrTo(reflect.TypeOf(m.Result)) {
+		return fmt.Errorf("Result of %s::%s must be decoded into a %T, got %T",
+			r.Definition.Name, method, reflect.New(reflect.TypeOf(m.Result)).Interface(), pResult)
+	}
+
+	return r.Session.DoRequest(r.Definition.Name, method, params, &r.Options, pResult)
+}
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Dns_Domain) Id(id int) Dns_Domain {
 	return r
 }
 
+func (r Dns_Domain) InitParameter(name string, value interface{}) Dns_Domain {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Dns_Domain) Mask(mask string) Dns_Domain {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Dns_Domain) Limit(limit int) Dns_Domain {
 	return r
 }
 
+func (r Dns_Domain) Unlimited() Dns_Domain {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Dns_Domain) Offset(offset int) Dns_Domain {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Dns_Domain) Timeout(timeout time.Duration) Dns_Domain {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Dns_Domain) WithContext(ctx context.Context) Dns_Domain {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create an A record on a SoftLayer domain. This is a shortcut method, meant to take the work out of creating a SoftLayer_Dns_Domain_ResourceRecord if you already have a domain record available. createARecord returns the newly created SoftLayer_Dns_Domain_ResourceRecord_AType.
 func (r Dns_Domain) CreateARecord(host *string, data *string, ttl *int) (resp datatypes.Dns_Domain_ResourceRecord_AType, err error) {
 	params := []interface{}{
@@ -204,6 +226,14 @@ func (r Dns_Domain) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Domain) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Search for [[SoftLayer_Dns_Domain]] records by domain name. getByDomainName() performs an inclusive search for domain records, returning multiple records based on partial name matches. Use this method to locate domain records if you don't have access to their id numbers.
 func (r Dns_Domain) GetByDomainName(name *string) (resp []datatypes.Dns_Domain, err error) {
 	params := []interface{}{
@@ -219,6 +249,14 @@ func (r Dns_Domain) GetManagedResourceFlag() (resp bool, err error) {
 	return
 }
 
+// GetManagedResourceFlagWith is GetManagedResourceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Domain) GetManagedResourceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedResourceFlag()
+}
+
 // getObject retrieves the SoftLayer_Dns_Domain object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Dns_Domain service. You can only retrieve domains that are assigned to your SoftLayer account.
 func (r Dns_Domain) GetObject() (resp datatypes.Dns_Domain, err error) {
 	err = r.Session.DoRequest("SoftLayer_Dns_Domain", "getObject", nil, &r.Options, &resp)
@@ -231,18 +269,42 @@ func (r Dns_Domain) GetResourceRecords() (resp []datatypes.Dns_Domain_ResourceRe
 	return
 }
 
+// GetResourceRecordsWith is GetResourceRecords, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Domain) GetResourceRecordsWith(options sl.Options) (resp []datatypes.Dns_Domain_ResourceRecord, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResourceRecords()
+}
+
 // Retrieve The secondary DNS record that defines this domain as being managed through zone transfers.
 func (r Dns_Domain) GetSecondary() (resp datatypes.Dns_Secondary, err error) {
 	err = r.Session.DoRequest("SoftLayer_Dns_Domain", "getSecondary", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecondaryWith is GetSecondary, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Domain) GetSecondaryWith(options sl.Options) (resp datatypes.Dns_Secondary, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecondary()
+}
+
 // Retrieve The start of authority (SOA) record contains authoritative and propagation details for a DNS zone. This property is not considered in requests to createObject and editObject.
 func (r Dns_Domain) GetSoaResourceRecord() (resp datatypes.Dns_Domain_ResourceRecord_SoaType, err error) {
 	err = r.Session.DoRequest("SoftLayer_Dns_Domain", "getSoaResourceRecord", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoaResourceRecordWith is GetSoaResourceRecord, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Domain) GetSoaResourceRecordWith(options sl.Options) (resp datatypes.Dns_Domain_ResourceRecord_SoaType, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoaResourceRecord()
+}
+
 // Return a SoftLayer hosted domain and resource records' data formatted as zone file.
 func (r Dns_Domain) GetZoneFileContents() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Dns_Domain", "getZoneFileContents", nil, &r.Options, &resp)
@@ -265,6 +327,11 @@ func (r Dns_Domain_Registration) Id(id int) Dns_Domain_Registration {
 	return r
 }
 
+func (r Dns_Domain_Registration) InitParameter(name string, value interface{}) Dns_Domain_Registration {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Dns_Domain_Registration) Mask(mask string) Dns_Domain_Registration {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -284,11 +351,26 @@ func (r Dns_Domain_Registration) Limit(limit int) Dns_Domain_Registration {
 	return r
 }
 
+func (r Dns_Domain_Registration) Unlimited() Dns_Domain_Registration {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Dns_Domain_Registration) Offset(offset int) Dns_Domain_Registration {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Dns_Domain_Registration) Timeout(timeout time.Duration) Dns_Domain_Registration {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Dns_Domain_Registration) WithContext(ctx context.Context) Dns_Domain_Registration {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // The addNameserversToDomain method adds nameservers to a domain for a domain that already has nameservers assigned to it. This method does not create a nameserver; the nameserver must already exist.
 func (r Dns_Domain_Registration) AddNameserversToDomain(nameservers []string) (resp bool, err error) {
 	params := []interface{}{
@@ -313,6 +395,14 @@ func (r Dns_Domain_Registration) GetAccount() (resp datatypes.Account, err error
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Domain_Registration) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // The getAuthenticationCode method retrieves the authentication code for the domain.
 func (r Dns_Domain_Registration) GetAuthenticationCode() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Dns_Domain_Registration", "getAuthenticationCode", nil, &r.Options, &resp)
@@ -337,6 +427,14 @@ func (r Dns_Domain_Registration) GetDomainRegistrationStatus() (resp datatypes.D
 	return
 }
 
+// GetDomainRegistrationStatusWith is GetDomainRegistrationStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Domain_Registration) GetDomainRegistrationStatusWith(options sl.Options) (resp datatypes.Dns_Domain_Registration_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDomainRegistrationStatus()
+}
+
 // The getExtendedAttributes method determines if a country code top level domain requires extended attributes.
 func (r Dns_Domain_Registration) GetExtendedAttributes(domainName *string) (resp []datatypes.Container_Dns_Domain_Registration_ExtendedAttribute, err error) {
 	params := []interface{}{
@@ -364,6 +462,14 @@ func (r Dns_Domain_Registration) GetRegistrantVerificationStatus() (resp datatyp
 	return
 }
 
+// GetRegistrantVerificationStatusWith is GetRegistrantVerificationStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Domain_Registration) GetRegistrantVerificationStatusWith(options sl.Options) (resp datatypes.Dns_Domain_Registration_Registrant_Verification_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRegistrantVerificationStatus()
+}
+
 // When a domain is registered or transferred, or when the registrant contact information is changed, the registrant must reply to an email requesting them to confirm that the submitted contact information is correct. This method returns the current state of the verification request.
 func (r Dns_Domain_Registration) GetRegistrantVerificationStatusDetail() (resp datatypes.Container_Dns_Domain_Registration_Registrant_Verification_StatusDetail, err error) {
 	err = r.Session.DoRequest("SoftLayer_Dns_Domain_Registration", "getRegistrantVerificationStatusDetail", nil, &r.Options, &resp)
@@ -500,6 +606,11 @@ func (r Dns_Domain_Registration_Registrant_Verification_Status) Id(id int) Dns_D
 	return r
 }
 
+func (r Dns_Domain_Registration_Registrant_Verification_Status) InitParameter(name string, value interface{}) Dns_Domain_Registration_Registrant_Verification_Status {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Dns_Domain_Registration_Registrant_Verification_Status) Mask(mask string) Dns_Domain_Registration_Registrant_Verification_Status {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -519,11 +630,26 @@ func (r Dns_Domain_Registration_Registrant_Verification_Status) Limit(limit int)
 	return r
 }
 
+func (r Dns_Domain_Registration_Registrant_Verification_Status) Unlimited() Dns_Domain_Registration_Registrant_Verification_Status {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Dns_Domain_Registration_Registrant_Verification_Status) Offset(offset int) Dns_Domain_Registration_Registrant_Verification_Status {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Dns_Domain_Registration_Registrant_Verification_Status) Timeout(timeout time.Duration) Dns_Domain_Registration_Registrant_Verification_Status {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Dns_Domain_Registration_Registrant_Verification_Status) WithContext(ctx context.Context) Dns_Domain_Registration_Registrant_Verification_Status {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Dns_Domain_Registration_Registrant_Verification_Status) GetAllObjects() (resp []datatypes.Dns_Domain_Registration_Registrant_Verification_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Dns_Domain_Registration_Registrant_Verification_Status", "getAllObjects", nil, &r.Options, &resp)
@@ -561,6 +687,11 @@ func (r Dns_Domain_Registration_Status) Id(id int) Dns_Domain_Registration_Statu
 	return r
 }
 
+func (r Dns_Domain_Registration_Status) InitParameter(name string, value interface{}) Dns_Domain_Registration_Status {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Dns_Domain_Registration_Status) Mask(mask string) Dns_Domain_Registration_Status {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -580,11 +711,26 @@ func (r Dns_Domain_Registration_Status) Limit(limit int) Dns_Domain_Registration
 	return r
 }
 
+func (r Dns_Domain_Registration_Status) Unlimited() Dns_Domain_Registration_Status {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Dns_Domain_Registration_Status) Offset(offset int) Dns_Domain_Registration_Status {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Dns_Domain_Registration_Status) Timeout(timeout time.Duration) Dns_Domain_Registration_Status {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Dns_Domain_Registration_Status) WithContext(ctx context.Context) Dns_Domain_Registration_Status {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Dns_Domain_Registration_Status) GetAllObjects() (resp []datatypes.Dns_Domain_Registration_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Dns_Domain_Registration_Status", "getAllObjects", nil, &r.Options, &resp)
@@ -626,6 +772,11 @@ func (r Dns_Domain_ResourceRecord) Id(id int) Dns_Domain_ResourceRecord {
 	return r
 }
 
+func (r Dns_Domain_ResourceRecord) InitParameter(name string, value interface{}) Dns_Domain_ResourceRecord {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Dns_Domain_ResourceRecord) Mask(mask string) Dns_Domain_ResourceRecord {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -645,11 +796,26 @@ func (r Dns_Domain_ResourceRecord) Limit(limit int) Dns_Domain_ResourceRecord {
 	return r
 }
 
+func (r Dns_Domain_ResourceRecord) Unlimited() Dns_Domain_ResourceRecord {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Dns_Domain_ResourceRecord) Offset(offset int) Dns_Domain_ResourceRecord {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Dns_Domain_ResourceRecord) Timeout(timeout time.Duration) Dns_Domain_ResourceRecord {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Dns_Domain_ResourceRecord) WithContext(ctx context.Context) Dns_Domain_ResourceRecord {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // createObject creates a new domain resource record. The ''host'' property of the templateObject parameter is scrubbed to remove all non-alpha numeric characters except for "@", "_", ".", "*", and "-". The ''data'' property of the templateObject parameter is scrubbed to remove all non-alphanumeric characters for "." and "-". Creating a resource record updates the serial number of the domain the resource record is associated with.
 //
 // ''createObject'' returns Boolean ''true'' on successful create or ''false'' if it was unable to create a resource record.
@@ -719,6 +885,14 @@ func (r Dns_Domain_ResourceRecord) GetDomain() (resp datatypes.Dns_Domain, err e
 	return
 }
 
+// GetDomainWith is GetDomain, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Domain_ResourceRecord) GetDomainWith(options sl.Options) (resp datatypes.Dns_Domain, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDomain()
+}
+
 // getObject retrieves the SoftLayer_Dns_Domain_ResourceRecord object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Dns_Domain_ResourceRecord service. You can only retrieve resource records belonging to domains that are assigned to your SoftLayer account.
 func (r Dns_Domain_ResourceRecord) GetObject() (resp datatypes.Dns_Domain_ResourceRecord, err error) {
 	err = r.Session.DoRequest("SoftLayer_Dns_Domain_ResourceRecord", "getObject", nil, &r.Options, &resp)
@@ -745,6 +919,11 @@ func (r Dns_Domain_ResourceRecord_MxType) Id(id int) Dns_Domain_ResourceRecord_M
 	return r
 }
 
+func (r Dns_Domain_ResourceRecord_MxType) InitParameter(name string, value interface{}) Dns_Domain_ResourceRecord_MxType {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Dns_Domain_ResourceRecord_MxType) Mask(mask string) Dns_Domain_ResourceRecord_MxType {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -764,11 +943,26 @@ func (r Dns_Domain_ResourceRecord_MxType) Limit(limit int) Dns_Domain_ResourceRe
 	return r
 }
 
+func (r Dns_Domain_ResourceRecord_MxType) Unlimited() Dns_Domain_ResourceRecord_MxType {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Dns_Domain_ResourceRecord_MxType) Offset(offset int) Dns_Domain_ResourceRecord_MxType {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Dns_Domain_ResourceRecord_MxType) Timeout(timeout time.Duration) Dns_Domain_ResourceRecord_MxType {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Dns_Domain_ResourceRecord_MxType) WithContext(ctx context.Context) Dns_Domain_ResourceRecord_MxType {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // createObject creates a new MX record. The ''host'' property of the templateObject parameter is scrubbed to remove all non-alpha numeric characters except for "@", "_", ".", "*", and "-". The ''data'' property of the templateObject parameter is scrubbed to remove all non-alphanumeric characters for "." and "-". Creating an MX record updates the serial number of the domain the resource record is associated with.
 func (r Dns_Domain_ResourceRecord_MxType) CreateObject(templateObject *datatypes.Dns_Domain_ResourceRecord_MxType) (resp datatypes.Dns_Domain_ResourceRecord_MxType, err error) {
 	params := []interface{}{
@@ -836,6 +1030,14 @@ func (r Dns_Domain_ResourceRecord_MxType) GetDomain() (resp datatypes.Dns_Domain
 	return
 }
 
+// GetDomainWith is GetDomain, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Domain_ResourceRecord_MxType) GetDomainWith(options sl.Options) (resp datatypes.Dns_Domain, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDomain()
+}
+
 // getObject retrieves the SoftLayer_Dns_Domain_ResourceRecord_MxType object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Dns_Domain_ResourceRecord_MxType service. You can only retrieve resource records belonging to domains that are assigned to your SoftLayer account.
 func (r Dns_Domain_ResourceRecord_MxType) GetObject() (resp datatypes.Dns_Domain_ResourceRecord_MxType, err error) {
 	err = r.Session.DoRequest("SoftLayer_Dns_Domain_ResourceRecord_MxType", "getObject", nil, &r.Options, &resp)
@@ -858,6 +1060,11 @@ func (r Dns_Domain_ResourceRecord_SrvType) Id(id int) Dns_Domain_ResourceRecord_
 	return r
 }
 
+func (r Dns_Domain_ResourceRecord_SrvType) InitParameter(name string, value interface{}) Dns_Domain_ResourceRecord_SrvType {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Dns_Domain_ResourceRecord_SrvType) Mask(mask string) Dns_Domain_ResourceRecord_SrvType {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -877,11 +1084,26 @@ func (r Dns_Domain_ResourceRecord_SrvType) Limit(limit int) Dns_Domain_ResourceR
 	return r
 }
 
+func (r Dns_Domain_ResourceRecord_SrvType) Unlimited() Dns_Domain_ResourceRecord_SrvType {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Dns_Domain_ResourceRecord_SrvType) Offset(offset int) Dns_Domain_ResourceRecord_SrvType {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Dns_Domain_ResourceRecord_SrvType) Timeout(timeout time.Duration) Dns_Domain_ResourceRecord_SrvType {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Dns_Domain_ResourceRecord_SrvType) WithContext(ctx context.Context) Dns_Domain_ResourceRecord_SrvType {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // createObject creates a new SRV record. The ''host'' property of the templateObject parameter is scrubbed to remove all non-alpha numeric characters except for "@", "_", ".", "*", and "-". The ''data'' property of the templateObject parameter is scrubbed to remove all non-alphanumeric characters for "." and "-". Creating an SRV record updates the serial number of the domain the resource record is associated with.
 func (r Dns_Domain_ResourceRecord_SrvType) CreateObject(templateObject *datatypes.Dns_Domain_ResourceRecord_SrvType) (resp datatypes.Dns_Domain_ResourceRecord_SrvType, err error) {
 	params := []interface{}{
@@ -949,6 +1171,14 @@ func (r Dns_Domain_ResourceRecord_SrvType) GetDomain() (resp datatypes.Dns_Domai
 	return
 }
 
+// GetDomainWith is GetDomain, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Domain_ResourceRecord_SrvType) GetDomainWith(options sl.Options) (resp datatypes.Dns_Domain, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDomain()
+}
+
 // getObject retrieves the SoftLayer_Dns_Domain_ResourceRecord_SrvType object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Dns_Domain_ResourceRecord_SrvType service. You can only retrieve resource records belonging to domains that are assigned to your SoftLayer account.
 func (r Dns_Domain_ResourceRecord_SrvType) GetObject() (resp datatypes.Dns_Domain_ResourceRecord_SrvType, err error) {
 	err = r.Session.DoRequest("SoftLayer_Dns_Domain_ResourceRecord_SrvType", "getObject", nil, &r.Options, &resp)
@@ -971,6 +1201,11 @@ func (r Dns_Secondary) Id(id int) Dns_Secondary {
 	return r
 }
 
+func (r Dns_Secondary) InitParameter(name string, value interface{}) Dns_Secondary {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Dns_Secondary) Mask(mask string) Dns_Secondary {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -990,11 +1225,26 @@ func (r Dns_Secondary) Limit(limit int) Dns_Secondary {
 	return r
 }
 
+func (r Dns_Secondary) Unlimited() Dns_Secondary {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Dns_Secondary) Offset(offset int) Dns_Secondary {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Dns_Secondary) Timeout(timeout time.Duration) Dns_Secondary {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Dns_Secondary) WithContext(ctx context.Context) Dns_Secondary {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // A secondary DNS record may be converted to a primary DNS record. By converting a secondary DNS record, the SoftLayer name servers will be the authoritative nameserver for this domain and will be directly editable in the SoftLayer API and Portal.
 //
 // Primary DNS record conversion performs the following steps:
@@ -1054,6 +1304,14 @@ func (r Dns_Secondary) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Secondary) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Search for [[SoftLayer_Dns_Domain_Secondary]] records by domain name. getByDomainName() performs an inclusive search for secondary domain records, returning multiple records based on partial name matches. Use this method to locate secondary domain records if you don't have access to their id numbers.
 func (r Dns_Secondary) GetByDomainName(name *string) (resp []datatypes.Dns_Secondary, err error) {
 	params := []interface{}{
@@ -1069,12 +1327,28 @@ func (r Dns_Secondary) GetDomain() (resp datatypes.Dns_Domain, err error) {
 	return
 }
 
+// GetDomainWith is GetDomain, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Secondary) GetDomainWith(options sl.Options) (resp datatypes.Dns_Domain, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDomain()
+}
+
 // Retrieve The error messages created during secondary DNS record transfer.
 func (r Dns_Secondary) GetErrorMessages() (resp []datatypes.Dns_Message, err error) {
 	err = r.Session.DoRequest("SoftLayer_Dns_Secondary", "getErrorMessages", nil, &r.Options, &resp)
 	return
 }
 
+// GetErrorMessagesWith is GetErrorMessages, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Secondary) GetErrorMessagesWith(options sl.Options) (resp []datatypes.Dns_Message, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetErrorMessages()
+}
+
 // getObject retrieves the SoftLayer_Dns_Secondary object whose ID number corresponds to the ID number of the init paramater passed to the SoftLayer_Dns_Secondary service. You can only retrieve a secondary DNS record that is assigned to your SoftLayer customer account.
 func (r Dns_Secondary) GetObject() (resp datatypes.Dns_Secondary, err error) {
 	err = r.Session.DoRequest("SoftLayer_Dns_Secondary", "getObject", nil, &r.Options, &resp)
@@ -1087,6 +1361,14 @@ func (r Dns_Secondary) GetStatus() (resp datatypes.Dns_Status, err error) {
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Dns_Secondary) GetStatusWith(options sl.Options) (resp datatypes.Dns_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // Force a secondary DNS zone transfer by setting it's status "Transfer Now".  A zone transfer will be initiated within a minute of receiving this API call.
 func (r Dns_Secondary) TransferNow() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Dns_Secondary", "transferNow", nil, &r.Options, &resp)
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Email_Subscription) Id(id int) Email_Subscription {
 	return r
 }
 
+func (r Email_Subscription) InitParameter(name string, value interface{}) Email_Subscription {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Email_Subscription) Mask(mask string) Email_Subscription {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Email_Subscription) Limit(limit int) Email_Subscription {
 	return r
 }
 
+func (r Email_Subscription) Unlimited() Email_Subscription {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Email_Subscription) Offset(offset int) Email_Subscription {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Email_Subscription) Timeout(timeout time.Duration) Email_Subscription {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Email_Subscription) WithContext(ctx context.Context) Email_Subscription {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Email_Subscription) Disable() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Email_Subscription", "disable", nil, &r.Options, &resp)
@@ -115,6 +137,11 @@ func (r Email_Subscription_Group) Id(id int) Email_Subscription_Group {
 	return r
 }
 
+func (r Email_Subscription_Group) InitParameter(name string, value interface{}) Email_Subscription_Group {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Email_Subscription_Group) Mask(mask string) Email_Subscription_Group {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -134,11 +161,26 @@ func (r Email_Subscription_Group) Limit(limit int) Email_Subscription_Group {
 	return r
 }
 
+func (r Email_Subscription_Group) Unlimited() Email_Subscription_Group {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Email_Subscription_Group) Offset(offset int) Email_Subscription_Group {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Email_Subscription_Group) Timeout(timeout time.Duration) Email_Subscription_Group {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Email_Subscription_Group) WithContext(ctx context.Context) Email_Subscription_Group {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Email_Subscription_Group) GetAllObjects() (resp []datatypes.Email_Subscription_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Email_Subscription_Group", "getAllObjects", nil, &r.Options, &resp)
@@ -156,3 +198,11 @@ func (r Email_Subscription_Group) GetSubscriptions() (resp []datatypes.Email_Sub
 	err = r.Session.DoRequest("SoftLayer_Email_Subscription_Group", "getSubscriptions", nil, &r.Options, &resp)
 	return
 }
+
+// GetSubscriptionsWith is GetSubscriptions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Email_Subscription_Group) GetSubscriptionsWith(options sl.Options) (resp []datatypes.Email_Subscription, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubscriptions()
+}
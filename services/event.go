@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Event_Log) Id(id int) Event_Log {
 	return r
 }
 
+func (r Event_Log) InitParameter(name string, value interface{}) Event_Log {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Event_Log) Mask(mask string) Event_Log {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Event_Log) Limit(limit int) Event_Log {
 	return r
 }
 
+func (r Event_Log) Unlimited() Event_Log {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Event_Log) Offset(offset int) Event_Log {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Event_Log) Timeout(timeout time.Duration) Event_Log {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Event_Log) WithContext(ctx context.Context) Event_Log {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This all indexed event names.
 func (r Event_Log) GetAllEventNames(objectName *string) (resp []string, err error) {
 	params := []interface{}{
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/session"
 	"github.com/softlayer/softlayer-go/sl"
@@ -44,6 +46,11 @@ func (r Exception_Brand_Creation) Id(id int) Exception_Brand_Creation {
 	return r
 }
 
+func (r Exception_Brand_Creation) InitParameter(name string, value interface{}) Exception_Brand_Creation {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Exception_Brand_Creation) Mask(mask string) Exception_Brand_Creation {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -63,7 +70,22 @@ func (r Exception_Brand_Creation) Limit(limit int) Exception_Brand_Creation {
 	return r
 }
 
+func (r Exception_Brand_Creation) Unlimited() Exception_Brand_Creation {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Exception_Brand_Creation) Offset(offset int) Exception_Brand_Creation {
 	r.Options.Offset = &offset
 	return r
 }
+
+func (r Exception_Brand_Creation) Timeout(timeout time.Duration) Exception_Brand_Creation {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Exception_Brand_Creation) WithContext(ctx context.Context) Exception_Brand_Creation {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
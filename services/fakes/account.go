@@ -0,0 +1,4159 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/**
+ * AUTOMATICALLY GENERATED CODE - DO NOT MODIFY
+ */
+
+package fakes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// AccountService describes the API methods of services.Account
+type AccountService interface {
+	ActivatePartner(accountId *string, hashCode *string) (resp datatypes.Account, err error)
+	AddAchInformation(achInformation *datatypes.Container_Billing_Info_Ach) (resp bool, err error)
+	AddReferralPartnerPaymentOption(paymentOption *datatypes.Container_Referral_Partner_Payment_Option) (resp bool, err error)
+	AreVdrUpdatesBlockedForBilling() (resp bool, err error)
+	CancelPayPalTransaction(token *string, payerId *string) (resp bool, err error)
+	CompletePayPalTransaction(token *string, payerId *string) (resp string, err error)
+	CountHourlyInstances() (resp int, err error)
+	CreateUser(templateObject *datatypes.User_Customer, password *string, vpnPassword *string, silentlyCreateFlag *bool) (resp datatypes.User_Customer, err error)
+	DisableEuSupport() (err error)
+	EnableEuSupport() (err error)
+	GetAbuseEmail() (resp string, err error)
+	GetAbuseEmailWith(options sl.Options) (resp string, err error)
+	GetAbuseEmails() (resp []datatypes.Account_AbuseEmail, err error)
+	GetAbuseEmailsWith(options sl.Options) (resp []datatypes.Account_AbuseEmail, err error)
+	GetAccountBackupHistory(startDate *datatypes.Time, endDate *datatypes.Time, backupStatus *string) (resp []datatypes.Container_Network_Storage_Evault_WebCc_JobDetails, err error)
+	GetAccountContacts() (resp []datatypes.Account_Contact, err error)
+	GetAccountContactsWith(options sl.Options) (resp []datatypes.Account_Contact, err error)
+	GetAccountLicenses() (resp []datatypes.Software_AccountLicense, err error)
+	GetAccountLicensesWith(options sl.Options) (resp []datatypes.Software_AccountLicense, err error)
+	GetAccountLinks() (resp []datatypes.Account_Link, err error)
+	GetAccountStatus() (resp datatypes.Account_Status, err error)
+	GetAccountStatusWith(options sl.Options) (resp datatypes.Account_Status, err error)
+	GetAccountTraitValue(keyName *string) (resp string, err error)
+	GetActiveAccountDiscountBillingItem() (resp datatypes.Billing_Item, err error)
+	GetActiveAccountDiscountBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error)
+	GetActiveAccountLicenses() (resp []datatypes.Software_AccountLicense, err error)
+	GetActiveAccountLicensesWith(options sl.Options) (resp []datatypes.Software_AccountLicense, err error)
+	GetActiveAddresses() (resp []datatypes.Account_Address, err error)
+	GetActiveAddressesWith(options sl.Options) (resp []datatypes.Account_Address, err error)
+	GetActiveAgreements() (resp []datatypes.Account_Agreement, err error)
+	GetActiveAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error)
+	GetActiveAlarms() (resp []datatypes.Container_Monitoring_Alarm_History, err error)
+	GetActiveBillingAgreements() (resp []datatypes.Account_Agreement, err error)
+	GetActiveBillingAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error)
+	GetActiveCatalystEnrollment() (resp datatypes.Catalyst_Enrollment, err error)
+	GetActiveColocationContainers() (resp []datatypes.Billing_Item, err error)
+	GetActiveColocationContainersWith(options sl.Options) (resp []datatypes.Billing_Item, err error)
+	GetActiveFlexibleCreditEnrollment() (resp datatypes.FlexibleCredit_Enrollment, err error)
+	GetActiveFlexibleCreditEnrollmentWith(options sl.Options) (resp datatypes.FlexibleCredit_Enrollment, err error)
+	GetActiveNotificationSubscribers() (resp []datatypes.Notification_Subscriber, err error)
+	GetActiveOutletPackages() (resp []datatypes.Product_Package, err error)
+	GetActivePackages() (resp []datatypes.Product_Package, err error)
+	GetActivePackagesByAttribute(attributeKeyName *string) (resp []datatypes.Product_Package, err error)
+	GetActivePrivateHostedCloudPackages() (resp []datatypes.Product_Package, err error)
+	GetActiveQuotes() (resp []datatypes.Billing_Order_Quote, err error)
+	GetActiveQuotesWith(options sl.Options) (resp []datatypes.Billing_Order_Quote, err error)
+	GetActiveReservedCapacityAgreements() (resp []datatypes.Account_Agreement, err error)
+	GetActiveReservedCapacityAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error)
+	GetActiveVirtualLicenses() (resp []datatypes.Software_VirtualLicense, err error)
+	GetActiveVirtualLicensesWith(options sl.Options) (resp []datatypes.Software_VirtualLicense, err error)
+	GetAdcLoadBalancers() (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, err error)
+	GetAdcLoadBalancersWith(options sl.Options) (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, err error)
+	GetAddresses() (resp []datatypes.Account_Address, err error)
+	GetAddressesWith(options sl.Options) (resp []datatypes.Account_Address, err error)
+	GetAffiliateId() (resp string, err error)
+	GetAffiliateIdWith(options sl.Options) (resp string, err error)
+	GetAggregatedUptimeGraph(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Container_Graph, err error)
+	GetAllBillingItems() (resp []datatypes.Billing_Item, err error)
+	GetAllBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error)
+	GetAllCommissionBillingItems() (resp []datatypes.Billing_Item, err error)
+	GetAllCommissionBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error)
+	GetAllRecurringTopLevelBillingItems() (resp []datatypes.Billing_Item, err error)
+	GetAllRecurringTopLevelBillingItemsUnfiltered() (resp []datatypes.Billing_Item, err error)
+	GetAllRecurringTopLevelBillingItemsUnfilteredWith(options sl.Options) (resp []datatypes.Billing_Item, err error)
+	GetAllRecurringTopLevelBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error)
+	GetAllSubnetBillingItems() (resp []datatypes.Billing_Item, err error)
+	GetAllSubnetBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error)
+	GetAllTopLevelBillingItems() (resp []datatypes.Billing_Item, err error)
+	GetAllTopLevelBillingItemsUnfiltered() (resp []datatypes.Billing_Item, err error)
+	GetAllTopLevelBillingItemsUnfilteredWith(options sl.Options) (resp []datatypes.Billing_Item, err error)
+	GetAllTopLevelBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error)
+	GetAllowIbmIdSilentMigrationFlag() (resp bool, err error)
+	GetAllowIbmIdSilentMigrationFlagWith(options sl.Options) (resp bool, err error)
+	GetAllowsBluemixAccountLinkingFlag() (resp bool, err error)
+	GetAllowsBluemixAccountLinkingFlagWith(options sl.Options) (resp bool, err error)
+	GetAlternateCreditCardData() (resp datatypes.Container_Account_Payment_Method_CreditCard, err error)
+	GetApplicationDeliveryControllers() (resp []datatypes.Network_Application_Delivery_Controller, err error)
+	GetApplicationDeliveryControllersWith(options sl.Options) (resp []datatypes.Network_Application_Delivery_Controller, err error)
+	GetAttributeByType(attributeType *string) (resp datatypes.Account_Attribute, err error)
+	GetAttributes() (resp []datatypes.Account_Attribute, err error)
+	GetAttributesWith(options sl.Options) (resp []datatypes.Account_Attribute, err error)
+	GetAuxiliaryNotifications() (resp []datatypes.Container_Utility_Message, err error)
+	GetAvailablePublicNetworkVlans() (resp []datatypes.Network_Vlan, err error)
+	GetAvailablePublicNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error)
+	GetAverageArchiveUsageMetricDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp datatypes.Float64, err error)
+	GetAveragePublicUsageMetricDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp datatypes.Float64, err error)
+	GetBalance() (resp datatypes.Float64, err error)
+	GetBalanceWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetBandwidthAllotments() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetBandwidthAllotmentsOverAllocation() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetBandwidthAllotmentsOverAllocationWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetBandwidthAllotmentsProjectedOverAllocation() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetBandwidthAllotmentsProjectedOverAllocationWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetBandwidthAllotmentsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetBareMetalInstances() (resp []datatypes.Hardware, err error)
+	GetBareMetalInstancesWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetBillingAgreements() (resp []datatypes.Account_Agreement, err error)
+	GetBillingAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error)
+	GetBillingInfo() (resp datatypes.Billing_Info, err error)
+	GetBillingInfoWith(options sl.Options) (resp datatypes.Billing_Info, err error)
+	GetBlockDeviceTemplateGroups() (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error)
+	GetBlockDeviceTemplateGroupsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error)
+	GetBluemixAccountLink() (resp datatypes.Account_Link_Bluemix, err error)
+	GetBluemixAccountLinkWith(options sl.Options) (resp datatypes.Account_Link_Bluemix, err error)
+	GetBluemixLinkedFlag() (resp bool, err error)
+	GetBluemixLinkedFlagWith(options sl.Options) (resp bool, err error)
+	GetBrand() (resp datatypes.Brand, err error)
+	GetBrandAccountFlag() (resp bool, err error)
+	GetBrandKeyName() (resp string, err error)
+	GetBrandKeyNameWith(options sl.Options) (resp string, err error)
+	GetBusinessPartner() (resp datatypes.Account_Business_Partner, err error)
+	GetBusinessPartnerWith(options sl.Options) (resp datatypes.Account_Business_Partner, err error)
+	GetCanOrderAdditionalVlansFlag() (resp bool, err error)
+	GetCanOrderAdditionalVlansFlagWith(options sl.Options) (resp bool, err error)
+	GetCarts() (resp []datatypes.Billing_Order_Quote, err error)
+	GetCartsWith(options sl.Options) (resp []datatypes.Billing_Order_Quote, err error)
+	GetCatalystEnrollments() (resp []datatypes.Catalyst_Enrollment, err error)
+	GetCdnAccounts() (resp []datatypes.Network_ContentDelivery_Account, err error)
+	GetCdnAccountsWith(options sl.Options) (resp []datatypes.Network_ContentDelivery_Account, err error)
+	GetClosedTickets() (resp []datatypes.Ticket, err error)
+	GetClosedTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetCurrentBackupStatisticsGraph(detailedGraph *bool) (resp datatypes.Container_Account_Graph_Outputs, err error)
+	GetCurrentTicketStatisticsGraph(detailedGraph *bool) (resp datatypes.Container_Account_Graph_Outputs, err error)
+	GetCurrentUser() (resp datatypes.User_Customer, err error)
+	GetDatacentersWithSubnetAllocations() (resp []datatypes.Location, err error)
+	GetDatacentersWithSubnetAllocationsWith(options sl.Options) (resp []datatypes.Location, err error)
+	GetDedicatedHosts() (resp []datatypes.Virtual_DedicatedHost, err error)
+	GetDedicatedHostsForImageTemplate(imageTemplateId *int) (resp []datatypes.Virtual_DedicatedHost, err error)
+	GetDedicatedHostsWith(options sl.Options) (resp []datatypes.Virtual_DedicatedHost, err error)
+	GetDisablePaymentProcessingFlag() (resp bool, err error)
+	GetDisablePaymentProcessingFlagWith(options sl.Options) (resp bool, err error)
+	GetDiskUsageMetricDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error)
+	GetDiskUsageMetricDataFromLegacyByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error)
+	GetDiskUsageMetricDataFromMetricTrackingObjectSystemByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error)
+	GetDiskUsageMetricImageByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp datatypes.Container_Account_Graph_Outputs, err error)
+	GetDisplaySupportRepresentativeAssignments() (resp []datatypes.Account_Attachment_Employee, err error)
+	GetDisplaySupportRepresentativeAssignmentsWith(options sl.Options) (resp []datatypes.Account_Attachment_Employee, err error)
+	GetDomainRegistrations() (resp []datatypes.Dns_Domain_Registration, err error)
+	GetDomains() (resp []datatypes.Dns_Domain, err error)
+	GetDomainsWith(options sl.Options) (resp []datatypes.Dns_Domain, err error)
+	GetDomainsWithoutSecondaryDnsRecords() (resp []datatypes.Dns_Domain, err error)
+	GetDomainsWithoutSecondaryDnsRecordsWith(options sl.Options) (resp []datatypes.Dns_Domain, err error)
+	GetEuSupportedFlag() (resp bool, err error)
+	GetEuSupportedFlagWith(options sl.Options) (resp bool, err error)
+	GetEvaultCapacityGB() (resp uint, err error)
+	GetEvaultCapacityGBWith(options sl.Options) (resp uint, err error)
+	GetEvaultMasterUsers() (resp []datatypes.Account_Password, err error)
+	GetEvaultMasterUsersWith(options sl.Options) (resp []datatypes.Account_Password, err error)
+	GetEvaultNetworkStorage() (resp []datatypes.Network_Storage, err error)
+	GetEvaultNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetExecutiveSummaryPdf(pdfType *string, historicalType *string, startDate *string, endDate *string) (resp []byte, err error)
+	GetExpiredSecurityCertificates() (resp []datatypes.Security_Certificate, err error)
+	GetExpiredSecurityCertificatesWith(options sl.Options) (resp []datatypes.Security_Certificate, err error)
+	GetFacilityLogs() (resp []datatypes.User_Access_Facility_Log, err error)
+	GetFacilityLogsWith(options sl.Options) (resp []datatypes.User_Access_Facility_Log, err error)
+	GetFlexibleCreditEnrollments() (resp []datatypes.FlexibleCredit_Enrollment, err error)
+	GetFlexibleCreditEnrollmentsWith(options sl.Options) (resp []datatypes.FlexibleCredit_Enrollment, err error)
+	GetFlexibleCreditProgramInfo(forNextBillCycle *bool) (resp datatypes.Container_Account_Discount_Program, err error)
+	GetForcePaasAccountLinkDate() (resp string, err error)
+	GetForcePaasAccountLinkDateWith(options sl.Options) (resp string, err error)
+	GetGlobalIpRecords() (resp []datatypes.Network_Subnet_IpAddress_Global, err error)
+	GetGlobalIpv4Records() (resp []datatypes.Network_Subnet_IpAddress_Global, err error)
+	GetGlobalIpv6Records() (resp []datatypes.Network_Subnet_IpAddress_Global, err error)
+	GetGlobalLoadBalancerAccounts() (resp []datatypes.Network_LoadBalancer_Global_Account, err error)
+	GetGlobalLoadBalancerAccountsWith(options sl.Options) (resp []datatypes.Network_LoadBalancer_Global_Account, err error)
+	GetHardware() (resp []datatypes.Hardware, err error)
+	GetHardwareOverBandwidthAllocation() (resp []datatypes.Hardware, err error)
+	GetHardwareOverBandwidthAllocationWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetHardwarePools() (resp []datatypes.Container_Hardware_Pool_Details, err error)
+	GetHardwareProjectedOverBandwidthAllocation() (resp []datatypes.Hardware, err error)
+	GetHardwareProjectedOverBandwidthAllocationWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetHardwareWithCpanel() (resp []datatypes.Hardware, err error)
+	GetHardwareWithCpanelWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetHardwareWithHelm() (resp []datatypes.Hardware, err error)
+	GetHardwareWithHelmWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetHardwareWithMcafee() (resp []datatypes.Hardware, err error)
+	GetHardwareWithMcafeeAntivirusRedhat() (resp []datatypes.Hardware, err error)
+	GetHardwareWithMcafeeAntivirusRedhatWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetHardwareWithMcafeeAntivirusWindows() (resp []datatypes.Hardware, err error)
+	GetHardwareWithMcafeeAntivirusWindowsWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetHardwareWithMcafeeIntrusionDetectionSystem() (resp []datatypes.Hardware, err error)
+	GetHardwareWithMcafeeIntrusionDetectionSystemWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetHardwareWithMcafeeWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetHardwareWithPlesk() (resp []datatypes.Hardware, err error)
+	GetHardwareWithPleskWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetHardwareWithQuantastor() (resp []datatypes.Hardware, err error)
+	GetHardwareWithQuantastorWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetHardwareWithUrchin() (resp []datatypes.Hardware, err error)
+	GetHardwareWithUrchinWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetHardwareWithWindows() (resp []datatypes.Hardware, err error)
+	GetHardwareWithWindowsWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetHasEvaultBareMetalRestorePluginFlag() (resp bool, err error)
+	GetHasEvaultBareMetalRestorePluginFlagWith(options sl.Options) (resp bool, err error)
+	GetHasIderaBareMetalRestorePluginFlag() (resp bool, err error)
+	GetHasIderaBareMetalRestorePluginFlagWith(options sl.Options) (resp bool, err error)
+	GetHasPendingOrder() (resp uint, err error)
+	GetHasPendingOrderWith(options sl.Options) (resp uint, err error)
+	GetHasR1softBareMetalRestorePluginFlag() (resp bool, err error)
+	GetHasR1softBareMetalRestorePluginFlagWith(options sl.Options) (resp bool, err error)
+	GetHistoricalBackupGraph(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Container_Account_Graph_Outputs, err error)
+	GetHistoricalBandwidthGraph(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Container_Account_Graph_Outputs, err error)
+	GetHistoricalTicketGraph(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Container_Account_Graph_Outputs, err error)
+	GetHistoricalUptimeGraph(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Container_Account_Graph_Outputs, err error)
+	GetHourlyBareMetalInstances() (resp []datatypes.Hardware, err error)
+	GetHourlyBareMetalInstancesWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetHourlyServiceBillingItems() (resp []datatypes.Billing_Item, err error)
+	GetHourlyServiceBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error)
+	GetHourlyVirtualGuests() (resp []datatypes.Virtual_Guest, err error)
+	GetHourlyVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetHubNetworkStorage() (resp []datatypes.Network_Storage, err error)
+	GetHubNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetIbmCustomerNumber() (resp string, err error)
+	GetIbmCustomerNumberWith(options sl.Options) (resp string, err error)
+	GetIbmIdAuthenticationRequiredFlag() (resp bool, err error)
+	GetIbmIdAuthenticationRequiredFlagWith(options sl.Options) (resp bool, err error)
+	GetIbmIdMigrationExpirationTimestamp() (resp string, err error)
+	GetIbmIdMigrationExpirationTimestampWith(options sl.Options) (resp string, err error)
+	GetInProgressExternalAccountSetup() (resp datatypes.Account_External_Setup, err error)
+	GetInProgressExternalAccountSetupWith(options sl.Options) (resp datatypes.Account_External_Setup, err error)
+	GetInternalNotes() (resp []datatypes.Account_Note, err error)
+	GetInvoices() (resp []datatypes.Billing_Invoice, err error)
+	GetInvoicesWith(options sl.Options) (resp []datatypes.Billing_Invoice, err error)
+	GetIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error)
+	GetIscsiNetworkStorage() (resp []datatypes.Network_Storage, err error)
+	GetIscsiNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetLargestAllowedSubnetCidr(numberOfHosts *int, locationId *int) (resp int, err error)
+	GetLastCanceledBillingItem() (resp datatypes.Billing_Item, err error)
+	GetLastCanceledBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error)
+	GetLastCancelledServerBillingItem() (resp datatypes.Billing_Item, err error)
+	GetLastCancelledServerBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error)
+	GetLastFiveClosedAbuseTickets() (resp []datatypes.Ticket, err error)
+	GetLastFiveClosedAbuseTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetLastFiveClosedAccountingTickets() (resp []datatypes.Ticket, err error)
+	GetLastFiveClosedAccountingTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetLastFiveClosedOtherTickets() (resp []datatypes.Ticket, err error)
+	GetLastFiveClosedOtherTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetLastFiveClosedSalesTickets() (resp []datatypes.Ticket, err error)
+	GetLastFiveClosedSalesTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetLastFiveClosedSupportTickets() (resp []datatypes.Ticket, err error)
+	GetLastFiveClosedSupportTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetLastFiveClosedTickets() (resp []datatypes.Ticket, err error)
+	GetLastFiveClosedTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetLatestBillDate() (resp datatypes.Time, err error)
+	GetLatestBillDateWith(options sl.Options) (resp datatypes.Time, err error)
+	GetLatestRecurringInvoice() (resp datatypes.Billing_Invoice, err error)
+	GetLatestRecurringInvoiceWith(options sl.Options) (resp datatypes.Billing_Invoice, err error)
+	GetLatestRecurringPendingInvoice() (resp datatypes.Billing_Invoice, err error)
+	GetLatestRecurringPendingInvoiceWith(options sl.Options) (resp datatypes.Billing_Invoice, err error)
+	GetLegacyBandwidthAllotments() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetLegacyBandwidthAllotmentsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetLegacyIscsiCapacityGB() (resp uint, err error)
+	GetLegacyIscsiCapacityGBWith(options sl.Options) (resp uint, err error)
+	GetLoadBalancers() (resp []datatypes.Network_LoadBalancer_VirtualIpAddress, err error)
+	GetLoadBalancersWith(options sl.Options) (resp []datatypes.Network_LoadBalancer_VirtualIpAddress, err error)
+	GetLockboxCapacityGB() (resp uint, err error)
+	GetLockboxCapacityGBWith(options sl.Options) (resp uint, err error)
+	GetLockboxNetworkStorage() (resp []datatypes.Network_Storage, err error)
+	GetLockboxNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetManualPaymentsUnderReview() (resp []datatypes.Billing_Payment_Card_ManualPayment, err error)
+	GetMasterUser() (resp datatypes.User_Customer, err error)
+	GetMasterUserWith(options sl.Options) (resp datatypes.User_Customer, err error)
+	GetMediaDataTransferRequests() (resp []datatypes.Account_Media_Data_Transfer_Request, err error)
+	GetMediaDataTransferRequestsWith(options sl.Options) (resp []datatypes.Account_Media_Data_Transfer_Request, err error)
+	GetMonthlyBareMetalInstances() (resp []datatypes.Hardware, err error)
+	GetMonthlyBareMetalInstancesWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetMonthlyVirtualGuests() (resp []datatypes.Virtual_Guest, err error)
+	GetMonthlyVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetNasNetworkStorage() (resp []datatypes.Network_Storage, err error)
+	GetNasNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetNetAppActiveAccountLicenseKeys() (resp []string, err error)
+	GetNetworkCreationFlag() (resp bool, err error)
+	GetNetworkCreationFlagWith(options sl.Options) (resp bool, err error)
+	GetNetworkGateways() (resp []datatypes.Network_Gateway, err error)
+	GetNetworkGatewaysWith(options sl.Options) (resp []datatypes.Network_Gateway, err error)
+	GetNetworkHardware() (resp []datatypes.Hardware, err error)
+	GetNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetNetworkMessageDeliveryAccounts() (resp []datatypes.Network_Message_Delivery, err error)
+	GetNetworkMonitorDownHardware() (resp []datatypes.Hardware, err error)
+	GetNetworkMonitorDownHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetNetworkMonitorDownVirtualGuests() (resp []datatypes.Virtual_Guest, err error)
+	GetNetworkMonitorDownVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetNetworkMonitorRecoveringHardware() (resp []datatypes.Hardware, err error)
+	GetNetworkMonitorRecoveringHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetNetworkMonitorRecoveringVirtualGuests() (resp []datatypes.Virtual_Guest, err error)
+	GetNetworkMonitorRecoveringVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetNetworkMonitorUpHardware() (resp []datatypes.Hardware, err error)
+	GetNetworkMonitorUpHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetNetworkMonitorUpVirtualGuests() (resp []datatypes.Virtual_Guest, err error)
+	GetNetworkMonitorUpVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetNetworkStorage() (resp []datatypes.Network_Storage, err error)
+	GetNetworkStorageGroups() (resp []datatypes.Network_Storage_Group, err error)
+	GetNetworkStorageGroupsWith(options sl.Options) (resp []datatypes.Network_Storage_Group, err error)
+	GetNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetNetworkTunnelContexts() (resp []datatypes.Network_Tunnel_Module_Context, err error)
+	GetNetworkTunnelContextsWith(options sl.Options) (resp []datatypes.Network_Tunnel_Module_Context, err error)
+	GetNetworkVlanSpan() (resp datatypes.Account_Network_Vlan_Span, err error)
+	GetNetworkVlanSpanWith(options sl.Options) (resp datatypes.Account_Network_Vlan_Span, err error)
+	GetNetworkVlans() (resp []datatypes.Network_Vlan, err error)
+	GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error)
+	GetNextBillingPublicAllotmentHardwareBandwidthDetails() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetNextBillingPublicAllotmentHardwareBandwidthDetailsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetNextInvoiceExcel(documentCreateDate *datatypes.Time) (resp []byte, err error)
+	GetNextInvoiceIncubatorExemptTotal() (resp datatypes.Float64, err error)
+	GetNextInvoiceIncubatorExemptTotalWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetNextInvoicePdf(documentCreateDate *datatypes.Time) (resp []byte, err error)
+	GetNextInvoicePdfDetailed(documentCreateDate *datatypes.Time) (resp []byte, err error)
+	GetNextInvoiceRecurringAmountEligibleForAccountDiscount() (resp datatypes.Float64, err error)
+	GetNextInvoiceRecurringAmountEligibleForAccountDiscountWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetNextInvoiceTopLevelBillingItems() (resp []datatypes.Billing_Item, err error)
+	GetNextInvoiceTopLevelBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error)
+	GetNextInvoiceTotalAmount() (resp datatypes.Float64, err error)
+	GetNextInvoiceTotalAmountWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetNextInvoiceTotalOneTimeAmount() (resp datatypes.Float64, err error)
+	GetNextInvoiceTotalOneTimeAmountWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetNextInvoiceTotalOneTimeTaxAmount() (resp datatypes.Float64, err error)
+	GetNextInvoiceTotalOneTimeTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetNextInvoiceTotalRecurringAmount() (resp datatypes.Float64, err error)
+	GetNextInvoiceTotalRecurringAmountBeforeAccountDiscount() (resp datatypes.Float64, err error)
+	GetNextInvoiceTotalRecurringAmountBeforeAccountDiscountWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetNextInvoiceTotalRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetNextInvoiceTotalRecurringTaxAmount() (resp datatypes.Float64, err error)
+	GetNextInvoiceTotalRecurringTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetNextInvoiceTotalTaxableRecurringAmount() (resp datatypes.Float64, err error)
+	GetNextInvoiceTotalTaxableRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetNextInvoiceZeroFeeItemCounts() (resp []datatypes.Container_Product_Item_Category_ZeroFee_Count, err error)
+	GetNotificationSubscribers() (resp []datatypes.Notification_Subscriber, err error)
+	GetObject() (resp datatypes.Account, err error)
+	GetOpenAbuseTickets() (resp []datatypes.Ticket, err error)
+	GetOpenAbuseTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetOpenAccountingTickets() (resp []datatypes.Ticket, err error)
+	GetOpenAccountingTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetOpenBillingTickets() (resp []datatypes.Ticket, err error)
+	GetOpenBillingTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetOpenCancellationRequests() (resp []datatypes.Billing_Item_Cancellation_Request, err error)
+	GetOpenCancellationRequestsWith(options sl.Options) (resp []datatypes.Billing_Item_Cancellation_Request, err error)
+	GetOpenOtherTickets() (resp []datatypes.Ticket, err error)
+	GetOpenOtherTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetOpenRecurringInvoices() (resp []datatypes.Billing_Invoice, err error)
+	GetOpenRecurringInvoicesWith(options sl.Options) (resp []datatypes.Billing_Invoice, err error)
+	GetOpenSalesTickets() (resp []datatypes.Ticket, err error)
+	GetOpenSalesTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetOpenStackAccountLinks() (resp []datatypes.Account_Link, err error)
+	GetOpenStackObjectStorage() (resp []datatypes.Network_Storage, err error)
+	GetOpenStackObjectStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetOpenSupportTickets() (resp []datatypes.Ticket, err error)
+	GetOpenSupportTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetOpenTickets() (resp []datatypes.Ticket, err error)
+	GetOpenTicketsWaitingOnCustomer() (resp []datatypes.Ticket, err error)
+	GetOpenTicketsWaitingOnCustomerWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetOpenTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetOrders() (resp []datatypes.Billing_Order, err error)
+	GetOrdersWith(options sl.Options) (resp []datatypes.Billing_Order, err error)
+	GetOrphanBillingItems() (resp []datatypes.Billing_Item, err error)
+	GetOrphanBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error)
+	GetOwnedBrands() (resp []datatypes.Brand, err error)
+	GetOwnedHardwareGenericComponentModels() (resp []datatypes.Hardware_Component_Model_Generic, err error)
+	GetPaymentProcessors() (resp []datatypes.Billing_Payment_Processor, err error)
+	GetPendingCreditCardChangeRequestData() (resp []datatypes.Container_Account_Payment_Method_CreditCard, err error)
+	GetPendingEvents() (resp []datatypes.Notification_Occurrence_Event, err error)
+	GetPendingInvoice() (resp datatypes.Billing_Invoice, err error)
+	GetPendingInvoiceTopLevelItems() (resp []datatypes.Billing_Invoice_Item, err error)
+	GetPendingInvoiceTopLevelItemsWith(options sl.Options) (resp []datatypes.Billing_Invoice_Item, err error)
+	GetPendingInvoiceTotalAmount() (resp datatypes.Float64, err error)
+	GetPendingInvoiceTotalAmountWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetPendingInvoiceTotalOneTimeAmount() (resp datatypes.Float64, err error)
+	GetPendingInvoiceTotalOneTimeAmountWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetPendingInvoiceTotalOneTimeTaxAmount() (resp datatypes.Float64, err error)
+	GetPendingInvoiceTotalOneTimeTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetPendingInvoiceTotalRecurringAmount() (resp datatypes.Float64, err error)
+	GetPendingInvoiceTotalRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetPendingInvoiceTotalRecurringTaxAmount() (resp datatypes.Float64, err error)
+	GetPendingInvoiceTotalRecurringTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetPendingInvoiceWith(options sl.Options) (resp datatypes.Billing_Invoice, err error)
+	GetPermissionGroups() (resp []datatypes.User_Permission_Group, err error)
+	GetPermissionGroupsWith(options sl.Options) (resp []datatypes.User_Permission_Group, err error)
+	GetPermissionRoles() (resp []datatypes.User_Permission_Role, err error)
+	GetPermissionRolesWith(options sl.Options) (resp []datatypes.User_Permission_Role, err error)
+	GetPlacementGroups() (resp []datatypes.Virtual_PlacementGroup, err error)
+	GetPlacementGroupsWith(options sl.Options) (resp []datatypes.Virtual_PlacementGroup, err error)
+	GetPortableStorageVolumes() (resp []datatypes.Virtual_Disk_Image, err error)
+	GetPostProvisioningHooks() (resp []datatypes.Provisioning_Hook, err error)
+	GetPostProvisioningHooksWith(options sl.Options) (resp []datatypes.Provisioning_Hook, err error)
+	GetPptpVpnAllowedFlag() (resp bool, err error)
+	GetPptpVpnAllowedFlagWith(options sl.Options) (resp bool, err error)
+	GetPptpVpnUsers() (resp []datatypes.User_Customer, err error)
+	GetPptpVpnUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error)
+	GetPreviousRecurringRevenue() (resp datatypes.Float64, err error)
+	GetPreviousRecurringRevenueWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetPriceRestrictions() (resp []datatypes.Product_Item_Price_Account_Restriction, err error)
+	GetPriceRestrictionsWith(options sl.Options) (resp []datatypes.Product_Item_Price_Account_Restriction, err error)
+	GetPriorityOneTickets() (resp []datatypes.Ticket, err error)
+	GetPriorityOneTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetPrivateAllotmentHardwareBandwidthDetails() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetPrivateAllotmentHardwareBandwidthDetailsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetPrivateBlockDeviceTemplateGroups() (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error)
+	GetPrivateBlockDeviceTemplateGroupsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error)
+	GetPrivateIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error)
+	GetPrivateNetworkVlans() (resp []datatypes.Network_Vlan, err error)
+	GetPrivateNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error)
+	GetPrivateSubnets() (resp []datatypes.Network_Subnet, err error)
+	GetPrivateSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error)
+	GetProofOfConceptAccountFlag() (resp bool, err error)
+	GetProofOfConceptAccountFlagWith(options sl.Options) (resp bool, err error)
+	GetPublicAllotmentHardwareBandwidthDetails() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetPublicAllotmentHardwareBandwidthDetailsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetPublicIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error)
+	GetPublicNetworkVlans() (resp []datatypes.Network_Vlan, err error)
+	GetPublicNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error)
+	GetPublicSubnets() (resp []datatypes.Network_Subnet, err error)
+	GetPublicSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error)
+	GetQuotes() (resp []datatypes.Billing_Order_Quote, err error)
+	GetQuotesWith(options sl.Options) (resp []datatypes.Billing_Order_Quote, err error)
+	GetRecentEvents() (resp []datatypes.Notification_Occurrence_Event, err error)
+	GetReferralPartner() (resp datatypes.Account, err error)
+	GetReferralPartnerCommissionForecast() (resp []datatypes.Container_Referral_Partner_Commission, err error)
+	GetReferralPartnerCommissionHistory() (resp []datatypes.Container_Referral_Partner_Commission, err error)
+	GetReferralPartnerCommissionPending() (resp []datatypes.Container_Referral_Partner_Commission, err error)
+	GetReferralPartnerWith(options sl.Options) (resp datatypes.Account, err error)
+	GetReferredAccounts() (resp []datatypes.Account, err error)
+	GetReferredAccountsWith(options sl.Options) (resp []datatypes.Account, err error)
+	GetRegulatedWorkloads() (resp []datatypes.Legal_RegulatedWorkload, err error)
+	GetRemoteManagementCommandRequests() (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error)
+	GetRemoteManagementCommandRequestsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error)
+	GetReplicationEvents() (resp []datatypes.Network_Storage_Event, err error)
+	GetReplicationEventsWith(options sl.Options) (resp []datatypes.Network_Storage_Event, err error)
+	GetRequireSilentIBMidUserCreation() (resp bool, err error)
+	GetRequireSilentIBMidUserCreationWith(options sl.Options) (resp bool, err error)
+	GetReservedCapacityAgreements() (resp []datatypes.Account_Agreement, err error)
+	GetReservedCapacityAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error)
+	GetReservedCapacityGroups() (resp []datatypes.Virtual_ReservedCapacityGroup, err error)
+	GetReservedCapacityGroupsWith(options sl.Options) (resp []datatypes.Virtual_ReservedCapacityGroup, err error)
+	GetResourceGroups() (resp []datatypes.Resource_Group, err error)
+	GetResourceGroupsWith(options sl.Options) (resp []datatypes.Resource_Group, err error)
+	GetRouters() (resp []datatypes.Hardware, err error)
+	GetRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetRwhoisData() (resp datatypes.Network_Subnet_Rwhois_Data, err error)
+	GetRwhoisDataWith(options sl.Options) (resp datatypes.Network_Subnet_Rwhois_Data, err error)
+	GetSalesforceAccountLink() (resp datatypes.Account_Link, err error)
+	GetSamlAuthentication() (resp datatypes.Account_Authentication_Saml, err error)
+	GetSamlAuthenticationWith(options sl.Options) (resp datatypes.Account_Authentication_Saml, err error)
+	GetScaleGroups() (resp []datatypes.Scale_Group, err error)
+	GetScaleGroupsWith(options sl.Options) (resp []datatypes.Scale_Group, err error)
+	GetSecondaryDomains() (resp []datatypes.Dns_Secondary, err error)
+	GetSecondaryDomainsWith(options sl.Options) (resp []datatypes.Dns_Secondary, err error)
+	GetSecurityCertificates() (resp []datatypes.Security_Certificate, err error)
+	GetSecurityCertificatesWith(options sl.Options) (resp []datatypes.Security_Certificate, err error)
+	GetSecurityGroups() (resp []datatypes.Network_SecurityGroup, err error)
+	GetSecurityGroupsWith(options sl.Options) (resp []datatypes.Network_SecurityGroup, err error)
+	GetSecurityLevel() (resp datatypes.Security_Level, err error)
+	GetSecurityScanRequests() (resp []datatypes.Network_Security_Scanner_Request, err error)
+	GetSecurityScanRequestsWith(options sl.Options) (resp []datatypes.Network_Security_Scanner_Request, err error)
+	GetServiceBillingItems() (resp []datatypes.Billing_Item, err error)
+	GetServiceBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error)
+	GetSharedBlockDeviceTemplateGroups() (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error)
+	GetShipments() (resp []datatypes.Account_Shipment, err error)
+	GetShipmentsWith(options sl.Options) (resp []datatypes.Account_Shipment, err error)
+	GetSshKeys() (resp []datatypes.Security_Ssh_Key, err error)
+	GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error)
+	GetSslVpnUsers() (resp []datatypes.User_Customer, err error)
+	GetSslVpnUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error)
+	GetStandardPoolVirtualGuests() (resp []datatypes.Virtual_Guest, err error)
+	GetStandardPoolVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetSubnetRegistrationDetails() (resp []datatypes.Account_Regional_Registry_Detail, err error)
+	GetSubnetRegistrations() (resp []datatypes.Network_Subnet_Registration, err error)
+	GetSubnets() (resp []datatypes.Network_Subnet, err error)
+	GetSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error)
+	GetSupportRepresentatives() (resp []datatypes.User_Employee, err error)
+	GetSupportRepresentativesWith(options sl.Options) (resp []datatypes.User_Employee, err error)
+	GetSupportSubscriptions() (resp []datatypes.Billing_Item, err error)
+	GetSupportSubscriptionsWith(options sl.Options) (resp []datatypes.Billing_Item, err error)
+	GetSupportTier() (resp string, err error)
+	GetSuppressInvoicesFlag() (resp bool, err error)
+	GetSuppressInvoicesFlagWith(options sl.Options) (resp bool, err error)
+	GetTags() (resp []datatypes.Tag, err error)
+	GetTechIncubatorProgramInfo(forNextBillCycle *bool) (resp datatypes.Container_Account_Discount_Program, err error)
+	GetThirdPartyPoliciesAcceptanceStatus() (resp []datatypes.Container_Policy_Acceptance, err error)
+	GetTickets() (resp []datatypes.Ticket, err error)
+	GetTicketsClosedInTheLastThreeDays() (resp []datatypes.Ticket, err error)
+	GetTicketsClosedInTheLastThreeDaysWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetTicketsClosedToday() (resp []datatypes.Ticket, err error)
+	GetTicketsClosedTodayWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error)
+	GetTranscodeAccounts() (resp []datatypes.Network_Media_Transcode_Account, err error)
+	GetTranscodeAccountsWith(options sl.Options) (resp []datatypes.Network_Media_Transcode_Account, err error)
+	GetUpgradeRequests() (resp []datatypes.Product_Upgrade_Request, err error)
+	GetUpgradeRequestsWith(options sl.Options) (resp []datatypes.Product_Upgrade_Request, err error)
+	GetUsers() (resp []datatypes.User_Customer, err error)
+	GetUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error)
+	GetValidSecurityCertificateEntries() (resp []datatypes.Security_Certificate_Entry, err error)
+	GetValidSecurityCertificates() (resp []datatypes.Security_Certificate, err error)
+	GetValidSecurityCertificatesWith(options sl.Options) (resp []datatypes.Security_Certificate, err error)
+	GetVdrUpdatesInProgressFlag() (resp bool, err error)
+	GetVdrUpdatesInProgressFlagWith(options sl.Options) (resp bool, err error)
+	GetVirtualDedicatedRacks() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetVirtualDedicatedRacksWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetVirtualDiskImages() (resp []datatypes.Virtual_Disk_Image, err error)
+	GetVirtualDiskImagesWith(options sl.Options) (resp []datatypes.Virtual_Disk_Image, err error)
+	GetVirtualGuests() (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsOverBandwidthAllocation() (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsOverBandwidthAllocationWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsProjectedOverBandwidthAllocation() (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsProjectedOverBandwidthAllocationWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithCpanel() (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithCpanelWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithMcafee() (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithMcafeeAntivirusRedhat() (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithMcafeeAntivirusRedhatWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithMcafeeAntivirusWindows() (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithMcafeeAntivirusWindowsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithMcafeeIntrusionDetectionSystem() (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithMcafeeIntrusionDetectionSystemWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithMcafeeWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithPlesk() (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithPleskWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithQuantastor() (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithQuantastorWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithUrchin() (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWithUrchinWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualPrivateRack() (resp datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetVirtualPrivateRackWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetVirtualStorageArchiveRepositories() (resp []datatypes.Virtual_Storage_Repository, err error)
+	GetVirtualStorageArchiveRepositoriesWith(options sl.Options) (resp []datatypes.Virtual_Storage_Repository, err error)
+	GetVirtualStoragePublicRepositories() (resp []datatypes.Virtual_Storage_Repository, err error)
+	GetVirtualStoragePublicRepositoriesWith(options sl.Options) (resp []datatypes.Virtual_Storage_Repository, err error)
+	GetVmWareActiveAccountLicenseKeys() (resp []string, err error)
+	GetVpcVirtualGuests() (resp []datatypes.Virtual_Guest, err error)
+	GetVpcVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetWindowsUpdateStatus() (resp []datatypes.Container_Utility_Microsoft_Windows_UpdateServices_Status, err error)
+	HasAttribute(attributeType *string) (resp bool, err error)
+	HourlyInstanceLimit() (resp int, err error)
+	HourlyServerLimit() (resp int, err error)
+	IsEligibleForLocalCurrencyProgram() (resp bool, err error)
+	IsEligibleToLinkWithPaas() (resp bool, err error)
+	LinkExternalAccount(externalAccountId *string, authorizationToken *string, externalServiceProviderKey *string) (err error)
+	RemoveAlternateCreditCard() (resp bool, err error)
+	RequestCreditCardChange(request *datatypes.Billing_Payment_Card_ChangeRequest, vatId *string, paymentRoleName *string, onlyChangeNicknameFlag *bool) (resp datatypes.Billing_Payment_Card_ChangeRequest, err error)
+	RequestManualPayment(request *datatypes.Billing_Payment_Card_ManualPayment) (resp datatypes.Billing_Payment_Card_ManualPayment, err error)
+	RequestManualPaymentUsingCreditCardOnFile(amount *string, payWithAlternateCardFlag *bool, note *string) (resp datatypes.Billing_Payment_Card_ManualPayment, err error)
+	SetAbuseEmails(emails []string) (resp bool, err error)
+	SetManagedPoolQuantity(poolKeyName *string, backendRouter *string, quantity *int) (resp []byte, err error)
+	SetVlanSpan(enabled *bool) (resp bool, err error)
+	SwapCreditCards() (resp bool, err error)
+	SyncCurrentUserPopulationWithPaas() (err error)
+	UpdateVpnUsersForResource(objectId *int, objectType *string) (resp bool, err error)
+	Validate(account *datatypes.Account) (resp []string, err error)
+	ValidateManualPaymentAmount(amount *string) (resp bool, err error)
+}
+
+var _ AccountService = services.Account{}
+var _ AccountService = Account{}
+
+// Account is a recording fake of services.Account. Its copies, returned
+// by the fluent methods, share its Recorder.
+type Account struct {
+	*Recorder
+	Options sl.Options
+}
+
+// NewAccount returns a new fake of services.Account
+func NewAccount() Account {
+	return Account{Recorder: NewRecorder()}
+}
+
+func (r Account) Id(id int) Account {
+	r.Options.Id = &id
+	return r
+}
+
+func (r Account) InitParameter(name string, value interface{}) Account {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
+func (r Account) Mask(mask string) Account {
+	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
+		mask = fmt.Sprintf("mask[%s]", mask)
+	}
+
+	r.Options.Mask = mask
+	return r
+}
+
+func (r Account) Filter(filter string) Account {
+	r.Options.Filter = filter
+	return r
+}
+
+func (r Account) Limit(limit int) Account {
+	r.Options.Limit = &limit
+	return r
+}
+
+func (r Account) Unlimited() Account {
+	r.Options.Unlimited = true
+	return r
+}
+
+func (r Account) Offset(offset int) Account {
+	r.Options.Offset = &offset
+	return r
+}
+
+func (r Account) Timeout(timeout time.Duration) Account {
+	r.Options.Timeout = timeout
+	return r
+}
+
+// WithContext is accepted for compatibility with the service; fakes do not
+// use the context
+func (r Account) WithContext(ctx context.Context) Account {
+	return r
+}
+
+func (r Account) ActivatePartner(accountId *string, hashCode *string) (resp datatypes.Account, err error) {
+	err = r.record("ActivatePartner", []interface{}{accountId, hashCode}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) AddAchInformation(achInformation *datatypes.Container_Billing_Info_Ach) (resp bool, err error) {
+	err = r.record("AddAchInformation", []interface{}{achInformation}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) AddReferralPartnerPaymentOption(paymentOption *datatypes.Container_Referral_Partner_Payment_Option) (resp bool, err error) {
+	err = r.record("AddReferralPartnerPaymentOption", []interface{}{paymentOption}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) AreVdrUpdatesBlockedForBilling() (resp bool, err error) {
+	err = r.record("AreVdrUpdatesBlockedForBilling", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) CancelPayPalTransaction(token *string, payerId *string) (resp bool, err error) {
+	err = r.record("CancelPayPalTransaction", []interface{}{token, payerId}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) CompletePayPalTransaction(token *string, payerId *string) (resp string, err error) {
+	err = r.record("CompletePayPalTransaction", []interface{}{token, payerId}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) CountHourlyInstances() (resp int, err error) {
+	err = r.record("CountHourlyInstances", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) CreateUser(templateObject *datatypes.User_Customer, password *string, vpnPassword *string, silentlyCreateFlag *bool) (resp datatypes.User_Customer, err error) {
+	err = r.record("CreateUser", []interface{}{templateObject, password, vpnPassword, silentlyCreateFlag}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) DisableEuSupport() (err error) {
+	err = r.record("DisableEuSupport", nil, r.Options, nil)
+	return
+
+}
+
+func (r Account) EnableEuSupport() (err error) {
+	err = r.record("EnableEuSupport", nil, r.Options, nil)
+	return
+
+}
+
+func (r Account) GetAbuseEmail() (resp string, err error) {
+	err = r.record("GetAbuseEmail", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAbuseEmailWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAbuseEmail()
+
+}
+
+func (r Account) GetAbuseEmails() (resp []datatypes.Account_AbuseEmail, err error) {
+	err = r.record("GetAbuseEmails", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAbuseEmailsWith(options sl.Options) (resp []datatypes.Account_AbuseEmail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAbuseEmails()
+
+}
+
+func (r Account) GetAccountBackupHistory(startDate *datatypes.Time, endDate *datatypes.Time, backupStatus *string) (resp []datatypes.Container_Network_Storage_Evault_WebCc_JobDetails, err error) {
+	err = r.record("GetAccountBackupHistory", []interface{}{startDate, endDate, backupStatus}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAccountContacts() (resp []datatypes.Account_Contact, err error) {
+	err = r.record("GetAccountContacts", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAccountContactsWith(options sl.Options) (resp []datatypes.Account_Contact, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountContacts()
+
+}
+
+func (r Account) GetAccountLicenses() (resp []datatypes.Software_AccountLicense, err error) {
+	err = r.record("GetAccountLicenses", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAccountLicensesWith(options sl.Options) (resp []datatypes.Software_AccountLicense, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountLicenses()
+
+}
+
+func (r Account) GetAccountLinks() (resp []datatypes.Account_Link, err error) {
+	err = r.record("GetAccountLinks", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAccountStatus() (resp datatypes.Account_Status, err error) {
+	err = r.record("GetAccountStatus", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAccountStatusWith(options sl.Options) (resp datatypes.Account_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountStatus()
+
+}
+
+func (r Account) GetAccountTraitValue(keyName *string) (resp string, err error) {
+	err = r.record("GetAccountTraitValue", []interface{}{keyName}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveAccountDiscountBillingItem() (resp datatypes.Billing_Item, err error) {
+	err = r.record("GetActiveAccountDiscountBillingItem", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveAccountDiscountBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveAccountDiscountBillingItem()
+
+}
+
+func (r Account) GetActiveAccountLicenses() (resp []datatypes.Software_AccountLicense, err error) {
+	err = r.record("GetActiveAccountLicenses", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveAccountLicensesWith(options sl.Options) (resp []datatypes.Software_AccountLicense, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveAccountLicenses()
+
+}
+
+func (r Account) GetActiveAddresses() (resp []datatypes.Account_Address, err error) {
+	err = r.record("GetActiveAddresses", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveAddressesWith(options sl.Options) (resp []datatypes.Account_Address, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveAddresses()
+
+}
+
+func (r Account) GetActiveAgreements() (resp []datatypes.Account_Agreement, err error) {
+	err = r.record("GetActiveAgreements", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveAgreements()
+
+}
+
+func (r Account) GetActiveAlarms() (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
+	err = r.record("GetActiveAlarms", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveBillingAgreements() (resp []datatypes.Account_Agreement, err error) {
+	err = r.record("GetActiveBillingAgreements", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveBillingAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveBillingAgreements()
+
+}
+
+func (r Account) GetActiveCatalystEnrollment() (resp datatypes.Catalyst_Enrollment, err error) {
+	err = r.record("GetActiveCatalystEnrollment", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveColocationContainers() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetActiveColocationContainers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveColocationContainersWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveColocationContainers()
+
+}
+
+func (r Account) GetActiveFlexibleCreditEnrollment() (resp datatypes.FlexibleCredit_Enrollment, err error) {
+	err = r.record("GetActiveFlexibleCreditEnrollment", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveFlexibleCreditEnrollmentWith(options sl.Options) (resp datatypes.FlexibleCredit_Enrollment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveFlexibleCreditEnrollment()
+
+}
+
+func (r Account) GetActiveNotificationSubscribers() (resp []datatypes.Notification_Subscriber, err error) {
+	err = r.record("GetActiveNotificationSubscribers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveOutletPackages() (resp []datatypes.Product_Package, err error) {
+	err = r.record("GetActiveOutletPackages", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActivePackages() (resp []datatypes.Product_Package, err error) {
+	err = r.record("GetActivePackages", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActivePackagesByAttribute(attributeKeyName *string) (resp []datatypes.Product_Package, err error) {
+	err = r.record("GetActivePackagesByAttribute", []interface{}{attributeKeyName}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActivePrivateHostedCloudPackages() (resp []datatypes.Product_Package, err error) {
+	err = r.record("GetActivePrivateHostedCloudPackages", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveQuotes() (resp []datatypes.Billing_Order_Quote, err error) {
+	err = r.record("GetActiveQuotes", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveQuotesWith(options sl.Options) (resp []datatypes.Billing_Order_Quote, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveQuotes()
+
+}
+
+func (r Account) GetActiveReservedCapacityAgreements() (resp []datatypes.Account_Agreement, err error) {
+	err = r.record("GetActiveReservedCapacityAgreements", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveReservedCapacityAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveReservedCapacityAgreements()
+
+}
+
+func (r Account) GetActiveVirtualLicenses() (resp []datatypes.Software_VirtualLicense, err error) {
+	err = r.record("GetActiveVirtualLicenses", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetActiveVirtualLicensesWith(options sl.Options) (resp []datatypes.Software_VirtualLicense, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveVirtualLicenses()
+
+}
+
+func (r Account) GetAdcLoadBalancers() (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, err error) {
+	err = r.record("GetAdcLoadBalancers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAdcLoadBalancersWith(options sl.Options) (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAdcLoadBalancers()
+
+}
+
+func (r Account) GetAddresses() (resp []datatypes.Account_Address, err error) {
+	err = r.record("GetAddresses", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAddressesWith(options sl.Options) (resp []datatypes.Account_Address, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAddresses()
+
+}
+
+func (r Account) GetAffiliateId() (resp string, err error) {
+	err = r.record("GetAffiliateId", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAffiliateIdWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAffiliateId()
+
+}
+
+func (r Account) GetAggregatedUptimeGraph(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Container_Graph, err error) {
+	err = r.record("GetAggregatedUptimeGraph", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAllBillingItems() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetAllBillingItems", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAllBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllBillingItems()
+
+}
+
+func (r Account) GetAllCommissionBillingItems() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetAllCommissionBillingItems", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAllCommissionBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllCommissionBillingItems()
+
+}
+
+func (r Account) GetAllRecurringTopLevelBillingItems() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetAllRecurringTopLevelBillingItems", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAllRecurringTopLevelBillingItemsUnfiltered() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetAllRecurringTopLevelBillingItemsUnfiltered", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAllRecurringTopLevelBillingItemsUnfilteredWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllRecurringTopLevelBillingItemsUnfiltered()
+
+}
+
+func (r Account) GetAllRecurringTopLevelBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllRecurringTopLevelBillingItems()
+
+}
+
+func (r Account) GetAllSubnetBillingItems() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetAllSubnetBillingItems", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAllSubnetBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllSubnetBillingItems()
+
+}
+
+func (r Account) GetAllTopLevelBillingItems() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetAllTopLevelBillingItems", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAllTopLevelBillingItemsUnfiltered() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetAllTopLevelBillingItemsUnfiltered", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAllTopLevelBillingItemsUnfilteredWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllTopLevelBillingItemsUnfiltered()
+
+}
+
+func (r Account) GetAllTopLevelBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllTopLevelBillingItems()
+
+}
+
+func (r Account) GetAllowIbmIdSilentMigrationFlag() (resp bool, err error) {
+	err = r.record("GetAllowIbmIdSilentMigrationFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAllowIbmIdSilentMigrationFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowIbmIdSilentMigrationFlag()
+
+}
+
+func (r Account) GetAllowsBluemixAccountLinkingFlag() (resp bool, err error) {
+	err = r.record("GetAllowsBluemixAccountLinkingFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAllowsBluemixAccountLinkingFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowsBluemixAccountLinkingFlag()
+
+}
+
+func (r Account) GetAlternateCreditCardData() (resp datatypes.Container_Account_Payment_Method_CreditCard, err error) {
+	err = r.record("GetAlternateCreditCardData", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetApplicationDeliveryControllers() (resp []datatypes.Network_Application_Delivery_Controller, err error) {
+	err = r.record("GetApplicationDeliveryControllers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetApplicationDeliveryControllersWith(options sl.Options) (resp []datatypes.Network_Application_Delivery_Controller, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetApplicationDeliveryControllers()
+
+}
+
+func (r Account) GetAttributeByType(attributeType *string) (resp datatypes.Account_Attribute, err error) {
+	err = r.record("GetAttributeByType", []interface{}{attributeType}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAttributes() (resp []datatypes.Account_Attribute, err error) {
+	err = r.record("GetAttributes", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAttributesWith(options sl.Options) (resp []datatypes.Account_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+
+}
+
+func (r Account) GetAuxiliaryNotifications() (resp []datatypes.Container_Utility_Message, err error) {
+	err = r.record("GetAuxiliaryNotifications", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAvailablePublicNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
+	err = r.record("GetAvailablePublicNetworkVlans", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAvailablePublicNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAvailablePublicNetworkVlans()
+
+}
+
+func (r Account) GetAverageArchiveUsageMetricDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp datatypes.Float64, err error) {
+	err = r.record("GetAverageArchiveUsageMetricDataByDate", []interface{}{startDateTime, endDateTime}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetAveragePublicUsageMetricDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp datatypes.Float64, err error) {
+	err = r.record("GetAveragePublicUsageMetricDataByDate", []interface{}{startDateTime, endDateTime}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBalance() (resp datatypes.Float64, err error) {
+	err = r.record("GetBalance", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBalanceWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBalance()
+
+}
+
+func (r Account) GetBandwidthAllotments() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	err = r.record("GetBandwidthAllotments", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBandwidthAllotmentsOverAllocation() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	err = r.record("GetBandwidthAllotmentsOverAllocation", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBandwidthAllotmentsOverAllocationWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotmentsOverAllocation()
+
+}
+
+func (r Account) GetBandwidthAllotmentsProjectedOverAllocation() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	err = r.record("GetBandwidthAllotmentsProjectedOverAllocation", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBandwidthAllotmentsProjectedOverAllocationWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotmentsProjectedOverAllocation()
+
+}
+
+func (r Account) GetBandwidthAllotmentsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotments()
+
+}
+
+func (r Account) GetBareMetalInstances() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetBareMetalInstances", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBareMetalInstancesWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBareMetalInstances()
+
+}
+
+func (r Account) GetBillingAgreements() (resp []datatypes.Account_Agreement, err error) {
+	err = r.record("GetBillingAgreements", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBillingAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingAgreements()
+
+}
+
+func (r Account) GetBillingInfo() (resp datatypes.Billing_Info, err error) {
+	err = r.record("GetBillingInfo", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBillingInfoWith(options sl.Options) (resp datatypes.Billing_Info, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingInfo()
+
+}
+
+func (r Account) GetBlockDeviceTemplateGroups() (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	err = r.record("GetBlockDeviceTemplateGroups", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBlockDeviceTemplateGroupsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockDeviceTemplateGroups()
+
+}
+
+func (r Account) GetBluemixAccountLink() (resp datatypes.Account_Link_Bluemix, err error) {
+	err = r.record("GetBluemixAccountLink", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBluemixAccountLinkWith(options sl.Options) (resp datatypes.Account_Link_Bluemix, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBluemixAccountLink()
+
+}
+
+func (r Account) GetBluemixLinkedFlag() (resp bool, err error) {
+	err = r.record("GetBluemixLinkedFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBluemixLinkedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBluemixLinkedFlag()
+
+}
+
+func (r Account) GetBrand() (resp datatypes.Brand, err error) {
+	err = r.record("GetBrand", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBrandAccountFlag() (resp bool, err error) {
+	err = r.record("GetBrandAccountFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBrandKeyName() (resp string, err error) {
+	err = r.record("GetBrandKeyName", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBrandKeyNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBrandKeyName()
+
+}
+
+func (r Account) GetBusinessPartner() (resp datatypes.Account_Business_Partner, err error) {
+	err = r.record("GetBusinessPartner", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetBusinessPartnerWith(options sl.Options) (resp datatypes.Account_Business_Partner, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBusinessPartner()
+
+}
+
+func (r Account) GetCanOrderAdditionalVlansFlag() (resp bool, err error) {
+	err = r.record("GetCanOrderAdditionalVlansFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetCanOrderAdditionalVlansFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCanOrderAdditionalVlansFlag()
+
+}
+
+func (r Account) GetCarts() (resp []datatypes.Billing_Order_Quote, err error) {
+	err = r.record("GetCarts", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetCartsWith(options sl.Options) (resp []datatypes.Billing_Order_Quote, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCarts()
+
+}
+
+func (r Account) GetCatalystEnrollments() (resp []datatypes.Catalyst_Enrollment, err error) {
+	err = r.record("GetCatalystEnrollments", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetCdnAccounts() (resp []datatypes.Network_ContentDelivery_Account, err error) {
+	err = r.record("GetCdnAccounts", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetCdnAccountsWith(options sl.Options) (resp []datatypes.Network_ContentDelivery_Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCdnAccounts()
+
+}
+
+func (r Account) GetClosedTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetClosedTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetClosedTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetClosedTickets()
+
+}
+
+func (r Account) GetCurrentBackupStatisticsGraph(detailedGraph *bool) (resp datatypes.Container_Account_Graph_Outputs, err error) {
+	err = r.record("GetCurrentBackupStatisticsGraph", []interface{}{detailedGraph}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetCurrentTicketStatisticsGraph(detailedGraph *bool) (resp datatypes.Container_Account_Graph_Outputs, err error) {
+	err = r.record("GetCurrentTicketStatisticsGraph", []interface{}{detailedGraph}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetCurrentUser() (resp datatypes.User_Customer, err error) {
+	err = r.record("GetCurrentUser", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetDatacentersWithSubnetAllocations() (resp []datatypes.Location, err error) {
+	err = r.record("GetDatacentersWithSubnetAllocations", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetDatacentersWithSubnetAllocationsWith(options sl.Options) (resp []datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacentersWithSubnetAllocations()
+
+}
+
+func (r Account) GetDedicatedHosts() (resp []datatypes.Virtual_DedicatedHost, err error) {
+	err = r.record("GetDedicatedHosts", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetDedicatedHostsForImageTemplate(imageTemplateId *int) (resp []datatypes.Virtual_DedicatedHost, err error) {
+	err = r.record("GetDedicatedHostsForImageTemplate", []interface{}{imageTemplateId}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetDedicatedHostsWith(options sl.Options) (resp []datatypes.Virtual_DedicatedHost, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDedicatedHosts()
+
+}
+
+func (r Account) GetDisablePaymentProcessingFlag() (resp bool, err error) {
+	err = r.record("GetDisablePaymentProcessingFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetDisablePaymentProcessingFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDisablePaymentProcessingFlag()
+
+}
+
+func (r Account) GetDiskUsageMetricDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
+	err = r.record("GetDiskUsageMetricDataByDate", []interface{}{startDateTime, endDateTime}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetDiskUsageMetricDataFromLegacyByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
+	err = r.record("GetDiskUsageMetricDataFromLegacyByDate", []interface{}{startDateTime, endDateTime}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetDiskUsageMetricDataFromMetricTrackingObjectSystemByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
+	err = r.record("GetDiskUsageMetricDataFromMetricTrackingObjectSystemByDate", []interface{}{startDateTime, endDateTime}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetDiskUsageMetricImageByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp datatypes.Container_Account_Graph_Outputs, err error) {
+	err = r.record("GetDiskUsageMetricImageByDate", []interface{}{startDateTime, endDateTime}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetDisplaySupportRepresentativeAssignments() (resp []datatypes.Account_Attachment_Employee, err error) {
+	err = r.record("GetDisplaySupportRepresentativeAssignments", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetDisplaySupportRepresentativeAssignmentsWith(options sl.Options) (resp []datatypes.Account_Attachment_Employee, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDisplaySupportRepresentativeAssignments()
+
+}
+
+func (r Account) GetDomainRegistrations() (resp []datatypes.Dns_Domain_Registration, err error) {
+	err = r.record("GetDomainRegistrations", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetDomains() (resp []datatypes.Dns_Domain, err error) {
+	err = r.record("GetDomains", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetDomainsWith(options sl.Options) (resp []datatypes.Dns_Domain, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDomains()
+
+}
+
+func (r Account) GetDomainsWithoutSecondaryDnsRecords() (resp []datatypes.Dns_Domain, err error) {
+	err = r.record("GetDomainsWithoutSecondaryDnsRecords", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetDomainsWithoutSecondaryDnsRecordsWith(options sl.Options) (resp []datatypes.Dns_Domain, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDomainsWithoutSecondaryDnsRecords()
+
+}
+
+func (r Account) GetEuSupportedFlag() (resp bool, err error) {
+	err = r.record("GetEuSupportedFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetEuSupportedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEuSupportedFlag()
+
+}
+
+func (r Account) GetEvaultCapacityGB() (resp uint, err error) {
+	err = r.record("GetEvaultCapacityGB", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetEvaultCapacityGBWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvaultCapacityGB()
+
+}
+
+func (r Account) GetEvaultMasterUsers() (resp []datatypes.Account_Password, err error) {
+	err = r.record("GetEvaultMasterUsers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetEvaultMasterUsersWith(options sl.Options) (resp []datatypes.Account_Password, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvaultMasterUsers()
+
+}
+
+func (r Account) GetEvaultNetworkStorage() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetEvaultNetworkStorage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetEvaultNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvaultNetworkStorage()
+
+}
+
+func (r Account) GetExecutiveSummaryPdf(pdfType *string, historicalType *string, startDate *string, endDate *string) (resp []byte, err error) {
+	err = r.record("GetExecutiveSummaryPdf", []interface{}{pdfType, historicalType, startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetExpiredSecurityCertificates() (resp []datatypes.Security_Certificate, err error) {
+	err = r.record("GetExpiredSecurityCertificates", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetExpiredSecurityCertificatesWith(options sl.Options) (resp []datatypes.Security_Certificate, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetExpiredSecurityCertificates()
+
+}
+
+func (r Account) GetFacilityLogs() (resp []datatypes.User_Access_Facility_Log, err error) {
+	err = r.record("GetFacilityLogs", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetFacilityLogsWith(options sl.Options) (resp []datatypes.User_Access_Facility_Log, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFacilityLogs()
+
+}
+
+func (r Account) GetFlexibleCreditEnrollments() (resp []datatypes.FlexibleCredit_Enrollment, err error) {
+	err = r.record("GetFlexibleCreditEnrollments", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetFlexibleCreditEnrollmentsWith(options sl.Options) (resp []datatypes.FlexibleCredit_Enrollment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFlexibleCreditEnrollments()
+
+}
+
+func (r Account) GetFlexibleCreditProgramInfo(forNextBillCycle *bool) (resp datatypes.Container_Account_Discount_Program, err error) {
+	err = r.record("GetFlexibleCreditProgramInfo", []interface{}{forNextBillCycle}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetForcePaasAccountLinkDate() (resp string, err error) {
+	err = r.record("GetForcePaasAccountLinkDate", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetForcePaasAccountLinkDateWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetForcePaasAccountLinkDate()
+
+}
+
+func (r Account) GetGlobalIpRecords() (resp []datatypes.Network_Subnet_IpAddress_Global, err error) {
+	err = r.record("GetGlobalIpRecords", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetGlobalIpv4Records() (resp []datatypes.Network_Subnet_IpAddress_Global, err error) {
+	err = r.record("GetGlobalIpv4Records", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetGlobalIpv6Records() (resp []datatypes.Network_Subnet_IpAddress_Global, err error) {
+	err = r.record("GetGlobalIpv6Records", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetGlobalLoadBalancerAccounts() (resp []datatypes.Network_LoadBalancer_Global_Account, err error) {
+	err = r.record("GetGlobalLoadBalancerAccounts", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetGlobalLoadBalancerAccountsWith(options sl.Options) (resp []datatypes.Network_LoadBalancer_Global_Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGlobalLoadBalancerAccounts()
+
+}
+
+func (r Account) GetHardware() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetHardware", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHardwareOverBandwidthAllocation() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetHardwareOverBandwidthAllocation", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHardwareOverBandwidthAllocationWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareOverBandwidthAllocation()
+
+}
+
+func (r Account) GetHardwarePools() (resp []datatypes.Container_Hardware_Pool_Details, err error) {
+	err = r.record("GetHardwarePools", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHardwareProjectedOverBandwidthAllocation() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetHardwareProjectedOverBandwidthAllocation", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHardwareProjectedOverBandwidthAllocationWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareProjectedOverBandwidthAllocation()
+
+}
+
+func (r Account) GetHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+
+}
+
+func (r Account) GetHardwareWithCpanel() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetHardwareWithCpanel", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHardwareWithCpanelWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithCpanel()
+
+}
+
+func (r Account) GetHardwareWithHelm() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetHardwareWithHelm", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHardwareWithHelmWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithHelm()
+
+}
+
+func (r Account) GetHardwareWithMcafee() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetHardwareWithMcafee", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHardwareWithMcafeeAntivirusRedhat() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetHardwareWithMcafeeAntivirusRedhat", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHardwareWithMcafeeAntivirusRedhatWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithMcafeeAntivirusRedhat()
+
+}
+
+func (r Account) GetHardwareWithMcafeeAntivirusWindows() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetHardwareWithMcafeeAntivirusWindows", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHardwareWithMcafeeAntivirusWindowsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithMcafeeAntivirusWindows()
+
+}
+
+func (r Account) GetHardwareWithMcafeeIntrusionDetectionSystem() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetHardwareWithMcafeeIntrusionDetectionSystem", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHardwareWithMcafeeIntrusionDetectionSystemWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithMcafeeIntrusionDetectionSystem()
+
+}
+
+func (r Account) GetHardwareWithMcafeeWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithMcafee()
+
+}
+
+func (r Account) GetHardwareWithPlesk() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetHardwareWithPlesk", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHardwareWithPleskWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithPlesk()
+
+}
+
+func (r Account) GetHardwareWithQuantastor() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetHardwareWithQuantastor", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHardwareWithQuantastorWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithQuantastor()
+
+}
+
+func (r Account) GetHardwareWithUrchin() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetHardwareWithUrchin", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHardwareWithUrchinWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithUrchin()
+
+}
+
+func (r Account) GetHardwareWithWindows() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetHardwareWithWindows", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHardwareWithWindowsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareWithWindows()
+
+}
+
+func (r Account) GetHasEvaultBareMetalRestorePluginFlag() (resp bool, err error) {
+	err = r.record("GetHasEvaultBareMetalRestorePluginFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHasEvaultBareMetalRestorePluginFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasEvaultBareMetalRestorePluginFlag()
+
+}
+
+func (r Account) GetHasIderaBareMetalRestorePluginFlag() (resp bool, err error) {
+	err = r.record("GetHasIderaBareMetalRestorePluginFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHasIderaBareMetalRestorePluginFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasIderaBareMetalRestorePluginFlag()
+
+}
+
+func (r Account) GetHasPendingOrder() (resp uint, err error) {
+	err = r.record("GetHasPendingOrder", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHasPendingOrderWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasPendingOrder()
+
+}
+
+func (r Account) GetHasR1softBareMetalRestorePluginFlag() (resp bool, err error) {
+	err = r.record("GetHasR1softBareMetalRestorePluginFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHasR1softBareMetalRestorePluginFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasR1softBareMetalRestorePluginFlag()
+
+}
+
+func (r Account) GetHistoricalBackupGraph(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Container_Account_Graph_Outputs, err error) {
+	err = r.record("GetHistoricalBackupGraph", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHistoricalBandwidthGraph(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Container_Account_Graph_Outputs, err error) {
+	err = r.record("GetHistoricalBandwidthGraph", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHistoricalTicketGraph(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Container_Account_Graph_Outputs, err error) {
+	err = r.record("GetHistoricalTicketGraph", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHistoricalUptimeGraph(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Container_Account_Graph_Outputs, err error) {
+	err = r.record("GetHistoricalUptimeGraph", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHourlyBareMetalInstances() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetHourlyBareMetalInstances", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHourlyBareMetalInstancesWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyBareMetalInstances()
+
+}
+
+func (r Account) GetHourlyServiceBillingItems() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetHourlyServiceBillingItems", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHourlyServiceBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyServiceBillingItems()
+
+}
+
+func (r Account) GetHourlyVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetHourlyVirtualGuests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHourlyVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyVirtualGuests()
+
+}
+
+func (r Account) GetHubNetworkStorage() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetHubNetworkStorage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetHubNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHubNetworkStorage()
+
+}
+
+func (r Account) GetIbmCustomerNumber() (resp string, err error) {
+	err = r.record("GetIbmCustomerNumber", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetIbmCustomerNumberWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIbmCustomerNumber()
+
+}
+
+func (r Account) GetIbmIdAuthenticationRequiredFlag() (resp bool, err error) {
+	err = r.record("GetIbmIdAuthenticationRequiredFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetIbmIdAuthenticationRequiredFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIbmIdAuthenticationRequiredFlag()
+
+}
+
+func (r Account) GetIbmIdMigrationExpirationTimestamp() (resp string, err error) {
+	err = r.record("GetIbmIdMigrationExpirationTimestamp", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetIbmIdMigrationExpirationTimestampWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIbmIdMigrationExpirationTimestamp()
+
+}
+
+func (r Account) GetInProgressExternalAccountSetup() (resp datatypes.Account_External_Setup, err error) {
+	err = r.record("GetInProgressExternalAccountSetup", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetInProgressExternalAccountSetupWith(options sl.Options) (resp datatypes.Account_External_Setup, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInProgressExternalAccountSetup()
+
+}
+
+func (r Account) GetInternalNotes() (resp []datatypes.Account_Note, err error) {
+	err = r.record("GetInternalNotes", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetInvoices() (resp []datatypes.Billing_Invoice, err error) {
+	err = r.record("GetInvoices", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetInvoicesWith(options sl.Options) (resp []datatypes.Billing_Invoice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoices()
+
+}
+
+func (r Account) GetIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
+	err = r.record("GetIpAddresses", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetIscsiNetworkStorage() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetIscsiNetworkStorage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetIscsiNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIscsiNetworkStorage()
+
+}
+
+func (r Account) GetLargestAllowedSubnetCidr(numberOfHosts *int, locationId *int) (resp int, err error) {
+	err = r.record("GetLargestAllowedSubnetCidr", []interface{}{numberOfHosts, locationId}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLastCanceledBillingItem() (resp datatypes.Billing_Item, err error) {
+	err = r.record("GetLastCanceledBillingItem", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLastCanceledBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastCanceledBillingItem()
+
+}
+
+func (r Account) GetLastCancelledServerBillingItem() (resp datatypes.Billing_Item, err error) {
+	err = r.record("GetLastCancelledServerBillingItem", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLastCancelledServerBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastCancelledServerBillingItem()
+
+}
+
+func (r Account) GetLastFiveClosedAbuseTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetLastFiveClosedAbuseTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLastFiveClosedAbuseTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastFiveClosedAbuseTickets()
+
+}
+
+func (r Account) GetLastFiveClosedAccountingTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetLastFiveClosedAccountingTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLastFiveClosedAccountingTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastFiveClosedAccountingTickets()
+
+}
+
+func (r Account) GetLastFiveClosedOtherTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetLastFiveClosedOtherTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLastFiveClosedOtherTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastFiveClosedOtherTickets()
+
+}
+
+func (r Account) GetLastFiveClosedSalesTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetLastFiveClosedSalesTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLastFiveClosedSalesTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastFiveClosedSalesTickets()
+
+}
+
+func (r Account) GetLastFiveClosedSupportTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetLastFiveClosedSupportTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLastFiveClosedSupportTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastFiveClosedSupportTickets()
+
+}
+
+func (r Account) GetLastFiveClosedTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetLastFiveClosedTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLastFiveClosedTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastFiveClosedTickets()
+
+}
+
+func (r Account) GetLatestBillDate() (resp datatypes.Time, err error) {
+	err = r.record("GetLatestBillDate", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLatestBillDateWith(options sl.Options) (resp datatypes.Time, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestBillDate()
+
+}
+
+func (r Account) GetLatestRecurringInvoice() (resp datatypes.Billing_Invoice, err error) {
+	err = r.record("GetLatestRecurringInvoice", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLatestRecurringInvoiceWith(options sl.Options) (resp datatypes.Billing_Invoice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestRecurringInvoice()
+
+}
+
+func (r Account) GetLatestRecurringPendingInvoice() (resp datatypes.Billing_Invoice, err error) {
+	err = r.record("GetLatestRecurringPendingInvoice", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLatestRecurringPendingInvoiceWith(options sl.Options) (resp datatypes.Billing_Invoice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestRecurringPendingInvoice()
+
+}
+
+func (r Account) GetLegacyBandwidthAllotments() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	err = r.record("GetLegacyBandwidthAllotments", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLegacyBandwidthAllotmentsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLegacyBandwidthAllotments()
+
+}
+
+func (r Account) GetLegacyIscsiCapacityGB() (resp uint, err error) {
+	err = r.record("GetLegacyIscsiCapacityGB", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLegacyIscsiCapacityGBWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLegacyIscsiCapacityGB()
+
+}
+
+func (r Account) GetLoadBalancers() (resp []datatypes.Network_LoadBalancer_VirtualIpAddress, err error) {
+	err = r.record("GetLoadBalancers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLoadBalancersWith(options sl.Options) (resp []datatypes.Network_LoadBalancer_VirtualIpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLoadBalancers()
+
+}
+
+func (r Account) GetLockboxCapacityGB() (resp uint, err error) {
+	err = r.record("GetLockboxCapacityGB", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLockboxCapacityGBWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLockboxCapacityGB()
+
+}
+
+func (r Account) GetLockboxNetworkStorage() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetLockboxNetworkStorage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetLockboxNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLockboxNetworkStorage()
+
+}
+
+func (r Account) GetManualPaymentsUnderReview() (resp []datatypes.Billing_Payment_Card_ManualPayment, err error) {
+	err = r.record("GetManualPaymentsUnderReview", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetMasterUser() (resp datatypes.User_Customer, err error) {
+	err = r.record("GetMasterUser", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetMasterUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMasterUser()
+
+}
+
+func (r Account) GetMediaDataTransferRequests() (resp []datatypes.Account_Media_Data_Transfer_Request, err error) {
+	err = r.record("GetMediaDataTransferRequests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetMediaDataTransferRequestsWith(options sl.Options) (resp []datatypes.Account_Media_Data_Transfer_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMediaDataTransferRequests()
+
+}
+
+func (r Account) GetMonthlyBareMetalInstances() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetMonthlyBareMetalInstances", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetMonthlyBareMetalInstancesWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonthlyBareMetalInstances()
+
+}
+
+func (r Account) GetMonthlyVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetMonthlyVirtualGuests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetMonthlyVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonthlyVirtualGuests()
+
+}
+
+func (r Account) GetNasNetworkStorage() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetNasNetworkStorage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNasNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNasNetworkStorage()
+
+}
+
+func (r Account) GetNetAppActiveAccountLicenseKeys() (resp []string, err error) {
+	err = r.record("GetNetAppActiveAccountLicenseKeys", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkCreationFlag() (resp bool, err error) {
+	err = r.record("GetNetworkCreationFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkCreationFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkCreationFlag()
+
+}
+
+func (r Account) GetNetworkGateways() (resp []datatypes.Network_Gateway, err error) {
+	err = r.record("GetNetworkGateways", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkGatewaysWith(options sl.Options) (resp []datatypes.Network_Gateway, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGateways()
+
+}
+
+func (r Account) GetNetworkHardware() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetNetworkHardware", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkHardware()
+
+}
+
+func (r Account) GetNetworkMessageDeliveryAccounts() (resp []datatypes.Network_Message_Delivery, err error) {
+	err = r.record("GetNetworkMessageDeliveryAccounts", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkMonitorDownHardware() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetNetworkMonitorDownHardware", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkMonitorDownHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorDownHardware()
+
+}
+
+func (r Account) GetNetworkMonitorDownVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetNetworkMonitorDownVirtualGuests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkMonitorDownVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorDownVirtualGuests()
+
+}
+
+func (r Account) GetNetworkMonitorRecoveringHardware() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetNetworkMonitorRecoveringHardware", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkMonitorRecoveringHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorRecoveringHardware()
+
+}
+
+func (r Account) GetNetworkMonitorRecoveringVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetNetworkMonitorRecoveringVirtualGuests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkMonitorRecoveringVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorRecoveringVirtualGuests()
+
+}
+
+func (r Account) GetNetworkMonitorUpHardware() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetNetworkMonitorUpHardware", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkMonitorUpHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorUpHardware()
+
+}
+
+func (r Account) GetNetworkMonitorUpVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetNetworkMonitorUpVirtualGuests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkMonitorUpVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorUpVirtualGuests()
+
+}
+
+func (r Account) GetNetworkStorage() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetNetworkStorage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkStorageGroups() (resp []datatypes.Network_Storage_Group, err error) {
+	err = r.record("GetNetworkStorageGroups", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkStorageGroupsWith(options sl.Options) (resp []datatypes.Network_Storage_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStorageGroups()
+
+}
+
+func (r Account) GetNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStorage()
+
+}
+
+func (r Account) GetNetworkTunnelContexts() (resp []datatypes.Network_Tunnel_Module_Context, err error) {
+	err = r.record("GetNetworkTunnelContexts", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkTunnelContextsWith(options sl.Options) (resp []datatypes.Network_Tunnel_Module_Context, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkTunnelContexts()
+
+}
+
+func (r Account) GetNetworkVlanSpan() (resp datatypes.Account_Network_Vlan_Span, err error) {
+	err = r.record("GetNetworkVlanSpan", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkVlanSpanWith(options sl.Options) (resp datatypes.Account_Network_Vlan_Span, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlanSpan()
+
+}
+
+func (r Account) GetNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
+	err = r.record("GetNetworkVlans", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlans()
+
+}
+
+func (r Account) GetNextBillingPublicAllotmentHardwareBandwidthDetails() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	err = r.record("GetNextBillingPublicAllotmentHardwareBandwidthDetails", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNextBillingPublicAllotmentHardwareBandwidthDetailsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextBillingPublicAllotmentHardwareBandwidthDetails()
+
+}
+
+func (r Account) GetNextInvoiceExcel(documentCreateDate *datatypes.Time) (resp []byte, err error) {
+	err = r.record("GetNextInvoiceExcel", []interface{}{documentCreateDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNextInvoiceIncubatorExemptTotal() (resp datatypes.Float64, err error) {
+	err = r.record("GetNextInvoiceIncubatorExemptTotal", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNextInvoiceIncubatorExemptTotalWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceIncubatorExemptTotal()
+
+}
+
+func (r Account) GetNextInvoicePdf(documentCreateDate *datatypes.Time) (resp []byte, err error) {
+	err = r.record("GetNextInvoicePdf", []interface{}{documentCreateDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNextInvoicePdfDetailed(documentCreateDate *datatypes.Time) (resp []byte, err error) {
+	err = r.record("GetNextInvoicePdfDetailed", []interface{}{documentCreateDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNextInvoiceRecurringAmountEligibleForAccountDiscount() (resp datatypes.Float64, err error) {
+	err = r.record("GetNextInvoiceRecurringAmountEligibleForAccountDiscount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNextInvoiceRecurringAmountEligibleForAccountDiscountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceRecurringAmountEligibleForAccountDiscount()
+
+}
+
+func (r Account) GetNextInvoiceTopLevelBillingItems() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetNextInvoiceTopLevelBillingItems", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNextInvoiceTopLevelBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTopLevelBillingItems()
+
+}
+
+func (r Account) GetNextInvoiceTotalAmount() (resp datatypes.Float64, err error) {
+	err = r.record("GetNextInvoiceTotalAmount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNextInvoiceTotalAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalAmount()
+
+}
+
+func (r Account) GetNextInvoiceTotalOneTimeAmount() (resp datatypes.Float64, err error) {
+	err = r.record("GetNextInvoiceTotalOneTimeAmount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNextInvoiceTotalOneTimeAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalOneTimeAmount()
+
+}
+
+func (r Account) GetNextInvoiceTotalOneTimeTaxAmount() (resp datatypes.Float64, err error) {
+	err = r.record("GetNextInvoiceTotalOneTimeTaxAmount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNextInvoiceTotalOneTimeTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalOneTimeTaxAmount()
+
+}
+
+func (r Account) GetNextInvoiceTotalRecurringAmount() (resp datatypes.Float64, err error) {
+	err = r.record("GetNextInvoiceTotalRecurringAmount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNextInvoiceTotalRecurringAmountBeforeAccountDiscount() (resp datatypes.Float64, err error) {
+	err = r.record("GetNextInvoiceTotalRecurringAmountBeforeAccountDiscount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNextInvoiceTotalRecurringAmountBeforeAccountDiscountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalRecurringAmountBeforeAccountDiscount()
+
+}
+
+func (r Account) GetNextInvoiceTotalRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalRecurringAmount()
+
+}
+
+func (r Account) GetNextInvoiceTotalRecurringTaxAmount() (resp datatypes.Float64, err error) {
+	err = r.record("GetNextInvoiceTotalRecurringTaxAmount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNextInvoiceTotalRecurringTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalRecurringTaxAmount()
+
+}
+
+func (r Account) GetNextInvoiceTotalTaxableRecurringAmount() (resp datatypes.Float64, err error) {
+	err = r.record("GetNextInvoiceTotalTaxableRecurringAmount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNextInvoiceTotalTaxableRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextInvoiceTotalTaxableRecurringAmount()
+
+}
+
+func (r Account) GetNextInvoiceZeroFeeItemCounts() (resp []datatypes.Container_Product_Item_Category_ZeroFee_Count, err error) {
+	err = r.record("GetNextInvoiceZeroFeeItemCounts", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetNotificationSubscribers() (resp []datatypes.Notification_Subscriber, err error) {
+	err = r.record("GetNotificationSubscribers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetObject() (resp datatypes.Account, err error) {
+	err = r.record("GetObject", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOpenAbuseTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetOpenAbuseTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOpenAbuseTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenAbuseTickets()
+
+}
+
+func (r Account) GetOpenAccountingTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetOpenAccountingTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOpenAccountingTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenAccountingTickets()
+
+}
+
+func (r Account) GetOpenBillingTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetOpenBillingTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOpenBillingTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenBillingTickets()
+
+}
+
+func (r Account) GetOpenCancellationRequests() (resp []datatypes.Billing_Item_Cancellation_Request, err error) {
+	err = r.record("GetOpenCancellationRequests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOpenCancellationRequestsWith(options sl.Options) (resp []datatypes.Billing_Item_Cancellation_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenCancellationRequests()
+
+}
+
+func (r Account) GetOpenOtherTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetOpenOtherTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOpenOtherTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenOtherTickets()
+
+}
+
+func (r Account) GetOpenRecurringInvoices() (resp []datatypes.Billing_Invoice, err error) {
+	err = r.record("GetOpenRecurringInvoices", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOpenRecurringInvoicesWith(options sl.Options) (resp []datatypes.Billing_Invoice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenRecurringInvoices()
+
+}
+
+func (r Account) GetOpenSalesTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetOpenSalesTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOpenSalesTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenSalesTickets()
+
+}
+
+func (r Account) GetOpenStackAccountLinks() (resp []datatypes.Account_Link, err error) {
+	err = r.record("GetOpenStackAccountLinks", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOpenStackObjectStorage() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetOpenStackObjectStorage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOpenStackObjectStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenStackObjectStorage()
+
+}
+
+func (r Account) GetOpenSupportTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetOpenSupportTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOpenSupportTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenSupportTickets()
+
+}
+
+func (r Account) GetOpenTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetOpenTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOpenTicketsWaitingOnCustomer() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetOpenTicketsWaitingOnCustomer", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOpenTicketsWaitingOnCustomerWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenTicketsWaitingOnCustomer()
+
+}
+
+func (r Account) GetOpenTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenTickets()
+
+}
+
+func (r Account) GetOrders() (resp []datatypes.Billing_Order, err error) {
+	err = r.record("GetOrders", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOrdersWith(options sl.Options) (resp []datatypes.Billing_Order, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrders()
+
+}
+
+func (r Account) GetOrphanBillingItems() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetOrphanBillingItems", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOrphanBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrphanBillingItems()
+
+}
+
+func (r Account) GetOwnedBrands() (resp []datatypes.Brand, err error) {
+	err = r.record("GetOwnedBrands", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetOwnedHardwareGenericComponentModels() (resp []datatypes.Hardware_Component_Model_Generic, err error) {
+	err = r.record("GetOwnedHardwareGenericComponentModels", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPaymentProcessors() (resp []datatypes.Billing_Payment_Processor, err error) {
+	err = r.record("GetPaymentProcessors", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPendingCreditCardChangeRequestData() (resp []datatypes.Container_Account_Payment_Method_CreditCard, err error) {
+	err = r.record("GetPendingCreditCardChangeRequestData", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPendingEvents() (resp []datatypes.Notification_Occurrence_Event, err error) {
+	err = r.record("GetPendingEvents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPendingInvoice() (resp datatypes.Billing_Invoice, err error) {
+	err = r.record("GetPendingInvoice", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPendingInvoiceTopLevelItems() (resp []datatypes.Billing_Invoice_Item, err error) {
+	err = r.record("GetPendingInvoiceTopLevelItems", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPendingInvoiceTopLevelItemsWith(options sl.Options) (resp []datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingInvoiceTopLevelItems()
+
+}
+
+func (r Account) GetPendingInvoiceTotalAmount() (resp datatypes.Float64, err error) {
+	err = r.record("GetPendingInvoiceTotalAmount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPendingInvoiceTotalAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingInvoiceTotalAmount()
+
+}
+
+func (r Account) GetPendingInvoiceTotalOneTimeAmount() (resp datatypes.Float64, err error) {
+	err = r.record("GetPendingInvoiceTotalOneTimeAmount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPendingInvoiceTotalOneTimeAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingInvoiceTotalOneTimeAmount()
+
+}
+
+func (r Account) GetPendingInvoiceTotalOneTimeTaxAmount() (resp datatypes.Float64, err error) {
+	err = r.record("GetPendingInvoiceTotalOneTimeTaxAmount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPendingInvoiceTotalOneTimeTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingInvoiceTotalOneTimeTaxAmount()
+
+}
+
+func (r Account) GetPendingInvoiceTotalRecurringAmount() (resp datatypes.Float64, err error) {
+	err = r.record("GetPendingInvoiceTotalRecurringAmount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPendingInvoiceTotalRecurringAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingInvoiceTotalRecurringAmount()
+
+}
+
+func (r Account) GetPendingInvoiceTotalRecurringTaxAmount() (resp datatypes.Float64, err error) {
+	err = r.record("GetPendingInvoiceTotalRecurringTaxAmount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPendingInvoiceTotalRecurringTaxAmountWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingInvoiceTotalRecurringTaxAmount()
+
+}
+
+func (r Account) GetPendingInvoiceWith(options sl.Options) (resp datatypes.Billing_Invoice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingInvoice()
+
+}
+
+func (r Account) GetPermissionGroups() (resp []datatypes.User_Permission_Group, err error) {
+	err = r.record("GetPermissionGroups", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPermissionGroupsWith(options sl.Options) (resp []datatypes.User_Permission_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPermissionGroups()
+
+}
+
+func (r Account) GetPermissionRoles() (resp []datatypes.User_Permission_Role, err error) {
+	err = r.record("GetPermissionRoles", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPermissionRolesWith(options sl.Options) (resp []datatypes.User_Permission_Role, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPermissionRoles()
+
+}
+
+func (r Account) GetPlacementGroups() (resp []datatypes.Virtual_PlacementGroup, err error) {
+	err = r.record("GetPlacementGroups", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPlacementGroupsWith(options sl.Options) (resp []datatypes.Virtual_PlacementGroup, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPlacementGroups()
+
+}
+
+func (r Account) GetPortableStorageVolumes() (resp []datatypes.Virtual_Disk_Image, err error) {
+	err = r.record("GetPortableStorageVolumes", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPostProvisioningHooks() (resp []datatypes.Provisioning_Hook, err error) {
+	err = r.record("GetPostProvisioningHooks", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPostProvisioningHooksWith(options sl.Options) (resp []datatypes.Provisioning_Hook, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPostProvisioningHooks()
+
+}
+
+func (r Account) GetPptpVpnAllowedFlag() (resp bool, err error) {
+	err = r.record("GetPptpVpnAllowedFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPptpVpnAllowedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPptpVpnAllowedFlag()
+
+}
+
+func (r Account) GetPptpVpnUsers() (resp []datatypes.User_Customer, err error) {
+	err = r.record("GetPptpVpnUsers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPptpVpnUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPptpVpnUsers()
+
+}
+
+func (r Account) GetPreviousRecurringRevenue() (resp datatypes.Float64, err error) {
+	err = r.record("GetPreviousRecurringRevenue", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPreviousRecurringRevenueWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPreviousRecurringRevenue()
+
+}
+
+func (r Account) GetPriceRestrictions() (resp []datatypes.Product_Item_Price_Account_Restriction, err error) {
+	err = r.record("GetPriceRestrictions", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPriceRestrictionsWith(options sl.Options) (resp []datatypes.Product_Item_Price_Account_Restriction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPriceRestrictions()
+
+}
+
+func (r Account) GetPriorityOneTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetPriorityOneTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPriorityOneTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPriorityOneTickets()
+
+}
+
+func (r Account) GetPrivateAllotmentHardwareBandwidthDetails() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	err = r.record("GetPrivateAllotmentHardwareBandwidthDetails", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPrivateAllotmentHardwareBandwidthDetailsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateAllotmentHardwareBandwidthDetails()
+
+}
+
+func (r Account) GetPrivateBlockDeviceTemplateGroups() (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	err = r.record("GetPrivateBlockDeviceTemplateGroups", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPrivateBlockDeviceTemplateGroupsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateBlockDeviceTemplateGroups()
+
+}
+
+func (r Account) GetPrivateIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
+	err = r.record("GetPrivateIpAddresses", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPrivateNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
+	err = r.record("GetPrivateNetworkVlans", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPrivateNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateNetworkVlans()
+
+}
+
+func (r Account) GetPrivateSubnets() (resp []datatypes.Network_Subnet, err error) {
+	err = r.record("GetPrivateSubnets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPrivateSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateSubnets()
+
+}
+
+func (r Account) GetProofOfConceptAccountFlag() (resp bool, err error) {
+	err = r.record("GetProofOfConceptAccountFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetProofOfConceptAccountFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProofOfConceptAccountFlag()
+
+}
+
+func (r Account) GetPublicAllotmentHardwareBandwidthDetails() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	err = r.record("GetPublicAllotmentHardwareBandwidthDetails", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPublicAllotmentHardwareBandwidthDetailsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPublicAllotmentHardwareBandwidthDetails()
+
+}
+
+func (r Account) GetPublicIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
+	err = r.record("GetPublicIpAddresses", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPublicNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
+	err = r.record("GetPublicNetworkVlans", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPublicNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPublicNetworkVlans()
+
+}
+
+func (r Account) GetPublicSubnets() (resp []datatypes.Network_Subnet, err error) {
+	err = r.record("GetPublicSubnets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetPublicSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPublicSubnets()
+
+}
+
+func (r Account) GetQuotes() (resp []datatypes.Billing_Order_Quote, err error) {
+	err = r.record("GetQuotes", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetQuotesWith(options sl.Options) (resp []datatypes.Billing_Order_Quote, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetQuotes()
+
+}
+
+func (r Account) GetRecentEvents() (resp []datatypes.Notification_Occurrence_Event, err error) {
+	err = r.record("GetRecentEvents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetReferralPartner() (resp datatypes.Account, err error) {
+	err = r.record("GetReferralPartner", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetReferralPartnerCommissionForecast() (resp []datatypes.Container_Referral_Partner_Commission, err error) {
+	err = r.record("GetReferralPartnerCommissionForecast", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetReferralPartnerCommissionHistory() (resp []datatypes.Container_Referral_Partner_Commission, err error) {
+	err = r.record("GetReferralPartnerCommissionHistory", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetReferralPartnerCommissionPending() (resp []datatypes.Container_Referral_Partner_Commission, err error) {
+	err = r.record("GetReferralPartnerCommissionPending", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetReferralPartnerWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReferralPartner()
+
+}
+
+func (r Account) GetReferredAccounts() (resp []datatypes.Account, err error) {
+	err = r.record("GetReferredAccounts", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetReferredAccountsWith(options sl.Options) (resp []datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReferredAccounts()
+
+}
+
+func (r Account) GetRegulatedWorkloads() (resp []datatypes.Legal_RegulatedWorkload, err error) {
+	err = r.record("GetRegulatedWorkloads", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetRemoteManagementCommandRequests() (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
+	err = r.record("GetRemoteManagementCommandRequests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetRemoteManagementCommandRequestsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementCommandRequests()
+
+}
+
+func (r Account) GetReplicationEvents() (resp []datatypes.Network_Storage_Event, err error) {
+	err = r.record("GetReplicationEvents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetReplicationEventsWith(options sl.Options) (resp []datatypes.Network_Storage_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicationEvents()
+
+}
+
+func (r Account) GetRequireSilentIBMidUserCreation() (resp bool, err error) {
+	err = r.record("GetRequireSilentIBMidUserCreation", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetRequireSilentIBMidUserCreationWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRequireSilentIBMidUserCreation()
+
+}
+
+func (r Account) GetReservedCapacityAgreements() (resp []datatypes.Account_Agreement, err error) {
+	err = r.record("GetReservedCapacityAgreements", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetReservedCapacityAgreementsWith(options sl.Options) (resp []datatypes.Account_Agreement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReservedCapacityAgreements()
+
+}
+
+func (r Account) GetReservedCapacityGroups() (resp []datatypes.Virtual_ReservedCapacityGroup, err error) {
+	err = r.record("GetReservedCapacityGroups", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetReservedCapacityGroupsWith(options sl.Options) (resp []datatypes.Virtual_ReservedCapacityGroup, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReservedCapacityGroups()
+
+}
+
+func (r Account) GetResourceGroups() (resp []datatypes.Resource_Group, err error) {
+	err = r.record("GetResourceGroups", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetResourceGroupsWith(options sl.Options) (resp []datatypes.Resource_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResourceGroups()
+
+}
+
+func (r Account) GetRouters() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetRouters", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRouters()
+
+}
+
+func (r Account) GetRwhoisData() (resp datatypes.Network_Subnet_Rwhois_Data, err error) {
+	err = r.record("GetRwhoisData", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetRwhoisDataWith(options sl.Options) (resp datatypes.Network_Subnet_Rwhois_Data, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRwhoisData()
+
+}
+
+func (r Account) GetSalesforceAccountLink() (resp datatypes.Account_Link, err error) {
+	err = r.record("GetSalesforceAccountLink", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSamlAuthentication() (resp datatypes.Account_Authentication_Saml, err error) {
+	err = r.record("GetSamlAuthentication", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSamlAuthenticationWith(options sl.Options) (resp datatypes.Account_Authentication_Saml, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSamlAuthentication()
+
+}
+
+func (r Account) GetScaleGroups() (resp []datatypes.Scale_Group, err error) {
+	err = r.record("GetScaleGroups", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetScaleGroupsWith(options sl.Options) (resp []datatypes.Scale_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleGroups()
+
+}
+
+func (r Account) GetSecondaryDomains() (resp []datatypes.Dns_Secondary, err error) {
+	err = r.record("GetSecondaryDomains", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSecondaryDomainsWith(options sl.Options) (resp []datatypes.Dns_Secondary, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecondaryDomains()
+
+}
+
+func (r Account) GetSecurityCertificates() (resp []datatypes.Security_Certificate, err error) {
+	err = r.record("GetSecurityCertificates", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSecurityCertificatesWith(options sl.Options) (resp []datatypes.Security_Certificate, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityCertificates()
+
+}
+
+func (r Account) GetSecurityGroups() (resp []datatypes.Network_SecurityGroup, err error) {
+	err = r.record("GetSecurityGroups", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSecurityGroupsWith(options sl.Options) (resp []datatypes.Network_SecurityGroup, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityGroups()
+
+}
+
+func (r Account) GetSecurityLevel() (resp datatypes.Security_Level, err error) {
+	err = r.record("GetSecurityLevel", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSecurityScanRequests() (resp []datatypes.Network_Security_Scanner_Request, err error) {
+	err = r.record("GetSecurityScanRequests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSecurityScanRequestsWith(options sl.Options) (resp []datatypes.Network_Security_Scanner_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityScanRequests()
+
+}
+
+func (r Account) GetServiceBillingItems() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetServiceBillingItems", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetServiceBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceBillingItems()
+
+}
+
+func (r Account) GetSharedBlockDeviceTemplateGroups() (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	err = r.record("GetSharedBlockDeviceTemplateGroups", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetShipments() (resp []datatypes.Account_Shipment, err error) {
+	err = r.record("GetShipments", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetShipmentsWith(options sl.Options) (resp []datatypes.Account_Shipment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetShipments()
+
+}
+
+func (r Account) GetSshKeys() (resp []datatypes.Security_Ssh_Key, err error) {
+	err = r.record("GetSshKeys", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSshKeys()
+
+}
+
+func (r Account) GetSslVpnUsers() (resp []datatypes.User_Customer, err error) {
+	err = r.record("GetSslVpnUsers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSslVpnUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSslVpnUsers()
+
+}
+
+func (r Account) GetStandardPoolVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetStandardPoolVirtualGuests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetStandardPoolVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStandardPoolVirtualGuests()
+
+}
+
+func (r Account) GetSubnetRegistrationDetails() (resp []datatypes.Account_Regional_Registry_Detail, err error) {
+	err = r.record("GetSubnetRegistrationDetails", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSubnetRegistrations() (resp []datatypes.Network_Subnet_Registration, err error) {
+	err = r.record("GetSubnetRegistrations", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSubnets() (resp []datatypes.Network_Subnet, err error) {
+	err = r.record("GetSubnets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubnets()
+
+}
+
+func (r Account) GetSupportRepresentatives() (resp []datatypes.User_Employee, err error) {
+	err = r.record("GetSupportRepresentatives", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSupportRepresentativesWith(options sl.Options) (resp []datatypes.User_Employee, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSupportRepresentatives()
+
+}
+
+func (r Account) GetSupportSubscriptions() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetSupportSubscriptions", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSupportSubscriptionsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSupportSubscriptions()
+
+}
+
+func (r Account) GetSupportTier() (resp string, err error) {
+	err = r.record("GetSupportTier", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSuppressInvoicesFlag() (resp bool, err error) {
+	err = r.record("GetSuppressInvoicesFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetSuppressInvoicesFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSuppressInvoicesFlag()
+
+}
+
+func (r Account) GetTags() (resp []datatypes.Tag, err error) {
+	err = r.record("GetTags", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetTechIncubatorProgramInfo(forNextBillCycle *bool) (resp datatypes.Container_Account_Discount_Program, err error) {
+	err = r.record("GetTechIncubatorProgramInfo", []interface{}{forNextBillCycle}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetThirdPartyPoliciesAcceptanceStatus() (resp []datatypes.Container_Policy_Acceptance, err error) {
+	err = r.record("GetThirdPartyPoliciesAcceptanceStatus", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetTicketsClosedInTheLastThreeDays() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetTicketsClosedInTheLastThreeDays", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetTicketsClosedInTheLastThreeDaysWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTicketsClosedInTheLastThreeDays()
+
+}
+
+func (r Account) GetTicketsClosedToday() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetTicketsClosedToday", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetTicketsClosedTodayWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTicketsClosedToday()
+
+}
+
+func (r Account) GetTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTickets()
+
+}
+
+func (r Account) GetTranscodeAccounts() (resp []datatypes.Network_Media_Transcode_Account, err error) {
+	err = r.record("GetTranscodeAccounts", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetTranscodeAccountsWith(options sl.Options) (resp []datatypes.Network_Media_Transcode_Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTranscodeAccounts()
+
+}
+
+func (r Account) GetUpgradeRequests() (resp []datatypes.Product_Upgrade_Request, err error) {
+	err = r.record("GetUpgradeRequests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetUpgradeRequestsWith(options sl.Options) (resp []datatypes.Product_Upgrade_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeRequests()
+
+}
+
+func (r Account) GetUsers() (resp []datatypes.User_Customer, err error) {
+	err = r.record("GetUsers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUsers()
+
+}
+
+func (r Account) GetValidSecurityCertificateEntries() (resp []datatypes.Security_Certificate_Entry, err error) {
+	err = r.record("GetValidSecurityCertificateEntries", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetValidSecurityCertificates() (resp []datatypes.Security_Certificate, err error) {
+	err = r.record("GetValidSecurityCertificates", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetValidSecurityCertificatesWith(options sl.Options) (resp []datatypes.Security_Certificate, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetValidSecurityCertificates()
+
+}
+
+func (r Account) GetVdrUpdatesInProgressFlag() (resp bool, err error) {
+	err = r.record("GetVdrUpdatesInProgressFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVdrUpdatesInProgressFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVdrUpdatesInProgressFlag()
+
+}
+
+func (r Account) GetVirtualDedicatedRacks() (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	err = r.record("GetVirtualDedicatedRacks", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualDedicatedRacksWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualDedicatedRacks()
+
+}
+
+func (r Account) GetVirtualDiskImages() (resp []datatypes.Virtual_Disk_Image, err error) {
+	err = r.record("GetVirtualDiskImages", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualDiskImagesWith(options sl.Options) (resp []datatypes.Virtual_Disk_Image, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualDiskImages()
+
+}
+
+func (r Account) GetVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetVirtualGuests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualGuestsOverBandwidthAllocation() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetVirtualGuestsOverBandwidthAllocation", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualGuestsOverBandwidthAllocationWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsOverBandwidthAllocation()
+
+}
+
+func (r Account) GetVirtualGuestsProjectedOverBandwidthAllocation() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetVirtualGuestsProjectedOverBandwidthAllocation", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualGuestsProjectedOverBandwidthAllocationWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsProjectedOverBandwidthAllocation()
+
+}
+
+func (r Account) GetVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuests()
+
+}
+
+func (r Account) GetVirtualGuestsWithCpanel() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetVirtualGuestsWithCpanel", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualGuestsWithCpanelWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithCpanel()
+
+}
+
+func (r Account) GetVirtualGuestsWithMcafee() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetVirtualGuestsWithMcafee", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualGuestsWithMcafeeAntivirusRedhat() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetVirtualGuestsWithMcafeeAntivirusRedhat", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualGuestsWithMcafeeAntivirusRedhatWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithMcafeeAntivirusRedhat()
+
+}
+
+func (r Account) GetVirtualGuestsWithMcafeeAntivirusWindows() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetVirtualGuestsWithMcafeeAntivirusWindows", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualGuestsWithMcafeeAntivirusWindowsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithMcafeeAntivirusWindows()
+
+}
+
+func (r Account) GetVirtualGuestsWithMcafeeIntrusionDetectionSystem() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetVirtualGuestsWithMcafeeIntrusionDetectionSystem", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualGuestsWithMcafeeIntrusionDetectionSystemWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithMcafeeIntrusionDetectionSystem()
+
+}
+
+func (r Account) GetVirtualGuestsWithMcafeeWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithMcafee()
+
+}
+
+func (r Account) GetVirtualGuestsWithPlesk() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetVirtualGuestsWithPlesk", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualGuestsWithPleskWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithPlesk()
+
+}
+
+func (r Account) GetVirtualGuestsWithQuantastor() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetVirtualGuestsWithQuantastor", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualGuestsWithQuantastorWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithQuantastor()
+
+}
+
+func (r Account) GetVirtualGuestsWithUrchin() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetVirtualGuestsWithUrchin", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualGuestsWithUrchinWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestsWithUrchin()
+
+}
+
+func (r Account) GetVirtualPrivateRack() (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	err = r.record("GetVirtualPrivateRack", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualPrivateRackWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualPrivateRack()
+
+}
+
+func (r Account) GetVirtualStorageArchiveRepositories() (resp []datatypes.Virtual_Storage_Repository, err error) {
+	err = r.record("GetVirtualStorageArchiveRepositories", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualStorageArchiveRepositoriesWith(options sl.Options) (resp []datatypes.Virtual_Storage_Repository, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualStorageArchiveRepositories()
+
+}
+
+func (r Account) GetVirtualStoragePublicRepositories() (resp []datatypes.Virtual_Storage_Repository, err error) {
+	err = r.record("GetVirtualStoragePublicRepositories", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVirtualStoragePublicRepositoriesWith(options sl.Options) (resp []datatypes.Virtual_Storage_Repository, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualStoragePublicRepositories()
+
+}
+
+func (r Account) GetVmWareActiveAccountLicenseKeys() (resp []string, err error) {
+	err = r.record("GetVmWareActiveAccountLicenseKeys", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVpcVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetVpcVirtualGuests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) GetVpcVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVpcVirtualGuests()
+
+}
+
+func (r Account) GetWindowsUpdateStatus() (resp []datatypes.Container_Utility_Microsoft_Windows_UpdateServices_Status, err error) {
+	err = r.record("GetWindowsUpdateStatus", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) HasAttribute(attributeType *string) (resp bool, err error) {
+	err = r.record("HasAttribute", []interface{}{attributeType}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) HourlyInstanceLimit() (resp int, err error) {
+	err = r.record("HourlyInstanceLimit", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) HourlyServerLimit() (resp int, err error) {
+	err = r.record("HourlyServerLimit", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) IsEligibleForLocalCurrencyProgram() (resp bool, err error) {
+	err = r.record("IsEligibleForLocalCurrencyProgram", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) IsEligibleToLinkWithPaas() (resp bool, err error) {
+	err = r.record("IsEligibleToLinkWithPaas", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) LinkExternalAccount(externalAccountId *string, authorizationToken *string, externalServiceProviderKey *string) (err error) {
+	err = r.record("LinkExternalAccount", []interface{}{externalAccountId, authorizationToken, externalServiceProviderKey}, r.Options, nil)
+	return
+
+}
+
+func (r Account) RemoveAlternateCreditCard() (resp bool, err error) {
+	err = r.record("RemoveAlternateCreditCard", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) RequestCreditCardChange(request *datatypes.Billing_Payment_Card_ChangeRequest, vatId *string, paymentRoleName *string, onlyChangeNicknameFlag *bool) (resp datatypes.Billing_Payment_Card_ChangeRequest, err error) {
+	err = r.record("RequestCreditCardChange", []interface{}{request, vatId, paymentRoleName, onlyChangeNicknameFlag}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) RequestManualPayment(request *datatypes.Billing_Payment_Card_ManualPayment) (resp datatypes.Billing_Payment_Card_ManualPayment, err error) {
+	err = r.record("RequestManualPayment", []interface{}{request}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) RequestManualPaymentUsingCreditCardOnFile(amount *string, payWithAlternateCardFlag *bool, note *string) (resp datatypes.Billing_Payment_Card_ManualPayment, err error) {
+	err = r.record("RequestManualPaymentUsingCreditCardOnFile", []interface{}{amount, payWithAlternateCardFlag, note}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) SetAbuseEmails(emails []string) (resp bool, err error) {
+	err = r.record("SetAbuseEmails", []interface{}{emails}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) SetManagedPoolQuantity(poolKeyName *string, backendRouter *string, quantity *int) (resp []byte, err error) {
+	err = r.record("SetManagedPoolQuantity", []interface{}{poolKeyName, backendRouter, quantity}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) SetVlanSpan(enabled *bool) (resp bool, err error) {
+	err = r.record("SetVlanSpan", []interface{}{enabled}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) SwapCreditCards() (resp bool, err error) {
+	err = r.record("SwapCreditCards", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Account) SyncCurrentUserPopulationWithPaas() (err error) {
+	err = r.record("SyncCurrentUserPopulationWithPaas", nil, r.Options, nil)
+	return
+
+}
+
+func (r Account) UpdateVpnUsersForResource(objectId *int, objectType *string) (resp bool, err error) {
+	err = r.record("UpdateVpnUsersForResource", []interface{}{objectId, objectType}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) Validate(account *datatypes.Account) (resp []string, err error) {
+	err = r.record("Validate", []interface{}{account}, r.Options, &resp)
+	return
+
+}
+
+func (r Account) ValidateManualPaymentAmount(amount *string) (resp bool, err error) {
+	err = r.record("ValidateManualPaymentAmount", []interface{}{amount}, r.Options, &resp)
+	return
+
+}
@@ -0,0 +1,164 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fakes provides recording fakes for the most commonly used services,
+// along with interfaces describing their API methods.
+//
+// Each interface (e.g. VirtualGuestService) is satisfied by both the real
+// service (services.Virtual_Guest) and the matching fake (fakes.Virtual_Guest),
+// so code written against the interface can be unit tested without a
+// SoftLayer account. The interfaces are also suitable as input to mockgen:
+//
+//	mockgen -source=virtual_guest.go -destination=mock_virtual_guest.go
+//
+// The per-service files in this package are generated by `tools fakes`.
+package fakes
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Call records a single invocation of a fake service method
+type Call struct {
+	// Method is the Go name of the invoked method (e.g., "GetObject")
+	Method string
+
+	// Args holds the arguments the method was invoked with, in order
+	Args []interface{}
+
+	// Options holds the id, mask, filter and result limits in effect for the call
+	Options sl.Options
+}
+
+// StubFunc computes the result of a fake method call.  The returned value must
+// be assignable to the return type of the method (or nil for void methods).
+type StubFunc func(call Call) (interface{}, error)
+
+type result struct {
+	value interface{}
+	err   error
+}
+
+// Recorder holds the calls made against a fake, and the results programmed
+// for each method.  It is shared by a fake and every copy of it produced by
+// its fluent methods: Id(), GlobalID() (for services that have one),
+// InitParameter(), Mask(), Filter(), Limit(), Unlimited(), Offset(), Timeout()
+// and WithContext().  Results programmed on any copy apply to all of them, and
+// the calls made through any copy are recorded together.
+type Recorder struct {
+	mu      sync.Mutex
+	calls   []Call
+	results map[string][]result
+	stubs   map[string]StubFunc
+}
+
+// NewRecorder returns an empty Recorder
+func NewRecorder() *Recorder {
+	return &Recorder{
+		results: map[string][]result{},
+		stubs:   map[string]StubFunc{},
+	}
+}
+
+// Returns programs the result of the named method.  Successive calls to Returns
+// for the same method queue results, which are consumed in order; the last one
+// programmed is repeated once the queue is exhausted.
+func (r *Recorder) Returns(method string, value interface{}, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.results[method] = append(r.results[method], result{value: value, err: err})
+}
+
+// Stub installs a function that computes the result of the named method.  A
+// stub takes precedence over any results programmed with Returns.
+func (r *Recorder) Stub(method string, stub StubFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stubs[method] = stub
+}
+
+// Calls returns the recorded invocations of the named method, or all recorded
+// invocations if method is empty.
+func (r *Recorder) Calls(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	calls := []Call{}
+	for _, call := range r.calls {
+		if method == "" || call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// CallCount returns the number of times the named method was invoked
+func (r *Recorder) CallCount(method string) int {
+	return len(r.Calls(method))
+}
+
+// Reset discards all recorded calls, programmed results and stubs
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+	r.results = map[string][]result{}
+	r.stubs = map[string]StubFunc{}
+}
+
+// record logs the call and populates pResult (if non-nil) with the programmed
+// result for the method.  Methods without a programmed result return the
+// zero value and no error.
+func (r *Recorder) record(method string, args []interface{}, options sl.Options, pResult interface{}) error {
+	call := Call{Method: method, Args: args, Options: options}
+
+	r.mu.Lock()
+	r.calls = append(r.calls, call)
+
+	stub := r.stubs[method]
+
+	var res result
+	if queue := r.results[method]; len(queue) > 0 {
+		res = queue[0]
+		if len(queue) > 1 {
+			r.results[method] = queue[1:]
+		}
+	}
+	r.mu.Unlock()
+
+	if stub != nil {
+		res.value, res.err = stub(call)
+	}
+
+	if res.value != nil && pResult != nil {
+		target := reflect.ValueOf(pResult).Elem()
+		value := reflect.ValueOf(res.value)
+		if !value.Type().AssignableTo(target.Type()) {
+			return fmt.Errorf("fakes: %s returns %s, but %s was programmed", method, target.Type(), value.Type())
+		}
+		target.Set(value)
+	}
+
+	return res.err
+}
@@ -0,0 +1,112 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakes
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestFakeReturnsProgrammedResults(t *testing.T) {
+	fake := NewVirtualGuest()
+	fake.Returns("GetObject", datatypes.Virtual_Guest{Id: sl.Int(1)}, nil)
+	fake.Returns("GetObject", datatypes.Virtual_Guest{}, errors.New("boom"))
+
+	var service VirtualGuestService = fake.Id(1).Mask("id,hostname")
+
+	guest, err := service.GetObject()
+	if err != nil || sl.Get(guest.Id) != 1 {
+		t.Errorf("Expected guest 1 and no error, got %#v, %v", guest, err)
+	}
+
+	// The last programmed result repeats
+	for i := 0; i < 2; i++ {
+		if _, err = service.GetObject(); err == nil {
+			t.Errorf("Expected programmed error on call %d", i+2)
+		}
+	}
+
+	calls := fake.Calls("GetObject")
+	if len(calls) != 3 {
+		t.Fatalf("Expected 3 recorded calls, got %d", len(calls))
+	}
+
+	if sl.Get(calls[0].Options.Id) != 1 || calls[0].Options.Mask != "mask[id,hostname]" {
+		t.Errorf("Expected options to be recorded, got %#v", calls[0].Options)
+	}
+}
+
+func TestFakeRecordsArguments(t *testing.T) {
+	fake := NewVirtualGuest()
+	fake.Stub("SetTags", func(call Call) (interface{}, error) {
+		return *call.Args[0].(*string) == "a,b", nil
+	})
+
+	ok, err := fake.SetTags(sl.String("a,b"))
+	if err != nil || !ok {
+		t.Errorf("Expected stubbed result true, got %t, %v", ok, err)
+	}
+
+	if fake.CallCount("SetTags") != 1 || fake.CallCount("") != 1 {
+		t.Errorf("Expected exactly one recorded call")
+	}
+}
+
+func TestFakeRejectsMismatchedResult(t *testing.T) {
+	fake := NewAccount()
+	fake.Returns("GetObject", "not an account", nil)
+
+	if _, err := fake.GetObject(); err == nil {
+		t.Errorf("Expected an error when the programmed result has the wrong type")
+	}
+}
+
+func TestFakeScopedGetter(t *testing.T) {
+	fake := NewVirtualGuest()
+	fake.Returns("GetBillingItem", datatypes.Billing_Item_Virtual_Guest{}, nil)
+
+	service := fake.Id(1).Mask("id")
+	if _, err := service.GetBillingItemWith(sl.Options{Mask: "mask[recurringFee]", Id: sl.Int(2)}); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := fake.Calls("GetBillingItem")
+	if len(calls) != 1 || sl.Get(calls[0].Options.Id) != 1 || calls[0].Options.Mask != "mask[recurringFee]" {
+		t.Errorf("Expected the scoped options with the service id, got %#v", calls)
+	}
+
+	if service.Options.Mask != "id" {
+		t.Errorf("Expected the service options to be unchanged, got %q", service.Options.Mask)
+	}
+}
+
+func TestFakeScopedGetterKeepsInitParameters(t *testing.T) {
+	fake := NewVirtualGuest()
+	fake.Returns("GetBillingItem", datatypes.Billing_Item_Virtual_Guest{}, nil)
+
+	if _, err := fake.InitParameter("globalIdentifier", "abc-123").GetBillingItemWith(sl.Options{Mask: "recurringFee"}); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := fake.Calls("GetBillingItem")
+	if len(calls) != 1 || calls[0].Options.InitParameters == nil || (*calls[0].Options.InitParameters)["globalIdentifier"] != "abc-123" {
+		t.Errorf("Expected the init parameters of the service, got %#v", calls)
+	}
+}
@@ -0,0 +1,3337 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/**
+ * AUTOMATICALLY GENERATED CODE - DO NOT MODIFY
+ */
+
+package fakes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// HardwareServerService describes the API methods of services.Hardware_Server
+type HardwareServerService interface {
+	ActivatePrivatePort() (resp bool, err error)
+	ActivatePublicPort() (resp bool, err error)
+	AllowAccessToNetworkStorage(networkStorageTemplateObject *datatypes.Network_Storage) (resp bool, err error)
+	AllowAccessToNetworkStorageList(networkStorageTemplateObjects []datatypes.Network_Storage) (resp bool, err error)
+	BootToRescueLayer(noOsBootEnvironment *string) (resp bool, err error)
+	CaptureImage(captureTemplate *datatypes.Container_Disk_Image_Capture_Template) (resp datatypes.Virtual_Guest_Block_Device_Template_Group, err error)
+	CloseAlarm(alarmId *string) (resp bool, err error)
+	CreateFirmwareUpdateTransaction(ipmi *int, raidController *int, bios *int, harddrive *int) (resp bool, err error)
+	CreateObject(templateObject *datatypes.Hardware_Server) (resp datatypes.Hardware_Server, err error)
+	CreatePostSoftwareInstallTransaction(installCodes []string, returnBoolean *bool) (resp bool, err error)
+	DeleteObject() (resp bool, err error)
+	DeleteSoftwareComponentPasswords(softwareComponentPasswords []datatypes.Software_Component_Password) (resp bool, err error)
+	DeleteTag(tagName *string) (resp bool, err error)
+	EditObject(templateObject *datatypes.Hardware_Server) (resp bool, err error)
+	EditSoftwareComponentPasswords(softwareComponentPasswords []datatypes.Software_Component_Password) (resp bool, err error)
+	ExecuteRemoteScript(uri *string) (err error)
+	FindByIpAddress(ipAddress *string) (resp datatypes.Hardware, err error)
+	GenerateOrderTemplate(templateObject *datatypes.Hardware) (resp datatypes.Container_Product_Order, err error)
+	GetAccount() (resp datatypes.Account, err error)
+	GetAccountWith(options sl.Options) (resp datatypes.Account, err error)
+	GetActiveComponents() (resp []datatypes.Hardware_Component, err error)
+	GetActiveComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error)
+	GetActiveNetworkFirewallBillingItem() (resp datatypes.Billing_Item, err error)
+	GetActiveNetworkFirewallBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error)
+	GetActiveNetworkMonitorIncident() (resp []datatypes.Network_Monitor_Version1_Incident, err error)
+	GetActiveNetworkMonitorIncidentWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error)
+	GetActiveTickets() (resp []datatypes.Ticket, err error)
+	GetActiveTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error)
+	GetActiveTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error)
+	GetActiveTransactions() (resp []datatypes.Provisioning_Version1_Transaction, err error)
+	GetActiveTransactionsWith(options sl.Options) (resp []datatypes.Provisioning_Version1_Transaction, err error)
+	GetAlarmHistory(startDate *datatypes.Time, endDate *datatypes.Time, alarmId *string) (resp []datatypes.Container_Monitoring_Alarm_History, err error)
+	GetAllPowerComponents() (resp []datatypes.Hardware_Power_Component, err error)
+	GetAllowedHost() (resp datatypes.Network_Storage_Allowed_Host, err error)
+	GetAllowedHostWith(options sl.Options) (resp datatypes.Network_Storage_Allowed_Host, err error)
+	GetAllowedNetworkStorage() (resp []datatypes.Network_Storage, err error)
+	GetAllowedNetworkStorageReplicas() (resp []datatypes.Network_Storage, err error)
+	GetAllowedNetworkStorageReplicasWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetAllowedNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetAntivirusSpywareSoftwareComponent() (resp datatypes.Software_Component, err error)
+	GetAntivirusSpywareSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error)
+	GetAttachedNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error)
+	GetAttributes() (resp []datatypes.Hardware_Attribute, err error)
+	GetAttributesWith(options sl.Options) (resp []datatypes.Hardware_Attribute, err error)
+	GetAvailableMonitoring() (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error)
+	GetAvailableMonitoringWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error)
+	GetAvailableNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error)
+	GetAverageDailyBandwidthUsage() (resp datatypes.Float64, err error)
+	GetAverageDailyBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetAverageDailyPrivateBandwidthUsage() (resp datatypes.Float64, err error)
+	GetAverageDailyPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetAverageDailyPublicBandwidthUsage() (resp datatypes.Float64, err error)
+	GetAverageDailyPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetBackendBandwidthUsage(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error)
+	GetBackendBandwidthUse(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Network_Bandwidth_Version1_Usage_Detail, err error)
+	GetBackendIncomingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error)
+	GetBackendNetworkComponents() (resp []datatypes.Network_Component, err error)
+	GetBackendNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error)
+	GetBackendOutgoingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error)
+	GetBackendRouters() (resp []datatypes.Hardware, err error)
+	GetBackendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetBandwidthAllocation() (resp datatypes.Float64, err error)
+	GetBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetBandwidthAllotmentDetail() (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error)
+	GetBandwidthAllotmentDetailWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error)
+	GetBandwidthForDateRange(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error)
+	GetBandwidthImage(networkType *string, snapshotRange *string, draw *bool, dateSpecified *datatypes.Time, dateSpecifiedEnd *datatypes.Time) (resp datatypes.Container_Bandwidth_GraphOutputs, err error)
+	GetBenchmarkCertifications() (resp []datatypes.Hardware_Benchmark_Certification, err error)
+	GetBenchmarkCertificationsWith(options sl.Options) (resp []datatypes.Hardware_Benchmark_Certification, err error)
+	GetBillingCycleBandwidthUsage() (resp []datatypes.Network_Bandwidth_Usage, err error)
+	GetBillingCycleBandwidthUsageWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Usage, err error)
+	GetBillingCyclePrivateBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error)
+	GetBillingCyclePrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error)
+	GetBillingCyclePublicBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error)
+	GetBillingCyclePublicBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error)
+	GetBillingItem() (resp datatypes.Billing_Item_Hardware, err error)
+	GetBillingItemFlag() (resp bool, err error)
+	GetBillingItemFlagWith(options sl.Options) (resp bool, err error)
+	GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Hardware, err error)
+	GetBiosPasswordNullFlag() (resp bool, err error)
+	GetBiosPasswordNullFlagWith(options sl.Options) (resp bool, err error)
+	GetBlockCancelBecauseDisconnectedFlag() (resp bool, err error)
+	GetBlockCancelBecauseDisconnectedFlagWith(options sl.Options) (resp bool, err error)
+	GetBusinessContinuanceInsuranceFlag() (resp bool, err error)
+	GetBusinessContinuanceInsuranceFlagWith(options sl.Options) (resp bool, err error)
+	GetChildrenHardware() (resp []datatypes.Hardware, err error)
+	GetChildrenHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetComponentDetailsXML() (resp string, err error)
+	GetComponents() (resp []datatypes.Hardware_Component, err error)
+	GetComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error)
+	GetContainsSolidStateDrivesFlag() (resp bool, err error)
+	GetContinuousDataProtectionSoftwareComponent() (resp datatypes.Software_Component, err error)
+	GetContinuousDataProtectionSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error)
+	GetControlPanel() (resp datatypes.Software_Component_ControlPanel, err error)
+	GetControlPanelWith(options sl.Options) (resp datatypes.Software_Component_ControlPanel, err error)
+	GetCost() (resp datatypes.Float64, err error)
+	GetCostWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetCreateObjectOptions() (resp datatypes.Container_Hardware_Configuration, err error)
+	GetCurrentBandwidthSummary() (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error)
+	GetCurrentBandwidthSummaryWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error)
+	GetCurrentBenchmarkCertificationResultFile() (resp []byte, err error)
+	GetCurrentBillableBandwidthUsage() (resp datatypes.Float64, err error)
+	GetCurrentBillableBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetCurrentBillingDetail() (resp []datatypes.Billing_Item, err error)
+	GetCurrentBillingTotal() (resp datatypes.Float64, err error)
+	GetCustomBandwidthDataByDate(graphData *datatypes.Container_Graph) (resp datatypes.Container_Graph, err error)
+	GetCustomerInstalledOperatingSystemFlag() (resp bool, err error)
+	GetCustomerInstalledOperatingSystemFlagWith(options sl.Options) (resp bool, err error)
+	GetCustomerOwnedFlag() (resp bool, err error)
+	GetCustomerOwnedFlagWith(options sl.Options) (resp bool, err error)
+	GetDailyAverage(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error)
+	GetDatacenter() (resp datatypes.Location, err error)
+	GetDatacenterName() (resp string, err error)
+	GetDatacenterNameWith(options sl.Options) (resp string, err error)
+	GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error)
+	GetDaysInSparePool() (resp int, err error)
+	GetDaysInSparePoolWith(options sl.Options) (resp int, err error)
+	GetDownlinkHardware() (resp []datatypes.Hardware, err error)
+	GetDownlinkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetDownlinkNetworkHardware() (resp []datatypes.Hardware, err error)
+	GetDownlinkNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetDownlinkServers() (resp []datatypes.Hardware, err error)
+	GetDownlinkServersWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetDownlinkVirtualGuests() (resp []datatypes.Virtual_Guest, err error)
+	GetDownlinkVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetDownstreamHardwareBindings() (resp []datatypes.Network_Component_Uplink_Hardware, err error)
+	GetDownstreamHardwareBindingsWith(options sl.Options) (resp []datatypes.Network_Component_Uplink_Hardware, err error)
+	GetDownstreamNetworkHardware() (resp []datatypes.Hardware, err error)
+	GetDownstreamNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetDownstreamNetworkHardwareWithIncidents() (resp []datatypes.Hardware, err error)
+	GetDownstreamNetworkHardwareWithIncidentsWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetDownstreamServers() (resp []datatypes.Hardware, err error)
+	GetDownstreamServersWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetDownstreamVirtualGuests() (resp []datatypes.Virtual_Guest, err error)
+	GetDownstreamVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetDriveControllers() (resp []datatypes.Hardware_Component, err error)
+	GetDriveControllersWith(options sl.Options) (resp []datatypes.Hardware_Component, err error)
+	GetEvaultNetworkStorage() (resp []datatypes.Network_Storage, err error)
+	GetEvaultNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetFirewallProtectableSubnets() (resp []datatypes.Network_Subnet, err error)
+	GetFirewallServiceComponent() (resp datatypes.Network_Component_Firewall, err error)
+	GetFirewallServiceComponentWith(options sl.Options) (resp datatypes.Network_Component_Firewall, err error)
+	GetFixedConfigurationPreset() (resp datatypes.Product_Package_Preset, err error)
+	GetFixedConfigurationPresetWith(options sl.Options) (resp datatypes.Product_Package_Preset, err error)
+	GetFrontendBandwidthUsage(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error)
+	GetFrontendBandwidthUse(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Network_Bandwidth_Version1_Usage_Detail, err error)
+	GetFrontendIncomingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error)
+	GetFrontendNetworkComponents() (resp []datatypes.Network_Component, err error)
+	GetFrontendNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error)
+	GetFrontendOutgoingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error)
+	GetFrontendRouters() (resp []datatypes.Hardware, err error)
+	GetFrontendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetGlobalIdentifier() (resp string, err error)
+	GetGlobalIdentifierWith(options sl.Options) (resp string, err error)
+	GetHardDrives() (resp []datatypes.Hardware_Component, err error)
+	GetHardDrivesWith(options sl.Options) (resp []datatypes.Hardware_Component, err error)
+	GetHardwareByIpAddress(ipAddress *string) (resp datatypes.Hardware_Server, err error)
+	GetHardwareChassis() (resp datatypes.Hardware_Chassis, err error)
+	GetHardwareChassisWith(options sl.Options) (resp datatypes.Hardware_Chassis, err error)
+	GetHardwareFunction() (resp datatypes.Hardware_Function, err error)
+	GetHardwareFunctionDescription() (resp string, err error)
+	GetHardwareFunctionDescriptionWith(options sl.Options) (resp string, err error)
+	GetHardwareFunctionWith(options sl.Options) (resp datatypes.Hardware_Function, err error)
+	GetHardwareStatus() (resp datatypes.Hardware_Status, err error)
+	GetHardwareStatusWith(options sl.Options) (resp datatypes.Hardware_Status, err error)
+	GetHasSingleRootVirtualizationBillingItemFlag() (resp bool, err error)
+	GetHasSingleRootVirtualizationBillingItemFlagWith(options sl.Options) (resp bool, err error)
+	GetHasTrustedPlatformModuleBillingItemFlag() (resp bool, err error)
+	GetHasTrustedPlatformModuleBillingItemFlagWith(options sl.Options) (resp bool, err error)
+	GetHostIpsSoftwareComponent() (resp datatypes.Software_Component, err error)
+	GetHostIpsSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error)
+	GetHourlyBandwidth(mode *string, day *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error)
+	GetHourlyBillingFlag() (resp bool, err error)
+	GetHourlyBillingFlagWith(options sl.Options) (resp bool, err error)
+	GetInboundBandwidthUsage() (resp datatypes.Float64, err error)
+	GetInboundBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetInboundPrivateBandwidthUsage() (resp datatypes.Float64, err error)
+	GetInboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetInboundPublicBandwidthUsage() (resp datatypes.Float64, err error)
+	GetInboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetIsCloudReadyNodeCertified() (resp bool, err error)
+	GetIsCloudReadyNodeCertifiedWith(options sl.Options) (resp bool, err error)
+	GetIsIpmiDisabled() (resp bool, err error)
+	GetIsIpmiDisabledWith(options sl.Options) (resp bool, err error)
+	GetItemPricesFromSoftwareDescriptions(softwareDescriptions []datatypes.Software_Description, includeTranslationsFlag *bool, returnAllPricesFlag *bool) (resp []datatypes.Product_Item, err error)
+	GetLastOperatingSystemReload() (resp datatypes.Provisioning_Version1_Transaction, err error)
+	GetLastOperatingSystemReloadWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error)
+	GetLastTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error)
+	GetLastTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error)
+	GetLatestNetworkMonitorIncident() (resp datatypes.Network_Monitor_Version1_Incident, err error)
+	GetLatestNetworkMonitorIncidentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Incident, err error)
+	GetLocation() (resp datatypes.Location, err error)
+	GetLocationPathString() (resp string, err error)
+	GetLocationWith(options sl.Options) (resp datatypes.Location, err error)
+	GetLockboxNetworkStorage() (resp datatypes.Network_Storage, err error)
+	GetLockboxNetworkStorageWith(options sl.Options) (resp datatypes.Network_Storage, err error)
+	GetManagedResourceFlag() (resp bool, err error)
+	GetManagedResourceFlagWith(options sl.Options) (resp bool, err error)
+	GetManagementNetworkComponent() (resp datatypes.Network_Component, err error)
+	GetMemory() (resp []datatypes.Hardware_Component, err error)
+	GetMemoryCapacity() (resp uint, err error)
+	GetMemoryCapacityWith(options sl.Options) (resp uint, err error)
+	GetMemoryWith(options sl.Options) (resp []datatypes.Hardware_Component, err error)
+	GetMetricTrackingObject() (resp datatypes.Metric_Tracking_Object_HardwareServer, err error)
+	GetMetricTrackingObjectId() (resp int, err error)
+	GetMetricTrackingObjectIdWith(options sl.Options) (resp int, err error)
+	GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_HardwareServer, err error)
+	GetMonitoringActiveAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error)
+	GetMonitoringAgents() (resp []datatypes.Monitoring_Agent, err error)
+	GetMonitoringAgentsWith(options sl.Options) (resp []datatypes.Monitoring_Agent, err error)
+	GetMonitoringClosedAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error)
+	GetMonitoringRobot() (resp datatypes.Monitoring_Robot, err error)
+	GetMonitoringRobotWith(options sl.Options) (resp datatypes.Monitoring_Robot, err error)
+	GetMonitoringServiceComponent() (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error)
+	GetMonitoringServiceComponentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error)
+	GetMonitoringServiceEligibilityFlag() (resp bool, err error)
+	GetMonitoringServiceEligibilityFlagWith(options sl.Options) (resp bool, err error)
+	GetMonitoringServiceFlag() (resp bool, err error)
+	GetMonitoringServiceFlagWith(options sl.Options) (resp bool, err error)
+	GetMonitoringUserNotification() (resp []datatypes.User_Customer_Notification_Hardware, err error)
+	GetMonitoringUserNotificationWith(options sl.Options) (resp []datatypes.User_Customer_Notification_Hardware, err error)
+	GetMotherboard() (resp datatypes.Hardware_Component, err error)
+	GetMotherboardWith(options sl.Options) (resp datatypes.Hardware_Component, err error)
+	GetNetworkCards() (resp []datatypes.Hardware_Component, err error)
+	GetNetworkCardsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error)
+	GetNetworkComponentFirewallProtectableIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error)
+	GetNetworkComponents() (resp []datatypes.Network_Component, err error)
+	GetNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error)
+	GetNetworkGatewayMember() (resp datatypes.Network_Gateway_Member, err error)
+	GetNetworkGatewayMemberFlag() (resp bool, err error)
+	GetNetworkGatewayMemberFlagWith(options sl.Options) (resp bool, err error)
+	GetNetworkGatewayMemberWith(options sl.Options) (resp datatypes.Network_Gateway_Member, err error)
+	GetNetworkManagementIpAddress() (resp string, err error)
+	GetNetworkManagementIpAddressWith(options sl.Options) (resp string, err error)
+	GetNetworkMonitorAttachedDownHardware() (resp []datatypes.Hardware, err error)
+	GetNetworkMonitorAttachedDownHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetNetworkMonitorAttachedDownVirtualGuests() (resp []datatypes.Virtual_Guest, err error)
+	GetNetworkMonitorAttachedDownVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetNetworkMonitorIncidents() (resp []datatypes.Network_Monitor_Version1_Incident, err error)
+	GetNetworkMonitorIncidentsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error)
+	GetNetworkMonitors() (resp []datatypes.Network_Monitor_Version1_Query_Host, err error)
+	GetNetworkMonitorsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host, err error)
+	GetNetworkStatus() (resp string, err error)
+	GetNetworkStatusAttribute() (resp datatypes.Hardware_Attribute, err error)
+	GetNetworkStatusAttributeWith(options sl.Options) (resp datatypes.Hardware_Attribute, err error)
+	GetNetworkStatusWith(options sl.Options) (resp string, err error)
+	GetNetworkStorage() (resp []datatypes.Network_Storage, err error)
+	GetNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetNetworkVlans() (resp []datatypes.Network_Vlan, err error)
+	GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error)
+	GetNextBillingCycleBandwidthAllocation() (resp datatypes.Float64, err error)
+	GetNextBillingCycleBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetNotesHistory() (resp []datatypes.Hardware_Note, err error)
+	GetObject() (resp datatypes.Hardware_Server, err error)
+	GetOpenCancellationTicket() (resp datatypes.Ticket, err error)
+	GetOpenCancellationTicketWith(options sl.Options) (resp datatypes.Ticket, err error)
+	GetOperatingSystem() (resp datatypes.Software_Component_OperatingSystem, err error)
+	GetOperatingSystemReferenceCode() (resp string, err error)
+	GetOperatingSystemReferenceCodeWith(options sl.Options) (resp string, err error)
+	GetOperatingSystemWith(options sl.Options) (resp datatypes.Software_Component_OperatingSystem, err error)
+	GetOutboundBandwidthUsage() (resp datatypes.Float64, err error)
+	GetOutboundBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetOutboundPrivateBandwidthUsage() (resp datatypes.Float64, err error)
+	GetOutboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetOutboundPublicBandwidthUsage() (resp datatypes.Float64, err error)
+	GetOutboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetOverBandwidthAllocationFlag() (resp int, err error)
+	GetOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error)
+	GetPMInfo() (resp []datatypes.Container_RemoteManagement_PmInfo, err error)
+	GetParentBay() (resp datatypes.Hardware_Blade, err error)
+	GetParentBayWith(options sl.Options) (resp datatypes.Hardware_Blade, err error)
+	GetParentHardware() (resp datatypes.Hardware, err error)
+	GetParentHardwareWith(options sl.Options) (resp datatypes.Hardware, err error)
+	GetPointOfPresenceLocation() (resp datatypes.Location, err error)
+	GetPointOfPresenceLocationWith(options sl.Options) (resp datatypes.Location, err error)
+	GetPowerComponents() (resp []datatypes.Hardware_Power_Component, err error)
+	GetPowerComponentsWith(options sl.Options) (resp []datatypes.Hardware_Power_Component, err error)
+	GetPowerSupply() (resp []datatypes.Hardware_Component, err error)
+	GetPowerSupplyWith(options sl.Options) (resp []datatypes.Hardware_Component, err error)
+	GetPrimaryBackendIpAddress() (resp string, err error)
+	GetPrimaryBackendIpAddressWith(options sl.Options) (resp string, err error)
+	GetPrimaryBackendNetworkComponent() (resp datatypes.Network_Component, err error)
+	GetPrimaryBackendNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error)
+	GetPrimaryDriveSize() (resp int, err error)
+	GetPrimaryIpAddress() (resp string, err error)
+	GetPrimaryIpAddressWith(options sl.Options) (resp string, err error)
+	GetPrimaryNetworkComponent() (resp datatypes.Network_Component, err error)
+	GetPrimaryNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error)
+	GetPrivateBandwidthData(startTime *int, endTime *int) (resp []datatypes.Metric_Tracking_Object_Data, err error)
+	GetPrivateBandwidthDataSummary() (resp datatypes.Container_Network_Bandwidth_Data_Summary, err error)
+	GetPrivateBandwidthGraphImage(startTime *string, endTime *string) (resp []byte, err error)
+	GetPrivateIpAddress() (resp string, err error)
+	GetPrivateIpAddressWith(options sl.Options) (resp string, err error)
+	GetPrivateNetworkComponent() (resp datatypes.Network_Component, err error)
+	GetPrivateNetworkOnlyFlag() (resp bool, err error)
+	GetPrivateNetworkOnlyFlagWith(options sl.Options) (resp bool, err error)
+	GetPrivateVlan() (resp datatypes.Network_Vlan, err error)
+	GetPrivateVlanByIpAddress(ipAddress *string) (resp datatypes.Network_Vlan, err error)
+	GetProcessorCoreAmount() (resp uint, err error)
+	GetProcessorCoreAmountWith(options sl.Options) (resp uint, err error)
+	GetProcessorPhysicalCoreAmount() (resp uint, err error)
+	GetProcessorPhysicalCoreAmountWith(options sl.Options) (resp uint, err error)
+	GetProcessors() (resp []datatypes.Hardware_Component, err error)
+	GetProcessorsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error)
+	GetProjectedOverBandwidthAllocationFlag() (resp int, err error)
+	GetProjectedOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error)
+	GetProjectedPublicBandwidthUsage() (resp datatypes.Float64, err error)
+	GetProjectedPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetProvisionDate() (resp datatypes.Time, err error)
+	GetPublicBandwidthData(startTime *int, endTime *int) (resp []datatypes.Metric_Tracking_Object_Data, err error)
+	GetPublicBandwidthDataSummary() (resp datatypes.Container_Network_Bandwidth_Data_Summary, err error)
+	GetPublicBandwidthGraphImage(startTime *datatypes.Time, endTime *datatypes.Time) (resp []byte, err error)
+	GetPublicBandwidthTotal(startTime *int, endTime *int) (resp uint, err error)
+	GetPublicNetworkComponent() (resp datatypes.Network_Component, err error)
+	GetPublicVlan() (resp datatypes.Network_Vlan, err error)
+	GetPublicVlanByHostname(hostname *string) (resp datatypes.Network_Vlan, err error)
+	GetRack() (resp datatypes.Location, err error)
+	GetRaidControllers() (resp []datatypes.Hardware_Component, err error)
+	GetRaidControllersWith(options sl.Options) (resp []datatypes.Hardware_Component, err error)
+	GetReadyNodeFlag() (resp bool, err error)
+	GetReadyNodeFlagWith(options sl.Options) (resp bool, err error)
+	GetRecentEvents() (resp []datatypes.Notification_Occurrence_Event, err error)
+	GetRecentEventsWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Event, err error)
+	GetRecentRemoteManagementCommands() (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error)
+	GetRecentRemoteManagementCommandsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error)
+	GetRegionalInternetRegistry() (resp datatypes.Network_Regional_Internet_Registry, err error)
+	GetRemoteManagement() (resp datatypes.Hardware_Component_RemoteManagement, err error)
+	GetRemoteManagementAccounts() (resp []datatypes.Hardware_Component_RemoteManagement_User, err error)
+	GetRemoteManagementAccountsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_User, err error)
+	GetRemoteManagementComponent() (resp datatypes.Network_Component, err error)
+	GetRemoteManagementComponentWith(options sl.Options) (resp datatypes.Network_Component, err error)
+	GetRemoteManagementUsers() (resp []datatypes.Hardware_Component_RemoteManagement_User, err error)
+	GetRemoteManagementUsersWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_User, err error)
+	GetRemoteManagementWith(options sl.Options) (resp datatypes.Hardware_Component_RemoteManagement, err error)
+	GetResourceConfigurations() (resp []datatypes.Hardware_Resource_Configuration, err error)
+	GetResourceGroupMemberReferences() (resp []datatypes.Resource_Group_Member, err error)
+	GetResourceGroupRoles() (resp []datatypes.Resource_Group_Role, err error)
+	GetResourceGroups() (resp []datatypes.Resource_Group, err error)
+	GetResourceGroupsWith(options sl.Options) (resp []datatypes.Resource_Group, err error)
+	GetReverseDomainRecords() (resp []datatypes.Dns_Domain, err error)
+	GetRouters() (resp []datatypes.Hardware, err error)
+	GetRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetScaleAssets() (resp []datatypes.Scale_Asset, err error)
+	GetScaleAssetsWith(options sl.Options) (resp []datatypes.Scale_Asset, err error)
+	GetSecurityScanRequests() (resp []datatypes.Network_Security_Scanner_Request, err error)
+	GetSecurityScanRequestsWith(options sl.Options) (resp []datatypes.Network_Security_Scanner_Request, err error)
+	GetSensorData() (resp []datatypes.Container_RemoteManagement_SensorReading, err error)
+	GetSensorDataWithGraphs() (resp datatypes.Container_RemoteManagement_SensorReadingsWithGraphs, err error)
+	GetServerDetails() (resp datatypes.Container_Hardware_Server_Details, err error)
+	GetServerFanSpeedGraphs() (resp []datatypes.Container_RemoteManagement_Graphs_SensorSpeed, err error)
+	GetServerPowerState() (resp string, err error)
+	GetServerRoom() (resp datatypes.Location, err error)
+	GetServerRoomWith(options sl.Options) (resp datatypes.Location, err error)
+	GetServerTemperatureGraphs() (resp []datatypes.Container_RemoteManagement_Graphs_SensorTemperature, err error)
+	GetServiceProvider() (resp datatypes.Service_Provider, err error)
+	GetServiceProviderWith(options sl.Options) (resp datatypes.Service_Provider, err error)
+	GetSoftwareComponents() (resp []datatypes.Software_Component, err error)
+	GetSoftwareComponentsWith(options sl.Options) (resp []datatypes.Software_Component, err error)
+	GetSoftwareGuardExtensionEnabled() (resp bool, err error)
+	GetSoftwareGuardExtensionEnabledWith(options sl.Options) (resp bool, err error)
+	GetSparePoolBillingItem() (resp datatypes.Billing_Item_Hardware, err error)
+	GetSparePoolBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Hardware, err error)
+	GetSshKeys() (resp []datatypes.Security_Ssh_Key, err error)
+	GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error)
+	GetStatisticsRemoteManagement() (resp datatypes.Hardware_Component_RemoteManagement, err error)
+	GetStatisticsRemoteManagementWith(options sl.Options) (resp datatypes.Hardware_Component_RemoteManagement, err error)
+	GetStorageNetworkComponents() (resp []datatypes.Network_Component, err error)
+	GetTagReferences() (resp []datatypes.Tag_Reference, err error)
+	GetTopLevelLocation() (resp datatypes.Location, err error)
+	GetTransactionHistory() (resp []datatypes.Provisioning_Version1_Transaction_History, err error)
+	GetUefiBootFlag() (resp bool, err error)
+	GetUefiBootFlagWith(options sl.Options) (resp bool, err error)
+	GetUpgradeItemPrices() (resp []datatypes.Product_Item_Price, err error)
+	GetUpgradeRequest() (resp datatypes.Product_Upgrade_Request, err error)
+	GetUpgradeRequestWith(options sl.Options) (resp datatypes.Product_Upgrade_Request, err error)
+	GetUplinkHardware() (resp datatypes.Hardware, err error)
+	GetUplinkHardwareWith(options sl.Options) (resp datatypes.Hardware, err error)
+	GetUplinkNetworkComponents() (resp []datatypes.Network_Component, err error)
+	GetUplinkNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error)
+	GetUserData() (resp []datatypes.Hardware_Attribute, err error)
+	GetUserDataWith(options sl.Options) (resp []datatypes.Hardware_Attribute, err error)
+	GetUsers() (resp []datatypes.User_Customer, err error)
+	GetUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error)
+	GetValidBlockDeviceTemplateGroups(visibility *string) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error)
+	GetVirtualChassis() (resp datatypes.Hardware_Group, err error)
+	GetVirtualChassisSiblings() (resp []datatypes.Hardware, err error)
+	GetVirtualChassisSiblingsWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetVirtualChassisWith(options sl.Options) (resp datatypes.Hardware_Group, err error)
+	GetVirtualGuests() (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error)
+	GetVirtualHost() (resp datatypes.Virtual_Host, err error)
+	GetVirtualHostWith(options sl.Options) (resp datatypes.Virtual_Host, err error)
+	GetVirtualLicenses() (resp []datatypes.Software_VirtualLicense, err error)
+	GetVirtualLicensesWith(options sl.Options) (resp []datatypes.Software_VirtualLicense, err error)
+	GetVirtualRack() (resp datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetVirtualRackId() (resp int, err error)
+	GetVirtualRackIdWith(options sl.Options) (resp int, err error)
+	GetVirtualRackName() (resp string, err error)
+	GetVirtualRackNameWith(options sl.Options) (resp string, err error)
+	GetVirtualRackWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetVirtualizationPlatform() (resp datatypes.Software_Component, err error)
+	GetVirtualizationPlatformWith(options sl.Options) (resp datatypes.Software_Component, err error)
+	GetWindowsUpdateAvailableUpdates() (resp []datatypes.Container_Utility_Microsoft_Windows_UpdateServices_UpdateItem, err error)
+	GetWindowsUpdateInstalledUpdates() (resp []datatypes.Container_Utility_Microsoft_Windows_UpdateServices_UpdateItem, err error)
+	GetWindowsUpdateStatus() (resp datatypes.Container_Utility_Microsoft_Windows_UpdateServices_Status, err error)
+	ImportVirtualHost() (resp datatypes.Virtual_Host, err error)
+	InitiateIderaBareMetalRestore() (resp bool, err error)
+	InitiateR1SoftBareMetalRestore() (resp bool, err error)
+	IsBackendPingable() (resp bool, err error)
+	IsPingable() (resp bool, err error)
+	IsWindowsServer() (resp bool, err error)
+	MassFirmwareUpdate(hardwareIds []int, ipmi *bool, raidController *bool, bios *bool, harddrive *bool) (resp []datatypes.Container_Hardware_Server_Request, err error)
+	MassReloadOperatingSystem(hardwareIds []string, token *string, config *datatypes.Container_Hardware_Server_Configuration) (resp string, err error)
+	MassSparePool(hardwareIds []string, action *string, newOrder *bool) (resp []datatypes.Container_Hardware_Server_Request, err error)
+	Ping() (resp string, err error)
+	PopulateServerRam(ramSerialString *string) (err error)
+	PowerCycle() (resp bool, err error)
+	PowerOff() (resp bool, err error)
+	PowerOn() (resp bool, err error)
+	RebootDefault() (resp bool, err error)
+	RebootHard() (resp bool, err error)
+	RebootSoft() (resp bool, err error)
+	ReloadCurrentOperatingSystemConfiguration(token *string) (resp string, err error)
+	ReloadOperatingSystem(token *string, config *datatypes.Container_Hardware_Server_Configuration) (resp string, err error)
+	RemoveAccessToNetworkStorage(networkStorageTemplateObject *datatypes.Network_Storage) (resp bool, err error)
+	RemoveAccessToNetworkStorageList(networkStorageTemplateObjects []datatypes.Network_Storage) (resp bool, err error)
+	RemoveTags(tags *string) (resp bool, err error)
+	RunPassmarkCertificationBenchmark() (resp bool, err error)
+	SetOperatingSystemPassword(newPassword *string) (resp bool, err error)
+	SetPrivateNetworkInterfaceSpeed(newSpeed *int) (resp bool, err error)
+	SetPublicNetworkInterfaceSpeed(newSpeed *int) (resp bool, err error)
+	SetTags(tags *string) (resp bool, err error)
+	SetUserMetadata(metadata []string) (resp []datatypes.Hardware_Attribute, err error)
+	ShutdownPrivatePort() (resp bool, err error)
+	ShutdownPublicPort() (resp bool, err error)
+	SparePool(action *string, newOrder *bool) (resp bool, err error)
+	ToggleManagementInterface(enabled *bool) (resp bool, err error)
+	UpdateIpmiPassword(password *string) (resp bool, err error)
+	ValidatePartitionsForOperatingSystem(operatingSystem *datatypes.Software_Description, partitions []datatypes.Hardware_Component_Partition) (resp bool, err error)
+}
+
+var _ HardwareServerService = services.Hardware_Server{}
+var _ HardwareServerService = Hardware_Server{}
+
+// Hardware_Server is a recording fake of services.Hardware_Server. Its copies, returned
+// by the fluent methods, share its Recorder.
+type Hardware_Server struct {
+	*Recorder
+	Options sl.Options
+}
+
+// NewHardwareServer returns a new fake of services.Hardware_Server
+func NewHardwareServer() Hardware_Server {
+	return Hardware_Server{Recorder: NewRecorder()}
+}
+
+func (r Hardware_Server) Id(id int) Hardware_Server {
+	r.Options.Id = &id
+	return r
+}
+
+func (r Hardware_Server) InitParameter(name string, value interface{}) Hardware_Server {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
+func (r Hardware_Server) GlobalID(globalID string) Hardware_Server {
+	r.Options.GlobalID = &globalID
+	return r
+}
+
+func (r Hardware_Server) Mask(mask string) Hardware_Server {
+	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
+		mask = fmt.Sprintf("mask[%s]", mask)
+	}
+
+	r.Options.Mask = mask
+	return r
+}
+
+func (r Hardware_Server) Filter(filter string) Hardware_Server {
+	r.Options.Filter = filter
+	return r
+}
+
+func (r Hardware_Server) Limit(limit int) Hardware_Server {
+	r.Options.Limit = &limit
+	return r
+}
+
+func (r Hardware_Server) Unlimited() Hardware_Server {
+	r.Options.Unlimited = true
+	return r
+}
+
+func (r Hardware_Server) Offset(offset int) Hardware_Server {
+	r.Options.Offset = &offset
+	return r
+}
+
+func (r Hardware_Server) Timeout(timeout time.Duration) Hardware_Server {
+	r.Options.Timeout = timeout
+	return r
+}
+
+// WithContext is accepted for compatibility with the service; fakes do not
+// use the context
+func (r Hardware_Server) WithContext(ctx context.Context) Hardware_Server {
+	return r
+}
+
+func (r Hardware_Server) ActivatePrivatePort() (resp bool, err error) {
+	err = r.record("ActivatePrivatePort", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) ActivatePublicPort() (resp bool, err error) {
+	err = r.record("ActivatePublicPort", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) AllowAccessToNetworkStorage(networkStorageTemplateObject *datatypes.Network_Storage) (resp bool, err error) {
+	err = r.record("AllowAccessToNetworkStorage", []interface{}{networkStorageTemplateObject}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) AllowAccessToNetworkStorageList(networkStorageTemplateObjects []datatypes.Network_Storage) (resp bool, err error) {
+	err = r.record("AllowAccessToNetworkStorageList", []interface{}{networkStorageTemplateObjects}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) BootToRescueLayer(noOsBootEnvironment *string) (resp bool, err error) {
+	err = r.record("BootToRescueLayer", []interface{}{noOsBootEnvironment}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) CaptureImage(captureTemplate *datatypes.Container_Disk_Image_Capture_Template) (resp datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	err = r.record("CaptureImage", []interface{}{captureTemplate}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) CloseAlarm(alarmId *string) (resp bool, err error) {
+	err = r.record("CloseAlarm", []interface{}{alarmId}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) CreateFirmwareUpdateTransaction(ipmi *int, raidController *int, bios *int, harddrive *int) (resp bool, err error) {
+	err = r.record("CreateFirmwareUpdateTransaction", []interface{}{ipmi, raidController, bios, harddrive}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) CreateObject(templateObject *datatypes.Hardware_Server) (resp datatypes.Hardware_Server, err error) {
+	err = r.record("CreateObject", []interface{}{templateObject}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) CreatePostSoftwareInstallTransaction(installCodes []string, returnBoolean *bool) (resp bool, err error) {
+	err = r.record("CreatePostSoftwareInstallTransaction", []interface{}{installCodes, returnBoolean}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) DeleteObject() (resp bool, err error) {
+	err = r.record("DeleteObject", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) DeleteSoftwareComponentPasswords(softwareComponentPasswords []datatypes.Software_Component_Password) (resp bool, err error) {
+	err = r.record("DeleteSoftwareComponentPasswords", []interface{}{softwareComponentPasswords}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) DeleteTag(tagName *string) (resp bool, err error) {
+	err = r.record("DeleteTag", []interface{}{tagName}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) EditObject(templateObject *datatypes.Hardware_Server) (resp bool, err error) {
+	err = r.record("EditObject", []interface{}{templateObject}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) EditSoftwareComponentPasswords(softwareComponentPasswords []datatypes.Software_Component_Password) (resp bool, err error) {
+	err = r.record("EditSoftwareComponentPasswords", []interface{}{softwareComponentPasswords}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) ExecuteRemoteScript(uri *string) (err error) {
+	err = r.record("ExecuteRemoteScript", []interface{}{uri}, r.Options, nil)
+	return
+
+}
+
+func (r Hardware_Server) FindByIpAddress(ipAddress *string) (resp datatypes.Hardware, err error) {
+	err = r.record("FindByIpAddress", []interface{}{ipAddress}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GenerateOrderTemplate(templateObject *datatypes.Hardware) (resp datatypes.Container_Product_Order, err error) {
+	err = r.record("GenerateOrderTemplate", []interface{}{templateObject}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAccount() (resp datatypes.Account, err error) {
+	err = r.record("GetAccount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+
+}
+
+func (r Hardware_Server) GetActiveComponents() (resp []datatypes.Hardware_Component, err error) {
+	err = r.record("GetActiveComponents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetActiveComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveComponents()
+
+}
+
+func (r Hardware_Server) GetActiveNetworkFirewallBillingItem() (resp datatypes.Billing_Item, err error) {
+	err = r.record("GetActiveNetworkFirewallBillingItem", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetActiveNetworkFirewallBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveNetworkFirewallBillingItem()
+
+}
+
+func (r Hardware_Server) GetActiveNetworkMonitorIncident() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	err = r.record("GetActiveNetworkMonitorIncident", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetActiveNetworkMonitorIncidentWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveNetworkMonitorIncident()
+
+}
+
+func (r Hardware_Server) GetActiveTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetActiveTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetActiveTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("GetActiveTransaction", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetActiveTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransaction()
+
+}
+
+func (r Hardware_Server) GetActiveTransactions() (resp []datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("GetActiveTransactions", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetActiveTransactionsWith(options sl.Options) (resp []datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransactions()
+
+}
+
+func (r Hardware_Server) GetAlarmHistory(startDate *datatypes.Time, endDate *datatypes.Time, alarmId *string) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
+	err = r.record("GetAlarmHistory", []interface{}{startDate, endDate, alarmId}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAllPowerComponents() (resp []datatypes.Hardware_Power_Component, err error) {
+	err = r.record("GetAllPowerComponents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAllowedHost() (resp datatypes.Network_Storage_Allowed_Host, err error) {
+	err = r.record("GetAllowedHost", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAllowedHostWith(options sl.Options) (resp datatypes.Network_Storage_Allowed_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHost()
+
+}
+
+func (r Hardware_Server) GetAllowedNetworkStorage() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetAllowedNetworkStorage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAllowedNetworkStorageReplicas() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetAllowedNetworkStorageReplicas", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAllowedNetworkStorageReplicasWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorageReplicas()
+
+}
+
+func (r Hardware_Server) GetAllowedNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorage()
+
+}
+
+func (r Hardware_Server) GetAntivirusSpywareSoftwareComponent() (resp datatypes.Software_Component, err error) {
+	err = r.record("GetAntivirusSpywareSoftwareComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAntivirusSpywareSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAntivirusSpywareSoftwareComponent()
+
+}
+
+func (r Hardware_Server) GetAttachedNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetAttachedNetworkStorages", []interface{}{nasType}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAttributes() (resp []datatypes.Hardware_Attribute, err error) {
+	err = r.record("GetAttributes", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAttributesWith(options sl.Options) (resp []datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+
+}
+
+func (r Hardware_Server) GetAvailableMonitoring() (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	err = r.record("GetAvailableMonitoring", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAvailableMonitoringWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAvailableMonitoring()
+
+}
+
+func (r Hardware_Server) GetAvailableNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetAvailableNetworkStorages", []interface{}{nasType}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAverageDailyBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetAverageDailyBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAverageDailyBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyBandwidthUsage()
+
+}
+
+func (r Hardware_Server) GetAverageDailyPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetAverageDailyPrivateBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAverageDailyPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPrivateBandwidthUsage()
+
+}
+
+func (r Hardware_Server) GetAverageDailyPublicBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetAverageDailyPublicBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetAverageDailyPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPublicBandwidthUsage()
+
+}
+
+func (r Hardware_Server) GetBackendBandwidthUsage(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
+	err = r.record("GetBackendBandwidthUsage", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBackendBandwidthUse(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Network_Bandwidth_Version1_Usage_Detail, err error) {
+	err = r.record("GetBackendBandwidthUse", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBackendIncomingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
+	err = r.record("GetBackendIncomingBandwidth", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBackendNetworkComponents() (resp []datatypes.Network_Component, err error) {
+	err = r.record("GetBackendNetworkComponents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBackendNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendNetworkComponents()
+
+}
+
+func (r Hardware_Server) GetBackendOutgoingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
+	err = r.record("GetBackendOutgoingBandwidth", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBackendRouters() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetBackendRouters", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBackendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendRouters()
+
+}
+
+func (r Hardware_Server) GetBandwidthAllocation() (resp datatypes.Float64, err error) {
+	err = r.record("GetBandwidthAllocation", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllocation()
+
+}
+
+func (r Hardware_Server) GetBandwidthAllotmentDetail() (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
+	err = r.record("GetBandwidthAllotmentDetail", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBandwidthAllotmentDetailWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotmentDetail()
+
+}
+
+func (r Hardware_Server) GetBandwidthForDateRange(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
+	err = r.record("GetBandwidthForDateRange", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBandwidthImage(networkType *string, snapshotRange *string, draw *bool, dateSpecified *datatypes.Time, dateSpecifiedEnd *datatypes.Time) (resp datatypes.Container_Bandwidth_GraphOutputs, err error) {
+	err = r.record("GetBandwidthImage", []interface{}{networkType, snapshotRange, draw, dateSpecified, dateSpecifiedEnd}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBenchmarkCertifications() (resp []datatypes.Hardware_Benchmark_Certification, err error) {
+	err = r.record("GetBenchmarkCertifications", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBenchmarkCertificationsWith(options sl.Options) (resp []datatypes.Hardware_Benchmark_Certification, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBenchmarkCertifications()
+
+}
+
+func (r Hardware_Server) GetBillingCycleBandwidthUsage() (resp []datatypes.Network_Bandwidth_Usage, err error) {
+	err = r.record("GetBillingCycleBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBillingCycleBandwidthUsageWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCycleBandwidthUsage()
+
+}
+
+func (r Hardware_Server) GetBillingCyclePrivateBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
+	err = r.record("GetBillingCyclePrivateBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBillingCyclePrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePrivateBandwidthUsage()
+
+}
+
+func (r Hardware_Server) GetBillingCyclePublicBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
+	err = r.record("GetBillingCyclePublicBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBillingCyclePublicBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePublicBandwidthUsage()
+
+}
+
+func (r Hardware_Server) GetBillingItem() (resp datatypes.Billing_Item_Hardware, err error) {
+	err = r.record("GetBillingItem", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBillingItemFlag() (resp bool, err error) {
+	err = r.record("GetBillingItemFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItemFlag()
+
+}
+
+func (r Hardware_Server) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+
+}
+
+func (r Hardware_Server) GetBiosPasswordNullFlag() (resp bool, err error) {
+	err = r.record("GetBiosPasswordNullFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBiosPasswordNullFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBiosPasswordNullFlag()
+
+}
+
+func (r Hardware_Server) GetBlockCancelBecauseDisconnectedFlag() (resp bool, err error) {
+	err = r.record("GetBlockCancelBecauseDisconnectedFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBlockCancelBecauseDisconnectedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockCancelBecauseDisconnectedFlag()
+
+}
+
+func (r Hardware_Server) GetBusinessContinuanceInsuranceFlag() (resp bool, err error) {
+	err = r.record("GetBusinessContinuanceInsuranceFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetBusinessContinuanceInsuranceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBusinessContinuanceInsuranceFlag()
+
+}
+
+func (r Hardware_Server) GetChildrenHardware() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetChildrenHardware", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetChildrenHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildrenHardware()
+
+}
+
+func (r Hardware_Server) GetComponentDetailsXML() (resp string, err error) {
+	err = r.record("GetComponentDetailsXML", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetComponents() (resp []datatypes.Hardware_Component, err error) {
+	err = r.record("GetComponents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetComponents()
+
+}
+
+func (r Hardware_Server) GetContainsSolidStateDrivesFlag() (resp bool, err error) {
+	err = r.record("GetContainsSolidStateDrivesFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetContinuousDataProtectionSoftwareComponent() (resp datatypes.Software_Component, err error) {
+	err = r.record("GetContinuousDataProtectionSoftwareComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetContinuousDataProtectionSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetContinuousDataProtectionSoftwareComponent()
+
+}
+
+func (r Hardware_Server) GetControlPanel() (resp datatypes.Software_Component_ControlPanel, err error) {
+	err = r.record("GetControlPanel", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetControlPanelWith(options sl.Options) (resp datatypes.Software_Component_ControlPanel, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetControlPanel()
+
+}
+
+func (r Hardware_Server) GetCost() (resp datatypes.Float64, err error) {
+	err = r.record("GetCost", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetCostWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCost()
+
+}
+
+func (r Hardware_Server) GetCreateObjectOptions() (resp datatypes.Container_Hardware_Configuration, err error) {
+	err = r.record("GetCreateObjectOptions", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetCurrentBandwidthSummary() (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error) {
+	err = r.record("GetCurrentBandwidthSummary", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetCurrentBandwidthSummaryWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentBandwidthSummary()
+
+}
+
+func (r Hardware_Server) GetCurrentBenchmarkCertificationResultFile() (resp []byte, err error) {
+	err = r.record("GetCurrentBenchmarkCertificationResultFile", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetCurrentBillableBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetCurrentBillableBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetCurrentBillableBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentBillableBandwidthUsage()
+
+}
+
+func (r Hardware_Server) GetCurrentBillingDetail() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetCurrentBillingDetail", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetCurrentBillingTotal() (resp datatypes.Float64, err error) {
+	err = r.record("GetCurrentBillingTotal", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetCustomBandwidthDataByDate(graphData *datatypes.Container_Graph) (resp datatypes.Container_Graph, err error) {
+	err = r.record("GetCustomBandwidthDataByDate", []interface{}{graphData}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetCustomerInstalledOperatingSystemFlag() (resp bool, err error) {
+	err = r.record("GetCustomerInstalledOperatingSystemFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetCustomerInstalledOperatingSystemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCustomerInstalledOperatingSystemFlag()
+
+}
+
+func (r Hardware_Server) GetCustomerOwnedFlag() (resp bool, err error) {
+	err = r.record("GetCustomerOwnedFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetCustomerOwnedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCustomerOwnedFlag()
+
+}
+
+func (r Hardware_Server) GetDailyAverage(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
+	err = r.record("GetDailyAverage", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetDatacenter() (resp datatypes.Location, err error) {
+	err = r.record("GetDatacenter", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetDatacenterName() (resp string, err error) {
+	err = r.record("GetDatacenterName", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetDatacenterNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenterName()
+
+}
+
+func (r Hardware_Server) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+
+}
+
+func (r Hardware_Server) GetDaysInSparePool() (resp int, err error) {
+	err = r.record("GetDaysInSparePool", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetDaysInSparePoolWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDaysInSparePool()
+
+}
+
+func (r Hardware_Server) GetDownlinkHardware() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetDownlinkHardware", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetDownlinkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkHardware()
+
+}
+
+func (r Hardware_Server) GetDownlinkNetworkHardware() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetDownlinkNetworkHardware", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetDownlinkNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkNetworkHardware()
+
+}
+
+func (r Hardware_Server) GetDownlinkServers() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetDownlinkServers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetDownlinkServersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkServers()
+
+}
+
+func (r Hardware_Server) GetDownlinkVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetDownlinkVirtualGuests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetDownlinkVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkVirtualGuests()
+
+}
+
+func (r Hardware_Server) GetDownstreamHardwareBindings() (resp []datatypes.Network_Component_Uplink_Hardware, err error) {
+	err = r.record("GetDownstreamHardwareBindings", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetDownstreamHardwareBindingsWith(options sl.Options) (resp []datatypes.Network_Component_Uplink_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamHardwareBindings()
+
+}
+
+func (r Hardware_Server) GetDownstreamNetworkHardware() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetDownstreamNetworkHardware", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetDownstreamNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamNetworkHardware()
+
+}
+
+func (r Hardware_Server) GetDownstreamNetworkHardwareWithIncidents() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetDownstreamNetworkHardwareWithIncidents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetDownstreamNetworkHardwareWithIncidentsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamNetworkHardwareWithIncidents()
+
+}
+
+func (r Hardware_Server) GetDownstreamServers() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetDownstreamServers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetDownstreamServersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamServers()
+
+}
+
+func (r Hardware_Server) GetDownstreamVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetDownstreamVirtualGuests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetDownstreamVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamVirtualGuests()
+
+}
+
+func (r Hardware_Server) GetDriveControllers() (resp []datatypes.Hardware_Component, err error) {
+	err = r.record("GetDriveControllers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetDriveControllersWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDriveControllers()
+
+}
+
+func (r Hardware_Server) GetEvaultNetworkStorage() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetEvaultNetworkStorage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetEvaultNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvaultNetworkStorage()
+
+}
+
+func (r Hardware_Server) GetFirewallProtectableSubnets() (resp []datatypes.Network_Subnet, err error) {
+	err = r.record("GetFirewallProtectableSubnets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetFirewallServiceComponent() (resp datatypes.Network_Component_Firewall, err error) {
+	err = r.record("GetFirewallServiceComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetFirewallServiceComponentWith(options sl.Options) (resp datatypes.Network_Component_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirewallServiceComponent()
+
+}
+
+func (r Hardware_Server) GetFixedConfigurationPreset() (resp datatypes.Product_Package_Preset, err error) {
+	err = r.record("GetFixedConfigurationPreset", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetFixedConfigurationPresetWith(options sl.Options) (resp datatypes.Product_Package_Preset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFixedConfigurationPreset()
+
+}
+
+func (r Hardware_Server) GetFrontendBandwidthUsage(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
+	err = r.record("GetFrontendBandwidthUsage", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetFrontendBandwidthUse(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Network_Bandwidth_Version1_Usage_Detail, err error) {
+	err = r.record("GetFrontendBandwidthUse", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetFrontendIncomingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
+	err = r.record("GetFrontendIncomingBandwidth", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetFrontendNetworkComponents() (resp []datatypes.Network_Component, err error) {
+	err = r.record("GetFrontendNetworkComponents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetFrontendNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendNetworkComponents()
+
+}
+
+func (r Hardware_Server) GetFrontendOutgoingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
+	err = r.record("GetFrontendOutgoingBandwidth", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetFrontendRouters() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetFrontendRouters", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetFrontendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendRouters()
+
+}
+
+func (r Hardware_Server) GetGlobalIdentifier() (resp string, err error) {
+	err = r.record("GetGlobalIdentifier", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetGlobalIdentifierWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGlobalIdentifier()
+
+}
+
+func (r Hardware_Server) GetHardDrives() (resp []datatypes.Hardware_Component, err error) {
+	err = r.record("GetHardDrives", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetHardDrivesWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardDrives()
+
+}
+
+func (r Hardware_Server) GetHardwareByIpAddress(ipAddress *string) (resp datatypes.Hardware_Server, err error) {
+	err = r.record("GetHardwareByIpAddress", []interface{}{ipAddress}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetHardwareChassis() (resp datatypes.Hardware_Chassis, err error) {
+	err = r.record("GetHardwareChassis", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetHardwareChassisWith(options sl.Options) (resp datatypes.Hardware_Chassis, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareChassis()
+
+}
+
+func (r Hardware_Server) GetHardwareFunction() (resp datatypes.Hardware_Function, err error) {
+	err = r.record("GetHardwareFunction", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetHardwareFunctionDescription() (resp string, err error) {
+	err = r.record("GetHardwareFunctionDescription", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetHardwareFunctionDescriptionWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareFunctionDescription()
+
+}
+
+func (r Hardware_Server) GetHardwareFunctionWith(options sl.Options) (resp datatypes.Hardware_Function, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareFunction()
+
+}
+
+func (r Hardware_Server) GetHardwareStatus() (resp datatypes.Hardware_Status, err error) {
+	err = r.record("GetHardwareStatus", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetHardwareStatusWith(options sl.Options) (resp datatypes.Hardware_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareStatus()
+
+}
+
+func (r Hardware_Server) GetHasSingleRootVirtualizationBillingItemFlag() (resp bool, err error) {
+	err = r.record("GetHasSingleRootVirtualizationBillingItemFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetHasSingleRootVirtualizationBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasSingleRootVirtualizationBillingItemFlag()
+
+}
+
+func (r Hardware_Server) GetHasTrustedPlatformModuleBillingItemFlag() (resp bool, err error) {
+	err = r.record("GetHasTrustedPlatformModuleBillingItemFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetHasTrustedPlatformModuleBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasTrustedPlatformModuleBillingItemFlag()
+
+}
+
+func (r Hardware_Server) GetHostIpsSoftwareComponent() (resp datatypes.Software_Component, err error) {
+	err = r.record("GetHostIpsSoftwareComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetHostIpsSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHostIpsSoftwareComponent()
+
+}
+
+func (r Hardware_Server) GetHourlyBandwidth(mode *string, day *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
+	err = r.record("GetHourlyBandwidth", []interface{}{mode, day}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetHourlyBillingFlag() (resp bool, err error) {
+	err = r.record("GetHourlyBillingFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetHourlyBillingFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyBillingFlag()
+
+}
+
+func (r Hardware_Server) GetInboundBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetInboundBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetInboundBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundBandwidthUsage()
+
+}
+
+func (r Hardware_Server) GetInboundPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetInboundPrivateBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetInboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPrivateBandwidthUsage()
+
+}
+
+func (r Hardware_Server) GetInboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetInboundPublicBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetInboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPublicBandwidthUsage()
+
+}
+
+func (r Hardware_Server) GetIsCloudReadyNodeCertified() (resp bool, err error) {
+	err = r.record("GetIsCloudReadyNodeCertified", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetIsCloudReadyNodeCertifiedWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsCloudReadyNodeCertified()
+
+}
+
+func (r Hardware_Server) GetIsIpmiDisabled() (resp bool, err error) {
+	err = r.record("GetIsIpmiDisabled", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetIsIpmiDisabledWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsIpmiDisabled()
+
+}
+
+func (r Hardware_Server) GetItemPricesFromSoftwareDescriptions(softwareDescriptions []datatypes.Software_Description, includeTranslationsFlag *bool, returnAllPricesFlag *bool) (resp []datatypes.Product_Item, err error) {
+	err = r.record("GetItemPricesFromSoftwareDescriptions", []interface{}{softwareDescriptions, includeTranslationsFlag, returnAllPricesFlag}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetLastOperatingSystemReload() (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("GetLastOperatingSystemReload", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetLastOperatingSystemReloadWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastOperatingSystemReload()
+
+}
+
+func (r Hardware_Server) GetLastTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("GetLastTransaction", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetLastTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastTransaction()
+
+}
+
+func (r Hardware_Server) GetLatestNetworkMonitorIncident() (resp datatypes.Network_Monitor_Version1_Incident, err error) {
+	err = r.record("GetLatestNetworkMonitorIncident", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetLatestNetworkMonitorIncidentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestNetworkMonitorIncident()
+
+}
+
+func (r Hardware_Server) GetLocation() (resp datatypes.Location, err error) {
+	err = r.record("GetLocation", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetLocationPathString() (resp string, err error) {
+	err = r.record("GetLocationPathString", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+
+}
+
+func (r Hardware_Server) GetLockboxNetworkStorage() (resp datatypes.Network_Storage, err error) {
+	err = r.record("GetLockboxNetworkStorage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetLockboxNetworkStorageWith(options sl.Options) (resp datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLockboxNetworkStorage()
+
+}
+
+func (r Hardware_Server) GetManagedResourceFlag() (resp bool, err error) {
+	err = r.record("GetManagedResourceFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetManagedResourceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedResourceFlag()
+
+}
+
+func (r Hardware_Server) GetManagementNetworkComponent() (resp datatypes.Network_Component, err error) {
+	err = r.record("GetManagementNetworkComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetMemory() (resp []datatypes.Hardware_Component, err error) {
+	err = r.record("GetMemory", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetMemoryCapacity() (resp uint, err error) {
+	err = r.record("GetMemoryCapacity", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetMemoryCapacityWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMemoryCapacity()
+
+}
+
+func (r Hardware_Server) GetMemoryWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMemory()
+
+}
+
+func (r Hardware_Server) GetMetricTrackingObject() (resp datatypes.Metric_Tracking_Object_HardwareServer, err error) {
+	err = r.record("GetMetricTrackingObject", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetMetricTrackingObjectId() (resp int, err error) {
+	err = r.record("GetMetricTrackingObjectId", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetMetricTrackingObjectIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObjectId()
+
+}
+
+func (r Hardware_Server) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_HardwareServer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+
+}
+
+func (r Hardware_Server) GetMonitoringActiveAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
+	err = r.record("GetMonitoringActiveAlarms", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetMonitoringAgents() (resp []datatypes.Monitoring_Agent, err error) {
+	err = r.record("GetMonitoringAgents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetMonitoringAgentsWith(options sl.Options) (resp []datatypes.Monitoring_Agent, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringAgents()
+
+}
+
+func (r Hardware_Server) GetMonitoringClosedAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
+	err = r.record("GetMonitoringClosedAlarms", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetMonitoringRobot() (resp datatypes.Monitoring_Robot, err error) {
+	err = r.record("GetMonitoringRobot", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetMonitoringRobotWith(options sl.Options) (resp datatypes.Monitoring_Robot, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringRobot()
+
+}
+
+func (r Hardware_Server) GetMonitoringServiceComponent() (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	err = r.record("GetMonitoringServiceComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetMonitoringServiceComponentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceComponent()
+
+}
+
+func (r Hardware_Server) GetMonitoringServiceEligibilityFlag() (resp bool, err error) {
+	err = r.record("GetMonitoringServiceEligibilityFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetMonitoringServiceEligibilityFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceEligibilityFlag()
+
+}
+
+func (r Hardware_Server) GetMonitoringServiceFlag() (resp bool, err error) {
+	err = r.record("GetMonitoringServiceFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetMonitoringServiceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceFlag()
+
+}
+
+func (r Hardware_Server) GetMonitoringUserNotification() (resp []datatypes.User_Customer_Notification_Hardware, err error) {
+	err = r.record("GetMonitoringUserNotification", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetMonitoringUserNotificationWith(options sl.Options) (resp []datatypes.User_Customer_Notification_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringUserNotification()
+
+}
+
+func (r Hardware_Server) GetMotherboard() (resp datatypes.Hardware_Component, err error) {
+	err = r.record("GetMotherboard", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetMotherboardWith(options sl.Options) (resp datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMotherboard()
+
+}
+
+func (r Hardware_Server) GetNetworkCards() (resp []datatypes.Hardware_Component, err error) {
+	err = r.record("GetNetworkCards", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNetworkCardsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkCards()
+
+}
+
+func (r Hardware_Server) GetNetworkComponentFirewallProtectableIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
+	err = r.record("GetNetworkComponentFirewallProtectableIpAddresses", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNetworkComponents() (resp []datatypes.Network_Component, err error) {
+	err = r.record("GetNetworkComponents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponents()
+
+}
+
+func (r Hardware_Server) GetNetworkGatewayMember() (resp datatypes.Network_Gateway_Member, err error) {
+	err = r.record("GetNetworkGatewayMember", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNetworkGatewayMemberFlag() (resp bool, err error) {
+	err = r.record("GetNetworkGatewayMemberFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNetworkGatewayMemberFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGatewayMemberFlag()
+
+}
+
+func (r Hardware_Server) GetNetworkGatewayMemberWith(options sl.Options) (resp datatypes.Network_Gateway_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGatewayMember()
+
+}
+
+func (r Hardware_Server) GetNetworkManagementIpAddress() (resp string, err error) {
+	err = r.record("GetNetworkManagementIpAddress", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNetworkManagementIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkManagementIpAddress()
+
+}
+
+func (r Hardware_Server) GetNetworkMonitorAttachedDownHardware() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetNetworkMonitorAttachedDownHardware", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNetworkMonitorAttachedDownHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorAttachedDownHardware()
+
+}
+
+func (r Hardware_Server) GetNetworkMonitorAttachedDownVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetNetworkMonitorAttachedDownVirtualGuests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNetworkMonitorAttachedDownVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorAttachedDownVirtualGuests()
+
+}
+
+func (r Hardware_Server) GetNetworkMonitorIncidents() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	err = r.record("GetNetworkMonitorIncidents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNetworkMonitorIncidentsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorIncidents()
+
+}
+
+func (r Hardware_Server) GetNetworkMonitors() (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
+	err = r.record("GetNetworkMonitors", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNetworkMonitorsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitors()
+
+}
+
+func (r Hardware_Server) GetNetworkStatus() (resp string, err error) {
+	err = r.record("GetNetworkStatus", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNetworkStatusAttribute() (resp datatypes.Hardware_Attribute, err error) {
+	err = r.record("GetNetworkStatusAttribute", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNetworkStatusAttributeWith(options sl.Options) (resp datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStatusAttribute()
+
+}
+
+func (r Hardware_Server) GetNetworkStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStatus()
+
+}
+
+func (r Hardware_Server) GetNetworkStorage() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetNetworkStorage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStorage()
+
+}
+
+func (r Hardware_Server) GetNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
+	err = r.record("GetNetworkVlans", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlans()
+
+}
+
+func (r Hardware_Server) GetNextBillingCycleBandwidthAllocation() (resp datatypes.Float64, err error) {
+	err = r.record("GetNextBillingCycleBandwidthAllocation", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetNextBillingCycleBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextBillingCycleBandwidthAllocation()
+
+}
+
+func (r Hardware_Server) GetNotesHistory() (resp []datatypes.Hardware_Note, err error) {
+	err = r.record("GetNotesHistory", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetObject() (resp datatypes.Hardware_Server, err error) {
+	err = r.record("GetObject", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetOpenCancellationTicket() (resp datatypes.Ticket, err error) {
+	err = r.record("GetOpenCancellationTicket", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetOpenCancellationTicketWith(options sl.Options) (resp datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenCancellationTicket()
+
+}
+
+func (r Hardware_Server) GetOperatingSystem() (resp datatypes.Software_Component_OperatingSystem, err error) {
+	err = r.record("GetOperatingSystem", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetOperatingSystemReferenceCode() (resp string, err error) {
+	err = r.record("GetOperatingSystemReferenceCode", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetOperatingSystemReferenceCodeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystemReferenceCode()
+
+}
+
+func (r Hardware_Server) GetOperatingSystemWith(options sl.Options) (resp datatypes.Software_Component_OperatingSystem, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystem()
+
+}
+
+func (r Hardware_Server) GetOutboundBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetOutboundBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetOutboundBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundBandwidthUsage()
+
+}
+
+func (r Hardware_Server) GetOutboundPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetOutboundPrivateBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetOutboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPrivateBandwidthUsage()
+
+}
+
+func (r Hardware_Server) GetOutboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetOutboundPublicBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetOutboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPublicBandwidthUsage()
+
+}
+
+func (r Hardware_Server) GetOverBandwidthAllocationFlag() (resp int, err error) {
+	err = r.record("GetOverBandwidthAllocationFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOverBandwidthAllocationFlag()
+
+}
+
+func (r Hardware_Server) GetPMInfo() (resp []datatypes.Container_RemoteManagement_PmInfo, err error) {
+	err = r.record("GetPMInfo", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetParentBay() (resp datatypes.Hardware_Blade, err error) {
+	err = r.record("GetParentBay", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetParentBayWith(options sl.Options) (resp datatypes.Hardware_Blade, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentBay()
+
+}
+
+func (r Hardware_Server) GetParentHardware() (resp datatypes.Hardware, err error) {
+	err = r.record("GetParentHardware", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetParentHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentHardware()
+
+}
+
+func (r Hardware_Server) GetPointOfPresenceLocation() (resp datatypes.Location, err error) {
+	err = r.record("GetPointOfPresenceLocation", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPointOfPresenceLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPointOfPresenceLocation()
+
+}
+
+func (r Hardware_Server) GetPowerComponents() (resp []datatypes.Hardware_Power_Component, err error) {
+	err = r.record("GetPowerComponents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPowerComponentsWith(options sl.Options) (resp []datatypes.Hardware_Power_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPowerComponents()
+
+}
+
+func (r Hardware_Server) GetPowerSupply() (resp []datatypes.Hardware_Component, err error) {
+	err = r.record("GetPowerSupply", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPowerSupplyWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPowerSupply()
+
+}
+
+func (r Hardware_Server) GetPrimaryBackendIpAddress() (resp string, err error) {
+	err = r.record("GetPrimaryBackendIpAddress", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPrimaryBackendIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendIpAddress()
+
+}
+
+func (r Hardware_Server) GetPrimaryBackendNetworkComponent() (resp datatypes.Network_Component, err error) {
+	err = r.record("GetPrimaryBackendNetworkComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPrimaryBackendNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendNetworkComponent()
+
+}
+
+func (r Hardware_Server) GetPrimaryDriveSize() (resp int, err error) {
+	err = r.record("GetPrimaryDriveSize", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPrimaryIpAddress() (resp string, err error) {
+	err = r.record("GetPrimaryIpAddress", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPrimaryIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryIpAddress()
+
+}
+
+func (r Hardware_Server) GetPrimaryNetworkComponent() (resp datatypes.Network_Component, err error) {
+	err = r.record("GetPrimaryNetworkComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPrimaryNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryNetworkComponent()
+
+}
+
+func (r Hardware_Server) GetPrivateBandwidthData(startTime *int, endTime *int) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
+	err = r.record("GetPrivateBandwidthData", []interface{}{startTime, endTime}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPrivateBandwidthDataSummary() (resp datatypes.Container_Network_Bandwidth_Data_Summary, err error) {
+	err = r.record("GetPrivateBandwidthDataSummary", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPrivateBandwidthGraphImage(startTime *string, endTime *string) (resp []byte, err error) {
+	err = r.record("GetPrivateBandwidthGraphImage", []interface{}{startTime, endTime}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPrivateIpAddress() (resp string, err error) {
+	err = r.record("GetPrivateIpAddress", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPrivateIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateIpAddress()
+
+}
+
+func (r Hardware_Server) GetPrivateNetworkComponent() (resp datatypes.Network_Component, err error) {
+	err = r.record("GetPrivateNetworkComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPrivateNetworkOnlyFlag() (resp bool, err error) {
+	err = r.record("GetPrivateNetworkOnlyFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPrivateNetworkOnlyFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateNetworkOnlyFlag()
+
+}
+
+func (r Hardware_Server) GetPrivateVlan() (resp datatypes.Network_Vlan, err error) {
+	err = r.record("GetPrivateVlan", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPrivateVlanByIpAddress(ipAddress *string) (resp datatypes.Network_Vlan, err error) {
+	err = r.record("GetPrivateVlanByIpAddress", []interface{}{ipAddress}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetProcessorCoreAmount() (resp uint, err error) {
+	err = r.record("GetProcessorCoreAmount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetProcessorCoreAmountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessorCoreAmount()
+
+}
+
+func (r Hardware_Server) GetProcessorPhysicalCoreAmount() (resp uint, err error) {
+	err = r.record("GetProcessorPhysicalCoreAmount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetProcessorPhysicalCoreAmountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessorPhysicalCoreAmount()
+
+}
+
+func (r Hardware_Server) GetProcessors() (resp []datatypes.Hardware_Component, err error) {
+	err = r.record("GetProcessors", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetProcessorsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessors()
+
+}
+
+func (r Hardware_Server) GetProjectedOverBandwidthAllocationFlag() (resp int, err error) {
+	err = r.record("GetProjectedOverBandwidthAllocationFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetProjectedOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedOverBandwidthAllocationFlag()
+
+}
+
+func (r Hardware_Server) GetProjectedPublicBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetProjectedPublicBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetProjectedPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedPublicBandwidthUsage()
+
+}
+
+func (r Hardware_Server) GetProvisionDate() (resp datatypes.Time, err error) {
+	err = r.record("GetProvisionDate", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPublicBandwidthData(startTime *int, endTime *int) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
+	err = r.record("GetPublicBandwidthData", []interface{}{startTime, endTime}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPublicBandwidthDataSummary() (resp datatypes.Container_Network_Bandwidth_Data_Summary, err error) {
+	err = r.record("GetPublicBandwidthDataSummary", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPublicBandwidthGraphImage(startTime *datatypes.Time, endTime *datatypes.Time) (resp []byte, err error) {
+	err = r.record("GetPublicBandwidthGraphImage", []interface{}{startTime, endTime}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPublicBandwidthTotal(startTime *int, endTime *int) (resp uint, err error) {
+	err = r.record("GetPublicBandwidthTotal", []interface{}{startTime, endTime}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPublicNetworkComponent() (resp datatypes.Network_Component, err error) {
+	err = r.record("GetPublicNetworkComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPublicVlan() (resp datatypes.Network_Vlan, err error) {
+	err = r.record("GetPublicVlan", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetPublicVlanByHostname(hostname *string) (resp datatypes.Network_Vlan, err error) {
+	err = r.record("GetPublicVlanByHostname", []interface{}{hostname}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetRack() (resp datatypes.Location, err error) {
+	err = r.record("GetRack", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetRaidControllers() (resp []datatypes.Hardware_Component, err error) {
+	err = r.record("GetRaidControllers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetRaidControllersWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRaidControllers()
+
+}
+
+func (r Hardware_Server) GetReadyNodeFlag() (resp bool, err error) {
+	err = r.record("GetReadyNodeFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetReadyNodeFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReadyNodeFlag()
+
+}
+
+func (r Hardware_Server) GetRecentEvents() (resp []datatypes.Notification_Occurrence_Event, err error) {
+	err = r.record("GetRecentEvents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetRecentEventsWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRecentEvents()
+
+}
+
+func (r Hardware_Server) GetRecentRemoteManagementCommands() (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
+	err = r.record("GetRecentRemoteManagementCommands", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetRecentRemoteManagementCommandsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRecentRemoteManagementCommands()
+
+}
+
+func (r Hardware_Server) GetRegionalInternetRegistry() (resp datatypes.Network_Regional_Internet_Registry, err error) {
+	err = r.record("GetRegionalInternetRegistry", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetRemoteManagement() (resp datatypes.Hardware_Component_RemoteManagement, err error) {
+	err = r.record("GetRemoteManagement", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetRemoteManagementAccounts() (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
+	err = r.record("GetRemoteManagementAccounts", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetRemoteManagementAccountsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementAccounts()
+
+}
+
+func (r Hardware_Server) GetRemoteManagementComponent() (resp datatypes.Network_Component, err error) {
+	err = r.record("GetRemoteManagementComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetRemoteManagementComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementComponent()
+
+}
+
+func (r Hardware_Server) GetRemoteManagementUsers() (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
+	err = r.record("GetRemoteManagementUsers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetRemoteManagementUsersWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementUsers()
+
+}
+
+func (r Hardware_Server) GetRemoteManagementWith(options sl.Options) (resp datatypes.Hardware_Component_RemoteManagement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagement()
+
+}
+
+func (r Hardware_Server) GetResourceConfigurations() (resp []datatypes.Hardware_Resource_Configuration, err error) {
+	err = r.record("GetResourceConfigurations", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetResourceGroupMemberReferences() (resp []datatypes.Resource_Group_Member, err error) {
+	err = r.record("GetResourceGroupMemberReferences", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetResourceGroupRoles() (resp []datatypes.Resource_Group_Role, err error) {
+	err = r.record("GetResourceGroupRoles", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetResourceGroups() (resp []datatypes.Resource_Group, err error) {
+	err = r.record("GetResourceGroups", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetResourceGroupsWith(options sl.Options) (resp []datatypes.Resource_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResourceGroups()
+
+}
+
+func (r Hardware_Server) GetReverseDomainRecords() (resp []datatypes.Dns_Domain, err error) {
+	err = r.record("GetReverseDomainRecords", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetRouters() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetRouters", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRouters()
+
+}
+
+func (r Hardware_Server) GetScaleAssets() (resp []datatypes.Scale_Asset, err error) {
+	err = r.record("GetScaleAssets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetScaleAssetsWith(options sl.Options) (resp []datatypes.Scale_Asset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleAssets()
+
+}
+
+func (r Hardware_Server) GetSecurityScanRequests() (resp []datatypes.Network_Security_Scanner_Request, err error) {
+	err = r.record("GetSecurityScanRequests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetSecurityScanRequestsWith(options sl.Options) (resp []datatypes.Network_Security_Scanner_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityScanRequests()
+
+}
+
+func (r Hardware_Server) GetSensorData() (resp []datatypes.Container_RemoteManagement_SensorReading, err error) {
+	err = r.record("GetSensorData", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetSensorDataWithGraphs() (resp datatypes.Container_RemoteManagement_SensorReadingsWithGraphs, err error) {
+	err = r.record("GetSensorDataWithGraphs", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetServerDetails() (resp datatypes.Container_Hardware_Server_Details, err error) {
+	err = r.record("GetServerDetails", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetServerFanSpeedGraphs() (resp []datatypes.Container_RemoteManagement_Graphs_SensorSpeed, err error) {
+	err = r.record("GetServerFanSpeedGraphs", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetServerPowerState() (resp string, err error) {
+	err = r.record("GetServerPowerState", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetServerRoom() (resp datatypes.Location, err error) {
+	err = r.record("GetServerRoom", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetServerRoomWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServerRoom()
+
+}
+
+func (r Hardware_Server) GetServerTemperatureGraphs() (resp []datatypes.Container_RemoteManagement_Graphs_SensorTemperature, err error) {
+	err = r.record("GetServerTemperatureGraphs", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetServiceProvider() (resp datatypes.Service_Provider, err error) {
+	err = r.record("GetServiceProvider", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetServiceProviderWith(options sl.Options) (resp datatypes.Service_Provider, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceProvider()
+
+}
+
+func (r Hardware_Server) GetSoftwareComponents() (resp []datatypes.Software_Component, err error) {
+	err = r.record("GetSoftwareComponents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetSoftwareComponentsWith(options sl.Options) (resp []datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareComponents()
+
+}
+
+func (r Hardware_Server) GetSoftwareGuardExtensionEnabled() (resp bool, err error) {
+	err = r.record("GetSoftwareGuardExtensionEnabled", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetSoftwareGuardExtensionEnabledWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareGuardExtensionEnabled()
+
+}
+
+func (r Hardware_Server) GetSparePoolBillingItem() (resp datatypes.Billing_Item_Hardware, err error) {
+	err = r.record("GetSparePoolBillingItem", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetSparePoolBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSparePoolBillingItem()
+
+}
+
+func (r Hardware_Server) GetSshKeys() (resp []datatypes.Security_Ssh_Key, err error) {
+	err = r.record("GetSshKeys", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSshKeys()
+
+}
+
+func (r Hardware_Server) GetStatisticsRemoteManagement() (resp datatypes.Hardware_Component_RemoteManagement, err error) {
+	err = r.record("GetStatisticsRemoteManagement", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetStatisticsRemoteManagementWith(options sl.Options) (resp datatypes.Hardware_Component_RemoteManagement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatisticsRemoteManagement()
+
+}
+
+func (r Hardware_Server) GetStorageNetworkComponents() (resp []datatypes.Network_Component, err error) {
+	err = r.record("GetStorageNetworkComponents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetTagReferences() (resp []datatypes.Tag_Reference, err error) {
+	err = r.record("GetTagReferences", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetTopLevelLocation() (resp datatypes.Location, err error) {
+	err = r.record("GetTopLevelLocation", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetTransactionHistory() (resp []datatypes.Provisioning_Version1_Transaction_History, err error) {
+	err = r.record("GetTransactionHistory", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetUefiBootFlag() (resp bool, err error) {
+	err = r.record("GetUefiBootFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetUefiBootFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUefiBootFlag()
+
+}
+
+func (r Hardware_Server) GetUpgradeItemPrices() (resp []datatypes.Product_Item_Price, err error) {
+	err = r.record("GetUpgradeItemPrices", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetUpgradeRequest() (resp datatypes.Product_Upgrade_Request, err error) {
+	err = r.record("GetUpgradeRequest", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetUpgradeRequestWith(options sl.Options) (resp datatypes.Product_Upgrade_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeRequest()
+
+}
+
+func (r Hardware_Server) GetUplinkHardware() (resp datatypes.Hardware, err error) {
+	err = r.record("GetUplinkHardware", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetUplinkHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUplinkHardware()
+
+}
+
+func (r Hardware_Server) GetUplinkNetworkComponents() (resp []datatypes.Network_Component, err error) {
+	err = r.record("GetUplinkNetworkComponents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetUplinkNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUplinkNetworkComponents()
+
+}
+
+func (r Hardware_Server) GetUserData() (resp []datatypes.Hardware_Attribute, err error) {
+	err = r.record("GetUserData", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetUserDataWith(options sl.Options) (resp []datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUserData()
+
+}
+
+func (r Hardware_Server) GetUsers() (resp []datatypes.User_Customer, err error) {
+	err = r.record("GetUsers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUsers()
+
+}
+
+func (r Hardware_Server) GetValidBlockDeviceTemplateGroups(visibility *string) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	err = r.record("GetValidBlockDeviceTemplateGroups", []interface{}{visibility}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetVirtualChassis() (resp datatypes.Hardware_Group, err error) {
+	err = r.record("GetVirtualChassis", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetVirtualChassisSiblings() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetVirtualChassisSiblings", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetVirtualChassisSiblingsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualChassisSiblings()
+
+}
+
+func (r Hardware_Server) GetVirtualChassisWith(options sl.Options) (resp datatypes.Hardware_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualChassis()
+
+}
+
+func (r Hardware_Server) GetVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("GetVirtualGuests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuests()
+
+}
+
+func (r Hardware_Server) GetVirtualHost() (resp datatypes.Virtual_Host, err error) {
+	err = r.record("GetVirtualHost", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetVirtualHostWith(options sl.Options) (resp datatypes.Virtual_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualHost()
+
+}
+
+func (r Hardware_Server) GetVirtualLicenses() (resp []datatypes.Software_VirtualLicense, err error) {
+	err = r.record("GetVirtualLicenses", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetVirtualLicensesWith(options sl.Options) (resp []datatypes.Software_VirtualLicense, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualLicenses()
+
+}
+
+func (r Hardware_Server) GetVirtualRack() (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	err = r.record("GetVirtualRack", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetVirtualRackId() (resp int, err error) {
+	err = r.record("GetVirtualRackId", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetVirtualRackIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackId()
+
+}
+
+func (r Hardware_Server) GetVirtualRackName() (resp string, err error) {
+	err = r.record("GetVirtualRackName", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetVirtualRackNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackName()
+
+}
+
+func (r Hardware_Server) GetVirtualRackWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRack()
+
+}
+
+func (r Hardware_Server) GetVirtualizationPlatform() (resp datatypes.Software_Component, err error) {
+	err = r.record("GetVirtualizationPlatform", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetVirtualizationPlatformWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualizationPlatform()
+
+}
+
+func (r Hardware_Server) GetWindowsUpdateAvailableUpdates() (resp []datatypes.Container_Utility_Microsoft_Windows_UpdateServices_UpdateItem, err error) {
+	err = r.record("GetWindowsUpdateAvailableUpdates", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetWindowsUpdateInstalledUpdates() (resp []datatypes.Container_Utility_Microsoft_Windows_UpdateServices_UpdateItem, err error) {
+	err = r.record("GetWindowsUpdateInstalledUpdates", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) GetWindowsUpdateStatus() (resp datatypes.Container_Utility_Microsoft_Windows_UpdateServices_Status, err error) {
+	err = r.record("GetWindowsUpdateStatus", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) ImportVirtualHost() (resp datatypes.Virtual_Host, err error) {
+	err = r.record("ImportVirtualHost", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) InitiateIderaBareMetalRestore() (resp bool, err error) {
+	err = r.record("InitiateIderaBareMetalRestore", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) InitiateR1SoftBareMetalRestore() (resp bool, err error) {
+	err = r.record("InitiateR1SoftBareMetalRestore", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) IsBackendPingable() (resp bool, err error) {
+	err = r.record("IsBackendPingable", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) IsPingable() (resp bool, err error) {
+	err = r.record("IsPingable", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) IsWindowsServer() (resp bool, err error) {
+	err = r.record("IsWindowsServer", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) MassFirmwareUpdate(hardwareIds []int, ipmi *bool, raidController *bool, bios *bool, harddrive *bool) (resp []datatypes.Container_Hardware_Server_Request, err error) {
+	err = r.record("MassFirmwareUpdate", []interface{}{hardwareIds, ipmi, raidController, bios, harddrive}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) MassReloadOperatingSystem(hardwareIds []string, token *string, config *datatypes.Container_Hardware_Server_Configuration) (resp string, err error) {
+	err = r.record("MassReloadOperatingSystem", []interface{}{hardwareIds, token, config}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) MassSparePool(hardwareIds []string, action *string, newOrder *bool) (resp []datatypes.Container_Hardware_Server_Request, err error) {
+	err = r.record("MassSparePool", []interface{}{hardwareIds, action, newOrder}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) Ping() (resp string, err error) {
+	err = r.record("Ping", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) PopulateServerRam(ramSerialString *string) (err error) {
+	err = r.record("PopulateServerRam", []interface{}{ramSerialString}, r.Options, nil)
+	return
+
+}
+
+func (r Hardware_Server) PowerCycle() (resp bool, err error) {
+	err = r.record("PowerCycle", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) PowerOff() (resp bool, err error) {
+	err = r.record("PowerOff", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) PowerOn() (resp bool, err error) {
+	err = r.record("PowerOn", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) RebootDefault() (resp bool, err error) {
+	err = r.record("RebootDefault", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) RebootHard() (resp bool, err error) {
+	err = r.record("RebootHard", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) RebootSoft() (resp bool, err error) {
+	err = r.record("RebootSoft", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) ReloadCurrentOperatingSystemConfiguration(token *string) (resp string, err error) {
+	err = r.record("ReloadCurrentOperatingSystemConfiguration", []interface{}{token}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) ReloadOperatingSystem(token *string, config *datatypes.Container_Hardware_Server_Configuration) (resp string, err error) {
+	err = r.record("ReloadOperatingSystem", []interface{}{token, config}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) RemoveAccessToNetworkStorage(networkStorageTemplateObject *datatypes.Network_Storage) (resp bool, err error) {
+	err = r.record("RemoveAccessToNetworkStorage", []interface{}{networkStorageTemplateObject}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) RemoveAccessToNetworkStorageList(networkStorageTemplateObjects []datatypes.Network_Storage) (resp bool, err error) {
+	err = r.record("RemoveAccessToNetworkStorageList", []interface{}{networkStorageTemplateObjects}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) RemoveTags(tags *string) (resp bool, err error) {
+	err = r.record("RemoveTags", []interface{}{tags}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) RunPassmarkCertificationBenchmark() (resp bool, err error) {
+	err = r.record("RunPassmarkCertificationBenchmark", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) SetOperatingSystemPassword(newPassword *string) (resp bool, err error) {
+	err = r.record("SetOperatingSystemPassword", []interface{}{newPassword}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) SetPrivateNetworkInterfaceSpeed(newSpeed *int) (resp bool, err error) {
+	err = r.record("SetPrivateNetworkInterfaceSpeed", []interface{}{newSpeed}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) SetPublicNetworkInterfaceSpeed(newSpeed *int) (resp bool, err error) {
+	err = r.record("SetPublicNetworkInterfaceSpeed", []interface{}{newSpeed}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) SetTags(tags *string) (resp bool, err error) {
+	err = r.record("SetTags", []interface{}{tags}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) SetUserMetadata(metadata []string) (resp []datatypes.Hardware_Attribute, err error) {
+	err = r.record("SetUserMetadata", []interface{}{metadata}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) ShutdownPrivatePort() (resp bool, err error) {
+	err = r.record("ShutdownPrivatePort", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) ShutdownPublicPort() (resp bool, err error) {
+	err = r.record("ShutdownPublicPort", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) SparePool(action *string, newOrder *bool) (resp bool, err error) {
+	err = r.record("SparePool", []interface{}{action, newOrder}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) ToggleManagementInterface(enabled *bool) (resp bool, err error) {
+	err = r.record("ToggleManagementInterface", []interface{}{enabled}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) UpdateIpmiPassword(password *string) (resp bool, err error) {
+	err = r.record("UpdateIpmiPassword", []interface{}{password}, r.Options, &resp)
+	return
+
+}
+
+func (r Hardware_Server) ValidatePartitionsForOperatingSystem(operatingSystem *datatypes.Software_Description, partitions []datatypes.Hardware_Component_Partition) (resp bool, err error) {
+	err = r.record("ValidatePartitionsForOperatingSystem", []interface{}{operatingSystem, partitions}, r.Options, &resp)
+	return
+
+}
@@ -0,0 +1,2286 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/**
+ * AUTOMATICALLY GENERATED CODE - DO NOT MODIFY
+ */
+
+package fakes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// VirtualGuestService describes the API methods of services.Virtual_Guest
+type VirtualGuestService interface {
+	ActivatePrivatePort() (resp bool, err error)
+	ActivatePublicPort() (resp bool, err error)
+	AllowAccessToNetworkStorage(networkStorageTemplateObject *datatypes.Network_Storage) (resp bool, err error)
+	AllowAccessToNetworkStorageList(networkStorageTemplateObjects []datatypes.Network_Storage) (resp bool, err error)
+	AttachDiskImage(imageId *int) (resp datatypes.Provisioning_Version1_Transaction, err error)
+	CancelIsolationForDestructiveAction() (err error)
+	CaptureImage(captureTemplate *datatypes.Container_Disk_Image_Capture_Template) (resp datatypes.Virtual_Guest_Block_Device_Template_Group, err error)
+	CheckHostDiskAvailability(diskCapacity *int) (resp bool, err error)
+	CloseAlarm(alarmId *string) (resp bool, err error)
+	ConfigureMetadataDisk() (resp datatypes.Provisioning_Version1_Transaction, err error)
+	CreateArchiveTransaction(groupName *string, blockDevices []datatypes.Virtual_Guest_Block_Device, note *string) (resp datatypes.Provisioning_Version1_Transaction, err error)
+	CreateObject(templateObject *datatypes.Virtual_Guest) (resp datatypes.Virtual_Guest, err error)
+	CreateObjects(templateObjects []datatypes.Virtual_Guest) (resp []datatypes.Virtual_Guest, err error)
+	CreatePostSoftwareInstallTransaction(data *string, returnBoolean *bool) (resp bool, err error)
+	DeleteObject() (resp bool, err error)
+	DeleteTag(tagName *string) (resp bool, err error)
+	DeleteTransientWebhook() (err error)
+	DetachDiskImage(imageId *int) (resp datatypes.Provisioning_Version1_Transaction, err error)
+	EditObject(templateObject *datatypes.Virtual_Guest) (resp bool, err error)
+	ExecuteIderaBareMetalRestore() (resp bool, err error)
+	ExecuteR1SoftBareMetalRestore() (resp bool, err error)
+	ExecuteRemoteScript(uri *string) (err error)
+	ExecuteRescueLayer() (resp bool, err error)
+	FindByIpAddress(ipAddress *string) (resp datatypes.Virtual_Guest, err error)
+	GenerateOrderTemplate(templateObject *datatypes.Virtual_Guest) (resp datatypes.Container_Product_Order, err error)
+	GetAccount() (resp datatypes.Account, err error)
+	GetAccountOwnedPoolFlag() (resp bool, err error)
+	GetAccountWith(options sl.Options) (resp datatypes.Account, err error)
+	GetActiveNetworkMonitorIncident() (resp []datatypes.Network_Monitor_Version1_Incident, err error)
+	GetActiveNetworkMonitorIncidentWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error)
+	GetActiveTickets() (resp []datatypes.Ticket, err error)
+	GetActiveTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error)
+	GetActiveTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error)
+	GetActiveTransactions() (resp []datatypes.Provisioning_Version1_Transaction, err error)
+	GetActiveTransactionsWith(options sl.Options) (resp []datatypes.Provisioning_Version1_Transaction, err error)
+	GetAdditionalRequiredPricesForOsReload(config *datatypes.Container_Hardware_Server_Configuration) (resp []datatypes.Product_Item_Price, err error)
+	GetAlarmHistory(startDate *datatypes.Time, endDate *datatypes.Time, alarmId *string) (resp []datatypes.Container_Monitoring_Alarm_History, err error)
+	GetAllowedHost() (resp datatypes.Network_Storage_Allowed_Host, err error)
+	GetAllowedHostWith(options sl.Options) (resp datatypes.Network_Storage_Allowed_Host, err error)
+	GetAllowedNetworkStorage() (resp []datatypes.Network_Storage, err error)
+	GetAllowedNetworkStorageReplicas() (resp []datatypes.Network_Storage, err error)
+	GetAllowedNetworkStorageReplicasWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetAllowedNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetAntivirusSpywareSoftwareComponent() (resp datatypes.Software_Component, err error)
+	GetAntivirusSpywareSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error)
+	GetApplicationDeliveryController() (resp datatypes.Network_Application_Delivery_Controller, err error)
+	GetAttachedNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error)
+	GetAttributes() (resp []datatypes.Virtual_Guest_Attribute, err error)
+	GetAvailableBlockDevicePositions() (resp []string, err error)
+	GetAvailableMonitoring() (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error)
+	GetAvailableMonitoringWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error)
+	GetAvailableNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error)
+	GetAverageDailyPrivateBandwidthUsage() (resp datatypes.Float64, err error)
+	GetAverageDailyPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetAverageDailyPublicBandwidthUsage() (resp datatypes.Float64, err error)
+	GetAverageDailyPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetBackendNetworkComponents() (resp []datatypes.Virtual_Guest_Network_Component, err error)
+	GetBackendNetworkComponentsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Network_Component, err error)
+	GetBackendRouters() (resp []datatypes.Hardware, err error)
+	GetBackendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error)
+	GetBandwidthAllocation() (resp datatypes.Float64, err error)
+	GetBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetBandwidthAllotmentDetail() (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error)
+	GetBandwidthAllotmentDetailWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error)
+	GetBandwidthDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time, networkType *string) (resp []datatypes.Metric_Tracking_Object_Data, err error)
+	GetBandwidthForDateRange(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error)
+	GetBandwidthImage(networkType *string, snapshotRange *string, dateSpecified *datatypes.Time, dateSpecifiedEnd *datatypes.Time) (resp datatypes.Container_Bandwidth_GraphOutputs, err error)
+	GetBandwidthImageByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time, networkType *string) (resp datatypes.Container_Bandwidth_GraphOutputs, err error)
+	GetBandwidthTotal(startDateTime *datatypes.Time, endDateTime *datatypes.Time, direction *string, side *string) (resp uint, err error)
+	GetBillingCycleBandwidthUsage() (resp []datatypes.Network_Bandwidth_Usage, err error)
+	GetBillingCycleBandwidthUsageWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Usage, err error)
+	GetBillingCyclePrivateBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error)
+	GetBillingCyclePrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error)
+	GetBillingCyclePublicBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error)
+	GetBillingCyclePublicBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error)
+	GetBillingItem() (resp datatypes.Billing_Item_Virtual_Guest, err error)
+	GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Virtual_Guest, err error)
+	GetBlockCancelBecauseDisconnectedFlag() (resp bool, err error)
+	GetBlockCancelBecauseDisconnectedFlagWith(options sl.Options) (resp bool, err error)
+	GetBlockDeviceTemplateGroup() (resp datatypes.Virtual_Guest_Block_Device_Template_Group, err error)
+	GetBlockDeviceTemplateGroupWith(options sl.Options) (resp datatypes.Virtual_Guest_Block_Device_Template_Group, err error)
+	GetBlockDevices() (resp []datatypes.Virtual_Guest_Block_Device, err error)
+	GetBlockDevicesWith(options sl.Options) (resp []datatypes.Virtual_Guest_Block_Device, err error)
+	GetBootMode() (resp string, err error)
+	GetBootOrder() (resp string, err error)
+	GetConsoleAccessLog() (resp []datatypes.Network_Logging_Syslog, err error)
+	GetConsoleIpAddressFlag() (resp bool, err error)
+	GetConsoleIpAddressFlagWith(options sl.Options) (resp bool, err error)
+	GetConsoleIpAddressRecord() (resp datatypes.Virtual_Guest_Network_Component_IpAddress, err error)
+	GetConsoleIpAddressRecordWith(options sl.Options) (resp datatypes.Virtual_Guest_Network_Component_IpAddress, err error)
+	GetContinuousDataProtectionSoftwareComponent() (resp datatypes.Software_Component, err error)
+	GetContinuousDataProtectionSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error)
+	GetControlPanel() (resp datatypes.Software_Component, err error)
+	GetControlPanelWith(options sl.Options) (resp datatypes.Software_Component, err error)
+	GetCoreRestrictedOperatingSystemPrice() (resp datatypes.Product_Item_Price, err error)
+	GetCpuMetricDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time, cpuIndexes []int) (resp []datatypes.Metric_Tracking_Object_Data, err error)
+	GetCpuMetricImage(snapshotRange *string, dateSpecified *datatypes.Time) (resp datatypes.Container_Bandwidth_GraphOutputs, err error)
+	GetCpuMetricImageByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time, cpuIndexes []int) (resp datatypes.Container_Bandwidth_GraphOutputs, err error)
+	GetCreateObjectOptions() (resp datatypes.Container_Virtual_Guest_Configuration, err error)
+	GetCurrentBandwidthSummary() (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error)
+	GetCurrentBandwidthSummaryWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error)
+	GetCurrentBillingDetail() (resp []datatypes.Billing_Item, err error)
+	GetCurrentBillingTotal() (resp datatypes.Float64, err error)
+	GetCustomBandwidthDataByDate(graphData *datatypes.Container_Graph) (resp datatypes.Container_Graph, err error)
+	GetCustomMetricDataByDate(graphData *datatypes.Container_Graph) (resp datatypes.Container_Graph, err error)
+	GetDatacenter() (resp datatypes.Location, err error)
+	GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error)
+	GetDedicatedHost() (resp datatypes.Virtual_DedicatedHost, err error)
+	GetDedicatedHostWith(options sl.Options) (resp datatypes.Virtual_DedicatedHost, err error)
+	GetDriveRetentionItemPrice() (resp datatypes.Product_Item_Price, err error)
+	GetEvaultNetworkStorage() (resp []datatypes.Network_Storage, err error)
+	GetEvaultNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetFirewallProtectableSubnets() (resp []datatypes.Network_Subnet, err error)
+	GetFirewallServiceComponent() (resp datatypes.Network_Component_Firewall, err error)
+	GetFirewallServiceComponentWith(options sl.Options) (resp datatypes.Network_Component_Firewall, err error)
+	GetFirstAvailableBlockDevicePosition() (resp string, err error)
+	GetFrontendNetworkComponents() (resp []datatypes.Virtual_Guest_Network_Component, err error)
+	GetFrontendNetworkComponentsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Network_Component, err error)
+	GetFrontendRouters() (resp datatypes.Hardware, err error)
+	GetFrontendRoutersWith(options sl.Options) (resp datatypes.Hardware, err error)
+	GetGlobalIdentifier() (resp string, err error)
+	GetGlobalIdentifierWith(options sl.Options) (resp string, err error)
+	GetGuestBootParameter() (resp datatypes.Virtual_Guest_Boot_Parameter, err error)
+	GetHost() (resp datatypes.Virtual_Host, err error)
+	GetHostIpsSoftwareComponent() (resp datatypes.Software_Component, err error)
+	GetHostIpsSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error)
+	GetHostWith(options sl.Options) (resp datatypes.Virtual_Host, err error)
+	GetHourlyBillingFlag() (resp bool, err error)
+	GetHourlyBillingFlagWith(options sl.Options) (resp bool, err error)
+	GetInboundPrivateBandwidthUsage() (resp datatypes.Float64, err error)
+	GetInboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetInboundPublicBandwidthUsage() (resp datatypes.Float64, err error)
+	GetInboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetInternalTagReferences() (resp []datatypes.Tag_Reference, err error)
+	GetIsoBootImage() (resp datatypes.Virtual_Disk_Image, err error)
+	GetItemPricesFromSoftwareDescriptions(softwareDescriptions []datatypes.Software_Description, includeTranslationsFlag *bool, returnAllPricesFlag *bool) (resp []datatypes.Product_Item, err error)
+	GetLastKnownPowerState() (resp datatypes.Virtual_Guest_Power_State, err error)
+	GetLastKnownPowerStateWith(options sl.Options) (resp datatypes.Virtual_Guest_Power_State, err error)
+	GetLastOperatingSystemReload() (resp datatypes.Provisioning_Version1_Transaction, err error)
+	GetLastOperatingSystemReloadWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error)
+	GetLastTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error)
+	GetLastTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error)
+	GetLatestNetworkMonitorIncident() (resp datatypes.Network_Monitor_Version1_Incident, err error)
+	GetLatestNetworkMonitorIncidentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Incident, err error)
+	GetLocalDiskFlag() (resp bool, err error)
+	GetLocalDiskFlagWith(options sl.Options) (resp bool, err error)
+	GetLocation() (resp datatypes.Location, err error)
+	GetLocationWith(options sl.Options) (resp datatypes.Location, err error)
+	GetManagedResourceFlag() (resp bool, err error)
+	GetManagedResourceFlagWith(options sl.Options) (resp bool, err error)
+	GetMemoryMetricDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error)
+	GetMemoryMetricImage(snapshotRange *string, dateSpecified *datatypes.Time) (resp datatypes.Container_Bandwidth_GraphOutputs, err error)
+	GetMemoryMetricImageByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp datatypes.Container_Bandwidth_GraphOutputs, err error)
+	GetMetricTrackingObject() (resp datatypes.Metric_Tracking_Object, err error)
+	GetMetricTrackingObjectId() (resp int, err error)
+	GetMetricTrackingObjectIdWith(options sl.Options) (resp int, err error)
+	GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object, err error)
+	GetMonitoringActiveAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error)
+	GetMonitoringAgents() (resp []datatypes.Monitoring_Agent, err error)
+	GetMonitoringClosedAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error)
+	GetMonitoringRobot() (resp datatypes.Monitoring_Robot, err error)
+	GetMonitoringServiceComponent() (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error)
+	GetMonitoringServiceComponentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error)
+	GetMonitoringServiceEligibilityFlag() (resp bool, err error)
+	GetMonitoringServiceFlag() (resp bool, err error)
+	GetMonitoringUserNotification() (resp []datatypes.User_Customer_Notification_Virtual_Guest, err error)
+	GetMonitoringUserNotificationWith(options sl.Options) (resp []datatypes.User_Customer_Notification_Virtual_Guest, err error)
+	GetNetworkComponentFirewallProtectableIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error)
+	GetNetworkComponents() (resp []datatypes.Virtual_Guest_Network_Component, err error)
+	GetNetworkComponentsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Network_Component, err error)
+	GetNetworkMonitorIncidents() (resp []datatypes.Network_Monitor_Version1_Incident, err error)
+	GetNetworkMonitorIncidentsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error)
+	GetNetworkMonitors() (resp []datatypes.Network_Monitor_Version1_Query_Host, err error)
+	GetNetworkMonitorsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host, err error)
+	GetNetworkStorage() (resp []datatypes.Network_Storage, err error)
+	GetNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error)
+	GetNetworkVlans() (resp []datatypes.Network_Vlan, err error)
+	GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error)
+	GetObject() (resp datatypes.Virtual_Guest, err error)
+	GetOpenCancellationTicket() (resp datatypes.Ticket, err error)
+	GetOpenCancellationTicketWith(options sl.Options) (resp datatypes.Ticket, err error)
+	GetOperatingSystem() (resp datatypes.Software_Component_OperatingSystem, err error)
+	GetOperatingSystemReferenceCode() (resp string, err error)
+	GetOperatingSystemReferenceCodeWith(options sl.Options) (resp string, err error)
+	GetOperatingSystemWith(options sl.Options) (resp datatypes.Software_Component_OperatingSystem, err error)
+	GetOrderTemplate(billingType *string, orderPrices []datatypes.Product_Item_Price) (resp datatypes.Container_Product_Order, err error)
+	GetOrderedPackageId() (resp string, err error)
+	GetOrderedPackageIdWith(options sl.Options) (resp string, err error)
+	GetOutboundPrivateBandwidthUsage() (resp datatypes.Float64, err error)
+	GetOutboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetOutboundPublicBandwidthUsage() (resp datatypes.Float64, err error)
+	GetOutboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetOverBandwidthAllocationFlag() (resp int, err error)
+	GetOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error)
+	GetPendingMigrationFlag() (resp bool, err error)
+	GetPendingMigrationFlagWith(options sl.Options) (resp bool, err error)
+	GetPlacementGroup() (resp datatypes.Virtual_PlacementGroup, err error)
+	GetPlacementGroupWith(options sl.Options) (resp datatypes.Virtual_PlacementGroup, err error)
+	GetPowerState() (resp datatypes.Virtual_Guest_Power_State, err error)
+	GetPowerStateWith(options sl.Options) (resp datatypes.Virtual_Guest_Power_State, err error)
+	GetPrimaryBackendIpAddress() (resp string, err error)
+	GetPrimaryBackendIpAddressWith(options sl.Options) (resp string, err error)
+	GetPrimaryBackendNetworkComponent() (resp datatypes.Virtual_Guest_Network_Component, err error)
+	GetPrimaryBackendNetworkComponentWith(options sl.Options) (resp datatypes.Virtual_Guest_Network_Component, err error)
+	GetPrimaryIpAddress() (resp string, err error)
+	GetPrimaryIpAddressWith(options sl.Options) (resp string, err error)
+	GetPrimaryNetworkComponent() (resp datatypes.Virtual_Guest_Network_Component, err error)
+	GetPrimaryNetworkComponentWith(options sl.Options) (resp datatypes.Virtual_Guest_Network_Component, err error)
+	GetPrivateNetworkOnlyFlag() (resp bool, err error)
+	GetPrivateNetworkOnlyFlagWith(options sl.Options) (resp bool, err error)
+	GetProjectedOverBandwidthAllocationFlag() (resp int, err error)
+	GetProjectedOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error)
+	GetProjectedPublicBandwidthUsage() (resp datatypes.Float64, err error)
+	GetProjectedPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error)
+	GetProvisionDate() (resp datatypes.Time, err error)
+	GetRecentEvents() (resp []datatypes.Notification_Occurrence_Event, err error)
+	GetRecentEventsWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Event, err error)
+	GetRecentMetricData(time *uint) (resp []datatypes.Metric_Tracking_Object, err error)
+	GetRegionalGroup() (resp datatypes.Location_Group_Regional, err error)
+	GetRegionalGroupWith(options sl.Options) (resp datatypes.Location_Group_Regional, err error)
+	GetRegionalInternetRegistry() (resp datatypes.Network_Regional_Internet_Registry, err error)
+	GetRemoteMonitoringActiveAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error)
+	GetRemoteMonitoringClosedAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error)
+	GetReservedCapacityGroup() (resp datatypes.Virtual_ReservedCapacityGroup, err error)
+	GetReservedCapacityGroupFlag() (resp bool, err error)
+	GetReservedCapacityGroupFlagWith(options sl.Options) (resp bool, err error)
+	GetReservedCapacityGroupInstance() (resp datatypes.Virtual_ReservedCapacityGroup_Instance, err error)
+	GetReservedCapacityGroupInstanceWith(options sl.Options) (resp datatypes.Virtual_ReservedCapacityGroup_Instance, err error)
+	GetReservedCapacityGroupWith(options sl.Options) (resp datatypes.Virtual_ReservedCapacityGroup, err error)
+	GetReverseDomainRecords() (resp []datatypes.Dns_Domain, err error)
+	GetScaleAssets() (resp []datatypes.Scale_Asset, err error)
+	GetScaleAssetsWith(options sl.Options) (resp []datatypes.Scale_Asset, err error)
+	GetScaleMember() (resp datatypes.Scale_Member_Virtual_Guest, err error)
+	GetScaleMemberWith(options sl.Options) (resp datatypes.Scale_Member_Virtual_Guest, err error)
+	GetScaledFlag() (resp bool, err error)
+	GetScaledFlagWith(options sl.Options) (resp bool, err error)
+	GetSecurityScanRequests() (resp []datatypes.Network_Security_Scanner_Request, err error)
+	GetSecurityScanRequestsWith(options sl.Options) (resp []datatypes.Network_Security_Scanner_Request, err error)
+	GetServerRoom() (resp datatypes.Location, err error)
+	GetServerRoomWith(options sl.Options) (resp datatypes.Location, err error)
+	GetSoftwareComponents() (resp []datatypes.Software_Component, err error)
+	GetSoftwareComponentsWith(options sl.Options) (resp []datatypes.Software_Component, err error)
+	GetSshKeys() (resp []datatypes.Security_Ssh_Key, err error)
+	GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error)
+	GetStatus() (resp datatypes.Virtual_Guest_Status, err error)
+	GetStatusWith(options sl.Options) (resp datatypes.Virtual_Guest_Status, err error)
+	GetTagReferences() (resp []datatypes.Tag_Reference, err error)
+	GetTransientGuestFlag() (resp bool, err error)
+	GetTransientGuestFlagWith(options sl.Options) (resp bool, err error)
+	GetTransientWebhookURI() (resp datatypes.Virtual_Guest_Attribute, err error)
+	GetTransientWebhookURIWith(options sl.Options) (resp datatypes.Virtual_Guest_Attribute, err error)
+	GetType() (resp datatypes.Virtual_Guest_Type, err error)
+	GetTypeWith(options sl.Options) (resp datatypes.Virtual_Guest_Type, err error)
+	GetUpgradeItemPrices(includeDowngradeItemPrices *bool) (resp []datatypes.Product_Item_Price, err error)
+	GetUpgradeRequest() (resp datatypes.Product_Upgrade_Request, err error)
+	GetUpgradeRequestWith(options sl.Options) (resp datatypes.Product_Upgrade_Request, err error)
+	GetUserData() (resp []datatypes.Virtual_Guest_Attribute, err error)
+	GetUserDataWith(options sl.Options) (resp []datatypes.Virtual_Guest_Attribute, err error)
+	GetUsers() (resp []datatypes.User_Customer, err error)
+	GetUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error)
+	GetValidBlockDeviceTemplateGroups(visibility *string) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error)
+	GetVirtualRack() (resp datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	GetVirtualRackId() (resp int, err error)
+	GetVirtualRackIdWith(options sl.Options) (resp int, err error)
+	GetVirtualRackName() (resp string, err error)
+	GetVirtualRackNameWith(options sl.Options) (resp string, err error)
+	GetVirtualRackWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error)
+	IsBackendPingable() (resp bool, err error)
+	IsPingable() (resp bool, err error)
+	IsolateInstanceForDestructiveAction() (err error)
+	Migrate() (resp datatypes.Provisioning_Version1_Transaction, err error)
+	MigrateDedicatedHost(destinationHostId *int) (err error)
+	MountIsoImage(diskImageId *int) (resp datatypes.Provisioning_Version1_Transaction, err error)
+	Pause() (resp bool, err error)
+	PowerCycle() (resp bool, err error)
+	PowerOff() (resp bool, err error)
+	PowerOffSoft() (resp bool, err error)
+	PowerOn() (resp bool, err error)
+	RebootDefault() (resp bool, err error)
+	RebootHard() (resp bool, err error)
+	RebootSoft() (resp bool, err error)
+	ReloadCurrentOperatingSystemConfiguration() (resp datatypes.Provisioning_Version1_Transaction, err error)
+	ReloadOperatingSystem(token *string, config *datatypes.Container_Hardware_Server_Configuration) (resp string, err error)
+	RemoveAccessToNetworkStorage(networkStorageTemplateObject *datatypes.Network_Storage) (resp bool, err error)
+	RemoveAccessToNetworkStorageList(networkStorageTemplateObjects []datatypes.Network_Storage) (resp bool, err error)
+	RemoveTags(tags *string) (resp bool, err error)
+	Resume() (resp bool, err error)
+	SendTestReclaimScheduledAlert() (err error)
+	SetPrivateNetworkInterfaceSpeed(newSpeed *int) (resp bool, err error)
+	SetPublicNetworkInterfaceSpeed(newSpeed *int) (resp bool, err error)
+	SetTags(tags *string) (resp bool, err error)
+	SetTransientWebhook(uri *string, secret *string) (err error)
+	SetUserMetadata(metadata []string) (resp bool, err error)
+	ShutdownPrivatePort() (resp bool, err error)
+	ShutdownPublicPort() (resp bool, err error)
+	UnmountIsoImage() (resp datatypes.Provisioning_Version1_Transaction, err error)
+	ValidateImageTemplate(imageTemplateId *int) (resp bool, err error)
+	VerifyReloadOperatingSystem(config *datatypes.Container_Hardware_Server_Configuration) (resp bool, err error)
+}
+
+var _ VirtualGuestService = services.Virtual_Guest{}
+var _ VirtualGuestService = Virtual_Guest{}
+
+// Virtual_Guest is a recording fake of services.Virtual_Guest. Its copies, returned
+// by the fluent methods, share its Recorder.
+type Virtual_Guest struct {
+	*Recorder
+	Options sl.Options
+}
+
+// NewVirtualGuest returns a new fake of services.Virtual_Guest
+func NewVirtualGuest() Virtual_Guest {
+	return Virtual_Guest{Recorder: NewRecorder()}
+}
+
+func (r Virtual_Guest) Id(id int) Virtual_Guest {
+	r.Options.Id = &id
+	return r
+}
+
+func (r Virtual_Guest) InitParameter(name string, value interface{}) Virtual_Guest {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
+func (r Virtual_Guest) GlobalID(globalID string) Virtual_Guest {
+	r.Options.GlobalID = &globalID
+	return r
+}
+
+func (r Virtual_Guest) Mask(mask string) Virtual_Guest {
+	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
+		mask = fmt.Sprintf("mask[%s]", mask)
+	}
+
+	r.Options.Mask = mask
+	return r
+}
+
+func (r Virtual_Guest) Filter(filter string) Virtual_Guest {
+	r.Options.Filter = filter
+	return r
+}
+
+func (r Virtual_Guest) Limit(limit int) Virtual_Guest {
+	r.Options.Limit = &limit
+	return r
+}
+
+func (r Virtual_Guest) Unlimited() Virtual_Guest {
+	r.Options.Unlimited = true
+	return r
+}
+
+func (r Virtual_Guest) Offset(offset int) Virtual_Guest {
+	r.Options.Offset = &offset
+	return r
+}
+
+func (r Virtual_Guest) Timeout(timeout time.Duration) Virtual_Guest {
+	r.Options.Timeout = timeout
+	return r
+}
+
+// WithContext is accepted for compatibility with the service; fakes do not
+// use the context
+func (r Virtual_Guest) WithContext(ctx context.Context) Virtual_Guest {
+	return r
+}
+
+func (r Virtual_Guest) ActivatePrivatePort() (resp bool, err error) {
+	err = r.record("ActivatePrivatePort", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) ActivatePublicPort() (resp bool, err error) {
+	err = r.record("ActivatePublicPort", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) AllowAccessToNetworkStorage(networkStorageTemplateObject *datatypes.Network_Storage) (resp bool, err error) {
+	err = r.record("AllowAccessToNetworkStorage", []interface{}{networkStorageTemplateObject}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) AllowAccessToNetworkStorageList(networkStorageTemplateObjects []datatypes.Network_Storage) (resp bool, err error) {
+	err = r.record("AllowAccessToNetworkStorageList", []interface{}{networkStorageTemplateObjects}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) AttachDiskImage(imageId *int) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("AttachDiskImage", []interface{}{imageId}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) CancelIsolationForDestructiveAction() (err error) {
+	err = r.record("CancelIsolationForDestructiveAction", nil, r.Options, nil)
+	return
+
+}
+
+func (r Virtual_Guest) CaptureImage(captureTemplate *datatypes.Container_Disk_Image_Capture_Template) (resp datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	err = r.record("CaptureImage", []interface{}{captureTemplate}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) CheckHostDiskAvailability(diskCapacity *int) (resp bool, err error) {
+	err = r.record("CheckHostDiskAvailability", []interface{}{diskCapacity}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) CloseAlarm(alarmId *string) (resp bool, err error) {
+	err = r.record("CloseAlarm", []interface{}{alarmId}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) ConfigureMetadataDisk() (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("ConfigureMetadataDisk", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) CreateArchiveTransaction(groupName *string, blockDevices []datatypes.Virtual_Guest_Block_Device, note *string) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("CreateArchiveTransaction", []interface{}{groupName, blockDevices, note}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) CreateObject(templateObject *datatypes.Virtual_Guest) (resp datatypes.Virtual_Guest, err error) {
+	err = r.record("CreateObject", []interface{}{templateObject}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) CreateObjects(templateObjects []datatypes.Virtual_Guest) (resp []datatypes.Virtual_Guest, err error) {
+	err = r.record("CreateObjects", []interface{}{templateObjects}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) CreatePostSoftwareInstallTransaction(data *string, returnBoolean *bool) (resp bool, err error) {
+	err = r.record("CreatePostSoftwareInstallTransaction", []interface{}{data, returnBoolean}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) DeleteObject() (resp bool, err error) {
+	err = r.record("DeleteObject", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) DeleteTag(tagName *string) (resp bool, err error) {
+	err = r.record("DeleteTag", []interface{}{tagName}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) DeleteTransientWebhook() (err error) {
+	err = r.record("DeleteTransientWebhook", nil, r.Options, nil)
+	return
+
+}
+
+func (r Virtual_Guest) DetachDiskImage(imageId *int) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("DetachDiskImage", []interface{}{imageId}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) EditObject(templateObject *datatypes.Virtual_Guest) (resp bool, err error) {
+	err = r.record("EditObject", []interface{}{templateObject}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) ExecuteIderaBareMetalRestore() (resp bool, err error) {
+	err = r.record("ExecuteIderaBareMetalRestore", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) ExecuteR1SoftBareMetalRestore() (resp bool, err error) {
+	err = r.record("ExecuteR1SoftBareMetalRestore", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) ExecuteRemoteScript(uri *string) (err error) {
+	err = r.record("ExecuteRemoteScript", []interface{}{uri}, r.Options, nil)
+	return
+
+}
+
+func (r Virtual_Guest) ExecuteRescueLayer() (resp bool, err error) {
+	err = r.record("ExecuteRescueLayer", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) FindByIpAddress(ipAddress *string) (resp datatypes.Virtual_Guest, err error) {
+	err = r.record("FindByIpAddress", []interface{}{ipAddress}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GenerateOrderTemplate(templateObject *datatypes.Virtual_Guest) (resp datatypes.Container_Product_Order, err error) {
+	err = r.record("GenerateOrderTemplate", []interface{}{templateObject}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAccount() (resp datatypes.Account, err error) {
+	err = r.record("GetAccount", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAccountOwnedPoolFlag() (resp bool, err error) {
+	err = r.record("GetAccountOwnedPoolFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+
+}
+
+func (r Virtual_Guest) GetActiveNetworkMonitorIncident() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	err = r.record("GetActiveNetworkMonitorIncident", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetActiveNetworkMonitorIncidentWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveNetworkMonitorIncident()
+
+}
+
+func (r Virtual_Guest) GetActiveTickets() (resp []datatypes.Ticket, err error) {
+	err = r.record("GetActiveTickets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetActiveTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("GetActiveTransaction", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetActiveTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransaction()
+
+}
+
+func (r Virtual_Guest) GetActiveTransactions() (resp []datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("GetActiveTransactions", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetActiveTransactionsWith(options sl.Options) (resp []datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransactions()
+
+}
+
+func (r Virtual_Guest) GetAdditionalRequiredPricesForOsReload(config *datatypes.Container_Hardware_Server_Configuration) (resp []datatypes.Product_Item_Price, err error) {
+	err = r.record("GetAdditionalRequiredPricesForOsReload", []interface{}{config}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAlarmHistory(startDate *datatypes.Time, endDate *datatypes.Time, alarmId *string) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
+	err = r.record("GetAlarmHistory", []interface{}{startDate, endDate, alarmId}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAllowedHost() (resp datatypes.Network_Storage_Allowed_Host, err error) {
+	err = r.record("GetAllowedHost", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAllowedHostWith(options sl.Options) (resp datatypes.Network_Storage_Allowed_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHost()
+
+}
+
+func (r Virtual_Guest) GetAllowedNetworkStorage() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetAllowedNetworkStorage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAllowedNetworkStorageReplicas() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetAllowedNetworkStorageReplicas", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAllowedNetworkStorageReplicasWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorageReplicas()
+
+}
+
+func (r Virtual_Guest) GetAllowedNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorage()
+
+}
+
+func (r Virtual_Guest) GetAntivirusSpywareSoftwareComponent() (resp datatypes.Software_Component, err error) {
+	err = r.record("GetAntivirusSpywareSoftwareComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAntivirusSpywareSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAntivirusSpywareSoftwareComponent()
+
+}
+
+func (r Virtual_Guest) GetApplicationDeliveryController() (resp datatypes.Network_Application_Delivery_Controller, err error) {
+	err = r.record("GetApplicationDeliveryController", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAttachedNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetAttachedNetworkStorages", []interface{}{nasType}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAttributes() (resp []datatypes.Virtual_Guest_Attribute, err error) {
+	err = r.record("GetAttributes", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAvailableBlockDevicePositions() (resp []string, err error) {
+	err = r.record("GetAvailableBlockDevicePositions", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAvailableMonitoring() (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	err = r.record("GetAvailableMonitoring", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAvailableMonitoringWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAvailableMonitoring()
+
+}
+
+func (r Virtual_Guest) GetAvailableNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetAvailableNetworkStorages", []interface{}{nasType}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAverageDailyPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetAverageDailyPrivateBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAverageDailyPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPrivateBandwidthUsage()
+
+}
+
+func (r Virtual_Guest) GetAverageDailyPublicBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetAverageDailyPublicBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetAverageDailyPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPublicBandwidthUsage()
+
+}
+
+func (r Virtual_Guest) GetBackendNetworkComponents() (resp []datatypes.Virtual_Guest_Network_Component, err error) {
+	err = r.record("GetBackendNetworkComponents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBackendNetworkComponentsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendNetworkComponents()
+
+}
+
+func (r Virtual_Guest) GetBackendRouters() (resp []datatypes.Hardware, err error) {
+	err = r.record("GetBackendRouters", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBackendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendRouters()
+
+}
+
+func (r Virtual_Guest) GetBandwidthAllocation() (resp datatypes.Float64, err error) {
+	err = r.record("GetBandwidthAllocation", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllocation()
+
+}
+
+func (r Virtual_Guest) GetBandwidthAllotmentDetail() (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
+	err = r.record("GetBandwidthAllotmentDetail", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBandwidthAllotmentDetailWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotmentDetail()
+
+}
+
+func (r Virtual_Guest) GetBandwidthDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time, networkType *string) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
+	err = r.record("GetBandwidthDataByDate", []interface{}{startDateTime, endDateTime, networkType}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBandwidthForDateRange(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
+	err = r.record("GetBandwidthForDateRange", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBandwidthImage(networkType *string, snapshotRange *string, dateSpecified *datatypes.Time, dateSpecifiedEnd *datatypes.Time) (resp datatypes.Container_Bandwidth_GraphOutputs, err error) {
+	err = r.record("GetBandwidthImage", []interface{}{networkType, snapshotRange, dateSpecified, dateSpecifiedEnd}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBandwidthImageByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time, networkType *string) (resp datatypes.Container_Bandwidth_GraphOutputs, err error) {
+	err = r.record("GetBandwidthImageByDate", []interface{}{startDateTime, endDateTime, networkType}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBandwidthTotal(startDateTime *datatypes.Time, endDateTime *datatypes.Time, direction *string, side *string) (resp uint, err error) {
+	err = r.record("GetBandwidthTotal", []interface{}{startDateTime, endDateTime, direction, side}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBillingCycleBandwidthUsage() (resp []datatypes.Network_Bandwidth_Usage, err error) {
+	err = r.record("GetBillingCycleBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBillingCycleBandwidthUsageWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCycleBandwidthUsage()
+
+}
+
+func (r Virtual_Guest) GetBillingCyclePrivateBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
+	err = r.record("GetBillingCyclePrivateBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBillingCyclePrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePrivateBandwidthUsage()
+
+}
+
+func (r Virtual_Guest) GetBillingCyclePublicBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
+	err = r.record("GetBillingCyclePublicBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBillingCyclePublicBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePublicBandwidthUsage()
+
+}
+
+func (r Virtual_Guest) GetBillingItem() (resp datatypes.Billing_Item_Virtual_Guest, err error) {
+	err = r.record("GetBillingItem", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+
+}
+
+func (r Virtual_Guest) GetBlockCancelBecauseDisconnectedFlag() (resp bool, err error) {
+	err = r.record("GetBlockCancelBecauseDisconnectedFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBlockCancelBecauseDisconnectedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockCancelBecauseDisconnectedFlag()
+
+}
+
+func (r Virtual_Guest) GetBlockDeviceTemplateGroup() (resp datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	err = r.record("GetBlockDeviceTemplateGroup", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBlockDeviceTemplateGroupWith(options sl.Options) (resp datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockDeviceTemplateGroup()
+
+}
+
+func (r Virtual_Guest) GetBlockDevices() (resp []datatypes.Virtual_Guest_Block_Device, err error) {
+	err = r.record("GetBlockDevices", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBlockDevicesWith(options sl.Options) (resp []datatypes.Virtual_Guest_Block_Device, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockDevices()
+
+}
+
+func (r Virtual_Guest) GetBootMode() (resp string, err error) {
+	err = r.record("GetBootMode", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetBootOrder() (resp string, err error) {
+	err = r.record("GetBootOrder", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetConsoleAccessLog() (resp []datatypes.Network_Logging_Syslog, err error) {
+	err = r.record("GetConsoleAccessLog", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetConsoleIpAddressFlag() (resp bool, err error) {
+	err = r.record("GetConsoleIpAddressFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetConsoleIpAddressFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetConsoleIpAddressFlag()
+
+}
+
+func (r Virtual_Guest) GetConsoleIpAddressRecord() (resp datatypes.Virtual_Guest_Network_Component_IpAddress, err error) {
+	err = r.record("GetConsoleIpAddressRecord", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetConsoleIpAddressRecordWith(options sl.Options) (resp datatypes.Virtual_Guest_Network_Component_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetConsoleIpAddressRecord()
+
+}
+
+func (r Virtual_Guest) GetContinuousDataProtectionSoftwareComponent() (resp datatypes.Software_Component, err error) {
+	err = r.record("GetContinuousDataProtectionSoftwareComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetContinuousDataProtectionSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetContinuousDataProtectionSoftwareComponent()
+
+}
+
+func (r Virtual_Guest) GetControlPanel() (resp datatypes.Software_Component, err error) {
+	err = r.record("GetControlPanel", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetControlPanelWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetControlPanel()
+
+}
+
+func (r Virtual_Guest) GetCoreRestrictedOperatingSystemPrice() (resp datatypes.Product_Item_Price, err error) {
+	err = r.record("GetCoreRestrictedOperatingSystemPrice", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetCpuMetricDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time, cpuIndexes []int) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
+	err = r.record("GetCpuMetricDataByDate", []interface{}{startDateTime, endDateTime, cpuIndexes}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetCpuMetricImage(snapshotRange *string, dateSpecified *datatypes.Time) (resp datatypes.Container_Bandwidth_GraphOutputs, err error) {
+	err = r.record("GetCpuMetricImage", []interface{}{snapshotRange, dateSpecified}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetCpuMetricImageByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time, cpuIndexes []int) (resp datatypes.Container_Bandwidth_GraphOutputs, err error) {
+	err = r.record("GetCpuMetricImageByDate", []interface{}{startDateTime, endDateTime, cpuIndexes}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetCreateObjectOptions() (resp datatypes.Container_Virtual_Guest_Configuration, err error) {
+	err = r.record("GetCreateObjectOptions", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetCurrentBandwidthSummary() (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error) {
+	err = r.record("GetCurrentBandwidthSummary", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetCurrentBandwidthSummaryWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentBandwidthSummary()
+
+}
+
+func (r Virtual_Guest) GetCurrentBillingDetail() (resp []datatypes.Billing_Item, err error) {
+	err = r.record("GetCurrentBillingDetail", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetCurrentBillingTotal() (resp datatypes.Float64, err error) {
+	err = r.record("GetCurrentBillingTotal", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetCustomBandwidthDataByDate(graphData *datatypes.Container_Graph) (resp datatypes.Container_Graph, err error) {
+	err = r.record("GetCustomBandwidthDataByDate", []interface{}{graphData}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetCustomMetricDataByDate(graphData *datatypes.Container_Graph) (resp datatypes.Container_Graph, err error) {
+	err = r.record("GetCustomMetricDataByDate", []interface{}{graphData}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetDatacenter() (resp datatypes.Location, err error) {
+	err = r.record("GetDatacenter", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+
+}
+
+func (r Virtual_Guest) GetDedicatedHost() (resp datatypes.Virtual_DedicatedHost, err error) {
+	err = r.record("GetDedicatedHost", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetDedicatedHostWith(options sl.Options) (resp datatypes.Virtual_DedicatedHost, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDedicatedHost()
+
+}
+
+func (r Virtual_Guest) GetDriveRetentionItemPrice() (resp datatypes.Product_Item_Price, err error) {
+	err = r.record("GetDriveRetentionItemPrice", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetEvaultNetworkStorage() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetEvaultNetworkStorage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetEvaultNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvaultNetworkStorage()
+
+}
+
+func (r Virtual_Guest) GetFirewallProtectableSubnets() (resp []datatypes.Network_Subnet, err error) {
+	err = r.record("GetFirewallProtectableSubnets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetFirewallServiceComponent() (resp datatypes.Network_Component_Firewall, err error) {
+	err = r.record("GetFirewallServiceComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetFirewallServiceComponentWith(options sl.Options) (resp datatypes.Network_Component_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirewallServiceComponent()
+
+}
+
+func (r Virtual_Guest) GetFirstAvailableBlockDevicePosition() (resp string, err error) {
+	err = r.record("GetFirstAvailableBlockDevicePosition", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetFrontendNetworkComponents() (resp []datatypes.Virtual_Guest_Network_Component, err error) {
+	err = r.record("GetFrontendNetworkComponents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetFrontendNetworkComponentsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendNetworkComponents()
+
+}
+
+func (r Virtual_Guest) GetFrontendRouters() (resp datatypes.Hardware, err error) {
+	err = r.record("GetFrontendRouters", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetFrontendRoutersWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendRouters()
+
+}
+
+func (r Virtual_Guest) GetGlobalIdentifier() (resp string, err error) {
+	err = r.record("GetGlobalIdentifier", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetGlobalIdentifierWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGlobalIdentifier()
+
+}
+
+func (r Virtual_Guest) GetGuestBootParameter() (resp datatypes.Virtual_Guest_Boot_Parameter, err error) {
+	err = r.record("GetGuestBootParameter", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetHost() (resp datatypes.Virtual_Host, err error) {
+	err = r.record("GetHost", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetHostIpsSoftwareComponent() (resp datatypes.Software_Component, err error) {
+	err = r.record("GetHostIpsSoftwareComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetHostIpsSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHostIpsSoftwareComponent()
+
+}
+
+func (r Virtual_Guest) GetHostWith(options sl.Options) (resp datatypes.Virtual_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHost()
+
+}
+
+func (r Virtual_Guest) GetHourlyBillingFlag() (resp bool, err error) {
+	err = r.record("GetHourlyBillingFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetHourlyBillingFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyBillingFlag()
+
+}
+
+func (r Virtual_Guest) GetInboundPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetInboundPrivateBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetInboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPrivateBandwidthUsage()
+
+}
+
+func (r Virtual_Guest) GetInboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetInboundPublicBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetInboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPublicBandwidthUsage()
+
+}
+
+func (r Virtual_Guest) GetInternalTagReferences() (resp []datatypes.Tag_Reference, err error) {
+	err = r.record("GetInternalTagReferences", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetIsoBootImage() (resp datatypes.Virtual_Disk_Image, err error) {
+	err = r.record("GetIsoBootImage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetItemPricesFromSoftwareDescriptions(softwareDescriptions []datatypes.Software_Description, includeTranslationsFlag *bool, returnAllPricesFlag *bool) (resp []datatypes.Product_Item, err error) {
+	err = r.record("GetItemPricesFromSoftwareDescriptions", []interface{}{softwareDescriptions, includeTranslationsFlag, returnAllPricesFlag}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetLastKnownPowerState() (resp datatypes.Virtual_Guest_Power_State, err error) {
+	err = r.record("GetLastKnownPowerState", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetLastKnownPowerStateWith(options sl.Options) (resp datatypes.Virtual_Guest_Power_State, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastKnownPowerState()
+
+}
+
+func (r Virtual_Guest) GetLastOperatingSystemReload() (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("GetLastOperatingSystemReload", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetLastOperatingSystemReloadWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastOperatingSystemReload()
+
+}
+
+func (r Virtual_Guest) GetLastTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("GetLastTransaction", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetLastTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastTransaction()
+
+}
+
+func (r Virtual_Guest) GetLatestNetworkMonitorIncident() (resp datatypes.Network_Monitor_Version1_Incident, err error) {
+	err = r.record("GetLatestNetworkMonitorIncident", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetLatestNetworkMonitorIncidentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestNetworkMonitorIncident()
+
+}
+
+func (r Virtual_Guest) GetLocalDiskFlag() (resp bool, err error) {
+	err = r.record("GetLocalDiskFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetLocalDiskFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocalDiskFlag()
+
+}
+
+func (r Virtual_Guest) GetLocation() (resp datatypes.Location, err error) {
+	err = r.record("GetLocation", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+
+}
+
+func (r Virtual_Guest) GetManagedResourceFlag() (resp bool, err error) {
+	err = r.record("GetManagedResourceFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetManagedResourceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedResourceFlag()
+
+}
+
+func (r Virtual_Guest) GetMemoryMetricDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
+	err = r.record("GetMemoryMetricDataByDate", []interface{}{startDateTime, endDateTime}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetMemoryMetricImage(snapshotRange *string, dateSpecified *datatypes.Time) (resp datatypes.Container_Bandwidth_GraphOutputs, err error) {
+	err = r.record("GetMemoryMetricImage", []interface{}{snapshotRange, dateSpecified}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetMemoryMetricImageByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp datatypes.Container_Bandwidth_GraphOutputs, err error) {
+	err = r.record("GetMemoryMetricImageByDate", []interface{}{startDateTime, endDateTime}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetMetricTrackingObject() (resp datatypes.Metric_Tracking_Object, err error) {
+	err = r.record("GetMetricTrackingObject", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetMetricTrackingObjectId() (resp int, err error) {
+	err = r.record("GetMetricTrackingObjectId", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetMetricTrackingObjectIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObjectId()
+
+}
+
+func (r Virtual_Guest) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+
+}
+
+func (r Virtual_Guest) GetMonitoringActiveAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
+	err = r.record("GetMonitoringActiveAlarms", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetMonitoringAgents() (resp []datatypes.Monitoring_Agent, err error) {
+	err = r.record("GetMonitoringAgents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetMonitoringClosedAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
+	err = r.record("GetMonitoringClosedAlarms", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetMonitoringRobot() (resp datatypes.Monitoring_Robot, err error) {
+	err = r.record("GetMonitoringRobot", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetMonitoringServiceComponent() (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	err = r.record("GetMonitoringServiceComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetMonitoringServiceComponentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceComponent()
+
+}
+
+func (r Virtual_Guest) GetMonitoringServiceEligibilityFlag() (resp bool, err error) {
+	err = r.record("GetMonitoringServiceEligibilityFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetMonitoringServiceFlag() (resp bool, err error) {
+	err = r.record("GetMonitoringServiceFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetMonitoringUserNotification() (resp []datatypes.User_Customer_Notification_Virtual_Guest, err error) {
+	err = r.record("GetMonitoringUserNotification", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetMonitoringUserNotificationWith(options sl.Options) (resp []datatypes.User_Customer_Notification_Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringUserNotification()
+
+}
+
+func (r Virtual_Guest) GetNetworkComponentFirewallProtectableIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
+	err = r.record("GetNetworkComponentFirewallProtectableIpAddresses", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetNetworkComponents() (resp []datatypes.Virtual_Guest_Network_Component, err error) {
+	err = r.record("GetNetworkComponents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetNetworkComponentsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponents()
+
+}
+
+func (r Virtual_Guest) GetNetworkMonitorIncidents() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	err = r.record("GetNetworkMonitorIncidents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetNetworkMonitorIncidentsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorIncidents()
+
+}
+
+func (r Virtual_Guest) GetNetworkMonitors() (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
+	err = r.record("GetNetworkMonitors", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetNetworkMonitorsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitors()
+
+}
+
+func (r Virtual_Guest) GetNetworkStorage() (resp []datatypes.Network_Storage, err error) {
+	err = r.record("GetNetworkStorage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStorage()
+
+}
+
+func (r Virtual_Guest) GetNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
+	err = r.record("GetNetworkVlans", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlans()
+
+}
+
+func (r Virtual_Guest) GetObject() (resp datatypes.Virtual_Guest, err error) {
+	err = r.record("GetObject", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetOpenCancellationTicket() (resp datatypes.Ticket, err error) {
+	err = r.record("GetOpenCancellationTicket", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetOpenCancellationTicketWith(options sl.Options) (resp datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenCancellationTicket()
+
+}
+
+func (r Virtual_Guest) GetOperatingSystem() (resp datatypes.Software_Component_OperatingSystem, err error) {
+	err = r.record("GetOperatingSystem", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetOperatingSystemReferenceCode() (resp string, err error) {
+	err = r.record("GetOperatingSystemReferenceCode", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetOperatingSystemReferenceCodeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystemReferenceCode()
+
+}
+
+func (r Virtual_Guest) GetOperatingSystemWith(options sl.Options) (resp datatypes.Software_Component_OperatingSystem, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystem()
+
+}
+
+func (r Virtual_Guest) GetOrderTemplate(billingType *string, orderPrices []datatypes.Product_Item_Price) (resp datatypes.Container_Product_Order, err error) {
+	err = r.record("GetOrderTemplate", []interface{}{billingType, orderPrices}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetOrderedPackageId() (resp string, err error) {
+	err = r.record("GetOrderedPackageId", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetOrderedPackageIdWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderedPackageId()
+
+}
+
+func (r Virtual_Guest) GetOutboundPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetOutboundPrivateBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetOutboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPrivateBandwidthUsage()
+
+}
+
+func (r Virtual_Guest) GetOutboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetOutboundPublicBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetOutboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPublicBandwidthUsage()
+
+}
+
+func (r Virtual_Guest) GetOverBandwidthAllocationFlag() (resp int, err error) {
+	err = r.record("GetOverBandwidthAllocationFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOverBandwidthAllocationFlag()
+
+}
+
+func (r Virtual_Guest) GetPendingMigrationFlag() (resp bool, err error) {
+	err = r.record("GetPendingMigrationFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetPendingMigrationFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingMigrationFlag()
+
+}
+
+func (r Virtual_Guest) GetPlacementGroup() (resp datatypes.Virtual_PlacementGroup, err error) {
+	err = r.record("GetPlacementGroup", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetPlacementGroupWith(options sl.Options) (resp datatypes.Virtual_PlacementGroup, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPlacementGroup()
+
+}
+
+func (r Virtual_Guest) GetPowerState() (resp datatypes.Virtual_Guest_Power_State, err error) {
+	err = r.record("GetPowerState", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetPowerStateWith(options sl.Options) (resp datatypes.Virtual_Guest_Power_State, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPowerState()
+
+}
+
+func (r Virtual_Guest) GetPrimaryBackendIpAddress() (resp string, err error) {
+	err = r.record("GetPrimaryBackendIpAddress", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetPrimaryBackendIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendIpAddress()
+
+}
+
+func (r Virtual_Guest) GetPrimaryBackendNetworkComponent() (resp datatypes.Virtual_Guest_Network_Component, err error) {
+	err = r.record("GetPrimaryBackendNetworkComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetPrimaryBackendNetworkComponentWith(options sl.Options) (resp datatypes.Virtual_Guest_Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendNetworkComponent()
+
+}
+
+func (r Virtual_Guest) GetPrimaryIpAddress() (resp string, err error) {
+	err = r.record("GetPrimaryIpAddress", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetPrimaryIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryIpAddress()
+
+}
+
+func (r Virtual_Guest) GetPrimaryNetworkComponent() (resp datatypes.Virtual_Guest_Network_Component, err error) {
+	err = r.record("GetPrimaryNetworkComponent", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetPrimaryNetworkComponentWith(options sl.Options) (resp datatypes.Virtual_Guest_Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryNetworkComponent()
+
+}
+
+func (r Virtual_Guest) GetPrivateNetworkOnlyFlag() (resp bool, err error) {
+	err = r.record("GetPrivateNetworkOnlyFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetPrivateNetworkOnlyFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateNetworkOnlyFlag()
+
+}
+
+func (r Virtual_Guest) GetProjectedOverBandwidthAllocationFlag() (resp int, err error) {
+	err = r.record("GetProjectedOverBandwidthAllocationFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetProjectedOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedOverBandwidthAllocationFlag()
+
+}
+
+func (r Virtual_Guest) GetProjectedPublicBandwidthUsage() (resp datatypes.Float64, err error) {
+	err = r.record("GetProjectedPublicBandwidthUsage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetProjectedPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedPublicBandwidthUsage()
+
+}
+
+func (r Virtual_Guest) GetProvisionDate() (resp datatypes.Time, err error) {
+	err = r.record("GetProvisionDate", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetRecentEvents() (resp []datatypes.Notification_Occurrence_Event, err error) {
+	err = r.record("GetRecentEvents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetRecentEventsWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRecentEvents()
+
+}
+
+func (r Virtual_Guest) GetRecentMetricData(time *uint) (resp []datatypes.Metric_Tracking_Object, err error) {
+	err = r.record("GetRecentMetricData", []interface{}{time}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetRegionalGroup() (resp datatypes.Location_Group_Regional, err error) {
+	err = r.record("GetRegionalGroup", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetRegionalGroupWith(options sl.Options) (resp datatypes.Location_Group_Regional, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRegionalGroup()
+
+}
+
+func (r Virtual_Guest) GetRegionalInternetRegistry() (resp datatypes.Network_Regional_Internet_Registry, err error) {
+	err = r.record("GetRegionalInternetRegistry", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetRemoteMonitoringActiveAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
+	err = r.record("GetRemoteMonitoringActiveAlarms", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetRemoteMonitoringClosedAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
+	err = r.record("GetRemoteMonitoringClosedAlarms", []interface{}{startDate, endDate}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetReservedCapacityGroup() (resp datatypes.Virtual_ReservedCapacityGroup, err error) {
+	err = r.record("GetReservedCapacityGroup", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetReservedCapacityGroupFlag() (resp bool, err error) {
+	err = r.record("GetReservedCapacityGroupFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetReservedCapacityGroupFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReservedCapacityGroupFlag()
+
+}
+
+func (r Virtual_Guest) GetReservedCapacityGroupInstance() (resp datatypes.Virtual_ReservedCapacityGroup_Instance, err error) {
+	err = r.record("GetReservedCapacityGroupInstance", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetReservedCapacityGroupInstanceWith(options sl.Options) (resp datatypes.Virtual_ReservedCapacityGroup_Instance, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReservedCapacityGroupInstance()
+
+}
+
+func (r Virtual_Guest) GetReservedCapacityGroupWith(options sl.Options) (resp datatypes.Virtual_ReservedCapacityGroup, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReservedCapacityGroup()
+
+}
+
+func (r Virtual_Guest) GetReverseDomainRecords() (resp []datatypes.Dns_Domain, err error) {
+	err = r.record("GetReverseDomainRecords", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetScaleAssets() (resp []datatypes.Scale_Asset, err error) {
+	err = r.record("GetScaleAssets", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetScaleAssetsWith(options sl.Options) (resp []datatypes.Scale_Asset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleAssets()
+
+}
+
+func (r Virtual_Guest) GetScaleMember() (resp datatypes.Scale_Member_Virtual_Guest, err error) {
+	err = r.record("GetScaleMember", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetScaleMemberWith(options sl.Options) (resp datatypes.Scale_Member_Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleMember()
+
+}
+
+func (r Virtual_Guest) GetScaledFlag() (resp bool, err error) {
+	err = r.record("GetScaledFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetScaledFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaledFlag()
+
+}
+
+func (r Virtual_Guest) GetSecurityScanRequests() (resp []datatypes.Network_Security_Scanner_Request, err error) {
+	err = r.record("GetSecurityScanRequests", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetSecurityScanRequestsWith(options sl.Options) (resp []datatypes.Network_Security_Scanner_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityScanRequests()
+
+}
+
+func (r Virtual_Guest) GetServerRoom() (resp datatypes.Location, err error) {
+	err = r.record("GetServerRoom", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetServerRoomWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServerRoom()
+
+}
+
+func (r Virtual_Guest) GetSoftwareComponents() (resp []datatypes.Software_Component, err error) {
+	err = r.record("GetSoftwareComponents", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetSoftwareComponentsWith(options sl.Options) (resp []datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareComponents()
+
+}
+
+func (r Virtual_Guest) GetSshKeys() (resp []datatypes.Security_Ssh_Key, err error) {
+	err = r.record("GetSshKeys", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSshKeys()
+
+}
+
+func (r Virtual_Guest) GetStatus() (resp datatypes.Virtual_Guest_Status, err error) {
+	err = r.record("GetStatus", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetStatusWith(options sl.Options) (resp datatypes.Virtual_Guest_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+
+}
+
+func (r Virtual_Guest) GetTagReferences() (resp []datatypes.Tag_Reference, err error) {
+	err = r.record("GetTagReferences", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetTransientGuestFlag() (resp bool, err error) {
+	err = r.record("GetTransientGuestFlag", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetTransientGuestFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTransientGuestFlag()
+
+}
+
+func (r Virtual_Guest) GetTransientWebhookURI() (resp datatypes.Virtual_Guest_Attribute, err error) {
+	err = r.record("GetTransientWebhookURI", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetTransientWebhookURIWith(options sl.Options) (resp datatypes.Virtual_Guest_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTransientWebhookURI()
+
+}
+
+func (r Virtual_Guest) GetType() (resp datatypes.Virtual_Guest_Type, err error) {
+	err = r.record("GetType", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetTypeWith(options sl.Options) (resp datatypes.Virtual_Guest_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+
+}
+
+func (r Virtual_Guest) GetUpgradeItemPrices(includeDowngradeItemPrices *bool) (resp []datatypes.Product_Item_Price, err error) {
+	err = r.record("GetUpgradeItemPrices", []interface{}{includeDowngradeItemPrices}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetUpgradeRequest() (resp datatypes.Product_Upgrade_Request, err error) {
+	err = r.record("GetUpgradeRequest", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetUpgradeRequestWith(options sl.Options) (resp datatypes.Product_Upgrade_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeRequest()
+
+}
+
+func (r Virtual_Guest) GetUserData() (resp []datatypes.Virtual_Guest_Attribute, err error) {
+	err = r.record("GetUserData", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetUserDataWith(options sl.Options) (resp []datatypes.Virtual_Guest_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUserData()
+
+}
+
+func (r Virtual_Guest) GetUsers() (resp []datatypes.User_Customer, err error) {
+	err = r.record("GetUsers", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUsers()
+
+}
+
+func (r Virtual_Guest) GetValidBlockDeviceTemplateGroups(visibility *string) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	err = r.record("GetValidBlockDeviceTemplateGroups", []interface{}{visibility}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetVirtualRack() (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	err = r.record("GetVirtualRack", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetVirtualRackId() (resp int, err error) {
+	err = r.record("GetVirtualRackId", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetVirtualRackIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackId()
+
+}
+
+func (r Virtual_Guest) GetVirtualRackName() (resp string, err error) {
+	err = r.record("GetVirtualRackName", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) GetVirtualRackNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackName()
+
+}
+
+func (r Virtual_Guest) GetVirtualRackWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRack()
+
+}
+
+func (r Virtual_Guest) IsBackendPingable() (resp bool, err error) {
+	err = r.record("IsBackendPingable", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) IsPingable() (resp bool, err error) {
+	err = r.record("IsPingable", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) IsolateInstanceForDestructiveAction() (err error) {
+	err = r.record("IsolateInstanceForDestructiveAction", nil, r.Options, nil)
+	return
+
+}
+
+func (r Virtual_Guest) Migrate() (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("Migrate", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) MigrateDedicatedHost(destinationHostId *int) (err error) {
+	err = r.record("MigrateDedicatedHost", []interface{}{destinationHostId}, r.Options, nil)
+	return
+
+}
+
+func (r Virtual_Guest) MountIsoImage(diskImageId *int) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("MountIsoImage", []interface{}{diskImageId}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) Pause() (resp bool, err error) {
+	err = r.record("Pause", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) PowerCycle() (resp bool, err error) {
+	err = r.record("PowerCycle", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) PowerOff() (resp bool, err error) {
+	err = r.record("PowerOff", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) PowerOffSoft() (resp bool, err error) {
+	err = r.record("PowerOffSoft", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) PowerOn() (resp bool, err error) {
+	err = r.record("PowerOn", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) RebootDefault() (resp bool, err error) {
+	err = r.record("RebootDefault", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) RebootHard() (resp bool, err error) {
+	err = r.record("RebootHard", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) RebootSoft() (resp bool, err error) {
+	err = r.record("RebootSoft", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) ReloadCurrentOperatingSystemConfiguration() (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("ReloadCurrentOperatingSystemConfiguration", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) ReloadOperatingSystem(token *string, config *datatypes.Container_Hardware_Server_Configuration) (resp string, err error) {
+	err = r.record("ReloadOperatingSystem", []interface{}{token, config}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) RemoveAccessToNetworkStorage(networkStorageTemplateObject *datatypes.Network_Storage) (resp bool, err error) {
+	err = r.record("RemoveAccessToNetworkStorage", []interface{}{networkStorageTemplateObject}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) RemoveAccessToNetworkStorageList(networkStorageTemplateObjects []datatypes.Network_Storage) (resp bool, err error) {
+	err = r.record("RemoveAccessToNetworkStorageList", []interface{}{networkStorageTemplateObjects}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) RemoveTags(tags *string) (resp bool, err error) {
+	err = r.record("RemoveTags", []interface{}{tags}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) Resume() (resp bool, err error) {
+	err = r.record("Resume", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) SendTestReclaimScheduledAlert() (err error) {
+	err = r.record("SendTestReclaimScheduledAlert", nil, r.Options, nil)
+	return
+
+}
+
+func (r Virtual_Guest) SetPrivateNetworkInterfaceSpeed(newSpeed *int) (resp bool, err error) {
+	err = r.record("SetPrivateNetworkInterfaceSpeed", []interface{}{newSpeed}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) SetPublicNetworkInterfaceSpeed(newSpeed *int) (resp bool, err error) {
+	err = r.record("SetPublicNetworkInterfaceSpeed", []interface{}{newSpeed}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) SetTags(tags *string) (resp bool, err error) {
+	err = r.record("SetTags", []interface{}{tags}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) SetTransientWebhook(uri *string, secret *string) (err error) {
+	err = r.record("SetTransientWebhook", []interface{}{uri, secret}, r.Options, nil)
+	return
+
+}
+
+func (r Virtual_Guest) SetUserMetadata(metadata []string) (resp bool, err error) {
+	err = r.record("SetUserMetadata", []interface{}{metadata}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) ShutdownPrivatePort() (resp bool, err error) {
+	err = r.record("ShutdownPrivatePort", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) ShutdownPublicPort() (resp bool, err error) {
+	err = r.record("ShutdownPublicPort", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) UnmountIsoImage() (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	err = r.record("UnmountIsoImage", nil, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) ValidateImageTemplate(imageTemplateId *int) (resp bool, err error) {
+	err = r.record("ValidateImageTemplate", []interface{}{imageTemplateId}, r.Options, &resp)
+	return
+
+}
+
+func (r Virtual_Guest) VerifyReloadOperatingSystem(config *datatypes.Container_Hardware_Server_Configuration) (resp bool, err error) {
+	err = r.record("VerifyReloadOperatingSystem", []interface{}{config}, r.Options, &resp)
+	return
+
+}
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r FlexibleCredit_Program) Id(id int) FlexibleCredit_Program {
 	return r
 }
 
+func (r FlexibleCredit_Program) InitParameter(name string, value interface{}) FlexibleCredit_Program {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r FlexibleCredit_Program) Mask(mask string) FlexibleCredit_Program {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r FlexibleCredit_Program) Limit(limit int) FlexibleCredit_Program {
 	return r
 }
 
+func (r FlexibleCredit_Program) Unlimited() FlexibleCredit_Program {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r FlexibleCredit_Program) Offset(offset int) FlexibleCredit_Program {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r FlexibleCredit_Program) Timeout(timeout time.Duration) FlexibleCredit_Program {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r FlexibleCredit_Program) WithContext(ctx context.Context) FlexibleCredit_Program {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r FlexibleCredit_Program) GetAffiliatesAvailableForSelfEnrollmentByVerificationType(verificationTypeKeyName *string) (resp []datatypes.FlexibleCredit_Affiliate, err error) {
 	params := []interface{}{
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,16 @@ func (r Hardware) Id(id int) Hardware {
 	return r
 }
 
+func (r Hardware) InitParameter(name string, value interface{}) Hardware {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
+func (r Hardware) GlobalID(globalID string) Hardware {
+	r.Options.GlobalID = &globalID
+	return r
+}
+
 func (r Hardware) Mask(mask string) Hardware {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +76,26 @@ func (r Hardware) Limit(limit int) Hardware {
 	return r
 }
 
+func (r Hardware) Unlimited() Hardware {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Hardware) Offset(offset int) Hardware {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Hardware) Timeout(timeout time.Duration) Hardware {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Hardware) WithContext(ctx context.Context) Hardware {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is used to allow access to a SoftLayer_Network_Storage volume that supports host- or network-level access control.
 func (r Hardware) AllowAccessToNetworkStorage(networkStorageTemplateObject *datatypes.Network_Storage) (resp bool, err error) {
 	params := []interface{}{
@@ -454,18 +481,42 @@ func (r Hardware) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve A piece of hardware's active physical components.
 func (r Hardware) GetActiveComponents() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getActiveComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveComponentsWith is GetActiveComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetActiveComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveComponents()
+}
+
 // Retrieve A piece of hardware's active network monitoring incidents.
 func (r Hardware) GetActiveNetworkMonitorIncident() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getActiveNetworkMonitorIncident", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveNetworkMonitorIncidentWith is GetActiveNetworkMonitorIncident, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetActiveNetworkMonitorIncidentWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveNetworkMonitorIncident()
+}
+
 // The '''getAlarmHistory''' method retrieves a detailed history for the monitoring alarm. When calling this method, a start and end date for the history to be retrieved must be entered.
 func (r Hardware) GetAlarmHistory(startDate *datatypes.Time, endDate *datatypes.Time, alarmId *string) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -489,24 +540,56 @@ func (r Hardware) GetAllowedHost() (resp datatypes.Network_Storage_Allowed_Host,
 	return
 }
 
+// GetAllowedHostWith is GetAllowedHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetAllowedHostWith(options sl.Options) (resp datatypes.Network_Storage_Allowed_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHost()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects that this SoftLayer_Hardware has access to.
 func (r Hardware) GetAllowedNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getAllowedNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageWith is GetAllowedNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetAllowedNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorage()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects whose Replica that this SoftLayer_Hardware has access to.
 func (r Hardware) GetAllowedNetworkStorageReplicas() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getAllowedNetworkStorageReplicas", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageReplicasWith is GetAllowedNetworkStorageReplicas, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetAllowedNetworkStorageReplicasWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorageReplicas()
+}
+
 // Retrieve Information regarding an antivirus/spyware software component object.
 func (r Hardware) GetAntivirusSpywareSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getAntivirusSpywareSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetAntivirusSpywareSoftwareComponentWith is GetAntivirusSpywareSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetAntivirusSpywareSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAntivirusSpywareSoftwareComponent()
+}
+
 // This method is retrieve a list of SoftLayer_Network_Storage volumes that are authorized access to this SoftLayer_Hardware.
 func (r Hardware) GetAttachedNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
 	params := []interface{}{
@@ -522,6 +605,14 @@ func (r Hardware) GetAttributes() (resp []datatypes.Hardware_Attribute, err erro
 	return
 }
 
+// GetAttributesWith is GetAttributes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetAttributesWith(options sl.Options) (resp []datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+}
+
 // This method retrieves a list of SoftLayer_Network_Storage volumes that can be authorized to this SoftLayer_Hardware.
 func (r Hardware) GetAvailableNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
 	params := []interface{}{
@@ -537,6 +628,14 @@ func (r Hardware) GetAverageDailyPublicBandwidthUsage() (resp datatypes.Float64,
 	return
 }
 
+// GetAverageDailyPublicBandwidthUsageWith is GetAverageDailyPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetAverageDailyPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPublicBandwidthUsage()
+}
+
 // The '''getBackendIncomingBandwidth''' method retrieves the amount of incoming private network traffic used between the given start date and end date parameters. When entering start and end dates, only the month, day and year are used to calculate bandwidth totals - the time (HH:MM:SS) is ignored and defaults to midnight. The amount of bandwidth retrieved is measured in gigabytes.
 func (r Hardware) GetBackendIncomingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -553,6 +652,14 @@ func (r Hardware) GetBackendNetworkComponents() (resp []datatypes.Network_Compon
 	return
 }
 
+// GetBackendNetworkComponentsWith is GetBackendNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetBackendNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendNetworkComponents()
+}
+
 // The '''getBackendOutgoingBandwidth''' method retrieves the amount of outgoing private network traffic used between the given start date and end date parameters. When entering start and end dates, only the month, day and year are used to calculate bandwidth totals - the time (HH:MM:SS) is ignored and defaults to midnight. The amount of bandwidth retrieved is measured in gigabytes.
 func (r Hardware) GetBackendOutgoingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -569,54 +676,126 @@ func (r Hardware) GetBackendRouters() (resp []datatypes.Hardware, err error) {
 	return
 }
 
+// GetBackendRoutersWith is GetBackendRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetBackendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendRouters()
+}
+
 // Retrieve A hardware's allotted bandwidth (measured in GB).
 func (r Hardware) GetBandwidthAllocation() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getBandwidthAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllocationWith is GetBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllocation()
+}
+
 // Retrieve A hardware's allotted detail record. Allotment details link bandwidth allocation with allotments.
 func (r Hardware) GetBandwidthAllotmentDetail() (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getBandwidthAllotmentDetail", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllotmentDetailWith is GetBandwidthAllotmentDetail, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetBandwidthAllotmentDetailWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotmentDetail()
+}
+
 // Retrieve Information regarding a piece of hardware's benchmark certifications.
 func (r Hardware) GetBenchmarkCertifications() (resp []datatypes.Hardware_Benchmark_Certification, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getBenchmarkCertifications", nil, &r.Options, &resp)
 	return
 }
 
+// GetBenchmarkCertificationsWith is GetBenchmarkCertifications, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetBenchmarkCertificationsWith(options sl.Options) (resp []datatypes.Hardware_Benchmark_Certification, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBenchmarkCertifications()
+}
+
 // Retrieve Information regarding the billing item for a server.
 func (r Hardware) GetBillingItem() (resp datatypes.Billing_Item_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve A flag indicating that a billing item exists.
 func (r Hardware) GetBillingItemFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getBillingItemFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemFlagWith is GetBillingItemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItemFlag()
+}
+
 // Retrieve Determines whether the hardware is ineligible for cancellation because it is disconnected.
 func (r Hardware) GetBlockCancelBecauseDisconnectedFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getBlockCancelBecauseDisconnectedFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBlockCancelBecauseDisconnectedFlagWith is GetBlockCancelBecauseDisconnectedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetBlockCancelBecauseDisconnectedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockCancelBecauseDisconnectedFlag()
+}
+
 // Retrieve Status indicating whether or not a piece of hardware has business continuance insurance.
 func (r Hardware) GetBusinessContinuanceInsuranceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getBusinessContinuanceInsuranceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBusinessContinuanceInsuranceFlagWith is GetBusinessContinuanceInsuranceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetBusinessContinuanceInsuranceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBusinessContinuanceInsuranceFlag()
+}
+
 // Retrieve Child hardware.
 func (r Hardware) GetChildrenHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getChildrenHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildrenHardwareWith is GetChildrenHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetChildrenHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildrenHardware()
+}
+
 // no documentation yet
 func (r Hardware) GetComponentDetailsXML() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getComponentDetailsXML", nil, &r.Options, &resp)
@@ -629,12 +808,28 @@ func (r Hardware) GetComponents() (resp []datatypes.Hardware_Component, err erro
 	return
 }
 
+// GetComponentsWith is GetComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetComponents()
+}
+
 // Retrieve A continuous data protection/server backup software component object.
 func (r Hardware) GetContinuousDataProtectionSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getContinuousDataProtectionSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetContinuousDataProtectionSoftwareComponentWith is GetContinuousDataProtectionSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetContinuousDataProtectionSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetContinuousDataProtectionSoftwareComponent()
+}
+
 //
 // There are many options that may be provided while ordering a server, this method can be used to determine what these options are.
 //
@@ -651,6 +846,14 @@ func (r Hardware) GetCurrentBillableBandwidthUsage() (resp datatypes.Float64, er
 	return
 }
 
+// GetCurrentBillableBandwidthUsageWith is GetCurrentBillableBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetCurrentBillableBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentBillableBandwidthUsage()
+}
+
 // Get the billing detail for this hardware for the current billing period. This does not include bandwidth usage.
 func (r Hardware) GetCurrentBillingDetail() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getCurrentBillingDetail", nil, &r.Options, &resp)
@@ -679,96 +882,224 @@ func (r Hardware) GetDatacenter() (resp datatypes.Location, err error) {
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // Retrieve The name of the datacenter in which a piece of hardware resides.
 func (r Hardware) GetDatacenterName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getDatacenterName", nil, &r.Options, &resp)
 	return
 }
 
+// GetDatacenterNameWith is GetDatacenterName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetDatacenterNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenterName()
+}
+
 // Retrieve Number of day(s) a server have been in spare pool.
 func (r Hardware) GetDaysInSparePool() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getDaysInSparePool", nil, &r.Options, &resp)
 	return
 }
 
+// GetDaysInSparePoolWith is GetDaysInSparePool, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetDaysInSparePoolWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDaysInSparePool()
+}
+
 // Retrieve All hardware that has uplink network connections to a piece of hardware.
 func (r Hardware) GetDownlinkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getDownlinkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkHardwareWith is GetDownlinkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetDownlinkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkHardware()
+}
+
 // Retrieve All hardware that has uplink network connections to a piece of hardware.
 func (r Hardware) GetDownlinkNetworkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getDownlinkNetworkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkNetworkHardwareWith is GetDownlinkNetworkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetDownlinkNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkNetworkHardware()
+}
+
 // Retrieve Information regarding all servers attached to a piece of network hardware.
 func (r Hardware) GetDownlinkServers() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getDownlinkServers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkServersWith is GetDownlinkServers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetDownlinkServersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkServers()
+}
+
 // Retrieve Information regarding all virtual guests attached to a piece of network hardware.
 func (r Hardware) GetDownlinkVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getDownlinkVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkVirtualGuestsWith is GetDownlinkVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetDownlinkVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkVirtualGuests()
+}
+
 // Retrieve All hardware downstream from a network device.
 func (r Hardware) GetDownstreamHardwareBindings() (resp []datatypes.Network_Component_Uplink_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getDownstreamHardwareBindings", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamHardwareBindingsWith is GetDownstreamHardwareBindings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetDownstreamHardwareBindingsWith(options sl.Options) (resp []datatypes.Network_Component_Uplink_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamHardwareBindings()
+}
+
 // Retrieve All network hardware downstream from the selected piece of hardware.
 func (r Hardware) GetDownstreamNetworkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getDownstreamNetworkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamNetworkHardwareWith is GetDownstreamNetworkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetDownstreamNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamNetworkHardware()
+}
+
 // Retrieve All network hardware with monitoring warnings or errors that are downstream from the selected piece of hardware.
 func (r Hardware) GetDownstreamNetworkHardwareWithIncidents() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getDownstreamNetworkHardwareWithIncidents", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamNetworkHardwareWithIncidentsWith is GetDownstreamNetworkHardwareWithIncidents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetDownstreamNetworkHardwareWithIncidentsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamNetworkHardwareWithIncidents()
+}
+
 // Retrieve Information regarding all servers attached downstream to a piece of network hardware.
 func (r Hardware) GetDownstreamServers() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getDownstreamServers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamServersWith is GetDownstreamServers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetDownstreamServersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamServers()
+}
+
 // Retrieve Information regarding all virtual guests attached to a piece of network hardware.
 func (r Hardware) GetDownstreamVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getDownstreamVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamVirtualGuestsWith is GetDownstreamVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetDownstreamVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamVirtualGuests()
+}
+
 // Retrieve The drive controllers contained within a piece of hardware.
 func (r Hardware) GetDriveControllers() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getDriveControllers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDriveControllersWith is GetDriveControllers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetDriveControllersWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDriveControllers()
+}
+
 // Retrieve Information regarding a piece of hardware's associated EVault network storage service account.
 func (r Hardware) GetEvaultNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getEvaultNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetEvaultNetworkStorageWith is GetEvaultNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetEvaultNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvaultNetworkStorage()
+}
+
 // Retrieve Information regarding a piece of hardware's firewall services.
 func (r Hardware) GetFirewallServiceComponent() (resp datatypes.Network_Component_Firewall, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getFirewallServiceComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetFirewallServiceComponentWith is GetFirewallServiceComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetFirewallServiceComponentWith(options sl.Options) (resp datatypes.Network_Component_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirewallServiceComponent()
+}
+
 // Retrieve Defines the fixed components in a fixed configuration bare metal server.
 func (r Hardware) GetFixedConfigurationPreset() (resp datatypes.Product_Package_Preset, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getFixedConfigurationPreset", nil, &r.Options, &resp)
 	return
 }
 
+// GetFixedConfigurationPresetWith is GetFixedConfigurationPreset, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetFixedConfigurationPresetWith(options sl.Options) (resp datatypes.Product_Package_Preset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFixedConfigurationPreset()
+}
+
 // The '''getFrontendIncomingBandwidth''' method retrieves the amount of incoming public network traffic used by a server between the given start and end date parameters. When entering the ''dateTime'' parameter, only the month, day and year of the start and end dates are required - the time (hour, minute and second) are set to midnight by default and cannot be changed. The amount of bandwidth retrieved is measured in gigabytes (GB).
 func (r Hardware) GetFrontendIncomingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -785,6 +1116,14 @@ func (r Hardware) GetFrontendNetworkComponents() (resp []datatypes.Network_Compo
 	return
 }
 
+// GetFrontendNetworkComponentsWith is GetFrontendNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetFrontendNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendNetworkComponents()
+}
+
 // The '''getFrontendOutgoingBandwidth''' method retrieves the amount of outgoing public network traffic used by a server between the given start and end date parameters. The ''dateTime'' parameter requires only the day, month and year to be entered - the time (hour, minute and second) are set to midnight be default in order to gather the data for the entire start and end date indicated in the parameter. The amount of bandwidth retrieved is measured in gigabytes (GB).
 func (r Hardware) GetFrontendOutgoingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -801,54 +1140,126 @@ func (r Hardware) GetFrontendRouters() (resp []datatypes.Hardware, err error) {
 	return
 }
 
+// GetFrontendRoutersWith is GetFrontendRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetFrontendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendRouters()
+}
+
 // Retrieve A hardware's universally unique identifier.
 func (r Hardware) GetGlobalIdentifier() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getGlobalIdentifier", nil, &r.Options, &resp)
 	return
 }
 
+// GetGlobalIdentifierWith is GetGlobalIdentifier, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetGlobalIdentifierWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGlobalIdentifier()
+}
+
 // Retrieve The hard drives contained within a piece of hardware.
 func (r Hardware) GetHardDrives() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getHardDrives", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardDrivesWith is GetHardDrives, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetHardDrivesWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardDrives()
+}
+
 // Retrieve The chassis that a piece of hardware is housed in.
 func (r Hardware) GetHardwareChassis() (resp datatypes.Hardware_Chassis, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getHardwareChassis", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareChassisWith is GetHardwareChassis, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetHardwareChassisWith(options sl.Options) (resp datatypes.Hardware_Chassis, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareChassis()
+}
+
 // Retrieve A hardware's function.
 func (r Hardware) GetHardwareFunction() (resp datatypes.Hardware_Function, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getHardwareFunction", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareFunctionWith is GetHardwareFunction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetHardwareFunctionWith(options sl.Options) (resp datatypes.Hardware_Function, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareFunction()
+}
+
 // Retrieve A hardware's function.
 func (r Hardware) GetHardwareFunctionDescription() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getHardwareFunctionDescription", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareFunctionDescriptionWith is GetHardwareFunctionDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetHardwareFunctionDescriptionWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareFunctionDescription()
+}
+
 // Retrieve A hardware's status.
 func (r Hardware) GetHardwareStatus() (resp datatypes.Hardware_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getHardwareStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareStatusWith is GetHardwareStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetHardwareStatusWith(options sl.Options) (resp datatypes.Hardware_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareStatus()
+}
+
 // Retrieve Determine in hardware object has TPM enabled.
 func (r Hardware) GetHasTrustedPlatformModuleBillingItemFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getHasTrustedPlatformModuleBillingItemFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasTrustedPlatformModuleBillingItemFlagWith is GetHasTrustedPlatformModuleBillingItemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetHasTrustedPlatformModuleBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasTrustedPlatformModuleBillingItemFlag()
+}
+
 // Retrieve Information regarding a host IPS software component object.
 func (r Hardware) GetHostIpsSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getHostIpsSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetHostIpsSoftwareComponentWith is GetHostIpsSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetHostIpsSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHostIpsSoftwareComponent()
+}
+
 // The '''getHourlyBandwidth''' method retrieves all bandwidth updates hourly for the specified hardware. Because the potential number of data points can become excessive, the method limits the user to obtain data in 24-hour intervals. The required ''dateTime'' parameter is used as the starting point for the query and will be calculated for the 24-hour period starting with the specified date and time. For example, entering a parameter of
 //
 // '02/01/2008 0:00'
@@ -871,36 +1282,84 @@ func (r Hardware) GetHourlyBillingFlag() (resp bool, err error) {
 	return
 }
 
+// GetHourlyBillingFlagWith is GetHourlyBillingFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetHourlyBillingFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyBillingFlag()
+}
+
 // Retrieve The sum of all the inbound network traffic data for the last 30 days.
 func (r Hardware) GetInboundBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getInboundBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundBandwidthUsageWith is GetInboundBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetInboundBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundBandwidthUsage()
+}
+
 // Retrieve The total public inbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware) GetInboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getInboundPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundPublicBandwidthUsageWith is GetInboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetInboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPublicBandwidthUsage()
+}
+
 // Retrieve Information regarding the last transaction a server performed.
 func (r Hardware) GetLastTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getLastTransaction", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastTransactionWith is GetLastTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetLastTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastTransaction()
+}
+
 // Retrieve A piece of hardware's latest network monitoring incident.
 func (r Hardware) GetLatestNetworkMonitorIncident() (resp datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getLatestNetworkMonitorIncident", nil, &r.Options, &resp)
 	return
 }
 
+// GetLatestNetworkMonitorIncidentWith is GetLatestNetworkMonitorIncident, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetLatestNetworkMonitorIncidentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestNetworkMonitorIncident()
+}
+
 // Retrieve Where a piece of hardware is located within SoftLayer's location hierarchy.
 func (r Hardware) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve
 func (r Hardware) GetLocationPathString() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getLocationPathString", nil, &r.Options, &resp)
@@ -913,30 +1372,70 @@ func (r Hardware) GetLockboxNetworkStorage() (resp datatypes.Network_Storage, er
 	return
 }
 
+// GetLockboxNetworkStorageWith is GetLockboxNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetLockboxNetworkStorageWith(options sl.Options) (resp datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLockboxNetworkStorage()
+}
+
 // Retrieve A flag indicating that the hardware is a managed resource.
 func (r Hardware) GetManagedResourceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getManagedResourceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetManagedResourceFlagWith is GetManagedResourceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetManagedResourceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedResourceFlag()
+}
+
 // Retrieve Information regarding a piece of hardware's memory.
 func (r Hardware) GetMemory() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getMemory", nil, &r.Options, &resp)
 	return
 }
 
+// GetMemoryWith is GetMemory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetMemoryWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMemory()
+}
+
 // Retrieve The amount of memory a piece of hardware has, measured in gigabytes.
 func (r Hardware) GetMemoryCapacity() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getMemoryCapacity", nil, &r.Options, &resp)
 	return
 }
 
+// GetMemoryCapacityWith is GetMemoryCapacity, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetMemoryCapacityWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMemoryCapacity()
+}
+
 // Retrieve A piece of hardware's metric tracking object.
 func (r Hardware) GetMetricTrackingObject() (resp datatypes.Metric_Tracking_Object_HardwareServer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getMetricTrackingObject", nil, &r.Options, &resp)
 	return
 }
 
+// GetMetricTrackingObjectWith is GetMetricTrackingObject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_HardwareServer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+}
+
 // Returns open monitoring alarms for a given time period
 func (r Hardware) GetMonitoringActiveAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -953,6 +1452,14 @@ func (r Hardware) GetMonitoringAgents() (resp []datatypes.Monitoring_Agent, err
 	return
 }
 
+// GetMonitoringAgentsWith is GetMonitoringAgents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetMonitoringAgentsWith(options sl.Options) (resp []datatypes.Monitoring_Agent, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringAgents()
+}
+
 // Returns closed monitoring alarms for a given time period
 func (r Hardware) GetMonitoringClosedAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -969,114 +1476,266 @@ func (r Hardware) GetMonitoringRobot() (resp datatypes.Monitoring_Robot, err err
 	return
 }
 
+// GetMonitoringRobotWith is GetMonitoringRobot, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetMonitoringRobotWith(options sl.Options) (resp datatypes.Monitoring_Robot, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringRobot()
+}
+
 // Retrieve Information regarding a piece of hardware's network monitoring services.
 func (r Hardware) GetMonitoringServiceComponent() (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getMonitoringServiceComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceComponentWith is GetMonitoringServiceComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetMonitoringServiceComponentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceComponent()
+}
+
 // Retrieve The monitoring service flag eligibility status for a piece of hardware.
 func (r Hardware) GetMonitoringServiceEligibilityFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getMonitoringServiceEligibilityFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceEligibilityFlagWith is GetMonitoringServiceEligibilityFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetMonitoringServiceEligibilityFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceEligibilityFlag()
+}
+
 // Retrieve The service flag status for a piece of hardware.
 func (r Hardware) GetMonitoringServiceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getMonitoringServiceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceFlagWith is GetMonitoringServiceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetMonitoringServiceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceFlag()
+}
+
 // Retrieve Information regarding a piece of hardware's motherboard.
 func (r Hardware) GetMotherboard() (resp datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getMotherboard", nil, &r.Options, &resp)
 	return
 }
 
+// GetMotherboardWith is GetMotherboard, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetMotherboardWith(options sl.Options) (resp datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMotherboard()
+}
+
 // Retrieve Information regarding a piece of hardware's network cards.
 func (r Hardware) GetNetworkCards() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNetworkCards", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkCardsWith is GetNetworkCards, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetNetworkCardsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkCards()
+}
+
 // Retrieve Returns a hardware's network components.
 func (r Hardware) GetNetworkComponents() (resp []datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNetworkComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkComponentsWith is GetNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponents()
+}
+
 // Retrieve The gateway member if this device is part of a network gateway.
 func (r Hardware) GetNetworkGatewayMember() (resp datatypes.Network_Gateway_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNetworkGatewayMember", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkGatewayMemberWith is GetNetworkGatewayMember, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetNetworkGatewayMemberWith(options sl.Options) (resp datatypes.Network_Gateway_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGatewayMember()
+}
+
 // Retrieve Whether or not this device is part of a network gateway.
 func (r Hardware) GetNetworkGatewayMemberFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNetworkGatewayMemberFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkGatewayMemberFlagWith is GetNetworkGatewayMemberFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetNetworkGatewayMemberFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGatewayMemberFlag()
+}
+
 // Retrieve A piece of hardware's network management IP address.
 func (r Hardware) GetNetworkManagementIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNetworkManagementIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkManagementIpAddressWith is GetNetworkManagementIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetNetworkManagementIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkManagementIpAddress()
+}
+
 // Retrieve All servers with failed monitoring that are attached downstream to a piece of hardware.
 func (r Hardware) GetNetworkMonitorAttachedDownHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNetworkMonitorAttachedDownHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorAttachedDownHardwareWith is GetNetworkMonitorAttachedDownHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetNetworkMonitorAttachedDownHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorAttachedDownHardware()
+}
+
 // Retrieve Virtual guests that are attached downstream to a hardware that have failed monitoring
 func (r Hardware) GetNetworkMonitorAttachedDownVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNetworkMonitorAttachedDownVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorAttachedDownVirtualGuestsWith is GetNetworkMonitorAttachedDownVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetNetworkMonitorAttachedDownVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorAttachedDownVirtualGuests()
+}
+
 // Retrieve The status of all of a piece of hardware's network monitoring incidents.
 func (r Hardware) GetNetworkMonitorIncidents() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNetworkMonitorIncidents", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorIncidentsWith is GetNetworkMonitorIncidents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetNetworkMonitorIncidentsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorIncidents()
+}
+
 // Retrieve Information regarding a piece of hardware's network monitors.
 func (r Hardware) GetNetworkMonitors() (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNetworkMonitors", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorsWith is GetNetworkMonitors, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetNetworkMonitorsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitors()
+}
+
 // Retrieve The value of a hardware's network status attribute.
 func (r Hardware) GetNetworkStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNetworkStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStatusWith is GetNetworkStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetNetworkStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStatus()
+}
+
 // Retrieve The hardware's related network status attribute.
 func (r Hardware) GetNetworkStatusAttribute() (resp datatypes.Hardware_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNetworkStatusAttribute", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStatusAttributeWith is GetNetworkStatusAttribute, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetNetworkStatusAttributeWith(options sl.Options) (resp datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStatusAttribute()
+}
+
 // Retrieve Information regarding a piece of hardware's associated network storage service account.
 func (r Hardware) GetNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStorageWith is GetNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStorage()
+}
+
 // Retrieve The network virtual LANs (VLANs) associated with a piece of hardware's network components.
 func (r Hardware) GetNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNetworkVlans", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlansWith is GetNetworkVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlans()
+}
+
 // Retrieve A hardware's allotted bandwidth for the next billing cycle (measured in GB).
 func (r Hardware) GetNextBillingCycleBandwidthAllocation() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNextBillingCycleBandwidthAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextBillingCycleBandwidthAllocationWith is GetNextBillingCycleBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetNextBillingCycleBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextBillingCycleBandwidthAllocation()
+}
+
 // Retrieve
 func (r Hardware) GetNotesHistory() (resp []datatypes.Hardware_Note, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getNotesHistory", nil, &r.Options, &resp)
@@ -1095,78 +1754,182 @@ func (r Hardware) GetOperatingSystem() (resp datatypes.Software_Component_Operat
 	return
 }
 
+// GetOperatingSystemWith is GetOperatingSystem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetOperatingSystemWith(options sl.Options) (resp datatypes.Software_Component_OperatingSystem, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystem()
+}
+
 // Retrieve A hardware's operating system software description.
 func (r Hardware) GetOperatingSystemReferenceCode() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getOperatingSystemReferenceCode", nil, &r.Options, &resp)
 	return
 }
 
+// GetOperatingSystemReferenceCodeWith is GetOperatingSystemReferenceCode, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetOperatingSystemReferenceCodeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystemReferenceCode()
+}
+
 // Retrieve The sum of all the outbound network traffic data for the last 30 days.
 func (r Hardware) GetOutboundBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getOutboundBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundBandwidthUsageWith is GetOutboundBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetOutboundBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundBandwidthUsage()
+}
+
 // Retrieve The total public outbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware) GetOutboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getOutboundPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundPublicBandwidthUsageWith is GetOutboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetOutboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPublicBandwidthUsage()
+}
+
 // Retrieve Blade Bay
 func (r Hardware) GetParentBay() (resp datatypes.Hardware_Blade, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getParentBay", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentBayWith is GetParentBay, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetParentBayWith(options sl.Options) (resp datatypes.Hardware_Blade, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentBay()
+}
+
 // Retrieve Parent Hardware.
 func (r Hardware) GetParentHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getParentHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentHardwareWith is GetParentHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetParentHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentHardware()
+}
+
 // Retrieve Information regarding the Point of Presence (PoP) location in which a piece of hardware resides.
 func (r Hardware) GetPointOfPresenceLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getPointOfPresenceLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetPointOfPresenceLocationWith is GetPointOfPresenceLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetPointOfPresenceLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPointOfPresenceLocation()
+}
+
 // Retrieve The power components for a hardware object.
 func (r Hardware) GetPowerComponents() (resp []datatypes.Hardware_Power_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getPowerComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetPowerComponentsWith is GetPowerComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetPowerComponentsWith(options sl.Options) (resp []datatypes.Hardware_Power_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPowerComponents()
+}
+
 // Retrieve Information regarding a piece of hardware's power supply.
 func (r Hardware) GetPowerSupply() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getPowerSupply", nil, &r.Options, &resp)
 	return
 }
 
+// GetPowerSupplyWith is GetPowerSupply, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetPowerSupplyWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPowerSupply()
+}
+
 // Retrieve The hardware's primary private IP address.
 func (r Hardware) GetPrimaryBackendIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getPrimaryBackendIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryBackendIpAddressWith is GetPrimaryBackendIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetPrimaryBackendIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendIpAddress()
+}
+
 // Retrieve Information regarding the hardware's primary back-end network component.
 func (r Hardware) GetPrimaryBackendNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getPrimaryBackendNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryBackendNetworkComponentWith is GetPrimaryBackendNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetPrimaryBackendNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendNetworkComponent()
+}
+
 // Retrieve The hardware's primary public IP address.
 func (r Hardware) GetPrimaryIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getPrimaryIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryIpAddressWith is GetPrimaryIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetPrimaryIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryIpAddress()
+}
+
 // Retrieve Information regarding the hardware's primary public network component.
 func (r Hardware) GetPrimaryNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getPrimaryNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryNetworkComponentWith is GetPrimaryNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetPrimaryNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryNetworkComponent()
+}
+
 // Retrieve a graph of a server's private network bandwidth usage over the specified timeframe. If no timeframe is specified then getPublicBandwidthGraphImage retrieves the last 24 hours of public bandwidth usage. getPrivateBandwidthGraphImage returns a PNG image measuring 827 pixels by 293 pixels.
 func (r Hardware) GetPrivateBandwidthData(startTime *int, endTime *int) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -1183,24 +1946,56 @@ func (r Hardware) GetPrivateNetworkOnlyFlag() (resp bool, err error) {
 	return
 }
 
+// GetPrivateNetworkOnlyFlagWith is GetPrivateNetworkOnlyFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetPrivateNetworkOnlyFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateNetworkOnlyFlag()
+}
+
 // Retrieve The total number of processor cores, summed from all processors that are attached to a piece of hardware
 func (r Hardware) GetProcessorCoreAmount() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getProcessorCoreAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetProcessorCoreAmountWith is GetProcessorCoreAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetProcessorCoreAmountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessorCoreAmount()
+}
+
 // Retrieve The total number of physical processor cores, summed from all processors that are attached to a piece of hardware
 func (r Hardware) GetProcessorPhysicalCoreAmount() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getProcessorPhysicalCoreAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetProcessorPhysicalCoreAmountWith is GetProcessorPhysicalCoreAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetProcessorPhysicalCoreAmountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessorPhysicalCoreAmount()
+}
+
 // Retrieve Information regarding a piece of hardware's processors.
 func (r Hardware) GetProcessors() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getProcessors", nil, &r.Options, &resp)
 	return
 }
 
+// GetProcessorsWith is GetProcessors, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetProcessorsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessors()
+}
+
 // Retrieve a graph of a server's public network bandwidth usage over the specified timeframe. If no timeframe is specified then getPublicBandwidthGraphImage retrieves the last 24 hours of public bandwidth usage. getPublicBandwidthGraphImage returns a PNG image measuring 827 pixels by 293 pixels.
 func (r Hardware) GetPublicBandwidthData(startTime *int, endTime *int) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -1223,24 +2018,56 @@ func (r Hardware) GetRaidControllers() (resp []datatypes.Hardware_Component, err
 	return
 }
 
+// GetRaidControllersWith is GetRaidControllers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetRaidControllersWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRaidControllers()
+}
+
 // Retrieve Recent events that impact this hardware.
 func (r Hardware) GetRecentEvents() (resp []datatypes.Notification_Occurrence_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getRecentEvents", nil, &r.Options, &resp)
 	return
 }
 
+// GetRecentEventsWith is GetRecentEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetRecentEventsWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRecentEvents()
+}
+
 // Retrieve User credentials to issue commands and/or interact with the server's remote management card.
 func (r Hardware) GetRemoteManagementAccounts() (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getRemoteManagementAccounts", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementAccountsWith is GetRemoteManagementAccounts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetRemoteManagementAccountsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementAccounts()
+}
+
 // Retrieve A hardware's associated remote management component. This is normally IPMI.
 func (r Hardware) GetRemoteManagementComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getRemoteManagementComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementComponentWith is GetRemoteManagementComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetRemoteManagementComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementComponent()
+}
+
 // Retrieve
 func (r Hardware) GetResourceConfigurations() (resp []datatypes.Hardware_Resource_Configuration, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getResourceConfigurations", nil, &r.Options, &resp)
@@ -1265,24 +2092,56 @@ func (r Hardware) GetResourceGroups() (resp []datatypes.Resource_Group, err erro
 	return
 }
 
+// GetResourceGroupsWith is GetResourceGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetResourceGroupsWith(options sl.Options) (resp []datatypes.Resource_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResourceGroups()
+}
+
 // Retrieve A hardware's routers.
 func (r Hardware) GetRouters() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getRouters", nil, &r.Options, &resp)
 	return
 }
 
+// GetRoutersWith is GetRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRouters()
+}
+
 // Retrieve Collection of scale assets this hardware corresponds to.
 func (r Hardware) GetScaleAssets() (resp []datatypes.Scale_Asset, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getScaleAssets", nil, &r.Options, &resp)
 	return
 }
 
+// GetScaleAssetsWith is GetScaleAssets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetScaleAssetsWith(options sl.Options) (resp []datatypes.Scale_Asset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleAssets()
+}
+
 // Retrieve Information regarding a piece of hardware's vulnerability scan requests.
 func (r Hardware) GetSecurityScanRequests() (resp []datatypes.Network_Security_Scanner_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getSecurityScanRequests", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecurityScanRequestsWith is GetSecurityScanRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetSecurityScanRequestsWith(options sl.Options) (resp []datatypes.Network_Security_Scanner_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityScanRequests()
+}
+
 // The '''getSensorData''' method retrieves a server's hardware state via its internal sensors. Remote sensor data is transmitted to the SoftLayer API by way of the server's remote management card. Sensor data measures various information, including system temperatures, voltages and other local server settings. Sensor data is cached for 30 second; calls made to this method for the same server within 30 seconds of each other will result in the same data being returned. To ensure that the data retrieved retrieves snapshot of varied data, make calls greater than 30 seconds apart.
 func (r Hardware) GetSensorData() (resp []datatypes.Container_RemoteManagement_SensorReading, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getSensorData", nil, &r.Options, &resp)
@@ -1313,6 +2172,14 @@ func (r Hardware) GetServerRoom() (resp datatypes.Location, err error) {
 	return
 }
 
+// GetServerRoomWith is GetServerRoom, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetServerRoomWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServerRoom()
+}
+
 // The '''getServerTemperatureGraphs''' retrieves the server's temperatures and displays the various temperatures using thermometer graphs. Temperatures retrieved are CPU temperature(s) and system temperatures. Data used to construct the graphs is retrieved from the server's remote management card. All graphs returned will have an associated title.
 func (r Hardware) GetServerTemperatureGraphs() (resp []datatypes.Container_RemoteManagement_Graphs_SensorTemperature, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getServerTemperatureGraphs", nil, &r.Options, &resp)
@@ -1325,24 +2192,56 @@ func (r Hardware) GetServiceProvider() (resp datatypes.Service_Provider, err err
 	return
 }
 
+// GetServiceProviderWith is GetServiceProvider, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetServiceProviderWith(options sl.Options) (resp datatypes.Service_Provider, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceProvider()
+}
+
 // Retrieve Information regarding a piece of hardware's installed software.
 func (r Hardware) GetSoftwareComponents() (resp []datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getSoftwareComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareComponentsWith is GetSoftwareComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetSoftwareComponentsWith(options sl.Options) (resp []datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareComponents()
+}
+
 // Retrieve Information regarding the billing item for a spare pool server.
 func (r Hardware) GetSparePoolBillingItem() (resp datatypes.Billing_Item_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getSparePoolBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetSparePoolBillingItemWith is GetSparePoolBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetSparePoolBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSparePoolBillingItem()
+}
+
 // Retrieve SSH keys to be installed on the server during provisioning or an OS reload.
 func (r Hardware) GetSshKeys() (resp []datatypes.Security_Ssh_Key, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getSshKeys", nil, &r.Options, &resp)
 	return
 }
 
+// GetSshKeysWith is GetSshKeys, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSshKeys()
+}
+
 // Retrieve
 func (r Hardware) GetStorageNetworkComponents() (resp []datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getStorageNetworkComponents", nil, &r.Options, &resp)
@@ -1380,73 +2279,169 @@ func (r Hardware) GetUpgradeRequest() (resp datatypes.Product_Upgrade_Request, e
 	return
 }
 
+// GetUpgradeRequestWith is GetUpgradeRequest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetUpgradeRequestWith(options sl.Options) (resp datatypes.Product_Upgrade_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeRequest()
+}
+
 // Retrieve The network device connected to a piece of hardware.
 func (r Hardware) GetUplinkHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getUplinkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetUplinkHardwareWith is GetUplinkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetUplinkHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUplinkHardware()
+}
+
 // Retrieve Information regarding the network component that is one level higher than a piece of hardware on the network infrastructure.
 func (r Hardware) GetUplinkNetworkComponents() (resp []datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getUplinkNetworkComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetUplinkNetworkComponentsWith is GetUplinkNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetUplinkNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUplinkNetworkComponents()
+}
+
 // Retrieve An array containing a single string of custom user data for a hardware order. Max size is 16 kb.
 func (r Hardware) GetUserData() (resp []datatypes.Hardware_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getUserData", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserDataWith is GetUserData, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetUserDataWith(options sl.Options) (resp []datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUserData()
+}
+
 // Retrieve Information regarding the virtual chassis for a piece of hardware.
 func (r Hardware) GetVirtualChassis() (resp datatypes.Hardware_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getVirtualChassis", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualChassisWith is GetVirtualChassis, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetVirtualChassisWith(options sl.Options) (resp datatypes.Hardware_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualChassis()
+}
+
 // Retrieve Information regarding the virtual chassis siblings for a piece of hardware.
 func (r Hardware) GetVirtualChassisSiblings() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getVirtualChassisSiblings", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualChassisSiblingsWith is GetVirtualChassisSiblings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetVirtualChassisSiblingsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualChassisSiblings()
+}
+
 // Retrieve A piece of hardware's virtual host record.
 func (r Hardware) GetVirtualHost() (resp datatypes.Virtual_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getVirtualHost", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualHostWith is GetVirtualHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetVirtualHostWith(options sl.Options) (resp datatypes.Virtual_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualHost()
+}
+
 // Retrieve Information regarding a piece of hardware's virtual software licenses.
 func (r Hardware) GetVirtualLicenses() (resp []datatypes.Software_VirtualLicense, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getVirtualLicenses", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualLicensesWith is GetVirtualLicenses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetVirtualLicensesWith(options sl.Options) (resp []datatypes.Software_VirtualLicense, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualLicenses()
+}
+
 // Retrieve Information regarding the bandwidth allotment to which a piece of hardware belongs.
 func (r Hardware) GetVirtualRack() (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getVirtualRack", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackWith is GetVirtualRack, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetVirtualRackWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRack()
+}
+
 // Retrieve The name of the bandwidth allotment belonging to a piece of hardware.
 func (r Hardware) GetVirtualRackId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getVirtualRackId", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackIdWith is GetVirtualRackId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetVirtualRackIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackId()
+}
+
 // Retrieve The name of the bandwidth allotment belonging to a piece of hardware.
 func (r Hardware) GetVirtualRackName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getVirtualRackName", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackNameWith is GetVirtualRackName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetVirtualRackNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackName()
+}
+
 // Retrieve A piece of hardware's virtualization platform software.
 func (r Hardware) GetVirtualizationPlatform() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "getVirtualizationPlatform", nil, &r.Options, &resp)
 	return
 }
 
-// The '''importVirtualHost''' method attempts to import the host record for the virtualization platform running on a server.
+// GetVirtualizationPlatformWith is GetVirtualizationPlatform, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware) GetVirtualizationPlatformWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualizationPlatform()
+}
+
+// The '''importVirtualHost''' method attempts to import the host record for the virtualization platform running on a server.
 func (r Hardware) ImportVirtualHost() (resp datatypes.Virtual_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware", "importVirtualHost", nil, &r.Options, &resp)
 	return
@@ -1583,6 +2578,11 @@ func (r Hardware_Benchmark_Certification) Id(id int) Hardware_Benchmark_Certific
 	return r
 }
 
+func (r Hardware_Benchmark_Certification) InitParameter(name string, value interface{}) Hardware_Benchmark_Certification {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Hardware_Benchmark_Certification) Mask(mask string) Hardware_Benchmark_Certification {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1602,23 +2602,54 @@ func (r Hardware_Benchmark_Certification) Limit(limit int) Hardware_Benchmark_Ce
 	return r
 }
 
+func (r Hardware_Benchmark_Certification) Unlimited() Hardware_Benchmark_Certification {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Hardware_Benchmark_Certification) Offset(offset int) Hardware_Benchmark_Certification {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Hardware_Benchmark_Certification) Timeout(timeout time.Duration) Hardware_Benchmark_Certification {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Hardware_Benchmark_Certification) WithContext(ctx context.Context) Hardware_Benchmark_Certification {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve Information regarding a benchmark certification result's associated SoftLayer customer account.
 func (r Hardware_Benchmark_Certification) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Benchmark_Certification", "getAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Benchmark_Certification) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve Information regarding the piece of hardware on which a benchmark certification test was performed.
 func (r Hardware_Benchmark_Certification) GetHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Benchmark_Certification", "getHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Benchmark_Certification) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // getObject retrieves the SoftLayer_Hardware_Benchmark_Certification object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Hardware_Benchmark_Certification service.
 func (r Hardware_Benchmark_Certification) GetObject() (resp datatypes.Hardware_Benchmark_Certification, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Benchmark_Certification", "getObject", nil, &r.Options, &resp)
@@ -1647,6 +2678,11 @@ func (r Hardware_Blade) Id(id int) Hardware_Blade {
 	return r
 }
 
+func (r Hardware_Blade) InitParameter(name string, value interface{}) Hardware_Blade {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Hardware_Blade) Mask(mask string) Hardware_Blade {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1666,11 +2702,26 @@ func (r Hardware_Blade) Limit(limit int) Hardware_Blade {
 	return r
 }
 
+func (r Hardware_Blade) Unlimited() Hardware_Blade {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Hardware_Blade) Offset(offset int) Hardware_Blade {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Hardware_Blade) Timeout(timeout time.Duration) Hardware_Blade {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Hardware_Blade) WithContext(ctx context.Context) Hardware_Blade {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Hardware_Blade) GetHardwareChild() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Blade", "getHardwareChild", nil, &r.Options, &resp)
@@ -1705,6 +2756,11 @@ func (r Hardware_Component_Model) Id(id int) Hardware_Component_Model {
 	return r
 }
 
+func (r Hardware_Component_Model) InitParameter(name string, value interface{}) Hardware_Component_Model {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Hardware_Component_Model) Mask(mask string) Hardware_Component_Model {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1724,11 +2780,26 @@ func (r Hardware_Component_Model) Limit(limit int) Hardware_Component_Model {
 	return r
 }
 
+func (r Hardware_Component_Model) Unlimited() Hardware_Component_Model {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Hardware_Component_Model) Offset(offset int) Hardware_Component_Model {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Hardware_Component_Model) Timeout(timeout time.Duration) Hardware_Component_Model {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Hardware_Component_Model) WithContext(ctx context.Context) Hardware_Component_Model {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Hardware_Component_Model) GetArchitectureType() (resp datatypes.Hardware_Component_Model_Architecture_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Component_Model", "getArchitectureType", nil, &r.Options, &resp)
@@ -1753,12 +2824,28 @@ func (r Hardware_Component_Model) GetCompatibleChildComponentModels() (resp []da
 	return
 }
 
+// GetCompatibleChildComponentModelsWith is GetCompatibleChildComponentModels, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Component_Model) GetCompatibleChildComponentModelsWith(options sl.Options) (resp []datatypes.Hardware_Component_Model, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCompatibleChildComponentModels()
+}
+
 // Retrieve All the component models that a hardware component model is compatible with.
 func (r Hardware_Component_Model) GetCompatibleParentComponentModels() (resp []datatypes.Hardware_Component_Model, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Component_Model", "getCompatibleParentComponentModels", nil, &r.Options, &resp)
 	return
 }
 
+// GetCompatibleParentComponentModelsWith is GetCompatibleParentComponentModels, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Component_Model) GetCompatibleParentComponentModelsWith(options sl.Options) (resp []datatypes.Hardware_Component_Model, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCompatibleParentComponentModels()
+}
+
 // Retrieve
 func (r Hardware_Component_Model) GetFirmwareQuantity() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Component_Model", "getFirmwareQuantity", nil, &r.Options, &resp)
@@ -1777,12 +2864,28 @@ func (r Hardware_Component_Model) GetHardwareComponents() (resp []datatypes.Hard
 	return
 }
 
+// GetHardwareComponentsWith is GetHardwareComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Component_Model) GetHardwareComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareComponents()
+}
+
 // Retrieve The non-vendor specific generic component model for a hardware component model.
 func (r Hardware_Component_Model) GetHardwareGenericComponentModel() (resp datatypes.Hardware_Component_Model_Generic, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Component_Model", "getHardwareGenericComponentModel", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareGenericComponentModelWith is GetHardwareGenericComponentModel, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Component_Model) GetHardwareGenericComponentModelWith(options sl.Options) (resp datatypes.Hardware_Component_Model_Generic, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareGenericComponentModel()
+}
+
 // Retrieve
 func (r Hardware_Component_Model) GetInfinibandCompatibleAttribute() (resp datatypes.Hardware_Component_Model_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Component_Model", "getInfinibandCompatibleAttribute", nil, &r.Options, &resp)
@@ -1813,18 +2916,42 @@ func (r Hardware_Component_Model) GetRebootTime() (resp datatypes.Hardware_Compo
 	return
 }
 
+// GetRebootTimeWith is GetRebootTime, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Component_Model) GetRebootTimeWith(options sl.Options) (resp datatypes.Hardware_Component_Motherboard_Reboot_Time, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRebootTime()
+}
+
 // Retrieve A hardware component model's type.
 func (r Hardware_Component_Model) GetType() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Component_Model", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Component_Model) GetTypeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Retrieve The types of attributes that are allowed for a given hardware component model.
 func (r Hardware_Component_Model) GetValidAttributeTypes() (resp []datatypes.Hardware_Component_Model_Attribute_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Component_Model", "getValidAttributeTypes", nil, &r.Options, &resp)
 	return
 }
 
+// GetValidAttributeTypesWith is GetValidAttributeTypes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Component_Model) GetValidAttributeTypesWith(options sl.Options) (resp []datatypes.Hardware_Component_Model_Attribute_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetValidAttributeTypes()
+}
+
 // The SoftLayer_Hardware_Component_Partition_OperatingSystem data type contains general information relating to a single SoftLayer Operating System Partition Template.
 type Hardware_Component_Partition_OperatingSystem struct {
 	Session *session.Session
@@ -1841,6 +2968,11 @@ func (r Hardware_Component_Partition_OperatingSystem) Id(id int) Hardware_Compon
 	return r
 }
 
+func (r Hardware_Component_Partition_OperatingSystem) InitParameter(name string, value interface{}) Hardware_Component_Partition_OperatingSystem {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Hardware_Component_Partition_OperatingSystem) Mask(mask string) Hardware_Component_Partition_OperatingSystem {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1860,11 +2992,26 @@ func (r Hardware_Component_Partition_OperatingSystem) Limit(limit int) Hardware_
 	return r
 }
 
+func (r Hardware_Component_Partition_OperatingSystem) Unlimited() Hardware_Component_Partition_OperatingSystem {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Hardware_Component_Partition_OperatingSystem) Offset(offset int) Hardware_Component_Partition_OperatingSystem {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Hardware_Component_Partition_OperatingSystem) Timeout(timeout time.Duration) Hardware_Component_Partition_OperatingSystem {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Hardware_Component_Partition_OperatingSystem) WithContext(ctx context.Context) Hardware_Component_Partition_OperatingSystem {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Hardware_Component_Partition_OperatingSystem) GetAllObjects() (resp []datatypes.Hardware_Component_Partition_OperatingSystem, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Component_Partition_OperatingSystem", "getAllObjects", nil, &r.Options, &resp)
@@ -1892,6 +3039,14 @@ func (r Hardware_Component_Partition_OperatingSystem) GetPartitionTemplates() (r
 	return
 }
 
+// GetPartitionTemplatesWith is GetPartitionTemplates, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Component_Partition_OperatingSystem) GetPartitionTemplatesWith(options sl.Options) (resp []datatypes.Hardware_Component_Partition_Template, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPartitionTemplates()
+}
+
 // The SoftLayer_Hardware_Component_Partition_Template data type contains general information relating to a single SoftLayer partition template.  Partition templates group 1 or more partition configurations that can be used to predefine how a hard drive's partitions will be configured.
 type Hardware_Component_Partition_Template struct {
 	Session *session.Session
@@ -1908,6 +3063,11 @@ func (r Hardware_Component_Partition_Template) Id(id int) Hardware_Component_Par
 	return r
 }
 
+func (r Hardware_Component_Partition_Template) InitParameter(name string, value interface{}) Hardware_Component_Partition_Template {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Hardware_Component_Partition_Template) Mask(mask string) Hardware_Component_Partition_Template {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1927,23 +3087,54 @@ func (r Hardware_Component_Partition_Template) Limit(limit int) Hardware_Compone
 	return r
 }
 
+func (r Hardware_Component_Partition_Template) Unlimited() Hardware_Component_Partition_Template {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Hardware_Component_Partition_Template) Offset(offset int) Hardware_Component_Partition_Template {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Hardware_Component_Partition_Template) Timeout(timeout time.Duration) Hardware_Component_Partition_Template {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Hardware_Component_Partition_Template) WithContext(ctx context.Context) Hardware_Component_Partition_Template {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve A partition template's associated [[SoftLayer_Account|Account]].
 func (r Hardware_Component_Partition_Template) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Component_Partition_Template", "getAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Component_Partition_Template) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve An individual partition for a partition template. This is identical to 'partitionTemplatePartition' except this will sort unix partitions.
 func (r Hardware_Component_Partition_Template) GetData() (resp []datatypes.Hardware_Component_Partition_Template_Partition, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Component_Partition_Template", "getData", nil, &r.Options, &resp)
 	return
 }
 
+// GetDataWith is GetData, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Component_Partition_Template) GetDataWith(options sl.Options) (resp []datatypes.Hardware_Component_Partition_Template_Partition, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetData()
+}
+
 // Retrieve
 func (r Hardware_Component_Partition_Template) GetExpireDate() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Component_Partition_Template", "getExpireDate", nil, &r.Options, &resp)
@@ -1962,12 +3153,28 @@ func (r Hardware_Component_Partition_Template) GetPartitionOperatingSystem() (re
 	return
 }
 
+// GetPartitionOperatingSystemWith is GetPartitionOperatingSystem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Component_Partition_Template) GetPartitionOperatingSystemWith(options sl.Options) (resp datatypes.Hardware_Component_Partition_OperatingSystem, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPartitionOperatingSystem()
+}
+
 // Retrieve An individual partition for a partition template.
 func (r Hardware_Component_Partition_Template) GetPartitionTemplatePartition() (resp []datatypes.Hardware_Component_Partition_Template_Partition, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Component_Partition_Template", "getPartitionTemplatePartition", nil, &r.Options, &resp)
 	return
 }
 
+// GetPartitionTemplatePartitionWith is GetPartitionTemplatePartition, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Component_Partition_Template) GetPartitionTemplatePartitionWith(options sl.Options) (resp []datatypes.Hardware_Component_Partition_Template_Partition, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPartitionTemplatePartition()
+}
+
 // The SoftLayer_Hardware_Router data type contains general information relating to a single SoftLayer router.
 type Hardware_Router struct {
 	Session *session.Session
@@ -1984,6 +3191,16 @@ func (r Hardware_Router) Id(id int) Hardware_Router {
 	return r
 }
 
+func (r Hardware_Router) InitParameter(name string, value interface{}) Hardware_Router {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
+func (r Hardware_Router) GlobalID(globalID string) Hardware_Router {
+	r.Options.GlobalID = &globalID
+	return r
+}
+
 func (r Hardware_Router) Mask(mask string) Hardware_Router {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2003,11 +3220,26 @@ func (r Hardware_Router) Limit(limit int) Hardware_Router {
 	return r
 }
 
+func (r Hardware_Router) Unlimited() Hardware_Router {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Hardware_Router) Offset(offset int) Hardware_Router {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Hardware_Router) Timeout(timeout time.Duration) Hardware_Router {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Hardware_Router) WithContext(ctx context.Context) Hardware_Router {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is used to allow access to a SoftLayer_Network_Storage volume that supports host- or network-level access control.
 func (r Hardware_Router) AllowAccessToNetworkStorage(networkStorageTemplateObject *datatypes.Network_Storage) (resp bool, err error) {
 	params := []interface{}{
@@ -2393,18 +3625,42 @@ func (r Hardware_Router) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve A piece of hardware's active physical components.
 func (r Hardware_Router) GetActiveComponents() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getActiveComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveComponentsWith is GetActiveComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetActiveComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveComponents()
+}
+
 // Retrieve A piece of hardware's active network monitoring incidents.
 func (r Hardware_Router) GetActiveNetworkMonitorIncident() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getActiveNetworkMonitorIncident", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveNetworkMonitorIncidentWith is GetActiveNetworkMonitorIncident, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetActiveNetworkMonitorIncidentWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveNetworkMonitorIncident()
+}
+
 // The '''getAlarmHistory''' method retrieves a detailed history for the monitoring alarm. When calling this method, a start and end date for the history to be retrieved must be entered.
 func (r Hardware_Router) GetAlarmHistory(startDate *datatypes.Time, endDate *datatypes.Time, alarmId *string) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -2428,24 +3684,56 @@ func (r Hardware_Router) GetAllowedHost() (resp datatypes.Network_Storage_Allowe
 	return
 }
 
+// GetAllowedHostWith is GetAllowedHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetAllowedHostWith(options sl.Options) (resp datatypes.Network_Storage_Allowed_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHost()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects that this SoftLayer_Hardware has access to.
 func (r Hardware_Router) GetAllowedNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getAllowedNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageWith is GetAllowedNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetAllowedNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorage()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects whose Replica that this SoftLayer_Hardware has access to.
 func (r Hardware_Router) GetAllowedNetworkStorageReplicas() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getAllowedNetworkStorageReplicas", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageReplicasWith is GetAllowedNetworkStorageReplicas, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetAllowedNetworkStorageReplicasWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorageReplicas()
+}
+
 // Retrieve Information regarding an antivirus/spyware software component object.
 func (r Hardware_Router) GetAntivirusSpywareSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getAntivirusSpywareSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetAntivirusSpywareSoftwareComponentWith is GetAntivirusSpywareSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetAntivirusSpywareSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAntivirusSpywareSoftwareComponent()
+}
+
 // This method is retrieve a list of SoftLayer_Network_Storage volumes that are authorized access to this SoftLayer_Hardware.
 func (r Hardware_Router) GetAttachedNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
 	params := []interface{}{
@@ -2461,6 +3749,14 @@ func (r Hardware_Router) GetAttributes() (resp []datatypes.Hardware_Attribute, e
 	return
 }
 
+// GetAttributesWith is GetAttributes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetAttributesWith(options sl.Options) (resp []datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+}
+
 // This method retrieves a list of SoftLayer_Network_Storage volumes that can be authorized to this SoftLayer_Hardware.
 func (r Hardware_Router) GetAvailableNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
 	params := []interface{}{
@@ -2476,6 +3772,14 @@ func (r Hardware_Router) GetAverageDailyPublicBandwidthUsage() (resp datatypes.F
 	return
 }
 
+// GetAverageDailyPublicBandwidthUsageWith is GetAverageDailyPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetAverageDailyPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPublicBandwidthUsage()
+}
+
 // The '''getBackendIncomingBandwidth''' method retrieves the amount of incoming private network traffic used between the given start date and end date parameters. When entering start and end dates, only the month, day and year are used to calculate bandwidth totals - the time (HH:MM:SS) is ignored and defaults to midnight. The amount of bandwidth retrieved is measured in gigabytes.
 func (r Hardware_Router) GetBackendIncomingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -2492,6 +3796,14 @@ func (r Hardware_Router) GetBackendNetworkComponents() (resp []datatypes.Network
 	return
 }
 
+// GetBackendNetworkComponentsWith is GetBackendNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetBackendNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendNetworkComponents()
+}
+
 // The '''getBackendOutgoingBandwidth''' method retrieves the amount of outgoing private network traffic used between the given start date and end date parameters. When entering start and end dates, only the month, day and year are used to calculate bandwidth totals - the time (HH:MM:SS) is ignored and defaults to midnight. The amount of bandwidth retrieved is measured in gigabytes.
 func (r Hardware_Router) GetBackendOutgoingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -2508,60 +3820,140 @@ func (r Hardware_Router) GetBackendRouters() (resp []datatypes.Hardware, err err
 	return
 }
 
+// GetBackendRoutersWith is GetBackendRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetBackendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendRouters()
+}
+
 // Retrieve A hardware's allotted bandwidth (measured in GB).
 func (r Hardware_Router) GetBandwidthAllocation() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getBandwidthAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllocationWith is GetBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllocation()
+}
+
 // Retrieve A hardware's allotted detail record. Allotment details link bandwidth allocation with allotments.
 func (r Hardware_Router) GetBandwidthAllotmentDetail() (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getBandwidthAllotmentDetail", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllotmentDetailWith is GetBandwidthAllotmentDetail, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetBandwidthAllotmentDetailWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotmentDetail()
+}
+
 // Retrieve Information regarding a piece of hardware's benchmark certifications.
 func (r Hardware_Router) GetBenchmarkCertifications() (resp []datatypes.Hardware_Benchmark_Certification, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getBenchmarkCertifications", nil, &r.Options, &resp)
 	return
 }
 
+// GetBenchmarkCertificationsWith is GetBenchmarkCertifications, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetBenchmarkCertificationsWith(options sl.Options) (resp []datatypes.Hardware_Benchmark_Certification, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBenchmarkCertifications()
+}
+
 // Retrieve Information regarding the billing item for a server.
 func (r Hardware_Router) GetBillingItem() (resp datatypes.Billing_Item_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve A flag indicating that a billing item exists.
 func (r Hardware_Router) GetBillingItemFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getBillingItemFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemFlagWith is GetBillingItemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItemFlag()
+}
+
 // Retrieve Determines whether the hardware is ineligible for cancellation because it is disconnected.
 func (r Hardware_Router) GetBlockCancelBecauseDisconnectedFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getBlockCancelBecauseDisconnectedFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBlockCancelBecauseDisconnectedFlagWith is GetBlockCancelBecauseDisconnectedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetBlockCancelBecauseDisconnectedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockCancelBecauseDisconnectedFlag()
+}
+
 // Retrieve Associated subnets for a router object.
 func (r Hardware_Router) GetBoundSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getBoundSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetBoundSubnetsWith is GetBoundSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetBoundSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBoundSubnets()
+}
+
 // Retrieve Status indicating whether or not a piece of hardware has business continuance insurance.
 func (r Hardware_Router) GetBusinessContinuanceInsuranceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getBusinessContinuanceInsuranceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBusinessContinuanceInsuranceFlagWith is GetBusinessContinuanceInsuranceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetBusinessContinuanceInsuranceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBusinessContinuanceInsuranceFlag()
+}
+
 // Retrieve Child hardware.
 func (r Hardware_Router) GetChildrenHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getChildrenHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildrenHardwareWith is GetChildrenHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetChildrenHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildrenHardware()
+}
+
 // no documentation yet
 func (r Hardware_Router) GetComponentDetailsXML() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getComponentDetailsXML", nil, &r.Options, &resp)
@@ -2574,12 +3966,28 @@ func (r Hardware_Router) GetComponents() (resp []datatypes.Hardware_Component, e
 	return
 }
 
+// GetComponentsWith is GetComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetComponents()
+}
+
 // Retrieve A continuous data protection/server backup software component object.
 func (r Hardware_Router) GetContinuousDataProtectionSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getContinuousDataProtectionSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetContinuousDataProtectionSoftwareComponentWith is GetContinuousDataProtectionSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetContinuousDataProtectionSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetContinuousDataProtectionSoftwareComponent()
+}
+
 //
 // There are many options that may be provided while ordering a server, this method can be used to determine what these options are.
 //
@@ -2596,6 +4004,14 @@ func (r Hardware_Router) GetCurrentBillableBandwidthUsage() (resp datatypes.Floa
 	return
 }
 
+// GetCurrentBillableBandwidthUsageWith is GetCurrentBillableBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetCurrentBillableBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentBillableBandwidthUsage()
+}
+
 // Get the billing detail for this hardware for the current billing period. This does not include bandwidth usage.
 func (r Hardware_Router) GetCurrentBillingDetail() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getCurrentBillingDetail", nil, &r.Options, &resp)
@@ -2624,96 +4040,224 @@ func (r Hardware_Router) GetDatacenter() (resp datatypes.Location, err error) {
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // Retrieve The name of the datacenter in which a piece of hardware resides.
 func (r Hardware_Router) GetDatacenterName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getDatacenterName", nil, &r.Options, &resp)
 	return
 }
 
+// GetDatacenterNameWith is GetDatacenterName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetDatacenterNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenterName()
+}
+
 // Retrieve Number of day(s) a server have been in spare pool.
 func (r Hardware_Router) GetDaysInSparePool() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getDaysInSparePool", nil, &r.Options, &resp)
 	return
 }
 
+// GetDaysInSparePoolWith is GetDaysInSparePool, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetDaysInSparePoolWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDaysInSparePool()
+}
+
 // Retrieve All hardware that has uplink network connections to a piece of hardware.
 func (r Hardware_Router) GetDownlinkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getDownlinkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkHardwareWith is GetDownlinkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetDownlinkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkHardware()
+}
+
 // Retrieve All hardware that has uplink network connections to a piece of hardware.
 func (r Hardware_Router) GetDownlinkNetworkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getDownlinkNetworkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkNetworkHardwareWith is GetDownlinkNetworkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetDownlinkNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkNetworkHardware()
+}
+
 // Retrieve Information regarding all servers attached to a piece of network hardware.
 func (r Hardware_Router) GetDownlinkServers() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getDownlinkServers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkServersWith is GetDownlinkServers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetDownlinkServersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkServers()
+}
+
 // Retrieve Information regarding all virtual guests attached to a piece of network hardware.
 func (r Hardware_Router) GetDownlinkVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getDownlinkVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkVirtualGuestsWith is GetDownlinkVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetDownlinkVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkVirtualGuests()
+}
+
 // Retrieve All hardware downstream from a network device.
 func (r Hardware_Router) GetDownstreamHardwareBindings() (resp []datatypes.Network_Component_Uplink_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getDownstreamHardwareBindings", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamHardwareBindingsWith is GetDownstreamHardwareBindings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetDownstreamHardwareBindingsWith(options sl.Options) (resp []datatypes.Network_Component_Uplink_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamHardwareBindings()
+}
+
 // Retrieve All network hardware downstream from the selected piece of hardware.
 func (r Hardware_Router) GetDownstreamNetworkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getDownstreamNetworkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamNetworkHardwareWith is GetDownstreamNetworkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetDownstreamNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamNetworkHardware()
+}
+
 // Retrieve All network hardware with monitoring warnings or errors that are downstream from the selected piece of hardware.
 func (r Hardware_Router) GetDownstreamNetworkHardwareWithIncidents() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getDownstreamNetworkHardwareWithIncidents", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamNetworkHardwareWithIncidentsWith is GetDownstreamNetworkHardwareWithIncidents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetDownstreamNetworkHardwareWithIncidentsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamNetworkHardwareWithIncidents()
+}
+
 // Retrieve Information regarding all servers attached downstream to a piece of network hardware.
 func (r Hardware_Router) GetDownstreamServers() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getDownstreamServers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamServersWith is GetDownstreamServers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetDownstreamServersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamServers()
+}
+
 // Retrieve Information regarding all virtual guests attached to a piece of network hardware.
 func (r Hardware_Router) GetDownstreamVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getDownstreamVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamVirtualGuestsWith is GetDownstreamVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetDownstreamVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamVirtualGuests()
+}
+
 // Retrieve The drive controllers contained within a piece of hardware.
 func (r Hardware_Router) GetDriveControllers() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getDriveControllers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDriveControllersWith is GetDriveControllers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetDriveControllersWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDriveControllers()
+}
+
 // Retrieve Information regarding a piece of hardware's associated EVault network storage service account.
 func (r Hardware_Router) GetEvaultNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getEvaultNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetEvaultNetworkStorageWith is GetEvaultNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetEvaultNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvaultNetworkStorage()
+}
+
 // Retrieve Information regarding a piece of hardware's firewall services.
 func (r Hardware_Router) GetFirewallServiceComponent() (resp datatypes.Network_Component_Firewall, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getFirewallServiceComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetFirewallServiceComponentWith is GetFirewallServiceComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetFirewallServiceComponentWith(options sl.Options) (resp datatypes.Network_Component_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirewallServiceComponent()
+}
+
 // Retrieve Defines the fixed components in a fixed configuration bare metal server.
 func (r Hardware_Router) GetFixedConfigurationPreset() (resp datatypes.Product_Package_Preset, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getFixedConfigurationPreset", nil, &r.Options, &resp)
 	return
 }
 
+// GetFixedConfigurationPresetWith is GetFixedConfigurationPreset, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetFixedConfigurationPresetWith(options sl.Options) (resp datatypes.Product_Package_Preset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFixedConfigurationPreset()
+}
+
 // The '''getFrontendIncomingBandwidth''' method retrieves the amount of incoming public network traffic used by a server between the given start and end date parameters. When entering the ''dateTime'' parameter, only the month, day and year of the start and end dates are required - the time (hour, minute and second) are set to midnight by default and cannot be changed. The amount of bandwidth retrieved is measured in gigabytes (GB).
 func (r Hardware_Router) GetFrontendIncomingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -2730,6 +4274,14 @@ func (r Hardware_Router) GetFrontendNetworkComponents() (resp []datatypes.Networ
 	return
 }
 
+// GetFrontendNetworkComponentsWith is GetFrontendNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetFrontendNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendNetworkComponents()
+}
+
 // The '''getFrontendOutgoingBandwidth''' method retrieves the amount of outgoing public network traffic used by a server between the given start and end date parameters. The ''dateTime'' parameter requires only the day, month and year to be entered - the time (hour, minute and second) are set to midnight be default in order to gather the data for the entire start and end date indicated in the parameter. The amount of bandwidth retrieved is measured in gigabytes (GB).
 func (r Hardware_Router) GetFrontendOutgoingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -2746,54 +4298,126 @@ func (r Hardware_Router) GetFrontendRouters() (resp []datatypes.Hardware, err er
 	return
 }
 
+// GetFrontendRoutersWith is GetFrontendRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetFrontendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendRouters()
+}
+
 // Retrieve A hardware's universally unique identifier.
 func (r Hardware_Router) GetGlobalIdentifier() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getGlobalIdentifier", nil, &r.Options, &resp)
 	return
 }
 
+// GetGlobalIdentifierWith is GetGlobalIdentifier, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetGlobalIdentifierWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGlobalIdentifier()
+}
+
 // Retrieve The hard drives contained within a piece of hardware.
 func (r Hardware_Router) GetHardDrives() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getHardDrives", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardDrivesWith is GetHardDrives, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetHardDrivesWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardDrives()
+}
+
 // Retrieve The chassis that a piece of hardware is housed in.
 func (r Hardware_Router) GetHardwareChassis() (resp datatypes.Hardware_Chassis, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getHardwareChassis", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareChassisWith is GetHardwareChassis, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetHardwareChassisWith(options sl.Options) (resp datatypes.Hardware_Chassis, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareChassis()
+}
+
 // Retrieve A hardware's function.
 func (r Hardware_Router) GetHardwareFunction() (resp datatypes.Hardware_Function, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getHardwareFunction", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareFunctionWith is GetHardwareFunction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetHardwareFunctionWith(options sl.Options) (resp datatypes.Hardware_Function, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareFunction()
+}
+
 // Retrieve A hardware's function.
 func (r Hardware_Router) GetHardwareFunctionDescription() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getHardwareFunctionDescription", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareFunctionDescriptionWith is GetHardwareFunctionDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetHardwareFunctionDescriptionWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareFunctionDescription()
+}
+
 // Retrieve A hardware's status.
 func (r Hardware_Router) GetHardwareStatus() (resp datatypes.Hardware_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getHardwareStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareStatusWith is GetHardwareStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetHardwareStatusWith(options sl.Options) (resp datatypes.Hardware_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareStatus()
+}
+
 // Retrieve Determine in hardware object has TPM enabled.
 func (r Hardware_Router) GetHasTrustedPlatformModuleBillingItemFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getHasTrustedPlatformModuleBillingItemFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasTrustedPlatformModuleBillingItemFlagWith is GetHasTrustedPlatformModuleBillingItemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetHasTrustedPlatformModuleBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasTrustedPlatformModuleBillingItemFlag()
+}
+
 // Retrieve Information regarding a host IPS software component object.
 func (r Hardware_Router) GetHostIpsSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getHostIpsSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetHostIpsSoftwareComponentWith is GetHostIpsSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetHostIpsSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHostIpsSoftwareComponent()
+}
+
 // The '''getHourlyBandwidth''' method retrieves all bandwidth updates hourly for the specified hardware. Because the potential number of data points can become excessive, the method limits the user to obtain data in 24-hour intervals. The required ''dateTime'' parameter is used as the starting point for the query and will be calculated for the 24-hour period starting with the specified date and time. For example, entering a parameter of
 //
 // '02/01/2008 0:00'
@@ -2816,42 +4440,98 @@ func (r Hardware_Router) GetHourlyBillingFlag() (resp bool, err error) {
 	return
 }
 
+// GetHourlyBillingFlagWith is GetHourlyBillingFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetHourlyBillingFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyBillingFlag()
+}
+
 // Retrieve The sum of all the inbound network traffic data for the last 30 days.
 func (r Hardware_Router) GetInboundBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getInboundBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundBandwidthUsageWith is GetInboundBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetInboundBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundBandwidthUsage()
+}
+
 // Retrieve The total public inbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_Router) GetInboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getInboundPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundPublicBandwidthUsageWith is GetInboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetInboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPublicBandwidthUsage()
+}
+
 // Retrieve Information regarding the last transaction a server performed.
 func (r Hardware_Router) GetLastTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getLastTransaction", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastTransactionWith is GetLastTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetLastTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastTransaction()
+}
+
 // Retrieve A piece of hardware's latest network monitoring incident.
 func (r Hardware_Router) GetLatestNetworkMonitorIncident() (resp datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getLatestNetworkMonitorIncident", nil, &r.Options, &resp)
 	return
 }
 
+// GetLatestNetworkMonitorIncidentWith is GetLatestNetworkMonitorIncident, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetLatestNetworkMonitorIncidentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestNetworkMonitorIncident()
+}
+
 // Retrieve A flag indicating that a VLAN on the router can be assigned to a host that has local disk functionality.
 func (r Hardware_Router) GetLocalDiskStorageCapabilityFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getLocalDiskStorageCapabilityFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocalDiskStorageCapabilityFlagWith is GetLocalDiskStorageCapabilityFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetLocalDiskStorageCapabilityFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocalDiskStorageCapabilityFlag()
+}
+
 // Retrieve Where a piece of hardware is located within SoftLayer's location hierarchy.
 func (r Hardware_Router) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve
 func (r Hardware_Router) GetLocationPathString() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getLocationPathString", nil, &r.Options, &resp)
@@ -2864,30 +4544,70 @@ func (r Hardware_Router) GetLockboxNetworkStorage() (resp datatypes.Network_Stor
 	return
 }
 
+// GetLockboxNetworkStorageWith is GetLockboxNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetLockboxNetworkStorageWith(options sl.Options) (resp datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLockboxNetworkStorage()
+}
+
 // Retrieve A flag indicating that the hardware is a managed resource.
 func (r Hardware_Router) GetManagedResourceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getManagedResourceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetManagedResourceFlagWith is GetManagedResourceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetManagedResourceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedResourceFlag()
+}
+
 // Retrieve Information regarding a piece of hardware's memory.
 func (r Hardware_Router) GetMemory() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getMemory", nil, &r.Options, &resp)
 	return
 }
 
+// GetMemoryWith is GetMemory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetMemoryWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMemory()
+}
+
 // Retrieve The amount of memory a piece of hardware has, measured in gigabytes.
 func (r Hardware_Router) GetMemoryCapacity() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getMemoryCapacity", nil, &r.Options, &resp)
 	return
 }
 
+// GetMemoryCapacityWith is GetMemoryCapacity, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetMemoryCapacityWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMemoryCapacity()
+}
+
 // Retrieve A piece of hardware's metric tracking object.
 func (r Hardware_Router) GetMetricTrackingObject() (resp datatypes.Metric_Tracking_Object_HardwareServer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getMetricTrackingObject", nil, &r.Options, &resp)
 	return
 }
 
+// GetMetricTrackingObjectWith is GetMetricTrackingObject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_HardwareServer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+}
+
 // Returns open monitoring alarms for a given time period
 func (r Hardware_Router) GetMonitoringActiveAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -2904,6 +4624,14 @@ func (r Hardware_Router) GetMonitoringAgents() (resp []datatypes.Monitoring_Agen
 	return
 }
 
+// GetMonitoringAgentsWith is GetMonitoringAgents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetMonitoringAgentsWith(options sl.Options) (resp []datatypes.Monitoring_Agent, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringAgents()
+}
+
 // Returns closed monitoring alarms for a given time period
 func (r Hardware_Router) GetMonitoringClosedAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -2920,114 +4648,266 @@ func (r Hardware_Router) GetMonitoringRobot() (resp datatypes.Monitoring_Robot,
 	return
 }
 
+// GetMonitoringRobotWith is GetMonitoringRobot, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetMonitoringRobotWith(options sl.Options) (resp datatypes.Monitoring_Robot, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringRobot()
+}
+
 // Retrieve Information regarding a piece of hardware's network monitoring services.
 func (r Hardware_Router) GetMonitoringServiceComponent() (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getMonitoringServiceComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceComponentWith is GetMonitoringServiceComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetMonitoringServiceComponentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceComponent()
+}
+
 // Retrieve The monitoring service flag eligibility status for a piece of hardware.
 func (r Hardware_Router) GetMonitoringServiceEligibilityFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getMonitoringServiceEligibilityFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceEligibilityFlagWith is GetMonitoringServiceEligibilityFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetMonitoringServiceEligibilityFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceEligibilityFlag()
+}
+
 // Retrieve The service flag status for a piece of hardware.
 func (r Hardware_Router) GetMonitoringServiceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getMonitoringServiceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceFlagWith is GetMonitoringServiceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetMonitoringServiceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceFlag()
+}
+
 // Retrieve Information regarding a piece of hardware's motherboard.
 func (r Hardware_Router) GetMotherboard() (resp datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getMotherboard", nil, &r.Options, &resp)
 	return
 }
 
+// GetMotherboardWith is GetMotherboard, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetMotherboardWith(options sl.Options) (resp datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMotherboard()
+}
+
 // Retrieve Information regarding a piece of hardware's network cards.
 func (r Hardware_Router) GetNetworkCards() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNetworkCards", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkCardsWith is GetNetworkCards, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetNetworkCardsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkCards()
+}
+
 // Retrieve Returns a hardware's network components.
 func (r Hardware_Router) GetNetworkComponents() (resp []datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNetworkComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkComponentsWith is GetNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponents()
+}
+
 // Retrieve The gateway member if this device is part of a network gateway.
 func (r Hardware_Router) GetNetworkGatewayMember() (resp datatypes.Network_Gateway_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNetworkGatewayMember", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkGatewayMemberWith is GetNetworkGatewayMember, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetNetworkGatewayMemberWith(options sl.Options) (resp datatypes.Network_Gateway_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGatewayMember()
+}
+
 // Retrieve Whether or not this device is part of a network gateway.
 func (r Hardware_Router) GetNetworkGatewayMemberFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNetworkGatewayMemberFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkGatewayMemberFlagWith is GetNetworkGatewayMemberFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetNetworkGatewayMemberFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGatewayMemberFlag()
+}
+
 // Retrieve A piece of hardware's network management IP address.
 func (r Hardware_Router) GetNetworkManagementIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNetworkManagementIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkManagementIpAddressWith is GetNetworkManagementIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetNetworkManagementIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkManagementIpAddress()
+}
+
 // Retrieve All servers with failed monitoring that are attached downstream to a piece of hardware.
 func (r Hardware_Router) GetNetworkMonitorAttachedDownHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNetworkMonitorAttachedDownHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorAttachedDownHardwareWith is GetNetworkMonitorAttachedDownHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetNetworkMonitorAttachedDownHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorAttachedDownHardware()
+}
+
 // Retrieve Virtual guests that are attached downstream to a hardware that have failed monitoring
 func (r Hardware_Router) GetNetworkMonitorAttachedDownVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNetworkMonitorAttachedDownVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorAttachedDownVirtualGuestsWith is GetNetworkMonitorAttachedDownVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetNetworkMonitorAttachedDownVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorAttachedDownVirtualGuests()
+}
+
 // Retrieve The status of all of a piece of hardware's network monitoring incidents.
 func (r Hardware_Router) GetNetworkMonitorIncidents() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNetworkMonitorIncidents", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorIncidentsWith is GetNetworkMonitorIncidents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetNetworkMonitorIncidentsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorIncidents()
+}
+
 // Retrieve Information regarding a piece of hardware's network monitors.
 func (r Hardware_Router) GetNetworkMonitors() (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNetworkMonitors", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorsWith is GetNetworkMonitors, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetNetworkMonitorsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitors()
+}
+
 // Retrieve The value of a hardware's network status attribute.
 func (r Hardware_Router) GetNetworkStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNetworkStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStatusWith is GetNetworkStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetNetworkStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStatus()
+}
+
 // Retrieve The hardware's related network status attribute.
 func (r Hardware_Router) GetNetworkStatusAttribute() (resp datatypes.Hardware_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNetworkStatusAttribute", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStatusAttributeWith is GetNetworkStatusAttribute, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetNetworkStatusAttributeWith(options sl.Options) (resp datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStatusAttribute()
+}
+
 // Retrieve Information regarding a piece of hardware's associated network storage service account.
 func (r Hardware_Router) GetNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStorageWith is GetNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStorage()
+}
+
 // Retrieve The network virtual LANs (VLANs) associated with a piece of hardware's network components.
 func (r Hardware_Router) GetNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNetworkVlans", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlansWith is GetNetworkVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlans()
+}
+
 // Retrieve A hardware's allotted bandwidth for the next billing cycle (measured in GB).
 func (r Hardware_Router) GetNextBillingCycleBandwidthAllocation() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNextBillingCycleBandwidthAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextBillingCycleBandwidthAllocationWith is GetNextBillingCycleBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetNextBillingCycleBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextBillingCycleBandwidthAllocation()
+}
+
 // Retrieve
 func (r Hardware_Router) GetNotesHistory() (resp []datatypes.Hardware_Note, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getNotesHistory", nil, &r.Options, &resp)
@@ -3046,78 +4926,182 @@ func (r Hardware_Router) GetOperatingSystem() (resp datatypes.Software_Component
 	return
 }
 
+// GetOperatingSystemWith is GetOperatingSystem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetOperatingSystemWith(options sl.Options) (resp datatypes.Software_Component_OperatingSystem, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystem()
+}
+
 // Retrieve A hardware's operating system software description.
 func (r Hardware_Router) GetOperatingSystemReferenceCode() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getOperatingSystemReferenceCode", nil, &r.Options, &resp)
 	return
 }
 
+// GetOperatingSystemReferenceCodeWith is GetOperatingSystemReferenceCode, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetOperatingSystemReferenceCodeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystemReferenceCode()
+}
+
 // Retrieve The sum of all the outbound network traffic data for the last 30 days.
 func (r Hardware_Router) GetOutboundBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getOutboundBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundBandwidthUsageWith is GetOutboundBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetOutboundBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundBandwidthUsage()
+}
+
 // Retrieve The total public outbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_Router) GetOutboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getOutboundPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundPublicBandwidthUsageWith is GetOutboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetOutboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPublicBandwidthUsage()
+}
+
 // Retrieve Blade Bay
 func (r Hardware_Router) GetParentBay() (resp datatypes.Hardware_Blade, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getParentBay", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentBayWith is GetParentBay, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetParentBayWith(options sl.Options) (resp datatypes.Hardware_Blade, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentBay()
+}
+
 // Retrieve Parent Hardware.
 func (r Hardware_Router) GetParentHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getParentHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentHardwareWith is GetParentHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetParentHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentHardware()
+}
+
 // Retrieve Information regarding the Point of Presence (PoP) location in which a piece of hardware resides.
 func (r Hardware_Router) GetPointOfPresenceLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getPointOfPresenceLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetPointOfPresenceLocationWith is GetPointOfPresenceLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetPointOfPresenceLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPointOfPresenceLocation()
+}
+
 // Retrieve The power components for a hardware object.
 func (r Hardware_Router) GetPowerComponents() (resp []datatypes.Hardware_Power_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getPowerComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetPowerComponentsWith is GetPowerComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetPowerComponentsWith(options sl.Options) (resp []datatypes.Hardware_Power_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPowerComponents()
+}
+
 // Retrieve Information regarding a piece of hardware's power supply.
 func (r Hardware_Router) GetPowerSupply() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getPowerSupply", nil, &r.Options, &resp)
 	return
 }
 
+// GetPowerSupplyWith is GetPowerSupply, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetPowerSupplyWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPowerSupply()
+}
+
 // Retrieve The hardware's primary private IP address.
 func (r Hardware_Router) GetPrimaryBackendIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getPrimaryBackendIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryBackendIpAddressWith is GetPrimaryBackendIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetPrimaryBackendIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendIpAddress()
+}
+
 // Retrieve Information regarding the hardware's primary back-end network component.
 func (r Hardware_Router) GetPrimaryBackendNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getPrimaryBackendNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryBackendNetworkComponentWith is GetPrimaryBackendNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetPrimaryBackendNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendNetworkComponent()
+}
+
 // Retrieve The hardware's primary public IP address.
 func (r Hardware_Router) GetPrimaryIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getPrimaryIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryIpAddressWith is GetPrimaryIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetPrimaryIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryIpAddress()
+}
+
 // Retrieve Information regarding the hardware's primary public network component.
 func (r Hardware_Router) GetPrimaryNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getPrimaryNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryNetworkComponentWith is GetPrimaryNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetPrimaryNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryNetworkComponent()
+}
+
 // Retrieve a graph of a server's private network bandwidth usage over the specified timeframe. If no timeframe is specified then getPublicBandwidthGraphImage retrieves the last 24 hours of public bandwidth usage. getPrivateBandwidthGraphImage returns a PNG image measuring 827 pixels by 293 pixels.
 func (r Hardware_Router) GetPrivateBandwidthData(startTime *int, endTime *int) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -3134,24 +5118,56 @@ func (r Hardware_Router) GetPrivateNetworkOnlyFlag() (resp bool, err error) {
 	return
 }
 
+// GetPrivateNetworkOnlyFlagWith is GetPrivateNetworkOnlyFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetPrivateNetworkOnlyFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateNetworkOnlyFlag()
+}
+
 // Retrieve The total number of processor cores, summed from all processors that are attached to a piece of hardware
 func (r Hardware_Router) GetProcessorCoreAmount() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getProcessorCoreAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetProcessorCoreAmountWith is GetProcessorCoreAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetProcessorCoreAmountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessorCoreAmount()
+}
+
 // Retrieve The total number of physical processor cores, summed from all processors that are attached to a piece of hardware
 func (r Hardware_Router) GetProcessorPhysicalCoreAmount() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getProcessorPhysicalCoreAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetProcessorPhysicalCoreAmountWith is GetProcessorPhysicalCoreAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetProcessorPhysicalCoreAmountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessorPhysicalCoreAmount()
+}
+
 // Retrieve Information regarding a piece of hardware's processors.
 func (r Hardware_Router) GetProcessors() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getProcessors", nil, &r.Options, &resp)
 	return
 }
 
+// GetProcessorsWith is GetProcessors, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetProcessorsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessors()
+}
+
 // Retrieve a graph of a server's public network bandwidth usage over the specified timeframe. If no timeframe is specified then getPublicBandwidthGraphImage retrieves the last 24 hours of public bandwidth usage. getPublicBandwidthGraphImage returns a PNG image measuring 827 pixels by 293 pixels.
 func (r Hardware_Router) GetPublicBandwidthData(startTime *int, endTime *int) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -3174,24 +5190,56 @@ func (r Hardware_Router) GetRaidControllers() (resp []datatypes.Hardware_Compone
 	return
 }
 
+// GetRaidControllersWith is GetRaidControllers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetRaidControllersWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRaidControllers()
+}
+
 // Retrieve Recent events that impact this hardware.
 func (r Hardware_Router) GetRecentEvents() (resp []datatypes.Notification_Occurrence_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getRecentEvents", nil, &r.Options, &resp)
 	return
 }
 
+// GetRecentEventsWith is GetRecentEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetRecentEventsWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRecentEvents()
+}
+
 // Retrieve User credentials to issue commands and/or interact with the server's remote management card.
 func (r Hardware_Router) GetRemoteManagementAccounts() (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getRemoteManagementAccounts", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementAccountsWith is GetRemoteManagementAccounts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetRemoteManagementAccountsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementAccounts()
+}
+
 // Retrieve A hardware's associated remote management component. This is normally IPMI.
 func (r Hardware_Router) GetRemoteManagementComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getRemoteManagementComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementComponentWith is GetRemoteManagementComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetRemoteManagementComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementComponent()
+}
+
 // Retrieve
 func (r Hardware_Router) GetResourceConfigurations() (resp []datatypes.Hardware_Resource_Configuration, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getResourceConfigurations", nil, &r.Options, &resp)
@@ -3216,30 +5264,70 @@ func (r Hardware_Router) GetResourceGroups() (resp []datatypes.Resource_Group, e
 	return
 }
 
+// GetResourceGroupsWith is GetResourceGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetResourceGroupsWith(options sl.Options) (resp []datatypes.Resource_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResourceGroups()
+}
+
 // Retrieve A hardware's routers.
 func (r Hardware_Router) GetRouters() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getRouters", nil, &r.Options, &resp)
 	return
 }
 
+// GetRoutersWith is GetRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRouters()
+}
+
 // Retrieve A flag indicating that a VLAN on the router can be assigned to a host that has SAN disk functionality.
 func (r Hardware_Router) GetSanStorageCapabilityFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getSanStorageCapabilityFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetSanStorageCapabilityFlagWith is GetSanStorageCapabilityFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetSanStorageCapabilityFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSanStorageCapabilityFlag()
+}
+
 // Retrieve Collection of scale assets this hardware corresponds to.
 func (r Hardware_Router) GetScaleAssets() (resp []datatypes.Scale_Asset, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getScaleAssets", nil, &r.Options, &resp)
 	return
 }
 
+// GetScaleAssetsWith is GetScaleAssets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetScaleAssetsWith(options sl.Options) (resp []datatypes.Scale_Asset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleAssets()
+}
+
 // Retrieve Information regarding a piece of hardware's vulnerability scan requests.
 func (r Hardware_Router) GetSecurityScanRequests() (resp []datatypes.Network_Security_Scanner_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getSecurityScanRequests", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecurityScanRequestsWith is GetSecurityScanRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetSecurityScanRequestsWith(options sl.Options) (resp []datatypes.Network_Security_Scanner_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityScanRequests()
+}
+
 // The '''getSensorData''' method retrieves a server's hardware state via its internal sensors. Remote sensor data is transmitted to the SoftLayer API by way of the server's remote management card. Sensor data measures various information, including system temperatures, voltages and other local server settings. Sensor data is cached for 30 second; calls made to this method for the same server within 30 seconds of each other will result in the same data being returned. To ensure that the data retrieved retrieves snapshot of varied data, make calls greater than 30 seconds apart.
 func (r Hardware_Router) GetSensorData() (resp []datatypes.Container_RemoteManagement_SensorReading, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getSensorData", nil, &r.Options, &resp)
@@ -3270,6 +5358,14 @@ func (r Hardware_Router) GetServerRoom() (resp datatypes.Location, err error) {
 	return
 }
 
+// GetServerRoomWith is GetServerRoom, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetServerRoomWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServerRoom()
+}
+
 // The '''getServerTemperatureGraphs''' retrieves the server's temperatures and displays the various temperatures using thermometer graphs. Temperatures retrieved are CPU temperature(s) and system temperatures. Data used to construct the graphs is retrieved from the server's remote management card. All graphs returned will have an associated title.
 func (r Hardware_Router) GetServerTemperatureGraphs() (resp []datatypes.Container_RemoteManagement_Graphs_SensorTemperature, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getServerTemperatureGraphs", nil, &r.Options, &resp)
@@ -3282,24 +5378,56 @@ func (r Hardware_Router) GetServiceProvider() (resp datatypes.Service_Provider,
 	return
 }
 
+// GetServiceProviderWith is GetServiceProvider, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetServiceProviderWith(options sl.Options) (resp datatypes.Service_Provider, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceProvider()
+}
+
 // Retrieve Information regarding a piece of hardware's installed software.
 func (r Hardware_Router) GetSoftwareComponents() (resp []datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getSoftwareComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareComponentsWith is GetSoftwareComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetSoftwareComponentsWith(options sl.Options) (resp []datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareComponents()
+}
+
 // Retrieve Information regarding the billing item for a spare pool server.
 func (r Hardware_Router) GetSparePoolBillingItem() (resp datatypes.Billing_Item_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getSparePoolBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetSparePoolBillingItemWith is GetSparePoolBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetSparePoolBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSparePoolBillingItem()
+}
+
 // Retrieve SSH keys to be installed on the server during provisioning or an OS reload.
 func (r Hardware_Router) GetSshKeys() (resp []datatypes.Security_Ssh_Key, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getSshKeys", nil, &r.Options, &resp)
 	return
 }
 
+// GetSshKeysWith is GetSshKeys, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSshKeys()
+}
+
 // Retrieve
 func (r Hardware_Router) GetStorageNetworkComponents() (resp []datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getStorageNetworkComponents", nil, &r.Options, &resp)
@@ -3337,72 +5465,168 @@ func (r Hardware_Router) GetUpgradeRequest() (resp datatypes.Product_Upgrade_Req
 	return
 }
 
+// GetUpgradeRequestWith is GetUpgradeRequest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetUpgradeRequestWith(options sl.Options) (resp datatypes.Product_Upgrade_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeRequest()
+}
+
 // Retrieve The network device connected to a piece of hardware.
 func (r Hardware_Router) GetUplinkHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getUplinkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetUplinkHardwareWith is GetUplinkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetUplinkHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUplinkHardware()
+}
+
 // Retrieve Information regarding the network component that is one level higher than a piece of hardware on the network infrastructure.
 func (r Hardware_Router) GetUplinkNetworkComponents() (resp []datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getUplinkNetworkComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetUplinkNetworkComponentsWith is GetUplinkNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetUplinkNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUplinkNetworkComponents()
+}
+
 // Retrieve An array containing a single string of custom user data for a hardware order. Max size is 16 kb.
 func (r Hardware_Router) GetUserData() (resp []datatypes.Hardware_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getUserData", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserDataWith is GetUserData, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetUserDataWith(options sl.Options) (resp []datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUserData()
+}
+
 // Retrieve Information regarding the virtual chassis for a piece of hardware.
 func (r Hardware_Router) GetVirtualChassis() (resp datatypes.Hardware_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getVirtualChassis", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualChassisWith is GetVirtualChassis, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetVirtualChassisWith(options sl.Options) (resp datatypes.Hardware_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualChassis()
+}
+
 // Retrieve Information regarding the virtual chassis siblings for a piece of hardware.
 func (r Hardware_Router) GetVirtualChassisSiblings() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getVirtualChassisSiblings", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualChassisSiblingsWith is GetVirtualChassisSiblings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetVirtualChassisSiblingsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualChassisSiblings()
+}
+
 // Retrieve A piece of hardware's virtual host record.
 func (r Hardware_Router) GetVirtualHost() (resp datatypes.Virtual_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getVirtualHost", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualHostWith is GetVirtualHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetVirtualHostWith(options sl.Options) (resp datatypes.Virtual_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualHost()
+}
+
 // Retrieve Information regarding a piece of hardware's virtual software licenses.
 func (r Hardware_Router) GetVirtualLicenses() (resp []datatypes.Software_VirtualLicense, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getVirtualLicenses", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualLicensesWith is GetVirtualLicenses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetVirtualLicensesWith(options sl.Options) (resp []datatypes.Software_VirtualLicense, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualLicenses()
+}
+
 // Retrieve Information regarding the bandwidth allotment to which a piece of hardware belongs.
 func (r Hardware_Router) GetVirtualRack() (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getVirtualRack", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackWith is GetVirtualRack, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetVirtualRackWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRack()
+}
+
 // Retrieve The name of the bandwidth allotment belonging to a piece of hardware.
 func (r Hardware_Router) GetVirtualRackId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getVirtualRackId", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackIdWith is GetVirtualRackId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetVirtualRackIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackId()
+}
+
 // Retrieve The name of the bandwidth allotment belonging to a piece of hardware.
 func (r Hardware_Router) GetVirtualRackName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getVirtualRackName", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackNameWith is GetVirtualRackName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetVirtualRackNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackName()
+}
+
 // Retrieve A piece of hardware's virtualization platform software.
 func (r Hardware_Router) GetVirtualizationPlatform() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "getVirtualizationPlatform", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualizationPlatformWith is GetVirtualizationPlatform, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Router) GetVirtualizationPlatformWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualizationPlatform()
+}
+
 // The '''importVirtualHost''' method attempts to import the host record for the virtualization platform running on a server.
 func (r Hardware_Router) ImportVirtualHost() (resp datatypes.Virtual_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Router", "importVirtualHost", nil, &r.Options, &resp)
@@ -3540,6 +5764,16 @@ func (r Hardware_SecurityModule) Id(id int) Hardware_SecurityModule {
 	return r
 }
 
+func (r Hardware_SecurityModule) InitParameter(name string, value interface{}) Hardware_SecurityModule {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
+func (r Hardware_SecurityModule) GlobalID(globalID string) Hardware_SecurityModule {
+	r.Options.GlobalID = &globalID
+	return r
+}
+
 func (r Hardware_SecurityModule) Mask(mask string) Hardware_SecurityModule {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3559,11 +5793,26 @@ func (r Hardware_SecurityModule) Limit(limit int) Hardware_SecurityModule {
 	return r
 }
 
+func (r Hardware_SecurityModule) Unlimited() Hardware_SecurityModule {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Hardware_SecurityModule) Offset(offset int) Hardware_SecurityModule {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Hardware_SecurityModule) Timeout(timeout time.Duration) Hardware_SecurityModule {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Hardware_SecurityModule) WithContext(ctx context.Context) Hardware_SecurityModule {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // <b>Note:</b> All error handling and parameter documentation is referencing behavior available on January 4th, 2019.
 //
 // <h3>Behavior deprecated on January 4th, 2019</h3>
@@ -4027,24 +6276,56 @@ func (r Hardware_SecurityModule) GetAccount() (resp datatypes.Account, err error
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve A piece of hardware's active physical components.
 func (r Hardware_SecurityModule) GetActiveComponents() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getActiveComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveComponentsWith is GetActiveComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetActiveComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveComponents()
+}
+
 // Retrieve The billing item for a server's attached network firewall.
 func (r Hardware_SecurityModule) GetActiveNetworkFirewallBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getActiveNetworkFirewallBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveNetworkFirewallBillingItemWith is GetActiveNetworkFirewallBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetActiveNetworkFirewallBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveNetworkFirewallBillingItem()
+}
+
 // Retrieve A piece of hardware's active network monitoring incidents.
 func (r Hardware_SecurityModule) GetActiveNetworkMonitorIncident() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getActiveNetworkMonitorIncident", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveNetworkMonitorIncidentWith is GetActiveNetworkMonitorIncident, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetActiveNetworkMonitorIncidentWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveNetworkMonitorIncident()
+}
+
 // Retrieve
 func (r Hardware_SecurityModule) GetActiveTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getActiveTickets", nil, &r.Options, &resp)
@@ -4057,12 +6338,28 @@ func (r Hardware_SecurityModule) GetActiveTransaction() (resp datatypes.Provisio
 	return
 }
 
+// GetActiveTransactionWith is GetActiveTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetActiveTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransaction()
+}
+
 // Retrieve Any active transaction(s) that are currently running for the server (example: os reload).
 func (r Hardware_SecurityModule) GetActiveTransactions() (resp []datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getActiveTransactions", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveTransactionsWith is GetActiveTransactions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetActiveTransactionsWith(options sl.Options) (resp []datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransactions()
+}
+
 // The '''getAlarmHistory''' method retrieves a detailed history for the monitoring alarm. When calling this method, a start and end date for the history to be retrieved must be entered.
 func (r Hardware_SecurityModule) GetAlarmHistory(startDate *datatypes.Time, endDate *datatypes.Time, alarmId *string) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -4086,24 +6383,56 @@ func (r Hardware_SecurityModule) GetAllowedHost() (resp datatypes.Network_Storag
 	return
 }
 
+// GetAllowedHostWith is GetAllowedHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetAllowedHostWith(options sl.Options) (resp datatypes.Network_Storage_Allowed_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHost()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects that this SoftLayer_Hardware has access to.
 func (r Hardware_SecurityModule) GetAllowedNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getAllowedNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageWith is GetAllowedNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetAllowedNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorage()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects whose Replica that this SoftLayer_Hardware has access to.
 func (r Hardware_SecurityModule) GetAllowedNetworkStorageReplicas() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getAllowedNetworkStorageReplicas", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageReplicasWith is GetAllowedNetworkStorageReplicas, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetAllowedNetworkStorageReplicasWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorageReplicas()
+}
+
 // Retrieve Information regarding an antivirus/spyware software component object.
 func (r Hardware_SecurityModule) GetAntivirusSpywareSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getAntivirusSpywareSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetAntivirusSpywareSoftwareComponentWith is GetAntivirusSpywareSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetAntivirusSpywareSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAntivirusSpywareSoftwareComponent()
+}
+
 // This method is retrieve a list of SoftLayer_Network_Storage volumes that are authorized access to this SoftLayer_Hardware.
 func (r Hardware_SecurityModule) GetAttachedNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
 	params := []interface{}{
@@ -4119,12 +6448,28 @@ func (r Hardware_SecurityModule) GetAttributes() (resp []datatypes.Hardware_Attr
 	return
 }
 
+// GetAttributesWith is GetAttributes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetAttributesWith(options sl.Options) (resp []datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+}
+
 // Retrieve An object that stores the maximum level for the monitoring query types and response types.
 func (r Hardware_SecurityModule) GetAvailableMonitoring() (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getAvailableMonitoring", nil, &r.Options, &resp)
 	return
 }
 
+// GetAvailableMonitoringWith is GetAvailableMonitoring, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetAvailableMonitoringWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAvailableMonitoring()
+}
+
 // This method retrieves a list of SoftLayer_Network_Storage volumes that can be authorized to this SoftLayer_Hardware.
 func (r Hardware_SecurityModule) GetAvailableNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
 	params := []interface{}{
@@ -4140,18 +6485,42 @@ func (r Hardware_SecurityModule) GetAverageDailyBandwidthUsage() (resp datatypes
 	return
 }
 
+// GetAverageDailyBandwidthUsageWith is GetAverageDailyBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetAverageDailyBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyBandwidthUsage()
+}
+
 // Retrieve The average daily private bandwidth usage for the current billing cycle.
 func (r Hardware_SecurityModule) GetAverageDailyPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getAverageDailyPrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAverageDailyPrivateBandwidthUsageWith is GetAverageDailyPrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetAverageDailyPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPrivateBandwidthUsage()
+}
+
 // Retrieve The average daily public bandwidth usage for the current billing cycle.
 func (r Hardware_SecurityModule) GetAverageDailyPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getAverageDailyPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAverageDailyPublicBandwidthUsageWith is GetAverageDailyPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetAverageDailyPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPublicBandwidthUsage()
+}
+
 // Use this method to return an array of private bandwidth utilization records between a given date range.
 //
 // This method represents the NEW version of getFrontendBandwidthUse
@@ -4190,6 +6559,14 @@ func (r Hardware_SecurityModule) GetBackendNetworkComponents() (resp []datatypes
 	return
 }
 
+// GetBackendNetworkComponentsWith is GetBackendNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetBackendNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendNetworkComponents()
+}
+
 // The '''getBackendOutgoingBandwidth''' method retrieves the amount of outgoing private network traffic used between the given start date and end date parameters. When entering start and end dates, only the month, day and year are used to calculate bandwidth totals - the time (HH:MM:SS) is ignored and defaults to midnight. The amount of bandwidth retrieved is measured in gigabytes.
 func (r Hardware_SecurityModule) GetBackendOutgoingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -4206,18 +6583,42 @@ func (r Hardware_SecurityModule) GetBackendRouters() (resp []datatypes.Hardware,
 	return
 }
 
+// GetBackendRoutersWith is GetBackendRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetBackendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendRouters()
+}
+
 // Retrieve A hardware's allotted bandwidth (measured in GB).
 func (r Hardware_SecurityModule) GetBandwidthAllocation() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getBandwidthAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllocationWith is GetBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllocation()
+}
+
 // Retrieve A hardware's allotted detail record. Allotment details link bandwidth allocation with allotments.
 func (r Hardware_SecurityModule) GetBandwidthAllotmentDetail() (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getBandwidthAllotmentDetail", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllotmentDetailWith is GetBandwidthAllotmentDetail, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetBandwidthAllotmentDetailWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotmentDetail()
+}
+
 // Retrieve a collection of bandwidth data from an individual public or private network tracking object. Data is ideal if you with to employ your own traffic storage and graphing systems.
 func (r Hardware_SecurityModule) GetBandwidthForDateRange(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -4247,60 +6648,140 @@ func (r Hardware_SecurityModule) GetBenchmarkCertifications() (resp []datatypes.
 	return
 }
 
+// GetBenchmarkCertificationsWith is GetBenchmarkCertifications, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetBenchmarkCertificationsWith(options sl.Options) (resp []datatypes.Hardware_Benchmark_Certification, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBenchmarkCertifications()
+}
+
 // Retrieve The raw bandwidth usage data for the current billing cycle. One object will be returned for each network this server is attached to.
 func (r Hardware_SecurityModule) GetBillingCycleBandwidthUsage() (resp []datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getBillingCycleBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCycleBandwidthUsageWith is GetBillingCycleBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetBillingCycleBandwidthUsageWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCycleBandwidthUsage()
+}
+
 // Retrieve The raw private bandwidth usage data for the current billing cycle.
 func (r Hardware_SecurityModule) GetBillingCyclePrivateBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getBillingCyclePrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCyclePrivateBandwidthUsageWith is GetBillingCyclePrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetBillingCyclePrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePrivateBandwidthUsage()
+}
+
 // Retrieve The raw public bandwidth usage data for the current billing cycle.
 func (r Hardware_SecurityModule) GetBillingCyclePublicBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getBillingCyclePublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCyclePublicBandwidthUsageWith is GetBillingCyclePublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetBillingCyclePublicBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePublicBandwidthUsage()
+}
+
 // Retrieve Information regarding the billing item for a server.
 func (r Hardware_SecurityModule) GetBillingItem() (resp datatypes.Billing_Item_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve A flag indicating that a billing item exists.
 func (r Hardware_SecurityModule) GetBillingItemFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getBillingItemFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemFlagWith is GetBillingItemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItemFlag()
+}
+
 // Retrieve Determine if BIOS password should be left as null.
 func (r Hardware_SecurityModule) GetBiosPasswordNullFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getBiosPasswordNullFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBiosPasswordNullFlagWith is GetBiosPasswordNullFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetBiosPasswordNullFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBiosPasswordNullFlag()
+}
+
 // Retrieve Determines whether the hardware is ineligible for cancellation because it is disconnected.
 func (r Hardware_SecurityModule) GetBlockCancelBecauseDisconnectedFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getBlockCancelBecauseDisconnectedFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBlockCancelBecauseDisconnectedFlagWith is GetBlockCancelBecauseDisconnectedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetBlockCancelBecauseDisconnectedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockCancelBecauseDisconnectedFlag()
+}
+
 // Retrieve Status indicating whether or not a piece of hardware has business continuance insurance.
 func (r Hardware_SecurityModule) GetBusinessContinuanceInsuranceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getBusinessContinuanceInsuranceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBusinessContinuanceInsuranceFlagWith is GetBusinessContinuanceInsuranceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetBusinessContinuanceInsuranceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBusinessContinuanceInsuranceFlag()
+}
+
 // Retrieve Child hardware.
 func (r Hardware_SecurityModule) GetChildrenHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getChildrenHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildrenHardwareWith is GetChildrenHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetChildrenHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildrenHardware()
+}
+
 // no documentation yet
 func (r Hardware_SecurityModule) GetComponentDetailsXML() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getComponentDetailsXML", nil, &r.Options, &resp)
@@ -4313,6 +6794,14 @@ func (r Hardware_SecurityModule) GetComponents() (resp []datatypes.Hardware_Comp
 	return
 }
 
+// GetComponentsWith is GetComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetComponents()
+}
+
 // Retrieve
 func (r Hardware_SecurityModule) GetContainsSolidStateDrivesFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getContainsSolidStateDrivesFlag", nil, &r.Options, &resp)
@@ -4325,18 +6814,42 @@ func (r Hardware_SecurityModule) GetContinuousDataProtectionSoftwareComponent()
 	return
 }
 
+// GetContinuousDataProtectionSoftwareComponentWith is GetContinuousDataProtectionSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetContinuousDataProtectionSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetContinuousDataProtectionSoftwareComponent()
+}
+
 // Retrieve A server's control panel.
 func (r Hardware_SecurityModule) GetControlPanel() (resp datatypes.Software_Component_ControlPanel, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getControlPanel", nil, &r.Options, &resp)
 	return
 }
 
+// GetControlPanelWith is GetControlPanel, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetControlPanelWith(options sl.Options) (resp datatypes.Software_Component_ControlPanel, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetControlPanel()
+}
+
 // Retrieve The total cost of a server, measured in US Dollars ($USD).
 func (r Hardware_SecurityModule) GetCost() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getCost", nil, &r.Options, &resp)
 	return
 }
 
+// GetCostWith is GetCost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetCostWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCost()
+}
+
 //
 // There are many options that may be provided while ordering a server, this method can be used to determine what these options are.
 //
@@ -4353,6 +6866,14 @@ func (r Hardware_SecurityModule) GetCurrentBandwidthSummary() (resp datatypes.Me
 	return
 }
 
+// GetCurrentBandwidthSummaryWith is GetCurrentBandwidthSummary, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetCurrentBandwidthSummaryWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentBandwidthSummary()
+}
+
 // Attempt to retrieve the file associated with the current benchmark certification result, if such a file exists.  If there is no file for this benchmark certification result, calling this method throws an exception.
 func (r Hardware_SecurityModule) GetCurrentBenchmarkCertificationResultFile() (resp []byte, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getCurrentBenchmarkCertificationResultFile", nil, &r.Options, &resp)
@@ -4365,6 +6886,14 @@ func (r Hardware_SecurityModule) GetCurrentBillableBandwidthUsage() (resp dataty
 	return
 }
 
+// GetCurrentBillableBandwidthUsageWith is GetCurrentBillableBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetCurrentBillableBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentBillableBandwidthUsage()
+}
+
 // Get the billing detail for this hardware for the current billing period. This does not include bandwidth usage.
 func (r Hardware_SecurityModule) GetCurrentBillingDetail() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getCurrentBillingDetail", nil, &r.Options, &resp)
@@ -4392,12 +6921,28 @@ func (r Hardware_SecurityModule) GetCustomerInstalledOperatingSystemFlag() (resp
 	return
 }
 
+// GetCustomerInstalledOperatingSystemFlagWith is GetCustomerInstalledOperatingSystemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetCustomerInstalledOperatingSystemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCustomerInstalledOperatingSystemFlag()
+}
+
 // Retrieve Indicates if a server is a customer owned device.
 func (r Hardware_SecurityModule) GetCustomerOwnedFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getCustomerOwnedFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetCustomerOwnedFlagWith is GetCustomerOwnedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetCustomerOwnedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCustomerOwnedFlag()
+}
+
 // The '''getDailyAverage''' method calculates the average daily network traffic used by the selected server. Using the required parameter ''dateTime'' to enter a start and end date, the user retrieves this average, measure in gigabytes (GB) for the specified date range. When entering parameters, only the month, day and year are required - time entries are omitted as this method defaults the time to midnight in order to account for the entire day.
 func (r Hardware_SecurityModule) GetDailyAverage(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -4414,84 +6959,196 @@ func (r Hardware_SecurityModule) GetDatacenter() (resp datatypes.Location, err e
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // Retrieve The name of the datacenter in which a piece of hardware resides.
 func (r Hardware_SecurityModule) GetDatacenterName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getDatacenterName", nil, &r.Options, &resp)
 	return
 }
 
+// GetDatacenterNameWith is GetDatacenterName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetDatacenterNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenterName()
+}
+
 // Retrieve Number of day(s) a server have been in spare pool.
 func (r Hardware_SecurityModule) GetDaysInSparePool() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getDaysInSparePool", nil, &r.Options, &resp)
 	return
 }
 
+// GetDaysInSparePoolWith is GetDaysInSparePool, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetDaysInSparePoolWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDaysInSparePool()
+}
+
 // Retrieve All hardware that has uplink network connections to a piece of hardware.
 func (r Hardware_SecurityModule) GetDownlinkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getDownlinkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkHardwareWith is GetDownlinkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetDownlinkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkHardware()
+}
+
 // Retrieve All hardware that has uplink network connections to a piece of hardware.
 func (r Hardware_SecurityModule) GetDownlinkNetworkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getDownlinkNetworkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkNetworkHardwareWith is GetDownlinkNetworkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetDownlinkNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkNetworkHardware()
+}
+
 // Retrieve Information regarding all servers attached to a piece of network hardware.
 func (r Hardware_SecurityModule) GetDownlinkServers() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getDownlinkServers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkServersWith is GetDownlinkServers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetDownlinkServersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkServers()
+}
+
 // Retrieve Information regarding all virtual guests attached to a piece of network hardware.
 func (r Hardware_SecurityModule) GetDownlinkVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getDownlinkVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkVirtualGuestsWith is GetDownlinkVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetDownlinkVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkVirtualGuests()
+}
+
 // Retrieve All hardware downstream from a network device.
 func (r Hardware_SecurityModule) GetDownstreamHardwareBindings() (resp []datatypes.Network_Component_Uplink_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getDownstreamHardwareBindings", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamHardwareBindingsWith is GetDownstreamHardwareBindings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetDownstreamHardwareBindingsWith(options sl.Options) (resp []datatypes.Network_Component_Uplink_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamHardwareBindings()
+}
+
 // Retrieve All network hardware downstream from the selected piece of hardware.
 func (r Hardware_SecurityModule) GetDownstreamNetworkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getDownstreamNetworkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamNetworkHardwareWith is GetDownstreamNetworkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetDownstreamNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamNetworkHardware()
+}
+
 // Retrieve All network hardware with monitoring warnings or errors that are downstream from the selected piece of hardware.
 func (r Hardware_SecurityModule) GetDownstreamNetworkHardwareWithIncidents() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getDownstreamNetworkHardwareWithIncidents", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamNetworkHardwareWithIncidentsWith is GetDownstreamNetworkHardwareWithIncidents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetDownstreamNetworkHardwareWithIncidentsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamNetworkHardwareWithIncidents()
+}
+
 // Retrieve Information regarding all servers attached downstream to a piece of network hardware.
 func (r Hardware_SecurityModule) GetDownstreamServers() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getDownstreamServers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamServersWith is GetDownstreamServers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetDownstreamServersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamServers()
+}
+
 // Retrieve Information regarding all virtual guests attached to a piece of network hardware.
 func (r Hardware_SecurityModule) GetDownstreamVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getDownstreamVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamVirtualGuestsWith is GetDownstreamVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetDownstreamVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamVirtualGuests()
+}
+
 // Retrieve The drive controllers contained within a piece of hardware.
 func (r Hardware_SecurityModule) GetDriveControllers() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getDriveControllers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDriveControllersWith is GetDriveControllers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetDriveControllersWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDriveControllers()
+}
+
 // Retrieve Information regarding a piece of hardware's associated EVault network storage service account.
 func (r Hardware_SecurityModule) GetEvaultNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getEvaultNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetEvaultNetworkStorageWith is GetEvaultNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetEvaultNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvaultNetworkStorage()
+}
+
 // Get the subnets associated with this server that are protectable by a network component firewall.
 func (r Hardware_SecurityModule) GetFirewallProtectableSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getFirewallProtectableSubnets", nil, &r.Options, &resp)
@@ -4504,12 +7161,28 @@ func (r Hardware_SecurityModule) GetFirewallServiceComponent() (resp datatypes.N
 	return
 }
 
+// GetFirewallServiceComponentWith is GetFirewallServiceComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetFirewallServiceComponentWith(options sl.Options) (resp datatypes.Network_Component_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirewallServiceComponent()
+}
+
 // Retrieve Defines the fixed components in a fixed configuration bare metal server.
 func (r Hardware_SecurityModule) GetFixedConfigurationPreset() (resp datatypes.Product_Package_Preset, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getFixedConfigurationPreset", nil, &r.Options, &resp)
 	return
 }
 
+// GetFixedConfigurationPresetWith is GetFixedConfigurationPreset, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetFixedConfigurationPresetWith(options sl.Options) (resp datatypes.Product_Package_Preset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFixedConfigurationPreset()
+}
+
 // Use this method to return an array of public bandwidth utilization records between a given date range.
 //
 // This method represents the NEW version of getFrontendBandwidthUse
@@ -4548,6 +7221,14 @@ func (r Hardware_SecurityModule) GetFrontendNetworkComponents() (resp []datatype
 	return
 }
 
+// GetFrontendNetworkComponentsWith is GetFrontendNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetFrontendNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendNetworkComponents()
+}
+
 // The '''getFrontendOutgoingBandwidth''' method retrieves the amount of outgoing public network traffic used by a server between the given start and end date parameters. The ''dateTime'' parameter requires only the day, month and year to be entered - the time (hour, minute and second) are set to midnight be default in order to gather the data for the entire start and end date indicated in the parameter. The amount of bandwidth retrieved is measured in gigabytes (GB).
 func (r Hardware_SecurityModule) GetFrontendOutgoingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -4564,18 +7245,42 @@ func (r Hardware_SecurityModule) GetFrontendRouters() (resp []datatypes.Hardware
 	return
 }
 
+// GetFrontendRoutersWith is GetFrontendRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetFrontendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendRouters()
+}
+
 // Retrieve A hardware's universally unique identifier.
 func (r Hardware_SecurityModule) GetGlobalIdentifier() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getGlobalIdentifier", nil, &r.Options, &resp)
 	return
 }
 
+// GetGlobalIdentifierWith is GetGlobalIdentifier, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetGlobalIdentifierWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGlobalIdentifier()
+}
+
 // Retrieve The hard drives contained within a piece of hardware.
 func (r Hardware_SecurityModule) GetHardDrives() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getHardDrives", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardDrivesWith is GetHardDrives, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetHardDrivesWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardDrives()
+}
+
 // Retrieve a server by searching for the primary IP address.
 func (r Hardware_SecurityModule) GetHardwareByIpAddress(ipAddress *string) (resp datatypes.Hardware_Server, err error) {
 	params := []interface{}{
@@ -4591,42 +7296,98 @@ func (r Hardware_SecurityModule) GetHardwareChassis() (resp datatypes.Hardware_C
 	return
 }
 
+// GetHardwareChassisWith is GetHardwareChassis, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetHardwareChassisWith(options sl.Options) (resp datatypes.Hardware_Chassis, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareChassis()
+}
+
 // Retrieve A hardware's function.
 func (r Hardware_SecurityModule) GetHardwareFunction() (resp datatypes.Hardware_Function, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getHardwareFunction", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareFunctionWith is GetHardwareFunction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetHardwareFunctionWith(options sl.Options) (resp datatypes.Hardware_Function, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareFunction()
+}
+
 // Retrieve A hardware's function.
 func (r Hardware_SecurityModule) GetHardwareFunctionDescription() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getHardwareFunctionDescription", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareFunctionDescriptionWith is GetHardwareFunctionDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetHardwareFunctionDescriptionWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareFunctionDescription()
+}
+
 // Retrieve A hardware's status.
 func (r Hardware_SecurityModule) GetHardwareStatus() (resp datatypes.Hardware_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getHardwareStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareStatusWith is GetHardwareStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetHardwareStatusWith(options sl.Options) (resp datatypes.Hardware_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareStatus()
+}
+
 // Retrieve Determine if hardware has Single Root IO VIrtualization (SR-IOV) billing item.
 func (r Hardware_SecurityModule) GetHasSingleRootVirtualizationBillingItemFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getHasSingleRootVirtualizationBillingItemFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasSingleRootVirtualizationBillingItemFlagWith is GetHasSingleRootVirtualizationBillingItemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetHasSingleRootVirtualizationBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasSingleRootVirtualizationBillingItemFlag()
+}
+
 // Retrieve Determine in hardware object has TPM enabled.
 func (r Hardware_SecurityModule) GetHasTrustedPlatformModuleBillingItemFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getHasTrustedPlatformModuleBillingItemFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasTrustedPlatformModuleBillingItemFlagWith is GetHasTrustedPlatformModuleBillingItemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetHasTrustedPlatformModuleBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasTrustedPlatformModuleBillingItemFlag()
+}
+
 // Retrieve Information regarding a host IPS software component object.
 func (r Hardware_SecurityModule) GetHostIpsSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getHostIpsSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetHostIpsSoftwareComponentWith is GetHostIpsSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetHostIpsSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHostIpsSoftwareComponent()
+}
+
 // The '''getHourlyBandwidth''' method retrieves all bandwidth updates hourly for the specified hardware. Because the potential number of data points can become excessive, the method limits the user to obtain data in 24-hour intervals. The required ''dateTime'' parameter is used as the starting point for the query and will be calculated for the 24-hour period starting with the specified date and time. For example, entering a parameter of
 //
 // '02/01/2008 0:00'
@@ -4649,36 +7410,84 @@ func (r Hardware_SecurityModule) GetHourlyBillingFlag() (resp bool, err error) {
 	return
 }
 
+// GetHourlyBillingFlagWith is GetHourlyBillingFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetHourlyBillingFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyBillingFlag()
+}
+
 // Retrieve The sum of all the inbound network traffic data for the last 30 days.
 func (r Hardware_SecurityModule) GetInboundBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getInboundBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundBandwidthUsageWith is GetInboundBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetInboundBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundBandwidthUsage()
+}
+
 // Retrieve The total private inbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_SecurityModule) GetInboundPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getInboundPrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundPrivateBandwidthUsageWith is GetInboundPrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetInboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPrivateBandwidthUsage()
+}
+
 // Retrieve The total public inbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_SecurityModule) GetInboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getInboundPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundPublicBandwidthUsageWith is GetInboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetInboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPublicBandwidthUsage()
+}
+
 // Retrieve Determine if hardware object has the IBM_CLOUD_READY_NODE_CERTIFIED attribute.
 func (r Hardware_SecurityModule) GetIsCloudReadyNodeCertified() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getIsCloudReadyNodeCertified", nil, &r.Options, &resp)
 	return
 }
 
+// GetIsCloudReadyNodeCertifiedWith is GetIsCloudReadyNodeCertified, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetIsCloudReadyNodeCertifiedWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsCloudReadyNodeCertified()
+}
+
 // Retrieve Determine if remote management has been disabled due to port speed.
 func (r Hardware_SecurityModule) GetIsIpmiDisabled() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getIsIpmiDisabled", nil, &r.Options, &resp)
 	return
 }
 
+// GetIsIpmiDisabledWith is GetIsIpmiDisabled, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetIsIpmiDisabledWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsIpmiDisabled()
+}
+
 // Return a collection of SoftLayer_Item_Price objects from a collection of SoftLayer_Software_Description
 func (r Hardware_SecurityModule) GetItemPricesFromSoftwareDescriptions(softwareDescriptions []datatypes.Software_Description, includeTranslationsFlag *bool, returnAllPricesFlag *bool) (resp []datatypes.Product_Item, err error) {
 	params := []interface{}{
@@ -4696,24 +7505,56 @@ func (r Hardware_SecurityModule) GetLastOperatingSystemReload() (resp datatypes.
 	return
 }
 
+// GetLastOperatingSystemReloadWith is GetLastOperatingSystemReload, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetLastOperatingSystemReloadWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastOperatingSystemReload()
+}
+
 // Retrieve Information regarding the last transaction a server performed.
 func (r Hardware_SecurityModule) GetLastTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getLastTransaction", nil, &r.Options, &resp)
 	return
 }
 
-// Retrieve A piece of hardware's latest network monitoring incident.
+// GetLastTransactionWith is GetLastTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetLastTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastTransaction()
+}
+
+// Retrieve A piece of hardware's latest network monitoring incident.
 func (r Hardware_SecurityModule) GetLatestNetworkMonitorIncident() (resp datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getLatestNetworkMonitorIncident", nil, &r.Options, &resp)
 	return
 }
 
+// GetLatestNetworkMonitorIncidentWith is GetLatestNetworkMonitorIncident, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetLatestNetworkMonitorIncidentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestNetworkMonitorIncident()
+}
+
 // Retrieve Where a piece of hardware is located within SoftLayer's location hierarchy.
 func (r Hardware_SecurityModule) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve
 func (r Hardware_SecurityModule) GetLocationPathString() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getLocationPathString", nil, &r.Options, &resp)
@@ -4726,12 +7567,28 @@ func (r Hardware_SecurityModule) GetLockboxNetworkStorage() (resp datatypes.Netw
 	return
 }
 
+// GetLockboxNetworkStorageWith is GetLockboxNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetLockboxNetworkStorageWith(options sl.Options) (resp datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLockboxNetworkStorage()
+}
+
 // Retrieve A flag indicating that the hardware is a managed resource.
 func (r Hardware_SecurityModule) GetManagedResourceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getManagedResourceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetManagedResourceFlagWith is GetManagedResourceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetManagedResourceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedResourceFlag()
+}
+
 // Retrieve the remote management network component attached with this server.
 func (r Hardware_SecurityModule) GetManagementNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getManagementNetworkComponent", nil, &r.Options, &resp)
@@ -4744,24 +7601,56 @@ func (r Hardware_SecurityModule) GetMemory() (resp []datatypes.Hardware_Componen
 	return
 }
 
+// GetMemoryWith is GetMemory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetMemoryWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMemory()
+}
+
 // Retrieve The amount of memory a piece of hardware has, measured in gigabytes.
 func (r Hardware_SecurityModule) GetMemoryCapacity() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getMemoryCapacity", nil, &r.Options, &resp)
 	return
 }
 
+// GetMemoryCapacityWith is GetMemoryCapacity, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetMemoryCapacityWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMemoryCapacity()
+}
+
 // Retrieve A piece of hardware's metric tracking object.
 func (r Hardware_SecurityModule) GetMetricTrackingObject() (resp datatypes.Metric_Tracking_Object_HardwareServer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getMetricTrackingObject", nil, &r.Options, &resp)
 	return
 }
 
+// GetMetricTrackingObjectWith is GetMetricTrackingObject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_HardwareServer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+}
+
 // Retrieve The metric tracking object id for this server.
 func (r Hardware_SecurityModule) GetMetricTrackingObjectId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getMetricTrackingObjectId", nil, &r.Options, &resp)
 	return
 }
 
+// GetMetricTrackingObjectIdWith is GetMetricTrackingObjectId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetMetricTrackingObjectIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObjectId()
+}
+
 // Returns open monitoring alarms for a given time period
 func (r Hardware_SecurityModule) GetMonitoringActiveAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -4778,6 +7667,14 @@ func (r Hardware_SecurityModule) GetMonitoringAgents() (resp []datatypes.Monitor
 	return
 }
 
+// GetMonitoringAgentsWith is GetMonitoringAgents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetMonitoringAgentsWith(options sl.Options) (resp []datatypes.Monitoring_Agent, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringAgents()
+}
+
 // Returns closed monitoring alarms for a given time period
 func (r Hardware_SecurityModule) GetMonitoringClosedAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -4794,42 +7691,98 @@ func (r Hardware_SecurityModule) GetMonitoringRobot() (resp datatypes.Monitoring
 	return
 }
 
+// GetMonitoringRobotWith is GetMonitoringRobot, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetMonitoringRobotWith(options sl.Options) (resp datatypes.Monitoring_Robot, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringRobot()
+}
+
 // Retrieve Information regarding a piece of hardware's network monitoring services.
 func (r Hardware_SecurityModule) GetMonitoringServiceComponent() (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getMonitoringServiceComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceComponentWith is GetMonitoringServiceComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetMonitoringServiceComponentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceComponent()
+}
+
 // Retrieve The monitoring service flag eligibility status for a piece of hardware.
 func (r Hardware_SecurityModule) GetMonitoringServiceEligibilityFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getMonitoringServiceEligibilityFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceEligibilityFlagWith is GetMonitoringServiceEligibilityFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetMonitoringServiceEligibilityFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceEligibilityFlag()
+}
+
 // Retrieve The service flag status for a piece of hardware.
 func (r Hardware_SecurityModule) GetMonitoringServiceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getMonitoringServiceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceFlagWith is GetMonitoringServiceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetMonitoringServiceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceFlag()
+}
+
 // Retrieve The monitoring notification objects for this hardware. Each object links this hardware instance to a user account that will be notified if monitoring on this hardware object fails
 func (r Hardware_SecurityModule) GetMonitoringUserNotification() (resp []datatypes.User_Customer_Notification_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getMonitoringUserNotification", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringUserNotificationWith is GetMonitoringUserNotification, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetMonitoringUserNotificationWith(options sl.Options) (resp []datatypes.User_Customer_Notification_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringUserNotification()
+}
+
 // Retrieve Information regarding a piece of hardware's motherboard.
 func (r Hardware_SecurityModule) GetMotherboard() (resp datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getMotherboard", nil, &r.Options, &resp)
 	return
 }
 
+// GetMotherboardWith is GetMotherboard, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetMotherboardWith(options sl.Options) (resp datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMotherboard()
+}
+
 // Retrieve Information regarding a piece of hardware's network cards.
 func (r Hardware_SecurityModule) GetNetworkCards() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNetworkCards", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkCardsWith is GetNetworkCards, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetNetworkCardsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkCards()
+}
+
 // Get the IP addresses associated with this server that are protectable by a network component firewall. Note, this may not return all values for IPv6 subnets for this server. Please use getFirewallProtectableSubnets to get all protectable subnets.
 func (r Hardware_SecurityModule) GetNetworkComponentFirewallProtectableIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNetworkComponentFirewallProtectableIpAddresses", nil, &r.Options, &resp)
@@ -4842,78 +7795,182 @@ func (r Hardware_SecurityModule) GetNetworkComponents() (resp []datatypes.Networ
 	return
 }
 
+// GetNetworkComponentsWith is GetNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponents()
+}
+
 // Retrieve The gateway member if this device is part of a network gateway.
 func (r Hardware_SecurityModule) GetNetworkGatewayMember() (resp datatypes.Network_Gateway_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNetworkGatewayMember", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkGatewayMemberWith is GetNetworkGatewayMember, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetNetworkGatewayMemberWith(options sl.Options) (resp datatypes.Network_Gateway_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGatewayMember()
+}
+
 // Retrieve Whether or not this device is part of a network gateway.
 func (r Hardware_SecurityModule) GetNetworkGatewayMemberFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNetworkGatewayMemberFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkGatewayMemberFlagWith is GetNetworkGatewayMemberFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetNetworkGatewayMemberFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGatewayMemberFlag()
+}
+
 // Retrieve A piece of hardware's network management IP address.
 func (r Hardware_SecurityModule) GetNetworkManagementIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNetworkManagementIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkManagementIpAddressWith is GetNetworkManagementIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetNetworkManagementIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkManagementIpAddress()
+}
+
 // Retrieve All servers with failed monitoring that are attached downstream to a piece of hardware.
 func (r Hardware_SecurityModule) GetNetworkMonitorAttachedDownHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNetworkMonitorAttachedDownHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorAttachedDownHardwareWith is GetNetworkMonitorAttachedDownHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetNetworkMonitorAttachedDownHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorAttachedDownHardware()
+}
+
 // Retrieve Virtual guests that are attached downstream to a hardware that have failed monitoring
 func (r Hardware_SecurityModule) GetNetworkMonitorAttachedDownVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNetworkMonitorAttachedDownVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorAttachedDownVirtualGuestsWith is GetNetworkMonitorAttachedDownVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetNetworkMonitorAttachedDownVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorAttachedDownVirtualGuests()
+}
+
 // Retrieve The status of all of a piece of hardware's network monitoring incidents.
 func (r Hardware_SecurityModule) GetNetworkMonitorIncidents() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNetworkMonitorIncidents", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorIncidentsWith is GetNetworkMonitorIncidents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetNetworkMonitorIncidentsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorIncidents()
+}
+
 // Retrieve Information regarding a piece of hardware's network monitors.
 func (r Hardware_SecurityModule) GetNetworkMonitors() (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNetworkMonitors", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorsWith is GetNetworkMonitors, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetNetworkMonitorsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitors()
+}
+
 // Retrieve The value of a hardware's network status attribute.
 func (r Hardware_SecurityModule) GetNetworkStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNetworkStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStatusWith is GetNetworkStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetNetworkStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStatus()
+}
+
 // Retrieve The hardware's related network status attribute.
 func (r Hardware_SecurityModule) GetNetworkStatusAttribute() (resp datatypes.Hardware_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNetworkStatusAttribute", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStatusAttributeWith is GetNetworkStatusAttribute, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetNetworkStatusAttributeWith(options sl.Options) (resp datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStatusAttribute()
+}
+
 // Retrieve Information regarding a piece of hardware's associated network storage service account.
 func (r Hardware_SecurityModule) GetNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStorageWith is GetNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStorage()
+}
+
 // Retrieve The network virtual LANs (VLANs) associated with a piece of hardware's network components.
 func (r Hardware_SecurityModule) GetNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNetworkVlans", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlansWith is GetNetworkVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlans()
+}
+
 // Retrieve A hardware's allotted bandwidth for the next billing cycle (measured in GB).
 func (r Hardware_SecurityModule) GetNextBillingCycleBandwidthAllocation() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNextBillingCycleBandwidthAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextBillingCycleBandwidthAllocationWith is GetNextBillingCycleBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetNextBillingCycleBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextBillingCycleBandwidthAllocation()
+}
+
 // Retrieve
 func (r Hardware_SecurityModule) GetNotesHistory() (resp []datatypes.Hardware_Note, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getNotesHistory", nil, &r.Options, &resp)
@@ -4932,42 +7989,98 @@ func (r Hardware_SecurityModule) GetOpenCancellationTicket() (resp datatypes.Tic
 	return
 }
 
+// GetOpenCancellationTicketWith is GetOpenCancellationTicket, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetOpenCancellationTicketWith(options sl.Options) (resp datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenCancellationTicket()
+}
+
 // Retrieve Information regarding a piece of hardware's operating system.
 func (r Hardware_SecurityModule) GetOperatingSystem() (resp datatypes.Software_Component_OperatingSystem, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getOperatingSystem", nil, &r.Options, &resp)
 	return
 }
 
+// GetOperatingSystemWith is GetOperatingSystem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetOperatingSystemWith(options sl.Options) (resp datatypes.Software_Component_OperatingSystem, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystem()
+}
+
 // Retrieve A hardware's operating system software description.
 func (r Hardware_SecurityModule) GetOperatingSystemReferenceCode() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getOperatingSystemReferenceCode", nil, &r.Options, &resp)
 	return
 }
 
+// GetOperatingSystemReferenceCodeWith is GetOperatingSystemReferenceCode, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetOperatingSystemReferenceCodeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystemReferenceCode()
+}
+
 // Retrieve The sum of all the outbound network traffic data for the last 30 days.
 func (r Hardware_SecurityModule) GetOutboundBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getOutboundBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundBandwidthUsageWith is GetOutboundBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetOutboundBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundBandwidthUsage()
+}
+
 // Retrieve The total private outbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_SecurityModule) GetOutboundPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getOutboundPrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundPrivateBandwidthUsageWith is GetOutboundPrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetOutboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPrivateBandwidthUsage()
+}
+
 // Retrieve The total public outbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_SecurityModule) GetOutboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getOutboundPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundPublicBandwidthUsageWith is GetOutboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetOutboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPublicBandwidthUsage()
+}
+
 // Retrieve Whether the bandwidth usage for this hardware for the current billing cycle exceeds the allocation.
 func (r Hardware_SecurityModule) GetOverBandwidthAllocationFlag() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getOverBandwidthAllocationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetOverBandwidthAllocationFlagWith is GetOverBandwidthAllocationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOverBandwidthAllocationFlag()
+}
+
 // Retrieve a server's hardware state via its internal sensors. Remote sensor data is transmitted to the SoftLayer API by way of the server's remote management card. Sensor data measures system temperatures, voltages, and other local server settings. Sensor data is cached for 30 seconds. Calls made to getSensorData for the same server within 30 seconds of each other will return the same data. Subsequent calls will return new data once the cache expires.
 func (r Hardware_SecurityModule) GetPMInfo() (resp []datatypes.Container_RemoteManagement_PmInfo, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getPMInfo", nil, &r.Options, &resp)
@@ -4980,42 +8093,98 @@ func (r Hardware_SecurityModule) GetParentBay() (resp datatypes.Hardware_Blade,
 	return
 }
 
+// GetParentBayWith is GetParentBay, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetParentBayWith(options sl.Options) (resp datatypes.Hardware_Blade, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentBay()
+}
+
 // Retrieve Parent Hardware.
 func (r Hardware_SecurityModule) GetParentHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getParentHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentHardwareWith is GetParentHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetParentHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentHardware()
+}
+
 // Retrieve Information regarding the Point of Presence (PoP) location in which a piece of hardware resides.
 func (r Hardware_SecurityModule) GetPointOfPresenceLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getPointOfPresenceLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetPointOfPresenceLocationWith is GetPointOfPresenceLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetPointOfPresenceLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPointOfPresenceLocation()
+}
+
 // Retrieve The power components for a hardware object.
 func (r Hardware_SecurityModule) GetPowerComponents() (resp []datatypes.Hardware_Power_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getPowerComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetPowerComponentsWith is GetPowerComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetPowerComponentsWith(options sl.Options) (resp []datatypes.Hardware_Power_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPowerComponents()
+}
+
 // Retrieve Information regarding a piece of hardware's power supply.
 func (r Hardware_SecurityModule) GetPowerSupply() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getPowerSupply", nil, &r.Options, &resp)
 	return
 }
 
+// GetPowerSupplyWith is GetPowerSupply, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetPowerSupplyWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPowerSupply()
+}
+
 // Retrieve The hardware's primary private IP address.
 func (r Hardware_SecurityModule) GetPrimaryBackendIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getPrimaryBackendIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryBackendIpAddressWith is GetPrimaryBackendIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetPrimaryBackendIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendIpAddress()
+}
+
 // Retrieve Information regarding the hardware's primary back-end network component.
 func (r Hardware_SecurityModule) GetPrimaryBackendNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getPrimaryBackendNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryBackendNetworkComponentWith is GetPrimaryBackendNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetPrimaryBackendNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendNetworkComponent()
+}
+
 // no documentation yet
 func (r Hardware_SecurityModule) GetPrimaryDriveSize() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getPrimaryDriveSize", nil, &r.Options, &resp)
@@ -5028,12 +8197,28 @@ func (r Hardware_SecurityModule) GetPrimaryIpAddress() (resp string, err error)
 	return
 }
 
+// GetPrimaryIpAddressWith is GetPrimaryIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetPrimaryIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryIpAddress()
+}
+
 // Retrieve Information regarding the hardware's primary public network component.
 func (r Hardware_SecurityModule) GetPrimaryNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getPrimaryNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryNetworkComponentWith is GetPrimaryNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetPrimaryNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryNetworkComponent()
+}
+
 // Retrieve a graph of a server's private network bandwidth usage over the specified timeframe. If no timeframe is specified then getPublicBandwidthGraphImage retrieves the last 24 hours of public bandwidth usage. getPrivateBandwidthGraphImage returns a PNG image measuring 827 pixels by 293 pixels.
 func (r Hardware_SecurityModule) GetPrivateBandwidthData(startTime *int, endTime *int) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -5066,6 +8251,14 @@ func (r Hardware_SecurityModule) GetPrivateIpAddress() (resp string, err error)
 	return
 }
 
+// GetPrivateIpAddressWith is GetPrivateIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetPrivateIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateIpAddress()
+}
+
 // Retrieve the private network component attached with this server.
 func (r Hardware_SecurityModule) GetPrivateNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getPrivateNetworkComponent", nil, &r.Options, &resp)
@@ -5078,6 +8271,14 @@ func (r Hardware_SecurityModule) GetPrivateNetworkOnlyFlag() (resp bool, err err
 	return
 }
 
+// GetPrivateNetworkOnlyFlagWith is GetPrivateNetworkOnlyFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetPrivateNetworkOnlyFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateNetworkOnlyFlag()
+}
+
 // Retrieve the backend VLAN for the primary IP address of the server
 func (r Hardware_SecurityModule) GetPrivateVlan() (resp datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getPrivateVlan", nil, &r.Options, &resp)
@@ -5099,30 +8300,70 @@ func (r Hardware_SecurityModule) GetProcessorCoreAmount() (resp uint, err error)
 	return
 }
 
+// GetProcessorCoreAmountWith is GetProcessorCoreAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetProcessorCoreAmountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessorCoreAmount()
+}
+
 // Retrieve The total number of physical processor cores, summed from all processors that are attached to a piece of hardware
 func (r Hardware_SecurityModule) GetProcessorPhysicalCoreAmount() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getProcessorPhysicalCoreAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetProcessorPhysicalCoreAmountWith is GetProcessorPhysicalCoreAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetProcessorPhysicalCoreAmountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessorPhysicalCoreAmount()
+}
+
 // Retrieve Information regarding a piece of hardware's processors.
 func (r Hardware_SecurityModule) GetProcessors() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getProcessors", nil, &r.Options, &resp)
 	return
 }
 
+// GetProcessorsWith is GetProcessors, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetProcessorsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessors()
+}
+
 // Retrieve Whether the bandwidth usage for this hardware for the current billing cycle is projected to exceed the allocation.
 func (r Hardware_SecurityModule) GetProjectedOverBandwidthAllocationFlag() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getProjectedOverBandwidthAllocationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetProjectedOverBandwidthAllocationFlagWith is GetProjectedOverBandwidthAllocationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetProjectedOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedOverBandwidthAllocationFlag()
+}
+
 // Retrieve The projected public outbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_SecurityModule) GetProjectedPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getProjectedPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetProjectedPublicBandwidthUsageWith is GetProjectedPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetProjectedPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedPublicBandwidthUsage()
+}
+
 // no documentation yet
 func (r Hardware_SecurityModule) GetProvisionDate() (resp datatypes.Time, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getProvisionDate", nil, &r.Options, &resp)
@@ -5198,24 +8439,56 @@ func (r Hardware_SecurityModule) GetRaidControllers() (resp []datatypes.Hardware
 	return
 }
 
+// GetRaidControllersWith is GetRaidControllers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetRaidControllersWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRaidControllers()
+}
+
 // Retrieve Determine if hardware object is vSan Ready Node.
 func (r Hardware_SecurityModule) GetReadyNodeFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getReadyNodeFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetReadyNodeFlagWith is GetReadyNodeFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetReadyNodeFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReadyNodeFlag()
+}
+
 // Retrieve Recent events that impact this hardware.
 func (r Hardware_SecurityModule) GetRecentEvents() (resp []datatypes.Notification_Occurrence_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getRecentEvents", nil, &r.Options, &resp)
 	return
 }
 
+// GetRecentEventsWith is GetRecentEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetRecentEventsWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRecentEvents()
+}
+
 // Retrieve The last five commands issued to the server's remote management card.
 func (r Hardware_SecurityModule) GetRecentRemoteManagementCommands() (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getRecentRemoteManagementCommands", nil, &r.Options, &resp)
 	return
 }
 
+// GetRecentRemoteManagementCommandsWith is GetRecentRemoteManagementCommands, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetRecentRemoteManagementCommandsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRecentRemoteManagementCommands()
+}
+
 // Retrieve
 func (r Hardware_SecurityModule) GetRegionalInternetRegistry() (resp datatypes.Network_Regional_Internet_Registry, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getRegionalInternetRegistry", nil, &r.Options, &resp)
@@ -5228,24 +8501,56 @@ func (r Hardware_SecurityModule) GetRemoteManagement() (resp datatypes.Hardware_
 	return
 }
 
+// GetRemoteManagementWith is GetRemoteManagement, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetRemoteManagementWith(options sl.Options) (resp datatypes.Hardware_Component_RemoteManagement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagement()
+}
+
 // Retrieve User credentials to issue commands and/or interact with the server's remote management card.
 func (r Hardware_SecurityModule) GetRemoteManagementAccounts() (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getRemoteManagementAccounts", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementAccountsWith is GetRemoteManagementAccounts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetRemoteManagementAccountsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementAccounts()
+}
+
 // Retrieve A hardware's associated remote management component. This is normally IPMI.
 func (r Hardware_SecurityModule) GetRemoteManagementComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getRemoteManagementComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementComponentWith is GetRemoteManagementComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetRemoteManagementComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementComponent()
+}
+
 // Retrieve User(s) who have access to issue commands and/or interact with the server's remote management card.
 func (r Hardware_SecurityModule) GetRemoteManagementUsers() (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getRemoteManagementUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementUsersWith is GetRemoteManagementUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetRemoteManagementUsersWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementUsers()
+}
+
 // Retrieve
 func (r Hardware_SecurityModule) GetResourceConfigurations() (resp []datatypes.Hardware_Resource_Configuration, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getResourceConfigurations", nil, &r.Options, &resp)
@@ -5270,6 +8575,14 @@ func (r Hardware_SecurityModule) GetResourceGroups() (resp []datatypes.Resource_
 	return
 }
 
+// GetResourceGroupsWith is GetResourceGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetResourceGroupsWith(options sl.Options) (resp []datatypes.Resource_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResourceGroups()
+}
+
 // Retrieve the reverse domain records associated with this server.
 func (r Hardware_SecurityModule) GetReverseDomainRecords() (resp []datatypes.Dns_Domain, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getReverseDomainRecords", nil, &r.Options, &resp)
@@ -5282,18 +8595,42 @@ func (r Hardware_SecurityModule) GetRouters() (resp []datatypes.Hardware, err er
 	return
 }
 
+// GetRoutersWith is GetRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRouters()
+}
+
 // Retrieve Collection of scale assets this hardware corresponds to.
 func (r Hardware_SecurityModule) GetScaleAssets() (resp []datatypes.Scale_Asset, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getScaleAssets", nil, &r.Options, &resp)
 	return
 }
 
+// GetScaleAssetsWith is GetScaleAssets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetScaleAssetsWith(options sl.Options) (resp []datatypes.Scale_Asset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleAssets()
+}
+
 // Retrieve Information regarding a piece of hardware's vulnerability scan requests.
 func (r Hardware_SecurityModule) GetSecurityScanRequests() (resp []datatypes.Network_Security_Scanner_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getSecurityScanRequests", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecurityScanRequestsWith is GetSecurityScanRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetSecurityScanRequestsWith(options sl.Options) (resp []datatypes.Network_Security_Scanner_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityScanRequests()
+}
+
 // Retrieve a server's hardware state via its internal sensors. Remote sensor data is transmitted to the SoftLayer API by way of the server's remote management card. Sensor data measures system temperatures, voltages, and other local server settings. Sensor data is cached for 30 seconds. Calls made to getSensorData for the same server within 30 seconds of each other will return the same data. Subsequent calls will return new data once the cache expires.
 func (r Hardware_SecurityModule) GetSensorData() (resp []datatypes.Container_RemoteManagement_SensorReading, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getSensorData", nil, &r.Options, &resp)
@@ -5330,6 +8667,14 @@ func (r Hardware_SecurityModule) GetServerRoom() (resp datatypes.Location, err e
 	return
 }
 
+// GetServerRoomWith is GetServerRoom, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetServerRoomWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServerRoom()
+}
+
 // Retrieve the server's temperature and displays them using thermometer graphs.  Temperatures retrieved are CPU(s) and system temperatures.  Data used to construct graphs is retrieved from the server's remote management card.  All graphs returned will have a title associated with it.
 func (r Hardware_SecurityModule) GetServerTemperatureGraphs() (resp []datatypes.Container_RemoteManagement_Graphs_SensorTemperature, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getServerTemperatureGraphs", nil, &r.Options, &resp)
@@ -5342,36 +8687,84 @@ func (r Hardware_SecurityModule) GetServiceProvider() (resp datatypes.Service_Pr
 	return
 }
 
+// GetServiceProviderWith is GetServiceProvider, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetServiceProviderWith(options sl.Options) (resp datatypes.Service_Provider, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceProvider()
+}
+
 // Retrieve Information regarding a piece of hardware's installed software.
 func (r Hardware_SecurityModule) GetSoftwareComponents() (resp []datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getSoftwareComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareComponentsWith is GetSoftwareComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetSoftwareComponentsWith(options sl.Options) (resp []datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareComponents()
+}
+
 // Retrieve Determine if hardware object has Software Guard Extension (SGX) enabled.
 func (r Hardware_SecurityModule) GetSoftwareGuardExtensionEnabled() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getSoftwareGuardExtensionEnabled", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareGuardExtensionEnabledWith is GetSoftwareGuardExtensionEnabled, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetSoftwareGuardExtensionEnabledWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareGuardExtensionEnabled()
+}
+
 // Retrieve Information regarding the billing item for a spare pool server.
 func (r Hardware_SecurityModule) GetSparePoolBillingItem() (resp datatypes.Billing_Item_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getSparePoolBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetSparePoolBillingItemWith is GetSparePoolBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetSparePoolBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSparePoolBillingItem()
+}
+
 // Retrieve SSH keys to be installed on the server during provisioning or an OS reload.
 func (r Hardware_SecurityModule) GetSshKeys() (resp []datatypes.Security_Ssh_Key, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getSshKeys", nil, &r.Options, &resp)
 	return
 }
 
+// GetSshKeysWith is GetSshKeys, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSshKeys()
+}
+
 // Retrieve A server's remote management card used for statistics.
 func (r Hardware_SecurityModule) GetStatisticsRemoteManagement() (resp datatypes.Hardware_Component_RemoteManagement, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getStatisticsRemoteManagement", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatisticsRemoteManagementWith is GetStatisticsRemoteManagement, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetStatisticsRemoteManagementWith(options sl.Options) (resp datatypes.Hardware_Component_RemoteManagement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatisticsRemoteManagement()
+}
+
 // Retrieve
 func (r Hardware_SecurityModule) GetStorageNetworkComponents() (resp []datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getStorageNetworkComponents", nil, &r.Options, &resp)
@@ -5403,6 +8796,14 @@ func (r Hardware_SecurityModule) GetUefiBootFlag() (resp bool, err error) {
 	return
 }
 
+// GetUefiBootFlagWith is GetUefiBootFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetUefiBootFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUefiBootFlag()
+}
+
 // Retrieve a list of upgradeable items available to this piece of hardware. Currently, getUpgradeItemPrices retrieves upgrades available for a server's memory, hard drives, network port speed, bandwidth allocation and GPUs.
 func (r Hardware_SecurityModule) GetUpgradeItemPrices() (resp []datatypes.Product_Item_Price, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getUpgradeItemPrices", nil, &r.Options, &resp)
@@ -5415,30 +8816,70 @@ func (r Hardware_SecurityModule) GetUpgradeRequest() (resp datatypes.Product_Upg
 	return
 }
 
+// GetUpgradeRequestWith is GetUpgradeRequest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetUpgradeRequestWith(options sl.Options) (resp datatypes.Product_Upgrade_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeRequest()
+}
+
 // Retrieve The network device connected to a piece of hardware.
 func (r Hardware_SecurityModule) GetUplinkHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getUplinkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetUplinkHardwareWith is GetUplinkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetUplinkHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUplinkHardware()
+}
+
 // Retrieve Information regarding the network component that is one level higher than a piece of hardware on the network infrastructure.
 func (r Hardware_SecurityModule) GetUplinkNetworkComponents() (resp []datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getUplinkNetworkComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetUplinkNetworkComponentsWith is GetUplinkNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetUplinkNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUplinkNetworkComponents()
+}
+
 // Retrieve An array containing a single string of custom user data for a hardware order. Max size is 16 kb.
 func (r Hardware_SecurityModule) GetUserData() (resp []datatypes.Hardware_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getUserData", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserDataWith is GetUserData, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetUserDataWith(options sl.Options) (resp []datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUserData()
+}
+
 // Retrieve A list of users that have access to this computing instance.
 func (r Hardware_SecurityModule) GetUsers() (resp []datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetUsersWith is GetUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUsers()
+}
+
 // This method will return the list of block device template groups that are valid to the host. For instance, it will only retrieve FLEX images.
 func (r Hardware_SecurityModule) GetValidBlockDeviceTemplateGroups(visibility *string) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
 	params := []interface{}{
@@ -5454,54 +8895,126 @@ func (r Hardware_SecurityModule) GetVirtualChassis() (resp datatypes.Hardware_Gr
 	return
 }
 
+// GetVirtualChassisWith is GetVirtualChassis, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetVirtualChassisWith(options sl.Options) (resp datatypes.Hardware_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualChassis()
+}
+
 // Retrieve Information regarding the virtual chassis siblings for a piece of hardware.
 func (r Hardware_SecurityModule) GetVirtualChassisSiblings() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getVirtualChassisSiblings", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualChassisSiblingsWith is GetVirtualChassisSiblings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetVirtualChassisSiblingsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualChassisSiblings()
+}
+
 // Retrieve A hardware server's virtual servers.
 func (r Hardware_SecurityModule) GetVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWith is GetVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuests()
+}
+
 // Retrieve A piece of hardware's virtual host record.
 func (r Hardware_SecurityModule) GetVirtualHost() (resp datatypes.Virtual_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getVirtualHost", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualHostWith is GetVirtualHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetVirtualHostWith(options sl.Options) (resp datatypes.Virtual_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualHost()
+}
+
 // Retrieve Information regarding a piece of hardware's virtual software licenses.
 func (r Hardware_SecurityModule) GetVirtualLicenses() (resp []datatypes.Software_VirtualLicense, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getVirtualLicenses", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualLicensesWith is GetVirtualLicenses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetVirtualLicensesWith(options sl.Options) (resp []datatypes.Software_VirtualLicense, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualLicenses()
+}
+
 // Retrieve Information regarding the bandwidth allotment to which a piece of hardware belongs.
 func (r Hardware_SecurityModule) GetVirtualRack() (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getVirtualRack", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackWith is GetVirtualRack, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetVirtualRackWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRack()
+}
+
 // Retrieve The name of the bandwidth allotment belonging to a piece of hardware.
 func (r Hardware_SecurityModule) GetVirtualRackId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getVirtualRackId", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackIdWith is GetVirtualRackId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetVirtualRackIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackId()
+}
+
 // Retrieve The name of the bandwidth allotment belonging to a piece of hardware.
 func (r Hardware_SecurityModule) GetVirtualRackName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getVirtualRackName", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackNameWith is GetVirtualRackName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetVirtualRackNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackName()
+}
+
 // Retrieve A piece of hardware's virtualization platform software.
 func (r Hardware_SecurityModule) GetVirtualizationPlatform() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getVirtualizationPlatform", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualizationPlatformWith is GetVirtualizationPlatform, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule) GetVirtualizationPlatformWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualizationPlatform()
+}
+
 // Retrieve a list of Windows updates available for a server from the local SoftLayer Windows Server Update Services (WSUS) server. Windows servers provisioned by SoftLayer are configured to use the local WSUS server via the private network by default.
 func (r Hardware_SecurityModule) GetWindowsUpdateAvailableUpdates() (resp []datatypes.Container_Utility_Microsoft_Windows_UpdateServices_UpdateItem, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule", "getWindowsUpdateAvailableUpdates", nil, &r.Options, &resp)
@@ -5900,6 +9413,16 @@ func (r Hardware_SecurityModule750) Id(id int) Hardware_SecurityModule750 {
 	return r
 }
 
+func (r Hardware_SecurityModule750) InitParameter(name string, value interface{}) Hardware_SecurityModule750 {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
+func (r Hardware_SecurityModule750) GlobalID(globalID string) Hardware_SecurityModule750 {
+	r.Options.GlobalID = &globalID
+	return r
+}
+
 func (r Hardware_SecurityModule750) Mask(mask string) Hardware_SecurityModule750 {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5919,11 +9442,26 @@ func (r Hardware_SecurityModule750) Limit(limit int) Hardware_SecurityModule750
 	return r
 }
 
+func (r Hardware_SecurityModule750) Unlimited() Hardware_SecurityModule750 {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Hardware_SecurityModule750) Offset(offset int) Hardware_SecurityModule750 {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Hardware_SecurityModule750) Timeout(timeout time.Duration) Hardware_SecurityModule750 {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Hardware_SecurityModule750) WithContext(ctx context.Context) Hardware_SecurityModule750 {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // <b>Note:</b> All error handling and parameter documentation is referencing behavior available on January 4th, 2019.
 //
 // <h3>Behavior deprecated on January 4th, 2019</h3>
@@ -6387,24 +9925,56 @@ func (r Hardware_SecurityModule750) GetAccount() (resp datatypes.Account, err er
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve A piece of hardware's active physical components.
 func (r Hardware_SecurityModule750) GetActiveComponents() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getActiveComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveComponentsWith is GetActiveComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetActiveComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveComponents()
+}
+
 // Retrieve The billing item for a server's attached network firewall.
 func (r Hardware_SecurityModule750) GetActiveNetworkFirewallBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getActiveNetworkFirewallBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveNetworkFirewallBillingItemWith is GetActiveNetworkFirewallBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetActiveNetworkFirewallBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveNetworkFirewallBillingItem()
+}
+
 // Retrieve A piece of hardware's active network monitoring incidents.
 func (r Hardware_SecurityModule750) GetActiveNetworkMonitorIncident() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getActiveNetworkMonitorIncident", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveNetworkMonitorIncidentWith is GetActiveNetworkMonitorIncident, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetActiveNetworkMonitorIncidentWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveNetworkMonitorIncident()
+}
+
 // Retrieve
 func (r Hardware_SecurityModule750) GetActiveTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getActiveTickets", nil, &r.Options, &resp)
@@ -6417,12 +9987,28 @@ func (r Hardware_SecurityModule750) GetActiveTransaction() (resp datatypes.Provi
 	return
 }
 
+// GetActiveTransactionWith is GetActiveTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetActiveTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransaction()
+}
+
 // Retrieve Any active transaction(s) that are currently running for the server (example: os reload).
 func (r Hardware_SecurityModule750) GetActiveTransactions() (resp []datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getActiveTransactions", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveTransactionsWith is GetActiveTransactions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetActiveTransactionsWith(options sl.Options) (resp []datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransactions()
+}
+
 // The '''getAlarmHistory''' method retrieves a detailed history for the monitoring alarm. When calling this method, a start and end date for the history to be retrieved must be entered.
 func (r Hardware_SecurityModule750) GetAlarmHistory(startDate *datatypes.Time, endDate *datatypes.Time, alarmId *string) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -6446,24 +10032,56 @@ func (r Hardware_SecurityModule750) GetAllowedHost() (resp datatypes.Network_Sto
 	return
 }
 
+// GetAllowedHostWith is GetAllowedHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetAllowedHostWith(options sl.Options) (resp datatypes.Network_Storage_Allowed_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHost()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects that this SoftLayer_Hardware has access to.
 func (r Hardware_SecurityModule750) GetAllowedNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getAllowedNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageWith is GetAllowedNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetAllowedNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorage()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects whose Replica that this SoftLayer_Hardware has access to.
 func (r Hardware_SecurityModule750) GetAllowedNetworkStorageReplicas() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getAllowedNetworkStorageReplicas", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageReplicasWith is GetAllowedNetworkStorageReplicas, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetAllowedNetworkStorageReplicasWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorageReplicas()
+}
+
 // Retrieve Information regarding an antivirus/spyware software component object.
 func (r Hardware_SecurityModule750) GetAntivirusSpywareSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getAntivirusSpywareSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetAntivirusSpywareSoftwareComponentWith is GetAntivirusSpywareSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetAntivirusSpywareSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAntivirusSpywareSoftwareComponent()
+}
+
 // This method is retrieve a list of SoftLayer_Network_Storage volumes that are authorized access to this SoftLayer_Hardware.
 func (r Hardware_SecurityModule750) GetAttachedNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
 	params := []interface{}{
@@ -6479,12 +10097,28 @@ func (r Hardware_SecurityModule750) GetAttributes() (resp []datatypes.Hardware_A
 	return
 }
 
+// GetAttributesWith is GetAttributes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetAttributesWith(options sl.Options) (resp []datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+}
+
 // Retrieve An object that stores the maximum level for the monitoring query types and response types.
 func (r Hardware_SecurityModule750) GetAvailableMonitoring() (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getAvailableMonitoring", nil, &r.Options, &resp)
 	return
 }
 
+// GetAvailableMonitoringWith is GetAvailableMonitoring, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetAvailableMonitoringWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAvailableMonitoring()
+}
+
 // This method retrieves a list of SoftLayer_Network_Storage volumes that can be authorized to this SoftLayer_Hardware.
 func (r Hardware_SecurityModule750) GetAvailableNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
 	params := []interface{}{
@@ -6500,18 +10134,42 @@ func (r Hardware_SecurityModule750) GetAverageDailyBandwidthUsage() (resp dataty
 	return
 }
 
+// GetAverageDailyBandwidthUsageWith is GetAverageDailyBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetAverageDailyBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyBandwidthUsage()
+}
+
 // Retrieve The average daily private bandwidth usage for the current billing cycle.
 func (r Hardware_SecurityModule750) GetAverageDailyPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getAverageDailyPrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAverageDailyPrivateBandwidthUsageWith is GetAverageDailyPrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetAverageDailyPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPrivateBandwidthUsage()
+}
+
 // Retrieve The average daily public bandwidth usage for the current billing cycle.
 func (r Hardware_SecurityModule750) GetAverageDailyPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getAverageDailyPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAverageDailyPublicBandwidthUsageWith is GetAverageDailyPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetAverageDailyPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPublicBandwidthUsage()
+}
+
 // Use this method to return an array of private bandwidth utilization records between a given date range.
 //
 // This method represents the NEW version of getFrontendBandwidthUse
@@ -6550,6 +10208,14 @@ func (r Hardware_SecurityModule750) GetBackendNetworkComponents() (resp []dataty
 	return
 }
 
+// GetBackendNetworkComponentsWith is GetBackendNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetBackendNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendNetworkComponents()
+}
+
 // The '''getBackendOutgoingBandwidth''' method retrieves the amount of outgoing private network traffic used between the given start date and end date parameters. When entering start and end dates, only the month, day and year are used to calculate bandwidth totals - the time (HH:MM:SS) is ignored and defaults to midnight. The amount of bandwidth retrieved is measured in gigabytes.
 func (r Hardware_SecurityModule750) GetBackendOutgoingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -6566,18 +10232,42 @@ func (r Hardware_SecurityModule750) GetBackendRouters() (resp []datatypes.Hardwa
 	return
 }
 
+// GetBackendRoutersWith is GetBackendRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetBackendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendRouters()
+}
+
 // Retrieve A hardware's allotted bandwidth (measured in GB).
 func (r Hardware_SecurityModule750) GetBandwidthAllocation() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getBandwidthAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllocationWith is GetBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllocation()
+}
+
 // Retrieve A hardware's allotted detail record. Allotment details link bandwidth allocation with allotments.
 func (r Hardware_SecurityModule750) GetBandwidthAllotmentDetail() (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getBandwidthAllotmentDetail", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllotmentDetailWith is GetBandwidthAllotmentDetail, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetBandwidthAllotmentDetailWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotmentDetail()
+}
+
 // Retrieve a collection of bandwidth data from an individual public or private network tracking object. Data is ideal if you with to employ your own traffic storage and graphing systems.
 func (r Hardware_SecurityModule750) GetBandwidthForDateRange(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -6607,60 +10297,140 @@ func (r Hardware_SecurityModule750) GetBenchmarkCertifications() (resp []datatyp
 	return
 }
 
+// GetBenchmarkCertificationsWith is GetBenchmarkCertifications, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetBenchmarkCertificationsWith(options sl.Options) (resp []datatypes.Hardware_Benchmark_Certification, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBenchmarkCertifications()
+}
+
 // Retrieve The raw bandwidth usage data for the current billing cycle. One object will be returned for each network this server is attached to.
 func (r Hardware_SecurityModule750) GetBillingCycleBandwidthUsage() (resp []datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getBillingCycleBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCycleBandwidthUsageWith is GetBillingCycleBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetBillingCycleBandwidthUsageWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCycleBandwidthUsage()
+}
+
 // Retrieve The raw private bandwidth usage data for the current billing cycle.
 func (r Hardware_SecurityModule750) GetBillingCyclePrivateBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getBillingCyclePrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCyclePrivateBandwidthUsageWith is GetBillingCyclePrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetBillingCyclePrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePrivateBandwidthUsage()
+}
+
 // Retrieve The raw public bandwidth usage data for the current billing cycle.
 func (r Hardware_SecurityModule750) GetBillingCyclePublicBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getBillingCyclePublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCyclePublicBandwidthUsageWith is GetBillingCyclePublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetBillingCyclePublicBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePublicBandwidthUsage()
+}
+
 // Retrieve Information regarding the billing item for a server.
 func (r Hardware_SecurityModule750) GetBillingItem() (resp datatypes.Billing_Item_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve A flag indicating that a billing item exists.
 func (r Hardware_SecurityModule750) GetBillingItemFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getBillingItemFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemFlagWith is GetBillingItemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItemFlag()
+}
+
 // Retrieve Determine if BIOS password should be left as null.
 func (r Hardware_SecurityModule750) GetBiosPasswordNullFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getBiosPasswordNullFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBiosPasswordNullFlagWith is GetBiosPasswordNullFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetBiosPasswordNullFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBiosPasswordNullFlag()
+}
+
 // Retrieve Determines whether the hardware is ineligible for cancellation because it is disconnected.
 func (r Hardware_SecurityModule750) GetBlockCancelBecauseDisconnectedFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getBlockCancelBecauseDisconnectedFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBlockCancelBecauseDisconnectedFlagWith is GetBlockCancelBecauseDisconnectedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetBlockCancelBecauseDisconnectedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockCancelBecauseDisconnectedFlag()
+}
+
 // Retrieve Status indicating whether or not a piece of hardware has business continuance insurance.
 func (r Hardware_SecurityModule750) GetBusinessContinuanceInsuranceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getBusinessContinuanceInsuranceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBusinessContinuanceInsuranceFlagWith is GetBusinessContinuanceInsuranceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetBusinessContinuanceInsuranceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBusinessContinuanceInsuranceFlag()
+}
+
 // Retrieve Child hardware.
 func (r Hardware_SecurityModule750) GetChildrenHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getChildrenHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildrenHardwareWith is GetChildrenHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetChildrenHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildrenHardware()
+}
+
 // no documentation yet
 func (r Hardware_SecurityModule750) GetComponentDetailsXML() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getComponentDetailsXML", nil, &r.Options, &resp)
@@ -6673,6 +10443,14 @@ func (r Hardware_SecurityModule750) GetComponents() (resp []datatypes.Hardware_C
 	return
 }
 
+// GetComponentsWith is GetComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetComponents()
+}
+
 // Retrieve
 func (r Hardware_SecurityModule750) GetContainsSolidStateDrivesFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getContainsSolidStateDrivesFlag", nil, &r.Options, &resp)
@@ -6685,18 +10463,42 @@ func (r Hardware_SecurityModule750) GetContinuousDataProtectionSoftwareComponent
 	return
 }
 
+// GetContinuousDataProtectionSoftwareComponentWith is GetContinuousDataProtectionSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetContinuousDataProtectionSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetContinuousDataProtectionSoftwareComponent()
+}
+
 // Retrieve A server's control panel.
 func (r Hardware_SecurityModule750) GetControlPanel() (resp datatypes.Software_Component_ControlPanel, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getControlPanel", nil, &r.Options, &resp)
 	return
 }
 
+// GetControlPanelWith is GetControlPanel, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetControlPanelWith(options sl.Options) (resp datatypes.Software_Component_ControlPanel, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetControlPanel()
+}
+
 // Retrieve The total cost of a server, measured in US Dollars ($USD).
 func (r Hardware_SecurityModule750) GetCost() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getCost", nil, &r.Options, &resp)
 	return
 }
 
+// GetCostWith is GetCost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetCostWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCost()
+}
+
 //
 // There are many options that may be provided while ordering a server, this method can be used to determine what these options are.
 //
@@ -6713,6 +10515,14 @@ func (r Hardware_SecurityModule750) GetCurrentBandwidthSummary() (resp datatypes
 	return
 }
 
+// GetCurrentBandwidthSummaryWith is GetCurrentBandwidthSummary, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetCurrentBandwidthSummaryWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentBandwidthSummary()
+}
+
 // Attempt to retrieve the file associated with the current benchmark certification result, if such a file exists.  If there is no file for this benchmark certification result, calling this method throws an exception.
 func (r Hardware_SecurityModule750) GetCurrentBenchmarkCertificationResultFile() (resp []byte, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getCurrentBenchmarkCertificationResultFile", nil, &r.Options, &resp)
@@ -6725,6 +10535,14 @@ func (r Hardware_SecurityModule750) GetCurrentBillableBandwidthUsage() (resp dat
 	return
 }
 
+// GetCurrentBillableBandwidthUsageWith is GetCurrentBillableBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetCurrentBillableBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentBillableBandwidthUsage()
+}
+
 // Get the billing detail for this hardware for the current billing period. This does not include bandwidth usage.
 func (r Hardware_SecurityModule750) GetCurrentBillingDetail() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getCurrentBillingDetail", nil, &r.Options, &resp)
@@ -6752,12 +10570,28 @@ func (r Hardware_SecurityModule750) GetCustomerInstalledOperatingSystemFlag() (r
 	return
 }
 
+// GetCustomerInstalledOperatingSystemFlagWith is GetCustomerInstalledOperatingSystemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetCustomerInstalledOperatingSystemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCustomerInstalledOperatingSystemFlag()
+}
+
 // Retrieve Indicates if a server is a customer owned device.
 func (r Hardware_SecurityModule750) GetCustomerOwnedFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getCustomerOwnedFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetCustomerOwnedFlagWith is GetCustomerOwnedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetCustomerOwnedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCustomerOwnedFlag()
+}
+
 // The '''getDailyAverage''' method calculates the average daily network traffic used by the selected server. Using the required parameter ''dateTime'' to enter a start and end date, the user retrieves this average, measure in gigabytes (GB) for the specified date range. When entering parameters, only the month, day and year are required - time entries are omitted as this method defaults the time to midnight in order to account for the entire day.
 func (r Hardware_SecurityModule750) GetDailyAverage(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -6774,84 +10608,196 @@ func (r Hardware_SecurityModule750) GetDatacenter() (resp datatypes.Location, er
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // Retrieve The name of the datacenter in which a piece of hardware resides.
 func (r Hardware_SecurityModule750) GetDatacenterName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getDatacenterName", nil, &r.Options, &resp)
 	return
 }
 
+// GetDatacenterNameWith is GetDatacenterName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetDatacenterNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenterName()
+}
+
 // Retrieve Number of day(s) a server have been in spare pool.
 func (r Hardware_SecurityModule750) GetDaysInSparePool() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getDaysInSparePool", nil, &r.Options, &resp)
 	return
 }
 
+// GetDaysInSparePoolWith is GetDaysInSparePool, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetDaysInSparePoolWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDaysInSparePool()
+}
+
 // Retrieve All hardware that has uplink network connections to a piece of hardware.
 func (r Hardware_SecurityModule750) GetDownlinkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getDownlinkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkHardwareWith is GetDownlinkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetDownlinkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkHardware()
+}
+
 // Retrieve All hardware that has uplink network connections to a piece of hardware.
 func (r Hardware_SecurityModule750) GetDownlinkNetworkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getDownlinkNetworkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkNetworkHardwareWith is GetDownlinkNetworkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetDownlinkNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkNetworkHardware()
+}
+
 // Retrieve Information regarding all servers attached to a piece of network hardware.
 func (r Hardware_SecurityModule750) GetDownlinkServers() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getDownlinkServers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkServersWith is GetDownlinkServers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetDownlinkServersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkServers()
+}
+
 // Retrieve Information regarding all virtual guests attached to a piece of network hardware.
 func (r Hardware_SecurityModule750) GetDownlinkVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getDownlinkVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkVirtualGuestsWith is GetDownlinkVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetDownlinkVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkVirtualGuests()
+}
+
 // Retrieve All hardware downstream from a network device.
 func (r Hardware_SecurityModule750) GetDownstreamHardwareBindings() (resp []datatypes.Network_Component_Uplink_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getDownstreamHardwareBindings", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamHardwareBindingsWith is GetDownstreamHardwareBindings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetDownstreamHardwareBindingsWith(options sl.Options) (resp []datatypes.Network_Component_Uplink_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamHardwareBindings()
+}
+
 // Retrieve All network hardware downstream from the selected piece of hardware.
 func (r Hardware_SecurityModule750) GetDownstreamNetworkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getDownstreamNetworkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamNetworkHardwareWith is GetDownstreamNetworkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetDownstreamNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamNetworkHardware()
+}
+
 // Retrieve All network hardware with monitoring warnings or errors that are downstream from the selected piece of hardware.
 func (r Hardware_SecurityModule750) GetDownstreamNetworkHardwareWithIncidents() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getDownstreamNetworkHardwareWithIncidents", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamNetworkHardwareWithIncidentsWith is GetDownstreamNetworkHardwareWithIncidents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetDownstreamNetworkHardwareWithIncidentsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamNetworkHardwareWithIncidents()
+}
+
 // Retrieve Information regarding all servers attached downstream to a piece of network hardware.
 func (r Hardware_SecurityModule750) GetDownstreamServers() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getDownstreamServers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamServersWith is GetDownstreamServers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetDownstreamServersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamServers()
+}
+
 // Retrieve Information regarding all virtual guests attached to a piece of network hardware.
 func (r Hardware_SecurityModule750) GetDownstreamVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getDownstreamVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamVirtualGuestsWith is GetDownstreamVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetDownstreamVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamVirtualGuests()
+}
+
 // Retrieve The drive controllers contained within a piece of hardware.
 func (r Hardware_SecurityModule750) GetDriveControllers() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getDriveControllers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDriveControllersWith is GetDriveControllers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetDriveControllersWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDriveControllers()
+}
+
 // Retrieve Information regarding a piece of hardware's associated EVault network storage service account.
 func (r Hardware_SecurityModule750) GetEvaultNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getEvaultNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetEvaultNetworkStorageWith is GetEvaultNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetEvaultNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvaultNetworkStorage()
+}
+
 // Get the subnets associated with this server that are protectable by a network component firewall.
 func (r Hardware_SecurityModule750) GetFirewallProtectableSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getFirewallProtectableSubnets", nil, &r.Options, &resp)
@@ -6864,12 +10810,28 @@ func (r Hardware_SecurityModule750) GetFirewallServiceComponent() (resp datatype
 	return
 }
 
+// GetFirewallServiceComponentWith is GetFirewallServiceComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetFirewallServiceComponentWith(options sl.Options) (resp datatypes.Network_Component_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirewallServiceComponent()
+}
+
 // Retrieve Defines the fixed components in a fixed configuration bare metal server.
 func (r Hardware_SecurityModule750) GetFixedConfigurationPreset() (resp datatypes.Product_Package_Preset, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getFixedConfigurationPreset", nil, &r.Options, &resp)
 	return
 }
 
+// GetFixedConfigurationPresetWith is GetFixedConfigurationPreset, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetFixedConfigurationPresetWith(options sl.Options) (resp datatypes.Product_Package_Preset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFixedConfigurationPreset()
+}
+
 // Use this method to return an array of public bandwidth utilization records between a given date range.
 //
 // This method represents the NEW version of getFrontendBandwidthUse
@@ -6908,6 +10870,14 @@ func (r Hardware_SecurityModule750) GetFrontendNetworkComponents() (resp []datat
 	return
 }
 
+// GetFrontendNetworkComponentsWith is GetFrontendNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetFrontendNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendNetworkComponents()
+}
+
 // The '''getFrontendOutgoingBandwidth''' method retrieves the amount of outgoing public network traffic used by a server between the given start and end date parameters. The ''dateTime'' parameter requires only the day, month and year to be entered - the time (hour, minute and second) are set to midnight be default in order to gather the data for the entire start and end date indicated in the parameter. The amount of bandwidth retrieved is measured in gigabytes (GB).
 func (r Hardware_SecurityModule750) GetFrontendOutgoingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -6924,18 +10894,42 @@ func (r Hardware_SecurityModule750) GetFrontendRouters() (resp []datatypes.Hardw
 	return
 }
 
+// GetFrontendRoutersWith is GetFrontendRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetFrontendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendRouters()
+}
+
 // Retrieve A hardware's universally unique identifier.
 func (r Hardware_SecurityModule750) GetGlobalIdentifier() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getGlobalIdentifier", nil, &r.Options, &resp)
 	return
 }
 
+// GetGlobalIdentifierWith is GetGlobalIdentifier, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetGlobalIdentifierWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGlobalIdentifier()
+}
+
 // Retrieve The hard drives contained within a piece of hardware.
 func (r Hardware_SecurityModule750) GetHardDrives() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getHardDrives", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardDrivesWith is GetHardDrives, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetHardDrivesWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardDrives()
+}
+
 // Retrieve a server by searching for the primary IP address.
 func (r Hardware_SecurityModule750) GetHardwareByIpAddress(ipAddress *string) (resp datatypes.Hardware_Server, err error) {
 	params := []interface{}{
@@ -6951,42 +10945,98 @@ func (r Hardware_SecurityModule750) GetHardwareChassis() (resp datatypes.Hardwar
 	return
 }
 
+// GetHardwareChassisWith is GetHardwareChassis, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetHardwareChassisWith(options sl.Options) (resp datatypes.Hardware_Chassis, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareChassis()
+}
+
 // Retrieve A hardware's function.
 func (r Hardware_SecurityModule750) GetHardwareFunction() (resp datatypes.Hardware_Function, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getHardwareFunction", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareFunctionWith is GetHardwareFunction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetHardwareFunctionWith(options sl.Options) (resp datatypes.Hardware_Function, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareFunction()
+}
+
 // Retrieve A hardware's function.
 func (r Hardware_SecurityModule750) GetHardwareFunctionDescription() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getHardwareFunctionDescription", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareFunctionDescriptionWith is GetHardwareFunctionDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetHardwareFunctionDescriptionWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareFunctionDescription()
+}
+
 // Retrieve A hardware's status.
 func (r Hardware_SecurityModule750) GetHardwareStatus() (resp datatypes.Hardware_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getHardwareStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareStatusWith is GetHardwareStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetHardwareStatusWith(options sl.Options) (resp datatypes.Hardware_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareStatus()
+}
+
 // Retrieve Determine if hardware has Single Root IO VIrtualization (SR-IOV) billing item.
 func (r Hardware_SecurityModule750) GetHasSingleRootVirtualizationBillingItemFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getHasSingleRootVirtualizationBillingItemFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasSingleRootVirtualizationBillingItemFlagWith is GetHasSingleRootVirtualizationBillingItemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetHasSingleRootVirtualizationBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasSingleRootVirtualizationBillingItemFlag()
+}
+
 // Retrieve Determine in hardware object has TPM enabled.
 func (r Hardware_SecurityModule750) GetHasTrustedPlatformModuleBillingItemFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getHasTrustedPlatformModuleBillingItemFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasTrustedPlatformModuleBillingItemFlagWith is GetHasTrustedPlatformModuleBillingItemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetHasTrustedPlatformModuleBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasTrustedPlatformModuleBillingItemFlag()
+}
+
 // Retrieve Information regarding a host IPS software component object.
 func (r Hardware_SecurityModule750) GetHostIpsSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getHostIpsSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetHostIpsSoftwareComponentWith is GetHostIpsSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetHostIpsSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHostIpsSoftwareComponent()
+}
+
 // The '''getHourlyBandwidth''' method retrieves all bandwidth updates hourly for the specified hardware. Because the potential number of data points can become excessive, the method limits the user to obtain data in 24-hour intervals. The required ''dateTime'' parameter is used as the starting point for the query and will be calculated for the 24-hour period starting with the specified date and time. For example, entering a parameter of
 //
 // '02/01/2008 0:00'
@@ -7009,36 +11059,84 @@ func (r Hardware_SecurityModule750) GetHourlyBillingFlag() (resp bool, err error
 	return
 }
 
+// GetHourlyBillingFlagWith is GetHourlyBillingFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetHourlyBillingFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyBillingFlag()
+}
+
 // Retrieve The sum of all the inbound network traffic data for the last 30 days.
 func (r Hardware_SecurityModule750) GetInboundBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getInboundBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundBandwidthUsageWith is GetInboundBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetInboundBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundBandwidthUsage()
+}
+
 // Retrieve The total private inbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_SecurityModule750) GetInboundPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getInboundPrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundPrivateBandwidthUsageWith is GetInboundPrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetInboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPrivateBandwidthUsage()
+}
+
 // Retrieve The total public inbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_SecurityModule750) GetInboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getInboundPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundPublicBandwidthUsageWith is GetInboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetInboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPublicBandwidthUsage()
+}
+
 // Retrieve Determine if hardware object has the IBM_CLOUD_READY_NODE_CERTIFIED attribute.
 func (r Hardware_SecurityModule750) GetIsCloudReadyNodeCertified() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getIsCloudReadyNodeCertified", nil, &r.Options, &resp)
 	return
 }
 
+// GetIsCloudReadyNodeCertifiedWith is GetIsCloudReadyNodeCertified, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetIsCloudReadyNodeCertifiedWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsCloudReadyNodeCertified()
+}
+
 // Retrieve Determine if remote management has been disabled due to port speed.
 func (r Hardware_SecurityModule750) GetIsIpmiDisabled() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getIsIpmiDisabled", nil, &r.Options, &resp)
 	return
 }
 
+// GetIsIpmiDisabledWith is GetIsIpmiDisabled, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetIsIpmiDisabledWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsIpmiDisabled()
+}
+
 // Return a collection of SoftLayer_Item_Price objects from a collection of SoftLayer_Software_Description
 func (r Hardware_SecurityModule750) GetItemPricesFromSoftwareDescriptions(softwareDescriptions []datatypes.Software_Description, includeTranslationsFlag *bool, returnAllPricesFlag *bool) (resp []datatypes.Product_Item, err error) {
 	params := []interface{}{
@@ -7056,24 +11154,56 @@ func (r Hardware_SecurityModule750) GetLastOperatingSystemReload() (resp datatyp
 	return
 }
 
+// GetLastOperatingSystemReloadWith is GetLastOperatingSystemReload, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetLastOperatingSystemReloadWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastOperatingSystemReload()
+}
+
 // Retrieve Information regarding the last transaction a server performed.
 func (r Hardware_SecurityModule750) GetLastTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getLastTransaction", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastTransactionWith is GetLastTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetLastTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastTransaction()
+}
+
 // Retrieve A piece of hardware's latest network monitoring incident.
 func (r Hardware_SecurityModule750) GetLatestNetworkMonitorIncident() (resp datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getLatestNetworkMonitorIncident", nil, &r.Options, &resp)
 	return
 }
 
+// GetLatestNetworkMonitorIncidentWith is GetLatestNetworkMonitorIncident, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetLatestNetworkMonitorIncidentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestNetworkMonitorIncident()
+}
+
 // Retrieve Where a piece of hardware is located within SoftLayer's location hierarchy.
 func (r Hardware_SecurityModule750) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve
 func (r Hardware_SecurityModule750) GetLocationPathString() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getLocationPathString", nil, &r.Options, &resp)
@@ -7086,12 +11216,28 @@ func (r Hardware_SecurityModule750) GetLockboxNetworkStorage() (resp datatypes.N
 	return
 }
 
+// GetLockboxNetworkStorageWith is GetLockboxNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetLockboxNetworkStorageWith(options sl.Options) (resp datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLockboxNetworkStorage()
+}
+
 // Retrieve A flag indicating that the hardware is a managed resource.
 func (r Hardware_SecurityModule750) GetManagedResourceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getManagedResourceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetManagedResourceFlagWith is GetManagedResourceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetManagedResourceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedResourceFlag()
+}
+
 // Retrieve the remote management network component attached with this server.
 func (r Hardware_SecurityModule750) GetManagementNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getManagementNetworkComponent", nil, &r.Options, &resp)
@@ -7104,24 +11250,56 @@ func (r Hardware_SecurityModule750) GetMemory() (resp []datatypes.Hardware_Compo
 	return
 }
 
+// GetMemoryWith is GetMemory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetMemoryWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMemory()
+}
+
 // Retrieve The amount of memory a piece of hardware has, measured in gigabytes.
 func (r Hardware_SecurityModule750) GetMemoryCapacity() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getMemoryCapacity", nil, &r.Options, &resp)
 	return
 }
 
+// GetMemoryCapacityWith is GetMemoryCapacity, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetMemoryCapacityWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMemoryCapacity()
+}
+
 // Retrieve A piece of hardware's metric tracking object.
 func (r Hardware_SecurityModule750) GetMetricTrackingObject() (resp datatypes.Metric_Tracking_Object_HardwareServer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getMetricTrackingObject", nil, &r.Options, &resp)
 	return
 }
 
+// GetMetricTrackingObjectWith is GetMetricTrackingObject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_HardwareServer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+}
+
 // Retrieve The metric tracking object id for this server.
 func (r Hardware_SecurityModule750) GetMetricTrackingObjectId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getMetricTrackingObjectId", nil, &r.Options, &resp)
 	return
 }
 
+// GetMetricTrackingObjectIdWith is GetMetricTrackingObjectId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetMetricTrackingObjectIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObjectId()
+}
+
 // Returns open monitoring alarms for a given time period
 func (r Hardware_SecurityModule750) GetMonitoringActiveAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -7138,6 +11316,14 @@ func (r Hardware_SecurityModule750) GetMonitoringAgents() (resp []datatypes.Moni
 	return
 }
 
+// GetMonitoringAgentsWith is GetMonitoringAgents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetMonitoringAgentsWith(options sl.Options) (resp []datatypes.Monitoring_Agent, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringAgents()
+}
+
 // Returns closed monitoring alarms for a given time period
 func (r Hardware_SecurityModule750) GetMonitoringClosedAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -7154,42 +11340,98 @@ func (r Hardware_SecurityModule750) GetMonitoringRobot() (resp datatypes.Monitor
 	return
 }
 
+// GetMonitoringRobotWith is GetMonitoringRobot, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetMonitoringRobotWith(options sl.Options) (resp datatypes.Monitoring_Robot, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringRobot()
+}
+
 // Retrieve Information regarding a piece of hardware's network monitoring services.
 func (r Hardware_SecurityModule750) GetMonitoringServiceComponent() (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getMonitoringServiceComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceComponentWith is GetMonitoringServiceComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetMonitoringServiceComponentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceComponent()
+}
+
 // Retrieve The monitoring service flag eligibility status for a piece of hardware.
 func (r Hardware_SecurityModule750) GetMonitoringServiceEligibilityFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getMonitoringServiceEligibilityFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceEligibilityFlagWith is GetMonitoringServiceEligibilityFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetMonitoringServiceEligibilityFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceEligibilityFlag()
+}
+
 // Retrieve The service flag status for a piece of hardware.
 func (r Hardware_SecurityModule750) GetMonitoringServiceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getMonitoringServiceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceFlagWith is GetMonitoringServiceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetMonitoringServiceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceFlag()
+}
+
 // Retrieve The monitoring notification objects for this hardware. Each object links this hardware instance to a user account that will be notified if monitoring on this hardware object fails
 func (r Hardware_SecurityModule750) GetMonitoringUserNotification() (resp []datatypes.User_Customer_Notification_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getMonitoringUserNotification", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringUserNotificationWith is GetMonitoringUserNotification, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetMonitoringUserNotificationWith(options sl.Options) (resp []datatypes.User_Customer_Notification_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringUserNotification()
+}
+
 // Retrieve Information regarding a piece of hardware's motherboard.
 func (r Hardware_SecurityModule750) GetMotherboard() (resp datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getMotherboard", nil, &r.Options, &resp)
 	return
 }
 
+// GetMotherboardWith is GetMotherboard, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetMotherboardWith(options sl.Options) (resp datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMotherboard()
+}
+
 // Retrieve Information regarding a piece of hardware's network cards.
 func (r Hardware_SecurityModule750) GetNetworkCards() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNetworkCards", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkCardsWith is GetNetworkCards, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetNetworkCardsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkCards()
+}
+
 // Get the IP addresses associated with this server that are protectable by a network component firewall. Note, this may not return all values for IPv6 subnets for this server. Please use getFirewallProtectableSubnets to get all protectable subnets.
 func (r Hardware_SecurityModule750) GetNetworkComponentFirewallProtectableIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNetworkComponentFirewallProtectableIpAddresses", nil, &r.Options, &resp)
@@ -7202,78 +11444,182 @@ func (r Hardware_SecurityModule750) GetNetworkComponents() (resp []datatypes.Net
 	return
 }
 
+// GetNetworkComponentsWith is GetNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponents()
+}
+
 // Retrieve The gateway member if this device is part of a network gateway.
 func (r Hardware_SecurityModule750) GetNetworkGatewayMember() (resp datatypes.Network_Gateway_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNetworkGatewayMember", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkGatewayMemberWith is GetNetworkGatewayMember, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetNetworkGatewayMemberWith(options sl.Options) (resp datatypes.Network_Gateway_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGatewayMember()
+}
+
 // Retrieve Whether or not this device is part of a network gateway.
 func (r Hardware_SecurityModule750) GetNetworkGatewayMemberFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNetworkGatewayMemberFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkGatewayMemberFlagWith is GetNetworkGatewayMemberFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetNetworkGatewayMemberFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGatewayMemberFlag()
+}
+
 // Retrieve A piece of hardware's network management IP address.
 func (r Hardware_SecurityModule750) GetNetworkManagementIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNetworkManagementIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkManagementIpAddressWith is GetNetworkManagementIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetNetworkManagementIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkManagementIpAddress()
+}
+
 // Retrieve All servers with failed monitoring that are attached downstream to a piece of hardware.
 func (r Hardware_SecurityModule750) GetNetworkMonitorAttachedDownHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNetworkMonitorAttachedDownHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorAttachedDownHardwareWith is GetNetworkMonitorAttachedDownHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetNetworkMonitorAttachedDownHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorAttachedDownHardware()
+}
+
 // Retrieve Virtual guests that are attached downstream to a hardware that have failed monitoring
 func (r Hardware_SecurityModule750) GetNetworkMonitorAttachedDownVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNetworkMonitorAttachedDownVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorAttachedDownVirtualGuestsWith is GetNetworkMonitorAttachedDownVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetNetworkMonitorAttachedDownVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorAttachedDownVirtualGuests()
+}
+
 // Retrieve The status of all of a piece of hardware's network monitoring incidents.
 func (r Hardware_SecurityModule750) GetNetworkMonitorIncidents() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNetworkMonitorIncidents", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorIncidentsWith is GetNetworkMonitorIncidents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetNetworkMonitorIncidentsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorIncidents()
+}
+
 // Retrieve Information regarding a piece of hardware's network monitors.
 func (r Hardware_SecurityModule750) GetNetworkMonitors() (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNetworkMonitors", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorsWith is GetNetworkMonitors, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetNetworkMonitorsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitors()
+}
+
 // Retrieve The value of a hardware's network status attribute.
 func (r Hardware_SecurityModule750) GetNetworkStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNetworkStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStatusWith is GetNetworkStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetNetworkStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStatus()
+}
+
 // Retrieve The hardware's related network status attribute.
 func (r Hardware_SecurityModule750) GetNetworkStatusAttribute() (resp datatypes.Hardware_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNetworkStatusAttribute", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStatusAttributeWith is GetNetworkStatusAttribute, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetNetworkStatusAttributeWith(options sl.Options) (resp datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStatusAttribute()
+}
+
 // Retrieve Information regarding a piece of hardware's associated network storage service account.
 func (r Hardware_SecurityModule750) GetNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStorageWith is GetNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStorage()
+}
+
 // Retrieve The network virtual LANs (VLANs) associated with a piece of hardware's network components.
 func (r Hardware_SecurityModule750) GetNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNetworkVlans", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlansWith is GetNetworkVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlans()
+}
+
 // Retrieve A hardware's allotted bandwidth for the next billing cycle (measured in GB).
 func (r Hardware_SecurityModule750) GetNextBillingCycleBandwidthAllocation() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNextBillingCycleBandwidthAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextBillingCycleBandwidthAllocationWith is GetNextBillingCycleBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetNextBillingCycleBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextBillingCycleBandwidthAllocation()
+}
+
 // Retrieve
 func (r Hardware_SecurityModule750) GetNotesHistory() (resp []datatypes.Hardware_Note, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getNotesHistory", nil, &r.Options, &resp)
@@ -7292,42 +11638,98 @@ func (r Hardware_SecurityModule750) GetOpenCancellationTicket() (resp datatypes.
 	return
 }
 
+// GetOpenCancellationTicketWith is GetOpenCancellationTicket, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetOpenCancellationTicketWith(options sl.Options) (resp datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenCancellationTicket()
+}
+
 // Retrieve Information regarding a piece of hardware's operating system.
 func (r Hardware_SecurityModule750) GetOperatingSystem() (resp datatypes.Software_Component_OperatingSystem, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getOperatingSystem", nil, &r.Options, &resp)
 	return
 }
 
+// GetOperatingSystemWith is GetOperatingSystem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetOperatingSystemWith(options sl.Options) (resp datatypes.Software_Component_OperatingSystem, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystem()
+}
+
 // Retrieve A hardware's operating system software description.
 func (r Hardware_SecurityModule750) GetOperatingSystemReferenceCode() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getOperatingSystemReferenceCode", nil, &r.Options, &resp)
 	return
 }
 
+// GetOperatingSystemReferenceCodeWith is GetOperatingSystemReferenceCode, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetOperatingSystemReferenceCodeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystemReferenceCode()
+}
+
 // Retrieve The sum of all the outbound network traffic data for the last 30 days.
 func (r Hardware_SecurityModule750) GetOutboundBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getOutboundBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundBandwidthUsageWith is GetOutboundBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetOutboundBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundBandwidthUsage()
+}
+
 // Retrieve The total private outbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_SecurityModule750) GetOutboundPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getOutboundPrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundPrivateBandwidthUsageWith is GetOutboundPrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetOutboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPrivateBandwidthUsage()
+}
+
 // Retrieve The total public outbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_SecurityModule750) GetOutboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getOutboundPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundPublicBandwidthUsageWith is GetOutboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetOutboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPublicBandwidthUsage()
+}
+
 // Retrieve Whether the bandwidth usage for this hardware for the current billing cycle exceeds the allocation.
 func (r Hardware_SecurityModule750) GetOverBandwidthAllocationFlag() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getOverBandwidthAllocationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetOverBandwidthAllocationFlagWith is GetOverBandwidthAllocationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOverBandwidthAllocationFlag()
+}
+
 // Retrieve a server's hardware state via its internal sensors. Remote sensor data is transmitted to the SoftLayer API by way of the server's remote management card. Sensor data measures system temperatures, voltages, and other local server settings. Sensor data is cached for 30 seconds. Calls made to getSensorData for the same server within 30 seconds of each other will return the same data. Subsequent calls will return new data once the cache expires.
 func (r Hardware_SecurityModule750) GetPMInfo() (resp []datatypes.Container_RemoteManagement_PmInfo, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getPMInfo", nil, &r.Options, &resp)
@@ -7340,42 +11742,98 @@ func (r Hardware_SecurityModule750) GetParentBay() (resp datatypes.Hardware_Blad
 	return
 }
 
+// GetParentBayWith is GetParentBay, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetParentBayWith(options sl.Options) (resp datatypes.Hardware_Blade, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentBay()
+}
+
 // Retrieve Parent Hardware.
 func (r Hardware_SecurityModule750) GetParentHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getParentHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentHardwareWith is GetParentHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetParentHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentHardware()
+}
+
 // Retrieve Information regarding the Point of Presence (PoP) location in which a piece of hardware resides.
 func (r Hardware_SecurityModule750) GetPointOfPresenceLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getPointOfPresenceLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetPointOfPresenceLocationWith is GetPointOfPresenceLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetPointOfPresenceLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPointOfPresenceLocation()
+}
+
 // Retrieve The power components for a hardware object.
 func (r Hardware_SecurityModule750) GetPowerComponents() (resp []datatypes.Hardware_Power_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getPowerComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetPowerComponentsWith is GetPowerComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetPowerComponentsWith(options sl.Options) (resp []datatypes.Hardware_Power_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPowerComponents()
+}
+
 // Retrieve Information regarding a piece of hardware's power supply.
 func (r Hardware_SecurityModule750) GetPowerSupply() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getPowerSupply", nil, &r.Options, &resp)
 	return
 }
 
+// GetPowerSupplyWith is GetPowerSupply, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetPowerSupplyWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPowerSupply()
+}
+
 // Retrieve The hardware's primary private IP address.
 func (r Hardware_SecurityModule750) GetPrimaryBackendIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getPrimaryBackendIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryBackendIpAddressWith is GetPrimaryBackendIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetPrimaryBackendIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendIpAddress()
+}
+
 // Retrieve Information regarding the hardware's primary back-end network component.
 func (r Hardware_SecurityModule750) GetPrimaryBackendNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getPrimaryBackendNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryBackendNetworkComponentWith is GetPrimaryBackendNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetPrimaryBackendNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendNetworkComponent()
+}
+
 // no documentation yet
 func (r Hardware_SecurityModule750) GetPrimaryDriveSize() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getPrimaryDriveSize", nil, &r.Options, &resp)
@@ -7388,12 +11846,28 @@ func (r Hardware_SecurityModule750) GetPrimaryIpAddress() (resp string, err erro
 	return
 }
 
+// GetPrimaryIpAddressWith is GetPrimaryIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetPrimaryIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryIpAddress()
+}
+
 // Retrieve Information regarding the hardware's primary public network component.
 func (r Hardware_SecurityModule750) GetPrimaryNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getPrimaryNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryNetworkComponentWith is GetPrimaryNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetPrimaryNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryNetworkComponent()
+}
+
 // Retrieve a graph of a server's private network bandwidth usage over the specified timeframe. If no timeframe is specified then getPublicBandwidthGraphImage retrieves the last 24 hours of public bandwidth usage. getPrivateBandwidthGraphImage returns a PNG image measuring 827 pixels by 293 pixels.
 func (r Hardware_SecurityModule750) GetPrivateBandwidthData(startTime *int, endTime *int) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -7426,6 +11900,14 @@ func (r Hardware_SecurityModule750) GetPrivateIpAddress() (resp string, err erro
 	return
 }
 
+// GetPrivateIpAddressWith is GetPrivateIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetPrivateIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateIpAddress()
+}
+
 // Retrieve the private network component attached with this server.
 func (r Hardware_SecurityModule750) GetPrivateNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getPrivateNetworkComponent", nil, &r.Options, &resp)
@@ -7438,6 +11920,14 @@ func (r Hardware_SecurityModule750) GetPrivateNetworkOnlyFlag() (resp bool, err
 	return
 }
 
+// GetPrivateNetworkOnlyFlagWith is GetPrivateNetworkOnlyFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetPrivateNetworkOnlyFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateNetworkOnlyFlag()
+}
+
 // Retrieve the backend VLAN for the primary IP address of the server
 func (r Hardware_SecurityModule750) GetPrivateVlan() (resp datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getPrivateVlan", nil, &r.Options, &resp)
@@ -7459,30 +11949,70 @@ func (r Hardware_SecurityModule750) GetProcessorCoreAmount() (resp uint, err err
 	return
 }
 
+// GetProcessorCoreAmountWith is GetProcessorCoreAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetProcessorCoreAmountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessorCoreAmount()
+}
+
 // Retrieve The total number of physical processor cores, summed from all processors that are attached to a piece of hardware
 func (r Hardware_SecurityModule750) GetProcessorPhysicalCoreAmount() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getProcessorPhysicalCoreAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetProcessorPhysicalCoreAmountWith is GetProcessorPhysicalCoreAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetProcessorPhysicalCoreAmountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessorPhysicalCoreAmount()
+}
+
 // Retrieve Information regarding a piece of hardware's processors.
 func (r Hardware_SecurityModule750) GetProcessors() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getProcessors", nil, &r.Options, &resp)
 	return
 }
 
+// GetProcessorsWith is GetProcessors, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetProcessorsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessors()
+}
+
 // Retrieve Whether the bandwidth usage for this hardware for the current billing cycle is projected to exceed the allocation.
 func (r Hardware_SecurityModule750) GetProjectedOverBandwidthAllocationFlag() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getProjectedOverBandwidthAllocationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetProjectedOverBandwidthAllocationFlagWith is GetProjectedOverBandwidthAllocationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetProjectedOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedOverBandwidthAllocationFlag()
+}
+
 // Retrieve The projected public outbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_SecurityModule750) GetProjectedPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getProjectedPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetProjectedPublicBandwidthUsageWith is GetProjectedPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetProjectedPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedPublicBandwidthUsage()
+}
+
 // no documentation yet
 func (r Hardware_SecurityModule750) GetProvisionDate() (resp datatypes.Time, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getProvisionDate", nil, &r.Options, &resp)
@@ -7558,24 +12088,56 @@ func (r Hardware_SecurityModule750) GetRaidControllers() (resp []datatypes.Hardw
 	return
 }
 
+// GetRaidControllersWith is GetRaidControllers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetRaidControllersWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRaidControllers()
+}
+
 // Retrieve Determine if hardware object is vSan Ready Node.
 func (r Hardware_SecurityModule750) GetReadyNodeFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getReadyNodeFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetReadyNodeFlagWith is GetReadyNodeFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetReadyNodeFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReadyNodeFlag()
+}
+
 // Retrieve Recent events that impact this hardware.
 func (r Hardware_SecurityModule750) GetRecentEvents() (resp []datatypes.Notification_Occurrence_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getRecentEvents", nil, &r.Options, &resp)
 	return
 }
 
+// GetRecentEventsWith is GetRecentEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetRecentEventsWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRecentEvents()
+}
+
 // Retrieve The last five commands issued to the server's remote management card.
 func (r Hardware_SecurityModule750) GetRecentRemoteManagementCommands() (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getRecentRemoteManagementCommands", nil, &r.Options, &resp)
 	return
 }
 
+// GetRecentRemoteManagementCommandsWith is GetRecentRemoteManagementCommands, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetRecentRemoteManagementCommandsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRecentRemoteManagementCommands()
+}
+
 // Retrieve
 func (r Hardware_SecurityModule750) GetRegionalInternetRegistry() (resp datatypes.Network_Regional_Internet_Registry, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getRegionalInternetRegistry", nil, &r.Options, &resp)
@@ -7588,24 +12150,56 @@ func (r Hardware_SecurityModule750) GetRemoteManagement() (resp datatypes.Hardwa
 	return
 }
 
+// GetRemoteManagementWith is GetRemoteManagement, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetRemoteManagementWith(options sl.Options) (resp datatypes.Hardware_Component_RemoteManagement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagement()
+}
+
 // Retrieve User credentials to issue commands and/or interact with the server's remote management card.
 func (r Hardware_SecurityModule750) GetRemoteManagementAccounts() (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getRemoteManagementAccounts", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementAccountsWith is GetRemoteManagementAccounts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetRemoteManagementAccountsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementAccounts()
+}
+
 // Retrieve A hardware's associated remote management component. This is normally IPMI.
 func (r Hardware_SecurityModule750) GetRemoteManagementComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getRemoteManagementComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementComponentWith is GetRemoteManagementComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetRemoteManagementComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementComponent()
+}
+
 // Retrieve User(s) who have access to issue commands and/or interact with the server's remote management card.
 func (r Hardware_SecurityModule750) GetRemoteManagementUsers() (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getRemoteManagementUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementUsersWith is GetRemoteManagementUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetRemoteManagementUsersWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementUsers()
+}
+
 // Retrieve
 func (r Hardware_SecurityModule750) GetResourceConfigurations() (resp []datatypes.Hardware_Resource_Configuration, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getResourceConfigurations", nil, &r.Options, &resp)
@@ -7630,6 +12224,14 @@ func (r Hardware_SecurityModule750) GetResourceGroups() (resp []datatypes.Resour
 	return
 }
 
+// GetResourceGroupsWith is GetResourceGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetResourceGroupsWith(options sl.Options) (resp []datatypes.Resource_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResourceGroups()
+}
+
 // Retrieve the reverse domain records associated with this server.
 func (r Hardware_SecurityModule750) GetReverseDomainRecords() (resp []datatypes.Dns_Domain, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getReverseDomainRecords", nil, &r.Options, &resp)
@@ -7642,18 +12244,42 @@ func (r Hardware_SecurityModule750) GetRouters() (resp []datatypes.Hardware, err
 	return
 }
 
+// GetRoutersWith is GetRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRouters()
+}
+
 // Retrieve Collection of scale assets this hardware corresponds to.
 func (r Hardware_SecurityModule750) GetScaleAssets() (resp []datatypes.Scale_Asset, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getScaleAssets", nil, &r.Options, &resp)
 	return
 }
 
+// GetScaleAssetsWith is GetScaleAssets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetScaleAssetsWith(options sl.Options) (resp []datatypes.Scale_Asset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleAssets()
+}
+
 // Retrieve Information regarding a piece of hardware's vulnerability scan requests.
 func (r Hardware_SecurityModule750) GetSecurityScanRequests() (resp []datatypes.Network_Security_Scanner_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getSecurityScanRequests", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecurityScanRequestsWith is GetSecurityScanRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetSecurityScanRequestsWith(options sl.Options) (resp []datatypes.Network_Security_Scanner_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityScanRequests()
+}
+
 // Retrieve a server's hardware state via its internal sensors. Remote sensor data is transmitted to the SoftLayer API by way of the server's remote management card. Sensor data measures system temperatures, voltages, and other local server settings. Sensor data is cached for 30 seconds. Calls made to getSensorData for the same server within 30 seconds of each other will return the same data. Subsequent calls will return new data once the cache expires.
 func (r Hardware_SecurityModule750) GetSensorData() (resp []datatypes.Container_RemoteManagement_SensorReading, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getSensorData", nil, &r.Options, &resp)
@@ -7690,6 +12316,14 @@ func (r Hardware_SecurityModule750) GetServerRoom() (resp datatypes.Location, er
 	return
 }
 
+// GetServerRoomWith is GetServerRoom, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetServerRoomWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServerRoom()
+}
+
 // Retrieve the server's temperature and displays them using thermometer graphs.  Temperatures retrieved are CPU(s) and system temperatures.  Data used to construct graphs is retrieved from the server's remote management card.  All graphs returned will have a title associated with it.
 func (r Hardware_SecurityModule750) GetServerTemperatureGraphs() (resp []datatypes.Container_RemoteManagement_Graphs_SensorTemperature, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getServerTemperatureGraphs", nil, &r.Options, &resp)
@@ -7702,36 +12336,84 @@ func (r Hardware_SecurityModule750) GetServiceProvider() (resp datatypes.Service
 	return
 }
 
+// GetServiceProviderWith is GetServiceProvider, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetServiceProviderWith(options sl.Options) (resp datatypes.Service_Provider, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceProvider()
+}
+
 // Retrieve Information regarding a piece of hardware's installed software.
 func (r Hardware_SecurityModule750) GetSoftwareComponents() (resp []datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getSoftwareComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareComponentsWith is GetSoftwareComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetSoftwareComponentsWith(options sl.Options) (resp []datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareComponents()
+}
+
 // Retrieve Determine if hardware object has Software Guard Extension (SGX) enabled.
 func (r Hardware_SecurityModule750) GetSoftwareGuardExtensionEnabled() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getSoftwareGuardExtensionEnabled", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareGuardExtensionEnabledWith is GetSoftwareGuardExtensionEnabled, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetSoftwareGuardExtensionEnabledWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareGuardExtensionEnabled()
+}
+
 // Retrieve Information regarding the billing item for a spare pool server.
 func (r Hardware_SecurityModule750) GetSparePoolBillingItem() (resp datatypes.Billing_Item_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getSparePoolBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetSparePoolBillingItemWith is GetSparePoolBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetSparePoolBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSparePoolBillingItem()
+}
+
 // Retrieve SSH keys to be installed on the server during provisioning or an OS reload.
 func (r Hardware_SecurityModule750) GetSshKeys() (resp []datatypes.Security_Ssh_Key, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getSshKeys", nil, &r.Options, &resp)
 	return
 }
 
+// GetSshKeysWith is GetSshKeys, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSshKeys()
+}
+
 // Retrieve A server's remote management card used for statistics.
 func (r Hardware_SecurityModule750) GetStatisticsRemoteManagement() (resp datatypes.Hardware_Component_RemoteManagement, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getStatisticsRemoteManagement", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatisticsRemoteManagementWith is GetStatisticsRemoteManagement, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetStatisticsRemoteManagementWith(options sl.Options) (resp datatypes.Hardware_Component_RemoteManagement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatisticsRemoteManagement()
+}
+
 // Retrieve
 func (r Hardware_SecurityModule750) GetStorageNetworkComponents() (resp []datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getStorageNetworkComponents", nil, &r.Options, &resp)
@@ -7763,6 +12445,14 @@ func (r Hardware_SecurityModule750) GetUefiBootFlag() (resp bool, err error) {
 	return
 }
 
+// GetUefiBootFlagWith is GetUefiBootFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetUefiBootFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUefiBootFlag()
+}
+
 // Retrieve a list of upgradeable items available to this piece of hardware. Currently, getUpgradeItemPrices retrieves upgrades available for a server's memory, hard drives, network port speed, bandwidth allocation and GPUs.
 func (r Hardware_SecurityModule750) GetUpgradeItemPrices() (resp []datatypes.Product_Item_Price, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getUpgradeItemPrices", nil, &r.Options, &resp)
@@ -7775,30 +12465,70 @@ func (r Hardware_SecurityModule750) GetUpgradeRequest() (resp datatypes.Product_
 	return
 }
 
+// GetUpgradeRequestWith is GetUpgradeRequest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetUpgradeRequestWith(options sl.Options) (resp datatypes.Product_Upgrade_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeRequest()
+}
+
 // Retrieve The network device connected to a piece of hardware.
 func (r Hardware_SecurityModule750) GetUplinkHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getUplinkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetUplinkHardwareWith is GetUplinkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetUplinkHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUplinkHardware()
+}
+
 // Retrieve Information regarding the network component that is one level higher than a piece of hardware on the network infrastructure.
 func (r Hardware_SecurityModule750) GetUplinkNetworkComponents() (resp []datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getUplinkNetworkComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetUplinkNetworkComponentsWith is GetUplinkNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetUplinkNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUplinkNetworkComponents()
+}
+
 // Retrieve An array containing a single string of custom user data for a hardware order. Max size is 16 kb.
 func (r Hardware_SecurityModule750) GetUserData() (resp []datatypes.Hardware_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getUserData", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserDataWith is GetUserData, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetUserDataWith(options sl.Options) (resp []datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUserData()
+}
+
 // Retrieve A list of users that have access to this computing instance.
 func (r Hardware_SecurityModule750) GetUsers() (resp []datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetUsersWith is GetUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUsers()
+}
+
 // This method will return the list of block device template groups that are valid to the host. For instance, it will only retrieve FLEX images.
 func (r Hardware_SecurityModule750) GetValidBlockDeviceTemplateGroups(visibility *string) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
 	params := []interface{}{
@@ -7814,54 +12544,126 @@ func (r Hardware_SecurityModule750) GetVirtualChassis() (resp datatypes.Hardware
 	return
 }
 
+// GetVirtualChassisWith is GetVirtualChassis, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetVirtualChassisWith(options sl.Options) (resp datatypes.Hardware_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualChassis()
+}
+
 // Retrieve Information regarding the virtual chassis siblings for a piece of hardware.
 func (r Hardware_SecurityModule750) GetVirtualChassisSiblings() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getVirtualChassisSiblings", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualChassisSiblingsWith is GetVirtualChassisSiblings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetVirtualChassisSiblingsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualChassisSiblings()
+}
+
 // Retrieve A hardware server's virtual servers.
 func (r Hardware_SecurityModule750) GetVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWith is GetVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuests()
+}
+
 // Retrieve A piece of hardware's virtual host record.
 func (r Hardware_SecurityModule750) GetVirtualHost() (resp datatypes.Virtual_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getVirtualHost", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualHostWith is GetVirtualHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetVirtualHostWith(options sl.Options) (resp datatypes.Virtual_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualHost()
+}
+
 // Retrieve Information regarding a piece of hardware's virtual software licenses.
 func (r Hardware_SecurityModule750) GetVirtualLicenses() (resp []datatypes.Software_VirtualLicense, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getVirtualLicenses", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualLicensesWith is GetVirtualLicenses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetVirtualLicensesWith(options sl.Options) (resp []datatypes.Software_VirtualLicense, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualLicenses()
+}
+
 // Retrieve Information regarding the bandwidth allotment to which a piece of hardware belongs.
 func (r Hardware_SecurityModule750) GetVirtualRack() (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getVirtualRack", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackWith is GetVirtualRack, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetVirtualRackWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRack()
+}
+
 // Retrieve The name of the bandwidth allotment belonging to a piece of hardware.
 func (r Hardware_SecurityModule750) GetVirtualRackId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getVirtualRackId", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackIdWith is GetVirtualRackId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetVirtualRackIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackId()
+}
+
 // Retrieve The name of the bandwidth allotment belonging to a piece of hardware.
 func (r Hardware_SecurityModule750) GetVirtualRackName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getVirtualRackName", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackNameWith is GetVirtualRackName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetVirtualRackNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackName()
+}
+
 // Retrieve A piece of hardware's virtualization platform software.
 func (r Hardware_SecurityModule750) GetVirtualizationPlatform() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getVirtualizationPlatform", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualizationPlatformWith is GetVirtualizationPlatform, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_SecurityModule750) GetVirtualizationPlatformWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualizationPlatform()
+}
+
 // Retrieve a list of Windows updates available for a server from the local SoftLayer Windows Server Update Services (WSUS) server. Windows servers provisioned by SoftLayer are configured to use the local WSUS server via the private network by default.
 func (r Hardware_SecurityModule750) GetWindowsUpdateAvailableUpdates() (resp []datatypes.Container_Utility_Microsoft_Windows_UpdateServices_UpdateItem, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_SecurityModule750", "getWindowsUpdateAvailableUpdates", nil, &r.Options, &resp)
@@ -8260,6 +13062,16 @@ func (r Hardware_Server) Id(id int) Hardware_Server {
 	return r
 }
 
+func (r Hardware_Server) InitParameter(name string, value interface{}) Hardware_Server {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
+func (r Hardware_Server) GlobalID(globalID string) Hardware_Server {
+	r.Options.GlobalID = &globalID
+	return r
+}
+
 func (r Hardware_Server) Mask(mask string) Hardware_Server {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -8279,11 +13091,26 @@ func (r Hardware_Server) Limit(limit int) Hardware_Server {
 	return r
 }
 
+func (r Hardware_Server) Unlimited() Hardware_Server {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Hardware_Server) Offset(offset int) Hardware_Server {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Hardware_Server) Timeout(timeout time.Duration) Hardware_Server {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Hardware_Server) WithContext(ctx context.Context) Hardware_Server {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // <b>Note:</b> All error handling and parameter documentation is referencing behavior available on January 4th, 2019.
 //
 // <h3>Behavior deprecated on January 4th, 2019</h3>
@@ -8747,24 +13574,56 @@ func (r Hardware_Server) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve A piece of hardware's active physical components.
 func (r Hardware_Server) GetActiveComponents() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getActiveComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveComponentsWith is GetActiveComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetActiveComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveComponents()
+}
+
 // Retrieve The billing item for a server's attached network firewall.
 func (r Hardware_Server) GetActiveNetworkFirewallBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getActiveNetworkFirewallBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveNetworkFirewallBillingItemWith is GetActiveNetworkFirewallBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetActiveNetworkFirewallBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveNetworkFirewallBillingItem()
+}
+
 // Retrieve A piece of hardware's active network monitoring incidents.
 func (r Hardware_Server) GetActiveNetworkMonitorIncident() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getActiveNetworkMonitorIncident", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveNetworkMonitorIncidentWith is GetActiveNetworkMonitorIncident, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetActiveNetworkMonitorIncidentWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveNetworkMonitorIncident()
+}
+
 // Retrieve
 func (r Hardware_Server) GetActiveTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getActiveTickets", nil, &r.Options, &resp)
@@ -8777,12 +13636,28 @@ func (r Hardware_Server) GetActiveTransaction() (resp datatypes.Provisioning_Ver
 	return
 }
 
+// GetActiveTransactionWith is GetActiveTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetActiveTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransaction()
+}
+
 // Retrieve Any active transaction(s) that are currently running for the server (example: os reload).
 func (r Hardware_Server) GetActiveTransactions() (resp []datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getActiveTransactions", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveTransactionsWith is GetActiveTransactions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetActiveTransactionsWith(options sl.Options) (resp []datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransactions()
+}
+
 // The '''getAlarmHistory''' method retrieves a detailed history for the monitoring alarm. When calling this method, a start and end date for the history to be retrieved must be entered.
 func (r Hardware_Server) GetAlarmHistory(startDate *datatypes.Time, endDate *datatypes.Time, alarmId *string) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -8806,24 +13681,56 @@ func (r Hardware_Server) GetAllowedHost() (resp datatypes.Network_Storage_Allowe
 	return
 }
 
+// GetAllowedHostWith is GetAllowedHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetAllowedHostWith(options sl.Options) (resp datatypes.Network_Storage_Allowed_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHost()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects that this SoftLayer_Hardware has access to.
 func (r Hardware_Server) GetAllowedNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getAllowedNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageWith is GetAllowedNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetAllowedNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorage()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects whose Replica that this SoftLayer_Hardware has access to.
 func (r Hardware_Server) GetAllowedNetworkStorageReplicas() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getAllowedNetworkStorageReplicas", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageReplicasWith is GetAllowedNetworkStorageReplicas, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetAllowedNetworkStorageReplicasWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorageReplicas()
+}
+
 // Retrieve Information regarding an antivirus/spyware software component object.
 func (r Hardware_Server) GetAntivirusSpywareSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getAntivirusSpywareSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetAntivirusSpywareSoftwareComponentWith is GetAntivirusSpywareSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetAntivirusSpywareSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAntivirusSpywareSoftwareComponent()
+}
+
 // This method is retrieve a list of SoftLayer_Network_Storage volumes that are authorized access to this SoftLayer_Hardware.
 func (r Hardware_Server) GetAttachedNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
 	params := []interface{}{
@@ -8839,12 +13746,28 @@ func (r Hardware_Server) GetAttributes() (resp []datatypes.Hardware_Attribute, e
 	return
 }
 
+// GetAttributesWith is GetAttributes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetAttributesWith(options sl.Options) (resp []datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+}
+
 // Retrieve An object that stores the maximum level for the monitoring query types and response types.
 func (r Hardware_Server) GetAvailableMonitoring() (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getAvailableMonitoring", nil, &r.Options, &resp)
 	return
 }
 
+// GetAvailableMonitoringWith is GetAvailableMonitoring, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetAvailableMonitoringWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAvailableMonitoring()
+}
+
 // This method retrieves a list of SoftLayer_Network_Storage volumes that can be authorized to this SoftLayer_Hardware.
 func (r Hardware_Server) GetAvailableNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
 	params := []interface{}{
@@ -8860,18 +13783,42 @@ func (r Hardware_Server) GetAverageDailyBandwidthUsage() (resp datatypes.Float64
 	return
 }
 
+// GetAverageDailyBandwidthUsageWith is GetAverageDailyBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetAverageDailyBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyBandwidthUsage()
+}
+
 // Retrieve The average daily private bandwidth usage for the current billing cycle.
 func (r Hardware_Server) GetAverageDailyPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getAverageDailyPrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAverageDailyPrivateBandwidthUsageWith is GetAverageDailyPrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetAverageDailyPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPrivateBandwidthUsage()
+}
+
 // Retrieve The average daily public bandwidth usage for the current billing cycle.
 func (r Hardware_Server) GetAverageDailyPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getAverageDailyPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAverageDailyPublicBandwidthUsageWith is GetAverageDailyPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetAverageDailyPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPublicBandwidthUsage()
+}
+
 // Use this method to return an array of private bandwidth utilization records between a given date range.
 //
 // This method represents the NEW version of getFrontendBandwidthUse
@@ -8910,6 +13857,14 @@ func (r Hardware_Server) GetBackendNetworkComponents() (resp []datatypes.Network
 	return
 }
 
+// GetBackendNetworkComponentsWith is GetBackendNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetBackendNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendNetworkComponents()
+}
+
 // The '''getBackendOutgoingBandwidth''' method retrieves the amount of outgoing private network traffic used between the given start date and end date parameters. When entering start and end dates, only the month, day and year are used to calculate bandwidth totals - the time (HH:MM:SS) is ignored and defaults to midnight. The amount of bandwidth retrieved is measured in gigabytes.
 func (r Hardware_Server) GetBackendOutgoingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -8926,18 +13881,42 @@ func (r Hardware_Server) GetBackendRouters() (resp []datatypes.Hardware, err err
 	return
 }
 
+// GetBackendRoutersWith is GetBackendRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetBackendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendRouters()
+}
+
 // Retrieve A hardware's allotted bandwidth (measured in GB).
 func (r Hardware_Server) GetBandwidthAllocation() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getBandwidthAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllocationWith is GetBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllocation()
+}
+
 // Retrieve A hardware's allotted detail record. Allotment details link bandwidth allocation with allotments.
 func (r Hardware_Server) GetBandwidthAllotmentDetail() (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getBandwidthAllotmentDetail", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllotmentDetailWith is GetBandwidthAllotmentDetail, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetBandwidthAllotmentDetailWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotmentDetail()
+}
+
 // Retrieve a collection of bandwidth data from an individual public or private network tracking object. Data is ideal if you with to employ your own traffic storage and graphing systems.
 func (r Hardware_Server) GetBandwidthForDateRange(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -8967,60 +13946,140 @@ func (r Hardware_Server) GetBenchmarkCertifications() (resp []datatypes.Hardware
 	return
 }
 
+// GetBenchmarkCertificationsWith is GetBenchmarkCertifications, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetBenchmarkCertificationsWith(options sl.Options) (resp []datatypes.Hardware_Benchmark_Certification, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBenchmarkCertifications()
+}
+
 // Retrieve The raw bandwidth usage data for the current billing cycle. One object will be returned for each network this server is attached to.
 func (r Hardware_Server) GetBillingCycleBandwidthUsage() (resp []datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getBillingCycleBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCycleBandwidthUsageWith is GetBillingCycleBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetBillingCycleBandwidthUsageWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCycleBandwidthUsage()
+}
+
 // Retrieve The raw private bandwidth usage data for the current billing cycle.
 func (r Hardware_Server) GetBillingCyclePrivateBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getBillingCyclePrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCyclePrivateBandwidthUsageWith is GetBillingCyclePrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetBillingCyclePrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePrivateBandwidthUsage()
+}
+
 // Retrieve The raw public bandwidth usage data for the current billing cycle.
 func (r Hardware_Server) GetBillingCyclePublicBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getBillingCyclePublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCyclePublicBandwidthUsageWith is GetBillingCyclePublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetBillingCyclePublicBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePublicBandwidthUsage()
+}
+
 // Retrieve Information regarding the billing item for a server.
 func (r Hardware_Server) GetBillingItem() (resp datatypes.Billing_Item_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve A flag indicating that a billing item exists.
 func (r Hardware_Server) GetBillingItemFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getBillingItemFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemFlagWith is GetBillingItemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItemFlag()
+}
+
 // Retrieve Determine if BIOS password should be left as null.
 func (r Hardware_Server) GetBiosPasswordNullFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getBiosPasswordNullFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBiosPasswordNullFlagWith is GetBiosPasswordNullFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetBiosPasswordNullFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBiosPasswordNullFlag()
+}
+
 // Retrieve Determines whether the hardware is ineligible for cancellation because it is disconnected.
 func (r Hardware_Server) GetBlockCancelBecauseDisconnectedFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getBlockCancelBecauseDisconnectedFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBlockCancelBecauseDisconnectedFlagWith is GetBlockCancelBecauseDisconnectedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetBlockCancelBecauseDisconnectedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockCancelBecauseDisconnectedFlag()
+}
+
 // Retrieve Status indicating whether or not a piece of hardware has business continuance insurance.
 func (r Hardware_Server) GetBusinessContinuanceInsuranceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getBusinessContinuanceInsuranceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBusinessContinuanceInsuranceFlagWith is GetBusinessContinuanceInsuranceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetBusinessContinuanceInsuranceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBusinessContinuanceInsuranceFlag()
+}
+
 // Retrieve Child hardware.
 func (r Hardware_Server) GetChildrenHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getChildrenHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildrenHardwareWith is GetChildrenHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetChildrenHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildrenHardware()
+}
+
 // no documentation yet
 func (r Hardware_Server) GetComponentDetailsXML() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getComponentDetailsXML", nil, &r.Options, &resp)
@@ -9033,6 +14092,14 @@ func (r Hardware_Server) GetComponents() (resp []datatypes.Hardware_Component, e
 	return
 }
 
+// GetComponentsWith is GetComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetComponentsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetComponents()
+}
+
 // Retrieve
 func (r Hardware_Server) GetContainsSolidStateDrivesFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getContainsSolidStateDrivesFlag", nil, &r.Options, &resp)
@@ -9045,18 +14112,42 @@ func (r Hardware_Server) GetContinuousDataProtectionSoftwareComponent() (resp da
 	return
 }
 
+// GetContinuousDataProtectionSoftwareComponentWith is GetContinuousDataProtectionSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetContinuousDataProtectionSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetContinuousDataProtectionSoftwareComponent()
+}
+
 // Retrieve A server's control panel.
 func (r Hardware_Server) GetControlPanel() (resp datatypes.Software_Component_ControlPanel, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getControlPanel", nil, &r.Options, &resp)
 	return
 }
 
+// GetControlPanelWith is GetControlPanel, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetControlPanelWith(options sl.Options) (resp datatypes.Software_Component_ControlPanel, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetControlPanel()
+}
+
 // Retrieve The total cost of a server, measured in US Dollars ($USD).
 func (r Hardware_Server) GetCost() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getCost", nil, &r.Options, &resp)
 	return
 }
 
+// GetCostWith is GetCost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetCostWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCost()
+}
+
 //
 // There are many options that may be provided while ordering a server, this method can be used to determine what these options are.
 //
@@ -9073,6 +14164,14 @@ func (r Hardware_Server) GetCurrentBandwidthSummary() (resp datatypes.Metric_Tra
 	return
 }
 
+// GetCurrentBandwidthSummaryWith is GetCurrentBandwidthSummary, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetCurrentBandwidthSummaryWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentBandwidthSummary()
+}
+
 // Attempt to retrieve the file associated with the current benchmark certification result, if such a file exists.  If there is no file for this benchmark certification result, calling this method throws an exception.
 func (r Hardware_Server) GetCurrentBenchmarkCertificationResultFile() (resp []byte, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getCurrentBenchmarkCertificationResultFile", nil, &r.Options, &resp)
@@ -9085,6 +14184,14 @@ func (r Hardware_Server) GetCurrentBillableBandwidthUsage() (resp datatypes.Floa
 	return
 }
 
+// GetCurrentBillableBandwidthUsageWith is GetCurrentBillableBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetCurrentBillableBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentBillableBandwidthUsage()
+}
+
 // Get the billing detail for this hardware for the current billing period. This does not include bandwidth usage.
 func (r Hardware_Server) GetCurrentBillingDetail() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getCurrentBillingDetail", nil, &r.Options, &resp)
@@ -9112,12 +14219,28 @@ func (r Hardware_Server) GetCustomerInstalledOperatingSystemFlag() (resp bool, e
 	return
 }
 
+// GetCustomerInstalledOperatingSystemFlagWith is GetCustomerInstalledOperatingSystemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetCustomerInstalledOperatingSystemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCustomerInstalledOperatingSystemFlag()
+}
+
 // Retrieve Indicates if a server is a customer owned device.
 func (r Hardware_Server) GetCustomerOwnedFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getCustomerOwnedFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetCustomerOwnedFlagWith is GetCustomerOwnedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetCustomerOwnedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCustomerOwnedFlag()
+}
+
 // The '''getDailyAverage''' method calculates the average daily network traffic used by the selected server. Using the required parameter ''dateTime'' to enter a start and end date, the user retrieves this average, measure in gigabytes (GB) for the specified date range. When entering parameters, only the month, day and year are required - time entries are omitted as this method defaults the time to midnight in order to account for the entire day.
 func (r Hardware_Server) GetDailyAverage(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -9134,84 +14257,196 @@ func (r Hardware_Server) GetDatacenter() (resp datatypes.Location, err error) {
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // Retrieve The name of the datacenter in which a piece of hardware resides.
 func (r Hardware_Server) GetDatacenterName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getDatacenterName", nil, &r.Options, &resp)
 	return
 }
 
+// GetDatacenterNameWith is GetDatacenterName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetDatacenterNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenterName()
+}
+
 // Retrieve Number of day(s) a server have been in spare pool.
 func (r Hardware_Server) GetDaysInSparePool() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getDaysInSparePool", nil, &r.Options, &resp)
 	return
 }
 
+// GetDaysInSparePoolWith is GetDaysInSparePool, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetDaysInSparePoolWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDaysInSparePool()
+}
+
 // Retrieve All hardware that has uplink network connections to a piece of hardware.
 func (r Hardware_Server) GetDownlinkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getDownlinkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkHardwareWith is GetDownlinkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetDownlinkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkHardware()
+}
+
 // Retrieve All hardware that has uplink network connections to a piece of hardware.
 func (r Hardware_Server) GetDownlinkNetworkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getDownlinkNetworkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkNetworkHardwareWith is GetDownlinkNetworkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetDownlinkNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkNetworkHardware()
+}
+
 // Retrieve Information regarding all servers attached to a piece of network hardware.
 func (r Hardware_Server) GetDownlinkServers() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getDownlinkServers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkServersWith is GetDownlinkServers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetDownlinkServersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkServers()
+}
+
 // Retrieve Information regarding all virtual guests attached to a piece of network hardware.
 func (r Hardware_Server) GetDownlinkVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getDownlinkVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownlinkVirtualGuestsWith is GetDownlinkVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetDownlinkVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkVirtualGuests()
+}
+
 // Retrieve All hardware downstream from a network device.
 func (r Hardware_Server) GetDownstreamHardwareBindings() (resp []datatypes.Network_Component_Uplink_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getDownstreamHardwareBindings", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamHardwareBindingsWith is GetDownstreamHardwareBindings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetDownstreamHardwareBindingsWith(options sl.Options) (resp []datatypes.Network_Component_Uplink_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamHardwareBindings()
+}
+
 // Retrieve All network hardware downstream from the selected piece of hardware.
 func (r Hardware_Server) GetDownstreamNetworkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getDownstreamNetworkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamNetworkHardwareWith is GetDownstreamNetworkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetDownstreamNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamNetworkHardware()
+}
+
 // Retrieve All network hardware with monitoring warnings or errors that are downstream from the selected piece of hardware.
 func (r Hardware_Server) GetDownstreamNetworkHardwareWithIncidents() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getDownstreamNetworkHardwareWithIncidents", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamNetworkHardwareWithIncidentsWith is GetDownstreamNetworkHardwareWithIncidents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetDownstreamNetworkHardwareWithIncidentsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamNetworkHardwareWithIncidents()
+}
+
 // Retrieve Information regarding all servers attached downstream to a piece of network hardware.
 func (r Hardware_Server) GetDownstreamServers() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getDownstreamServers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamServersWith is GetDownstreamServers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetDownstreamServersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamServers()
+}
+
 // Retrieve Information regarding all virtual guests attached to a piece of network hardware.
 func (r Hardware_Server) GetDownstreamVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getDownstreamVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetDownstreamVirtualGuestsWith is GetDownstreamVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetDownstreamVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownstreamVirtualGuests()
+}
+
 // Retrieve The drive controllers contained within a piece of hardware.
 func (r Hardware_Server) GetDriveControllers() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getDriveControllers", nil, &r.Options, &resp)
 	return
 }
 
+// GetDriveControllersWith is GetDriveControllers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetDriveControllersWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDriveControllers()
+}
+
 // Retrieve Information regarding a piece of hardware's associated EVault network storage service account.
 func (r Hardware_Server) GetEvaultNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getEvaultNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetEvaultNetworkStorageWith is GetEvaultNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetEvaultNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvaultNetworkStorage()
+}
+
 // Get the subnets associated with this server that are protectable by a network component firewall.
 func (r Hardware_Server) GetFirewallProtectableSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getFirewallProtectableSubnets", nil, &r.Options, &resp)
@@ -9224,12 +14459,28 @@ func (r Hardware_Server) GetFirewallServiceComponent() (resp datatypes.Network_C
 	return
 }
 
+// GetFirewallServiceComponentWith is GetFirewallServiceComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetFirewallServiceComponentWith(options sl.Options) (resp datatypes.Network_Component_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirewallServiceComponent()
+}
+
 // Retrieve Defines the fixed components in a fixed configuration bare metal server.
 func (r Hardware_Server) GetFixedConfigurationPreset() (resp datatypes.Product_Package_Preset, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getFixedConfigurationPreset", nil, &r.Options, &resp)
 	return
 }
 
+// GetFixedConfigurationPresetWith is GetFixedConfigurationPreset, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetFixedConfigurationPresetWith(options sl.Options) (resp datatypes.Product_Package_Preset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFixedConfigurationPreset()
+}
+
 // Use this method to return an array of public bandwidth utilization records between a given date range.
 //
 // This method represents the NEW version of getFrontendBandwidthUse
@@ -9268,6 +14519,14 @@ func (r Hardware_Server) GetFrontendNetworkComponents() (resp []datatypes.Networ
 	return
 }
 
+// GetFrontendNetworkComponentsWith is GetFrontendNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetFrontendNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendNetworkComponents()
+}
+
 // The '''getFrontendOutgoingBandwidth''' method retrieves the amount of outgoing public network traffic used by a server between the given start and end date parameters. The ''dateTime'' parameter requires only the day, month and year to be entered - the time (hour, minute and second) are set to midnight be default in order to gather the data for the entire start and end date indicated in the parameter. The amount of bandwidth retrieved is measured in gigabytes (GB).
 func (r Hardware_Server) GetFrontendOutgoingBandwidth(startDate *datatypes.Time, endDate *datatypes.Time) (resp datatypes.Float64, err error) {
 	params := []interface{}{
@@ -9284,18 +14543,42 @@ func (r Hardware_Server) GetFrontendRouters() (resp []datatypes.Hardware, err er
 	return
 }
 
+// GetFrontendRoutersWith is GetFrontendRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetFrontendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendRouters()
+}
+
 // Retrieve A hardware's universally unique identifier.
 func (r Hardware_Server) GetGlobalIdentifier() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getGlobalIdentifier", nil, &r.Options, &resp)
 	return
 }
 
+// GetGlobalIdentifierWith is GetGlobalIdentifier, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetGlobalIdentifierWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGlobalIdentifier()
+}
+
 // Retrieve The hard drives contained within a piece of hardware.
 func (r Hardware_Server) GetHardDrives() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getHardDrives", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardDrivesWith is GetHardDrives, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetHardDrivesWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardDrives()
+}
+
 // Retrieve a server by searching for the primary IP address.
 func (r Hardware_Server) GetHardwareByIpAddress(ipAddress *string) (resp datatypes.Hardware_Server, err error) {
 	params := []interface{}{
@@ -9311,42 +14594,98 @@ func (r Hardware_Server) GetHardwareChassis() (resp datatypes.Hardware_Chassis,
 	return
 }
 
+// GetHardwareChassisWith is GetHardwareChassis, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetHardwareChassisWith(options sl.Options) (resp datatypes.Hardware_Chassis, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareChassis()
+}
+
 // Retrieve A hardware's function.
 func (r Hardware_Server) GetHardwareFunction() (resp datatypes.Hardware_Function, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getHardwareFunction", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareFunctionWith is GetHardwareFunction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetHardwareFunctionWith(options sl.Options) (resp datatypes.Hardware_Function, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareFunction()
+}
+
 // Retrieve A hardware's function.
 func (r Hardware_Server) GetHardwareFunctionDescription() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getHardwareFunctionDescription", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareFunctionDescriptionWith is GetHardwareFunctionDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetHardwareFunctionDescriptionWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareFunctionDescription()
+}
+
 // Retrieve A hardware's status.
 func (r Hardware_Server) GetHardwareStatus() (resp datatypes.Hardware_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getHardwareStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareStatusWith is GetHardwareStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetHardwareStatusWith(options sl.Options) (resp datatypes.Hardware_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareStatus()
+}
+
 // Retrieve Determine if hardware has Single Root IO VIrtualization (SR-IOV) billing item.
 func (r Hardware_Server) GetHasSingleRootVirtualizationBillingItemFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getHasSingleRootVirtualizationBillingItemFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasSingleRootVirtualizationBillingItemFlagWith is GetHasSingleRootVirtualizationBillingItemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetHasSingleRootVirtualizationBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasSingleRootVirtualizationBillingItemFlag()
+}
+
 // Retrieve Determine in hardware object has TPM enabled.
 func (r Hardware_Server) GetHasTrustedPlatformModuleBillingItemFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getHasTrustedPlatformModuleBillingItemFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasTrustedPlatformModuleBillingItemFlagWith is GetHasTrustedPlatformModuleBillingItemFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetHasTrustedPlatformModuleBillingItemFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasTrustedPlatformModuleBillingItemFlag()
+}
+
 // Retrieve Information regarding a host IPS software component object.
 func (r Hardware_Server) GetHostIpsSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getHostIpsSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetHostIpsSoftwareComponentWith is GetHostIpsSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetHostIpsSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHostIpsSoftwareComponent()
+}
+
 // The '''getHourlyBandwidth''' method retrieves all bandwidth updates hourly for the specified hardware. Because the potential number of data points can become excessive, the method limits the user to obtain data in 24-hour intervals. The required ''dateTime'' parameter is used as the starting point for the query and will be calculated for the 24-hour period starting with the specified date and time. For example, entering a parameter of
 //
 // '02/01/2008 0:00'
@@ -9369,36 +14708,84 @@ func (r Hardware_Server) GetHourlyBillingFlag() (resp bool, err error) {
 	return
 }
 
+// GetHourlyBillingFlagWith is GetHourlyBillingFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetHourlyBillingFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyBillingFlag()
+}
+
 // Retrieve The sum of all the inbound network traffic data for the last 30 days.
 func (r Hardware_Server) GetInboundBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getInboundBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundBandwidthUsageWith is GetInboundBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetInboundBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundBandwidthUsage()
+}
+
 // Retrieve The total private inbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_Server) GetInboundPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getInboundPrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundPrivateBandwidthUsageWith is GetInboundPrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetInboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPrivateBandwidthUsage()
+}
+
 // Retrieve The total public inbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_Server) GetInboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getInboundPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundPublicBandwidthUsageWith is GetInboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetInboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPublicBandwidthUsage()
+}
+
 // Retrieve Determine if hardware object has the IBM_CLOUD_READY_NODE_CERTIFIED attribute.
 func (r Hardware_Server) GetIsCloudReadyNodeCertified() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getIsCloudReadyNodeCertified", nil, &r.Options, &resp)
 	return
 }
 
+// GetIsCloudReadyNodeCertifiedWith is GetIsCloudReadyNodeCertified, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetIsCloudReadyNodeCertifiedWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsCloudReadyNodeCertified()
+}
+
 // Retrieve Determine if remote management has been disabled due to port speed.
 func (r Hardware_Server) GetIsIpmiDisabled() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getIsIpmiDisabled", nil, &r.Options, &resp)
 	return
 }
 
+// GetIsIpmiDisabledWith is GetIsIpmiDisabled, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetIsIpmiDisabledWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsIpmiDisabled()
+}
+
 // Return a collection of SoftLayer_Item_Price objects from a collection of SoftLayer_Software_Description
 func (r Hardware_Server) GetItemPricesFromSoftwareDescriptions(softwareDescriptions []datatypes.Software_Description, includeTranslationsFlag *bool, returnAllPricesFlag *bool) (resp []datatypes.Product_Item, err error) {
 	params := []interface{}{
@@ -9416,24 +14803,56 @@ func (r Hardware_Server) GetLastOperatingSystemReload() (resp datatypes.Provisio
 	return
 }
 
+// GetLastOperatingSystemReloadWith is GetLastOperatingSystemReload, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetLastOperatingSystemReloadWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastOperatingSystemReload()
+}
+
 // Retrieve Information regarding the last transaction a server performed.
 func (r Hardware_Server) GetLastTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getLastTransaction", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastTransactionWith is GetLastTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetLastTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastTransaction()
+}
+
 // Retrieve A piece of hardware's latest network monitoring incident.
 func (r Hardware_Server) GetLatestNetworkMonitorIncident() (resp datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getLatestNetworkMonitorIncident", nil, &r.Options, &resp)
 	return
 }
 
+// GetLatestNetworkMonitorIncidentWith is GetLatestNetworkMonitorIncident, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetLatestNetworkMonitorIncidentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestNetworkMonitorIncident()
+}
+
 // Retrieve Where a piece of hardware is located within SoftLayer's location hierarchy.
 func (r Hardware_Server) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve
 func (r Hardware_Server) GetLocationPathString() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getLocationPathString", nil, &r.Options, &resp)
@@ -9446,12 +14865,28 @@ func (r Hardware_Server) GetLockboxNetworkStorage() (resp datatypes.Network_Stor
 	return
 }
 
+// GetLockboxNetworkStorageWith is GetLockboxNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetLockboxNetworkStorageWith(options sl.Options) (resp datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLockboxNetworkStorage()
+}
+
 // Retrieve A flag indicating that the hardware is a managed resource.
 func (r Hardware_Server) GetManagedResourceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getManagedResourceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetManagedResourceFlagWith is GetManagedResourceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetManagedResourceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedResourceFlag()
+}
+
 // Retrieve the remote management network component attached with this server.
 func (r Hardware_Server) GetManagementNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getManagementNetworkComponent", nil, &r.Options, &resp)
@@ -9464,24 +14899,56 @@ func (r Hardware_Server) GetMemory() (resp []datatypes.Hardware_Component, err e
 	return
 }
 
+// GetMemoryWith is GetMemory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetMemoryWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMemory()
+}
+
 // Retrieve The amount of memory a piece of hardware has, measured in gigabytes.
 func (r Hardware_Server) GetMemoryCapacity() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getMemoryCapacity", nil, &r.Options, &resp)
 	return
 }
 
+// GetMemoryCapacityWith is GetMemoryCapacity, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetMemoryCapacityWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMemoryCapacity()
+}
+
 // Retrieve A piece of hardware's metric tracking object.
 func (r Hardware_Server) GetMetricTrackingObject() (resp datatypes.Metric_Tracking_Object_HardwareServer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getMetricTrackingObject", nil, &r.Options, &resp)
 	return
 }
 
+// GetMetricTrackingObjectWith is GetMetricTrackingObject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_HardwareServer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+}
+
 // Retrieve The metric tracking object id for this server.
 func (r Hardware_Server) GetMetricTrackingObjectId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getMetricTrackingObjectId", nil, &r.Options, &resp)
 	return
 }
 
+// GetMetricTrackingObjectIdWith is GetMetricTrackingObjectId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetMetricTrackingObjectIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObjectId()
+}
+
 // Returns open monitoring alarms for a given time period
 func (r Hardware_Server) GetMonitoringActiveAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -9498,6 +14965,14 @@ func (r Hardware_Server) GetMonitoringAgents() (resp []datatypes.Monitoring_Agen
 	return
 }
 
+// GetMonitoringAgentsWith is GetMonitoringAgents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetMonitoringAgentsWith(options sl.Options) (resp []datatypes.Monitoring_Agent, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringAgents()
+}
+
 // Returns closed monitoring alarms for a given time period
 func (r Hardware_Server) GetMonitoringClosedAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -9514,42 +14989,98 @@ func (r Hardware_Server) GetMonitoringRobot() (resp datatypes.Monitoring_Robot,
 	return
 }
 
+// GetMonitoringRobotWith is GetMonitoringRobot, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetMonitoringRobotWith(options sl.Options) (resp datatypes.Monitoring_Robot, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringRobot()
+}
+
 // Retrieve Information regarding a piece of hardware's network monitoring services.
 func (r Hardware_Server) GetMonitoringServiceComponent() (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getMonitoringServiceComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceComponentWith is GetMonitoringServiceComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetMonitoringServiceComponentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceComponent()
+}
+
 // Retrieve The monitoring service flag eligibility status for a piece of hardware.
 func (r Hardware_Server) GetMonitoringServiceEligibilityFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getMonitoringServiceEligibilityFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceEligibilityFlagWith is GetMonitoringServiceEligibilityFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetMonitoringServiceEligibilityFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceEligibilityFlag()
+}
+
 // Retrieve The service flag status for a piece of hardware.
 func (r Hardware_Server) GetMonitoringServiceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getMonitoringServiceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringServiceFlagWith is GetMonitoringServiceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetMonitoringServiceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceFlag()
+}
+
 // Retrieve The monitoring notification objects for this hardware. Each object links this hardware instance to a user account that will be notified if monitoring on this hardware object fails
 func (r Hardware_Server) GetMonitoringUserNotification() (resp []datatypes.User_Customer_Notification_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getMonitoringUserNotification", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonitoringUserNotificationWith is GetMonitoringUserNotification, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetMonitoringUserNotificationWith(options sl.Options) (resp []datatypes.User_Customer_Notification_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringUserNotification()
+}
+
 // Retrieve Information regarding a piece of hardware's motherboard.
 func (r Hardware_Server) GetMotherboard() (resp datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getMotherboard", nil, &r.Options, &resp)
 	return
 }
 
+// GetMotherboardWith is GetMotherboard, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetMotherboardWith(options sl.Options) (resp datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMotherboard()
+}
+
 // Retrieve Information regarding a piece of hardware's network cards.
 func (r Hardware_Server) GetNetworkCards() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNetworkCards", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkCardsWith is GetNetworkCards, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetNetworkCardsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkCards()
+}
+
 // Get the IP addresses associated with this server that are protectable by a network component firewall. Note, this may not return all values for IPv6 subnets for this server. Please use getFirewallProtectableSubnets to get all protectable subnets.
 func (r Hardware_Server) GetNetworkComponentFirewallProtectableIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNetworkComponentFirewallProtectableIpAddresses", nil, &r.Options, &resp)
@@ -9562,78 +15093,182 @@ func (r Hardware_Server) GetNetworkComponents() (resp []datatypes.Network_Compon
 	return
 }
 
+// GetNetworkComponentsWith is GetNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponents()
+}
+
 // Retrieve The gateway member if this device is part of a network gateway.
 func (r Hardware_Server) GetNetworkGatewayMember() (resp datatypes.Network_Gateway_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNetworkGatewayMember", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkGatewayMemberWith is GetNetworkGatewayMember, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetNetworkGatewayMemberWith(options sl.Options) (resp datatypes.Network_Gateway_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGatewayMember()
+}
+
 // Retrieve Whether or not this device is part of a network gateway.
 func (r Hardware_Server) GetNetworkGatewayMemberFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNetworkGatewayMemberFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkGatewayMemberFlagWith is GetNetworkGatewayMemberFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetNetworkGatewayMemberFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGatewayMemberFlag()
+}
+
 // Retrieve A piece of hardware's network management IP address.
 func (r Hardware_Server) GetNetworkManagementIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNetworkManagementIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkManagementIpAddressWith is GetNetworkManagementIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetNetworkManagementIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkManagementIpAddress()
+}
+
 // Retrieve All servers with failed monitoring that are attached downstream to a piece of hardware.
 func (r Hardware_Server) GetNetworkMonitorAttachedDownHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNetworkMonitorAttachedDownHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorAttachedDownHardwareWith is GetNetworkMonitorAttachedDownHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetNetworkMonitorAttachedDownHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorAttachedDownHardware()
+}
+
 // Retrieve Virtual guests that are attached downstream to a hardware that have failed monitoring
 func (r Hardware_Server) GetNetworkMonitorAttachedDownVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNetworkMonitorAttachedDownVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorAttachedDownVirtualGuestsWith is GetNetworkMonitorAttachedDownVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetNetworkMonitorAttachedDownVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorAttachedDownVirtualGuests()
+}
+
 // Retrieve The status of all of a piece of hardware's network monitoring incidents.
 func (r Hardware_Server) GetNetworkMonitorIncidents() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNetworkMonitorIncidents", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorIncidentsWith is GetNetworkMonitorIncidents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetNetworkMonitorIncidentsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorIncidents()
+}
+
 // Retrieve Information regarding a piece of hardware's network monitors.
 func (r Hardware_Server) GetNetworkMonitors() (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNetworkMonitors", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorsWith is GetNetworkMonitors, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetNetworkMonitorsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitors()
+}
+
 // Retrieve The value of a hardware's network status attribute.
 func (r Hardware_Server) GetNetworkStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNetworkStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStatusWith is GetNetworkStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetNetworkStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStatus()
+}
+
 // Retrieve The hardware's related network status attribute.
 func (r Hardware_Server) GetNetworkStatusAttribute() (resp datatypes.Hardware_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNetworkStatusAttribute", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStatusAttributeWith is GetNetworkStatusAttribute, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetNetworkStatusAttributeWith(options sl.Options) (resp datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStatusAttribute()
+}
+
 // Retrieve Information regarding a piece of hardware's associated network storage service account.
 func (r Hardware_Server) GetNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStorageWith is GetNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStorage()
+}
+
 // Retrieve The network virtual LANs (VLANs) associated with a piece of hardware's network components.
 func (r Hardware_Server) GetNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNetworkVlans", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlansWith is GetNetworkVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlans()
+}
+
 // Retrieve A hardware's allotted bandwidth for the next billing cycle (measured in GB).
 func (r Hardware_Server) GetNextBillingCycleBandwidthAllocation() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNextBillingCycleBandwidthAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetNextBillingCycleBandwidthAllocationWith is GetNextBillingCycleBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetNextBillingCycleBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNextBillingCycleBandwidthAllocation()
+}
+
 // Retrieve
 func (r Hardware_Server) GetNotesHistory() (resp []datatypes.Hardware_Note, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getNotesHistory", nil, &r.Options, &resp)
@@ -9652,42 +15287,98 @@ func (r Hardware_Server) GetOpenCancellationTicket() (resp datatypes.Ticket, err
 	return
 }
 
+// GetOpenCancellationTicketWith is GetOpenCancellationTicket, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetOpenCancellationTicketWith(options sl.Options) (resp datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenCancellationTicket()
+}
+
 // Retrieve Information regarding a piece of hardware's operating system.
 func (r Hardware_Server) GetOperatingSystem() (resp datatypes.Software_Component_OperatingSystem, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getOperatingSystem", nil, &r.Options, &resp)
 	return
 }
 
+// GetOperatingSystemWith is GetOperatingSystem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetOperatingSystemWith(options sl.Options) (resp datatypes.Software_Component_OperatingSystem, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystem()
+}
+
 // Retrieve A hardware's operating system software description.
 func (r Hardware_Server) GetOperatingSystemReferenceCode() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getOperatingSystemReferenceCode", nil, &r.Options, &resp)
 	return
 }
 
+// GetOperatingSystemReferenceCodeWith is GetOperatingSystemReferenceCode, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetOperatingSystemReferenceCodeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystemReferenceCode()
+}
+
 // Retrieve The sum of all the outbound network traffic data for the last 30 days.
 func (r Hardware_Server) GetOutboundBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getOutboundBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundBandwidthUsageWith is GetOutboundBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetOutboundBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundBandwidthUsage()
+}
+
 // Retrieve The total private outbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_Server) GetOutboundPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getOutboundPrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundPrivateBandwidthUsageWith is GetOutboundPrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetOutboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPrivateBandwidthUsage()
+}
+
 // Retrieve The total public outbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_Server) GetOutboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getOutboundPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundPublicBandwidthUsageWith is GetOutboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetOutboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPublicBandwidthUsage()
+}
+
 // Retrieve Whether the bandwidth usage for this hardware for the current billing cycle exceeds the allocation.
 func (r Hardware_Server) GetOverBandwidthAllocationFlag() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getOverBandwidthAllocationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetOverBandwidthAllocationFlagWith is GetOverBandwidthAllocationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOverBandwidthAllocationFlag()
+}
+
 // Retrieve a server's hardware state via its internal sensors. Remote sensor data is transmitted to the SoftLayer API by way of the server's remote management card. Sensor data measures system temperatures, voltages, and other local server settings. Sensor data is cached for 30 seconds. Calls made to getSensorData for the same server within 30 seconds of each other will return the same data. Subsequent calls will return new data once the cache expires.
 func (r Hardware_Server) GetPMInfo() (resp []datatypes.Container_RemoteManagement_PmInfo, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getPMInfo", nil, &r.Options, &resp)
@@ -9700,42 +15391,98 @@ func (r Hardware_Server) GetParentBay() (resp datatypes.Hardware_Blade, err erro
 	return
 }
 
+// GetParentBayWith is GetParentBay, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetParentBayWith(options sl.Options) (resp datatypes.Hardware_Blade, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentBay()
+}
+
 // Retrieve Parent Hardware.
 func (r Hardware_Server) GetParentHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getParentHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentHardwareWith is GetParentHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetParentHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentHardware()
+}
+
 // Retrieve Information regarding the Point of Presence (PoP) location in which a piece of hardware resides.
 func (r Hardware_Server) GetPointOfPresenceLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getPointOfPresenceLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetPointOfPresenceLocationWith is GetPointOfPresenceLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetPointOfPresenceLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPointOfPresenceLocation()
+}
+
 // Retrieve The power components for a hardware object.
 func (r Hardware_Server) GetPowerComponents() (resp []datatypes.Hardware_Power_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getPowerComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetPowerComponentsWith is GetPowerComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetPowerComponentsWith(options sl.Options) (resp []datatypes.Hardware_Power_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPowerComponents()
+}
+
 // Retrieve Information regarding a piece of hardware's power supply.
 func (r Hardware_Server) GetPowerSupply() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getPowerSupply", nil, &r.Options, &resp)
 	return
 }
 
+// GetPowerSupplyWith is GetPowerSupply, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetPowerSupplyWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPowerSupply()
+}
+
 // Retrieve The hardware's primary private IP address.
 func (r Hardware_Server) GetPrimaryBackendIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getPrimaryBackendIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryBackendIpAddressWith is GetPrimaryBackendIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetPrimaryBackendIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendIpAddress()
+}
+
 // Retrieve Information regarding the hardware's primary back-end network component.
 func (r Hardware_Server) GetPrimaryBackendNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getPrimaryBackendNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryBackendNetworkComponentWith is GetPrimaryBackendNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetPrimaryBackendNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendNetworkComponent()
+}
+
 // no documentation yet
 func (r Hardware_Server) GetPrimaryDriveSize() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getPrimaryDriveSize", nil, &r.Options, &resp)
@@ -9748,12 +15495,28 @@ func (r Hardware_Server) GetPrimaryIpAddress() (resp string, err error) {
 	return
 }
 
+// GetPrimaryIpAddressWith is GetPrimaryIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetPrimaryIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryIpAddress()
+}
+
 // Retrieve Information regarding the hardware's primary public network component.
 func (r Hardware_Server) GetPrimaryNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getPrimaryNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryNetworkComponentWith is GetPrimaryNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetPrimaryNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryNetworkComponent()
+}
+
 // Retrieve a graph of a server's private network bandwidth usage over the specified timeframe. If no timeframe is specified then getPublicBandwidthGraphImage retrieves the last 24 hours of public bandwidth usage. getPrivateBandwidthGraphImage returns a PNG image measuring 827 pixels by 293 pixels.
 func (r Hardware_Server) GetPrivateBandwidthData(startTime *int, endTime *int) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -9786,6 +15549,14 @@ func (r Hardware_Server) GetPrivateIpAddress() (resp string, err error) {
 	return
 }
 
+// GetPrivateIpAddressWith is GetPrivateIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetPrivateIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateIpAddress()
+}
+
 // Retrieve the private network component attached with this server.
 func (r Hardware_Server) GetPrivateNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getPrivateNetworkComponent", nil, &r.Options, &resp)
@@ -9798,6 +15569,14 @@ func (r Hardware_Server) GetPrivateNetworkOnlyFlag() (resp bool, err error) {
 	return
 }
 
+// GetPrivateNetworkOnlyFlagWith is GetPrivateNetworkOnlyFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetPrivateNetworkOnlyFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateNetworkOnlyFlag()
+}
+
 // Retrieve the backend VLAN for the primary IP address of the server
 func (r Hardware_Server) GetPrivateVlan() (resp datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getPrivateVlan", nil, &r.Options, &resp)
@@ -9819,30 +15598,70 @@ func (r Hardware_Server) GetProcessorCoreAmount() (resp uint, err error) {
 	return
 }
 
+// GetProcessorCoreAmountWith is GetProcessorCoreAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetProcessorCoreAmountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessorCoreAmount()
+}
+
 // Retrieve The total number of physical processor cores, summed from all processors that are attached to a piece of hardware
 func (r Hardware_Server) GetProcessorPhysicalCoreAmount() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getProcessorPhysicalCoreAmount", nil, &r.Options, &resp)
 	return
 }
 
+// GetProcessorPhysicalCoreAmountWith is GetProcessorPhysicalCoreAmount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetProcessorPhysicalCoreAmountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessorPhysicalCoreAmount()
+}
+
 // Retrieve Information regarding a piece of hardware's processors.
 func (r Hardware_Server) GetProcessors() (resp []datatypes.Hardware_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getProcessors", nil, &r.Options, &resp)
 	return
 }
 
+// GetProcessorsWith is GetProcessors, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetProcessorsWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProcessors()
+}
+
 // Retrieve Whether the bandwidth usage for this hardware for the current billing cycle is projected to exceed the allocation.
 func (r Hardware_Server) GetProjectedOverBandwidthAllocationFlag() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getProjectedOverBandwidthAllocationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetProjectedOverBandwidthAllocationFlagWith is GetProjectedOverBandwidthAllocationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetProjectedOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedOverBandwidthAllocationFlag()
+}
+
 // Retrieve The projected public outbound bandwidth for this hardware for the current billing cycle.
 func (r Hardware_Server) GetProjectedPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getProjectedPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetProjectedPublicBandwidthUsageWith is GetProjectedPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetProjectedPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedPublicBandwidthUsage()
+}
+
 // no documentation yet
 func (r Hardware_Server) GetProvisionDate() (resp datatypes.Time, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getProvisionDate", nil, &r.Options, &resp)
@@ -9918,24 +15737,56 @@ func (r Hardware_Server) GetRaidControllers() (resp []datatypes.Hardware_Compone
 	return
 }
 
+// GetRaidControllersWith is GetRaidControllers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetRaidControllersWith(options sl.Options) (resp []datatypes.Hardware_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRaidControllers()
+}
+
 // Retrieve Determine if hardware object is vSan Ready Node.
 func (r Hardware_Server) GetReadyNodeFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getReadyNodeFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetReadyNodeFlagWith is GetReadyNodeFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetReadyNodeFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReadyNodeFlag()
+}
+
 // Retrieve Recent events that impact this hardware.
 func (r Hardware_Server) GetRecentEvents() (resp []datatypes.Notification_Occurrence_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getRecentEvents", nil, &r.Options, &resp)
 	return
 }
 
+// GetRecentEventsWith is GetRecentEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetRecentEventsWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRecentEvents()
+}
+
 // Retrieve The last five commands issued to the server's remote management card.
 func (r Hardware_Server) GetRecentRemoteManagementCommands() (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getRecentRemoteManagementCommands", nil, &r.Options, &resp)
 	return
 }
 
+// GetRecentRemoteManagementCommandsWith is GetRecentRemoteManagementCommands, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetRecentRemoteManagementCommandsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRecentRemoteManagementCommands()
+}
+
 // Retrieve
 func (r Hardware_Server) GetRegionalInternetRegistry() (resp datatypes.Network_Regional_Internet_Registry, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getRegionalInternetRegistry", nil, &r.Options, &resp)
@@ -9948,24 +15799,56 @@ func (r Hardware_Server) GetRemoteManagement() (resp datatypes.Hardware_Componen
 	return
 }
 
+// GetRemoteManagementWith is GetRemoteManagement, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetRemoteManagementWith(options sl.Options) (resp datatypes.Hardware_Component_RemoteManagement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagement()
+}
+
 // Retrieve User credentials to issue commands and/or interact with the server's remote management card.
 func (r Hardware_Server) GetRemoteManagementAccounts() (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getRemoteManagementAccounts", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementAccountsWith is GetRemoteManagementAccounts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetRemoteManagementAccountsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementAccounts()
+}
+
 // Retrieve A hardware's associated remote management component. This is normally IPMI.
 func (r Hardware_Server) GetRemoteManagementComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getRemoteManagementComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementComponentWith is GetRemoteManagementComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetRemoteManagementComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementComponent()
+}
+
 // Retrieve User(s) who have access to issue commands and/or interact with the server's remote management card.
 func (r Hardware_Server) GetRemoteManagementUsers() (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getRemoteManagementUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementUsersWith is GetRemoteManagementUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetRemoteManagementUsersWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementUsers()
+}
+
 // Retrieve
 func (r Hardware_Server) GetResourceConfigurations() (resp []datatypes.Hardware_Resource_Configuration, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getResourceConfigurations", nil, &r.Options, &resp)
@@ -9990,6 +15873,14 @@ func (r Hardware_Server) GetResourceGroups() (resp []datatypes.Resource_Group, e
 	return
 }
 
+// GetResourceGroupsWith is GetResourceGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetResourceGroupsWith(options sl.Options) (resp []datatypes.Resource_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResourceGroups()
+}
+
 // Retrieve the reverse domain records associated with this server.
 func (r Hardware_Server) GetReverseDomainRecords() (resp []datatypes.Dns_Domain, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getReverseDomainRecords", nil, &r.Options, &resp)
@@ -10002,18 +15893,42 @@ func (r Hardware_Server) GetRouters() (resp []datatypes.Hardware, err error) {
 	return
 }
 
+// GetRoutersWith is GetRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRouters()
+}
+
 // Retrieve Collection of scale assets this hardware corresponds to.
 func (r Hardware_Server) GetScaleAssets() (resp []datatypes.Scale_Asset, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getScaleAssets", nil, &r.Options, &resp)
 	return
 }
 
+// GetScaleAssetsWith is GetScaleAssets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetScaleAssetsWith(options sl.Options) (resp []datatypes.Scale_Asset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleAssets()
+}
+
 // Retrieve Information regarding a piece of hardware's vulnerability scan requests.
 func (r Hardware_Server) GetSecurityScanRequests() (resp []datatypes.Network_Security_Scanner_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getSecurityScanRequests", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecurityScanRequestsWith is GetSecurityScanRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetSecurityScanRequestsWith(options sl.Options) (resp []datatypes.Network_Security_Scanner_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityScanRequests()
+}
+
 // Retrieve a server's hardware state via its internal sensors. Remote sensor data is transmitted to the SoftLayer API by way of the server's remote management card. Sensor data measures system temperatures, voltages, and other local server settings. Sensor data is cached for 30 seconds. Calls made to getSensorData for the same server within 30 seconds of each other will return the same data. Subsequent calls will return new data once the cache expires.
 func (r Hardware_Server) GetSensorData() (resp []datatypes.Container_RemoteManagement_SensorReading, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getSensorData", nil, &r.Options, &resp)
@@ -10050,6 +15965,14 @@ func (r Hardware_Server) GetServerRoom() (resp datatypes.Location, err error) {
 	return
 }
 
+// GetServerRoomWith is GetServerRoom, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetServerRoomWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServerRoom()
+}
+
 // Retrieve the server's temperature and displays them using thermometer graphs.  Temperatures retrieved are CPU(s) and system temperatures.  Data used to construct graphs is retrieved from the server's remote management card.  All graphs returned will have a title associated with it.
 func (r Hardware_Server) GetServerTemperatureGraphs() (resp []datatypes.Container_RemoteManagement_Graphs_SensorTemperature, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getServerTemperatureGraphs", nil, &r.Options, &resp)
@@ -10062,36 +15985,84 @@ func (r Hardware_Server) GetServiceProvider() (resp datatypes.Service_Provider,
 	return
 }
 
+// GetServiceProviderWith is GetServiceProvider, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetServiceProviderWith(options sl.Options) (resp datatypes.Service_Provider, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceProvider()
+}
+
 // Retrieve Information regarding a piece of hardware's installed software.
 func (r Hardware_Server) GetSoftwareComponents() (resp []datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getSoftwareComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareComponentsWith is GetSoftwareComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetSoftwareComponentsWith(options sl.Options) (resp []datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareComponents()
+}
+
 // Retrieve Determine if hardware object has Software Guard Extension (SGX) enabled.
 func (r Hardware_Server) GetSoftwareGuardExtensionEnabled() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getSoftwareGuardExtensionEnabled", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareGuardExtensionEnabledWith is GetSoftwareGuardExtensionEnabled, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetSoftwareGuardExtensionEnabledWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareGuardExtensionEnabled()
+}
+
 // Retrieve Information regarding the billing item for a spare pool server.
 func (r Hardware_Server) GetSparePoolBillingItem() (resp datatypes.Billing_Item_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getSparePoolBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetSparePoolBillingItemWith is GetSparePoolBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetSparePoolBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSparePoolBillingItem()
+}
+
 // Retrieve SSH keys to be installed on the server during provisioning or an OS reload.
 func (r Hardware_Server) GetSshKeys() (resp []datatypes.Security_Ssh_Key, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getSshKeys", nil, &r.Options, &resp)
 	return
 }
 
+// GetSshKeysWith is GetSshKeys, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSshKeys()
+}
+
 // Retrieve A server's remote management card used for statistics.
 func (r Hardware_Server) GetStatisticsRemoteManagement() (resp datatypes.Hardware_Component_RemoteManagement, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getStatisticsRemoteManagement", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatisticsRemoteManagementWith is GetStatisticsRemoteManagement, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetStatisticsRemoteManagementWith(options sl.Options) (resp datatypes.Hardware_Component_RemoteManagement, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatisticsRemoteManagement()
+}
+
 // Retrieve
 func (r Hardware_Server) GetStorageNetworkComponents() (resp []datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getStorageNetworkComponents", nil, &r.Options, &resp)
@@ -10123,6 +16094,14 @@ func (r Hardware_Server) GetUefiBootFlag() (resp bool, err error) {
 	return
 }
 
+// GetUefiBootFlagWith is GetUefiBootFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetUefiBootFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUefiBootFlag()
+}
+
 // Retrieve a list of upgradeable items available to this piece of hardware. Currently, getUpgradeItemPrices retrieves upgrades available for a server's memory, hard drives, network port speed, bandwidth allocation and GPUs.
 func (r Hardware_Server) GetUpgradeItemPrices() (resp []datatypes.Product_Item_Price, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getUpgradeItemPrices", nil, &r.Options, &resp)
@@ -10135,30 +16114,70 @@ func (r Hardware_Server) GetUpgradeRequest() (resp datatypes.Product_Upgrade_Req
 	return
 }
 
+// GetUpgradeRequestWith is GetUpgradeRequest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetUpgradeRequestWith(options sl.Options) (resp datatypes.Product_Upgrade_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeRequest()
+}
+
 // Retrieve The network device connected to a piece of hardware.
 func (r Hardware_Server) GetUplinkHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getUplinkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetUplinkHardwareWith is GetUplinkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetUplinkHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUplinkHardware()
+}
+
 // Retrieve Information regarding the network component that is one level higher than a piece of hardware on the network infrastructure.
 func (r Hardware_Server) GetUplinkNetworkComponents() (resp []datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getUplinkNetworkComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetUplinkNetworkComponentsWith is GetUplinkNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetUplinkNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUplinkNetworkComponents()
+}
+
 // Retrieve An array containing a single string of custom user data for a hardware order. Max size is 16 kb.
 func (r Hardware_Server) GetUserData() (resp []datatypes.Hardware_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getUserData", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserDataWith is GetUserData, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetUserDataWith(options sl.Options) (resp []datatypes.Hardware_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUserData()
+}
+
 // Retrieve A list of users that have access to this computing instance.
 func (r Hardware_Server) GetUsers() (resp []datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetUsersWith is GetUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUsers()
+}
+
 // This method will return the list of block device template groups that are valid to the host. For instance, it will only retrieve FLEX images.
 func (r Hardware_Server) GetValidBlockDeviceTemplateGroups(visibility *string) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
 	params := []interface{}{
@@ -10174,54 +16193,126 @@ func (r Hardware_Server) GetVirtualChassis() (resp datatypes.Hardware_Group, err
 	return
 }
 
+// GetVirtualChassisWith is GetVirtualChassis, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetVirtualChassisWith(options sl.Options) (resp datatypes.Hardware_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualChassis()
+}
+
 // Retrieve Information regarding the virtual chassis siblings for a piece of hardware.
 func (r Hardware_Server) GetVirtualChassisSiblings() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getVirtualChassisSiblings", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualChassisSiblingsWith is GetVirtualChassisSiblings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetVirtualChassisSiblingsWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualChassisSiblings()
+}
+
 // Retrieve A hardware server's virtual servers.
 func (r Hardware_Server) GetVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWith is GetVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuests()
+}
+
 // Retrieve A piece of hardware's virtual host record.
 func (r Hardware_Server) GetVirtualHost() (resp datatypes.Virtual_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getVirtualHost", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualHostWith is GetVirtualHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetVirtualHostWith(options sl.Options) (resp datatypes.Virtual_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualHost()
+}
+
 // Retrieve Information regarding a piece of hardware's virtual software licenses.
 func (r Hardware_Server) GetVirtualLicenses() (resp []datatypes.Software_VirtualLicense, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getVirtualLicenses", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualLicensesWith is GetVirtualLicenses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetVirtualLicensesWith(options sl.Options) (resp []datatypes.Software_VirtualLicense, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualLicenses()
+}
+
 // Retrieve Information regarding the bandwidth allotment to which a piece of hardware belongs.
 func (r Hardware_Server) GetVirtualRack() (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getVirtualRack", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackWith is GetVirtualRack, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetVirtualRackWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRack()
+}
+
 // Retrieve The name of the bandwidth allotment belonging to a piece of hardware.
 func (r Hardware_Server) GetVirtualRackId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getVirtualRackId", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackIdWith is GetVirtualRackId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetVirtualRackIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackId()
+}
+
 // Retrieve The name of the bandwidth allotment belonging to a piece of hardware.
 func (r Hardware_Server) GetVirtualRackName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getVirtualRackName", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackNameWith is GetVirtualRackName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetVirtualRackNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackName()
+}
+
 // Retrieve A piece of hardware's virtualization platform software.
 func (r Hardware_Server) GetVirtualizationPlatform() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getVirtualizationPlatform", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualizationPlatformWith is GetVirtualizationPlatform, with the mask, filter and result limit of options applied to
+// this call only
+func (r Hardware_Server) GetVirtualizationPlatformWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualizationPlatform()
+}
+
 // Retrieve a list of Windows updates available for a server from the local SoftLayer Windows Server Update Services (WSUS) server. Windows servers provisioned by SoftLayer are configured to use the local WSUS server via the private network by default.
 func (r Hardware_Server) GetWindowsUpdateAvailableUpdates() (resp []datatypes.Container_Utility_Microsoft_Windows_UpdateServices_UpdateItem, err error) {
 	err = r.Session.DoRequest("SoftLayer_Hardware_Server", "getWindowsUpdateAvailableUpdates", nil, &r.Options, &resp)
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Layout_Container) Id(id int) Layout_Container {
 	return r
 }
 
+func (r Layout_Container) InitParameter(name string, value interface{}) Layout_Container {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Layout_Container) Mask(mask string) Layout_Container {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Layout_Container) Limit(limit int) Layout_Container {
 	return r
 }
 
+func (r Layout_Container) Unlimited() Layout_Container {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Layout_Container) Offset(offset int) Layout_Container {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Layout_Container) Timeout(timeout time.Duration) Layout_Container {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Layout_Container) WithContext(ctx context.Context) Layout_Container {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Use this method to retrieve all active layout containers that can be customized.
 func (r Layout_Container) GetAllObjects() (resp []datatypes.Layout_Container, err error) {
 	err = r.Session.DoRequest("SoftLayer_Layout_Container", "getAllObjects", nil, &r.Options, &resp)
@@ -81,12 +103,28 @@ func (r Layout_Container) GetLayoutContainerType() (resp datatypes.Layout_Contai
 	return
 }
 
+// GetLayoutContainerTypeWith is GetLayoutContainerType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Layout_Container) GetLayoutContainerTypeWith(options sl.Options) (resp datatypes.Layout_Container_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLayoutContainerType()
+}
+
 // Retrieve The layout items assigned to this layout container
 func (r Layout_Container) GetLayoutItems() (resp []datatypes.Layout_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Layout_Container", "getLayoutItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetLayoutItemsWith is GetLayoutItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Layout_Container) GetLayoutItemsWith(options sl.Options) (resp []datatypes.Layout_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLayoutItems()
+}
+
 // no documentation yet
 func (r Layout_Container) GetObject() (resp datatypes.Layout_Container, err error) {
 	err = r.Session.DoRequest("SoftLayer_Layout_Container", "getObject", nil, &r.Options, &resp)
@@ -109,6 +147,11 @@ func (r Layout_Item) Id(id int) Layout_Item {
 	return r
 }
 
+func (r Layout_Item) InitParameter(name string, value interface{}) Layout_Item {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Layout_Item) Mask(mask string) Layout_Item {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -128,23 +171,54 @@ func (r Layout_Item) Limit(limit int) Layout_Item {
 	return r
 }
 
+func (r Layout_Item) Unlimited() Layout_Item {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Layout_Item) Offset(offset int) Layout_Item {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Layout_Item) Timeout(timeout time.Duration) Layout_Item {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Layout_Item) WithContext(ctx context.Context) Layout_Item {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The layout preferences assigned to this layout item
 func (r Layout_Item) GetLayoutItemPreferences() (resp []datatypes.Layout_Preference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Layout_Item", "getLayoutItemPreferences", nil, &r.Options, &resp)
 	return
 }
 
+// GetLayoutItemPreferencesWith is GetLayoutItemPreferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Layout_Item) GetLayoutItemPreferencesWith(options sl.Options) (resp []datatypes.Layout_Preference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLayoutItemPreferences()
+}
+
 // Retrieve The type of the layout item object
 func (r Layout_Item) GetLayoutItemType() (resp datatypes.Layout_Item_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Layout_Item", "getLayoutItemType", nil, &r.Options, &resp)
 	return
 }
 
+// GetLayoutItemTypeWith is GetLayoutItemType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Layout_Item) GetLayoutItemTypeWith(options sl.Options) (resp datatypes.Layout_Item_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLayoutItemType()
+}
+
 // no documentation yet
 func (r Layout_Item) GetObject() (resp datatypes.Layout_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Layout_Item", "getObject", nil, &r.Options, &resp)
@@ -167,6 +241,11 @@ func (r Layout_Profile) Id(id int) Layout_Profile {
 	return r
 }
 
+func (r Layout_Profile) InitParameter(name string, value interface{}) Layout_Profile {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Layout_Profile) Mask(mask string) Layout_Profile {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -186,11 +265,26 @@ func (r Layout_Profile) Limit(limit int) Layout_Profile {
 	return r
 }
 
+func (r Layout_Profile) Unlimited() Layout_Profile {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Layout_Profile) Offset(offset int) Layout_Profile {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Layout_Profile) Timeout(timeout time.Duration) Layout_Profile {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Layout_Profile) WithContext(ctx context.Context) Layout_Profile {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method creates a new layout profile object.
 func (r Layout_Profile) CreateObject(templateObject *datatypes.Layout_Profile) (resp bool, err error) {
 	params := []interface{}{
@@ -271,6 +365,11 @@ func (r Layout_Profile_Containers) Id(id int) Layout_Profile_Containers {
 	return r
 }
 
+func (r Layout_Profile_Containers) InitParameter(name string, value interface{}) Layout_Profile_Containers {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Layout_Profile_Containers) Mask(mask string) Layout_Profile_Containers {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -290,11 +389,26 @@ func (r Layout_Profile_Containers) Limit(limit int) Layout_Profile_Containers {
 	return r
 }
 
+func (r Layout_Profile_Containers) Unlimited() Layout_Profile_Containers {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Layout_Profile_Containers) Offset(offset int) Layout_Profile_Containers {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Layout_Profile_Containers) Timeout(timeout time.Duration) Layout_Profile_Containers {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Layout_Profile_Containers) WithContext(ctx context.Context) Layout_Profile_Containers {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Layout_Profile_Containers) CreateObject(templateObject *datatypes.Layout_Profile_Containers) (resp bool, err error) {
 	params := []interface{}{
@@ -319,12 +433,28 @@ func (r Layout_Profile_Containers) GetLayoutContainerType() (resp datatypes.Layo
 	return
 }
 
+// GetLayoutContainerTypeWith is GetLayoutContainerType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Layout_Profile_Containers) GetLayoutContainerTypeWith(options sl.Options) (resp datatypes.Layout_Container, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLayoutContainerType()
+}
+
 // Retrieve The profile containing this container
 func (r Layout_Profile_Containers) GetLayoutProfile() (resp datatypes.Layout_Profile, err error) {
 	err = r.Session.DoRequest("SoftLayer_Layout_Profile_Containers", "getLayoutProfile", nil, &r.Options, &resp)
 	return
 }
 
+// GetLayoutProfileWith is GetLayoutProfile, with the mask, filter and result limit of options applied to
+// this call only
+func (r Layout_Profile_Containers) GetLayoutProfileWith(options sl.Options) (resp datatypes.Layout_Profile, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLayoutProfile()
+}
+
 // no documentation yet
 func (r Layout_Profile_Containers) GetObject() (resp datatypes.Layout_Profile_Containers, err error) {
 	err = r.Session.DoRequest("SoftLayer_Layout_Profile_Containers", "getObject", nil, &r.Options, &resp)
@@ -347,6 +477,11 @@ func (r Layout_Profile_Customer) Id(id int) Layout_Profile_Customer {
 	return r
 }
 
+func (r Layout_Profile_Customer) InitParameter(name string, value interface{}) Layout_Profile_Customer {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Layout_Profile_Customer) Mask(mask string) Layout_Profile_Customer {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -366,11 +501,26 @@ func (r Layout_Profile_Customer) Limit(limit int) Layout_Profile_Customer {
 	return r
 }
 
+func (r Layout_Profile_Customer) Unlimited() Layout_Profile_Customer {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Layout_Profile_Customer) Offset(offset int) Layout_Profile_Customer {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Layout_Profile_Customer) Timeout(timeout time.Duration) Layout_Profile_Customer {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Layout_Profile_Customer) WithContext(ctx context.Context) Layout_Profile_Customer {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method creates a new layout profile object.
 func (r Layout_Profile_Customer) CreateObject(templateObject *datatypes.Layout_Profile) (resp bool, err error) {
 	params := []interface{}{
@@ -457,6 +607,11 @@ func (r Layout_Profile_Preference) Id(id int) Layout_Profile_Preference {
 	return r
 }
 
+func (r Layout_Profile_Preference) InitParameter(name string, value interface{}) Layout_Profile_Preference {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Layout_Profile_Preference) Mask(mask string) Layout_Profile_Preference {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -476,11 +631,26 @@ func (r Layout_Profile_Preference) Limit(limit int) Layout_Profile_Preference {
 	return r
 }
 
+func (r Layout_Profile_Preference) Unlimited() Layout_Profile_Preference {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Layout_Profile_Preference) Offset(offset int) Layout_Profile_Preference {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Layout_Profile_Preference) Timeout(timeout time.Duration) Layout_Profile_Preference {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Layout_Profile_Preference) WithContext(ctx context.Context) Layout_Profile_Preference {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Layout_Profile_Preference) GetLayoutContainer() (resp datatypes.Layout_Container, err error) {
 	err = r.Session.DoRequest("SoftLayer_Layout_Profile_Preference", "getLayoutContainer", nil, &r.Options, &resp)
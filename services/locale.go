@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Locale) Id(id int) Locale {
 	return r
 }
 
+func (r Locale) InitParameter(name string, value interface{}) Locale {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Locale) Mask(mask string) Locale {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Locale) Limit(limit int) Locale {
 	return r
 }
 
+func (r Locale) Unlimited() Locale {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Locale) Offset(offset int) Locale {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Locale) Timeout(timeout time.Duration) Locale {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Locale) WithContext(ctx context.Context) Locale {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Locale) GetClosestToLanguageTag(languageTag *string) (resp datatypes.Locale, err error) {
 	params := []interface{}{
@@ -100,6 +122,11 @@ func (r Locale_Country) Id(id int) Locale_Country {
 	return r
 }
 
+func (r Locale_Country) InitParameter(name string, value interface{}) Locale_Country {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Locale_Country) Mask(mask string) Locale_Country {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -119,11 +146,26 @@ func (r Locale_Country) Limit(limit int) Locale_Country {
 	return r
 }
 
+func (r Locale_Country) Unlimited() Locale_Country {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Locale_Country) Offset(offset int) Locale_Country {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Locale_Country) Timeout(timeout time.Duration) Locale_Country {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Locale_Country) WithContext(ctx context.Context) Locale_Country {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is to get the collection of VAT country codes and VAT ID Regexes.
 func (r Locale_Country) GetAllVatCountryCodesAndVatIdRegexes() (resp []datatypes.Container_Collection_Locale_VatCountryCodeAndFormat, err error) {
 	err = r.Session.DoRequest("SoftLayer_Locale_Country", "getAllVatCountryCodesAndVatIdRegexes", nil, &r.Options, &resp)
@@ -163,6 +205,14 @@ func (r Locale_Country) GetStates() (resp []datatypes.Locale_StateProvince, err
 	return
 }
 
+// GetStatesWith is GetStates, with the mask, filter and result limit of options applied to
+// this call only
+func (r Locale_Country) GetStatesWith(options sl.Options) (resp []datatypes.Locale_StateProvince, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStates()
+}
+
 // no documentation yet
 func (r Locale_Country) IsEuropeanUnionCountry(iso2CountryCode *string) (resp bool, err error) {
 	params := []interface{}{
@@ -188,6 +238,11 @@ func (r Locale_Timezone) Id(id int) Locale_Timezone {
 	return r
 }
 
+func (r Locale_Timezone) InitParameter(name string, value interface{}) Locale_Timezone {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Locale_Timezone) Mask(mask string) Locale_Timezone {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -207,11 +262,26 @@ func (r Locale_Timezone) Limit(limit int) Locale_Timezone {
 	return r
 }
 
+func (r Locale_Timezone) Unlimited() Locale_Timezone {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Locale_Timezone) Offset(offset int) Locale_Timezone {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Locale_Timezone) Timeout(timeout time.Duration) Locale_Timezone {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Locale_Timezone) WithContext(ctx context.Context) Locale_Timezone {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve all timezone objects.
 func (r Locale_Timezone) GetAllObjects() (resp []datatypes.Locale_Timezone, err error) {
 	err = r.Session.DoRequest("SoftLayer_Locale_Timezone", "getAllObjects", nil, &r.Options, &resp)
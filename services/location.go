@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Location) Id(id int) Location {
 	return r
 }
 
+func (r Location) InitParameter(name string, value interface{}) Location {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Location) Mask(mask string) Location {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Location) Limit(limit int) Location {
 	return r
 }
 
+func (r Location) Unlimited() Location {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Location) Offset(offset int) Location {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Location) Timeout(timeout time.Duration) Location {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Location) WithContext(ctx context.Context) Location {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Location) GetActivePresaleEvents() (resp []datatypes.Sales_Presale_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location", "getActivePresaleEvents", nil, &r.Options, &resp)
@@ -105,12 +127,28 @@ func (r Location) GetEuCompliantFlag() (resp bool, err error) {
 	return
 }
 
+// GetEuCompliantFlagWith is GetEuCompliantFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location) GetEuCompliantFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEuCompliantFlag()
+}
+
 // Retrieve A location can be a member of 1 or more groups. This will show which groups to which a location belongs.
 func (r Location) GetGroups() (resp []datatypes.Location_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location", "getGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetGroupsWith is GetGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location) GetGroupsWith(options sl.Options) (resp []datatypes.Location_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGroups()
+}
+
 // Retrieve
 func (r Location) GetHardwareFirewalls() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location", "getHardwareFirewalls", nil, &r.Options, &resp)
@@ -123,18 +161,42 @@ func (r Location) GetLocationAddress() (resp datatypes.Account_Address, err erro
 	return
 }
 
+// GetLocationAddressWith is GetLocationAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location) GetLocationAddressWith(options sl.Options) (resp datatypes.Account_Address, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocationAddress()
+}
+
 // Retrieve A location's Dedicated Rack member
 func (r Location) GetLocationReservationMember() (resp datatypes.Location_Reservation_Rack_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location", "getLocationReservationMember", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationReservationMemberWith is GetLocationReservationMember, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location) GetLocationReservationMemberWith(options sl.Options) (resp datatypes.Location_Reservation_Rack_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocationReservationMember()
+}
+
 // Retrieve The current locations status.
 func (r Location) GetLocationStatus() (resp datatypes.Location_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location", "getLocationStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationStatusWith is GetLocationStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location) GetLocationStatusWith(options sl.Options) (resp datatypes.Location_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocationStatus()
+}
+
 // Retrieve
 func (r Location) GetNetworkConfigurationAttribute() (resp datatypes.Hardware_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location", "getNetworkConfigurationAttribute", nil, &r.Options, &resp)
@@ -153,12 +215,28 @@ func (r Location) GetOnlinePptpVpnUserCount() (resp int, err error) {
 	return
 }
 
+// GetOnlinePptpVpnUserCountWith is GetOnlinePptpVpnUserCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location) GetOnlinePptpVpnUserCountWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOnlinePptpVpnUserCount()
+}
+
 // Retrieve The total number of users online using SoftLayer's SSL VPN service for a location.
 func (r Location) GetOnlineSslVpnUserCount() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location", "getOnlineSslVpnUserCount", nil, &r.Options, &resp)
 	return
 }
 
+// GetOnlineSslVpnUserCountWith is GetOnlineSslVpnUserCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location) GetOnlineSslVpnUserCountWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOnlineSslVpnUserCount()
+}
+
 // Retrieve
 func (r Location) GetPathString() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location", "getPathString", nil, &r.Options, &resp)
@@ -171,12 +249,28 @@ func (r Location) GetPriceGroups() (resp []datatypes.Location_Group, err error)
 	return
 }
 
+// GetPriceGroupsWith is GetPriceGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location) GetPriceGroupsWith(options sl.Options) (resp []datatypes.Location_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPriceGroups()
+}
+
 // Retrieve A location can be a member of 1 or more regions. This will show which regions to which a location belongs.
 func (r Location) GetRegions() (resp []datatypes.Location_Region, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location", "getRegions", nil, &r.Options, &resp)
 	return
 }
 
+// GetRegionsWith is GetRegions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location) GetRegionsWith(options sl.Options) (resp []datatypes.Location_Region, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRegions()
+}
+
 // Retrieve
 func (r Location) GetTimezone() (resp datatypes.Locale_Timezone, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location", "getTimezone", nil, &r.Options, &resp)
@@ -189,6 +283,14 @@ func (r Location) GetVdrGroup() (resp datatypes.Location_Group_Location_CrossRef
 	return
 }
 
+// GetVdrGroupWith is GetVdrGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location) GetVdrGroupWith(options sl.Options) (resp datatypes.Location_Group_Location_CrossReference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVdrGroup()
+}
+
 // Retrieve all datacenter locations. SoftLayer's datacenters exist in various cities and each contain one or more server rooms which house network and server infrastructure.
 func (r Location) GetViewableDatacenters() (resp []datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location", "getViewableDatacenters", nil, &r.Options, &resp)
@@ -229,6 +331,11 @@ func (r Location_Datacenter) Id(id int) Location_Datacenter {
 	return r
 }
 
+func (r Location_Datacenter) InitParameter(name string, value interface{}) Location_Datacenter {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Location_Datacenter) Mask(mask string) Location_Datacenter {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -248,11 +355,26 @@ func (r Location_Datacenter) Limit(limit int) Location_Datacenter {
 	return r
 }
 
+func (r Location_Datacenter) Unlimited() Location_Datacenter {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Location_Datacenter) Offset(offset int) Location_Datacenter {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Location_Datacenter) Timeout(timeout time.Duration) Location_Datacenter {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Location_Datacenter) WithContext(ctx context.Context) Location_Datacenter {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Location_Datacenter) GetActiveItemPresaleEvents() (resp []datatypes.Sales_Presale_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getActiveItemPresaleEvents", nil, &r.Options, &resp)
@@ -289,12 +411,28 @@ func (r Location_Datacenter) GetBoundSubnets() (resp []datatypes.Network_Subnet,
 	return
 }
 
+// GetBoundSubnetsWith is GetBoundSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Datacenter) GetBoundSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBoundSubnets()
+}
+
 // Retrieve This references relationship between brands, locations and countries associated with a user's account that are ineligible when ordering products. For example, the India datacenter may not be available on this brand for customers that live in Great Britain.
 func (r Location_Datacenter) GetBrandCountryRestrictions() (resp []datatypes.Brand_Restriction_Location_CustomerCountry, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getBrandCountryRestrictions", nil, &r.Options, &resp)
 	return
 }
 
+// GetBrandCountryRestrictionsWith is GetBrandCountryRestrictions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Datacenter) GetBrandCountryRestrictionsWith(options sl.Options) (resp []datatypes.Brand_Restriction_Location_CustomerCountry, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBrandCountryRestrictions()
+}
+
 // Retrieve all datacenter locations. SoftLayer's datacenters exist in various cities and each contain one or more server rooms which house network and server infrastructure.
 func (r Location_Datacenter) GetDatacenters() (resp []datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getDatacenters", nil, &r.Options, &resp)
@@ -313,6 +451,14 @@ func (r Location_Datacenter) GetEuCompliantFlag() (resp bool, err error) {
 	return
 }
 
+// GetEuCompliantFlagWith is GetEuCompliantFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Datacenter) GetEuCompliantFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEuCompliantFlag()
+}
+
 // Retrieve
 func (r Location_Datacenter) GetFrontendHardwareRouters() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getFrontendHardwareRouters", nil, &r.Options, &resp)
@@ -325,6 +471,14 @@ func (r Location_Datacenter) GetGroups() (resp []datatypes.Location_Group, err e
 	return
 }
 
+// GetGroupsWith is GetGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Datacenter) GetGroupsWith(options sl.Options) (resp []datatypes.Location_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGroups()
+}
+
 // Retrieve
 func (r Location_Datacenter) GetHardwareFirewalls() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getHardwareFirewalls", nil, &r.Options, &resp)
@@ -343,18 +497,42 @@ func (r Location_Datacenter) GetLocationAddress() (resp datatypes.Account_Addres
 	return
 }
 
+// GetLocationAddressWith is GetLocationAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Datacenter) GetLocationAddressWith(options sl.Options) (resp datatypes.Account_Address, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocationAddress()
+}
+
 // Retrieve A location's Dedicated Rack member
 func (r Location_Datacenter) GetLocationReservationMember() (resp datatypes.Location_Reservation_Rack_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getLocationReservationMember", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationReservationMemberWith is GetLocationReservationMember, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Datacenter) GetLocationReservationMemberWith(options sl.Options) (resp datatypes.Location_Reservation_Rack_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocationReservationMember()
+}
+
 // Retrieve The current locations status.
 func (r Location_Datacenter) GetLocationStatus() (resp datatypes.Location_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getLocationStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationStatusWith is GetLocationStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Datacenter) GetLocationStatusWith(options sl.Options) (resp datatypes.Location_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocationStatus()
+}
+
 // Retrieve
 func (r Location_Datacenter) GetNetworkConfigurationAttribute() (resp datatypes.Hardware_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getNetworkConfigurationAttribute", nil, &r.Options, &resp)
@@ -373,12 +551,28 @@ func (r Location_Datacenter) GetOnlinePptpVpnUserCount() (resp int, err error) {
 	return
 }
 
+// GetOnlinePptpVpnUserCountWith is GetOnlinePptpVpnUserCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Datacenter) GetOnlinePptpVpnUserCountWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOnlinePptpVpnUserCount()
+}
+
 // Retrieve The total number of users online using SoftLayer's SSL VPN service for a location.
 func (r Location_Datacenter) GetOnlineSslVpnUserCount() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getOnlineSslVpnUserCount", nil, &r.Options, &resp)
 	return
 }
 
+// GetOnlineSslVpnUserCountWith is GetOnlineSslVpnUserCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Datacenter) GetOnlineSslVpnUserCountWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOnlineSslVpnUserCount()
+}
+
 // Retrieve
 func (r Location_Datacenter) GetPathString() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getPathString", nil, &r.Options, &resp)
@@ -397,12 +591,28 @@ func (r Location_Datacenter) GetPriceGroups() (resp []datatypes.Location_Group,
 	return
 }
 
+// GetPriceGroupsWith is GetPriceGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Datacenter) GetPriceGroupsWith(options sl.Options) (resp []datatypes.Location_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPriceGroups()
+}
+
 // Retrieve The regional group this datacenter belongs to.
 func (r Location_Datacenter) GetRegionalGroup() (resp datatypes.Location_Group_Regional, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getRegionalGroup", nil, &r.Options, &resp)
 	return
 }
 
+// GetRegionalGroupWith is GetRegionalGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Datacenter) GetRegionalGroupWith(options sl.Options) (resp datatypes.Location_Group_Regional, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRegionalGroup()
+}
+
 // Retrieve
 func (r Location_Datacenter) GetRegionalInternetRegistry() (resp datatypes.Network_Regional_Internet_Registry, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getRegionalInternetRegistry", nil, &r.Options, &resp)
@@ -415,12 +625,28 @@ func (r Location_Datacenter) GetRegions() (resp []datatypes.Location_Region, err
 	return
 }
 
+// GetRegionsWith is GetRegions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Datacenter) GetRegionsWith(options sl.Options) (resp []datatypes.Location_Region, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRegions()
+}
+
 // Retrieve Retrieve all subnets that are eligible to be routed; those which the account has permission to associate with a vlan.
 func (r Location_Datacenter) GetRoutableBoundSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getRoutableBoundSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetRoutableBoundSubnetsWith is GetRoutableBoundSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Datacenter) GetRoutableBoundSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRoutableBoundSubnets()
+}
+
 // Retrieve a graph of a SoftLayer datacenter's last 48 hours of network activity. Statistics graphs show traffic outbound from a datacenter on top and inbound traffic on the bottom followed by a legend of the network services tracked in the graph. getStatisticsGraphImage returns a PNG image of variable width and height depending on the number of services reported in the image.
 func (r Location_Datacenter) GetStatisticsGraphImage() (resp []byte, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getStatisticsGraphImage", nil, &r.Options, &resp)
@@ -439,6 +665,14 @@ func (r Location_Datacenter) GetVdrGroup() (resp datatypes.Location_Group_Locati
 	return
 }
 
+// GetVdrGroupWith is GetVdrGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Datacenter) GetVdrGroupWith(options sl.Options) (resp datatypes.Location_Group_Location_CrossReference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVdrGroup()
+}
+
 // Retrieve all datacenter locations. SoftLayer's datacenters exist in various cities and each contain one or more server rooms which house network and server infrastructure.
 func (r Location_Datacenter) GetViewableDatacenters() (resp []datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Datacenter", "getViewableDatacenters", nil, &r.Options, &resp)
@@ -479,6 +713,11 @@ func (r Location_Group) Id(id int) Location_Group {
 	return r
 }
 
+func (r Location_Group) InitParameter(name string, value interface{}) Location_Group {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Location_Group) Mask(mask string) Location_Group {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -498,11 +737,26 @@ func (r Location_Group) Limit(limit int) Location_Group {
 	return r
 }
 
+func (r Location_Group) Unlimited() Location_Group {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Location_Group) Offset(offset int) Location_Group {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Location_Group) Timeout(timeout time.Duration) Location_Group {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Location_Group) WithContext(ctx context.Context) Location_Group {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Location_Group) GetAllObjects() (resp []datatypes.Location_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Group", "getAllObjects", nil, &r.Options, &resp)
@@ -515,12 +769,28 @@ func (r Location_Group) GetLocationGroupType() (resp datatypes.Location_Group_Ty
 	return
 }
 
+// GetLocationGroupTypeWith is GetLocationGroupType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Group) GetLocationGroupTypeWith(options sl.Options) (resp datatypes.Location_Group_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocationGroupType()
+}
+
 // Retrieve The locations in a group.
 func (r Location_Group) GetLocations() (resp []datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Group", "getLocations", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationsWith is GetLocations, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Group) GetLocationsWith(options sl.Options) (resp []datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocations()
+}
+
 // no documentation yet
 func (r Location_Group) GetObject() (resp datatypes.Location_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Group", "getObject", nil, &r.Options, &resp)
@@ -543,6 +813,11 @@ func (r Location_Group_Pricing) Id(id int) Location_Group_Pricing {
 	return r
 }
 
+func (r Location_Group_Pricing) InitParameter(name string, value interface{}) Location_Group_Pricing {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Location_Group_Pricing) Mask(mask string) Location_Group_Pricing {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -562,11 +837,26 @@ func (r Location_Group_Pricing) Limit(limit int) Location_Group_Pricing {
 	return r
 }
 
+func (r Location_Group_Pricing) Unlimited() Location_Group_Pricing {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Location_Group_Pricing) Offset(offset int) Location_Group_Pricing {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Location_Group_Pricing) Timeout(timeout time.Duration) Location_Group_Pricing {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Location_Group_Pricing) WithContext(ctx context.Context) Location_Group_Pricing {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Location_Group_Pricing) GetAllObjects() (resp []datatypes.Location_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Group_Pricing", "getAllObjects", nil, &r.Options, &resp)
@@ -579,12 +869,28 @@ func (r Location_Group_Pricing) GetLocationGroupType() (resp datatypes.Location_
 	return
 }
 
+// GetLocationGroupTypeWith is GetLocationGroupType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Group_Pricing) GetLocationGroupTypeWith(options sl.Options) (resp datatypes.Location_Group_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocationGroupType()
+}
+
 // Retrieve The locations in a group.
 func (r Location_Group_Pricing) GetLocations() (resp []datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Group_Pricing", "getLocations", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationsWith is GetLocations, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Group_Pricing) GetLocationsWith(options sl.Options) (resp []datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocations()
+}
+
 // no documentation yet
 func (r Location_Group_Pricing) GetObject() (resp datatypes.Location_Group_Pricing, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Group_Pricing", "getObject", nil, &r.Options, &resp)
@@ -597,6 +903,14 @@ func (r Location_Group_Pricing) GetPrices() (resp []datatypes.Product_Item_Price
 	return
 }
 
+// GetPricesWith is GetPrices, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Group_Pricing) GetPricesWith(options sl.Options) (resp []datatypes.Product_Item_Price, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrices()
+}
+
 // no documentation yet
 type Location_Group_Regional struct {
 	Session *session.Session
@@ -613,6 +927,11 @@ func (r Location_Group_Regional) Id(id int) Location_Group_Regional {
 	return r
 }
 
+func (r Location_Group_Regional) InitParameter(name string, value interface{}) Location_Group_Regional {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Location_Group_Regional) Mask(mask string) Location_Group_Regional {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -632,11 +951,26 @@ func (r Location_Group_Regional) Limit(limit int) Location_Group_Regional {
 	return r
 }
 
+func (r Location_Group_Regional) Unlimited() Location_Group_Regional {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Location_Group_Regional) Offset(offset int) Location_Group_Regional {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Location_Group_Regional) Timeout(timeout time.Duration) Location_Group_Regional {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Location_Group_Regional) WithContext(ctx context.Context) Location_Group_Regional {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Location_Group_Regional) GetAllObjects() (resp []datatypes.Location_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Group_Regional", "getAllObjects", nil, &r.Options, &resp)
@@ -649,18 +983,42 @@ func (r Location_Group_Regional) GetDatacenters() (resp []datatypes.Location, er
 	return
 }
 
+// GetDatacentersWith is GetDatacenters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Group_Regional) GetDatacentersWith(options sl.Options) (resp []datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenters()
+}
+
 // Retrieve The type for this location group.
 func (r Location_Group_Regional) GetLocationGroupType() (resp datatypes.Location_Group_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Group_Regional", "getLocationGroupType", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationGroupTypeWith is GetLocationGroupType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Group_Regional) GetLocationGroupTypeWith(options sl.Options) (resp datatypes.Location_Group_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocationGroupType()
+}
+
 // Retrieve The locations in a group.
 func (r Location_Group_Regional) GetLocations() (resp []datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Group_Regional", "getLocations", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationsWith is GetLocations, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Group_Regional) GetLocationsWith(options sl.Options) (resp []datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocations()
+}
+
 // no documentation yet
 func (r Location_Group_Regional) GetObject() (resp datatypes.Location_Group_Regional, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Group_Regional", "getObject", nil, &r.Options, &resp)
@@ -673,6 +1031,14 @@ func (r Location_Group_Regional) GetPreferredDatacenter() (resp datatypes.Locati
 	return
 }
 
+// GetPreferredDatacenterWith is GetPreferredDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Group_Regional) GetPreferredDatacenterWith(options sl.Options) (resp datatypes.Location_Datacenter, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPreferredDatacenter()
+}
+
 // no documentation yet
 type Location_Reservation struct {
 	Session *session.Session
@@ -689,6 +1055,11 @@ func (r Location_Reservation) Id(id int) Location_Reservation {
 	return r
 }
 
+func (r Location_Reservation) InitParameter(name string, value interface{}) Location_Reservation {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Location_Reservation) Mask(mask string) Location_Reservation {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -708,17 +1079,40 @@ func (r Location_Reservation) Limit(limit int) Location_Reservation {
 	return r
 }
 
+func (r Location_Reservation) Unlimited() Location_Reservation {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Location_Reservation) Offset(offset int) Location_Reservation {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Location_Reservation) Timeout(timeout time.Duration) Location_Reservation {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Location_Reservation) WithContext(ctx context.Context) Location_Reservation {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The account that a billing item belongs to.
 func (r Location_Reservation) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Reservation", "getAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Reservation) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // no documentation yet
 func (r Location_Reservation) GetAccountReservations() (resp []datatypes.Location_Reservation, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Reservation", "getAccountReservations", nil, &r.Options, &resp)
@@ -731,24 +1125,56 @@ func (r Location_Reservation) GetAllotment() (resp datatypes.Network_Bandwidth_V
 	return
 }
 
+// GetAllotmentWith is GetAllotment, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Reservation) GetAllotmentWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllotment()
+}
+
 // Retrieve The bandwidth allotment that the reservation belongs to.
 func (r Location_Reservation) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Reservation", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Reservation) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The datacenter location that the reservation belongs to.
 func (r Location_Reservation) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Reservation", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Reservation) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve Rack information for the reservation
 func (r Location_Reservation) GetLocationReservationRack() (resp datatypes.Location_Reservation_Rack, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Reservation", "getLocationReservationRack", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationReservationRackWith is GetLocationReservationRack, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Reservation) GetLocationReservationRackWith(options sl.Options) (resp datatypes.Location_Reservation_Rack, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocationReservationRack()
+}
+
 // no documentation yet
 func (r Location_Reservation) GetObject() (resp datatypes.Location_Reservation, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Reservation", "getObject", nil, &r.Options, &resp)
@@ -771,6 +1197,11 @@ func (r Location_Reservation_Rack) Id(id int) Location_Reservation_Rack {
 	return r
 }
 
+func (r Location_Reservation_Rack) InitParameter(name string, value interface{}) Location_Reservation_Rack {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Location_Reservation_Rack) Mask(mask string) Location_Reservation_Rack {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -790,23 +1221,54 @@ func (r Location_Reservation_Rack) Limit(limit int) Location_Reservation_Rack {
 	return r
 }
 
+func (r Location_Reservation_Rack) Unlimited() Location_Reservation_Rack {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Location_Reservation_Rack) Offset(offset int) Location_Reservation_Rack {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Location_Reservation_Rack) Timeout(timeout time.Duration) Location_Reservation_Rack {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Location_Reservation_Rack) WithContext(ctx context.Context) Location_Reservation_Rack {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The bandwidth allotment that the reservation belongs to.
 func (r Location_Reservation_Rack) GetAllotment() (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Reservation_Rack", "getAllotment", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllotmentWith is GetAllotment, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Reservation_Rack) GetAllotmentWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllotment()
+}
+
 // Retrieve Members of the rack.
 func (r Location_Reservation_Rack) GetChildren() (resp []datatypes.Location_Reservation_Rack_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Reservation_Rack", "getChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildrenWith is GetChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Reservation_Rack) GetChildrenWith(options sl.Options) (resp []datatypes.Location_Reservation_Rack_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildren()
+}
+
 // Retrieve
 func (r Location_Reservation_Rack) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Reservation_Rack", "getLocation", nil, &r.Options, &resp)
@@ -841,6 +1303,11 @@ func (r Location_Reservation_Rack_Member) Id(id int) Location_Reservation_Rack_M
 	return r
 }
 
+func (r Location_Reservation_Rack_Member) InitParameter(name string, value interface{}) Location_Reservation_Rack_Member {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Location_Reservation_Rack_Member) Mask(mask string) Location_Reservation_Rack_Member {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -860,17 +1327,40 @@ func (r Location_Reservation_Rack_Member) Limit(limit int) Location_Reservation_
 	return r
 }
 
+func (r Location_Reservation_Rack_Member) Unlimited() Location_Reservation_Rack_Member {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Location_Reservation_Rack_Member) Offset(offset int) Location_Reservation_Rack_Member {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Location_Reservation_Rack_Member) Timeout(timeout time.Duration) Location_Reservation_Rack_Member {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Location_Reservation_Rack_Member) WithContext(ctx context.Context) Location_Reservation_Rack_Member {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve Location relation for the rack member
 func (r Location_Reservation_Rack_Member) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Reservation_Rack_Member", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Location_Reservation_Rack_Member) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve
 func (r Location_Reservation_Rack_Member) GetLocationReservationRack() (resp datatypes.Location_Reservation_Rack, err error) {
 	err = r.Session.DoRequest("SoftLayer_Location_Reservation_Rack_Member", "getLocationReservationRack", nil, &r.Options, &resp)
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Marketplace_Partner) Id(id int) Marketplace_Partner {
 	return r
 }
 
+func (r Marketplace_Partner) InitParameter(name string, value interface{}) Marketplace_Partner {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Marketplace_Partner) Mask(mask string) Marketplace_Partner {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Marketplace_Partner) Limit(limit int) Marketplace_Partner {
 	return r
 }
 
+func (r Marketplace_Partner) Unlimited() Marketplace_Partner {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Marketplace_Partner) Offset(offset int) Marketplace_Partner {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Marketplace_Partner) Timeout(timeout time.Duration) Marketplace_Partner {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Marketplace_Partner) WithContext(ctx context.Context) Marketplace_Partner {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Marketplace_Partner) GetAllObjects() (resp []datatypes.Marketplace_Partner, err error) {
 	err = r.Session.DoRequest("SoftLayer_Marketplace_Partner", "getAllObjects", nil, &r.Options, &resp)
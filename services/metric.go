@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Metric_Tracking_Object) Id(id int) Metric_Tracking_Object {
 	return r
 }
 
+func (r Metric_Tracking_Object) InitParameter(name string, value interface{}) Metric_Tracking_Object {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Metric_Tracking_Object) Mask(mask string) Metric_Tracking_Object {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Metric_Tracking_Object) Limit(limit int) Metric_Tracking_Object {
 	return r
 }
 
+func (r Metric_Tracking_Object) Unlimited() Metric_Tracking_Object {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Metric_Tracking_Object) Offset(offset int) Metric_Tracking_Object {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Metric_Tracking_Object) Timeout(timeout time.Duration) Metric_Tracking_Object {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Metric_Tracking_Object) WithContext(ctx context.Context) Metric_Tracking_Object {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve a PNG image of the last 24 hours of bandwidth usage of one of SoftLayer's network backbones.
 func (r Metric_Tracking_Object) GetBackboneBandwidthGraph(graphTitle *string) (resp datatypes.Container_Bandwidth_GraphOutputs, err error) {
 	params := []interface{}{
@@ -187,6 +209,14 @@ func (r Metric_Tracking_Object) GetType() (resp datatypes.Metric_Tracking_Object
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Metric_Tracking_Object) GetTypeWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // This data type provides commonly used bandwidth summary components for the current billing cycle.
 type Metric_Tracking_Object_Bandwidth_Summary struct {
 	Session *session.Session
@@ -203,6 +233,11 @@ func (r Metric_Tracking_Object_Bandwidth_Summary) Id(id int) Metric_Tracking_Obj
 	return r
 }
 
+func (r Metric_Tracking_Object_Bandwidth_Summary) InitParameter(name string, value interface{}) Metric_Tracking_Object_Bandwidth_Summary {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Metric_Tracking_Object_Bandwidth_Summary) Mask(mask string) Metric_Tracking_Object_Bandwidth_Summary {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -222,11 +257,26 @@ func (r Metric_Tracking_Object_Bandwidth_Summary) Limit(limit int) Metric_Tracki
 	return r
 }
 
+func (r Metric_Tracking_Object_Bandwidth_Summary) Unlimited() Metric_Tracking_Object_Bandwidth_Summary {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Metric_Tracking_Object_Bandwidth_Summary) Offset(offset int) Metric_Tracking_Object_Bandwidth_Summary {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Metric_Tracking_Object_Bandwidth_Summary) Timeout(timeout time.Duration) Metric_Tracking_Object_Bandwidth_Summary {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Metric_Tracking_Object_Bandwidth_Summary) WithContext(ctx context.Context) Metric_Tracking_Object_Bandwidth_Summary {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Metric_Tracking_Object_Bandwidth_Summary) GetObject() (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error) {
 	err = r.Session.DoRequest("SoftLayer_Metric_Tracking_Object_Bandwidth_Summary", "getObject", nil, &r.Options, &resp)
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Monitoring_Agent) Id(id int) Monitoring_Agent {
 	return r
 }
 
+func (r Monitoring_Agent) InitParameter(name string, value interface{}) Monitoring_Agent {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Monitoring_Agent) Mask(mask string) Monitoring_Agent {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Monitoring_Agent) Limit(limit int) Monitoring_Agent {
 	return r
 }
 
+func (r Monitoring_Agent) Unlimited() Monitoring_Agent {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Monitoring_Agent) Offset(offset int) Monitoring_Agent {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Monitoring_Agent) Timeout(timeout time.Duration) Monitoring_Agent {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Monitoring_Agent) WithContext(ctx context.Context) Monitoring_Agent {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method activates a SoftLayer_Monitoring_Agent.
 func (r Monitoring_Agent) Activate() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Agent", "activate", nil, &r.Options, &resp)
@@ -130,6 +152,14 @@ func (r Monitoring_Agent) GetAgentStatus() (resp datatypes.Monitoring_Agent_Stat
 	return
 }
 
+// GetAgentStatusWith is GetAgentStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Monitoring_Agent) GetAgentStatusWith(options sl.Options) (resp datatypes.Monitoring_Agent_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAgentStatus()
+}
+
 // This method returns an array of available SoftLayer_Configuration_Template objects for this monitoring agent.
 func (r Monitoring_Agent) GetAvailableConfigurationTemplates() (resp []datatypes.Configuration_Template, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Agent", "getAvailableConfigurationTemplates", nil, &r.Options, &resp)
@@ -152,18 +182,42 @@ func (r Monitoring_Agent) GetConfigurationProfiles() (resp []datatypes.Configura
 	return
 }
 
+// GetConfigurationProfilesWith is GetConfigurationProfiles, with the mask, filter and result limit of options applied to
+// this call only
+func (r Monitoring_Agent) GetConfigurationProfilesWith(options sl.Options) (resp []datatypes.Configuration_Template_Section_Profile, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetConfigurationProfiles()
+}
+
 // Retrieve A template of an agent's current configuration which contains information about the structure of the configuration values.
 func (r Monitoring_Agent) GetConfigurationTemplate() (resp datatypes.Configuration_Template, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Agent", "getConfigurationTemplate", nil, &r.Options, &resp)
 	return
 }
 
+// GetConfigurationTemplateWith is GetConfigurationTemplate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Monitoring_Agent) GetConfigurationTemplateWith(options sl.Options) (resp datatypes.Configuration_Template, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetConfigurationTemplate()
+}
+
 // Retrieve The values associated with the corresponding Agent configuration.
 func (r Monitoring_Agent) GetConfigurationValues() (resp []datatypes.Monitoring_Agent_Configuration_Value, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Agent", "getConfigurationValues", nil, &r.Options, &resp)
 	return
 }
 
+// GetConfigurationValuesWith is GetConfigurationValues, with the mask, filter and result limit of options applied to
+// this call only
+func (r Monitoring_Agent) GetConfigurationValuesWith(options sl.Options) (resp []datatypes.Monitoring_Agent_Configuration_Value, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetConfigurationValues()
+}
+
 // This method returns an array of SoftLayer_User_Customer objects, representing those who are allowed to be used as alarm subscribers.
 func (r Monitoring_Agent) GetEligibleAlarmSubscibers() (resp []datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Agent", "getEligibleAlarmSubscibers", nil, &r.Options, &resp)
@@ -198,6 +252,14 @@ func (r Monitoring_Agent) GetHardware() (resp datatypes.Hardware, err error) {
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Monitoring_Agent) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // This method retrieves a monitoring agent whose identifier corresponds to the value provided in the initialization parameter passed to the SoftLayer_Monitoring_Agent service.
 func (r Monitoring_Agent) GetObject() (resp datatypes.Monitoring_Agent, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Agent", "getObject", nil, &r.Options, &resp)
@@ -210,24 +272,56 @@ func (r Monitoring_Agent) GetProductItem() (resp datatypes.Product_Item, err err
 	return
 }
 
+// GetProductItemWith is GetProductItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Monitoring_Agent) GetProductItemWith(options sl.Options) (resp datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProductItem()
+}
+
 // Retrieve A description for a specific installation of a Software Component
 func (r Monitoring_Agent) GetSoftwareDescription() (resp datatypes.Software_Description, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Agent", "getSoftwareDescription", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareDescriptionWith is GetSoftwareDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Monitoring_Agent) GetSoftwareDescriptionWith(options sl.Options) (resp datatypes.Software_Description, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareDescription()
+}
+
 // Retrieve Monitoring agent status name.
 func (r Monitoring_Agent) GetStatusName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Agent", "getStatusName", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatusNameWith is GetStatusName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Monitoring_Agent) GetStatusNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatusName()
+}
+
 // Retrieve Softlayer_Virtual_Guest object related to the monitoring agent, which this virtual guest object and hardware is on the server of the running agent.
 func (r Monitoring_Agent) GetVirtualGuest() (resp datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Agent", "getVirtualGuest", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestWith is GetVirtualGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Monitoring_Agent) GetVirtualGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuest()
+}
+
 // Use of this method will allow removing active subscribers from the monitoring agent. The agent subscribers can be managed within the portal from the "Alarm Subscribers" tab of the monitoring agent configuration.
 func (r Monitoring_Agent) RemoveActiveAlarmSubscriber(userRecordId *int) (resp bool, err error) {
 	params := []interface{}{
@@ -274,6 +368,11 @@ func (r Monitoring_Agent_Configuration_Template_Group) Id(id int) Monitoring_Age
 	return r
 }
 
+func (r Monitoring_Agent_Configuration_Template_Group) InitParameter(name string, value interface{}) Monitoring_Agent_Configuration_Template_Group {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Monitoring_Agent_Configuration_Template_Group) Mask(mask string) Monitoring_Agent_Configuration_Template_Group {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -293,11 +392,26 @@ func (r Monitoring_Agent_Configuration_Template_Group) Limit(limit int) Monitori
 	return r
 }
 
+func (r Monitoring_Agent_Configuration_Template_Group) Unlimited() Monitoring_Agent_Configuration_Template_Group {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Monitoring_Agent_Configuration_Template_Group) Offset(offset int) Monitoring_Agent_Configuration_Template_Group {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Monitoring_Agent_Configuration_Template_Group) Timeout(timeout time.Duration) Monitoring_Agent_Configuration_Template_Group {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Monitoring_Agent_Configuration_Template_Group) WithContext(ctx context.Context) Monitoring_Agent_Configuration_Template_Group {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method creates a SoftLayer_Monitoring_Agent_Configuration_Template_Group using the values provided in the template object. The template objects accountId will be overridden to use the active user's accountId as it shows on their associated SoftLayer_User_Customer object.
 func (r Monitoring_Agent_Configuration_Template_Group) CreateObject(templateObject *datatypes.Monitoring_Agent_Configuration_Template_Group) (resp datatypes.Monitoring_Agent_Configuration_Template_Group, err error) {
 	params := []interface{}{
@@ -383,6 +497,11 @@ func (r Monitoring_Agent_Configuration_Template_Group_Reference) Id(id int) Moni
 	return r
 }
 
+func (r Monitoring_Agent_Configuration_Template_Group_Reference) InitParameter(name string, value interface{}) Monitoring_Agent_Configuration_Template_Group_Reference {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Monitoring_Agent_Configuration_Template_Group_Reference) Mask(mask string) Monitoring_Agent_Configuration_Template_Group_Reference {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -402,11 +521,26 @@ func (r Monitoring_Agent_Configuration_Template_Group_Reference) Limit(limit int
 	return r
 }
 
+func (r Monitoring_Agent_Configuration_Template_Group_Reference) Unlimited() Monitoring_Agent_Configuration_Template_Group_Reference {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Monitoring_Agent_Configuration_Template_Group_Reference) Offset(offset int) Monitoring_Agent_Configuration_Template_Group_Reference {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Monitoring_Agent_Configuration_Template_Group_Reference) Timeout(timeout time.Duration) Monitoring_Agent_Configuration_Template_Group_Reference {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Monitoring_Agent_Configuration_Template_Group_Reference) WithContext(ctx context.Context) Monitoring_Agent_Configuration_Template_Group_Reference {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method creates a monitoring agent configuration template group reference by passing in an object with the SoftLayer_Monitoring_Agent_Configuration_Template_Group_Reference structure as the $templateObject parameter.
 func (r Monitoring_Agent_Configuration_Template_Group_Reference) CreateObject(templateObject *datatypes.Monitoring_Agent_Configuration_Template_Group_Reference) (resp datatypes.Monitoring_Agent_Configuration_Template_Group_Reference, err error) {
 	params := []interface{}{
@@ -483,6 +617,11 @@ func (r Monitoring_Agent_Configuration_Value) Id(id int) Monitoring_Agent_Config
 	return r
 }
 
+func (r Monitoring_Agent_Configuration_Value) InitParameter(name string, value interface{}) Monitoring_Agent_Configuration_Value {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Monitoring_Agent_Configuration_Value) Mask(mask string) Monitoring_Agent_Configuration_Value {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -502,11 +641,26 @@ func (r Monitoring_Agent_Configuration_Value) Limit(limit int) Monitoring_Agent_
 	return r
 }
 
+func (r Monitoring_Agent_Configuration_Value) Unlimited() Monitoring_Agent_Configuration_Value {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Monitoring_Agent_Configuration_Value) Offset(offset int) Monitoring_Agent_Configuration_Value {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Monitoring_Agent_Configuration_Value) Timeout(timeout time.Duration) Monitoring_Agent_Configuration_Value {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Monitoring_Agent_Configuration_Value) WithContext(ctx context.Context) Monitoring_Agent_Configuration_Value {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Monitoring_Agent_Configuration_Value) GetDefinition() (resp datatypes.Configuration_Template_Section_Definition, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Agent_Configuration_Value", "getDefinition", nil, &r.Options, &resp)
@@ -519,6 +673,14 @@ func (r Monitoring_Agent_Configuration_Value) GetMetricDataType() (resp datatype
 	return
 }
 
+// GetMetricDataTypeWith is GetMetricDataType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Monitoring_Agent_Configuration_Value) GetMetricDataTypeWith(options sl.Options) (resp datatypes.Container_Metric_Data_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricDataType()
+}
+
 // Retrieve
 func (r Monitoring_Agent_Configuration_Value) GetMonitoringAgent() (resp datatypes.Monitoring_Agent, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Agent_Configuration_Value", "getMonitoringAgent", nil, &r.Options, &resp)
@@ -553,6 +715,11 @@ func (r Monitoring_Agent_Status) Id(id int) Monitoring_Agent_Status {
 	return r
 }
 
+func (r Monitoring_Agent_Status) InitParameter(name string, value interface{}) Monitoring_Agent_Status {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Monitoring_Agent_Status) Mask(mask string) Monitoring_Agent_Status {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -572,11 +739,26 @@ func (r Monitoring_Agent_Status) Limit(limit int) Monitoring_Agent_Status {
 	return r
 }
 
+func (r Monitoring_Agent_Status) Unlimited() Monitoring_Agent_Status {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Monitoring_Agent_Status) Offset(offset int) Monitoring_Agent_Status {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Monitoring_Agent_Status) Timeout(timeout time.Duration) Monitoring_Agent_Status {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Monitoring_Agent_Status) WithContext(ctx context.Context) Monitoring_Agent_Status {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Monitoring_Agent_Status) GetObject() (resp datatypes.Monitoring_Agent_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Agent_Status", "getObject", nil, &r.Options, &resp)
@@ -599,6 +781,11 @@ func (r Monitoring_Robot) Id(id int) Monitoring_Robot {
 	return r
 }
 
+func (r Monitoring_Robot) InitParameter(name string, value interface{}) Monitoring_Robot {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Monitoring_Robot) Mask(mask string) Monitoring_Robot {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -618,11 +805,26 @@ func (r Monitoring_Robot) Limit(limit int) Monitoring_Robot {
 	return r
 }
 
+func (r Monitoring_Robot) Unlimited() Monitoring_Robot {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Monitoring_Robot) Offset(offset int) Monitoring_Robot {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Monitoring_Robot) Timeout(timeout time.Duration) Monitoring_Robot {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Monitoring_Robot) WithContext(ctx context.Context) Monitoring_Robot {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Checks if a monitoring robot can communicate with SoftLayer monitoring management system via the private network.
 //
 // TCP port 48000 - 48002 must be open on your server or your virtual server in order for this test to succeed.
@@ -646,6 +848,14 @@ func (r Monitoring_Robot) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Monitoring_Robot) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Returns available configuration template groups for this monitoring agent.
 func (r Monitoring_Robot) GetAvailableConfigurationGroups() (resp []datatypes.Monitoring_Agent_Configuration_Template_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Robot", "getAvailableConfigurationGroups", nil, &r.Options, &resp)
@@ -658,6 +868,14 @@ func (r Monitoring_Robot) GetMonitoringAgents() (resp []datatypes.Monitoring_Age
 	return
 }
 
+// GetMonitoringAgentsWith is GetMonitoringAgents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Monitoring_Robot) GetMonitoringAgentsWith(options sl.Options) (resp []datatypes.Monitoring_Agent, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringAgents()
+}
+
 // no documentation yet
 func (r Monitoring_Robot) GetObject() (resp datatypes.Monitoring_Robot, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Robot", "getObject", nil, &r.Options, &resp)
@@ -670,12 +888,28 @@ func (r Monitoring_Robot) GetRobotStatus() (resp datatypes.Monitoring_Robot_Stat
 	return
 }
 
+// GetRobotStatusWith is GetRobotStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Monitoring_Robot) GetRobotStatusWith(options sl.Options) (resp datatypes.Monitoring_Robot_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRobotStatus()
+}
+
 // Retrieve The SoftLayer_Software_Component that corresponds to the robot installation on the server.
 func (r Monitoring_Robot) GetSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Monitoring_Robot", "getSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareComponentWith is GetSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Monitoring_Robot) GetSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareComponent()
+}
+
 // If our monitoring management system is not able to connect to your monitoring robot, it sets the robot status to "Limited Connectivity". Robots in this status will not be process by our monitoring management system. You cannot manage monitoring agents either.
 //
 // Use this method to resets monitoring robot status to "Active" to indicate the connection issue is resolved.
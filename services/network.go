@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Network) Id(id int) Network {
 	return r
 }
 
+func (r Network) InitParameter(name string, value interface{}) Network {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network) Mask(mask string) Network {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Network) Limit(limit int) Network {
 	return r
 }
 
+func (r Network) Unlimited() Network {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network) Offset(offset int) Network {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network) Timeout(timeout time.Duration) Network {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network) WithContext(ctx context.Context) Network {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Connect user account network to Private Endpoint Service account. Network update occurs asynchronously after a successful connect request.
 func (r Network) ConnectPrivateEndpointService() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network", "connectPrivateEndpointService", nil, &r.Options, &resp)
@@ -182,24 +204,56 @@ func (r Network) GetCidr() (resp int, err error) {
 	return
 }
 
+// GetCidrWith is GetCidr, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network) GetCidrWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCidr()
+}
+
 // Retrieve A name for the Network. This is required during creation of a Network and is entirely user defined.
 func (r Network) GetName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network", "getName", nil, &r.Options, &resp)
 	return
 }
 
+// GetNameWith is GetName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network) GetNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetName()
+}
+
 // Retrieve The starting IP address of the Network. Specified in conjunction with the ``cidr`` property to specify the bounding IP address space for the Network. Required for creation. See [[SoftLayer_Network/createObject]] documentation for creation details.
 func (r Network) GetNetworkIdentifier() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network", "getNetworkIdentifier", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkIdentifierWith is GetNetworkIdentifier, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network) GetNetworkIdentifierWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkIdentifier()
+}
+
 // Retrieve Notes, or a description of the Network. This is entirely user defined.
 func (r Network) GetNotes() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network", "getNotes", nil, &r.Options, &resp)
 	return
 }
 
+// GetNotesWith is GetNotes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network) GetNotesWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNotes()
+}
+
 // no documentation yet
 func (r Network) GetObject() (resp datatypes.Network, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network", "getObject", nil, &r.Options, &resp)
@@ -212,6 +266,14 @@ func (r Network) GetSubnets() (resp []datatypes.Network_Subnet, err error) {
 	return
 }
 
+// GetSubnetsWith is GetSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network) GetSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubnets()
+}
+
 // Checks connectivity between user account and Private Endpoint Service. Returns True if user account and Private Endpoint Service are connected. Returns False if user account and Private Endpoint Service are ready for connections but not connected.
 func (r Network) IsConnectedToPrivateEndpointService() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network", "isConnectedToPrivateEndpointService", nil, &r.Options, &resp)
@@ -234,6 +296,11 @@ func (r Network_Application_Delivery_Controller) Id(id int) Network_Application_
 	return r
 }
 
+func (r Network_Application_Delivery_Controller) InitParameter(name string, value interface{}) Network_Application_Delivery_Controller {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Application_Delivery_Controller) Mask(mask string) Network_Application_Delivery_Controller {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -253,11 +320,26 @@ func (r Network_Application_Delivery_Controller) Limit(limit int) Network_Applic
 	return r
 }
 
+func (r Network_Application_Delivery_Controller) Unlimited() Network_Application_Delivery_Controller {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Application_Delivery_Controller) Offset(offset int) Network_Application_Delivery_Controller {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Application_Delivery_Controller) Timeout(timeout time.Duration) Network_Application_Delivery_Controller {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Application_Delivery_Controller) WithContext(ctx context.Context) Network_Application_Delivery_Controller {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create or add to an application delivery controller based load balancer service. The loadBalancer parameter must have its ''name'', ''type'', ''sourcePort'', and ''virtualIpAddress'' properties populated. Changes are reflected immediately in the application delivery controller.
 func (r Network_Application_Delivery_Controller) CreateLiveLoadBalancer(loadBalancer *datatypes.Network_LoadBalancer_VirtualIpAddress) (resp bool, err error) {
 	params := []interface{}{
@@ -301,12 +383,28 @@ func (r Network_Application_Delivery_Controller) GetAccount() (resp datatypes.Ac
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The average daily public bandwidth usage for the current billing cycle.
 func (r Network_Application_Delivery_Controller) GetAverageDailyPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller", "getAverageDailyPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAverageDailyPublicBandwidthUsageWith is GetAverageDailyPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetAverageDailyPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPublicBandwidthUsage()
+}
+
 // no documentation yet
 func (r Network_Application_Delivery_Controller) GetBandwidthDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time, networkType *string) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -335,12 +433,28 @@ func (r Network_Application_Delivery_Controller) GetBillingItem() (resp datatype
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Network_Application_Delivery_Controller, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve Previous configurations for an Application Delivery Controller.
 func (r Network_Application_Delivery_Controller) GetConfigurationHistory() (resp []datatypes.Network_Application_Delivery_Controller_Configuration_History, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller", "getConfigurationHistory", nil, &r.Options, &resp)
 	return
 }
 
+// GetConfigurationHistoryWith is GetConfigurationHistory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetConfigurationHistoryWith(options sl.Options) (resp []datatypes.Network_Application_Delivery_Controller_Configuration_History, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetConfigurationHistory()
+}
+
 // Retrieve bandwidth graph by date.
 func (r Network_Application_Delivery_Controller) GetCustomBandwidthDataByDate(graphData *datatypes.Container_Graph) (resp datatypes.Container_Graph, err error) {
 	params := []interface{}{
@@ -356,18 +470,42 @@ func (r Network_Application_Delivery_Controller) GetDatacenter() (resp datatypes
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // Retrieve A brief description of an application delivery controller record.
 func (r Network_Application_Delivery_Controller) GetDescription() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller", "getDescription", nil, &r.Options, &resp)
 	return
 }
 
+// GetDescriptionWith is GetDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetDescriptionWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDescription()
+}
+
 // Retrieve The date in which the license for this application delivery controller will expire.
 func (r Network_Application_Delivery_Controller) GetLicenseExpirationDate() (resp datatypes.Time, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller", "getLicenseExpirationDate", nil, &r.Options, &resp)
 	return
 }
 
+// GetLicenseExpirationDateWith is GetLicenseExpirationDate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetLicenseExpirationDateWith(options sl.Options) (resp datatypes.Time, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLicenseExpirationDate()
+}
+
 // Get the graph image for an application delivery controller service based on the supplied graph type and metric.  The available graph types are: 'connections' and 'status', and the available metrics are: 'day', 'week' and 'month'.
 //
 // This method returns the raw binary image data.
@@ -387,30 +525,70 @@ func (r Network_Application_Delivery_Controller) GetLoadBalancers() (resp []data
 	return
 }
 
+// GetLoadBalancersWith is GetLoadBalancers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetLoadBalancersWith(options sl.Options) (resp []datatypes.Network_LoadBalancer_VirtualIpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLoadBalancers()
+}
+
 // Retrieve A flag indicating that this Application Delivery Controller is a managed resource.
 func (r Network_Application_Delivery_Controller) GetManagedResourceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller", "getManagedResourceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetManagedResourceFlagWith is GetManagedResourceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetManagedResourceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedResourceFlag()
+}
+
 // Retrieve An application delivery controller's management ip address.
 func (r Network_Application_Delivery_Controller) GetManagementIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller", "getManagementIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetManagementIpAddressWith is GetManagementIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetManagementIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagementIpAddress()
+}
+
 // Retrieve The network VLAN that an application delivery controller resides on.
 func (r Network_Application_Delivery_Controller) GetNetworkVlan() (resp datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller", "getNetworkVlan", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlanWith is GetNetworkVlan, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetNetworkVlanWith(options sl.Options) (resp datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlan()
+}
+
 // Retrieve The network VLANs that an application delivery controller resides on.
 func (r Network_Application_Delivery_Controller) GetNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller", "getNetworkVlans", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlansWith is GetNetworkVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlans()
+}
+
 // getObject retrieves the SoftLayer_Network_Application_Delivery_Controller object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Network_Application_Delivery_Controller service. You can only retrieve application delivery controllers that are associated with your SoftLayer customer account.
 func (r Network_Application_Delivery_Controller) GetObject() (resp datatypes.Network_Application_Delivery_Controller, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller", "getObject", nil, &r.Options, &resp)
@@ -423,30 +601,70 @@ func (r Network_Application_Delivery_Controller) GetOutboundPublicBandwidthUsage
 	return
 }
 
+// GetOutboundPublicBandwidthUsageWith is GetOutboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetOutboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPublicBandwidthUsage()
+}
+
 // Retrieve The password used to connect to an application delivery controller's management interface when it is operating in advanced view mode.
 func (r Network_Application_Delivery_Controller) GetPassword() (resp datatypes.Software_Component_Password, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller", "getPassword", nil, &r.Options, &resp)
 	return
 }
 
+// GetPasswordWith is GetPassword, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetPasswordWith(options sl.Options) (resp datatypes.Software_Component_Password, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPassword()
+}
+
 // Retrieve An application delivery controller's primary public IP address.
 func (r Network_Application_Delivery_Controller) GetPrimaryIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller", "getPrimaryIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryIpAddressWith is GetPrimaryIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetPrimaryIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryIpAddress()
+}
+
 // Retrieve The projected public outbound bandwidth for the current billing cycle.
 func (r Network_Application_Delivery_Controller) GetProjectedPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller", "getProjectedPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetProjectedPublicBandwidthUsageWith is GetProjectedPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetProjectedPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedPublicBandwidthUsage()
+}
+
 // Retrieve A network application controller's subnets. A subnet is a group of IP addresses
 func (r Network_Application_Delivery_Controller) GetSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller", "getSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetSubnetsWith is GetSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller) GetSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubnets()
+}
+
 // Retrieve
 func (r Network_Application_Delivery_Controller) GetTagReferences() (resp []datatypes.Tag_Reference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller", "getTagReferences", nil, &r.Options, &resp)
@@ -524,6 +742,11 @@ func (r Network_Application_Delivery_Controller_Configuration_History) Id(id int
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_Configuration_History) InitParameter(name string, value interface{}) Network_Application_Delivery_Controller_Configuration_History {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_Configuration_History) Mask(mask string) Network_Application_Delivery_Controller_Configuration_History {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -543,11 +766,26 @@ func (r Network_Application_Delivery_Controller_Configuration_History) Limit(lim
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_Configuration_History) Unlimited() Network_Application_Delivery_Controller_Configuration_History {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_Configuration_History) Offset(offset int) Network_Application_Delivery_Controller_Configuration_History {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_Configuration_History) Timeout(timeout time.Duration) Network_Application_Delivery_Controller_Configuration_History {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Application_Delivery_Controller_Configuration_History) WithContext(ctx context.Context) Network_Application_Delivery_Controller_Configuration_History {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // deleteObject permanently removes a configuration history record
 func (r Network_Application_Delivery_Controller_Configuration_History) DeleteObject() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_Configuration_History", "deleteObject", nil, &r.Options, &resp)
@@ -560,6 +798,14 @@ func (r Network_Application_Delivery_Controller_Configuration_History) GetContro
 	return
 }
 
+// GetControllerWith is GetController, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller_Configuration_History) GetControllerWith(options sl.Options) (resp datatypes.Network_Application_Delivery_Controller, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetController()
+}
+
 // no documentation yet
 func (r Network_Application_Delivery_Controller_Configuration_History) GetObject() (resp datatypes.Network_Application_Delivery_Controller_Configuration_History, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_Configuration_History", "getObject", nil, &r.Options, &resp)
@@ -582,6 +828,11 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute) I
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute) InitParameter(name string, value interface{}) Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute) Mask(mask string) Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -601,11 +852,26 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute) L
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute) Unlimited() Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute) Offset(offset int) Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute) Timeout(timeout time.Duration) Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute) WithContext(ctx context.Context) Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute) GetHealthCheck() (resp datatypes.Network_Application_Delivery_Controller_LoadBalancer_Health_Check, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute", "getHealthCheck", nil, &r.Options, &resp)
@@ -640,6 +906,11 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Ty
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type) InitParameter(name string, value interface{}) Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type) Mask(mask string) Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -659,11 +930,26 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Ty
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type) Unlimited() Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type) Offset(offset int) Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type) Timeout(timeout time.Duration) Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type) WithContext(ctx context.Context) Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type) GetAllObjects() (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -692,6 +978,11 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check) Id(id
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check) InitParameter(name string, value interface{}) Network_Application_Delivery_Controller_LoadBalancer_Health_Check {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check) Mask(mask string) Network_Application_Delivery_Controller_LoadBalancer_Health_Check {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -711,11 +1002,26 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check) Limit
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check) Unlimited() Network_Application_Delivery_Controller_LoadBalancer_Health_Check {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check) Offset(offset int) Network_Application_Delivery_Controller_LoadBalancer_Health_Check {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check) Timeout(timeout time.Duration) Network_Application_Delivery_Controller_LoadBalancer_Health_Check {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check) WithContext(ctx context.Context) Network_Application_Delivery_Controller_LoadBalancer_Health_Check {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check) GetAttributes() (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_Health_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Health_Check", "getAttributes", nil, &r.Options, &resp)
@@ -734,6 +1040,14 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check) GetSc
 	return
 }
 
+// GetScaleLoadBalancersWith is GetScaleLoadBalancers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check) GetScaleLoadBalancersWith(options sl.Options) (resp []datatypes.Scale_LoadBalancer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleLoadBalancers()
+}
+
 // Retrieve
 func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check) GetServices() (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_Service, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Health_Check", "getServices", nil, &r.Options, &resp)
@@ -762,6 +1076,11 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type)
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type) InitParameter(name string, value interface{}) Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type) Mask(mask string) Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -781,11 +1100,26 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type)
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type) Unlimited() Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type) Offset(offset int) Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type) Timeout(timeout time.Duration) Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type) WithContext(ctx context.Context) Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type) GetAllObjects() (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -814,6 +1148,11 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Method) Id(
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Method) InitParameter(name string, value interface{}) Network_Application_Delivery_Controller_LoadBalancer_Routing_Method {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Method) Mask(mask string) Network_Application_Delivery_Controller_LoadBalancer_Routing_Method {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -833,11 +1172,26 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Method) Lim
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Method) Unlimited() Network_Application_Delivery_Controller_LoadBalancer_Routing_Method {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Method) Offset(offset int) Network_Application_Delivery_Controller_LoadBalancer_Routing_Method {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Method) Timeout(timeout time.Duration) Network_Application_Delivery_Controller_LoadBalancer_Routing_Method {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Method) WithContext(ctx context.Context) Network_Application_Delivery_Controller_LoadBalancer_Routing_Method {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Method) GetAllObjects() (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_Routing_Method, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Routing_Method", "getAllObjects", nil, &r.Options, &resp)
@@ -866,6 +1220,11 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Type) Id(id
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Type) InitParameter(name string, value interface{}) Network_Application_Delivery_Controller_LoadBalancer_Routing_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Type) Mask(mask string) Network_Application_Delivery_Controller_LoadBalancer_Routing_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -885,11 +1244,26 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Type) Limit
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Type) Unlimited() Network_Application_Delivery_Controller_LoadBalancer_Routing_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Type) Offset(offset int) Network_Application_Delivery_Controller_LoadBalancer_Routing_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Type) Timeout(timeout time.Duration) Network_Application_Delivery_Controller_LoadBalancer_Routing_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Type) WithContext(ctx context.Context) Network_Application_Delivery_Controller_LoadBalancer_Routing_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Application_Delivery_Controller_LoadBalancer_Routing_Type) GetAllObjects() (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_Routing_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Routing_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -918,6 +1292,11 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Service) Id(id int)
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Service) InitParameter(name string, value interface{}) Network_Application_Delivery_Controller_LoadBalancer_Service {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Service) Mask(mask string) Network_Application_Delivery_Controller_LoadBalancer_Service {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -937,11 +1316,26 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Service) Limit(limi
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Service) Unlimited() Network_Application_Delivery_Controller_LoadBalancer_Service {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Service) Offset(offset int) Network_Application_Delivery_Controller_LoadBalancer_Service {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Service) Timeout(timeout time.Duration) Network_Application_Delivery_Controller_LoadBalancer_Service {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Application_Delivery_Controller_LoadBalancer_Service) WithContext(ctx context.Context) Network_Application_Delivery_Controller_LoadBalancer_Service {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Application_Delivery_Controller_LoadBalancer_Service) DeleteObject() (err error) {
 	var resp datatypes.Void
@@ -1025,6 +1419,11 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Service_Group) Id(i
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Service_Group) InitParameter(name string, value interface{}) Network_Application_Delivery_Controller_LoadBalancer_Service_Group {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Service_Group) Mask(mask string) Network_Application_Delivery_Controller_LoadBalancer_Service_Group {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1044,11 +1443,26 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_Service_Group) Limi
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Service_Group) Unlimited() Network_Application_Delivery_Controller_LoadBalancer_Service_Group {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_Service_Group) Offset(offset int) Network_Application_Delivery_Controller_LoadBalancer_Service_Group {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_Service_Group) Timeout(timeout time.Duration) Network_Application_Delivery_Controller_LoadBalancer_Service_Group {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Application_Delivery_Controller_LoadBalancer_Service_Group) WithContext(ctx context.Context) Network_Application_Delivery_Controller_LoadBalancer_Service_Group {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Get the graph image for a load balancer service group based on the supplied graph type and metric.  The only available graph type currently is: 'connections', and the available metrics are: 'day', 'week' and 'month'.
 //
 // This method returns the raw binary image data.
@@ -1125,6 +1539,11 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) I
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) InitParameter(name string, value interface{}) Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) Mask(mask string) Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1144,11 +1563,26 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) L
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) Unlimited() Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) Offset(offset int) Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) Timeout(timeout time.Duration) Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) WithContext(ctx context.Context) Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Like any other API object, the load balancers can have their exposed properties edited by passing in a modified version of the object.  The load balancer object also can modify its services in this way.  Simply request the load balancer object you wish to edit, then modify the objects in the services array and pass the modified object to this function.  WARNING:  Services cannot be deleted in this manner, you must call deleteObject() on the service to physically remove them from the load balancer.
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) EditObject(templateObject *datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) (resp bool, err error) {
 	params := []interface{}{
@@ -1170,12 +1604,28 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) G
 	return
 }
 
+// GetApplicationDeliveryControllerWith is GetApplicationDeliveryController, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetApplicationDeliveryControllerWith(options sl.Options) (resp datatypes.Network_Application_Delivery_Controller, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetApplicationDeliveryController()
+}
+
 // Retrieve A virtual IP address's associated application delivery controllers.
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetApplicationDeliveryControllers() (resp []datatypes.Network_Application_Delivery_Controller, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress", "getApplicationDeliveryControllers", nil, &r.Options, &resp)
 	return
 }
 
+// GetApplicationDeliveryControllersWith is GetApplicationDeliveryControllers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetApplicationDeliveryControllersWith(options sl.Options) (resp []datatypes.Network_Application_Delivery_Controller, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetApplicationDeliveryControllers()
+}
+
 // Yields a list of the SSL/TLS encryption ciphers that are currently supported on this virtual IP address instance.
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetAvailableSecureTransportCiphers() (resp []datatypes.Security_SecureTransportCipher, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress", "getAvailableSecureTransportCiphers", nil, &r.Options, &resp)
@@ -1194,18 +1644,42 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) G
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The current billing item for the load balancing device housing the virtual IP. This billing item represents a device which could contain other virtual IPs. Caution should be taken when canceling. This is only valid when dedicatedFlag is true.
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetDedicatedBillingItem() (resp datatypes.Billing_Item_Network_LoadBalancer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress", "getDedicatedBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetDedicatedBillingItemWith is GetDedicatedBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetDedicatedBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Network_LoadBalancer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDedicatedBillingItem()
+}
+
 // Retrieve Denotes whether the virtual IP is configured within a high availability cluster.
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetHighAvailabilityFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress", "getHighAvailabilityFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHighAvailabilityFlagWith is GetHighAvailabilityFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetHighAvailabilityFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHighAvailabilityFlag()
+}
+
 // Retrieve
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetIpAddress() (resp datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress", "getIpAddress", nil, &r.Options, &resp)
@@ -1224,6 +1698,14 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) G
 	return
 }
 
+// GetManagedResourceFlagWith is GetManagedResourceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetManagedResourceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedResourceFlag()
+}
+
 // no documentation yet
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetObject() (resp datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress", "getObject", nil, &r.Options, &resp)
@@ -1236,24 +1718,56 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) G
 	return
 }
 
+// GetSecureTransportCiphersWith is GetSecureTransportCiphers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetSecureTransportCiphersWith(options sl.Options) (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_SecureTransportCipher, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecureTransportCiphers()
+}
+
 // Retrieve The list of secure transport protocols enabled for this virtual IP address
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetSecureTransportProtocols() (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_SecureTransportProtocol, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress", "getSecureTransportProtocols", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecureTransportProtocolsWith is GetSecureTransportProtocols, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetSecureTransportProtocolsWith(options sl.Options) (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_SecureTransportProtocol, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecureTransportProtocols()
+}
+
 // Retrieve The SSL certificate currently associated with the VIP.
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetSecurityCertificate() (resp datatypes.Security_Certificate, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress", "getSecurityCertificate", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecurityCertificateWith is GetSecurityCertificate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetSecurityCertificateWith(options sl.Options) (resp datatypes.Security_Certificate, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityCertificate()
+}
+
 // Retrieve The SSL certificate currently associated with the VIP. Provides chosen certificate visibility to unprivileged users.
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetSecurityCertificateEntry() (resp datatypes.Security_Certificate_Entry, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress", "getSecurityCertificateEntry", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecurityCertificateEntryWith is GetSecurityCertificateEntry, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetSecurityCertificateEntryWith(options sl.Options) (resp datatypes.Security_Certificate_Entry, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityCertificateEntry()
+}
+
 // Retrieve
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) GetVirtualServers() (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualServer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress", "getVirtualServers", nil, &r.Options, &resp)
@@ -1294,6 +1808,11 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualServer) Id(i
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualServer) InitParameter(name string, value interface{}) Network_Application_Delivery_Controller_LoadBalancer_VirtualServer {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualServer) Mask(mask string) Network_Application_Delivery_Controller_LoadBalancer_VirtualServer {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1313,11 +1832,26 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualServer) Limi
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualServer) Unlimited() Network_Application_Delivery_Controller_LoadBalancer_VirtualServer {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualServer) Offset(offset int) Network_Application_Delivery_Controller_LoadBalancer_VirtualServer {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualServer) Timeout(timeout time.Duration) Network_Application_Delivery_Controller_LoadBalancer_VirtualServer {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualServer) WithContext(ctx context.Context) Network_Application_Delivery_Controller_LoadBalancer_VirtualServer {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualServer) DeleteObject() (err error) {
 	var resp datatypes.Void
@@ -1343,6 +1877,14 @@ func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualServer) GetS
 	return
 }
 
+// GetScaleLoadBalancersWith is GetScaleLoadBalancers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualServer) GetScaleLoadBalancersWith(options sl.Options) (resp []datatypes.Scale_LoadBalancer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleLoadBalancers()
+}
+
 // Retrieve
 func (r Network_Application_Delivery_Controller_LoadBalancer_VirtualServer) GetServiceGroups() (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_Service_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualServer", "getServiceGroups", nil, &r.Options, &resp)
@@ -1383,6 +1925,11 @@ func (r Network_Backbone) Id(id int) Network_Backbone {
 	return r
 }
 
+func (r Network_Backbone) InitParameter(name string, value interface{}) Network_Backbone {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Backbone) Mask(mask string) Network_Backbone {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1402,11 +1949,26 @@ func (r Network_Backbone) Limit(limit int) Network_Backbone {
 	return r
 }
 
+func (r Network_Backbone) Unlimited() Network_Backbone {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Backbone) Offset(offset int) Network_Backbone {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Backbone) Timeout(timeout time.Duration) Network_Backbone {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Backbone) WithContext(ctx context.Context) Network_Backbone {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve a list of all SoftLayer backbone connections. Use this method if you need all backbones or don't know the id number of a specific backbone.
 func (r Network_Backbone) GetAllBackbones() (resp []datatypes.Network_Backbone, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Backbone", "getAllBackbones", nil, &r.Options, &resp)
@@ -1434,18 +1996,42 @@ func (r Network_Backbone) GetHealth() (resp string, err error) {
 	return
 }
 
+// GetHealthWith is GetHealth, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Backbone) GetHealthWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHealth()
+}
+
 // Retrieve Which of the SoftLayer datacenters a backbone is connected to.
 func (r Network_Backbone) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Backbone", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Backbone) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve A backbone's primary network component.
 func (r Network_Backbone) GetNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Backbone", "getNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkComponentWith is GetNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Backbone) GetNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponent()
+}
+
 // Retrieve an individual SoftLayer_Network_Backbone record. Use the getAllBackbones() method to retrieve a list of all SoftLayer network backbones.
 func (r Network_Backbone) GetObject() (resp datatypes.Network_Backbone, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Backbone", "getObject", nil, &r.Options, &resp)
@@ -1468,6 +2054,11 @@ func (r Network_Backbone_Location_Dependent) Id(id int) Network_Backbone_Locatio
 	return r
 }
 
+func (r Network_Backbone_Location_Dependent) InitParameter(name string, value interface{}) Network_Backbone_Location_Dependent {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Backbone_Location_Dependent) Mask(mask string) Network_Backbone_Location_Dependent {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1487,11 +2078,26 @@ func (r Network_Backbone_Location_Dependent) Limit(limit int) Network_Backbone_L
 	return r
 }
 
+func (r Network_Backbone_Location_Dependent) Unlimited() Network_Backbone_Location_Dependent {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Backbone_Location_Dependent) Offset(offset int) Network_Backbone_Location_Dependent {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Backbone_Location_Dependent) Timeout(timeout time.Duration) Network_Backbone_Location_Dependent {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Backbone_Location_Dependent) WithContext(ctx context.Context) Network_Backbone_Location_Dependent {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Backbone_Location_Dependent) GetAllObjects() (resp []datatypes.Network_Backbone_Location_Dependent, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Backbone_Location_Dependent", "getAllObjects", nil, &r.Options, &resp)
@@ -1541,6 +2147,11 @@ func (r Network_Bandwidth_Version1_Allotment) Id(id int) Network_Bandwidth_Versi
 	return r
 }
 
+func (r Network_Bandwidth_Version1_Allotment) InitParameter(name string, value interface{}) Network_Bandwidth_Version1_Allotment {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Bandwidth_Version1_Allotment) Mask(mask string) Network_Bandwidth_Version1_Allotment {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1560,11 +2171,26 @@ func (r Network_Bandwidth_Version1_Allotment) Limit(limit int) Network_Bandwidth
 	return r
 }
 
+func (r Network_Bandwidth_Version1_Allotment) Unlimited() Network_Bandwidth_Version1_Allotment {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Bandwidth_Version1_Allotment) Offset(offset int) Network_Bandwidth_Version1_Allotment {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Bandwidth_Version1_Allotment) Timeout(timeout time.Duration) Network_Bandwidth_Version1_Allotment {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Bandwidth_Version1_Allotment) WithContext(ctx context.Context) Network_Bandwidth_Version1_Allotment {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a allotment for servers to pool bandwidth and avoid overages in billing if they use more than there allocated bandwidth.
 func (r Network_Bandwidth_Version1_Allotment) CreateObject(templateObject *datatypes.Network_Bandwidth_Version1_Allotment) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	params := []interface{}{
@@ -1589,24 +2215,56 @@ func (r Network_Bandwidth_Version1_Allotment) GetAccount() (resp datatypes.Accou
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The bandwidth allotment detail records associated with this virtual rack.
 func (r Network_Bandwidth_Version1_Allotment) GetActiveDetails() (resp []datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getActiveDetails", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveDetailsWith is GetActiveDetails, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetActiveDetailsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveDetails()
+}
+
 // Retrieve The Application Delivery Controller contained within a virtual rack.
 func (r Network_Bandwidth_Version1_Allotment) GetApplicationDeliveryControllers() (resp []datatypes.Network_Application_Delivery_Controller, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getApplicationDeliveryControllers", nil, &r.Options, &resp)
 	return
 }
 
+// GetApplicationDeliveryControllersWith is GetApplicationDeliveryControllers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetApplicationDeliveryControllersWith(options sl.Options) (resp []datatypes.Network_Application_Delivery_Controller, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetApplicationDeliveryControllers()
+}
+
 // Retrieve The average daily public bandwidth usage for the current billing cycle.
 func (r Network_Bandwidth_Version1_Allotment) GetAverageDailyPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getAverageDailyPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAverageDailyPublicBandwidthUsageWith is GetAverageDailyPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetAverageDailyPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPublicBandwidthUsage()
+}
+
 // This method recurses through all servers on a Bandwidth Pool for 24 hour time span starting at a given date/time. To get the private data set for all servers on a Bandwidth Pool from midnight Feb 1st, 2008 to 23:59 on Feb 1st, you would pass a parameter of '02/01/2008 0:00'.  The ending date / time is calculated for you to prevent requesting data from the server for periods larger than 24 hours as this method requires processing a lot of data records and can get slow at times.
 func (r Network_Bandwidth_Version1_Allotment) GetBackendBandwidthByHour(date *datatypes.Time) (resp []datatypes.Container_Network_Bandwidth_Version1_Usage, err error) {
 	params := []interface{}{
@@ -1632,6 +2290,14 @@ func (r Network_Bandwidth_Version1_Allotment) GetBandwidthAllotmentType() (resp
 	return
 }
 
+// GetBandwidthAllotmentTypeWith is GetBandwidthAllotmentType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetBandwidthAllotmentTypeWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotmentType()
+}
+
 // Retrieve a collection of bandwidth data from an individual public or private network tracking object. Data is ideal if you with to employ your own traffic storage and graphing systems.
 func (r Network_Bandwidth_Version1_Allotment) GetBandwidthForDateRange(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -1661,42 +2327,98 @@ func (r Network_Bandwidth_Version1_Allotment) GetBareMetalInstances() (resp []da
 	return
 }
 
+// GetBareMetalInstancesWith is GetBareMetalInstances, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetBareMetalInstancesWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBareMetalInstances()
+}
+
 // Retrieve A virtual rack's raw bandwidth usage data for an account's current billing cycle. One object is returned for each network this server is attached to.
 func (r Network_Bandwidth_Version1_Allotment) GetBillingCycleBandwidthUsage() (resp []datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getBillingCycleBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCycleBandwidthUsageWith is GetBillingCycleBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetBillingCycleBandwidthUsageWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCycleBandwidthUsage()
+}
+
 // Retrieve A virtual rack's raw private network bandwidth usage data for an account's current billing cycle.
 func (r Network_Bandwidth_Version1_Allotment) GetBillingCyclePrivateBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getBillingCyclePrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCyclePrivateBandwidthUsageWith is GetBillingCyclePrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetBillingCyclePrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePrivateBandwidthUsage()
+}
+
 // Retrieve A virtual rack's raw public network bandwidth usage data for an account's current billing cycle.
 func (r Network_Bandwidth_Version1_Allotment) GetBillingCyclePublicBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getBillingCyclePublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCyclePublicBandwidthUsageWith is GetBillingCyclePublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetBillingCyclePublicBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePublicBandwidthUsage()
+}
+
 // Retrieve The total public bandwidth used in this virtual rack for an account's current billing cycle.
 func (r Network_Bandwidth_Version1_Allotment) GetBillingCyclePublicUsageTotal() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getBillingCyclePublicUsageTotal", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCyclePublicUsageTotalWith is GetBillingCyclePublicUsageTotal, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetBillingCyclePublicUsageTotalWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePublicUsageTotal()
+}
+
 // Retrieve A virtual rack's billing item.
 func (r Network_Bandwidth_Version1_Allotment) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve An object that provides commonly used bandwidth summary components for the current billing cycle.
 func (r Network_Bandwidth_Version1_Allotment) GetCurrentBandwidthSummary() (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getCurrentBandwidthSummary", nil, &r.Options, &resp)
 	return
 }
 
+// GetCurrentBandwidthSummaryWith is GetCurrentBandwidthSummary, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetCurrentBandwidthSummaryWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentBandwidthSummary()
+}
+
 // Retrieve bandwidth graph by date.
 func (r Network_Bandwidth_Version1_Allotment) GetCustomBandwidthDataByDate(graphData *datatypes.Container_Graph) (resp datatypes.Container_Graph, err error) {
 	params := []interface{}{
@@ -1712,6 +2434,14 @@ func (r Network_Bandwidth_Version1_Allotment) GetDetails() (resp []datatypes.Net
 	return
 }
 
+// GetDetailsWith is GetDetails, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetDetailsWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDetails()
+}
+
 // This method recurses through all servers on a Bandwidth Pool for 24 hour time span starting at a given date/time. To get the public data set for all servers on a Bandwidth Pool from midnight Feb 1st, 2008 to 23:59 on Feb 1st, you would pass a parameter of '02/01/2008 0:00'.  The ending date / time is calculated for you to prevent requesting data from the server for periods larger than 24 hours as this method requires processing a lot of data records and can get slow at times.
 func (r Network_Bandwidth_Version1_Allotment) GetFrontendBandwidthByHour(date *datatypes.Time) (resp []datatypes.Container_Network_Bandwidth_Version1_Usage, err error) {
 	params := []interface{}{
@@ -1737,48 +2467,112 @@ func (r Network_Bandwidth_Version1_Allotment) GetHardware() (resp []datatypes.Ha
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve The total public inbound bandwidth used in this virtual rack for an account's current billing cycle.
 func (r Network_Bandwidth_Version1_Allotment) GetInboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getInboundPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundPublicBandwidthUsageWith is GetInboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetInboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPublicBandwidthUsage()
+}
+
 // Retrieve The location group associated with this virtual rack.
 func (r Network_Bandwidth_Version1_Allotment) GetLocationGroup() (resp datatypes.Location_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getLocationGroup", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationGroupWith is GetLocationGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetLocationGroupWith(options sl.Options) (resp datatypes.Location_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocationGroup()
+}
+
 // Retrieve The managed bare metal server instances contained within a virtual rack.
 func (r Network_Bandwidth_Version1_Allotment) GetManagedBareMetalInstances() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getManagedBareMetalInstances", nil, &r.Options, &resp)
 	return
 }
 
+// GetManagedBareMetalInstancesWith is GetManagedBareMetalInstances, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetManagedBareMetalInstancesWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedBareMetalInstances()
+}
+
 // Retrieve The managed hardware contained within a virtual rack.
 func (r Network_Bandwidth_Version1_Allotment) GetManagedHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getManagedHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetManagedHardwareWith is GetManagedHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetManagedHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedHardware()
+}
+
 // Retrieve The managed Virtual Server contained within a virtual rack.
 func (r Network_Bandwidth_Version1_Allotment) GetManagedVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getManagedVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetManagedVirtualGuestsWith is GetManagedVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetManagedVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedVirtualGuests()
+}
+
 // Retrieve A virtual rack's metric tracking object. This object records all periodic polled data available to this rack.
 func (r Network_Bandwidth_Version1_Allotment) GetMetricTrackingObject() (resp datatypes.Metric_Tracking_Object_VirtualDedicatedRack, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getMetricTrackingObject", nil, &r.Options, &resp)
 	return
 }
 
+// GetMetricTrackingObjectWith is GetMetricTrackingObject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_VirtualDedicatedRack, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+}
+
 // Retrieve The metric tracking object id for this allotment.
 func (r Network_Bandwidth_Version1_Allotment) GetMetricTrackingObjectId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getMetricTrackingObjectId", nil, &r.Options, &resp)
 	return
 }
 
+// GetMetricTrackingObjectIdWith is GetMetricTrackingObjectId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetMetricTrackingObjectIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObjectId()
+}
+
 // getObject retrieves the SoftLayer_Network_Bandwidth_Version1_Allotment object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Hardware service. You can only retrieve an allotment associated with the account that your portal user is assigned to.
 func (r Network_Bandwidth_Version1_Allotment) GetObject() (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getObject", nil, &r.Options, &resp)
@@ -1791,30 +2585,70 @@ func (r Network_Bandwidth_Version1_Allotment) GetOutboundPublicBandwidthUsage()
 	return
 }
 
+// GetOutboundPublicBandwidthUsageWith is GetOutboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetOutboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPublicBandwidthUsage()
+}
+
 // Retrieve Whether the bandwidth usage for this bandwidth pool for the current billing cycle exceeds the allocation.
 func (r Network_Bandwidth_Version1_Allotment) GetOverBandwidthAllocationFlag() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getOverBandwidthAllocationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetOverBandwidthAllocationFlagWith is GetOverBandwidthAllocationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOverBandwidthAllocationFlag()
+}
+
 // Retrieve The private network only hardware contained within a virtual rack.
 func (r Network_Bandwidth_Version1_Allotment) GetPrivateNetworkOnlyHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getPrivateNetworkOnlyHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrivateNetworkOnlyHardwareWith is GetPrivateNetworkOnlyHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetPrivateNetworkOnlyHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateNetworkOnlyHardware()
+}
+
 // Retrieve Whether the bandwidth usage for this bandwidth pool for the current billing cycle is projected to exceed the allocation.
 func (r Network_Bandwidth_Version1_Allotment) GetProjectedOverBandwidthAllocationFlag() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getProjectedOverBandwidthAllocationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetProjectedOverBandwidthAllocationFlagWith is GetProjectedOverBandwidthAllocationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetProjectedOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedOverBandwidthAllocationFlag()
+}
+
 // Retrieve The projected public outbound bandwidth for this virtual server for the current billing cycle.
 func (r Network_Bandwidth_Version1_Allotment) GetProjectedPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getProjectedPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetProjectedPublicBandwidthUsageWith is GetProjectedPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetProjectedPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedPublicBandwidthUsage()
+}
+
 // Retrieve
 func (r Network_Bandwidth_Version1_Allotment) GetServiceProvider() (resp datatypes.Service_Provider, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getServiceProvider", nil, &r.Options, &resp)
@@ -1827,6 +2661,14 @@ func (r Network_Bandwidth_Version1_Allotment) GetTotalBandwidthAllocated() (resp
 	return
 }
 
+// GetTotalBandwidthAllocatedWith is GetTotalBandwidthAllocated, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetTotalBandwidthAllocatedWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalBandwidthAllocated()
+}
+
 // Gets the monthly recurring fee of a pooled server.
 func (r Network_Bandwidth_Version1_Allotment) GetVdrMemberRecurringFee() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Bandwidth_Version1_Allotment", "getVdrMemberRecurringFee", nil, &r.Options, &resp)
@@ -1839,6 +2681,14 @@ func (r Network_Bandwidth_Version1_Allotment) GetVirtualGuests() (resp []datatyp
 	return
 }
 
+// GetVirtualGuestsWith is GetVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Bandwidth_Version1_Allotment) GetVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuests()
+}
+
 // This method will reassign a collection of SoftLayer hardware to a bandwidth allotment Bandwidth Pool.
 func (r Network_Bandwidth_Version1_Allotment) ReassignServers(templateObjects []datatypes.Hardware, newAllotmentId *int) (resp bool, err error) {
 	params := []interface{}{
@@ -1924,6 +2774,11 @@ func (r Network_CdnMarketplace_Account) Id(id int) Network_CdnMarketplace_Accoun
 	return r
 }
 
+func (r Network_CdnMarketplace_Account) InitParameter(name string, value interface{}) Network_CdnMarketplace_Account {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_CdnMarketplace_Account) Mask(mask string) Network_CdnMarketplace_Account {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1943,23 +2798,54 @@ func (r Network_CdnMarketplace_Account) Limit(limit int) Network_CdnMarketplace_
 	return r
 }
 
+func (r Network_CdnMarketplace_Account) Unlimited() Network_CdnMarketplace_Account {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_CdnMarketplace_Account) Offset(offset int) Network_CdnMarketplace_Account {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_CdnMarketplace_Account) Timeout(timeout time.Duration) Network_CdnMarketplace_Account {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_CdnMarketplace_Account) WithContext(ctx context.Context) Network_CdnMarketplace_Account {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve SoftLayer account to which the CDN account belongs.
 func (r Network_CdnMarketplace_Account) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_CdnMarketplace_Account", "getAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_CdnMarketplace_Account) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve An associated parent billing item which is active.
 func (r Network_CdnMarketplace_Account) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_CdnMarketplace_Account", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_CdnMarketplace_Account) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // no documentation yet
 func (r Network_CdnMarketplace_Account) GetObject() (resp datatypes.Network_CdnMarketplace_Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_CdnMarketplace_Account", "getObject", nil, &r.Options, &resp)
@@ -1991,6 +2877,11 @@ func (r Network_CdnMarketplace_Configuration_Behavior_Geoblocking) Id(id int) Ne
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Behavior_Geoblocking) InitParameter(name string, value interface{}) Network_CdnMarketplace_Configuration_Behavior_Geoblocking {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_CdnMarketplace_Configuration_Behavior_Geoblocking) Mask(mask string) Network_CdnMarketplace_Configuration_Behavior_Geoblocking {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2010,11 +2901,26 @@ func (r Network_CdnMarketplace_Configuration_Behavior_Geoblocking) Limit(limit i
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Behavior_Geoblocking) Unlimited() Network_CdnMarketplace_Configuration_Behavior_Geoblocking {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_CdnMarketplace_Configuration_Behavior_Geoblocking) Offset(offset int) Network_CdnMarketplace_Configuration_Behavior_Geoblocking {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Behavior_Geoblocking) Timeout(timeout time.Duration) Network_CdnMarketplace_Configuration_Behavior_Geoblocking {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_CdnMarketplace_Configuration_Behavior_Geoblocking) WithContext(ctx context.Context) Network_CdnMarketplace_Configuration_Behavior_Geoblocking {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_CdnMarketplace_Configuration_Behavior_Geoblocking) CreateGeoblocking(input *datatypes.Container_Network_CdnMarketplace_Configuration_Input) (resp datatypes.Network_CdnMarketplace_Configuration_Behavior_Geoblocking, err error) {
 	params := []interface{}{
@@ -2082,6 +2988,11 @@ func (r Network_CdnMarketplace_Configuration_Cache_Purge) Id(id int) Network_Cdn
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Cache_Purge) InitParameter(name string, value interface{}) Network_CdnMarketplace_Configuration_Cache_Purge {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_CdnMarketplace_Configuration_Cache_Purge) Mask(mask string) Network_CdnMarketplace_Configuration_Cache_Purge {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2101,11 +3012,26 @@ func (r Network_CdnMarketplace_Configuration_Cache_Purge) Limit(limit int) Netwo
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Cache_Purge) Unlimited() Network_CdnMarketplace_Configuration_Cache_Purge {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_CdnMarketplace_Configuration_Cache_Purge) Offset(offset int) Network_CdnMarketplace_Configuration_Cache_Purge {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Cache_Purge) Timeout(timeout time.Duration) Network_CdnMarketplace_Configuration_Cache_Purge {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_CdnMarketplace_Configuration_Cache_Purge) WithContext(ctx context.Context) Network_CdnMarketplace_Configuration_Cache_Purge {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_CdnMarketplace_Configuration_Cache_Purge) CreatePurge(uniqueId *string, path *string) (resp []datatypes.Container_Network_CdnMarketplace_Configuration_Cache_Purge, err error) {
 	params := []interface{}{
@@ -2169,6 +3095,11 @@ func (r Network_CdnMarketplace_Configuration_Cache_TimeToLive) Id(id int) Networ
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Cache_TimeToLive) InitParameter(name string, value interface{}) Network_CdnMarketplace_Configuration_Cache_TimeToLive {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_CdnMarketplace_Configuration_Cache_TimeToLive) Mask(mask string) Network_CdnMarketplace_Configuration_Cache_TimeToLive {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2188,11 +3119,26 @@ func (r Network_CdnMarketplace_Configuration_Cache_TimeToLive) Limit(limit int)
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Cache_TimeToLive) Unlimited() Network_CdnMarketplace_Configuration_Cache_TimeToLive {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_CdnMarketplace_Configuration_Cache_TimeToLive) Offset(offset int) Network_CdnMarketplace_Configuration_Cache_TimeToLive {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Cache_TimeToLive) Timeout(timeout time.Duration) Network_CdnMarketplace_Configuration_Cache_TimeToLive {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_CdnMarketplace_Configuration_Cache_TimeToLive) WithContext(ctx context.Context) Network_CdnMarketplace_Configuration_Cache_TimeToLive {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_CdnMarketplace_Configuration_Cache_TimeToLive) CreateTimeToLive(uniqueId *string, pathName *string, ttl *string) (resp string, err error) {
 	params := []interface{}{
@@ -2258,6 +3204,11 @@ func (r Network_CdnMarketplace_Configuration_Mapping) Id(id int) Network_CdnMark
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Mapping) InitParameter(name string, value interface{}) Network_CdnMarketplace_Configuration_Mapping {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_CdnMarketplace_Configuration_Mapping) Mask(mask string) Network_CdnMarketplace_Configuration_Mapping {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2277,11 +3228,26 @@ func (r Network_CdnMarketplace_Configuration_Mapping) Limit(limit int) Network_C
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Mapping) Unlimited() Network_CdnMarketplace_Configuration_Mapping {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_CdnMarketplace_Configuration_Mapping) Offset(offset int) Network_CdnMarketplace_Configuration_Mapping {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Mapping) Timeout(timeout time.Duration) Network_CdnMarketplace_Configuration_Mapping {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_CdnMarketplace_Configuration_Mapping) WithContext(ctx context.Context) Network_CdnMarketplace_Configuration_Mapping {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_CdnMarketplace_Configuration_Mapping) CreateDomainMapping(input *datatypes.Container_Network_CdnMarketplace_Configuration_Input) (resp []datatypes.Container_Network_CdnMarketplace_Configuration_Mapping, err error) {
 	params := []interface{}{
@@ -2391,6 +3357,11 @@ func (r Network_CdnMarketplace_Configuration_Mapping_Path) Id(id int) Network_Cd
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Mapping_Path) InitParameter(name string, value interface{}) Network_CdnMarketplace_Configuration_Mapping_Path {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_CdnMarketplace_Configuration_Mapping_Path) Mask(mask string) Network_CdnMarketplace_Configuration_Mapping_Path {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2410,11 +3381,26 @@ func (r Network_CdnMarketplace_Configuration_Mapping_Path) Limit(limit int) Netw
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Mapping_Path) Unlimited() Network_CdnMarketplace_Configuration_Mapping_Path {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_CdnMarketplace_Configuration_Mapping_Path) Offset(offset int) Network_CdnMarketplace_Configuration_Mapping_Path {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_CdnMarketplace_Configuration_Mapping_Path) Timeout(timeout time.Duration) Network_CdnMarketplace_Configuration_Mapping_Path {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_CdnMarketplace_Configuration_Mapping_Path) WithContext(ctx context.Context) Network_CdnMarketplace_Configuration_Mapping_Path {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_CdnMarketplace_Configuration_Mapping_Path) CreateOriginPath(input *datatypes.Container_Network_CdnMarketplace_Configuration_Input) (resp []datatypes.Container_Network_CdnMarketplace_Configuration_Mapping_Path, err error) {
 	params := []interface{}{
@@ -2474,6 +3460,11 @@ func (r Network_CdnMarketplace_Metrics) Id(id int) Network_CdnMarketplace_Metric
 	return r
 }
 
+func (r Network_CdnMarketplace_Metrics) InitParameter(name string, value interface{}) Network_CdnMarketplace_Metrics {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_CdnMarketplace_Metrics) Mask(mask string) Network_CdnMarketplace_Metrics {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2493,11 +3484,26 @@ func (r Network_CdnMarketplace_Metrics) Limit(limit int) Network_CdnMarketplace_
 	return r
 }
 
+func (r Network_CdnMarketplace_Metrics) Unlimited() Network_CdnMarketplace_Metrics {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_CdnMarketplace_Metrics) Offset(offset int) Network_CdnMarketplace_Metrics {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_CdnMarketplace_Metrics) Timeout(timeout time.Duration) Network_CdnMarketplace_Metrics {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_CdnMarketplace_Metrics) WithContext(ctx context.Context) Network_CdnMarketplace_Metrics {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_CdnMarketplace_Metrics) GetCustomerUsageMetrics(vendorName *string, startDate *int, endDate *int, frequency *string) (resp []datatypes.Container_Network_CdnMarketplace_Metrics, err error) {
 	params := []interface{}{
@@ -2586,6 +3592,11 @@ func (r Network_CdnMarketplace_Vendor) Id(id int) Network_CdnMarketplace_Vendor
 	return r
 }
 
+func (r Network_CdnMarketplace_Vendor) InitParameter(name string, value interface{}) Network_CdnMarketplace_Vendor {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_CdnMarketplace_Vendor) Mask(mask string) Network_CdnMarketplace_Vendor {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2605,11 +3616,26 @@ func (r Network_CdnMarketplace_Vendor) Limit(limit int) Network_CdnMarketplace_V
 	return r
 }
 
+func (r Network_CdnMarketplace_Vendor) Unlimited() Network_CdnMarketplace_Vendor {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_CdnMarketplace_Vendor) Offset(offset int) Network_CdnMarketplace_Vendor {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_CdnMarketplace_Vendor) Timeout(timeout time.Duration) Network_CdnMarketplace_Vendor {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_CdnMarketplace_Vendor) WithContext(ctx context.Context) Network_CdnMarketplace_Vendor {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_CdnMarketplace_Vendor) GetObject() (resp datatypes.Network_CdnMarketplace_Vendor, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_CdnMarketplace_Vendor", "getObject", nil, &r.Options, &resp)
@@ -2638,6 +3664,11 @@ func (r Network_Component) Id(id int) Network_Component {
 	return r
 }
 
+func (r Network_Component) InitParameter(name string, value interface{}) Network_Component {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Component) Mask(mask string) Network_Component {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2657,11 +3688,26 @@ func (r Network_Component) Limit(limit int) Network_Component {
 	return r
 }
 
+func (r Network_Component) Unlimited() Network_Component {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Component) Offset(offset int) Network_Component {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Component) Timeout(timeout time.Duration) Network_Component {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Component) WithContext(ctx context.Context) Network_Component {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Add VLANs as trunks to a network component. The VLANs given must be assigned to your account, and on the router to which this network component is connected. The current native VLAN (networkVlanId/networkVlan) cannot be added as a trunk. This method should be called on a network component attached directly to customer assigned hardware, though all trunking operations will occur on the uplinkComponent. A current list of VLAN trunks for a network component on a customer server can be found at 'uplinkComponent->networkVlanTrunks'.
 //
 // This method returns an array of SoftLayer_Network_Vlans which were added as trunks. Any requested trunks which are already trunked will be silently ignored, and will not be returned.
@@ -2687,6 +3733,14 @@ func (r Network_Component) GetActiveCommand() (resp datatypes.Hardware_Component
 	return
 }
 
+// GetActiveCommandWith is GetActiveCommand, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetActiveCommandWith(options sl.Options) (resp datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveCommand()
+}
+
 // Retrieve bandwidth graph by date.
 func (r Network_Component) GetCustomBandwidthDataByDate(graphData *datatypes.Container_Graph) (resp datatypes.Container_Graph, err error) {
 	params := []interface{}{
@@ -2702,18 +3756,42 @@ func (r Network_Component) GetDownlinkComponent() (resp datatypes.Network_Compon
 	return
 }
 
+// GetDownlinkComponentWith is GetDownlinkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetDownlinkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDownlinkComponent()
+}
+
 // Retrieve The duplex mode of a network component.
 func (r Network_Component) GetDuplexMode() (resp datatypes.Network_Component_Duplex_Mode, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getDuplexMode", nil, &r.Options, &resp)
 	return
 }
 
+// GetDuplexModeWith is GetDuplexMode, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetDuplexModeWith(options sl.Options) (resp datatypes.Network_Component_Duplex_Mode, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDuplexMode()
+}
+
 // Retrieve The hardware that a network component resides in.
 func (r Network_Component) GetHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve
 func (r Network_Component) GetHighAvailabilityFirewallFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getHighAvailabilityFirewallFlag", nil, &r.Options, &resp)
@@ -2726,12 +3804,28 @@ func (r Network_Component) GetInterface() (resp datatypes.Network_Bandwidth_Vers
 	return
 }
 
+// GetInterfaceWith is GetInterface, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetInterfaceWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Interface, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInterface()
+}
+
 // Retrieve The records of all IP addresses bound to a network component.
 func (r Network_Component) GetIpAddressBindings() (resp []datatypes.Network_Component_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getIpAddressBindings", nil, &r.Options, &resp)
 	return
 }
 
+// GetIpAddressBindingsWith is GetIpAddressBindings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetIpAddressBindingsWith(options sl.Options) (resp []datatypes.Network_Component_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIpAddressBindings()
+}
+
 // Retrieve
 func (r Network_Component) GetIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getIpAddresses", nil, &r.Options, &resp)
@@ -2744,42 +3838,98 @@ func (r Network_Component) GetLastCommand() (resp datatypes.Hardware_Component_R
 	return
 }
 
+// GetLastCommandWith is GetLastCommand, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetLastCommandWith(options sl.Options) (resp datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastCommand()
+}
+
 // Retrieve The metric tracking object for this network component.
 func (r Network_Component) GetMetricTrackingObject() (resp datatypes.Metric_Tracking_Object, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getMetricTrackingObject", nil, &r.Options, &resp)
 	return
 }
 
+// GetMetricTrackingObjectWith is GetMetricTrackingObject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+}
+
 // Retrieve The upstream network component firewall.
 func (r Network_Component) GetNetworkComponentFirewall() (resp datatypes.Network_Component_Firewall, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getNetworkComponentFirewall", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkComponentFirewallWith is GetNetworkComponentFirewall, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetNetworkComponentFirewallWith(options sl.Options) (resp datatypes.Network_Component_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponentFirewall()
+}
+
 // Retrieve A network component's associated group.
 func (r Network_Component) GetNetworkComponentGroup() (resp datatypes.Network_Component_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getNetworkComponentGroup", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkComponentGroupWith is GetNetworkComponentGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetNetworkComponentGroupWith(options sl.Options) (resp datatypes.Network_Component_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponentGroup()
+}
+
 // Retrieve All network devices in SoftLayer's network hierarchy that this device is connected to.
 func (r Network_Component) GetNetworkHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getNetworkHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkHardwareWith is GetNetworkHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetNetworkHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkHardware()
+}
+
 // Retrieve The VLAN that a network component's subnet is associated with.
 func (r Network_Component) GetNetworkVlan() (resp datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getNetworkVlan", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlanWith is GetNetworkVlan, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetNetworkVlanWith(options sl.Options) (resp datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlan()
+}
+
 // Retrieve The VLANs that are trunked to this network component.
 func (r Network_Component) GetNetworkVlanTrunks() (resp []datatypes.Network_Component_Network_Vlan_Trunk, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getNetworkVlanTrunks", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlanTrunksWith is GetNetworkVlanTrunks, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetNetworkVlanTrunksWith(options sl.Options) (resp []datatypes.Network_Component_Network_Vlan_Trunk, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlanTrunks()
+}
+
 // no documentation yet
 func (r Network_Component) GetObject() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getObject", nil, &r.Options, &resp)
@@ -2809,72 +3959,168 @@ func (r Network_Component) GetPrimaryIpAddressRecord() (resp datatypes.Network_S
 	return
 }
 
+// GetPrimaryIpAddressRecordWith is GetPrimaryIpAddressRecord, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetPrimaryIpAddressRecordWith(options sl.Options) (resp datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryIpAddressRecord()
+}
+
 // Retrieve The subnet of the primary IP address assigned to this network component.
 func (r Network_Component) GetPrimarySubnet() (resp datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getPrimarySubnet", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimarySubnetWith is GetPrimarySubnet, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetPrimarySubnetWith(options sl.Options) (resp datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimarySubnet()
+}
+
 // Retrieve The primary IPv6 Address record for a network component.
 func (r Network_Component) GetPrimaryVersion6IpAddressRecord() (resp datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getPrimaryVersion6IpAddressRecord", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryVersion6IpAddressRecordWith is GetPrimaryVersion6IpAddressRecord, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetPrimaryVersion6IpAddressRecordWith(options sl.Options) (resp datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryVersion6IpAddressRecord()
+}
+
 // Retrieve The last five reboot/power (rebootDefault, rebootSoft, rebootHard, powerOn, powerOff and powerCycle) commands issued to the server's remote management card.
 func (r Network_Component) GetRecentCommands() (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getRecentCommands", nil, &r.Options, &resp)
 	return
 }
 
+// GetRecentCommandsWith is GetRecentCommands, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetRecentCommandsWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_Command_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRecentCommands()
+}
+
 // Retrieve Indicates whether the network component is participating in a group of two or more components capable of being operationally redundant, if enabled.
 func (r Network_Component) GetRedundancyCapableFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getRedundancyCapableFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetRedundancyCapableFlagWith is GetRedundancyCapableFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetRedundancyCapableFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRedundancyCapableFlag()
+}
+
 // Retrieve Indicates whether the network component is participating in a group of two or more components which is actively providing link redundancy.
 func (r Network_Component) GetRedundancyEnabledFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getRedundancyEnabledFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetRedundancyEnabledFlagWith is GetRedundancyEnabledFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetRedundancyEnabledFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRedundancyEnabledFlag()
+}
+
 // Retrieve User(s) credentials to issue commands and/or interact with the server's remote management card.
 func (r Network_Component) GetRemoteManagementUsers() (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getRemoteManagementUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementUsersWith is GetRemoteManagementUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetRemoteManagementUsersWith(options sl.Options) (resp []datatypes.Hardware_Component_RemoteManagement_User, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementUsers()
+}
+
 // Retrieve A network component's routers.
 func (r Network_Component) GetRouter() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getRouter", nil, &r.Options, &resp)
 	return
 }
 
+// GetRouterWith is GetRouter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetRouterWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRouter()
+}
+
 // Retrieve Whether a network component's primary ip address is from a storage network subnet or not.
 func (r Network_Component) GetStorageNetworkFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getStorageNetworkFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetStorageNetworkFlagWith is GetStorageNetworkFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetStorageNetworkFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStorageNetworkFlag()
+}
+
 // Retrieve A network component's subnets. A subnet is a group of IP addresses
 func (r Network_Component) GetSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetSubnetsWith is GetSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubnets()
+}
+
 // Retrieve The network component linking this object to parent
 func (r Network_Component) GetUplinkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getUplinkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetUplinkComponentWith is GetUplinkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetUplinkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUplinkComponent()
+}
+
 // Retrieve The duplex mode of the uplink network component linking to this object
 func (r Network_Component) GetUplinkDuplexMode() (resp datatypes.Network_Component_Duplex_Mode, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component", "getUplinkDuplexMode", nil, &r.Options, &resp)
 	return
 }
 
+// GetUplinkDuplexModeWith is GetUplinkDuplexMode, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component) GetUplinkDuplexModeWith(options sl.Options) (resp datatypes.Network_Component_Duplex_Mode, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUplinkDuplexMode()
+}
+
 // Remove one or more VLANs currently attached to the uplinkComponent of this networkComponent. The VLANs given must be assigned to your account, and on the router the network component is connected to. If any VLANs not currently trunked are given, they will be silently ignored.
 //
 // This method should be called on a network component attached directly to customer assigned hardware, though all trunking operations will occur on the uplinkComponent. A current list of VLAN trunks for a network component on a customer server can be found at 'uplinkComponent->networkVlanTrunks'.
@@ -2904,6 +4150,11 @@ func (r Network_Component_Firewall) Id(id int) Network_Component_Firewall {
 	return r
 }
 
+func (r Network_Component_Firewall) InitParameter(name string, value interface{}) Network_Component_Firewall {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Component_Firewall) Mask(mask string) Network_Component_Firewall {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2923,41 +4174,96 @@ func (r Network_Component_Firewall) Limit(limit int) Network_Component_Firewall
 	return r
 }
 
+func (r Network_Component_Firewall) Unlimited() Network_Component_Firewall {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Component_Firewall) Offset(offset int) Network_Component_Firewall {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Component_Firewall) Timeout(timeout time.Duration) Network_Component_Firewall {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Component_Firewall) WithContext(ctx context.Context) Network_Component_Firewall {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The additional subnets linked to this network component firewall, that inherit rules from the host that the context slot is attached to.
 func (r Network_Component_Firewall) GetApplyServerRuleSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component_Firewall", "getApplyServerRuleSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetApplyServerRuleSubnetsWith is GetApplyServerRuleSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component_Firewall) GetApplyServerRuleSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetApplyServerRuleSubnets()
+}
+
 // Retrieve The billing item for a Hardware Firewall (Dedicated).
 func (r Network_Component_Firewall) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component_Firewall", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component_Firewall) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The network component of the guest virtual server that this network component firewall belongs to.
 func (r Network_Component_Firewall) GetGuestNetworkComponent() (resp datatypes.Virtual_Guest_Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component_Firewall", "getGuestNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetGuestNetworkComponentWith is GetGuestNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component_Firewall) GetGuestNetworkComponentWith(options sl.Options) (resp datatypes.Virtual_Guest_Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGuestNetworkComponent()
+}
+
 // Retrieve The network component of the switch interface that this network component firewall belongs to.
 func (r Network_Component_Firewall) GetNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component_Firewall", "getNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkComponentWith is GetNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component_Firewall) GetNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponent()
+}
+
 // Retrieve The update requests made for this firewall.
 func (r Network_Component_Firewall) GetNetworkFirewallUpdateRequest() (resp []datatypes.Network_Firewall_Update_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component_Firewall", "getNetworkFirewallUpdateRequest", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkFirewallUpdateRequestWith is GetNetworkFirewallUpdateRequest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component_Firewall) GetNetworkFirewallUpdateRequestWith(options sl.Options) (resp []datatypes.Network_Firewall_Update_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkFirewallUpdateRequest()
+}
+
 // getObject returns a SoftLayer_Network_Firewall_Module_Context_Interface_AccessControlList_Network_Component object. You can only get objects for servers attached to your account that have a network firewall enabled.
 func (r Network_Component_Firewall) GetObject() (resp datatypes.Network_Component_Firewall, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component_Firewall", "getObject", nil, &r.Options, &resp)
@@ -2970,12 +4276,28 @@ func (r Network_Component_Firewall) GetRules() (resp []datatypes.Network_Compone
 	return
 }
 
+// GetRulesWith is GetRules, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component_Firewall) GetRulesWith(options sl.Options) (resp []datatypes.Network_Component_Firewall_Rule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRules()
+}
+
 // Retrieve The additional subnets linked to this network component firewall.
 func (r Network_Component_Firewall) GetSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Component_Firewall", "getSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetSubnetsWith is GetSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Component_Firewall) GetSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubnets()
+}
+
 // The SoftLayer_Network_ContentDelivery_Account data type models an individual CDN account. CDN accounts contain references to the SoftLayer customer account they belong to, login credentials for upload services, and a CDN account's status. Please contact SoftLayer sales to purchase or cancel a CDN account
 type Network_ContentDelivery_Account struct {
 	Session *session.Session
@@ -2992,6 +4314,11 @@ func (r Network_ContentDelivery_Account) Id(id int) Network_ContentDelivery_Acco
 	return r
 }
 
+func (r Network_ContentDelivery_Account) InitParameter(name string, value interface{}) Network_ContentDelivery_Account {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_ContentDelivery_Account) Mask(mask string) Network_ContentDelivery_Account {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3011,11 +4338,26 @@ func (r Network_ContentDelivery_Account) Limit(limit int) Network_ContentDeliver
 	return r
 }
 
+func (r Network_ContentDelivery_Account) Unlimited() Network_ContentDelivery_Account {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_ContentDelivery_Account) Offset(offset int) Network_ContentDelivery_Account {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_ContentDelivery_Account) Timeout(timeout time.Duration) Network_ContentDelivery_Account {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_ContentDelivery_Account) WithContext(ctx context.Context) Network_ContentDelivery_Account {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Internap servers attempts to validate a token before serving a protected content. SoftLayer customer does not need to invoke this method.  Please refer to [[SoftLayer_Network_ContentDelivery_Authentication_Token|Authentication Token]] object for more details on Content Authentication Service.
 func (r Network_ContentDelivery_Account) AuthenticateResourceRequest(parameter *datatypes.Container_Network_ContentDelivery_Authentication_Parameter) (resp bool, err error) {
 	params := []interface{}{
@@ -3127,6 +4469,14 @@ func (r Network_ContentDelivery_Account) GetAccount() (resp datatypes.Account, e
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_ContentDelivery_Account) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // This method returns bandwidth data for each POP. [[SoftLayer_Container_Network_ContentDelivery_Bandwidth_PointsOfPresence_Summary|POP Bandwidth]] object contains a starting time, ending time, total bytes, POP name and bandwidth unit.
 //
 // POP bandwidth data is updated everyday at 22:50 CST (or CDT). It queries and stores POP data from the day before. It is a more resource intensive process than a regular CDN bandwidth update thus we run this once a day. Since the POP bandwidth data is delayed for a day, there is no correction process for POP data. The POP bandwidth is not associated with any billing process and is mainly used to generate a POP bandwidth graph.
@@ -3157,12 +4507,28 @@ func (r Network_ContentDelivery_Account) GetAssociatedCdnAccountId() (resp strin
 	return
 }
 
+// GetAssociatedCdnAccountIdWith is GetAssociatedCdnAccountId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_ContentDelivery_Account) GetAssociatedCdnAccountIdWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssociatedCdnAccountId()
+}
+
 // Retrieve The IP addresses that are used for the content authentication service.
 func (r Network_ContentDelivery_Account) GetAuthenticationIpAddresses() (resp []datatypes.Network_ContentDelivery_Authentication_Address, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_ContentDelivery_Account", "getAuthenticationIpAddresses", nil, &r.Options, &resp)
 	return
 }
 
+// GetAuthenticationIpAddressesWith is GetAuthenticationIpAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_ContentDelivery_Account) GetAuthenticationIpAddressesWith(options sl.Options) (resp []datatypes.Network_ContentDelivery_Authentication_Address, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAuthenticationIpAddresses()
+}
+
 // CDN servers will invoke a Web Service method to validate a content authentication token. This method returns all token validation web service endpoints set for a CDN account. You can override the default web service by calling [[SoftLayer_Network_ContentDelivery_Authentication_Token|setContentAuthenticationWsdl setContentAuthenticationWsdl]] method.
 func (r Network_ContentDelivery_Account) GetAuthenticationServiceEndpoints() (resp []datatypes.Container_Network_ContentDelivery_Authentication_ServiceEndpoint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_ContentDelivery_Account", "getAuthenticationServiceEndpoints", nil, &r.Options, &resp)
@@ -3217,24 +4583,56 @@ func (r Network_ContentDelivery_Account) GetBillingItem() (resp datatypes.Billin
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_ContentDelivery_Account) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The name of a CDN account.
 func (r Network_ContentDelivery_Account) GetCdnAccountName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_ContentDelivery_Account", "getCdnAccountName", nil, &r.Options, &resp)
 	return
 }
 
+// GetCdnAccountNameWith is GetCdnAccountName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_ContentDelivery_Account) GetCdnAccountNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCdnAccountName()
+}
+
 // Retrieve A brief note on a CDN account.
 func (r Network_ContentDelivery_Account) GetCdnAccountNote() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_ContentDelivery_Account", "getCdnAccountNote", nil, &r.Options, &resp)
 	return
 }
 
+// GetCdnAccountNoteWith is GetCdnAccountNote, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_ContentDelivery_Account) GetCdnAccountNoteWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCdnAccountNote()
+}
+
 // Retrieve The solution type of a CDN account.
 func (r Network_ContentDelivery_Account) GetCdnSolutionName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_ContentDelivery_Account", "getCdnSolutionName", nil, &r.Options, &resp)
 	return
 }
 
+// GetCdnSolutionNameWith is GetCdnSolutionName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_ContentDelivery_Account) GetCdnSolutionNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCdnSolutionName()
+}
+
 // An origin pull mapping is a combination of your customer origin record and a CNAME (optional) record. You can now keep track of your customer origin records separate from your CNAME records. This service returns your customer origin records.
 func (r Network_ContentDelivery_Account) GetCustomerOrigins(mediaType *string) (resp []datatypes.Container_Network_ContentDelivery_OriginPull_Mapping, err error) {
 	params := []interface{}{
@@ -3250,6 +4648,14 @@ func (r Network_ContentDelivery_Account) GetDependantServiceFlag() (resp bool, e
 	return
 }
 
+// GetDependantServiceFlagWith is GetDependantServiceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_ContentDelivery_Account) GetDependantServiceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDependantServiceFlag()
+}
+
 // This method returns an array of [[SoftLayer_Container_Network_Directory_Listing|Directory Listing]] objects. You must have CDN_FILE_MANAGE privilege and you can only retrieve directory information within <b>/media</b> directory. A [[SoftLayer_Container_Network_Directory_Listing|Directory Listing]] object contains type (indicating whether it is a file or a directory), name and file count if it is a directory.
 func (r Network_ContentDelivery_Account) GetDirectoryInformation(directoryName *string) (resp []datatypes.Container_Network_Directory_Listing, err error) {
 	params := []interface{}{
@@ -3293,12 +4699,28 @@ func (r Network_ContentDelivery_Account) GetLegacyCdnFlag() (resp bool, err erro
 	return
 }
 
+// GetLegacyCdnFlagWith is GetLegacyCdnFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_ContentDelivery_Account) GetLegacyCdnFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLegacyCdnFlag()
+}
+
 // Retrieve Indicates if CDN logging is enabled.
 func (r Network_ContentDelivery_Account) GetLogEnabledFlag() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_ContentDelivery_Account", "getLogEnabledFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetLogEnabledFlagWith is GetLogEnabledFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_ContentDelivery_Account) GetLogEnabledFlagWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLogEnabledFlag()
+}
+
 // This method returns CDN URLs for static file (http), Flash streaming (rtmp) and Window Media (mms) streaming services. You can generate your CDN URLs based on the information retrieved by this method.
 func (r Network_ContentDelivery_Account) GetMediaUrls() (resp []datatypes.Container_Network_ContentDelivery_SupportedProtocol, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_ContentDelivery_Account", "getMediaUrls", nil, &r.Options, &resp)
@@ -3341,6 +4763,14 @@ func (r Network_ContentDelivery_Account) GetProviderPortalAccessFlag() (resp boo
 	return
 }
 
+// GetProviderPortalAccessFlagWith is GetProviderPortalAccessFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_ContentDelivery_Account) GetProviderPortalAccessFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProviderPortalAccessFlag()
+}
+
 // This method returns your login credentials to the CDN provider portal.
 func (r Network_ContentDelivery_Account) GetProviderPortalCredentials() (resp datatypes.Container_Network_Authentication_Data, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_ContentDelivery_Account", "getProviderPortalCredentials", nil, &r.Options, &resp)
@@ -3353,6 +4783,14 @@ func (r Network_ContentDelivery_Account) GetStatus() (resp datatypes.Network_Con
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_ContentDelivery_Account) GetStatusWith(options sl.Options) (resp datatypes.Network_ContentDelivery_Account_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // This method returns all token authentication directories.
 func (r Network_ContentDelivery_Account) GetTokenAuthenticationDirectories() (resp []datatypes.Container_Network_Directory_Listing, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_ContentDelivery_Account", "getTokenAuthenticationDirectories", nil, &r.Options, &resp)
@@ -3365,6 +4803,14 @@ func (r Network_ContentDelivery_Account) GetTokenAuthenticationEnabledFlag() (re
 	return
 }
 
+// GetTokenAuthenticationEnabledFlagWith is GetTokenAuthenticationEnabledFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_ContentDelivery_Account) GetTokenAuthenticationEnabledFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTokenAuthenticationEnabledFlag()
+}
+
 // This method returns your login credentials to the public CDN FTP.
 func (r Network_ContentDelivery_Account) GetVendorFtpAttributes() (resp datatypes.Container_Network_Authentication_Data, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_ContentDelivery_Account", "getVendorFtpAttributes", nil, &r.Options, &resp)
@@ -3496,6 +4942,11 @@ func (r Network_ContentDelivery_Authentication_Address) Id(id int) Network_Conte
 	return r
 }
 
+func (r Network_ContentDelivery_Authentication_Address) InitParameter(name string, value interface{}) Network_ContentDelivery_Authentication_Address {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_ContentDelivery_Authentication_Address) Mask(mask string) Network_ContentDelivery_Authentication_Address {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3515,11 +4966,26 @@ func (r Network_ContentDelivery_Authentication_Address) Limit(limit int) Network
 	return r
 }
 
+func (r Network_ContentDelivery_Authentication_Address) Unlimited() Network_ContentDelivery_Authentication_Address {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_ContentDelivery_Authentication_Address) Offset(offset int) Network_ContentDelivery_Authentication_Address {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_ContentDelivery_Authentication_Address) Timeout(timeout time.Duration) Network_ContentDelivery_Authentication_Address {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_ContentDelivery_Authentication_Address) WithContext(ctx context.Context) Network_ContentDelivery_Authentication_Address {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method creates an authentication IP record.  Required parameters are
 //
 //
@@ -3583,6 +5049,11 @@ func (r Network_ContentDelivery_Authentication_Token) Id(id int) Network_Content
 	return r
 }
 
+func (r Network_ContentDelivery_Authentication_Token) InitParameter(name string, value interface{}) Network_ContentDelivery_Authentication_Token {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_ContentDelivery_Authentication_Token) Mask(mask string) Network_ContentDelivery_Authentication_Token {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3602,11 +5073,26 @@ func (r Network_ContentDelivery_Authentication_Token) Limit(limit int) Network_C
 	return r
 }
 
+func (r Network_ContentDelivery_Authentication_Token) Unlimited() Network_ContentDelivery_Authentication_Token {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_ContentDelivery_Authentication_Token) Offset(offset int) Network_ContentDelivery_Authentication_Token {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_ContentDelivery_Authentication_Token) Timeout(timeout time.Duration) Network_ContentDelivery_Authentication_Token {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_ContentDelivery_Authentication_Token) WithContext(ctx context.Context) Network_ContentDelivery_Authentication_Token {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is deprecated! Use the [[SoftLayer_Network_ContentDelivery_Authentication_Token::getTimedToken|getTimedToken]] method.
 //
 // This method creates a managed authentication token. When passing a parameter, the only required value is your CDN account id which can be obtained from the [[SoftLayer_Account::getCdnAccounts|getCdnAccounts]] method. There are 3 optional parameters you can pass:
@@ -3721,6 +5207,11 @@ func (r Network_Customer_Subnet) Id(id int) Network_Customer_Subnet {
 	return r
 }
 
+func (r Network_Customer_Subnet) InitParameter(name string, value interface{}) Network_Customer_Subnet {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Customer_Subnet) Mask(mask string) Network_Customer_Subnet {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3740,11 +5231,26 @@ func (r Network_Customer_Subnet) Limit(limit int) Network_Customer_Subnet {
 	return r
 }
 
+func (r Network_Customer_Subnet) Unlimited() Network_Customer_Subnet {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Customer_Subnet) Offset(offset int) Network_Customer_Subnet {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Customer_Subnet) Timeout(timeout time.Duration) Network_Customer_Subnet {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Customer_Subnet) WithContext(ctx context.Context) Network_Customer_Subnet {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // For IPSec network tunnels, customers can create their local subnets using this method.  After the customer is created successfully, the customer subnet can then be added to the IPSec network tunnel.
 func (r Network_Customer_Subnet) CreateObject(templateObject *datatypes.Network_Customer_Subnet) (resp datatypes.Network_Customer_Subnet, err error) {
 	params := []interface{}{
@@ -3760,6 +5266,14 @@ func (r Network_Customer_Subnet) GetIpAddresses() (resp []datatypes.Network_Cust
 	return
 }
 
+// GetIpAddressesWith is GetIpAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Customer_Subnet) GetIpAddressesWith(options sl.Options) (resp []datatypes.Network_Customer_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIpAddresses()
+}
+
 // getObject retrieves the SoftLayer_Network_Customer_Subnet object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Network_Customer_Subnet service. You can only retrieve the subnet whose account matches the account that your portal user is assigned to.
 func (r Network_Customer_Subnet) GetObject() (resp datatypes.Network_Customer_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Customer_Subnet", "getObject", nil, &r.Options, &resp)
@@ -3782,6 +5296,11 @@ func (r Network_DirectLink_Location) Id(id int) Network_DirectLink_Location {
 	return r
 }
 
+func (r Network_DirectLink_Location) InitParameter(name string, value interface{}) Network_DirectLink_Location {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_DirectLink_Location) Mask(mask string) Network_DirectLink_Location {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3801,11 +5320,26 @@ func (r Network_DirectLink_Location) Limit(limit int) Network_DirectLink_Locatio
 	return r
 }
 
+func (r Network_DirectLink_Location) Unlimited() Network_DirectLink_Location {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_DirectLink_Location) Offset(offset int) Network_DirectLink_Location {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_DirectLink_Location) Timeout(timeout time.Duration) Network_DirectLink_Location {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_DirectLink_Location) WithContext(ctx context.Context) Network_DirectLink_Location {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Return all existing Direct Link location.
 func (r Network_DirectLink_Location) GetAllObjects() (resp []datatypes.Network_DirectLink_Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_DirectLink_Location", "getAllObjects", nil, &r.Options, &resp)
@@ -3818,6 +5352,14 @@ func (r Network_DirectLink_Location) GetLocation() (resp datatypes.Location, err
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_DirectLink_Location) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // no documentation yet
 func (r Network_DirectLink_Location) GetObject() (resp datatypes.Network_DirectLink_Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_DirectLink_Location", "getObject", nil, &r.Options, &resp)
@@ -3830,12 +5372,28 @@ func (r Network_DirectLink_Location) GetProvider() (resp datatypes.Network_Direc
 	return
 }
 
+// GetProviderWith is GetProvider, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_DirectLink_Location) GetProviderWith(options sl.Options) (resp datatypes.Network_DirectLink_Provider, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProvider()
+}
+
 // Retrieve The Id of Direct Link service type.
 func (r Network_DirectLink_Location) GetServiceType() (resp datatypes.Network_DirectLink_ServiceType, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_DirectLink_Location", "getServiceType", nil, &r.Options, &resp)
 	return
 }
 
+// GetServiceTypeWith is GetServiceType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_DirectLink_Location) GetServiceTypeWith(options sl.Options) (resp datatypes.Network_DirectLink_ServiceType, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceType()
+}
+
 // The SoftLayer_Network_DirectLink_Provider presents a structure containing attributes of a Direct Link provider.
 type Network_DirectLink_Provider struct {
 	Session *session.Session
@@ -3852,6 +5410,11 @@ func (r Network_DirectLink_Provider) Id(id int) Network_DirectLink_Provider {
 	return r
 }
 
+func (r Network_DirectLink_Provider) InitParameter(name string, value interface{}) Network_DirectLink_Provider {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_DirectLink_Provider) Mask(mask string) Network_DirectLink_Provider {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3871,11 +5434,26 @@ func (r Network_DirectLink_Provider) Limit(limit int) Network_DirectLink_Provide
 	return r
 }
 
+func (r Network_DirectLink_Provider) Unlimited() Network_DirectLink_Provider {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_DirectLink_Provider) Offset(offset int) Network_DirectLink_Provider {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_DirectLink_Provider) Timeout(timeout time.Duration) Network_DirectLink_Provider {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_DirectLink_Provider) WithContext(ctx context.Context) Network_DirectLink_Provider {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_DirectLink_Provider) GetObject() (resp datatypes.Network_DirectLink_Provider, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_DirectLink_Provider", "getObject", nil, &r.Options, &resp)
@@ -3898,6 +5476,11 @@ func (r Network_DirectLink_ServiceType) Id(id int) Network_DirectLink_ServiceTyp
 	return r
 }
 
+func (r Network_DirectLink_ServiceType) InitParameter(name string, value interface{}) Network_DirectLink_ServiceType {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_DirectLink_ServiceType) Mask(mask string) Network_DirectLink_ServiceType {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3917,11 +5500,26 @@ func (r Network_DirectLink_ServiceType) Limit(limit int) Network_DirectLink_Serv
 	return r
 }
 
+func (r Network_DirectLink_ServiceType) Unlimited() Network_DirectLink_ServiceType {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_DirectLink_ServiceType) Offset(offset int) Network_DirectLink_ServiceType {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_DirectLink_ServiceType) Timeout(timeout time.Duration) Network_DirectLink_ServiceType {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_DirectLink_ServiceType) WithContext(ctx context.Context) Network_DirectLink_ServiceType {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_DirectLink_ServiceType) GetObject() (resp datatypes.Network_DirectLink_ServiceType, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_DirectLink_ServiceType", "getObject", nil, &r.Options, &resp)
@@ -3944,6 +5542,11 @@ func (r Network_Firewall_AccessControlList) Id(id int) Network_Firewall_AccessCo
 	return r
 }
 
+func (r Network_Firewall_AccessControlList) InitParameter(name string, value interface{}) Network_Firewall_AccessControlList {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Firewall_AccessControlList) Mask(mask string) Network_Firewall_AccessControlList {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3963,17 +5566,40 @@ func (r Network_Firewall_AccessControlList) Limit(limit int) Network_Firewall_Ac
 	return r
 }
 
+func (r Network_Firewall_AccessControlList) Unlimited() Network_Firewall_AccessControlList {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Firewall_AccessControlList) Offset(offset int) Network_Firewall_AccessControlList {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Firewall_AccessControlList) Timeout(timeout time.Duration) Network_Firewall_AccessControlList {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Firewall_AccessControlList) WithContext(ctx context.Context) Network_Firewall_AccessControlList {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The update requests made for this firewall.
 func (r Network_Firewall_AccessControlList) GetNetworkFirewallUpdateRequests() (resp []datatypes.Network_Firewall_Update_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Firewall_AccessControlList", "getNetworkFirewallUpdateRequests", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkFirewallUpdateRequestsWith is GetNetworkFirewallUpdateRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Firewall_AccessControlList) GetNetworkFirewallUpdateRequestsWith(options sl.Options) (resp []datatypes.Network_Firewall_Update_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkFirewallUpdateRequests()
+}
+
 // Retrieve
 func (r Network_Firewall_AccessControlList) GetNetworkVlan() (resp datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Firewall_AccessControlList", "getNetworkVlan", nil, &r.Options, &resp)
@@ -3992,6 +5618,14 @@ func (r Network_Firewall_AccessControlList) GetRules() (resp []datatypes.Network
 	return
 }
 
+// GetRulesWith is GetRules, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Firewall_AccessControlList) GetRulesWith(options sl.Options) (resp []datatypes.Network_Vlan_Firewall_Rule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRules()
+}
+
 // The SoftLayer_Network_Firewall_Interface data type contains general information relating to a single SoftLayer firewall interface. This is the object which ties the firewall context access control list to a firewall. Use the [[SoftLayer Network Firewall Template]] service to pull SoftLayer recommended rule set templates. Use the [[SoftLayer Network Firewall Update Request]] service to submit a firewall update request.
 type Network_Firewall_Interface struct {
 	Session *session.Session
@@ -4008,6 +5642,11 @@ func (r Network_Firewall_Interface) Id(id int) Network_Firewall_Interface {
 	return r
 }
 
+func (r Network_Firewall_Interface) InitParameter(name string, value interface{}) Network_Firewall_Interface {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Firewall_Interface) Mask(mask string) Network_Firewall_Interface {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4027,11 +5666,26 @@ func (r Network_Firewall_Interface) Limit(limit int) Network_Firewall_Interface
 	return r
 }
 
+func (r Network_Firewall_Interface) Unlimited() Network_Firewall_Interface {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Firewall_Interface) Offset(offset int) Network_Firewall_Interface {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Firewall_Interface) Timeout(timeout time.Duration) Network_Firewall_Interface {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Firewall_Interface) WithContext(ctx context.Context) Network_Firewall_Interface {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Network_Firewall_Interface) GetFirewallContextAccessControlLists() (resp []datatypes.Network_Firewall_AccessControlList, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Firewall_Interface", "getFirewallContextAccessControlLists", nil, &r.Options, &resp)
@@ -4066,6 +5720,11 @@ func (r Network_Firewall_Module_Context_Interface) Id(id int) Network_Firewall_M
 	return r
 }
 
+func (r Network_Firewall_Module_Context_Interface) InitParameter(name string, value interface{}) Network_Firewall_Module_Context_Interface {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Firewall_Module_Context_Interface) Mask(mask string) Network_Firewall_Module_Context_Interface {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4085,11 +5744,26 @@ func (r Network_Firewall_Module_Context_Interface) Limit(limit int) Network_Fire
 	return r
 }
 
+func (r Network_Firewall_Module_Context_Interface) Unlimited() Network_Firewall_Module_Context_Interface {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Firewall_Module_Context_Interface) Offset(offset int) Network_Firewall_Module_Context_Interface {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Firewall_Module_Context_Interface) Timeout(timeout time.Duration) Network_Firewall_Module_Context_Interface {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Firewall_Module_Context_Interface) WithContext(ctx context.Context) Network_Firewall_Module_Context_Interface {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Network_Firewall_Module_Context_Interface) GetFirewallContextAccessControlLists() (resp []datatypes.Network_Firewall_AccessControlList, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Firewall_Module_Context_Interface", "getFirewallContextAccessControlLists", nil, &r.Options, &resp)
@@ -4126,6 +5800,11 @@ func (r Network_Firewall_Template) Id(id int) Network_Firewall_Template {
 	return r
 }
 
+func (r Network_Firewall_Template) InitParameter(name string, value interface{}) Network_Firewall_Template {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Firewall_Template) Mask(mask string) Network_Firewall_Template {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4145,11 +5824,26 @@ func (r Network_Firewall_Template) Limit(limit int) Network_Firewall_Template {
 	return r
 }
 
+func (r Network_Firewall_Template) Unlimited() Network_Firewall_Template {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Firewall_Template) Offset(offset int) Network_Firewall_Template {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Firewall_Template) Timeout(timeout time.Duration) Network_Firewall_Template {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Firewall_Template) WithContext(ctx context.Context) Network_Firewall_Template {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Get all available firewall template objects.
 //
 // ''getAllObjects'' returns an array of SoftLayer_Network_Firewall_Template objects upon success.
@@ -4172,6 +5866,14 @@ func (r Network_Firewall_Template) GetRules() (resp []datatypes.Network_Firewall
 	return
 }
 
+// GetRulesWith is GetRules, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Firewall_Template) GetRulesWith(options sl.Options) (resp []datatypes.Network_Firewall_Template_Rule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRules()
+}
+
 // The SoftLayer_Network_Firewall_Update_Request data type contains information relating to a SoftLayer network firewall update request. Use the [[SoftLayer Network Component Firewall]] service to view current rules. Use the [[SoftLayer Network Firewall Template]] service to pull SoftLayer recommended rule set templates.
 type Network_Firewall_Update_Request struct {
 	Session *session.Session
@@ -4188,6 +5890,11 @@ func (r Network_Firewall_Update_Request) Id(id int) Network_Firewall_Update_Requ
 	return r
 }
 
+func (r Network_Firewall_Update_Request) InitParameter(name string, value interface{}) Network_Firewall_Update_Request {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Firewall_Update_Request) Mask(mask string) Network_Firewall_Update_Request {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4207,11 +5914,26 @@ func (r Network_Firewall_Update_Request) Limit(limit int) Network_Firewall_Updat
 	return r
 }
 
+func (r Network_Firewall_Update_Request) Unlimited() Network_Firewall_Update_Request {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Firewall_Update_Request) Offset(offset int) Network_Firewall_Update_Request {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Firewall_Update_Request) Timeout(timeout time.Duration) Network_Firewall_Update_Request {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Firewall_Update_Request) WithContext(ctx context.Context) Network_Firewall_Update_Request {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a new firewall update request. The SoftLayer_Network_Firewall_Update_Request object passed to this function must have at least one rule.
 //
 // ''createObject'' returns a Boolean ''true'' on successful object creation or ''false'' if your firewall update request was unable to be created.
@@ -4229,6 +5951,14 @@ func (r Network_Firewall_Update_Request) GetAuthorizingUser() (resp datatypes.Us
 	return
 }
 
+// GetAuthorizingUserWith is GetAuthorizingUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Firewall_Update_Request) GetAuthorizingUserWith(options sl.Options) (resp datatypes.User_Interface, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAuthorizingUser()
+}
+
 // Get the possible attribute values for a firewall update request rule.  These are the valid values which may be submitted as rule parameters for a firewall update request.
 //
 // ''getFirewallUpdateRequestRuleAttributes'' returns a SoftLayer_Container_Utility_Network_Firewall_Rule_Attribute object upon success.
@@ -4243,18 +5973,42 @@ func (r Network_Firewall_Update_Request) GetGuest() (resp datatypes.Virtual_Gues
 	return
 }
 
+// GetGuestWith is GetGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Firewall_Update_Request) GetGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGuest()
+}
+
 // Retrieve The downstream server that the rule set will be applied to.
 func (r Network_Firewall_Update_Request) GetHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Firewall_Update_Request", "getHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Firewall_Update_Request) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve The network component firewall that the rule set will be applied to.
 func (r Network_Firewall_Update_Request) GetNetworkComponentFirewall() (resp datatypes.Network_Component_Firewall, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Firewall_Update_Request", "getNetworkComponentFirewall", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkComponentFirewallWith is GetNetworkComponentFirewall, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Firewall_Update_Request) GetNetworkComponentFirewallWith(options sl.Options) (resp datatypes.Network_Component_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponentFirewall()
+}
+
 // ''getObject'' returns a SoftLayer_Network_Firewall_Update_Request object. You can only get historical objects for servers attached to your account that have a network firewall enabled. ''createObject'' inserts a new SoftLayer_Network_Firewall_Update_Request object. You can only insert requests for servers attached to your account that have a network firewall enabled. ''getFirewallUpdateRequestRuleAttributes'' Get the possible attribute values for a firewall update request rule.
 func (r Network_Firewall_Update_Request) GetObject() (resp datatypes.Network_Firewall_Update_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Firewall_Update_Request", "getObject", nil, &r.Options, &resp)
@@ -4267,6 +6021,14 @@ func (r Network_Firewall_Update_Request) GetRules() (resp []datatypes.Network_Fi
 	return
 }
 
+// GetRulesWith is GetRules, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Firewall_Update_Request) GetRulesWith(options sl.Options) (resp []datatypes.Network_Firewall_Update_Request_Rule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRules()
+}
+
 // no documentation yet
 func (r Network_Firewall_Update_Request) UpdateRuleNote(fwRule *datatypes.Network_Component_Firewall_Rule, note *string) (resp bool, err error) {
 	params := []interface{}{
@@ -4293,6 +6055,11 @@ func (r Network_Firewall_Update_Request_Rule) Id(id int) Network_Firewall_Update
 	return r
 }
 
+func (r Network_Firewall_Update_Request_Rule) InitParameter(name string, value interface{}) Network_Firewall_Update_Request_Rule {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Firewall_Update_Request_Rule) Mask(mask string) Network_Firewall_Update_Request_Rule {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4312,11 +6079,26 @@ func (r Network_Firewall_Update_Request_Rule) Limit(limit int) Network_Firewall_
 	return r
 }
 
+func (r Network_Firewall_Update_Request_Rule) Unlimited() Network_Firewall_Update_Request_Rule {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Firewall_Update_Request_Rule) Offset(offset int) Network_Firewall_Update_Request_Rule {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Firewall_Update_Request_Rule) Timeout(timeout time.Duration) Network_Firewall_Update_Request_Rule {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Firewall_Update_Request_Rule) WithContext(ctx context.Context) Network_Firewall_Update_Request_Rule {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a new firewall update request. The SoftLayer_Network_Firewall_Update_Request object passed to this function must have at least one rule.
 //
 // ''createObject'' returns a Boolean ''true'' on successful object creation or ''false'' if your firewall update request was unable to be created..
@@ -4334,6 +6116,14 @@ func (r Network_Firewall_Update_Request_Rule) GetFirewallUpdateRequest() (resp d
 	return
 }
 
+// GetFirewallUpdateRequestWith is GetFirewallUpdateRequest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Firewall_Update_Request_Rule) GetFirewallUpdateRequestWith(options sl.Options) (resp datatypes.Network_Firewall_Update_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirewallUpdateRequest()
+}
+
 // getObject returns a SoftLayer_Network_Firewall_Update_Request_Rule object. You can only get historical objects for servers attached to your account that have a network firewall enabled. createObject inserts a new SoftLayer_Network_Firewall_Update_Request_Rule object. Use the SoftLayer_Network_Firewall_Update_Request to create groups of rules for an update request.
 func (r Network_Firewall_Update_Request_Rule) GetObject() (resp datatypes.Network_Firewall_Update_Request_Rule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Firewall_Update_Request_Rule", "getObject", nil, &r.Options, &resp)
@@ -4370,6 +6160,11 @@ func (r Network_Gateway) Id(id int) Network_Gateway {
 	return r
 }
 
+func (r Network_Gateway) InitParameter(name string, value interface{}) Network_Gateway {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Gateway) Mask(mask string) Network_Gateway {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4389,11 +6184,26 @@ func (r Network_Gateway) Limit(limit int) Network_Gateway {
 	return r
 }
 
+func (r Network_Gateway) Unlimited() Network_Gateway {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Gateway) Offset(offset int) Network_Gateway {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Gateway) Timeout(timeout time.Duration) Network_Gateway {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Gateway) WithContext(ctx context.Context) Network_Gateway {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Start the asynchronous process to bypass all VLANs. Any VLANs that are already bypassed will be ignored. The status field can be checked for progress.
 func (r Network_Gateway) BypassAllVlans() (err error) {
 	var resp datatypes.Void
@@ -4435,6 +6245,14 @@ func (r Network_Gateway) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Returns the Gbps capacity of the gateway object
 //
 //
@@ -4449,6 +6267,14 @@ func (r Network_Gateway) GetInsideVlans() (resp []datatypes.Network_Gateway_Vlan
 	return
 }
 
+// GetInsideVlansWith is GetInsideVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway) GetInsideVlansWith(options sl.Options) (resp []datatypes.Network_Gateway_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInsideVlans()
+}
+
 // Returns manufacturer name for a given gateway object
 //
 //
@@ -4463,18 +6289,42 @@ func (r Network_Gateway) GetMembers() (resp []datatypes.Network_Gateway_Member,
 	return
 }
 
+// GetMembersWith is GetMembers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway) GetMembersWith(options sl.Options) (resp []datatypes.Network_Gateway_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMembers()
+}
+
 // Retrieve The firewall associated with this gateway, if any.
 func (r Network_Gateway) GetNetworkFirewall() (resp datatypes.Network_Vlan_Firewall, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway", "getNetworkFirewall", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkFirewallWith is GetNetworkFirewall, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway) GetNetworkFirewallWith(options sl.Options) (resp datatypes.Network_Vlan_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkFirewall()
+}
+
 // Retrieve Whether or not there is a firewall associated with this gateway.
 func (r Network_Gateway) GetNetworkFirewallFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway", "getNetworkFirewallFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkFirewallFlagWith is GetNetworkFirewallFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway) GetNetworkFirewallFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkFirewallFlag()
+}
+
 // no documentation yet
 func (r Network_Gateway) GetObject() (resp datatypes.Network_Gateway, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway", "getObject", nil, &r.Options, &resp)
@@ -4493,36 +6343,84 @@ func (r Network_Gateway) GetPrivateIpAddress() (resp datatypes.Network_Subnet_Ip
 	return
 }
 
+// GetPrivateIpAddressWith is GetPrivateIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway) GetPrivateIpAddressWith(options sl.Options) (resp datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateIpAddress()
+}
+
 // Retrieve The private VLAN for accessing this gateway.
 func (r Network_Gateway) GetPrivateVlan() (resp datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway", "getPrivateVlan", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrivateVlanWith is GetPrivateVlan, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway) GetPrivateVlanWith(options sl.Options) (resp datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateVlan()
+}
+
 // Retrieve The public gateway IP address.
 func (r Network_Gateway) GetPublicIpAddress() (resp datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway", "getPublicIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetPublicIpAddressWith is GetPublicIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway) GetPublicIpAddressWith(options sl.Options) (resp datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPublicIpAddress()
+}
+
 // Retrieve The public gateway IPv6 address.
 func (r Network_Gateway) GetPublicIpv6Address() (resp datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway", "getPublicIpv6Address", nil, &r.Options, &resp)
 	return
 }
 
+// GetPublicIpv6AddressWith is GetPublicIpv6Address, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway) GetPublicIpv6AddressWith(options sl.Options) (resp datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPublicIpv6Address()
+}
+
 // Retrieve The public VLAN for accessing this gateway.
 func (r Network_Gateway) GetPublicVlan() (resp datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway", "getPublicVlan", nil, &r.Options, &resp)
 	return
 }
 
+// GetPublicVlanWith is GetPublicVlan, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway) GetPublicVlanWith(options sl.Options) (resp datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPublicVlan()
+}
+
 // Retrieve The current status of the gateway.
 func (r Network_Gateway) GetStatus() (resp datatypes.Network_Gateway_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway", "getStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway) GetStatusWith(options sl.Options) (resp datatypes.Network_Gateway_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // Rebuild a vSRX gateway with HA cluster by destroying existing vSRX and installing new vSRX on both gateway servers, then creating HA cluster between 2 vSRX. This is a destructive process which will remove existing vSRX configuration and stop all gateway capabilities. vSRX will need to be re-configured after this operation.
 //
 //
@@ -4564,6 +6462,11 @@ func (r Network_Gateway_Member) Id(id int) Network_Gateway_Member {
 	return r
 }
 
+func (r Network_Gateway_Member) InitParameter(name string, value interface{}) Network_Gateway_Member {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Gateway_Member) Mask(mask string) Network_Gateway_Member {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4583,11 +6486,26 @@ func (r Network_Gateway_Member) Limit(limit int) Network_Gateway_Member {
 	return r
 }
 
+func (r Network_Gateway_Member) Unlimited() Network_Gateway_Member {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Gateway_Member) Offset(offset int) Network_Gateway_Member {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Gateway_Member) Timeout(timeout time.Duration) Network_Gateway_Member {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Gateway_Member) WithContext(ctx context.Context) Network_Gateway_Member {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a new hardware member on the gateway. This also asynchronously sets up the network for this member. Progress of this process can be monitored via the gateway status. All members created with this object must have no VLANs attached.
 func (r Network_Gateway_Member) CreateObject(templateObject *datatypes.Network_Gateway_Member) (resp datatypes.Network_Gateway_Member, err error) {
 	params := []interface{}{
@@ -4612,18 +6530,42 @@ func (r Network_Gateway_Member) GetAttributes() (resp datatypes.Network_Gateway_
 	return
 }
 
+// GetAttributesWith is GetAttributes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway_Member) GetAttributesWith(options sl.Options) (resp datatypes.Network_Gateway_Member_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+}
+
 // Retrieve The device for this member.
 func (r Network_Gateway_Member) GetHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway_Member", "getHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway_Member) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve The gateway this member belongs to.
 func (r Network_Gateway_Member) GetNetworkGateway() (resp datatypes.Network_Gateway, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway_Member", "getNetworkGateway", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkGatewayWith is GetNetworkGateway, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway_Member) GetNetworkGatewayWith(options sl.Options) (resp datatypes.Network_Gateway, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGateway()
+}
+
 // no documentation yet
 func (r Network_Gateway_Member) GetObject() (resp datatypes.Network_Gateway_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway_Member", "getObject", nil, &r.Options, &resp)
@@ -4646,6 +6588,11 @@ func (r Network_Gateway_Member_Attribute) Id(id int) Network_Gateway_Member_Attr
 	return r
 }
 
+func (r Network_Gateway_Member_Attribute) InitParameter(name string, value interface{}) Network_Gateway_Member_Attribute {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Gateway_Member_Attribute) Mask(mask string) Network_Gateway_Member_Attribute {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4665,17 +6612,40 @@ func (r Network_Gateway_Member_Attribute) Limit(limit int) Network_Gateway_Membe
 	return r
 }
 
+func (r Network_Gateway_Member_Attribute) Unlimited() Network_Gateway_Member_Attribute {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Gateway_Member_Attribute) Offset(offset int) Network_Gateway_Member_Attribute {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Gateway_Member_Attribute) Timeout(timeout time.Duration) Network_Gateway_Member_Attribute {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Gateway_Member_Attribute) WithContext(ctx context.Context) Network_Gateway_Member_Attribute {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The gateway member has these attributes.
 func (r Network_Gateway_Member_Attribute) GetGatewayMember() (resp datatypes.Network_Gateway_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway_Member_Attribute", "getGatewayMember", nil, &r.Options, &resp)
 	return
 }
 
+// GetGatewayMemberWith is GetGatewayMember, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway_Member_Attribute) GetGatewayMemberWith(options sl.Options) (resp datatypes.Network_Gateway_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGatewayMember()
+}
+
 // no documentation yet
 func (r Network_Gateway_Member_Attribute) GetObject() (resp datatypes.Network_Gateway_Member_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway_Member_Attribute", "getObject", nil, &r.Options, &resp)
@@ -4704,6 +6674,11 @@ func (r Network_Gateway_Status) Id(id int) Network_Gateway_Status {
 	return r
 }
 
+func (r Network_Gateway_Status) InitParameter(name string, value interface{}) Network_Gateway_Status {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Gateway_Status) Mask(mask string) Network_Gateway_Status {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4723,11 +6698,26 @@ func (r Network_Gateway_Status) Limit(limit int) Network_Gateway_Status {
 	return r
 }
 
+func (r Network_Gateway_Status) Unlimited() Network_Gateway_Status {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Gateway_Status) Offset(offset int) Network_Gateway_Status {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Gateway_Status) Timeout(timeout time.Duration) Network_Gateway_Status {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Gateway_Status) WithContext(ctx context.Context) Network_Gateway_Status {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Gateway_Status) GetObject() (resp datatypes.Network_Gateway_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway_Status", "getObject", nil, &r.Options, &resp)
@@ -4750,6 +6740,11 @@ func (r Network_Gateway_Vlan) Id(id int) Network_Gateway_Vlan {
 	return r
 }
 
+func (r Network_Gateway_Vlan) InitParameter(name string, value interface{}) Network_Gateway_Vlan {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Gateway_Vlan) Mask(mask string) Network_Gateway_Vlan {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4769,11 +6764,26 @@ func (r Network_Gateway_Vlan) Limit(limit int) Network_Gateway_Vlan {
 	return r
 }
 
+func (r Network_Gateway_Vlan) Unlimited() Network_Gateway_Vlan {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Gateway_Vlan) Offset(offset int) Network_Gateway_Vlan {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Gateway_Vlan) Timeout(timeout time.Duration) Network_Gateway_Vlan {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Gateway_Vlan) WithContext(ctx context.Context) Network_Gateway_Vlan {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Start the asynchronous process to bypass/unroute the VLAN from this gateway.
 func (r Network_Gateway_Vlan) Bypass() (err error) {
 	var resp datatypes.Void
@@ -4821,12 +6831,28 @@ func (r Network_Gateway_Vlan) GetNetworkGateway() (resp datatypes.Network_Gatewa
 	return
 }
 
+// GetNetworkGatewayWith is GetNetworkGateway, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway_Vlan) GetNetworkGatewayWith(options sl.Options) (resp datatypes.Network_Gateway, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGateway()
+}
+
 // Retrieve The network VLAN record.
 func (r Network_Gateway_Vlan) GetNetworkVlan() (resp datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway_Vlan", "getNetworkVlan", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlanWith is GetNetworkVlan, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Gateway_Vlan) GetNetworkVlanWith(options sl.Options) (resp datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlan()
+}
+
 // no documentation yet
 func (r Network_Gateway_Vlan) GetObject() (resp datatypes.Network_Gateway_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Gateway_Vlan", "getObject", nil, &r.Options, &resp)
@@ -4856,6 +6882,11 @@ func (r Network_Interconnect_Tenant) Id(id int) Network_Interconnect_Tenant {
 	return r
 }
 
+func (r Network_Interconnect_Tenant) InitParameter(name string, value interface{}) Network_Interconnect_Tenant {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Interconnect_Tenant) Mask(mask string) Network_Interconnect_Tenant {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4875,11 +6906,26 @@ func (r Network_Interconnect_Tenant) Limit(limit int) Network_Interconnect_Tenan
 	return r
 }
 
+func (r Network_Interconnect_Tenant) Unlimited() Network_Interconnect_Tenant {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Interconnect_Tenant) Offset(offset int) Network_Interconnect_Tenant {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Interconnect_Tenant) Timeout(timeout time.Duration) Network_Interconnect_Tenant {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Interconnect_Tenant) WithContext(ctx context.Context) Network_Interconnect_Tenant {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Interconnect_Tenant) AllowDeleteConnection(serviceKey *string) (resp bool, err error) {
 	params := []interface{}{
@@ -4943,6 +6989,14 @@ func (r Network_Interconnect_Tenant) GetBillingItem() (resp datatypes.Billing_It
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Interconnect_Tenant) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Network_Interconnect, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // no documentation yet
 func (r Network_Interconnect_Tenant) GetConnection(serviceKey *string, provider *string) (resp string, err error) {
 	params := []interface{}{
@@ -5053,6 +7107,11 @@ func (r Network_LBaaS_HealthMonitor) Id(id int) Network_LBaaS_HealthMonitor {
 	return r
 }
 
+func (r Network_LBaaS_HealthMonitor) InitParameter(name string, value interface{}) Network_LBaaS_HealthMonitor {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_LBaaS_HealthMonitor) Mask(mask string) Network_LBaaS_HealthMonitor {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5072,11 +7131,26 @@ func (r Network_LBaaS_HealthMonitor) Limit(limit int) Network_LBaaS_HealthMonito
 	return r
 }
 
+func (r Network_LBaaS_HealthMonitor) Unlimited() Network_LBaaS_HealthMonitor {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_LBaaS_HealthMonitor) Offset(offset int) Network_LBaaS_HealthMonitor {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_LBaaS_HealthMonitor) Timeout(timeout time.Duration) Network_LBaaS_HealthMonitor {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_LBaaS_HealthMonitor) WithContext(ctx context.Context) Network_LBaaS_HealthMonitor {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_LBaaS_HealthMonitor) GetObject() (resp datatypes.Network_LBaaS_HealthMonitor, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LBaaS_HealthMonitor", "getObject", nil, &r.Options, &resp)
@@ -5109,6 +7183,11 @@ func (r Network_LBaaS_L7Member) Id(id int) Network_LBaaS_L7Member {
 	return r
 }
 
+func (r Network_LBaaS_L7Member) InitParameter(name string, value interface{}) Network_LBaaS_L7Member {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_LBaaS_L7Member) Mask(mask string) Network_LBaaS_L7Member {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5128,11 +7207,26 @@ func (r Network_LBaaS_L7Member) Limit(limit int) Network_LBaaS_L7Member {
 	return r
 }
 
+func (r Network_LBaaS_L7Member) Unlimited() Network_LBaaS_L7Member {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_LBaaS_L7Member) Offset(offset int) Network_LBaaS_L7Member {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_LBaaS_L7Member) Timeout(timeout time.Duration) Network_LBaaS_L7Member {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_LBaaS_L7Member) WithContext(ctx context.Context) Network_LBaaS_L7Member {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Add server instances as members to a L7pool and return the LoadBalancer Object with listeners, pools and members populated
 func (r Network_LBaaS_L7Member) AddL7PoolMembers(l7PoolUuid *string, memberInstances []datatypes.Network_LBaaS_L7Member) (resp datatypes.Network_LBaaS_LoadBalancer, err error) {
 	params := []interface{}{
@@ -5185,6 +7279,11 @@ func (r Network_LBaaS_L7Policy) Id(id int) Network_LBaaS_L7Policy {
 	return r
 }
 
+func (r Network_LBaaS_L7Policy) InitParameter(name string, value interface{}) Network_LBaaS_L7Policy {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_LBaaS_L7Policy) Mask(mask string) Network_LBaaS_L7Policy {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5204,11 +7303,26 @@ func (r Network_LBaaS_L7Policy) Limit(limit int) Network_LBaaS_L7Policy {
 	return r
 }
 
+func (r Network_LBaaS_L7Policy) Unlimited() Network_LBaaS_L7Policy {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_LBaaS_L7Policy) Offset(offset int) Network_LBaaS_L7Policy {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_LBaaS_L7Policy) Timeout(timeout time.Duration) Network_LBaaS_L7Policy {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_LBaaS_L7Policy) WithContext(ctx context.Context) Network_LBaaS_L7Policy {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This function creates multiple policies with rules for the given listener.
 func (r Network_LBaaS_L7Policy) AddL7Policies(listenerUuid *string, policiesRules []datatypes.Network_LBaaS_PolicyRule) (resp datatypes.Network_LBaaS_LoadBalancer, err error) {
 	params := []interface{}{
@@ -5262,6 +7376,11 @@ func (r Network_LBaaS_L7Pool) Id(id int) Network_LBaaS_L7Pool {
 	return r
 }
 
+func (r Network_LBaaS_L7Pool) InitParameter(name string, value interface{}) Network_LBaaS_L7Pool {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_LBaaS_L7Pool) Mask(mask string) Network_LBaaS_L7Pool {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5281,11 +7400,26 @@ func (r Network_LBaaS_L7Pool) Limit(limit int) Network_LBaaS_L7Pool {
 	return r
 }
 
+func (r Network_LBaaS_L7Pool) Unlimited() Network_LBaaS_L7Pool {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_LBaaS_L7Pool) Offset(offset int) Network_LBaaS_L7Pool {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_LBaaS_L7Pool) Timeout(timeout time.Duration) Network_LBaaS_L7Pool {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_LBaaS_L7Pool) WithContext(ctx context.Context) Network_LBaaS_L7Pool {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a backend to be used for L7 load balancing. This L7 pool has backend protocol, L7 members, L7 health monitor and session affinity. L7 pool is associated with L7 policies.
 func (r Network_LBaaS_L7Pool) CreateL7Pool(loadBalancerUuid *string, l7Pool *datatypes.Network_LBaaS_L7Pool, l7Members []datatypes.Network_LBaaS_L7Member, l7HealthMonitor *datatypes.Network_LBaaS_L7HealthMonitor, l7SessionAffinity *datatypes.Network_LBaaS_L7SessionAffinity) (resp datatypes.Network_LBaaS_LoadBalancer, err error) {
 	params := []interface{}{
@@ -5372,6 +7506,11 @@ func (r Network_LBaaS_L7Rule) Id(id int) Network_LBaaS_L7Rule {
 	return r
 }
 
+func (r Network_LBaaS_L7Rule) InitParameter(name string, value interface{}) Network_LBaaS_L7Rule {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_LBaaS_L7Rule) Mask(mask string) Network_LBaaS_L7Rule {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5391,11 +7530,26 @@ func (r Network_LBaaS_L7Rule) Limit(limit int) Network_LBaaS_L7Rule {
 	return r
 }
 
+func (r Network_LBaaS_L7Rule) Unlimited() Network_LBaaS_L7Rule {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_LBaaS_L7Rule) Offset(offset int) Network_LBaaS_L7Rule {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_LBaaS_L7Rule) Timeout(timeout time.Duration) Network_LBaaS_L7Rule {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_LBaaS_L7Rule) WithContext(ctx context.Context) Network_LBaaS_L7Rule {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This function creates and adds multiple Rules to a given L7 policy with all the details provided for rules
 func (r Network_LBaaS_L7Rule) AddL7Rules(policyUuid *string, rules []datatypes.Network_LBaaS_L7Rule) (resp datatypes.Network_LBaaS_LoadBalancer, err error) {
 	params := []interface{}{
@@ -5448,6 +7602,11 @@ func (r Network_LBaaS_Listener) Id(id int) Network_LBaaS_Listener {
 	return r
 }
 
+func (r Network_LBaaS_Listener) InitParameter(name string, value interface{}) Network_LBaaS_Listener {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_LBaaS_Listener) Mask(mask string) Network_LBaaS_Listener {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5467,11 +7626,26 @@ func (r Network_LBaaS_Listener) Limit(limit int) Network_LBaaS_Listener {
 	return r
 }
 
+func (r Network_LBaaS_Listener) Unlimited() Network_LBaaS_Listener {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_LBaaS_Listener) Offset(offset int) Network_LBaaS_Listener {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_LBaaS_Listener) Timeout(timeout time.Duration) Network_LBaaS_Listener {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_LBaaS_Listener) WithContext(ctx context.Context) Network_LBaaS_Listener {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Delete load balancers front- and backend protocols and return load balancer object with listeners (frontend), pools (backend), server instances (members) and datacenter populated.
 func (r Network_LBaaS_Listener) DeleteLoadBalancerProtocols(loadBalancerUuid *string, listenerUuids []string) (resp datatypes.Network_LBaaS_LoadBalancer, err error) {
 	params := []interface{}{
@@ -5526,6 +7700,11 @@ func (r Network_LBaaS_LoadBalancer) Id(id int) Network_LBaaS_LoadBalancer {
 	return r
 }
 
+func (r Network_LBaaS_LoadBalancer) InitParameter(name string, value interface{}) Network_LBaaS_LoadBalancer {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_LBaaS_LoadBalancer) Mask(mask string) Network_LBaaS_LoadBalancer {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5545,11 +7724,26 @@ func (r Network_LBaaS_LoadBalancer) Limit(limit int) Network_LBaaS_LoadBalancer
 	return r
 }
 
+func (r Network_LBaaS_LoadBalancer) Unlimited() Network_LBaaS_LoadBalancer {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_LBaaS_LoadBalancer) Offset(offset int) Network_LBaaS_LoadBalancer {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_LBaaS_LoadBalancer) Timeout(timeout time.Duration) Network_LBaaS_LoadBalancer {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_LBaaS_LoadBalancer) WithContext(ctx context.Context) Network_LBaaS_LoadBalancer {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Cancel a load balancer with the given uuid. The billing system will execute the deletion of load balancer and all objects associated with it such as load balancer appliances, listeners, pools and members in the background.
 func (r Network_LBaaS_LoadBalancer) CancelLoadBalancer(uuid *string) (resp bool, err error) {
 	params := []interface{}{
@@ -5571,18 +7765,42 @@ func (r Network_LBaaS_LoadBalancer) GetDatacenter() (resp datatypes.Location, er
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LBaaS_LoadBalancer) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // Retrieve Health monitors for the backend members.
 func (r Network_LBaaS_LoadBalancer) GetHealthMonitors() (resp []datatypes.Network_LBaaS_HealthMonitor, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LBaaS_LoadBalancer", "getHealthMonitors", nil, &r.Options, &resp)
 	return
 }
 
+// GetHealthMonitorsWith is GetHealthMonitors, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LBaaS_LoadBalancer) GetHealthMonitorsWith(options sl.Options) (resp []datatypes.Network_LBaaS_HealthMonitor, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHealthMonitors()
+}
+
 // Retrieve L7Pools for load balancer.
 func (r Network_LBaaS_LoadBalancer) GetL7Pools() (resp []datatypes.Network_LBaaS_L7Pool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LBaaS_LoadBalancer", "getL7Pools", nil, &r.Options, &resp)
 	return
 }
 
+// GetL7PoolsWith is GetL7Pools, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LBaaS_LoadBalancer) GetL7PoolsWith(options sl.Options) (resp []datatypes.Network_LBaaS_L7Pool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetL7Pools()
+}
+
 // Return listener time series datapoints. The time series data is available for Throughput, ConnectionRate and ActiveConnections. Throughput is in bits per second. The values are an average over the time range. The time series data is available for 1hour, 6hours, 12hours, 1day, 1week or 2weeks.
 //
 //
@@ -5603,6 +7821,14 @@ func (r Network_LBaaS_LoadBalancer) GetListeners() (resp []datatypes.Network_LBa
 	return
 }
 
+// GetListenersWith is GetListeners, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LBaaS_LoadBalancer) GetListenersWith(options sl.Options) (resp []datatypes.Network_LBaaS_Listener, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetListeners()
+}
+
 // Get the load balancer object with given uuid.
 func (r Network_LBaaS_LoadBalancer) GetLoadBalancer(uuid *string) (resp datatypes.Network_LBaaS_LoadBalancer, err error) {
 	params := []interface{}{
@@ -5636,6 +7862,14 @@ func (r Network_LBaaS_LoadBalancer) GetMembers() (resp []datatypes.Network_LBaaS
 	return
 }
 
+// GetMembersWith is GetMembers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LBaaS_LoadBalancer) GetMembersWith(options sl.Options) (resp []datatypes.Network_LBaaS_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMembers()
+}
+
 // no documentation yet
 func (r Network_LBaaS_LoadBalancer) GetObject() (resp datatypes.Network_LBaaS_LoadBalancer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LBaaS_LoadBalancer", "getObject", nil, &r.Options, &resp)
@@ -5648,6 +7882,14 @@ func (r Network_LBaaS_LoadBalancer) GetSslCiphers() (resp []datatypes.Network_LB
 	return
 }
 
+// GetSslCiphersWith is GetSslCiphers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LBaaS_LoadBalancer) GetSslCiphersWith(options sl.Options) (resp []datatypes.Network_LBaaS_SSLCipher, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSslCiphers()
+}
+
 // no documentation yet
 func (r Network_LBaaS_LoadBalancer) ServiceLoadBalancer(data *string) (resp datatypes.Network_LBaaS_LoadBalancer, err error) {
 	params := []interface{}{
@@ -5693,6 +7935,11 @@ func (r Network_LBaaS_Member) Id(id int) Network_LBaaS_Member {
 	return r
 }
 
+func (r Network_LBaaS_Member) InitParameter(name string, value interface{}) Network_LBaaS_Member {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_LBaaS_Member) Mask(mask string) Network_LBaaS_Member {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5712,11 +7959,26 @@ func (r Network_LBaaS_Member) Limit(limit int) Network_LBaaS_Member {
 	return r
 }
 
+func (r Network_LBaaS_Member) Unlimited() Network_LBaaS_Member {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_LBaaS_Member) Offset(offset int) Network_LBaaS_Member {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_LBaaS_Member) Timeout(timeout time.Duration) Network_LBaaS_Member {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_LBaaS_Member) WithContext(ctx context.Context) Network_LBaaS_Member {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Add server instances as members to load balancer and return it with listeners, pools and members populated
 func (r Network_LBaaS_Member) AddLoadBalancerMembers(loadBalancerUuid *string, serverInstances []datatypes.Network_LBaaS_LoadBalancerServerInstanceInfo) (resp datatypes.Network_LBaaS_LoadBalancer, err error) {
 	params := []interface{}{
@@ -5771,6 +8033,11 @@ func (r Network_LBaaS_SSLCipher) Id(id int) Network_LBaaS_SSLCipher {
 	return r
 }
 
+func (r Network_LBaaS_SSLCipher) InitParameter(name string, value interface{}) Network_LBaaS_SSLCipher {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_LBaaS_SSLCipher) Mask(mask string) Network_LBaaS_SSLCipher {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5790,11 +8057,26 @@ func (r Network_LBaaS_SSLCipher) Limit(limit int) Network_LBaaS_SSLCipher {
 	return r
 }
 
+func (r Network_LBaaS_SSLCipher) Unlimited() Network_LBaaS_SSLCipher {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_LBaaS_SSLCipher) Offset(offset int) Network_LBaaS_SSLCipher {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_LBaaS_SSLCipher) Timeout(timeout time.Duration) Network_LBaaS_SSLCipher {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_LBaaS_SSLCipher) WithContext(ctx context.Context) Network_LBaaS_SSLCipher {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Returns all supported cipher list
 func (r Network_LBaaS_SSLCipher) GetAllObjects() (resp []datatypes.Network_LBaaS_SSLCipher, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LBaaS_SSLCipher", "getAllObjects", nil, &r.Options, &resp)
@@ -5823,6 +8105,11 @@ func (r Network_LoadBalancer_Global_Account) Id(id int) Network_LoadBalancer_Glo
 	return r
 }
 
+func (r Network_LoadBalancer_Global_Account) InitParameter(name string, value interface{}) Network_LoadBalancer_Global_Account {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_LoadBalancer_Global_Account) Mask(mask string) Network_LoadBalancer_Global_Account {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5842,11 +8129,26 @@ func (r Network_LoadBalancer_Global_Account) Limit(limit int) Network_LoadBalanc
 	return r
 }
 
+func (r Network_LoadBalancer_Global_Account) Unlimited() Network_LoadBalancer_Global_Account {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_LoadBalancer_Global_Account) Offset(offset int) Network_LoadBalancer_Global_Account {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_LoadBalancer_Global_Account) Timeout(timeout time.Duration) Network_LoadBalancer_Global_Account {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_LoadBalancer_Global_Account) WithContext(ctx context.Context) Network_LoadBalancer_Global_Account {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // If your globally load balanced domain is hosted on the SoftLayer nameservers this method will add the required NS resource record to your DNS zone file and remove any A records that match the host portion of a global load balancer account hostname.  A NS resource record is required to be able to use your SoftLayer global load balancer account. Please make sure the zone file for the hostname listed on your SoftLayer global load balancer account is setup prior to using this method.  If your globally load balanced domain is hosted on any other nameservers this method will not be able to add the required NS record.
 func (r Network_LoadBalancer_Global_Account) AddNsRecord() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_Global_Account", "addNsRecord", nil, &r.Options, &resp)
@@ -5893,30 +8195,70 @@ func (r Network_LoadBalancer_Global_Account) GetAccount() (resp datatypes.Accoun
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LoadBalancer_Global_Account) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The current billing item for a Global Load Balancer account.
 func (r Network_LoadBalancer_Global_Account) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_Global_Account", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LoadBalancer_Global_Account) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The hosts in the load balancing pool for a global load balancer account.
 func (r Network_LoadBalancer_Global_Account) GetHosts() (resp []datatypes.Network_LoadBalancer_Global_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_Global_Account", "getHosts", nil, &r.Options, &resp)
 	return
 }
 
+// GetHostsWith is GetHosts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LoadBalancer_Global_Account) GetHostsWith(options sl.Options) (resp []datatypes.Network_LoadBalancer_Global_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHosts()
+}
+
 // Retrieve The load balance method of a global load balancer account
 func (r Network_LoadBalancer_Global_Account) GetLoadBalanceType() (resp datatypes.Network_LoadBalancer_Global_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_Global_Account", "getLoadBalanceType", nil, &r.Options, &resp)
 	return
 }
 
+// GetLoadBalanceTypeWith is GetLoadBalanceType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LoadBalancer_Global_Account) GetLoadBalanceTypeWith(options sl.Options) (resp datatypes.Network_LoadBalancer_Global_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLoadBalanceType()
+}
+
 // Retrieve A flag indicating that the global load balancer is a managed resource.
 func (r Network_LoadBalancer_Global_Account) GetManagedResourceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_Global_Account", "getManagedResourceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetManagedResourceFlagWith is GetManagedResourceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LoadBalancer_Global_Account) GetManagedResourceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedResourceFlag()
+}
+
 // getObject retrieves the SoftLayer_Network_LoadBalancer_Global_Account object whose ID number corresponds to the ID number of the init paramater passed to the SoftLayer_Network_LoadBalancer_Global_Account service. You can only retrieve a global load balancer account that is assigned to your SoftLayer customer account.
 func (r Network_LoadBalancer_Global_Account) GetObject() (resp datatypes.Network_LoadBalancer_Global_Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_Global_Account", "getObject", nil, &r.Options, &resp)
@@ -5949,6 +8291,11 @@ func (r Network_LoadBalancer_Global_Host) Id(id int) Network_LoadBalancer_Global
 	return r
 }
 
+func (r Network_LoadBalancer_Global_Host) InitParameter(name string, value interface{}) Network_LoadBalancer_Global_Host {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_LoadBalancer_Global_Host) Mask(mask string) Network_LoadBalancer_Global_Host {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -5968,11 +8315,26 @@ func (r Network_LoadBalancer_Global_Host) Limit(limit int) Network_LoadBalancer_
 	return r
 }
 
+func (r Network_LoadBalancer_Global_Host) Unlimited() Network_LoadBalancer_Global_Host {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_LoadBalancer_Global_Host) Offset(offset int) Network_LoadBalancer_Global_Host {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_LoadBalancer_Global_Host) Timeout(timeout time.Duration) Network_LoadBalancer_Global_Host {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_LoadBalancer_Global_Host) WithContext(ctx context.Context) Network_LoadBalancer_Global_Host {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Remove a host from the load balancing pool of a global load balancer account.
 func (r Network_LoadBalancer_Global_Host) DeleteObject() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_Global_Host", "deleteObject", nil, &r.Options, &resp)
@@ -5985,6 +8347,14 @@ func (r Network_LoadBalancer_Global_Host) GetLoadBalancerAccount() (resp datatyp
 	return
 }
 
+// GetLoadBalancerAccountWith is GetLoadBalancerAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LoadBalancer_Global_Host) GetLoadBalancerAccountWith(options sl.Options) (resp datatypes.Network_LoadBalancer_Global_Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLoadBalancerAccount()
+}
+
 // getObject retrieves the SoftLayer_Network_LoadBalancer_Global_Host object whose ID number corresponds to the ID number of the init paramater passed to the SoftLayer_Network_LoadBalancer_Global_Host service. You can only retrieve a global load balancer host that is assigned to your SoftLayer global load balancer account.
 func (r Network_LoadBalancer_Global_Host) GetObject() (resp datatypes.Network_LoadBalancer_Global_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_Global_Host", "getObject", nil, &r.Options, &resp)
@@ -6009,6 +8379,11 @@ func (r Network_LoadBalancer_Service) Id(id int) Network_LoadBalancer_Service {
 	return r
 }
 
+func (r Network_LoadBalancer_Service) InitParameter(name string, value interface{}) Network_LoadBalancer_Service {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_LoadBalancer_Service) Mask(mask string) Network_LoadBalancer_Service {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -6028,11 +8403,26 @@ func (r Network_LoadBalancer_Service) Limit(limit int) Network_LoadBalancer_Serv
 	return r
 }
 
+func (r Network_LoadBalancer_Service) Unlimited() Network_LoadBalancer_Service {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_LoadBalancer_Service) Offset(offset int) Network_LoadBalancer_Service {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_LoadBalancer_Service) Timeout(timeout time.Duration) Network_LoadBalancer_Service {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_LoadBalancer_Service) WithContext(ctx context.Context) Network_LoadBalancer_Service {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Calling deleteObject on a particular server will remove it from the load balancer.  This is the only way to remove a service from your load balancer.  If you wish to remove a server, first call this function, then reload the virtualIpAddress object and edit the remaining services to reflect the other changes that you wish to make.
 func (r Network_LoadBalancer_Service) DeleteObject() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_Service", "deleteObject", nil, &r.Options, &resp)
@@ -6084,6 +8474,14 @@ func (r Network_LoadBalancer_Service) GetVip() (resp datatypes.Network_LoadBalan
 	return
 }
 
+// GetVipWith is GetVip, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LoadBalancer_Service) GetVipWith(options sl.Options) (resp datatypes.Network_LoadBalancer_VirtualIpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVip()
+}
+
 // Calling resetPeakConnections will set the peakConnections variable to zero on this particular object. Peak connections will continue to increase normally after this method call, it will only temporarily reset the statistic to zero, until the next time it is polled.
 func (r Network_LoadBalancer_Service) ResetPeakConnections() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_Service", "resetPeakConnections", nil, &r.Options, &resp)
@@ -6108,6 +8506,11 @@ func (r Network_LoadBalancer_VirtualIpAddress) Id(id int) Network_LoadBalancer_V
 	return r
 }
 
+func (r Network_LoadBalancer_VirtualIpAddress) InitParameter(name string, value interface{}) Network_LoadBalancer_VirtualIpAddress {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_LoadBalancer_VirtualIpAddress) Mask(mask string) Network_LoadBalancer_VirtualIpAddress {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -6127,11 +8530,26 @@ func (r Network_LoadBalancer_VirtualIpAddress) Limit(limit int) Network_LoadBala
 	return r
 }
 
+func (r Network_LoadBalancer_VirtualIpAddress) Unlimited() Network_LoadBalancer_VirtualIpAddress {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_LoadBalancer_VirtualIpAddress) Offset(offset int) Network_LoadBalancer_VirtualIpAddress {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_LoadBalancer_VirtualIpAddress) Timeout(timeout time.Duration) Network_LoadBalancer_VirtualIpAddress {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_LoadBalancer_VirtualIpAddress) WithContext(ctx context.Context) Network_LoadBalancer_VirtualIpAddress {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Disable a Virtual IP Address, removing it from load balancer rotation and denying all connections to that IP address.
 func (r Network_LoadBalancer_VirtualIpAddress) Disable() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_VirtualIpAddress", "disable", nil, &r.Options, &resp)
@@ -6159,24 +8577,56 @@ func (r Network_LoadBalancer_VirtualIpAddress) GetAccount() (resp datatypes.Acco
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LoadBalancer_VirtualIpAddress) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The current billing item for the Load Balancer.
 func (r Network_LoadBalancer_VirtualIpAddress) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_VirtualIpAddress", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LoadBalancer_VirtualIpAddress) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve If false, this VIP and associated services may be edited via the portal or the API. If true, you must configure this VIP manually on the device.
 func (r Network_LoadBalancer_VirtualIpAddress) GetCustomerManagedFlag() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_VirtualIpAddress", "getCustomerManagedFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetCustomerManagedFlagWith is GetCustomerManagedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LoadBalancer_VirtualIpAddress) GetCustomerManagedFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCustomerManagedFlag()
+}
+
 // Retrieve A flag indicating that the load balancer is a managed resource.
 func (r Network_LoadBalancer_VirtualIpAddress) GetManagedResourceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_VirtualIpAddress", "getManagedResourceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetManagedResourceFlagWith is GetManagedResourceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LoadBalancer_VirtualIpAddress) GetManagedResourceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedResourceFlag()
+}
+
 // getObject retrieves the SoftLayer_Network_LoadBalancer_VirtualIpAddress object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Network_LoadBalancer_VirtualIpAddress service. You can only retrieve Load Balancers assigned to your account.
 func (r Network_LoadBalancer_VirtualIpAddress) GetObject() (resp datatypes.Network_LoadBalancer_VirtualIpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_VirtualIpAddress", "getObject", nil, &r.Options, &resp)
@@ -6189,6 +8639,14 @@ func (r Network_LoadBalancer_VirtualIpAddress) GetServices() (resp []datatypes.N
 	return
 }
 
+// GetServicesWith is GetServices, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_LoadBalancer_VirtualIpAddress) GetServicesWith(options sl.Options) (resp []datatypes.Network_LoadBalancer_Service, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServices()
+}
+
 // Quickly remove all active external connections to a Virtual IP Address.
 func (r Network_LoadBalancer_VirtualIpAddress) KickAllConnections() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_LoadBalancer_VirtualIpAddress", "kickAllConnections", nil, &r.Options, &resp)
@@ -6217,6 +8675,11 @@ func (r Network_Media_Transcode_Account) Id(id int) Network_Media_Transcode_Acco
 	return r
 }
 
+func (r Network_Media_Transcode_Account) InitParameter(name string, value interface{}) Network_Media_Transcode_Account {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Media_Transcode_Account) Mask(mask string) Network_Media_Transcode_Account {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -6236,11 +8699,26 @@ func (r Network_Media_Transcode_Account) Limit(limit int) Network_Media_Transcod
 	return r
 }
 
-func (r Network_Media_Transcode_Account) Offset(offset int) Network_Media_Transcode_Account {
+func (r Network_Media_Transcode_Account) Unlimited() Network_Media_Transcode_Account {
+	r.Options.Unlimited = true
+	return r
+}
+
+func (r Network_Media_Transcode_Account) Offset(offset int) Network_Media_Transcode_Account {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Media_Transcode_Account) Timeout(timeout time.Duration) Network_Media_Transcode_Account {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Media_Transcode_Account) WithContext(ctx context.Context) Network_Media_Transcode_Account {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // With this method, you can create a transcode account.  Individual SoftLayer account can have a single Transcode account. You have to pass your SoftLayer account id as a parameter.
 func (r Network_Media_Transcode_Account) CreateTranscodeAccount() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Media_Transcode_Account", "createTranscodeAccount", nil, &r.Options, &resp)
@@ -6288,6 +8766,14 @@ func (r Network_Media_Transcode_Account) GetAccount() (resp datatypes.Account, e
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Media_Transcode_Account) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // This method returns a collection of SoftLayer_Container_Network_Ftp_Directory objects. You can retrieve directory information for /in and /out directories. A [[SoftLayer_Container_Network_Directory_Listing|Directory Listing]] object contains a type (indicating whether it is a file or a directory), name and file count if it is a directory.
 func (r Network_Media_Transcode_Account) GetDirectoryInformation(directoryName *string, extensionFilter *string) (resp []datatypes.Container_Network_Directory_Listing, err error) {
 	params := []interface{}{
@@ -6342,6 +8828,14 @@ func (r Network_Media_Transcode_Account) GetTranscodeJobs() (resp []datatypes.Ne
 	return
 }
 
+// GetTranscodeJobsWith is GetTranscodeJobs, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Media_Transcode_Account) GetTranscodeJobsWith(options sl.Options) (resp []datatypes.Network_Media_Transcode_Job, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTranscodeJobs()
+}
+
 // The SoftLayer_Network_Media_Transcode_Job contains information regarding a transcode job such as input file, output format, user id and so on.
 type Network_Media_Transcode_Job struct {
 	Session *session.Session
@@ -6358,6 +8852,11 @@ func (r Network_Media_Transcode_Job) Id(id int) Network_Media_Transcode_Job {
 	return r
 }
 
+func (r Network_Media_Transcode_Job) InitParameter(name string, value interface{}) Network_Media_Transcode_Job {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Media_Transcode_Job) Mask(mask string) Network_Media_Transcode_Job {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -6377,11 +8876,26 @@ func (r Network_Media_Transcode_Job) Limit(limit int) Network_Media_Transcode_Jo
 	return r
 }
 
+func (r Network_Media_Transcode_Job) Unlimited() Network_Media_Transcode_Job {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Media_Transcode_Job) Offset(offset int) Network_Media_Transcode_Job {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Media_Transcode_Job) Timeout(timeout time.Duration) Network_Media_Transcode_Job {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Media_Transcode_Job) WithContext(ctx context.Context) Network_Media_Transcode_Job {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // With this method, you can create a transcode job.
 //
 // The very first step of creating a transcode job is to upload your media files to the /in directory on your Transcode FTP space. Then, you have to pass a [[SoftLayer_Network_Media_Transcode_Job|Transcode job]] object as a parameter for this method.
@@ -6433,24 +8947,56 @@ func (r Network_Media_Transcode_Job) GetTranscodeAccount() (resp datatypes.Netwo
 	return
 }
 
+// GetTranscodeAccountWith is GetTranscodeAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Media_Transcode_Job) GetTranscodeAccountWith(options sl.Options) (resp datatypes.Network_Media_Transcode_Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTranscodeAccount()
+}
+
 // Retrieve The status information of a transcode job
 func (r Network_Media_Transcode_Job) GetTranscodeStatus() (resp datatypes.Network_Media_Transcode_Job_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Media_Transcode_Job", "getTranscodeStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetTranscodeStatusWith is GetTranscodeStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Media_Transcode_Job) GetTranscodeStatusWith(options sl.Options) (resp datatypes.Network_Media_Transcode_Job_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTranscodeStatus()
+}
+
 // Retrieve The status of a transcode job
 func (r Network_Media_Transcode_Job) GetTranscodeStatusName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Media_Transcode_Job", "getTranscodeStatusName", nil, &r.Options, &resp)
 	return
 }
 
+// GetTranscodeStatusNameWith is GetTranscodeStatusName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Media_Transcode_Job) GetTranscodeStatusNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTranscodeStatusName()
+}
+
 // Retrieve The SoftLayer user that created the transcode job
 func (r Network_Media_Transcode_Job) GetUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Media_Transcode_Job", "getUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserWith is GetUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Media_Transcode_Job) GetUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUser()
+}
+
 // The SoftLayer_Network_Media_Transcode_Job_Status contains information on a transcode job status.
 type Network_Media_Transcode_Job_Status struct {
 	Session *session.Session
@@ -6467,6 +9013,11 @@ func (r Network_Media_Transcode_Job_Status) Id(id int) Network_Media_Transcode_J
 	return r
 }
 
+func (r Network_Media_Transcode_Job_Status) InitParameter(name string, value interface{}) Network_Media_Transcode_Job_Status {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Media_Transcode_Job_Status) Mask(mask string) Network_Media_Transcode_Job_Status {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -6486,11 +9037,26 @@ func (r Network_Media_Transcode_Job_Status) Limit(limit int) Network_Media_Trans
 	return r
 }
 
+func (r Network_Media_Transcode_Job_Status) Unlimited() Network_Media_Transcode_Job_Status {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Media_Transcode_Job_Status) Offset(offset int) Network_Media_Transcode_Job_Status {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Media_Transcode_Job_Status) Timeout(timeout time.Duration) Network_Media_Transcode_Job_Status {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Media_Transcode_Job_Status) WithContext(ctx context.Context) Network_Media_Transcode_Job_Status {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method returns all transcode job statuses.
 func (r Network_Media_Transcode_Job_Status) GetAllStatuses() (resp []datatypes.Network_Media_Transcode_Job_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Media_Transcode_Job_Status", "getAllStatuses", nil, &r.Options, &resp)
@@ -6519,6 +9085,11 @@ func (r Network_Message_Delivery) Id(id int) Network_Message_Delivery {
 	return r
 }
 
+func (r Network_Message_Delivery) InitParameter(name string, value interface{}) Network_Message_Delivery {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Message_Delivery) Mask(mask string) Network_Message_Delivery {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -6538,11 +9109,26 @@ func (r Network_Message_Delivery) Limit(limit int) Network_Message_Delivery {
 	return r
 }
 
+func (r Network_Message_Delivery) Unlimited() Network_Message_Delivery {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Message_Delivery) Offset(offset int) Network_Message_Delivery {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Message_Delivery) Timeout(timeout time.Duration) Network_Message_Delivery {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Message_Delivery) WithContext(ctx context.Context) Network_Message_Delivery {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Message_Delivery) EditObject(templateObject *datatypes.Network_Message_Delivery) (resp bool, err error) {
 	params := []interface{}{
@@ -6558,12 +9144,28 @@ func (r Network_Message_Delivery) GetAccount() (resp datatypes.Account, err erro
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Message_Delivery) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The billing item for a network message delivery account.
 func (r Network_Message_Delivery) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Message_Delivery", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Message_Delivery) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // no documentation yet
 func (r Network_Message_Delivery) GetObject() (resp datatypes.Network_Message_Delivery, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Message_Delivery", "getObject", nil, &r.Options, &resp)
@@ -6576,12 +9178,28 @@ func (r Network_Message_Delivery) GetType() (resp datatypes.Network_Message_Deli
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Message_Delivery) GetTypeWith(options sl.Options) (resp datatypes.Network_Message_Delivery_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Retrieve The vendor for a network message delivery account.
 func (r Network_Message_Delivery) GetVendor() (resp datatypes.Network_Message_Delivery_Vendor, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Message_Delivery", "getVendor", nil, &r.Options, &resp)
 	return
 }
 
+// GetVendorWith is GetVendor, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Message_Delivery) GetVendorWith(options sl.Options) (resp datatypes.Network_Message_Delivery_Vendor, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVendor()
+}
+
 // no documentation yet
 type Network_Message_Delivery_Email_Sendgrid struct {
 	Session *session.Session
@@ -6598,6 +9216,11 @@ func (r Network_Message_Delivery_Email_Sendgrid) Id(id int) Network_Message_Deli
 	return r
 }
 
+func (r Network_Message_Delivery_Email_Sendgrid) InitParameter(name string, value interface{}) Network_Message_Delivery_Email_Sendgrid {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Message_Delivery_Email_Sendgrid) Mask(mask string) Network_Message_Delivery_Email_Sendgrid {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -6617,11 +9240,26 @@ func (r Network_Message_Delivery_Email_Sendgrid) Limit(limit int) Network_Messag
 	return r
 }
 
+func (r Network_Message_Delivery_Email_Sendgrid) Unlimited() Network_Message_Delivery_Email_Sendgrid {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Message_Delivery_Email_Sendgrid) Offset(offset int) Network_Message_Delivery_Email_Sendgrid {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Message_Delivery_Email_Sendgrid) Timeout(timeout time.Duration) Network_Message_Delivery_Email_Sendgrid {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Message_Delivery_Email_Sendgrid) WithContext(ctx context.Context) Network_Message_Delivery_Email_Sendgrid {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Message_Delivery_Email_Sendgrid) AddUnsubscribeEmailAddress(emailAddress *string) (resp bool, err error) {
 	params := []interface{}{
@@ -6668,6 +9306,14 @@ func (r Network_Message_Delivery_Email_Sendgrid) GetAccount() (resp datatypes.Ac
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Message_Delivery_Email_Sendgrid) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // no documentation yet
 func (r Network_Message_Delivery_Email_Sendgrid) GetAccountOverview() (resp datatypes.Container_Network_Message_Delivery_Email_Sendgrid_Account_Overview, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Message_Delivery_Email_Sendgrid", "getAccountOverview", nil, &r.Options, &resp)
@@ -6680,6 +9326,14 @@ func (r Network_Message_Delivery_Email_Sendgrid) GetBillingItem() (resp datatype
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Message_Delivery_Email_Sendgrid) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // no documentation yet
 func (r Network_Message_Delivery_Email_Sendgrid) GetCategoryList() (resp []string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Message_Delivery_Email_Sendgrid", "getCategoryList", nil, &r.Options, &resp)
@@ -6692,6 +9346,14 @@ func (r Network_Message_Delivery_Email_Sendgrid) GetEmailAddress() (resp string,
 	return
 }
 
+// GetEmailAddressWith is GetEmailAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Message_Delivery_Email_Sendgrid) GetEmailAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEmailAddress()
+}
+
 // no documentation yet
 func (r Network_Message_Delivery_Email_Sendgrid) GetEmailList(list *string) (resp []datatypes.Container_Network_Message_Delivery_Email_Sendgrid_List_Entry, err error) {
 	params := []interface{}{
@@ -6713,6 +9375,14 @@ func (r Network_Message_Delivery_Email_Sendgrid) GetSmtpAccess() (resp string, e
 	return
 }
 
+// GetSmtpAccessWith is GetSmtpAccess, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Message_Delivery_Email_Sendgrid) GetSmtpAccessWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSmtpAccess()
+}
+
 // no documentation yet
 func (r Network_Message_Delivery_Email_Sendgrid) GetStatistics(options *datatypes.Container_Network_Message_Delivery_Email_Sendgrid_Statistics_Options) (resp []datatypes.Container_Network_Message_Delivery_Email_Sendgrid_Statistics, err error) {
 	params := []interface{}{
@@ -6737,12 +9407,28 @@ func (r Network_Message_Delivery_Email_Sendgrid) GetType() (resp datatypes.Netwo
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Message_Delivery_Email_Sendgrid) GetTypeWith(options sl.Options) (resp datatypes.Network_Message_Delivery_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Retrieve The vendor for a network message delivery account.
 func (r Network_Message_Delivery_Email_Sendgrid) GetVendor() (resp datatypes.Network_Message_Delivery_Vendor, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Message_Delivery_Email_Sendgrid", "getVendor", nil, &r.Options, &resp)
 	return
 }
 
+// GetVendorWith is GetVendor, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Message_Delivery_Email_Sendgrid) GetVendorWith(options sl.Options) (resp datatypes.Network_Message_Delivery_Vendor, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVendor()
+}
+
 // no documentation yet
 func (r Network_Message_Delivery_Email_Sendgrid) GetVendorPortalUrl() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Message_Delivery_Email_Sendgrid", "getVendorPortalUrl", nil, &r.Options, &resp)
@@ -6783,6 +9469,11 @@ func (r Network_Monitor) Id(id int) Network_Monitor {
 	return r
 }
 
+func (r Network_Monitor) InitParameter(name string, value interface{}) Network_Monitor {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Monitor) Mask(mask string) Network_Monitor {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -6802,11 +9493,26 @@ func (r Network_Monitor) Limit(limit int) Network_Monitor {
 	return r
 }
 
+func (r Network_Monitor) Unlimited() Network_Monitor {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Monitor) Offset(offset int) Network_Monitor {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Monitor) Timeout(timeout time.Duration) Network_Monitor {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Monitor) WithContext(ctx context.Context) Network_Monitor {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This will return an arrayObject of objects containing the ipaddresses.  Using an string parameter you can send a partial ipaddress to search within a given ipaddress.  You can also set the max limit as well using the setting the resultLimit.
 func (r Network_Monitor) GetIpAddressesByHardware(hardware *datatypes.Hardware, partialIpAddress *string) (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	params := []interface{}{
@@ -6843,6 +9549,11 @@ func (r Network_Monitor_Version1_Query_Host) Id(id int) Network_Monitor_Version1
 	return r
 }
 
+func (r Network_Monitor_Version1_Query_Host) InitParameter(name string, value interface{}) Network_Monitor_Version1_Query_Host {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Monitor_Version1_Query_Host) Mask(mask string) Network_Monitor_Version1_Query_Host {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -6862,11 +9573,26 @@ func (r Network_Monitor_Version1_Query_Host) Limit(limit int) Network_Monitor_Ve
 	return r
 }
 
+func (r Network_Monitor_Version1_Query_Host) Unlimited() Network_Monitor_Version1_Query_Host {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Monitor_Version1_Query_Host) Offset(offset int) Network_Monitor_Version1_Query_Host {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Monitor_Version1_Query_Host) Timeout(timeout time.Duration) Network_Monitor_Version1_Query_Host {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Monitor_Version1_Query_Host) WithContext(ctx context.Context) Network_Monitor_Version1_Query_Host {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Passing in an unsaved instances of a Query_Host object into this function will create the object and return the results to the user.
 func (r Network_Monitor_Version1_Query_Host) CreateObject(templateObject *datatypes.Network_Monitor_Version1_Query_Host) (resp datatypes.Network_Monitor_Version1_Query_Host, err error) {
 	params := []interface{}{
@@ -6935,12 +9661,28 @@ func (r Network_Monitor_Version1_Query_Host) GetHardware() (resp datatypes.Hardw
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Monitor_Version1_Query_Host) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve The most recent result for this particular monitoring instance.
 func (r Network_Monitor_Version1_Query_Host) GetLastResult() (resp datatypes.Network_Monitor_Version1_Query_Result, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Monitor_Version1_Query_Host", "getLastResult", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastResultWith is GetLastResult, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Monitor_Version1_Query_Host) GetLastResultWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Query_Result, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastResult()
+}
+
 // getObject retrieves the SoftLayer_Network_Monitor_Version1_Query_Host object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Network_Monitor_Version1_Query_Host service. You can only retrieve query hosts attached to hardware that belong to your account.
 func (r Network_Monitor_Version1_Query_Host) GetObject() (resp datatypes.Network_Monitor_Version1_Query_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Monitor_Version1_Query_Host", "getObject", nil, &r.Options, &resp)
@@ -6953,12 +9695,28 @@ func (r Network_Monitor_Version1_Query_Host) GetQueryType() (resp datatypes.Netw
 	return
 }
 
+// GetQueryTypeWith is GetQueryType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Monitor_Version1_Query_Host) GetQueryTypeWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Query_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetQueryType()
+}
+
 // Retrieve The action taken when a monitor fails.
 func (r Network_Monitor_Version1_Query_Host) GetResponseAction() (resp datatypes.Network_Monitor_Version1_Query_ResponseType, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Monitor_Version1_Query_Host", "getResponseAction", nil, &r.Options, &resp)
 	return
 }
 
+// GetResponseActionWith is GetResponseAction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Monitor_Version1_Query_Host) GetResponseActionWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Query_ResponseType, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResponseAction()
+}
+
 // The monitoring stratum type stores the maximum level of the various components of the monitoring system that a particular hardware object has access to.  This object cannot be accessed by ID, and cannot be modified. The user can access this object through Hardware_Server->availableMonitoring.
 //
 // There are two values on this object that are important:
@@ -6982,6 +9740,11 @@ func (r Network_Monitor_Version1_Query_Host_Stratum) Id(id int) Network_Monitor_
 	return r
 }
 
+func (r Network_Monitor_Version1_Query_Host_Stratum) InitParameter(name string, value interface{}) Network_Monitor_Version1_Query_Host_Stratum {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Monitor_Version1_Query_Host_Stratum) Mask(mask string) Network_Monitor_Version1_Query_Host_Stratum {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -7001,11 +9764,26 @@ func (r Network_Monitor_Version1_Query_Host_Stratum) Limit(limit int) Network_Mo
 	return r
 }
 
+func (r Network_Monitor_Version1_Query_Host_Stratum) Unlimited() Network_Monitor_Version1_Query_Host_Stratum {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Monitor_Version1_Query_Host_Stratum) Offset(offset int) Network_Monitor_Version1_Query_Host_Stratum {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Monitor_Version1_Query_Host_Stratum) Timeout(timeout time.Duration) Network_Monitor_Version1_Query_Host_Stratum {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Monitor_Version1_Query_Host_Stratum) WithContext(ctx context.Context) Network_Monitor_Version1_Query_Host_Stratum {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Calling this function returns all possible query type objects. These objects are to be used to set the values on the SoftLayer_Network_Monitor_Version1_Query_Host when creating new monitoring instances.
 func (r Network_Monitor_Version1_Query_Host_Stratum) GetAllQueryTypes() (resp []datatypes.Network_Monitor_Version1_Query_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Monitor_Version1_Query_Host_Stratum", "getAllQueryTypes", nil, &r.Options, &resp)
@@ -7024,6 +9802,14 @@ func (r Network_Monitor_Version1_Query_Host_Stratum) GetHardware() (resp datatyp
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Monitor_Version1_Query_Host_Stratum) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // getObject retrieves the SoftLayer_Network_Monitor_Version1_Query_Host_Stratum object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Network_Monitor_Version1_Query_Host_Stratum service. You can only retrieve strata attached to hardware that belong to your account.
 func (r Network_Monitor_Version1_Query_Host_Stratum) GetObject() (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Monitor_Version1_Query_Host_Stratum", "getObject", nil, &r.Options, &resp)
@@ -7046,6 +9832,11 @@ func (r Network_Pod) Id(id int) Network_Pod {
 	return r
 }
 
+func (r Network_Pod) InitParameter(name string, value interface{}) Network_Pod {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Pod) Mask(mask string) Network_Pod {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -7065,11 +9856,26 @@ func (r Network_Pod) Limit(limit int) Network_Pod {
 	return r
 }
 
+func (r Network_Pod) Unlimited() Network_Pod {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Pod) Offset(offset int) Network_Pod {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Pod) Timeout(timeout time.Duration) Network_Pod {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Pod) WithContext(ctx context.Context) Network_Pod {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Filtering is supported for ``datacenterName`` and ``capabilities``. When filtering on capabilities, use the ``in`` operation. Pods fulfilling all capabilities provided will be returned. ``datacenterName`` represents an operation against ``SoftLayer_Location_Datacenter.name`, such as dal05 when referring to Dallas 5.
 //
 // ```Examples:```
@@ -7118,6 +9924,11 @@ func (r Network_SecurityGroup) Id(id int) Network_SecurityGroup {
 	return r
 }
 
+func (r Network_SecurityGroup) InitParameter(name string, value interface{}) Network_SecurityGroup {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_SecurityGroup) Mask(mask string) Network_SecurityGroup {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -7137,11 +9948,26 @@ func (r Network_SecurityGroup) Limit(limit int) Network_SecurityGroup {
 	return r
 }
 
+func (r Network_SecurityGroup) Unlimited() Network_SecurityGroup {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_SecurityGroup) Offset(offset int) Network_SecurityGroup {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_SecurityGroup) Timeout(timeout time.Duration) Network_SecurityGroup {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_SecurityGroup) WithContext(ctx context.Context) Network_SecurityGroup {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Add new rules to a security group by sending in an array of template [[SoftLayer_Network_SecurityGroup_Rule (type)]] objects to be created.
 func (r Network_SecurityGroup) AddRules(ruleTemplates []datatypes.Network_SecurityGroup_Rule) (resp datatypes.Network_SecurityGroup_RequestRules, err error) {
 	params := []interface{}{
@@ -7235,6 +10061,14 @@ func (r Network_SecurityGroup) GetAccount() (resp datatypes.Account, err error)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_SecurityGroup) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // no documentation yet
 func (r Network_SecurityGroup) GetAllObjects() (resp []datatypes.Network_SecurityGroup, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_SecurityGroup", "getAllObjects", nil, &r.Options, &resp)
@@ -7253,6 +10087,14 @@ func (r Network_SecurityGroup) GetNetworkComponentBindings() (resp []datatypes.V
 	return
 }
 
+// GetNetworkComponentBindingsWith is GetNetworkComponentBindings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_SecurityGroup) GetNetworkComponentBindingsWith(options sl.Options) (resp []datatypes.Virtual_Network_SecurityGroup_NetworkComponentBinding, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponentBindings()
+}
+
 // no documentation yet
 func (r Network_SecurityGroup) GetObject() (resp datatypes.Network_SecurityGroup, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_SecurityGroup", "getObject", nil, &r.Options, &resp)
@@ -7265,12 +10107,28 @@ func (r Network_SecurityGroup) GetOrderBindings() (resp []datatypes.Network_Secu
 	return
 }
 
+// GetOrderBindingsWith is GetOrderBindings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_SecurityGroup) GetOrderBindingsWith(options sl.Options) (resp []datatypes.Network_SecurityGroup_OrderBinding, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderBindings()
+}
+
 // Retrieve The rules for this security group.
 func (r Network_SecurityGroup) GetRules() (resp []datatypes.Network_SecurityGroup_Rule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_SecurityGroup", "getRules", nil, &r.Options, &resp)
 	return
 }
 
+// GetRulesWith is GetRules, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_SecurityGroup) GetRulesWith(options sl.Options) (resp []datatypes.Network_SecurityGroup_Rule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRules()
+}
+
 // List the data centers that currently support the use of security groups.
 func (r Network_SecurityGroup) GetSupportedDataCenters() (resp []datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_SecurityGroup", "getSupportedDataCenters", nil, &r.Options, &resp)
@@ -7307,6 +10165,11 @@ func (r Network_Security_Scanner_Request) Id(id int) Network_Security_Scanner_Re
 	return r
 }
 
+func (r Network_Security_Scanner_Request) InitParameter(name string, value interface{}) Network_Security_Scanner_Request {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Security_Scanner_Request) Mask(mask string) Network_Security_Scanner_Request {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -7326,11 +10189,26 @@ func (r Network_Security_Scanner_Request) Limit(limit int) Network_Security_Scan
 	return r
 }
 
+func (r Network_Security_Scanner_Request) Unlimited() Network_Security_Scanner_Request {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Security_Scanner_Request) Offset(offset int) Network_Security_Scanner_Request {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Security_Scanner_Request) Timeout(timeout time.Duration) Network_Security_Scanner_Request {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Security_Scanner_Request) WithContext(ctx context.Context) Network_Security_Scanner_Request {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a new vulnerability scan request. New scan requests are picked up every five minutes, and the time to complete an actual scan may vary. Once the scan is finished, it can take up to another five minutes for the report to be generated and accessible.
 func (r Network_Security_Scanner_Request) CreateObject(templateObject *datatypes.Network_Security_Scanner_Request) (resp datatypes.Network_Security_Scanner_Request, err error) {
 	params := []interface{}{
@@ -7346,18 +10224,42 @@ func (r Network_Security_Scanner_Request) GetAccount() (resp datatypes.Account,
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Security_Scanner_Request) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The virtual guest a security scan is run against.
 func (r Network_Security_Scanner_Request) GetGuest() (resp datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Security_Scanner_Request", "getGuest", nil, &r.Options, &resp)
 	return
 }
 
+// GetGuestWith is GetGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Security_Scanner_Request) GetGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGuest()
+}
+
 // Retrieve The hardware a security scan is run against.
 func (r Network_Security_Scanner_Request) GetHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Security_Scanner_Request", "getHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Security_Scanner_Request) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // getObject retrieves the SoftLayer_Network_Security_Scanner_Request object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Network_Security_Scanner_Request service. You can only retrieve requests and reports that are assigned to your SoftLayer account.
 func (r Network_Security_Scanner_Request) GetObject() (resp datatypes.Network_Security_Scanner_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Security_Scanner_Request", "getObject", nil, &r.Options, &resp)
@@ -7376,12 +10278,28 @@ func (r Network_Security_Scanner_Request) GetRequestorOwnedFlag() (resp bool, er
 	return
 }
 
+// GetRequestorOwnedFlagWith is GetRequestorOwnedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Security_Scanner_Request) GetRequestorOwnedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRequestorOwnedFlag()
+}
+
 // Retrieve A security scan request's status.
 func (r Network_Security_Scanner_Request) GetStatus() (resp datatypes.Network_Security_Scanner_Request_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Security_Scanner_Request", "getStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Security_Scanner_Request) GetStatusWith(options sl.Options) (resp datatypes.Network_Security_Scanner_Request_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // The SoftLayer_Network_Service_Vpn_Overrides data type contains information relating user ids to subnet ids when VPN access is manually configured.  It is essentially an entry in a 'white list' of subnets a SoftLayer portal VPN user may access.
 type Network_Service_Vpn_Overrides struct {
 	Session *session.Session
@@ -7398,6 +10316,11 @@ func (r Network_Service_Vpn_Overrides) Id(id int) Network_Service_Vpn_Overrides
 	return r
 }
 
+func (r Network_Service_Vpn_Overrides) InitParameter(name string, value interface{}) Network_Service_Vpn_Overrides {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Service_Vpn_Overrides) Mask(mask string) Network_Service_Vpn_Overrides {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -7417,11 +10340,26 @@ func (r Network_Service_Vpn_Overrides) Limit(limit int) Network_Service_Vpn_Over
 	return r
 }
 
+func (r Network_Service_Vpn_Overrides) Unlimited() Network_Service_Vpn_Overrides {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Service_Vpn_Overrides) Offset(offset int) Network_Service_Vpn_Overrides {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Service_Vpn_Overrides) Timeout(timeout time.Duration) Network_Service_Vpn_Overrides {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Service_Vpn_Overrides) WithContext(ctx context.Context) Network_Service_Vpn_Overrides {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create Softlayer portal user VPN overrides.
 func (r Network_Service_Vpn_Overrides) CreateObjects(templateObjects []datatypes.Network_Service_Vpn_Overrides) (resp bool, err error) {
 	params := []interface{}{
@@ -7458,12 +10396,28 @@ func (r Network_Service_Vpn_Overrides) GetSubnet() (resp datatypes.Network_Subne
 	return
 }
 
+// GetSubnetWith is GetSubnet, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Service_Vpn_Overrides) GetSubnetWith(options sl.Options) (resp datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubnet()
+}
+
 // Retrieve SoftLayer VPN portal user.
 func (r Network_Service_Vpn_Overrides) GetUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Service_Vpn_Overrides", "getUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserWith is GetUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Service_Vpn_Overrides) GetUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUser()
+}
+
 // The SoftLayer_Network_Storage data type contains general information regarding a Storage product such as account id, access username and password, the Storage product type, and the server the Storage service is associated with. Currently, only EVault backup storage has an associated server.
 type Network_Storage struct {
 	Session *session.Session
@@ -7480,6 +10434,11 @@ func (r Network_Storage) Id(id int) Network_Storage {
 	return r
 }
 
+func (r Network_Storage) InitParameter(name string, value interface{}) Network_Storage {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage) Mask(mask string) Network_Storage {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -7499,11 +10458,26 @@ func (r Network_Storage) Limit(limit int) Network_Storage {
 	return r
 }
 
+func (r Network_Storage) Unlimited() Network_Storage {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage) Offset(offset int) Network_Storage {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage) Timeout(timeout time.Duration) Network_Storage {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage) WithContext(ctx context.Context) Network_Storage {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is used to modify the access control list for this Storage volume.  The SoftLayer_Hardware objects which have been allowed access to this storage will be listed in the allowedHardware property of this storage volume.
 func (r Network_Storage) AllowAccessFromHardware(hardwareObjectTemplate *datatypes.Hardware) (resp bool, err error) {
 	params := []interface{}{
@@ -7872,18 +10846,42 @@ func (r Network_Storage) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve Other usernames and passwords associated with a Storage volume.
 func (r Network_Storage) GetAccountPassword() (resp datatypes.Account_Password, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getAccountPassword", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountPasswordWith is GetAccountPassword, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetAccountPasswordWith(options sl.Options) (resp datatypes.Account_Password, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountPassword()
+}
+
 // Retrieve The currently active transactions on a network storage volume.
 func (r Network_Storage) GetActiveTransactions() (resp []datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getActiveTransactions", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveTransactionsWith is GetActiveTransactions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetActiveTransactionsWith(options sl.Options) (resp []datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransactions()
+}
+
 // {{CloudLayerOnlyMethod}} Retrieve details such as id, name, size, create date for all files in a Storage account's root directory. This does not download file content.
 func (r Network_Storage) GetAllFiles() (resp []datatypes.Container_Utility_File_Entity, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getAllFiles", nil, &r.Options, &resp)
@@ -7942,6 +10940,14 @@ func (r Network_Storage) GetAllowedHardware() (resp []datatypes.Hardware, err er
 	return
 }
 
+// GetAllowedHardwareWith is GetAllowedHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetAllowedHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHardware()
+}
+
 // Retrieves the total number of allowed hosts limit per volume.
 func (r Network_Storage) GetAllowedHostsLimit() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getAllowedHostsLimit", nil, &r.Options, &resp)
@@ -7954,48 +10960,112 @@ func (r Network_Storage) GetAllowedIpAddresses() (resp []datatypes.Network_Subne
 	return
 }
 
+// GetAllowedIpAddressesWith is GetAllowedIpAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetAllowedIpAddressesWith(options sl.Options) (resp []datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedIpAddresses()
+}
+
 // Retrieve The SoftLayer_Hardware objects which are allowed access to this storage volume's Replicant.
 func (r Network_Storage) GetAllowedReplicationHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getAllowedReplicationHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedReplicationHardwareWith is GetAllowedReplicationHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetAllowedReplicationHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedReplicationHardware()
+}
+
 // Retrieve The SoftLayer_Network_Subnet_IpAddress objects which are allowed access to this storage volume's Replicant.
 func (r Network_Storage) GetAllowedReplicationIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getAllowedReplicationIpAddresses", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedReplicationIpAddressesWith is GetAllowedReplicationIpAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetAllowedReplicationIpAddressesWith(options sl.Options) (resp []datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedReplicationIpAddresses()
+}
+
 // Retrieve The SoftLayer_Network_Subnet objects which are allowed access to this storage volume's Replicant.
 func (r Network_Storage) GetAllowedReplicationSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getAllowedReplicationSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedReplicationSubnetsWith is GetAllowedReplicationSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetAllowedReplicationSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedReplicationSubnets()
+}
+
 // Retrieve The SoftLayer_Hardware objects which are allowed access to this storage volume's Replicant.
 func (r Network_Storage) GetAllowedReplicationVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getAllowedReplicationVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedReplicationVirtualGuestsWith is GetAllowedReplicationVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetAllowedReplicationVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedReplicationVirtualGuests()
+}
+
 // Retrieve The SoftLayer_Network_Subnet objects which are allowed access to this storage volume.
 func (r Network_Storage) GetAllowedSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getAllowedSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedSubnetsWith is GetAllowedSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetAllowedSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedSubnets()
+}
+
 // Retrieve The SoftLayer_Virtual_Guest objects which are allowed access to this storage volume.
 func (r Network_Storage) GetAllowedVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getAllowedVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedVirtualGuestsWith is GetAllowedVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetAllowedVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedVirtualGuests()
+}
+
 // Retrieve The current billing item for a Storage volume.
 func (r Network_Storage) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve
 func (r Network_Storage) GetBillingItemCategory() (resp datatypes.Product_Item_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getBillingItemCategory", nil, &r.Options, &resp)
@@ -8018,6 +11088,14 @@ func (r Network_Storage) GetBytesUsed() (resp string, err error) {
 	return
 }
 
+// GetBytesUsedWith is GetBytesUsed, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetBytesUsedWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBytesUsed()
+}
+
 // no documentation yet
 func (r Network_Storage) GetCdnUrls() (resp []datatypes.Container_Network_Storage_Hub_ObjectStorage_ContentDeliveryUrl, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getCdnUrls", nil, &r.Options, &resp)
@@ -8036,6 +11114,14 @@ func (r Network_Storage) GetCreationScheduleId() (resp string, err error) {
 	return
 }
 
+// GetCreationScheduleIdWith is GetCreationScheduleId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetCreationScheduleIdWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCreationScheduleId()
+}
+
 // Retrieve
 func (r Network_Storage) GetCredentials() (resp []datatypes.Network_Storage_Credential, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getCredentials", nil, &r.Options, &resp)
@@ -8048,12 +11134,28 @@ func (r Network_Storage) GetDailySchedule() (resp datatypes.Network_Storage_Sche
 	return
 }
 
+// GetDailyScheduleWith is GetDailySchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetDailyScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDailySchedule()
+}
+
 // Retrieve The events which have taken place on a network storage volume.
 func (r Network_Storage) GetEvents() (resp []datatypes.Network_Storage_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getEvents", nil, &r.Options, &resp)
 	return
 }
 
+// GetEventsWith is GetEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetEventsWith(options sl.Options) (resp []datatypes.Network_Storage_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvents()
+}
+
 //
 //
 //
@@ -8093,6 +11195,14 @@ func (r Network_Storage) GetFileNetworkMountAddress() (resp string, err error) {
 	return
 }
 
+// GetFileNetworkMountAddressWith is GetFileNetworkMountAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetFileNetworkMountAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFileNetworkMountAddress()
+}
+
 // {{CloudLayerOnlyMethod}} Retrieve the number of files pending deletion in a Storage account's recycle bin. Files in an account's recycle bin may either be restored to the account's root directory or permanently deleted.
 func (r Network_Storage) GetFilePendingDeleteCount() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getFilePendingDeleteCount", nil, &r.Options, &resp)
@@ -8130,6 +11240,14 @@ func (r Network_Storage) GetHardware() (resp datatypes.Hardware, err error) {
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve
 func (r Network_Storage) GetHasEncryptionAtRest() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getHasEncryptionAtRest", nil, &r.Options, &resp)
@@ -8142,54 +11260,126 @@ func (r Network_Storage) GetHourlySchedule() (resp datatypes.Network_Storage_Sch
 	return
 }
 
+// GetHourlyScheduleWith is GetHourlySchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetHourlyScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlySchedule()
+}
+
 // Retrieve The Interval Schedule which is associated with this network storage volume.
 func (r Network_Storage) GetIntervalSchedule() (resp datatypes.Network_Storage_Schedule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getIntervalSchedule", nil, &r.Options, &resp)
 	return
 }
 
+// GetIntervalScheduleWith is GetIntervalSchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetIntervalScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIntervalSchedule()
+}
+
 // Retrieve The maximum number of IOPs selected for this volume.
 func (r Network_Storage) GetIops() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getIops", nil, &r.Options, &resp)
 	return
 }
 
+// GetIopsWith is GetIops, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetIopsWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIops()
+}
+
 // Retrieve Determines whether a volume is ready to order snapshot space, or, if snapshot space is already available, to assign a snapshot schedule, or to take a manual snapshot.
 func (r Network_Storage) GetIsReadyForSnapshot() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getIsReadyForSnapshot", nil, &r.Options, &resp)
 	return
 }
 
+// GetIsReadyForSnapshotWith is GetIsReadyForSnapshot, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetIsReadyForSnapshotWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsReadyForSnapshot()
+}
+
 // Retrieve Determines whether a volume is ready to have Hosts authorized to access it. This does not indicate whether another operation may be blocking, please refer to this volume's volumeStatus property for details.
 func (r Network_Storage) GetIsReadyToMount() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getIsReadyToMount", nil, &r.Options, &resp)
 	return
 }
 
+// GetIsReadyToMountWith is GetIsReadyToMount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetIsReadyToMountWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsReadyToMount()
+}
+
 // Retrieve Relationship between a container volume and iSCSI LUNs.
 func (r Network_Storage) GetIscsiLuns() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getIscsiLuns", nil, &r.Options, &resp)
 	return
 }
 
+// GetIscsiLunsWith is GetIscsiLuns, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetIscsiLunsWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIscsiLuns()
+}
+
 // Retrieve Returns the target IP addresses of an iSCSI volume.
 func (r Network_Storage) GetIscsiTargetIpAddresses() (resp []string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getIscsiTargetIpAddresses", nil, &r.Options, &resp)
 	return
 }
 
+// GetIscsiTargetIpAddressesWith is GetIscsiTargetIpAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetIscsiTargetIpAddressesWith(options sl.Options) (resp []string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIscsiTargetIpAddresses()
+}
+
 // Retrieve The ID of the LUN volume.
 func (r Network_Storage) GetLunId() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getLunId", nil, &r.Options, &resp)
 	return
 }
 
+// GetLunIdWith is GetLunId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetLunIdWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLunId()
+}
+
 // Retrieve The manually-created snapshots associated with this SoftLayer_Network_Storage volume. Does not support pagination by result limit and offset.
 func (r Network_Storage) GetManualSnapshots() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getManualSnapshots", nil, &r.Options, &resp)
 	return
 }
 
+// GetManualSnapshotsWith is GetManualSnapshots, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetManualSnapshotsWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManualSnapshots()
+}
+
 // no documentation yet
 func (r Network_Storage) GetMaximumExpansionSize() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getMaximumExpansionSize", nil, &r.Options, &resp)
@@ -8202,18 +11392,42 @@ func (r Network_Storage) GetMetricTrackingObject() (resp datatypes.Metric_Tracki
 	return
 }
 
+// GetMetricTrackingObjectWith is GetMetricTrackingObject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+}
+
 // Retrieve Whether or not a network storage volume may be mounted.
 func (r Network_Storage) GetMountableFlag() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getMountableFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetMountableFlagWith is GetMountableFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetMountableFlagWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMountableFlag()
+}
+
 // Retrieve The current status of split or move operation as a part of volume duplication.
 func (r Network_Storage) GetMoveAndSplitStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getMoveAndSplitStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetMoveAndSplitStatusWith is GetMoveAndSplitStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetMoveAndSplitStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMoveAndSplitStatus()
+}
+
 // no documentation yet
 func (r Network_Storage) GetNetworkConnectionDetails() (resp datatypes.Container_Network_Storage_NetworkConnectionInformation, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getNetworkConnectionDetails", nil, &r.Options, &resp)
@@ -8232,6 +11446,14 @@ func (r Network_Storage) GetNotificationSubscribers() (resp []datatypes.Notifica
 	return
 }
 
+// GetNotificationSubscribersWith is GetNotificationSubscribers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetNotificationSubscribersWith(options sl.Options) (resp []datatypes.Notification_User_Subscriber, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNotificationSubscribers()
+}
+
 // getObject retrieves the SoftLayer_Network_Storage object whose ID corresponds to the ID number of the init parameter passed to the SoftLayer_Network_Storage service.
 //
 // Please use the associated methods in the [[SoftLayer_Network_Storage]] service to retrieve a Storage account's id.
@@ -8261,66 +11483,154 @@ func (r Network_Storage) GetOriginalSnapshotName() (resp string, err error) {
 	return
 }
 
+// GetOriginalSnapshotNameWith is GetOriginalSnapshotName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetOriginalSnapshotNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOriginalSnapshotName()
+}
+
 // Retrieve The name of the volume that this volume was duplicated from.
 func (r Network_Storage) GetOriginalVolumeName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getOriginalVolumeName", nil, &r.Options, &resp)
 	return
 }
 
+// GetOriginalVolumeNameWith is GetOriginalVolumeName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetOriginalVolumeNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOriginalVolumeName()
+}
+
 // Retrieve The size (in GB) of the volume or LUN before any size expansion, or of the volume (before any possible size expansion) from which the duplicate volume or LUN was created.
 func (r Network_Storage) GetOriginalVolumeSize() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getOriginalVolumeSize", nil, &r.Options, &resp)
 	return
 }
 
+// GetOriginalVolumeSizeWith is GetOriginalVolumeSize, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetOriginalVolumeSizeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOriginalVolumeSize()
+}
+
 // Retrieve A volume's configured SoftLayer_Network_Storage_Iscsi_OS_Type.
 func (r Network_Storage) GetOsType() (resp datatypes.Network_Storage_Iscsi_OS_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getOsType", nil, &r.Options, &resp)
 	return
 }
 
+// GetOsTypeWith is GetOsType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetOsTypeWith(options sl.Options) (resp datatypes.Network_Storage_Iscsi_OS_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOsType()
+}
+
 // Retrieve A volume's configured SoftLayer_Network_Storage_Iscsi_OS_Type ID.
 func (r Network_Storage) GetOsTypeId() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getOsTypeId", nil, &r.Options, &resp)
 	return
 }
 
+// GetOsTypeIdWith is GetOsTypeId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetOsTypeIdWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOsTypeId()
+}
+
 // Retrieve The volumes or snapshots partnered with a network storage volume in a parental role.
 func (r Network_Storage) GetParentPartnerships() (resp []datatypes.Network_Storage_Partnership, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getParentPartnerships", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentPartnershipsWith is GetParentPartnerships, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetParentPartnershipsWith(options sl.Options) (resp []datatypes.Network_Storage_Partnership, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentPartnerships()
+}
+
 // Retrieve The parent volume of a volume in a complex storage relationship.
 func (r Network_Storage) GetParentVolume() (resp datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getParentVolume", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentVolumeWith is GetParentVolume, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetParentVolumeWith(options sl.Options) (resp datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentVolume()
+}
+
 // Retrieve The volumes or snapshots partnered with a network storage volume.
 func (r Network_Storage) GetPartnerships() (resp []datatypes.Network_Storage_Partnership, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getPartnerships", nil, &r.Options, &resp)
 	return
 }
 
+// GetPartnershipsWith is GetPartnerships, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetPartnershipsWith(options sl.Options) (resp []datatypes.Network_Storage_Partnership, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPartnerships()
+}
+
 // Retrieve All permissions group(s) this volume is in.
 func (r Network_Storage) GetPermissionsGroups() (resp []datatypes.Network_Storage_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getPermissionsGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetPermissionsGroupsWith is GetPermissionsGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetPermissionsGroupsWith(options sl.Options) (resp []datatypes.Network_Storage_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPermissionsGroups()
+}
+
 // Retrieve The properties used to provide additional details about a network storage volume.
 func (r Network_Storage) GetProperties() (resp []datatypes.Network_Storage_Property, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getProperties", nil, &r.Options, &resp)
 	return
 }
 
+// GetPropertiesWith is GetProperties, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetPropertiesWith(options sl.Options) (resp []datatypes.Network_Storage_Property, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProperties()
+}
+
 // Retrieve The number of IOPs provisioned for this volume.
 func (r Network_Storage) GetProvisionedIops() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getProvisionedIops", nil, &r.Options, &resp)
 	return
 }
 
+// GetProvisionedIopsWith is GetProvisionedIops, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetProvisionedIopsWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProvisionedIops()
+}
+
 // {{CloudLayerOnlyMethod}} Retrieve the details of a file that is pending deletion in a Storage account's a recycle bin.
 func (r Network_Storage) GetRecycleBinFileByIdentifier(fileId *string) (resp datatypes.Container_Utility_File_Entity, err error) {
 	params := []interface{}{
@@ -8348,96 +11658,224 @@ func (r Network_Storage) GetReplicatingLuns() (resp []datatypes.Network_Storage,
 	return
 }
 
+// GetReplicatingLunsWith is GetReplicatingLuns, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetReplicatingLunsWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicatingLuns()
+}
+
 // Retrieve The network storage volume being replicated by a volume.
 func (r Network_Storage) GetReplicatingVolume() (resp datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getReplicatingVolume", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicatingVolumeWith is GetReplicatingVolume, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetReplicatingVolumeWith(options sl.Options) (resp datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicatingVolume()
+}
+
 // Retrieve The volume replication events.
 func (r Network_Storage) GetReplicationEvents() (resp []datatypes.Network_Storage_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getReplicationEvents", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicationEventsWith is GetReplicationEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetReplicationEventsWith(options sl.Options) (resp []datatypes.Network_Storage_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicationEvents()
+}
+
 // Retrieve The network storage volumes configured to be replicants of a volume.
 func (r Network_Storage) GetReplicationPartners() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getReplicationPartners", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicationPartnersWith is GetReplicationPartners, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetReplicationPartnersWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicationPartners()
+}
+
 // Retrieve The Replication Schedule associated with a network storage volume.
 func (r Network_Storage) GetReplicationSchedule() (resp datatypes.Network_Storage_Schedule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getReplicationSchedule", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicationScheduleWith is GetReplicationSchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetReplicationScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicationSchedule()
+}
+
 // Retrieve The current replication status of a network storage volume. Indicates Failover or Failback status.
 func (r Network_Storage) GetReplicationStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getReplicationStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicationStatusWith is GetReplicationStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetReplicationStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicationStatus()
+}
+
 // Retrieve The schedules which are associated with a network storage volume.
 func (r Network_Storage) GetSchedules() (resp []datatypes.Network_Storage_Schedule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getSchedules", nil, &r.Options, &resp)
 	return
 }
 
+// GetSchedulesWith is GetSchedules, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetSchedulesWith(options sl.Options) (resp []datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSchedules()
+}
+
 // Retrieve The network resource a Storage service is connected to.
 func (r Network_Storage) GetServiceResource() (resp datatypes.Network_Service_Resource, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getServiceResource", nil, &r.Options, &resp)
 	return
 }
 
+// GetServiceResourceWith is GetServiceResource, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetServiceResourceWith(options sl.Options) (resp datatypes.Network_Service_Resource, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceResource()
+}
+
 // Retrieve The IP address of a Storage resource.
 func (r Network_Storage) GetServiceResourceBackendIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getServiceResourceBackendIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetServiceResourceBackendIpAddressWith is GetServiceResourceBackendIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetServiceResourceBackendIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceResourceBackendIpAddress()
+}
+
 // Retrieve The name of a Storage's network resource.
 func (r Network_Storage) GetServiceResourceName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getServiceResourceName", nil, &r.Options, &resp)
 	return
 }
 
+// GetServiceResourceNameWith is GetServiceResourceName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetServiceResourceNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceResourceName()
+}
+
 // Retrieve A volume's configured snapshot space size.
 func (r Network_Storage) GetSnapshotCapacityGb() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getSnapshotCapacityGb", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotCapacityGbWith is GetSnapshotCapacityGb, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetSnapshotCapacityGbWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotCapacityGb()
+}
+
 // Retrieve The creation timestamp of the snapshot on the storage platform.
 func (r Network_Storage) GetSnapshotCreationTimestamp() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getSnapshotCreationTimestamp", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotCreationTimestampWith is GetSnapshotCreationTimestamp, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetSnapshotCreationTimestampWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotCreationTimestamp()
+}
+
 // Retrieve The percentage of used snapshot space after which to delete automated snapshots.
 func (r Network_Storage) GetSnapshotDeletionThresholdPercentage() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getSnapshotDeletionThresholdPercentage", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotDeletionThresholdPercentageWith is GetSnapshotDeletionThresholdPercentage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetSnapshotDeletionThresholdPercentageWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotDeletionThresholdPercentage()
+}
+
 // Retrieve The snapshot size in bytes.
 func (r Network_Storage) GetSnapshotSizeBytes() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getSnapshotSizeBytes", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotSizeBytesWith is GetSnapshotSizeBytes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetSnapshotSizeBytesWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotSizeBytes()
+}
+
 // Retrieve A volume's available snapshot reservation space.
 func (r Network_Storage) GetSnapshotSpaceAvailable() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getSnapshotSpaceAvailable", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotSpaceAvailableWith is GetSnapshotSpaceAvailable, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetSnapshotSpaceAvailableWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotSpaceAvailable()
+}
+
 // Retrieve The snapshots associated with this SoftLayer_Network_Storage volume.
 func (r Network_Storage) GetSnapshots() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getSnapshots", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotsWith is GetSnapshots, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetSnapshotsWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshots()
+}
+
 // Retrieves a list of snapshots for this SoftLayer_Network_Storage volume. This method works with the result limits and offset to support pagination.
 func (r Network_Storage) GetSnapshotsForVolume() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getSnapshotsForVolume", nil, &r.Options, &resp)
@@ -8456,6 +11894,14 @@ func (r Network_Storage) GetStorageGroups() (resp []datatypes.Network_Storage_Gr
 	return
 }
 
+// GetStorageGroupsWith is GetStorageGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetStorageGroupsWith(options sl.Options) (resp []datatypes.Network_Storage_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStorageGroups()
+}
+
 // no documentation yet
 func (r Network_Storage) GetStorageGroupsNetworkConnectionDetails() (resp []datatypes.Container_Network_Storage_NetworkConnectionInformation, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getStorageGroupsNetworkConnectionDetails", nil, &r.Options, &resp)
@@ -8474,6 +11920,14 @@ func (r Network_Storage) GetStorageType() (resp datatypes.Network_Storage_Type,
 	return
 }
 
+// GetStorageTypeWith is GetStorageType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetStorageTypeWith(options sl.Options) (resp datatypes.Network_Storage_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStorageType()
+}
+
 // no documentation yet
 func (r Network_Storage) GetTargetIpAddresses() (resp []string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getTargetIpAddresses", nil, &r.Options, &resp)
@@ -8486,18 +11940,42 @@ func (r Network_Storage) GetTotalBytesUsed() (resp string, err error) {
 	return
 }
 
+// GetTotalBytesUsedWith is GetTotalBytesUsed, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetTotalBytesUsedWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalBytesUsed()
+}
+
 // Retrieve The total snapshot retention count of all schedules on this network storage volume.
 func (r Network_Storage) GetTotalScheduleSnapshotRetentionCount() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getTotalScheduleSnapshotRetentionCount", nil, &r.Options, &resp)
 	return
 }
 
+// GetTotalScheduleSnapshotRetentionCountWith is GetTotalScheduleSnapshotRetentionCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetTotalScheduleSnapshotRetentionCountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalScheduleSnapshotRetentionCount()
+}
+
 // Retrieve The usage notification for SL Storage services.
 func (r Network_Storage) GetUsageNotification() (resp datatypes.Notification, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getUsageNotification", nil, &r.Options, &resp)
 	return
 }
 
+// GetUsageNotificationWith is GetUsageNotification, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetUsageNotificationWith(options sl.Options) (resp datatypes.Notification, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUsageNotification()
+}
+
 // no documentation yet
 func (r Network_Storage) GetValidReplicationTargetDatacenterLocations() (resp []datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getValidReplicationTargetDatacenterLocations", nil, &r.Options, &resp)
@@ -8510,12 +11988,28 @@ func (r Network_Storage) GetVendorName() (resp string, err error) {
 	return
 }
 
+// GetVendorNameWith is GetVendorName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetVendorNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVendorName()
+}
+
 // Retrieve When applicable, the virtual guest associated with a Storage service.
 func (r Network_Storage) GetVirtualGuest() (resp datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getVirtualGuest", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestWith is GetVirtualGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetVirtualGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuest()
+}
+
 // This method returns the parameters for cloning a volume
 func (r Network_Storage) GetVolumeDuplicateParameters() (resp datatypes.Container_Network_Storage_VolumeDuplicateParameters, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getVolumeDuplicateParameters", nil, &r.Options, &resp)
@@ -8528,24 +12022,56 @@ func (r Network_Storage) GetVolumeHistory() (resp []datatypes.Network_Storage_Hi
 	return
 }
 
+// GetVolumeHistoryWith is GetVolumeHistory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetVolumeHistoryWith(options sl.Options) (resp []datatypes.Network_Storage_History, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVolumeHistory()
+}
+
 // Retrieve The current status of a network storage volume.
 func (r Network_Storage) GetVolumeStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getVolumeStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetVolumeStatusWith is GetVolumeStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetVolumeStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVolumeStatus()
+}
+
 // Retrieve The account username and password for the EVault webCC interface.
 func (r Network_Storage) GetWebccAccount() (resp datatypes.Account_Password, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getWebccAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetWebccAccountWith is GetWebccAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetWebccAccountWith(options sl.Options) (resp datatypes.Account_Password, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetWebccAccount()
+}
+
 // Retrieve The Weekly Schedule which is associated with this network storage volume.
 func (r Network_Storage) GetWeeklySchedule() (resp datatypes.Network_Storage_Schedule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage", "getWeeklySchedule", nil, &r.Options, &resp)
 	return
 }
 
+// GetWeeklyScheduleWith is GetWeeklySchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage) GetWeeklyScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetWeeklySchedule()
+}
+
 // Immediate Failover to a volume replicant.  During the time which the replicant is in use the local nas volume will not be available.
 func (r Network_Storage) ImmediateFailoverToReplicant(replicantId *int) (resp bool, err error) {
 	params := []interface{}{
@@ -8812,6 +12338,11 @@ func (r Network_Storage_Allowed_Host) Id(id int) Network_Storage_Allowed_Host {
 	return r
 }
 
+func (r Network_Storage_Allowed_Host) InitParameter(name string, value interface{}) Network_Storage_Allowed_Host {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Allowed_Host) Mask(mask string) Network_Storage_Allowed_Host {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -8831,11 +12362,26 @@ func (r Network_Storage_Allowed_Host) Limit(limit int) Network_Storage_Allowed_H
 	return r
 }
 
+func (r Network_Storage_Allowed_Host) Unlimited() Network_Storage_Allowed_Host {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Allowed_Host) Offset(offset int) Network_Storage_Allowed_Host {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Allowed_Host) Timeout(timeout time.Duration) Network_Storage_Allowed_Host {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Allowed_Host) WithContext(ctx context.Context) Network_Storage_Allowed_Host {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is used to create a new SoftLayer_Network_Storage_Allowed_Host using an existing SoftLayer_Hardware object's id.
 func (r Network_Storage_Allowed_Host) CreateFromHardware(hardwareId *int, iqn *string) (resp datatypes.Network_Storage_Allowed_Host, err error) {
 	params := []interface{}{
@@ -8896,36 +12442,84 @@ func (r Network_Storage_Allowed_Host) GetAssignedGroups() (resp []datatypes.Netw
 	return
 }
 
+// GetAssignedGroupsWith is GetAssignedGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host) GetAssignedGroupsWith(options sl.Options) (resp []datatypes.Network_Storage_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedGroups()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host) GetAssignedIscsiVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host", "getAssignedIscsiVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedIscsiVolumesWith is GetAssignedIscsiVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host) GetAssignedIscsiVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedIscsiVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host) GetAssignedNfsVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host", "getAssignedNfsVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedNfsVolumesWith is GetAssignedNfsVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host) GetAssignedNfsVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedNfsVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage primary volumes whose replicas are allowed access.
 func (r Network_Storage_Allowed_Host) GetAssignedReplicationVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host", "getAssignedReplicationVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedReplicationVolumesWith is GetAssignedReplicationVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host) GetAssignedReplicationVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedReplicationVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host) GetAssignedVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host", "getAssignedVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedVolumesWith is GetAssignedVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host) GetAssignedVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage_Credential this allowed host uses.
 func (r Network_Storage_Allowed_Host) GetCredential() (resp datatypes.Network_Storage_Credential, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host", "getCredential", nil, &r.Options, &resp)
 	return
 }
 
+// GetCredentialWith is GetCredential, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host) GetCredentialWith(options sl.Options) (resp datatypes.Network_Storage_Credential, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCredential()
+}
+
 // no documentation yet
 func (r Network_Storage_Allowed_Host) GetObject() (resp datatypes.Network_Storage_Allowed_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host", "getObject", nil, &r.Options, &resp)
@@ -8938,6 +12532,14 @@ func (r Network_Storage_Allowed_Host) GetSourceSubnet() (resp string, err error)
 	return
 }
 
+// GetSourceSubnetWith is GetSourceSubnet, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host) GetSourceSubnetWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSourceSubnet()
+}
+
 // Use this method to modify the credential password for a SoftLayer_Network_Storage_Allowed_Host object.
 func (r Network_Storage_Allowed_Host) SetCredentialPassword(password *string) (resp bool, err error) {
 	params := []interface{}{
@@ -8963,6 +12565,11 @@ func (r Network_Storage_Allowed_Host_Hardware) Id(id int) Network_Storage_Allowe
 	return r
 }
 
+func (r Network_Storage_Allowed_Host_Hardware) InitParameter(name string, value interface{}) Network_Storage_Allowed_Host_Hardware {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Allowed_Host_Hardware) Mask(mask string) Network_Storage_Allowed_Host_Hardware {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -8982,11 +12589,26 @@ func (r Network_Storage_Allowed_Host_Hardware) Limit(limit int) Network_Storage_
 	return r
 }
 
+func (r Network_Storage_Allowed_Host_Hardware) Unlimited() Network_Storage_Allowed_Host_Hardware {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Allowed_Host_Hardware) Offset(offset int) Network_Storage_Allowed_Host_Hardware {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Allowed_Host_Hardware) Timeout(timeout time.Duration) Network_Storage_Allowed_Host_Hardware {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Allowed_Host_Hardware) WithContext(ctx context.Context) Network_Storage_Allowed_Host_Hardware {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is used to create a new SoftLayer_Network_Storage_Allowed_Host using an existing SoftLayer_Hardware object's id.
 func (r Network_Storage_Allowed_Host_Hardware) CreateFromHardware(hardwareId *int, iqn *string) (resp datatypes.Network_Storage_Allowed_Host, err error) {
 	params := []interface{}{
@@ -9041,6 +12663,14 @@ func (r Network_Storage_Allowed_Host_Hardware) GetAccount() (resp datatypes.Acco
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Hardware) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // no documentation yet
 func (r Network_Storage_Allowed_Host_Hardware) GetAllObjects() (resp []datatypes.Network_Storage_Allowed_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Hardware", "getAllObjects", nil, &r.Options, &resp)
@@ -9053,36 +12683,84 @@ func (r Network_Storage_Allowed_Host_Hardware) GetAssignedGroups() (resp []datat
 	return
 }
 
+// GetAssignedGroupsWith is GetAssignedGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Hardware) GetAssignedGroupsWith(options sl.Options) (resp []datatypes.Network_Storage_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedGroups()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host_Hardware) GetAssignedIscsiVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Hardware", "getAssignedIscsiVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedIscsiVolumesWith is GetAssignedIscsiVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Hardware) GetAssignedIscsiVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedIscsiVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host_Hardware) GetAssignedNfsVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Hardware", "getAssignedNfsVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedNfsVolumesWith is GetAssignedNfsVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Hardware) GetAssignedNfsVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedNfsVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage primary volumes whose replicas are allowed access.
 func (r Network_Storage_Allowed_Host_Hardware) GetAssignedReplicationVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Hardware", "getAssignedReplicationVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedReplicationVolumesWith is GetAssignedReplicationVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Hardware) GetAssignedReplicationVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedReplicationVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host_Hardware) GetAssignedVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Hardware", "getAssignedVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedVolumesWith is GetAssignedVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Hardware) GetAssignedVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage_Credential this allowed host uses.
 func (r Network_Storage_Allowed_Host_Hardware) GetCredential() (resp datatypes.Network_Storage_Credential, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Hardware", "getCredential", nil, &r.Options, &resp)
 	return
 }
 
+// GetCredentialWith is GetCredential, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Hardware) GetCredentialWith(options sl.Options) (resp datatypes.Network_Storage_Credential, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCredential()
+}
+
 // no documentation yet
 func (r Network_Storage_Allowed_Host_Hardware) GetObject() (resp datatypes.Network_Storage_Allowed_Host_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Hardware", "getObject", nil, &r.Options, &resp)
@@ -9095,12 +12773,28 @@ func (r Network_Storage_Allowed_Host_Hardware) GetResource() (resp datatypes.Har
 	return
 }
 
+// GetResourceWith is GetResource, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Hardware) GetResourceWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResource()
+}
+
 // Retrieve Connections to a target with a source IP in this subnet prefix are allowed.
 func (r Network_Storage_Allowed_Host_Hardware) GetSourceSubnet() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Hardware", "getSourceSubnet", nil, &r.Options, &resp)
 	return
 }
 
+// GetSourceSubnetWith is GetSourceSubnet, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Hardware) GetSourceSubnetWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSourceSubnet()
+}
+
 // Use this method to modify the credential password for a SoftLayer_Network_Storage_Allowed_Host object.
 func (r Network_Storage_Allowed_Host_Hardware) SetCredentialPassword(password *string) (resp bool, err error) {
 	params := []interface{}{
@@ -9126,6 +12820,11 @@ func (r Network_Storage_Allowed_Host_IpAddress) Id(id int) Network_Storage_Allow
 	return r
 }
 
+func (r Network_Storage_Allowed_Host_IpAddress) InitParameter(name string, value interface{}) Network_Storage_Allowed_Host_IpAddress {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Allowed_Host_IpAddress) Mask(mask string) Network_Storage_Allowed_Host_IpAddress {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -9145,11 +12844,26 @@ func (r Network_Storage_Allowed_Host_IpAddress) Limit(limit int) Network_Storage
 	return r
 }
 
+func (r Network_Storage_Allowed_Host_IpAddress) Unlimited() Network_Storage_Allowed_Host_IpAddress {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Allowed_Host_IpAddress) Offset(offset int) Network_Storage_Allowed_Host_IpAddress {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Allowed_Host_IpAddress) Timeout(timeout time.Duration) Network_Storage_Allowed_Host_IpAddress {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Allowed_Host_IpAddress) WithContext(ctx context.Context) Network_Storage_Allowed_Host_IpAddress {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is used to create a new SoftLayer_Network_Storage_Allowed_Host using an existing SoftLayer_Hardware object's id.
 func (r Network_Storage_Allowed_Host_IpAddress) CreateFromHardware(hardwareId *int, iqn *string) (resp datatypes.Network_Storage_Allowed_Host, err error) {
 	params := []interface{}{
@@ -9204,6 +12918,14 @@ func (r Network_Storage_Allowed_Host_IpAddress) GetAccount() (resp datatypes.Acc
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_IpAddress) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // no documentation yet
 func (r Network_Storage_Allowed_Host_IpAddress) GetAllObjects() (resp []datatypes.Network_Storage_Allowed_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_IpAddress", "getAllObjects", nil, &r.Options, &resp)
@@ -9216,36 +12938,84 @@ func (r Network_Storage_Allowed_Host_IpAddress) GetAssignedGroups() (resp []data
 	return
 }
 
+// GetAssignedGroupsWith is GetAssignedGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_IpAddress) GetAssignedGroupsWith(options sl.Options) (resp []datatypes.Network_Storage_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedGroups()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host_IpAddress) GetAssignedIscsiVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_IpAddress", "getAssignedIscsiVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedIscsiVolumesWith is GetAssignedIscsiVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_IpAddress) GetAssignedIscsiVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedIscsiVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host_IpAddress) GetAssignedNfsVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_IpAddress", "getAssignedNfsVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedNfsVolumesWith is GetAssignedNfsVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_IpAddress) GetAssignedNfsVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedNfsVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage primary volumes whose replicas are allowed access.
 func (r Network_Storage_Allowed_Host_IpAddress) GetAssignedReplicationVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_IpAddress", "getAssignedReplicationVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedReplicationVolumesWith is GetAssignedReplicationVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_IpAddress) GetAssignedReplicationVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedReplicationVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host_IpAddress) GetAssignedVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_IpAddress", "getAssignedVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedVolumesWith is GetAssignedVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_IpAddress) GetAssignedVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage_Credential this allowed host uses.
 func (r Network_Storage_Allowed_Host_IpAddress) GetCredential() (resp datatypes.Network_Storage_Credential, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_IpAddress", "getCredential", nil, &r.Options, &resp)
 	return
 }
 
+// GetCredentialWith is GetCredential, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_IpAddress) GetCredentialWith(options sl.Options) (resp datatypes.Network_Storage_Credential, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCredential()
+}
+
 // no documentation yet
 func (r Network_Storage_Allowed_Host_IpAddress) GetObject() (resp datatypes.Network_Storage_Allowed_Host_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_IpAddress", "getObject", nil, &r.Options, &resp)
@@ -9258,12 +13028,28 @@ func (r Network_Storage_Allowed_Host_IpAddress) GetResource() (resp datatypes.Ne
 	return
 }
 
+// GetResourceWith is GetResource, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_IpAddress) GetResourceWith(options sl.Options) (resp datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResource()
+}
+
 // Retrieve Connections to a target with a source IP in this subnet prefix are allowed.
 func (r Network_Storage_Allowed_Host_IpAddress) GetSourceSubnet() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_IpAddress", "getSourceSubnet", nil, &r.Options, &resp)
 	return
 }
 
+// GetSourceSubnetWith is GetSourceSubnet, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_IpAddress) GetSourceSubnetWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSourceSubnet()
+}
+
 // Use this method to modify the credential password for a SoftLayer_Network_Storage_Allowed_Host object.
 func (r Network_Storage_Allowed_Host_IpAddress) SetCredentialPassword(password *string) (resp bool, err error) {
 	params := []interface{}{
@@ -9289,6 +13075,11 @@ func (r Network_Storage_Allowed_Host_Subnet) Id(id int) Network_Storage_Allowed_
 	return r
 }
 
+func (r Network_Storage_Allowed_Host_Subnet) InitParameter(name string, value interface{}) Network_Storage_Allowed_Host_Subnet {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Allowed_Host_Subnet) Mask(mask string) Network_Storage_Allowed_Host_Subnet {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -9308,11 +13099,26 @@ func (r Network_Storage_Allowed_Host_Subnet) Limit(limit int) Network_Storage_Al
 	return r
 }
 
+func (r Network_Storage_Allowed_Host_Subnet) Unlimited() Network_Storage_Allowed_Host_Subnet {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Allowed_Host_Subnet) Offset(offset int) Network_Storage_Allowed_Host_Subnet {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Allowed_Host_Subnet) Timeout(timeout time.Duration) Network_Storage_Allowed_Host_Subnet {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Allowed_Host_Subnet) WithContext(ctx context.Context) Network_Storage_Allowed_Host_Subnet {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is used to create a new SoftLayer_Network_Storage_Allowed_Host using an existing SoftLayer_Hardware object's id.
 func (r Network_Storage_Allowed_Host_Subnet) CreateFromHardware(hardwareId *int, iqn *string) (resp datatypes.Network_Storage_Allowed_Host, err error) {
 	params := []interface{}{
@@ -9367,6 +13173,14 @@ func (r Network_Storage_Allowed_Host_Subnet) GetAccount() (resp datatypes.Accoun
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Subnet) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // no documentation yet
 func (r Network_Storage_Allowed_Host_Subnet) GetAllObjects() (resp []datatypes.Network_Storage_Allowed_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Subnet", "getAllObjects", nil, &r.Options, &resp)
@@ -9379,36 +13193,84 @@ func (r Network_Storage_Allowed_Host_Subnet) GetAssignedGroups() (resp []datatyp
 	return
 }
 
+// GetAssignedGroupsWith is GetAssignedGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Subnet) GetAssignedGroupsWith(options sl.Options) (resp []datatypes.Network_Storage_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedGroups()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host_Subnet) GetAssignedIscsiVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Subnet", "getAssignedIscsiVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedIscsiVolumesWith is GetAssignedIscsiVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Subnet) GetAssignedIscsiVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedIscsiVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host_Subnet) GetAssignedNfsVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Subnet", "getAssignedNfsVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedNfsVolumesWith is GetAssignedNfsVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Subnet) GetAssignedNfsVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedNfsVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage primary volumes whose replicas are allowed access.
 func (r Network_Storage_Allowed_Host_Subnet) GetAssignedReplicationVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Subnet", "getAssignedReplicationVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedReplicationVolumesWith is GetAssignedReplicationVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Subnet) GetAssignedReplicationVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedReplicationVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host_Subnet) GetAssignedVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Subnet", "getAssignedVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedVolumesWith is GetAssignedVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Subnet) GetAssignedVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage_Credential this allowed host uses.
 func (r Network_Storage_Allowed_Host_Subnet) GetCredential() (resp datatypes.Network_Storage_Credential, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Subnet", "getCredential", nil, &r.Options, &resp)
 	return
 }
 
+// GetCredentialWith is GetCredential, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Subnet) GetCredentialWith(options sl.Options) (resp datatypes.Network_Storage_Credential, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCredential()
+}
+
 // no documentation yet
 func (r Network_Storage_Allowed_Host_Subnet) GetObject() (resp datatypes.Network_Storage_Allowed_Host_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Subnet", "getObject", nil, &r.Options, &resp)
@@ -9421,12 +13283,28 @@ func (r Network_Storage_Allowed_Host_Subnet) GetResource() (resp datatypes.Netwo
 	return
 }
 
+// GetResourceWith is GetResource, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Subnet) GetResourceWith(options sl.Options) (resp datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResource()
+}
+
 // Retrieve Connections to a target with a source IP in this subnet prefix are allowed.
 func (r Network_Storage_Allowed_Host_Subnet) GetSourceSubnet() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_Subnet", "getSourceSubnet", nil, &r.Options, &resp)
 	return
 }
 
+// GetSourceSubnetWith is GetSourceSubnet, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_Subnet) GetSourceSubnetWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSourceSubnet()
+}
+
 // Use this method to modify the credential password for a SoftLayer_Network_Storage_Allowed_Host object.
 func (r Network_Storage_Allowed_Host_Subnet) SetCredentialPassword(password *string) (resp bool, err error) {
 	params := []interface{}{
@@ -9452,6 +13330,11 @@ func (r Network_Storage_Allowed_Host_VirtualGuest) Id(id int) Network_Storage_Al
 	return r
 }
 
+func (r Network_Storage_Allowed_Host_VirtualGuest) InitParameter(name string, value interface{}) Network_Storage_Allowed_Host_VirtualGuest {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Allowed_Host_VirtualGuest) Mask(mask string) Network_Storage_Allowed_Host_VirtualGuest {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -9471,11 +13354,26 @@ func (r Network_Storage_Allowed_Host_VirtualGuest) Limit(limit int) Network_Stor
 	return r
 }
 
+func (r Network_Storage_Allowed_Host_VirtualGuest) Unlimited() Network_Storage_Allowed_Host_VirtualGuest {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Allowed_Host_VirtualGuest) Offset(offset int) Network_Storage_Allowed_Host_VirtualGuest {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Allowed_Host_VirtualGuest) Timeout(timeout time.Duration) Network_Storage_Allowed_Host_VirtualGuest {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Allowed_Host_VirtualGuest) WithContext(ctx context.Context) Network_Storage_Allowed_Host_VirtualGuest {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is used to create a new SoftLayer_Network_Storage_Allowed_Host using an existing SoftLayer_Hardware object's id.
 func (r Network_Storage_Allowed_Host_VirtualGuest) CreateFromHardware(hardwareId *int, iqn *string) (resp datatypes.Network_Storage_Allowed_Host, err error) {
 	params := []interface{}{
@@ -9530,6 +13428,14 @@ func (r Network_Storage_Allowed_Host_VirtualGuest) GetAccount() (resp datatypes.
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_VirtualGuest) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // no documentation yet
 func (r Network_Storage_Allowed_Host_VirtualGuest) GetAllObjects() (resp []datatypes.Network_Storage_Allowed_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_VirtualGuest", "getAllObjects", nil, &r.Options, &resp)
@@ -9542,36 +13448,84 @@ func (r Network_Storage_Allowed_Host_VirtualGuest) GetAssignedGroups() (resp []d
 	return
 }
 
+// GetAssignedGroupsWith is GetAssignedGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_VirtualGuest) GetAssignedGroupsWith(options sl.Options) (resp []datatypes.Network_Storage_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedGroups()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host_VirtualGuest) GetAssignedIscsiVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_VirtualGuest", "getAssignedIscsiVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedIscsiVolumesWith is GetAssignedIscsiVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_VirtualGuest) GetAssignedIscsiVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedIscsiVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host_VirtualGuest) GetAssignedNfsVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_VirtualGuest", "getAssignedNfsVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedNfsVolumesWith is GetAssignedNfsVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_VirtualGuest) GetAssignedNfsVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedNfsVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage primary volumes whose replicas are allowed access.
 func (r Network_Storage_Allowed_Host_VirtualGuest) GetAssignedReplicationVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_VirtualGuest", "getAssignedReplicationVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedReplicationVolumesWith is GetAssignedReplicationVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_VirtualGuest) GetAssignedReplicationVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedReplicationVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage volumes to which this SoftLayer_Network_Storage_Allowed_Host is allowed access.
 func (r Network_Storage_Allowed_Host_VirtualGuest) GetAssignedVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_VirtualGuest", "getAssignedVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAssignedVolumesWith is GetAssignedVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_VirtualGuest) GetAssignedVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedVolumes()
+}
+
 // Retrieve The SoftLayer_Network_Storage_Credential this allowed host uses.
 func (r Network_Storage_Allowed_Host_VirtualGuest) GetCredential() (resp datatypes.Network_Storage_Credential, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_VirtualGuest", "getCredential", nil, &r.Options, &resp)
 	return
 }
 
+// GetCredentialWith is GetCredential, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_VirtualGuest) GetCredentialWith(options sl.Options) (resp datatypes.Network_Storage_Credential, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCredential()
+}
+
 // no documentation yet
 func (r Network_Storage_Allowed_Host_VirtualGuest) GetObject() (resp datatypes.Network_Storage_Allowed_Host_VirtualGuest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_VirtualGuest", "getObject", nil, &r.Options, &resp)
@@ -9584,12 +13538,28 @@ func (r Network_Storage_Allowed_Host_VirtualGuest) GetResource() (resp datatypes
 	return
 }
 
+// GetResourceWith is GetResource, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_VirtualGuest) GetResourceWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResource()
+}
+
 // Retrieve Connections to a target with a source IP in this subnet prefix are allowed.
 func (r Network_Storage_Allowed_Host_VirtualGuest) GetSourceSubnet() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Allowed_Host_VirtualGuest", "getSourceSubnet", nil, &r.Options, &resp)
 	return
 }
 
+// GetSourceSubnetWith is GetSourceSubnet, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Allowed_Host_VirtualGuest) GetSourceSubnetWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSourceSubnet()
+}
+
 // Use this method to modify the credential password for a SoftLayer_Network_Storage_Allowed_Host object.
 func (r Network_Storage_Allowed_Host_VirtualGuest) SetCredentialPassword(password *string) (resp bool, err error) {
 	params := []interface{}{
@@ -9615,6 +13585,11 @@ func (r Network_Storage_Backup_Evault) Id(id int) Network_Storage_Backup_Evault
 	return r
 }
 
+func (r Network_Storage_Backup_Evault) InitParameter(name string, value interface{}) Network_Storage_Backup_Evault {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Backup_Evault) Mask(mask string) Network_Storage_Backup_Evault {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -9634,15 +13609,30 @@ func (r Network_Storage_Backup_Evault) Limit(limit int) Network_Storage_Backup_E
 	return r
 }
 
+func (r Network_Storage_Backup_Evault) Unlimited() Network_Storage_Backup_Evault {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Backup_Evault) Offset(offset int) Network_Storage_Backup_Evault {
 	r.Options.Offset = &offset
 	return r
 }
 
-// This method is used to modify the access control list for this Storage volume.  The SoftLayer_Hardware objects which have been allowed access to this storage will be listed in the allowedHardware property of this storage volume.
-func (r Network_Storage_Backup_Evault) AllowAccessFromHardware(hardwareObjectTemplate *datatypes.Hardware) (resp bool, err error) {
-	params := []interface{}{
-		hardwareObjectTemplate,
+func (r Network_Storage_Backup_Evault) Timeout(timeout time.Duration) Network_Storage_Backup_Evault {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Backup_Evault) WithContext(ctx context.Context) Network_Storage_Backup_Evault {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
+// This method is used to modify the access control list for this Storage volume.  The SoftLayer_Hardware objects which have been allowed access to this storage will be listed in the allowedHardware property of this storage volume.
+func (r Network_Storage_Backup_Evault) AllowAccessFromHardware(hardwareObjectTemplate *datatypes.Hardware) (resp bool, err error) {
+	params := []interface{}{
+		hardwareObjectTemplate,
 	}
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "allowAccessFromHardware", params, &r.Options, &resp)
 	return
@@ -10020,18 +14010,42 @@ func (r Network_Storage_Backup_Evault) GetAccount() (resp datatypes.Account, err
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve Other usernames and passwords associated with a Storage volume.
 func (r Network_Storage_Backup_Evault) GetAccountPassword() (resp datatypes.Account_Password, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getAccountPassword", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountPasswordWith is GetAccountPassword, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetAccountPasswordWith(options sl.Options) (resp datatypes.Account_Password, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountPassword()
+}
+
 // Retrieve The currently active transactions on a network storage volume.
 func (r Network_Storage_Backup_Evault) GetActiveTransactions() (resp []datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getActiveTransactions", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveTransactionsWith is GetActiveTransactions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetActiveTransactionsWith(options sl.Options) (resp []datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransactions()
+}
+
 // {{CloudLayerOnlyMethod}} Retrieve details such as id, name, size, create date for all files in a Storage account's root directory. This does not download file content.
 func (r Network_Storage_Backup_Evault) GetAllFiles() (resp []datatypes.Container_Utility_File_Entity, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getAllFiles", nil, &r.Options, &resp)
@@ -10090,6 +14104,14 @@ func (r Network_Storage_Backup_Evault) GetAllowedHardware() (resp []datatypes.Ha
 	return
 }
 
+// GetAllowedHardwareWith is GetAllowedHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetAllowedHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHardware()
+}
+
 // Retrieves the total number of allowed hosts limit per volume.
 func (r Network_Storage_Backup_Evault) GetAllowedHostsLimit() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getAllowedHostsLimit", nil, &r.Options, &resp)
@@ -10102,48 +14124,112 @@ func (r Network_Storage_Backup_Evault) GetAllowedIpAddresses() (resp []datatypes
 	return
 }
 
+// GetAllowedIpAddressesWith is GetAllowedIpAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetAllowedIpAddressesWith(options sl.Options) (resp []datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedIpAddresses()
+}
+
 // Retrieve The SoftLayer_Hardware objects which are allowed access to this storage volume's Replicant.
 func (r Network_Storage_Backup_Evault) GetAllowedReplicationHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getAllowedReplicationHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedReplicationHardwareWith is GetAllowedReplicationHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetAllowedReplicationHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedReplicationHardware()
+}
+
 // Retrieve The SoftLayer_Network_Subnet_IpAddress objects which are allowed access to this storage volume's Replicant.
 func (r Network_Storage_Backup_Evault) GetAllowedReplicationIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getAllowedReplicationIpAddresses", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedReplicationIpAddressesWith is GetAllowedReplicationIpAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetAllowedReplicationIpAddressesWith(options sl.Options) (resp []datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedReplicationIpAddresses()
+}
+
 // Retrieve The SoftLayer_Network_Subnet objects which are allowed access to this storage volume's Replicant.
 func (r Network_Storage_Backup_Evault) GetAllowedReplicationSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getAllowedReplicationSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedReplicationSubnetsWith is GetAllowedReplicationSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetAllowedReplicationSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedReplicationSubnets()
+}
+
 // Retrieve The SoftLayer_Hardware objects which are allowed access to this storage volume's Replicant.
 func (r Network_Storage_Backup_Evault) GetAllowedReplicationVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getAllowedReplicationVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedReplicationVirtualGuestsWith is GetAllowedReplicationVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetAllowedReplicationVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedReplicationVirtualGuests()
+}
+
 // Retrieve The SoftLayer_Network_Subnet objects which are allowed access to this storage volume.
 func (r Network_Storage_Backup_Evault) GetAllowedSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getAllowedSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedSubnetsWith is GetAllowedSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetAllowedSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedSubnets()
+}
+
 // Retrieve The SoftLayer_Virtual_Guest objects which are allowed access to this storage volume.
 func (r Network_Storage_Backup_Evault) GetAllowedVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getAllowedVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedVirtualGuestsWith is GetAllowedVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetAllowedVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedVirtualGuests()
+}
+
 // Retrieve The current billing item for a Storage volume.
 func (r Network_Storage_Backup_Evault) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve
 func (r Network_Storage_Backup_Evault) GetBillingItemCategory() (resp datatypes.Product_Item_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getBillingItemCategory", nil, &r.Options, &resp)
@@ -10166,6 +14252,14 @@ func (r Network_Storage_Backup_Evault) GetBytesUsed() (resp string, err error) {
 	return
 }
 
+// GetBytesUsedWith is GetBytesUsed, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetBytesUsedWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBytesUsed()
+}
+
 // no documentation yet
 func (r Network_Storage_Backup_Evault) GetCdnUrls() (resp []datatypes.Container_Network_Storage_Hub_ObjectStorage_ContentDeliveryUrl, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getCdnUrls", nil, &r.Options, &resp)
@@ -10184,6 +14278,14 @@ func (r Network_Storage_Backup_Evault) GetCreationScheduleId() (resp string, err
 	return
 }
 
+// GetCreationScheduleIdWith is GetCreationScheduleId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetCreationScheduleIdWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCreationScheduleId()
+}
+
 // Retrieve
 func (r Network_Storage_Backup_Evault) GetCredentials() (resp []datatypes.Network_Storage_Credential, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getCredentials", nil, &r.Options, &resp)
@@ -10196,12 +14298,28 @@ func (r Network_Storage_Backup_Evault) GetDailySchedule() (resp datatypes.Networ
 	return
 }
 
+// GetDailyScheduleWith is GetDailySchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetDailyScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDailySchedule()
+}
+
 // Retrieve The events which have taken place on a network storage volume.
 func (r Network_Storage_Backup_Evault) GetEvents() (resp []datatypes.Network_Storage_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getEvents", nil, &r.Options, &resp)
 	return
 }
 
+// GetEventsWith is GetEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetEventsWith(options sl.Options) (resp []datatypes.Network_Storage_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvents()
+}
+
 //
 //
 //
@@ -10241,6 +14359,14 @@ func (r Network_Storage_Backup_Evault) GetFileNetworkMountAddress() (resp string
 	return
 }
 
+// GetFileNetworkMountAddressWith is GetFileNetworkMountAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetFileNetworkMountAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFileNetworkMountAddress()
+}
+
 // {{CloudLayerOnlyMethod}} Retrieve the number of files pending deletion in a Storage account's recycle bin. Files in an account's recycle bin may either be restored to the account's root directory or permanently deleted.
 func (r Network_Storage_Backup_Evault) GetFilePendingDeleteCount() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getFilePendingDeleteCount", nil, &r.Options, &resp)
@@ -10278,6 +14404,14 @@ func (r Network_Storage_Backup_Evault) GetHardware() (resp datatypes.Hardware, e
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve a list of hardware associated with a SoftLayer customer account, placing all hardware with associated EVault storage accounts at the beginning of the list. The return type is SoftLayer_Hardware_Server[] contains the results; the number of items returned in the result will be returned in the soap header (totalItems). ''getHardwareWithEvaultFirst'' is useful in situations where you wish to search for hardware and provide paginated output.
 //
 //
@@ -10310,54 +14444,126 @@ func (r Network_Storage_Backup_Evault) GetHourlySchedule() (resp datatypes.Netwo
 	return
 }
 
+// GetHourlyScheduleWith is GetHourlySchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetHourlyScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlySchedule()
+}
+
 // Retrieve The Interval Schedule which is associated with this network storage volume.
 func (r Network_Storage_Backup_Evault) GetIntervalSchedule() (resp datatypes.Network_Storage_Schedule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getIntervalSchedule", nil, &r.Options, &resp)
 	return
 }
 
+// GetIntervalScheduleWith is GetIntervalSchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetIntervalScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIntervalSchedule()
+}
+
 // Retrieve The maximum number of IOPs selected for this volume.
 func (r Network_Storage_Backup_Evault) GetIops() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getIops", nil, &r.Options, &resp)
 	return
 }
 
+// GetIopsWith is GetIops, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetIopsWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIops()
+}
+
 // Retrieve Determines whether a volume is ready to order snapshot space, or, if snapshot space is already available, to assign a snapshot schedule, or to take a manual snapshot.
 func (r Network_Storage_Backup_Evault) GetIsReadyForSnapshot() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getIsReadyForSnapshot", nil, &r.Options, &resp)
 	return
 }
 
+// GetIsReadyForSnapshotWith is GetIsReadyForSnapshot, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetIsReadyForSnapshotWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsReadyForSnapshot()
+}
+
 // Retrieve Determines whether a volume is ready to have Hosts authorized to access it. This does not indicate whether another operation may be blocking, please refer to this volume's volumeStatus property for details.
 func (r Network_Storage_Backup_Evault) GetIsReadyToMount() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getIsReadyToMount", nil, &r.Options, &resp)
 	return
 }
 
+// GetIsReadyToMountWith is GetIsReadyToMount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetIsReadyToMountWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsReadyToMount()
+}
+
 // Retrieve Relationship between a container volume and iSCSI LUNs.
 func (r Network_Storage_Backup_Evault) GetIscsiLuns() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getIscsiLuns", nil, &r.Options, &resp)
 	return
 }
 
+// GetIscsiLunsWith is GetIscsiLuns, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetIscsiLunsWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIscsiLuns()
+}
+
 // Retrieve Returns the target IP addresses of an iSCSI volume.
 func (r Network_Storage_Backup_Evault) GetIscsiTargetIpAddresses() (resp []string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getIscsiTargetIpAddresses", nil, &r.Options, &resp)
 	return
 }
 
+// GetIscsiTargetIpAddressesWith is GetIscsiTargetIpAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetIscsiTargetIpAddressesWith(options sl.Options) (resp []string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIscsiTargetIpAddresses()
+}
+
 // Retrieve The ID of the LUN volume.
 func (r Network_Storage_Backup_Evault) GetLunId() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getLunId", nil, &r.Options, &resp)
 	return
 }
 
+// GetLunIdWith is GetLunId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetLunIdWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLunId()
+}
+
 // Retrieve The manually-created snapshots associated with this SoftLayer_Network_Storage volume. Does not support pagination by result limit and offset.
 func (r Network_Storage_Backup_Evault) GetManualSnapshots() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getManualSnapshots", nil, &r.Options, &resp)
 	return
 }
 
+// GetManualSnapshotsWith is GetManualSnapshots, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetManualSnapshotsWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManualSnapshots()
+}
+
 // no documentation yet
 func (r Network_Storage_Backup_Evault) GetMaximumExpansionSize() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getMaximumExpansionSize", nil, &r.Options, &resp)
@@ -10370,18 +14576,42 @@ func (r Network_Storage_Backup_Evault) GetMetricTrackingObject() (resp datatypes
 	return
 }
 
+// GetMetricTrackingObjectWith is GetMetricTrackingObject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+}
+
 // Retrieve Whether or not a network storage volume may be mounted.
 func (r Network_Storage_Backup_Evault) GetMountableFlag() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getMountableFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetMountableFlagWith is GetMountableFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetMountableFlagWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMountableFlag()
+}
+
 // Retrieve The current status of split or move operation as a part of volume duplication.
 func (r Network_Storage_Backup_Evault) GetMoveAndSplitStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getMoveAndSplitStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetMoveAndSplitStatusWith is GetMoveAndSplitStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetMoveAndSplitStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMoveAndSplitStatus()
+}
+
 // no documentation yet
 func (r Network_Storage_Backup_Evault) GetNetworkConnectionDetails() (resp datatypes.Container_Network_Storage_NetworkConnectionInformation, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getNetworkConnectionDetails", nil, &r.Options, &resp)
@@ -10400,6 +14630,14 @@ func (r Network_Storage_Backup_Evault) GetNotificationSubscribers() (resp []data
 	return
 }
 
+// GetNotificationSubscribersWith is GetNotificationSubscribers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetNotificationSubscribersWith(options sl.Options) (resp []datatypes.Notification_User_Subscriber, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNotificationSubscribers()
+}
+
 // getObject retrieves the SoftLayer_Network_Storage_Backup_Evault object whose ID corresponds to the ID number of the init parameter passed to the SoftLayer_Network_Storage_Backup_Evault service.
 func (r Network_Storage_Backup_Evault) GetObject() (resp datatypes.Network_Storage_Backup_Evault, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getObject", nil, &r.Options, &resp)
@@ -10427,66 +14665,154 @@ func (r Network_Storage_Backup_Evault) GetOriginalSnapshotName() (resp string, e
 	return
 }
 
+// GetOriginalSnapshotNameWith is GetOriginalSnapshotName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetOriginalSnapshotNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOriginalSnapshotName()
+}
+
 // Retrieve The name of the volume that this volume was duplicated from.
 func (r Network_Storage_Backup_Evault) GetOriginalVolumeName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getOriginalVolumeName", nil, &r.Options, &resp)
 	return
 }
 
+// GetOriginalVolumeNameWith is GetOriginalVolumeName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetOriginalVolumeNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOriginalVolumeName()
+}
+
 // Retrieve The size (in GB) of the volume or LUN before any size expansion, or of the volume (before any possible size expansion) from which the duplicate volume or LUN was created.
 func (r Network_Storage_Backup_Evault) GetOriginalVolumeSize() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getOriginalVolumeSize", nil, &r.Options, &resp)
 	return
 }
 
+// GetOriginalVolumeSizeWith is GetOriginalVolumeSize, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetOriginalVolumeSizeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOriginalVolumeSize()
+}
+
 // Retrieve A volume's configured SoftLayer_Network_Storage_Iscsi_OS_Type.
 func (r Network_Storage_Backup_Evault) GetOsType() (resp datatypes.Network_Storage_Iscsi_OS_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getOsType", nil, &r.Options, &resp)
 	return
 }
 
+// GetOsTypeWith is GetOsType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetOsTypeWith(options sl.Options) (resp datatypes.Network_Storage_Iscsi_OS_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOsType()
+}
+
 // Retrieve A volume's configured SoftLayer_Network_Storage_Iscsi_OS_Type ID.
 func (r Network_Storage_Backup_Evault) GetOsTypeId() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getOsTypeId", nil, &r.Options, &resp)
 	return
 }
 
+// GetOsTypeIdWith is GetOsTypeId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetOsTypeIdWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOsTypeId()
+}
+
 // Retrieve The volumes or snapshots partnered with a network storage volume in a parental role.
 func (r Network_Storage_Backup_Evault) GetParentPartnerships() (resp []datatypes.Network_Storage_Partnership, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getParentPartnerships", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentPartnershipsWith is GetParentPartnerships, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetParentPartnershipsWith(options sl.Options) (resp []datatypes.Network_Storage_Partnership, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentPartnerships()
+}
+
 // Retrieve The parent volume of a volume in a complex storage relationship.
 func (r Network_Storage_Backup_Evault) GetParentVolume() (resp datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getParentVolume", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentVolumeWith is GetParentVolume, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetParentVolumeWith(options sl.Options) (resp datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentVolume()
+}
+
 // Retrieve The volumes or snapshots partnered with a network storage volume.
 func (r Network_Storage_Backup_Evault) GetPartnerships() (resp []datatypes.Network_Storage_Partnership, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getPartnerships", nil, &r.Options, &resp)
 	return
 }
 
+// GetPartnershipsWith is GetPartnerships, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetPartnershipsWith(options sl.Options) (resp []datatypes.Network_Storage_Partnership, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPartnerships()
+}
+
 // Retrieve All permissions group(s) this volume is in.
 func (r Network_Storage_Backup_Evault) GetPermissionsGroups() (resp []datatypes.Network_Storage_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getPermissionsGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetPermissionsGroupsWith is GetPermissionsGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetPermissionsGroupsWith(options sl.Options) (resp []datatypes.Network_Storage_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPermissionsGroups()
+}
+
 // Retrieve The properties used to provide additional details about a network storage volume.
 func (r Network_Storage_Backup_Evault) GetProperties() (resp []datatypes.Network_Storage_Property, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getProperties", nil, &r.Options, &resp)
 	return
 }
 
+// GetPropertiesWith is GetProperties, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetPropertiesWith(options sl.Options) (resp []datatypes.Network_Storage_Property, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProperties()
+}
+
 // Retrieve The number of IOPs provisioned for this volume.
 func (r Network_Storage_Backup_Evault) GetProvisionedIops() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getProvisionedIops", nil, &r.Options, &resp)
 	return
 }
 
+// GetProvisionedIopsWith is GetProvisionedIops, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetProvisionedIopsWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProvisionedIops()
+}
+
 // {{CloudLayerOnlyMethod}} Retrieve the details of a file that is pending deletion in a Storage account's a recycle bin.
 func (r Network_Storage_Backup_Evault) GetRecycleBinFileByIdentifier(fileId *string) (resp datatypes.Container_Utility_File_Entity, err error) {
 	params := []interface{}{
@@ -10514,96 +14840,224 @@ func (r Network_Storage_Backup_Evault) GetReplicatingLuns() (resp []datatypes.Ne
 	return
 }
 
+// GetReplicatingLunsWith is GetReplicatingLuns, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetReplicatingLunsWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicatingLuns()
+}
+
 // Retrieve The network storage volume being replicated by a volume.
 func (r Network_Storage_Backup_Evault) GetReplicatingVolume() (resp datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getReplicatingVolume", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicatingVolumeWith is GetReplicatingVolume, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetReplicatingVolumeWith(options sl.Options) (resp datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicatingVolume()
+}
+
 // Retrieve The volume replication events.
 func (r Network_Storage_Backup_Evault) GetReplicationEvents() (resp []datatypes.Network_Storage_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getReplicationEvents", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicationEventsWith is GetReplicationEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetReplicationEventsWith(options sl.Options) (resp []datatypes.Network_Storage_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicationEvents()
+}
+
 // Retrieve The network storage volumes configured to be replicants of a volume.
 func (r Network_Storage_Backup_Evault) GetReplicationPartners() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getReplicationPartners", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicationPartnersWith is GetReplicationPartners, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetReplicationPartnersWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicationPartners()
+}
+
 // Retrieve The Replication Schedule associated with a network storage volume.
 func (r Network_Storage_Backup_Evault) GetReplicationSchedule() (resp datatypes.Network_Storage_Schedule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getReplicationSchedule", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicationScheduleWith is GetReplicationSchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetReplicationScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicationSchedule()
+}
+
 // Retrieve The current replication status of a network storage volume. Indicates Failover or Failback status.
 func (r Network_Storage_Backup_Evault) GetReplicationStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getReplicationStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicationStatusWith is GetReplicationStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetReplicationStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicationStatus()
+}
+
 // Retrieve The schedules which are associated with a network storage volume.
 func (r Network_Storage_Backup_Evault) GetSchedules() (resp []datatypes.Network_Storage_Schedule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getSchedules", nil, &r.Options, &resp)
 	return
 }
 
+// GetSchedulesWith is GetSchedules, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetSchedulesWith(options sl.Options) (resp []datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSchedules()
+}
+
 // Retrieve The network resource a Storage service is connected to.
 func (r Network_Storage_Backup_Evault) GetServiceResource() (resp datatypes.Network_Service_Resource, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getServiceResource", nil, &r.Options, &resp)
 	return
 }
 
+// GetServiceResourceWith is GetServiceResource, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetServiceResourceWith(options sl.Options) (resp datatypes.Network_Service_Resource, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceResource()
+}
+
 // Retrieve The IP address of a Storage resource.
 func (r Network_Storage_Backup_Evault) GetServiceResourceBackendIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getServiceResourceBackendIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetServiceResourceBackendIpAddressWith is GetServiceResourceBackendIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetServiceResourceBackendIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceResourceBackendIpAddress()
+}
+
 // Retrieve The name of a Storage's network resource.
 func (r Network_Storage_Backup_Evault) GetServiceResourceName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getServiceResourceName", nil, &r.Options, &resp)
 	return
 }
 
+// GetServiceResourceNameWith is GetServiceResourceName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetServiceResourceNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceResourceName()
+}
+
 // Retrieve A volume's configured snapshot space size.
 func (r Network_Storage_Backup_Evault) GetSnapshotCapacityGb() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getSnapshotCapacityGb", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotCapacityGbWith is GetSnapshotCapacityGb, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetSnapshotCapacityGbWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotCapacityGb()
+}
+
 // Retrieve The creation timestamp of the snapshot on the storage platform.
 func (r Network_Storage_Backup_Evault) GetSnapshotCreationTimestamp() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getSnapshotCreationTimestamp", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotCreationTimestampWith is GetSnapshotCreationTimestamp, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetSnapshotCreationTimestampWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotCreationTimestamp()
+}
+
 // Retrieve The percentage of used snapshot space after which to delete automated snapshots.
 func (r Network_Storage_Backup_Evault) GetSnapshotDeletionThresholdPercentage() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getSnapshotDeletionThresholdPercentage", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotDeletionThresholdPercentageWith is GetSnapshotDeletionThresholdPercentage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetSnapshotDeletionThresholdPercentageWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotDeletionThresholdPercentage()
+}
+
 // Retrieve The snapshot size in bytes.
 func (r Network_Storage_Backup_Evault) GetSnapshotSizeBytes() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getSnapshotSizeBytes", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotSizeBytesWith is GetSnapshotSizeBytes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetSnapshotSizeBytesWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotSizeBytes()
+}
+
 // Retrieve A volume's available snapshot reservation space.
 func (r Network_Storage_Backup_Evault) GetSnapshotSpaceAvailable() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getSnapshotSpaceAvailable", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotSpaceAvailableWith is GetSnapshotSpaceAvailable, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetSnapshotSpaceAvailableWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotSpaceAvailable()
+}
+
 // Retrieve The snapshots associated with this SoftLayer_Network_Storage volume.
 func (r Network_Storage_Backup_Evault) GetSnapshots() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getSnapshots", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotsWith is GetSnapshots, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetSnapshotsWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshots()
+}
+
 // Retrieves a list of snapshots for this SoftLayer_Network_Storage volume. This method works with the result limits and offset to support pagination.
 func (r Network_Storage_Backup_Evault) GetSnapshotsForVolume() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getSnapshotsForVolume", nil, &r.Options, &resp)
@@ -10622,6 +15076,14 @@ func (r Network_Storage_Backup_Evault) GetStorageGroups() (resp []datatypes.Netw
 	return
 }
 
+// GetStorageGroupsWith is GetStorageGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetStorageGroupsWith(options sl.Options) (resp []datatypes.Network_Storage_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStorageGroups()
+}
+
 // no documentation yet
 func (r Network_Storage_Backup_Evault) GetStorageGroupsNetworkConnectionDetails() (resp []datatypes.Container_Network_Storage_NetworkConnectionInformation, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getStorageGroupsNetworkConnectionDetails", nil, &r.Options, &resp)
@@ -10640,6 +15102,14 @@ func (r Network_Storage_Backup_Evault) GetStorageType() (resp datatypes.Network_
 	return
 }
 
+// GetStorageTypeWith is GetStorageType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetStorageTypeWith(options sl.Options) (resp datatypes.Network_Storage_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStorageType()
+}
+
 // no documentation yet
 func (r Network_Storage_Backup_Evault) GetTargetIpAddresses() (resp []string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getTargetIpAddresses", nil, &r.Options, &resp)
@@ -10652,18 +15122,42 @@ func (r Network_Storage_Backup_Evault) GetTotalBytesUsed() (resp string, err err
 	return
 }
 
+// GetTotalBytesUsedWith is GetTotalBytesUsed, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetTotalBytesUsedWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalBytesUsed()
+}
+
 // Retrieve The total snapshot retention count of all schedules on this network storage volume.
 func (r Network_Storage_Backup_Evault) GetTotalScheduleSnapshotRetentionCount() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getTotalScheduleSnapshotRetentionCount", nil, &r.Options, &resp)
 	return
 }
 
+// GetTotalScheduleSnapshotRetentionCountWith is GetTotalScheduleSnapshotRetentionCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetTotalScheduleSnapshotRetentionCountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalScheduleSnapshotRetentionCount()
+}
+
 // Retrieve The usage notification for SL Storage services.
 func (r Network_Storage_Backup_Evault) GetUsageNotification() (resp datatypes.Notification, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getUsageNotification", nil, &r.Options, &resp)
 	return
 }
 
+// GetUsageNotificationWith is GetUsageNotification, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetUsageNotificationWith(options sl.Options) (resp datatypes.Notification, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUsageNotification()
+}
+
 // no documentation yet
 func (r Network_Storage_Backup_Evault) GetValidReplicationTargetDatacenterLocations() (resp []datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getValidReplicationTargetDatacenterLocations", nil, &r.Options, &resp)
@@ -10676,12 +15170,28 @@ func (r Network_Storage_Backup_Evault) GetVendorName() (resp string, err error)
 	return
 }
 
+// GetVendorNameWith is GetVendorName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetVendorNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVendorName()
+}
+
 // Retrieve When applicable, the virtual guest associated with a Storage service.
 func (r Network_Storage_Backup_Evault) GetVirtualGuest() (resp datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getVirtualGuest", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestWith is GetVirtualGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetVirtualGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuest()
+}
+
 // This method returns the parameters for cloning a volume
 func (r Network_Storage_Backup_Evault) GetVolumeDuplicateParameters() (resp datatypes.Container_Network_Storage_VolumeDuplicateParameters, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getVolumeDuplicateParameters", nil, &r.Options, &resp)
@@ -10694,12 +15204,28 @@ func (r Network_Storage_Backup_Evault) GetVolumeHistory() (resp []datatypes.Netw
 	return
 }
 
+// GetVolumeHistoryWith is GetVolumeHistory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetVolumeHistoryWith(options sl.Options) (resp []datatypes.Network_Storage_History, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVolumeHistory()
+}
+
 // Retrieve The current status of a network storage volume.
 func (r Network_Storage_Backup_Evault) GetVolumeStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getVolumeStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetVolumeStatusWith is GetVolumeStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetVolumeStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVolumeStatus()
+}
+
 // no documentation yet
 func (r Network_Storage_Backup_Evault) GetWebCCAuthenticationDetails() (resp datatypes.Container_Network_Storage_Backup_Evault_WebCc_Authentication_Details, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getWebCCAuthenticationDetails", nil, &r.Options, &resp)
@@ -10712,12 +15238,28 @@ func (r Network_Storage_Backup_Evault) GetWebccAccount() (resp datatypes.Account
 	return
 }
 
+// GetWebccAccountWith is GetWebccAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetWebccAccountWith(options sl.Options) (resp datatypes.Account_Password, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetWebccAccount()
+}
+
 // Retrieve The Weekly Schedule which is associated with this network storage volume.
 func (r Network_Storage_Backup_Evault) GetWeeklySchedule() (resp datatypes.Network_Storage_Schedule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Backup_Evault", "getWeeklySchedule", nil, &r.Options, &resp)
 	return
 }
 
+// GetWeeklyScheduleWith is GetWeeklySchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Backup_Evault) GetWeeklyScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetWeeklySchedule()
+}
+
 // Immediate Failover to a volume replicant.  During the time which the replicant is in use the local nas volume will not be available.
 func (r Network_Storage_Backup_Evault) ImmediateFailoverToReplicant(replicantId *int) (resp bool, err error) {
 	params := []interface{}{
@@ -10999,6 +15541,11 @@ func (r Network_Storage_Group) Id(id int) Network_Storage_Group {
 	return r
 }
 
+func (r Network_Storage_Group) InitParameter(name string, value interface{}) Network_Storage_Group {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Group) Mask(mask string) Network_Storage_Group {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -11018,11 +15565,26 @@ func (r Network_Storage_Group) Limit(limit int) Network_Storage_Group {
 	return r
 }
 
+func (r Network_Storage_Group) Unlimited() Network_Storage_Group {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Group) Offset(offset int) Network_Storage_Group {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Group) Timeout(timeout time.Duration) Network_Storage_Group {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Group) WithContext(ctx context.Context) Network_Storage_Group {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Use this method to attach a SoftLayer_Network_Storage_Allowed_Host object to this group.  This will automatically enable access from this host to any SoftLayer_Network_Storage volumes currently attached to this group.
 func (r Network_Storage_Group) AddAllowedHost(allowedHost *datatypes.Network_Storage_Allowed_Host) (resp bool, err error) {
 	params := []interface{}{
@@ -11071,6 +15633,14 @@ func (r Network_Storage_Group) GetAccount() (resp datatypes.Account, err error)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Use this method to retrieve all network storage groups.
 func (r Network_Storage_Group) GetAllObjects() (resp []datatypes.Network_Storage_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group", "getAllObjects", nil, &r.Options, &resp)
@@ -11083,18 +15653,42 @@ func (r Network_Storage_Group) GetAllowedHosts() (resp []datatypes.Network_Stora
 	return
 }
 
+// GetAllowedHostsWith is GetAllowedHosts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group) GetAllowedHostsWith(options sl.Options) (resp []datatypes.Network_Storage_Allowed_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHosts()
+}
+
 // Retrieve The network storage volumes this group is attached to.
 func (r Network_Storage_Group) GetAttachedVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group", "getAttachedVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAttachedVolumesWith is GetAttachedVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group) GetAttachedVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttachedVolumes()
+}
+
 // Retrieve The type which defines this group.
 func (r Network_Storage_Group) GetGroupType() (resp datatypes.Network_Storage_Group_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group", "getGroupType", nil, &r.Options, &resp)
 	return
 }
 
+// GetGroupTypeWith is GetGroupType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group) GetGroupTypeWith(options sl.Options) (resp datatypes.Network_Storage_Group_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGroupType()
+}
+
 // Use this method to retrieve network connection information for SoftLayer_Network_Storage_Allowed_Host objects within this group.
 func (r Network_Storage_Group) GetNetworkConnectionDetails() (resp datatypes.Container_Network_Storage_NetworkConnectionInformation, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group", "getNetworkConnectionDetails", nil, &r.Options, &resp)
@@ -11113,12 +15707,28 @@ func (r Network_Storage_Group) GetOsType() (resp datatypes.Network_Storage_Iscsi
 	return
 }
 
+// GetOsTypeWith is GetOsType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group) GetOsTypeWith(options sl.Options) (resp datatypes.Network_Storage_Iscsi_OS_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOsType()
+}
+
 // Retrieve The network resource this group is created on.
 func (r Network_Storage_Group) GetServiceResource() (resp datatypes.Network_Service_Resource, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group", "getServiceResource", nil, &r.Options, &resp)
 	return
 }
 
+// GetServiceResourceWith is GetServiceResource, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group) GetServiceResourceWith(options sl.Options) (resp datatypes.Network_Service_Resource, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceResource()
+}
+
 // Use this method to remove a SoftLayer_Network_Storage_Allowed_Host object from this group.  This will automatically disable access from this host to any SoftLayer_Network_Storage volumes currently attached to this group.
 func (r Network_Storage_Group) RemoveAllowedHost(allowedHost *datatypes.Network_Storage_Allowed_Host) (resp bool, err error) {
 	params := []interface{}{
@@ -11153,6 +15763,11 @@ func (r Network_Storage_Group_Iscsi) Id(id int) Network_Storage_Group_Iscsi {
 	return r
 }
 
+func (r Network_Storage_Group_Iscsi) InitParameter(name string, value interface{}) Network_Storage_Group_Iscsi {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Group_Iscsi) Mask(mask string) Network_Storage_Group_Iscsi {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -11172,11 +15787,26 @@ func (r Network_Storage_Group_Iscsi) Limit(limit int) Network_Storage_Group_Iscs
 	return r
 }
 
+func (r Network_Storage_Group_Iscsi) Unlimited() Network_Storage_Group_Iscsi {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Group_Iscsi) Offset(offset int) Network_Storage_Group_Iscsi {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Group_Iscsi) Timeout(timeout time.Duration) Network_Storage_Group_Iscsi {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Group_Iscsi) WithContext(ctx context.Context) Network_Storage_Group_Iscsi {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Use this method to attach a SoftLayer_Network_Storage_Allowed_Host object to this group.  This will automatically enable access from this host to any SoftLayer_Network_Storage volumes currently attached to this group.
 func (r Network_Storage_Group_Iscsi) AddAllowedHost(allowedHost *datatypes.Network_Storage_Allowed_Host) (resp bool, err error) {
 	params := []interface{}{
@@ -11225,6 +15855,14 @@ func (r Network_Storage_Group_Iscsi) GetAccount() (resp datatypes.Account, err e
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group_Iscsi) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Use this method to retrieve all network storage groups.
 func (r Network_Storage_Group_Iscsi) GetAllObjects() (resp []datatypes.Network_Storage_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group_Iscsi", "getAllObjects", nil, &r.Options, &resp)
@@ -11237,18 +15875,42 @@ func (r Network_Storage_Group_Iscsi) GetAllowedHosts() (resp []datatypes.Network
 	return
 }
 
+// GetAllowedHostsWith is GetAllowedHosts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group_Iscsi) GetAllowedHostsWith(options sl.Options) (resp []datatypes.Network_Storage_Allowed_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHosts()
+}
+
 // Retrieve The network storage volumes this group is attached to.
 func (r Network_Storage_Group_Iscsi) GetAttachedVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group_Iscsi", "getAttachedVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAttachedVolumesWith is GetAttachedVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group_Iscsi) GetAttachedVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttachedVolumes()
+}
+
 // Retrieve The type which defines this group.
 func (r Network_Storage_Group_Iscsi) GetGroupType() (resp datatypes.Network_Storage_Group_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group_Iscsi", "getGroupType", nil, &r.Options, &resp)
 	return
 }
 
+// GetGroupTypeWith is GetGroupType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group_Iscsi) GetGroupTypeWith(options sl.Options) (resp datatypes.Network_Storage_Group_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGroupType()
+}
+
 // Use this method to retrieve network connection information for SoftLayer_Network_Storage_Allowed_Host objects within this group.
 func (r Network_Storage_Group_Iscsi) GetNetworkConnectionDetails() (resp datatypes.Container_Network_Storage_NetworkConnectionInformation, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group_Iscsi", "getNetworkConnectionDetails", nil, &r.Options, &resp)
@@ -11267,12 +15929,28 @@ func (r Network_Storage_Group_Iscsi) GetOsType() (resp datatypes.Network_Storage
 	return
 }
 
+// GetOsTypeWith is GetOsType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group_Iscsi) GetOsTypeWith(options sl.Options) (resp datatypes.Network_Storage_Iscsi_OS_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOsType()
+}
+
 // Retrieve The network resource this group is created on.
 func (r Network_Storage_Group_Iscsi) GetServiceResource() (resp datatypes.Network_Service_Resource, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group_Iscsi", "getServiceResource", nil, &r.Options, &resp)
 	return
 }
 
+// GetServiceResourceWith is GetServiceResource, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group_Iscsi) GetServiceResourceWith(options sl.Options) (resp datatypes.Network_Service_Resource, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceResource()
+}
+
 // Use this method to remove a SoftLayer_Network_Storage_Allowed_Host object from this group.  This will automatically disable access from this host to any SoftLayer_Network_Storage volumes currently attached to this group.
 func (r Network_Storage_Group_Iscsi) RemoveAllowedHost(allowedHost *datatypes.Network_Storage_Allowed_Host) (resp bool, err error) {
 	params := []interface{}{
@@ -11307,6 +15985,11 @@ func (r Network_Storage_Group_Nfs) Id(id int) Network_Storage_Group_Nfs {
 	return r
 }
 
+func (r Network_Storage_Group_Nfs) InitParameter(name string, value interface{}) Network_Storage_Group_Nfs {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Group_Nfs) Mask(mask string) Network_Storage_Group_Nfs {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -11326,11 +16009,26 @@ func (r Network_Storage_Group_Nfs) Limit(limit int) Network_Storage_Group_Nfs {
 	return r
 }
 
+func (r Network_Storage_Group_Nfs) Unlimited() Network_Storage_Group_Nfs {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Group_Nfs) Offset(offset int) Network_Storage_Group_Nfs {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Group_Nfs) Timeout(timeout time.Duration) Network_Storage_Group_Nfs {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Group_Nfs) WithContext(ctx context.Context) Network_Storage_Group_Nfs {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Use this method to attach a SoftLayer_Network_Storage_Allowed_Host object to this group.  This will automatically enable access from this host to any SoftLayer_Network_Storage volumes currently attached to this group.
 func (r Network_Storage_Group_Nfs) AddAllowedHost(allowedHost *datatypes.Network_Storage_Allowed_Host) (resp bool, err error) {
 	params := []interface{}{
@@ -11379,6 +16077,14 @@ func (r Network_Storage_Group_Nfs) GetAccount() (resp datatypes.Account, err err
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group_Nfs) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Use this method to retrieve all network storage groups.
 func (r Network_Storage_Group_Nfs) GetAllObjects() (resp []datatypes.Network_Storage_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group_Nfs", "getAllObjects", nil, &r.Options, &resp)
@@ -11391,18 +16097,42 @@ func (r Network_Storage_Group_Nfs) GetAllowedHosts() (resp []datatypes.Network_S
 	return
 }
 
+// GetAllowedHostsWith is GetAllowedHosts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group_Nfs) GetAllowedHostsWith(options sl.Options) (resp []datatypes.Network_Storage_Allowed_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHosts()
+}
+
 // Retrieve The network storage volumes this group is attached to.
 func (r Network_Storage_Group_Nfs) GetAttachedVolumes() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group_Nfs", "getAttachedVolumes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAttachedVolumesWith is GetAttachedVolumes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group_Nfs) GetAttachedVolumesWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttachedVolumes()
+}
+
 // Retrieve The type which defines this group.
 func (r Network_Storage_Group_Nfs) GetGroupType() (resp datatypes.Network_Storage_Group_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group_Nfs", "getGroupType", nil, &r.Options, &resp)
 	return
 }
 
+// GetGroupTypeWith is GetGroupType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group_Nfs) GetGroupTypeWith(options sl.Options) (resp datatypes.Network_Storage_Group_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGroupType()
+}
+
 // Use this method to retrieve network connection information for SoftLayer_Network_Storage_Allowed_Host objects within this group.
 func (r Network_Storage_Group_Nfs) GetNetworkConnectionDetails() (resp datatypes.Container_Network_Storage_NetworkConnectionInformation, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group_Nfs", "getNetworkConnectionDetails", nil, &r.Options, &resp)
@@ -11421,12 +16151,28 @@ func (r Network_Storage_Group_Nfs) GetOsType() (resp datatypes.Network_Storage_I
 	return
 }
 
+// GetOsTypeWith is GetOsType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group_Nfs) GetOsTypeWith(options sl.Options) (resp datatypes.Network_Storage_Iscsi_OS_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOsType()
+}
+
 // Retrieve The network resource this group is created on.
 func (r Network_Storage_Group_Nfs) GetServiceResource() (resp datatypes.Network_Service_Resource, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group_Nfs", "getServiceResource", nil, &r.Options, &resp)
 	return
 }
 
+// GetServiceResourceWith is GetServiceResource, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Group_Nfs) GetServiceResourceWith(options sl.Options) (resp datatypes.Network_Service_Resource, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceResource()
+}
+
 // Use this method to remove a SoftLayer_Network_Storage_Allowed_Host object from this group.  This will automatically disable access from this host to any SoftLayer_Network_Storage volumes currently attached to this group.
 func (r Network_Storage_Group_Nfs) RemoveAllowedHost(allowedHost *datatypes.Network_Storage_Allowed_Host) (resp bool, err error) {
 	params := []interface{}{
@@ -11461,6 +16207,11 @@ func (r Network_Storage_Group_Type) Id(id int) Network_Storage_Group_Type {
 	return r
 }
 
+func (r Network_Storage_Group_Type) InitParameter(name string, value interface{}) Network_Storage_Group_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Group_Type) Mask(mask string) Network_Storage_Group_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -11480,11 +16231,26 @@ func (r Network_Storage_Group_Type) Limit(limit int) Network_Storage_Group_Type
 	return r
 }
 
+func (r Network_Storage_Group_Type) Unlimited() Network_Storage_Group_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Group_Type) Offset(offset int) Network_Storage_Group_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Group_Type) Timeout(timeout time.Duration) Network_Storage_Group_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Group_Type) WithContext(ctx context.Context) Network_Storage_Group_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Use this method to retrieve all storage group types available.
 func (r Network_Storage_Group_Type) GetAllObjects() (resp []datatypes.Network_Storage_Group_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Group_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -11513,6 +16279,11 @@ func (r Network_Storage_Hub_Cleversafe_Account) Id(id int) Network_Storage_Hub_C
 	return r
 }
 
+func (r Network_Storage_Hub_Cleversafe_Account) InitParameter(name string, value interface{}) Network_Storage_Hub_Cleversafe_Account {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Hub_Cleversafe_Account) Mask(mask string) Network_Storage_Hub_Cleversafe_Account {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -11532,11 +16303,26 @@ func (r Network_Storage_Hub_Cleversafe_Account) Limit(limit int) Network_Storage
 	return r
 }
 
+func (r Network_Storage_Hub_Cleversafe_Account) Unlimited() Network_Storage_Hub_Cleversafe_Account {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Hub_Cleversafe_Account) Offset(offset int) Network_Storage_Hub_Cleversafe_Account {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Hub_Cleversafe_Account) Timeout(timeout time.Duration) Network_Storage_Hub_Cleversafe_Account {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Hub_Cleversafe_Account) WithContext(ctx context.Context) Network_Storage_Hub_Cleversafe_Account {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create credentials for an IBM Cloud Object Storage Account
 func (r Network_Storage_Hub_Cleversafe_Account) CredentialCreate() (resp []datatypes.Network_Storage_Credential, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Hub_Cleversafe_Account", "credentialCreate", nil, &r.Options, &resp)
@@ -11558,6 +16344,14 @@ func (r Network_Storage_Hub_Cleversafe_Account) GetAccount() (resp datatypes.Acc
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Hub_Cleversafe_Account) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // no documentation yet
 func (r Network_Storage_Hub_Cleversafe_Account) GetAllObjects() (resp []datatypes.Network_Storage_Hub_Cleversafe_Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Hub_Cleversafe_Account", "getAllObjects", nil, &r.Options, &resp)
@@ -11570,6 +16364,14 @@ func (r Network_Storage_Hub_Cleversafe_Account) GetBillingItem() (resp datatypes
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Hub_Cleversafe_Account) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Get buckets
 func (r Network_Storage_Hub_Cleversafe_Account) GetBuckets() (resp []datatypes.Container_Network_Storage_Hub_ObjectStorage_Bucket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Hub_Cleversafe_Account", "getBuckets", nil, &r.Options, &resp)
@@ -11582,6 +16384,14 @@ func (r Network_Storage_Hub_Cleversafe_Account) GetCancelledBillingItem() (resp
 	return
 }
 
+// GetCancelledBillingItemWith is GetCancelledBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Hub_Cleversafe_Account) GetCancelledBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCancelledBillingItem()
+}
+
 // Returns the capacity usage for an IBM Cloud Object Storage account.
 func (r Network_Storage_Hub_Cleversafe_Account) GetCapacityUsage() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Hub_Cleversafe_Account", "getCapacityUsage", nil, &r.Options, &resp)
@@ -11629,6 +16439,14 @@ func (r Network_Storage_Hub_Cleversafe_Account) GetCredentials() (resp []datatyp
 	return
 }
 
+// GetCredentialsWith is GetCredentials, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Hub_Cleversafe_Account) GetCredentialsWith(options sl.Options) (resp []datatypes.Network_Storage_Credential, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCredentials()
+}
+
 // Returns a collection of endpoint URLs available to this IBM Cloud Object Storage account.
 func (r Network_Storage_Hub_Cleversafe_Account) GetEndpoints(accountId *int) (resp []datatypes.Container_Network_Storage_Hub_ObjectStorage_Endpoint, err error) {
 	params := []interface{}{
@@ -11644,6 +16462,14 @@ func (r Network_Storage_Hub_Cleversafe_Account) GetMetricTrackingObject() (resp
 	return
 }
 
+// GetMetricTrackingObjectWith is GetMetricTrackingObject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Hub_Cleversafe_Account) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+}
+
 // no documentation yet
 func (r Network_Storage_Hub_Cleversafe_Account) GetObject() (resp datatypes.Network_Storage_Hub_Cleversafe_Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Hub_Cleversafe_Account", "getObject", nil, &r.Options, &resp)
@@ -11656,6 +16482,14 @@ func (r Network_Storage_Hub_Cleversafe_Account) GetUuid() (resp string, err erro
 	return
 }
 
+// GetUuidWith is GetUuid, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Hub_Cleversafe_Account) GetUuidWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUuid()
+}
+
 // no documentation yet
 type Network_Storage_Hub_Swift_Share struct {
 	Session *session.Session
@@ -11672,6 +16506,11 @@ func (r Network_Storage_Hub_Swift_Share) Id(id int) Network_Storage_Hub_Swift_Sh
 	return r
 }
 
+func (r Network_Storage_Hub_Swift_Share) InitParameter(name string, value interface{}) Network_Storage_Hub_Swift_Share {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Hub_Swift_Share) Mask(mask string) Network_Storage_Hub_Swift_Share {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -11691,11 +16530,26 @@ func (r Network_Storage_Hub_Swift_Share) Limit(limit int) Network_Storage_Hub_Sw
 	return r
 }
 
+func (r Network_Storage_Hub_Swift_Share) Unlimited() Network_Storage_Hub_Swift_Share {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Hub_Swift_Share) Offset(offset int) Network_Storage_Hub_Swift_Share {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Hub_Swift_Share) Timeout(timeout time.Duration) Network_Storage_Hub_Swift_Share {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Hub_Swift_Share) WithContext(ctx context.Context) Network_Storage_Hub_Swift_Share {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method returns a collection of container objects.
 func (r Network_Storage_Hub_Swift_Share) GetContainerList() (resp []datatypes.Container_Network_Storage_Hub_ObjectStorage_Folder, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Hub_Swift_Share", "getContainerList", nil, &r.Options, &resp)
@@ -11738,6 +16592,11 @@ func (r Network_Storage_Iscsi) Id(id int) Network_Storage_Iscsi {
 	return r
 }
 
+func (r Network_Storage_Iscsi) InitParameter(name string, value interface{}) Network_Storage_Iscsi {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Iscsi) Mask(mask string) Network_Storage_Iscsi {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -11757,11 +16616,26 @@ func (r Network_Storage_Iscsi) Limit(limit int) Network_Storage_Iscsi {
 	return r
 }
 
+func (r Network_Storage_Iscsi) Unlimited() Network_Storage_Iscsi {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Iscsi) Offset(offset int) Network_Storage_Iscsi {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Iscsi) Timeout(timeout time.Duration) Network_Storage_Iscsi {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Iscsi) WithContext(ctx context.Context) Network_Storage_Iscsi {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is used to modify the access control list for this Storage volume.  The SoftLayer_Hardware objects which have been allowed access to this storage will be listed in the allowedHardware property of this storage volume.
 func (r Network_Storage_Iscsi) AllowAccessFromHardware(hardwareObjectTemplate *datatypes.Hardware) (resp bool, err error) {
 	params := []interface{}{
@@ -12130,18 +17004,42 @@ func (r Network_Storage_Iscsi) GetAccount() (resp datatypes.Account, err error)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve Other usernames and passwords associated with a Storage volume.
 func (r Network_Storage_Iscsi) GetAccountPassword() (resp datatypes.Account_Password, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getAccountPassword", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountPasswordWith is GetAccountPassword, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetAccountPasswordWith(options sl.Options) (resp datatypes.Account_Password, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountPassword()
+}
+
 // Retrieve The currently active transactions on a network storage volume.
 func (r Network_Storage_Iscsi) GetActiveTransactions() (resp []datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getActiveTransactions", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveTransactionsWith is GetActiveTransactions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetActiveTransactionsWith(options sl.Options) (resp []datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransactions()
+}
+
 // {{CloudLayerOnlyMethod}} Retrieve details such as id, name, size, create date for all files in a Storage account's root directory. This does not download file content.
 func (r Network_Storage_Iscsi) GetAllFiles() (resp []datatypes.Container_Utility_File_Entity, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getAllFiles", nil, &r.Options, &resp)
@@ -12200,6 +17098,14 @@ func (r Network_Storage_Iscsi) GetAllowedHardware() (resp []datatypes.Hardware,
 	return
 }
 
+// GetAllowedHardwareWith is GetAllowedHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetAllowedHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHardware()
+}
+
 // Retrieves the total number of allowed hosts limit per volume.
 func (r Network_Storage_Iscsi) GetAllowedHostsLimit() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getAllowedHostsLimit", nil, &r.Options, &resp)
@@ -12212,48 +17118,112 @@ func (r Network_Storage_Iscsi) GetAllowedIpAddresses() (resp []datatypes.Network
 	return
 }
 
+// GetAllowedIpAddressesWith is GetAllowedIpAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetAllowedIpAddressesWith(options sl.Options) (resp []datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedIpAddresses()
+}
+
 // Retrieve The SoftLayer_Hardware objects which are allowed access to this storage volume's Replicant.
 func (r Network_Storage_Iscsi) GetAllowedReplicationHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getAllowedReplicationHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedReplicationHardwareWith is GetAllowedReplicationHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetAllowedReplicationHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedReplicationHardware()
+}
+
 // Retrieve The SoftLayer_Network_Subnet_IpAddress objects which are allowed access to this storage volume's Replicant.
 func (r Network_Storage_Iscsi) GetAllowedReplicationIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getAllowedReplicationIpAddresses", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedReplicationIpAddressesWith is GetAllowedReplicationIpAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetAllowedReplicationIpAddressesWith(options sl.Options) (resp []datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedReplicationIpAddresses()
+}
+
 // Retrieve The SoftLayer_Network_Subnet objects which are allowed access to this storage volume's Replicant.
 func (r Network_Storage_Iscsi) GetAllowedReplicationSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getAllowedReplicationSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedReplicationSubnetsWith is GetAllowedReplicationSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetAllowedReplicationSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedReplicationSubnets()
+}
+
 // Retrieve The SoftLayer_Hardware objects which are allowed access to this storage volume's Replicant.
 func (r Network_Storage_Iscsi) GetAllowedReplicationVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getAllowedReplicationVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedReplicationVirtualGuestsWith is GetAllowedReplicationVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetAllowedReplicationVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedReplicationVirtualGuests()
+}
+
 // Retrieve The SoftLayer_Network_Subnet objects which are allowed access to this storage volume.
 func (r Network_Storage_Iscsi) GetAllowedSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getAllowedSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedSubnetsWith is GetAllowedSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetAllowedSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedSubnets()
+}
+
 // Retrieve The SoftLayer_Virtual_Guest objects which are allowed access to this storage volume.
 func (r Network_Storage_Iscsi) GetAllowedVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getAllowedVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedVirtualGuestsWith is GetAllowedVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetAllowedVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedVirtualGuests()
+}
+
 // Retrieve The current billing item for a Storage volume.
 func (r Network_Storage_Iscsi) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve
 func (r Network_Storage_Iscsi) GetBillingItemCategory() (resp datatypes.Product_Item_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getBillingItemCategory", nil, &r.Options, &resp)
@@ -12276,6 +17246,14 @@ func (r Network_Storage_Iscsi) GetBytesUsed() (resp string, err error) {
 	return
 }
 
+// GetBytesUsedWith is GetBytesUsed, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetBytesUsedWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBytesUsed()
+}
+
 // no documentation yet
 func (r Network_Storage_Iscsi) GetCdnUrls() (resp []datatypes.Container_Network_Storage_Hub_ObjectStorage_ContentDeliveryUrl, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getCdnUrls", nil, &r.Options, &resp)
@@ -12294,6 +17272,14 @@ func (r Network_Storage_Iscsi) GetCreationScheduleId() (resp string, err error)
 	return
 }
 
+// GetCreationScheduleIdWith is GetCreationScheduleId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetCreationScheduleIdWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCreationScheduleId()
+}
+
 // Retrieve
 func (r Network_Storage_Iscsi) GetCredentials() (resp []datatypes.Network_Storage_Credential, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getCredentials", nil, &r.Options, &resp)
@@ -12306,12 +17292,28 @@ func (r Network_Storage_Iscsi) GetDailySchedule() (resp datatypes.Network_Storag
 	return
 }
 
+// GetDailyScheduleWith is GetDailySchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetDailyScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDailySchedule()
+}
+
 // Retrieve The events which have taken place on a network storage volume.
 func (r Network_Storage_Iscsi) GetEvents() (resp []datatypes.Network_Storage_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getEvents", nil, &r.Options, &resp)
 	return
 }
 
+// GetEventsWith is GetEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetEventsWith(options sl.Options) (resp []datatypes.Network_Storage_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvents()
+}
+
 //
 //
 //
@@ -12351,6 +17353,14 @@ func (r Network_Storage_Iscsi) GetFileNetworkMountAddress() (resp string, err er
 	return
 }
 
+// GetFileNetworkMountAddressWith is GetFileNetworkMountAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetFileNetworkMountAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFileNetworkMountAddress()
+}
+
 // {{CloudLayerOnlyMethod}} Retrieve the number of files pending deletion in a Storage account's recycle bin. Files in an account's recycle bin may either be restored to the account's root directory or permanently deleted.
 func (r Network_Storage_Iscsi) GetFilePendingDeleteCount() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getFilePendingDeleteCount", nil, &r.Options, &resp)
@@ -12388,6 +17398,14 @@ func (r Network_Storage_Iscsi) GetHardware() (resp datatypes.Hardware, err error
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve
 func (r Network_Storage_Iscsi) GetHasEncryptionAtRest() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getHasEncryptionAtRest", nil, &r.Options, &resp)
@@ -12400,54 +17418,126 @@ func (r Network_Storage_Iscsi) GetHourlySchedule() (resp datatypes.Network_Stora
 	return
 }
 
+// GetHourlyScheduleWith is GetHourlySchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetHourlyScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlySchedule()
+}
+
 // Retrieve The Interval Schedule which is associated with this network storage volume.
 func (r Network_Storage_Iscsi) GetIntervalSchedule() (resp datatypes.Network_Storage_Schedule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getIntervalSchedule", nil, &r.Options, &resp)
 	return
 }
 
+// GetIntervalScheduleWith is GetIntervalSchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetIntervalScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIntervalSchedule()
+}
+
 // Retrieve The maximum number of IOPs selected for this volume.
 func (r Network_Storage_Iscsi) GetIops() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getIops", nil, &r.Options, &resp)
 	return
 }
 
+// GetIopsWith is GetIops, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetIopsWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIops()
+}
+
 // Retrieve Determines whether a volume is ready to order snapshot space, or, if snapshot space is already available, to assign a snapshot schedule, or to take a manual snapshot.
 func (r Network_Storage_Iscsi) GetIsReadyForSnapshot() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getIsReadyForSnapshot", nil, &r.Options, &resp)
 	return
 }
 
+// GetIsReadyForSnapshotWith is GetIsReadyForSnapshot, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetIsReadyForSnapshotWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsReadyForSnapshot()
+}
+
 // Retrieve Determines whether a volume is ready to have Hosts authorized to access it. This does not indicate whether another operation may be blocking, please refer to this volume's volumeStatus property for details.
 func (r Network_Storage_Iscsi) GetIsReadyToMount() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getIsReadyToMount", nil, &r.Options, &resp)
 	return
 }
 
+// GetIsReadyToMountWith is GetIsReadyToMount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetIsReadyToMountWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsReadyToMount()
+}
+
 // Retrieve Relationship between a container volume and iSCSI LUNs.
 func (r Network_Storage_Iscsi) GetIscsiLuns() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getIscsiLuns", nil, &r.Options, &resp)
 	return
 }
 
+// GetIscsiLunsWith is GetIscsiLuns, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetIscsiLunsWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIscsiLuns()
+}
+
 // Retrieve Returns the target IP addresses of an iSCSI volume.
 func (r Network_Storage_Iscsi) GetIscsiTargetIpAddresses() (resp []string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getIscsiTargetIpAddresses", nil, &r.Options, &resp)
 	return
 }
 
+// GetIscsiTargetIpAddressesWith is GetIscsiTargetIpAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetIscsiTargetIpAddressesWith(options sl.Options) (resp []string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIscsiTargetIpAddresses()
+}
+
 // Retrieve The ID of the LUN volume.
 func (r Network_Storage_Iscsi) GetLunId() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getLunId", nil, &r.Options, &resp)
 	return
 }
 
+// GetLunIdWith is GetLunId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetLunIdWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLunId()
+}
+
 // Retrieve The manually-created snapshots associated with this SoftLayer_Network_Storage volume. Does not support pagination by result limit and offset.
 func (r Network_Storage_Iscsi) GetManualSnapshots() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getManualSnapshots", nil, &r.Options, &resp)
 	return
 }
 
+// GetManualSnapshotsWith is GetManualSnapshots, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetManualSnapshotsWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManualSnapshots()
+}
+
 // no documentation yet
 func (r Network_Storage_Iscsi) GetMaximumExpansionSize() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getMaximumExpansionSize", nil, &r.Options, &resp)
@@ -12460,18 +17550,42 @@ func (r Network_Storage_Iscsi) GetMetricTrackingObject() (resp datatypes.Metric_
 	return
 }
 
+// GetMetricTrackingObjectWith is GetMetricTrackingObject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+}
+
 // Retrieve Whether or not a network storage volume may be mounted.
 func (r Network_Storage_Iscsi) GetMountableFlag() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getMountableFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetMountableFlagWith is GetMountableFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetMountableFlagWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMountableFlag()
+}
+
 // Retrieve The current status of split or move operation as a part of volume duplication.
 func (r Network_Storage_Iscsi) GetMoveAndSplitStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getMoveAndSplitStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetMoveAndSplitStatusWith is GetMoveAndSplitStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetMoveAndSplitStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMoveAndSplitStatus()
+}
+
 // no documentation yet
 func (r Network_Storage_Iscsi) GetNetworkConnectionDetails() (resp datatypes.Container_Network_Storage_NetworkConnectionInformation, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getNetworkConnectionDetails", nil, &r.Options, &resp)
@@ -12490,6 +17604,14 @@ func (r Network_Storage_Iscsi) GetNotificationSubscribers() (resp []datatypes.No
 	return
 }
 
+// GetNotificationSubscribersWith is GetNotificationSubscribers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetNotificationSubscribersWith(options sl.Options) (resp []datatypes.Notification_User_Subscriber, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNotificationSubscribers()
+}
+
 // no documentation yet
 func (r Network_Storage_Iscsi) GetObject() (resp datatypes.Network_Storage_Iscsi, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getObject", nil, &r.Options, &resp)
@@ -12517,66 +17639,154 @@ func (r Network_Storage_Iscsi) GetOriginalSnapshotName() (resp string, err error
 	return
 }
 
+// GetOriginalSnapshotNameWith is GetOriginalSnapshotName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetOriginalSnapshotNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOriginalSnapshotName()
+}
+
 // Retrieve The name of the volume that this volume was duplicated from.
 func (r Network_Storage_Iscsi) GetOriginalVolumeName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getOriginalVolumeName", nil, &r.Options, &resp)
 	return
 }
 
+// GetOriginalVolumeNameWith is GetOriginalVolumeName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetOriginalVolumeNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOriginalVolumeName()
+}
+
 // Retrieve The size (in GB) of the volume or LUN before any size expansion, or of the volume (before any possible size expansion) from which the duplicate volume or LUN was created.
 func (r Network_Storage_Iscsi) GetOriginalVolumeSize() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getOriginalVolumeSize", nil, &r.Options, &resp)
 	return
 }
 
+// GetOriginalVolumeSizeWith is GetOriginalVolumeSize, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetOriginalVolumeSizeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOriginalVolumeSize()
+}
+
 // Retrieve A volume's configured SoftLayer_Network_Storage_Iscsi_OS_Type.
 func (r Network_Storage_Iscsi) GetOsType() (resp datatypes.Network_Storage_Iscsi_OS_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getOsType", nil, &r.Options, &resp)
 	return
 }
 
+// GetOsTypeWith is GetOsType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetOsTypeWith(options sl.Options) (resp datatypes.Network_Storage_Iscsi_OS_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOsType()
+}
+
 // Retrieve A volume's configured SoftLayer_Network_Storage_Iscsi_OS_Type ID.
 func (r Network_Storage_Iscsi) GetOsTypeId() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getOsTypeId", nil, &r.Options, &resp)
 	return
 }
 
+// GetOsTypeIdWith is GetOsTypeId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetOsTypeIdWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOsTypeId()
+}
+
 // Retrieve The volumes or snapshots partnered with a network storage volume in a parental role.
 func (r Network_Storage_Iscsi) GetParentPartnerships() (resp []datatypes.Network_Storage_Partnership, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getParentPartnerships", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentPartnershipsWith is GetParentPartnerships, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetParentPartnershipsWith(options sl.Options) (resp []datatypes.Network_Storage_Partnership, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentPartnerships()
+}
+
 // Retrieve The parent volume of a volume in a complex storage relationship.
 func (r Network_Storage_Iscsi) GetParentVolume() (resp datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getParentVolume", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentVolumeWith is GetParentVolume, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetParentVolumeWith(options sl.Options) (resp datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParentVolume()
+}
+
 // Retrieve The volumes or snapshots partnered with a network storage volume.
 func (r Network_Storage_Iscsi) GetPartnerships() (resp []datatypes.Network_Storage_Partnership, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getPartnerships", nil, &r.Options, &resp)
 	return
 }
 
+// GetPartnershipsWith is GetPartnerships, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetPartnershipsWith(options sl.Options) (resp []datatypes.Network_Storage_Partnership, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPartnerships()
+}
+
 // Retrieve All permissions group(s) this volume is in.
 func (r Network_Storage_Iscsi) GetPermissionsGroups() (resp []datatypes.Network_Storage_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getPermissionsGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetPermissionsGroupsWith is GetPermissionsGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetPermissionsGroupsWith(options sl.Options) (resp []datatypes.Network_Storage_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPermissionsGroups()
+}
+
 // Retrieve The properties used to provide additional details about a network storage volume.
 func (r Network_Storage_Iscsi) GetProperties() (resp []datatypes.Network_Storage_Property, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getProperties", nil, &r.Options, &resp)
 	return
 }
 
+// GetPropertiesWith is GetProperties, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetPropertiesWith(options sl.Options) (resp []datatypes.Network_Storage_Property, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProperties()
+}
+
 // Retrieve The number of IOPs provisioned for this volume.
 func (r Network_Storage_Iscsi) GetProvisionedIops() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getProvisionedIops", nil, &r.Options, &resp)
 	return
 }
 
+// GetProvisionedIopsWith is GetProvisionedIops, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetProvisionedIopsWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProvisionedIops()
+}
+
 // {{CloudLayerOnlyMethod}} Retrieve the details of a file that is pending deletion in a Storage account's a recycle bin.
 func (r Network_Storage_Iscsi) GetRecycleBinFileByIdentifier(fileId *string) (resp datatypes.Container_Utility_File_Entity, err error) {
 	params := []interface{}{
@@ -12604,96 +17814,224 @@ func (r Network_Storage_Iscsi) GetReplicatingLuns() (resp []datatypes.Network_St
 	return
 }
 
+// GetReplicatingLunsWith is GetReplicatingLuns, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetReplicatingLunsWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicatingLuns()
+}
+
 // Retrieve The network storage volume being replicated by a volume.
 func (r Network_Storage_Iscsi) GetReplicatingVolume() (resp datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getReplicatingVolume", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicatingVolumeWith is GetReplicatingVolume, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetReplicatingVolumeWith(options sl.Options) (resp datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicatingVolume()
+}
+
 // Retrieve The volume replication events.
 func (r Network_Storage_Iscsi) GetReplicationEvents() (resp []datatypes.Network_Storage_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getReplicationEvents", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicationEventsWith is GetReplicationEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetReplicationEventsWith(options sl.Options) (resp []datatypes.Network_Storage_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicationEvents()
+}
+
 // Retrieve The network storage volumes configured to be replicants of a volume.
 func (r Network_Storage_Iscsi) GetReplicationPartners() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getReplicationPartners", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicationPartnersWith is GetReplicationPartners, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetReplicationPartnersWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicationPartners()
+}
+
 // Retrieve The Replication Schedule associated with a network storage volume.
 func (r Network_Storage_Iscsi) GetReplicationSchedule() (resp datatypes.Network_Storage_Schedule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getReplicationSchedule", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicationScheduleWith is GetReplicationSchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetReplicationScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicationSchedule()
+}
+
 // Retrieve The current replication status of a network storage volume. Indicates Failover or Failback status.
 func (r Network_Storage_Iscsi) GetReplicationStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getReplicationStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicationStatusWith is GetReplicationStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetReplicationStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicationStatus()
+}
+
 // Retrieve The schedules which are associated with a network storage volume.
 func (r Network_Storage_Iscsi) GetSchedules() (resp []datatypes.Network_Storage_Schedule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getSchedules", nil, &r.Options, &resp)
 	return
 }
 
+// GetSchedulesWith is GetSchedules, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetSchedulesWith(options sl.Options) (resp []datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSchedules()
+}
+
 // Retrieve The network resource a Storage service is connected to.
 func (r Network_Storage_Iscsi) GetServiceResource() (resp datatypes.Network_Service_Resource, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getServiceResource", nil, &r.Options, &resp)
 	return
 }
 
+// GetServiceResourceWith is GetServiceResource, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetServiceResourceWith(options sl.Options) (resp datatypes.Network_Service_Resource, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceResource()
+}
+
 // Retrieve The IP address of a Storage resource.
 func (r Network_Storage_Iscsi) GetServiceResourceBackendIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getServiceResourceBackendIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetServiceResourceBackendIpAddressWith is GetServiceResourceBackendIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetServiceResourceBackendIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceResourceBackendIpAddress()
+}
+
 // Retrieve The name of a Storage's network resource.
 func (r Network_Storage_Iscsi) GetServiceResourceName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getServiceResourceName", nil, &r.Options, &resp)
 	return
 }
 
+// GetServiceResourceNameWith is GetServiceResourceName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetServiceResourceNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceResourceName()
+}
+
 // Retrieve A volume's configured snapshot space size.
 func (r Network_Storage_Iscsi) GetSnapshotCapacityGb() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getSnapshotCapacityGb", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotCapacityGbWith is GetSnapshotCapacityGb, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetSnapshotCapacityGbWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotCapacityGb()
+}
+
 // Retrieve The creation timestamp of the snapshot on the storage platform.
 func (r Network_Storage_Iscsi) GetSnapshotCreationTimestamp() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getSnapshotCreationTimestamp", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotCreationTimestampWith is GetSnapshotCreationTimestamp, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetSnapshotCreationTimestampWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotCreationTimestamp()
+}
+
 // Retrieve The percentage of used snapshot space after which to delete automated snapshots.
 func (r Network_Storage_Iscsi) GetSnapshotDeletionThresholdPercentage() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getSnapshotDeletionThresholdPercentage", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotDeletionThresholdPercentageWith is GetSnapshotDeletionThresholdPercentage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetSnapshotDeletionThresholdPercentageWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotDeletionThresholdPercentage()
+}
+
 // Retrieve The snapshot size in bytes.
 func (r Network_Storage_Iscsi) GetSnapshotSizeBytes() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getSnapshotSizeBytes", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotSizeBytesWith is GetSnapshotSizeBytes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetSnapshotSizeBytesWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotSizeBytes()
+}
+
 // Retrieve A volume's available snapshot reservation space.
 func (r Network_Storage_Iscsi) GetSnapshotSpaceAvailable() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getSnapshotSpaceAvailable", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotSpaceAvailableWith is GetSnapshotSpaceAvailable, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetSnapshotSpaceAvailableWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshotSpaceAvailable()
+}
+
 // Retrieve The snapshots associated with this SoftLayer_Network_Storage volume.
 func (r Network_Storage_Iscsi) GetSnapshots() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getSnapshots", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotsWith is GetSnapshots, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetSnapshotsWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshots()
+}
+
 // Retrieves a list of snapshots for this SoftLayer_Network_Storage volume. This method works with the result limits and offset to support pagination.
 func (r Network_Storage_Iscsi) GetSnapshotsForVolume() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getSnapshotsForVolume", nil, &r.Options, &resp)
@@ -12712,6 +18050,14 @@ func (r Network_Storage_Iscsi) GetStorageGroups() (resp []datatypes.Network_Stor
 	return
 }
 
+// GetStorageGroupsWith is GetStorageGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetStorageGroupsWith(options sl.Options) (resp []datatypes.Network_Storage_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStorageGroups()
+}
+
 // no documentation yet
 func (r Network_Storage_Iscsi) GetStorageGroupsNetworkConnectionDetails() (resp []datatypes.Container_Network_Storage_NetworkConnectionInformation, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getStorageGroupsNetworkConnectionDetails", nil, &r.Options, &resp)
@@ -12730,6 +18076,14 @@ func (r Network_Storage_Iscsi) GetStorageType() (resp datatypes.Network_Storage_
 	return
 }
 
+// GetStorageTypeWith is GetStorageType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetStorageTypeWith(options sl.Options) (resp datatypes.Network_Storage_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStorageType()
+}
+
 // no documentation yet
 func (r Network_Storage_Iscsi) GetTargetIpAddresses() (resp []string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getTargetIpAddresses", nil, &r.Options, &resp)
@@ -12742,18 +18096,42 @@ func (r Network_Storage_Iscsi) GetTotalBytesUsed() (resp string, err error) {
 	return
 }
 
+// GetTotalBytesUsedWith is GetTotalBytesUsed, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetTotalBytesUsedWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalBytesUsed()
+}
+
 // Retrieve The total snapshot retention count of all schedules on this network storage volume.
 func (r Network_Storage_Iscsi) GetTotalScheduleSnapshotRetentionCount() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getTotalScheduleSnapshotRetentionCount", nil, &r.Options, &resp)
 	return
 }
 
+// GetTotalScheduleSnapshotRetentionCountWith is GetTotalScheduleSnapshotRetentionCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetTotalScheduleSnapshotRetentionCountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalScheduleSnapshotRetentionCount()
+}
+
 // Retrieve The usage notification for SL Storage services.
 func (r Network_Storage_Iscsi) GetUsageNotification() (resp datatypes.Notification, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getUsageNotification", nil, &r.Options, &resp)
 	return
 }
 
+// GetUsageNotificationWith is GetUsageNotification, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetUsageNotificationWith(options sl.Options) (resp datatypes.Notification, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUsageNotification()
+}
+
 // no documentation yet
 func (r Network_Storage_Iscsi) GetValidReplicationTargetDatacenterLocations() (resp []datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getValidReplicationTargetDatacenterLocations", nil, &r.Options, &resp)
@@ -12766,12 +18144,28 @@ func (r Network_Storage_Iscsi) GetVendorName() (resp string, err error) {
 	return
 }
 
+// GetVendorNameWith is GetVendorName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetVendorNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVendorName()
+}
+
 // Retrieve When applicable, the virtual guest associated with a Storage service.
 func (r Network_Storage_Iscsi) GetVirtualGuest() (resp datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getVirtualGuest", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestWith is GetVirtualGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetVirtualGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuest()
+}
+
 // This method returns the parameters for cloning a volume
 func (r Network_Storage_Iscsi) GetVolumeDuplicateParameters() (resp datatypes.Container_Network_Storage_VolumeDuplicateParameters, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getVolumeDuplicateParameters", nil, &r.Options, &resp)
@@ -12784,24 +18178,56 @@ func (r Network_Storage_Iscsi) GetVolumeHistory() (resp []datatypes.Network_Stor
 	return
 }
 
+// GetVolumeHistoryWith is GetVolumeHistory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetVolumeHistoryWith(options sl.Options) (resp []datatypes.Network_Storage_History, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVolumeHistory()
+}
+
 // Retrieve The current status of a network storage volume.
 func (r Network_Storage_Iscsi) GetVolumeStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getVolumeStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetVolumeStatusWith is GetVolumeStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetVolumeStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVolumeStatus()
+}
+
 // Retrieve The account username and password for the EVault webCC interface.
 func (r Network_Storage_Iscsi) GetWebccAccount() (resp datatypes.Account_Password, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getWebccAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetWebccAccountWith is GetWebccAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetWebccAccountWith(options sl.Options) (resp datatypes.Account_Password, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetWebccAccount()
+}
+
 // Retrieve The Weekly Schedule which is associated with this network storage volume.
 func (r Network_Storage_Iscsi) GetWeeklySchedule() (resp datatypes.Network_Storage_Schedule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi", "getWeeklySchedule", nil, &r.Options, &resp)
 	return
 }
 
+// GetWeeklyScheduleWith is GetWeeklySchedule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Iscsi) GetWeeklyScheduleWith(options sl.Options) (resp datatypes.Network_Storage_Schedule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetWeeklySchedule()
+}
+
 // Immediate Failover to a volume replicant.  During the time which the replicant is in use the local nas volume will not be available.
 func (r Network_Storage_Iscsi) ImmediateFailoverToReplicant(replicantId *int) (resp bool, err error) {
 	params := []interface{}{
@@ -13068,6 +18494,11 @@ func (r Network_Storage_Iscsi_OS_Type) Id(id int) Network_Storage_Iscsi_OS_Type
 	return r
 }
 
+func (r Network_Storage_Iscsi_OS_Type) InitParameter(name string, value interface{}) Network_Storage_Iscsi_OS_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Iscsi_OS_Type) Mask(mask string) Network_Storage_Iscsi_OS_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -13087,11 +18518,26 @@ func (r Network_Storage_Iscsi_OS_Type) Limit(limit int) Network_Storage_Iscsi_OS
 	return r
 }
 
+func (r Network_Storage_Iscsi_OS_Type) Unlimited() Network_Storage_Iscsi_OS_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Iscsi_OS_Type) Offset(offset int) Network_Storage_Iscsi_OS_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Iscsi_OS_Type) Timeout(timeout time.Duration) Network_Storage_Iscsi_OS_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Iscsi_OS_Type) WithContext(ctx context.Context) Network_Storage_Iscsi_OS_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Use this method to retrieve all iSCSI OS Types.
 func (r Network_Storage_Iscsi_OS_Type) GetAllObjects() (resp []datatypes.Network_Storage_Iscsi_OS_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Iscsi_OS_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -13120,6 +18566,11 @@ func (r Network_Storage_MassDataMigration_CrossRegion_Country_Xref) Id(id int) N
 	return r
 }
 
+func (r Network_Storage_MassDataMigration_CrossRegion_Country_Xref) InitParameter(name string, value interface{}) Network_Storage_MassDataMigration_CrossRegion_Country_Xref {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_MassDataMigration_CrossRegion_Country_Xref) Mask(mask string) Network_Storage_MassDataMigration_CrossRegion_Country_Xref {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -13139,11 +18590,26 @@ func (r Network_Storage_MassDataMigration_CrossRegion_Country_Xref) Limit(limit
 	return r
 }
 
+func (r Network_Storage_MassDataMigration_CrossRegion_Country_Xref) Unlimited() Network_Storage_MassDataMigration_CrossRegion_Country_Xref {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_MassDataMigration_CrossRegion_Country_Xref) Offset(offset int) Network_Storage_MassDataMigration_CrossRegion_Country_Xref {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_MassDataMigration_CrossRegion_Country_Xref) Timeout(timeout time.Duration) Network_Storage_MassDataMigration_CrossRegion_Country_Xref {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_MassDataMigration_CrossRegion_Country_Xref) WithContext(ctx context.Context) Network_Storage_MassDataMigration_CrossRegion_Country_Xref {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Storage_MassDataMigration_CrossRegion_Country_Xref) GetAllObjects() (resp []datatypes.Network_Storage_MassDataMigration_CrossRegion_Country_Xref, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_CrossRegion_Country_Xref", "getAllObjects", nil, &r.Options, &resp)
@@ -13156,12 +18622,28 @@ func (r Network_Storage_MassDataMigration_CrossRegion_Country_Xref) GetCountry()
 	return
 }
 
+// GetCountryWith is GetCountry, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_CrossRegion_Country_Xref) GetCountryWith(options sl.Options) (resp datatypes.Locale_Country, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCountry()
+}
+
 // Retrieve Location Group ID of CleverSafe cross region.
 func (r Network_Storage_MassDataMigration_CrossRegion_Country_Xref) GetLocationGroup() (resp datatypes.Location_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_CrossRegion_Country_Xref", "getLocationGroup", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationGroupWith is GetLocationGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_CrossRegion_Country_Xref) GetLocationGroupWith(options sl.Options) (resp datatypes.Location_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocationGroup()
+}
+
 // no documentation yet
 func (r Network_Storage_MassDataMigration_CrossRegion_Country_Xref) GetObject() (resp datatypes.Network_Storage_MassDataMigration_CrossRegion_Country_Xref, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_CrossRegion_Country_Xref", "getObject", nil, &r.Options, &resp)
@@ -13193,6 +18675,11 @@ func (r Network_Storage_MassDataMigration_Request) Id(id int) Network_Storage_Ma
 	return r
 }
 
+func (r Network_Storage_MassDataMigration_Request) InitParameter(name string, value interface{}) Network_Storage_MassDataMigration_Request {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_MassDataMigration_Request) Mask(mask string) Network_Storage_MassDataMigration_Request {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -13212,29 +18699,68 @@ func (r Network_Storage_MassDataMigration_Request) Limit(limit int) Network_Stor
 	return r
 }
 
+func (r Network_Storage_MassDataMigration_Request) Unlimited() Network_Storage_MassDataMigration_Request {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_MassDataMigration_Request) Offset(offset int) Network_Storage_MassDataMigration_Request {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_MassDataMigration_Request) Timeout(timeout time.Duration) Network_Storage_MassDataMigration_Request {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_MassDataMigration_Request) WithContext(ctx context.Context) Network_Storage_MassDataMigration_Request {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The account to which the request belongs.
 func (r Network_Storage_MassDataMigration_Request) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The active tickets that are attached to the MDMS request.
 func (r Network_Storage_MassDataMigration_Request) GetActiveTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getActiveTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveTicketsWith is GetActiveTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetActiveTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTickets()
+}
+
 // Retrieve The customer address where the device is shipped to.
 func (r Network_Storage_MassDataMigration_Request) GetAddress() (resp datatypes.Account_Address, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetAddressWith is GetAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetAddressWith(options sl.Options) (resp datatypes.Account_Address, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAddress()
+}
+
 // no documentation yet
 func (r Network_Storage_MassDataMigration_Request) GetAllObjects() (resp []datatypes.Network_Storage_MassDataMigration_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getAllObjects", nil, &r.Options, &resp)
@@ -13253,48 +18779,112 @@ func (r Network_Storage_MassDataMigration_Request) GetBillingItem() (resp dataty
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The employee user who created the request.
 func (r Network_Storage_MassDataMigration_Request) GetCreateEmployee() (resp datatypes.User_Employee, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getCreateEmployee", nil, &r.Options, &resp)
 	return
 }
 
+// GetCreateEmployeeWith is GetCreateEmployee, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetCreateEmployeeWith(options sl.Options) (resp datatypes.User_Employee, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCreateEmployee()
+}
+
 // Retrieve The customer user who created the request.
 func (r Network_Storage_MassDataMigration_Request) GetCreateUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getCreateUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetCreateUserWith is GetCreateUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetCreateUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCreateUser()
+}
+
 // Retrieve The device configurations.
 func (r Network_Storage_MassDataMigration_Request) GetDeviceConfiguration() (resp datatypes.Network_Storage_MassDataMigration_Request_DeviceConfiguration, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getDeviceConfiguration", nil, &r.Options, &resp)
 	return
 }
 
+// GetDeviceConfigurationWith is GetDeviceConfiguration, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetDeviceConfigurationWith(options sl.Options) (resp datatypes.Network_Storage_MassDataMigration_Request_DeviceConfiguration, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDeviceConfiguration()
+}
+
 // Retrieve The model of device assigned to this request.
 func (r Network_Storage_MassDataMigration_Request) GetDeviceModel() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getDeviceModel", nil, &r.Options, &resp)
 	return
 }
 
+// GetDeviceModelWith is GetDeviceModel, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetDeviceModelWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDeviceModel()
+}
+
 // Retrieve The key contacts for this requests.
 func (r Network_Storage_MassDataMigration_Request) GetKeyContacts() (resp []datatypes.Network_Storage_MassDataMigration_Request_KeyContact, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getKeyContacts", nil, &r.Options, &resp)
 	return
 }
 
+// GetKeyContactsWith is GetKeyContacts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetKeyContactsWith(options sl.Options) (resp []datatypes.Network_Storage_MassDataMigration_Request_KeyContact, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetKeyContacts()
+}
+
 // Retrieve The employee who last modified the request.
 func (r Network_Storage_MassDataMigration_Request) GetModifyEmployee() (resp datatypes.User_Employee, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getModifyEmployee", nil, &r.Options, &resp)
 	return
 }
 
+// GetModifyEmployeeWith is GetModifyEmployee, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetModifyEmployeeWith(options sl.Options) (resp datatypes.User_Employee, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetModifyEmployee()
+}
+
 // Retrieve The customer user who last modified the request.
 func (r Network_Storage_MassDataMigration_Request) GetModifyUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getModifyUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetModifyUserWith is GetModifyUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetModifyUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetModifyUser()
+}
+
 // no documentation yet
 func (r Network_Storage_MassDataMigration_Request) GetObject() (resp datatypes.Network_Storage_MassDataMigration_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getObject", nil, &r.Options, &resp)
@@ -13313,24 +18903,56 @@ func (r Network_Storage_MassDataMigration_Request) GetShipments() (resp []dataty
 	return
 }
 
+// GetShipmentsWith is GetShipments, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetShipmentsWith(options sl.Options) (resp []datatypes.Account_Shipment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetShipments()
+}
+
 // Retrieve The status of the request.
 func (r Network_Storage_MassDataMigration_Request) GetStatus() (resp datatypes.Network_Storage_MassDataMigration_Request_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetStatusWith(options sl.Options) (resp datatypes.Network_Storage_MassDataMigration_Request_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // Retrieve Ticket that is attached to this mass data migration request.
 func (r Network_Storage_MassDataMigration_Request) GetTicket() (resp datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getTicket", nil, &r.Options, &resp)
 	return
 }
 
+// GetTicketWith is GetTicket, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetTicketWith(options sl.Options) (resp datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTicket()
+}
+
 // Retrieve All tickets that are attached to the mass data migration request.
 func (r Network_Storage_MassDataMigration_Request) GetTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request", "getTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetTicketsWith is GetTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request) GetTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTickets()
+}
+
 // The SoftLayer_Network_Storage_MassDataMigration_Request_KeyContact data type contains name, email, and phone for key contact at customer location who will handle Mass Data Migration.
 type Network_Storage_MassDataMigration_Request_KeyContact struct {
 	Session *session.Session
@@ -13347,6 +18969,11 @@ func (r Network_Storage_MassDataMigration_Request_KeyContact) Id(id int) Network
 	return r
 }
 
+func (r Network_Storage_MassDataMigration_Request_KeyContact) InitParameter(name string, value interface{}) Network_Storage_MassDataMigration_Request_KeyContact {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_MassDataMigration_Request_KeyContact) Mask(mask string) Network_Storage_MassDataMigration_Request_KeyContact {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -13366,17 +18993,40 @@ func (r Network_Storage_MassDataMigration_Request_KeyContact) Limit(limit int) N
 	return r
 }
 
+func (r Network_Storage_MassDataMigration_Request_KeyContact) Unlimited() Network_Storage_MassDataMigration_Request_KeyContact {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_MassDataMigration_Request_KeyContact) Offset(offset int) Network_Storage_MassDataMigration_Request_KeyContact {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_MassDataMigration_Request_KeyContact) Timeout(timeout time.Duration) Network_Storage_MassDataMigration_Request_KeyContact {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_MassDataMigration_Request_KeyContact) WithContext(ctx context.Context) Network_Storage_MassDataMigration_Request_KeyContact {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The request this key contact belongs to.
 func (r Network_Storage_MassDataMigration_Request_KeyContact) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request_KeyContact", "getAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request_KeyContact) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // no documentation yet
 func (r Network_Storage_MassDataMigration_Request_KeyContact) GetObject() (resp datatypes.Network_Storage_MassDataMigration_Request_KeyContact, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request_KeyContact", "getObject", nil, &r.Options, &resp)
@@ -13389,6 +19039,14 @@ func (r Network_Storage_MassDataMigration_Request_KeyContact) GetRequest() (resp
 	return
 }
 
+// GetRequestWith is GetRequest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_MassDataMigration_Request_KeyContact) GetRequestWith(options sl.Options) (resp datatypes.Network_Storage_MassDataMigration_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRequest()
+}
+
 // The SoftLayer_Network_Storage_MassDataMigration_Request_Status data type contains general information relating to the statuses to which a Mass Data Migration Request may be set.
 type Network_Storage_MassDataMigration_Request_Status struct {
 	Session *session.Session
@@ -13405,6 +19063,11 @@ func (r Network_Storage_MassDataMigration_Request_Status) Id(id int) Network_Sto
 	return r
 }
 
+func (r Network_Storage_MassDataMigration_Request_Status) InitParameter(name string, value interface{}) Network_Storage_MassDataMigration_Request_Status {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_MassDataMigration_Request_Status) Mask(mask string) Network_Storage_MassDataMigration_Request_Status {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -13424,11 +19087,26 @@ func (r Network_Storage_MassDataMigration_Request_Status) Limit(limit int) Netwo
 	return r
 }
 
+func (r Network_Storage_MassDataMigration_Request_Status) Unlimited() Network_Storage_MassDataMigration_Request_Status {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_MassDataMigration_Request_Status) Offset(offset int) Network_Storage_MassDataMigration_Request_Status {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_MassDataMigration_Request_Status) Timeout(timeout time.Duration) Network_Storage_MassDataMigration_Request_Status {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_MassDataMigration_Request_Status) WithContext(ctx context.Context) Network_Storage_MassDataMigration_Request_Status {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Storage_MassDataMigration_Request_Status) GetObject() (resp datatypes.Network_Storage_MassDataMigration_Request_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_MassDataMigration_Request_Status", "getObject", nil, &r.Options, &resp)
@@ -13451,6 +19129,11 @@ func (r Network_Storage_Schedule) Id(id int) Network_Storage_Schedule {
 	return r
 }
 
+func (r Network_Storage_Schedule) InitParameter(name string, value interface{}) Network_Storage_Schedule {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Schedule) Mask(mask string) Network_Storage_Schedule {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -13470,11 +19153,26 @@ func (r Network_Storage_Schedule) Limit(limit int) Network_Storage_Schedule {
 	return r
 }
 
+func (r Network_Storage_Schedule) Unlimited() Network_Storage_Schedule {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Schedule) Offset(offset int) Network_Storage_Schedule {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Schedule) Timeout(timeout time.Duration) Network_Storage_Schedule {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Schedule) WithContext(ctx context.Context) Network_Storage_Schedule {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a nas volume schedule
 func (r Network_Storage_Schedule) CreateObject(templateObject *datatypes.Network_Storage_Schedule) (resp datatypes.Network_Storage_Schedule, err error) {
 	params := []interface{}{
@@ -13505,42 +19203,98 @@ func (r Network_Storage_Schedule) GetDay() (resp string, err error) {
 	return
 }
 
+// GetDayWith is GetDay, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetDayWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDay()
+}
+
 // Retrieve The day of the month parameter of this schedule.
 func (r Network_Storage_Schedule) GetDayOfMonth() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule", "getDayOfMonth", nil, &r.Options, &resp)
 	return
 }
 
+// GetDayOfMonthWith is GetDayOfMonth, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetDayOfMonthWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDayOfMonth()
+}
+
 // Retrieve The day of the week parameter of this schedule.
 func (r Network_Storage_Schedule) GetDayOfWeek() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule", "getDayOfWeek", nil, &r.Options, &resp)
 	return
 }
 
+// GetDayOfWeekWith is GetDayOfWeek, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetDayOfWeekWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDayOfWeek()
+}
+
 // Retrieve Events which have been created as the result of a schedule execution.
 func (r Network_Storage_Schedule) GetEvents() (resp []datatypes.Network_Storage_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule", "getEvents", nil, &r.Options, &resp)
 	return
 }
 
+// GetEventsWith is GetEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetEventsWith(options sl.Options) (resp []datatypes.Network_Storage_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvents()
+}
+
 // Retrieve The hour parameter of this schedule.
 func (r Network_Storage_Schedule) GetHour() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule", "getHour", nil, &r.Options, &resp)
 	return
 }
 
+// GetHourWith is GetHour, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetHourWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHour()
+}
+
 // Retrieve The minute parameter of this schedule.
 func (r Network_Storage_Schedule) GetMinute() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule", "getMinute", nil, &r.Options, &resp)
 	return
 }
 
+// GetMinuteWith is GetMinute, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetMinuteWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMinute()
+}
+
 // Retrieve The month of the year parameter of this schedule.
 func (r Network_Storage_Schedule) GetMonthOfYear() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule", "getMonthOfYear", nil, &r.Options, &resp)
 	return
 }
 
+// GetMonthOfYearWith is GetMonthOfYear, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetMonthOfYearWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonthOfYear()
+}
+
 // no documentation yet
 func (r Network_Storage_Schedule) GetObject() (resp datatypes.Network_Storage_Schedule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule", "getObject", nil, &r.Options, &resp)
@@ -13553,48 +19307,112 @@ func (r Network_Storage_Schedule) GetPartnership() (resp datatypes.Network_Stora
 	return
 }
 
+// GetPartnershipWith is GetPartnership, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetPartnershipWith(options sl.Options) (resp datatypes.Network_Storage_Partnership, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPartnership()
+}
+
 // Retrieve Properties used for configuration of a schedule.
 func (r Network_Storage_Schedule) GetProperties() (resp []datatypes.Network_Storage_Schedule_Property, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule", "getProperties", nil, &r.Options, &resp)
 	return
 }
 
+// GetPropertiesWith is GetProperties, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetPropertiesWith(options sl.Options) (resp []datatypes.Network_Storage_Schedule_Property, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProperties()
+}
+
 // Retrieve Replica snapshots which have been created as the result of this schedule's execution.
 func (r Network_Storage_Schedule) GetReplicaSnapshots() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule", "getReplicaSnapshots", nil, &r.Options, &resp)
 	return
 }
 
+// GetReplicaSnapshotsWith is GetReplicaSnapshots, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetReplicaSnapshotsWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReplicaSnapshots()
+}
+
 // Retrieve The number of snapshots this schedule is configured to retain.
 func (r Network_Storage_Schedule) GetRetentionCount() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule", "getRetentionCount", nil, &r.Options, &resp)
 	return
 }
 
+// GetRetentionCountWith is GetRetentionCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetRetentionCountWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRetentionCount()
+}
+
 // Retrieve The minute parameter of this schedule.
 func (r Network_Storage_Schedule) GetSecond() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule", "getSecond", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecondWith is GetSecond, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetSecondWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecond()
+}
+
 // Retrieve Snapshots which have been created as the result of this schedule's execution.
 func (r Network_Storage_Schedule) GetSnapshots() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule", "getSnapshots", nil, &r.Options, &resp)
 	return
 }
 
+// GetSnapshotsWith is GetSnapshots, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetSnapshotsWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSnapshots()
+}
+
 // Retrieve The type provides a standardized definition for a schedule.
 func (r Network_Storage_Schedule) GetType() (resp datatypes.Network_Storage_Schedule_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetTypeWith(options sl.Options) (resp datatypes.Network_Storage_Schedule_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Retrieve The associated volume for a schedule.
 func (r Network_Storage_Schedule) GetVolume() (resp datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule", "getVolume", nil, &r.Options, &resp)
 	return
 }
 
+// GetVolumeWith is GetVolume, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Storage_Schedule) GetVolumeWith(options sl.Options) (resp datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVolume()
+}
+
 // A schedule property type is used to allow for a standardized method of defining network storage schedules.
 type Network_Storage_Schedule_Property_Type struct {
 	Session *session.Session
@@ -13611,6 +19429,11 @@ func (r Network_Storage_Schedule_Property_Type) Id(id int) Network_Storage_Sched
 	return r
 }
 
+func (r Network_Storage_Schedule_Property_Type) InitParameter(name string, value interface{}) Network_Storage_Schedule_Property_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Storage_Schedule_Property_Type) Mask(mask string) Network_Storage_Schedule_Property_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -13630,11 +19453,26 @@ func (r Network_Storage_Schedule_Property_Type) Limit(limit int) Network_Storage
 	return r
 }
 
+func (r Network_Storage_Schedule_Property_Type) Unlimited() Network_Storage_Schedule_Property_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Storage_Schedule_Property_Type) Offset(offset int) Network_Storage_Schedule_Property_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Storage_Schedule_Property_Type) Timeout(timeout time.Duration) Network_Storage_Schedule_Property_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Storage_Schedule_Property_Type) WithContext(ctx context.Context) Network_Storage_Schedule_Property_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Use this method to retrieve all network storage schedule property types.
 func (r Network_Storage_Schedule_Property_Type) GetAllObjects() (resp []datatypes.Network_Storage_Schedule_Property_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Storage_Schedule_Property_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -13663,6 +19501,11 @@ func (r Network_Subnet) Id(id int) Network_Subnet {
 	return r
 }
 
+func (r Network_Subnet) InitParameter(name string, value interface{}) Network_Subnet {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Subnet) Mask(mask string) Network_Subnet {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -13682,11 +19525,26 @@ func (r Network_Subnet) Limit(limit int) Network_Subnet {
 	return r
 }
 
+func (r Network_Subnet) Unlimited() Network_Subnet {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Subnet) Offset(offset int) Network_Subnet {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Subnet) Timeout(timeout time.Duration) Network_Subnet {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Subnet) WithContext(ctx context.Context) Network_Subnet {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is used to allow access to a SoftLayer_Network_Storage volume that supports host- or network-level access control.
 func (r Network_Subnet) AllowAccessToNetworkStorage(networkStorageTemplateObject *datatypes.Network_Storage) (resp bool, err error) {
 	params := []interface{}{
@@ -13757,42 +19615,98 @@ func (r Network_Subnet) GetActiveRegistration() (resp datatypes.Network_Subnet_R
 	return
 }
 
+// GetActiveRegistrationWith is GetActiveRegistration, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetActiveRegistrationWith(options sl.Options) (resp datatypes.Network_Subnet_Registration, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveRegistration()
+}
+
 // Retrieve All the swip transactions associated with a subnet that are still active.
 func (r Network_Subnet) GetActiveSwipTransaction() (resp datatypes.Network_Subnet_Swip_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getActiveSwipTransaction", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveSwipTransactionWith is GetActiveSwipTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetActiveSwipTransactionWith(options sl.Options) (resp datatypes.Network_Subnet_Swip_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveSwipTransaction()
+}
+
 // Retrieve The billing item for a subnet.
 func (r Network_Subnet) GetActiveTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getActiveTransaction", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveTransactionWith is GetActiveTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetActiveTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransaction()
+}
+
 // Retrieve Identifier which distinguishes whether the subnet is public or private address space.
 func (r Network_Subnet) GetAddressSpace() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getAddressSpace", nil, &r.Options, &resp)
 	return
 }
 
+// GetAddressSpaceWith is GetAddressSpace, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetAddressSpaceWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAddressSpace()
+}
+
 // Retrieve The SoftLayer_Network_Storage_Allowed_Host information to connect this Subnet to Network Storage supporting access control lists.
 func (r Network_Subnet) GetAllowedHost() (resp datatypes.Network_Storage_Allowed_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getAllowedHost", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedHostWith is GetAllowedHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetAllowedHostWith(options sl.Options) (resp datatypes.Network_Storage_Allowed_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHost()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects that this SoftLayer_Hardware has access to.
 func (r Network_Subnet) GetAllowedNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getAllowedNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageWith is GetAllowedNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetAllowedNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorage()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects whose Replica that this SoftLayer_Hardware has access to.
 func (r Network_Subnet) GetAllowedNetworkStorageReplicas() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getAllowedNetworkStorageReplicas", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageReplicasWith is GetAllowedNetworkStorageReplicas, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetAllowedNetworkStorageReplicasWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorageReplicas()
+}
+
 // This method is retrieve a list of SoftLayer_Network_Storage volumes that are authorized access to this SoftLayer_Network_Subnet.
 func (r Network_Subnet) GetAttachedNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
 	params := []interface{}{
@@ -13817,6 +19731,14 @@ func (r Network_Subnet) GetBillingItem() (resp datatypes.Billing_Item, err error
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve
 func (r Network_Subnet) GetBoundDescendants() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getBoundDescendants", nil, &r.Options, &resp)
@@ -13829,6 +19751,14 @@ func (r Network_Subnet) GetBoundRouterFlag() (resp bool, err error) {
 	return
 }
 
+// GetBoundRouterFlagWith is GetBoundRouterFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetBoundRouterFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBoundRouterFlag()
+}
+
 // Retrieve
 func (r Network_Subnet) GetBoundRouters() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getBoundRouters", nil, &r.Options, &resp)
@@ -13847,6 +19777,14 @@ func (r Network_Subnet) GetDatacenter() (resp datatypes.Location_Datacenter, err
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetDatacenterWith(options sl.Options) (resp datatypes.Location_Datacenter, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // Retrieve
 func (r Network_Subnet) GetDescendants() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getDescendants", nil, &r.Options, &resp)
@@ -13865,6 +19803,14 @@ func (r Network_Subnet) GetEndPointIpAddress() (resp datatypes.Network_Subnet_Ip
 	return
 }
 
+// GetEndPointIpAddressWith is GetEndPointIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetEndPointIpAddressWith(options sl.Options) (resp datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEndPointIpAddress()
+}
+
 // Retrieve
 func (r Network_Subnet) GetGlobalIpRecord() (resp datatypes.Network_Subnet_IpAddress_Global, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getGlobalIpRecord", nil, &r.Options, &resp)
@@ -13877,24 +19823,56 @@ func (r Network_Subnet) GetHardware() (resp []datatypes.Hardware, err error) {
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve All the ip addresses associated with a subnet.
 func (r Network_Subnet) GetIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getIpAddresses", nil, &r.Options, &resp)
 	return
 }
 
+// GetIpAddressesWith is GetIpAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetIpAddressesWith(options sl.Options) (resp []datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIpAddresses()
+}
+
 // Retrieve The upstream network component firewall.
 func (r Network_Subnet) GetNetworkComponentFirewall() (resp datatypes.Network_Component_Firewall, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getNetworkComponentFirewall", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkComponentFirewallWith is GetNetworkComponentFirewall, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetNetworkComponentFirewallWith(options sl.Options) (resp datatypes.Network_Component_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponentFirewall()
+}
+
 // Retrieve The Private Network identifier this subnet is within, if applicable.
 func (r Network_Subnet) GetNetworkId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getNetworkId", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkIdWith is GetNetworkId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetNetworkIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkId()
+}
+
 // Retrieve
 func (r Network_Subnet) GetNetworkProtectionAddresses() (resp []datatypes.Network_Protection_Address, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getNetworkProtectionAddresses", nil, &r.Options, &resp)
@@ -13907,12 +19885,28 @@ func (r Network_Subnet) GetNetworkTunnelContexts() (resp []datatypes.Network_Tun
 	return
 }
 
+// GetNetworkTunnelContextsWith is GetNetworkTunnelContexts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetNetworkTunnelContextsWith(options sl.Options) (resp []datatypes.Network_Tunnel_Module_Context, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkTunnelContexts()
+}
+
 // Retrieve The VLAN object that a subnet is associated with.
 func (r Network_Subnet) GetNetworkVlan() (resp datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getNetworkVlan", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlanWith is GetNetworkVlan, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetNetworkVlanWith(options sl.Options) (resp datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlan()
+}
+
 // getObject retrieves the SoftLayer_Network_Subnet object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Network_Subnet service. You can only retrieve the subnet whose vlan is associated with the account that you portal user is assigned to.
 func (r Network_Subnet) GetObject() (resp datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getObject", nil, &r.Options, &resp)
@@ -13925,6 +19919,14 @@ func (r Network_Subnet) GetPodName() (resp string, err error) {
 	return
 }
 
+// GetPodNameWith is GetPodName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetPodNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPodName()
+}
+
 // Retrieve
 func (r Network_Subnet) GetProtectedIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getProtectedIpAddresses", nil, &r.Options, &resp)
@@ -13943,12 +19945,28 @@ func (r Network_Subnet) GetRegistrations() (resp []datatypes.Network_Subnet_Regi
 	return
 }
 
+// GetRegistrationsWith is GetRegistrations, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetRegistrationsWith(options sl.Options) (resp []datatypes.Network_Subnet_Registration, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRegistrations()
+}
+
 // Retrieve The reverse DNS domain associated with this subnet.
 func (r Network_Subnet) GetReverseDomain() (resp datatypes.Dns_Domain, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getReverseDomain", nil, &r.Options, &resp)
 	return
 }
 
+// GetReverseDomainWith is GetReverseDomain, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetReverseDomainWith(options sl.Options) (resp datatypes.Dns_Domain, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReverseDomain()
+}
+
 // Retrieve all reverse DNS records associated with a subnet.
 func (r Network_Subnet) GetReverseDomainRecords() (resp []datatypes.Dns_Domain, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getReverseDomainRecords", nil, &r.Options, &resp)
@@ -13961,12 +19979,28 @@ func (r Network_Subnet) GetRoleKeyName() (resp string, err error) {
 	return
 }
 
+// GetRoleKeyNameWith is GetRoleKeyName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetRoleKeyNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRoleKeyName()
+}
+
 // Retrieve The name of the role the subnet is within. Roles dictate how a subnet may be used.
 func (r Network_Subnet) GetRoleName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getRoleName", nil, &r.Options, &resp)
 	return
 }
 
+// GetRoleNameWith is GetRoleName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetRoleNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRoleName()
+}
+
 // getRoutableEndpointAddresses retrieves valid routable endpoint addresses for a subnet. You may use any IP address in a portable subnet, but may not use the network identifier, gateway, or broadcast address for primary and secondary on VLAN subnets.
 func (r Network_Subnet) GetRoutableEndpointIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getRoutableEndpointIpAddresses", nil, &r.Options, &resp)
@@ -13979,12 +20013,28 @@ func (r Network_Subnet) GetRoutingTypeKeyName() (resp string, err error) {
 	return
 }
 
+// GetRoutingTypeKeyNameWith is GetRoutingTypeKeyName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetRoutingTypeKeyNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRoutingTypeKeyName()
+}
+
 // Retrieve The name for the type of route then subnet is currently configured for.
 func (r Network_Subnet) GetRoutingTypeName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getRoutingTypeName", nil, &r.Options, &resp)
 	return
 }
 
+// GetRoutingTypeNameWith is GetRoutingTypeName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetRoutingTypeNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRoutingTypeName()
+}
+
 // Retrieve the subnet associated with an IP address. You may only retrieve subnets assigned to your SoftLayer customer account.
 func (r Network_Subnet) GetSubnetForIpAddress(ipAddress *string) (resp datatypes.Network_Subnet, err error) {
 	params := []interface{}{
@@ -14000,6 +20050,14 @@ func (r Network_Subnet) GetSwipTransaction() (resp []datatypes.Network_Subnet_Sw
 	return
 }
 
+// GetSwipTransactionWith is GetSwipTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetSwipTransactionWith(options sl.Options) (resp []datatypes.Network_Subnet_Swip_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSwipTransaction()
+}
+
 // Retrieve
 func (r Network_Subnet) GetUnboundDescendants() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getUnboundDescendants", nil, &r.Options, &resp)
@@ -14012,12 +20070,28 @@ func (r Network_Subnet) GetUtilizedIpAddressCount() (resp uint, err error) {
 	return
 }
 
+// GetUtilizedIpAddressCountWith is GetUtilizedIpAddressCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetUtilizedIpAddressCountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUtilizedIpAddressCount()
+}
+
 // Retrieve The Virtual Servers using IP addresses on this subnet.
 func (r Network_Subnet) GetVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet", "getVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWith is GetVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet) GetVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuests()
+}
+
 // This method is used to remove access to multiple SoftLayer_Network_Storage volumes
 func (r Network_Subnet) RemoveAccessToNetworkStorageList(networkStorageTemplateObjects []datatypes.Network_Storage) (resp bool, err error) {
 	params := []interface{}{
@@ -14043,6 +20117,11 @@ func (r Network_Subnet_IpAddress) Id(id int) Network_Subnet_IpAddress {
 	return r
 }
 
+func (r Network_Subnet_IpAddress) InitParameter(name string, value interface{}) Network_Subnet_IpAddress {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Subnet_IpAddress) Mask(mask string) Network_Subnet_IpAddress {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -14062,11 +20141,26 @@ func (r Network_Subnet_IpAddress) Limit(limit int) Network_Subnet_IpAddress {
 	return r
 }
 
+func (r Network_Subnet_IpAddress) Unlimited() Network_Subnet_IpAddress {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Subnet_IpAddress) Offset(offset int) Network_Subnet_IpAddress {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Subnet_IpAddress) Timeout(timeout time.Duration) Network_Subnet_IpAddress {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Subnet_IpAddress) WithContext(ctx context.Context) Network_Subnet_IpAddress {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method is used to allow access to a SoftLayer_Network_Storage volume that supports host- or network-level access control.
 func (r Network_Subnet_IpAddress) AllowAccessToNetworkStorage(networkStorageTemplateObject *datatypes.Network_Storage) (resp bool, err error) {
 	params := []interface{}{
@@ -14118,24 +20212,56 @@ func (r Network_Subnet_IpAddress) GetAllowedHost() (resp datatypes.Network_Stora
 	return
 }
 
+// GetAllowedHostWith is GetAllowedHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetAllowedHostWith(options sl.Options) (resp datatypes.Network_Storage_Allowed_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHost()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects that this SoftLayer_Hardware has access to.
 func (r Network_Subnet_IpAddress) GetAllowedNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getAllowedNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageWith is GetAllowedNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetAllowedNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorage()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects whose Replica that this SoftLayer_Hardware has access to.
 func (r Network_Subnet_IpAddress) GetAllowedNetworkStorageReplicas() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getAllowedNetworkStorageReplicas", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageReplicasWith is GetAllowedNetworkStorageReplicas, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetAllowedNetworkStorageReplicasWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorageReplicas()
+}
+
 // Retrieve The application delivery controller using this address.
 func (r Network_Subnet_IpAddress) GetApplicationDeliveryController() (resp datatypes.Network_Application_Delivery_Controller, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getApplicationDeliveryController", nil, &r.Options, &resp)
 	return
 }
 
+// GetApplicationDeliveryControllerWith is GetApplicationDeliveryController, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetApplicationDeliveryControllerWith(options sl.Options) (resp datatypes.Network_Application_Delivery_Controller, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetApplicationDeliveryController()
+}
+
 // This method is retrieve a list of SoftLayer_Network_Storage volumes that are authorized access to this SoftLayer_Network_Subnet_IpAddress.
 func (r Network_Subnet_IpAddress) GetAttachedNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
 	params := []interface{}{
@@ -14169,36 +20295,84 @@ func (r Network_Subnet_IpAddress) GetContextTunnelTranslations() (resp []datatyp
 	return
 }
 
+// GetContextTunnelTranslationsWith is GetContextTunnelTranslations, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetContextTunnelTranslationsWith(options sl.Options) (resp []datatypes.Network_Tunnel_Module_Context_Address_Translation, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetContextTunnelTranslations()
+}
+
 // Retrieve All the subnets routed to an IP address.
 func (r Network_Subnet_IpAddress) GetEndpointSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getEndpointSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetEndpointSubnetsWith is GetEndpointSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetEndpointSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEndpointSubnets()
+}
+
 // Retrieve A network component that is statically routed to an IP address.
 func (r Network_Subnet_IpAddress) GetGuestNetworkComponent() (resp datatypes.Virtual_Guest_Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getGuestNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetGuestNetworkComponentWith is GetGuestNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetGuestNetworkComponentWith(options sl.Options) (resp datatypes.Virtual_Guest_Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGuestNetworkComponent()
+}
+
 // Retrieve A network component that is statically routed to an IP address.
 func (r Network_Subnet_IpAddress) GetGuestNetworkComponentBinding() (resp datatypes.Virtual_Guest_Network_Component_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getGuestNetworkComponentBinding", nil, &r.Options, &resp)
 	return
 }
 
+// GetGuestNetworkComponentBindingWith is GetGuestNetworkComponentBinding, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetGuestNetworkComponentBindingWith(options sl.Options) (resp datatypes.Virtual_Guest_Network_Component_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGuestNetworkComponentBinding()
+}
+
 // Retrieve A server that this IP address is routed to.
 func (r Network_Subnet_IpAddress) GetHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve A network component that is statically routed to an IP address.
 func (r Network_Subnet_IpAddress) GetNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkComponentWith is GetNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponent()
+}
+
 // getObject retrieves the SoftLayer_Network_Subnet_IpAddress object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Network_Subnet_IpAddress service. You can only retrieve the IP address whose subnet is associated with a VLAN that is associated with the account that your portal user is assigned to.
 func (r Network_Subnet_IpAddress) GetObject() (resp datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getObject", nil, &r.Options, &resp)
@@ -14211,6 +20385,14 @@ func (r Network_Subnet_IpAddress) GetPrivateNetworkGateway() (resp datatypes.Net
 	return
 }
 
+// GetPrivateNetworkGatewayWith is GetPrivateNetworkGateway, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetPrivateNetworkGatewayWith(options sl.Options) (resp datatypes.Network_Gateway, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateNetworkGateway()
+}
+
 // Retrieve
 func (r Network_Subnet_IpAddress) GetProtectionAddress() (resp []datatypes.Network_Protection_Address, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getProtectionAddress", nil, &r.Options, &resp)
@@ -14223,90 +20405,210 @@ func (r Network_Subnet_IpAddress) GetPublicNetworkGateway() (resp datatypes.Netw
 	return
 }
 
+// GetPublicNetworkGatewayWith is GetPublicNetworkGateway, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetPublicNetworkGatewayWith(options sl.Options) (resp datatypes.Network_Gateway, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPublicNetworkGateway()
+}
+
 // Retrieve An IPMI-based management network component of the IP address.
 func (r Network_Subnet_IpAddress) GetRemoteManagementNetworkComponent() (resp datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getRemoteManagementNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetRemoteManagementNetworkComponentWith is GetRemoteManagementNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetRemoteManagementNetworkComponentWith(options sl.Options) (resp datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRemoteManagementNetworkComponent()
+}
+
 // Retrieve An IP address' associated subnet.
 func (r Network_Subnet_IpAddress) GetSubnet() (resp datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getSubnet", nil, &r.Options, &resp)
 	return
 }
 
+// GetSubnetWith is GetSubnet, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetSubnetWith(options sl.Options) (resp datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubnet()
+}
+
 // Retrieve All events for this IP address stored in the datacenter syslogs from the last 24 hours
 func (r Network_Subnet_IpAddress) GetSyslogEventsOneDay() (resp []datatypes.Network_Logging_Syslog, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getSyslogEventsOneDay", nil, &r.Options, &resp)
 	return
 }
 
+// GetSyslogEventsOneDayWith is GetSyslogEventsOneDay, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetSyslogEventsOneDayWith(options sl.Options) (resp []datatypes.Network_Logging_Syslog, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSyslogEventsOneDay()
+}
+
 // Retrieve All events for this IP address stored in the datacenter syslogs from the last 7 days
 func (r Network_Subnet_IpAddress) GetSyslogEventsSevenDays() (resp []datatypes.Network_Logging_Syslog, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getSyslogEventsSevenDays", nil, &r.Options, &resp)
 	return
 }
 
+// GetSyslogEventsSevenDaysWith is GetSyslogEventsSevenDays, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetSyslogEventsSevenDaysWith(options sl.Options) (resp []datatypes.Network_Logging_Syslog, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSyslogEventsSevenDays()
+}
+
 // Retrieve Top Ten network datacenter syslog events, grouped by destination port, for the last 24 hours
 func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsByDestinationPortOneDay() (resp []datatypes.Network_Logging_Syslog, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getTopTenSyslogEventsByDestinationPortOneDay", nil, &r.Options, &resp)
 	return
 }
 
+// GetTopTenSyslogEventsByDestinationPortOneDayWith is GetTopTenSyslogEventsByDestinationPortOneDay, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsByDestinationPortOneDayWith(options sl.Options) (resp []datatypes.Network_Logging_Syslog, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTopTenSyslogEventsByDestinationPortOneDay()
+}
+
 // Retrieve Top Ten network datacenter syslog events, grouped by destination port, for the last 7 days
 func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsByDestinationPortSevenDays() (resp []datatypes.Network_Logging_Syslog, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getTopTenSyslogEventsByDestinationPortSevenDays", nil, &r.Options, &resp)
 	return
 }
 
+// GetTopTenSyslogEventsByDestinationPortSevenDaysWith is GetTopTenSyslogEventsByDestinationPortSevenDays, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsByDestinationPortSevenDaysWith(options sl.Options) (resp []datatypes.Network_Logging_Syslog, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTopTenSyslogEventsByDestinationPortSevenDays()
+}
+
 // Retrieve Top Ten network datacenter syslog events, grouped by source port, for the last 24 hours
 func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsByProtocolsOneDay() (resp []datatypes.Network_Logging_Syslog, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getTopTenSyslogEventsByProtocolsOneDay", nil, &r.Options, &resp)
 	return
 }
 
+// GetTopTenSyslogEventsByProtocolsOneDayWith is GetTopTenSyslogEventsByProtocolsOneDay, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsByProtocolsOneDayWith(options sl.Options) (resp []datatypes.Network_Logging_Syslog, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTopTenSyslogEventsByProtocolsOneDay()
+}
+
 // Retrieve Top Ten network datacenter syslog events, grouped by source port, for the last 7 days
 func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsByProtocolsSevenDays() (resp []datatypes.Network_Logging_Syslog, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getTopTenSyslogEventsByProtocolsSevenDays", nil, &r.Options, &resp)
 	return
 }
 
+// GetTopTenSyslogEventsByProtocolsSevenDaysWith is GetTopTenSyslogEventsByProtocolsSevenDays, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsByProtocolsSevenDaysWith(options sl.Options) (resp []datatypes.Network_Logging_Syslog, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTopTenSyslogEventsByProtocolsSevenDays()
+}
+
 // Retrieve Top Ten network datacenter syslog events, grouped by source ip address, for the last 24 hours
 func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsBySourceIpOneDay() (resp []datatypes.Network_Logging_Syslog, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getTopTenSyslogEventsBySourceIpOneDay", nil, &r.Options, &resp)
 	return
 }
 
+// GetTopTenSyslogEventsBySourceIpOneDayWith is GetTopTenSyslogEventsBySourceIpOneDay, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsBySourceIpOneDayWith(options sl.Options) (resp []datatypes.Network_Logging_Syslog, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTopTenSyslogEventsBySourceIpOneDay()
+}
+
 // Retrieve Top Ten network datacenter syslog events, grouped by source ip address, for the last 7 days
 func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsBySourceIpSevenDays() (resp []datatypes.Network_Logging_Syslog, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getTopTenSyslogEventsBySourceIpSevenDays", nil, &r.Options, &resp)
 	return
 }
 
+// GetTopTenSyslogEventsBySourceIpSevenDaysWith is GetTopTenSyslogEventsBySourceIpSevenDays, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsBySourceIpSevenDaysWith(options sl.Options) (resp []datatypes.Network_Logging_Syslog, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTopTenSyslogEventsBySourceIpSevenDays()
+}
+
 // Retrieve Top Ten network datacenter syslog events, grouped by source port, for the last 24 hours
 func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsBySourcePortOneDay() (resp []datatypes.Network_Logging_Syslog, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getTopTenSyslogEventsBySourcePortOneDay", nil, &r.Options, &resp)
 	return
 }
 
+// GetTopTenSyslogEventsBySourcePortOneDayWith is GetTopTenSyslogEventsBySourcePortOneDay, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsBySourcePortOneDayWith(options sl.Options) (resp []datatypes.Network_Logging_Syslog, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTopTenSyslogEventsBySourcePortOneDay()
+}
+
 // Retrieve Top Ten network datacenter syslog events, grouped by source port, for the last 7 days
 func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsBySourcePortSevenDays() (resp []datatypes.Network_Logging_Syslog, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getTopTenSyslogEventsBySourcePortSevenDays", nil, &r.Options, &resp)
 	return
 }
 
+// GetTopTenSyslogEventsBySourcePortSevenDaysWith is GetTopTenSyslogEventsBySourcePortSevenDays, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetTopTenSyslogEventsBySourcePortSevenDaysWith(options sl.Options) (resp []datatypes.Network_Logging_Syslog, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTopTenSyslogEventsBySourcePortSevenDays()
+}
+
 // Retrieve A virtual guest that this IP address is routed to.
 func (r Network_Subnet_IpAddress) GetVirtualGuest() (resp datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getVirtualGuest", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestWith is GetVirtualGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetVirtualGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuest()
+}
+
 // Retrieve Virtual licenses allocated for an IP Address.
 func (r Network_Subnet_IpAddress) GetVirtualLicenses() (resp []datatypes.Software_VirtualLicense, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress", "getVirtualLicenses", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualLicensesWith is GetVirtualLicenses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress) GetVirtualLicensesWith(options sl.Options) (resp []datatypes.Software_VirtualLicense, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualLicenses()
+}
+
 // This method is used to remove access to multiple SoftLayer_Network_Storage volumes
 func (r Network_Subnet_IpAddress) RemoveAccessToNetworkStorageList(networkStorageTemplateObjects []datatypes.Network_Storage) (resp bool, err error) {
 	params := []interface{}{
@@ -14332,6 +20634,11 @@ func (r Network_Subnet_IpAddress_Global) Id(id int) Network_Subnet_IpAddress_Glo
 	return r
 }
 
+func (r Network_Subnet_IpAddress_Global) InitParameter(name string, value interface{}) Network_Subnet_IpAddress_Global {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Subnet_IpAddress_Global) Mask(mask string) Network_Subnet_IpAddress_Global {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -14351,11 +20658,26 @@ func (r Network_Subnet_IpAddress_Global) Limit(limit int) Network_Subnet_IpAddre
 	return r
 }
 
+func (r Network_Subnet_IpAddress_Global) Unlimited() Network_Subnet_IpAddress_Global {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Subnet_IpAddress_Global) Offset(offset int) Network_Subnet_IpAddress_Global {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Subnet_IpAddress_Global) Timeout(timeout time.Duration) Network_Subnet_IpAddress_Global {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Subnet_IpAddress_Global) WithContext(ctx context.Context) Network_Subnet_IpAddress_Global {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Network_Subnet_IpAddress_Global) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress_Global", "getAccount", nil, &r.Options, &resp)
@@ -14368,12 +20690,28 @@ func (r Network_Subnet_IpAddress_Global) GetActiveTransaction() (resp datatypes.
 	return
 }
 
+// GetActiveTransactionWith is GetActiveTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress_Global) GetActiveTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransaction()
+}
+
 // Retrieve The billing item for this Global IP.
 func (r Network_Subnet_IpAddress_Global) GetBillingItem() (resp datatypes.Billing_Item_Network_Subnet_IpAddress_Global, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress_Global", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_IpAddress_Global) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Network_Subnet_IpAddress_Global, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve
 func (r Network_Subnet_IpAddress_Global) GetDestinationIpAddress() (resp datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_IpAddress_Global", "getDestinationIpAddress", nil, &r.Options, &resp)
@@ -14423,6 +20761,11 @@ func (r Network_Subnet_Registration) Id(id int) Network_Subnet_Registration {
 	return r
 }
 
+func (r Network_Subnet_Registration) InitParameter(name string, value interface{}) Network_Subnet_Registration {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Subnet_Registration) Mask(mask string) Network_Subnet_Registration {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -14442,11 +20785,26 @@ func (r Network_Subnet_Registration) Limit(limit int) Network_Subnet_Registratio
 	return r
 }
 
+func (r Network_Subnet_Registration) Unlimited() Network_Subnet_Registration {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Subnet_Registration) Offset(offset int) Network_Subnet_Registration {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Subnet_Registration) Timeout(timeout time.Duration) Network_Subnet_Registration {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Subnet_Registration) WithContext(ctx context.Context) Network_Subnet_Registration {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method will initiate the removal of a subnet registration.
 func (r Network_Subnet_Registration) ClearRegistration() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_Registration", "clearRegistration", nil, &r.Options, &resp)
@@ -14510,24 +20868,56 @@ func (r Network_Subnet_Registration) GetAccount() (resp datatypes.Account, err e
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_Registration) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The cross-reference records that tie the [[SoftLayer_Account_Regional_Registry_Detail]] objects to the registration object.
 func (r Network_Subnet_Registration) GetDetailReferences() (resp []datatypes.Network_Subnet_Registration_Details, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_Registration", "getDetailReferences", nil, &r.Options, &resp)
 	return
 }
 
+// GetDetailReferencesWith is GetDetailReferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_Registration) GetDetailReferencesWith(options sl.Options) (resp []datatypes.Network_Subnet_Registration_Details, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDetailReferences()
+}
+
 // Retrieve The related registration events.
 func (r Network_Subnet_Registration) GetEvents() (resp []datatypes.Network_Subnet_Registration_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_Registration", "getEvents", nil, &r.Options, &resp)
 	return
 }
 
+// GetEventsWith is GetEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_Registration) GetEventsWith(options sl.Options) (resp []datatypes.Network_Subnet_Registration_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvents()
+}
+
 // Retrieve The "network" detail object.
 func (r Network_Subnet_Registration) GetNetworkDetail() (resp datatypes.Account_Regional_Registry_Detail, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_Registration", "getNetworkDetail", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkDetailWith is GetNetworkDetail, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_Registration) GetNetworkDetailWith(options sl.Options) (resp datatypes.Account_Regional_Registry_Detail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkDetail()
+}
+
 // no documentation yet
 func (r Network_Subnet_Registration) GetObject() (resp datatypes.Network_Subnet_Registration, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_Registration", "getObject", nil, &r.Options, &resp)
@@ -14540,30 +20930,70 @@ func (r Network_Subnet_Registration) GetPersonDetail() (resp datatypes.Account_R
 	return
 }
 
+// GetPersonDetailWith is GetPersonDetail, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_Registration) GetPersonDetailWith(options sl.Options) (resp datatypes.Account_Regional_Registry_Detail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPersonDetail()
+}
+
 // Retrieve The related Regional Internet Registry.
 func (r Network_Subnet_Registration) GetRegionalInternetRegistry() (resp datatypes.Network_Regional_Internet_Registry, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_Registration", "getRegionalInternetRegistry", nil, &r.Options, &resp)
 	return
 }
 
+// GetRegionalInternetRegistryWith is GetRegionalInternetRegistry, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_Registration) GetRegionalInternetRegistryWith(options sl.Options) (resp datatypes.Network_Regional_Internet_Registry, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRegionalInternetRegistry()
+}
+
 // Retrieve The RIR handle that this registration object belongs to. This field may not be populated until the registration is complete.
 func (r Network_Subnet_Registration) GetRegionalInternetRegistryHandle() (resp datatypes.Account_Rwhois_Handle, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_Registration", "getRegionalInternetRegistryHandle", nil, &r.Options, &resp)
 	return
 }
 
+// GetRegionalInternetRegistryHandleWith is GetRegionalInternetRegistryHandle, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_Registration) GetRegionalInternetRegistryHandleWith(options sl.Options) (resp datatypes.Account_Rwhois_Handle, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRegionalInternetRegistryHandle()
+}
+
 // Retrieve The status of this registration.
 func (r Network_Subnet_Registration) GetStatus() (resp datatypes.Network_Subnet_Registration_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_Registration", "getStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_Registration) GetStatusWith(options sl.Options) (resp datatypes.Network_Subnet_Registration_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // Retrieve The subnet that this registration pertains to.
 func (r Network_Subnet_Registration) GetSubnet() (resp datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_Registration", "getSubnet", nil, &r.Options, &resp)
 	return
 }
 
+// GetSubnetWith is GetSubnet, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_Registration) GetSubnetWith(options sl.Options) (resp datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubnet()
+}
+
 // The SoftLayer_Network_Subnet_Registration_Details objects are used to relate [[SoftLayer_Account_Regional_Registry_Detail]] objects to a [[SoftLayer_Network_Subnet_Registration]] object. This allows for easy reuse of registration details. It is important to note that only one detail object per type may be associated to a registration object.
 type Network_Subnet_Registration_Details struct {
 	Session *session.Session
@@ -14580,6 +21010,11 @@ func (r Network_Subnet_Registration_Details) Id(id int) Network_Subnet_Registrat
 	return r
 }
 
+func (r Network_Subnet_Registration_Details) InitParameter(name string, value interface{}) Network_Subnet_Registration_Details {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Subnet_Registration_Details) Mask(mask string) Network_Subnet_Registration_Details {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -14599,11 +21034,26 @@ func (r Network_Subnet_Registration_Details) Limit(limit int) Network_Subnet_Reg
 	return r
 }
 
+func (r Network_Subnet_Registration_Details) Unlimited() Network_Subnet_Registration_Details {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Subnet_Registration_Details) Offset(offset int) Network_Subnet_Registration_Details {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Subnet_Registration_Details) Timeout(timeout time.Duration) Network_Subnet_Registration_Details {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Subnet_Registration_Details) WithContext(ctx context.Context) Network_Subnet_Registration_Details {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // <style type="text/css">.create_object > li > div { padding-top: .5em; padding-bottom: .5em}</style> This method will create a new SoftLayer_Network_Subnet_Registration_Details object.
 //
 // <b>Input</b> - [[SoftLayer_Network_Subnet_Registration_Details (type)|SoftLayer_Network_Subnet_Registration_Details]] <ul class="create_object"> <li><code>detailId</code> <div> The numeric ID of the [[SoftLayer_Account_Regional_Registry_Detail|detail]] object to relate. </div> <ul> <li><b>Required</b></li> <li><b>Type</b> - integer</li> </ul> </li> <li><code>registrationId</code> <div> The numeric ID of the [[SoftLayer_Network_Subnet_Registration|registration]] object to relate. </div> <ul> <li><b>Required</b></li> <li><b>Type</b> - integer</li> </ul> </li> </ul>
@@ -14627,6 +21077,14 @@ func (r Network_Subnet_Registration_Details) GetDetail() (resp datatypes.Account
 	return
 }
 
+// GetDetailWith is GetDetail, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_Registration_Details) GetDetailWith(options sl.Options) (resp datatypes.Account_Regional_Registry_Detail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDetail()
+}
+
 // no documentation yet
 func (r Network_Subnet_Registration_Details) GetObject() (resp datatypes.Network_Subnet_Registration_Details, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_Registration_Details", "getObject", nil, &r.Options, &resp)
@@ -14639,6 +21097,14 @@ func (r Network_Subnet_Registration_Details) GetRegistration() (resp datatypes.N
 	return
 }
 
+// GetRegistrationWith is GetRegistration, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_Registration_Details) GetRegistrationWith(options sl.Options) (resp datatypes.Network_Subnet_Registration, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRegistration()
+}
+
 // Subnet Registration Status objects describe the current status of a subnet registration.
 //
 // The standard values for these objects are as follows: <ul> <li><strong>OPEN</strong> - Indicates that the registration object is new and has yet to be submitted to the RIR</li> <li><strong>PENDING</strong> - Indicates that the registration object has been submitted to the RIR and is awaiting response</li> <li><strong>COMPLETE</strong> - Indicates that the RIR action has completed</li> <li><strong>DELETED</strong> - Indicates that the registration object has been gracefully removed is no longer valid</li> <li><strong>CANCELLED</strong> - Indicates that the registration object has been abruptly removed is no longer valid</li> </ul>
@@ -14657,6 +21123,11 @@ func (r Network_Subnet_Registration_Status) Id(id int) Network_Subnet_Registrati
 	return r
 }
 
+func (r Network_Subnet_Registration_Status) InitParameter(name string, value interface{}) Network_Subnet_Registration_Status {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Subnet_Registration_Status) Mask(mask string) Network_Subnet_Registration_Status {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -14676,11 +21147,26 @@ func (r Network_Subnet_Registration_Status) Limit(limit int) Network_Subnet_Regi
 	return r
 }
 
+func (r Network_Subnet_Registration_Status) Unlimited() Network_Subnet_Registration_Status {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Subnet_Registration_Status) Offset(offset int) Network_Subnet_Registration_Status {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Subnet_Registration_Status) Timeout(timeout time.Duration) Network_Subnet_Registration_Status {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Subnet_Registration_Status) WithContext(ctx context.Context) Network_Subnet_Registration_Status {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Subnet_Registration_Status) GetAllObjects() (resp []datatypes.Network_Subnet_Registration_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_Registration_Status", "getAllObjects", nil, &r.Options, &resp)
@@ -14711,6 +21197,11 @@ func (r Network_Subnet_Rwhois_Data) Id(id int) Network_Subnet_Rwhois_Data {
 	return r
 }
 
+func (r Network_Subnet_Rwhois_Data) InitParameter(name string, value interface{}) Network_Subnet_Rwhois_Data {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Subnet_Rwhois_Data) Mask(mask string) Network_Subnet_Rwhois_Data {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -14730,11 +21221,26 @@ func (r Network_Subnet_Rwhois_Data) Limit(limit int) Network_Subnet_Rwhois_Data
 	return r
 }
 
+func (r Network_Subnet_Rwhois_Data) Unlimited() Network_Subnet_Rwhois_Data {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Subnet_Rwhois_Data) Offset(offset int) Network_Subnet_Rwhois_Data {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Subnet_Rwhois_Data) Timeout(timeout time.Duration) Network_Subnet_Rwhois_Data {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Subnet_Rwhois_Data) WithContext(ctx context.Context) Network_Subnet_Rwhois_Data {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Edit the RWHOIS record by passing in a modified version of the record object.  All fields are editable.
 func (r Network_Subnet_Rwhois_Data) EditObject(templateObject *datatypes.Network_Subnet_Rwhois_Data) (resp bool, err error) {
 	params := []interface{}{
@@ -14750,6 +21256,14 @@ func (r Network_Subnet_Rwhois_Data) GetAccount() (resp datatypes.Account, err er
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_Rwhois_Data) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // getObject retrieves the SoftLayer_Network_Subnet_Rwhois_Data object whose ID corresponds to the ID number of the init parameter passed to the SoftLayer_Network_Subnet_Rwhois_Data service.
 //
 // The best way to get Rwhois Data for an account is through getRhwoisData on the Account service.
@@ -14785,6 +21299,11 @@ func (r Network_Subnet_Swip_Transaction) Id(id int) Network_Subnet_Swip_Transact
 	return r
 }
 
+func (r Network_Subnet_Swip_Transaction) InitParameter(name string, value interface{}) Network_Subnet_Swip_Transaction {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Subnet_Swip_Transaction) Mask(mask string) Network_Subnet_Swip_Transaction {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -14804,11 +21323,26 @@ func (r Network_Subnet_Swip_Transaction) Limit(limit int) Network_Subnet_Swip_Tr
 	return r
 }
 
+func (r Network_Subnet_Swip_Transaction) Unlimited() Network_Subnet_Swip_Transaction {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Subnet_Swip_Transaction) Offset(offset int) Network_Subnet_Swip_Transaction {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Subnet_Swip_Transaction) Timeout(timeout time.Duration) Network_Subnet_Swip_Transaction {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Subnet_Swip_Transaction) WithContext(ctx context.Context) Network_Subnet_Swip_Transaction {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This function will return an array of SoftLayer_Network_Subnet_Swip_Transaction objects, one for each SWIP that is currently in transaction with ARIN.  This includes all swip registrations, swip removal requests, and SWIP objects that are currently OK.
 func (r Network_Subnet_Swip_Transaction) FindMyTransactions() (resp []datatypes.Network_Subnet_Swip_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_Swip_Transaction", "findMyTransactions", nil, &r.Options, &resp)
@@ -14821,6 +21355,14 @@ func (r Network_Subnet_Swip_Transaction) GetAccount() (resp datatypes.Account, e
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_Swip_Transaction) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // getObject retrieves the SoftLayer_Network_Subnet_Swip_Transaction object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Network_Subnet_Swip_transaction service. You can only retrieve Swip transactions tied to the account.
 func (r Network_Subnet_Swip_Transaction) GetObject() (resp datatypes.Network_Subnet_Swip_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_Swip_Transaction", "getObject", nil, &r.Options, &resp)
@@ -14833,6 +21375,14 @@ func (r Network_Subnet_Swip_Transaction) GetSubnet() (resp datatypes.Network_Sub
 	return
 }
 
+// GetSubnetWith is GetSubnet, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Subnet_Swip_Transaction) GetSubnetWith(options sl.Options) (resp datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubnet()
+}
+
 // This method finds all subnets attached to your account that are in OK status and starts "DELETE" transactions with ARIN, allowing you to remove your SWIP registration information.
 func (r Network_Subnet_Swip_Transaction) RemoveAllSubnetSwips() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Subnet_Swip_Transaction", "removeAllSubnetSwips", nil, &r.Options, &resp)
@@ -14879,6 +21429,11 @@ func (r Network_TippingPointReporting) Id(id int) Network_TippingPointReporting
 	return r
 }
 
+func (r Network_TippingPointReporting) InitParameter(name string, value interface{}) Network_TippingPointReporting {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_TippingPointReporting) Mask(mask string) Network_TippingPointReporting {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -14898,11 +21453,26 @@ func (r Network_TippingPointReporting) Limit(limit int) Network_TippingPointRepo
 	return r
 }
 
+func (r Network_TippingPointReporting) Unlimited() Network_TippingPointReporting {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_TippingPointReporting) Offset(offset int) Network_TippingPointReporting {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_TippingPointReporting) Timeout(timeout time.Duration) Network_TippingPointReporting {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_TippingPointReporting) WithContext(ctx context.Context) Network_TippingPointReporting {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method, when given an attack signature ID (available in the return values of getReportForIpAddressOrSubnet and  getSubnetReportForEntireAccount) and an IP Address and subnet mask, returns all attacks for that subnet in the specified time frame and direction.  Once the results have been filtered, additional data is available, including starting and ending times for the attack, originating IP address and port, and destination IP address and port.
 //
 // CVE and Bugtraq information is not available at this level.
@@ -14986,6 +21556,11 @@ func (r Network_Tunnel_Module_Context) Id(id int) Network_Tunnel_Module_Context
 	return r
 }
 
+func (r Network_Tunnel_Module_Context) InitParameter(name string, value interface{}) Network_Tunnel_Module_Context {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Tunnel_Module_Context) Mask(mask string) Network_Tunnel_Module_Context {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -15005,11 +21580,26 @@ func (r Network_Tunnel_Module_Context) Limit(limit int) Network_Tunnel_Module_Co
 	return r
 }
 
+func (r Network_Tunnel_Module_Context) Unlimited() Network_Tunnel_Module_Context {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Tunnel_Module_Context) Offset(offset int) Network_Tunnel_Module_Context {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Tunnel_Module_Context) Timeout(timeout time.Duration) Network_Tunnel_Module_Context {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Tunnel_Module_Context) WithContext(ctx context.Context) Network_Tunnel_Module_Context {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Associates a remote subnet to the network tunnel.  When a remote subnet is associated, a network tunnel will allow the customer (remote) network to communicate with the private and service subnets on the SoftLayer network which are on the other end of this network tunnel.
 //
 // NOTE:  A network tunnel's configurations must be applied to the network device in order for the association described above to take effect.
@@ -15188,12 +21778,28 @@ func (r Network_Tunnel_Module_Context) GetAccount() (resp datatypes.Account, err
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Tunnel_Module_Context) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The transaction that is currently applying configurations for the network tunnel.
 func (r Network_Tunnel_Module_Context) GetActiveTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Tunnel_Module_Context", "getActiveTransaction", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveTransactionWith is GetActiveTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Tunnel_Module_Context) GetActiveTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransaction()
+}
+
 // The address translations will be returned.  All the translations will be formatted so that the configurations can be copied into a host file.
 //
 // Format:
@@ -15210,12 +21816,28 @@ func (r Network_Tunnel_Module_Context) GetAddressTranslations() (resp []datatype
 	return
 }
 
+// GetAddressTranslationsWith is GetAddressTranslations, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Tunnel_Module_Context) GetAddressTranslationsWith(options sl.Options) (resp []datatypes.Network_Tunnel_Module_Context_Address_Translation, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAddressTranslations()
+}
+
 // Retrieve Subnets that provide access to SoftLayer services such as the management portal and the SoftLayer API.
 func (r Network_Tunnel_Module_Context) GetAllAvailableServiceSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Tunnel_Module_Context", "getAllAvailableServiceSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllAvailableServiceSubnetsWith is GetAllAvailableServiceSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Tunnel_Module_Context) GetAllAvailableServiceSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllAvailableServiceSubnets()
+}
+
 // The default authentication type used for both phases of the negotiation process.  The default value is set to MD5.
 func (r Network_Tunnel_Module_Context) GetAuthenticationDefault() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Tunnel_Module_Context", "getAuthenticationDefault", nil, &r.Options, &resp)
@@ -15239,18 +21861,42 @@ func (r Network_Tunnel_Module_Context) GetBillingItem() (resp datatypes.Billing_
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Tunnel_Module_Context) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve Remote subnets that are allowed access through a network tunnel.
 func (r Network_Tunnel_Module_Context) GetCustomerSubnets() (resp []datatypes.Network_Customer_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Tunnel_Module_Context", "getCustomerSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetCustomerSubnetsWith is GetCustomerSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Tunnel_Module_Context) GetCustomerSubnetsWith(options sl.Options) (resp []datatypes.Network_Customer_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCustomerSubnets()
+}
+
 // Retrieve The datacenter location for one end of the network tunnel that allows access to account's private subnets.
 func (r Network_Tunnel_Module_Context) GetDatacenter() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Tunnel_Module_Context", "getDatacenter", nil, &r.Options, &resp)
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Tunnel_Module_Context) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // The default Diffie-Hellman group used for both phases of the negotiation process.  The default value is set to 2.
 func (r Network_Tunnel_Module_Context) GetDiffieHellmanGroupDefault() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Tunnel_Module_Context", "getDiffieHellmanGroupDefault", nil, &r.Options, &resp)
@@ -15294,6 +21940,14 @@ func (r Network_Tunnel_Module_Context) GetInternalSubnets() (resp []datatypes.Ne
 	return
 }
 
+// GetInternalSubnetsWith is GetInternalSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Tunnel_Module_Context) GetInternalSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInternalSubnets()
+}
+
 // The keylife limits.  Keylife max limit is set to 120.  Keylife min limit is set to 172800.
 func (r Network_Tunnel_Module_Context) GetKeylifeLimits() (resp []int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Tunnel_Module_Context", "getKeylifeLimits", nil, &r.Options, &resp)
@@ -15330,18 +21984,42 @@ func (r Network_Tunnel_Module_Context) GetServiceSubnets() (resp []datatypes.Net
 	return
 }
 
+// GetServiceSubnetsWith is GetServiceSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Tunnel_Module_Context) GetServiceSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServiceSubnets()
+}
+
 // Retrieve Subnets used for a network tunnel's address translations.
 func (r Network_Tunnel_Module_Context) GetStaticRouteSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Tunnel_Module_Context", "getStaticRouteSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetStaticRouteSubnetsWith is GetStaticRouteSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Tunnel_Module_Context) GetStaticRouteSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStaticRouteSubnets()
+}
+
 // Retrieve The transaction history for this network tunnel.
 func (r Network_Tunnel_Module_Context) GetTransactionHistory() (resp []datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Tunnel_Module_Context", "getTransactionHistory", nil, &r.Options, &resp)
 	return
 }
 
+// GetTransactionHistoryWith is GetTransactionHistory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Tunnel_Module_Context) GetTransactionHistoryWith(options sl.Options) (resp []datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTransactionHistory()
+}
+
 // Disassociate a customer subnet (remote) from a network tunnel.  When a remote subnet is disassociated, that subnet will not able to communicate with private and service subnets on the SoftLayer network.
 //
 // NOTE:  A network tunnel's configurations must be applied to the network device in order for the disassociation described above to take effect.
@@ -15391,6 +22069,11 @@ func (r Network_Vlan) Id(id int) Network_Vlan {
 	return r
 }
 
+func (r Network_Vlan) InitParameter(name string, value interface{}) Network_Vlan {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Vlan) Mask(mask string) Network_Vlan {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -15410,11 +22093,26 @@ func (r Network_Vlan) Limit(limit int) Network_Vlan {
 	return r
 }
 
+func (r Network_Vlan) Unlimited() Network_Vlan {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Vlan) Offset(offset int) Network_Vlan {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Vlan) Timeout(timeout time.Duration) Network_Vlan {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Vlan) WithContext(ctx context.Context) Network_Vlan {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Edit a VLAN's properties
 func (r Network_Vlan) EditObject(templateObject *datatypes.Network_Vlan) (resp bool, err error) {
 	params := []interface{}{
@@ -15430,36 +22128,84 @@ func (r Network_Vlan) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve A VLAN's additional primary subnets. These are used to extend the number of servers attached to the VLAN by adding more ip addresses to the primary IP address pool.
 func (r Network_Vlan) GetAdditionalPrimarySubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getAdditionalPrimarySubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetAdditionalPrimarySubnetsWith is GetAdditionalPrimarySubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetAdditionalPrimarySubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAdditionalPrimarySubnets()
+}
+
 // Retrieve The gateway this VLAN is inside of.
 func (r Network_Vlan) GetAttachedNetworkGateway() (resp datatypes.Network_Gateway, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getAttachedNetworkGateway", nil, &r.Options, &resp)
 	return
 }
 
+// GetAttachedNetworkGatewayWith is GetAttachedNetworkGateway, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetAttachedNetworkGatewayWith(options sl.Options) (resp datatypes.Network_Gateway, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttachedNetworkGateway()
+}
+
 // Retrieve Whether or not this VLAN is inside a gateway.
 func (r Network_Vlan) GetAttachedNetworkGatewayFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getAttachedNetworkGatewayFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetAttachedNetworkGatewayFlagWith is GetAttachedNetworkGatewayFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetAttachedNetworkGatewayFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttachedNetworkGatewayFlag()
+}
+
 // Retrieve The inside VLAN record if this VLAN is inside a network gateway.
 func (r Network_Vlan) GetAttachedNetworkGatewayVlan() (resp datatypes.Network_Gateway_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getAttachedNetworkGatewayVlan", nil, &r.Options, &resp)
 	return
 }
 
+// GetAttachedNetworkGatewayVlanWith is GetAttachedNetworkGatewayVlan, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetAttachedNetworkGatewayVlanWith(options sl.Options) (resp datatypes.Network_Gateway_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttachedNetworkGatewayVlan()
+}
+
 // Retrieve The billing item for a network vlan.
 func (r Network_Vlan) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Get a set of reasons why this VLAN may not be cancelled. If the result is empty, this VLAN may be cancelled.
 func (r Network_Vlan) GetCancelFailureReasons() (resp []string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getCancelFailureReasons", nil, &r.Options, &resp)
@@ -15472,30 +22218,70 @@ func (r Network_Vlan) GetDedicatedFirewallFlag() (resp int, err error) {
 	return
 }
 
+// GetDedicatedFirewallFlagWith is GetDedicatedFirewallFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetDedicatedFirewallFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDedicatedFirewallFlag()
+}
+
 // Retrieve The extension router that a VLAN is associated with.
 func (r Network_Vlan) GetExtensionRouter() (resp datatypes.Hardware_Router, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getExtensionRouter", nil, &r.Options, &resp)
 	return
 }
 
+// GetExtensionRouterWith is GetExtensionRouter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetExtensionRouterWith(options sl.Options) (resp datatypes.Hardware_Router, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetExtensionRouter()
+}
+
 // Retrieve A firewalled Vlan's network components.
 func (r Network_Vlan) GetFirewallGuestNetworkComponents() (resp []datatypes.Network_Component_Firewall, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getFirewallGuestNetworkComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetFirewallGuestNetworkComponentsWith is GetFirewallGuestNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetFirewallGuestNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirewallGuestNetworkComponents()
+}
+
 // Retrieve A firewalled vlan's inbound/outbound interfaces.
 func (r Network_Vlan) GetFirewallInterfaces() (resp []datatypes.Network_Firewall_Module_Context_Interface, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getFirewallInterfaces", nil, &r.Options, &resp)
 	return
 }
 
+// GetFirewallInterfacesWith is GetFirewallInterfaces, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetFirewallInterfacesWith(options sl.Options) (resp []datatypes.Network_Firewall_Module_Context_Interface, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirewallInterfaces()
+}
+
 // Retrieve A firewalled Vlan's network components.
 func (r Network_Vlan) GetFirewallNetworkComponents() (resp []datatypes.Network_Component_Firewall, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getFirewallNetworkComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetFirewallNetworkComponentsWith is GetFirewallNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetFirewallNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirewallNetworkComponents()
+}
+
 // Get the IP addresses associated with this server that are protectable by a network component firewall. Note, this may not return all values for IPv6 subnets for this VLAN. Please use getFirewallProtectableSubnets to get all protectable subnets.
 func (r Network_Vlan) GetFirewallProtectableIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getFirewallProtectableIpAddresses", nil, &r.Options, &resp)
@@ -15514,18 +22300,42 @@ func (r Network_Vlan) GetFirewallRules() (resp []datatypes.Network_Vlan_Firewall
 	return
 }
 
+// GetFirewallRulesWith is GetFirewallRules, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetFirewallRulesWith(options sl.Options) (resp []datatypes.Network_Vlan_Firewall_Rule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirewallRules()
+}
+
 // Retrieve The networking components that are connected to a VLAN.
 func (r Network_Vlan) GetGuestNetworkComponents() (resp []datatypes.Virtual_Guest_Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getGuestNetworkComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetGuestNetworkComponentsWith is GetGuestNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetGuestNetworkComponentsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGuestNetworkComponents()
+}
+
 // Retrieve All of the hardware that exists on a VLAN. Hardware is associated with a VLAN by its networking components.
 func (r Network_Vlan) GetHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve
 func (r Network_Vlan) GetHighAvailabilityFirewallFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getHighAvailabilityFirewallFlag", nil, &r.Options, &resp)
@@ -15538,36 +22348,84 @@ func (r Network_Vlan) GetLocalDiskStorageCapabilityFlag() (resp bool, err error)
 	return
 }
 
+// GetLocalDiskStorageCapabilityFlagWith is GetLocalDiskStorageCapabilityFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetLocalDiskStorageCapabilityFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocalDiskStorageCapabilityFlag()
+}
+
 // Retrieve The network in which this VLAN resides.
 func (r Network_Vlan) GetNetwork() (resp datatypes.Network, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getNetwork", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkWith is GetNetwork, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetNetworkWith(options sl.Options) (resp datatypes.Network, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetwork()
+}
+
 // Retrieve The network components that are connected to this VLAN through a trunk.
 func (r Network_Vlan) GetNetworkComponentTrunks() (resp []datatypes.Network_Component_Network_Vlan_Trunk, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getNetworkComponentTrunks", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkComponentTrunksWith is GetNetworkComponentTrunks, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetNetworkComponentTrunksWith(options sl.Options) (resp []datatypes.Network_Component_Network_Vlan_Trunk, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponentTrunks()
+}
+
 // Retrieve The networking components that are connected to a VLAN.
 func (r Network_Vlan) GetNetworkComponents() (resp []datatypes.Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getNetworkComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkComponentsWith is GetNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetNetworkComponentsWith(options sl.Options) (resp []datatypes.Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponents()
+}
+
 // Retrieve Identifier to denote whether a VLAN is used for public or private connectivity.
 func (r Network_Vlan) GetNetworkSpace() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getNetworkSpace", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkSpaceWith is GetNetworkSpace, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetNetworkSpaceWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkSpace()
+}
+
 // Retrieve The Hardware Firewall (Dedicated) for a network vlan.
 func (r Network_Vlan) GetNetworkVlanFirewall() (resp datatypes.Network_Vlan_Firewall, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getNetworkVlanFirewall", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlanFirewallWith is GetNetworkVlanFirewall, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetNetworkVlanFirewallWith(options sl.Options) (resp datatypes.Network_Vlan_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlanFirewall()
+}
+
 // getObject retrieves the SoftLayer_Network_Vlan object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Network_Vlan service. You can only retrieve VLANs that are associated with your SoftLayer customer account.
 func (r Network_Vlan) GetObject() (resp datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getObject", nil, &r.Options, &resp)
@@ -15580,18 +22438,42 @@ func (r Network_Vlan) GetPrimaryRouter() (resp datatypes.Hardware_Router, err er
 	return
 }
 
+// GetPrimaryRouterWith is GetPrimaryRouter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetPrimaryRouterWith(options sl.Options) (resp datatypes.Hardware_Router, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryRouter()
+}
+
 // Retrieve A VLAN's primary subnet. Each VLAN has at least one subnet, usually the subnet that is assigned to a server or new IP address block when it's purchased.
 func (r Network_Vlan) GetPrimarySubnet() (resp datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getPrimarySubnet", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimarySubnetWith is GetPrimarySubnet, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetPrimarySubnetWith(options sl.Options) (resp datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimarySubnet()
+}
+
 // Retrieve A VLAN's primary IPv6 subnet. Some VLAN's may not have a primary IPv6 subnet.
 func (r Network_Vlan) GetPrimarySubnetVersion6() (resp datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getPrimarySubnetVersion6", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimarySubnetVersion6With is GetPrimarySubnetVersion6, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetPrimarySubnetVersion6With(options sl.Options) (resp datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimarySubnetVersion6()
+}
+
 // Retrieve
 func (r Network_Vlan) GetPrimarySubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getPrimarySubnets", nil, &r.Options, &resp)
@@ -15604,6 +22486,14 @@ func (r Network_Vlan) GetPrivateNetworkGateways() (resp []datatypes.Network_Gate
 	return
 }
 
+// GetPrivateNetworkGatewaysWith is GetPrivateNetworkGateways, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetPrivateNetworkGatewaysWith(options sl.Options) (resp []datatypes.Network_Gateway, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateNetworkGateways()
+}
+
 // Retrieve a VLAN's associated private network VLAN. getPrivateVlan gathers it's information by retrieving the private VLAN of a VLAN's primary hardware object.
 func (r Network_Vlan) GetPrivateVlan() (resp datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getPrivateVlan", nil, &r.Options, &resp)
@@ -15631,6 +22521,14 @@ func (r Network_Vlan) GetPublicNetworkGateways() (resp []datatypes.Network_Gatew
 	return
 }
 
+// GetPublicNetworkGatewaysWith is GetPublicNetworkGateways, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetPublicNetworkGatewaysWith(options sl.Options) (resp []datatypes.Network_Gateway, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPublicNetworkGateways()
+}
+
 // Retrieve the VLAN that belongs to a server's public network interface, as described by a server's fully-qualified domain name. A server's ''FQDN'' is it's hostname, followed by a period then it's domain name.
 func (r Network_Vlan) GetPublicVlanByFqdn(fqdn *string) (resp datatypes.Network_Vlan, err error) {
 	params := []interface{}{
@@ -15652,54 +22550,126 @@ func (r Network_Vlan) GetSanStorageCapabilityFlag() (resp bool, err error) {
 	return
 }
 
+// GetSanStorageCapabilityFlagWith is GetSanStorageCapabilityFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetSanStorageCapabilityFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSanStorageCapabilityFlag()
+}
+
 // Retrieve Collection of scale VLANs this VLAN applies to.
 func (r Network_Vlan) GetScaleVlans() (resp []datatypes.Scale_Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getScaleVlans", nil, &r.Options, &resp)
 	return
 }
 
+// GetScaleVlansWith is GetScaleVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetScaleVlansWith(options sl.Options) (resp []datatypes.Scale_Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleVlans()
+}
+
 // Retrieve The secondary router that a VLAN is associated with. Every SoftLayer VLAN is connected to more than one router for greater network redundancy.
 func (r Network_Vlan) GetSecondaryRouter() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getSecondaryRouter", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecondaryRouterWith is GetSecondaryRouter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetSecondaryRouterWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecondaryRouter()
+}
+
 // Retrieve The subnets that exist as secondary interfaces on a VLAN
 func (r Network_Vlan) GetSecondarySubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getSecondarySubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecondarySubnetsWith is GetSecondarySubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetSecondarySubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecondarySubnets()
+}
+
 // Retrieve All of the subnets that exist as VLAN interfaces.
 func (r Network_Vlan) GetSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetSubnetsWith is GetSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubnets()
+}
+
 // Retrieve References to all tags for this VLAN.
 func (r Network_Vlan) GetTagReferences() (resp []datatypes.Tag_Reference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getTagReferences", nil, &r.Options, &resp)
 	return
 }
 
+// GetTagReferencesWith is GetTagReferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetTagReferencesWith(options sl.Options) (resp []datatypes.Tag_Reference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTagReferences()
+}
+
 // Retrieve The number of primary IP addresses in a VLAN.
 func (r Network_Vlan) GetTotalPrimaryIpAddressCount() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getTotalPrimaryIpAddressCount", nil, &r.Options, &resp)
 	return
 }
 
+// GetTotalPrimaryIpAddressCountWith is GetTotalPrimaryIpAddressCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetTotalPrimaryIpAddressCountWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalPrimaryIpAddressCount()
+}
+
 // Retrieve The type of this VLAN.
 func (r Network_Vlan) GetType() (resp datatypes.Network_Vlan_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetTypeWith(options sl.Options) (resp datatypes.Network_Vlan_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Retrieve All of the Virtual Servers that are connected to a VLAN.
 func (r Network_Vlan) GetVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan", "getVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWith is GetVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan) GetVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuests()
+}
+
 // Retrieve the VLAN associated with an IP address via the IP's associated subnet.
 func (r Network_Vlan) GetVlanForIpAddress(ipAddress *string) (resp datatypes.Network_Vlan, err error) {
 	params := []interface{}{
@@ -15744,6 +22714,11 @@ func (r Network_Vlan_Firewall) Id(id int) Network_Vlan_Firewall {
 	return r
 }
 
+func (r Network_Vlan_Firewall) InitParameter(name string, value interface{}) Network_Vlan_Firewall {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Vlan_Firewall) Mask(mask string) Network_Vlan_Firewall {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -15763,11 +22738,26 @@ func (r Network_Vlan_Firewall) Limit(limit int) Network_Vlan_Firewall {
 	return r
 }
 
+func (r Network_Vlan_Firewall) Unlimited() Network_Vlan_Firewall {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Vlan_Firewall) Offset(offset int) Network_Vlan_Firewall {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Vlan_Firewall) Timeout(timeout time.Duration) Network_Vlan_Firewall {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Vlan_Firewall) WithContext(ctx context.Context) Network_Vlan_Firewall {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Approve a request from technical support to bypass the firewall. Once approved, support will be able to route and unroute the VLAN on the firewall.
 func (r Network_Vlan_Firewall) ApproveBypassRequest() (err error) {
 	var resp datatypes.Void
@@ -15787,96 +22777,224 @@ func (r Network_Vlan_Firewall) GetBandwidthAllocation() (resp datatypes.Float64,
 	return
 }
 
+// GetBandwidthAllocationWith is GetBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllocation()
+}
+
 // Retrieve The raw bandwidth usage data for the current billing cycle. One object will be returned for each network this firewall is attached to.
 func (r Network_Vlan_Firewall) GetBillingCycleBandwidthUsage() (resp []datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getBillingCycleBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCycleBandwidthUsageWith is GetBillingCycleBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetBillingCycleBandwidthUsageWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCycleBandwidthUsage()
+}
+
 // Retrieve The raw private bandwidth usage data for the current billing cycle.
 func (r Network_Vlan_Firewall) GetBillingCyclePrivateBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getBillingCyclePrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCyclePrivateBandwidthUsageWith is GetBillingCyclePrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetBillingCyclePrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePrivateBandwidthUsage()
+}
+
 // Retrieve The raw public bandwidth usage data for the current billing cycle.
 func (r Network_Vlan_Firewall) GetBillingCyclePublicBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getBillingCyclePublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCyclePublicBandwidthUsageWith is GetBillingCyclePublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetBillingCyclePublicBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePublicBandwidthUsage()
+}
+
 // Retrieve The billing item for a Hardware Firewall (Dedicated).
 func (r Network_Vlan_Firewall) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve Administrative bypass request status.
 func (r Network_Vlan_Firewall) GetBypassRequestStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getBypassRequestStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetBypassRequestStatusWith is GetBypassRequestStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetBypassRequestStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBypassRequestStatus()
+}
+
 // Retrieve The datacenter that the firewall resides in.
 func (r Network_Vlan_Firewall) GetDatacenter() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getDatacenter", nil, &r.Options, &resp)
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // Retrieve The firewall device type.
 func (r Network_Vlan_Firewall) GetFirewallType() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getFirewallType", nil, &r.Options, &resp)
 	return
 }
 
+// GetFirewallTypeWith is GetFirewallType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetFirewallTypeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirewallType()
+}
+
 // Retrieve A name reflecting the hostname and domain of the firewall. This is created from the combined values of the firewall's logical name and vlan number automatically, and thus can not be edited directly.
 func (r Network_Vlan_Firewall) GetFullyQualifiedDomainName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getFullyQualifiedDomainName", nil, &r.Options, &resp)
 	return
 }
 
+// GetFullyQualifiedDomainNameWith is GetFullyQualifiedDomainName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetFullyQualifiedDomainNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFullyQualifiedDomainName()
+}
+
 // Retrieve The credentials to log in to a firewall device. This is only present for dedicated appliances.
 func (r Network_Vlan_Firewall) GetManagementCredentials() (resp datatypes.Software_Component_Password, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getManagementCredentials", nil, &r.Options, &resp)
 	return
 }
 
+// GetManagementCredentialsWith is GetManagementCredentials, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetManagementCredentialsWith(options sl.Options) (resp datatypes.Software_Component_Password, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagementCredentials()
+}
+
 // Retrieve A firewall's metric tracking object.
 func (r Network_Vlan_Firewall) GetMetricTrackingObject() (resp datatypes.Metric_Tracking_Object, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getMetricTrackingObject", nil, &r.Options, &resp)
 	return
 }
 
+// GetMetricTrackingObjectWith is GetMetricTrackingObject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+}
+
 // Retrieve The metric tracking object ID for this firewall.
 func (r Network_Vlan_Firewall) GetMetricTrackingObjectId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getMetricTrackingObjectId", nil, &r.Options, &resp)
 	return
 }
 
+// GetMetricTrackingObjectIdWith is GetMetricTrackingObjectId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetMetricTrackingObjectIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObjectId()
+}
+
 // Retrieve The update requests made for this firewall.
 func (r Network_Vlan_Firewall) GetNetworkFirewallUpdateRequests() (resp []datatypes.Network_Firewall_Update_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getNetworkFirewallUpdateRequests", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkFirewallUpdateRequestsWith is GetNetworkFirewallUpdateRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetNetworkFirewallUpdateRequestsWith(options sl.Options) (resp []datatypes.Network_Firewall_Update_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkFirewallUpdateRequests()
+}
+
 // Retrieve The gateway associated with this firewall, if any.
 func (r Network_Vlan_Firewall) GetNetworkGateway() (resp datatypes.Network_Gateway, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getNetworkGateway", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkGatewayWith is GetNetworkGateway, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetNetworkGatewayWith(options sl.Options) (resp datatypes.Network_Gateway, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkGateway()
+}
+
 // Retrieve The VLAN object that a firewall is associated with and protecting.
 func (r Network_Vlan_Firewall) GetNetworkVlan() (resp datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getNetworkVlan", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlanWith is GetNetworkVlan, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetNetworkVlanWith(options sl.Options) (resp datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlan()
+}
+
 // Retrieve The VLAN objects that a firewall is associated with and protecting.
 func (r Network_Vlan_Firewall) GetNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getNetworkVlans", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlansWith is GetNetworkVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlans()
+}
+
 // getObject returns a SoftLayer_Network_Vlan_Firewall object. You can only get objects for vlans attached to your account that have a network firewall enabled.
 func (r Network_Vlan_Firewall) GetObject() (resp datatypes.Network_Vlan_Firewall, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getObject", nil, &r.Options, &resp)
@@ -15889,6 +23007,14 @@ func (r Network_Vlan_Firewall) GetRules() (resp []datatypes.Network_Vlan_Firewal
 	return
 }
 
+// GetRulesWith is GetRules, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetRulesWith(options sl.Options) (resp []datatypes.Network_Vlan_Firewall_Rule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRules()
+}
+
 // Retrieve
 func (r Network_Vlan_Firewall) GetTagReferences() (resp []datatypes.Tag_Reference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "getTagReferences", nil, &r.Options, &resp)
@@ -15901,6 +23027,14 @@ func (r Network_Vlan_Firewall) GetUpgradeRequest() (resp datatypes.Product_Upgra
 	return
 }
 
+// GetUpgradeRequestWith is GetUpgradeRequest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Network_Vlan_Firewall) GetUpgradeRequestWith(options sl.Options) (resp datatypes.Product_Upgrade_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeRequest()
+}
+
 // Whether this firewall qualifies for High Availability upgrade.
 func (r Network_Vlan_Firewall) IsHighAvailabilityUpgradeAvailable() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Firewall", "isHighAvailabilityUpgradeAvailable", nil, &r.Options, &resp)
@@ -15954,6 +23088,11 @@ func (r Network_Vlan_Type) Id(id int) Network_Vlan_Type {
 	return r
 }
 
+func (r Network_Vlan_Type) InitParameter(name string, value interface{}) Network_Vlan_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Network_Vlan_Type) Mask(mask string) Network_Vlan_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -15973,11 +23112,26 @@ func (r Network_Vlan_Type) Limit(limit int) Network_Vlan_Type {
 	return r
 }
 
+func (r Network_Vlan_Type) Unlimited() Network_Vlan_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Network_Vlan_Type) Offset(offset int) Network_Vlan_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Network_Vlan_Type) Timeout(timeout time.Duration) Network_Vlan_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Network_Vlan_Type) WithContext(ctx context.Context) Network_Vlan_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Network_Vlan_Type) GetObject() (resp datatypes.Network_Vlan_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Network_Vlan_Type", "getObject", nil, &r.Options, &resp)
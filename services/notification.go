@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Notification) Id(id int) Notification {
 	return r
 }
 
+func (r Notification) InitParameter(name string, value interface{}) Notification {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Notification) Mask(mask string) Notification {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Notification) Limit(limit int) Notification {
 	return r
 }
 
+func (r Notification) Unlimited() Notification {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Notification) Offset(offset int) Notification {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Notification) Timeout(timeout time.Duration) Notification {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Notification) WithContext(ctx context.Context) Notification {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Use this method to retrieve all active notifications that can be subscribed to.
 func (r Notification) GetAllObjects() (resp []datatypes.Notification, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification", "getAllObjects", nil, &r.Options, &resp)
@@ -87,12 +109,28 @@ func (r Notification) GetPreferences() (resp []datatypes.Notification_Preference
 	return
 }
 
+// GetPreferencesWith is GetPreferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification) GetPreferencesWith(options sl.Options) (resp []datatypes.Notification_Preference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPreferences()
+}
+
 // Retrieve The required preferences related to the notification. While configurable, the subscriber does not have the option whether to use the preference.
 func (r Notification) GetRequiredPreferences() (resp []datatypes.Notification_Preference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification", "getRequiredPreferences", nil, &r.Options, &resp)
 	return
 }
 
+// GetRequiredPreferencesWith is GetRequiredPreferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification) GetRequiredPreferencesWith(options sl.Options) (resp []datatypes.Notification_Preference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRequiredPreferences()
+}
+
 // This is an extension of the SoftLayer_Notification class.  These are implementation details specific to those notifications which can be subscribed to and received on a mobile device.
 type Notification_Mobile struct {
 	Session *session.Session
@@ -109,6 +147,11 @@ func (r Notification_Mobile) Id(id int) Notification_Mobile {
 	return r
 }
 
+func (r Notification_Mobile) InitParameter(name string, value interface{}) Notification_Mobile {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Notification_Mobile) Mask(mask string) Notification_Mobile {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -128,11 +171,26 @@ func (r Notification_Mobile) Limit(limit int) Notification_Mobile {
 	return r
 }
 
+func (r Notification_Mobile) Unlimited() Notification_Mobile {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Notification_Mobile) Offset(offset int) Notification_Mobile {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Notification_Mobile) Timeout(timeout time.Duration) Notification_Mobile {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Notification_Mobile) WithContext(ctx context.Context) Notification_Mobile {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a new subscriber for a given resource.
 func (r Notification_Mobile) CreateSubscriberForMobileDevice(keyName *string, resourceTableId *int, userRecordId *int) (resp bool, err error) {
 	params := []interface{}{
@@ -162,12 +220,28 @@ func (r Notification_Mobile) GetPreferences() (resp []datatypes.Notification_Pre
 	return
 }
 
+// GetPreferencesWith is GetPreferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_Mobile) GetPreferencesWith(options sl.Options) (resp []datatypes.Notification_Preference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPreferences()
+}
+
 // Retrieve The required preferences related to the notification. While configurable, the subscriber does not have the option whether to use the preference.
 func (r Notification_Mobile) GetRequiredPreferences() (resp []datatypes.Notification_Preference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_Mobile", "getRequiredPreferences", nil, &r.Options, &resp)
 	return
 }
 
+// GetRequiredPreferencesWith is GetRequiredPreferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_Mobile) GetRequiredPreferencesWith(options sl.Options) (resp []datatypes.Notification_Preference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRequiredPreferences()
+}
+
 // no documentation yet
 type Notification_Occurrence_Event struct {
 	Session *session.Session
@@ -184,6 +258,11 @@ func (r Notification_Occurrence_Event) Id(id int) Notification_Occurrence_Event
 	return r
 }
 
+func (r Notification_Occurrence_Event) InitParameter(name string, value interface{}) Notification_Occurrence_Event {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Notification_Occurrence_Event) Mask(mask string) Notification_Occurrence_Event {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -203,11 +282,26 @@ func (r Notification_Occurrence_Event) Limit(limit int) Notification_Occurrence_
 	return r
 }
 
+func (r Notification_Occurrence_Event) Unlimited() Notification_Occurrence_Event {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Notification_Occurrence_Event) Offset(offset int) Notification_Occurrence_Event {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Notification_Occurrence_Event) Timeout(timeout time.Duration) Notification_Occurrence_Event {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Notification_Occurrence_Event) WithContext(ctx context.Context) Notification_Occurrence_Event {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // <<<< EOT
 func (r Notification_Occurrence_Event) AcknowledgeNotification() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_Occurrence_Event", "acknowledgeNotification", nil, &r.Options, &resp)
@@ -220,6 +314,14 @@ func (r Notification_Occurrence_Event) GetAcknowledgedFlag() (resp bool, err err
 	return
 }
 
+// GetAcknowledgedFlagWith is GetAcknowledgedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_Occurrence_Event) GetAcknowledgedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAcknowledgedFlag()
+}
+
 // no documentation yet
 func (r Notification_Occurrence_Event) GetAllObjects() (resp []datatypes.Notification_Occurrence_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_Occurrence_Event", "getAllObjects", nil, &r.Options, &resp)
@@ -241,12 +343,28 @@ func (r Notification_Occurrence_Event) GetAttachments() (resp []datatypes.Notifi
 	return
 }
 
+// GetAttachmentsWith is GetAttachments, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_Occurrence_Event) GetAttachmentsWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Event_Attachment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttachments()
+}
+
 // Retrieve The first update for this event.
 func (r Notification_Occurrence_Event) GetFirstUpdate() (resp datatypes.Notification_Occurrence_Update, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_Occurrence_Event", "getFirstUpdate", nil, &r.Options, &resp)
 	return
 }
 
+// GetFirstUpdateWith is GetFirstUpdate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_Occurrence_Event) GetFirstUpdateWith(options sl.Options) (resp datatypes.Notification_Occurrence_Update, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirstUpdate()
+}
+
 // This method will return the number of impacted owned accounts associated with this event for the current user.
 func (r Notification_Occurrence_Event) GetImpactedAccountCount() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_Occurrence_Event", "getImpactedAccountCount", nil, &r.Options, &resp)
@@ -259,6 +377,14 @@ func (r Notification_Occurrence_Event) GetImpactedAccounts() (resp []datatypes.N
 	return
 }
 
+// GetImpactedAccountsWith is GetImpactedAccounts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_Occurrence_Event) GetImpactedAccountsWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetImpactedAccounts()
+}
+
 // This method will return the number of impacted devices associated with this event for the current user.
 func (r Notification_Occurrence_Event) GetImpactedDeviceCount() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_Occurrence_Event", "getImpactedDeviceCount", nil, &r.Options, &resp)
@@ -277,24 +403,56 @@ func (r Notification_Occurrence_Event) GetImpactedResources() (resp []datatypes.
 	return
 }
 
+// GetImpactedResourcesWith is GetImpactedResources, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_Occurrence_Event) GetImpactedResourcesWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Resource, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetImpactedResources()
+}
+
 // Retrieve A collection of users impacted by this event. Each impacted user record relates directly to a [[SoftLayer_User_Customer]].
 func (r Notification_Occurrence_Event) GetImpactedUsers() (resp []datatypes.Notification_Occurrence_User, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_Occurrence_Event", "getImpactedUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetImpactedUsersWith is GetImpactedUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_Occurrence_Event) GetImpactedUsersWith(options sl.Options) (resp []datatypes.Notification_Occurrence_User, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetImpactedUsers()
+}
+
 // Retrieve The last update for this event.
 func (r Notification_Occurrence_Event) GetLastUpdate() (resp datatypes.Notification_Occurrence_Update, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_Occurrence_Event", "getLastUpdate", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastUpdateWith is GetLastUpdate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_Occurrence_Event) GetLastUpdateWith(options sl.Options) (resp datatypes.Notification_Occurrence_Update, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastUpdate()
+}
+
 // Retrieve The type of event such as planned or unplanned maintenance.
 func (r Notification_Occurrence_Event) GetNotificationOccurrenceEventType() (resp datatypes.Notification_Occurrence_Event_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_Occurrence_Event", "getNotificationOccurrenceEventType", nil, &r.Options, &resp)
 	return
 }
 
+// GetNotificationOccurrenceEventTypeWith is GetNotificationOccurrenceEventType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_Occurrence_Event) GetNotificationOccurrenceEventTypeWith(options sl.Options) (resp datatypes.Notification_Occurrence_Event_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNotificationOccurrenceEventType()
+}
+
 // no documentation yet
 func (r Notification_Occurrence_Event) GetObject() (resp datatypes.Notification_Occurrence_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_Occurrence_Event", "getObject", nil, &r.Options, &resp)
@@ -313,6 +471,14 @@ func (r Notification_Occurrence_Event) GetUpdates() (resp []datatypes.Notificati
 	return
 }
 
+// GetUpdatesWith is GetUpdates, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_Occurrence_Event) GetUpdatesWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Update, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpdates()
+}
+
 // This type contains general information relating to a user that may be impacted by a [[SoftLayer_Notification_Occurrence_Event]].
 type Notification_Occurrence_User struct {
 	Session *session.Session
@@ -329,6 +495,11 @@ func (r Notification_Occurrence_User) Id(id int) Notification_Occurrence_User {
 	return r
 }
 
+func (r Notification_Occurrence_User) InitParameter(name string, value interface{}) Notification_Occurrence_User {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Notification_Occurrence_User) Mask(mask string) Notification_Occurrence_User {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -348,11 +519,26 @@ func (r Notification_Occurrence_User) Limit(limit int) Notification_Occurrence_U
 	return r
 }
 
+func (r Notification_Occurrence_User) Unlimited() Notification_Occurrence_User {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Notification_Occurrence_User) Offset(offset int) Notification_Occurrence_User {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Notification_Occurrence_User) Timeout(timeout time.Duration) Notification_Occurrence_User {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Notification_Occurrence_User) WithContext(ctx context.Context) Notification_Occurrence_User {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Notification_Occurrence_User) Acknowledge() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_Occurrence_User", "acknowledge", nil, &r.Options, &resp)
@@ -377,12 +563,28 @@ func (r Notification_Occurrence_User) GetImpactedResources() (resp []datatypes.N
 	return
 }
 
+// GetImpactedResourcesWith is GetImpactedResources, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_Occurrence_User) GetImpactedResourcesWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Resource, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetImpactedResources()
+}
+
 // Retrieve The associated event.
 func (r Notification_Occurrence_User) GetNotificationOccurrenceEvent() (resp datatypes.Notification_Occurrence_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_Occurrence_User", "getNotificationOccurrenceEvent", nil, &r.Options, &resp)
 	return
 }
 
+// GetNotificationOccurrenceEventWith is GetNotificationOccurrenceEvent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_Occurrence_User) GetNotificationOccurrenceEventWith(options sl.Options) (resp datatypes.Notification_Occurrence_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNotificationOccurrenceEvent()
+}
+
 // no documentation yet
 func (r Notification_Occurrence_User) GetObject() (resp datatypes.Notification_Occurrence_User, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_Occurrence_User", "getObject", nil, &r.Options, &resp)
@@ -395,6 +597,14 @@ func (r Notification_Occurrence_User) GetUser() (resp datatypes.User_Customer, e
 	return
 }
 
+// GetUserWith is GetUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_Occurrence_User) GetUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUser()
+}
+
 // A notification subscriber will have details pertaining to the subscriber's notification subscription.  You can receive details such as preferences, details of the preferences, delivery methods and the delivery methods for the subscriber.
 //
 // NOTE: There are preferences and delivery methods that cannot be modified.  Also, there are some subscriptions that are required.
@@ -413,6 +623,11 @@ func (r Notification_User_Subscriber) Id(id int) Notification_User_Subscriber {
 	return r
 }
 
+func (r Notification_User_Subscriber) InitParameter(name string, value interface{}) Notification_User_Subscriber {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Notification_User_Subscriber) Mask(mask string) Notification_User_Subscriber {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -432,11 +647,26 @@ func (r Notification_User_Subscriber) Limit(limit int) Notification_User_Subscri
 	return r
 }
 
+func (r Notification_User_Subscriber) Unlimited() Notification_User_Subscriber {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Notification_User_Subscriber) Offset(offset int) Notification_User_Subscriber {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Notification_User_Subscriber) Timeout(timeout time.Duration) Notification_User_Subscriber {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Notification_User_Subscriber) WithContext(ctx context.Context) Notification_User_Subscriber {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Use the method to create a new subscription for a notification.  This method is the entry method to the notification system. Certain properties are required to create a subscription while others are optional.
 //
 // The required property is the resourceRecord property which is type SoftLayer_Notification_User_Subscriber_Resource.  For the resourceRecord property, the only property that needs to be populated is the resourceTableId.  The resourceTableId is the unique identifier of a SoftLayer service to create the subscription for.  For example, the unique identifier of the Storage Evault service to create the subscription on.
@@ -493,12 +723,28 @@ func (r Notification_User_Subscriber) GetDeliveryMethods() (resp []datatypes.Not
 	return
 }
 
+// GetDeliveryMethodsWith is GetDeliveryMethods, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber) GetDeliveryMethodsWith(options sl.Options) (resp []datatypes.Notification_Delivery_Method, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDeliveryMethods()
+}
+
 // Retrieve Notification subscribed to.
 func (r Notification_User_Subscriber) GetNotification() (resp datatypes.Notification, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber", "getNotification", nil, &r.Options, &resp)
 	return
 }
 
+// GetNotificationWith is GetNotification, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber) GetNotificationWith(options sl.Options) (resp datatypes.Notification, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNotification()
+}
+
 // no documentation yet
 func (r Notification_User_Subscriber) GetObject() (resp datatypes.Notification_User_Subscriber, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber", "getObject", nil, &r.Options, &resp)
@@ -511,24 +757,56 @@ func (r Notification_User_Subscriber) GetPreferences() (resp []datatypes.Notific
 	return
 }
 
+// GetPreferencesWith is GetPreferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber) GetPreferencesWith(options sl.Options) (resp []datatypes.Notification_User_Subscriber_Preference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPreferences()
+}
+
 // Retrieve Preference details such as description, minimum and maximum limits, default value and unit of measure.
 func (r Notification_User_Subscriber) GetPreferencesDetails() (resp []datatypes.Notification_Preference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber", "getPreferencesDetails", nil, &r.Options, &resp)
 	return
 }
 
+// GetPreferencesDetailsWith is GetPreferencesDetails, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber) GetPreferencesDetailsWith(options sl.Options) (resp []datatypes.Notification_Preference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPreferencesDetails()
+}
+
 // Retrieve The subscriber id to resource id mapping.
 func (r Notification_User_Subscriber) GetResourceRecord() (resp datatypes.Notification_User_Subscriber_Resource, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber", "getResourceRecord", nil, &r.Options, &resp)
 	return
 }
 
+// GetResourceRecordWith is GetResourceRecord, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber) GetResourceRecordWith(options sl.Options) (resp datatypes.Notification_User_Subscriber_Resource, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResourceRecord()
+}
+
 // Retrieve User record for the subscription.
 func (r Notification_User_Subscriber) GetUserRecord() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber", "getUserRecord", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserRecordWith is GetUserRecord, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber) GetUserRecordWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUserRecord()
+}
+
 // A notification subscriber will have details pertaining to the subscriber's notification subscription.  You can receive details such as preferences, details of the preferences, delivery methods and the delivery methods for the subscriber.
 //
 // NOTE: There are preferences and delivery methods that cannot be modified.  Also, there are some subscriptions that are required.
@@ -547,6 +825,11 @@ func (r Notification_User_Subscriber_Billing) Id(id int) Notification_User_Subsc
 	return r
 }
 
+func (r Notification_User_Subscriber_Billing) InitParameter(name string, value interface{}) Notification_User_Subscriber_Billing {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Notification_User_Subscriber_Billing) Mask(mask string) Notification_User_Subscriber_Billing {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -566,11 +849,26 @@ func (r Notification_User_Subscriber_Billing) Limit(limit int) Notification_User
 	return r
 }
 
+func (r Notification_User_Subscriber_Billing) Unlimited() Notification_User_Subscriber_Billing {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Notification_User_Subscriber_Billing) Offset(offset int) Notification_User_Subscriber_Billing {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Notification_User_Subscriber_Billing) Timeout(timeout time.Duration) Notification_User_Subscriber_Billing {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Notification_User_Subscriber_Billing) WithContext(ctx context.Context) Notification_User_Subscriber_Billing {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Use the method to create a new subscription for a notification.  This method is the entry method to the notification system. Certain properties are required to create a subscription while others are optional.
 //
 // The required property is the resourceRecord property which is type SoftLayer_Notification_User_Subscriber_Resource.  For the resourceRecord property, the only property that needs to be populated is the resourceTableId.  The resourceTableId is the unique identifier of a SoftLayer service to create the subscription for.  For example, the unique identifier of the Storage Evault service to create the subscription on.
@@ -627,12 +925,28 @@ func (r Notification_User_Subscriber_Billing) GetDeliveryMethods() (resp []datat
 	return
 }
 
+// GetDeliveryMethodsWith is GetDeliveryMethods, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber_Billing) GetDeliveryMethodsWith(options sl.Options) (resp []datatypes.Notification_Delivery_Method, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDeliveryMethods()
+}
+
 // Retrieve Notification subscribed to.
 func (r Notification_User_Subscriber_Billing) GetNotification() (resp datatypes.Notification, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber_Billing", "getNotification", nil, &r.Options, &resp)
 	return
 }
 
+// GetNotificationWith is GetNotification, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber_Billing) GetNotificationWith(options sl.Options) (resp datatypes.Notification, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNotification()
+}
+
 // no documentation yet
 func (r Notification_User_Subscriber_Billing) GetObject() (resp datatypes.Notification_User_Subscriber_Billing, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber_Billing", "getObject", nil, &r.Options, &resp)
@@ -645,24 +959,56 @@ func (r Notification_User_Subscriber_Billing) GetPreferences() (resp []datatypes
 	return
 }
 
+// GetPreferencesWith is GetPreferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber_Billing) GetPreferencesWith(options sl.Options) (resp []datatypes.Notification_User_Subscriber_Preference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPreferences()
+}
+
 // Retrieve Preference details such as description, minimum and maximum limits, default value and unit of measure.
 func (r Notification_User_Subscriber_Billing) GetPreferencesDetails() (resp []datatypes.Notification_Preference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber_Billing", "getPreferencesDetails", nil, &r.Options, &resp)
 	return
 }
 
+// GetPreferencesDetailsWith is GetPreferencesDetails, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber_Billing) GetPreferencesDetailsWith(options sl.Options) (resp []datatypes.Notification_Preference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPreferencesDetails()
+}
+
 // Retrieve The subscriber id to resource id mapping.
 func (r Notification_User_Subscriber_Billing) GetResourceRecord() (resp datatypes.Notification_User_Subscriber_Resource, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber_Billing", "getResourceRecord", nil, &r.Options, &resp)
 	return
 }
 
+// GetResourceRecordWith is GetResourceRecord, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber_Billing) GetResourceRecordWith(options sl.Options) (resp datatypes.Notification_User_Subscriber_Resource, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResourceRecord()
+}
+
 // Retrieve User record for the subscription.
 func (r Notification_User_Subscriber_Billing) GetUserRecord() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber_Billing", "getUserRecord", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserRecordWith is GetUserRecord, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber_Billing) GetUserRecordWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUserRecord()
+}
+
 // A notification subscriber will have details pertaining to the subscriber's notification subscription.  You can receive details such as preferences, details of the preferences, delivery methods and the delivery methods for the subscriber.
 //
 // NOTE: There are preferences and delivery methods that cannot be modified.  Also, there are some subscriptions that are required.
@@ -681,6 +1027,11 @@ func (r Notification_User_Subscriber_Mobile) Id(id int) Notification_User_Subscr
 	return r
 }
 
+func (r Notification_User_Subscriber_Mobile) InitParameter(name string, value interface{}) Notification_User_Subscriber_Mobile {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Notification_User_Subscriber_Mobile) Mask(mask string) Notification_User_Subscriber_Mobile {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -700,11 +1051,26 @@ func (r Notification_User_Subscriber_Mobile) Limit(limit int) Notification_User_
 	return r
 }
 
+func (r Notification_User_Subscriber_Mobile) Unlimited() Notification_User_Subscriber_Mobile {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Notification_User_Subscriber_Mobile) Offset(offset int) Notification_User_Subscriber_Mobile {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Notification_User_Subscriber_Mobile) Timeout(timeout time.Duration) Notification_User_Subscriber_Mobile {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Notification_User_Subscriber_Mobile) WithContext(ctx context.Context) Notification_User_Subscriber_Mobile {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Notification_User_Subscriber_Mobile) ClearSnoozeTimer() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber_Mobile", "clearSnoozeTimer", nil, &r.Options, &resp)
@@ -767,12 +1133,28 @@ func (r Notification_User_Subscriber_Mobile) GetDeliveryMethods() (resp []dataty
 	return
 }
 
+// GetDeliveryMethodsWith is GetDeliveryMethods, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber_Mobile) GetDeliveryMethodsWith(options sl.Options) (resp []datatypes.Notification_Delivery_Method, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDeliveryMethods()
+}
+
 // Retrieve Notification subscribed to.
 func (r Notification_User_Subscriber_Mobile) GetNotification() (resp datatypes.Notification, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber_Mobile", "getNotification", nil, &r.Options, &resp)
 	return
 }
 
+// GetNotificationWith is GetNotification, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber_Mobile) GetNotificationWith(options sl.Options) (resp datatypes.Notification, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNotification()
+}
+
 // no documentation yet
 func (r Notification_User_Subscriber_Mobile) GetObject() (resp datatypes.Notification_User_Subscriber_Mobile, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber_Mobile", "getObject", nil, &r.Options, &resp)
@@ -785,24 +1167,56 @@ func (r Notification_User_Subscriber_Mobile) GetPreferences() (resp []datatypes.
 	return
 }
 
+// GetPreferencesWith is GetPreferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber_Mobile) GetPreferencesWith(options sl.Options) (resp []datatypes.Notification_User_Subscriber_Preference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPreferences()
+}
+
 // Retrieve Preference details such as description, minimum and maximum limits, default value and unit of measure.
 func (r Notification_User_Subscriber_Mobile) GetPreferencesDetails() (resp []datatypes.Notification_Preference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber_Mobile", "getPreferencesDetails", nil, &r.Options, &resp)
 	return
 }
 
+// GetPreferencesDetailsWith is GetPreferencesDetails, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber_Mobile) GetPreferencesDetailsWith(options sl.Options) (resp []datatypes.Notification_Preference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPreferencesDetails()
+}
+
 // Retrieve The subscriber id to resource id mapping.
 func (r Notification_User_Subscriber_Mobile) GetResourceRecord() (resp datatypes.Notification_User_Subscriber_Resource, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber_Mobile", "getResourceRecord", nil, &r.Options, &resp)
 	return
 }
 
+// GetResourceRecordWith is GetResourceRecord, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber_Mobile) GetResourceRecordWith(options sl.Options) (resp datatypes.Notification_User_Subscriber_Resource, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResourceRecord()
+}
+
 // Retrieve User record for the subscription.
 func (r Notification_User_Subscriber_Mobile) GetUserRecord() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber_Mobile", "getUserRecord", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserRecordWith is GetUserRecord, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber_Mobile) GetUserRecordWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUserRecord()
+}
+
 // no documentation yet
 func (r Notification_User_Subscriber_Mobile) SetSnoozeTimer(start *int, end *int) (resp bool, err error) {
 	params := []interface{}{
@@ -831,6 +1245,11 @@ func (r Notification_User_Subscriber_Preference) Id(id int) Notification_User_Su
 	return r
 }
 
+func (r Notification_User_Subscriber_Preference) InitParameter(name string, value interface{}) Notification_User_Subscriber_Preference {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Notification_User_Subscriber_Preference) Mask(mask string) Notification_User_Subscriber_Preference {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -850,11 +1269,26 @@ func (r Notification_User_Subscriber_Preference) Limit(limit int) Notification_U
 	return r
 }
 
+func (r Notification_User_Subscriber_Preference) Unlimited() Notification_User_Subscriber_Preference {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Notification_User_Subscriber_Preference) Offset(offset int) Notification_User_Subscriber_Preference {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Notification_User_Subscriber_Preference) Timeout(timeout time.Duration) Notification_User_Subscriber_Preference {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Notification_User_Subscriber_Preference) WithContext(ctx context.Context) Notification_User_Subscriber_Preference {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Use the method to create a new notification preference for a subscriber
 func (r Notification_User_Subscriber_Preference) CreateObject(templateObject *datatypes.Notification_User_Subscriber_Preference) (resp bool, err error) {
 	params := []interface{}{
@@ -879,12 +1313,28 @@ func (r Notification_User_Subscriber_Preference) GetDefaultPreference() (resp da
 	return
 }
 
+// GetDefaultPreferenceWith is GetDefaultPreference, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber_Preference) GetDefaultPreferenceWith(options sl.Options) (resp datatypes.Notification_Preference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDefaultPreference()
+}
+
 // Retrieve Details of the subscriber tied to the preference.
 func (r Notification_User_Subscriber_Preference) GetNotificationUserSubscriber() (resp datatypes.Notification_User_Subscriber, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber_Preference", "getNotificationUserSubscriber", nil, &r.Options, &resp)
 	return
 }
 
+// GetNotificationUserSubscriberWith is GetNotificationUserSubscriber, with the mask, filter and result limit of options applied to
+// this call only
+func (r Notification_User_Subscriber_Preference) GetNotificationUserSubscriberWith(options sl.Options) (resp datatypes.Notification_User_Subscriber, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNotificationUserSubscriber()
+}
+
 // no documentation yet
 func (r Notification_User_Subscriber_Preference) GetObject() (resp datatypes.Notification_User_Subscriber_Preference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Notification_User_Subscriber_Preference", "getObject", nil, &r.Options, &resp)
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Product_Item_Category) Id(id int) Product_Item_Category {
 	return r
 }
 
+func (r Product_Item_Category) InitParameter(name string, value interface{}) Product_Item_Category {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Product_Item_Category) Mask(mask string) Product_Item_Category {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Product_Item_Category) Limit(limit int) Product_Item_Category {
 	return r
 }
 
+func (r Product_Item_Category) Unlimited() Product_Item_Category {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Product_Item_Category) Offset(offset int) Product_Item_Category {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Product_Item_Category) Timeout(timeout time.Duration) Product_Item_Category {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Product_Item_Category) WithContext(ctx context.Context) Product_Item_Category {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Returns a list of of active Items in the "Additional Services" package with their active prices for a given product item category and sorts them by price.
 func (r Product_Item_Category) GetAdditionalProductsForCategory() (resp []datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Category", "getAdditionalProductsForCategory", nil, &r.Options, &resp)
@@ -87,6 +109,14 @@ func (r Product_Item_Category) GetBillingItems() (resp []datatypes.Billing_Item,
 	return
 }
 
+// GetBillingItemsWith is GetBillingItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Category) GetBillingItemsWith(options sl.Options) (resp []datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItems()
+}
+
 // This method returns a collection of computing categories. These categories are also top level items in a service offering.
 func (r Product_Item_Category) GetComputingCategories(resetCache *bool) (resp []datatypes.Product_Item_Category, err error) {
 	params := []interface{}{
@@ -117,12 +147,28 @@ func (r Product_Item_Category) GetGroup() (resp datatypes.Product_Item_Category_
 	return
 }
 
+// GetGroupWith is GetGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Category) GetGroupWith(options sl.Options) (resp datatypes.Product_Item_Category_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGroup()
+}
+
 // Retrieve A collection of service offering category groups. Each group contains a collection of items associated with this category.
 func (r Product_Item_Category) GetGroups() (resp []datatypes.Product_Package_Item_Category_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Category", "getGroups", nil, &r.Options, &resp)
 	return
 }
 
+// GetGroupsWith is GetGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Category) GetGroupsWith(options sl.Options) (resp []datatypes.Product_Package_Item_Category_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGroups()
+}
+
 // Each product item price must be tied to a category for it to be sold. These categories describe how a particular product item is sold. For example, the 250GB hard drive can be sold as disk0, disk1, ... disk11. There are different prices for this product item depending on which category it is. This keeps down the number of products in total.
 func (r Product_Item_Category) GetObject() (resp datatypes.Product_Item_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Category", "getObject", nil, &r.Options, &resp)
@@ -144,30 +190,70 @@ func (r Product_Item_Category) GetOrderOptions() (resp []datatypes.Product_Item_
 	return
 }
 
+// GetOrderOptionsWith is GetOrderOptions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Category) GetOrderOptionsWith(options sl.Options) (resp []datatypes.Product_Item_Category_Order_Option_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderOptions()
+}
+
 // Retrieve A list of configuration available in this category.'
 func (r Product_Item_Category) GetPackageConfigurations() (resp []datatypes.Product_Package_Order_Configuration, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Category", "getPackageConfigurations", nil, &r.Options, &resp)
 	return
 }
 
+// GetPackageConfigurationsWith is GetPackageConfigurations, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Category) GetPackageConfigurationsWith(options sl.Options) (resp []datatypes.Product_Package_Order_Configuration, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPackageConfigurations()
+}
+
 // Retrieve A list of preset configurations this category is used in.'
 func (r Product_Item_Category) GetPresetConfigurations() (resp []datatypes.Product_Package_Preset_Configuration, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Category", "getPresetConfigurations", nil, &r.Options, &resp)
 	return
 }
 
+// GetPresetConfigurationsWith is GetPresetConfigurations, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Category) GetPresetConfigurationsWith(options sl.Options) (resp []datatypes.Product_Package_Preset_Configuration, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPresetConfigurations()
+}
+
 // Retrieve The question references that are associated with an item category.
 func (r Product_Item_Category) GetQuestionReferences() (resp []datatypes.Product_Item_Category_Question_Xref, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Category", "getQuestionReferences", nil, &r.Options, &resp)
 	return
 }
 
+// GetQuestionReferencesWith is GetQuestionReferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Category) GetQuestionReferencesWith(options sl.Options) (resp []datatypes.Product_Item_Category_Question_Xref, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetQuestionReferences()
+}
+
 // Retrieve The questions that are associated with an item category.
 func (r Product_Item_Category) GetQuestions() (resp []datatypes.Product_Item_Category_Question, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Category", "getQuestions", nil, &r.Options, &resp)
 	return
 }
 
+// GetQuestionsWith is GetQuestions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Category) GetQuestionsWith(options sl.Options) (resp []datatypes.Product_Item_Category_Question, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetQuestions()
+}
+
 // no documentation yet
 func (r Product_Item_Category) GetSoftwareCategories() (resp []datatypes.Product_Item_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Category", "getSoftwareCategories", nil, &r.Options, &resp)
@@ -217,6 +303,11 @@ func (r Product_Item_Category_Group) Id(id int) Product_Item_Category_Group {
 	return r
 }
 
+func (r Product_Item_Category_Group) InitParameter(name string, value interface{}) Product_Item_Category_Group {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Product_Item_Category_Group) Mask(mask string) Product_Item_Category_Group {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -236,11 +327,26 @@ func (r Product_Item_Category_Group) Limit(limit int) Product_Item_Category_Grou
 	return r
 }
 
+func (r Product_Item_Category_Group) Unlimited() Product_Item_Category_Group {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Product_Item_Category_Group) Offset(offset int) Product_Item_Category_Group {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Product_Item_Category_Group) Timeout(timeout time.Duration) Product_Item_Category_Group {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Product_Item_Category_Group) WithContext(ctx context.Context) Product_Item_Category_Group {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Each product item category must be tied to a category group. These category groups describe how a particular product item category is categorized. For example, the disk0, disk1, ... disk11 can be categorized as Server and Attached Services. There are different groups for each of this product item category depending on the function of the item product in the subject category.
 func (r Product_Item_Category_Group) GetObject() (resp datatypes.Product_Item_Category_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Category_Group", "getObject", nil, &r.Options, &resp)
@@ -263,6 +369,11 @@ func (r Product_Item_Policy_Assignment) Id(id int) Product_Item_Policy_Assignmen
 	return r
 }
 
+func (r Product_Item_Policy_Assignment) InitParameter(name string, value interface{}) Product_Item_Policy_Assignment {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Product_Item_Policy_Assignment) Mask(mask string) Product_Item_Policy_Assignment {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -282,11 +393,26 @@ func (r Product_Item_Policy_Assignment) Limit(limit int) Product_Item_Policy_Ass
 	return r
 }
 
+func (r Product_Item_Policy_Assignment) Unlimited() Product_Item_Policy_Assignment {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Product_Item_Policy_Assignment) Offset(offset int) Product_Item_Policy_Assignment {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Product_Item_Policy_Assignment) Timeout(timeout time.Duration) Product_Item_Policy_Assignment {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Product_Item_Policy_Assignment) WithContext(ctx context.Context) Product_Item_Policy_Assignment {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Register the acceptance of the associated policy to product assignment, and link the created record to a Ticket.
 func (r Product_Item_Policy_Assignment) AcceptFromTicket(ticketId *int) (resp bool, err error) {
 	params := []interface{}{
@@ -314,12 +440,28 @@ func (r Product_Item_Policy_Assignment) GetPolicyName() (resp string, err error)
 	return
 }
 
+// GetPolicyNameWith is GetPolicyName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Policy_Assignment) GetPolicyNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPolicyName()
+}
+
 // Retrieve The [[SoftLayer_Product_Item]] for this policy assignment.
 func (r Product_Item_Policy_Assignment) GetProduct() (resp datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Policy_Assignment", "getProduct", nil, &r.Options, &resp)
 	return
 }
 
+// GetProductWith is GetProduct, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Policy_Assignment) GetProductWith(options sl.Options) (resp datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProduct()
+}
+
 // The SoftLayer_Product_Item_Price data type contains general information relating to a single SoftLayer product item price. You can find out what packages each price is in as well as which category under which this price is sold. All prices are returned in floating point values measured in US Dollars ($USD).
 type Product_Item_Price struct {
 	Session *session.Session
@@ -336,6 +478,11 @@ func (r Product_Item_Price) Id(id int) Product_Item_Price {
 	return r
 }
 
+func (r Product_Item_Price) InitParameter(name string, value interface{}) Product_Item_Price {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Product_Item_Price) Mask(mask string) Product_Item_Price {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -355,17 +502,40 @@ func (r Product_Item_Price) Limit(limit int) Product_Item_Price {
 	return r
 }
 
+func (r Product_Item_Price) Unlimited() Product_Item_Price {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Product_Item_Price) Offset(offset int) Product_Item_Price {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Product_Item_Price) Timeout(timeout time.Duration) Product_Item_Price {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Product_Item_Price) WithContext(ctx context.Context) Product_Item_Price {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The account that the item price is restricted to.
 func (r Product_Item_Price) GetAccountRestrictions() (resp []datatypes.Product_Item_Price_Account_Restriction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getAccountRestrictions", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountRestrictionsWith is GetAccountRestrictions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetAccountRestrictionsWith(options sl.Options) (resp []datatypes.Product_Item_Price_Account_Restriction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountRestrictions()
+}
+
 // Retrieve
 func (r Product_Item_Price) GetAttributes() (resp []datatypes.Product_Item_Price_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getAttributes", nil, &r.Options, &resp)
@@ -378,54 +548,126 @@ func (r Product_Item_Price) GetBigDataOsJournalDiskFlag() (resp bool, err error)
 	return
 }
 
+// GetBigDataOsJournalDiskFlagWith is GetBigDataOsJournalDiskFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetBigDataOsJournalDiskFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBigDataOsJournalDiskFlag()
+}
+
 // Retrieve cross reference for bundles
 func (r Product_Item_Price) GetBundleReferences() (resp []datatypes.Product_Item_Bundles, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getBundleReferences", nil, &r.Options, &resp)
 	return
 }
 
+// GetBundleReferencesWith is GetBundleReferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetBundleReferencesWith(options sl.Options) (resp []datatypes.Product_Item_Bundles, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBundleReferences()
+}
+
 // Retrieve The maximum capacity value for which this price is suitable.
 func (r Product_Item_Price) GetCapacityRestrictionMaximum() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getCapacityRestrictionMaximum", nil, &r.Options, &resp)
 	return
 }
 
+// GetCapacityRestrictionMaximumWith is GetCapacityRestrictionMaximum, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetCapacityRestrictionMaximumWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCapacityRestrictionMaximum()
+}
+
 // Retrieve The minimum capacity value for which this price is suitable.
 func (r Product_Item_Price) GetCapacityRestrictionMinimum() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getCapacityRestrictionMinimum", nil, &r.Options, &resp)
 	return
 }
 
+// GetCapacityRestrictionMinimumWith is GetCapacityRestrictionMinimum, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetCapacityRestrictionMinimumWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCapacityRestrictionMinimum()
+}
+
 // Retrieve The type of capacity restriction by which this price must abide.
 func (r Product_Item_Price) GetCapacityRestrictionType() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getCapacityRestrictionType", nil, &r.Options, &resp)
 	return
 }
 
+// GetCapacityRestrictionTypeWith is GetCapacityRestrictionType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetCapacityRestrictionTypeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCapacityRestrictionType()
+}
+
 // Retrieve All categories which this item is a member.
 func (r Product_Item_Price) GetCategories() (resp []datatypes.Product_Item_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getCategories", nil, &r.Options, &resp)
 	return
 }
 
+// GetCategoriesWith is GetCategories, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetCategoriesWith(options sl.Options) (resp []datatypes.Product_Item_Category, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCategories()
+}
+
 // Retrieve Signifies pricing that is only available on a dedicated host virtual server order.
 func (r Product_Item_Price) GetDedicatedHostInstanceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getDedicatedHostInstanceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetDedicatedHostInstanceFlagWith is GetDedicatedHostInstanceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetDedicatedHostInstanceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDedicatedHostInstanceFlag()
+}
+
 // Retrieve Whether this price defines a software license for its product item.
 func (r Product_Item_Price) GetDefinedSoftwareLicenseFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getDefinedSoftwareLicenseFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetDefinedSoftwareLicenseFlagWith is GetDefinedSoftwareLicenseFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetDefinedSoftwareLicenseFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDefinedSoftwareLicenseFlag()
+}
+
 // Retrieve The product item a price is tied to.
 func (r Product_Item_Price) GetItem() (resp datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetItemWith is GetItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetItemWith(options sl.Options) (resp datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItem()
+}
+
 // no documentation yet
 func (r Product_Item_Price) GetObject() (resp datatypes.Product_Item_Price, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getObject", nil, &r.Options, &resp)
@@ -444,42 +686,98 @@ func (r Product_Item_Price) GetPackageReferences() (resp []datatypes.Product_Pac
 	return
 }
 
+// GetPackageReferencesWith is GetPackageReferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetPackageReferencesWith(options sl.Options) (resp []datatypes.Product_Package_Item_Prices, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPackageReferences()
+}
+
 // Retrieve A price's packages under which this item is sold.
 func (r Product_Item_Price) GetPackages() (resp []datatypes.Product_Package, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getPackages", nil, &r.Options, &resp)
 	return
 }
 
+// GetPackagesWith is GetPackages, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetPackagesWith(options sl.Options) (resp []datatypes.Product_Package, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPackages()
+}
+
 // Retrieve A list of preset configurations this price is used in.'
 func (r Product_Item_Price) GetPresetConfigurations() (resp []datatypes.Product_Package_Preset_Configuration, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getPresetConfigurations", nil, &r.Options, &resp)
 	return
 }
 
+// GetPresetConfigurationsWith is GetPresetConfigurations, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetPresetConfigurationsWith(options sl.Options) (resp []datatypes.Product_Package_Preset_Configuration, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPresetConfigurations()
+}
+
 // Retrieve The type keyname of this price which can be STANDARD or TIERED.
 func (r Product_Item_Price) GetPriceType() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getPriceType", nil, &r.Options, &resp)
 	return
 }
 
+// GetPriceTypeWith is GetPriceType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetPriceTypeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPriceType()
+}
+
 // Retrieve The pricing location group that this price is applicable for. Prices that have a pricing location group will only be available for ordering with the locations specified on the location group.
 func (r Product_Item_Price) GetPricingLocationGroup() (resp datatypes.Location_Group_Pricing, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getPricingLocationGroup", nil, &r.Options, &resp)
 	return
 }
 
+// GetPricingLocationGroupWith is GetPricingLocationGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetPricingLocationGroupWith(options sl.Options) (resp datatypes.Location_Group_Pricing, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPricingLocationGroup()
+}
+
 // Retrieve The number of server cores required to order this item. This is deprecated. Use [[SoftLayer_Product_Item_Price/getCapacityRestrictionMinimum|getCapacityRestrictionMinimum]] and [[SoftLayer_Product_Item_Price/getCapacityRestrictionMaximum|getCapacityRestrictionMaximum]]
 func (r Product_Item_Price) GetRequiredCoreCount() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getRequiredCoreCount", nil, &r.Options, &resp)
 	return
 }
 
+// GetRequiredCoreCountWith is GetRequiredCoreCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetRequiredCoreCountWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRequiredCoreCount()
+}
+
 // Retrieve Signifies pricing that is only available on a reserved capacity virtual server order.
 func (r Product_Item_Price) GetReservedCapacityInstanceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price", "getReservedCapacityInstanceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetReservedCapacityInstanceFlagWith is GetReservedCapacityInstanceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Item_Price) GetReservedCapacityInstanceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReservedCapacityInstanceFlag()
+}
+
 // Returns a collection of rate-based [[SoftLayer_Product_Item_Price]] objects associated with the [[SoftLayer_Product_Item]] objects and the [[SoftLayer_Location]] specified. The location is required to get the appropriate rate-based prices because the usage rates may vary from datacenter to datacenter.
 func (r Product_Item_Price) GetUsageRatePrices(location *datatypes.Location, items []datatypes.Product_Item) (resp []datatypes.Product_Item_Price, err error) {
 	params := []interface{}{
@@ -506,6 +804,11 @@ func (r Product_Item_Price_Premium) Id(id int) Product_Item_Price_Premium {
 	return r
 }
 
+func (r Product_Item_Price_Premium) InitParameter(name string, value interface{}) Product_Item_Price_Premium {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Product_Item_Price_Premium) Mask(mask string) Product_Item_Price_Premium {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -525,11 +828,26 @@ func (r Product_Item_Price_Premium) Limit(limit int) Product_Item_Price_Premium
 	return r
 }
 
+func (r Product_Item_Price_Premium) Unlimited() Product_Item_Price_Premium {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Product_Item_Price_Premium) Offset(offset int) Product_Item_Price_Premium {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Product_Item_Price_Premium) Timeout(timeout time.Duration) Product_Item_Price_Premium {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Product_Item_Price_Premium) WithContext(ctx context.Context) Product_Item_Price_Premium {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Product_Item_Price_Premium) GetItemPrice() (resp datatypes.Product_Item_Price, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Item_Price_Premium", "getItemPrice", nil, &r.Options, &resp)
@@ -570,6 +888,11 @@ func (r Product_Order) Id(id int) Product_Order {
 	return r
 }
 
+func (r Product_Order) InitParameter(name string, value interface{}) Product_Order {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Product_Order) Mask(mask string) Product_Order {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -589,11 +912,26 @@ func (r Product_Order) Limit(limit int) Product_Order {
 	return r
 }
 
+func (r Product_Order) Unlimited() Product_Order {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Product_Order) Offset(offset int) Product_Order {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Product_Order) Timeout(timeout time.Duration) Product_Order {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Product_Order) WithContext(ctx context.Context) Product_Order {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Product_Order) CheckItemAvailability(itemPrices []datatypes.Product_Item_Price, accountId *int, availabilityTypeKeyNames []string) (resp bool, err error) {
 	params := []interface{}{
@@ -1178,6 +1516,11 @@ func (r Product_Package) Id(id int) Product_Package {
 	return r
 }
 
+func (r Product_Package) InitParameter(name string, value interface{}) Product_Package {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Product_Package) Mask(mask string) Product_Package {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1197,29 +1540,68 @@ func (r Product_Package) Limit(limit int) Product_Package {
 	return r
 }
 
+func (r Product_Package) Unlimited() Product_Package {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Product_Package) Offset(offset int) Product_Package {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Product_Package) Timeout(timeout time.Duration) Product_Package {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Product_Package) WithContext(ctx context.Context) Product_Package {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The preset configurations available only for the authenticated account and this package.
 func (r Product_Package) GetAccountRestrictedActivePresets() (resp []datatypes.Product_Package_Preset, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getAccountRestrictedActivePresets", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountRestrictedActivePresetsWith is GetAccountRestrictedActivePresets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetAccountRestrictedActivePresetsWith(options sl.Options) (resp []datatypes.Product_Package_Preset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountRestrictedActivePresets()
+}
+
 // Retrieve The results from this call are similar to [[SoftLayer_Product_Package/getCategories|getCategories]], but these ONLY include account-restricted prices. Not all accounts have restricted pricing.
 func (r Product_Package) GetAccountRestrictedCategories() (resp []datatypes.Product_Item_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getAccountRestrictedCategories", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountRestrictedCategoriesWith is GetAccountRestrictedCategories, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetAccountRestrictedCategoriesWith(options sl.Options) (resp []datatypes.Product_Item_Category, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountRestrictedCategories()
+}
+
 // Retrieve The flag to indicate if there are any restricted prices in a package for the currently-active account.
 func (r Product_Package) GetAccountRestrictedPricesFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getAccountRestrictedPricesFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountRestrictedPricesFlagWith is GetAccountRestrictedPricesFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetAccountRestrictedPricesFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountRestrictedPricesFlag()
+}
+
 // Return a list of Items in the package with their active prices.
 func (r Product_Package) GetActiveItems() (resp []datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getActiveItems", nil, &r.Options, &resp)
@@ -1243,6 +1625,14 @@ func (r Product_Package) GetActivePresets() (resp []datatypes.Product_Package_Pr
 	return
 }
 
+// GetActivePresetsWith is GetActivePresets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetActivePresetsWith(options sl.Options) (resp []datatypes.Product_Package_Preset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActivePresets()
+}
+
 // This method pulls all the active private hosted cloud packages. This will give you a basic description of the packages that are currently active and from which you can order private hosted cloud configurations.
 func (r Product_Package) GetActivePrivateHostedCloudPackages() (resp []datatypes.Product_Package, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getActivePrivateHostedCloudPackages", nil, &r.Options, &resp)
@@ -1255,24 +1645,56 @@ func (r Product_Package) GetActiveRamItems() (resp []datatypes.Product_Item, err
 	return
 }
 
+// GetActiveRamItemsWith is GetActiveRamItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetActiveRamItemsWith(options sl.Options) (resp []datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveRamItems()
+}
+
 // Retrieve A collection of valid server items available for purchase in this package.
 func (r Product_Package) GetActiveServerItems() (resp []datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getActiveServerItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveServerItemsWith is GetActiveServerItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetActiveServerItemsWith(options sl.Options) (resp []datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveServerItems()
+}
+
 // Retrieve A collection of valid software items available for purchase in this package.
 func (r Product_Package) GetActiveSoftwareItems() (resp []datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getActiveSoftwareItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveSoftwareItemsWith is GetActiveSoftwareItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetActiveSoftwareItemsWith(options sl.Options) (resp []datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveSoftwareItems()
+}
+
 // Retrieve A collection of [[SoftLayer_Product_Item_Price]] objects for pay-as-you-go usage.
 func (r Product_Package) GetActiveUsagePrices() (resp []datatypes.Product_Item_Price, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getActiveUsagePrices", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveUsagePricesWith is GetActiveUsagePrices, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetActiveUsagePricesWith(options sl.Options) (resp []datatypes.Product_Item_Price, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveUsagePrices()
+}
+
 // This method returns a collection of active usage rate [[SoftLayer_Product_Item_Price]] objects for the current package and specified datacenter. Optionally you can retrieve the active usage rate prices for a particular [[SoftLayer_Product_Item_Category]] by specifying a category code as the first parameter. This information is useful so that you can see "pay as you go" rates (if any) for the current package, location and optionally category.
 func (r Product_Package) GetActiveUsageRatePrices(locationId *int, categoryCode *string) (resp []datatypes.Product_Item_Price, err error) {
 	params := []interface{}{
@@ -1289,6 +1711,14 @@ func (r Product_Package) GetAdditionalServiceFlag() (resp bool, err error) {
 	return
 }
 
+// GetAdditionalServiceFlagWith is GetAdditionalServiceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetAdditionalServiceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAdditionalServiceFlag()
+}
+
 // This method pulls all the active packages. This will give you a basic description of the packages that are currently active
 func (r Product_Package) GetAllObjects() (resp []datatypes.Product_Package, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getAllObjects", nil, &r.Options, &resp)
@@ -1307,6 +1737,14 @@ func (r Product_Package) GetAvailableLocations() (resp []datatypes.Product_Packa
 	return
 }
 
+// GetAvailableLocationsWith is GetAvailableLocations, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetAvailableLocationsWith(options sl.Options) (resp []datatypes.Product_Package_Locations, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAvailableLocations()
+}
+
 // no documentation yet
 func (r Product_Package) GetAvailablePackagesForImageTemplate(imageTemplate *datatypes.Virtual_Guest_Block_Device_Template_Group) (resp []datatypes.Product_Package, err error) {
 	params := []interface{}{
@@ -1322,12 +1760,28 @@ func (r Product_Package) GetAvailableStorageUnits() (resp uint, err error) {
 	return
 }
 
+// GetAvailableStorageUnitsWith is GetAvailableStorageUnits, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetAvailableStorageUnitsWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAvailableStorageUnits()
+}
+
 // Retrieve This is a collection of categories ([[SoftLayer_Product_Item_Category]]) associated with a package which can be used for ordering. These categories have several objects prepopulated which are useful when determining the available products for purchase. The categories contain groups ([[SoftLayer_Product_Package_Item_Category_Group]]) that organize the products and prices by similar features. For example, operating systems will be grouped by their manufacturer and virtual server disks will be grouped by their disk type (SAN vs. local). Each group will contain prices ([[SoftLayer_Product_Item_Price]]) which you can use determine the cost of each product. Each price has a product ([[SoftLayer_Product_Item]]) which provides the name and other useful information about the server, service or software you may purchase.
 func (r Product_Package) GetCategories() (resp []datatypes.Product_Item_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getCategories", nil, &r.Options, &resp)
 	return
 }
 
+// GetCategoriesWith is GetCategories, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetCategoriesWith(options sl.Options) (resp []datatypes.Product_Item_Category, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCategories()
+}
+
 // no documentation yet
 func (r Product_Package) GetCdnItems() (resp []datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getCdnItems", nil, &r.Options, &resp)
@@ -1349,72 +1803,168 @@ func (r Product_Package) GetConfiguration() (resp []datatypes.Product_Package_Or
 	return
 }
 
+// GetConfigurationWith is GetConfiguration, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetConfigurationWith(options sl.Options) (resp []datatypes.Product_Package_Order_Configuration, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetConfiguration()
+}
+
 // Retrieve A collection of valid RAM items available for purchase in this package.
 func (r Product_Package) GetDefaultRamItems() (resp []datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getDefaultRamItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetDefaultRamItemsWith is GetDefaultRamItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetDefaultRamItemsWith(options sl.Options) (resp []datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDefaultRamItems()
+}
+
 // Retrieve The node type for a package in a solution deployment.
 func (r Product_Package) GetDeploymentNodeType() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getDeploymentNodeType", nil, &r.Options, &resp)
 	return
 }
 
+// GetDeploymentNodeTypeWith is GetDeploymentNodeType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetDeploymentNodeTypeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDeploymentNodeType()
+}
+
 // Retrieve The packages that are allowed in a multi-server solution. (Deprecated)
 func (r Product_Package) GetDeploymentPackages() (resp []datatypes.Product_Package, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getDeploymentPackages", nil, &r.Options, &resp)
 	return
 }
 
+// GetDeploymentPackagesWith is GetDeploymentPackages, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetDeploymentPackagesWith(options sl.Options) (resp []datatypes.Product_Package, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDeploymentPackages()
+}
+
 // Retrieve The solution deployment type.
 func (r Product_Package) GetDeploymentType() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getDeploymentType", nil, &r.Options, &resp)
 	return
 }
 
+// GetDeploymentTypeWith is GetDeploymentType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetDeploymentTypeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDeploymentType()
+}
+
 // Retrieve The package that represents a multi-server solution. (Deprecated)
 func (r Product_Package) GetDeployments() (resp []datatypes.Product_Package, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getDeployments", nil, &r.Options, &resp)
 	return
 }
 
+// GetDeploymentsWith is GetDeployments, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetDeploymentsWith(options sl.Options) (resp []datatypes.Product_Package, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDeployments()
+}
+
 // Retrieve This flag indicates the package does not allow custom disk partitions.
 func (r Product_Package) GetDisallowCustomDiskPartitions() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getDisallowCustomDiskPartitions", nil, &r.Options, &resp)
 	return
 }
 
+// GetDisallowCustomDiskPartitionsWith is GetDisallowCustomDiskPartitions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetDisallowCustomDiskPartitionsWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDisallowCustomDiskPartitions()
+}
+
 // Retrieve The Softlayer order step is optionally step-based. This returns the first SoftLayer_Product_Package_Order_Step in the step-based order process.
 func (r Product_Package) GetFirstOrderStep() (resp datatypes.Product_Package_Order_Step, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getFirstOrderStep", nil, &r.Options, &resp)
 	return
 }
 
+// GetFirstOrderStepWith is GetFirstOrderStep, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetFirstOrderStepWith(options sl.Options) (resp datatypes.Product_Package_Order_Step, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirstOrderStep()
+}
+
 // Retrieve Whether the package is a specialized network gateway appliance package.
 func (r Product_Package) GetGatewayApplianceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getGatewayApplianceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetGatewayApplianceFlagWith is GetGatewayApplianceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetGatewayApplianceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGatewayApplianceFlag()
+}
+
 // Retrieve This flag indicates that the package supports GPUs.
 func (r Product_Package) GetGpuFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getGpuFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetGpuFlagWith is GetGpuFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetGpuFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGpuFlag()
+}
+
 // Retrieve Determines whether the package contains prices that can be ordered hourly.
 func (r Product_Package) GetHourlyBillingAvailableFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getHourlyBillingAvailableFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHourlyBillingAvailableFlagWith is GetHourlyBillingAvailableFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetHourlyBillingAvailableFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyBillingAvailableFlag()
+}
+
 // Retrieve Packages with this flag do not allow monthly orders.
 func (r Product_Package) GetHourlyOnlyOrders() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getHourlyOnlyOrders", nil, &r.Options, &resp)
 	return
 }
 
+// GetHourlyOnlyOrdersWith is GetHourlyOnlyOrders, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetHourlyOnlyOrdersWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyOnlyOrders()
+}
+
 // Returns a collection of SoftLayer_Product_Item_Attribute_Type objects.  These item attribute types specifically deal with when an item, SoftLayer_Product_Item, from the product catalog may no longer be available.  The keynames for these attribute types start with 'UNAVAILABLE_AFTER_DATE_*', where the '*' may represent any string.  For example, 'UNAVAILABLE_AFTER_DATE_NEW_ORDERS', signifies that the item is not available for new orders.  There is a catch all attribute type, 'UNAVAILABLE_AFTER_DATE_ALL'.  If an item has one of these availability attributes set, the value should be a valid date in MM/DD/YYYY, indicating the date after which the item will no longer be available.
 func (r Product_Package) GetItemAvailabilityTypes() (resp []datatypes.Product_Item_Attribute_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getItemAvailabilityTypes", nil, &r.Options, &resp)
@@ -1427,24 +1977,56 @@ func (r Product_Package) GetItemConflicts() (resp []datatypes.Product_Item_Resou
 	return
 }
 
+// GetItemConflictsWith is GetItemConflicts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetItemConflictsWith(options sl.Options) (resp []datatypes.Product_Item_Resource_Conflict, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItemConflicts()
+}
+
 // Retrieve The item-location conflicts associated with a package.
 func (r Product_Package) GetItemLocationConflicts() (resp []datatypes.Product_Item_Resource_Conflict, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getItemLocationConflicts", nil, &r.Options, &resp)
 	return
 }
 
+// GetItemLocationConflictsWith is GetItemLocationConflicts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetItemLocationConflictsWith(options sl.Options) (resp []datatypes.Product_Item_Resource_Conflict, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItemLocationConflicts()
+}
+
 // Retrieve cross reference for item prices
 func (r Product_Package) GetItemPriceReferences() (resp []datatypes.Product_Package_Item_Prices, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getItemPriceReferences", nil, &r.Options, &resp)
 	return
 }
 
+// GetItemPriceReferencesWith is GetItemPriceReferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetItemPriceReferencesWith(options sl.Options) (resp []datatypes.Product_Package_Item_Prices, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItemPriceReferences()
+}
+
 // Retrieve A collection of SoftLayer_Product_Item_Prices that are valid for this package.
 func (r Product_Package) GetItemPrices() (resp []datatypes.Product_Item_Price, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getItemPrices", nil, &r.Options, &resp)
 	return
 }
 
+// GetItemPricesWith is GetItemPrices, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetItemPricesWith(options sl.Options) (resp []datatypes.Product_Item_Price, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItemPrices()
+}
+
 // Return a collection of SoftLayer_Item_Price objects from a collection of SoftLayer_Software_Description
 func (r Product_Package) GetItemPricesFromSoftwareDescriptions(softwareDescriptions []datatypes.Software_Description, includeTranslationsFlag *bool, returnAllPricesFlag *bool) (resp []datatypes.Product_Item_Price, err error) {
 	params := []interface{}{
@@ -1462,6 +2044,14 @@ func (r Product_Package) GetItems() (resp []datatypes.Product_Item, err error) {
 	return
 }
 
+// GetItemsWith is GetItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetItemsWith(options sl.Options) (resp []datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItems()
+}
+
 // Return a collection of [[SoftLayer_Product_Item]] objects from a [[SoftLayer_Virtual_Guest_Block_Device_Template_Group]] object
 func (r Product_Package) GetItemsFromImageTemplate(imageTemplate *datatypes.Virtual_Guest_Block_Device_Template_Group) (resp []datatypes.Product_Item, err error) {
 	params := []interface{}{
@@ -1477,18 +2067,42 @@ func (r Product_Package) GetLocations() (resp []datatypes.Location, err error) {
 	return
 }
 
+// GetLocationsWith is GetLocations, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetLocationsWith(options sl.Options) (resp []datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocations()
+}
+
 // Retrieve The lowest server [[SoftLayer_Product_Item_Price]] related to this package.
 func (r Product_Package) GetLowestServerPrice() (resp datatypes.Product_Item_Price, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getLowestServerPrice", nil, &r.Options, &resp)
 	return
 }
 
+// GetLowestServerPriceWith is GetLowestServerPrice, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetLowestServerPriceWith(options sl.Options) (resp datatypes.Product_Item_Price, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLowestServerPrice()
+}
+
 // Retrieve The maximum available network speed associated with the package.
 func (r Product_Package) GetMaximumPortSpeed() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getMaximumPortSpeed", nil, &r.Options, &resp)
 	return
 }
 
+// GetMaximumPortSpeedWith is GetMaximumPortSpeed, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetMaximumPortSpeedWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMaximumPortSpeed()
+}
+
 // no documentation yet
 func (r Product_Package) GetMessageQueueItems() (resp []datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getMessageQueueItems", nil, &r.Options, &resp)
@@ -1501,18 +2115,42 @@ func (r Product_Package) GetMinimumPortSpeed() (resp uint, err error) {
 	return
 }
 
+// GetMinimumPortSpeedWith is GetMinimumPortSpeed, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetMinimumPortSpeedWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMinimumPortSpeed()
+}
+
 // Retrieve This flag indicates that this is a MongoDB engineered package. (Deprecated)
 func (r Product_Package) GetMongoDbEngineeredFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getMongoDbEngineeredFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetMongoDbEngineeredFlagWith is GetMongoDbEngineeredFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetMongoDbEngineeredFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMongoDbEngineeredFlag()
+}
+
 // Retrieve Whether the package is not in compliance with EU support.
 func (r Product_Package) GetNonEuCompliantFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getNonEuCompliantFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetNonEuCompliantFlagWith is GetNonEuCompliantFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetNonEuCompliantFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNonEuCompliantFlag()
+}
+
 // no documentation yet
 func (r Product_Package) GetObject() (resp datatypes.Product_Package, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getObject", nil, &r.Options, &resp)
@@ -1537,78 +2175,182 @@ func (r Product_Package) GetOrderPremiums() (resp []datatypes.Product_Item_Price
 	return
 }
 
+// GetOrderPremiumsWith is GetOrderPremiums, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetOrderPremiumsWith(options sl.Options) (resp []datatypes.Product_Item_Price_Premium, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderPremiums()
+}
+
 // Retrieve This flag indicates if the package may be available in PoP locations in addition to Datacenters.
 func (r Product_Package) GetPopLocationAvailabilityFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getPopLocationAvailabilityFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetPopLocationAvailabilityFlagWith is GetPopLocationAvailabilityFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetPopLocationAvailabilityFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPopLocationAvailabilityFlag()
+}
+
 // Retrieve This flag indicates the package is pre-configured. (Deprecated)
 func (r Product_Package) GetPreconfiguredFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getPreconfiguredFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetPreconfiguredFlagWith is GetPreconfiguredFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetPreconfiguredFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPreconfiguredFlag()
+}
+
 // Retrieve Whether the package requires the user to define a preset configuration.
 func (r Product_Package) GetPresetConfigurationRequiredFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getPresetConfigurationRequiredFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetPresetConfigurationRequiredFlagWith is GetPresetConfigurationRequiredFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetPresetConfigurationRequiredFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPresetConfigurationRequiredFlag()
+}
+
 // Retrieve Whether the package prevents the user from specifying a Vlan.
 func (r Product_Package) GetPreventVlanSelectionFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getPreventVlanSelectionFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetPreventVlanSelectionFlagWith is GetPreventVlanSelectionFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetPreventVlanSelectionFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPreventVlanSelectionFlag()
+}
+
 // Retrieve This flag indicates the package is for a private hosted cloud deployment. (Deprecated)
 func (r Product_Package) GetPrivateHostedCloudPackageFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getPrivateHostedCloudPackageFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrivateHostedCloudPackageFlagWith is GetPrivateHostedCloudPackageFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetPrivateHostedCloudPackageFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateHostedCloudPackageFlag()
+}
+
 // Retrieve The server role of the private hosted cloud deployment. (Deprecated)
 func (r Product_Package) GetPrivateHostedCloudPackageType() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getPrivateHostedCloudPackageType", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrivateHostedCloudPackageTypeWith is GetPrivateHostedCloudPackageType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetPrivateHostedCloudPackageTypeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateHostedCloudPackageType()
+}
+
 // Retrieve Whether the package only has access to the private network.
 func (r Product_Package) GetPrivateNetworkOnlyFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getPrivateNetworkOnlyFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrivateNetworkOnlyFlagWith is GetPrivateNetworkOnlyFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetPrivateNetworkOnlyFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateNetworkOnlyFlag()
+}
+
 // Retrieve Whether the package is a specialized mass storage QuantaStor package. (Deprecated)
 func (r Product_Package) GetQuantaStorPackageFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getQuantaStorPackageFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetQuantaStorPackageFlagWith is GetQuantaStorPackageFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetQuantaStorPackageFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetQuantaStorPackageFlag()
+}
+
 // Retrieve This flag indicates the package does not allow different disks with RAID.
 func (r Product_Package) GetRaidDiskRestrictionFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getRaidDiskRestrictionFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetRaidDiskRestrictionFlagWith is GetRaidDiskRestrictionFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetRaidDiskRestrictionFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRaidDiskRestrictionFlag()
+}
+
 // Retrieve This flag determines if the package contains a redundant power supply product.
 func (r Product_Package) GetRedundantPowerFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getRedundantPowerFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetRedundantPowerFlagWith is GetRedundantPowerFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetRedundantPowerFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRedundantPowerFlag()
+}
+
 // Retrieve The regional locations that a package is available in.
 func (r Product_Package) GetRegions() (resp []datatypes.Location_Region, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getRegions", nil, &r.Options, &resp)
 	return
 }
 
+// GetRegionsWith is GetRegions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetRegionsWith(options sl.Options) (resp []datatypes.Location_Region, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRegions()
+}
+
 // Retrieve The resource group template that describes a multi-server solution. (Deprecated)
 func (r Product_Package) GetResourceGroupTemplate() (resp datatypes.Resource_Group_Template, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getResourceGroupTemplate", nil, &r.Options, &resp)
 	return
 }
 
+// GetResourceGroupTemplateWith is GetResourceGroupTemplate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetResourceGroupTemplateWith(options sl.Options) (resp datatypes.Resource_Group_Template, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResourceGroupTemplate()
+}
+
 // This call is similar to [[SoftLayer_Product_Package/getCategories|getCategories]], except that it does not include account-restricted pricing. Not all accounts have restricted pricing.
 func (r Product_Package) GetStandardCategories() (resp []datatypes.Product_Item_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getStandardCategories", nil, &r.Options, &resp)
@@ -1621,12 +2363,28 @@ func (r Product_Package) GetTopLevelItemCategoryCode() (resp string, err error)
 	return
 }
 
+// GetTopLevelItemCategoryCodeWith is GetTopLevelItemCategoryCode, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetTopLevelItemCategoryCodeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTopLevelItemCategoryCode()
+}
+
 // Retrieve The type of service offering. This property can be used to help filter packages.
 func (r Product_Package) GetType() (resp datatypes.Product_Package_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package) GetTypeWith(options sl.Options) (resp datatypes.Product_Package_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Package presets are used to simplify ordering by eliminating the need for price ids when submitting orders.
 //
 // Orders submitted with a preset id defined will use the prices included in the package preset. Prices submitted on an order with a preset id will replace the prices included in the package preset for that prices category. If the package preset has a fixed configuration flag <em>(fixedConfigurationFlag)</em> set then the prices included in the preset configuration cannot be replaced by prices submitted on the order. The only exception to the fixed configuration flag would be if a price submitted on the order is an account-restricted price for the same product item.
@@ -1645,6 +2403,11 @@ func (r Product_Package_Preset) Id(id int) Product_Package_Preset {
 	return r
 }
 
+func (r Product_Package_Preset) InitParameter(name string, value interface{}) Product_Package_Preset {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Product_Package_Preset) Mask(mask string) Product_Package_Preset {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1664,11 +2427,26 @@ func (r Product_Package_Preset) Limit(limit int) Product_Package_Preset {
 	return r
 }
 
+func (r Product_Package_Preset) Unlimited() Product_Package_Preset {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Product_Package_Preset) Offset(offset int) Product_Package_Preset {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Product_Package_Preset) Timeout(timeout time.Duration) Product_Package_Preset {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Product_Package_Preset) WithContext(ctx context.Context) Product_Package_Preset {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method returns all the active package presets.
 func (r Product_Package_Preset) GetAllObjects() (resp []datatypes.Product_Package_Preset, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Preset", "getAllObjects", nil, &r.Options, &resp)
@@ -1687,42 +2465,98 @@ func (r Product_Package_Preset) GetCategories() (resp []datatypes.Product_Item_C
 	return
 }
 
+// GetCategoriesWith is GetCategories, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package_Preset) GetCategoriesWith(options sl.Options) (resp []datatypes.Product_Item_Category, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCategories()
+}
+
 // Retrieve The compute family this configuration belongs to.
 func (r Product_Package_Preset) GetComputeGroup() (resp datatypes.Product_Item_Server_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Preset", "getComputeGroup", nil, &r.Options, &resp)
 	return
 }
 
+// GetComputeGroupWith is GetComputeGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package_Preset) GetComputeGroupWith(options sl.Options) (resp datatypes.Product_Item_Server_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetComputeGroup()
+}
+
 // Retrieve The preset configuration (category and price).
 func (r Product_Package_Preset) GetConfiguration() (resp []datatypes.Product_Package_Preset_Configuration, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Preset", "getConfiguration", nil, &r.Options, &resp)
 	return
 }
 
+// GetConfigurationWith is GetConfiguration, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package_Preset) GetConfigurationWith(options sl.Options) (resp []datatypes.Product_Package_Preset_Configuration, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetConfiguration()
+}
+
 // Retrieve When true this preset is only allowed to upgrade/downgrade to other presets in the same compute family.
 func (r Product_Package_Preset) GetDisallowedComputeGroupUpgradeFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Preset", "getDisallowedComputeGroupUpgradeFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetDisallowedComputeGroupUpgradeFlagWith is GetDisallowedComputeGroupUpgradeFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package_Preset) GetDisallowedComputeGroupUpgradeFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDisallowedComputeGroupUpgradeFlag()
+}
+
 // Retrieve A package preset with this flag set will not allow the price's defined in the preset configuration to be overriden during order placement.
 func (r Product_Package_Preset) GetFixedConfigurationFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Preset", "getFixedConfigurationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetFixedConfigurationFlagWith is GetFixedConfigurationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package_Preset) GetFixedConfigurationFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFixedConfigurationFlag()
+}
+
 // Retrieve The locations this preset configuration is available in. If empty the preset is available in all locations the package is available in.
 func (r Product_Package_Preset) GetLocations() (resp []datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Preset", "getLocations", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationsWith is GetLocations, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package_Preset) GetLocationsWith(options sl.Options) (resp []datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocations()
+}
+
 // Retrieve The lowest server prices related to this package preset.
 func (r Product_Package_Preset) GetLowestPresetServerPrice() (resp datatypes.Product_Item_Price, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Preset", "getLowestPresetServerPrice", nil, &r.Options, &resp)
 	return
 }
 
+// GetLowestPresetServerPriceWith is GetLowestPresetServerPrice, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package_Preset) GetLowestPresetServerPriceWith(options sl.Options) (resp datatypes.Product_Item_Price, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLowestPresetServerPrice()
+}
+
 // no documentation yet
 func (r Product_Package_Preset) GetObject() (resp datatypes.Product_Package_Preset, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Preset", "getObject", nil, &r.Options, &resp)
@@ -1735,36 +2569,84 @@ func (r Product_Package_Preset) GetPackage() (resp datatypes.Product_Package, er
 	return
 }
 
+// GetPackageWith is GetPackage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package_Preset) GetPackageWith(options sl.Options) (resp datatypes.Product_Package, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPackage()
+}
+
 // Retrieve The item categories associated with a package preset, including information detailing which item categories are required as part of a SoftLayer product order.
 func (r Product_Package_Preset) GetPackageConfiguration() (resp []datatypes.Product_Package_Order_Configuration, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Preset", "getPackageConfiguration", nil, &r.Options, &resp)
 	return
 }
 
+// GetPackageConfigurationWith is GetPackageConfiguration, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package_Preset) GetPackageConfigurationWith(options sl.Options) (resp []datatypes.Product_Package_Order_Configuration, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPackageConfiguration()
+}
+
 // Retrieve The item prices that are included in this package preset configuration.
 func (r Product_Package_Preset) GetPrices() (resp []datatypes.Product_Item_Price, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Preset", "getPrices", nil, &r.Options, &resp)
 	return
 }
 
+// GetPricesWith is GetPrices, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package_Preset) GetPricesWith(options sl.Options) (resp []datatypes.Product_Item_Price, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrices()
+}
+
 // Retrieve Describes how all disks in this preset will be configured.
 func (r Product_Package_Preset) GetStorageGroupTemplateArrays() (resp []datatypes.Configuration_Storage_Group_Template_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Preset", "getStorageGroupTemplateArrays", nil, &r.Options, &resp)
 	return
 }
 
+// GetStorageGroupTemplateArraysWith is GetStorageGroupTemplateArrays, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package_Preset) GetStorageGroupTemplateArraysWith(options sl.Options) (resp []datatypes.Configuration_Storage_Group_Template_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStorageGroupTemplateArrays()
+}
+
 // Retrieve The starting hourly price for this configuration. Additional options not defined in the preset may increase the cost.
 func (r Product_Package_Preset) GetTotalMinimumHourlyFee() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Preset", "getTotalMinimumHourlyFee", nil, &r.Options, &resp)
 	return
 }
 
+// GetTotalMinimumHourlyFeeWith is GetTotalMinimumHourlyFee, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package_Preset) GetTotalMinimumHourlyFeeWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalMinimumHourlyFee()
+}
+
 // Retrieve The starting monthly price for this configuration. Additional options not defined in the preset may increase the cost.
 func (r Product_Package_Preset) GetTotalMinimumRecurringFee() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Preset", "getTotalMinimumRecurringFee", nil, &r.Options, &resp)
 	return
 }
 
+// GetTotalMinimumRecurringFeeWith is GetTotalMinimumRecurringFee, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package_Preset) GetTotalMinimumRecurringFeeWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTotalMinimumRecurringFee()
+}
+
 // The SoftLayer_Product_Package_Server data type contains summarized information for bare metal servers regarding pricing, processor stats, and feature sets.
 type Product_Package_Server struct {
 	Session *session.Session
@@ -1781,6 +2663,11 @@ func (r Product_Package_Server) Id(id int) Product_Package_Server {
 	return r
 }
 
+func (r Product_Package_Server) InitParameter(name string, value interface{}) Product_Package_Server {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Product_Package_Server) Mask(mask string) Product_Package_Server {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1800,11 +2687,26 @@ func (r Product_Package_Server) Limit(limit int) Product_Package_Server {
 	return r
 }
 
+func (r Product_Package_Server) Unlimited() Product_Package_Server {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Product_Package_Server) Offset(offset int) Product_Package_Server {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Product_Package_Server) Timeout(timeout time.Duration) Product_Package_Server {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Product_Package_Server) WithContext(ctx context.Context) Product_Package_Server {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method will grab all the package servers.
 func (r Product_Package_Server) GetAllObjects() (resp []datatypes.Product_Package_Server, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Server", "getAllObjects", nil, &r.Options, &resp)
@@ -1863,6 +2765,11 @@ func (r Product_Package_Server_Option) Id(id int) Product_Package_Server_Option
 	return r
 }
 
+func (r Product_Package_Server_Option) InitParameter(name string, value interface{}) Product_Package_Server_Option {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Product_Package_Server_Option) Mask(mask string) Product_Package_Server_Option {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1882,11 +2789,26 @@ func (r Product_Package_Server_Option) Limit(limit int) Product_Package_Server_O
 	return r
 }
 
+func (r Product_Package_Server_Option) Unlimited() Product_Package_Server_Option {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Product_Package_Server_Option) Offset(offset int) Product_Package_Server_Option {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Product_Package_Server_Option) Timeout(timeout time.Duration) Product_Package_Server_Option {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Product_Package_Server_Option) WithContext(ctx context.Context) Product_Package_Server_Option {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method will grab all the package server options.
 func (r Product_Package_Server_Option) GetAllOptions() (resp []datatypes.Product_Package_Server_Option, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Server_Option", "getAllOptions", nil, &r.Options, &resp)
@@ -1924,6 +2846,11 @@ func (r Product_Package_Type) Id(id int) Product_Package_Type {
 	return r
 }
 
+func (r Product_Package_Type) InitParameter(name string, value interface{}) Product_Package_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Product_Package_Type) Mask(mask string) Product_Package_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1943,11 +2870,26 @@ func (r Product_Package_Type) Limit(limit int) Product_Package_Type {
 	return r
 }
 
+func (r Product_Package_Type) Unlimited() Product_Package_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Product_Package_Type) Offset(offset int) Product_Package_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Product_Package_Type) Timeout(timeout time.Duration) Product_Package_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Product_Package_Type) WithContext(ctx context.Context) Product_Package_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method will return all of the available package types.
 func (r Product_Package_Type) GetAllObjects() (resp []datatypes.Product_Package_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Package_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -1966,6 +2908,14 @@ func (r Product_Package_Type) GetPackages() (resp []datatypes.Product_Package, e
 	return
 }
 
+// GetPackagesWith is GetPackages, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Package_Type) GetPackagesWith(options sl.Options) (resp []datatypes.Product_Package, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPackages()
+}
+
 // The SoftLayer_Product_Upgrade_Request data type contains general information relating to a hardware, virtual server, or service upgrade. It also relates a [[SoftLayer_Billing_Order]] to a [[SoftLayer_Ticket]].
 type Product_Upgrade_Request struct {
 	Session *session.Session
@@ -1982,6 +2932,11 @@ func (r Product_Upgrade_Request) Id(id int) Product_Upgrade_Request {
 	return r
 }
 
+func (r Product_Upgrade_Request) InitParameter(name string, value interface{}) Product_Upgrade_Request {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Product_Upgrade_Request) Mask(mask string) Product_Upgrade_Request {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2001,11 +2956,26 @@ func (r Product_Upgrade_Request) Limit(limit int) Product_Upgrade_Request {
 	return r
 }
 
+func (r Product_Upgrade_Request) Unlimited() Product_Upgrade_Request {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Product_Upgrade_Request) Offset(offset int) Product_Upgrade_Request {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Product_Upgrade_Request) Timeout(timeout time.Duration) Product_Upgrade_Request {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Product_Upgrade_Request) WithContext(ctx context.Context) Product_Upgrade_Request {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // When a change is made to an upgrade by Sales, this method will approve the changes that were made. A customer must acknowledge the change and approve it so that the upgrade request can proceed.
 func (r Product_Upgrade_Request) ApproveChanges() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Upgrade_Request", "approveChanges", nil, &r.Options, &resp)
@@ -2018,18 +2988,42 @@ func (r Product_Upgrade_Request) GetAccount() (resp datatypes.Account, err error
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Upgrade_Request) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve Indicates that the upgrade request has completed or has been cancelled.
 func (r Product_Upgrade_Request) GetCompletedFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Upgrade_Request", "getCompletedFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetCompletedFlagWith is GetCompletedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Upgrade_Request) GetCompletedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCompletedFlag()
+}
+
 // Retrieve This is the invoice associated with the upgrade request. For hourly servers or services, an invoice will not be available.
 func (r Product_Upgrade_Request) GetInvoice() (resp datatypes.Billing_Invoice, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Upgrade_Request", "getInvoice", nil, &r.Options, &resp)
 	return
 }
 
+// GetInvoiceWith is GetInvoice, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Upgrade_Request) GetInvoiceWith(options sl.Options) (resp datatypes.Billing_Invoice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoice()
+}
+
 // getObject retrieves a SoftLayer_Product_Upgrade_Request object on your account whose ID corresponds to the ID of the init parameter passed to the SoftLayer_Product_Upgrade_Request service.
 func (r Product_Upgrade_Request) GetObject() (resp datatypes.Product_Upgrade_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Upgrade_Request", "getObject", nil, &r.Options, &resp)
@@ -2042,36 +3036,84 @@ func (r Product_Upgrade_Request) GetOrder() (resp datatypes.Billing_Order, err e
 	return
 }
 
+// GetOrderWith is GetOrder, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Upgrade_Request) GetOrderWith(options sl.Options) (resp datatypes.Billing_Order, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrder()
+}
+
 // Retrieve A server object associated with the upgrade request if any.
 func (r Product_Upgrade_Request) GetServer() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Upgrade_Request", "getServer", nil, &r.Options, &resp)
 	return
 }
 
+// GetServerWith is GetServer, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Upgrade_Request) GetServerWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServer()
+}
+
 // Retrieve The current status of the upgrade request.
 func (r Product_Upgrade_Request) GetStatus() (resp datatypes.Product_Upgrade_Request_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Upgrade_Request", "getStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Upgrade_Request) GetStatusWith(options sl.Options) (resp datatypes.Product_Upgrade_Request_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // Retrieve The ticket that is used to coordinate the upgrade process.
 func (r Product_Upgrade_Request) GetTicket() (resp datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Upgrade_Request", "getTicket", nil, &r.Options, &resp)
 	return
 }
 
+// GetTicketWith is GetTicket, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Upgrade_Request) GetTicketWith(options sl.Options) (resp datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTicket()
+}
+
 // Retrieve The user that placed the order.
 func (r Product_Upgrade_Request) GetUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Upgrade_Request", "getUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserWith is GetUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Upgrade_Request) GetUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUser()
+}
+
 // Retrieve A virtual server object associated with the upgrade request if any.
 func (r Product_Upgrade_Request) GetVirtualGuest() (resp datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Product_Upgrade_Request", "getVirtualGuest", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestWith is GetVirtualGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Product_Upgrade_Request) GetVirtualGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuest()
+}
+
 // In case an upgrade cannot be performed, the maintenance window needs to be updated to a future date.
 func (r Product_Upgrade_Request) UpdateMaintenanceWindow(maintenanceStartTime *datatypes.Time, maintenanceWindowId *int) (resp bool, err error) {
 	params := []interface{}{
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Provisioning_Hook) Id(id int) Provisioning_Hook {
 	return r
 }
 
+func (r Provisioning_Hook) InitParameter(name string, value interface{}) Provisioning_Hook {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Provisioning_Hook) Mask(mask string) Provisioning_Hook {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Provisioning_Hook) Limit(limit int) Provisioning_Hook {
 	return r
 }
 
+func (r Provisioning_Hook) Unlimited() Provisioning_Hook {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Provisioning_Hook) Offset(offset int) Provisioning_Hook {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Provisioning_Hook) Timeout(timeout time.Duration) Provisioning_Hook {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Provisioning_Hook) WithContext(ctx context.Context) Provisioning_Hook {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Provisioning_Hook) CreateObject(templateObject *datatypes.Provisioning_Hook) (resp datatypes.Provisioning_Hook, err error) {
 	params := []interface{}{
@@ -127,6 +149,11 @@ func (r Provisioning_Hook_Type) Id(id int) Provisioning_Hook_Type {
 	return r
 }
 
+func (r Provisioning_Hook_Type) InitParameter(name string, value interface{}) Provisioning_Hook_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Provisioning_Hook_Type) Mask(mask string) Provisioning_Hook_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -146,11 +173,26 @@ func (r Provisioning_Hook_Type) Limit(limit int) Provisioning_Hook_Type {
 	return r
 }
 
+func (r Provisioning_Hook_Type) Unlimited() Provisioning_Hook_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Provisioning_Hook_Type) Offset(offset int) Provisioning_Hook_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Provisioning_Hook_Type) Timeout(timeout time.Duration) Provisioning_Hook_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Provisioning_Hook_Type) WithContext(ctx context.Context) Provisioning_Hook_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Provisioning_Hook_Type) GetAllHookTypes() (resp []datatypes.Provisioning_Hook_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Provisioning_Hook_Type", "getAllHookTypes", nil, &r.Options, &resp)
@@ -179,6 +221,11 @@ func (r Provisioning_Maintenance_Classification) Id(id int) Provisioning_Mainten
 	return r
 }
 
+func (r Provisioning_Maintenance_Classification) InitParameter(name string, value interface{}) Provisioning_Maintenance_Classification {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Provisioning_Maintenance_Classification) Mask(mask string) Provisioning_Maintenance_Classification {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -198,11 +245,26 @@ func (r Provisioning_Maintenance_Classification) Limit(limit int) Provisioning_M
 	return r
 }
 
+func (r Provisioning_Maintenance_Classification) Unlimited() Provisioning_Maintenance_Classification {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Provisioning_Maintenance_Classification) Offset(offset int) Provisioning_Maintenance_Classification {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Provisioning_Maintenance_Classification) Timeout(timeout time.Duration) Provisioning_Maintenance_Classification {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Provisioning_Maintenance_Classification) WithContext(ctx context.Context) Provisioning_Maintenance_Classification {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Provisioning_Maintenance_Classification) GetItemCategories() (resp []datatypes.Provisioning_Maintenance_Classification_Item_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Provisioning_Maintenance_Classification", "getItemCategories", nil, &r.Options, &resp)
@@ -246,6 +308,11 @@ func (r Provisioning_Maintenance_Classification_Item_Category) Id(id int) Provis
 	return r
 }
 
+func (r Provisioning_Maintenance_Classification_Item_Category) InitParameter(name string, value interface{}) Provisioning_Maintenance_Classification_Item_Category {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Provisioning_Maintenance_Classification_Item_Category) Mask(mask string) Provisioning_Maintenance_Classification_Item_Category {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -265,11 +332,26 @@ func (r Provisioning_Maintenance_Classification_Item_Category) Limit(limit int)
 	return r
 }
 
+func (r Provisioning_Maintenance_Classification_Item_Category) Unlimited() Provisioning_Maintenance_Classification_Item_Category {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Provisioning_Maintenance_Classification_Item_Category) Offset(offset int) Provisioning_Maintenance_Classification_Item_Category {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Provisioning_Maintenance_Classification_Item_Category) Timeout(timeout time.Duration) Provisioning_Maintenance_Classification_Item_Category {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Provisioning_Maintenance_Classification_Item_Category) WithContext(ctx context.Context) Provisioning_Maintenance_Classification_Item_Category {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Provisioning_Maintenance_Classification_Item_Category) GetMaintenanceClassification() (resp datatypes.Provisioning_Maintenance_Classification, err error) {
 	err = r.Session.DoRequest("SoftLayer_Provisioning_Maintenance_Classification_Item_Category", "getMaintenanceClassification", nil, &r.Options, &resp)
@@ -298,6 +380,11 @@ func (r Provisioning_Maintenance_Slots) Id(id int) Provisioning_Maintenance_Slot
 	return r
 }
 
+func (r Provisioning_Maintenance_Slots) InitParameter(name string, value interface{}) Provisioning_Maintenance_Slots {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Provisioning_Maintenance_Slots) Mask(mask string) Provisioning_Maintenance_Slots {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -317,11 +404,26 @@ func (r Provisioning_Maintenance_Slots) Limit(limit int) Provisioning_Maintenanc
 	return r
 }
 
+func (r Provisioning_Maintenance_Slots) Unlimited() Provisioning_Maintenance_Slots {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Provisioning_Maintenance_Slots) Offset(offset int) Provisioning_Maintenance_Slots {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Provisioning_Maintenance_Slots) Timeout(timeout time.Duration) Provisioning_Maintenance_Slots {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Provisioning_Maintenance_Slots) WithContext(ctx context.Context) Provisioning_Maintenance_Slots {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Provisioning_Maintenance_Slots) GetObject() (resp datatypes.Provisioning_Maintenance_Slots, err error) {
 	err = r.Session.DoRequest("SoftLayer_Provisioning_Maintenance_Slots", "getObject", nil, &r.Options, &resp)
@@ -344,6 +446,11 @@ func (r Provisioning_Maintenance_Ticket) Id(id int) Provisioning_Maintenance_Tic
 	return r
 }
 
+func (r Provisioning_Maintenance_Ticket) InitParameter(name string, value interface{}) Provisioning_Maintenance_Ticket {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Provisioning_Maintenance_Ticket) Mask(mask string) Provisioning_Maintenance_Ticket {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -363,11 +470,26 @@ func (r Provisioning_Maintenance_Ticket) Limit(limit int) Provisioning_Maintenan
 	return r
 }
 
+func (r Provisioning_Maintenance_Ticket) Unlimited() Provisioning_Maintenance_Ticket {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Provisioning_Maintenance_Ticket) Offset(offset int) Provisioning_Maintenance_Ticket {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Provisioning_Maintenance_Ticket) Timeout(timeout time.Duration) Provisioning_Maintenance_Ticket {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Provisioning_Maintenance_Ticket) WithContext(ctx context.Context) Provisioning_Maintenance_Ticket {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r Provisioning_Maintenance_Ticket) GetAvailableSlots() (resp datatypes.Provisioning_Maintenance_Slots, err error) {
 	err = r.Session.DoRequest("SoftLayer_Provisioning_Maintenance_Ticket", "getAvailableSlots", nil, &r.Options, &resp)
@@ -408,6 +530,11 @@ func (r Provisioning_Maintenance_Window) Id(id int) Provisioning_Maintenance_Win
 	return r
 }
 
+func (r Provisioning_Maintenance_Window) InitParameter(name string, value interface{}) Provisioning_Maintenance_Window {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Provisioning_Maintenance_Window) Mask(mask string) Provisioning_Maintenance_Window {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -427,11 +554,26 @@ func (r Provisioning_Maintenance_Window) Limit(limit int) Provisioning_Maintenan
 	return r
 }
 
+func (r Provisioning_Maintenance_Window) Unlimited() Provisioning_Maintenance_Window {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Provisioning_Maintenance_Window) Offset(offset int) Provisioning_Maintenance_Window {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Provisioning_Maintenance_Window) Timeout(timeout time.Duration) Provisioning_Maintenance_Window {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Provisioning_Maintenance_Window) WithContext(ctx context.Context) Provisioning_Maintenance_Window {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // getMaintenceWindowForTicket() returns a boolean
 func (r Provisioning_Maintenance_Window) AddCustomerUpgradeWindow(customerUpgradeWindow *datatypes.Container_Provisioning_Maintenance_Window) (resp bool, err error) {
 	params := []interface{}{
@@ -527,6 +669,11 @@ func (r Provisioning_Version1_Transaction_Group) Id(id int) Provisioning_Version
 	return r
 }
 
+func (r Provisioning_Version1_Transaction_Group) InitParameter(name string, value interface{}) Provisioning_Version1_Transaction_Group {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Provisioning_Version1_Transaction_Group) Mask(mask string) Provisioning_Version1_Transaction_Group {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -546,11 +693,26 @@ func (r Provisioning_Version1_Transaction_Group) Limit(limit int) Provisioning_V
 	return r
 }
 
+func (r Provisioning_Version1_Transaction_Group) Unlimited() Provisioning_Version1_Transaction_Group {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Provisioning_Version1_Transaction_Group) Offset(offset int) Provisioning_Version1_Transaction_Group {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Provisioning_Version1_Transaction_Group) Timeout(timeout time.Duration) Provisioning_Version1_Transaction_Group {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Provisioning_Version1_Transaction_Group) WithContext(ctx context.Context) Provisioning_Version1_Transaction_Group {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Provisioning_Version1_Transaction_Group) GetAllObjects() (resp []datatypes.Provisioning_Version1_Transaction_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Provisioning_Version1_Transaction_Group", "getAllObjects", nil, &r.Options, &resp)
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Resource_Configuration) Id(id int) Resource_Configuration {
 	return r
 }
 
+func (r Resource_Configuration) InitParameter(name string, value interface{}) Resource_Configuration {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Resource_Configuration) Mask(mask string) Resource_Configuration {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Resource_Configuration) Limit(limit int) Resource_Configuration {
 	return r
 }
 
+func (r Resource_Configuration) Unlimited() Resource_Configuration {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Resource_Configuration) Offset(offset int) Resource_Configuration {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Resource_Configuration) Timeout(timeout time.Duration) Resource_Configuration {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Resource_Configuration) WithContext(ctx context.Context) Resource_Configuration {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // The setOsPasswordFromEncrypted method is used to set the operating system password from a key/pair encrypted password signed by SoftLayer.
 func (r Resource_Configuration) SetOsPasswordFromEncrypted(encryptedPassword *string) (resp bool, err error) {
 	params := []interface{}{
@@ -94,6 +116,11 @@ func (r Resource_Group) Id(id int) Resource_Group {
 	return r
 }
 
+func (r Resource_Group) InitParameter(name string, value interface{}) Resource_Group {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Resource_Group) Mask(mask string) Resource_Group {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -113,11 +140,26 @@ func (r Resource_Group) Limit(limit int) Resource_Group {
 	return r
 }
 
+func (r Resource_Group) Unlimited() Resource_Group {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Resource_Group) Offset(offset int) Resource_Group {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Resource_Group) Timeout(timeout time.Duration) Resource_Group {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Resource_Group) WithContext(ctx context.Context) Resource_Group {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Resource_Group) EditObject(templateObject *datatypes.Resource_Group) (resp bool, err error) {
 	params := []interface{}{
@@ -133,24 +175,56 @@ func (r Resource_Group) GetAncestorGroups() (resp []datatypes.Resource_Group, er
 	return
 }
 
+// GetAncestorGroupsWith is GetAncestorGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Resource_Group) GetAncestorGroupsWith(options sl.Options) (resp []datatypes.Resource_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAncestorGroups()
+}
+
 // Retrieve A resource group's associated attributes.
 func (r Resource_Group) GetAttributes() (resp []datatypes.Resource_Group_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Resource_Group", "getAttributes", nil, &r.Options, &resp)
 	return
 }
 
+// GetAttributesWith is GetAttributes, with the mask, filter and result limit of options applied to
+// this call only
+func (r Resource_Group) GetAttributesWith(options sl.Options) (resp []datatypes.Resource_Group_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+}
+
 // Retrieve A resource group's associated hardware members.
 func (r Resource_Group) GetHardwareMembers() (resp []datatypes.Resource_Group_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Resource_Group", "getHardwareMembers", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareMembersWith is GetHardwareMembers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Resource_Group) GetHardwareMembersWith(options sl.Options) (resp []datatypes.Resource_Group_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareMembers()
+}
+
 // Retrieve A resource group's associated members.
 func (r Resource_Group) GetMembers() (resp []datatypes.Resource_Group_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Resource_Group", "getMembers", nil, &r.Options, &resp)
 	return
 }
 
+// GetMembersWith is GetMembers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Resource_Group) GetMembersWith(options sl.Options) (resp []datatypes.Resource_Group_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMembers()
+}
+
 // no documentation yet
 func (r Resource_Group) GetObject() (resp datatypes.Resource_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Resource_Group", "getObject", nil, &r.Options, &resp)
@@ -163,24 +237,56 @@ func (r Resource_Group) GetRootResourceGroup() (resp datatypes.Resource_Group, e
 	return
 }
 
+// GetRootResourceGroupWith is GetRootResourceGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Resource_Group) GetRootResourceGroupWith(options sl.Options) (resp datatypes.Resource_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRootResourceGroup()
+}
+
 // Retrieve A resource group's associated subnet members.
 func (r Resource_Group) GetSubnetMembers() (resp []datatypes.Resource_Group_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Resource_Group", "getSubnetMembers", nil, &r.Options, &resp)
 	return
 }
 
+// GetSubnetMembersWith is GetSubnetMembers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Resource_Group) GetSubnetMembersWith(options sl.Options) (resp []datatypes.Resource_Group_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubnetMembers()
+}
+
 // Retrieve A resource group's associated template.
 func (r Resource_Group) GetTemplate() (resp datatypes.Resource_Group_Template, err error) {
 	err = r.Session.DoRequest("SoftLayer_Resource_Group", "getTemplate", nil, &r.Options, &resp)
 	return
 }
 
+// GetTemplateWith is GetTemplate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Resource_Group) GetTemplateWith(options sl.Options) (resp datatypes.Resource_Group_Template, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTemplate()
+}
+
 // Retrieve A resource group's associated VLAN members.
 func (r Resource_Group) GetVlanMembers() (resp []datatypes.Resource_Group_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Resource_Group", "getVlanMembers", nil, &r.Options, &resp)
 	return
 }
 
+// GetVlanMembersWith is GetVlanMembers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Resource_Group) GetVlanMembersWith(options sl.Options) (resp []datatypes.Resource_Group_Member, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVlanMembers()
+}
+
 // no documentation yet
 type Resource_Group_Template struct {
 	Session *session.Session
@@ -197,6 +303,11 @@ func (r Resource_Group_Template) Id(id int) Resource_Group_Template {
 	return r
 }
 
+func (r Resource_Group_Template) InitParameter(name string, value interface{}) Resource_Group_Template {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Resource_Group_Template) Mask(mask string) Resource_Group_Template {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -216,11 +327,26 @@ func (r Resource_Group_Template) Limit(limit int) Resource_Group_Template {
 	return r
 }
 
+func (r Resource_Group_Template) Unlimited() Resource_Group_Template {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Resource_Group_Template) Offset(offset int) Resource_Group_Template {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Resource_Group_Template) Timeout(timeout time.Duration) Resource_Group_Template {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Resource_Group_Template) WithContext(ctx context.Context) Resource_Group_Template {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Resource_Group_Template) GetAllObjects() (resp []datatypes.Resource_Group_Template, err error) {
 	err = r.Session.DoRequest("SoftLayer_Resource_Group_Template", "getAllObjects", nil, &r.Options, &resp)
@@ -267,6 +393,11 @@ func (r Resource_Metadata) Id(id int) Resource_Metadata {
 	return r
 }
 
+func (r Resource_Metadata) InitParameter(name string, value interface{}) Resource_Metadata {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Resource_Metadata) Mask(mask string) Resource_Metadata {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -286,11 +417,26 @@ func (r Resource_Metadata) Limit(limit int) Resource_Metadata {
 	return r
 }
 
+func (r Resource_Metadata) Unlimited() Resource_Metadata {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Resource_Metadata) Offset(offset int) Resource_Metadata {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Resource_Metadata) Timeout(timeout time.Duration) Resource_Metadata {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Resource_Metadata) WithContext(ctx context.Context) Resource_Metadata {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // The getBackendMacAddresses method retrieves a list of backend MAC addresses for the resource
 func (r Resource_Metadata) GetBackendMacAddresses() (resp []string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Resource_Metadata", "getBackendMacAddresses", nil, &r.Options, &resp)
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Sales_Presale_Event) Id(id int) Sales_Presale_Event {
 	return r
 }
 
+func (r Sales_Presale_Event) InitParameter(name string, value interface{}) Sales_Presale_Event {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Sales_Presale_Event) Mask(mask string) Sales_Presale_Event {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,17 +71,40 @@ func (r Sales_Presale_Event) Limit(limit int) Sales_Presale_Event {
 	return r
 }
 
+func (r Sales_Presale_Event) Unlimited() Sales_Presale_Event {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Sales_Presale_Event) Offset(offset int) Sales_Presale_Event {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Sales_Presale_Event) Timeout(timeout time.Duration) Sales_Presale_Event {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Sales_Presale_Event) WithContext(ctx context.Context) Sales_Presale_Event {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve A flag to indicate that the presale event is currently active. A presale event is active if the current time is between the start and end dates.
 func (r Sales_Presale_Event) GetActiveFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Sales_Presale_Event", "getActiveFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveFlagWith is GetActiveFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Sales_Presale_Event) GetActiveFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveFlag()
+}
+
 // no documentation yet
 func (r Sales_Presale_Event) GetAllObjects() (resp []datatypes.Sales_Presale_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Sales_Presale_Event", "getAllObjects", nil, &r.Options, &resp)
@@ -87,18 +117,42 @@ func (r Sales_Presale_Event) GetExpiredFlag() (resp bool, err error) {
 	return
 }
 
+// GetExpiredFlagWith is GetExpiredFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Sales_Presale_Event) GetExpiredFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetExpiredFlag()
+}
+
 // Retrieve The [[SoftLayer_Product_Item]] associated with the presale event.
 func (r Sales_Presale_Event) GetItem() (resp datatypes.Product_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Sales_Presale_Event", "getItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetItemWith is GetItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Sales_Presale_Event) GetItemWith(options sl.Options) (resp datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetItem()
+}
+
 // Retrieve The [[SoftLayer_Location]] associated with the presale event.
 func (r Sales_Presale_Event) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Sales_Presale_Event", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Sales_Presale_Event) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // '''getObject''' retrieves the [[SoftLayer_Sales_Presale_Event]] object whose id number corresponds to the id number of the init parameter passed to the SoftLayer_Sales_Presale_Event service. Customers may only retrieve presale events that are currently active.
 func (r Sales_Presale_Event) GetObject() (resp datatypes.Sales_Presale_Event, err error) {
 	err = r.Session.DoRequest("SoftLayer_Sales_Presale_Event", "getObject", nil, &r.Options, &resp)
@@ -110,3 +164,11 @@ func (r Sales_Presale_Event) GetOrders() (resp []datatypes.Billing_Order, err er
 	err = r.Session.DoRequest("SoftLayer_Sales_Presale_Event", "getOrders", nil, &r.Options, &resp)
 	return
 }
+
+// GetOrdersWith is GetOrders, with the mask, filter and result limit of options applied to
+// this call only
+func (r Sales_Presale_Event) GetOrdersWith(options sl.Options) (resp []datatypes.Billing_Order, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrders()
+}
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Scale_Asset) Id(id int) Scale_Asset {
 	return r
 }
 
+func (r Scale_Asset) InitParameter(name string, value interface{}) Scale_Asset {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Asset) Mask(mask string) Scale_Asset {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Scale_Asset) Limit(limit int) Scale_Asset {
 	return r
 }
 
+func (r Scale_Asset) Unlimited() Scale_Asset {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Asset) Offset(offset int) Scale_Asset {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Asset) Timeout(timeout time.Duration) Scale_Asset {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Asset) WithContext(ctx context.Context) Scale_Asset {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Asset) DeleteObject() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Asset", "deleteObject", nil, &r.Options, &resp)
@@ -87,6 +109,14 @@ func (r Scale_Asset) GetScaleGroup() (resp datatypes.Scale_Group, err error) {
 	return
 }
 
+// GetScaleGroupWith is GetScaleGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Asset) GetScaleGroupWith(options sl.Options) (resp datatypes.Scale_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleGroup()
+}
+
 // no documentation yet
 type Scale_Asset_Hardware struct {
 	Session *session.Session
@@ -103,6 +133,11 @@ func (r Scale_Asset_Hardware) Id(id int) Scale_Asset_Hardware {
 	return r
 }
 
+func (r Scale_Asset_Hardware) InitParameter(name string, value interface{}) Scale_Asset_Hardware {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Asset_Hardware) Mask(mask string) Scale_Asset_Hardware {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -122,11 +157,26 @@ func (r Scale_Asset_Hardware) Limit(limit int) Scale_Asset_Hardware {
 	return r
 }
 
+func (r Scale_Asset_Hardware) Unlimited() Scale_Asset_Hardware {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Asset_Hardware) Offset(offset int) Scale_Asset_Hardware {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Asset_Hardware) Timeout(timeout time.Duration) Scale_Asset_Hardware {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Asset_Hardware) WithContext(ctx context.Context) Scale_Asset_Hardware {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Asset_Hardware) CreateObject(templateObject *datatypes.Scale_Asset_Hardware) (resp datatypes.Scale_Asset_Hardware, err error) {
 	params := []interface{}{
@@ -148,12 +198,28 @@ func (r Scale_Asset_Hardware) GetHardware() (resp datatypes.Hardware, err error)
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Asset_Hardware) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve The identifier of the hardware for this asset.
 func (r Scale_Asset_Hardware) GetHardwareId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Asset_Hardware", "getHardwareId", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareIdWith is GetHardwareId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Asset_Hardware) GetHardwareIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareId()
+}
+
 // no documentation yet
 func (r Scale_Asset_Hardware) GetObject() (resp datatypes.Scale_Asset_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Asset_Hardware", "getObject", nil, &r.Options, &resp)
@@ -166,6 +232,14 @@ func (r Scale_Asset_Hardware) GetScaleGroup() (resp datatypes.Scale_Group, err e
 	return
 }
 
+// GetScaleGroupWith is GetScaleGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Asset_Hardware) GetScaleGroupWith(options sl.Options) (resp datatypes.Scale_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleGroup()
+}
+
 // no documentation yet
 type Scale_Asset_Virtual_Guest struct {
 	Session *session.Session
@@ -182,6 +256,11 @@ func (r Scale_Asset_Virtual_Guest) Id(id int) Scale_Asset_Virtual_Guest {
 	return r
 }
 
+func (r Scale_Asset_Virtual_Guest) InitParameter(name string, value interface{}) Scale_Asset_Virtual_Guest {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Asset_Virtual_Guest) Mask(mask string) Scale_Asset_Virtual_Guest {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -201,11 +280,26 @@ func (r Scale_Asset_Virtual_Guest) Limit(limit int) Scale_Asset_Virtual_Guest {
 	return r
 }
 
+func (r Scale_Asset_Virtual_Guest) Unlimited() Scale_Asset_Virtual_Guest {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Asset_Virtual_Guest) Offset(offset int) Scale_Asset_Virtual_Guest {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Asset_Virtual_Guest) Timeout(timeout time.Duration) Scale_Asset_Virtual_Guest {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Asset_Virtual_Guest) WithContext(ctx context.Context) Scale_Asset_Virtual_Guest {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Asset_Virtual_Guest) CreateObject(templateObject *datatypes.Scale_Asset_Virtual_Guest) (resp datatypes.Scale_Asset_Virtual_Guest, err error) {
 	params := []interface{}{
@@ -233,18 +327,42 @@ func (r Scale_Asset_Virtual_Guest) GetScaleGroup() (resp datatypes.Scale_Group,
 	return
 }
 
+// GetScaleGroupWith is GetScaleGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Asset_Virtual_Guest) GetScaleGroupWith(options sl.Options) (resp datatypes.Scale_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleGroup()
+}
+
 // Retrieve The guest for this asset.
 func (r Scale_Asset_Virtual_Guest) GetVirtualGuest() (resp datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Asset_Virtual_Guest", "getVirtualGuest", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestWith is GetVirtualGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Asset_Virtual_Guest) GetVirtualGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuest()
+}
+
 // Retrieve The identifier of the guest for this asset.
 func (r Scale_Asset_Virtual_Guest) GetVirtualGuestId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Asset_Virtual_Guest", "getVirtualGuestId", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestIdWith is GetVirtualGuestId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Asset_Virtual_Guest) GetVirtualGuestIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestId()
+}
+
 // no documentation yet
 type Scale_Group struct {
 	Session *session.Session
@@ -261,6 +379,11 @@ func (r Scale_Group) Id(id int) Scale_Group {
 	return r
 }
 
+func (r Scale_Group) InitParameter(name string, value interface{}) Scale_Group {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Group) Mask(mask string) Scale_Group {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -280,11 +403,26 @@ func (r Scale_Group) Limit(limit int) Scale_Group {
 	return r
 }
 
+func (r Scale_Group) Unlimited() Scale_Group {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Group) Offset(offset int) Scale_Group {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Group) Timeout(timeout time.Duration) Scale_Group {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Group) WithContext(ctx context.Context) Scale_Group {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a scale group. If minimumMemberCount is greater than zero or desiredMemberCount is present, guest members will be created right away.
 func (r Scale_Group) CreateObject(templateObject *datatypes.Scale_Group) (resp datatypes.Scale_Group, err error) {
 	params := []interface{}{
@@ -323,6 +461,14 @@ func (r Scale_Group) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Group) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // This returns the number of hourly instances an account can add from this point. It is essentially the same as [[SoftLayer_Account/hourlyInstanceLimit|hourlyInstanceLimit]] minus existing hourly instances and ones spoken for as part of a scaling group (as determined by the group's maximum). This number can be used to help determine a maximum member count for a new group to ensure it won't go over the account limit. This can return a negative value if the current hourly instance count combined with the unused-but-possible count (based on other scale group maximums) is over the limit.
 func (r Scale_Group) GetAvailableHourlyInstanceLimit() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Group", "getAvailableHourlyInstanceLimit", nil, &r.Options, &resp)
@@ -341,18 +487,42 @@ func (r Scale_Group) GetLoadBalancers() (resp []datatypes.Scale_LoadBalancer, er
 	return
 }
 
+// GetLoadBalancersWith is GetLoadBalancers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Group) GetLoadBalancersWith(options sl.Options) (resp []datatypes.Scale_LoadBalancer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLoadBalancers()
+}
+
 // Retrieve Collection of log entries for this group.
 func (r Scale_Group) GetLogs() (resp []datatypes.Scale_Group_Log, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Group", "getLogs", nil, &r.Options, &resp)
 	return
 }
 
+// GetLogsWith is GetLogs, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Group) GetLogsWith(options sl.Options) (resp []datatypes.Scale_Group_Log, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLogs()
+}
+
 // Retrieve Collection of VLANs for this auto scale group. VLANs are optional. This can contain a public or private VLAN or both. When a single VLAN for a public/private type is given it can be a non-purchased VLAN only if the minimumMemberCount on the group is >= 1. This can also contain any number of public/private purchased VLANs and members are staggered across them when scaled up.
 func (r Scale_Group) GetNetworkVlans() (resp []datatypes.Scale_Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Group", "getNetworkVlans", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlansWith is GetNetworkVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Group) GetNetworkVlansWith(options sl.Options) (resp []datatypes.Scale_Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlans()
+}
+
 // no documentation yet
 func (r Scale_Group) GetObject() (resp datatypes.Scale_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Group", "getObject", nil, &r.Options, &resp)
@@ -365,36 +535,84 @@ func (r Scale_Group) GetPolicies() (resp []datatypes.Scale_Policy, err error) {
 	return
 }
 
+// GetPoliciesWith is GetPolicies, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Group) GetPoliciesWith(options sl.Options) (resp []datatypes.Scale_Policy, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPolicies()
+}
+
 // Retrieve The regional group for this scale group.
 func (r Scale_Group) GetRegionalGroup() (resp datatypes.Location_Group_Regional, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Group", "getRegionalGroup", nil, &r.Options, &resp)
 	return
 }
 
+// GetRegionalGroupWith is GetRegionalGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Group) GetRegionalGroupWith(options sl.Options) (resp datatypes.Location_Group_Regional, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRegionalGroup()
+}
+
 // Retrieve The status for this scale group.
 func (r Scale_Group) GetStatus() (resp datatypes.Scale_Group_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Group", "getStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Group) GetStatusWith(options sl.Options) (resp datatypes.Scale_Group_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // Retrieve The termination policy for this scaling group.
 func (r Scale_Group) GetTerminationPolicy() (resp datatypes.Scale_Termination_Policy, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Group", "getTerminationPolicy", nil, &r.Options, &resp)
 	return
 }
 
+// GetTerminationPolicyWith is GetTerminationPolicy, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Group) GetTerminationPolicyWith(options sl.Options) (resp datatypes.Scale_Termination_Policy, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTerminationPolicy()
+}
+
 // Retrieve Collection of guests that have been pinned to this group. Guest assets are only used for certain trigger checks such as resource watches. They do not count towards the auto scaling guest counts of this group in anyway and are never automatically added or removed.
 func (r Scale_Group) GetVirtualGuestAssets() (resp []datatypes.Scale_Asset_Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Group", "getVirtualGuestAssets", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestAssetsWith is GetVirtualGuestAssets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Group) GetVirtualGuestAssetsWith(options sl.Options) (resp []datatypes.Scale_Asset_Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestAssets()
+}
+
 // Retrieve Collection of guests that have been scaled with the group. When this group is active, the count of guests here is guaranteed to be between minimumMemberCount and maximumMemberCount inclusively.
 func (r Scale_Group) GetVirtualGuestMembers() (resp []datatypes.Scale_Member_Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Group", "getVirtualGuestMembers", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestMembersWith is GetVirtualGuestMembers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Group) GetVirtualGuestMembersWith(options sl.Options) (resp []datatypes.Scale_Member_Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestMembers()
+}
+
 // Resume this group. The group must be in a suspended status to do this. By doing this, the group's status will become active.
 func (r Scale_Group) Resume() (err error) {
 	var resp datatypes.Void
@@ -443,6 +661,11 @@ func (r Scale_Group_Status) Id(id int) Scale_Group_Status {
 	return r
 }
 
+func (r Scale_Group_Status) InitParameter(name string, value interface{}) Scale_Group_Status {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Group_Status) Mask(mask string) Scale_Group_Status {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -462,11 +685,26 @@ func (r Scale_Group_Status) Limit(limit int) Scale_Group_Status {
 	return r
 }
 
+func (r Scale_Group_Status) Unlimited() Scale_Group_Status {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Group_Status) Offset(offset int) Scale_Group_Status {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Group_Status) Timeout(timeout time.Duration) Scale_Group_Status {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Group_Status) WithContext(ctx context.Context) Scale_Group_Status {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Group_Status) GetAllObjects() (resp []datatypes.Scale_Group_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Group_Status", "getAllObjects", nil, &r.Options, &resp)
@@ -495,6 +733,11 @@ func (r Scale_LoadBalancer) Id(id int) Scale_LoadBalancer {
 	return r
 }
 
+func (r Scale_LoadBalancer) InitParameter(name string, value interface{}) Scale_LoadBalancer {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_LoadBalancer) Mask(mask string) Scale_LoadBalancer {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -514,11 +757,26 @@ func (r Scale_LoadBalancer) Limit(limit int) Scale_LoadBalancer {
 	return r
 }
 
+func (r Scale_LoadBalancer) Unlimited() Scale_LoadBalancer {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_LoadBalancer) Offset(offset int) Scale_LoadBalancer {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_LoadBalancer) Timeout(timeout time.Duration) Scale_LoadBalancer {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_LoadBalancer) WithContext(ctx context.Context) Scale_LoadBalancer {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a load balancer for a scale group. Once created, the configuration will be used to configure the load balancers for autoscaled members.
 //
 // If the given virtual server port exists for the given virtual IP address, it is reused here if all the other values match. Otherwise, the virtual server port will be created.
@@ -551,12 +809,28 @@ func (r Scale_LoadBalancer) GetAllocationPercent() (resp int, err error) {
 	return
 }
 
+// GetAllocationPercentWith is GetAllocationPercent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_LoadBalancer) GetAllocationPercentWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllocationPercent()
+}
+
 // Retrieve The health check for this configuration.
 func (r Scale_LoadBalancer) GetHealthCheck() (resp datatypes.Network_Application_Delivery_Controller_LoadBalancer_Health_Check, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_LoadBalancer", "getHealthCheck", nil, &r.Options, &resp)
 	return
 }
 
+// GetHealthCheckWith is GetHealthCheck, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_LoadBalancer) GetHealthCheckWith(options sl.Options) (resp datatypes.Network_Application_Delivery_Controller_LoadBalancer_Health_Check, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHealthCheck()
+}
+
 // no documentation yet
 func (r Scale_LoadBalancer) GetObject() (resp datatypes.Scale_LoadBalancer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_LoadBalancer", "getObject", nil, &r.Options, &resp)
@@ -569,36 +843,84 @@ func (r Scale_LoadBalancer) GetRoutingMethod() (resp datatypes.Network_Applicati
 	return
 }
 
+// GetRoutingMethodWith is GetRoutingMethod, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_LoadBalancer) GetRoutingMethodWith(options sl.Options) (resp datatypes.Network_Application_Delivery_Controller_LoadBalancer_Routing_Method, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRoutingMethod()
+}
+
 // Retrieve The routing type.
 func (r Scale_LoadBalancer) GetRoutingType() (resp datatypes.Network_Application_Delivery_Controller_LoadBalancer_Routing_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_LoadBalancer", "getRoutingType", nil, &r.Options, &resp)
 	return
 }
 
+// GetRoutingTypeWith is GetRoutingType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_LoadBalancer) GetRoutingTypeWith(options sl.Options) (resp datatypes.Network_Application_Delivery_Controller_LoadBalancer_Routing_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRoutingType()
+}
+
 // Retrieve The group this load balancer configuration is for.
 func (r Scale_LoadBalancer) GetScaleGroup() (resp datatypes.Scale_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_LoadBalancer", "getScaleGroup", nil, &r.Options, &resp)
 	return
 }
 
+// GetScaleGroupWith is GetScaleGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_LoadBalancer) GetScaleGroupWith(options sl.Options) (resp datatypes.Scale_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleGroup()
+}
+
 // Retrieve The ID of the virtual IP address.
 func (r Scale_LoadBalancer) GetVirtualIpAddressId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_LoadBalancer", "getVirtualIpAddressId", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualIpAddressIdWith is GetVirtualIpAddressId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_LoadBalancer) GetVirtualIpAddressIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualIpAddressId()
+}
+
 // Retrieve The virtual server for this configuration.
 func (r Scale_LoadBalancer) GetVirtualServer() (resp datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualServer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_LoadBalancer", "getVirtualServer", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualServerWith is GetVirtualServer, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_LoadBalancer) GetVirtualServerWith(options sl.Options) (resp datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualServer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualServer()
+}
+
 // Retrieve The port on the virtual server.
 func (r Scale_LoadBalancer) GetVirtualServerPort() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_LoadBalancer", "getVirtualServerPort", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualServerPortWith is GetVirtualServerPort, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_LoadBalancer) GetVirtualServerPortWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualServerPort()
+}
+
 // no documentation yet
 type Scale_Member struct {
 	Session *session.Session
@@ -615,6 +937,11 @@ func (r Scale_Member) Id(id int) Scale_Member {
 	return r
 }
 
+func (r Scale_Member) InitParameter(name string, value interface{}) Scale_Member {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Member) Mask(mask string) Scale_Member {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -634,11 +961,26 @@ func (r Scale_Member) Limit(limit int) Scale_Member {
 	return r
 }
 
+func (r Scale_Member) Unlimited() Scale_Member {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Member) Offset(offset int) Scale_Member {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Member) Timeout(timeout time.Duration) Scale_Member {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Member) WithContext(ctx context.Context) Scale_Member {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Member) DeleteObject() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Member", "deleteObject", nil, &r.Options, &resp)
@@ -657,6 +999,14 @@ func (r Scale_Member) GetScaleGroup() (resp datatypes.Scale_Group, err error) {
 	return
 }
 
+// GetScaleGroupWith is GetScaleGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Member) GetScaleGroupWith(options sl.Options) (resp datatypes.Scale_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleGroup()
+}
+
 // no documentation yet
 type Scale_Member_Virtual_Guest struct {
 	Session *session.Session
@@ -673,6 +1023,11 @@ func (r Scale_Member_Virtual_Guest) Id(id int) Scale_Member_Virtual_Guest {
 	return r
 }
 
+func (r Scale_Member_Virtual_Guest) InitParameter(name string, value interface{}) Scale_Member_Virtual_Guest {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Member_Virtual_Guest) Mask(mask string) Scale_Member_Virtual_Guest {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -692,11 +1047,26 @@ func (r Scale_Member_Virtual_Guest) Limit(limit int) Scale_Member_Virtual_Guest
 	return r
 }
 
+func (r Scale_Member_Virtual_Guest) Unlimited() Scale_Member_Virtual_Guest {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Member_Virtual_Guest) Offset(offset int) Scale_Member_Virtual_Guest {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Member_Virtual_Guest) Timeout(timeout time.Duration) Scale_Member_Virtual_Guest {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Member_Virtual_Guest) WithContext(ctx context.Context) Scale_Member_Virtual_Guest {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Delete this group member. Note, this can only be done on an active group when it wont cause the group to go below its minimumMemberCount. This is not the recommended way to delete members. Instead, users should invoke scale(-1) on SoftLayer_Scale_Group so it can choose the best guest member to remove.
 func (r Scale_Member_Virtual_Guest) DeleteObject() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Member_Virtual_Guest", "deleteObject", nil, &r.Options, &resp)
@@ -715,18 +1085,42 @@ func (r Scale_Member_Virtual_Guest) GetScaleGroup() (resp datatypes.Scale_Group,
 	return
 }
 
+// GetScaleGroupWith is GetScaleGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Member_Virtual_Guest) GetScaleGroupWith(options sl.Options) (resp datatypes.Scale_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleGroup()
+}
+
 // Retrieve The guest for this member.
 func (r Scale_Member_Virtual_Guest) GetVirtualGuest() (resp datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Member_Virtual_Guest", "getVirtualGuest", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestWith is GetVirtualGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Member_Virtual_Guest) GetVirtualGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuest()
+}
+
 // Retrieve The identifier of the guest for this member.
 func (r Scale_Member_Virtual_Guest) GetVirtualGuestId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Member_Virtual_Guest", "getVirtualGuestId", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestIdWith is GetVirtualGuestId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Member_Virtual_Guest) GetVirtualGuestIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestId()
+}
+
 // no documentation yet
 type Scale_Network_Vlan struct {
 	Session *session.Session
@@ -743,6 +1137,11 @@ func (r Scale_Network_Vlan) Id(id int) Scale_Network_Vlan {
 	return r
 }
 
+func (r Scale_Network_Vlan) InitParameter(name string, value interface{}) Scale_Network_Vlan {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Network_Vlan) Mask(mask string) Scale_Network_Vlan {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -762,11 +1161,26 @@ func (r Scale_Network_Vlan) Limit(limit int) Scale_Network_Vlan {
 	return r
 }
 
+func (r Scale_Network_Vlan) Unlimited() Scale_Network_Vlan {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Network_Vlan) Offset(offset int) Scale_Network_Vlan {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Network_Vlan) Timeout(timeout time.Duration) Scale_Network_Vlan {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Network_Vlan) WithContext(ctx context.Context) Scale_Network_Vlan {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Network_Vlan) CreateObject(templateObject *datatypes.Scale_Network_Vlan) (resp datatypes.Scale_Network_Vlan, err error) {
 	params := []interface{}{
@@ -788,6 +1202,14 @@ func (r Scale_Network_Vlan) GetNetworkVlan() (resp datatypes.Network_Vlan, err e
 	return
 }
 
+// GetNetworkVlanWith is GetNetworkVlan, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Network_Vlan) GetNetworkVlanWith(options sl.Options) (resp datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlan()
+}
+
 // no documentation yet
 func (r Scale_Network_Vlan) GetObject() (resp datatypes.Scale_Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Network_Vlan", "getObject", nil, &r.Options, &resp)
@@ -800,6 +1222,14 @@ func (r Scale_Network_Vlan) GetScaleGroup() (resp datatypes.Scale_Group, err err
 	return
 }
 
+// GetScaleGroupWith is GetScaleGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Network_Vlan) GetScaleGroupWith(options sl.Options) (resp datatypes.Scale_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleGroup()
+}
+
 // no documentation yet
 type Scale_Policy struct {
 	Session *session.Session
@@ -816,6 +1246,11 @@ func (r Scale_Policy) Id(id int) Scale_Policy {
 	return r
 }
 
+func (r Scale_Policy) InitParameter(name string, value interface{}) Scale_Policy {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Policy) Mask(mask string) Scale_Policy {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -835,11 +1270,26 @@ func (r Scale_Policy) Limit(limit int) Scale_Policy {
 	return r
 }
 
+func (r Scale_Policy) Unlimited() Scale_Policy {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Policy) Offset(offset int) Scale_Policy {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Policy) Timeout(timeout time.Duration) Scale_Policy {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Policy) WithContext(ctx context.Context) Scale_Policy {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Policy) CreateObject(templateObject *datatypes.Scale_Policy) (resp datatypes.Scale_Policy, err error) {
 	params := []interface{}{
@@ -870,6 +1320,14 @@ func (r Scale_Policy) GetActions() (resp []datatypes.Scale_Policy_Action, err er
 	return
 }
 
+// GetActionsWith is GetActions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy) GetActionsWith(options sl.Options) (resp []datatypes.Scale_Policy_Action, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActions()
+}
+
 // no documentation yet
 func (r Scale_Policy) GetObject() (resp datatypes.Scale_Policy, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy", "getObject", nil, &r.Options, &resp)
@@ -882,36 +1340,84 @@ func (r Scale_Policy) GetOneTimeTriggers() (resp []datatypes.Scale_Policy_Trigge
 	return
 }
 
+// GetOneTimeTriggersWith is GetOneTimeTriggers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy) GetOneTimeTriggersWith(options sl.Options) (resp []datatypes.Scale_Policy_Trigger_OneTime, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOneTimeTriggers()
+}
+
 // Retrieve The repeating triggers to check for this group.
 func (r Scale_Policy) GetRepeatingTriggers() (resp []datatypes.Scale_Policy_Trigger_Repeating, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy", "getRepeatingTriggers", nil, &r.Options, &resp)
 	return
 }
 
+// GetRepeatingTriggersWith is GetRepeatingTriggers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy) GetRepeatingTriggersWith(options sl.Options) (resp []datatypes.Scale_Policy_Trigger_Repeating, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRepeatingTriggers()
+}
+
 // Retrieve The resource-use triggers to check for this group.
 func (r Scale_Policy) GetResourceUseTriggers() (resp []datatypes.Scale_Policy_Trigger_ResourceUse, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy", "getResourceUseTriggers", nil, &r.Options, &resp)
 	return
 }
 
+// GetResourceUseTriggersWith is GetResourceUseTriggers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy) GetResourceUseTriggersWith(options sl.Options) (resp []datatypes.Scale_Policy_Trigger_ResourceUse, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetResourceUseTriggers()
+}
+
 // Retrieve The scale actions to perform upon any trigger hit. Currently this must be a single value.
 func (r Scale_Policy) GetScaleActions() (resp []datatypes.Scale_Policy_Action_Scale, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy", "getScaleActions", nil, &r.Options, &resp)
 	return
 }
 
+// GetScaleActionsWith is GetScaleActions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy) GetScaleActionsWith(options sl.Options) (resp []datatypes.Scale_Policy_Action_Scale, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleActions()
+}
+
 // Retrieve The group this policy is on.
 func (r Scale_Policy) GetScaleGroup() (resp datatypes.Scale_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy", "getScaleGroup", nil, &r.Options, &resp)
 	return
 }
 
+// GetScaleGroupWith is GetScaleGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy) GetScaleGroupWith(options sl.Options) (resp datatypes.Scale_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleGroup()
+}
+
 // Retrieve The triggers to check for this group.
 func (r Scale_Policy) GetTriggers() (resp []datatypes.Scale_Policy_Trigger, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy", "getTriggers", nil, &r.Options, &resp)
 	return
 }
 
+// GetTriggersWith is GetTriggers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy) GetTriggersWith(options sl.Options) (resp []datatypes.Scale_Policy_Trigger, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTriggers()
+}
+
 // Manually trigger the actions on this policy. Returns members if the trigger has an effect, or an empty set of members if there is no effect. Sometimes this may not have an effect if the group is not active, in cooldown, or the result would violate the group range. If this call fails, the group is suspended, the failure logged, and a ticket is created.
 func (r Scale_Policy) Trigger() (resp []datatypes.Scale_Member, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy", "trigger", nil, &r.Options, &resp)
@@ -934,6 +1440,11 @@ func (r Scale_Policy_Action) Id(id int) Scale_Policy_Action {
 	return r
 }
 
+func (r Scale_Policy_Action) InitParameter(name string, value interface{}) Scale_Policy_Action {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Policy_Action) Mask(mask string) Scale_Policy_Action {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -953,11 +1464,26 @@ func (r Scale_Policy_Action) Limit(limit int) Scale_Policy_Action {
 	return r
 }
 
+func (r Scale_Policy_Action) Unlimited() Scale_Policy_Action {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Policy_Action) Offset(offset int) Scale_Policy_Action {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Policy_Action) Timeout(timeout time.Duration) Scale_Policy_Action {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Policy_Action) WithContext(ctx context.Context) Scale_Policy_Action {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Policy_Action) DeleteObject() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy_Action", "deleteObject", nil, &r.Options, &resp)
@@ -985,12 +1511,28 @@ func (r Scale_Policy_Action) GetScalePolicy() (resp datatypes.Scale_Policy, err
 	return
 }
 
+// GetScalePolicyWith is GetScalePolicy, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy_Action) GetScalePolicyWith(options sl.Options) (resp datatypes.Scale_Policy, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScalePolicy()
+}
+
 // Retrieve The type of action.
 func (r Scale_Policy_Action) GetType() (resp datatypes.Scale_Policy_Action_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy_Action", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy_Action) GetTypeWith(options sl.Options) (resp datatypes.Scale_Policy_Action_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // no documentation yet
 type Scale_Policy_Action_Scale struct {
 	Session *session.Session
@@ -1007,6 +1549,11 @@ func (r Scale_Policy_Action_Scale) Id(id int) Scale_Policy_Action_Scale {
 	return r
 }
 
+func (r Scale_Policy_Action_Scale) InitParameter(name string, value interface{}) Scale_Policy_Action_Scale {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Policy_Action_Scale) Mask(mask string) Scale_Policy_Action_Scale {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1026,11 +1573,26 @@ func (r Scale_Policy_Action_Scale) Limit(limit int) Scale_Policy_Action_Scale {
 	return r
 }
 
+func (r Scale_Policy_Action_Scale) Unlimited() Scale_Policy_Action_Scale {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Policy_Action_Scale) Offset(offset int) Scale_Policy_Action_Scale {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Policy_Action_Scale) Timeout(timeout time.Duration) Scale_Policy_Action_Scale {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Policy_Action_Scale) WithContext(ctx context.Context) Scale_Policy_Action_Scale {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Policy_Action_Scale) CreateObject(templateObject *datatypes.Scale_Policy_Action_Scale) (resp datatypes.Scale_Policy_Action_Scale, err error) {
 	params := []interface{}{
@@ -1067,12 +1629,28 @@ func (r Scale_Policy_Action_Scale) GetScalePolicy() (resp datatypes.Scale_Policy
 	return
 }
 
+// GetScalePolicyWith is GetScalePolicy, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy_Action_Scale) GetScalePolicyWith(options sl.Options) (resp datatypes.Scale_Policy, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScalePolicy()
+}
+
 // Retrieve The type of action.
 func (r Scale_Policy_Action_Scale) GetType() (resp datatypes.Scale_Policy_Action_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy_Action_Scale", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy_Action_Scale) GetTypeWith(options sl.Options) (resp datatypes.Scale_Policy_Action_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // no documentation yet
 type Scale_Policy_Action_Type struct {
 	Session *session.Session
@@ -1089,6 +1667,11 @@ func (r Scale_Policy_Action_Type) Id(id int) Scale_Policy_Action_Type {
 	return r
 }
 
+func (r Scale_Policy_Action_Type) InitParameter(name string, value interface{}) Scale_Policy_Action_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Policy_Action_Type) Mask(mask string) Scale_Policy_Action_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1108,11 +1691,26 @@ func (r Scale_Policy_Action_Type) Limit(limit int) Scale_Policy_Action_Type {
 	return r
 }
 
+func (r Scale_Policy_Action_Type) Unlimited() Scale_Policy_Action_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Policy_Action_Type) Offset(offset int) Scale_Policy_Action_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Policy_Action_Type) Timeout(timeout time.Duration) Scale_Policy_Action_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Policy_Action_Type) WithContext(ctx context.Context) Scale_Policy_Action_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Policy_Action_Type) GetAllObjects() (resp []datatypes.Scale_Policy_Action_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy_Action_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -1141,6 +1739,11 @@ func (r Scale_Policy_Trigger) Id(id int) Scale_Policy_Trigger {
 	return r
 }
 
+func (r Scale_Policy_Trigger) InitParameter(name string, value interface{}) Scale_Policy_Trigger {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Policy_Trigger) Mask(mask string) Scale_Policy_Trigger {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1160,11 +1763,26 @@ func (r Scale_Policy_Trigger) Limit(limit int) Scale_Policy_Trigger {
 	return r
 }
 
+func (r Scale_Policy_Trigger) Unlimited() Scale_Policy_Trigger {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Policy_Trigger) Offset(offset int) Scale_Policy_Trigger {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Policy_Trigger) Timeout(timeout time.Duration) Scale_Policy_Trigger {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Policy_Trigger) WithContext(ctx context.Context) Scale_Policy_Trigger {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Policy_Trigger) CreateObject(templateObject *datatypes.Scale_Policy_Trigger) (resp datatypes.Scale_Policy_Trigger, err error) {
 	params := []interface{}{
@@ -1201,12 +1819,28 @@ func (r Scale_Policy_Trigger) GetScalePolicy() (resp datatypes.Scale_Policy, err
 	return
 }
 
+// GetScalePolicyWith is GetScalePolicy, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy_Trigger) GetScalePolicyWith(options sl.Options) (resp datatypes.Scale_Policy, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScalePolicy()
+}
+
 // Retrieve The type of trigger.
 func (r Scale_Policy_Trigger) GetType() (resp datatypes.Scale_Policy_Trigger_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy_Trigger", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy_Trigger) GetTypeWith(options sl.Options) (resp datatypes.Scale_Policy_Trigger_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // no documentation yet
 type Scale_Policy_Trigger_OneTime struct {
 	Session *session.Session
@@ -1223,6 +1857,11 @@ func (r Scale_Policy_Trigger_OneTime) Id(id int) Scale_Policy_Trigger_OneTime {
 	return r
 }
 
+func (r Scale_Policy_Trigger_OneTime) InitParameter(name string, value interface{}) Scale_Policy_Trigger_OneTime {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Policy_Trigger_OneTime) Mask(mask string) Scale_Policy_Trigger_OneTime {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1242,11 +1881,26 @@ func (r Scale_Policy_Trigger_OneTime) Limit(limit int) Scale_Policy_Trigger_OneT
 	return r
 }
 
+func (r Scale_Policy_Trigger_OneTime) Unlimited() Scale_Policy_Trigger_OneTime {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Policy_Trigger_OneTime) Offset(offset int) Scale_Policy_Trigger_OneTime {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Policy_Trigger_OneTime) Timeout(timeout time.Duration) Scale_Policy_Trigger_OneTime {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Policy_Trigger_OneTime) WithContext(ctx context.Context) Scale_Policy_Trigger_OneTime {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Policy_Trigger_OneTime) CreateObject(templateObject *datatypes.Scale_Policy_Trigger_OneTime) (resp datatypes.Scale_Policy_Trigger_OneTime, err error) {
 	params := []interface{}{
@@ -1283,12 +1937,28 @@ func (r Scale_Policy_Trigger_OneTime) GetScalePolicy() (resp datatypes.Scale_Pol
 	return
 }
 
+// GetScalePolicyWith is GetScalePolicy, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy_Trigger_OneTime) GetScalePolicyWith(options sl.Options) (resp datatypes.Scale_Policy, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScalePolicy()
+}
+
 // Retrieve The type of trigger.
 func (r Scale_Policy_Trigger_OneTime) GetType() (resp datatypes.Scale_Policy_Trigger_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy_Trigger_OneTime", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy_Trigger_OneTime) GetTypeWith(options sl.Options) (resp datatypes.Scale_Policy_Trigger_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // no documentation yet
 type Scale_Policy_Trigger_Repeating struct {
 	Session *session.Session
@@ -1305,6 +1975,11 @@ func (r Scale_Policy_Trigger_Repeating) Id(id int) Scale_Policy_Trigger_Repeatin
 	return r
 }
 
+func (r Scale_Policy_Trigger_Repeating) InitParameter(name string, value interface{}) Scale_Policy_Trigger_Repeating {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Policy_Trigger_Repeating) Mask(mask string) Scale_Policy_Trigger_Repeating {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1324,11 +1999,26 @@ func (r Scale_Policy_Trigger_Repeating) Limit(limit int) Scale_Policy_Trigger_Re
 	return r
 }
 
+func (r Scale_Policy_Trigger_Repeating) Unlimited() Scale_Policy_Trigger_Repeating {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Policy_Trigger_Repeating) Offset(offset int) Scale_Policy_Trigger_Repeating {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Policy_Trigger_Repeating) Timeout(timeout time.Duration) Scale_Policy_Trigger_Repeating {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Policy_Trigger_Repeating) WithContext(ctx context.Context) Scale_Policy_Trigger_Repeating {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Policy_Trigger_Repeating) CreateObject(templateObject *datatypes.Scale_Policy_Trigger_Repeating) (resp datatypes.Scale_Policy_Trigger_Repeating, err error) {
 	params := []interface{}{
@@ -1365,12 +2055,28 @@ func (r Scale_Policy_Trigger_Repeating) GetScalePolicy() (resp datatypes.Scale_P
 	return
 }
 
+// GetScalePolicyWith is GetScalePolicy, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy_Trigger_Repeating) GetScalePolicyWith(options sl.Options) (resp datatypes.Scale_Policy, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScalePolicy()
+}
+
 // Retrieve The type of trigger.
 func (r Scale_Policy_Trigger_Repeating) GetType() (resp datatypes.Scale_Policy_Trigger_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy_Trigger_Repeating", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy_Trigger_Repeating) GetTypeWith(options sl.Options) (resp datatypes.Scale_Policy_Trigger_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // no documentation yet
 func (r Scale_Policy_Trigger_Repeating) ValidateCronExpression(expression *string) (err error) {
 	var resp datatypes.Void
@@ -1397,6 +2103,11 @@ func (r Scale_Policy_Trigger_ResourceUse) Id(id int) Scale_Policy_Trigger_Resour
 	return r
 }
 
+func (r Scale_Policy_Trigger_ResourceUse) InitParameter(name string, value interface{}) Scale_Policy_Trigger_ResourceUse {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Policy_Trigger_ResourceUse) Mask(mask string) Scale_Policy_Trigger_ResourceUse {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1416,11 +2127,26 @@ func (r Scale_Policy_Trigger_ResourceUse) Limit(limit int) Scale_Policy_Trigger_
 	return r
 }
 
+func (r Scale_Policy_Trigger_ResourceUse) Unlimited() Scale_Policy_Trigger_ResourceUse {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Policy_Trigger_ResourceUse) Offset(offset int) Scale_Policy_Trigger_ResourceUse {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Policy_Trigger_ResourceUse) Timeout(timeout time.Duration) Scale_Policy_Trigger_ResourceUse {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Policy_Trigger_ResourceUse) WithContext(ctx context.Context) Scale_Policy_Trigger_ResourceUse {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Policy_Trigger_ResourceUse) CreateObject(templateObject *datatypes.Scale_Policy_Trigger_ResourceUse) (resp datatypes.Scale_Policy_Trigger_ResourceUse, err error) {
 	params := []interface{}{
@@ -1457,18 +2183,42 @@ func (r Scale_Policy_Trigger_ResourceUse) GetScalePolicy() (resp datatypes.Scale
 	return
 }
 
+// GetScalePolicyWith is GetScalePolicy, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy_Trigger_ResourceUse) GetScalePolicyWith(options sl.Options) (resp datatypes.Scale_Policy, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScalePolicy()
+}
+
 // Retrieve The type of trigger.
 func (r Scale_Policy_Trigger_ResourceUse) GetType() (resp datatypes.Scale_Policy_Trigger_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy_Trigger_ResourceUse", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy_Trigger_ResourceUse) GetTypeWith(options sl.Options) (resp datatypes.Scale_Policy_Trigger_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Retrieve The resource watches for this trigger.
 func (r Scale_Policy_Trigger_ResourceUse) GetWatches() (resp []datatypes.Scale_Policy_Trigger_ResourceUse_Watch, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy_Trigger_ResourceUse", "getWatches", nil, &r.Options, &resp)
 	return
 }
 
+// GetWatchesWith is GetWatches, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy_Trigger_ResourceUse) GetWatchesWith(options sl.Options) (resp []datatypes.Scale_Policy_Trigger_ResourceUse_Watch, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetWatches()
+}
+
 // no documentation yet
 type Scale_Policy_Trigger_ResourceUse_Watch struct {
 	Session *session.Session
@@ -1485,6 +2235,11 @@ func (r Scale_Policy_Trigger_ResourceUse_Watch) Id(id int) Scale_Policy_Trigger_
 	return r
 }
 
+func (r Scale_Policy_Trigger_ResourceUse_Watch) InitParameter(name string, value interface{}) Scale_Policy_Trigger_ResourceUse_Watch {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Policy_Trigger_ResourceUse_Watch) Mask(mask string) Scale_Policy_Trigger_ResourceUse_Watch {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1504,11 +2259,26 @@ func (r Scale_Policy_Trigger_ResourceUse_Watch) Limit(limit int) Scale_Policy_Tr
 	return r
 }
 
+func (r Scale_Policy_Trigger_ResourceUse_Watch) Unlimited() Scale_Policy_Trigger_ResourceUse_Watch {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Policy_Trigger_ResourceUse_Watch) Offset(offset int) Scale_Policy_Trigger_ResourceUse_Watch {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Policy_Trigger_ResourceUse_Watch) Timeout(timeout time.Duration) Scale_Policy_Trigger_ResourceUse_Watch {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Policy_Trigger_ResourceUse_Watch) WithContext(ctx context.Context) Scale_Policy_Trigger_ResourceUse_Watch {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Policy_Trigger_ResourceUse_Watch) CreateObject(templateObject *datatypes.Scale_Policy_Trigger_ResourceUse_Watch) (resp datatypes.Scale_Policy_Trigger_ResourceUse_Watch, err error) {
 	params := []interface{}{
@@ -1563,6 +2333,14 @@ func (r Scale_Policy_Trigger_ResourceUse_Watch) GetScalePolicyTrigger() (resp da
 	return
 }
 
+// GetScalePolicyTriggerWith is GetScalePolicyTrigger, with the mask, filter and result limit of options applied to
+// this call only
+func (r Scale_Policy_Trigger_ResourceUse_Watch) GetScalePolicyTriggerWith(options sl.Options) (resp datatypes.Scale_Policy_Trigger_ResourceUse, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScalePolicyTrigger()
+}
+
 // no documentation yet
 type Scale_Policy_Trigger_Type struct {
 	Session *session.Session
@@ -1579,6 +2357,11 @@ func (r Scale_Policy_Trigger_Type) Id(id int) Scale_Policy_Trigger_Type {
 	return r
 }
 
+func (r Scale_Policy_Trigger_Type) InitParameter(name string, value interface{}) Scale_Policy_Trigger_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Policy_Trigger_Type) Mask(mask string) Scale_Policy_Trigger_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1598,11 +2381,26 @@ func (r Scale_Policy_Trigger_Type) Limit(limit int) Scale_Policy_Trigger_Type {
 	return r
 }
 
+func (r Scale_Policy_Trigger_Type) Unlimited() Scale_Policy_Trigger_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Policy_Trigger_Type) Offset(offset int) Scale_Policy_Trigger_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Policy_Trigger_Type) Timeout(timeout time.Duration) Scale_Policy_Trigger_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Policy_Trigger_Type) WithContext(ctx context.Context) Scale_Policy_Trigger_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Policy_Trigger_Type) GetAllObjects() (resp []datatypes.Scale_Policy_Trigger_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Policy_Trigger_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -1631,6 +2429,11 @@ func (r Scale_Termination_Policy) Id(id int) Scale_Termination_Policy {
 	return r
 }
 
+func (r Scale_Termination_Policy) InitParameter(name string, value interface{}) Scale_Termination_Policy {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Scale_Termination_Policy) Mask(mask string) Scale_Termination_Policy {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1650,11 +2453,26 @@ func (r Scale_Termination_Policy) Limit(limit int) Scale_Termination_Policy {
 	return r
 }
 
+func (r Scale_Termination_Policy) Unlimited() Scale_Termination_Policy {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Scale_Termination_Policy) Offset(offset int) Scale_Termination_Policy {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Scale_Termination_Policy) Timeout(timeout time.Duration) Scale_Termination_Policy {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Scale_Termination_Policy) WithContext(ctx context.Context) Scale_Termination_Policy {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Scale_Termination_Policy) GetAllObjects() (resp []datatypes.Scale_Termination_Policy, err error) {
 	err = r.Session.DoRequest("SoftLayer_Scale_Termination_Policy", "getAllObjects", nil, &r.Options, &resp)
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Search) Id(id int) Search {
 	return r
 }
 
+func (r Search) InitParameter(name string, value interface{}) Search {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Search) Mask(mask string) Search {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Search) Limit(limit int) Search {
 	return r
 }
 
+func (r Search) Unlimited() Search {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Search) Offset(offset int) Search {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Search) Timeout(timeout time.Duration) Search {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Search) WithContext(ctx context.Context) Search {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method allows for searching for SoftLayer resources by simple terms and operators.  Fields that are used for searching will be available at sldn.softlayer.com. It returns a collection or array of <b>[[SoftLayer_Container_Search_Result (type)|SoftLayer_Container_Search_Result]]</b> objects that have search metadata for each result and the resulting resource found.
 //
 // The advancedSearch() method recognizes the special <b><code>_objectType:</code></b> quantifier in search strings.  See the documentation for the <b>[[SoftLayer_Search/search|search()]]</b> method on how to restrict searches using object types.
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Security_Certificate) Id(id int) Security_Certificate {
 	return r
 }
 
+func (r Security_Certificate) InitParameter(name string, value interface{}) Security_Certificate {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Security_Certificate) Mask(mask string) Security_Certificate {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Security_Certificate) Limit(limit int) Security_Certificate {
 	return r
 }
 
+func (r Security_Certificate) Unlimited() Security_Certificate {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Security_Certificate) Offset(offset int) Security_Certificate {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Security_Certificate) Timeout(timeout time.Duration) Security_Certificate {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Security_Certificate) WithContext(ctx context.Context) Security_Certificate {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Add a certificate to your account for your records, or for use with various services. Only the certificate and private key are usually required. If your issuer provided an intermediate certificate, you must also provide that certificate. Details will be extracted from the certificate. Validation will be performed between the certificate and the private key as well as the certificate and the intermediate certificate, if provided.
 //
 // The certificate signing request is not required, but can be provided for your records.
@@ -110,12 +132,28 @@ func (r Security_Certificate) GetAssociatedServiceCount() (resp int, err error)
 	return
 }
 
+// GetAssociatedServiceCountWith is GetAssociatedServiceCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Security_Certificate) GetAssociatedServiceCountWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssociatedServiceCount()
+}
+
 // Retrieve The load balancers virtual IP addresses currently associated with the certificate.
 func (r Security_Certificate) GetLoadBalancerVirtualIpAddresses() (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Security_Certificate", "getLoadBalancerVirtualIpAddresses", nil, &r.Options, &resp)
 	return
 }
 
+// GetLoadBalancerVirtualIpAddressesWith is GetLoadBalancerVirtualIpAddresses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Security_Certificate) GetLoadBalancerVirtualIpAddressesWith(options sl.Options) (resp []datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLoadBalancerVirtualIpAddresses()
+}
+
 // no documentation yet
 func (r Security_Certificate) GetObject() (resp datatypes.Security_Certificate, err error) {
 	err = r.Session.DoRequest("SoftLayer_Security_Certificate", "getObject", nil, &r.Options, &resp)
@@ -144,6 +182,11 @@ func (r Security_Certificate_Request) Id(id int) Security_Certificate_Request {
 	return r
 }
 
+func (r Security_Certificate_Request) InitParameter(name string, value interface{}) Security_Certificate_Request {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Security_Certificate_Request) Mask(mask string) Security_Certificate_Request {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -163,11 +206,26 @@ func (r Security_Certificate_Request) Limit(limit int) Security_Certificate_Requ
 	return r
 }
 
+func (r Security_Certificate_Request) Unlimited() Security_Certificate_Request {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Security_Certificate_Request) Offset(offset int) Security_Certificate_Request {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Security_Certificate_Request) Timeout(timeout time.Duration) Security_Certificate_Request {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Security_Certificate_Request) WithContext(ctx context.Context) Security_Certificate_Request {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Cancels a pending SSL certificate order at the Certificate Authority
 func (r Security_Certificate_Request) CancelSslOrder() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Security_Certificate_Request", "cancelSslOrder", nil, &r.Options, &resp)
@@ -180,6 +238,14 @@ func (r Security_Certificate_Request) GetAccount() (resp datatypes.Account, err
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Security_Certificate_Request) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Gets the email domains that can be used to validate a certificate to a domain.
 func (r Security_Certificate_Request) GetAdministratorEmailDomains(commonName *string) (resp []string, err error) {
 	params := []interface{}{
@@ -201,6 +267,14 @@ func (r Security_Certificate_Request) GetCertificateAuthorityName() (resp string
 	return
 }
 
+// GetCertificateAuthorityNameWith is GetCertificateAuthorityName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Security_Certificate_Request) GetCertificateAuthorityNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCertificateAuthorityName()
+}
+
 // no documentation yet
 func (r Security_Certificate_Request) GetObject() (resp datatypes.Security_Certificate_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Security_Certificate_Request", "getObject", nil, &r.Options, &resp)
@@ -213,12 +287,28 @@ func (r Security_Certificate_Request) GetOrder() (resp datatypes.Billing_Order,
 	return
 }
 
+// GetOrderWith is GetOrder, with the mask, filter and result limit of options applied to
+// this call only
+func (r Security_Certificate_Request) GetOrderWith(options sl.Options) (resp datatypes.Billing_Order, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrder()
+}
+
 // Retrieve The associated order item for this SSL certificate request.
 func (r Security_Certificate_Request) GetOrderItem() (resp datatypes.Billing_Order_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Security_Certificate_Request", "getOrderItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetOrderItemWith is GetOrderItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Security_Certificate_Request) GetOrderItemWith(options sl.Options) (resp datatypes.Billing_Order_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderItem()
+}
+
 // Returns previous SSL certificate order data. You can use this data for to place a renewal order for a completed SSL certificate.
 func (r Security_Certificate_Request) GetPreviousOrderData() (resp datatypes.Container_Product_Order_Security_Certificate, err error) {
 	err = r.Session.DoRequest("SoftLayer_Security_Certificate_Request", "getPreviousOrderData", nil, &r.Options, &resp)
@@ -240,6 +330,14 @@ func (r Security_Certificate_Request) GetStatus() (resp datatypes.Security_Certi
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Security_Certificate_Request) GetStatusWith(options sl.Options) (resp datatypes.Security_Certificate_Request_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // A Certificate Authority sends out various emails to your domain administrator or your technical contact. Use this service to have these emails re-sent.
 func (r Security_Certificate_Request) ResendEmail(emailType *string) (resp bool, err error) {
 	params := []interface{}{
@@ -279,6 +377,11 @@ func (r Security_Certificate_Request_ServerType) Id(id int) Security_Certificate
 	return r
 }
 
+func (r Security_Certificate_Request_ServerType) InitParameter(name string, value interface{}) Security_Certificate_Request_ServerType {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Security_Certificate_Request_ServerType) Mask(mask string) Security_Certificate_Request_ServerType {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -298,11 +401,26 @@ func (r Security_Certificate_Request_ServerType) Limit(limit int) Security_Certi
 	return r
 }
 
+func (r Security_Certificate_Request_ServerType) Unlimited() Security_Certificate_Request_ServerType {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Security_Certificate_Request_ServerType) Offset(offset int) Security_Certificate_Request_ServerType {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Security_Certificate_Request_ServerType) Timeout(timeout time.Duration) Security_Certificate_Request_ServerType {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Security_Certificate_Request_ServerType) WithContext(ctx context.Context) Security_Certificate_Request_ServerType {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Returns all SSL certificate server types, which are passed in on a [[SoftLayer_Container_Product_Order_Security_Certificate|certificate order]].
 func (r Security_Certificate_Request_ServerType) GetAllObjects() (resp []datatypes.Security_Certificate_Request_ServerType, err error) {
 	err = r.Session.DoRequest("SoftLayer_Security_Certificate_Request_ServerType", "getAllObjects", nil, &r.Options, &resp)
@@ -331,6 +449,11 @@ func (r Security_Certificate_Request_Status) Id(id int) Security_Certificate_Req
 	return r
 }
 
+func (r Security_Certificate_Request_Status) InitParameter(name string, value interface{}) Security_Certificate_Request_Status {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Security_Certificate_Request_Status) Mask(mask string) Security_Certificate_Request_Status {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -350,11 +473,26 @@ func (r Security_Certificate_Request_Status) Limit(limit int) Security_Certifica
 	return r
 }
 
+func (r Security_Certificate_Request_Status) Unlimited() Security_Certificate_Request_Status {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Security_Certificate_Request_Status) Offset(offset int) Security_Certificate_Request_Status {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Security_Certificate_Request_Status) Timeout(timeout time.Duration) Security_Certificate_Request_Status {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Security_Certificate_Request_Status) WithContext(ctx context.Context) Security_Certificate_Request_Status {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Security_Certificate_Request_Status) GetObject() (resp datatypes.Security_Certificate_Request_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Security_Certificate_Request_Status", "getObject", nil, &r.Options, &resp)
@@ -383,6 +521,11 @@ func (r Security_Ssh_Key) Id(id int) Security_Ssh_Key {
 	return r
 }
 
+func (r Security_Ssh_Key) InitParameter(name string, value interface{}) Security_Ssh_Key {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Security_Ssh_Key) Mask(mask string) Security_Ssh_Key {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -402,11 +545,26 @@ func (r Security_Ssh_Key) Limit(limit int) Security_Ssh_Key {
 	return r
 }
 
+func (r Security_Ssh_Key) Unlimited() Security_Ssh_Key {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Security_Ssh_Key) Offset(offset int) Security_Ssh_Key {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Security_Ssh_Key) Timeout(timeout time.Duration) Security_Ssh_Key {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Security_Ssh_Key) WithContext(ctx context.Context) Security_Ssh_Key {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Add a ssh key to your account for use during server provisioning and os reloads.
 func (r Security_Ssh_Key) CreateObject(templateObject *datatypes.Security_Ssh_Key) (resp datatypes.Security_Ssh_Key, err error) {
 	params := []interface{}{
@@ -443,6 +601,14 @@ func (r Security_Ssh_Key) GetBlockDeviceTemplateGroups() (resp []datatypes.Virtu
 	return
 }
 
+// GetBlockDeviceTemplateGroupsWith is GetBlockDeviceTemplateGroups, with the mask, filter and result limit of options applied to
+// this call only
+func (r Security_Ssh_Key) GetBlockDeviceTemplateGroupsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockDeviceTemplateGroups()
+}
+
 // no documentation yet
 func (r Security_Ssh_Key) GetObject() (resp datatypes.Security_Ssh_Key, err error) {
 	err = r.Session.DoRequest("SoftLayer_Security_Ssh_Key", "getObject", nil, &r.Options, &resp)
@@ -454,3 +620,11 @@ func (r Security_Ssh_Key) GetSoftwarePasswords() (resp []datatypes.Software_Comp
 	err = r.Session.DoRequest("SoftLayer_Security_Ssh_Key", "getSoftwarePasswords", nil, &r.Options, &resp)
 	return
 }
+
+// GetSoftwarePasswordsWith is GetSoftwarePasswords, with the mask, filter and result limit of options applied to
+// this call only
+func (r Security_Ssh_Key) GetSoftwarePasswordsWith(options sl.Options) (resp []datatypes.Software_Component_Password, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwarePasswords()
+}
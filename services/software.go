@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Software_AccountLicense) Id(id int) Software_AccountLicense {
 	return r
 }
 
+func (r Software_AccountLicense) InitParameter(name string, value interface{}) Software_AccountLicense {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Software_AccountLicense) Mask(mask string) Software_AccountLicense {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,17 +71,40 @@ func (r Software_AccountLicense) Limit(limit int) Software_AccountLicense {
 	return r
 }
 
+func (r Software_AccountLicense) Unlimited() Software_AccountLicense {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Software_AccountLicense) Offset(offset int) Software_AccountLicense {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Software_AccountLicense) Timeout(timeout time.Duration) Software_AccountLicense {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Software_AccountLicense) WithContext(ctx context.Context) Software_AccountLicense {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The customer account this Account License belongs to.
 func (r Software_AccountLicense) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_AccountLicense", "getAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_AccountLicense) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // no documentation yet
 func (r Software_AccountLicense) GetAllObjects() (resp []datatypes.Software_AccountLicense, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_AccountLicense", "getAllObjects", nil, &r.Options, &resp)
@@ -87,6 +117,14 @@ func (r Software_AccountLicense) GetBillingItem() (resp datatypes.Billing_Item,
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_AccountLicense) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // no documentation yet
 func (r Software_AccountLicense) GetObject() (resp datatypes.Software_AccountLicense, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_AccountLicense", "getObject", nil, &r.Options, &resp)
@@ -99,6 +137,14 @@ func (r Software_AccountLicense) GetSoftwareDescription() (resp datatypes.Softwa
 	return
 }
 
+// GetSoftwareDescriptionWith is GetSoftwareDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_AccountLicense) GetSoftwareDescriptionWith(options sl.Options) (resp datatypes.Software_Description, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareDescription()
+}
+
 // A SoftLayer_Software_Component ties the installation of a specific piece of software onto a specific piece of hardware.
 //
 // SoftLayer_Software_Component works with SoftLayer_Software_License and SoftLayer_Software_Description to tie this all together.
@@ -119,6 +165,11 @@ func (r Software_Component) Id(id int) Software_Component {
 	return r
 }
 
+func (r Software_Component) InitParameter(name string, value interface{}) Software_Component {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Software_Component) Mask(mask string) Software_Component {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -138,29 +189,68 @@ func (r Software_Component) Limit(limit int) Software_Component {
 	return r
 }
 
+func (r Software_Component) Unlimited() Software_Component {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Software_Component) Offset(offset int) Software_Component {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Software_Component) Timeout(timeout time.Duration) Software_Component {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Software_Component) WithContext(ctx context.Context) Software_Component {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The average amount of time that a software component takes to install.
 func (r Software_Component) GetAverageInstallationDuration() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component", "getAverageInstallationDuration", nil, &r.Options, &resp)
 	return
 }
 
+// GetAverageInstallationDurationWith is GetAverageInstallationDuration, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component) GetAverageInstallationDurationWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageInstallationDuration()
+}
+
 // Retrieve The billing item for a software component.
 func (r Software_Component) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The hardware this Software Component is installed upon.
 func (r Software_Component) GetHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component", "getHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Attempt to retrieve the file associated with a software component.  If the software component does not support downloading license files an exception will be thrown.
 func (r Software_Component) GetLicenseFile() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component", "getLicenseFile", nil, &r.Options, &resp)
@@ -181,24 +271,56 @@ func (r Software_Component) GetPasswordHistory() (resp []datatypes.Software_Comp
 	return
 }
 
+// GetPasswordHistoryWith is GetPasswordHistory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component) GetPasswordHistoryWith(options sl.Options) (resp []datatypes.Software_Component_Password_History, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPasswordHistory()
+}
+
 // Retrieve Username/Password pairs used for access to this Software Installation.
 func (r Software_Component) GetPasswords() (resp []datatypes.Software_Component_Password, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component", "getPasswords", nil, &r.Options, &resp)
 	return
 }
 
+// GetPasswordsWith is GetPasswords, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component) GetPasswordsWith(options sl.Options) (resp []datatypes.Software_Component_Password, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPasswords()
+}
+
 // Retrieve The Software Description of this Software Component.
 func (r Software_Component) GetSoftwareDescription() (resp datatypes.Software_Description, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component", "getSoftwareDescription", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareDescriptionWith is GetSoftwareDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component) GetSoftwareDescriptionWith(options sl.Options) (resp datatypes.Software_Description, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareDescription()
+}
+
 // Retrieve The License this Software Component uses.
 func (r Software_Component) GetSoftwareLicense() (resp datatypes.Software_License, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component", "getSoftwareLicense", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareLicenseWith is GetSoftwareLicense, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component) GetSoftwareLicenseWith(options sl.Options) (resp datatypes.Software_License, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareLicense()
+}
+
 // no documentation yet
 func (r Software_Component) GetVendorSetUpConfiguration() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component", "getVendorSetUpConfiguration", nil, &r.Options, &resp)
@@ -211,6 +333,14 @@ func (r Software_Component) GetVirtualGuest() (resp datatypes.Virtual_Guest, err
 	return
 }
 
+// GetVirtualGuestWith is GetVirtualGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component) GetVirtualGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuest()
+}
+
 // This object specifies a specific type of Software Component:  An Anti-virus/spyware instance. Anti-virus/spyware installations have specific properties and methods such as SoftLayer_Software_Component_AntivirusSpyware::updateAntivirusSpywarePolicy. Defaults are initiated by this object.
 type Software_Component_AntivirusSpyware struct {
 	Session *session.Session
@@ -227,6 +357,11 @@ func (r Software_Component_AntivirusSpyware) Id(id int) Software_Component_Antiv
 	return r
 }
 
+func (r Software_Component_AntivirusSpyware) InitParameter(name string, value interface{}) Software_Component_AntivirusSpyware {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Software_Component_AntivirusSpyware) Mask(mask string) Software_Component_AntivirusSpyware {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -246,29 +381,68 @@ func (r Software_Component_AntivirusSpyware) Limit(limit int) Software_Component
 	return r
 }
 
+func (r Software_Component_AntivirusSpyware) Unlimited() Software_Component_AntivirusSpyware {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Software_Component_AntivirusSpyware) Offset(offset int) Software_Component_AntivirusSpyware {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Software_Component_AntivirusSpyware) Timeout(timeout time.Duration) Software_Component_AntivirusSpyware {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Software_Component_AntivirusSpyware) WithContext(ctx context.Context) Software_Component_AntivirusSpyware {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The average amount of time that a software component takes to install.
 func (r Software_Component_AntivirusSpyware) GetAverageInstallationDuration() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_AntivirusSpyware", "getAverageInstallationDuration", nil, &r.Options, &resp)
 	return
 }
 
+// GetAverageInstallationDurationWith is GetAverageInstallationDuration, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_AntivirusSpyware) GetAverageInstallationDurationWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageInstallationDuration()
+}
+
 // Retrieve The billing item for a software component.
 func (r Software_Component_AntivirusSpyware) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_AntivirusSpyware", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_AntivirusSpyware) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The hardware this Software Component is installed upon.
 func (r Software_Component_AntivirusSpyware) GetHardware() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_AntivirusSpyware", "getHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_AntivirusSpyware) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Attempt to retrieve the file associated with a software component.  If the software component does not support downloading license files an exception will be thrown.
 func (r Software_Component_AntivirusSpyware) GetLicenseFile() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_AntivirusSpyware", "getLicenseFile", nil, &r.Options, &resp)
@@ -287,24 +461,56 @@ func (r Software_Component_AntivirusSpyware) GetPasswordHistory() (resp []dataty
 	return
 }
 
+// GetPasswordHistoryWith is GetPasswordHistory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_AntivirusSpyware) GetPasswordHistoryWith(options sl.Options) (resp []datatypes.Software_Component_Password_History, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPasswordHistory()
+}
+
 // Retrieve Username/Password pairs used for access to this Software Installation.
 func (r Software_Component_AntivirusSpyware) GetPasswords() (resp []datatypes.Software_Component_Password, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_AntivirusSpyware", "getPasswords", nil, &r.Options, &resp)
 	return
 }
 
+// GetPasswordsWith is GetPasswords, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_AntivirusSpyware) GetPasswordsWith(options sl.Options) (resp []datatypes.Software_Component_Password, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPasswords()
+}
+
 // Retrieve The Software Description of this Software Component.
 func (r Software_Component_AntivirusSpyware) GetSoftwareDescription() (resp datatypes.Software_Description, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_AntivirusSpyware", "getSoftwareDescription", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareDescriptionWith is GetSoftwareDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_AntivirusSpyware) GetSoftwareDescriptionWith(options sl.Options) (resp datatypes.Software_Description, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareDescription()
+}
+
 // Retrieve The License this Software Component uses.
 func (r Software_Component_AntivirusSpyware) GetSoftwareLicense() (resp datatypes.Software_License, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_AntivirusSpyware", "getSoftwareLicense", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareLicenseWith is GetSoftwareLicense, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_AntivirusSpyware) GetSoftwareLicenseWith(options sl.Options) (resp datatypes.Software_License, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareLicense()
+}
+
 // no documentation yet
 func (r Software_Component_AntivirusSpyware) GetVendorSetUpConfiguration() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_AntivirusSpyware", "getVendorSetUpConfiguration", nil, &r.Options, &resp)
@@ -317,6 +523,14 @@ func (r Software_Component_AntivirusSpyware) GetVirtualGuest() (resp datatypes.V
 	return
 }
 
+// GetVirtualGuestWith is GetVirtualGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_AntivirusSpyware) GetVirtualGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuest()
+}
+
 // Update an anti-virus/spyware policy. The policy options that it accepts are the following:
 // *1 - Minimal
 // *2 - Relaxed
@@ -348,6 +562,11 @@ func (r Software_Component_HostIps) Id(id int) Software_Component_HostIps {
 	return r
 }
 
+func (r Software_Component_HostIps) InitParameter(name string, value interface{}) Software_Component_HostIps {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Software_Component_HostIps) Mask(mask string) Software_Component_HostIps {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -367,23 +586,54 @@ func (r Software_Component_HostIps) Limit(limit int) Software_Component_HostIps
 	return r
 }
 
+func (r Software_Component_HostIps) Unlimited() Software_Component_HostIps {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Software_Component_HostIps) Offset(offset int) Software_Component_HostIps {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Software_Component_HostIps) Timeout(timeout time.Duration) Software_Component_HostIps {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Software_Component_HostIps) WithContext(ctx context.Context) Software_Component_HostIps {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The average amount of time that a software component takes to install.
 func (r Software_Component_HostIps) GetAverageInstallationDuration() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_HostIps", "getAverageInstallationDuration", nil, &r.Options, &resp)
 	return
 }
 
+// GetAverageInstallationDurationWith is GetAverageInstallationDuration, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_HostIps) GetAverageInstallationDurationWith(options sl.Options) (resp uint, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageInstallationDuration()
+}
+
 // Retrieve The billing item for a software component.
 func (r Software_Component_HostIps) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_HostIps", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_HostIps) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Get the current Host IPS policies.
 func (r Software_Component_HostIps) GetCurrentHostIpsPolicies() (resp []datatypes.Container_Software_Component_HostIps_Policy, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_HostIps", "getCurrentHostIpsPolicies", nil, &r.Options, &resp)
@@ -396,6 +646,14 @@ func (r Software_Component_HostIps) GetHardware() (resp datatypes.Hardware, err
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_HostIps) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Attempt to retrieve the file associated with a software component.  If the software component does not support downloading license files an exception will be thrown.
 func (r Software_Component_HostIps) GetLicenseFile() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_HostIps", "getLicenseFile", nil, &r.Options, &resp)
@@ -414,24 +672,56 @@ func (r Software_Component_HostIps) GetPasswordHistory() (resp []datatypes.Softw
 	return
 }
 
+// GetPasswordHistoryWith is GetPasswordHistory, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_HostIps) GetPasswordHistoryWith(options sl.Options) (resp []datatypes.Software_Component_Password_History, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPasswordHistory()
+}
+
 // Retrieve Username/Password pairs used for access to this Software Installation.
 func (r Software_Component_HostIps) GetPasswords() (resp []datatypes.Software_Component_Password, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_HostIps", "getPasswords", nil, &r.Options, &resp)
 	return
 }
 
+// GetPasswordsWith is GetPasswords, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_HostIps) GetPasswordsWith(options sl.Options) (resp []datatypes.Software_Component_Password, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPasswords()
+}
+
 // Retrieve The Software Description of this Software Component.
 func (r Software_Component_HostIps) GetSoftwareDescription() (resp datatypes.Software_Description, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_HostIps", "getSoftwareDescription", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareDescriptionWith is GetSoftwareDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_HostIps) GetSoftwareDescriptionWith(options sl.Options) (resp datatypes.Software_Description, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareDescription()
+}
+
 // Retrieve The License this Software Component uses.
 func (r Software_Component_HostIps) GetSoftwareLicense() (resp datatypes.Software_License, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_HostIps", "getSoftwareLicense", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareLicenseWith is GetSoftwareLicense, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_HostIps) GetSoftwareLicenseWith(options sl.Options) (resp datatypes.Software_License, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareLicense()
+}
+
 // no documentation yet
 func (r Software_Component_HostIps) GetVendorSetUpConfiguration() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_HostIps", "getVendorSetUpConfiguration", nil, &r.Options, &resp)
@@ -444,6 +734,14 @@ func (r Software_Component_HostIps) GetVirtualGuest() (resp datatypes.Virtual_Gu
 	return
 }
 
+// GetVirtualGuestWith is GetVirtualGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_HostIps) GetVirtualGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuest()
+}
+
 // Update the Host IPS policies. To retrieve valid policy options you must use the provided relationships.
 func (r Software_Component_HostIps) UpdateHipsPolicies(newIpsMode *string, newIpsProtection *string, newFirewallMode *string, newFirewallRuleset *string, newApplicationMode *string, newApplicationRuleset *string, newEnforcementPolicy *string) (resp bool, err error) {
 	params := []interface{}{
@@ -475,6 +773,11 @@ func (r Software_Component_Password) Id(id int) Software_Component_Password {
 	return r
 }
 
+func (r Software_Component_Password) InitParameter(name string, value interface{}) Software_Component_Password {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Software_Component_Password) Mask(mask string) Software_Component_Password {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -494,11 +797,26 @@ func (r Software_Component_Password) Limit(limit int) Software_Component_Passwor
 	return r
 }
 
+func (r Software_Component_Password) Unlimited() Software_Component_Password {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Software_Component_Password) Offset(offset int) Software_Component_Password {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Software_Component_Password) Timeout(timeout time.Duration) Software_Component_Password {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Software_Component_Password) WithContext(ctx context.Context) Software_Component_Password {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a password for a software component.
 func (r Software_Component_Password) CreateObject(templateObject *datatypes.Software_Component_Password) (resp datatypes.Software_Component_Password, err error) {
 	params := []interface{}{
@@ -562,12 +880,28 @@ func (r Software_Component_Password) GetSoftware() (resp datatypes.Software_Comp
 	return
 }
 
+// GetSoftwareWith is GetSoftware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_Password) GetSoftwareWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftware()
+}
+
 // Retrieve SSH keys to be installed on the server during provisioning or an OS reload.
 func (r Software_Component_Password) GetSshKeys() (resp []datatypes.Security_Ssh_Key, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Component_Password", "getSshKeys", nil, &r.Options, &resp)
 	return
 }
 
+// GetSshKeysWith is GetSshKeys, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Component_Password) GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSshKeys()
+}
+
 // This class holds a description for a specific installation of a Software Component.
 //
 // SoftLayer_Software_Licenses tie a Software Component (A specific installation on a piece of hardware) to it's description.
@@ -588,6 +922,11 @@ func (r Software_Description) Id(id int) Software_Description {
 	return r
 }
 
+func (r Software_Description) InitParameter(name string, value interface{}) Software_Description {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Software_Description) Mask(mask string) Software_Description {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -607,11 +946,26 @@ func (r Software_Description) Limit(limit int) Software_Description {
 	return r
 }
 
+func (r Software_Description) Unlimited() Software_Description {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Software_Description) Offset(offset int) Software_Description {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Software_Description) Timeout(timeout time.Duration) Software_Description {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Software_Description) WithContext(ctx context.Context) Software_Description {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Software_Description) GetAllObjects() (resp []datatypes.Software_Description, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Description", "getAllObjects", nil, &r.Options, &resp)
@@ -630,12 +984,28 @@ func (r Software_Description) GetAverageInstallationDuration() (resp int, err er
 	return
 }
 
+// GetAverageInstallationDurationWith is GetAverageInstallationDuration, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Description) GetAverageInstallationDurationWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageInstallationDuration()
+}
+
 // Retrieve A list of the software descriptions that are compatible with this software description.
 func (r Software_Description) GetCompatibleSoftwareDescriptions() (resp []datatypes.Software_Description, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Description", "getCompatibleSoftwareDescriptions", nil, &r.Options, &resp)
 	return
 }
 
+// GetCompatibleSoftwareDescriptionsWith is GetCompatibleSoftwareDescriptions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Description) GetCompatibleSoftwareDescriptionsWith(options sl.Options) (resp []datatypes.Software_Description, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCompatibleSoftwareDescriptions()
+}
+
 // no documentation yet
 func (r Software_Description) GetCustomerOwnedLicenseDescriptions() (resp []datatypes.Software_Description, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Description", "getCustomerOwnedLicenseDescriptions", nil, &r.Options, &resp)
@@ -648,12 +1018,28 @@ func (r Software_Description) GetFeatures() (resp []datatypes.Software_Descripti
 	return
 }
 
+// GetFeaturesWith is GetFeatures, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Description) GetFeaturesWith(options sl.Options) (resp []datatypes.Software_Description_Feature, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFeatures()
+}
+
 // Retrieve The latest version of a software description.
 func (r Software_Description) GetLatestVersion() (resp []datatypes.Software_Description, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Description", "getLatestVersion", nil, &r.Options, &resp)
 	return
 }
 
+// GetLatestVersionWith is GetLatestVersion, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Description) GetLatestVersionWith(options sl.Options) (resp []datatypes.Software_Description, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestVersion()
+}
+
 // no documentation yet
 func (r Software_Description) GetObject() (resp datatypes.Software_Description, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Description", "getObject", nil, &r.Options, &resp)
@@ -666,42 +1052,98 @@ func (r Software_Description) GetProductItems() (resp []datatypes.Product_Item,
 	return
 }
 
+// GetProductItemsWith is GetProductItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Description) GetProductItemsWith(options sl.Options) (resp []datatypes.Product_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProductItems()
+}
+
 // Retrieve This details the provisioning transaction group for this software. This is only valid for Operating System software.
 func (r Software_Description) GetProvisionTransactionGroup() (resp datatypes.Provisioning_Version1_Transaction_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Description", "getProvisionTransactionGroup", nil, &r.Options, &resp)
 	return
 }
 
+// GetProvisionTransactionGroupWith is GetProvisionTransactionGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Description) GetProvisionTransactionGroupWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProvisionTransactionGroup()
+}
+
 // Retrieve The transaction group that a software description belongs to. A transaction group is a sequence of transactions that must be performed in a specific order for the installation of software.
 func (r Software_Description) GetReloadTransactionGroup() (resp datatypes.Provisioning_Version1_Transaction_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Description", "getReloadTransactionGroup", nil, &r.Options, &resp)
 	return
 }
 
+// GetReloadTransactionGroupWith is GetReloadTransactionGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Description) GetReloadTransactionGroupWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReloadTransactionGroup()
+}
+
 // Retrieve The default user created for a given a software description.
 func (r Software_Description) GetRequiredUser() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Description", "getRequiredUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetRequiredUserWith is GetRequiredUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Description) GetRequiredUserWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRequiredUser()
+}
+
 // Retrieve Software Licenses that govern this Software Description.
 func (r Software_Description) GetSoftwareLicenses() (resp []datatypes.Software_License, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Description", "getSoftwareLicenses", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareLicensesWith is GetSoftwareLicenses, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Description) GetSoftwareLicensesWith(options sl.Options) (resp []datatypes.Software_License, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareLicenses()
+}
+
 // Retrieve A suggestion for an upgrade path from this Software Description
 func (r Software_Description) GetUpgradeSoftwareDescription() (resp datatypes.Software_Description, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Description", "getUpgradeSoftwareDescription", nil, &r.Options, &resp)
 	return
 }
 
+// GetUpgradeSoftwareDescriptionWith is GetUpgradeSoftwareDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Description) GetUpgradeSoftwareDescriptionWith(options sl.Options) (resp datatypes.Software_Description, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeSoftwareDescription()
+}
+
 // Retrieve A suggestion for an upgrade path from this Software Description (Deprecated - Use upgradeSoftwareDescription)
 func (r Software_Description) GetUpgradeSwDesc() (resp datatypes.Software_Description, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Description", "getUpgradeSwDesc", nil, &r.Options, &resp)
 	return
 }
 
+// GetUpgradeSwDescWith is GetUpgradeSwDesc, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_Description) GetUpgradeSwDescWith(options sl.Options) (resp datatypes.Software_Description, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeSwDesc()
+}
+
 // Retrieve
 func (r Software_Description) GetValidFilesystemTypes() (resp []datatypes.Configuration_Storage_Filesystem_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_Description", "getValidFilesystemTypes", nil, &r.Options, &resp)
@@ -724,6 +1166,11 @@ func (r Software_VirtualLicense) Id(id int) Software_VirtualLicense {
 	return r
 }
 
+func (r Software_VirtualLicense) InitParameter(name string, value interface{}) Software_VirtualLicense {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Software_VirtualLicense) Mask(mask string) Software_VirtualLicense {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -743,35 +1190,82 @@ func (r Software_VirtualLicense) Limit(limit int) Software_VirtualLicense {
 	return r
 }
 
+func (r Software_VirtualLicense) Unlimited() Software_VirtualLicense {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Software_VirtualLicense) Offset(offset int) Software_VirtualLicense {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Software_VirtualLicense) Timeout(timeout time.Duration) Software_VirtualLicense {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Software_VirtualLicense) WithContext(ctx context.Context) Software_VirtualLicense {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The customer account this Virtual License belongs to.
 func (r Software_VirtualLicense) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_VirtualLicense", "getAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_VirtualLicense) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The billing item for a software virtual license.
 func (r Software_VirtualLicense) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_VirtualLicense", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_VirtualLicense) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The hardware record to which the software virtual license is assigned.
 func (r Software_VirtualLicense) GetHostHardware() (resp datatypes.Hardware_Server, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_VirtualLicense", "getHostHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetHostHardwareWith is GetHostHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_VirtualLicense) GetHostHardwareWith(options sl.Options) (resp datatypes.Hardware_Server, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHostHardware()
+}
+
 // Retrieve The IP Address record associated with a virtual license.
 func (r Software_VirtualLicense) GetIpAddressRecord() (resp datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_VirtualLicense", "getIpAddressRecord", nil, &r.Options, &resp)
 	return
 }
 
+// GetIpAddressRecordWith is GetIpAddressRecord, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_VirtualLicense) GetIpAddressRecordWith(options sl.Options) (resp datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIpAddressRecord()
+}
+
 // Attempt to retrieve the file associated with a virtual license, if such a file exists.  If there is no file for this virtual license, calling this method will either throw an exception or return false.
 func (r Software_VirtualLicense) GetLicenseFile() (resp []byte, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_VirtualLicense", "getLicenseFile", nil, &r.Options, &resp)
@@ -790,8 +1284,24 @@ func (r Software_VirtualLicense) GetSoftwareDescription() (resp datatypes.Softwa
 	return
 }
 
+// GetSoftwareDescriptionWith is GetSoftwareDescription, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_VirtualLicense) GetSoftwareDescriptionWith(options sl.Options) (resp datatypes.Software_Description, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareDescription()
+}
+
 // Retrieve The subnet this Virtual License's IP address belongs to.
 func (r Software_VirtualLicense) GetSubnet() (resp datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Software_VirtualLicense", "getSubnet", nil, &r.Options, &resp)
 	return
 }
+
+// GetSubnetWith is GetSubnet, with the mask, filter and result limit of options applied to
+// this call only
+func (r Software_VirtualLicense) GetSubnetWith(options sl.Options) (resp datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubnet()
+}
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Survey) Id(id int) Survey {
 	return r
 }
 
+func (r Survey) InitParameter(name string, value interface{}) Survey {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Survey) Mask(mask string) Survey {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Survey) Limit(limit int) Survey {
 	return r
 }
 
+func (r Survey) Unlimited() Survey {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Survey) Offset(offset int) Survey {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Survey) Timeout(timeout time.Duration) Survey {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Survey) WithContext(ctx context.Context) Survey {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Provides survey details for the given type
 func (r Survey) GetActiveSurveyByType(typ *string) (resp datatypes.Survey, err error) {
 	params := []interface{}{
@@ -90,18 +112,42 @@ func (r Survey) GetQuestions() (resp []datatypes.Survey_Question, err error) {
 	return
 }
 
+// GetQuestionsWith is GetQuestions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Survey) GetQuestionsWith(options sl.Options) (resp []datatypes.Survey_Question, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetQuestions()
+}
+
 // Retrieve The status of the survey
 func (r Survey) GetStatus() (resp datatypes.Survey_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Survey", "getStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Survey) GetStatusWith(options sl.Options) (resp datatypes.Survey_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // Retrieve The type of survey
 func (r Survey) GetType() (resp datatypes.Survey_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Survey", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Survey) GetTypeWith(options sl.Options) (resp datatypes.Survey_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Response to a SoftLayer survey's questions.
 func (r Survey) TakeSurvey(responses []datatypes.Survey_Response) (resp bool, err error) {
 	params := []interface{}{
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Tag) Id(id int) Tag {
 	return r
 }
 
+func (r Tag) InitParameter(name string, value interface{}) Tag {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Tag) Mask(mask string) Tag {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Tag) Limit(limit int) Tag {
 	return r
 }
 
+func (r Tag) Unlimited() Tag {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Tag) Offset(offset int) Tag {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Tag) Timeout(timeout time.Duration) Tag {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Tag) WithContext(ctx context.Context) Tag {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This function is responsible for setting the Tags values. The internal flag is set to 0 if the user is a customer, and 1 otherwise. AccountId is set to the account bound to the user, and the tags name is set to the clean version of the tag inputted by the user.
 func (r Tag) AutoComplete(tag *string) (resp []datatypes.Tag, err error) {
 	params := []interface{}{
@@ -93,6 +115,14 @@ func (r Tag) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Tag) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Returns all tags of a given object type.
 func (r Tag) GetAllTagTypes() (resp []datatypes.Tag_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Tag", "getAllTagTypes", nil, &r.Options, &resp)
@@ -111,6 +141,14 @@ func (r Tag) GetReferences() (resp []datatypes.Tag_Reference, err error) {
 	return
 }
 
+// GetReferencesWith is GetReferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Tag) GetReferencesWith(options sl.Options) (resp []datatypes.Tag_Reference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReferences()
+}
+
 // Returns the Tag object with a given name. The user types in the tag name and this method returns the tag with that name.
 func (r Tag) GetTagByTagName(tagList *string) (resp []datatypes.Tag, err error) {
 	params := []interface{}{
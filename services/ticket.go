@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -47,6 +49,11 @@ func (r Ticket) Id(id int) Ticket {
 	return r
 }
 
+func (r Ticket) InitParameter(name string, value interface{}) Ticket {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Ticket) Mask(mask string) Ticket {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -66,11 +73,26 @@ func (r Ticket) Limit(limit int) Ticket {
 	return r
 }
 
+func (r Ticket) Unlimited() Ticket {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Ticket) Offset(offset int) Ticket {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Ticket) Timeout(timeout time.Duration) Ticket {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Ticket) WithContext(ctx context.Context) Ticket {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 //
 //
 //
@@ -298,6 +320,14 @@ func (r Ticket) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // getAllTicketGroups() retrieves a list of all groups that a ticket may be assigned to. Ticket groups represent the internal department at SoftLayer who a ticket is assigned to.
 //
 // Every SoftLayer ticket has groupId and ticketGroup properties that correspond to one of the groups returned by getAllTicketGroups().
@@ -326,18 +356,42 @@ func (r Ticket) GetAssignedUser() (resp datatypes.User_Customer, err error) {
 	return
 }
 
+// GetAssignedUserWith is GetAssignedUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetAssignedUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAssignedUser()
+}
+
 // Retrieve The list of additional emails to notify when a ticket update is made.
 func (r Ticket) GetAttachedAdditionalEmails() (resp []datatypes.User_Customer_AdditionalEmail, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getAttachedAdditionalEmails", nil, &r.Options, &resp)
 	return
 }
 
+// GetAttachedAdditionalEmailsWith is GetAttachedAdditionalEmails, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetAttachedAdditionalEmailsWith(options sl.Options) (resp []datatypes.User_Customer_AdditionalEmail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttachedAdditionalEmails()
+}
+
 // Retrieve The Dedicated Hosts associated with a ticket. This is used in cases where a ticket is directly associated with one or more Dedicated Hosts.
 func (r Ticket) GetAttachedDedicatedHosts() (resp []datatypes.Virtual_DedicatedHost, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getAttachedDedicatedHosts", nil, &r.Options, &resp)
 	return
 }
 
+// GetAttachedDedicatedHostsWith is GetAttachedDedicatedHosts, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetAttachedDedicatedHostsWith(options sl.Options) (resp []datatypes.Virtual_DedicatedHost, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttachedDedicatedHosts()
+}
+
 // Retrieve the file attached to a SoftLayer ticket by it's given identifier. To retrieve a list of files attached to a ticket either call the SoftLayer_Ticket::getAttachedFiles method or call SoftLayer_Ticket::getObject with ''attachedFiles'' defined in an object mask.
 func (r Ticket) GetAttachedFile(attachmentId *int) (resp []byte, err error) {
 	params := []interface{}{
@@ -353,12 +407,28 @@ func (r Ticket) GetAttachedFiles() (resp []datatypes.Ticket_Attachment_File, err
 	return
 }
 
+// GetAttachedFilesWith is GetAttachedFiles, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetAttachedFilesWith(options sl.Options) (resp []datatypes.Ticket_Attachment_File, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttachedFiles()
+}
+
 // Retrieve The hardware associated with a ticket. This is used in cases where a ticket is directly associated with one or more pieces of hardware.
 func (r Ticket) GetAttachedHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getAttachedHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetAttachedHardwareWith is GetAttachedHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetAttachedHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttachedHardware()
+}
+
 // Retrieve
 func (r Ticket) GetAttachedHardwareCount() (resp uint, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getAttachedHardwareCount", nil, &r.Options, &resp)
@@ -377,18 +447,42 @@ func (r Ticket) GetAttachedVirtualGuests() (resp []datatypes.Virtual_Guest, err
 	return
 }
 
+// GetAttachedVirtualGuestsWith is GetAttachedVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetAttachedVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttachedVirtualGuests()
+}
+
 // Retrieve Ticket is waiting on a response from a customer flag.
 func (r Ticket) GetAwaitingUserResponseFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getAwaitingUserResponseFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetAwaitingUserResponseFlagWith is GetAwaitingUserResponseFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetAwaitingUserResponseFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAwaitingUserResponseFlag()
+}
+
 // Retrieve A service cancellation request.
 func (r Ticket) GetCancellationRequest() (resp datatypes.Billing_Item_Cancellation_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getCancellationRequest", nil, &r.Options, &resp)
 	return
 }
 
+// GetCancellationRequestWith is GetCancellationRequest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetCancellationRequestWith(options sl.Options) (resp datatypes.Billing_Item_Cancellation_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCancellationRequest()
+}
+
 // Retrieve
 func (r Ticket) GetEmployeeAttachments() (resp []datatypes.User_Employee, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getEmployeeAttachments", nil, &r.Options, &resp)
@@ -401,30 +495,70 @@ func (r Ticket) GetEuSupportedFlag() (resp bool, err error) {
 	return
 }
 
+// GetEuSupportedFlagWith is GetEuSupportedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetEuSupportedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEuSupportedFlag()
+}
+
 // Retrieve The first physical or virtual server attached to a ticket.
 func (r Ticket) GetFirstAttachedResource() (resp datatypes.Ticket_Attachment, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getFirstAttachedResource", nil, &r.Options, &resp)
 	return
 }
 
+// GetFirstAttachedResourceWith is GetFirstAttachedResource, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetFirstAttachedResourceWith(options sl.Options) (resp datatypes.Ticket_Attachment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirstAttachedResource()
+}
+
 // Retrieve The first update made to a ticket. This is typically the contents of a ticket when it's created.
 func (r Ticket) GetFirstUpdate() (resp datatypes.Ticket_Update, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getFirstUpdate", nil, &r.Options, &resp)
 	return
 }
 
+// GetFirstUpdateWith is GetFirstUpdate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetFirstUpdateWith(options sl.Options) (resp datatypes.Ticket_Update, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirstUpdate()
+}
+
 // Retrieve The SoftLayer department that a ticket is assigned to.
 func (r Ticket) GetGroup() (resp datatypes.Ticket_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getGroup", nil, &r.Options, &resp)
 	return
 }
 
+// GetGroupWith is GetGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetGroupWith(options sl.Options) (resp datatypes.Ticket_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGroup()
+}
+
 // Retrieve The invoice items associated with a ticket. Ticket based invoice items only exist when a ticket incurs a fee that has been invoiced.
 func (r Ticket) GetInvoiceItems() (resp []datatypes.Billing_Invoice_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getInvoiceItems", nil, &r.Options, &resp)
 	return
 }
 
+// GetInvoiceItemsWith is GetInvoiceItems, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetInvoiceItemsWith(options sl.Options) (resp []datatypes.Billing_Invoice_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInvoiceItems()
+}
+
 // Retrieve
 func (r Ticket) GetLastActivity() (resp datatypes.Ticket_Activity, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getLastActivity", nil, &r.Options, &resp)
@@ -443,24 +577,56 @@ func (r Ticket) GetLastUpdate() (resp datatypes.Ticket_Update, err error) {
 	return
 }
 
+// GetLastUpdateWith is GetLastUpdate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetLastUpdateWith(options sl.Options) (resp datatypes.Ticket_Update, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastUpdate()
+}
+
 // Retrieve A timestamp of the last time the Ticket was viewed by the active user.
 func (r Ticket) GetLastViewedDate() (resp datatypes.Time, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getLastViewedDate", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastViewedDateWith is GetLastViewedDate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetLastViewedDateWith(options sl.Options) (resp datatypes.Time, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastViewedDate()
+}
+
 // Retrieve A ticket's associated location within the SoftLayer location hierarchy.
 func (r Ticket) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve True if there are new, unread updates to this ticket for the current user, False otherwise.
 func (r Ticket) GetNewUpdatesFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getNewUpdatesFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetNewUpdatesFlagWith is GetNewUpdatesFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetNewUpdatesFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNewUpdatesFlag()
+}
+
 // getObject retrieves the SoftLayer_Ticket object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_Ticket service. You can only retrieve tickets that are associated with your SoftLayer customer account.
 func (r Ticket) GetObject() (resp datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getObject", nil, &r.Options, &resp)
@@ -479,12 +645,28 @@ func (r Ticket) GetServerAdministrationBillingInvoice() (resp datatypes.Billing_
 	return
 }
 
+// GetServerAdministrationBillingInvoiceWith is GetServerAdministrationBillingInvoice, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetServerAdministrationBillingInvoiceWith(options sl.Options) (resp datatypes.Billing_Invoice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServerAdministrationBillingInvoice()
+}
+
 // Retrieve The refund invoice associated with a ticket. Only tickets with a refund applied in them have an associated refund invoice.
 func (r Ticket) GetServerAdministrationRefundInvoice() (resp datatypes.Billing_Invoice, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getServerAdministrationRefundInvoice", nil, &r.Options, &resp)
 	return
 }
 
+// GetServerAdministrationRefundInvoiceWith is GetServerAdministrationRefundInvoice, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetServerAdministrationRefundInvoiceWith(options sl.Options) (resp datatypes.Billing_Invoice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServerAdministrationRefundInvoice()
+}
+
 // Retrieve
 func (r Ticket) GetServiceProvider() (resp datatypes.Service_Provider, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getServiceProvider", nil, &r.Options, &resp)
@@ -503,12 +685,28 @@ func (r Ticket) GetStatus() (resp datatypes.Ticket_Status, err error) {
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetStatusWith(options sl.Options) (resp datatypes.Ticket_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // Retrieve A ticket's subject. Only standard support tickets have an associated subject. A standard support ticket's title corresponds with it's subject's name.
 func (r Ticket) GetSubject() (resp datatypes.Ticket_Subject, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getSubject", nil, &r.Options, &resp)
 	return
 }
 
+// GetSubjectWith is GetSubject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetSubjectWith(options sl.Options) (resp datatypes.Ticket_Subject, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubject()
+}
+
 // Retrieve
 func (r Ticket) GetTagReferences() (resp []datatypes.Tag_Reference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getTagReferences", nil, &r.Options, &resp)
@@ -530,12 +728,28 @@ func (r Ticket) GetUpdateRatingFlag() (resp bool, err error) {
 	return
 }
 
+// GetUpdateRatingFlagWith is GetUpdateRatingFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetUpdateRatingFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpdateRatingFlag()
+}
+
 // Retrieve A ticket's updates.
 func (r Ticket) GetUpdates() (resp []datatypes.Ticket_Update, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket", "getUpdates", nil, &r.Options, &resp)
 	return
 }
 
+// GetUpdatesWith is GetUpdates, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket) GetUpdatesWith(options sl.Options) (resp []datatypes.Ticket_Update, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpdates()
+}
+
 // Mark a ticket as viewed.  All currently posted updates will be marked as viewed. The lastViewedDate property will be updated to the current time.
 func (r Ticket) MarkAsViewed() (err error) {
 	var resp datatypes.Void
@@ -636,6 +850,11 @@ func (r Ticket_Attachment_File) Id(id int) Ticket_Attachment_File {
 	return r
 }
 
+func (r Ticket_Attachment_File) InitParameter(name string, value interface{}) Ticket_Attachment_File {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Ticket_Attachment_File) Mask(mask string) Ticket_Attachment_File {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -655,11 +874,26 @@ func (r Ticket_Attachment_File) Limit(limit int) Ticket_Attachment_File {
 	return r
 }
 
+func (r Ticket_Attachment_File) Unlimited() Ticket_Attachment_File {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Ticket_Attachment_File) Offset(offset int) Ticket_Attachment_File {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Ticket_Attachment_File) Timeout(timeout time.Duration) Ticket_Attachment_File {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Ticket_Attachment_File) WithContext(ctx context.Context) Ticket_Attachment_File {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Ticket_Attachment_File) GetExtensionWhitelist() (resp []string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket_Attachment_File", "getExtensionWhitelist", nil, &r.Options, &resp)
@@ -684,6 +918,14 @@ func (r Ticket_Attachment_File) GetUpdate() (resp datatypes.Ticket_Update, err e
 	return
 }
 
+// GetUpdateWith is GetUpdate, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket_Attachment_File) GetUpdateWith(options sl.Options) (resp datatypes.Ticket_Update, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpdate()
+}
+
 // no documentation yet
 type Ticket_Priority struct {
 	Session *session.Session
@@ -700,6 +942,11 @@ func (r Ticket_Priority) Id(id int) Ticket_Priority {
 	return r
 }
 
+func (r Ticket_Priority) InitParameter(name string, value interface{}) Ticket_Priority {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Ticket_Priority) Mask(mask string) Ticket_Priority {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -719,11 +966,26 @@ func (r Ticket_Priority) Limit(limit int) Ticket_Priority {
 	return r
 }
 
+func (r Ticket_Priority) Unlimited() Ticket_Priority {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Ticket_Priority) Offset(offset int) Ticket_Priority {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Ticket_Priority) Timeout(timeout time.Duration) Ticket_Priority {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Ticket_Priority) WithContext(ctx context.Context) Ticket_Priority {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Ticket_Priority) GetPriorities() (resp []datatypes.Container_Ticket_Priority, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket_Priority", "getPriorities", nil, &r.Options, &resp)
@@ -746,6 +1008,11 @@ func (r Ticket_Subject) Id(id int) Ticket_Subject {
 	return r
 }
 
+func (r Ticket_Subject) InitParameter(name string, value interface{}) Ticket_Subject {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Ticket_Subject) Mask(mask string) Ticket_Subject {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -765,11 +1032,26 @@ func (r Ticket_Subject) Limit(limit int) Ticket_Subject {
 	return r
 }
 
+func (r Ticket_Subject) Unlimited() Ticket_Subject {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Ticket_Subject) Offset(offset int) Ticket_Subject {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Ticket_Subject) Timeout(timeout time.Duration) Ticket_Subject {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Ticket_Subject) WithContext(ctx context.Context) Ticket_Subject {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve all possible ticket subjects. The SoftLayer customer portal uses this method in the add standard support ticket form.
 func (r Ticket_Subject) GetAllObjects() (resp []datatypes.Ticket_Subject, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket_Subject", "getAllObjects", nil, &r.Options, &resp)
@@ -788,6 +1070,14 @@ func (r Ticket_Subject) GetChildren() (resp []datatypes.Ticket_Subject, err erro
 	return
 }
 
+// GetChildrenWith is GetChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket_Subject) GetChildrenWith(options sl.Options) (resp []datatypes.Ticket_Subject, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildren()
+}
+
 // Retrieve
 func (r Ticket_Subject) GetGroup() (resp datatypes.Ticket_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket_Subject", "getGroup", nil, &r.Options, &resp)
@@ -806,6 +1096,14 @@ func (r Ticket_Subject) GetParent() (resp datatypes.Ticket_Subject, err error) {
 	return
 }
 
+// GetParentWith is GetParent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Ticket_Subject) GetParentWith(options sl.Options) (resp datatypes.Ticket_Subject, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParent()
+}
+
 // SoftLayer maintains relationships between the generic subjects for standard administration and the top five commonly asked questions about these subjects. getTopFileKnowledgeLayerQuestions() retrieves the top five questions and answers from the SoftLayer KnowledgeLayer related to the given ticket subject.
 func (r Ticket_Subject) GetTopFiveKnowledgeLayerQuestions() (resp []datatypes.Container_KnowledgeLayer_QuestionAnswer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket_Subject", "getTopFiveKnowledgeLayerQuestions", nil, &r.Options, &resp)
@@ -828,6 +1126,11 @@ func (r Ticket_Subject_Category) Id(id int) Ticket_Subject_Category {
 	return r
 }
 
+func (r Ticket_Subject_Category) InitParameter(name string, value interface{}) Ticket_Subject_Category {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Ticket_Subject_Category) Mask(mask string) Ticket_Subject_Category {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -847,11 +1150,26 @@ func (r Ticket_Subject_Category) Limit(limit int) Ticket_Subject_Category {
 	return r
 }
 
+func (r Ticket_Subject_Category) Unlimited() Ticket_Subject_Category {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Ticket_Subject_Category) Offset(offset int) Ticket_Subject_Category {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Ticket_Subject_Category) Timeout(timeout time.Duration) Ticket_Subject_Category {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Ticket_Subject_Category) WithContext(ctx context.Context) Ticket_Subject_Category {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve all ticket subject categories.
 func (r Ticket_Subject_Category) GetAllObjects() (resp []datatypes.Ticket_Subject_Category, err error) {
 	err = r.Session.DoRequest("SoftLayer_Ticket_Subject_Category", "getAllObjects", nil, &r.Options, &resp)
@@ -886,6 +1204,11 @@ func (r Ticket_Survey) Id(id int) Ticket_Survey {
 	return r
 }
 
+func (r Ticket_Survey) InitParameter(name string, value interface{}) Ticket_Survey {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Ticket_Survey) Mask(mask string) Ticket_Survey {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -905,11 +1228,26 @@ func (r Ticket_Survey) Limit(limit int) Ticket_Survey {
 	return r
 }
 
+func (r Ticket_Survey) Unlimited() Ticket_Survey {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Ticket_Survey) Offset(offset int) Ticket_Survey {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Ticket_Survey) Timeout(timeout time.Duration) Ticket_Survey {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Ticket_Survey) WithContext(ctx context.Context) Ticket_Survey {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // (DEPRECATED) To opt in or out of future surveys, please follow the link found in the email survey.
 func (r Ticket_Survey) GetPreference() (err error) {
 	var resp datatypes.Void
@@ -947,6 +1285,11 @@ func (r Ticket_Update_Employee) Id(id int) Ticket_Update_Employee {
 	return r
 }
 
+func (r Ticket_Update_Employee) InitParameter(name string, value interface{}) Ticket_Update_Employee {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Ticket_Update_Employee) Mask(mask string) Ticket_Update_Employee {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -966,11 +1309,26 @@ func (r Ticket_Update_Employee) Limit(limit int) Ticket_Update_Employee {
 	return r
 }
 
+func (r Ticket_Update_Employee) Unlimited() Ticket_Update_Employee {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Ticket_Update_Employee) Offset(offset int) Ticket_Update_Employee {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Ticket_Update_Employee) Timeout(timeout time.Duration) Ticket_Update_Employee {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Ticket_Update_Employee) WithContext(ctx context.Context) Ticket_Update_Employee {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // As part of the customer service process SoftLayer has provided a quick feedback mechanism for its customers to rate the responses that its employees give on tickets. addResponseRating() sets the rating for a single ticket update made by a SoftLayer employee. Ticket ratings have the integer values 1 through 5, with 1 being the worst and 5 being the best. Once the rating is set ''addResponseRating()'' returns a boolean true.
 func (r Ticket_Update_Employee) AddResponseRating(responseRating *int, responseIndex *int) (resp bool, err error) {
 	params := []interface{}{
@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r User_Customer) Id(id int) User_Customer {
 	return r
 }
 
+func (r User_Customer) InitParameter(name string, value interface{}) User_Customer {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer) Mask(mask string) User_Customer {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r User_Customer) Limit(limit int) User_Customer {
 	return r
 }
 
+func (r User_Customer) Unlimited() User_Customer {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer) Offset(offset int) User_Customer {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer) Timeout(timeout time.Duration) User_Customer {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer) WithContext(ctx context.Context) User_Customer {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r User_Customer) AcknowledgeSupportPolicy() (err error) {
 	var resp datatypes.Void
@@ -347,6 +369,14 @@ func (r User_Customer) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve
 func (r User_Customer) GetActions() (resp []datatypes.User_Permission_Action, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getActions", nil, &r.Options, &resp)
@@ -365,6 +395,14 @@ func (r User_Customer) GetAdditionalEmails() (resp []datatypes.User_Customer_Add
 	return
 }
 
+// GetAdditionalEmailsWith is GetAdditionalEmails, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetAdditionalEmailsWith(options sl.Options) (resp []datatypes.User_Customer_AdditionalEmail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAdditionalEmails()
+}
+
 // no documentation yet
 func (r User_Customer) GetAllowedDedicatedHostIds() (resp []int, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getAllowedDedicatedHostIds", nil, &r.Options, &resp)
@@ -389,6 +427,14 @@ func (r User_Customer) GetApiAuthenticationKeys() (resp []datatypes.User_Custome
 	return
 }
 
+// GetApiAuthenticationKeysWith is GetApiAuthenticationKeys, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetApiAuthenticationKeysWith(options sl.Options) (resp []datatypes.User_Customer_ApiAuthentication, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetApiAuthenticationKeys()
+}
+
 // no documentation yet
 func (r User_Customer) GetAuthenticationToken(token *datatypes.Container_User_Authentication_Token) (resp datatypes.Container_User_Authentication_Token, err error) {
 	params := []interface{}{
@@ -404,24 +450,56 @@ func (r User_Customer) GetCdnAccounts() (resp []datatypes.Network_ContentDeliver
 	return
 }
 
+// GetCdnAccountsWith is GetCdnAccounts, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetCdnAccountsWith(options sl.Options) (resp []datatypes.Network_ContentDelivery_Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCdnAccounts()
+}
+
 // Retrieve A portal user's child users. Some portal users may not have child users.
 func (r User_Customer) GetChildUsers() (resp []datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getChildUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildUsersWith is GetChildUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetChildUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildUsers()
+}
+
 // Retrieve An user's associated closed tickets.
 func (r User_Customer) GetClosedTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getClosedTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetClosedTicketsWith is GetClosedTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetClosedTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetClosedTickets()
+}
+
 // Retrieve The dedicated hosts to which the user has been granted access.
 func (r User_Customer) GetDedicatedHosts() (resp []datatypes.Virtual_DedicatedHost, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getDedicatedHosts", nil, &r.Options, &resp)
 	return
 }
 
+// GetDedicatedHostsWith is GetDedicatedHosts, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetDedicatedHostsWith(options sl.Options) (resp []datatypes.Virtual_DedicatedHost, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDedicatedHosts()
+}
+
 // This API gets the default account for the OpenIdConnect identity that is linked to the current SoftLayer user identity. If there is no default present, the API returns null, except in the special case where we find one active user linked to the IBMid. In that case, we will set the link from the IBMid to that user as default, and return the account of which that user is a member. Invoke this only on IBMid-authenticated users.
 func (r User_Customer) GetDefaultAccount(providerType *string) (resp datatypes.Account, err error) {
 	params := []interface{}{
@@ -437,48 +515,112 @@ func (r User_Customer) GetExternalBindings() (resp []datatypes.User_External_Bin
 	return
 }
 
+// GetExternalBindingsWith is GetExternalBindings, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetExternalBindingsWith(options sl.Options) (resp []datatypes.User_External_Binding, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetExternalBindings()
+}
+
 // Retrieve A portal user's accessible hardware. These permissions control which hardware a user has access to in the SoftLayer customer portal.
 func (r User_Customer) GetHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve the number of servers that a portal user has access to. Portal users can have restrictions set to limit services for and to perform actions on hardware. You can set these permissions in the portal by clicking the "administrative" then "user admin" links.
 func (r User_Customer) GetHardwareCount() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getHardwareCount", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareCountWith is GetHardwareCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetHardwareCountWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareCount()
+}
+
 // Retrieve Hardware notifications associated with this user. A hardware notification links a user to a piece of hardware, and that user will be notified if any monitors on that hardware fail, if the monitors have a status of 'Notify User'.
 func (r User_Customer) GetHardwareNotifications() (resp []datatypes.User_Customer_Notification_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getHardwareNotifications", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareNotificationsWith is GetHardwareNotifications, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetHardwareNotificationsWith(options sl.Options) (resp []datatypes.User_Customer_Notification_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareNotifications()
+}
+
 // Retrieve Whether or not a user has acknowledged the support policy.
 func (r User_Customer) GetHasAcknowledgedSupportPolicyFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getHasAcknowledgedSupportPolicyFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasAcknowledgedSupportPolicyFlagWith is GetHasAcknowledgedSupportPolicyFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetHasAcknowledgedSupportPolicyFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasAcknowledgedSupportPolicyFlag()
+}
+
 // Retrieve Permission granting the user access to all Dedicated Host devices on the account.
 func (r User_Customer) GetHasFullDedicatedHostAccessFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getHasFullDedicatedHostAccessFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasFullDedicatedHostAccessFlagWith is GetHasFullDedicatedHostAccessFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetHasFullDedicatedHostAccessFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasFullDedicatedHostAccessFlag()
+}
+
 // Retrieve Whether or not a portal user has access to all hardware on their account.
 func (r User_Customer) GetHasFullHardwareAccessFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getHasFullHardwareAccessFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasFullHardwareAccessFlagWith is GetHasFullHardwareAccessFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetHasFullHardwareAccessFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasFullHardwareAccessFlag()
+}
+
 // Retrieve Whether or not a portal user has access to all hardware on their account.
 func (r User_Customer) GetHasFullVirtualGuestAccessFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getHasFullVirtualGuestAccessFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasFullVirtualGuestAccessFlagWith is GetHasFullVirtualGuestAccessFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetHasFullVirtualGuestAccessFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasFullVirtualGuestAccessFlag()
+}
+
 // Retrieve
 func (r User_Customer) GetIbmIdLink() (resp datatypes.User_Customer_Link, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getIbmIdLink", nil, &r.Options, &resp)
@@ -503,12 +645,28 @@ func (r User_Customer) GetLocale() (resp datatypes.Locale, err error) {
 	return
 }
 
+// GetLocaleWith is GetLocale, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetLocaleWith(options sl.Options) (resp datatypes.Locale, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocale()
+}
+
 // Retrieve A user's attempts to log into the SoftLayer customer portal.
 func (r User_Customer) GetLoginAttempts() (resp []datatypes.User_Customer_Access_Authentication, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getLoginAttempts", nil, &r.Options, &resp)
 	return
 }
 
+// GetLoginAttemptsWith is GetLoginAttempts, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetLoginAttemptsWith(options sl.Options) (resp []datatypes.User_Customer_Access_Authentication, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLoginAttempts()
+}
+
 // Attempt to authenticate a user to the SoftLayer customer portal using the provided authentication container. Depending on the specific type of authentication container that is used, this API will leverage the appropriate authentication protocol. If authentication is successful then the API returns a list of linked accounts for the user, a token containing the ID of the authenticated user and a hash key used by the SoftLayer customer portal to maintain authentication.
 func (r User_Customer) GetLoginToken(request *datatypes.Container_Authentication_Request_Contract) (resp datatypes.Container_Authentication_Response_Common, err error) {
 	params := []interface{}{
@@ -533,12 +691,28 @@ func (r User_Customer) GetMobileDevices() (resp []datatypes.User_Customer_Mobile
 	return
 }
 
+// GetMobileDevicesWith is GetMobileDevices, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetMobileDevicesWith(options sl.Options) (resp []datatypes.User_Customer_MobileDevice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMobileDevices()
+}
+
 // Retrieve Notification subscription records for the user.
 func (r User_Customer) GetNotificationSubscribers() (resp []datatypes.Notification_Subscriber, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getNotificationSubscribers", nil, &r.Options, &resp)
 	return
 }
 
+// GetNotificationSubscribersWith is GetNotificationSubscribers, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetNotificationSubscribersWith(options sl.Options) (resp []datatypes.Notification_Subscriber, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNotificationSubscribers()
+}
+
 // getObject retrieves the SoftLayer_User_Customer object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_User_Customer service. You can only retrieve users that are assigned to the customer account belonging to the user making the API call.
 func (r User_Customer) GetObject() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getObject", nil, &r.Options, &resp)
@@ -557,24 +731,56 @@ func (r User_Customer) GetOpenTickets() (resp []datatypes.Ticket, err error) {
 	return
 }
 
+// GetOpenTicketsWith is GetOpenTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetOpenTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenTickets()
+}
+
 // Retrieve A portal user's vpn accessible subnets.
 func (r User_Customer) GetOverrides() (resp []datatypes.Network_Service_Vpn_Overrides, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getOverrides", nil, &r.Options, &resp)
 	return
 }
 
+// GetOverridesWith is GetOverrides, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetOverridesWith(options sl.Options) (resp []datatypes.Network_Service_Vpn_Overrides, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOverrides()
+}
+
 // Retrieve A portal user's parent user. If a SoftLayer_User_Customer has a null parentId property then it doesn't have a parent user.
 func (r User_Customer) GetParent() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getParent", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentWith is GetParent, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetParentWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParent()
+}
+
 // Retrieve A portal user's permissions. These permissions control that user's access to functions within the SoftLayer customer portal and API.
 func (r User_Customer) GetPermissions() (resp []datatypes.User_Customer_CustomerPermission_Permission, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getPermissions", nil, &r.Options, &resp)
 	return
 }
 
+// GetPermissionsWith is GetPermissions, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetPermissionsWith(options sl.Options) (resp []datatypes.User_Customer_CustomerPermission_Permission, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPermissions()
+}
+
 // Attempt to authenticate a username and password to the SoftLayer customer portal. Many portal user accounts are configured to require answering a security question on login. In this case getPortalLoginToken() also verifies the given security question ID and answer. If authentication is successful then the API returns a token containing the ID of the authenticated user and a hash key used by the SoftLayer customer portal to maintain authentication.
 func (r User_Customer) GetPortalLoginToken(username *string, password *string, securityQuestionId *int, securityQuestionAnswer *string) (resp datatypes.Container_User_Customer_Portal_Token, err error) {
 	params := []interface{}{
@@ -635,24 +841,56 @@ func (r User_Customer) GetSecurityAnswers() (resp []datatypes.User_Customer_Secu
 	return
 }
 
+// GetSecurityAnswersWith is GetSecurityAnswers, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetSecurityAnswersWith(options sl.Options) (resp []datatypes.User_Customer_Security_Answer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityAnswers()
+}
+
 // Retrieve A user's notification subscription records.
 func (r User_Customer) GetSubscribers() (resp []datatypes.Notification_User_Subscriber, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getSubscribers", nil, &r.Options, &resp)
 	return
 }
 
+// GetSubscribersWith is GetSubscribers, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetSubscribersWith(options sl.Options) (resp []datatypes.Notification_User_Subscriber, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubscribers()
+}
+
 // Retrieve A user's successful attempts to log into the SoftLayer customer portal.
 func (r User_Customer) GetSuccessfulLogins() (resp []datatypes.User_Customer_Access_Authentication, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getSuccessfulLogins", nil, &r.Options, &resp)
 	return
 }
 
+// GetSuccessfulLoginsWith is GetSuccessfulLogins, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetSuccessfulLoginsWith(options sl.Options) (resp []datatypes.User_Customer_Access_Authentication, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSuccessfulLogins()
+}
+
 // Retrieve Whether or not a user is required to acknowledge the support policy for portal access.
 func (r User_Customer) GetSupportPolicyAcknowledgementRequiredFlag() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getSupportPolicyAcknowledgementRequiredFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetSupportPolicyAcknowledgementRequiredFlagWith is GetSupportPolicyAcknowledgementRequiredFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetSupportPolicyAcknowledgementRequiredFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSupportPolicyAcknowledgementRequiredFlag()
+}
+
 // no documentation yet
 func (r User_Customer) GetSupportPolicyDocument() (resp []byte, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getSupportPolicyDocument", nil, &r.Options, &resp)
@@ -677,30 +915,70 @@ func (r User_Customer) GetSurveyRequiredFlag() (resp bool, err error) {
 	return
 }
 
+// GetSurveyRequiredFlagWith is GetSurveyRequiredFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetSurveyRequiredFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSurveyRequiredFlag()
+}
+
 // Retrieve The surveys that a user has taken in the SoftLayer customer portal.
 func (r User_Customer) GetSurveys() (resp []datatypes.Survey, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getSurveys", nil, &r.Options, &resp)
 	return
 }
 
+// GetSurveysWith is GetSurveys, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetSurveysWith(options sl.Options) (resp []datatypes.Survey, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSurveys()
+}
+
 // Retrieve An user's associated tickets.
 func (r User_Customer) GetTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetTicketsWith is GetTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTickets()
+}
+
 // Retrieve A portal user's time zone.
 func (r User_Customer) GetTimezone() (resp datatypes.Locale_Timezone, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getTimezone", nil, &r.Options, &resp)
 	return
 }
 
+// GetTimezoneWith is GetTimezone, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetTimezoneWith(options sl.Options) (resp datatypes.Locale_Timezone, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTimezone()
+}
+
 // Retrieve A user's unsuccessful attempts to log into the SoftLayer customer portal.
 func (r User_Customer) GetUnsuccessfulLogins() (resp []datatypes.User_Customer_Access_Authentication, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getUnsuccessfulLogins", nil, &r.Options, &resp)
 	return
 }
 
+// GetUnsuccessfulLoginsWith is GetUnsuccessfulLogins, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetUnsuccessfulLoginsWith(options sl.Options) (resp []datatypes.User_Customer_Access_Authentication, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUnsuccessfulLogins()
+}
+
 // Retrieve a user object using a password token. When a new user is created or when a user has requested a password change using initiatePortalPasswordChange, they will have received an email that contains a url with a token.  That token is used as the parameter for getUserIdForPasswordSet.
 func (r User_Customer) GetUserIdForPasswordSet(key *string) (resp int, err error) {
 	params := []interface{}{
@@ -732,18 +1010,42 @@ func (r User_Customer) GetUserStatus() (resp datatypes.User_Customer_Status, err
 	return
 }
 
+// GetUserStatusWith is GetUserStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetUserStatusWith(options sl.Options) (resp datatypes.User_Customer_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUserStatus()
+}
+
 // Retrieve the number of CloudLayer Computing Instances that a portal user has access to. Portal users can have restrictions set to limit services for and to perform actions on CloudLayer Computing Instances. You can set these permissions in the portal by clicking the "administrative" then "user admin" links.
 func (r User_Customer) GetVirtualGuestCount() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getVirtualGuestCount", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestCountWith is GetVirtualGuestCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetVirtualGuestCountWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestCount()
+}
+
 // Retrieve A portal user's accessible CloudLayer Computing Instances. These permissions control which CloudLayer Computing Instances a user has access to in the SoftLayer customer portal.
 func (r User_Customer) GetVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "getVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWith is GetVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer) GetVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuests()
+}
+
 // no documentation yet
 func (r User_Customer) InTerminalStatus() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer", "inTerminalStatus", nil, &r.Options, &resp)
@@ -1178,6 +1480,11 @@ func (r User_Customer_ApiAuthentication) Id(id int) User_Customer_ApiAuthenticat
 	return r
 }
 
+func (r User_Customer_ApiAuthentication) InitParameter(name string, value interface{}) User_Customer_ApiAuthentication {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_ApiAuthentication) Mask(mask string) User_Customer_ApiAuthentication {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1197,11 +1504,26 @@ func (r User_Customer_ApiAuthentication) Limit(limit int) User_Customer_ApiAuthe
 	return r
 }
 
+func (r User_Customer_ApiAuthentication) Unlimited() User_Customer_ApiAuthentication {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_ApiAuthentication) Offset(offset int) User_Customer_ApiAuthentication {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_ApiAuthentication) Timeout(timeout time.Duration) User_Customer_ApiAuthentication {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_ApiAuthentication) WithContext(ctx context.Context) User_Customer_ApiAuthentication {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r User_Customer_ApiAuthentication) EditObject(templateObject *datatypes.User_Customer_ApiAuthentication) (resp datatypes.User_Customer_ApiAuthentication, err error) {
 	params := []interface{}{
@@ -1223,6 +1545,14 @@ func (r User_Customer_ApiAuthentication) GetUser() (resp datatypes.User_Customer
 	return
 }
 
+// GetUserWith is GetUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_ApiAuthentication) GetUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUser()
+}
+
 // Each SoftLayer portal account is assigned a series of permissions that determine what access the user has to functions within the SoftLayer customer portal. This status is reflected in the SoftLayer_User_Customer_Status data type. Permissions differ from user status in that user status applies globally to the portal while user permissions are applied to specific portal functions.
 type User_Customer_CustomerPermission_Permission struct {
 	Session *session.Session
@@ -1239,6 +1569,11 @@ func (r User_Customer_CustomerPermission_Permission) Id(id int) User_Customer_Cu
 	return r
 }
 
+func (r User_Customer_CustomerPermission_Permission) InitParameter(name string, value interface{}) User_Customer_CustomerPermission_Permission {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_CustomerPermission_Permission) Mask(mask string) User_Customer_CustomerPermission_Permission {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1258,11 +1593,26 @@ func (r User_Customer_CustomerPermission_Permission) Limit(limit int) User_Custo
 	return r
 }
 
+func (r User_Customer_CustomerPermission_Permission) Unlimited() User_Customer_CustomerPermission_Permission {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_CustomerPermission_Permission) Offset(offset int) User_Customer_CustomerPermission_Permission {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_CustomerPermission_Permission) Timeout(timeout time.Duration) User_Customer_CustomerPermission_Permission {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_CustomerPermission_Permission) WithContext(ctx context.Context) User_Customer_CustomerPermission_Permission {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve all available permissions.
 func (r User_Customer_CustomerPermission_Permission) GetAllObjects() (resp []datatypes.User_Customer_CustomerPermission_Permission, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_CustomerPermission_Permission", "getAllObjects", nil, &r.Options, &resp)
@@ -1291,6 +1641,11 @@ func (r User_Customer_External_Binding) Id(id int) User_Customer_External_Bindin
 	return r
 }
 
+func (r User_Customer_External_Binding) InitParameter(name string, value interface{}) User_Customer_External_Binding {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_External_Binding) Mask(mask string) User_Customer_External_Binding {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1310,11 +1665,26 @@ func (r User_Customer_External_Binding) Limit(limit int) User_Customer_External_
 	return r
 }
 
+func (r User_Customer_External_Binding) Unlimited() User_Customer_External_Binding {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_External_Binding) Offset(offset int) User_Customer_External_Binding {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_External_Binding) Timeout(timeout time.Duration) User_Customer_External_Binding {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_External_Binding) WithContext(ctx context.Context) User_Customer_External_Binding {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Delete an external authentication binding.  If the external binding currently has an active billing item associated you will be prevented from deleting the binding.  The alternative method to remove an external authentication binding is to use the service cancellation form.
 func (r User_Customer_External_Binding) DeleteObject() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding", "deleteObject", nil, &r.Options, &resp)
@@ -1350,18 +1720,42 @@ func (r User_Customer_External_Binding) GetAttributes() (resp []datatypes.User_E
 	return
 }
 
+// GetAttributesWith is GetAttributes, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding) GetAttributesWith(options sl.Options) (resp []datatypes.User_External_Binding_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+}
+
 // Retrieve Information regarding the billing item for external authentication.
 func (r User_Customer_External_Binding) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve An optional note for identifying the external binding.
 func (r User_Customer_External_Binding) GetNote() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding", "getNote", nil, &r.Options, &resp)
 	return
 }
 
+// GetNoteWith is GetNote, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding) GetNoteWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNote()
+}
+
 // no documentation yet
 func (r User_Customer_External_Binding) GetObject() (resp datatypes.User_Customer_External_Binding, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding", "getObject", nil, &r.Options, &resp)
@@ -1374,18 +1768,42 @@ func (r User_Customer_External_Binding) GetType() (resp datatypes.User_External_
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding) GetTypeWith(options sl.Options) (resp datatypes.User_External_Binding_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Retrieve The SoftLayer user that the external authentication binding belongs to.
 func (r User_Customer_External_Binding) GetUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding", "getUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserWith is GetUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding) GetUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUser()
+}
+
 // Retrieve The vendor of an external authentication binding.
 func (r User_Customer_External_Binding) GetVendor() (resp datatypes.User_External_Binding_Vendor, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding", "getVendor", nil, &r.Options, &resp)
 	return
 }
 
+// GetVendorWith is GetVendor, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding) GetVendorWith(options sl.Options) (resp datatypes.User_External_Binding_Vendor, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVendor()
+}
+
 // Update the note of an external binding.  The note is an optional property that is used to store information about a binding.
 func (r User_Customer_External_Binding) UpdateNote(text *string) (resp bool, err error) {
 	params := []interface{}{
@@ -1413,6 +1831,11 @@ func (r User_Customer_External_Binding_Phone) Id(id int) User_Customer_External_
 	return r
 }
 
+func (r User_Customer_External_Binding_Phone) InitParameter(name string, value interface{}) User_Customer_External_Binding_Phone {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_External_Binding_Phone) Mask(mask string) User_Customer_External_Binding_Phone {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1432,11 +1855,26 @@ func (r User_Customer_External_Binding_Phone) Limit(limit int) User_Customer_Ext
 	return r
 }
 
+func (r User_Customer_External_Binding_Phone) Unlimited() User_Customer_External_Binding_Phone {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_External_Binding_Phone) Offset(offset int) User_Customer_External_Binding_Phone {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_External_Binding_Phone) Timeout(timeout time.Duration) User_Customer_External_Binding_Phone {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_External_Binding_Phone) WithContext(ctx context.Context) User_Customer_External_Binding_Phone {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Return a phone validation result.
 func (r User_Customer_External_Binding_Phone) CheckPhoneValidationResult(token *string) (resp bool, err error) {
 	params := []interface{}{
@@ -1496,6 +1934,14 @@ func (r User_Customer_External_Binding_Phone) GetAttributes() (resp []datatypes.
 	return
 }
 
+// GetAttributesWith is GetAttributes, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Phone) GetAttributesWith(options sl.Options) (resp []datatypes.User_External_Binding_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+}
+
 // no documentation yet
 func (r User_Customer_External_Binding_Phone) GetAuthenticationMode() (resp datatypes.Container_User_Customer_External_Binding_Phone_Mode, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Phone", "getAuthenticationMode", nil, &r.Options, &resp)
@@ -1508,18 +1954,42 @@ func (r User_Customer_External_Binding_Phone) GetBillingItem() (resp datatypes.B
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Phone) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The current external binding status. It can be "ACTIVE" or "BLOCKED".
 func (r User_Customer_External_Binding_Phone) GetBindingStatus() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Phone", "getBindingStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetBindingStatusWith is GetBindingStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Phone) GetBindingStatusWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBindingStatus()
+}
+
 // Retrieve An optional note for identifying the external binding.
 func (r User_Customer_External_Binding_Phone) GetNote() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Phone", "getNote", nil, &r.Options, &resp)
 	return
 }
 
+// GetNoteWith is GetNote, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Phone) GetNoteWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNote()
+}
+
 // no documentation yet
 func (r User_Customer_External_Binding_Phone) GetObject() (resp datatypes.User_Customer_External_Binding_Phone, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Phone", "getObject", nil, &r.Options, &resp)
@@ -1550,18 +2020,42 @@ func (r User_Customer_External_Binding_Phone) GetType() (resp datatypes.User_Ext
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Phone) GetTypeWith(options sl.Options) (resp datatypes.User_External_Binding_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Retrieve The SoftLayer user that the external authentication binding belongs to.
 func (r User_Customer_External_Binding_Phone) GetUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Phone", "getUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserWith is GetUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Phone) GetUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUser()
+}
+
 // Retrieve The vendor of an external authentication binding.
 func (r User_Customer_External_Binding_Phone) GetVendor() (resp datatypes.User_External_Binding_Vendor, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Phone", "getVendor", nil, &r.Options, &resp)
 	return
 }
 
+// GetVendorWith is GetVendor, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Phone) GetVendorWith(options sl.Options) (resp datatypes.User_External_Binding_Vendor, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVendor()
+}
+
 // Initiates a phone validation requests and returns a unique token. Use [[SoftLayer_User_Customer_External_Binding_Phone::checkPhoneValidationResult|checkPhoneValidationResult]] to find the phone validation result.
 func (r User_Customer_External_Binding_Phone) RequestPhoneValidation(phoneData *datatypes.Container_User_Data_Phone) (resp string, err error) {
 	params := []interface{}{
@@ -1634,6 +2128,11 @@ func (r User_Customer_External_Binding_Totp) Id(id int) User_Customer_External_B
 	return r
 }
 
+func (r User_Customer_External_Binding_Totp) InitParameter(name string, value interface{}) User_Customer_External_Binding_Totp {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_External_Binding_Totp) Mask(mask string) User_Customer_External_Binding_Totp {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1653,11 +2152,26 @@ func (r User_Customer_External_Binding_Totp) Limit(limit int) User_Customer_Exte
 	return r
 }
 
+func (r User_Customer_External_Binding_Totp) Unlimited() User_Customer_External_Binding_Totp {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_External_Binding_Totp) Offset(offset int) User_Customer_External_Binding_Totp {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_External_Binding_Totp) Timeout(timeout time.Duration) User_Customer_External_Binding_Totp {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_External_Binding_Totp) WithContext(ctx context.Context) User_Customer_External_Binding_Totp {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r User_Customer_External_Binding_Totp) Activate() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Totp", "activate", nil, &r.Options, &resp)
@@ -1711,18 +2225,42 @@ func (r User_Customer_External_Binding_Totp) GetAttributes() (resp []datatypes.U
 	return
 }
 
+// GetAttributesWith is GetAttributes, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Totp) GetAttributesWith(options sl.Options) (resp []datatypes.User_External_Binding_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+}
+
 // Retrieve Information regarding the billing item for external authentication.
 func (r User_Customer_External_Binding_Totp) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Totp", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Totp) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve An optional note for identifying the external binding.
 func (r User_Customer_External_Binding_Totp) GetNote() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Totp", "getNote", nil, &r.Options, &resp)
 	return
 }
 
+// GetNoteWith is GetNote, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Totp) GetNoteWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNote()
+}
+
 // no documentation yet
 func (r User_Customer_External_Binding_Totp) GetObject() (resp datatypes.User_Customer_External_Binding_Totp, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Totp", "getObject", nil, &r.Options, &resp)
@@ -1735,18 +2273,42 @@ func (r User_Customer_External_Binding_Totp) GetType() (resp datatypes.User_Exte
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Totp) GetTypeWith(options sl.Options) (resp datatypes.User_External_Binding_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Retrieve The SoftLayer user that the external authentication binding belongs to.
 func (r User_Customer_External_Binding_Totp) GetUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Totp", "getUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserWith is GetUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Totp) GetUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUser()
+}
+
 // Retrieve The vendor of an external authentication binding.
 func (r User_Customer_External_Binding_Totp) GetVendor() (resp datatypes.User_External_Binding_Vendor, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Totp", "getVendor", nil, &r.Options, &resp)
 	return
 }
 
+// GetVendorWith is GetVendor, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Totp) GetVendorWith(options sl.Options) (resp datatypes.User_External_Binding_Vendor, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVendor()
+}
+
 // Update the note of an external binding.  The note is an optional property that is used to store information about a binding.
 func (r User_Customer_External_Binding_Totp) UpdateNote(text *string) (resp bool, err error) {
 	params := []interface{}{
@@ -1772,6 +2334,11 @@ func (r User_Customer_External_Binding_Vendor) Id(id int) User_Customer_External
 	return r
 }
 
+func (r User_Customer_External_Binding_Vendor) InitParameter(name string, value interface{}) User_Customer_External_Binding_Vendor {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_External_Binding_Vendor) Mask(mask string) User_Customer_External_Binding_Vendor {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1791,11 +2358,26 @@ func (r User_Customer_External_Binding_Vendor) Limit(limit int) User_Customer_Ex
 	return r
 }
 
+func (r User_Customer_External_Binding_Vendor) Unlimited() User_Customer_External_Binding_Vendor {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_External_Binding_Vendor) Offset(offset int) User_Customer_External_Binding_Vendor {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_External_Binding_Vendor) Timeout(timeout time.Duration) User_Customer_External_Binding_Vendor {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_External_Binding_Vendor) WithContext(ctx context.Context) User_Customer_External_Binding_Vendor {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // getAllObjects() will return a list of the available external binding vendors that SoftLayer supports.  Use this list to select the appropriate vendor when creating a new external binding.
 func (r User_Customer_External_Binding_Vendor) GetAllObjects() (resp []datatypes.User_External_Binding_Vendor, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Vendor", "getAllObjects", nil, &r.Options, &resp)
@@ -1836,6 +2418,11 @@ func (r User_Customer_External_Binding_Verisign) Id(id int) User_Customer_Extern
 	return r
 }
 
+func (r User_Customer_External_Binding_Verisign) InitParameter(name string, value interface{}) User_Customer_External_Binding_Verisign {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_External_Binding_Verisign) Mask(mask string) User_Customer_External_Binding_Verisign {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -1855,11 +2442,26 @@ func (r User_Customer_External_Binding_Verisign) Limit(limit int) User_Customer_
 	return r
 }
 
+func (r User_Customer_External_Binding_Verisign) Unlimited() User_Customer_External_Binding_Verisign {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_External_Binding_Verisign) Offset(offset int) User_Customer_External_Binding_Verisign {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_External_Binding_Verisign) Timeout(timeout time.Duration) User_Customer_External_Binding_Verisign {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_External_Binding_Verisign) WithContext(ctx context.Context) User_Customer_External_Binding_Verisign {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Delete a VeriSign external binding.  The only VeriSign external binding that can be deleted through this method is the free VeriSign external binding for the master user of a SoftLayer account. All other external bindings must be canceled using the SoftLayer service cancellation form.
 //
 // When a VeriSign external binding is deleted the credential is deactivated in VeriSign's system for use on the SoftLayer site and the $0 billing item associated with the free VeriSign external binding is cancelled.
@@ -1903,42 +2505,98 @@ func (r User_Customer_External_Binding_Verisign) GetAttributes() (resp []datatyp
 	return
 }
 
+// GetAttributesWith is GetAttributes, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Verisign) GetAttributesWith(options sl.Options) (resp []datatypes.User_External_Binding_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+}
+
 // Retrieve Information regarding the billing item for external authentication.
 func (r User_Customer_External_Binding_Verisign) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Verisign", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Verisign) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The date that a VeriSign credential expires.
 func (r User_Customer_External_Binding_Verisign) GetCredentialExpirationDate() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Verisign", "getCredentialExpirationDate", nil, &r.Options, &resp)
 	return
 }
 
+// GetCredentialExpirationDateWith is GetCredentialExpirationDate, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Verisign) GetCredentialExpirationDateWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCredentialExpirationDate()
+}
+
 // Retrieve The last time a VeriSign credential was updated.
 func (r User_Customer_External_Binding_Verisign) GetCredentialLastUpdateDate() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Verisign", "getCredentialLastUpdateDate", nil, &r.Options, &resp)
 	return
 }
 
+// GetCredentialLastUpdateDateWith is GetCredentialLastUpdateDate, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Verisign) GetCredentialLastUpdateDateWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCredentialLastUpdateDate()
+}
+
 // Retrieve The current state of a VeriSign credential. This can be 'Enabled', 'Disabled', or 'Locked'.
 func (r User_Customer_External_Binding_Verisign) GetCredentialState() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Verisign", "getCredentialState", nil, &r.Options, &resp)
 	return
 }
 
+// GetCredentialStateWith is GetCredentialState, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Verisign) GetCredentialStateWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCredentialState()
+}
+
 // Retrieve The type of VeriSign credential. This can be either 'Hardware' or 'Software'.
 func (r User_Customer_External_Binding_Verisign) GetCredentialType() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Verisign", "getCredentialType", nil, &r.Options, &resp)
 	return
 }
 
+// GetCredentialTypeWith is GetCredentialType, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Verisign) GetCredentialTypeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCredentialType()
+}
+
 // Retrieve An optional note for identifying the external binding.
 func (r User_Customer_External_Binding_Verisign) GetNote() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Verisign", "getNote", nil, &r.Options, &resp)
 	return
 }
 
+// GetNoteWith is GetNote, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Verisign) GetNoteWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNote()
+}
+
 // no documentation yet
 func (r User_Customer_External_Binding_Verisign) GetObject() (resp datatypes.User_Customer_External_Binding_Verisign, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Verisign", "getObject", nil, &r.Options, &resp)
@@ -1951,18 +2609,42 @@ func (r User_Customer_External_Binding_Verisign) GetType() (resp datatypes.User_
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Verisign) GetTypeWith(options sl.Options) (resp datatypes.User_External_Binding_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Retrieve The SoftLayer user that the external authentication binding belongs to.
 func (r User_Customer_External_Binding_Verisign) GetUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Verisign", "getUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserWith is GetUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Verisign) GetUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUser()
+}
+
 // Retrieve The vendor of an external authentication binding.
 func (r User_Customer_External_Binding_Verisign) GetVendor() (resp datatypes.User_External_Binding_Vendor, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_External_Binding_Verisign", "getVendor", nil, &r.Options, &resp)
 	return
 }
 
+// GetVendorWith is GetVendor, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_External_Binding_Verisign) GetVendorWith(options sl.Options) (resp datatypes.User_External_Binding_Vendor, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVendor()
+}
+
 // If a VeriSign credential becomes locked because of too many failed login attempts the unlock method can be used to unlock a VeriSign credential. As a security precaution a valid security code generated by the credential will be required before the credential is unlocked.
 func (r User_Customer_External_Binding_Verisign) Unlock(securityCode *string) (resp bool, err error) {
 	params := []interface{}{
@@ -2007,6 +2689,11 @@ func (r User_Customer_Invitation) Id(id int) User_Customer_Invitation {
 	return r
 }
 
+func (r User_Customer_Invitation) InitParameter(name string, value interface{}) User_Customer_Invitation {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_Invitation) Mask(mask string) User_Customer_Invitation {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2026,11 +2713,26 @@ func (r User_Customer_Invitation) Limit(limit int) User_Customer_Invitation {
 	return r
 }
 
+func (r User_Customer_Invitation) Unlimited() User_Customer_Invitation {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_Invitation) Offset(offset int) User_Customer_Invitation {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_Invitation) Timeout(timeout time.Duration) User_Customer_Invitation {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_Invitation) WithContext(ctx context.Context) User_Customer_Invitation {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r User_Customer_Invitation) GetObject() (resp datatypes.User_Customer_Invitation, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_Invitation", "getObject", nil, &r.Options, &resp)
@@ -2059,6 +2761,11 @@ func (r User_Customer_MobileDevice) Id(id int) User_Customer_MobileDevice {
 	return r
 }
 
+func (r User_Customer_MobileDevice) InitParameter(name string, value interface{}) User_Customer_MobileDevice {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_MobileDevice) Mask(mask string) User_Customer_MobileDevice {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2078,11 +2785,26 @@ func (r User_Customer_MobileDevice) Limit(limit int) User_Customer_MobileDevice
 	return r
 }
 
+func (r User_Customer_MobileDevice) Unlimited() User_Customer_MobileDevice {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_MobileDevice) Offset(offset int) User_Customer_MobileDevice {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_MobileDevice) Timeout(timeout time.Duration) User_Customer_MobileDevice {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_MobileDevice) WithContext(ctx context.Context) User_Customer_MobileDevice {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a new mobile device association for a user.
 func (r User_Customer_MobileDevice) CreateObject(templateObject *datatypes.User_Customer_MobileDevice) (resp datatypes.User_Customer_MobileDevice, err error) {
 	params := []interface{}{
@@ -2113,12 +2835,28 @@ func (r User_Customer_MobileDevice) GetAvailablePushNotificationSubscriptions()
 	return
 }
 
+// GetAvailablePushNotificationSubscriptionsWith is GetAvailablePushNotificationSubscriptions, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_MobileDevice) GetAvailablePushNotificationSubscriptionsWith(options sl.Options) (resp []datatypes.Notification, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAvailablePushNotificationSubscriptions()
+}
+
 // Retrieve The user this mobile device belongs to.
 func (r User_Customer_MobileDevice) GetCustomer() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_MobileDevice", "getCustomer", nil, &r.Options, &resp)
 	return
 }
 
+// GetCustomerWith is GetCustomer, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_MobileDevice) GetCustomerWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCustomer()
+}
+
 // no documentation yet
 func (r User_Customer_MobileDevice) GetObject() (resp datatypes.User_Customer_MobileDevice, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_MobileDevice", "getObject", nil, &r.Options, &resp)
@@ -2131,18 +2869,42 @@ func (r User_Customer_MobileDevice) GetOperatingSystem() (resp datatypes.User_Cu
 	return
 }
 
+// GetOperatingSystemWith is GetOperatingSystem, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_MobileDevice) GetOperatingSystemWith(options sl.Options) (resp datatypes.User_Customer_MobileDevice_OperatingSystem, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystem()
+}
+
 // Retrieve Notification subscriptions attached to a mobile device.
 func (r User_Customer_MobileDevice) GetPushNotificationSubscriptions() (resp []datatypes.Notification_User_Subscriber, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_MobileDevice", "getPushNotificationSubscriptions", nil, &r.Options, &resp)
 	return
 }
 
+// GetPushNotificationSubscriptionsWith is GetPushNotificationSubscriptions, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_MobileDevice) GetPushNotificationSubscriptionsWith(options sl.Options) (resp []datatypes.Notification_User_Subscriber, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPushNotificationSubscriptions()
+}
+
 // Retrieve The type of device this user is using
 func (r User_Customer_MobileDevice) GetType() (resp datatypes.User_Customer_MobileDevice_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_MobileDevice", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_MobileDevice) GetTypeWith(options sl.Options) (resp datatypes.User_Customer_MobileDevice_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // This class represents the mobile operating system installed on a user's registered mobile device. It assists us when determining the how to get a push notification to the user.
 type User_Customer_MobileDevice_OperatingSystem struct {
 	Session *session.Session
@@ -2159,6 +2921,11 @@ func (r User_Customer_MobileDevice_OperatingSystem) Id(id int) User_Customer_Mob
 	return r
 }
 
+func (r User_Customer_MobileDevice_OperatingSystem) InitParameter(name string, value interface{}) User_Customer_MobileDevice_OperatingSystem {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_MobileDevice_OperatingSystem) Mask(mask string) User_Customer_MobileDevice_OperatingSystem {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2178,11 +2945,26 @@ func (r User_Customer_MobileDevice_OperatingSystem) Limit(limit int) User_Custom
 	return r
 }
 
+func (r User_Customer_MobileDevice_OperatingSystem) Unlimited() User_Customer_MobileDevice_OperatingSystem {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_MobileDevice_OperatingSystem) Offset(offset int) User_Customer_MobileDevice_OperatingSystem {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_MobileDevice_OperatingSystem) Timeout(timeout time.Duration) User_Customer_MobileDevice_OperatingSystem {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_MobileDevice_OperatingSystem) WithContext(ctx context.Context) User_Customer_MobileDevice_OperatingSystem {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r User_Customer_MobileDevice_OperatingSystem) GetAllObjects() (resp []datatypes.User_Customer_MobileDevice_OperatingSystem, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_MobileDevice_OperatingSystem", "getAllObjects", nil, &r.Options, &resp)
@@ -2211,6 +2993,11 @@ func (r User_Customer_MobileDevice_Type) Id(id int) User_Customer_MobileDevice_T
 	return r
 }
 
+func (r User_Customer_MobileDevice_Type) InitParameter(name string, value interface{}) User_Customer_MobileDevice_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_MobileDevice_Type) Mask(mask string) User_Customer_MobileDevice_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2225,13 +3012,28 @@ func (r User_Customer_MobileDevice_Type) Filter(filter string) User_Customer_Mob
 	return r
 }
 
-func (r User_Customer_MobileDevice_Type) Limit(limit int) User_Customer_MobileDevice_Type {
-	r.Options.Limit = &limit
+func (r User_Customer_MobileDevice_Type) Limit(limit int) User_Customer_MobileDevice_Type {
+	r.Options.Limit = &limit
+	return r
+}
+
+func (r User_Customer_MobileDevice_Type) Unlimited() User_Customer_MobileDevice_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
+func (r User_Customer_MobileDevice_Type) Offset(offset int) User_Customer_MobileDevice_Type {
+	r.Options.Offset = &offset
+	return r
+}
+
+func (r User_Customer_MobileDevice_Type) Timeout(timeout time.Duration) User_Customer_MobileDevice_Type {
+	r.Options.Timeout = timeout
 	return r
 }
 
-func (r User_Customer_MobileDevice_Type) Offset(offset int) User_Customer_MobileDevice_Type {
-	r.Options.Offset = &offset
+func (r User_Customer_MobileDevice_Type) WithContext(ctx context.Context) User_Customer_MobileDevice_Type {
+	r.Session = r.Session.SetContext(ctx)
 	return r
 }
 
@@ -2263,6 +3065,11 @@ func (r User_Customer_Notification_Hardware) Id(id int) User_Customer_Notificati
 	return r
 }
 
+func (r User_Customer_Notification_Hardware) InitParameter(name string, value interface{}) User_Customer_Notification_Hardware {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_Notification_Hardware) Mask(mask string) User_Customer_Notification_Hardware {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2282,11 +3089,26 @@ func (r User_Customer_Notification_Hardware) Limit(limit int) User_Customer_Noti
 	return r
 }
 
+func (r User_Customer_Notification_Hardware) Unlimited() User_Customer_Notification_Hardware {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_Notification_Hardware) Offset(offset int) User_Customer_Notification_Hardware {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_Notification_Hardware) Timeout(timeout time.Duration) User_Customer_Notification_Hardware {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_Notification_Hardware) WithContext(ctx context.Context) User_Customer_Notification_Hardware {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Passing in an unsaved instances of a Customer_Notification_Hardware object into this function will create the object and return the results to the user.
 func (r User_Customer_Notification_Hardware) CreateObject(templateObject *datatypes.User_Customer_Notification_Hardware) (resp datatypes.User_Customer_Notification_Hardware, err error) {
 	params := []interface{}{
@@ -2331,6 +3153,14 @@ func (r User_Customer_Notification_Hardware) GetHardware() (resp datatypes.Hardw
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_Notification_Hardware) GetHardwareWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // getObject retrieves the SoftLayer_User_Customer_Notification_Hardware object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_User_Customer_Notification_Hardware service. You can only retrieve hardware notifications attached to hardware and users that belong to your account
 func (r User_Customer_Notification_Hardware) GetObject() (resp datatypes.User_Customer_Notification_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_Notification_Hardware", "getObject", nil, &r.Options, &resp)
@@ -2343,6 +3173,14 @@ func (r User_Customer_Notification_Hardware) GetUser() (resp datatypes.User_Cust
 	return
 }
 
+// GetUserWith is GetUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_Notification_Hardware) GetUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUser()
+}
+
 // The SoftLayer_User_Customer_Notification_Virtual_Guest object stores links between customers and the virtual guests they wish to monitor.  This link is not enough, the user must be sure to also create SoftLayer_Network_Monitor_Version1_Query_Host instance with the response action set to "notify users" in order for the users linked to that hardware object to be notified on failure.
 type User_Customer_Notification_Virtual_Guest struct {
 	Session *session.Session
@@ -2359,6 +3197,11 @@ func (r User_Customer_Notification_Virtual_Guest) Id(id int) User_Customer_Notif
 	return r
 }
 
+func (r User_Customer_Notification_Virtual_Guest) InitParameter(name string, value interface{}) User_Customer_Notification_Virtual_Guest {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_Notification_Virtual_Guest) Mask(mask string) User_Customer_Notification_Virtual_Guest {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2378,11 +3221,26 @@ func (r User_Customer_Notification_Virtual_Guest) Limit(limit int) User_Customer
 	return r
 }
 
+func (r User_Customer_Notification_Virtual_Guest) Unlimited() User_Customer_Notification_Virtual_Guest {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_Notification_Virtual_Guest) Offset(offset int) User_Customer_Notification_Virtual_Guest {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_Notification_Virtual_Guest) Timeout(timeout time.Duration) User_Customer_Notification_Virtual_Guest {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_Notification_Virtual_Guest) WithContext(ctx context.Context) User_Customer_Notification_Virtual_Guest {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Passing in an unsaved instance of a SoftLayer_Customer_Notification_Virtual_Guest object into this function will create the object and return the results to the user.
 func (r User_Customer_Notification_Virtual_Guest) CreateObject(templateObject *datatypes.User_Customer_Notification_Virtual_Guest) (resp datatypes.User_Customer_Notification_Virtual_Guest, err error) {
 	params := []interface{}{
@@ -2427,6 +3285,14 @@ func (r User_Customer_Notification_Virtual_Guest) GetGuest() (resp datatypes.Vir
 	return
 }
 
+// GetGuestWith is GetGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_Notification_Virtual_Guest) GetGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGuest()
+}
+
 // getObject retrieves the SoftLayer_User_Customer_Notification_Virtual_Guest object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_User_Customer_Notification_Virtual_Guest service. You can only retrieve guest notifications attached to virtual guests and users that belong to your account
 func (r User_Customer_Notification_Virtual_Guest) GetObject() (resp datatypes.User_Customer_Notification_Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_Notification_Virtual_Guest", "getObject", nil, &r.Options, &resp)
@@ -2439,6 +3305,14 @@ func (r User_Customer_Notification_Virtual_Guest) GetUser() (resp datatypes.User
 	return
 }
 
+// GetUserWith is GetUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_Notification_Virtual_Guest) GetUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUser()
+}
+
 // no documentation yet
 type User_Customer_OpenIdConnect struct {
 	Session *session.Session
@@ -2455,6 +3329,11 @@ func (r User_Customer_OpenIdConnect) Id(id int) User_Customer_OpenIdConnect {
 	return r
 }
 
+func (r User_Customer_OpenIdConnect) InitParameter(name string, value interface{}) User_Customer_OpenIdConnect {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_OpenIdConnect) Mask(mask string) User_Customer_OpenIdConnect {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2474,11 +3353,26 @@ func (r User_Customer_OpenIdConnect) Limit(limit int) User_Customer_OpenIdConnec
 	return r
 }
 
+func (r User_Customer_OpenIdConnect) Unlimited() User_Customer_OpenIdConnect {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_OpenIdConnect) Offset(offset int) User_Customer_OpenIdConnect {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_OpenIdConnect) Timeout(timeout time.Duration) User_Customer_OpenIdConnect {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_OpenIdConnect) WithContext(ctx context.Context) User_Customer_OpenIdConnect {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r User_Customer_OpenIdConnect) AcknowledgeSupportPolicy() (err error) {
 	var resp datatypes.Void
@@ -2803,6 +3697,14 @@ func (r User_Customer_OpenIdConnect) GetAccount() (resp datatypes.Account, err e
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve
 func (r User_Customer_OpenIdConnect) GetActions() (resp []datatypes.User_Permission_Action, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getActions", nil, &r.Options, &resp)
@@ -2821,6 +3723,14 @@ func (r User_Customer_OpenIdConnect) GetAdditionalEmails() (resp []datatypes.Use
 	return
 }
 
+// GetAdditionalEmailsWith is GetAdditionalEmails, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetAdditionalEmailsWith(options sl.Options) (resp []datatypes.User_Customer_AdditionalEmail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAdditionalEmails()
+}
+
 // no documentation yet
 func (r User_Customer_OpenIdConnect) GetAllowedDedicatedHostIds() (resp []int, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getAllowedDedicatedHostIds", nil, &r.Options, &resp)
@@ -2845,6 +3755,14 @@ func (r User_Customer_OpenIdConnect) GetApiAuthenticationKeys() (resp []datatype
 	return
 }
 
+// GetApiAuthenticationKeysWith is GetApiAuthenticationKeys, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetApiAuthenticationKeysWith(options sl.Options) (resp []datatypes.User_Customer_ApiAuthentication, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetApiAuthenticationKeys()
+}
+
 // no documentation yet
 func (r User_Customer_OpenIdConnect) GetAuthenticationToken(token *datatypes.Container_User_Authentication_Token) (resp datatypes.Container_User_Authentication_Token, err error) {
 	params := []interface{}{
@@ -2860,24 +3778,56 @@ func (r User_Customer_OpenIdConnect) GetCdnAccounts() (resp []datatypes.Network_
 	return
 }
 
+// GetCdnAccountsWith is GetCdnAccounts, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetCdnAccountsWith(options sl.Options) (resp []datatypes.Network_ContentDelivery_Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCdnAccounts()
+}
+
 // Retrieve A portal user's child users. Some portal users may not have child users.
 func (r User_Customer_OpenIdConnect) GetChildUsers() (resp []datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getChildUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildUsersWith is GetChildUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetChildUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildUsers()
+}
+
 // Retrieve An user's associated closed tickets.
 func (r User_Customer_OpenIdConnect) GetClosedTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getClosedTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetClosedTicketsWith is GetClosedTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetClosedTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetClosedTickets()
+}
+
 // Retrieve The dedicated hosts to which the user has been granted access.
 func (r User_Customer_OpenIdConnect) GetDedicatedHosts() (resp []datatypes.Virtual_DedicatedHost, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getDedicatedHosts", nil, &r.Options, &resp)
 	return
 }
 
+// GetDedicatedHostsWith is GetDedicatedHosts, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetDedicatedHostsWith(options sl.Options) (resp []datatypes.Virtual_DedicatedHost, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDedicatedHosts()
+}
+
 // This API gets the default account for the OpenIdConnect identity that is linked to the current SoftLayer user identity. If there is no default present, the API returns null, except in the special case where we find one active user linked to the IAMid. In that case, we will set the link from the IAMid to that user as default, and return the account of which that user is a member. Invoke this only on IAMid-authenticated users.
 func (r User_Customer_OpenIdConnect) GetDefaultAccount(providerType *string) (resp datatypes.Account, err error) {
 	params := []interface{}{
@@ -2893,48 +3843,112 @@ func (r User_Customer_OpenIdConnect) GetExternalBindings() (resp []datatypes.Use
 	return
 }
 
+// GetExternalBindingsWith is GetExternalBindings, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetExternalBindingsWith(options sl.Options) (resp []datatypes.User_External_Binding, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetExternalBindings()
+}
+
 // Retrieve A portal user's accessible hardware. These permissions control which hardware a user has access to in the SoftLayer customer portal.
 func (r User_Customer_OpenIdConnect) GetHardware() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetHardwareWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Retrieve the number of servers that a portal user has access to. Portal users can have restrictions set to limit services for and to perform actions on hardware. You can set these permissions in the portal by clicking the "administrative" then "user admin" links.
 func (r User_Customer_OpenIdConnect) GetHardwareCount() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getHardwareCount", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareCountWith is GetHardwareCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetHardwareCountWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareCount()
+}
+
 // Retrieve Hardware notifications associated with this user. A hardware notification links a user to a piece of hardware, and that user will be notified if any monitors on that hardware fail, if the monitors have a status of 'Notify User'.
 func (r User_Customer_OpenIdConnect) GetHardwareNotifications() (resp []datatypes.User_Customer_Notification_Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getHardwareNotifications", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareNotificationsWith is GetHardwareNotifications, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetHardwareNotificationsWith(options sl.Options) (resp []datatypes.User_Customer_Notification_Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardwareNotifications()
+}
+
 // Retrieve Whether or not a user has acknowledged the support policy.
 func (r User_Customer_OpenIdConnect) GetHasAcknowledgedSupportPolicyFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getHasAcknowledgedSupportPolicyFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasAcknowledgedSupportPolicyFlagWith is GetHasAcknowledgedSupportPolicyFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetHasAcknowledgedSupportPolicyFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasAcknowledgedSupportPolicyFlag()
+}
+
 // Retrieve Permission granting the user access to all Dedicated Host devices on the account.
 func (r User_Customer_OpenIdConnect) GetHasFullDedicatedHostAccessFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getHasFullDedicatedHostAccessFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasFullDedicatedHostAccessFlagWith is GetHasFullDedicatedHostAccessFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetHasFullDedicatedHostAccessFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasFullDedicatedHostAccessFlag()
+}
+
 // Retrieve Whether or not a portal user has access to all hardware on their account.
 func (r User_Customer_OpenIdConnect) GetHasFullHardwareAccessFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getHasFullHardwareAccessFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasFullHardwareAccessFlagWith is GetHasFullHardwareAccessFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetHasFullHardwareAccessFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasFullHardwareAccessFlag()
+}
+
 // Retrieve Whether or not a portal user has access to all hardware on their account.
 func (r User_Customer_OpenIdConnect) GetHasFullVirtualGuestAccessFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getHasFullVirtualGuestAccessFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHasFullVirtualGuestAccessFlagWith is GetHasFullVirtualGuestAccessFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetHasFullVirtualGuestAccessFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHasFullVirtualGuestAccessFlag()
+}
+
 // Retrieve
 func (r User_Customer_OpenIdConnect) GetIbmIdLink() (resp datatypes.User_Customer_Link, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getIbmIdLink", nil, &r.Options, &resp)
@@ -2959,6 +3973,14 @@ func (r User_Customer_OpenIdConnect) GetLocale() (resp datatypes.Locale, err err
 	return
 }
 
+// GetLocaleWith is GetLocale, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetLocaleWith(options sl.Options) (resp datatypes.Locale, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocale()
+}
+
 // Validates a supplied OpenIdConnect access token to the SoftLayer customer portal and returns the default account name and id for the active user. An exception will be thrown if no matching customer is found.
 func (r User_Customer_OpenIdConnect) GetLoginAccountInfoOpenIdConnect(providerType *string, accessToken *string) (resp datatypes.Container_User_Customer_OpenIdConnect_LoginAccountInfo, err error) {
 	params := []interface{}{
@@ -2975,6 +3997,14 @@ func (r User_Customer_OpenIdConnect) GetLoginAttempts() (resp []datatypes.User_C
 	return
 }
 
+// GetLoginAttemptsWith is GetLoginAttempts, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetLoginAttemptsWith(options sl.Options) (resp []datatypes.User_Customer_Access_Authentication, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLoginAttempts()
+}
+
 // Attempt to authenticate a user to the SoftLayer customer portal using the provided authentication container. Depending on the specific type of authentication container that is used, this API will leverage the appropriate authentication protocol. If authentication is successful then the API returns a list of linked accounts for the user, a token containing the ID of the authenticated user and a hash key used by the SoftLayer customer portal to maintain authentication.
 func (r User_Customer_OpenIdConnect) GetLoginToken(request *datatypes.Container_Authentication_Request_Contract) (resp datatypes.Container_Authentication_Response_Common, err error) {
 	params := []interface{}{
@@ -2999,12 +4029,28 @@ func (r User_Customer_OpenIdConnect) GetMobileDevices() (resp []datatypes.User_C
 	return
 }
 
+// GetMobileDevicesWith is GetMobileDevices, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetMobileDevicesWith(options sl.Options) (resp []datatypes.User_Customer_MobileDevice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMobileDevices()
+}
+
 // Retrieve Notification subscription records for the user.
 func (r User_Customer_OpenIdConnect) GetNotificationSubscribers() (resp []datatypes.Notification_Subscriber, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getNotificationSubscribers", nil, &r.Options, &resp)
 	return
 }
 
+// GetNotificationSubscribersWith is GetNotificationSubscribers, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetNotificationSubscribersWith(options sl.Options) (resp []datatypes.Notification_Subscriber, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNotificationSubscribers()
+}
+
 // no documentation yet
 func (r User_Customer_OpenIdConnect) GetObject() (resp datatypes.User_Customer_OpenIdConnect, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getObject", nil, &r.Options, &resp)
@@ -3033,24 +4079,56 @@ func (r User_Customer_OpenIdConnect) GetOpenTickets() (resp []datatypes.Ticket,
 	return
 }
 
+// GetOpenTicketsWith is GetOpenTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetOpenTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenTickets()
+}
+
 // Retrieve A portal user's vpn accessible subnets.
 func (r User_Customer_OpenIdConnect) GetOverrides() (resp []datatypes.Network_Service_Vpn_Overrides, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getOverrides", nil, &r.Options, &resp)
 	return
 }
 
+// GetOverridesWith is GetOverrides, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetOverridesWith(options sl.Options) (resp []datatypes.Network_Service_Vpn_Overrides, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOverrides()
+}
+
 // Retrieve A portal user's parent user. If a SoftLayer_User_Customer has a null parentId property then it doesn't have a parent user.
 func (r User_Customer_OpenIdConnect) GetParent() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getParent", nil, &r.Options, &resp)
 	return
 }
 
+// GetParentWith is GetParent, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetParentWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParent()
+}
+
 // Retrieve A portal user's permissions. These permissions control that user's access to functions within the SoftLayer customer portal and API.
 func (r User_Customer_OpenIdConnect) GetPermissions() (resp []datatypes.User_Customer_CustomerPermission_Permission, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getPermissions", nil, &r.Options, &resp)
 	return
 }
 
+// GetPermissionsWith is GetPermissions, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetPermissionsWith(options sl.Options) (resp []datatypes.User_Customer_CustomerPermission_Permission, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPermissions()
+}
+
 // Attempt to authenticate a username and password to the SoftLayer customer portal. Many portal user accounts are configured to require answering a security question on login. In this case getPortalLoginToken() also verifies the given security question ID and answer. If authentication is successful then the API returns a token containing the ID of the authenticated user and a hash key used by the SoftLayer customer portal to maintain authentication.
 func (r User_Customer_OpenIdConnect) GetPortalLoginToken(username *string, password *string, securityQuestionId *int, securityQuestionAnswer *string) (resp datatypes.Container_User_Customer_Portal_Token, err error) {
 	params := []interface{}{
@@ -3124,24 +4202,56 @@ func (r User_Customer_OpenIdConnect) GetSecurityAnswers() (resp []datatypes.User
 	return
 }
 
+// GetSecurityAnswersWith is GetSecurityAnswers, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetSecurityAnswersWith(options sl.Options) (resp []datatypes.User_Customer_Security_Answer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityAnswers()
+}
+
 // Retrieve A user's notification subscription records.
 func (r User_Customer_OpenIdConnect) GetSubscribers() (resp []datatypes.Notification_User_Subscriber, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getSubscribers", nil, &r.Options, &resp)
 	return
 }
 
+// GetSubscribersWith is GetSubscribers, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetSubscribersWith(options sl.Options) (resp []datatypes.Notification_User_Subscriber, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubscribers()
+}
+
 // Retrieve A user's successful attempts to log into the SoftLayer customer portal.
 func (r User_Customer_OpenIdConnect) GetSuccessfulLogins() (resp []datatypes.User_Customer_Access_Authentication, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getSuccessfulLogins", nil, &r.Options, &resp)
 	return
 }
 
+// GetSuccessfulLoginsWith is GetSuccessfulLogins, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetSuccessfulLoginsWith(options sl.Options) (resp []datatypes.User_Customer_Access_Authentication, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSuccessfulLogins()
+}
+
 // Retrieve Whether or not a user is required to acknowledge the support policy for portal access.
 func (r User_Customer_OpenIdConnect) GetSupportPolicyAcknowledgementRequiredFlag() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getSupportPolicyAcknowledgementRequiredFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetSupportPolicyAcknowledgementRequiredFlagWith is GetSupportPolicyAcknowledgementRequiredFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetSupportPolicyAcknowledgementRequiredFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSupportPolicyAcknowledgementRequiredFlag()
+}
+
 // no documentation yet
 func (r User_Customer_OpenIdConnect) GetSupportPolicyDocument() (resp []byte, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getSupportPolicyDocument", nil, &r.Options, &resp)
@@ -3166,30 +4276,70 @@ func (r User_Customer_OpenIdConnect) GetSurveyRequiredFlag() (resp bool, err err
 	return
 }
 
+// GetSurveyRequiredFlagWith is GetSurveyRequiredFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetSurveyRequiredFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSurveyRequiredFlag()
+}
+
 // Retrieve The surveys that a user has taken in the SoftLayer customer portal.
 func (r User_Customer_OpenIdConnect) GetSurveys() (resp []datatypes.Survey, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getSurveys", nil, &r.Options, &resp)
 	return
 }
 
+// GetSurveysWith is GetSurveys, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetSurveysWith(options sl.Options) (resp []datatypes.Survey, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSurveys()
+}
+
 // Retrieve An user's associated tickets.
 func (r User_Customer_OpenIdConnect) GetTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getTickets", nil, &r.Options, &resp)
 	return
 }
 
+// GetTicketsWith is GetTickets, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetTicketsWith(options sl.Options) (resp []datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTickets()
+}
+
 // Retrieve A portal user's time zone.
 func (r User_Customer_OpenIdConnect) GetTimezone() (resp datatypes.Locale_Timezone, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getTimezone", nil, &r.Options, &resp)
 	return
 }
 
+// GetTimezoneWith is GetTimezone, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetTimezoneWith(options sl.Options) (resp datatypes.Locale_Timezone, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTimezone()
+}
+
 // Retrieve A user's unsuccessful attempts to log into the SoftLayer customer portal.
 func (r User_Customer_OpenIdConnect) GetUnsuccessfulLogins() (resp []datatypes.User_Customer_Access_Authentication, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getUnsuccessfulLogins", nil, &r.Options, &resp)
 	return
 }
 
+// GetUnsuccessfulLoginsWith is GetUnsuccessfulLogins, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetUnsuccessfulLoginsWith(options sl.Options) (resp []datatypes.User_Customer_Access_Authentication, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUnsuccessfulLogins()
+}
+
 // Returns an IMS User Object from the provided OpenIdConnect User ID or IBMid Unique Identifier for the Account of the active user. Enforces the User Management permissions for the Active User. An exception will be thrown if no matching IMS User is found. NOTE that providing IBMid Unique Identifier is optional, but it will be preferred over OpenIdConnect User ID if provided.
 func (r User_Customer_OpenIdConnect) GetUserForUnifiedInvitation(openIdConnectUserId *string, uniqueIdentifier *string) (resp datatypes.User_Customer_OpenIdConnect, err error) {
 	params := []interface{}{
@@ -3231,18 +4381,42 @@ func (r User_Customer_OpenIdConnect) GetUserStatus() (resp datatypes.User_Custom
 	return
 }
 
+// GetUserStatusWith is GetUserStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetUserStatusWith(options sl.Options) (resp datatypes.User_Customer_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUserStatus()
+}
+
 // Retrieve the number of CloudLayer Computing Instances that a portal user has access to. Portal users can have restrictions set to limit services for and to perform actions on CloudLayer Computing Instances. You can set these permissions in the portal by clicking the "administrative" then "user admin" links.
 func (r User_Customer_OpenIdConnect) GetVirtualGuestCount() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getVirtualGuestCount", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestCountWith is GetVirtualGuestCount, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetVirtualGuestCountWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuestCount()
+}
+
 // Retrieve A portal user's accessible CloudLayer Computing Instances. These permissions control which CloudLayer Computing Instances a user has access to in the SoftLayer customer portal.
 func (r User_Customer_OpenIdConnect) GetVirtualGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "getVirtualGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualGuestsWith is GetVirtualGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_OpenIdConnect) GetVirtualGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualGuests()
+}
+
 // no documentation yet
 func (r User_Customer_OpenIdConnect) InTerminalStatus() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_OpenIdConnect", "inTerminalStatus", nil, &r.Options, &resp)
@@ -3677,6 +4851,11 @@ func (r User_Customer_Prospect_ServiceProvider_EnrollRequest) Id(id int) User_Cu
 	return r
 }
 
+func (r User_Customer_Prospect_ServiceProvider_EnrollRequest) InitParameter(name string, value interface{}) User_Customer_Prospect_ServiceProvider_EnrollRequest {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_Prospect_ServiceProvider_EnrollRequest) Mask(mask string) User_Customer_Prospect_ServiceProvider_EnrollRequest {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3696,11 +4875,26 @@ func (r User_Customer_Prospect_ServiceProvider_EnrollRequest) Limit(limit int) U
 	return r
 }
 
+func (r User_Customer_Prospect_ServiceProvider_EnrollRequest) Unlimited() User_Customer_Prospect_ServiceProvider_EnrollRequest {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_Prospect_ServiceProvider_EnrollRequest) Offset(offset int) User_Customer_Prospect_ServiceProvider_EnrollRequest {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_Prospect_ServiceProvider_EnrollRequest) Timeout(timeout time.Duration) User_Customer_Prospect_ServiceProvider_EnrollRequest {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_Prospect_ServiceProvider_EnrollRequest) WithContext(ctx context.Context) User_Customer_Prospect_ServiceProvider_EnrollRequest {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Create a new Service Provider Enrollment
 func (r User_Customer_Prospect_ServiceProvider_EnrollRequest) Enroll(templateObject *datatypes.User_Customer_Prospect_ServiceProvider_EnrollRequest) (resp datatypes.User_Customer_Prospect_ServiceProvider_EnrollRequest, err error) {
 	params := []interface{}{
@@ -3716,6 +4910,14 @@ func (r User_Customer_Prospect_ServiceProvider_EnrollRequest) GetCompanyType() (
 	return
 }
 
+// GetCompanyTypeWith is GetCompanyType, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_Prospect_ServiceProvider_EnrollRequest) GetCompanyTypeWith(options sl.Options) (resp datatypes.Catalyst_Company_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCompanyType()
+}
+
 // no documentation yet
 func (r User_Customer_Prospect_ServiceProvider_EnrollRequest) GetObject() (resp datatypes.User_Customer_Prospect_ServiceProvider_EnrollRequest, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_Prospect_ServiceProvider_EnrollRequest", "getObject", nil, &r.Options, &resp)
@@ -3738,6 +4940,11 @@ func (r User_Customer_Security_Answer) Id(id int) User_Customer_Security_Answer
 	return r
 }
 
+func (r User_Customer_Security_Answer) InitParameter(name string, value interface{}) User_Customer_Security_Answer {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_Security_Answer) Mask(mask string) User_Customer_Security_Answer {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3757,11 +4964,26 @@ func (r User_Customer_Security_Answer) Limit(limit int) User_Customer_Security_A
 	return r
 }
 
+func (r User_Customer_Security_Answer) Unlimited() User_Customer_Security_Answer {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_Security_Answer) Offset(offset int) User_Customer_Security_Answer {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_Security_Answer) Timeout(timeout time.Duration) User_Customer_Security_Answer {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_Security_Answer) WithContext(ctx context.Context) User_Customer_Security_Answer {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // getObject retrieves the SoftLayer_User_Customer_Security_Answer object whose ID number corresponds to the ID number of the init parameter passed to the SoftLayer_User_Customer_Security_Answer service.
 func (r User_Customer_Security_Answer) GetObject() (resp datatypes.User_Customer_Security_Answer, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_Security_Answer", "getObject", nil, &r.Options, &resp)
@@ -3774,12 +4996,28 @@ func (r User_Customer_Security_Answer) GetQuestion() (resp datatypes.User_Securi
 	return
 }
 
+// GetQuestionWith is GetQuestion, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_Security_Answer) GetQuestionWith(options sl.Options) (resp datatypes.User_Security_Question, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetQuestion()
+}
+
 // Retrieve The user who the security answer belongs to.
 func (r User_Customer_Security_Answer) GetUser() (resp datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_Security_Answer", "getUser", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserWith is GetUser, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Customer_Security_Answer) GetUserWith(options sl.Options) (resp datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUser()
+}
+
 // Each SoftLayer portal account is assigned a status code that determines how it's treated in the customer portal. This status is reflected in the SoftLayer_User_Customer_Status data type. Status differs from user permissions in that user status applies globally to the portal while user permissions are applied to specific portal functions.
 type User_Customer_Status struct {
 	Session *session.Session
@@ -3796,6 +5034,11 @@ func (r User_Customer_Status) Id(id int) User_Customer_Status {
 	return r
 }
 
+func (r User_Customer_Status) InitParameter(name string, value interface{}) User_Customer_Status {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Customer_Status) Mask(mask string) User_Customer_Status {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3815,11 +5058,26 @@ func (r User_Customer_Status) Limit(limit int) User_Customer_Status {
 	return r
 }
 
+func (r User_Customer_Status) Unlimited() User_Customer_Status {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Customer_Status) Offset(offset int) User_Customer_Status {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Customer_Status) Timeout(timeout time.Duration) User_Customer_Status {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Customer_Status) WithContext(ctx context.Context) User_Customer_Status {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve all user status objects.
 func (r User_Customer_Status) GetAllObjects() (resp []datatypes.User_Customer_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Customer_Status", "getAllObjects", nil, &r.Options, &resp)
@@ -3848,6 +5106,11 @@ func (r User_External_Binding) Id(id int) User_External_Binding {
 	return r
 }
 
+func (r User_External_Binding) InitParameter(name string, value interface{}) User_External_Binding {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_External_Binding) Mask(mask string) User_External_Binding {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3867,11 +5130,26 @@ func (r User_External_Binding) Limit(limit int) User_External_Binding {
 	return r
 }
 
+func (r User_External_Binding) Unlimited() User_External_Binding {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_External_Binding) Offset(offset int) User_External_Binding {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_External_Binding) Timeout(timeout time.Duration) User_External_Binding {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_External_Binding) WithContext(ctx context.Context) User_External_Binding {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Delete an external authentication binding.  If the external binding currently has an active billing item associated you will be prevented from deleting the binding.  The alternative method to remove an external authentication binding is to use the service cancellation form.
 func (r User_External_Binding) DeleteObject() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_External_Binding", "deleteObject", nil, &r.Options, &resp)
@@ -3884,18 +5162,42 @@ func (r User_External_Binding) GetAttributes() (resp []datatypes.User_External_B
 	return
 }
 
+// GetAttributesWith is GetAttributes, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_External_Binding) GetAttributesWith(options sl.Options) (resp []datatypes.User_External_Binding_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAttributes()
+}
+
 // Retrieve Information regarding the billing item for external authentication.
 func (r User_External_Binding) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_External_Binding", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_External_Binding) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve An optional note for identifying the external binding.
 func (r User_External_Binding) GetNote() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_External_Binding", "getNote", nil, &r.Options, &resp)
 	return
 }
 
+// GetNoteWith is GetNote, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_External_Binding) GetNoteWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNote()
+}
+
 // no documentation yet
 func (r User_External_Binding) GetObject() (resp datatypes.User_External_Binding, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_External_Binding", "getObject", nil, &r.Options, &resp)
@@ -3908,12 +5210,28 @@ func (r User_External_Binding) GetType() (resp datatypes.User_External_Binding_T
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_External_Binding) GetTypeWith(options sl.Options) (resp datatypes.User_External_Binding_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Retrieve The vendor of an external authentication binding.
 func (r User_External_Binding) GetVendor() (resp datatypes.User_External_Binding_Vendor, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_External_Binding", "getVendor", nil, &r.Options, &resp)
 	return
 }
 
+// GetVendorWith is GetVendor, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_External_Binding) GetVendorWith(options sl.Options) (resp datatypes.User_External_Binding_Vendor, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVendor()
+}
+
 // Update the note of an external binding.  The note is an optional property that is used to store information about a binding.
 func (r User_External_Binding) UpdateNote(text *string) (resp bool, err error) {
 	params := []interface{}{
@@ -3939,6 +5257,11 @@ func (r User_External_Binding_Vendor) Id(id int) User_External_Binding_Vendor {
 	return r
 }
 
+func (r User_External_Binding_Vendor) InitParameter(name string, value interface{}) User_External_Binding_Vendor {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_External_Binding_Vendor) Mask(mask string) User_External_Binding_Vendor {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3958,11 +5281,26 @@ func (r User_External_Binding_Vendor) Limit(limit int) User_External_Binding_Ven
 	return r
 }
 
+func (r User_External_Binding_Vendor) Unlimited() User_External_Binding_Vendor {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_External_Binding_Vendor) Offset(offset int) User_External_Binding_Vendor {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_External_Binding_Vendor) Timeout(timeout time.Duration) User_External_Binding_Vendor {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_External_Binding_Vendor) WithContext(ctx context.Context) User_External_Binding_Vendor {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // getAllObjects() will return a list of the available external binding vendors that SoftLayer supports.  Use this list to select the appropriate vendor when creating a new external binding.
 func (r User_External_Binding_Vendor) GetAllObjects() (resp []datatypes.User_External_Binding_Vendor, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_External_Binding_Vendor", "getAllObjects", nil, &r.Options, &resp)
@@ -3991,6 +5329,11 @@ func (r User_Permission_Action) Id(id int) User_Permission_Action {
 	return r
 }
 
+func (r User_Permission_Action) InitParameter(name string, value interface{}) User_Permission_Action {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Permission_Action) Mask(mask string) User_Permission_Action {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4010,11 +5353,26 @@ func (r User_Permission_Action) Limit(limit int) User_Permission_Action {
 	return r
 }
 
+func (r User_Permission_Action) Unlimited() User_Permission_Action {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Permission_Action) Offset(offset int) User_Permission_Action {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Permission_Action) Timeout(timeout time.Duration) User_Permission_Action {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Permission_Action) WithContext(ctx context.Context) User_Permission_Action {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r User_Permission_Action) GetAllObjects() (resp []datatypes.User_Permission_Action, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Permission_Action", "getAllObjects", nil, &r.Options, &resp)
@@ -4043,6 +5401,11 @@ func (r User_Permission_Group) Id(id int) User_Permission_Group {
 	return r
 }
 
+func (r User_Permission_Group) InitParameter(name string, value interface{}) User_Permission_Group {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Permission_Group) Mask(mask string) User_Permission_Group {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4062,11 +5425,26 @@ func (r User_Permission_Group) Limit(limit int) User_Permission_Group {
 	return r
 }
 
+func (r User_Permission_Group) Unlimited() User_Permission_Group {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Permission_Group) Offset(offset int) User_Permission_Group {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Permission_Group) Timeout(timeout time.Duration) User_Permission_Group {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Permission_Group) WithContext(ctx context.Context) User_Permission_Group {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r User_Permission_Group) AddAction(action *datatypes.User_Permission_Action) (err error) {
 	var resp datatypes.Void
@@ -4161,6 +5539,14 @@ func (r User_Permission_Group) GetType() (resp datatypes.User_Permission_Group_T
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r User_Permission_Group) GetTypeWith(options sl.Options) (resp datatypes.User_Permission_Group_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // no documentation yet
 func (r User_Permission_Group) LinkRole(role *datatypes.User_Permission_Role) (err error) {
 	var resp datatypes.Void
@@ -4237,6 +5623,11 @@ func (r User_Permission_Group_Type) Id(id int) User_Permission_Group_Type {
 	return r
 }
 
+func (r User_Permission_Group_Type) InitParameter(name string, value interface{}) User_Permission_Group_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Permission_Group_Type) Mask(mask string) User_Permission_Group_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4256,11 +5647,26 @@ func (r User_Permission_Group_Type) Limit(limit int) User_Permission_Group_Type
 	return r
 }
 
+func (r User_Permission_Group_Type) Unlimited() User_Permission_Group_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Permission_Group_Type) Offset(offset int) User_Permission_Group_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Permission_Group_Type) Timeout(timeout time.Duration) User_Permission_Group_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Permission_Group_Type) WithContext(ctx context.Context) User_Permission_Group_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve
 func (r User_Permission_Group_Type) GetGroups() (resp []datatypes.User_Permission_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Permission_Group_Type", "getGroups", nil, &r.Options, &resp)
@@ -4289,6 +5695,11 @@ func (r User_Permission_Role) Id(id int) User_Permission_Role {
 	return r
 }
 
+func (r User_Permission_Role) InitParameter(name string, value interface{}) User_Permission_Role {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Permission_Role) Mask(mask string) User_Permission_Role {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4308,11 +5719,26 @@ func (r User_Permission_Role) Limit(limit int) User_Permission_Role {
 	return r
 }
 
+func (r User_Permission_Role) Unlimited() User_Permission_Role {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Permission_Role) Offset(offset int) User_Permission_Role {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Permission_Role) Timeout(timeout time.Duration) User_Permission_Role {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Permission_Role) WithContext(ctx context.Context) User_Permission_Role {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r User_Permission_Role) AddUser(user *datatypes.User_Customer) (err error) {
 	var resp datatypes.Void
@@ -4423,6 +5849,11 @@ func (r User_Security_Question) Id(id int) User_Security_Question {
 	return r
 }
 
+func (r User_Security_Question) InitParameter(name string, value interface{}) User_Security_Question {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r User_Security_Question) Mask(mask string) User_Security_Question {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -4442,11 +5873,26 @@ func (r User_Security_Question) Limit(limit int) User_Security_Question {
 	return r
 }
 
+func (r User_Security_Question) Unlimited() User_Security_Question {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r User_Security_Question) Offset(offset int) User_Security_Question {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r User_Security_Question) Timeout(timeout time.Duration) User_Security_Question {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r User_Security_Question) WithContext(ctx context.Context) User_Security_Question {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve all viewable security questions.
 func (r User_Security_Question) GetAllObjects() (resp []datatypes.User_Security_Question, err error) {
 	err = r.Session.DoRequest("SoftLayer_User_Security_Question", "getAllObjects", nil, &r.Options, &resp)
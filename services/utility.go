@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/session"
 	"github.com/softlayer/softlayer-go/sl"
@@ -44,6 +46,11 @@ func (r Utility_Network) Id(id int) Utility_Network {
 	return r
 }
 
+func (r Utility_Network) InitParameter(name string, value interface{}) Utility_Network {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Utility_Network) Mask(mask string) Utility_Network {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -63,11 +70,26 @@ func (r Utility_Network) Limit(limit int) Utility_Network {
 	return r
 }
 
+func (r Utility_Network) Unlimited() Utility_Network {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Utility_Network) Offset(offset int) Utility_Network {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Utility_Network) Timeout(timeout time.Duration) Utility_Network {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Utility_Network) WithContext(ctx context.Context) Utility_Network {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // A method used to return the nameserver information for a given address
 func (r Utility_Network) NsLookup(address *string, typ *string) (resp string, err error) {
 	params := []interface{}{
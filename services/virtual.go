@@ -21,8 +21,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
@@ -45,6 +47,11 @@ func (r Virtual_DedicatedHost) Id(id int) Virtual_DedicatedHost {
 	return r
 }
 
+func (r Virtual_DedicatedHost) InitParameter(name string, value interface{}) Virtual_DedicatedHost {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Virtual_DedicatedHost) Mask(mask string) Virtual_DedicatedHost {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -64,11 +71,26 @@ func (r Virtual_DedicatedHost) Limit(limit int) Virtual_DedicatedHost {
 	return r
 }
 
+func (r Virtual_DedicatedHost) Unlimited() Virtual_DedicatedHost {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Virtual_DedicatedHost) Offset(offset int) Virtual_DedicatedHost {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Virtual_DedicatedHost) Timeout(timeout time.Duration) Virtual_DedicatedHost {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Virtual_DedicatedHost) WithContext(ctx context.Context) Virtual_DedicatedHost {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method will cancel a dedicated host immediately.
 func (r Virtual_DedicatedHost) DeleteObject() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_DedicatedHost", "deleteObject", nil, &r.Options, &resp)
@@ -90,12 +112,28 @@ func (r Virtual_DedicatedHost) GetAccount() (resp datatypes.Account, err error)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_DedicatedHost) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The container that represents allocations on the dedicated host.
 func (r Virtual_DedicatedHost) GetAllocationStatus() (resp datatypes.Container_Virtual_DedicatedHost_AllocationStatus, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_DedicatedHost", "getAllocationStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllocationStatusWith is GetAllocationStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_DedicatedHost) GetAllocationStatusWith(options sl.Options) (resp datatypes.Container_Virtual_DedicatedHost_AllocationStatus, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllocationStatus()
+}
+
 // This method will get the available backend routers to order a dedicated host.
 func (r Virtual_DedicatedHost) GetAvailableRouters(dedicatedHost *datatypes.Virtual_DedicatedHost) (resp []datatypes.Hardware, err error) {
 	params := []interface{}{
@@ -111,24 +149,56 @@ func (r Virtual_DedicatedHost) GetBackendRouter() (resp datatypes.Hardware_Route
 	return
 }
 
+// GetBackendRouterWith is GetBackendRouter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_DedicatedHost) GetBackendRouterWith(options sl.Options) (resp datatypes.Hardware_Router_Backend, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendRouter()
+}
+
 // Retrieve The billing item for the dedicated host.
 func (r Virtual_DedicatedHost) GetBillingItem() (resp datatypes.Billing_Item_Virtual_DedicatedHost, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_DedicatedHost", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_DedicatedHost) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Virtual_DedicatedHost, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The datacenter that the dedicated host resides in.
 func (r Virtual_DedicatedHost) GetDatacenter() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_DedicatedHost", "getDatacenter", nil, &r.Options, &resp)
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_DedicatedHost) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // Retrieve The guests associated with the dedicated host.
 func (r Virtual_DedicatedHost) GetGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_DedicatedHost", "getGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetGuestsWith is GetGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_DedicatedHost) GetGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGuests()
+}
+
 // Retrieve
 func (r Virtual_DedicatedHost) GetInternalTagReferences() (resp []datatypes.Tag_Reference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_DedicatedHost", "getInternalTagReferences", nil, &r.Options, &resp)
@@ -147,12 +217,28 @@ func (r Virtual_DedicatedHost) GetPciDeviceAllocationStatus() (resp datatypes.Co
 	return
 }
 
+// GetPciDeviceAllocationStatusWith is GetPciDeviceAllocationStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_DedicatedHost) GetPciDeviceAllocationStatusWith(options sl.Options) (resp datatypes.Container_Virtual_DedicatedHost_Pci_Device_AllocationStatus, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPciDeviceAllocationStatus()
+}
+
 // Retrieve A collection of SoftLayer_Virtual_Host_PciDevice objects on the host.
 func (r Virtual_DedicatedHost) GetPciDevices() (resp []datatypes.Virtual_Host_PciDevice, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_DedicatedHost", "getPciDevices", nil, &r.Options, &resp)
 	return
 }
 
+// GetPciDevicesWith is GetPciDevices, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_DedicatedHost) GetPciDevicesWith(options sl.Options) (resp []datatypes.Virtual_Host_PciDevice, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPciDevices()
+}
+
 // Retrieve
 func (r Virtual_DedicatedHost) GetTagReferences() (resp []datatypes.Tag_Reference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_DedicatedHost", "getTagReferences", nil, &r.Options, &resp)
@@ -186,6 +272,11 @@ func (r Virtual_Disk_Image) Id(id int) Virtual_Disk_Image {
 	return r
 }
 
+func (r Virtual_Disk_Image) InitParameter(name string, value interface{}) Virtual_Disk_Image {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Virtual_Disk_Image) Mask(mask string) Virtual_Disk_Image {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -205,11 +296,26 @@ func (r Virtual_Disk_Image) Limit(limit int) Virtual_Disk_Image {
 	return r
 }
 
+func (r Virtual_Disk_Image) Unlimited() Virtual_Disk_Image {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Virtual_Disk_Image) Offset(offset int) Virtual_Disk_Image {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Virtual_Disk_Image) Timeout(timeout time.Duration) Virtual_Disk_Image {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Virtual_Disk_Image) WithContext(ctx context.Context) Virtual_Disk_Image {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Virtual_Disk_Image) EditObject(templateObject *datatypes.Virtual_Disk_Image) (resp bool, err error) {
 	params := []interface{}{
@@ -231,12 +337,28 @@ func (r Virtual_Disk_Image) GetBillingItem() (resp datatypes.Billing_Item_Virtua
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Disk_Image) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Virtual_Disk_Image, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The block devices that a disk image is attached to. Block devices connect computing instances to disk images.
 func (r Virtual_Disk_Image) GetBlockDevices() (resp []datatypes.Virtual_Guest_Block_Device, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Disk_Image", "getBlockDevices", nil, &r.Options, &resp)
 	return
 }
 
+// GetBlockDevicesWith is GetBlockDevices, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Disk_Image) GetBlockDevicesWith(options sl.Options) (resp []datatypes.Virtual_Guest_Block_Device, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockDevices()
+}
+
 // Retrieve
 func (r Virtual_Disk_Image) GetBootableVolumeFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Disk_Image", "getBootableVolumeFlag", nil, &r.Options, &resp)
@@ -261,6 +383,14 @@ func (r Virtual_Disk_Image) GetIsEncrypted() (resp bool, err error) {
 	return
 }
 
+// GetIsEncryptedWith is GetIsEncrypted, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Disk_Image) GetIsEncryptedWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIsEncrypted()
+}
+
 // Retrieve
 func (r Virtual_Disk_Image) GetLocalDiskFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Disk_Image", "getLocalDiskFlag", nil, &r.Options, &resp)
@@ -273,6 +403,14 @@ func (r Virtual_Disk_Image) GetMetadataFlag() (resp bool, err error) {
 	return
 }
 
+// GetMetadataFlagWith is GetMetadataFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Disk_Image) GetMetadataFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetadataFlag()
+}
+
 // no documentation yet
 func (r Virtual_Disk_Image) GetObject() (resp datatypes.Virtual_Disk_Image, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Disk_Image", "getObject", nil, &r.Options, &resp)
@@ -291,36 +429,84 @@ func (r Virtual_Disk_Image) GetSoftwareReferences() (resp []datatypes.Virtual_Di
 	return
 }
 
+// GetSoftwareReferencesWith is GetSoftwareReferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Disk_Image) GetSoftwareReferencesWith(options sl.Options) (resp []datatypes.Virtual_Disk_Image_Software, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareReferences()
+}
+
 // Retrieve The original disk image that the current disk image was cloned from.
 func (r Virtual_Disk_Image) GetSourceDiskImage() (resp datatypes.Virtual_Disk_Image, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Disk_Image", "getSourceDiskImage", nil, &r.Options, &resp)
 	return
 }
 
+// GetSourceDiskImageWith is GetSourceDiskImage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Disk_Image) GetSourceDiskImageWith(options sl.Options) (resp datatypes.Virtual_Disk_Image, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSourceDiskImage()
+}
+
 // Retrieve The storage repository that a disk image resides in.
 func (r Virtual_Disk_Image) GetStorageRepository() (resp datatypes.Virtual_Storage_Repository, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Disk_Image", "getStorageRepository", nil, &r.Options, &resp)
 	return
 }
 
+// GetStorageRepositoryWith is GetStorageRepository, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Disk_Image) GetStorageRepositoryWith(options sl.Options) (resp datatypes.Virtual_Storage_Repository, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStorageRepository()
+}
+
 // Retrieve The type of storage repository that a disk image resides in.
 func (r Virtual_Disk_Image) GetStorageRepositoryType() (resp datatypes.Virtual_Storage_Repository_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Disk_Image", "getStorageRepositoryType", nil, &r.Options, &resp)
 	return
 }
 
+// GetStorageRepositoryTypeWith is GetStorageRepositoryType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Disk_Image) GetStorageRepositoryTypeWith(options sl.Options) (resp datatypes.Virtual_Storage_Repository_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStorageRepositoryType()
+}
+
 // Retrieve The template that attaches a disk image to a [[SoftLayer_Virtual_Guest_Block_Device_Template_Group|archive]].
 func (r Virtual_Disk_Image) GetTemplateBlockDevice() (resp datatypes.Virtual_Guest_Block_Device_Template, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Disk_Image", "getTemplateBlockDevice", nil, &r.Options, &resp)
 	return
 }
 
+// GetTemplateBlockDeviceWith is GetTemplateBlockDevice, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Disk_Image) GetTemplateBlockDeviceWith(options sl.Options) (resp datatypes.Virtual_Guest_Block_Device_Template, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTemplateBlockDevice()
+}
+
 // Retrieve A virtual disk image's type.
 func (r Virtual_Disk_Image) GetType() (resp datatypes.Virtual_Disk_Image_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Disk_Image", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Disk_Image) GetTypeWith(options sl.Options) (resp datatypes.Virtual_Disk_Image_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // The virtual guest data type presents the structure in which all virtual guests will be presented. Internally, the structure supports various virtualization platforms with no change to external interaction.
 //
 // A guest, also known as a virtual server, represents an allocation of resources on a virtual host.
@@ -339,6 +525,16 @@ func (r Virtual_Guest) Id(id int) Virtual_Guest {
 	return r
 }
 
+func (r Virtual_Guest) InitParameter(name string, value interface{}) Virtual_Guest {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
+func (r Virtual_Guest) GlobalID(globalID string) Virtual_Guest {
+	r.Options.GlobalID = &globalID
+	return r
+}
+
 func (r Virtual_Guest) Mask(mask string) Virtual_Guest {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -358,11 +554,26 @@ func (r Virtual_Guest) Limit(limit int) Virtual_Guest {
 	return r
 }
 
+func (r Virtual_Guest) Unlimited() Virtual_Guest {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Virtual_Guest) Offset(offset int) Virtual_Guest {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Virtual_Guest) Timeout(timeout time.Duration) Virtual_Guest {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Virtual_Guest) WithContext(ctx context.Context) Virtual_Guest {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Activate the private network port
 func (r Virtual_Guest) ActivatePrivatePort() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "activatePrivatePort", nil, &r.Options, &resp)
@@ -994,6 +1205,14 @@ func (r Virtual_Guest) GetAccount() (resp datatypes.Account, err error) {
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve
 func (r Virtual_Guest) GetAccountOwnedPoolFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getAccountOwnedPoolFlag", nil, &r.Options, &resp)
@@ -1006,6 +1225,14 @@ func (r Virtual_Guest) GetActiveNetworkMonitorIncident() (resp []datatypes.Netwo
 	return
 }
 
+// GetActiveNetworkMonitorIncidentWith is GetActiveNetworkMonitorIncident, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetActiveNetworkMonitorIncidentWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveNetworkMonitorIncident()
+}
+
 // Retrieve
 func (r Virtual_Guest) GetActiveTickets() (resp []datatypes.Ticket, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getActiveTickets", nil, &r.Options, &resp)
@@ -1018,12 +1245,28 @@ func (r Virtual_Guest) GetActiveTransaction() (resp datatypes.Provisioning_Versi
 	return
 }
 
+// GetActiveTransactionWith is GetActiveTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetActiveTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransaction()
+}
+
 // Retrieve Any active transaction(s) that are currently running for the server (example: os reload).
 func (r Virtual_Guest) GetActiveTransactions() (resp []datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getActiveTransactions", nil, &r.Options, &resp)
 	return
 }
 
+// GetActiveTransactionsWith is GetActiveTransactions, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetActiveTransactionsWith(options sl.Options) (resp []datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetActiveTransactions()
+}
+
 // Return a collection of SoftLayer_Item_Price objects for an OS reload
 func (r Virtual_Guest) GetAdditionalRequiredPricesForOsReload(config *datatypes.Container_Hardware_Server_Configuration) (resp []datatypes.Product_Item_Price, err error) {
 	params := []interface{}{
@@ -1050,24 +1293,56 @@ func (r Virtual_Guest) GetAllowedHost() (resp datatypes.Network_Storage_Allowed_
 	return
 }
 
+// GetAllowedHostWith is GetAllowedHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetAllowedHostWith(options sl.Options) (resp datatypes.Network_Storage_Allowed_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedHost()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects that this SoftLayer_Virtual_Guest has access to.
 func (r Virtual_Guest) GetAllowedNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getAllowedNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageWith is GetAllowedNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetAllowedNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorage()
+}
+
 // Retrieve The SoftLayer_Network_Storage objects whose Replica that this SoftLayer_Virtual_Guest has access to.
 func (r Virtual_Guest) GetAllowedNetworkStorageReplicas() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getAllowedNetworkStorageReplicas", nil, &r.Options, &resp)
 	return
 }
 
+// GetAllowedNetworkStorageReplicasWith is GetAllowedNetworkStorageReplicas, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetAllowedNetworkStorageReplicasWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAllowedNetworkStorageReplicas()
+}
+
 // Retrieve A antivirus / spyware software component object.
 func (r Virtual_Guest) GetAntivirusSpywareSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getAntivirusSpywareSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetAntivirusSpywareSoftwareComponentWith is GetAntivirusSpywareSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetAntivirusSpywareSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAntivirusSpywareSoftwareComponent()
+}
+
 // Retrieve
 func (r Virtual_Guest) GetApplicationDeliveryController() (resp datatypes.Network_Application_Delivery_Controller, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getApplicationDeliveryController", nil, &r.Options, &resp)
@@ -1101,6 +1376,14 @@ func (r Virtual_Guest) GetAvailableMonitoring() (resp []datatypes.Network_Monito
 	return
 }
 
+// GetAvailableMonitoringWith is GetAvailableMonitoring, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetAvailableMonitoringWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAvailableMonitoring()
+}
+
 // This method retrieves a list of SoftLayer_Network_Storage volumes that can be authorized to this SoftLayer_Virtual_Guest.
 func (r Virtual_Guest) GetAvailableNetworkStorages(nasType *string) (resp []datatypes.Network_Storage, err error) {
 	params := []interface{}{
@@ -1116,36 +1399,84 @@ func (r Virtual_Guest) GetAverageDailyPrivateBandwidthUsage() (resp datatypes.Fl
 	return
 }
 
+// GetAverageDailyPrivateBandwidthUsageWith is GetAverageDailyPrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetAverageDailyPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPrivateBandwidthUsage()
+}
+
 // Retrieve The average daily public bandwidth usage for the current billing cycle.
 func (r Virtual_Guest) GetAverageDailyPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getAverageDailyPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetAverageDailyPublicBandwidthUsageWith is GetAverageDailyPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetAverageDailyPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAverageDailyPublicBandwidthUsage()
+}
+
 // Retrieve A guests's backend network components.
 func (r Virtual_Guest) GetBackendNetworkComponents() (resp []datatypes.Virtual_Guest_Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getBackendNetworkComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetBackendNetworkComponentsWith is GetBackendNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetBackendNetworkComponentsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendNetworkComponents()
+}
+
 // Retrieve A guest's backend or private router.
 func (r Virtual_Guest) GetBackendRouters() (resp []datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getBackendRouters", nil, &r.Options, &resp)
 	return
 }
 
+// GetBackendRoutersWith is GetBackendRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetBackendRoutersWith(options sl.Options) (resp []datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendRouters()
+}
+
 // Retrieve A computing instance's allotted bandwidth (measured in GB).
 func (r Virtual_Guest) GetBandwidthAllocation() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getBandwidthAllocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllocationWith is GetBandwidthAllocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetBandwidthAllocationWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllocation()
+}
+
 // Retrieve A computing instance's allotted detail record. Allotment details link bandwidth allocation with allotments.
 func (r Virtual_Guest) GetBandwidthAllotmentDetail() (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getBandwidthAllotmentDetail", nil, &r.Options, &resp)
 	return
 }
 
+// GetBandwidthAllotmentDetailWith is GetBandwidthAllotmentDetail, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetBandwidthAllotmentDetailWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment_Detail, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBandwidthAllotmentDetail()
+}
+
 // Use this method when needing the metric data for bandwidth for a single guest.  It will gather the correct input parameters based on the date ranges
 func (r Virtual_Guest) GetBandwidthDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time, networkType *string) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -1208,42 +1539,98 @@ func (r Virtual_Guest) GetBillingCycleBandwidthUsage() (resp []datatypes.Network
 	return
 }
 
+// GetBillingCycleBandwidthUsageWith is GetBillingCycleBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetBillingCycleBandwidthUsageWith(options sl.Options) (resp []datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCycleBandwidthUsage()
+}
+
 // Retrieve The raw private bandwidth usage data for the current billing cycle.
 func (r Virtual_Guest) GetBillingCyclePrivateBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getBillingCyclePrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCyclePrivateBandwidthUsageWith is GetBillingCyclePrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetBillingCyclePrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePrivateBandwidthUsage()
+}
+
 // Retrieve The raw public bandwidth usage data for the current billing cycle.
 func (r Virtual_Guest) GetBillingCyclePublicBandwidthUsage() (resp datatypes.Network_Bandwidth_Usage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getBillingCyclePublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingCyclePublicBandwidthUsageWith is GetBillingCyclePublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetBillingCyclePublicBandwidthUsageWith(options sl.Options) (resp datatypes.Network_Bandwidth_Usage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingCyclePublicBandwidthUsage()
+}
+
 // Retrieve The billing item for a CloudLayer Compute Instance.
 func (r Virtual_Guest) GetBillingItem() (resp datatypes.Billing_Item_Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item_Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve Determines whether the instance is ineligible for cancellation because it is disconnected.
 func (r Virtual_Guest) GetBlockCancelBecauseDisconnectedFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getBlockCancelBecauseDisconnectedFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBlockCancelBecauseDisconnectedFlagWith is GetBlockCancelBecauseDisconnectedFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetBlockCancelBecauseDisconnectedFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockCancelBecauseDisconnectedFlag()
+}
+
 // Retrieve The global identifier for the image template that was used to provision or reload a guest.
 func (r Virtual_Guest) GetBlockDeviceTemplateGroup() (resp datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getBlockDeviceTemplateGroup", nil, &r.Options, &resp)
 	return
 }
 
+// GetBlockDeviceTemplateGroupWith is GetBlockDeviceTemplateGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetBlockDeviceTemplateGroupWith(options sl.Options) (resp datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockDeviceTemplateGroup()
+}
+
 // Retrieve A computing instance's block devices. Block devices link [[SoftLayer_Virtual_Disk_Image|disk images]] to computing instances.
 func (r Virtual_Guest) GetBlockDevices() (resp []datatypes.Virtual_Guest_Block_Device, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getBlockDevices", nil, &r.Options, &resp)
 	return
 }
 
+// GetBlockDevicesWith is GetBlockDevices, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetBlockDevicesWith(options sl.Options) (resp []datatypes.Virtual_Guest_Block_Device, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockDevices()
+}
+
 // Retrieves the boot mode of the VSI.
 func (r Virtual_Guest) GetBootMode() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getBootMode", nil, &r.Options, &resp)
@@ -1268,24 +1655,56 @@ func (r Virtual_Guest) GetConsoleIpAddressFlag() (resp bool, err error) {
 	return
 }
 
+// GetConsoleIpAddressFlagWith is GetConsoleIpAddressFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetConsoleIpAddressFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetConsoleIpAddressFlag()
+}
+
 // Retrieve A record containing information about a computing instance's console IP and port number.
 func (r Virtual_Guest) GetConsoleIpAddressRecord() (resp datatypes.Virtual_Guest_Network_Component_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getConsoleIpAddressRecord", nil, &r.Options, &resp)
 	return
 }
 
+// GetConsoleIpAddressRecordWith is GetConsoleIpAddressRecord, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetConsoleIpAddressRecordWith(options sl.Options) (resp datatypes.Virtual_Guest_Network_Component_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetConsoleIpAddressRecord()
+}
+
 // Retrieve A continuous data protection software component object.
 func (r Virtual_Guest) GetContinuousDataProtectionSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getContinuousDataProtectionSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetContinuousDataProtectionSoftwareComponentWith is GetContinuousDataProtectionSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetContinuousDataProtectionSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetContinuousDataProtectionSoftwareComponent()
+}
+
 // Retrieve A guest's control panel.
 func (r Virtual_Guest) GetControlPanel() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getControlPanel", nil, &r.Options, &resp)
 	return
 }
 
+// GetControlPanelWith is GetControlPanel, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetControlPanelWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetControlPanel()
+}
+
 // If the virtual server currently has an operating system that has a core capacity restriction, return the associated core-restricted operating system item price. Some operating systems (e.g., Red Hat Enterprise Linux) may be billed by the number of processor cores, so therefore require that a certain number of cores be present on the server.
 func (r Virtual_Guest) GetCoreRestrictedOperatingSystemPrice() (resp datatypes.Product_Item_Price, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getCoreRestrictedOperatingSystemPrice", nil, &r.Options, &resp)
@@ -1340,6 +1759,14 @@ func (r Virtual_Guest) GetCurrentBandwidthSummary() (resp datatypes.Metric_Track
 	return
 }
 
+// GetCurrentBandwidthSummaryWith is GetCurrentBandwidthSummary, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetCurrentBandwidthSummaryWith(options sl.Options) (resp datatypes.Metric_Tracking_Object_Bandwidth_Summary, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetCurrentBandwidthSummary()
+}
+
 // Get the billing detail for this instance for the current billing period. This does not include bandwidth usage.
 func (r Virtual_Guest) GetCurrentBillingDetail() (resp []datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getCurrentBillingDetail", nil, &r.Options, &resp)
@@ -1376,12 +1803,28 @@ func (r Virtual_Guest) GetDatacenter() (resp datatypes.Location, err error) {
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // Retrieve The dedicated host associated with this guest.
 func (r Virtual_Guest) GetDedicatedHost() (resp datatypes.Virtual_DedicatedHost, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getDedicatedHost", nil, &r.Options, &resp)
 	return
 }
 
+// GetDedicatedHostWith is GetDedicatedHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetDedicatedHostWith(options sl.Options) (resp datatypes.Virtual_DedicatedHost, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDedicatedHost()
+}
+
 // Return a drive retention SoftLayer_Item_Price object for a guest.
 func (r Virtual_Guest) GetDriveRetentionItemPrice() (resp datatypes.Product_Item_Price, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getDriveRetentionItemPrice", nil, &r.Options, &resp)
@@ -1394,6 +1837,14 @@ func (r Virtual_Guest) GetEvaultNetworkStorage() (resp []datatypes.Network_Stora
 	return
 }
 
+// GetEvaultNetworkStorageWith is GetEvaultNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetEvaultNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetEvaultNetworkStorage()
+}
+
 // Get the subnets associated with this CloudLayer computing instance that are protectable by a network component firewall.
 func (r Virtual_Guest) GetFirewallProtectableSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getFirewallProtectableSubnets", nil, &r.Options, &resp)
@@ -1406,6 +1857,14 @@ func (r Virtual_Guest) GetFirewallServiceComponent() (resp datatypes.Network_Com
 	return
 }
 
+// GetFirewallServiceComponentWith is GetFirewallServiceComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetFirewallServiceComponentWith(options sl.Options) (resp datatypes.Network_Component_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFirewallServiceComponent()
+}
+
 // no documentation yet
 func (r Virtual_Guest) GetFirstAvailableBlockDevicePosition() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getFirstAvailableBlockDevicePosition", nil, &r.Options, &resp)
@@ -1418,18 +1877,42 @@ func (r Virtual_Guest) GetFrontendNetworkComponents() (resp []datatypes.Virtual_
 	return
 }
 
+// GetFrontendNetworkComponentsWith is GetFrontendNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetFrontendNetworkComponentsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendNetworkComponents()
+}
+
 // Retrieve A guest's frontend or public router.
 func (r Virtual_Guest) GetFrontendRouters() (resp datatypes.Hardware, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getFrontendRouters", nil, &r.Options, &resp)
 	return
 }
 
+// GetFrontendRoutersWith is GetFrontendRouters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetFrontendRoutersWith(options sl.Options) (resp datatypes.Hardware, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFrontendRouters()
+}
+
 // Retrieve A guest's universally unique identifier.
 func (r Virtual_Guest) GetGlobalIdentifier() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getGlobalIdentifier", nil, &r.Options, &resp)
 	return
 }
 
+// GetGlobalIdentifierWith is GetGlobalIdentifier, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetGlobalIdentifierWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGlobalIdentifier()
+}
+
 // Retrieve
 func (r Virtual_Guest) GetGuestBootParameter() (resp datatypes.Virtual_Guest_Boot_Parameter, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getGuestBootParameter", nil, &r.Options, &resp)
@@ -1442,30 +1925,70 @@ func (r Virtual_Guest) GetHost() (resp datatypes.Virtual_Host, err error) {
 	return
 }
 
+// GetHostWith is GetHost, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetHostWith(options sl.Options) (resp datatypes.Virtual_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHost()
+}
+
 // Retrieve A host IPS software component object.
 func (r Virtual_Guest) GetHostIpsSoftwareComponent() (resp datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getHostIpsSoftwareComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetHostIpsSoftwareComponentWith is GetHostIpsSoftwareComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetHostIpsSoftwareComponentWith(options sl.Options) (resp datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHostIpsSoftwareComponent()
+}
+
 // Retrieve A guest's hourly billing status.
 func (r Virtual_Guest) GetHourlyBillingFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getHourlyBillingFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetHourlyBillingFlagWith is GetHourlyBillingFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetHourlyBillingFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHourlyBillingFlag()
+}
+
 // Retrieve The total private inbound bandwidth for this computing instance for the current billing cycle.
 func (r Virtual_Guest) GetInboundPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getInboundPrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundPrivateBandwidthUsageWith is GetInboundPrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetInboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPrivateBandwidthUsage()
+}
+
 // Retrieve The total public inbound bandwidth for this computing instance for the current billing cycle.
 func (r Virtual_Guest) GetInboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getInboundPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetInboundPublicBandwidthUsageWith is GetInboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetInboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInboundPublicBandwidthUsage()
+}
+
 // Retrieve
 func (r Virtual_Guest) GetInternalTagReferences() (resp []datatypes.Tag_Reference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getInternalTagReferences", nil, &r.Options, &resp)
@@ -1495,42 +2018,98 @@ func (r Virtual_Guest) GetLastKnownPowerState() (resp datatypes.Virtual_Guest_Po
 	return
 }
 
+// GetLastKnownPowerStateWith is GetLastKnownPowerState, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetLastKnownPowerStateWith(options sl.Options) (resp datatypes.Virtual_Guest_Power_State, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastKnownPowerState()
+}
+
 // Retrieve The last transaction that a cloud server's operating system was loaded.
 func (r Virtual_Guest) GetLastOperatingSystemReload() (resp datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getLastOperatingSystemReload", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastOperatingSystemReloadWith is GetLastOperatingSystemReload, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetLastOperatingSystemReloadWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastOperatingSystemReload()
+}
+
 // Retrieve The last transaction a cloud server had performed.
 func (r Virtual_Guest) GetLastTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getLastTransaction", nil, &r.Options, &resp)
 	return
 }
 
+// GetLastTransactionWith is GetLastTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetLastTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLastTransaction()
+}
+
 // Retrieve A virtual guest's latest network monitoring incident.
 func (r Virtual_Guest) GetLatestNetworkMonitorIncident() (resp datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getLatestNetworkMonitorIncident", nil, &r.Options, &resp)
 	return
 }
 
+// GetLatestNetworkMonitorIncidentWith is GetLatestNetworkMonitorIncident, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetLatestNetworkMonitorIncidentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLatestNetworkMonitorIncident()
+}
+
 // Retrieve A flag indicating that the virtual guest has at least one disk which is local to the host it runs on. This does not include a SWAP device.
 func (r Virtual_Guest) GetLocalDiskFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getLocalDiskFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocalDiskFlagWith is GetLocalDiskFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetLocalDiskFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocalDiskFlag()
+}
+
 // Retrieve Where guest is located within SoftLayer's location hierarchy.
 func (r Virtual_Guest) GetLocation() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getLocation", nil, &r.Options, &resp)
 	return
 }
 
+// GetLocationWith is GetLocation, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetLocationWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetLocation()
+}
+
 // Retrieve A flag indicating that the virtual guest is a managed resource.
 func (r Virtual_Guest) GetManagedResourceFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getManagedResourceFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetManagedResourceFlagWith is GetManagedResourceFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetManagedResourceFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetManagedResourceFlag()
+}
+
 // Use this method when needing the metric data for memory for a single computing instance.
 func (r Virtual_Guest) GetMemoryMetricDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -1567,12 +2146,28 @@ func (r Virtual_Guest) GetMetricTrackingObject() (resp datatypes.Metric_Tracking
 	return
 }
 
+// GetMetricTrackingObjectWith is GetMetricTrackingObject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+}
+
 // Retrieve The metric tracking object id for this guest.
 func (r Virtual_Guest) GetMetricTrackingObjectId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getMetricTrackingObjectId", nil, &r.Options, &resp)
 	return
 }
 
+// GetMetricTrackingObjectIdWith is GetMetricTrackingObjectId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetMetricTrackingObjectIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObjectId()
+}
+
 // Returns open monitoring alarms for a given time period
 func (r Virtual_Guest) GetMonitoringActiveAlarms(startDate *datatypes.Time, endDate *datatypes.Time) (resp []datatypes.Container_Monitoring_Alarm_History, err error) {
 	params := []interface{}{
@@ -1611,6 +2206,14 @@ func (r Virtual_Guest) GetMonitoringServiceComponent() (resp datatypes.Network_M
 	return
 }
 
+// GetMonitoringServiceComponentWith is GetMonitoringServiceComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetMonitoringServiceComponentWith(options sl.Options) (resp datatypes.Network_Monitor_Version1_Query_Host_Stratum, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringServiceComponent()
+}
+
 // Retrieve
 func (r Virtual_Guest) GetMonitoringServiceEligibilityFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getMonitoringServiceEligibilityFlag", nil, &r.Options, &resp)
@@ -1629,6 +2232,14 @@ func (r Virtual_Guest) GetMonitoringUserNotification() (resp []datatypes.User_Cu
 	return
 }
 
+// GetMonitoringUserNotificationWith is GetMonitoringUserNotification, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetMonitoringUserNotificationWith(options sl.Options) (resp []datatypes.User_Customer_Notification_Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMonitoringUserNotification()
+}
+
 // Get the IP addresses associated with this CloudLayer computing instance that are protectable by a network component firewall. Note, this may not return all values for IPv6 subnets for this CloudLayer computing instance. Please use getFirewallProtectableSubnets to get all protectable subnets.
 func (r Virtual_Guest) GetNetworkComponentFirewallProtectableIpAddresses() (resp []datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getNetworkComponentFirewallProtectableIpAddresses", nil, &r.Options, &resp)
@@ -1641,30 +2252,70 @@ func (r Virtual_Guest) GetNetworkComponents() (resp []datatypes.Virtual_Guest_Ne
 	return
 }
 
+// GetNetworkComponentsWith is GetNetworkComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetNetworkComponentsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponents()
+}
+
 // Retrieve All of a virtual guest's network monitoring incidents.
 func (r Virtual_Guest) GetNetworkMonitorIncidents() (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getNetworkMonitorIncidents", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorIncidentsWith is GetNetworkMonitorIncidents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetNetworkMonitorIncidentsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Incident, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitorIncidents()
+}
+
 // Retrieve A guests's network monitors.
 func (r Virtual_Guest) GetNetworkMonitors() (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getNetworkMonitors", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkMonitorsWith is GetNetworkMonitors, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetNetworkMonitorsWith(options sl.Options) (resp []datatypes.Network_Monitor_Version1_Query_Host, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkMonitors()
+}
+
 // Retrieve A guest's associated network storage accounts.
 func (r Virtual_Guest) GetNetworkStorage() (resp []datatypes.Network_Storage, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getNetworkStorage", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkStorageWith is GetNetworkStorage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetNetworkStorageWith(options sl.Options) (resp []datatypes.Network_Storage, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkStorage()
+}
+
 // Retrieve The network Vlans that a guest's network components are associated with.
 func (r Virtual_Guest) GetNetworkVlans() (resp []datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getNetworkVlans", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlansWith is GetNetworkVlans, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetNetworkVlansWith(options sl.Options) (resp []datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlans()
+}
+
 // no documentation yet
 func (r Virtual_Guest) GetObject() (resp datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getObject", nil, &r.Options, &resp)
@@ -1677,18 +2328,42 @@ func (r Virtual_Guest) GetOpenCancellationTicket() (resp datatypes.Ticket, err e
 	return
 }
 
+// GetOpenCancellationTicketWith is GetOpenCancellationTicket, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetOpenCancellationTicketWith(options sl.Options) (resp datatypes.Ticket, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOpenCancellationTicket()
+}
+
 // Retrieve A guest's operating system.
 func (r Virtual_Guest) GetOperatingSystem() (resp datatypes.Software_Component_OperatingSystem, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getOperatingSystem", nil, &r.Options, &resp)
 	return
 }
 
+// GetOperatingSystemWith is GetOperatingSystem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetOperatingSystemWith(options sl.Options) (resp datatypes.Software_Component_OperatingSystem, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystem()
+}
+
 // Retrieve A guest's operating system software description.
 func (r Virtual_Guest) GetOperatingSystemReferenceCode() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getOperatingSystemReferenceCode", nil, &r.Options, &resp)
 	return
 }
 
+// GetOperatingSystemReferenceCodeWith is GetOperatingSystemReferenceCode, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetOperatingSystemReferenceCodeWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOperatingSystemReferenceCode()
+}
+
 // Obtain an order container that is ready to be sent to the [[SoftLayer_Product_Order#placeOrder|SoftLayer_Product_Order::placeOrder]] method. This container will include all services that the selected computing instance has. If desired you may remove prices which were returned.
 func (r Virtual_Guest) GetOrderTemplate(billingType *string, orderPrices []datatypes.Product_Item_Price) (resp datatypes.Container_Product_Order, err error) {
 	params := []interface{}{
@@ -1705,84 +2380,196 @@ func (r Virtual_Guest) GetOrderedPackageId() (resp string, err error) {
 	return
 }
 
+// GetOrderedPackageIdWith is GetOrderedPackageId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetOrderedPackageIdWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOrderedPackageId()
+}
+
 // Retrieve The total private outbound bandwidth for this computing instance for the current billing cycle.
 func (r Virtual_Guest) GetOutboundPrivateBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getOutboundPrivateBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundPrivateBandwidthUsageWith is GetOutboundPrivateBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetOutboundPrivateBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPrivateBandwidthUsage()
+}
+
 // Retrieve The total public outbound bandwidth for this computing instance for the current billing cycle.
 func (r Virtual_Guest) GetOutboundPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getOutboundPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetOutboundPublicBandwidthUsageWith is GetOutboundPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetOutboundPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOutboundPublicBandwidthUsage()
+}
+
 // Retrieve Whether the bandwidth usage for this computing instance for the current billing cycle exceeds the allocation.
 func (r Virtual_Guest) GetOverBandwidthAllocationFlag() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getOverBandwidthAllocationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetOverBandwidthAllocationFlagWith is GetOverBandwidthAllocationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOverBandwidthAllocationFlag()
+}
+
 // Retrieve When true this virtual guest must be migrated using SoftLayer_Virtual_Guest::migrate.
 func (r Virtual_Guest) GetPendingMigrationFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getPendingMigrationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetPendingMigrationFlagWith is GetPendingMigrationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetPendingMigrationFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPendingMigrationFlag()
+}
+
 // Retrieve The placement group that a virtual guest belongs to.
 func (r Virtual_Guest) GetPlacementGroup() (resp datatypes.Virtual_PlacementGroup, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getPlacementGroup", nil, &r.Options, &resp)
 	return
 }
 
+// GetPlacementGroupWith is GetPlacementGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetPlacementGroupWith(options sl.Options) (resp datatypes.Virtual_PlacementGroup, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPlacementGroup()
+}
+
 // Retrieve The current power state of a virtual guest.
 func (r Virtual_Guest) GetPowerState() (resp datatypes.Virtual_Guest_Power_State, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getPowerState", nil, &r.Options, &resp)
 	return
 }
 
+// GetPowerStateWith is GetPowerState, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetPowerStateWith(options sl.Options) (resp datatypes.Virtual_Guest_Power_State, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPowerState()
+}
+
 // Retrieve A guest's primary private IP address.
 func (r Virtual_Guest) GetPrimaryBackendIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getPrimaryBackendIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryBackendIpAddressWith is GetPrimaryBackendIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetPrimaryBackendIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendIpAddress()
+}
+
 // Retrieve A guest's primary backend network component.
 func (r Virtual_Guest) GetPrimaryBackendNetworkComponent() (resp datatypes.Virtual_Guest_Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getPrimaryBackendNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryBackendNetworkComponentWith is GetPrimaryBackendNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetPrimaryBackendNetworkComponentWith(options sl.Options) (resp datatypes.Virtual_Guest_Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryBackendNetworkComponent()
+}
+
 // Retrieve The guest's primary public IP address.
 func (r Virtual_Guest) GetPrimaryIpAddress() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getPrimaryIpAddress", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryIpAddressWith is GetPrimaryIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetPrimaryIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryIpAddress()
+}
+
 // Retrieve A guest's primary public network component.
 func (r Virtual_Guest) GetPrimaryNetworkComponent() (resp datatypes.Virtual_Guest_Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getPrimaryNetworkComponent", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryNetworkComponentWith is GetPrimaryNetworkComponent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetPrimaryNetworkComponentWith(options sl.Options) (resp datatypes.Virtual_Guest_Network_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryNetworkComponent()
+}
+
 // Retrieve Whether the computing instance only has access to the private network.
 func (r Virtual_Guest) GetPrivateNetworkOnlyFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getPrivateNetworkOnlyFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrivateNetworkOnlyFlagWith is GetPrivateNetworkOnlyFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetPrivateNetworkOnlyFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrivateNetworkOnlyFlag()
+}
+
 // Retrieve Whether the bandwidth usage for this computing instance for the current billing cycle is projected to exceed the allocation.
 func (r Virtual_Guest) GetProjectedOverBandwidthAllocationFlag() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getProjectedOverBandwidthAllocationFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetProjectedOverBandwidthAllocationFlagWith is GetProjectedOverBandwidthAllocationFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetProjectedOverBandwidthAllocationFlagWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedOverBandwidthAllocationFlag()
+}
+
 // Retrieve The projected public outbound bandwidth for this computing instance for the current billing cycle.
 func (r Virtual_Guest) GetProjectedPublicBandwidthUsage() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getProjectedPublicBandwidthUsage", nil, &r.Options, &resp)
 	return
 }
 
+// GetProjectedPublicBandwidthUsageWith is GetProjectedPublicBandwidthUsage, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetProjectedPublicBandwidthUsageWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetProjectedPublicBandwidthUsage()
+}
+
 // no documentation yet
 func (r Virtual_Guest) GetProvisionDate() (resp datatypes.Time, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getProvisionDate", nil, &r.Options, &resp)
@@ -1795,6 +2582,14 @@ func (r Virtual_Guest) GetRecentEvents() (resp []datatypes.Notification_Occurren
 	return
 }
 
+// GetRecentEventsWith is GetRecentEvents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetRecentEventsWith(options sl.Options) (resp []datatypes.Notification_Occurrence_Event, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRecentEvents()
+}
+
 // Recent metric data for a guest
 func (r Virtual_Guest) GetRecentMetricData(time *uint) (resp []datatypes.Metric_Tracking_Object, err error) {
 	params := []interface{}{
@@ -1810,6 +2605,14 @@ func (r Virtual_Guest) GetRegionalGroup() (resp datatypes.Location_Group_Regiona
 	return
 }
 
+// GetRegionalGroupWith is GetRegionalGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetRegionalGroupWith(options sl.Options) (resp datatypes.Location_Group_Regional, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRegionalGroup()
+}
+
 // Retrieve
 func (r Virtual_Guest) GetRegionalInternetRegistry() (resp datatypes.Network_Regional_Internet_Registry, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getRegionalInternetRegistry", nil, &r.Options, &resp)
@@ -1846,18 +2649,42 @@ func (r Virtual_Guest) GetReservedCapacityGroup() (resp datatypes.Virtual_Reserv
 	return
 }
 
+// GetReservedCapacityGroupWith is GetReservedCapacityGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetReservedCapacityGroupWith(options sl.Options) (resp datatypes.Virtual_ReservedCapacityGroup, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReservedCapacityGroup()
+}
+
 // Retrieve Flag to indicate whether or not a guest is part of a reserved capacity group.
 func (r Virtual_Guest) GetReservedCapacityGroupFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getReservedCapacityGroupFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetReservedCapacityGroupFlagWith is GetReservedCapacityGroupFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetReservedCapacityGroupFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReservedCapacityGroupFlag()
+}
+
 // Retrieve The reserved capacity group instance the guest is associated with.
 func (r Virtual_Guest) GetReservedCapacityGroupInstance() (resp datatypes.Virtual_ReservedCapacityGroup_Instance, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getReservedCapacityGroupInstance", nil, &r.Options, &resp)
 	return
 }
 
+// GetReservedCapacityGroupInstanceWith is GetReservedCapacityGroupInstance, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetReservedCapacityGroupInstanceWith(options sl.Options) (resp datatypes.Virtual_ReservedCapacityGroup_Instance, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReservedCapacityGroupInstance()
+}
+
 // Retrieve the reverse domain records associated with this server.
 func (r Virtual_Guest) GetReverseDomainRecords() (resp []datatypes.Dns_Domain, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getReverseDomainRecords", nil, &r.Options, &resp)
@@ -1870,48 +2697,112 @@ func (r Virtual_Guest) GetScaleAssets() (resp []datatypes.Scale_Asset, err error
 	return
 }
 
+// GetScaleAssetsWith is GetScaleAssets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetScaleAssetsWith(options sl.Options) (resp []datatypes.Scale_Asset, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleAssets()
+}
+
 // Retrieve The scale member for this guest, if applicable.
 func (r Virtual_Guest) GetScaleMember() (resp datatypes.Scale_Member_Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getScaleMember", nil, &r.Options, &resp)
 	return
 }
 
+// GetScaleMemberWith is GetScaleMember, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetScaleMemberWith(options sl.Options) (resp datatypes.Scale_Member_Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaleMember()
+}
+
 // Retrieve Whether or not this guest is a member of a scale group and was automatically created as part of a scale group action.
 func (r Virtual_Guest) GetScaledFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getScaledFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetScaledFlagWith is GetScaledFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetScaledFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetScaledFlag()
+}
+
 // Retrieve A guest's vulnerability scan requests.
 func (r Virtual_Guest) GetSecurityScanRequests() (resp []datatypes.Network_Security_Scanner_Request, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getSecurityScanRequests", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecurityScanRequestsWith is GetSecurityScanRequests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetSecurityScanRequestsWith(options sl.Options) (resp []datatypes.Network_Security_Scanner_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityScanRequests()
+}
+
 // Retrieve The server room that a guest is located at. There may be more than one server room for every data center.
 func (r Virtual_Guest) GetServerRoom() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getServerRoom", nil, &r.Options, &resp)
 	return
 }
 
+// GetServerRoomWith is GetServerRoom, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetServerRoomWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetServerRoom()
+}
+
 // Retrieve A guest's installed software.
 func (r Virtual_Guest) GetSoftwareComponents() (resp []datatypes.Software_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getSoftwareComponents", nil, &r.Options, &resp)
 	return
 }
 
+// GetSoftwareComponentsWith is GetSoftwareComponents, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetSoftwareComponentsWith(options sl.Options) (resp []datatypes.Software_Component, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSoftwareComponents()
+}
+
 // Retrieve SSH keys to be installed on the server during provisioning or an OS reload.
 func (r Virtual_Guest) GetSshKeys() (resp []datatypes.Security_Ssh_Key, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getSshKeys", nil, &r.Options, &resp)
 	return
 }
 
+// GetSshKeysWith is GetSshKeys, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSshKeys()
+}
+
 // Retrieve A computing instance's status.
 func (r Virtual_Guest) GetStatus() (resp datatypes.Virtual_Guest_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetStatusWith(options sl.Options) (resp datatypes.Virtual_Guest_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // Retrieve
 func (r Virtual_Guest) GetTagReferences() (resp []datatypes.Tag_Reference, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getTagReferences", nil, &r.Options, &resp)
@@ -1924,18 +2815,42 @@ func (r Virtual_Guest) GetTransientGuestFlag() (resp bool, err error) {
 	return
 }
 
+// GetTransientGuestFlagWith is GetTransientGuestFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetTransientGuestFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTransientGuestFlag()
+}
+
 // Retrieve The endpoint used to notify customers their transient guest is terminating.
 func (r Virtual_Guest) GetTransientWebhookURI() (resp datatypes.Virtual_Guest_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getTransientWebhookURI", nil, &r.Options, &resp)
 	return
 }
 
+// GetTransientWebhookURIWith is GetTransientWebhookURI, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetTransientWebhookURIWith(options sl.Options) (resp datatypes.Virtual_Guest_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTransientWebhookURI()
+}
+
 // Retrieve The type of this virtual guest.
 func (r Virtual_Guest) GetType() (resp datatypes.Virtual_Guest_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getType", nil, &r.Options, &resp)
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetTypeWith(options sl.Options) (resp datatypes.Virtual_Guest_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // getUpgradeItemPrices() retrieves a list of all upgrades available to a CloudLayer Computing Instance. Upgradeable items include, but are not limited to, number of cores, amount of RAM, storage configuration, and network port speed.
 //
 // This method exclude downgrade item prices by default. You can set the "includeDowngradeItemPrices" parameter to true so that it can include downgrade item prices.
@@ -1953,18 +2868,42 @@ func (r Virtual_Guest) GetUpgradeRequest() (resp datatypes.Product_Upgrade_Reque
 	return
 }
 
+// GetUpgradeRequestWith is GetUpgradeRequest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetUpgradeRequestWith(options sl.Options) (resp datatypes.Product_Upgrade_Request, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUpgradeRequest()
+}
+
 // Retrieve A base64 encoded string containing custom user data for a Cloud Computing Instance order.
 func (r Virtual_Guest) GetUserData() (resp []datatypes.Virtual_Guest_Attribute, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getUserData", nil, &r.Options, &resp)
 	return
 }
 
+// GetUserDataWith is GetUserData, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetUserDataWith(options sl.Options) (resp []datatypes.Virtual_Guest_Attribute, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUserData()
+}
+
 // Retrieve A list of users that have access to this computing instance.
 func (r Virtual_Guest) GetUsers() (resp []datatypes.User_Customer, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getUsers", nil, &r.Options, &resp)
 	return
 }
 
+// GetUsersWith is GetUsers, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetUsersWith(options sl.Options) (resp []datatypes.User_Customer, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetUsers()
+}
+
 // This method will return the list of block device template groups that are valid to the host. For instance, it will validate that the template groups returned are compatible with the size and number of disks on the host.
 func (r Virtual_Guest) GetValidBlockDeviceTemplateGroups(visibility *string) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
 	params := []interface{}{
@@ -1980,18 +2919,42 @@ func (r Virtual_Guest) GetVirtualRack() (resp datatypes.Network_Bandwidth_Versio
 	return
 }
 
+// GetVirtualRackWith is GetVirtualRack, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetVirtualRackWith(options sl.Options) (resp datatypes.Network_Bandwidth_Version1_Allotment, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRack()
+}
+
 // Retrieve The id of the bandwidth allotment that a computing instance belongs too.
 func (r Virtual_Guest) GetVirtualRackId() (resp int, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getVirtualRackId", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackIdWith is GetVirtualRackId, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetVirtualRackIdWith(options sl.Options) (resp int, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackId()
+}
+
 // Retrieve The name of the bandwidth allotment that a computing instance belongs too.
 func (r Virtual_Guest) GetVirtualRackName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "getVirtualRackName", nil, &r.Options, &resp)
 	return
 }
 
+// GetVirtualRackNameWith is GetVirtualRackName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest) GetVirtualRackNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetVirtualRackName()
+}
+
 // Issues a ping command and returns the success (true) or failure (false) of the ping command.
 func (r Virtual_Guest) IsBackendPingable() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest", "isBackendPingable", nil, &r.Options, &resp)
@@ -2251,6 +3214,16 @@ func (r Virtual_Guest_Block_Device_Template_Group) Id(id int) Virtual_Guest_Bloc
 	return r
 }
 
+func (r Virtual_Guest_Block_Device_Template_Group) InitParameter(name string, value interface{}) Virtual_Guest_Block_Device_Template_Group {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
+func (r Virtual_Guest_Block_Device_Template_Group) GlobalID(globalID string) Virtual_Guest_Block_Device_Template_Group {
+	r.Options.GlobalID = &globalID
+	return r
+}
+
 func (r Virtual_Guest_Block_Device_Template_Group) Mask(mask string) Virtual_Guest_Block_Device_Template_Group {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2270,11 +3243,26 @@ func (r Virtual_Guest_Block_Device_Template_Group) Limit(limit int) Virtual_Gues
 	return r
 }
 
+func (r Virtual_Guest_Block_Device_Template_Group) Unlimited() Virtual_Guest_Block_Device_Template_Group {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Virtual_Guest_Block_Device_Template_Group) Offset(offset int) Virtual_Guest_Block_Device_Template_Group {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Virtual_Guest_Block_Device_Template_Group) Timeout(timeout time.Duration) Virtual_Guest_Block_Device_Template_Group {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Virtual_Guest_Block_Device_Template_Group) WithContext(ctx context.Context) Virtual_Guest_Block_Device_Template_Group {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // This method allows you to mark this image template as customer managed software license (BYOL)
 func (r Virtual_Guest_Block_Device_Template_Group) AddByolAttribute() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "addByolAttribute", nil, &r.Options, &resp)
@@ -2404,6 +3392,14 @@ func (r Virtual_Guest_Block_Device_Template_Group) GetAccount() (resp datatypes.
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve
 func (r Virtual_Guest_Block_Device_Template_Group) GetAccountContacts() (resp []datatypes.Account_Contact, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getAccountContacts", nil, &r.Options, &resp)
@@ -2416,18 +3412,42 @@ func (r Virtual_Guest_Block_Device_Template_Group) GetAccountReferences() (resp
 	return
 }
 
+// GetAccountReferencesWith is GetAccountReferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetAccountReferencesWith(options sl.Options) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group_Accounts, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccountReferences()
+}
+
 // Retrieve The block devices that are part of an image template group
 func (r Virtual_Guest_Block_Device_Template_Group) GetBlockDevices() (resp []datatypes.Virtual_Guest_Block_Device_Template, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getBlockDevices", nil, &r.Options, &resp)
 	return
 }
 
+// GetBlockDevicesWith is GetBlockDevices, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetBlockDevicesWith(options sl.Options) (resp []datatypes.Virtual_Guest_Block_Device_Template, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockDevices()
+}
+
 // Retrieve The total disk space of all images in a image template group.
 func (r Virtual_Guest_Block_Device_Template_Group) GetBlockDevicesDiskSpaceTotal() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getBlockDevicesDiskSpaceTotal", nil, &r.Options, &resp)
 	return
 }
 
+// GetBlockDevicesDiskSpaceTotalWith is GetBlockDevicesDiskSpaceTotal, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetBlockDevicesDiskSpaceTotalWith(options sl.Options) (resp datatypes.Float64, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBlockDevicesDiskSpaceTotal()
+}
+
 // This method returns the boot mode, if any, set on a given image template.
 func (r Virtual_Guest_Block_Device_Template_Group) GetBootMode() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getBootMode", nil, &r.Options, &resp)
@@ -2440,24 +3460,56 @@ func (r Virtual_Guest_Block_Device_Template_Group) GetByolFlag() (resp bool, err
 	return
 }
 
+// GetByolFlagWith is GetByolFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetByolFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetByolFlag()
+}
+
 // Retrieve The image template groups that are clones of an image template group.
 func (r Virtual_Guest_Block_Device_Template_Group) GetChildren() (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getChildren", nil, &r.Options, &resp)
 	return
 }
 
+// GetChildrenWith is GetChildren, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetChildrenWith(options sl.Options) (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetChildren()
+}
+
 // Retrieve The location containing this image template group. Will only be populated for child template group objects.
 func (r Virtual_Guest_Block_Device_Template_Group) GetDatacenter() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getDatacenter", nil, &r.Options, &resp)
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // Retrieve A collection of locations containing a copy of this image template group. Will only be populated for parent template group objects.
 func (r Virtual_Guest_Block_Device_Template_Group) GetDatacenters() (resp []datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getDatacenters", nil, &r.Options, &resp)
 	return
 }
 
+// GetDatacentersWith is GetDatacenters, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetDatacentersWith(options sl.Options) (resp []datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenters()
+}
+
 // This method returns the default boot mode set by the software description
 func (r Virtual_Guest_Block_Device_Template_Group) GetDefaultBootMode() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getDefaultBootMode", nil, &r.Options, &resp)
@@ -2476,24 +3528,56 @@ func (r Virtual_Guest_Block_Device_Template_Group) GetFlexImageFlag() (resp bool
 	return
 }
 
+// GetFlexImageFlagWith is GetFlexImageFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetFlexImageFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetFlexImageFlag()
+}
+
 // Retrieve An image template's universally unique identifier.
 func (r Virtual_Guest_Block_Device_Template_Group) GetGlobalIdentifier() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getGlobalIdentifier", nil, &r.Options, &resp)
 	return
 }
 
+// GetGlobalIdentifierWith is GetGlobalIdentifier, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetGlobalIdentifierWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGlobalIdentifier()
+}
+
 // Retrieve The virtual disk image type of this template. Value will be populated on parent and child, but only supports object filtering on the parent.
 func (r Virtual_Guest_Block_Device_Template_Group) GetImageType() (resp datatypes.Virtual_Disk_Image_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getImageType", nil, &r.Options, &resp)
 	return
 }
 
+// GetImageTypeWith is GetImageType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetImageTypeWith(options sl.Options) (resp datatypes.Virtual_Disk_Image_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetImageType()
+}
+
 // Retrieve The virtual disk image type keyname (e.g. SYSTEM, DISK_CAPTURE, ISO, etc) of this template. Value will be populated on parent and child, but only supports object filtering on the parent.
 func (r Virtual_Guest_Block_Device_Template_Group) GetImageTypeKeyName() (resp string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getImageTypeKeyName", nil, &r.Options, &resp)
 	return
 }
 
+// GetImageTypeKeyNameWith is GetImageTypeKeyName, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetImageTypeKeyNameWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetImageTypeKeyName()
+}
+
 // no documentation yet
 func (r Virtual_Guest_Block_Device_Template_Group) GetObject() (resp datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getObject", nil, &r.Options, &resp)
@@ -2506,6 +3590,14 @@ func (r Virtual_Guest_Block_Device_Template_Group) GetParent() (resp datatypes.V
 	return
 }
 
+// GetParentWith is GetParent, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetParentWith(options sl.Options) (resp datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetParent()
+}
+
 // This method gets all public customer owned image templates that the user is allowed to see.
 func (r Virtual_Guest_Block_Device_Template_Group) GetPublicCustomerOwnedImages() (resp []datatypes.Virtual_Guest_Block_Device_Template_Group, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getPublicCustomerOwnedImages", nil, &r.Options, &resp)
@@ -2524,12 +3616,28 @@ func (r Virtual_Guest_Block_Device_Template_Group) GetSshKeys() (resp []datatype
 	return
 }
 
+// GetSshKeysWith is GetSshKeys, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetSshKeysWith(options sl.Options) (resp []datatypes.Security_Ssh_Key, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSshKeys()
+}
+
 // Retrieve A template group's status.
 func (r Virtual_Guest_Block_Device_Template_Group) GetStatus() (resp datatypes.Virtual_Guest_Block_Device_Template_Group_Status, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getStatus", nil, &r.Options, &resp)
 	return
 }
 
+// GetStatusWith is GetStatus, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetStatusWith(options sl.Options) (resp datatypes.Virtual_Guest_Block_Device_Template_Group_Status, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStatus()
+}
+
 // Returns the image storage locations.
 func (r Virtual_Guest_Block_Device_Template_Group) GetStorageLocations() (resp []datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getStorageLocations", nil, &r.Options, &resp)
@@ -2542,6 +3650,14 @@ func (r Virtual_Guest_Block_Device_Template_Group) GetStorageRepository() (resp
 	return
 }
 
+// GetStorageRepositoryWith is GetStorageRepository, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetStorageRepositoryWith(options sl.Options) (resp datatypes.Virtual_Storage_Repository, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetStorageRepository()
+}
+
 // This method indicates which boot modes are supported by the image.
 func (r Virtual_Guest_Block_Device_Template_Group) GetSupportedBootModes() (resp []string, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getSupportedBootModes", nil, &r.Options, &resp)
@@ -2554,12 +3670,28 @@ func (r Virtual_Guest_Block_Device_Template_Group) GetTagReferences() (resp []da
 	return
 }
 
+// GetTagReferencesWith is GetTagReferences, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetTagReferencesWith(options sl.Options) (resp []datatypes.Tag_Reference, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTagReferences()
+}
+
 // Retrieve A transaction that is being performed on a image template group.
 func (r Virtual_Guest_Block_Device_Template_Group) GetTransaction() (resp datatypes.Provisioning_Version1_Transaction, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getTransaction", nil, &r.Options, &resp)
 	return
 }
 
+// GetTransactionWith is GetTransaction, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Block_Device_Template_Group) GetTransactionWith(options sl.Options) (resp datatypes.Provisioning_Version1_Transaction, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetTransaction()
+}
+
 // Returns an array of SoftLayer_Software_Description that are supported for VHD imports.
 func (r Virtual_Guest_Block_Device_Template_Group) GetVhdImportSoftwareDescriptions() (resp []datatypes.Software_Description, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getVhdImportSoftwareDescriptions", nil, &r.Options, &resp)
@@ -2672,6 +3804,11 @@ func (r Virtual_Guest_Boot_Parameter) Id(id int) Virtual_Guest_Boot_Parameter {
 	return r
 }
 
+func (r Virtual_Guest_Boot_Parameter) InitParameter(name string, value interface{}) Virtual_Guest_Boot_Parameter {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Virtual_Guest_Boot_Parameter) Mask(mask string) Virtual_Guest_Boot_Parameter {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2691,11 +3828,26 @@ func (r Virtual_Guest_Boot_Parameter) Limit(limit int) Virtual_Guest_Boot_Parame
 	return r
 }
 
+func (r Virtual_Guest_Boot_Parameter) Unlimited() Virtual_Guest_Boot_Parameter {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Virtual_Guest_Boot_Parameter) Offset(offset int) Virtual_Guest_Boot_Parameter {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Virtual_Guest_Boot_Parameter) Timeout(timeout time.Duration) Virtual_Guest_Boot_Parameter {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Virtual_Guest_Boot_Parameter) WithContext(ctx context.Context) Virtual_Guest_Boot_Parameter {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Virtual_Guest_Boot_Parameter) CreateObject(templateObject *datatypes.Virtual_Guest_Boot_Parameter) (resp bool, err error) {
 	params := []interface{}{
@@ -2754,6 +3906,11 @@ func (r Virtual_Guest_Boot_Parameter_Type) Id(id int) Virtual_Guest_Boot_Paramet
 	return r
 }
 
+func (r Virtual_Guest_Boot_Parameter_Type) InitParameter(name string, value interface{}) Virtual_Guest_Boot_Parameter_Type {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Virtual_Guest_Boot_Parameter_Type) Mask(mask string) Virtual_Guest_Boot_Parameter_Type {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2773,11 +3930,26 @@ func (r Virtual_Guest_Boot_Parameter_Type) Limit(limit int) Virtual_Guest_Boot_P
 	return r
 }
 
+func (r Virtual_Guest_Boot_Parameter_Type) Unlimited() Virtual_Guest_Boot_Parameter_Type {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Virtual_Guest_Boot_Parameter_Type) Offset(offset int) Virtual_Guest_Boot_Parameter_Type {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Virtual_Guest_Boot_Parameter_Type) Timeout(timeout time.Duration) Virtual_Guest_Boot_Parameter_Type {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Virtual_Guest_Boot_Parameter_Type) WithContext(ctx context.Context) Virtual_Guest_Boot_Parameter_Type {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Virtual_Guest_Boot_Parameter_Type) GetAllObjects() (resp []datatypes.Virtual_Guest_Boot_Parameter_Type, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Boot_Parameter_Type", "getAllObjects", nil, &r.Options, &resp)
@@ -2808,6 +3980,11 @@ func (r Virtual_Guest_Network_Component) Id(id int) Virtual_Guest_Network_Compon
 	return r
 }
 
+func (r Virtual_Guest_Network_Component) InitParameter(name string, value interface{}) Virtual_Guest_Network_Component {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Virtual_Guest_Network_Component) Mask(mask string) Virtual_Guest_Network_Component {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2827,11 +4004,26 @@ func (r Virtual_Guest_Network_Component) Limit(limit int) Virtual_Guest_Network_
 	return r
 }
 
+func (r Virtual_Guest_Network_Component) Unlimited() Virtual_Guest_Network_Component {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Virtual_Guest_Network_Component) Offset(offset int) Virtual_Guest_Network_Component {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Virtual_Guest_Network_Component) Timeout(timeout time.Duration) Virtual_Guest_Network_Component {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Virtual_Guest_Network_Component) WithContext(ctx context.Context) Virtual_Guest_Network_Component {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Completely restrict all incoming and outgoing bandwidth traffic to a network component
 func (r Virtual_Guest_Network_Component) Disable() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Network_Component", "disable", nil, &r.Options, &resp)
@@ -2850,6 +4042,14 @@ func (r Virtual_Guest_Network_Component) GetGuest() (resp datatypes.Virtual_Gues
 	return
 }
 
+// GetGuestWith is GetGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Network_Component) GetGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGuest()
+}
+
 // Retrieve
 func (r Virtual_Guest_Network_Component) GetHighAvailabilityFirewallFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Network_Component", "getHighAvailabilityFirewallFlag", nil, &r.Options, &resp)
@@ -2868,18 +4068,42 @@ func (r Virtual_Guest_Network_Component) GetIpAddressBindings() (resp []datatype
 	return
 }
 
+// GetIpAddressBindingsWith is GetIpAddressBindings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Network_Component) GetIpAddressBindingsWith(options sl.Options) (resp []datatypes.Virtual_Guest_Network_Component_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetIpAddressBindings()
+}
+
 // Retrieve The upstream network component firewall.
 func (r Virtual_Guest_Network_Component) GetNetworkComponentFirewall() (resp datatypes.Network_Component_Firewall, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Network_Component", "getNetworkComponentFirewall", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkComponentFirewallWith is GetNetworkComponentFirewall, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Network_Component) GetNetworkComponentFirewallWith(options sl.Options) (resp datatypes.Network_Component_Firewall, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkComponentFirewall()
+}
+
 // Retrieve The VLAN that a computing instance network component's subnet is associated with.
 func (r Virtual_Guest_Network_Component) GetNetworkVlan() (resp datatypes.Network_Vlan, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Network_Component", "getNetworkVlan", nil, &r.Options, &resp)
 	return
 }
 
+// GetNetworkVlanWith is GetNetworkVlan, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Network_Component) GetNetworkVlanWith(options sl.Options) (resp datatypes.Network_Vlan, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetNetworkVlan()
+}
+
 // no documentation yet
 func (r Virtual_Guest_Network_Component) GetObject() (resp datatypes.Virtual_Guest_Network_Component, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Network_Component", "getObject", nil, &r.Options, &resp)
@@ -2892,6 +4116,14 @@ func (r Virtual_Guest_Network_Component) GetPrimaryIpAddress() (resp string, err
 	return
 }
 
+// GetPrimaryIpAddressWith is GetPrimaryIpAddress, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Network_Component) GetPrimaryIpAddressWith(options sl.Options) (resp string, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryIpAddress()
+}
+
 // Retrieve
 func (r Virtual_Guest_Network_Component) GetPrimaryIpAddressRecord() (resp datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Network_Component", "getPrimaryIpAddressRecord", nil, &r.Options, &resp)
@@ -2904,30 +4136,70 @@ func (r Virtual_Guest_Network_Component) GetPrimarySubnet() (resp datatypes.Netw
 	return
 }
 
+// GetPrimarySubnetWith is GetPrimarySubnet, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Network_Component) GetPrimarySubnetWith(options sl.Options) (resp datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimarySubnet()
+}
+
 // Retrieve A network component's primary IPv6 IP address record.
 func (r Virtual_Guest_Network_Component) GetPrimaryVersion6IpAddressRecord() (resp datatypes.Network_Subnet_IpAddress, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Network_Component", "getPrimaryVersion6IpAddressRecord", nil, &r.Options, &resp)
 	return
 }
 
+// GetPrimaryVersion6IpAddressRecordWith is GetPrimaryVersion6IpAddressRecord, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Network_Component) GetPrimaryVersion6IpAddressRecordWith(options sl.Options) (resp datatypes.Network_Subnet_IpAddress, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPrimaryVersion6IpAddressRecord()
+}
+
 // Retrieve A network component's routers.
 func (r Virtual_Guest_Network_Component) GetRouter() (resp datatypes.Hardware_Router, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Network_Component", "getRouter", nil, &r.Options, &resp)
 	return
 }
 
+// GetRouterWith is GetRouter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Network_Component) GetRouterWith(options sl.Options) (resp datatypes.Hardware_Router, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRouter()
+}
+
 // Retrieve The bindings associating security groups to this network component
 func (r Virtual_Guest_Network_Component) GetSecurityGroupBindings() (resp []datatypes.Virtual_Network_SecurityGroup_NetworkComponentBinding, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Network_Component", "getSecurityGroupBindings", nil, &r.Options, &resp)
 	return
 }
 
+// GetSecurityGroupBindingsWith is GetSecurityGroupBindings, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Network_Component) GetSecurityGroupBindingsWith(options sl.Options) (resp []datatypes.Virtual_Network_SecurityGroup_NetworkComponentBinding, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSecurityGroupBindings()
+}
+
 // Retrieve A network component's subnets. A subnet is a group of IP addresses
 func (r Virtual_Guest_Network_Component) GetSubnets() (resp []datatypes.Network_Subnet, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Network_Component", "getSubnets", nil, &r.Options, &resp)
 	return
 }
 
+// GetSubnetsWith is GetSubnets, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Guest_Network_Component) GetSubnetsWith(options sl.Options) (resp []datatypes.Network_Subnet, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetSubnets()
+}
+
 // Issues a ping command and returns the success (true) or failure (false) of the ping command.
 func (r Virtual_Guest_Network_Component) IsPingable() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Guest_Network_Component", "isPingable", nil, &r.Options, &resp)
@@ -2956,6 +4228,11 @@ func (r Virtual_Host) Id(id int) Virtual_Host {
 	return r
 }
 
+func (r Virtual_Host) InitParameter(name string, value interface{}) Virtual_Host {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Virtual_Host) Mask(mask string) Virtual_Host {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -2975,41 +4252,96 @@ func (r Virtual_Host) Limit(limit int) Virtual_Host {
 	return r
 }
 
+func (r Virtual_Host) Unlimited() Virtual_Host {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Virtual_Host) Offset(offset int) Virtual_Host {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Virtual_Host) Timeout(timeout time.Duration) Virtual_Host {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Virtual_Host) WithContext(ctx context.Context) Virtual_Host {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The account which a virtual host belongs to.
 func (r Virtual_Host) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Host", "getAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Host) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve Boolean flag indicating whether this virtualization platform gets billed per guest rather than at a fixed rate.
 func (r Virtual_Host) GetBilledPerGuestFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Host", "getBilledPerGuestFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBilledPerGuestFlagWith is GetBilledPerGuestFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Host) GetBilledPerGuestFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBilledPerGuestFlag()
+}
+
 // Retrieve Boolean flag indicating whether this virtualization platform gets billed per memory usage rather than at a fixed rate.
 func (r Virtual_Host) GetBilledPerMemoryUsageFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Host", "getBilledPerMemoryUsageFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetBilledPerMemoryUsageFlagWith is GetBilledPerMemoryUsageFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Host) GetBilledPerMemoryUsageFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBilledPerMemoryUsageFlag()
+}
+
 // Retrieve The guests associated with a virtual host.
 func (r Virtual_Host) GetGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Host", "getGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetGuestsWith is GetGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Host) GetGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGuests()
+}
+
 // Retrieve The hardware record which a virtual host resides on.
 func (r Virtual_Host) GetHardware() (resp datatypes.Hardware_Server, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Host", "getHardware", nil, &r.Options, &resp)
 	return
 }
 
+// GetHardwareWith is GetHardware, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Host) GetHardwareWith(options sl.Options) (resp datatypes.Hardware_Server, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetHardware()
+}
+
 // Query a virtualization platform directly to retrieve details regarding a guest.
 func (r Virtual_Host) GetLiveGuestByUuid(uuid *string) (resp datatypes.Virtual_Guest, err error) {
 	params := []interface{}{
@@ -3043,6 +4375,14 @@ func (r Virtual_Host) GetMetricTrackingObject() (resp datatypes.Metric_Tracking_
 	return
 }
 
+// GetMetricTrackingObjectWith is GetMetricTrackingObject, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Host) GetMetricTrackingObjectWith(options sl.Options) (resp datatypes.Metric_Tracking_Object, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetMetricTrackingObject()
+}
+
 // no documentation yet
 func (r Virtual_Host) GetObject() (resp datatypes.Virtual_Host, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Host", "getObject", nil, &r.Options, &resp)
@@ -3125,6 +4465,11 @@ func (r Virtual_PlacementGroup) Id(id int) Virtual_PlacementGroup {
 	return r
 }
 
+func (r Virtual_PlacementGroup) InitParameter(name string, value interface{}) Virtual_PlacementGroup {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Virtual_PlacementGroup) Mask(mask string) Virtual_PlacementGroup {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3144,11 +4489,26 @@ func (r Virtual_PlacementGroup) Limit(limit int) Virtual_PlacementGroup {
 	return r
 }
 
+func (r Virtual_PlacementGroup) Unlimited() Virtual_PlacementGroup {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Virtual_PlacementGroup) Offset(offset int) Virtual_PlacementGroup {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Virtual_PlacementGroup) Timeout(timeout time.Duration) Virtual_PlacementGroup {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Virtual_PlacementGroup) WithContext(ctx context.Context) Virtual_PlacementGroup {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Add a placement group to your account for use during VSI provisioning.
 func (r Virtual_PlacementGroup) CreateObject(templateObject *datatypes.Virtual_PlacementGroup) (resp datatypes.Virtual_PlacementGroup, err error) {
 	params := []interface{}{
@@ -3179,6 +4539,14 @@ func (r Virtual_PlacementGroup) GetAccount() (resp datatypes.Account, err error)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_PlacementGroup) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Returns all routers available for use with placement groups. If a datacenter location ID is provided, this method will further restrict the list of routers to ones contained within that datacenter.
 func (r Virtual_PlacementGroup) GetAvailableRouters(datacenterId *int) (resp []datatypes.Hardware, err error) {
 	params := []interface{}{
@@ -3194,12 +4562,28 @@ func (r Virtual_PlacementGroup) GetBackendRouter() (resp datatypes.Hardware_Rout
 	return
 }
 
+// GetBackendRouterWith is GetBackendRouter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_PlacementGroup) GetBackendRouterWith(options sl.Options) (resp datatypes.Hardware_Router_Backend, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendRouter()
+}
+
 // Retrieve The virtual guests that are members of the placement group.
 func (r Virtual_PlacementGroup) GetGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_PlacementGroup", "getGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetGuestsWith is GetGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_PlacementGroup) GetGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGuests()
+}
+
 // no documentation yet
 func (r Virtual_PlacementGroup) GetObject() (resp datatypes.Virtual_PlacementGroup, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_PlacementGroup", "getObject", nil, &r.Options, &resp)
@@ -3212,6 +4596,14 @@ func (r Virtual_PlacementGroup) GetRule() (resp datatypes.Virtual_PlacementGroup
 	return
 }
 
+// GetRuleWith is GetRule, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_PlacementGroup) GetRuleWith(options sl.Options) (resp datatypes.Virtual_PlacementGroup_Rule, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetRule()
+}
+
 // This data type presents the structure of a virtual guest placement group rule.
 type Virtual_PlacementGroup_Rule struct {
 	Session *session.Session
@@ -3228,6 +4620,11 @@ func (r Virtual_PlacementGroup_Rule) Id(id int) Virtual_PlacementGroup_Rule {
 	return r
 }
 
+func (r Virtual_PlacementGroup_Rule) InitParameter(name string, value interface{}) Virtual_PlacementGroup_Rule {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Virtual_PlacementGroup_Rule) Mask(mask string) Virtual_PlacementGroup_Rule {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3247,11 +4644,26 @@ func (r Virtual_PlacementGroup_Rule) Limit(limit int) Virtual_PlacementGroup_Rul
 	return r
 }
 
+func (r Virtual_PlacementGroup_Rule) Unlimited() Virtual_PlacementGroup_Rule {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Virtual_PlacementGroup_Rule) Offset(offset int) Virtual_PlacementGroup_Rule {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Virtual_PlacementGroup_Rule) Timeout(timeout time.Duration) Virtual_PlacementGroup_Rule {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Virtual_PlacementGroup_Rule) WithContext(ctx context.Context) Virtual_PlacementGroup_Rule {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // no documentation yet
 func (r Virtual_PlacementGroup_Rule) GetObject() (resp datatypes.Virtual_PlacementGroup_Rule, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_PlacementGroup_Rule", "getObject", nil, &r.Options, &resp)
@@ -3274,6 +4686,11 @@ func (r Virtual_ReservedCapacityGroup) Id(id int) Virtual_ReservedCapacityGroup
 	return r
 }
 
+func (r Virtual_ReservedCapacityGroup) InitParameter(name string, value interface{}) Virtual_ReservedCapacityGroup {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Virtual_ReservedCapacityGroup) Mask(mask string) Virtual_ReservedCapacityGroup {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3293,11 +4710,26 @@ func (r Virtual_ReservedCapacityGroup) Limit(limit int) Virtual_ReservedCapacity
 	return r
 }
 
+func (r Virtual_ReservedCapacityGroup) Unlimited() Virtual_ReservedCapacityGroup {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Virtual_ReservedCapacityGroup) Offset(offset int) Virtual_ReservedCapacityGroup {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Virtual_ReservedCapacityGroup) Timeout(timeout time.Duration) Virtual_ReservedCapacityGroup {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Virtual_ReservedCapacityGroup) WithContext(ctx context.Context) Virtual_ReservedCapacityGroup {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Update a reserved capacity group.
 func (r Virtual_ReservedCapacityGroup) EditObject(templateObject *datatypes.Virtual_ReservedCapacityGroup) (resp bool, err error) {
 	params := []interface{}{
@@ -3313,24 +4745,56 @@ func (r Virtual_ReservedCapacityGroup) GetAccount() (resp datatypes.Account, err
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_ReservedCapacityGroup) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Retrieve The instances available for guest provisions on this reserved capacity group.
 func (r Virtual_ReservedCapacityGroup) GetAvailableInstances() (resp []datatypes.Virtual_ReservedCapacityGroup_Instance, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_ReservedCapacityGroup", "getAvailableInstances", nil, &r.Options, &resp)
 	return
 }
 
+// GetAvailableInstancesWith is GetAvailableInstances, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_ReservedCapacityGroup) GetAvailableInstancesWith(options sl.Options) (resp []datatypes.Virtual_ReservedCapacityGroup_Instance, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAvailableInstances()
+}
+
 // Retrieve The router the reserved capacity group is implemented on.
 func (r Virtual_ReservedCapacityGroup) GetBackendRouter() (resp datatypes.Hardware_Router_Backend, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_ReservedCapacityGroup", "getBackendRouter", nil, &r.Options, &resp)
 	return
 }
 
+// GetBackendRouterWith is GetBackendRouter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_ReservedCapacityGroup) GetBackendRouterWith(options sl.Options) (resp datatypes.Hardware_Router_Backend, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBackendRouter()
+}
+
 // Retrieve The guest instances that are members of this reserved capacity group.
 func (r Virtual_ReservedCapacityGroup) GetInstances() (resp []datatypes.Virtual_ReservedCapacityGroup_Instance, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_ReservedCapacityGroup", "getInstances", nil, &r.Options, &resp)
 	return
 }
 
+// GetInstancesWith is GetInstances, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_ReservedCapacityGroup) GetInstancesWith(options sl.Options) (resp []datatypes.Virtual_ReservedCapacityGroup_Instance, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetInstances()
+}
+
 // no documentation yet
 func (r Virtual_ReservedCapacityGroup) GetObject() (resp datatypes.Virtual_ReservedCapacityGroup, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_ReservedCapacityGroup", "getObject", nil, &r.Options, &resp)
@@ -3343,6 +4807,14 @@ func (r Virtual_ReservedCapacityGroup) GetOccupiedInstances() (resp []datatypes.
 	return
 }
 
+// GetOccupiedInstancesWith is GetOccupiedInstances, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_ReservedCapacityGroup) GetOccupiedInstancesWith(options sl.Options) (resp []datatypes.Virtual_ReservedCapacityGroup_Instance, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetOccupiedInstances()
+}
+
 // This data type presents the structure for a virtual reserved capacity group instance.
 type Virtual_ReservedCapacityGroup_Instance struct {
 	Session *session.Session
@@ -3359,6 +4831,11 @@ func (r Virtual_ReservedCapacityGroup_Instance) Id(id int) Virtual_ReservedCapac
 	return r
 }
 
+func (r Virtual_ReservedCapacityGroup_Instance) InitParameter(name string, value interface{}) Virtual_ReservedCapacityGroup_Instance {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Virtual_ReservedCapacityGroup_Instance) Mask(mask string) Virtual_ReservedCapacityGroup_Instance {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3378,29 +4855,68 @@ func (r Virtual_ReservedCapacityGroup_Instance) Limit(limit int) Virtual_Reserve
 	return r
 }
 
+func (r Virtual_ReservedCapacityGroup_Instance) Unlimited() Virtual_ReservedCapacityGroup_Instance {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Virtual_ReservedCapacityGroup_Instance) Offset(offset int) Virtual_ReservedCapacityGroup_Instance {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Virtual_ReservedCapacityGroup_Instance) Timeout(timeout time.Duration) Virtual_ReservedCapacityGroup_Instance {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Virtual_ReservedCapacityGroup_Instance) WithContext(ctx context.Context) Virtual_ReservedCapacityGroup_Instance {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve Flag to indecate whether or not the reserved instance is available or not.
 func (r Virtual_ReservedCapacityGroup_Instance) GetAvailableFlag() (resp bool, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_ReservedCapacityGroup_Instance", "getAvailableFlag", nil, &r.Options, &resp)
 	return
 }
 
+// GetAvailableFlagWith is GetAvailableFlag, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_ReservedCapacityGroup_Instance) GetAvailableFlagWith(options sl.Options) (resp bool, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAvailableFlag()
+}
+
 // Retrieve The billing item for the reserved capacity group instance.
 func (r Virtual_ReservedCapacityGroup_Instance) GetBillingItem() (resp datatypes.Billing_Item, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_ReservedCapacityGroup_Instance", "getBillingItem", nil, &r.Options, &resp)
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_ReservedCapacityGroup_Instance) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The virtual guest associated with this reserved capacity group instance.
 func (r Virtual_ReservedCapacityGroup_Instance) GetGuest() (resp datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_ReservedCapacityGroup_Instance", "getGuest", nil, &r.Options, &resp)
 	return
 }
 
+// GetGuestWith is GetGuest, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_ReservedCapacityGroup_Instance) GetGuestWith(options sl.Options) (resp datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGuest()
+}
+
 // no documentation yet
 func (r Virtual_ReservedCapacityGroup_Instance) GetObject() (resp datatypes.Virtual_ReservedCapacityGroup_Instance, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_ReservedCapacityGroup_Instance", "getObject", nil, &r.Options, &resp)
@@ -3413,6 +4929,14 @@ func (r Virtual_ReservedCapacityGroup_Instance) GetReservedCapacityGroup() (resp
 	return
 }
 
+// GetReservedCapacityGroupWith is GetReservedCapacityGroup, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_ReservedCapacityGroup_Instance) GetReservedCapacityGroupWith(options sl.Options) (resp datatypes.Virtual_ReservedCapacityGroup, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetReservedCapacityGroup()
+}
+
 // The SoftLayer_Virtual_Storage_Repository represents a web based storage system that can be accessed through many types of devices, interfaces, and other resources.
 type Virtual_Storage_Repository struct {
 	Session *session.Session
@@ -3429,6 +4953,11 @@ func (r Virtual_Storage_Repository) Id(id int) Virtual_Storage_Repository {
 	return r
 }
 
+func (r Virtual_Storage_Repository) InitParameter(name string, value interface{}) Virtual_Storage_Repository {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
 func (r Virtual_Storage_Repository) Mask(mask string) Virtual_Storage_Repository {
 	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 		mask = fmt.Sprintf("mask[%s]", mask)
@@ -3448,17 +4977,40 @@ func (r Virtual_Storage_Repository) Limit(limit int) Virtual_Storage_Repository
 	return r
 }
 
+func (r Virtual_Storage_Repository) Unlimited() Virtual_Storage_Repository {
+	r.Options.Unlimited = true
+	return r
+}
+
 func (r Virtual_Storage_Repository) Offset(offset int) Virtual_Storage_Repository {
 	r.Options.Offset = &offset
 	return r
 }
 
+func (r Virtual_Storage_Repository) Timeout(timeout time.Duration) Virtual_Storage_Repository {
+	r.Options.Timeout = timeout
+	return r
+}
+
+func (r Virtual_Storage_Repository) WithContext(ctx context.Context) Virtual_Storage_Repository {
+	r.Session = r.Session.SetContext(ctx)
+	return r
+}
+
 // Retrieve The [[SoftLayer_Account|account]] that a storage repository belongs to.
 func (r Virtual_Storage_Repository) GetAccount() (resp datatypes.Account, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Storage_Repository", "getAccount", nil, &r.Options, &resp)
 	return
 }
 
+// GetAccountWith is GetAccount, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Storage_Repository) GetAccountWith(options sl.Options) (resp datatypes.Account, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetAccount()
+}
+
 // Returns the archive storage disk usage fee rate per gigabyte.
 func (r Virtual_Storage_Repository) GetArchiveDiskUsageRatePerGb() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Storage_Repository", "getArchiveDiskUsageRatePerGb", nil, &r.Options, &resp)
@@ -3481,24 +5033,56 @@ func (r Virtual_Storage_Repository) GetBillingItem() (resp datatypes.Billing_Ite
 	return
 }
 
+// GetBillingItemWith is GetBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Storage_Repository) GetBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetBillingItem()
+}
+
 // Retrieve The datacenter that a virtual storage repository resides in.
 func (r Virtual_Storage_Repository) GetDatacenter() (resp datatypes.Location, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Storage_Repository", "getDatacenter", nil, &r.Options, &resp)
 	return
 }
 
+// GetDatacenterWith is GetDatacenter, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Storage_Repository) GetDatacenterWith(options sl.Options) (resp datatypes.Location, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDatacenter()
+}
+
 // Retrieve The [[SoftLayer_Virtual_Disk_Image|disk images]] that are in a storage repository. Disk images are the virtual hard drives for a virtual guest.
 func (r Virtual_Storage_Repository) GetDiskImages() (resp []datatypes.Virtual_Disk_Image, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Storage_Repository", "getDiskImages", nil, &r.Options, &resp)
 	return
 }
 
+// GetDiskImagesWith is GetDiskImages, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Storage_Repository) GetDiskImagesWith(options sl.Options) (resp []datatypes.Virtual_Disk_Image, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetDiskImages()
+}
+
 // Retrieve The computing instances that have disk images in a storage repository.
 func (r Virtual_Storage_Repository) GetGuests() (resp []datatypes.Virtual_Guest, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Storage_Repository", "getGuests", nil, &r.Options, &resp)
 	return
 }
 
+// GetGuestsWith is GetGuests, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Storage_Repository) GetGuestsWith(options sl.Options) (resp []datatypes.Virtual_Guest, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetGuests()
+}
+
 // Retrieve
 func (r Virtual_Storage_Repository) GetMetricTrackingObject() (resp datatypes.Metric_Tracking_Object_Virtual_Storage_Repository, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Storage_Repository", "getMetricTrackingObject", nil, &r.Options, &resp)
@@ -3517,6 +5101,14 @@ func (r Virtual_Storage_Repository) GetPublicImageBillingItem() (resp datatypes.
 	return
 }
 
+// GetPublicImageBillingItemWith is GetPublicImageBillingItem, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Storage_Repository) GetPublicImageBillingItemWith(options sl.Options) (resp datatypes.Billing_Item, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetPublicImageBillingItem()
+}
+
 // Returns the public image storage disk usage fee rate per gigabyte.
 func (r Virtual_Storage_Repository) GetPublicImageDiskUsageRatePerGb() (resp datatypes.Float64, err error) {
 	err = r.Session.DoRequest("SoftLayer_Virtual_Storage_Repository", "getPublicImageDiskUsageRatePerGb", nil, &r.Options, &resp)
@@ -3535,6 +5127,14 @@ func (r Virtual_Storage_Repository) GetType() (resp datatypes.Virtual_Storage_Re
 	return
 }
 
+// GetTypeWith is GetType, with the mask, filter and result limit of options applied to
+// this call only
+func (r Virtual_Storage_Repository) GetTypeWith(options sl.Options) (resp datatypes.Virtual_Storage_Repository_Type, err error) {
+	options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.GetType()
+}
+
 // Retrieve disk usage data on a [[SoftLayer_Virtual_Guest|Cloud Computing Instance]] image for the time range you provide.  Each data entry objects contain ''dateTime'' and ''counter'' properties. ''dateTime'' property indicates the time that the disk usage data was measured and ''counter'' property holds the disk usage in bytes.
 func (r Virtual_Storage_Repository) GetUsageMetricDataByDate(startDateTime *datatypes.Time, endDateTime *datatypes.Time) (resp []datatypes.Metric_Tracking_Object_Data, err error) {
 	params := []interface{}{
@@ -0,0 +1,226 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"sync"
+	"time"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Defaults of a CircuitBreaker
+const (
+	DefaultBreakerThreshold   = 0.5
+	DefaultBreakerWindow      = time.Minute
+	DefaultBreakerCooldown    = 30 * time.Second
+	DefaultBreakerMinRequests = 10
+)
+
+// CircuitState is the state of a CircuitBreaker
+type CircuitState int
+
+// Circuit breaker states
+const (
+	// CircuitClosed lets calls through
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen rejects calls with an sl.CircuitOpenError
+	CircuitOpen
+
+	// CircuitHalfOpen lets a single call through, which closes the circuit if
+	// it succeeds, or opens it again if it fails
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// CircuitBreaker stops calls from being sent while the API is failing, so that
+// large numbers of clients (or goroutines) do not pile retries onto it. It
+// opens when the rate of failed calls over Window reaches Threshold, rejects
+// calls with an sl.CircuitOpenError for Cooldown, then lets one call through
+// to probe the API.
+//
+// A CircuitBreaker is shared by copies of the session, and safe for concurrent
+// use. Each attempt of a call (see RetryPolicy) counts as a call.
+type CircuitBreaker struct {
+	// Threshold is the rate (0 to 1) of failed calls opening the breaker.
+	// Defaults to DefaultBreakerThreshold.
+	Threshold float64
+
+	// MinRequests is the number of calls over Window below which the breaker
+	// does not open. Defaults to DefaultBreakerMinRequests.
+	MinRequests int
+
+	// Window is the period over which the rate of failed calls is measured.
+	// Defaults to DefaultBreakerWindow.
+	Window time.Duration
+
+	// Cooldown is how long the breaker stays open. Defaults to
+	// DefaultBreakerCooldown.
+	Cooldown time.Duration
+
+	// Failure, when set, decides whether an error counts as a failure instead
+	// of IsTransient. API exceptions (e.g., object not found) do not count by
+	// default, since the API handled the call.
+	Failure func(err error) bool
+
+	mu          sync.Mutex
+	state       CircuitState
+	windowStart time.Time
+	calls       int
+	failures    int
+	openedAt    time.Time
+	probing     bool
+}
+
+// State returns the state of the breaker
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == CircuitOpen && time.Since(b.openedAt) >= b.cooldown() {
+		return CircuitHalfOpen
+	}
+
+	return b.state
+}
+
+// allow returns an sl.CircuitOpenError if a call may not be sent. probe is
+// true if the call is the one probing the API for a half-open breaker.
+func (b *CircuitBreaker) allow(service string, method string) (probe bool, err error) {
+	if b == nil {
+		return false, nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == CircuitOpen {
+		if wait := b.cooldown() - time.Since(b.openedAt); wait > 0 {
+			return false, sl.CircuitOpenError{Service: service, Method: method, RetryAfter: wait}
+		}
+		b.state = CircuitHalfOpen
+	}
+
+	if b.state == CircuitHalfOpen {
+		if b.probing {
+			return false, sl.CircuitOpenError{Service: service, Method: method}
+		}
+		b.probing = true
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// release gives up the call let through by allow without counting it (e.g.,
+// if the call could not be sent), so that a half-open breaker lets another
+// call probe the API
+func (b *CircuitBreaker) release(probe bool) {
+	if b == nil || !probe {
+		return
+	}
+
+	b.mu.Lock()
+	b.probing = false
+	b.mu.Unlock()
+}
+
+// record counts the outcome of a call let through by allow, opening or closing
+// the breaker as needed. While half-open, only the probe decides the state:
+// calls sent before the breaker opened and finishing late are ignored.
+func (b *CircuitBreaker) record(sess *Session, probe bool, err error) {
+	if b == nil {
+		return
+	}
+
+	failed := err != nil && IsTransient(err)
+	if b.Failure != nil {
+		failed = err != nil && b.Failure(err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	if b.state == CircuitHalfOpen {
+		if !probe {
+			return
+		}
+
+		b.probing = false
+		if failed {
+			b.open(sess, now)
+		} else {
+			b.state = CircuitClosed
+			b.windowStart, b.calls, b.failures = now, 0, 0
+			sess.log(LogInfo, "Circuit breaker closed")
+		}
+		return
+	}
+
+	window := b.Window
+	if window <= 0 {
+		window = DefaultBreakerWindow
+	}
+	if now.Sub(b.windowStart) >= window {
+		b.windowStart, b.calls, b.failures = now, 0, 0
+	}
+
+	b.calls++
+	if failed {
+		b.failures++
+	}
+
+	minRequests := b.MinRequests
+	if minRequests <= 0 {
+		minRequests = DefaultBreakerMinRequests
+	}
+
+	threshold := b.Threshold
+	if threshold <= 0 {
+		threshold = DefaultBreakerThreshold
+	}
+
+	if b.state == CircuitClosed && b.calls >= minRequests && float64(b.failures) >= threshold*float64(b.calls) {
+		b.open(sess, now)
+	}
+}
+
+func (b *CircuitBreaker) open(sess *Session, now time.Time) {
+	sess.log(LogWarn, "Circuit breaker opened", "calls", b.calls, "failures", b.failures, "cooldown", b.cooldown())
+	b.state = CircuitOpen
+	b.openedAt = now
+}
+
+func (b *CircuitBreaker) cooldown() time.Duration {
+	if b.Cooldown <= 0 {
+		return DefaultBreakerCooldown
+	}
+
+	return b.Cooldown
+}
@@ -0,0 +1,214 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var requests, healthy int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if atomic.LoadInt32(&healthy) == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	breaker := &CircuitBreaker{Threshold: 0.5, MinRequests: 4, Cooldown: 50 * time.Millisecond}
+	sess := (&Session{Endpoint: server.URL, StructuredLogger: &recordingLogger{}}).SetCircuitBreaker(breaker)
+
+	var result struct {
+		Id int `json:"id"`
+	}
+	for i := 0; i < 4; i++ {
+		if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err == nil {
+			t.Fatalf("Expected the call to fail")
+		}
+	}
+
+	if breaker.State() != CircuitOpen {
+		t.Fatalf("Expected the breaker to be open, got %s", breaker.State())
+	}
+
+	// Calls are rejected without being sent
+	var openErr sl.CircuitOpenError
+	err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	if !errors.As(err, &openErr) || openErr.Service != "SoftLayer_Account" || openErr.RetryAfter <= 0 || requests != 4 {
+		t.Fatalf("Expected a CircuitOpenError without request, got %v after %d requests", err, requests)
+	}
+
+	// A failed probe opens the breaker again
+	time.Sleep(60 * time.Millisecond)
+	if breaker.State() != CircuitHalfOpen {
+		t.Fatalf("Expected the breaker to be half-open, got %s", breaker.State())
+	}
+
+	if err = sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err == nil || errors.As(err, &openErr) || requests != 5 {
+		t.Fatalf("Expected the probe to be sent and fail, got %v", err)
+	}
+
+	if breaker.State() != CircuitOpen {
+		t.Fatalf("Expected the breaker to open again, got %s", breaker.State())
+	}
+
+	// A successful probe closes it
+	atomic.StoreInt32(&healthy, 1)
+	time.Sleep(60 * time.Millisecond)
+	if err = sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil || result.Id != 1 {
+		t.Fatalf("Expected the probe to succeed, got %v", err)
+	}
+
+	if breaker.State() != CircuitClosed {
+		t.Errorf("Expected the breaker to be closed, got %s", breaker.State())
+	}
+}
+
+func TestCircuitBreakerIgnoresApiErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error": "Unable to find object", "code": "SoftLayer_Exception_ObjectNotFound"}`)
+	}))
+	defer server.Close()
+
+	breaker := &CircuitBreaker{MinRequests: 2}
+	sess := (&Session{Endpoint: server.URL}).SetCircuitBreaker(breaker)
+
+	var result struct{}
+	for i := 0; i < 3; i++ {
+		sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	}
+
+	if breaker.State() != CircuitClosed {
+		t.Errorf("Expected API exceptions not to open the breaker, got %s", breaker.State())
+	}
+}
+
+func TestCircuitBreakerAuthenticationFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	breaker := &CircuitBreaker{Cooldown: time.Millisecond}
+	breaker.state, breaker.openedAt = CircuitOpen, time.Now().Add(-time.Second)
+
+	var refreshes int32
+	sess := (&Session{Endpoint: server.URL}).SetCircuitBreaker(breaker).
+		SetTokenRefresher(func(ctx context.Context) (int, string, time.Time, error) {
+			if atomic.AddInt32(&refreshes, 1) == 1 {
+				return 0, "", time.Time{}, errors.New("token endpoint down")
+			}
+			return 1, "token", time.Time{}, nil
+		})
+
+	var result struct{}
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err == nil {
+		t.Fatal("Expected the authentication to fail")
+	}
+
+	// The probe was not sent, so the next call probes the API
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+		t.Fatalf("Expected the probe to be sent, got %s", err)
+	}
+
+	if breaker.State() != CircuitClosed {
+		t.Errorf("Expected the breaker to be closed, got %s", breaker.State())
+	}
+}
+
+func TestCircuitBreakerStaleCallWhileHalfOpen(t *testing.T) {
+	arrived := make(chan string, 2)
+	finish := map[string]chan struct{}{"getStale": make(chan struct{}), "getProbe": make(chan struct{})}
+	var finished sync.Map
+	release := func(method string) {
+		if _, done := finished.LoadOrStore(method, true); !done {
+			close(finish[method])
+		}
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := strings.TrimSuffix(path.Base(r.URL.Path), ".json")
+		if done, ok := finish[method]; ok {
+			arrived <- method
+			<-done
+		}
+		if method == "getProbe" {
+			fmt.Fprint(w, `{"id": 1}`)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	defer release("getProbe")
+	defer release("getStale")
+
+	breaker := &CircuitBreaker{Threshold: 0.5, MinRequests: 2, Cooldown: 50 * time.Millisecond}
+	sess := (&Session{Endpoint: server.URL, TransportHandler: &RestTransport{}}).SetCircuitBreaker(breaker)
+
+	call := func(method string) chan error {
+		errs := make(chan error, 1)
+		go func() {
+			var result struct{}
+			errs <- sess.DoRequest("SoftLayer_Account", method, nil, &sl.Options{}, &result)
+		}()
+		<-arrived
+		return errs
+	}
+
+	// A call sent while the breaker is closed is still running when it opens
+	stale := call("getStale")
+	var result struct{}
+	for i := 0; i < 2; i++ {
+		sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	}
+	if breaker.State() != CircuitOpen {
+		t.Fatalf("Expected the breaker to be open, got %s", breaker.State())
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	probe := call("getProbe")
+
+	// Its failure does not decide for the probe
+	release("getStale")
+	<-stale
+	if breaker.State() != CircuitHalfOpen {
+		t.Fatalf("Expected the stale call to leave the breaker half-open, got %s", breaker.State())
+	}
+
+	release("getProbe")
+	if err := <-probe; err != nil {
+		t.Fatalf("Expected the probe to succeed, got %s", err)
+	}
+	if breaker.State() != CircuitClosed {
+		t.Errorf("Expected the probe to close the breaker, got %s", breaker.State())
+	}
+}
@@ -0,0 +1,291 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// DefaultCacheTTL is how long a ResponseCache keeps responses by default
+const DefaultCacheTTL = 10 * time.Minute
+
+// CacheableServices are the services holding reference data (locations,
+// product catalog, ...), whose get methods a ResponseCache caches by default
+var CacheableServices = []string{
+	"SoftLayer_Location",
+	"SoftLayer_Location_Datacenter",
+	"SoftLayer_Location_Region",
+	"SoftLayer_Product_Package",
+	"SoftLayer_Product_Package_Preset",
+	"SoftLayer_Product_Package_Type",
+	"SoftLayer_Product_Item_Category",
+}
+
+// CacheBackend stores the responses of a ResponseCache, e.g. in memory (see
+// MemoryCache) or in a shared store such as Redis. Values are JSON documents.
+type CacheBackend interface {
+	// Get returns the value stored under key, or false if there is none or it
+	// has expired
+	Get(key string) ([]byte, bool)
+
+	// Set stores value under key for ttl
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// ResponseCache answers repeated read-only calls (e.g., getAllObjects of
+// SoftLayer_Location_Datacenter, or the items of a product package) from a
+// cache instead of the API. It is opt-in, as a middleware:
+//
+//	cache := &session.ResponseCache{TTL: time.Hour}
+//	sess = sess.AddMiddleware(cache.Middleware())
+//
+// Responses are cached per endpoint, credentials, custom headers, service,
+// method, arguments and options (id, mask, filter, limit, ...). Errors are not
+// cached. Hits are counted by the Telemetry of the session.
+type ResponseCache struct {
+	// Backend stores the responses. Defaults to a MemoryCache.
+	Backend CacheBackend
+
+	// TTL is how long responses are kept. Defaults to DefaultCacheTTL.
+	TTL time.Duration
+
+	// Cacheable, when set, decides whether the responses of a method are
+	// cached. By default, only those of the get methods of CacheableServices
+	// are: the state of other objects (e.g., the power state or active
+	// transaction of a server) changes too often.
+	Cacheable func(service string, method string) bool
+
+	once sync.Once
+}
+
+// Middleware returns the middleware answering calls from the cache
+func (c *ResponseCache) Middleware() Middleware {
+	c.once.Do(func() {
+		if c.Backend == nil {
+			c.Backend = NewMemoryCache(0)
+		}
+	})
+
+	return func(next TransportHandler) TransportHandler {
+		return TransportHandlerFunc(func(sess *Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+			if !c.cacheable(service, method) {
+				return next.DoRequest(sess, service, method, args, options, pResult)
+			}
+
+			key, err := cacheKey(sess, service, method, args, options)
+			if err != nil {
+				return next.DoRequest(sess, service, method, args, options, pResult)
+			}
+
+			if value, ok := c.Backend.Get(key); ok && json.Unmarshal(value, pResult) == nil {
+				sess.Telemetry.RecordCacheHit()
+				return nil
+			}
+
+			if err := next.DoRequest(sess, service, method, args, options, pResult); err != nil {
+				return err
+			}
+
+			if value, err := json.Marshal(pResult); err == nil {
+				ttl := c.TTL
+				if ttl <= 0 {
+					ttl = DefaultCacheTTL
+				}
+				c.Backend.Set(key, value, ttl)
+			}
+
+			return nil
+		})
+	}
+}
+
+func (c *ResponseCache) cacheable(service string, method string) bool {
+	if c.Cacheable != nil {
+		return c.Cacheable(service, method)
+	}
+
+	if !strings.HasPrefix(method, "get") {
+		return false
+	}
+
+	for _, cacheable := range CacheableServices {
+		if service == cacheable {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cacheKey returns the key of a call: a hash of everything its response
+// depends on, including the credentials and custom headers it is sent with.
+// The headers of the HeaderFunc of the session are computed for the purpose.
+func cacheKey(sess *Session, service string, method string, args []interface{}, options *sl.Options) (string, error) {
+	var opts sl.Options
+	if options != nil {
+		opts = *options
+		opts.Timeout = 0
+	}
+
+	call := struct {
+		Endpoint       string
+		Credentials    string
+		Headers        map[string]string
+		Service        string
+		Method         string
+		Args           []interface{}
+		Id             *int
+		GlobalID       *string
+		Mask           string
+		Filter         string
+		Limit          *int
+		Offset         *int
+		InitParameters *map[string]interface{}
+	}{
+		sess.Endpoint, cacheCredentials(sess), nil, service, method, args,
+		opts.Id, opts.GlobalID, opts.Mask, opts.Filter, opts.Limit, opts.Offset, opts.InitParameters,
+	}
+	if len(sess.Headers) > 0 || sess.HeaderFunc != nil {
+		call.Headers = map[string]string{}
+		for name, value := range sess.Headers {
+			call.Headers[http.CanonicalHeaderKey(name)] = value
+		}
+
+		if sess.HeaderFunc != nil {
+			headers, err := sess.HeaderFunc()
+			if err != nil {
+				return "", err
+			}
+			for name, value := range headers {
+				call.Headers[http.CanonicalHeaderKey(name)] = value
+			}
+		}
+	}
+
+	data, err := json.Marshal(call)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// cacheCredentials returns a hash of the credentials the transports send the
+// calls of the session with, in the same order of precedence
+func cacheCredentials(sess *Session) string {
+	var credentials string
+	switch {
+	case sess.Anonymous:
+		return "anonymous"
+	case sess.IAM != nil:
+		credentials = "iam:" + sess.IAM.APIKey
+	case sess.APIKey != "":
+		credentials = "apikey:" + sess.UserName + ":" + sess.APIKey
+	case sess.AuthToken != "":
+		credentials = fmt.Sprintf("token:%d:%s", sess.UserId, sess.AuthToken)
+	default:
+		return "none"
+	}
+
+	sum := sha256.Sum256([]byte(credentials))
+	return hex.EncodeToString(sum[:])
+}
+
+// MemoryCache is an in-memory CacheBackend, safe for concurrent use
+type MemoryCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+type memoryCacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache returns an in-memory cache holding up to maxEntries values
+// (any number if zero). The values expiring first are evicted when it is full.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{maxEntries: maxEntries, entries: map[string]memoryCacheEntry{}}
+}
+
+// Get returns the value stored under key, unless it has expired
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(entry.expires) {
+		delete(m.entries, key)
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+// Set stores value under key for ttl
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.entries[key]; !ok && m.maxEntries > 0 && len(m.entries) >= m.maxEntries {
+		m.evict()
+	}
+
+	m.entries[key] = memoryCacheEntry{value: value, expires: time.Now().Add(ttl)}
+}
+
+// Clear removes every value
+func (m *MemoryCache) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries = map[string]memoryCacheEntry{}
+}
+
+// evict removes the expired values or, if none has expired, the value
+// expiring first
+func (m *MemoryCache) evict() {
+	now := time.Now()
+	var first string
+	for key, entry := range m.entries {
+		if now.After(entry.expires) {
+			delete(m.entries, key)
+		} else if first == "" || entry.expires.Before(m.entries[first].expires) {
+			first = key
+		}
+	}
+
+	if len(m.entries) >= m.maxEntries {
+		delete(m.entries, first)
+	}
+}
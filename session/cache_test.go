@@ -0,0 +1,173 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestResponseCache(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		fmt.Fprint(w, `[{"id": 1, "name": "dal13"}]`)
+	}))
+	defer server.Close()
+
+	cache := &ResponseCache{TTL: 50 * time.Millisecond}
+	sess := (&Session{Endpoint: server.URL, Telemetry: &Telemetry{}}).AddMiddleware(cache.Middleware())
+
+	get := func(options *sl.Options) []datatypes.Location {
+		var locations []datatypes.Location
+		if err := sess.DoRequest("SoftLayer_Location_Datacenter", "getDatacenters", nil, options, &locations); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		return locations
+	}
+
+	for i := 0; i < 3; i++ {
+		if locations := get(&sl.Options{Mask: "id,name"}); len(locations) != 1 || *locations[0].Name != "dal13" {
+			t.Fatalf("Expected the cached datacenters, got %+v", locations)
+		}
+	}
+
+	if requests != 1 || sess.Telemetry.Summary().CacheHits != 2 {
+		t.Errorf("Expected 1 request and 2 cache hits, got %d and %d", requests, sess.Telemetry.Summary().CacheHits)
+	}
+
+	// Calls with other options are not answered from the cache
+	get(&sl.Options{Mask: "id"})
+	if requests != 2 {
+		t.Errorf("Expected a request for another mask, got %d requests", requests)
+	}
+
+	// Responses expire
+	time.Sleep(60 * time.Millisecond)
+	get(&sl.Options{Mask: "id,name"})
+	if requests != 3 {
+		t.Errorf("Expected a request once the response expired, got %d requests", requests)
+	}
+
+	// Other methods are not cached
+	var result []datatypes.Location
+	for i := 0; i < 2; i++ {
+		sess.DoRequest("SoftLayer_Location_Datacenter", "findDatacenters", nil, &sl.Options{}, &result)
+	}
+	if requests != 5 {
+		t.Errorf("Expected the calls not to be cached, got %d requests", requests)
+	}
+}
+
+func TestMemoryCacheEviction(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", []byte("1"), time.Minute)
+	cache.Set("b", []byte("2"), time.Hour)
+	cache.Set("c", []byte("3"), time.Hour)
+
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("Expected the entry expiring first to be evicted")
+	}
+
+	if value, ok := cache.Get("c"); !ok || string(value) != "3" {
+		t.Errorf("Expected the new entry to be stored, got %q", value)
+	}
+}
+
+func TestResponseCacheDefaults(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	cache := &ResponseCache{}
+	sess := (&Session{Endpoint: server.URL}).AddMiddleware(cache.Middleware())
+
+	// The state of servers is not cached
+	var result struct{}
+	for i := 0; i < 2; i++ {
+		sess.DoRequest("SoftLayer_Virtual_Guest", "getPowerState", nil, &sl.Options{Id: sl.Int(1)}, &result)
+	}
+	if requests != 2 {
+		t.Errorf("Expected the calls not to be cached, got %d requests", requests)
+	}
+
+	// Calls with other headers or credentials do not share responses
+	sessions := []*Session{sess}
+	for _, change := range []func(s *Session){
+		func(s *Session) { s.Headers = map[string]string{"X-Tenant": "a"} },
+		func(s *Session) { s.Headers = map[string]string{"X-Tenant": "b"} },
+		func(s *Session) {
+			s.HeaderFunc = func() (map[string]string, error) {
+				return map[string]string{"X-Tenant": "c"}, nil
+			}
+		},
+		func(s *Session) { s.Anonymous = true },
+	} {
+		s := *sess
+		change(&s)
+		sessions = append(sessions, &s)
+	}
+
+	for _, s := range sessions {
+		for i := 0; i < 2; i++ {
+			s.DoRequest("SoftLayer_Location", "getObject", nil, &sl.Options{Id: sl.Int(1)}, &result)
+		}
+	}
+	if requests != 2+int32(len(sessions)) {
+		t.Errorf("Expected a request per distinct session, got %d requests", requests-2)
+	}
+}
+
+func TestResponseCacheCredentials(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if _, key, _ := r.BasicAuth(); key != "valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": "Access Denied.", "code": "SoftLayer_Exception_InvalidCredentials"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	cache := &ResponseCache{}
+	valid := (&Session{Endpoint: server.URL, UserName: "user", APIKey: "valid"}).AddMiddleware(cache.Middleware())
+	revoked := (&Session{Endpoint: server.URL, UserName: "user", APIKey: "revoked"}).AddMiddleware(cache.Middleware())
+
+	var result struct{}
+	if err := valid.DoRequest("SoftLayer_Location", "getObject", nil, &sl.Options{Id: sl.Int(1)}, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := revoked.DoRequest("SoftLayer_Location", "getObject", nil, &sl.Options{Id: sl.Int(1)}, &result); err == nil {
+		t.Errorf("Expected a session with another API key not to get the cached response")
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
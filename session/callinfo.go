@@ -0,0 +1,144 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CallInfo describes a call made by a session, to attribute slowness to
+// specific calls
+type CallInfo struct {
+	Service string
+	Method  string
+
+	// Endpoint is the endpoint the call was last sent to (see Failover)
+	Endpoint string
+
+	// Duration is the time taken by the call, including retries
+	Duration time.Duration
+
+	// Requests is the number of HTTP requests sent, including retries. It is
+	// zero for calls answered without the API (e.g., from a ResponseCache).
+	Requests int
+
+	// BytesSent and BytesReceived are the sizes of the request and response
+	// bodies exchanged
+	BytesSent     int64
+	BytesReceived int64
+
+	// StatusCode is the HTTP status of the last response, if any
+	StatusCode int
+
+	// Header holds the HTTP headers of the last response, if any
+	Header http.Header
+
+	// TotalItems is the total number of results of a call returning a list,
+	// across all pages, as reported by the SoftLayer-Total-Items header of the
+	// REST API. It is -1 when not reported.
+	TotalItems int
+
+	// RateLimit is the request budget reported by the last response, or nil
+	RateLimit *RateLimit
+
+	// Labels are the labels of the call (see WithLabels)
+	Labels map[string]string
+
+	// Err is the error returned by the call, if any
+	Err error
+}
+
+// CallRecorder collects the CallInfo of the calls made with a context. See
+// RecordCalls.
+type CallRecorder struct {
+	mu    sync.Mutex
+	calls []CallInfo
+}
+
+type callRecorderKey struct{}
+
+// RecordCalls returns a context collecting, into the returned CallRecorder,
+// the CallInfo of the calls made with it (or a context derived from it), e.g.:
+//
+//	ctx, calls := session.RecordCalls(ctx)
+//	guests, err := services.GetAccountService(sess).WithContext(ctx).GetVirtualGuests()
+//	info, _ := calls.Last()
+//	log.Printf("getVirtualGuests: %s, %d bytes", info.Duration, info.BytesReceived)
+func RecordCalls(ctx context.Context) (context.Context, *CallRecorder) {
+	recorder := &CallRecorder{}
+	return context.WithValue(ctx, callRecorderKey{}, recorder), recorder
+}
+
+// Calls returns the CallInfo of the calls made, in the order they ended
+func (c *CallRecorder) Calls() []CallInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]CallInfo{}, c.calls...)
+}
+
+// Last returns the CallInfo of the last call made, or false if none was
+func (c *CallRecorder) Last() (CallInfo, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.calls) == 0 {
+		return CallInfo{}, false
+	}
+
+	return c.calls[len(c.calls)-1], true
+}
+
+// recordCallInfo adds the CallInfo of a call to the CallRecorder of ctx, if
+// any. The call was made with the context callCtx.
+func recordCallInfo(ctx context.Context, callCtx context.Context, info CallInfo) {
+	recorder, ok := ctx.Value(callRecorderKey{}).(*CallRecorder)
+	if !ok {
+		return
+	}
+
+	if hint, ok := callCtx.Value(responseHintKey{}).(*responseHint); ok {
+		hint.mu.Lock()
+		info.StatusCode = hint.status
+		info.Requests = hint.requests
+		info.BytesSent = hint.sent
+		info.BytesReceived = hint.received
+		info.Header = hint.header
+		if hint.endpoint != "" {
+			info.Endpoint = hint.endpoint
+		}
+		hint.mu.Unlock()
+	}
+
+	info.TotalItems = -1
+	if info.Header != nil {
+		if total, ok := headerInt(info.Header, "SoftLayer-Total-Items"); ok {
+			info.TotalItems = total
+		}
+
+		if limit, ok := parseRateLimit(info.Header, time.Now()); ok {
+			info.RateLimit = &limit
+		}
+	}
+
+	recorder.mu.Lock()
+	recorder.calls = append(recorder.calls, info)
+	recorder.mu.Unlock()
+}
@@ -0,0 +1,112 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestRecordCalls(t *testing.T) {
+	const body = `{"id": 1, "hostname": "web1"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, body)
+	}))
+	defer server.Close()
+
+	ctx, calls := RecordCalls(context.Background())
+	sess := (&Session{Endpoint: server.URL}).SetContext(ctx)
+
+	if _, ok := calls.Last(); ok {
+		t.Errorf("Expected no call to be recorded yet")
+	}
+
+	var result struct{}
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	args := []interface{}{map[string]string{"hostname": "web2"}}
+	if err := sess.DoRequest("SoftLayer_Virtual_Guest", "createObject", args, &sl.Options{}, &result); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	recorded := calls.Calls()
+	if len(recorded) != 2 {
+		t.Fatalf("Expected 2 calls, got %+v", recorded)
+	}
+
+	info := recorded[0]
+	if info.Service != "SoftLayer_Account" || info.Method != "getObject" || info.Endpoint != server.URL ||
+		info.Requests != 1 || info.StatusCode != 200 || info.Duration <= 0 ||
+		info.BytesSent != 0 || info.BytesReceived != int64(len(body)) {
+		t.Errorf("Unexpected call info %+v", info)
+	}
+
+	if last, _ := calls.Last(); last.Method != "createObject" || last.BytesSent == 0 {
+		t.Errorf("Expected the request body of the last call to be counted, got %+v", last)
+	}
+
+	// Calls made with other contexts are not recorded
+	sess.SetContext(context.Background()).DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	if len(calls.Calls()) != 2 {
+		t.Errorf("Expected only the calls made with the context to be recorded")
+	}
+}
+
+func TestCallInfoResponseMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("SoftLayer-Total-Items", "250")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		fmt.Fprint(w, `[{"id": 1}]`)
+	}))
+	defer server.Close()
+
+	ctx, calls := RecordCalls(context.Background())
+	sess := (&Session{Endpoint: server.URL}).SetContext(ctx)
+
+	var guests []struct{}
+	if err := sess.DoRequest("SoftLayer_Account", "getVirtualGuests", nil, &sl.Options{}, &guests); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	info, _ := calls.Last()
+	if info.TotalItems != 250 || info.Header.Get("SoftLayer-Total-Items") != "250" {
+		t.Errorf("Expected 250 total items, got %d (%v)", info.TotalItems, info.Header)
+	}
+	if info.RateLimit == nil || info.RateLimit.Remaining != 42 {
+		t.Errorf("Expected the rate limit of the response, got %+v", info.RateLimit)
+	}
+
+	// Calls answered without a response report nothing
+	sess.TransportHandler = TransportHandlerFunc(func(sess *Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+		return nil
+	})
+	if err := sess.DoRequest("SoftLayer_Account", "getVirtualGuests", nil, &sl.Options{}, &guests); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	info, _ = calls.Last()
+	if info.TotalItems != -1 || info.Header != nil || info.RateLimit != nil {
+		t.Errorf("Expected no response metadata, got %+v", info)
+	}
+}
@@ -0,0 +1,120 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// Compression configures the gzip compression of the bodies exchanged with the
+// API. Responses are requested compressed, and decompressed transparently,
+// whatever the transport of the session (the default transport of Go does it
+// on its own, but custom transports may not). Large request bodies, such as
+// the containers of placeOrder, can be compressed too.
+type Compression struct {
+	// RequestThreshold is the size, in bytes, above which request bodies are
+	// compressed (with a Content-Encoding: gzip header). Zero never compresses
+	// them.
+	RequestThreshold int
+}
+
+// gzipRoundTripper compresses requests and decompresses responses according
+// to the Compression of a session
+type gzipRoundTripper struct {
+	compression *Compression
+	base        http.RoundTripper
+}
+
+// compress returns base, compressing the bodies of its requests and responses
+// if the session has a Compression
+func (r *Session) compress(base http.RoundTripper) http.RoundTripper {
+	if r.Compression == nil {
+		return base
+	}
+
+	return gzipRoundTripper{compression: r.Compression, base: base}
+}
+
+func (g gzipRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the original request
+	request = request.Clone(request.Context())
+	if request.Header.Get("Accept-Encoding") == "" {
+		request.Header.Set("Accept-Encoding", "gzip")
+	}
+
+	if threshold := g.compression.RequestThreshold; threshold > 0 && request.Body != nil &&
+		request.ContentLength > int64(threshold) && request.Header.Get("Content-Encoding") == "" {
+		body, err := ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		var compressed bytes.Buffer
+		writer := gzip.NewWriter(&compressed)
+		writer.Write(body)
+		writer.Close()
+
+		data := compressed.Bytes()
+		request.Body = ioutil.NopCloser(bytes.NewReader(data))
+		request.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(data)), nil
+		}
+		request.ContentLength = int64(len(data))
+		request.Header.Set("Content-Encoding", "gzip")
+	}
+
+	base := g.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	response, err := base.RoundTrip(request)
+	if err != nil || response.Uncompressed || !strings.EqualFold(response.Header.Get("Content-Encoding"), "gzip") {
+		return response, err
+	}
+
+	reader, err := gzip.NewReader(response.Body)
+	if err != nil {
+		response.Body.Close()
+		return nil, err
+	}
+
+	response.Body = gzipBody{Reader: reader, body: response.Body}
+	response.Header.Del("Content-Encoding")
+	response.Header.Del("Content-Length")
+	response.ContentLength = -1
+	response.Uncompressed = true
+
+	return response, nil
+}
+
+// gzipBody decompresses a response body, and closes it
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (g gzipBody) Close() error {
+	g.Reader.Close()
+	return g.body.Close()
+}
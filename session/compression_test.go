@@ -0,0 +1,82 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestCompression(t *testing.T) {
+	var requestBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			reader, err := gzip.NewReader(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			body, _ := ioutil.ReadAll(reader)
+			requestBody = string(body)
+		}
+
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			w.Write([]byte(`{"id": 1}`))
+			return
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+		writer := gzip.NewWriter(w)
+		writer.Write([]byte(`{"id": 2}`))
+		writer.Close()
+	}))
+	defer server.Close()
+
+	// A transport which does not decompress responses on its own
+	transport := &http.Transport{DisableCompression: true}
+	sess := (&Session{Endpoint: server.URL}).SetRoundTripper(transport)
+
+	var result struct {
+		Id int `json:"id"`
+	}
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil || result.Id != 1 {
+		t.Fatalf("Expected an uncompressed response without Compression, got %d (%v)", result.Id, err)
+	}
+
+	sess = sess.SetCompression(100)
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil || result.Id != 2 {
+		t.Fatalf("Expected the compressed response to be decompressed, got %d (%v)", result.Id, err)
+	}
+
+	// Small requests are sent as is, large ones compressed
+	args := []interface{}{map[string]string{"hostname": "web1"}}
+	if err := sess.DoRequest("SoftLayer_Virtual_Guest", "createObject", args, &sl.Options{}, &result); err != nil || requestBody != "" {
+		t.Fatalf("Expected a small request not to be compressed, got %q (%v)", requestBody, err)
+	}
+
+	args = []interface{}{map[string]string{"hostname": strings.Repeat("a", 200)}}
+	if err := sess.DoRequest("SoftLayer_Virtual_Guest", "createObject", args, &sl.Options{}, &result); err != nil ||
+		!strings.Contains(requestBody, strings.Repeat("a", 200)) {
+		t.Errorf("Expected a large request to be compressed, got %q (%v)", requestBody, err)
+	}
+}
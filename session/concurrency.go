@@ -0,0 +1,175 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// ConcurrencyController limits the number of API requests a session (and its
+// copies) has in flight, adapting the limit to how the API responds: the limit
+// grows by about one request per round of successful calls (additive
+// increase), and is cut by DecreaseFactor when a call is throttled, fails
+// with a server error or is slower than LatencyTarget (multiplicative
+// decrease). Bulk jobs can then run as many goroutines as they like, and get
+// the most throughput the API allows without tripping its rate limits.
+type ConcurrencyController struct {
+	// Min and Max bound the limit. Min defaults to 1.
+	Min int
+	Max int
+
+	// LatencyTarget is the call latency above which the limit is decreased.
+	// Zero disables latency based decreases.
+	LatencyTarget time.Duration
+
+	// DecreaseFactor is applied to the limit on congestion. Defaults to 0.5.
+	DecreaseFactor float64
+
+	mu           sync.Mutex
+	released     chan struct{}
+	limit        float64
+	inFlight     int
+	lastDecrease time.Time
+}
+
+// NewConcurrencyController returns a controller starting at initial requests
+// in flight, which never allows more than max
+func NewConcurrencyController(initial int, max int) *ConcurrencyController {
+	return &ConcurrencyController{Max: max, limit: float64(initial)}
+}
+
+// Limit returns the current number of requests allowed in flight
+func (c *ConcurrencyController) Limit() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return int(c.limit)
+}
+
+// InFlight returns the number of requests currently in flight
+func (c *ConcurrencyController) InFlight() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.inFlight
+}
+
+// acquire waits for a request slot, or until ctx is done, and returns the
+// function to call with the result of the request once it completes
+func (c *ConcurrencyController) acquire(ctx context.Context) (func(error), error) {
+	if c == nil {
+		return func(error) {}, nil
+	}
+
+	c.mu.Lock()
+	for c.inFlight >= c.current() {
+		if c.released == nil {
+			c.released = make(chan struct{})
+		}
+		released := c.released
+		c.mu.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		c.mu.Lock()
+	}
+	c.inFlight++
+	c.mu.Unlock()
+
+	start := time.Now()
+	return func(err error) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		c.inFlight--
+		c.observe(time.Since(start), err)
+
+		// Wake up the requests waiting for a slot
+		if c.released != nil {
+			close(c.released)
+			c.released = nil
+		}
+	}, nil
+}
+
+// current returns the limit as a whole number of requests, within bounds
+func (c *ConcurrencyController) current() int {
+	min := c.Min
+	if min < 1 {
+		min = 1
+	}
+
+	if c.limit < float64(min) {
+		c.limit = float64(min)
+	}
+	if c.Max > 0 && c.limit > float64(c.Max) {
+		c.limit = float64(c.Max)
+	}
+
+	return int(c.limit)
+}
+
+// observe adjusts the limit for the outcome of a request. It must be called
+// with the lock held.
+func (c *ConcurrencyController) observe(latency time.Duration, err error) {
+	congested := isCongestion(err) || (c.LatencyTarget > 0 && latency > c.LatencyTarget)
+
+	if !congested {
+		if err == nil {
+			c.limit += 1 / float64(c.current())
+			c.current()
+		}
+		return
+	}
+
+	// Requests in flight when the limit was cut complete with the same
+	// congestion, so the limit is only cut once per round trip
+	if time.Since(c.lastDecrease) < latency {
+		return
+	}
+
+	factor := c.DecreaseFactor
+	if factor <= 0 || factor >= 1 {
+		factor = 0.5
+	}
+
+	c.limit *= factor
+	c.current()
+	c.lastDecrease = time.Now()
+}
+
+// isCongestion returns true for errors signalling the API is overloaded:
+// throttling, server errors and timeouts
+func isCongestion(err error) bool {
+	slErr, ok := err.(sl.Error)
+	if !ok {
+		return false
+	}
+
+	if slErr.StatusCode == 429 || slErr.StatusCode >= 500 {
+		return true
+	}
+
+	return hasRetryableCode(err)
+}
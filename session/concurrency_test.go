@@ -0,0 +1,113 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestConcurrencyAIMD(t *testing.T) {
+	c := NewConcurrencyController(4, 6)
+
+	for i := 0; i < 20; i++ {
+		c.observe(time.Millisecond, nil)
+	}
+	if c.Limit() != 6 {
+		t.Errorf("Expected the limit to grow to the maximum of 6, got %d", c.Limit())
+	}
+
+	c.observe(time.Millisecond, sl.Error{StatusCode: 429})
+	if c.Limit() != 3 {
+		t.Errorf("Expected throttling to halve the limit, got %d", c.Limit())
+	}
+
+	// Congestion reported by requests in flight during the cut is ignored
+	c.observe(time.Second, sl.Error{StatusCode: 503})
+	if c.Limit() != 3 {
+		t.Errorf("Expected a single decrease per round trip, got %d", c.Limit())
+	}
+
+	c.lastDecrease = time.Time{}
+	c.observe(time.Millisecond, sl.Error{StatusCode: 500})
+	c.lastDecrease = time.Time{}
+	c.observe(time.Millisecond, sl.Error{StatusCode: 500})
+	if c.Limit() != 1 {
+		t.Errorf("Expected the limit to stay at the minimum of 1, got %d", c.Limit())
+	}
+
+	// Client errors say nothing about congestion
+	c.observe(time.Millisecond, sl.Error{StatusCode: 404})
+	if c.Limit() != 1 {
+		t.Errorf("Expected a client error to leave the limit unchanged, got %d", c.Limit())
+	}
+}
+
+func TestConcurrencyLimitsInFlight(t *testing.T) {
+	c := NewConcurrencyController(2, 2)
+
+	release1, _ := c.acquire(context.Background())
+	release2, _ := c.acquire(context.Background())
+
+	acquired := make(chan func(error))
+	go func() {
+		release, _ := c.acquire(context.Background())
+		acquired <- release
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("Expected the third request to wait for a slot")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	release1(nil)
+	release3 := <-acquired
+	if c.InFlight() != 2 {
+		t.Errorf("Expected 2 requests in flight, got %d", c.InFlight())
+	}
+
+	release2(nil)
+	release3(nil)
+}
+
+func TestConcurrencyWaitIsCancelled(t *testing.T) {
+	c := NewConcurrencyController(1, 1)
+	release, _ := c.acquire(context.Background())
+	defer release(nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := c.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the wait to end with the context, got %v", err)
+	}
+
+	// A call whose context is done is not sent, and leaves its slot free
+	sess := (&Session{Endpoint: "http://127.0.0.1:1", Concurrency: c}).SetContext(ctx)
+	var result struct{}
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the call to fail with the context error, got %v", err)
+	}
+	if c.InFlight() != 1 {
+		t.Errorf("Expected 1 request in flight, got %d", c.InFlight())
+	}
+}
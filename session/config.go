@@ -0,0 +1,260 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/config"
+)
+
+// DefaultProfile is the section of the config file read by New, and by
+// NewFromConfig, when no profile is given
+const DefaultProfile = "softlayer"
+
+// Setting is a configuration key of a session, as named in the config file,
+// and the environment variables which set it, by order of precedence
+type Setting struct {
+	Key string
+	Env []string
+}
+
+// Settings are the settings of the sessions created by New, which resolves
+// each one by order of precedence from its explicit arguments (the first
+// four settings), the environment, then the config file:
+//
+//   - username: the API user name
+//   - api_key: the API key
+//   - endpoint_url: the API endpoint (defaults to DefaultEndpoint, or
+//     DefaultXmlRpcEndpoint for the XML-RPC transport)
+//   - timeout: the timeout of requests, in seconds
+//   - private_network: "true", or "auto" to use the private endpoints only
+//     when reachable
+//   - transport: "rest" or "xmlrpc", when it cannot be told from the endpoint
+var Settings = []Setting{
+	{Key: "username", Env: []string{"SL_USERNAME", "SOFTLAYER_USERNAME"}},
+	{Key: "api_key", Env: []string{"SL_API_KEY", "SOFTLAYER_API_KEY"}},
+	{Key: "endpoint_url", Env: []string{"SL_ENDPOINT_URL", "SL_API_ENDPOINT", "SOFTLAYER_ENDPOINT_URL"}},
+	{Key: "timeout", Env: []string{"SL_TIMEOUT", "SOFTLAYER_TIMEOUT"}},
+	{Key: "private_network", Env: []string{"SL_PRIVATE_NETWORK", "SOFTLAYER_PRIVATE_NETWORK"}},
+	{Key: "transport", Env: []string{"SL_TRANSPORT", "SOFTLAYER_TRANSPORT"}},
+}
+
+// profileEnv are the environment variables naming the profile of the config
+// file read by New
+var profileEnv = []string{"SL_PROFILE", "SOFTLAYER_PROFILE"}
+
+// DefaultConfigPath returns the path of the config file shared with the
+// SoftLayer CLI (slcli): ~/.softlayer, or "" if the home directory cannot be
+// determined
+func DefaultConfigPath() string {
+	var homeDir string
+	u, err := user.Current()
+	if err != nil {
+		for _, name := range []string{"HOME", "USERPROFILE"} { // *nix, windows
+			if dir := os.Getenv(name); dir != "" {
+				homeDir = dir
+				break
+			}
+		}
+	} else {
+		homeDir = u.HomeDir
+	}
+
+	if homeDir == "" {
+		return ""
+	}
+
+	return filepath.Join(homeDir, ".softlayer")
+}
+
+// NewFromConfig creates a session from a profile of the INI config file at
+// path, as written by the SoftLayer CLI:
+//
+//	[softlayer]
+//	username = user
+//	api_key = 0123456789abcdef
+//	endpoint_url = https://api.softlayer.com/xmlrpc/v3.1/
+//	timeout = 60
+//
+//	[staging]
+//	username = staging-user
+//	...
+//
+// The path defaults to DefaultConfigPath(), and the profile (the section of
+// the file) to DefaultProfile. Unlike New, the environment is not used, and
+// errors (e.g., an unknown profile or an invalid timeout) are returned.
+func NewFromConfig(path string, profile string) (*Session, error) {
+	if path == "" {
+		path = DefaultConfigPath()
+		if path == "" {
+			return nil, fmt.Errorf("Could not determine the home directory to read ~/.softlayer")
+		}
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("Could not read %s: %s", path, err)
+	}
+
+	values, err := resolveSettings(nil, false, path, profile, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSession(values)
+}
+
+// Profiles returns the names of the profiles of a config file, in order
+func Profiles(file config.File) []string {
+	profiles := []string{}
+	for name := range file {
+		if name != "" {
+			profiles = append(profiles, name)
+		}
+	}
+	sort.Strings(profiles)
+
+	return profiles
+}
+
+// resolveSettings returns the value of each of the Settings, taken from
+// explicit, then the environment (if useEnv), then the profile of the config
+// file at path, if it exists. The profile defaults to DefaultProfile, and must
+// exist in the file if required. A path of "" (the home directory is unknown)
+// is only an error if the credentials are still unresolved.
+func resolveSettings(
+	explicit map[string]string, useEnv bool,
+	path string, profile string, required bool) (map[string]string, error) {
+
+	values := map[string]string{}
+	for _, setting := range Settings {
+		values[setting.Key] = explicit[setting.Key]
+		if values[setting.Key] == "" && useEnv {
+			values[setting.Key] = lookupEnv(setting.Env)
+		}
+	}
+
+	if path == "" {
+		if values["username"] != "" && values["api_key"] != "" {
+			return values, nil
+		}
+		return values, fmt.Errorf("home dir could not be determined. Skipping read of ~/.softlayer.")
+	}
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return values, nil
+	}
+
+	file, err := config.LoadFile(path)
+	if err != nil {
+		return values, fmt.Errorf("Could not parse %s : %s", path, err)
+	}
+
+	if profile == "" {
+		profile = DefaultProfile
+	}
+
+	section, ok := file[profile]
+	if !ok && required {
+		return values, fmt.Errorf("No profile %q in %s (profiles: %v)", profile, path, Profiles(file))
+	}
+
+	for key, value := range values {
+		if value == "" {
+			values[key] = section[key]
+		}
+	}
+
+	return values, nil
+}
+
+// lookupEnv returns the value of the first of the environment variables which
+// is set
+func lookupEnv(names []string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// newSession creates a session from resolved Settings. Invalid settings are
+// ignored, and reported by the error, along with the session.
+func newSession(values map[string]string) (*Session, error) {
+	sess := &Session{
+		UserName:  values["username"],
+		APIKey:    values["api_key"],
+		Endpoint:  values["endpoint_url"],
+		RetryWait: DefaultRetryWait,
+		userAgent: getDefaultUserAgent(),
+	}
+
+	var err error
+	if timeout := values["timeout"]; timeout != "" {
+		seconds, parseErr := strconv.ParseFloat(timeout, 64)
+		if parseErr == nil {
+			sess.Timeout = time.Duration(seconds * float64(time.Second))
+		} else {
+			err = fmt.Errorf("Invalid timeout %q", timeout)
+		}
+	}
+
+	switch strings.ToLower(values["transport"]) {
+	case "":
+	case "rest":
+		sess.TransportHandler = &RestTransport{}
+	case "xmlrpc":
+		sess.TransportHandler = &XmlRpcTransport{}
+		if sess.Endpoint == "" {
+			sess.Endpoint = DefaultXmlRpcEndpoint
+		}
+	default:
+		err = fmt.Errorf("Invalid transport %q (expected rest or xmlrpc)", values["transport"])
+	}
+
+	if sess.Endpoint == "" {
+		sess.Endpoint = DefaultEndpoint
+	}
+
+	switch strings.ToLower(values["private_network"]) {
+	case "true":
+		sess = sess.SetPrivateNetwork()
+	case "auto":
+		timeout := PrivateNetworkDetectTimeout
+		if sess.Timeout > 0 && sess.Timeout < timeout {
+			timeout = sess.Timeout
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		if DetectPrivateNetwork(ctx) {
+			sess = sess.SetPrivateNetwork()
+		}
+		cancel()
+	}
+
+	return sess, err
+}
@@ -0,0 +1,149 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewFromConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "softlayer")
+	contents := `[softlayer]
+username = user
+api_key = key
+
+[staging]
+username = staging-user
+api_key = staging-key
+endpoint_url = https://api.example.com/rest/v3
+timeout = 1.5
+
+[broken]
+timeout = soon
+`
+	if err = ioutil.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	sess, err := NewFromConfig(path, "")
+	if err != nil || sess.UserName != "user" || sess.APIKey != "key" || sess.Endpoint != DefaultEndpoint {
+		t.Errorf("Expected the default profile, got %v, %v", sess, err)
+	}
+
+	sess, err = NewFromConfig(path, "staging")
+	if err != nil || sess.UserName != "staging-user" || sess.Endpoint != "https://api.example.com/rest/v3" ||
+		sess.Timeout != 1500*time.Millisecond || sess.RetryWait != DefaultRetryWait {
+		t.Errorf("Expected the staging profile, got %v, %v", sess, err)
+	}
+
+	_, err = NewFromConfig(path, "production")
+	if err == nil || !strings.Contains(err.Error(), "[broken softlayer staging]") {
+		t.Errorf("Expected an unknown profile error listing the profiles, got %v", err)
+	}
+
+	if _, err = NewFromConfig(path, "broken"); err == nil {
+		t.Errorf("Expected an invalid timeout error")
+	}
+
+	if _, err = NewFromConfig(filepath.Join(dir, "missing"), ""); err == nil {
+		t.Errorf("Expected a missing file error")
+	}
+}
+
+func TestSettingsPrecedence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "softlayer")
+	contents := `[staging]
+username = file-user
+api_key = file-key
+endpoint_url = https://file.example.com/rest/v3
+timeout = 30
+`
+	if err = ioutil.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, value := range map[string]string{"SL_API_KEY": "env-key", "SL_TRANSPORT": "xmlrpc"} {
+		previous, set := os.LookupEnv(name)
+		os.Setenv(name, value)
+		if set {
+			defer os.Setenv(name, previous)
+		} else {
+			defer os.Unsetenv(name)
+		}
+	}
+	for _, name := range []string{"SL_USERNAME", "SOFTLAYER_USERNAME", "SL_ENDPOINT_URL", "SL_API_ENDPOINT", "SOFTLAYER_ENDPOINT_URL", "SL_TIMEOUT", "SOFTLAYER_TIMEOUT"} {
+		if previous, set := os.LookupEnv(name); set {
+			os.Unsetenv(name)
+			defer os.Setenv(name, previous)
+		}
+	}
+
+	// Explicit arguments take precedence over the environment, which takes
+	// precedence over the config file
+	values, err := resolveSettings(map[string]string{"username": "explicit-user"}, true, path, "staging", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sess, err := newSession(values)
+	if err != nil || sess.UserName != "explicit-user" || sess.APIKey != "env-key" ||
+		sess.Endpoint != "https://file.example.com/rest/v3" || sess.Timeout != 30*time.Second {
+		t.Errorf("Unexpected session %+v (%v)", sess, err)
+	}
+	if _, ok := sess.TransportHandler.(*XmlRpcTransport); !ok {
+		t.Errorf("Expected SL_TRANSPORT to select the XML-RPC transport, got %T", sess.TransportHandler)
+	}
+
+	// The XML-RPC transport defaults to its own endpoint
+	sess, _ = newSession(map[string]string{"transport": "xmlrpc"})
+	if sess.Endpoint != DefaultXmlRpcEndpoint {
+		t.Errorf("Expected the default XML-RPC endpoint, got %s", sess.Endpoint)
+	}
+
+	if _, err = resolveSettings(nil, true, path, "production", true); err == nil {
+		t.Errorf("Expected an unknown profile error")
+	}
+
+	if _, err = newSession(map[string]string{"transport": "soap"}); err == nil {
+		t.Errorf("Expected an invalid transport error")
+	}
+
+	// Without a home directory, only missing credentials are an error
+	if _, err = resolveSettings(map[string]string{"username": "user", "api_key": "key"}, false, "", "", false); err != nil {
+		t.Errorf("Expected no error for resolved credentials, got %s", err)
+	}
+	if _, err = resolveSettings(nil, false, "", "", false); err == nil {
+		t.Errorf("Expected an error for unresolved credentials")
+	}
+}
@@ -0,0 +1,81 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// ValidateMask is the object mask of the call made by Validate
+const ValidateMask = "id,username"
+
+// Validate checks, with a cheap authenticated call
+// (SoftLayer_Account::getCurrentUser), that the endpoint of the session can
+// be reached and accepts its credentials, so tools can report problems at
+// startup. Failures are an sl.UnreachableError (the endpoint could not be
+// resolved or connected to), an sl.CredentialError or an sl.PermissionError;
+// other errors (e.g., a server error, or ctx being done) are returned
+// unchanged.
+func (r *Session) Validate(ctx context.Context) error {
+	if r.Anonymous {
+		return fmt.Errorf("Anonymous sessions have no credentials to validate")
+	}
+
+	var user struct {
+		Id       int    `json:"id"`
+		Username string `json:"username"`
+	}
+	err := r.SetContext(ctx).DoRequest("SoftLayer_Account", "getCurrentUser", nil, &sl.Options{Mask: ValidateMask}, &user)
+	if err == nil {
+		return nil
+	}
+
+	endpoint := r.Endpoint
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+
+	// The caller giving up (or the timeout of the session) is not the
+	// endpoint being unreachable
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	if isUnreachable(err) {
+		return sl.UnreachableError{Endpoint: endpoint, Err: err}
+	}
+
+	var slErr sl.Error
+	if !errors.As(err, &slErr) {
+		return err
+	}
+
+	switch {
+	case slErr.StatusCode == 401 || slErr.Exception == "SoftLayer_Exception_InvalidCredentials" ||
+		slErr.Exception == "SoftLayer_Exception_InvalidLegacyToken":
+		return sl.CredentialError{Err: err}
+	case slErr.StatusCode == 403 || strings.Contains(slErr.Exception, "Permission"):
+		return sl.PermissionError{Err: err}
+	}
+
+	return err
+}
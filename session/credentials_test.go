@@ -0,0 +1,90 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestValidate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch user, _, _ := r.BasicAuth(); user {
+		case "valid":
+			fmt.Fprint(w, `{"id": 1, "username": "valid"}`)
+		case "restricted":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"error": "Access denied from this IP address", "code": "SoftLayer_Exception_Public"}`)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": "Access Denied.", "code": "SoftLayer_Exception_InvalidCredentials"}`)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	if err := (&Session{Endpoint: server.URL, UserName: "valid", APIKey: "key"}).Validate(ctx); err != nil {
+		t.Errorf("Expected valid credentials, got %s", err)
+	}
+
+	var credentialErr sl.CredentialError
+	err := (&Session{Endpoint: server.URL, UserName: "wrong", APIKey: "key"}).Validate(ctx)
+	if !errors.As(err, &credentialErr) {
+		t.Errorf("Expected a CredentialError, got %T: %v", err, err)
+	}
+
+	var permissionErr sl.PermissionError
+	err = (&Session{Endpoint: server.URL, UserName: "restricted", APIKey: "key"}).Validate(ctx)
+	if !errors.As(err, &permissionErr) {
+		t.Errorf("Expected a PermissionError, got %T: %v", err, err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := "http://" + listener.Addr().String()
+	listener.Close()
+
+	var unreachableErr sl.UnreachableError
+	err = (&Session{Endpoint: closed, UserName: "valid", APIKey: "key"}).Validate(ctx)
+	if !errors.As(err, &unreachableErr) || unreachableErr.Endpoint != closed {
+		t.Errorf("Expected an UnreachableError, got %T: %v", err, err)
+	}
+
+	// The caller's deadline is not reported as the endpoint being unreachable
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer slow.Close()
+
+	timeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+
+	err = (&Session{Endpoint: slow.URL, UserName: "valid", APIKey: "key"}).Validate(timeout)
+	if errors.As(err, &unreachableErr) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the context error, got %T: %v", err, err)
+	}
+}
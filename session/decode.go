@@ -0,0 +1,216 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// unmarshalLenient decodes a JSON response into pResult, like json.Unmarshal,
+// but tolerates the type inconsistencies of some API properties: numbers sent
+// as strings ("1") or in exponent form (1.5E+10), and booleans sent as numbers
+// or strings (0/1, "1").  It is used when the regular decoding fails with a
+// type error.
+func unmarshalLenient(data []byte, pResult interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+
+	coerced, err := json.Marshal(coerce(value, reflect.TypeOf(pResult)))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(coerced, pResult)
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// coerce converts the decoded JSON value to the JSON kind expected by the
+// destination type t, where the conversion is unambiguous
+func coerce(value interface{}, t reflect.Type) interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	// Types which decode themselves (e.g., datatypes.Time) are left alone
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		return value
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		switch v := value.(type) {
+		case string:
+			if n, ok := integerText(v); ok {
+				return json.Number(n)
+			}
+		case json.Number:
+			if n, ok := integerText(v.String()); ok {
+				return json.Number(n)
+			}
+		case bool:
+			if v {
+				return json.Number("1")
+			}
+			return json.Number("0")
+		}
+	case reflect.Float32, reflect.Float64:
+		switch v := value.(type) {
+		case string:
+			if _, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+				return json.Number(strings.TrimSpace(v))
+			}
+		case bool:
+			if v {
+				return json.Number("1")
+			}
+			return json.Number("0")
+		}
+	case reflect.Bool:
+		switch v := value.(type) {
+		case string:
+			if b, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+				return b
+			}
+		case json.Number:
+			if f, err := v.Float64(); err == nil {
+				return f != 0
+			}
+		}
+	case reflect.String:
+		if v, ok := value.(json.Number); ok {
+			return v.String()
+		}
+	case reflect.Slice, reflect.Array:
+		if items, ok := value.([]interface{}); ok {
+			for i := range items {
+				items[i] = coerce(items[i], t.Elem())
+			}
+		}
+	case reflect.Map:
+		if m, ok := value.(map[string]interface{}); ok {
+			for key := range m {
+				m[key] = coerce(m[key], t.Elem())
+			}
+		}
+	case reflect.Struct:
+		if m, ok := value.(map[string]interface{}); ok {
+			fields := jsonFields(t)
+			for key := range m {
+				if ft, ok := fields[strings.ToLower(key)]; ok {
+					m[key] = coerce(m[key], ft)
+				}
+			}
+		}
+	}
+
+	return value
+}
+
+// Numbers longer than maxIntegerText, or with an exponent beyond
+// maxIntegerExponent, can not fit in an integer field, and are not converted
+// by integerText, since they could be costly to parse
+const (
+	maxIntegerText     = 64
+	maxIntegerExponent = 40
+)
+
+// integerText returns the plain decimal form of s, if it is an integer in
+// decimal or exponent form (e.g., counters sent as 1.073741824E+10)
+func integerText(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return s, true
+	}
+
+	if len(s) > maxIntegerText {
+		return "", false
+	}
+
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exponent, err := strconv.Atoi(s[i+1:])
+		if err != nil || exponent > maxIntegerExponent || exponent < -maxIntegerExponent {
+			return "", false
+		}
+	}
+
+	f, _, err := big.ParseFloat(s, 10, 256, big.ToNearestEven)
+	if err != nil || !f.IsInt() {
+		return "", false
+	}
+
+	i, _ := f.Int(nil)
+	return i.String(), true
+}
+
+var jsonFieldCache sync.Map
+
+// jsonFields returns the types of the fields of struct type t, by lowercased
+// JSON name, including those promoted from embedded structs
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	if cached, ok := jsonFieldCache.Load(t); ok {
+		return cached.(map[string]reflect.Type)
+	}
+
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+
+		ft := field.Type
+		if field.Anonymous && name == "" {
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for embedded, et := range jsonFields(ft) {
+					if _, ok := fields[embedded]; !ok {
+						fields[embedded] = et
+					}
+				}
+				continue
+			}
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields[strings.ToLower(name)] = ft
+	}
+
+	jsonFieldCache.Store(t, fields)
+	return fields
+}
@@ -0,0 +1,107 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestUnmarshalLenient(t *testing.T) {
+	data := []byte(`[{
+		"id": "123",
+		"hostname": 42,
+		"maxMemory": "2048",
+		"dedicatedAccountHostOnlyFlag": 1,
+		"privateNetworkOnlyFlag": "0",
+		"localDiskFlag": "true",
+		"startCpus": true,
+		"createDate": "2017-01-02T03:04:05-06:00",
+		"account": {"id": "7", "isReseller": "1"},
+		"tagReferences": [{"tagId": "9"}]
+	}]`)
+
+	var guests []datatypes.Virtual_Guest
+	if err := json.Unmarshal(data, &guests); err == nil {
+		t.Fatalf("Expected the regular decoding to fail")
+	}
+
+	guests = nil
+	if err := unmarshalLenient(data, &guests); err != nil {
+		t.Fatal(err)
+	}
+
+	guest := guests[0]
+	checks := map[string]bool{
+		"id":            sl.Get(guest.Id) == 123,
+		"hostname":      sl.Get(guest.Hostname) == "42",
+		"maxMemory":     sl.Get(guest.MaxMemory) == 2048,
+		"dedicated":     sl.Get(guest.DedicatedAccountHostOnlyFlag) == true,
+		"privateOnly":   guest.PrivateNetworkOnlyFlag != nil && !*guest.PrivateNetworkOnlyFlag,
+		"localDisk":     sl.Get(guest.LocalDiskFlag) == true,
+		"startCpus":     sl.Get(guest.StartCpus) == 1,
+		"createDate":    guest.CreateDate != nil && guest.CreateDate.Year() == 2017,
+		"account":       guest.Account != nil && sl.Get(guest.Account.Id) == 7,
+		"isReseller":    guest.Account != nil && sl.Get(guest.Account.IsReseller) == 1,
+		"tagReferences": len(guest.TagReferences) == 1 && sl.Get(guest.TagReferences[0].TagId) == 9,
+	}
+
+	for name, ok := range checks {
+		if !ok {
+			t.Errorf("Property %s was not coerced: %#v", name, guest)
+		}
+	}
+}
+
+func TestUnmarshalLenientExponents(t *testing.T) {
+	data := []byte(`{"bytesUsed": 1.073741824E+10, "hardwareId": "2e3"}`)
+
+	var details datatypes.Container_Network_Storage_Evault_WebCc_JobDetails
+	if err := unmarshalLenient(data, &details); err != nil {
+		t.Fatal(err)
+	}
+
+	if sl.Get(details.BytesUsed) != uint(10737418240) || sl.Get(details.HardwareId) != 2000 {
+		t.Errorf("Expected the exponent forms to be decoded, got %#v", details)
+	}
+
+	if err := unmarshalLenient([]byte(`{"bytesUsed": 1.5E+0}`), &details); err == nil {
+		t.Errorf("Expected a fractional value to fail to decode into an integer")
+	}
+
+	// Huge exponents are rejected without being parsed
+	start := time.Now()
+	for _, value := range []string{`1e99999999`, `"1E-99999999"`, `1e41`} {
+		if err := unmarshalLenient([]byte(`{"bytesUsed": `+value+`}`), &details); err == nil {
+			t.Errorf("Expected %s to fail to decode into an integer", value)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected huge exponents to be rejected quickly, took %s", elapsed)
+	}
+}
+
+func TestUnmarshalLenientKeepsErrors(t *testing.T) {
+	var guest datatypes.Virtual_Guest
+	if err := unmarshalLenient([]byte(`{"id": "abc"}`), &guest); err == nil {
+		t.Errorf("Expected a non-numeric string to fail to decode into an int")
+	}
+}
@@ -0,0 +1,85 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+var deprecationPhrases = []string{
+	"deprecated",
+	"has been removed",
+	"no longer supported",
+	"no longer available",
+	"has been retired",
+}
+
+var replacementRegex = regexp.MustCompile(
+	`(?i)(?:please use|use|replaced by|in favor of|instead of this,? use)\s+(?:the\s+)?(?:method\s+)?` +
+		`((?:SoftLayer_[A-Za-z0-9_]+(?:::|->|\.))?[A-Za-z_][A-Za-z0-9_]*)`)
+
+// deprecationsLogged records the service methods for which a deprecation
+// warning has already been logged
+var deprecationsLogged sync.Map
+
+// checkDeprecation converts API errors reporting a deprecated or removed method
+// into an sl.DeprecationError. Any other error is returned unchanged.
+func checkDeprecation(sess *Session, service string, method string, err error) error {
+	slErr, ok := err.(sl.Error)
+	if !ok {
+		return err
+	}
+
+	message := strings.ToLower(slErr.Message)
+	deprecated := false
+	for _, phrase := range deprecationPhrases {
+		if strings.Contains(message, phrase) {
+			deprecated = true
+			break
+		}
+	}
+
+	if !deprecated {
+		return err
+	}
+
+	depErr := sl.DeprecationError{
+		Service: service,
+		Method:  method,
+		Err:     slErr,
+	}
+
+	if match := replacementRegex.FindStringSubmatch(slErr.Message); match != nil {
+		depErr.Replacement = strings.NewReplacer("->", "::", ".", "::").Replace(match[1])
+	}
+
+	if sess.LogDeprecations {
+		if _, logged := deprecationsLogged.LoadOrStore(service+"::"+method, true); !logged {
+			fields := []interface{}{"service", service, "method", method}
+			if depErr.Replacement != "" {
+				fields = append(fields, "replacement", depErr.Replacement)
+			}
+			sess.log(LogWarn, "Deprecated method called", fields...)
+		}
+	}
+
+	return depErr
+}
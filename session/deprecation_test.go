@@ -0,0 +1,62 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestCheckDeprecation(t *testing.T) {
+	tests := []struct {
+		message     string
+		deprecated  bool
+		replacement string
+	}{
+		{"This method is deprecated. Please use SoftLayer_Account::getHardware instead.", true, "SoftLayer_Account::getHardware"},
+		{"getUpgradeItems has been removed in favor of getUpgradeItemPrices.", true, "getUpgradeItemPrices"},
+		{"Method is no longer supported.", true, ""},
+		{"Object does not exist to execute method on.", false, ""},
+	}
+
+	sess := &Session{}
+	for _, tc := range tests {
+		apiErr := sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_Public", Message: tc.message}
+		err := checkDeprecation(sess, "SoftLayer_Account", "getObject", apiErr)
+
+		depErr, ok := err.(sl.DeprecationError)
+		if ok != tc.deprecated {
+			t.Errorf("%q: expected deprecated=%t, got %#v", tc.message, tc.deprecated, err)
+			continue
+		}
+
+		if !ok {
+			continue
+		}
+
+		if depErr.Replacement != tc.replacement {
+			t.Errorf("%q: expected replacement %q, got %q", tc.message, tc.replacement, depErr.Replacement)
+		}
+
+		var original sl.Error
+		if !errors.As(err, &original) || original.Message != tc.message {
+			t.Errorf("%q: expected the original sl.Error to be unwrappable", tc.message)
+		}
+	}
+}
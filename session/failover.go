@@ -0,0 +1,142 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+// DefaultFailoverCooldown is how long a Failover skips an unreachable endpoint
+const DefaultFailoverCooldown = 30 * time.Second
+
+// Failover holds alternate API endpoints (e.g., a backup or the private
+// endpoint), to which calls are sent when the endpoint of the session cannot
+// be reached. An endpoint is unreachable when it cannot be connected to (DNS
+// failures, refused or timed out connections), so that no request has been
+// sent to it; calls are never sent again after a response was received.
+//
+// Unreachable endpoints are skipped, for Cooldown, by later calls. A Failover
+// is shared by copies of the session, and safe for concurrent use.
+type Failover struct {
+	// Endpoints are tried in order after the endpoint of the session. They must
+	// be of the same kind (REST or XML-RPC) as the endpoint of the session.
+	Endpoints []string
+
+	// Cooldown is how long an unreachable endpoint is skipped. Defaults to
+	// DefaultFailoverCooldown.
+	Cooldown time.Duration
+
+	mu   sync.Mutex
+	down map[string]time.Time
+}
+
+// NewFailover returns a Failover to the passed endpoints
+func NewFailover(endpoints ...string) *Failover {
+	return &Failover{Endpoints: endpoints}
+}
+
+// Healthy returns false if the passed endpoint was found unreachable within the
+// cooldown
+func (f *Failover) Healthy(endpoint string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	until, ok := f.down[endpoint]
+	return !ok || time.Now().After(until)
+}
+
+// candidates returns the endpoints to try, in order: the healthy ones, then
+// the others, so that a call is still attempted when all are down
+func (f *Failover) candidates(primary string) []string {
+	var healthy, down []string
+	for _, endpoint := range append([]string{primary}, f.Endpoints...) {
+		if f.Healthy(endpoint) {
+			healthy = append(healthy, endpoint)
+		} else {
+			down = append(down, endpoint)
+		}
+	}
+
+	return append(healthy, down...)
+}
+
+func (f *Failover) markDown(endpoint string) {
+	cooldown := f.Cooldown
+	if cooldown <= 0 {
+		cooldown = DefaultFailoverCooldown
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.down == nil {
+		f.down = map[string]time.Time{}
+	}
+	f.down[endpoint] = time.Now().Add(cooldown)
+}
+
+func (f *Failover) markUp(endpoint string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.down, endpoint)
+}
+
+// do sends a request, through request, to the endpoint of the call or, if it
+// is unreachable, to the next reachable alternate endpoint
+func (f *Failover) do(call *Session, request func() error) error {
+	if f == nil {
+		return request()
+	}
+
+	primary := call.Endpoint
+	defer func() { call.Endpoint = primary }()
+
+	var err error
+	for _, endpoint := range f.candidates(primary) {
+		call.Endpoint = endpoint
+		recordEndpoint(call.Context, endpoint)
+		if err = request(); !isUnreachable(err) {
+			f.markUp(endpoint)
+			return err
+		}
+
+		f.markDown(endpoint)
+		call.log(LogWarn, "Endpoint unreachable, failing over", "endpoint", endpoint, "error", err)
+	}
+
+	return err
+}
+
+// isUnreachable returns true for errors reporting that no connection could be
+// made, so that the request was not sent
+func isUnreachable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
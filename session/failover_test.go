@@ -0,0 +1,87 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestFailover(t *testing.T) {
+	// An endpoint refusing connections
+	closed := httptest.NewServer(http.NotFoundHandler())
+	unreachable := closed.URL
+	closed.Close()
+
+	var requests int32
+	backup := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer backup.Close()
+
+	sess := (&Session{Endpoint: unreachable, StructuredLogger: &recordingLogger{}}).SetFailover(backup.URL)
+
+	var result struct {
+		Id int `json:"id"`
+	}
+	err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	if err != nil || result.Id != 1 || requests != 1 {
+		t.Fatalf("Expected the call to fail over to the backup endpoint, got %v", err)
+	}
+
+	if sess.Failover.Healthy(unreachable) || !sess.Failover.Healthy(backup.URL) {
+		t.Errorf("Expected only the session endpoint to be marked unreachable")
+	}
+
+	logger := sess.StructuredLogger.(*recordingLogger)
+	if len(logger.entries) != 1 || logger.entries[0].level != LogWarn {
+		t.Errorf("Expected the failover to be logged, got %+v", logger.entries)
+	}
+
+	// Later calls skip the unreachable endpoint
+	err = sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	if err != nil || requests != 2 || len(logger.entries) != 1 {
+		t.Errorf("Expected the call to go to the backup endpoint directly, got %v", err)
+	}
+}
+
+func TestFailoverSkipsResponses(t *testing.T) {
+	var requests int32
+	backup := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	}))
+	defer backup.Close()
+
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer primary.Close()
+
+	sess := (&Session{Endpoint: primary.URL}).SetFailover(backup.URL)
+
+	var result struct{}
+	err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	if err == nil || requests != 0 {
+		t.Errorf("Expected the error of the session endpoint, without failover, got %v", err)
+	}
+}
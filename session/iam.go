@@ -0,0 +1,204 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultIAMEndpoint is the IBM Cloud IAM endpoint tokens are requested from
+const DefaultIAMEndpoint = "https://iam.cloud.ibm.com"
+
+// DefaultIAMRefreshMargin is how long before it expires a bearer token is
+// replaced
+const DefaultIAMRefreshMargin = 5 * time.Minute
+
+// IAMAuthenticator exchanges an IBM Cloud IAM API key for bearer tokens, which
+// are sent instead of the classic username and API key. A new token is
+// requested shortly before the current one expires. It is safe for concurrent
+// use, and shared by the copies of a session.
+type IAMAuthenticator struct {
+	// APIKey is the IBM Cloud IAM API key
+	APIKey string
+
+	// Endpoint is the IAM endpoint. Defaults to DefaultIAMEndpoint.
+	Endpoint string
+
+	// HTTPClient is used to request tokens. Defaults to a client going through
+	// the same transport (proxy, pinned hosts, TLS configuration, ...) as the
+	// API requests of the session, or http.DefaultClient when Token is called
+	// directly.
+	HTTPClient *http.Client
+
+	// RefreshMargin is how long before it expires a token is replaced.
+	// Defaults to DefaultIAMRefreshMargin.
+	RefreshMargin time.Duration
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+type iamToken struct {
+	AccessToken  string `json:"access_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+	Expiration   int64  `json:"expiration"`
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// NewIAMAuthenticator returns an IAMAuthenticator for an IBM Cloud IAM API key
+func NewIAMAuthenticator(apiKey string) *IAMAuthenticator {
+	return &IAMAuthenticator{APIKey: apiKey}
+}
+
+// Token returns a valid bearer token, requesting a new one if there is none
+// yet, or the current one is about to expire
+func (a *IAMAuthenticator) Token(ctx context.Context) (string, error) {
+	return a.sessionToken(ctx, nil)
+}
+
+// sessionToken is Token, requesting tokens with client unless the
+// authenticator has its own HTTPClient
+func (a *IAMAuthenticator) sessionToken(ctx context.Context, client *http.Client) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	margin := a.RefreshMargin
+	if margin <= 0 {
+		margin = DefaultIAMRefreshMargin
+	}
+
+	if a.token != "" && time.Now().Add(margin).Before(a.expires) {
+		return a.token, nil
+	}
+
+	token, expires, err := a.requestToken(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	a.token, a.expires = token, expires
+	return token, nil
+}
+
+// Invalidate discards the current token, so a new one is requested for the
+// next call (e.g., after the API rejected it)
+func (a *IAMAuthenticator) Invalidate() {
+	a.mu.Lock()
+	a.token = ""
+	a.mu.Unlock()
+}
+
+// invalidate discards the current token if it is still token, the one the API
+// rejected. A token requested meanwhile (e.g., by a concurrent call rejected
+// with the same token) is kept.
+func (a *IAMAuthenticator) invalidate(token string) {
+	a.mu.Lock()
+	if a.token == token {
+		a.token = ""
+	}
+	a.mu.Unlock()
+}
+
+func (a *IAMAuthenticator) requestToken(ctx context.Context, client *http.Client) (string, time.Time, error) {
+	endpoint := a.Endpoint
+	if endpoint == "" {
+		endpoint = DefaultIAMEndpoint
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ibm:params:oauth:grant-type:apikey")
+	form.Set("apikey", a.APIKey)
+
+	req, err := http.NewRequest("POST", strings.TrimRight(endpoint, "/")+"/identity/token",
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	if a.HTTPClient != nil {
+		client = a.HTTPClient
+	} else if client == nil {
+		client = http.DefaultClient
+	}
+
+	requested := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("Error requesting an IAM token: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("Error reading the IAM token: %s", err)
+	}
+
+	token := iamToken{}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", time.Time{}, fmt.Errorf("Error decoding the IAM token (HTTP %d): %s", resp.StatusCode, err)
+	}
+
+	if resp.StatusCode != http.StatusOK || token.AccessToken == "" {
+		return "", time.Time{}, fmt.Errorf("Error requesting an IAM token: %s: %s (HTTP %d)",
+			token.ErrorCode, token.ErrorMessage, resp.StatusCode)
+	}
+
+	expires := requested.Add(time.Duration(token.ExpiresIn) * time.Second)
+	if token.ExpiresIn == 0 && token.Expiration != 0 {
+		expires = time.Unix(token.Expiration, 0)
+	}
+
+	return token.AccessToken, expires, nil
+}
+
+// iamClient returns the client requesting the IAM tokens of the session: a
+// copy of its HTTPClient, or a new client, using the transport of its API
+// requests
+func (r *Session) iamClient() *http.Client {
+	client := &http.Client{}
+	if r.HTTPClient != nil {
+		*client = *r.HTTPClient
+	}
+
+	if client.Transport == nil {
+		client.Transport = r.roundTripper()
+	}
+
+	if r.Timeout < 0 {
+		client.Timeout = 0
+	} else if r.Timeout != 0 {
+		client.Timeout = r.Timeout
+	} else if client.Timeout == 0 {
+		client.Timeout = DefaultTimeout
+	}
+
+	return client
+}
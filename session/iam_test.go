@@ -0,0 +1,111 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestIAMAuthentication(t *testing.T) {
+	var tokens int32
+	iam := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/identity/token" || r.FormValue("apikey") != "iam-key" ||
+			r.FormValue("grant_type") != "urn:ibm:params:oauth:grant-type:apikey" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errorCode": "BXNIM0415E", "errorMessage": "Provided API key could not be found"}`)
+			return
+		}
+
+		fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": 3600}`,
+			atomic.AddInt32(&tokens, 1))
+	}))
+	defer iam.Close()
+
+	var authorization atomic.Value
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization.Store(r.Header.Get("Authorization"))
+		if _, _, ok := r.BasicAuth(); ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+	defer api.Close()
+
+	sess := (&Session{Endpoint: api.URL, UserName: "user", APIKey: "classic"}).SetIAMAPIKey("iam-key")
+	sess.IAM.Endpoint = iam.URL
+
+	var result struct{}
+	for i := 0; i < 2; i++ {
+		if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if authorization.Load() != "Bearer token-1" || tokens != 1 {
+		t.Errorf("Expected the first token to be reused, got %v after %d tokens", authorization.Load(), tokens)
+	}
+
+	// The token is replaced before it expires
+	sess.IAM.expires = time.Now().Add(time.Minute)
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	if authorization.Load() != "Bearer token-2" {
+		t.Errorf("Expected the token to be refreshed, got %v", authorization.Load())
+	}
+
+	// IAM errors are reported
+	sess = sess.SetIAMAPIKey("unknown")
+	sess.IAM.Endpoint = iam.URL
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err == nil {
+		t.Errorf("Expected an error for an unknown API key")
+	}
+}
+
+func TestIAMTokenUsesSessionTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/identity/token" {
+			fmt.Fprint(w, `{"access_token": "token", "token_type": "Bearer", "expires_in": 3600}`)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	// The host names only resolve through the pinned hosts of the session
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	sess := (&Session{
+		Endpoint:   "http://api.invalid:" + port,
+		DialConfig: &DialConfig{Hosts: map[string][]string{"api.invalid": {"127.0.0.1"}, "iam.invalid": {"127.0.0.1"}}},
+	}).SetIAMAPIKey("iam-key")
+	sess.IAM.Endpoint = "http://iam.invalid:" + port
+
+	var result struct{}
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+		t.Errorf("Expected the token to be requested through the session transport, got %s", err)
+	}
+}
@@ -0,0 +1,160 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// DefaultPageSize is the page size of iterators over calls that set no result
+// limit
+const DefaultPageSize = 100
+
+// Iterator fetches the results of a call returning a list page by page, e.g.:
+//
+//	it := session.NewIterator(sess, "SoftLayer_Account", "getVirtualGuests", nil, sl.Options{Mask: "id,hostname"})
+//	var guests []datatypes.Virtual_Guest
+//	for it.Next(&guests) {
+//		...
+//		save(it.Token())
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//
+// The Limit of the options is the page size, and their Offset the position to
+// start at.  Token returns an opaque resume token for the position reached,
+// which can be persisted and passed to ResumeIterator, e.g. to continue a scan
+// interrupted by a process restart.
+type Iterator struct {
+	sess    *Session
+	service string
+	method  string
+	args    []interface{}
+	options sl.Options
+
+	done bool
+	err  error
+}
+
+// resumeToken is the content of the resume tokens of iterators
+type resumeToken struct {
+	Service string     `json:"service"`
+	Method  string     `json:"method"`
+	Options sl.Options `json:"options"`
+}
+
+// NewIterator returns an iterator over the results of the method of the
+// service, called with the args and options
+func NewIterator(sess *Session, service string, method string, args []interface{}, options sl.Options) *Iterator {
+	if options.Limit == nil || *options.Limit <= 0 {
+		options.Limit = sl.Int(DefaultPageSize)
+	}
+	if options.Offset == nil {
+		options.Offset = sl.Int(0)
+	}
+	options.Unlimited = false
+
+	return &Iterator{
+		sess:    sess,
+		service: service,
+		method:  method,
+		args:    args,
+		options: options,
+	}
+}
+
+// ResumeIterator returns an iterator continuing from the position of the
+// token returned by the Token method of another iterator.  The token holds the
+// service, method, object mask, filter, page size and offset of the call, but
+// not its args, which must be passed again.
+func ResumeIterator(sess *Session, token string, args []interface{}) (*Iterator, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid resume token: %s", err)
+	}
+
+	var resume resumeToken
+	if err = json.Unmarshal(data, &resume); err != nil {
+		return nil, fmt.Errorf("Invalid resume token: %s", err)
+	}
+
+	if resume.Service == "" || resume.Method == "" {
+		return nil, errors.New("Invalid resume token: no service or method")
+	}
+
+	return NewIterator(sess, resume.Service, resume.Method, args, resume.Options), nil
+}
+
+// Next fetches the next page into pPage, a pointer to a slice, returning false
+// when there are no more results or the call failed (see Err)
+func (it *Iterator) Next(pPage interface{}) bool {
+	if it.done || it.err != nil {
+		return false
+	}
+
+	page := reflect.ValueOf(pPage)
+	if page.Kind() != reflect.Ptr || page.Elem().Kind() != reflect.Slice {
+		it.err = fmt.Errorf("Iterator page must be a pointer to a slice, not %T", pPage)
+		return false
+	}
+
+	options := it.options
+	if it.err = it.sess.DoRequest(it.service, it.method, it.args, &options, pPage); it.err != nil {
+		return false
+	}
+
+	count := page.Elem().Len()
+	it.options.Offset = sl.Int(*it.options.Offset + count)
+	if count < *it.options.Limit {
+		it.done = true
+	}
+
+	return count > 0
+}
+
+// Err returns the error of the last call made by Next, if any
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Offset returns the offset of the next page
+func (it *Iterator) Offset() int {
+	return *it.options.Offset
+}
+
+// Token returns an opaque token for the position of the iterator, after the
+// pages returned so far, to pass to ResumeIterator.  It returns an empty
+// string once all the results were fetched.
+func (it *Iterator) Token() string {
+	if it.done {
+		return ""
+	}
+
+	data, err := json.Marshal(resumeToken{Service: it.service, Method: it.method, Options: it.options})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
@@ -0,0 +1,91 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestIteratorResume(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		limit := strings.Split(r.URL.Query().Get("resultLimit"), ",")
+		offset, _ := strconv.Atoi(limit[0])
+		size, _ := strconv.Atoi(limit[1])
+
+		page := []map[string]int{}
+		for id := offset + 1; id <= offset+size && id <= 5; id++ {
+			page = append(page, map[string]int{"id": id})
+		}
+		json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	sess := &Session{Endpoint: server.URL}
+	options := sl.Options{Mask: "id", Filter: `{"id":{"operation":">0"}}`, Limit: sl.Int(2)}
+
+	it := NewIterator(sess, "SoftLayer_Account", "getVirtualGuests", nil, options)
+	var page []struct{ Id int }
+	if !it.Next(&page) || len(page) != 2 || page[0].Id != 1 {
+		t.Fatalf("Expected the first page, got %v (%v)", page, it.Err())
+	}
+
+	token := it.Token()
+	if token == "" {
+		t.Fatal("Expected a resume token")
+	}
+
+	resumed, err := ResumeIterator(sess, token, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	ids := []int{}
+	for resumed.Next(&page) {
+		for _, item := range page {
+			ids = append(ids, item.Id)
+		}
+	}
+	if resumed.Err() != nil {
+		t.Fatalf("Unexpected error: %s", resumed.Err())
+	}
+
+	if fmt.Sprint(ids) != "[3 4 5]" {
+		t.Errorf("Expected the remaining ids, got %v", ids)
+	}
+	if resumed.Token() != "" {
+		t.Errorf("Expected no resume token after the last page, got %s", resumed.Token())
+	}
+
+	last := queries[len(queries)-1]
+	if !strings.Contains(last, "objectMask=") || !strings.Contains(last, "objectFilter=") {
+		t.Errorf("Expected the mask and filter to be resumed, got %s", last)
+	}
+
+	if _, err := ResumeIterator(sess, "not a token", nil); err == nil {
+		t.Error("Expected an error for an invalid token")
+	}
+}
@@ -0,0 +1,116 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"sort"
+)
+
+// AttributeLabelPrefix prefixes the labels of a call in the attributes of its
+// span, e.g. "softlayer.label.tenant"
+const AttributeLabelPrefix = "softlayer.label."
+
+type labelsKey struct{}
+
+// WithLabels returns a context carrying labels (e.g., a tenant or a workflow
+// id), in addition to those already carried by ctx, which they override. The
+// labels of a call, made with the context or set on the session (see
+// Session.Labels), appear in the log entries of the session, the attributes of
+// the span of the call, and its CallInfo, and are available to middleware
+// through Session.CallLabels.
+func WithLabels(ctx context.Context, labels map[string]string) context.Context {
+	merged := map[string]string{}
+	for key, value := range ContextLabels(ctx) {
+		merged[key] = value
+	}
+
+	for key, value := range labels {
+		merged[key] = value
+	}
+
+	return context.WithValue(ctx, labelsKey{}, merged)
+}
+
+// ContextLabels returns the labels carried by ctx (see WithLabels)
+func ContextLabels(ctx context.Context) map[string]string {
+	if ctx == nil {
+		return nil
+	}
+
+	labels, _ := ctx.Value(labelsKey{}).(map[string]string)
+	return labels
+}
+
+// SetLabels creates a copy of the session with the passed labels added to its
+// Labels, and returns it
+func (r *Session) SetLabels(labels map[string]string) *Session {
+	var s Session
+	s = *r
+
+	s.Labels = map[string]string{}
+	for key, value := range r.Labels {
+		s.Labels[key] = value
+	}
+
+	for key, value := range labels {
+		s.Labels[key] = value
+	}
+
+	return &s
+}
+
+// CallLabels returns the labels of the calls made with the session: its
+// Labels, overridden by those carried by its Context
+func (r *Session) CallLabels() map[string]string {
+	contextLabels := ContextLabels(r.Context)
+	if len(contextLabels) == 0 {
+		return r.Labels
+	}
+
+	if len(r.Labels) == 0 {
+		return contextLabels
+	}
+
+	labels := map[string]string{}
+	for key, value := range r.Labels {
+		labels[key] = value
+	}
+
+	for key, value := range contextLabels {
+		labels[key] = value
+	}
+
+	return labels
+}
+
+// labelFields returns the labels of the session as log fields, ordered by key
+func (r *Session) labelFields() []interface{} {
+	labels := r.CallLabels()
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fields := make([]interface{}, 0, 2*len(keys))
+	for _, key := range keys {
+		fields = append(fields, key, labels[key])
+	}
+
+	return fields
+}
@@ -0,0 +1,82 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestLabels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	var seen map[string]string
+	tracer := &testTracer{}
+	logger := &recordingLogger{}
+	sess := (&Session{
+		Endpoint:         server.URL,
+		Debug:            true,
+		Tracer:           tracer,
+		StructuredLogger: logger,
+		Middleware: []Middleware{func(next TransportHandler) TransportHandler {
+			return TransportHandlerFunc(func(sess *Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+				seen = sess.CallLabels()
+				return next.DoRequest(sess, service, method, args, options, pResult)
+			})
+		}},
+	}).SetLabels(map[string]string{"tenant": "acme", "workflow": "default"})
+
+	ctx := WithLabels(context.Background(), map[string]string{"workflow": "42"})
+	ctx, calls := RecordCalls(ctx)
+
+	var result struct{}
+	if err := sess.SetContext(ctx).DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{"tenant": "acme", "workflow": "42"}
+	if !reflect.DeepEqual(seen, expected) {
+		t.Errorf("Expected middleware to see labels %v, got %v", expected, seen)
+	}
+
+	fields := logger.entries[0].fields
+	if !reflect.DeepEqual(fields[len(fields)-4:], []interface{}{"tenant", "acme", "workflow", "42"}) {
+		t.Errorf("Expected the log entries to carry the labels, got %v", fields)
+	}
+
+	attributes := tracer.spans[0].attributes
+	if attributes[AttributeLabelPrefix+"tenant"] != "acme" || attributes[AttributeLabelPrefix+"workflow"] != "42" {
+		t.Errorf("Expected the span to carry the labels, got %v", attributes)
+	}
+
+	if info, _ := calls.Last(); !reflect.DeepEqual(info.Labels, expected) {
+		t.Errorf("Expected the call info to carry the labels, got %v", info.Labels)
+	}
+
+	if sess.Labels["workflow"] != "default" {
+		t.Errorf("Expected the session labels to be left unchanged, got %v", sess.Labels)
+	}
+}
@@ -0,0 +1,75 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LogLevel is the severity of a log entry
+type LogLevel int
+
+// Log levels
+const (
+	LogDebug LogLevel = iota
+	LogInfo
+	LogWarn
+	LogError
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LogDebug:
+		return "DEBUG"
+	case LogInfo:
+		return "INFO"
+	case LogWarn:
+		return "WARN"
+	default:
+		return "ERROR"
+	}
+}
+
+// StructuredLogger receives the log entries of a session (the requests and
+// responses dumped in debug mode, and warnings), e.g. to hand them to the
+// logger of the application (slog, zap, logrus, ...). Fields alternate keys
+// (strings) and values.
+type StructuredLogger interface {
+	Log(level LogLevel, msg string, fields ...interface{})
+}
+
+// log sends an entry to the StructuredLogger of the session or, if it has
+// none, writes it to Logger
+func (r *Session) log(level LogLevel, msg string, fields ...interface{}) {
+	if r != nil {
+		fields = append(fields, r.labelFields()...)
+	}
+
+	if r != nil && r.StructuredLogger != nil {
+		r.StructuredLogger.Log(level, msg, fields...)
+		return
+	}
+
+	var line strings.Builder
+	fmt.Fprintf(&line, "[%s] session: %s", level, msg)
+	for i := 0; i+1 < len(fields); i += 2 {
+		fmt.Fprintf(&line, " %v=%v", fields[i], fields[i+1])
+	}
+
+	Logger.Println(line.String())
+}
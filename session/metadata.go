@@ -0,0 +1,222 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Metadata is a snapshot of the SoftLayer API metadata, as published by an
+// installation at <endpoint>/metadata/v3.1.  Pinning a session to the metadata
+// of an older (e.g., private cloud) installation allows calls to services,
+// methods and properties that installation does not know about to be detected
+// before they are sent.
+type Metadata struct {
+	types  map[string]metadataType
+	warned sync.Map
+}
+
+type metadataType struct {
+	Name       string                    `json:"name"`
+	Base       string                    `json:"base"`
+	Properties map[string]interface{}    `json:"properties"`
+	Methods    map[string]metadataMethod `json:"methods"`
+}
+
+type metadataMethod struct {
+	Type string `json:"type"`
+}
+
+// LoadMetadata reads a metadata snapshot in the format served by the API
+// metadata endpoint.
+func LoadMetadata(in io.Reader) (*Metadata, error) {
+	types := map[string]metadataType{}
+	err := json.NewDecoder(in).Decode(&types)
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding API metadata: %s", err)
+	}
+
+	return &Metadata{types: types}, nil
+}
+
+// LoadMetadataFile reads a metadata snapshot from a file on disk.
+func LoadMetadataFile(path string) (*Metadata, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadMetadata(f)
+}
+
+// AddService adds a service that is not in the snapshot (e.g., a private or
+// preview service), so calls to it pass the check. Methods maps the method names
+// to the names of their result types. The properties of the service are not
+// known, so object masks are not checked for its getObject method. Services
+// should be added before the metadata is used by a session.
+func (m *Metadata) AddService(name string, methods map[string]string) {
+	t := metadataType{Name: name, Methods: map[string]metadataMethod{}}
+	for method, resultType := range methods {
+		t.Methods[method] = metadataMethod{Type: resultType}
+	}
+
+	if m.types == nil {
+		m.types = map[string]metadataType{}
+	}
+	m.types[name] = t
+}
+
+// HasMethod returns true if the method (including those inherited from base
+// services) exists on the named service.
+func (m *Metadata) HasMethod(service string, method string) bool {
+	_, ok := m.method(service, method)
+	return ok
+}
+
+// HasProperty returns true if the property (including those inherited from
+// base types) exists on the named type.
+func (m *Metadata) HasProperty(typeName string, property string) bool {
+	for t, ok := m.types[typeName]; ok; t, ok = m.types[t.Base] {
+		if _, found := t.Properties[property]; found {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Metadata) method(service string, method string) (metadataMethod, bool) {
+	for t, ok := m.types[service]; ok; t, ok = m.types[t.Base] {
+		if found, ok := t.Methods[method]; ok {
+			return found, true
+		}
+
+		// Relational and local properties are also exposed as getters
+		if strings.HasPrefix(method, "get") && len(method) > 3 {
+			property := strings.ToLower(method[3:4]) + method[4:]
+			if _, ok := t.Properties[property]; ok {
+				return metadataMethod{Type: m.propertyType(t, property)}, true
+			}
+		}
+	}
+
+	return metadataMethod{}, false
+}
+
+func (m *Metadata) propertyType(t metadataType, property string) string {
+	if p, ok := t.Properties[property].(map[string]interface{}); ok {
+		if typeName, ok := p["type"].(string); ok {
+			return typeName
+		}
+	}
+
+	return ""
+}
+
+// Check verifies that the service method, and the top-level properties named
+// in the object mask of options, exist in the metadata snapshot.  A descriptive
+// error is returned for the first one that does not.
+func (m *Metadata) Check(service string, method string, options *sl.Options) error {
+	if _, ok := m.types[service]; !ok {
+		return fmt.Errorf("Service %s is not available in the pinned API metadata", service)
+	}
+
+	found, ok := m.method(service, method)
+	if !ok {
+		return fmt.Errorf("Method %s::%s is not available in the pinned API metadata", service, method)
+	}
+
+	resultType := found.Type
+	if method == "getObject" {
+		resultType = service
+	}
+
+	if options == nil || resultType == "" {
+		return nil
+	}
+
+	// Types without known properties (e.g., added services) are not checked
+	if t, ok := m.types[resultType]; !ok || t.Properties == nil {
+		return nil
+	}
+
+	for _, property := range maskProperties(options.Mask) {
+		if !m.HasProperty(resultType, property) {
+			return fmt.Errorf("Property %s.%s is not available in the pinned API metadata", resultType, property)
+		}
+	}
+
+	return nil
+}
+
+// warnOnce reports whether a warning for key has not yet been logged
+func (m *Metadata) warnOnce(key string) bool {
+	_, logged := m.warned.LoadOrStore(key, true)
+	return !logged
+}
+
+// maskProperties returns the top-level property names selected by an object
+// mask, in either the "mask[a,b[c]]" or "a;b.c" forms.
+func maskProperties(mask string) []string {
+	mask = strings.TrimSpace(mask)
+	if strings.HasPrefix(mask, "mask[") && strings.HasSuffix(mask, "]") {
+		mask = mask[5 : len(mask)-1]
+	} else if strings.HasPrefix(mask, "mask.") {
+		mask = mask[5:]
+	}
+
+	properties := []string{}
+	depth := 0
+	start := 0
+	for i := 0; i <= len(mask); i++ {
+		if i < len(mask) {
+			switch mask[i] {
+			case '[', '(':
+				depth++
+				continue
+			case ']', ')':
+				depth--
+				continue
+			case ',', ';':
+				if depth > 0 {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+
+		item := strings.TrimSpace(mask[start:i])
+		start = i + 1
+		if end := strings.IndexAny(item, ".[("); end != -1 {
+			item = item[:end]
+		}
+		if item != "" && item != "mask" {
+			properties = append(properties, item)
+		}
+	}
+
+	return properties
+}
@@ -0,0 +1,125 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const testMetadata = `{
+	"SoftLayer_Entity": {"name": "SoftLayer_Entity", "properties": {}, "methods": {}},
+	"SoftLayer_Hardware": {
+		"name": "SoftLayer_Hardware",
+		"base": "SoftLayer_Entity",
+		"properties": {"id": {"type": "int"}, "hostname": {"type": "string"}},
+		"methods": {"getObject": {"type": "SoftLayer_Hardware"}}
+	},
+	"SoftLayer_Hardware_Server": {
+		"name": "SoftLayer_Hardware_Server",
+		"base": "SoftLayer_Hardware",
+		"properties": {"datacenter": {"type": "SoftLayer_Location", "form": "relational"}},
+		"methods": {"reloadOperatingSystem": {"type": "string"}}
+	}
+}`
+
+func TestMetadataCheck(t *testing.T) {
+	meta, err := LoadMetadata(strings.NewReader(testMetadata))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valid := []struct {
+		service string
+		method  string
+		mask    string
+	}{
+		{"SoftLayer_Hardware_Server", "getObject", "mask[id,hostname,datacenter[name]]"},
+		{"SoftLayer_Hardware_Server", "reloadOperatingSystem", ""},
+		{"SoftLayer_Hardware_Server", "getDatacenter", "id;name"},
+	}
+
+	for _, tc := range valid {
+		if err := meta.Check(tc.service, tc.method, &sl.Options{Mask: tc.mask}); err != nil {
+			t.Errorf("Expected %s::%s to pass, got %s", tc.service, tc.method, err)
+		}
+	}
+
+	invalid := []struct {
+		service string
+		method  string
+		mask    string
+	}{
+		{"SoftLayer_Virtual_Guest", "getObject", ""},
+		{"SoftLayer_Hardware_Server", "getBootMode", ""},
+		{"SoftLayer_Hardware_Server", "getObject", "mask[id,bootMode]"},
+	}
+
+	for _, tc := range invalid {
+		if err := meta.Check(tc.service, tc.method, &sl.Options{Mask: tc.mask}); err == nil {
+			t.Errorf("Expected %s::%s (mask %q) to fail", tc.service, tc.method, tc.mask)
+		}
+	}
+}
+
+func TestMetadataStrictSession(t *testing.T) {
+	meta, _ := LoadMetadata(strings.NewReader(testMetadata))
+
+	s := &Session{Metadata: meta, MetadataStrict: true}
+	err := s.DoRequest("SoftLayer_Hardware_Server", "getBootMode", nil, &sl.Options{}, nil)
+	if err == nil || !strings.Contains(err.Error(), "getBootMode") {
+		t.Errorf("Expected the call to be rejected, got %v", err)
+	}
+}
+
+func TestMetadataAddService(t *testing.T) {
+	meta, _ := LoadMetadata(strings.NewReader(testMetadata))
+	meta.AddService("SoftLayer_Preview_Widget", map[string]string{
+		"getObject":   "SoftLayer_Preview_Widget",
+		"spinWidgets": "SoftLayer_Hardware",
+	})
+
+	if err := meta.Check("SoftLayer_Preview_Widget", "getObject", &sl.Options{Mask: "mask[id,color]"}); err != nil {
+		t.Errorf("Expected the added service to pass, got %s", err)
+	}
+
+	if err := meta.Check("SoftLayer_Preview_Widget", "spinWidgets", &sl.Options{Mask: "bootMode"}); err == nil {
+		t.Errorf("Expected the mask of a known result type to be checked")
+	}
+
+	if meta.HasMethod("SoftLayer_Preview_Widget", "deleteWidget") {
+		t.Errorf("Expected only the added methods to exist")
+	}
+}
+
+func TestMaskProperties(t *testing.T) {
+	tests := map[string][]string{
+		"":                                  {},
+		"id;hostname":                       {"id", "hostname"},
+		"mask[id,datacenter[id,name],tags]": {"id", "datacenter", "tags"},
+		"datacenter.name;id":                {"datacenter", "id"},
+	}
+
+	for mask, expected := range tests {
+		if actual := maskProperties(mask); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("Mask %q: expected %v, got %v", mask, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,318 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mock provides a TransportHandler for unit tests, which answers the
+// calls of a session with responses programmed by the test, and records them:
+//
+//	handler := mock.New()
+//	handler.On("SoftLayer_Virtual_Guest", "getObject").WithId(1234).Returns(datatypes.Virtual_Guest{Id: sl.Int(1234)})
+//	handler.On("SoftLayer_Account", "getVirtualGuests").ReturnsFile("testdata/guests.json")
+//
+//	sess := handler.Session()
+//	// ... code under test calling the API through sess ...
+//
+//	handler.AssertExpectations(t)
+//
+// Unlike the fakes of services/fakes, it works at the level of the API calls,
+// so it serves any service, and code calling the API through helpers.
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sync"
+
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Call records a call made through the handler
+type Call struct {
+	Service string
+	Method  string
+	Args    []interface{}
+	Options sl.Options
+}
+
+// TestingT is the part of *testing.T used by AssertExpectations
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// Expectation is a call the handler expects, and the response it returns to
+// it. Its methods configure it, and return it for chaining.
+type Expectation struct {
+	service string
+	method  string
+
+	args    []interface{}
+	hasArgs bool
+	id      *int
+
+	value interface{}
+	raw   []byte
+	err   error
+	stub  func(call Call) (interface{}, error)
+
+	times int
+	calls int
+}
+
+// Handler is a TransportHandler answering calls with the responses of the
+// expectations registered with On. Calls matching no expectation fail. It is
+// safe for concurrent use.
+type Handler struct {
+	mu           sync.Mutex
+	expectations []*Expectation
+	calls        []Call
+	unexpected   []Call
+}
+
+// New returns a handler without expectations
+func New() *Handler {
+	return &Handler{}
+}
+
+// Session returns a session sending its calls to the handler
+func (h *Handler) Session() *session.Session {
+	return &session.Session{TransportHandler: h}
+}
+
+// On registers an expected call to the method of a service (e.g.,
+// "SoftLayer_Account" and "getObject"). Calls are answered by the first
+// expectation registered that they match and that is not used up.
+func (h *Handler) On(service string, method string) *Expectation {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	e := &Expectation{service: service, method: method}
+	h.expectations = append(h.expectations, e)
+
+	return e
+}
+
+// WithArgs restricts the expectation to calls with the passed arguments,
+// compared by their JSON encoding (so that sl.Int(1) matches 1)
+func (e *Expectation) WithArgs(args ...interface{}) *Expectation {
+	e.args = args
+	e.hasArgs = true
+	return e
+}
+
+// WithId restricts the expectation to calls on the object with the passed id
+func (e *Expectation) WithId(id int) *Expectation {
+	e.id = &id
+	return e
+}
+
+// Returns sets the response of the expectation. The value is assigned to the
+// result of the call if its type allows it, and converted through JSON if not
+// (e.g., from a map).
+func (e *Expectation) Returns(value interface{}) *Expectation {
+	e.value = value
+	return e
+}
+
+// ReturnsJSON sets the response of the expectation to a JSON document, as
+// returned by the REST API
+func (e *Expectation) ReturnsJSON(data string) *Expectation {
+	e.raw = []byte(data)
+	return e
+}
+
+// ReturnsFile sets the response of the expectation to the JSON document of a
+// fixture file. It panics if the file cannot be read.
+func (e *Expectation) ReturnsFile(path string) *Expectation {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		panic(fmt.Sprintf("mock: error reading fixture: %s", err))
+	}
+
+	e.raw = data
+	return e
+}
+
+// ReturnsError sets the error returned by the expectation
+func (e *Expectation) ReturnsError(err error) *Expectation {
+	e.err = err
+	return e
+}
+
+// ReturnsAPIError sets the error returned by the expectation to an API error
+func (e *Expectation) ReturnsAPIError(statusCode int, exception string, message string) *Expectation {
+	return e.ReturnsError(sl.Error{StatusCode: statusCode, Exception: exception, Message: message})
+}
+
+// Stub sets a function computing the response of the expectation
+func (e *Expectation) Stub(stub func(call Call) (interface{}, error)) *Expectation {
+	e.stub = stub
+	return e
+}
+
+// Times sets the number of calls the expectation answers, after which it is
+// used up. By default, it answers any number of calls, and is expected to be
+// called at least once.
+func (e *Expectation) Times(n int) *Expectation {
+	e.times = n
+	return e
+}
+
+func (e *Expectation) matches(call Call) bool {
+	if e.service != call.Service || e.method != call.Method || (e.times > 0 && e.calls >= e.times) {
+		return false
+	}
+
+	if e.id != nil && (call.Options.Id == nil || *call.Options.Id != *e.id) {
+		return false
+	}
+
+	if e.hasArgs {
+		expected, err1 := json.Marshal(e.args)
+		actual, err2 := json.Marshal(call.Args)
+		if err1 != nil || err2 != nil || string(expected) != string(actual) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DoRequest answers a call with the response of the expectation it matches
+func (h *Handler) DoRequest(sess *session.Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+	call := Call{Service: service, Method: method, Args: args}
+	if options != nil {
+		call.Options = *options
+	}
+
+	h.mu.Lock()
+	h.calls = append(h.calls, call)
+
+	var expectation *Expectation
+	for _, e := range h.expectations {
+		if e.matches(call) {
+			expectation = e
+			e.calls++
+			break
+		}
+	}
+
+	if expectation == nil {
+		h.unexpected = append(h.unexpected, call)
+	}
+	h.mu.Unlock()
+
+	if expectation == nil {
+		return fmt.Errorf("mock: unexpected call to %s::%s with %s", service, method, formatArgs(args))
+	}
+
+	value, err := expectation.value, expectation.err
+	if expectation.stub != nil {
+		value, err = expectation.stub(call)
+	}
+
+	if expectation.raw != nil && expectation.stub == nil {
+		if jsonErr := json.Unmarshal(expectation.raw, pResult); jsonErr != nil {
+			return fmt.Errorf("mock: error decoding the response of %s::%s: %s", service, method, jsonErr)
+		}
+	} else if value != nil && pResult != nil {
+		if convErr := assign(value, pResult); convErr != nil {
+			return fmt.Errorf("mock: error setting the response of %s::%s: %s", service, method, convErr)
+		}
+	}
+
+	return err
+}
+
+// assign sets value into the result pointed to by pResult, directly or
+// through JSON
+func assign(value interface{}, pResult interface{}) error {
+	target := reflect.ValueOf(pResult).Elem()
+	v := reflect.ValueOf(value)
+	if v.Type().AssignableTo(target.Type()) {
+		target.Set(v)
+		return nil
+	}
+
+	if v.Kind() == reflect.Ptr && v.Elem().Type().AssignableTo(target.Type()) {
+		target.Set(v.Elem())
+		return nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, pResult)
+}
+
+// Calls returns the calls made to the method of a service, or all calls if
+// service and method are empty
+func (h *Handler) Calls(service string, method string) []Call {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	calls := []Call{}
+	for _, call := range h.calls {
+		if (service == "" || call.Service == service) && (method == "" || call.Method == method) {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// CallCount returns the number of calls made to the method of a service
+func (h *Handler) CallCount(service string, method string) int {
+	return len(h.Calls(service, method))
+}
+
+// AssertExpectations reports, through t, the expectations that were not
+// called (as many times as set with Times), and the unexpected calls
+func (h *Handler) AssertExpectations(t TestingT) {
+	t.Helper()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, e := range h.expectations {
+		if e.calls == 0 || (e.times > 0 && e.calls != e.times) {
+			expected := "at least once"
+			if e.times > 0 {
+				expected = fmt.Sprintf("%d times", e.times)
+			}
+			t.Errorf("mock: expected %s::%s to be called %s, was called %d times", e.service, e.method, expected, e.calls)
+		}
+	}
+
+	for _, call := range h.unexpected {
+		t.Errorf("mock: unexpected call to %s::%s with %s", call.Service, call.Method, formatArgs(call.Args))
+	}
+}
+
+// formatArgs returns the arguments of a call as JSON, so that pointers are
+// shown by value
+func formatArgs(args []interface{}) string {
+	data, err := json.Marshal(args)
+	if err != nil {
+		return fmt.Sprint(args)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,132 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mock
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// recordingT records the errors reported by AssertExpectations
+type recordingT struct {
+	errors []string
+}
+
+func (t *recordingT) Helper() {}
+
+func (t *recordingT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestHandler(t *testing.T) {
+	fixture := filepath.Join(t.TempDir(), "guests.json")
+	ioutil.WriteFile(fixture, []byte(`[{"id": 1, "hostname": "web1"}, {"id": 2, "hostname": "web2"}]`), 0600)
+
+	handler := New()
+	handler.On("SoftLayer_Virtual_Guest", "getObject").WithId(1234).Returns(datatypes.Virtual_Guest{Hostname: sl.String("db1")})
+	handler.On("SoftLayer_Virtual_Guest", "getObject").ReturnsAPIError(404, "SoftLayer_Exception_ObjectNotFound", "Unable to find object")
+	handler.On("SoftLayer_Account", "getVirtualGuests").ReturnsFile(fixture).Times(1)
+	handler.On("SoftLayer_Virtual_Guest", "setTags").WithArgs("a,b").Returns(true)
+
+	sess := handler.Session()
+
+	guest, err := services.GetVirtualGuestService(sess).Id(1234).GetObject()
+	if err != nil || *guest.Hostname != "db1" {
+		t.Errorf("Expected the programmed guest, got %+v (%v)", guest, err)
+	}
+
+	var slErr sl.Error
+	if _, err = services.GetVirtualGuestService(sess).Id(1).GetObject(); !errors.As(err, &slErr) || slErr.StatusCode != 404 {
+		t.Errorf("Expected the programmed API error, got %v", err)
+	}
+
+	guests, err := services.GetAccountService(sess).Mask("id;hostname").GetVirtualGuests()
+	if err != nil || len(guests) != 2 || *guests[1].Hostname != "web2" {
+		t.Errorf("Expected the guests of the fixture, got %+v (%v)", guests, err)
+	}
+
+	if ok, err := services.GetVirtualGuestService(sess).Id(1).SetTags(sl.String("a,b")); err != nil || !ok {
+		t.Errorf("Expected the call to match its arguments, got %v", err)
+	}
+
+	calls := handler.Calls("SoftLayer_Account", "getVirtualGuests")
+	if len(calls) != 1 || calls[0].Options.Mask == "" || handler.CallCount("SoftLayer_Virtual_Guest", "getObject") != 2 {
+		t.Errorf("Expected the calls to be recorded, got %+v", handler.Calls("", ""))
+	}
+
+	rt := &recordingT{}
+	handler.AssertExpectations(rt)
+	if len(rt.errors) != 0 {
+		t.Errorf("Expected the expectations to be met, got %v", rt.errors)
+	}
+
+	// Used up expectations, and calls with other arguments, are unexpected
+	if _, err = services.GetAccountService(sess).GetVirtualGuests(); err == nil {
+		t.Errorf("Expected the used up expectation not to answer")
+	}
+
+	if _, err = services.GetVirtualGuestService(sess).Id(1).SetTags(sl.String("c")); err == nil {
+		t.Errorf("Expected a call with other arguments to fail")
+	}
+
+	handler.AssertExpectations(rt)
+	if len(rt.errors) != 2 {
+		t.Errorf("Expected the unexpected calls to be reported, got %v", rt.errors)
+	}
+}
+
+func TestHandlerUncalledExpectation(t *testing.T) {
+	handler := New()
+	handler.On("SoftLayer_Account", "getObject").Returns(map[string]interface{}{"id": 1})
+	handler.On("SoftLayer_Account", "getUsers").Times(2)
+
+	account, err := services.GetAccountService(handler.Session()).GetObject()
+	if err != nil || *account.Id != 1 {
+		t.Errorf("Expected a map response to be converted, got %+v (%v)", account, err)
+	}
+
+	rt := &recordingT{}
+	handler.AssertExpectations(rt)
+	if len(rt.errors) != 1 {
+		t.Errorf("Expected the uncalled expectation to be reported, got %v", rt.errors)
+	}
+}
+
+func TestHandlerScopedGetterKeepsInitParameters(t *testing.T) {
+	handler := New()
+	handler.On("SoftLayer_Virtual_Guest", "getBillingItem").Returns(datatypes.Billing_Item_Virtual_Guest{})
+
+	service := services.GetVirtualGuestService(handler.Session()).InitParameter("globalIdentifier", "abc-123")
+	if _, err := service.GetBillingItemWith(sl.Options{Mask: "recurringFee"}); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := handler.Calls("SoftLayer_Virtual_Guest", "getBillingItem")
+	if len(calls) != 1 || calls[0].Options.InitParameters == nil || (*calls[0].Options.InitParameters)["globalIdentifier"] != "abc-123" {
+		t.Errorf("Expected the init parameters of the service, got %+v", calls)
+	}
+	if len(calls) == 1 && calls[0].Options.Mask != "recurringFee" {
+		t.Errorf("Expected the mask of the call, got %q", calls[0].Options.Mask)
+	}
+}
@@ -0,0 +1,111 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Endpoints reachable from the SoftLayer private network only
+const (
+	DefaultPrivateEndpoint       = "https://api.service.softlayer.com/rest/v3"
+	DefaultPrivateXmlRpcEndpoint = "https://api.service.softlayer.com/xmlrpc/v3"
+	DefaultPrivateIAMEndpoint    = "https://private.iam.cloud.ibm.com"
+)
+
+// PrivateNetworkDetectTimeout bounds the detection of the private network by
+// the sessions of New configured with private_network = auto, so creating a
+// session does not wait on an unreachable endpoint for long
+var PrivateNetworkDetectTimeout = 500 * time.Millisecond
+
+// privateHosts maps public API host names to their private network
+// counterparts
+var privateHosts = map[string]string{
+	"api.softlayer.com": "api.service.softlayer.com",
+	"iam.cloud.ibm.com": "private.iam.cloud.ibm.com",
+}
+
+// PrivateEndpoint returns the private network counterpart of a public
+// endpoint URL: the SoftLayer API (REST or XML-RPC), IBM Cloud IAM, or a
+// regional object storage endpoint (s3.<region>.cloud-object-storage...).
+// Other URLs are returned unchanged.
+func PrivateEndpoint(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return endpoint
+	}
+
+	host := u.Hostname()
+	if private, ok := privateHosts[host]; ok {
+		host = private
+	} else if strings.HasPrefix(host, "s3.") && strings.Contains(host, ".cloud-object-storage.") &&
+		!strings.HasPrefix(host, "s3.private.") && !strings.HasPrefix(host, "s3.direct.") {
+		host = "s3.private." + strings.TrimPrefix(host, "s3.")
+	} else {
+		return endpoint
+	}
+
+	if port := u.Port(); port != "" {
+		host = net.JoinHostPort(host, port)
+	}
+	u.Host = host
+
+	return u.String()
+}
+
+// DetectPrivateNetwork returns true if the private API endpoint can be
+// reached, i.e. the process runs on the SoftLayer private network
+func DetectPrivateNetwork(ctx context.Context) bool {
+	_, err := dialEndpoint(ctx, DefaultPrivateEndpoint)
+	return err == nil
+}
+
+// SetPrivateNetwork creates a copy of the session which sends its requests,
+// and those of its IAMAuthenticator (if any), to the private network
+// endpoints, to avoid public egress, and returns it.
+func (r *Session) SetPrivateNetwork() *Session {
+	var s Session
+	s = *r
+
+	endpoint := r.Endpoint
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	s.Endpoint = PrivateEndpoint(endpoint)
+
+	if r.IAM != nil {
+		iamEndpoint := r.IAM.Endpoint
+		if iamEndpoint == "" {
+			iamEndpoint = DefaultIAMEndpoint
+		}
+
+		if private := PrivateEndpoint(iamEndpoint); private != iamEndpoint {
+			s.IAM = &IAMAuthenticator{
+				APIKey:        r.IAM.APIKey,
+				Endpoint:      private,
+				HTTPClient:    r.IAM.HTTPClient,
+				RefreshMargin: r.IAM.RefreshMargin,
+			}
+		}
+	}
+
+	return &s
+}
@@ -0,0 +1,56 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"testing"
+)
+
+func TestPrivateEndpoint(t *testing.T) {
+	endpoints := map[string]string{
+		"https://api.softlayer.com/rest/v3":                             DefaultPrivateEndpoint,
+		"https://api.softlayer.com/xmlrpc/v3/":                          DefaultPrivateXmlRpcEndpoint + "/",
+		"https://iam.cloud.ibm.com":                                     DefaultPrivateIAMEndpoint,
+		"https://s3.us-south.cloud-object-storage.appdomain.cloud":      "https://s3.private.us-south.cloud-object-storage.appdomain.cloud",
+		"https://s3.private.eu-de.cloud-object-storage.appdomain.cloud": "https://s3.private.eu-de.cloud-object-storage.appdomain.cloud",
+		"https://api.service.softlayer.com/rest/v3":                     DefaultPrivateEndpoint,
+		"http://localhost:8080/rest/v3":                                 "http://localhost:8080/rest/v3",
+	}
+
+	for public, expected := range endpoints {
+		if actual := PrivateEndpoint(public); actual != expected {
+			t.Errorf("Expected %s to map to %s, got %s", public, expected, actual)
+		}
+	}
+}
+
+func TestSetPrivateNetwork(t *testing.T) {
+	sess := (&Session{}).SetIAMAPIKey("key")
+
+	private := sess.SetPrivateNetwork()
+	if private.Endpoint != DefaultPrivateEndpoint {
+		t.Errorf("Expected the private API endpoint, got %s", private.Endpoint)
+	}
+
+	if private.IAM.Endpoint != DefaultPrivateIAMEndpoint || private.IAM.APIKey != "key" {
+		t.Errorf("Expected the private IAM endpoint, got %+v", private.IAM)
+	}
+
+	if sess.Endpoint != "" || sess.IAM.Endpoint != "" {
+		t.Errorf("Expected the original session to be unchanged")
+	}
+}
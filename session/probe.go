@@ -0,0 +1,148 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultProbeTimeout bounds the probe of each endpoint by ProbeEndpoints
+const DefaultProbeTimeout = 2 * time.Second
+
+// The API endpoints probed by SetFastestEndpoint by default. The API has no
+// regional endpoints; its public and private endpoints are global, and are
+// routed to the nearest point of presence. Endpoints of other geographies
+// (e.g., proxies) can be passed to SetFastestEndpoint explicitly.
+var (
+	RestEndpoints   = []string{DefaultEndpoint, DefaultPrivateEndpoint}
+	XmlRpcEndpoints = []string{DefaultXmlRpcEndpoint, DefaultPrivateXmlRpcEndpoint}
+)
+
+// EndpointLatency is the result of the probe of an endpoint: the time taken
+// to connect to it, or the error if it is unreachable
+type EndpointLatency struct {
+	Endpoint string
+	Latency  time.Duration
+	Err      error
+}
+
+// ProbeEndpoints connects to the passed endpoints concurrently, and returns
+// their latency, the fastest reachable endpoints first and the unreachable
+// ones last. Each probe is bounded by DefaultProbeTimeout, and by ctx.
+func ProbeEndpoints(ctx context.Context, endpoints ...string) []EndpointLatency {
+	results := make([]EndpointLatency, len(endpoints))
+
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func(i int, endpoint string) {
+			defer wg.Done()
+
+			latency, err := dialEndpoint(ctx, endpoint)
+			results[i] = EndpointLatency{Endpoint: endpoint, Latency: latency, Err: err}
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if (results[i].Err == nil) != (results[j].Err == nil) {
+			return results[i].Err == nil
+		}
+
+		return results[i].Err == nil && results[i].Latency < results[j].Latency
+	})
+
+	return results
+}
+
+// SetFastestEndpoint probes the passed endpoints (by default, RestEndpoints
+// or XmlRpcEndpoints, depending on the endpoint of the session), creates a
+// copy of the session using the fastest reachable one, and returns it, along
+// with the probe results. The other reachable endpoints are set as its
+// Failover, fastest first.
+//
+// An error is returned if no endpoint is reachable.
+func (r *Session) SetFastestEndpoint(ctx context.Context, endpoints ...string) (*Session, []EndpointLatency, error) {
+	if len(endpoints) == 0 {
+		endpoints = RestEndpoints
+		if _, ok := getDefaultTransport(r.Endpoint).(*XmlRpcTransport); ok {
+			endpoints = XmlRpcEndpoints
+		}
+	}
+
+	results := ProbeEndpoints(ctx, endpoints...)
+	if len(results) == 0 {
+		return r, results, errors.New("No API endpoint to probe")
+	}
+
+	if results[0].Err != nil {
+		return r, results, fmt.Errorf("No API endpoint is reachable: %s", results[0].Err)
+	}
+
+	var alternates []string
+	for _, result := range results[1:] {
+		if result.Err == nil {
+			alternates = append(alternates, result.Endpoint)
+		}
+	}
+
+	var s Session
+	s = *r
+	s.Endpoint = results[0].Endpoint
+	if len(alternates) > 0 {
+		s.Failover = NewFailover(alternates...)
+	}
+
+	return &s, results, nil
+}
+
+// dialEndpoint connects to the host of an endpoint URL, and returns the time
+// it took
+func dialEndpoint(ctx context.Context, endpoint string) (time.Duration, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return 0, err
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "443"
+		if u.Scheme == "http" {
+			port = "80"
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultProbeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", net.JoinHostPort(u.Hostname(), port))
+	if err != nil {
+		return 0, err
+	}
+	latency := time.Since(start)
+	conn.Close()
+
+	return latency, nil
+}
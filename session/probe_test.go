@@ -0,0 +1,57 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProbeEndpoints(t *testing.T) {
+	closed := httptest.NewServer(http.NotFoundHandler())
+	unreachable := closed.URL
+	closed.Close()
+
+	first := httptest.NewServer(http.NotFoundHandler())
+	defer first.Close()
+	second := httptest.NewServer(http.NotFoundHandler())
+	defer second.Close()
+
+	results := ProbeEndpoints(context.Background(), unreachable, first.URL, second.URL)
+	if len(results) != 3 || results[0].Err != nil || results[1].Err != nil || results[2].Endpoint != unreachable || results[2].Err == nil {
+		t.Fatalf("Expected the reachable endpoints first, got %+v", results)
+	}
+
+	if results[0].Latency > results[1].Latency {
+		t.Errorf("Expected the fastest endpoint first, got %+v", results)
+	}
+
+	sess, _, err := (&Session{}).SetFastestEndpoint(context.Background(), unreachable, first.URL, second.URL)
+	if err != nil || sess.Endpoint == unreachable {
+		t.Fatalf("Expected a reachable endpoint to be selected, got %s (%v)", sess.Endpoint, err)
+	}
+
+	if sess.Failover == nil || len(sess.Failover.Endpoints) != 1 || sess.Failover.Endpoints[0] == unreachable {
+		t.Errorf("Expected the other reachable endpoint as failover, got %+v", sess.Failover)
+	}
+
+	if _, _, err = (&Session{}).SetFastestEndpoint(context.Background(), unreachable); err == nil {
+		t.Errorf("Expected an error when no endpoint is reachable")
+	}
+}
@@ -0,0 +1,262 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// RateLimit is the request budget last reported by the API in its response
+// headers (X-RateLimit-* or RateLimit-*, and Retry-After). Values the API did
+// not report are -1, or the zero time.
+type RateLimit struct {
+	// Limit is the number of requests allowed in the current window
+	Limit int
+
+	// Remaining is the number of requests left in the current window
+	Remaining int
+
+	// Reset is when the current window ends
+	Reset time.Time
+
+	// RetryAfter is when the API asked clients to retry a throttled request
+	RetryAfter time.Time
+
+	// Observed is when the headers were received
+	Observed time.Time
+}
+
+// parseRateLimit reads the rate limit headers of a response. It returns false
+// if the response has none.
+func parseRateLimit(header http.Header, now time.Time) (RateLimit, bool) {
+	limit := RateLimit{Limit: -1, Remaining: -1, Observed: now}
+	found := false
+
+	for _, prefix := range []string{"X-RateLimit-", "RateLimit-"} {
+		if value, ok := headerInt(header, prefix+"Limit"); ok && limit.Limit == -1 {
+			limit.Limit, found = value, true
+		}
+
+		if value, ok := headerInt(header, prefix+"Remaining"); ok && limit.Remaining == -1 {
+			limit.Remaining, found = value, true
+		}
+
+		if value, ok := headerInt(header, prefix+"Reset"); ok && limit.Reset.IsZero() {
+			limit.Reset, found = resetTime(value, now), true
+		}
+	}
+
+	if value := strings.TrimSpace(header.Get("Retry-After")); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil {
+			limit.RetryAfter, found = now.Add(time.Duration(seconds)*time.Second), true
+		} else if at, err := http.ParseTime(value); err == nil {
+			limit.RetryAfter, found = at, true
+		}
+	}
+
+	return limit, found
+}
+
+// headerInt returns the leading integer of a header value. The draft IETF
+// headers may carry a policy after the value (e.g., "100, 100;w=60").
+func headerInt(header http.Header, name string) (int, bool) {
+	value := strings.TrimSpace(header.Get(name))
+	if end := strings.IndexAny(value, ",;"); end != -1 {
+		value = strings.TrimSpace(value[:end])
+	}
+
+	n, err := strconv.Atoi(value)
+	return n, err == nil
+}
+
+// resetTime interprets a reset value as a Unix timestamp, or else as a number
+// of seconds from now
+func resetTime(value int, now time.Time) time.Time {
+	if int64(value) > now.Unix()/2 {
+		return time.Unix(int64(value), 0)
+	}
+
+	return now.Add(time.Duration(value) * time.Second)
+}
+
+// RateLimit returns the request budget last reported by the API to the
+// sessions sharing the Telemetry of this session. It returns false if no
+// Telemetry is set, or the API has not reported a budget.
+func (r *Session) RateLimit() (RateLimit, bool) {
+	return r.Telemetry.RateLimit()
+}
+
+// responseHint holds the status and Retry-After of the last response to a
+// call, and counts its requests and the bytes exchanged. It is carried by the
+// context of the call, as sessions are shared between calls.
+type responseHint struct {
+	mu       sync.Mutex
+	at       time.Time
+	status   int
+	requests int
+	sent     int64
+	received int64
+	endpoint string
+	header   http.Header
+}
+
+type responseHintKey struct{}
+
+func withResponseHint(ctx context.Context) context.Context {
+	return context.WithValue(ctx, responseHintKey{}, &responseHint{})
+}
+
+// recordResponse records the status and Retry-After header of a response to
+// the call of ctx, and counts the bytes of its request and body
+func recordResponse(ctx context.Context, response *http.Response) {
+	hint, ok := ctx.Value(responseHintKey{}).(*responseHint)
+	if !ok {
+		return
+	}
+
+	limit, _ := parseRateLimit(response.Header, time.Now())
+
+	hint.mu.Lock()
+	hint.at = limit.RetryAfter
+	hint.status = response.StatusCode
+	hint.header = response.Header
+	hint.requests++
+	if response.Request != nil && response.Request.ContentLength > 0 {
+		hint.sent += response.Request.ContentLength
+	}
+	hint.mu.Unlock()
+
+	if response.Body != nil {
+		response.Body = &hintReader{ReadCloser: response.Body, hint: hint}
+	}
+}
+
+// hintReader counts the bytes of a response body read for a call
+type hintReader struct {
+	io.ReadCloser
+	hint *responseHint
+}
+
+func (h *hintReader) Read(p []byte) (int, error) {
+	n, err := h.ReadCloser.Read(p)
+
+	h.hint.mu.Lock()
+	h.hint.received += int64(n)
+	h.hint.mu.Unlock()
+
+	return n, err
+}
+
+// recordEndpoint records the endpoint a call is sent to
+func recordEndpoint(ctx context.Context, endpoint string) {
+	hint, ok := ctx.Value(responseHintKey{}).(*responseHint)
+	if !ok {
+		return
+	}
+
+	hint.mu.Lock()
+	hint.endpoint = endpoint
+	hint.mu.Unlock()
+}
+
+// responseStatus returns the HTTP status of the last response to the call of
+// ctx, or zero if none was received
+func responseStatus(ctx context.Context) int {
+	hint, ok := ctx.Value(responseHintKey{}).(*responseHint)
+	if !ok {
+		return 0
+	}
+
+	hint.mu.Lock()
+	defer hint.mu.Unlock()
+
+	return hint.status
+}
+
+// retryAfter returns how long the API last asked to wait before retrying the
+// call of ctx, or zero
+func retryAfter(ctx context.Context) time.Duration {
+	hint, ok := ctx.Value(responseHintKey{}).(*responseHint)
+	if !ok {
+		return 0
+	}
+
+	hint.mu.Lock()
+	defer hint.mu.Unlock()
+
+	if hint.at.IsZero() {
+		return 0
+	}
+
+	if wait := time.Until(hint.at); wait > 0 {
+		return wait
+	}
+
+	return 0
+}
+
+// retryWait returns the wait before retrying a call, which is at least the
+// Retry-After reported by the API. It returns false if the API asked to wait
+// longer than max.
+func retryWait(ctx context.Context, wait time.Duration, max time.Duration) (time.Duration, bool) {
+	after := retryAfter(ctx)
+	if after > max {
+		return after, false
+	}
+
+	if after > wait {
+		return after, true
+	}
+
+	return wait, true
+}
+
+// isRateLimited returns true for errors reporting that the API throttled the
+// call
+func isRateLimited(err error) bool {
+	var slErr sl.Error
+	if !errors.As(err, &slErr) {
+		return false
+	}
+
+	return slErr.StatusCode == 429 || slErr.Exception == rateLimitExceeded
+}
+
+// checkRateLimit converts API errors reporting throttling into an
+// sl.RateLimitError. Any other error is returned unchanged.
+func checkRateLimit(ctx context.Context, service string, method string, err error) error {
+	slErr, ok := err.(sl.Error)
+	if !ok || !isRateLimited(slErr) {
+		return err
+	}
+
+	return sl.RateLimitError{
+		Service:    service,
+		Method:     method,
+		RetryAfter: retryAfter(ctx),
+		Err:        slErr,
+	}
+}
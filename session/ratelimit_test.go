@@ -0,0 +1,155 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "50")
+	header.Set("X-RateLimit-Remaining", "7")
+	header.Set("X-RateLimit-Reset", "1700000030")
+	limit, ok := parseRateLimit(header, now)
+	if !ok || limit.Limit != 50 || limit.Remaining != 7 || !limit.Reset.Equal(now.Add(30*time.Second)) {
+		t.Errorf("Unexpected rate limit %+v", limit)
+	}
+
+	header = http.Header{}
+	header.Set("RateLimit-Remaining", "0, 100;w=60")
+	header.Set("RateLimit-Reset", "12")
+	header.Set("Retry-After", "5")
+	limit, ok = parseRateLimit(header, now)
+	if !ok || limit.Limit != -1 || limit.Remaining != 0 ||
+		!limit.Reset.Equal(now.Add(12*time.Second)) || !limit.RetryAfter.Equal(now.Add(5*time.Second)) {
+		t.Errorf("Unexpected rate limit %+v", limit)
+	}
+
+	if _, ok = parseRateLimit(http.Header{"Content-Type": {"application/json"}}, now); ok {
+		t.Errorf("Expected no rate limit without the headers")
+	}
+}
+
+func TestSessionRateLimit(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", restEndpoint+"/SoftLayer_Account.json",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{}`)
+			resp.Header.Set("X-RateLimit-Limit", "50")
+			resp.Header.Set("X-RateLimit-Remaining", "49")
+			return resp, nil
+		})
+
+	sess := &Session{Endpoint: restEndpoint}
+	if _, ok := sess.RateLimit(); ok {
+		t.Errorf("Expected no rate limit without a Telemetry")
+	}
+
+	sess.Telemetry = NewTelemetry()
+	var result struct{}
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	limit, ok := sess.RateLimit()
+	if !ok || limit.Limit != 50 || limit.Remaining != 49 {
+		t.Errorf("Unexpected rate limit %+v", limit)
+	}
+
+	var out bytes.Buffer
+	if err := sess.Telemetry.Summary().WritePrometheus(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range []string{
+		"softlayer_api_calls_total 1",
+		`softlayer_api_service_calls_total{service="SoftLayer_Account"} 1`,
+		"softlayer_api_ratelimit_remaining 49",
+	} {
+		if !strings.Contains(out.String(), line+"\n") {
+			t.Errorf("Expected %q in:\n%s", line, out.String())
+		}
+	}
+}
+
+func TestRateLimitError(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	sess := &Session{Endpoint: server.URL}
+
+	var result struct{}
+	err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+
+	var rateErr sl.RateLimitError
+	if !errors.As(err, &rateErr) {
+		t.Fatalf("Expected a rate limit error, got %#v", err)
+	}
+
+	if rateErr.Method != "getObject" || rateErr.RetryAfter <= 0 || rateErr.RetryAfter > time.Second {
+		t.Errorf("Unexpected rate limit error %+v", rateErr)
+	}
+
+	var slErr sl.Error
+	if !errors.As(err, &slErr) || slErr.StatusCode != 429 {
+		t.Errorf("Expected the API error to be wrapped, got %#v", err)
+	}
+
+	// Retries wait as long as the API asked
+	atomic.StoreInt32(&requests, 0)
+	started := time.Now()
+	sess = sess.SetRetryPolicy(&RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond})
+	if err = sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+		t.Fatalf("Expected the call to be retried, got %s", err)
+	}
+
+	if requests != 2 || time.Since(started) < 500*time.Millisecond {
+		t.Errorf("Expected the retry to honor Retry-After, got %d requests in %s", requests, time.Since(started))
+	}
+
+	// No retry is made when the API asks to wait longer than the policy allows
+	atomic.StoreInt32(&requests, 0)
+	sess = sess.SetRetryPolicy(&RetryPolicy{MaxAttempts: 2, MaxDelay: 100 * time.Millisecond})
+	err = sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	if !errors.As(err, &rateErr) || requests != 1 {
+		t.Errorf("Expected the rate limit error without retrying, got %v after %d requests", err, requests)
+	}
+}
@@ -0,0 +1,250 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"regexp"
+	"sync"
+)
+
+// RecorderMode is the mode of a Recorder
+type RecorderMode int
+
+// Recorder modes
+const (
+	// RecordMode sends every request to the API, and records it
+	RecordMode RecorderMode = iota
+
+	// ReplayMode answers every request from the cassette, without sending it.
+	// Requests missing from the cassette fail.
+	ReplayMode
+
+	// AutoMode replays the cassette if its file exists, and records it if not
+	AutoMode
+)
+
+// scrubbedMembers matches the values of the JSON members and XML-RPC struct
+// members carrying credentials, in requests (e.g., the API key of the
+// authentication header of XML-RPC calls) and responses (e.g., the keys
+// returned by getApiAuthenticationKeys)
+var scrubbedMembers = []*regexp.Regexp{
+	regexp.MustCompile(`("(?:apiKey|authToken|authenticationKey|password)"\s*:\s*")(?:[^"\\]|\\.)*`),
+	regexp.MustCompile(`(<name>(?:apiKey|authToken|authenticationKey|password)</name>\s*<value>\s*(?:<string>)?)[^<]*`),
+}
+
+// Interaction is a request and its response, as recorded in a cassette
+type Interaction struct {
+	Request struct {
+		Method string      `json:"method"`
+		URL    string      `json:"url"`
+		Header http.Header `json:"header,omitempty"`
+		Body   string      `json:"body,omitempty"`
+	} `json:"request"`
+
+	Response struct {
+		StatusCode int         `json:"statusCode"`
+		Header     http.Header `json:"header,omitempty"`
+		Body       string      `json:"body,omitempty"`
+	} `json:"response"`
+}
+
+// Recorder is an http.RoundTripper recording the API requests of a session,
+// and their responses, to a cassette file, and replaying them, so that tests
+// can run offline and deterministically against recorded API responses:
+//
+//	recorder, err := session.NewRecorder("testdata/create-guest.json", session.AutoMode)
+//	defer recorder.Save()
+//	sess = sess.SetRoundTripper(recorder)
+//
+// Credentials are scrubbed from the cassette: the headers carrying them are
+// not recorded, and the values of apiKey, authToken, authenticationKey and
+// password members are replaced by a placeholder. Requests are matched by
+// method, URL and (scrubbed) body, in the order they were recorded.
+type Recorder struct {
+	// Path is the file of the cassette
+	Path string
+
+	// Mode is RecordMode or ReplayMode. NewRecorder resolves AutoMode.
+	Mode RecorderMode
+
+	// Transport sends the requests in RecordMode. Defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// Scrub, when set, is applied to the bodies of the requests and responses
+	// recorded, in addition to the default scrubbing, e.g. to remove other
+	// secrets or personal data
+	Scrub func(body []byte) []byte
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewRecorder returns a recorder of the passed cassette file, which is read
+// in ReplayMode (or AutoMode, if it exists)
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	r := &Recorder{Path: path, Mode: mode}
+	if mode == AutoMode {
+		r.Mode = RecordMode
+		if _, err := os.Stat(path); err == nil {
+			r.Mode = ReplayMode
+		}
+	}
+
+	if r.Mode == ReplayMode {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading cassette: %s", err)
+		}
+
+		if err = json.Unmarshal(data, &r.interactions); err != nil {
+			return nil, fmt.Errorf("Error reading cassette %s: %s", path, err)
+		}
+		r.used = make([]bool, len(r.interactions))
+	}
+
+	return r, nil
+}
+
+// RoundTrip records or replays a request
+func (r *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	var body []byte
+	if request.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(request.Body); err != nil {
+			return nil, err
+		}
+		request.Body.Close()
+	}
+
+	u := *request.URL
+	u.User = nil
+
+	var interaction Interaction
+	interaction.Request.Method = request.Method
+	interaction.Request.URL = u.String()
+	interaction.Request.Body = string(r.scrub(body))
+
+	if r.Mode == ReplayMode {
+		return r.replay(request, interaction)
+	}
+
+	request = request.Clone(request.Context())
+	request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	interaction.Request.Header = request.Header.Clone()
+	for _, header := range redactedHeaders {
+		interaction.Request.Header.Del(header)
+	}
+	interaction.Response.StatusCode = response.StatusCode
+	interaction.Response.Header = response.Header.Clone()
+	interaction.Response.Header.Del("Set-Cookie")
+	interaction.Response.Body = string(r.scrub(respBody))
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+
+	return response, nil
+}
+
+// replay returns the response of the first unused recorded interaction
+// matching a request
+func (r *Recorder) replay(request *http.Request, interaction Interaction) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, recorded := range r.interactions {
+		if r.used[i] || recorded.Request.Method != interaction.Request.Method ||
+			recorded.Request.URL != interaction.Request.URL || recorded.Request.Body != interaction.Request.Body {
+			continue
+		}
+		r.used[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.Response.StatusCode, http.StatusText(recorded.Response.StatusCode)),
+			StatusCode:    recorded.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        recorded.Response.Header.Clone(),
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(recorded.Response.Body))),
+			ContentLength: int64(len(recorded.Response.Body)),
+			Request:       request,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("No recorded interaction for %s %s in cassette %s",
+		interaction.Request.Method, interaction.Request.URL, r.Path)
+}
+
+// Save writes the interactions recorded to the cassette file. It does nothing
+// in ReplayMode.
+func (r *Recorder) Save() error {
+	if r.Mode == ReplayMode {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err = ioutil.WriteFile(r.Path, data, 0600); err != nil {
+		return fmt.Errorf("Error writing cassette: %s", err)
+	}
+
+	return nil
+}
+
+func (r *Recorder) scrub(body []byte) []byte {
+	for _, re := range scrubbedMembers {
+		body = re.ReplaceAll(body, []byte("${1}[REDACTED]"))
+	}
+
+	if r.Scrub != nil {
+		body = r.Scrub(body)
+	}
+
+	return body
+}
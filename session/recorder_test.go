@@ -0,0 +1,93 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": 7, "username": "jdoe", "authenticationKey": "s3cr3t"}]`)
+	}))
+
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	recorder, err := NewRecorder(cassette, AutoMode)
+	if err != nil || recorder.Mode != RecordMode {
+		t.Fatalf("Expected a recorder in record mode, got %v (%v)", recorder, err)
+	}
+
+	sess := (&Session{Endpoint: server.URL, UserName: "jdoe", APIKey: "apikey"}).SetRoundTripper(recorder)
+
+	var result []struct {
+		Id int `json:"id"`
+	}
+	options := &sl.Options{Mask: "id"}
+	if err = sess.DoRequest("SoftLayer_Account", "getUsers", nil, options, &result); err != nil || result[0].Id != 7 {
+		t.Fatalf("Expected the call to be recorded, got %v", err)
+	}
+	server.Close()
+
+	if err = recorder.Save(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	data, _ := ioutil.ReadFile(cassette)
+	if strings.Contains(string(data), "s3cr3t") || strings.Contains(string(data), "Authorization") {
+		t.Errorf("Expected credentials to be scrubbed from the cassette, got %s", data)
+	}
+
+	// The cassette is replayed without the server
+	replayer, err := NewRecorder(cassette, AutoMode)
+	if err != nil || replayer.Mode != ReplayMode {
+		t.Fatalf("Expected a recorder in replay mode, got %v (%v)", replayer, err)
+	}
+
+	sess = sess.SetRoundTripper(replayer)
+	result = nil
+	if err = sess.DoRequest("SoftLayer_Account", "getUsers", nil, options, &result); err != nil || result[0].Id != 7 {
+		t.Fatalf("Expected the call to be replayed, got %v", err)
+	}
+
+	// Each interaction is replayed once, and unknown requests fail
+	if err = sess.DoRequest("SoftLayer_Account", "getUsers", nil, options, &result); err == nil {
+		t.Errorf("Expected the call to fail once the interaction was replayed")
+	}
+
+	if err = sess.DoRequest("SoftLayer_Account", "getObject", nil, options, &result); err == nil {
+		t.Errorf("Expected a call missing from the cassette to fail")
+	}
+}
+
+func TestRecorderScrub(t *testing.T) {
+	recorder := &Recorder{}
+	body := `{"parameters": [{"apiKey": "abc\"def", "username": "jdoe"}]}` +
+		`<member><name>password</name><value><string>hunter2</string></value></member>`
+
+	scrubbed := string(recorder.scrub([]byte(body)))
+	if strings.Contains(scrubbed, "abc") || strings.Contains(scrubbed, "hunter2") || !strings.Contains(scrubbed, "jdoe") {
+		t.Errorf("Expected the credentials to be scrubbed, got %s", scrubbed)
+	}
+}
@@ -23,10 +23,13 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"text/template"
 	"time"
 
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -41,16 +44,15 @@ func (r *RestTransport) DoRequest(sess *Session, service string, method string,
 	restMethod := httpMethod(method, args)
 
 	// Parse any method parameters and determine the HTTP method
-	var parameters []byte
-	if len(args) > 0 {
-		// parse the parameters
-		parameters, _ = json.Marshal(
-			map[string]interface{}{
-				"parameters": args,
-			})
+	parameters, err := encodeParameters(sess, service, method, args)
+	if err != nil {
+		return err
 	}
 
-	path := buildPath(service, method, options)
+	path, err := buildSessionPath(sess, service, method, options)
+	if err != nil {
+		return err
+	}
 
 	resp, code, err := sendHTTPRequest(
 		sess,
@@ -72,9 +74,22 @@ func (r *RestTransport) DoRequest(sess *Session, service string, method string,
 		return err
 	}
 
+	if sess.DecodeFunc != nil {
+		resp, err = sess.DecodeFunc(service, method, resp)
+		if err != nil {
+			return fmt.Errorf("Error decoding response of %s::%s: %s", service, method, err)
+		}
+	}
+
+	// Void methods may return an empty body (e.g., 204 No Content)
+	if len(bytes.TrimSpace(resp)) == 0 {
+		zeroResult(pResult)
+		return nil
+	}
+
 	// Some APIs that normally return a collection, omit the []'s when the API returns a single value
 	returnType := reflect.TypeOf(pResult).String()
-	if strings.Index(returnType, "[]") == 1 && strings.Index(string(resp), "[") != 0 {
+	if strings.Index(returnType, "[]") == 1 && strings.Index(string(resp), "[") != 0 && !isNull(resp) {
 		resp = []byte("[" + string(resp) + "]")
 	}
 
@@ -85,11 +100,20 @@ func (r *RestTransport) DoRequest(sess *Session, service string, method string,
 	switch pResult.(type) {
 	case *[]uint8:
 		// exclude quotes
-		*pResult.(*[]uint8) = resp[1 : len(resp)-1]
+		if isNull(resp) {
+			*pResult.(*[]uint8) = nil
+		} else {
+			*pResult.(*[]uint8) = resp[1 : len(resp)-1]
+		}
 	case *datatypes.Void:
+		*pResult.(*datatypes.Void) = 0
 	case *uint:
 		var val uint64
 		val, err = strconv.ParseUint(string(resp), 0, 64)
+		if n, ok := integerText(string(resp)); err != nil && ok {
+			// Large counters are sometimes sent in exponent form
+			val, err = strconv.ParseUint(n, 10, 64)
+		}
 		if err == nil {
 			*pResult.(*uint) = uint(val)
 		}
@@ -111,6 +135,10 @@ func (r *RestTransport) DoRequest(sess *Session, service string, method string,
 	default:
 		// Must be a json representation of one of the many softlayer datatypes
 		err = json.Unmarshal(resp, pResult)
+		if _, ok := err.(*json.UnmarshalTypeError); ok {
+			zeroResult(pResult)
+			err = unmarshalLenient(resp, pResult)
+		}
 	}
 
 	if err != nil {
@@ -120,26 +148,169 @@ func (r *RestTransport) DoRequest(sess *Session, service string, method string,
 	return err
 }
 
+// encodeParameters returns the JSON request body for the parameters of a call,
+// after applying the EncodeFunc of the session (if any)
+func encodeParameters(sess *Session, service string, method string, args []interface{}) ([]byte, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	if sess.EncodeFunc != nil {
+		// Hand the hook generic values rather than the typed parameters
+		encoded, err := json.Marshal(args)
+		if err != nil {
+			return nil, fmt.Errorf("Error encoding parameters of %s::%s: %s", service, method, err)
+		}
+
+		var generic []interface{}
+		if err = json.Unmarshal(encoded, &generic); err != nil {
+			return nil, fmt.Errorf("Error encoding parameters of %s::%s: %s", service, method, err)
+		}
+
+		args, err = sess.EncodeFunc(service, method, generic)
+		if err != nil {
+			return nil, fmt.Errorf("Error encoding parameters of %s::%s: %s", service, method, err)
+		}
+	}
+
+	parameters, _ := json.Marshal(
+		map[string]interface{}{
+			"parameters": args,
+		})
+
+	return parameters, nil
+}
+
 type rawString struct {
 	Val string
 }
 
-func buildPath(service string, method string, options *sl.Options) string {
-	path := service
+// isNull returns true if resp is the JSON literal null
+func isNull(resp []byte) bool {
+	return string(bytes.TrimSpace(resp)) == "null"
+}
+
+// zeroResult resets the value pointed to by pResult, for responses that carry
+// no result
+func zeroResult(pResult interface{}) {
+	if v := reflect.ValueOf(pResult); v.Kind() == reflect.Ptr && !v.IsNil() {
+		v.Elem().Set(reflect.Zero(v.Elem().Type()))
+	}
+}
+
+// PathTemplateData holds the values available to a Session PathTemplate
+type PathTemplateData struct {
+	// Service is the full service name (e.g., SoftLayer_Account)
+	Service string
+
+	// Method is the API method name, or empty for methods that map onto the
+	// basic REST verbs (getObject, deleteObject, createObject, editObject(s))
+	Method string
+
+	// Id is the init parameter (the id, or else the global identifier), or
+	// empty if none was set
+	Id string
+}
+
+var pathTemplates sync.Map
+
+// renderPathTemplate executes the PathTemplate of the session against data.
+// Parsed templates are cached, since sessions are commonly copied.
+func renderPathTemplate(sess *Session, data PathTemplateData) (string, error) {
+	cached, ok := pathTemplates.Load(sess.PathTemplate)
+	if !ok {
+		t, err := template.New("path").Parse(sess.PathTemplate)
+		if err != nil {
+			return "", fmt.Errorf("Error parsing path template: %s", err)
+		}
+		cached, _ = pathTemplates.LoadOrStore(sess.PathTemplate, t)
+	}
+
+	var buf bytes.Buffer
+	err := cached.(*template.Template).Execute(&buf, data)
+	if err != nil {
+		return "", fmt.Errorf("Error executing path template: %s", err)
+	}
+
+	return buf.String(), nil
+}
+
+func buildSessionPath(sess *Session, service string, method string, options *sl.Options) (string, error) {
+	if options.Id == nil && options.GlobalID == nil && options.InitParameters != nil && len(*options.InitParameters) > 1 {
+		return "", fmt.Errorf("Compound init parameters are not supported by the REST transport; use the XML-RPC endpoint")
+	}
+
+	if sess.PathTemplate == "" {
+		return buildPath(service, method, options), nil
+	}
+
+	data := PathTemplateData{Service: service, Id: initParameter(options)}
 
+	if !isBasicRestMethod(method) {
+		data.Method = method
+	}
+
+	return renderPathTemplate(sess, data)
+}
+
+// initParameter returns the path segment identifying the object the method is
+// called on, or an empty string if no init parameter was set
+func initParameter(options *sl.Options) string {
 	if options.Id != nil {
-		path = path + "/" + strconv.Itoa(*options.Id)
+		return strconv.Itoa(*options.Id)
+	}
+
+	if options.GlobalID != nil {
+		return url.PathEscape(*options.GlobalID)
+	}
+
+	// A single init parameter other than the id, e.g. a username
+	if options.InitParameters != nil {
+		for _, value := range *options.InitParameters {
+			return url.PathEscape(fmt.Sprint(value))
+		}
+	}
+
+	return ""
+}
+
+func buildPath(service string, method string, options *sl.Options) string {
+	path := url.PathEscape(service)
+
+	if id := initParameter(options); id != "" {
+		path = path + "/" + id
 	}
 
 	// omit the API method name if the method represents one of the basic REST methods
-	if method != "getObject" && method != "deleteObject" && method != "createObject" &&
-		method != "editObject" && method != "editObjects" {
-		path = path + "/" + method
+	if !isBasicRestMethod(method) {
+		path = path + "/" + url.PathEscape(method)
 	}
 
 	return path + ".json"
 }
 
+// joinQuery appends the query of the request options to any query string set
+// through the session PathTemplate
+func joinQuery(raw string, query string) string {
+	if raw == "" {
+		return query
+	}
+
+	if query == "" {
+		return raw
+	}
+
+	return raw + "&" + query
+}
+
+func isBasicRestMethod(method string) bool {
+	return method == "getObject" || method == "deleteObject" || method == "createObject" ||
+		method == "editObject" || method == "editObjects"
+}
+
+// encodeQuery returns the query string carrying the mask, filter and result
+// limit of a request.  Values are fully escaped; spaces are sent as %20 rather
+// than '+', which the API does not decode in filter values.
 func encodeQuery(opts *sl.Options) string {
 	query := new(url.URL).Query()
 
@@ -162,7 +333,8 @@ func encodeQuery(opts *sl.Options) string {
 		query.Add("resultLimit", fmt.Sprintf("%d,%d", startOffset, *opts.Limit))
 	}
 
-	return query.Encode()
+	// QueryEscape encodes a literal '+' as %2B, so any '+' left is a space
+	return strings.Replace(query.Encode(), "+", "%20", -1)
 }
 
 func sendHTTPRequest(
@@ -196,7 +368,11 @@ func tryHTTPRequest(
 		if retries--; retries > 0 {
 			jitter := time.Duration(rand.Int63n(int64(wait)))
 			wait = wait + jitter/2
-			time.Sleep(wait)
+			delay, ok := retryWait(sess.requestContext(), wait, DefaultMaxRetryDelay)
+			if !ok || sess.sleep(delay) != nil {
+				return resp, code, err
+			}
+			sess.Telemetry.recordRetry()
 			return tryHTTPRequest(
 				retries, wait, sess, path, requestType, requestBody, options)
 		}
@@ -208,16 +384,24 @@ func tryHTTPRequest(
 func makeHTTPRequest(
 	session *Session, path string, requestType string,
 	requestBody *bytes.Buffer, options *sl.Options) ([]byte, int, error) {
-	log := Logger
+	// Work on a copy of the client, so that a client supplied by the user is
+	// not modified (possibly by concurrent requests)
+	client := &http.Client{}
+	if session.HTTPClient != nil {
+		*client = *session.HTTPClient
+	}
 
-	client := session.HTTPClient
-	if client == nil {
-		client = &http.Client{}
+	if client.Transport == nil {
+		client.Transport = session.roundTripper()
 	}
+	client.Transport = session.compress(client.Transport)
 
-	client.Timeout = DefaultTimeout
-	if session.Timeout != 0 {
+	if session.Timeout < 0 {
+		client.Timeout = 0
+	} else if session.Timeout != 0 {
 		client.Timeout = session.Timeout
+	} else if client.Timeout == 0 {
+		client.Timeout = DefaultTimeout
 	}
 
 	var url string
@@ -227,12 +411,22 @@ func makeHTTPRequest(
 		url = url + session.Endpoint
 	}
 	url = fmt.Sprintf("%s/%s", strings.TrimRight(url, "/"), path)
-	req, err := http.NewRequest(requestType, url, requestBody)
+	// The body is read from a copy, so it is sent again when retried
+	req, err := http.NewRequest(requestType, url, bytes.NewReader(requestBody.Bytes()))
 	if err != nil {
 		return nil, 0, err
 	}
 
-	if session.APIKey != "" {
+	req = req.WithContext(session.requestContext())
+	if session.Telemetry != nil {
+		req = req.WithContext(httptrace.WithClientTrace(req.Context(), session.Telemetry.clientTrace()))
+	}
+
+	if session.Anonymous {
+		// no authentication
+	} else if session.IAM != nil {
+		// a bearer token is set with the other headers
+	} else if session.APIKey != "" {
 		req.SetBasicAuth(session.UserName, session.APIKey)
 	} else if session.AuthToken != "" {
 		req.SetBasicAuth(fmt.Sprintf("%d", session.UserId), session.AuthToken)
@@ -245,17 +439,16 @@ func makeHTTPRequest(
 
 	req.Header.Set("User-Agent", session.userAgent)
 
-	if session.Headers != nil {
-		for key, value := range session.Headers {
-			req.Header.Set(key, value)
-		}
+	err = session.setHeaders(req)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	req.URL.RawQuery = encodeQuery(options)
+	req.URL.RawQuery = joinQuery(req.URL.RawQuery, encodeQuery(options))
 
 	if session.Debug {
-		log.Println("[DEBUG] Request URL: ", requestType, req.URL)
-		log.Println("[DEBUG] Parameters: ", requestBody.String())
+		session.log(LogDebug, "Request", "method", requestType, "url", req.URL.String(),
+			"parameters", requestBody.String())
 	}
 
 	resp, err := client.Do(req)
@@ -272,16 +465,19 @@ func makeHTTPRequest(
 		return nil, statusCode, err
 	}
 
+	session.Telemetry.recordRateLimit(resp.Header)
+	recordResponse(req.Context(), resp)
+	resp.Body = session.Telemetry.trackBody(resp.Body)
 	defer resp.Body.Close()
 
 	responseBody, err := ioutil.ReadAll(resp.Body)
+	session.Telemetry.recordBytes(req.ContentLength, 0)
 	if err != nil {
 		return nil, resp.StatusCode, err
 	}
 
 	if session.Debug {
-		log.Println("[DEBUG] Status Code: ", resp.StatusCode)
-		log.Println("[DEBUG] Response: ", string(responseBody))
+		session.log(LogDebug, "Response", "status", resp.StatusCode, "body", string(responseBody))
 	}
 	err = findResponseError(resp.StatusCode, responseBody)
 	return responseBody, resp.StatusCode, err
@@ -19,8 +19,18 @@ package session
 import (
 	"testing"
 
+	"context"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"reflect"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -159,6 +169,16 @@ var testcases = []testcase{
 		expected:    uint(256),
 		expectError: false,
 	},
+	{
+		description: "uint return in exponent form",
+		service:     "SoftLayer_Account",
+		method:      "getEvaultCapacityGB",
+		args:        nil,
+		options:     sl.Options{},
+		responder:   httpmock.NewStringResponder(200, `1.073741824E+10`),
+		expected:    uint(10737418240),
+		expectError: false,
+	},
 	{
 		description: "int return",
 		service:     "SoftLayer_Account",
@@ -199,6 +219,56 @@ var testcases = []testcase{
 		expected:    datatypes.Void(0),
 		expectError: false,
 	},
+	{
+		description: "void return, empty body",
+		service:     "SoftLayer_Virtual_Guest",
+		method:      "executeRemoteScript",
+		args:        nil,
+		options:     sl.Options{Id: sl.Int(12345)},
+		responder:   httpmock.NewStringResponder(200, ""),
+		expected:    datatypes.Void(0),
+		expectError: false,
+	},
+	{
+		description: "void return, 204 No Content",
+		service:     "SoftLayer_Account",
+		method:      "disableEuSupport",
+		args:        nil,
+		options:     sl.Options{Id: sl.Int(12345)},
+		responder:   httpmock.NewStringResponder(204, ""),
+		expected:    datatypes.Void(0),
+		expectError: false,
+	},
+	{
+		description: "null array return",
+		service:     "SoftLayer_Account",
+		method:      "getVirtualGuests",
+		args:        nil,
+		options:     sl.Options{},
+		responder:   httpmock.NewStringResponder(200, `null`),
+		expected:    []struct{}(nil),
+		expectError: false,
+	},
+	{
+		description: "null struct return",
+		service:     "SoftLayer_Account",
+		method:      "getCurrentUser",
+		args:        nil,
+		options:     sl.Options{},
+		responder:   httpmock.NewStringResponder(200, `null`),
+		expected:    struct{}{},
+		expectError: false,
+	},
+	{
+		description: "null []byte return",
+		service:     "SoftLayer_Account",
+		method:      "getNextInvoicePdf",
+		args:        nil,
+		options:     sl.Options{},
+		responder:   httpmock.NewStringResponder(200, `null`),
+		expected:    []uint8(nil),
+		expectError: false,
+	},
 	{
 		description: "method arguments",
 		service:     "SoftLayer_Virtual_Guest",
@@ -307,3 +377,484 @@ func setup(tc testcase) {
 func teardown() {
 	httpmock.Reset()
 }
+
+func TestRestPathTemplate(t *testing.T) {
+	sess := &Session{
+		Endpoint:     "https://gateway.example.com/softlayer",
+		PathTemplate: "v3/{{.Service}}/{{.Method}}{{if .Id}}?id={{.Id}}{{end}}",
+	}
+
+	path, err := buildSessionPath(sess, "SoftLayer_Account", "getVirtualGuests", &sl.Options{})
+	if err != nil || path != "v3/SoftLayer_Account/getVirtualGuests" {
+		t.Errorf("Unexpected path %q (error %v)", path, err)
+	}
+
+	sess.PathTemplate = "{{.Service}}{{if .Id}}/{{.Id}}{{end}}{{if .Method}}/{{.Method}}{{end}}.json"
+	for _, tc := range testcases {
+		expected := buildPath(tc.service, tc.method, &tc.options)
+		actual, err := buildSessionPath(sess, tc.service, tc.method, &tc.options)
+		if err != nil || actual != expected {
+			t.Errorf("[%s] Expected path %q, got %q (error %v)", tc.description, expected, actual, err)
+		}
+	}
+
+	sess.PathTemplate = "{{.Nope}}"
+	if _, err := buildSessionPath(sess, "SoftLayer_Account", "getObject", &sl.Options{}); err == nil {
+		t.Errorf("Expected an error for an invalid template")
+	}
+}
+
+func TestRestHeaderFunc(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	sess := &Session{
+		Endpoint: restEndpoint,
+		UserName: "user",
+		APIKey:   "key",
+		Headers:  map[string]string{"X-Static": "static", "X-Gateway-Token": "stale"},
+		HeaderFunc: func() (map[string]string, error) {
+			return map[string]string{"X-Gateway-Token": "fresh"}, nil
+		},
+	}
+
+	httpmock.RegisterResponder("GET", restEndpoint+"/SoftLayer_Account.json",
+		func(req *http.Request) (*http.Response, error) {
+			user, key, _ := req.BasicAuth()
+			if user != "user" || key != "key" {
+				t.Errorf("Expected SoftLayer credentials to be preserved")
+			}
+			if req.Header.Get("X-Static") != "static" || req.Header.Get("X-Gateway-Token") != "fresh" {
+				t.Errorf("Unexpected headers %v", req.Header)
+			}
+			return httpmock.NewStringResponse(200, `{}`), nil
+		})
+
+	var result struct{}
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	sess.HeaderFunc = func() (map[string]string, error) {
+		return nil, errors.New("token unavailable")
+	}
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err == nil {
+		t.Errorf("Expected the HeaderFunc error to be returned")
+	}
+}
+
+type recordingRoundTripper struct {
+	requests int
+}
+
+func (r *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.requests++
+	return &http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestRestCustomHTTPClient(t *testing.T) {
+	client := &http.Client{Timeout: time.Minute}
+	roundTripper := &recordingRoundTripper{}
+
+	sess := (&Session{Endpoint: restEndpoint}).SetHTTPClient(client).SetRoundTripper(roundTripper).SetTimeout(time.Second)
+
+	var result struct{}
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if roundTripper.requests != 1 {
+		t.Errorf("Expected the request to go through the custom round tripper, got %d requests", roundTripper.requests)
+	}
+
+	if client.Timeout != time.Minute || client.Transport != nil {
+		t.Errorf("Expected the user supplied client to be left unchanged, got %+v", client)
+	}
+
+	if sess.HTTPClient.Timeout != time.Minute {
+		t.Errorf("Expected SetRoundTripper to keep the other client settings, got %s", sess.HTTPClient.Timeout)
+	}
+}
+
+func TestRestEncodeDecodeFunc(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	sess := &Session{
+		Endpoint: restEndpoint,
+		EncodeFunc: func(service string, method string, parameters []interface{}) ([]interface{}, error) {
+			template := parameters[0].(map[string]interface{})
+			delete(template, "dedicatedAccountHostOnlyFlag")
+			template["datacenter"] = map[string]interface{}{"name": "dal13"}
+			return parameters, nil
+		},
+		DecodeFunc: func(service string, method string, body []byte) ([]byte, error) {
+			return []byte(strings.Replace(string(body), `"legacyName"`, `"hostname"`, 1)), nil
+		},
+	}
+
+	httpmock.RegisterResponder("POST", restEndpoint+"/SoftLayer_Virtual_Guest.json",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			expected := `{"parameters":[{"datacenter":{"name":"dal13"},"hostname":"web"}]}`
+			if string(body) != expected {
+				t.Errorf("Expected parameters %s, got %s", expected, body)
+			}
+			return httpmock.NewStringResponse(200, `{"legacyName": "web"}`), nil
+		})
+
+	template := datatypes.Virtual_Guest{Hostname: sl.String("web"), DedicatedAccountHostOnlyFlag: sl.Bool(true)}
+
+	var result datatypes.Virtual_Guest
+	err := sess.DoRequest("SoftLayer_Virtual_Guest", "createObject", []interface{}{&template}, &sl.Options{}, &result)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if result.Hostname == nil || *result.Hostname != "web" {
+		t.Errorf("Expected the decoded response to be rewritten, got %v", result.Hostname)
+	}
+
+	sess.EncodeFunc = func(string, string, []interface{}) ([]interface{}, error) {
+		return nil, errors.New("unsupported")
+	}
+	if err = sess.DoRequest("SoftLayer_Virtual_Guest", "createObject", []interface{}{&template}, &sl.Options{}, &result); err == nil {
+		t.Errorf("Expected the EncodeFunc error to be returned")
+	}
+}
+
+type logEntry struct {
+	level  LogLevel
+	msg    string
+	fields []interface{}
+}
+
+type recordingLogger struct {
+	entries []logEntry
+}
+
+func (l *recordingLogger) Log(level LogLevel, msg string, fields ...interface{}) {
+	l.entries = append(l.entries, logEntry{level, msg, fields})
+}
+
+func TestRestStructuredLogger(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", restEndpoint+"/SoftLayer_Account.json",
+		httpmock.NewStringResponder(200, `{"id": 1}`))
+
+	logger := &recordingLogger{}
+	sess := &Session{Endpoint: restEndpoint, Debug: true, StructuredLogger: logger}
+
+	var result struct{}
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(logger.entries) != 2 || logger.entries[0].msg != "Request" || logger.entries[1].msg != "Response" {
+		t.Fatalf("Expected the request and response to be logged, got %+v", logger.entries)
+	}
+
+	response := logger.entries[1]
+	if response.level != LogDebug || !reflect.DeepEqual(response.fields, []interface{}{"status", 200, "body", `{"id": 1}`}) {
+		t.Errorf("Unexpected response entry %+v", response)
+	}
+}
+
+func TestRestAnonymous(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	sess := NewAnonymous(restEndpoint)
+	sess.UserName = "user"
+	sess.APIKey = "key"
+
+	httpmock.RegisterResponder("GET", restEndpoint+"/SoftLayer_Resource_Metadata/getHostname.json",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Authorization") != "" {
+				t.Errorf("Expected no authentication for an anonymous session")
+			}
+			return httpmock.NewStringResponse(200, `"host1"`), nil
+		})
+
+	var hostname string
+	err := sess.DoRequest("SoftLayer_Resource_Metadata", "getHostname", nil, &sl.Options{}, &hostname)
+	if err != nil || hostname != "host1" {
+		t.Errorf("Expected host1, got %q (error %v)", hostname, err)
+	}
+}
+
+func TestRestGlobalID(t *testing.T) {
+	options := &sl.Options{GlobalID: sl.String("0b1c2d3e-aaaa-bbbb-cccc-0123456789ab")}
+
+	path := buildPath("SoftLayer_Virtual_Guest", "getObject", options)
+	if path != "SoftLayer_Virtual_Guest/0b1c2d3e-aaaa-bbbb-cccc-0123456789ab.json" {
+		t.Errorf("Unexpected path %q", path)
+	}
+
+	// An id takes precedence over the global identifier
+	options.Id = sl.Int(1234)
+	path = buildPath("SoftLayer_Virtual_Guest", "getPowerState", options)
+	if path != "SoftLayer_Virtual_Guest/1234/getPowerState.json" {
+		t.Errorf("Unexpected path %q", path)
+	}
+}
+
+func TestRestEncodeQuery(t *testing.T) {
+	options := []sl.Options{
+		{},
+		{Mask: "mask[id,hostname,datacenter[name]]"},
+		{Mask: "id;hostname", Limit: sl.Int(25)},
+		{Filter: `{"hostname":{"operation":"web server 01"}}`},
+		{Filter: `{"notes":{"operation":"~ a+b & c=d; 100%"}}`},
+		{Filter: `{"fullyQualifiedDomainName":{"operation":"*= .example.com"}}`},
+		{Filter: `{"notes":{"operation":"münchen \"dc\" <rack>/#1?"}}`},
+		{Mask: "mask(SoftLayer_Hardware_Server)[id]", Filter: `{"tagReferences":{"tag":{"name":{"operation":"in","options":[{"name":"data","value":["a b","c[d]"]}]}}}}`, Limit: sl.Int(10), Offset: sl.Int(20)},
+	}
+
+	for _, opts := range options {
+		query := encodeQuery(&opts)
+		if strings.ContainsAny(query, " +[]{}\"<>#") {
+			t.Errorf("Expected %q to be fully escaped", query)
+		}
+
+		values, err := url.ParseQuery(query)
+		if err != nil {
+			t.Errorf("Error parsing %q: %s", query, err)
+			continue
+		}
+
+		if values.Get("objectMask") != opts.Mask || values.Get("objectFilter") != opts.Filter {
+			t.Errorf("Expected %+v to round trip, got %v", opts, values)
+		}
+
+		if opts.Limit != nil && values.Get("resultLimit") != fmt.Sprintf("%d,%d", sl.Get(opts.Offset, 0), *opts.Limit) {
+			t.Errorf("Unexpected resultLimit %q", values.Get("resultLimit"))
+		}
+	}
+
+	query := encodeQuery(&sl.Options{Filter: `{"hostname":{"operation":"a b+c"}}`})
+	if !strings.Contains(query, "a%20b%2Bc") {
+		t.Errorf("Expected spaces and plus signs to be escaped distinctly, got %q", query)
+	}
+}
+
+func TestRestBuildPathEscaping(t *testing.T) {
+	paths := []struct {
+		method   string
+		options  sl.Options
+		expected string
+	}{
+		{"getObject", sl.Options{}, "SoftLayer_Virtual_Guest.json"},
+		{"getPowerState", sl.Options{Id: sl.Int(0)}, "SoftLayer_Virtual_Guest/0/getPowerState.json"},
+		{"getPowerState", sl.Options{GlobalID: sl.String("a/b c")}, "SoftLayer_Virtual_Guest/a%2Fb%20c/getPowerState.json"},
+		{"getPowerState", sl.Options{GlobalID: sl.String("?#%")}, "SoftLayer_Virtual_Guest/%3F%23%25/getPowerState.json"},
+		{"getObject", sl.Options{GlobalID: sl.String("münchen")}, "SoftLayer_Virtual_Guest/m%C3%BCnchen.json"},
+	}
+
+	for _, p := range paths {
+		if path := buildPath("SoftLayer_Virtual_Guest", p.method, &p.options); path != p.expected {
+			t.Errorf("Expected path %q, got %q", p.expected, path)
+		}
+	}
+
+	if query := joinQuery("version=2", "objectMask=id"); query != "version=2&objectMask=id" {
+		t.Errorf("Unexpected query %q", query)
+	}
+	if query := joinQuery("", "objectMask=id"); query != "objectMask=id" {
+		t.Errorf("Unexpected query %q", query)
+	}
+	if query := joinQuery("version=2", ""); query != "version=2" {
+		t.Errorf("Unexpected query %q", query)
+	}
+}
+
+func TestRestInitParameters(t *testing.T) {
+	sess := &Session{}
+
+	options := &sl.Options{}
+	options.InitParameters = options.WithInitParameter("username", "jdoe@example.com")
+	path, err := buildSessionPath(sess, "SoftLayer_User_Customer", "getObject", options)
+	if err != nil || path != "SoftLayer_User_Customer/jdoe@example.com.json" {
+		t.Errorf("Unexpected path %q (error %v)", path, err)
+	}
+
+	options.InitParameters = options.WithInitParameter("date", "2020-01-01")
+	if _, err = buildSessionPath(sess, "SoftLayer_User_Customer", "getObject", options); err == nil {
+		t.Errorf("Expected an error for compound init parameters")
+	}
+}
+
+func TestRestTelemetry(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", restEndpoint+"/SoftLayer_Account.json",
+		httpmock.NewStringResponder(200, `{"id": 1}`))
+	httpmock.RegisterResponder("GET", restEndpoint+"/SoftLayer_Virtual_Guest/1.json",
+		httpmock.NewStringResponder(404, `{"error": "Not found", "code": "SoftLayer_Exception_ObjectNotFound"}`))
+
+	sess := &Session{Endpoint: restEndpoint, Telemetry: NewTelemetry()}
+	copied := sess.SetTimeout(time.Second)
+
+	var result struct{}
+	sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	copied.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	copied.DoRequest("SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{Id: sl.Int(1)}, &result)
+
+	summary := sess.Telemetry.Summary()
+	if summary.Calls != 3 || summary.Errors != 1 {
+		t.Errorf("Expected 3 calls and 1 error, got %d and %d", summary.Calls, summary.Errors)
+	}
+
+	if summary.Services["SoftLayer_Account"] != 2 || summary.Services["SoftLayer_Virtual_Guest"] != 1 {
+		t.Errorf("Unexpected per-service counts %v", summary.Services)
+	}
+
+	if summary.Methods[CallKey{"SoftLayer_Account", "getObject", 200}] != 2 ||
+		summary.Methods[CallKey{"SoftLayer_Virtual_Guest", "getObject", 404}] != 1 {
+		t.Errorf("Unexpected per-method counts %v", summary.Methods)
+	}
+
+	latency := summary.Latency["SoftLayer_Account"]
+	if latency.Count != 2 || latency.Counts[len(LatencyBuckets)-1] != 2 {
+		t.Errorf("Unexpected latency histogram %+v", latency)
+	}
+
+	recorder := httptest.NewRecorder()
+	sess.Telemetry.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	for _, line := range []string{
+		`softlayer_api_method_calls_total{service="SoftLayer_Virtual_Guest",method="getObject",code="404"} 1`,
+		`softlayer_api_call_duration_seconds_bucket{service="SoftLayer_Account",le="+Inf"} 2`,
+		`softlayer_api_call_duration_seconds_count{service="SoftLayer_Virtual_Guest"} 1`,
+	} {
+		if !strings.Contains(recorder.Body.String(), line) {
+			t.Errorf("Expected the metrics to contain %s, got:\n%s", line, recorder.Body.String())
+		}
+	}
+
+	if summary.BytesReceived != int64(2*len(`{"id": 1}`)+len(`{"error": "Not found", "code": "SoftLayer_Exception_ObjectNotFound"}`)) {
+		t.Errorf("Unexpected bytes received %d", summary.BytesReceived)
+	}
+
+	if !strings.Contains(summary.String(), "3 API calls") {
+		t.Errorf("Unexpected summary %q", summary.String())
+	}
+
+	sess.Telemetry.Reset()
+	if sess.Telemetry.Summary().Calls != 0 {
+		t.Errorf("Expected the counters to be reset")
+	}
+}
+
+func TestRestReleasesConnections(t *testing.T) {
+	var mu sync.Mutex
+	bodies := []string{}
+	conns := 0
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		mu.Lock()
+		bodies = append(bodies, string(body))
+		mu.Unlock()
+
+		w.WriteHeader(504)
+		w.Write([]byte(`{"error": "Gateway timeout", "code": "SoftLayer_Exception_Timeout"}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			mu.Lock()
+			conns++
+			mu.Unlock()
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	sess := &Session{
+		Endpoint:  server.URL,
+		Retries:   3,
+		RetryWait: time.Millisecond,
+		Telemetry: NewTelemetry(),
+	}
+
+	for i := 0; i < 5; i++ {
+		var result bool
+		err := sess.DoRequest("SoftLayer_Virtual_Guest", "setTags", []interface{}{"a"}, &sl.Options{Id: sl.Int(1)}, &result)
+		if err == nil {
+			t.Fatalf("Expected the call to fail")
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(bodies) != 15 {
+		t.Fatalf("Expected 15 requests, got %d", len(bodies))
+	}
+
+	for _, body := range bodies {
+		if body != `{"parameters":["a"]}` {
+			t.Errorf("Expected retried requests to resend the parameters, got %q", body)
+		}
+	}
+
+	if conns != 1 {
+		t.Errorf("Expected a single connection to be reused by all requests, got %d", conns)
+	}
+
+	summary := sess.Telemetry.Summary()
+	if summary.OpenBodies != 0 || summary.NewConnections+summary.ReusedConnections != 15 {
+		t.Errorf("Expected all bodies closed and 15 connections used, got %+v", summary)
+	}
+}
+
+func TestRestContext(t *testing.T) {
+	requests := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- struct{}{}
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	sess := &Session{Endpoint: server.URL, Retries: 5, RetryWait: time.Millisecond}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-requests
+		cancel()
+	}()
+
+	var result struct{}
+	started := time.Now()
+	err := sess.SetContext(ctx).DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the call to be cancelled, got %v", err)
+	}
+
+	if time.Since(started) > 4*time.Second {
+		t.Errorf("Expected the call to be abandoned when cancelled")
+	}
+
+	if len(requests) != 0 {
+		t.Errorf("Expected the cancelled call not to be retried")
+	}
+
+	// A call with a cancelled context is not sent
+	err = sess.SetContext(ctx).DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	if !errors.Is(err, context.Canceled) || len(requests) != 0 {
+		t.Errorf("Expected the call not to be sent, got %v", err)
+	}
+
+	if sess.Context != nil {
+		t.Errorf("Expected SetContext to leave the original session unchanged")
+	}
+}
@@ -0,0 +1,178 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// DefaultMaxRetryDelay caps the delay between attempts of a RetryPolicy
+const DefaultMaxRetryDelay = 30 * time.Second
+
+// RetryPolicy retries calls failing with transient errors (connection resets,
+// timeouts, throttling and 5xx responses that carry no API exception), waiting
+// exponentially longer between attempts. It applies to both transports.
+//
+// Calls that change resources (e.g., placeOrder or createObject) may have been
+// carried out by the API even though the response was lost, and retrying them
+// could duplicate the resources (or billable orders). By default, only the
+// calls of get methods are retried on any transient error; other calls are
+// retried only when they never reached the API (see IsUnsent). Set Retryable
+// to retry them in more cases.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one
+	MaxAttempts int
+
+	// BaseDelay is the wait before the first retry. It doubles with each
+	// further retry, up to MaxDelay. Defaults to DefaultRetryWait.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the wait between attempts. Defaults to
+	// DefaultMaxRetryDelay.
+	MaxDelay time.Duration
+
+	// Jitter is the fraction (0 to 1) of each wait randomly added to it, so
+	// that clients failing together do not retry together
+	Jitter float64
+
+	// Retryable, when set, decides whether a failed call is retried instead of
+	// IsTransient (for get methods) and IsUnsent (for other methods)
+	Retryable func(service string, method string, err error) bool
+}
+
+func (p *RetryPolicy) attempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) retryable(service string, method string, err error) bool {
+	if p.Retryable != nil {
+		return p.Retryable(service, method, err)
+	}
+
+	if strings.HasPrefix(method, "get") {
+		return IsTransient(err)
+	}
+
+	return IsUnsent(err)
+}
+
+// wait returns the wait after the given (1-based) failed attempt, honoring the
+// Retry-After reported by the API. It returns false if the API asked to wait
+// longer than MaxDelay.
+func (p *RetryPolicy) wait(ctx context.Context, attempt int) (time.Duration, bool) {
+	max := p.MaxDelay
+	if max <= 0 {
+		max = DefaultMaxRetryDelay
+	}
+
+	return retryWait(ctx, p.delay(attempt), max)
+}
+
+// delay returns the wait after the given (1-based) failed attempt
+func (p *RetryPolicy) delay(attempt int) time.Duration {
+	delay := p.BaseDelay
+	if delay <= 0 {
+		delay = DefaultRetryWait
+	}
+
+	max := p.MaxDelay
+	if max <= 0 {
+		max = DefaultMaxRetryDelay
+	}
+
+	for i := 1; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+
+	if p.Jitter > 0 {
+		delay += time.Duration(rand.Float64() * p.Jitter * float64(delay))
+	}
+
+	return delay
+}
+
+// IsUnsent returns true for errors showing that a call never reached the API,
+// so it can safely be retried whatever it does: the endpoint could not be
+// resolved or connected to, or the API throttled the call
+func IsUnsent(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if isUnreachable(err) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	var slErr sl.Error
+	return errors.As(err, &slErr) && slErr.StatusCode == 429
+}
+
+// IsTransient returns true for errors likely to go away when the call is
+// retried: timeouts, connection failures, throttling, and 5xx responses that
+// carry no API exception (e.g., from a proxy or load balancer)
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if isRetryable(err) {
+		return true
+	}
+
+	var slErr sl.Error
+	if errors.As(err, &slErr) && slErr.Exception != "" {
+		// The API processed the call and raised an exception
+		return false
+	}
+
+	if slErr.StatusCode >= 500 {
+		return true
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
@@ -0,0 +1,154 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := &RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, delay := range expected {
+		if actual := policy.delay(i + 1); actual != delay {
+			t.Errorf("Attempt %d: expected a delay of %s, got %s", i+1, delay, actual)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for i := 0; i < 20; i++ {
+		if actual := policy.delay(2); actual < 2*time.Second || actual > 3*time.Second {
+			t.Errorf("Expected a jittered delay between 2s and 3s, got %s", actual)
+		}
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		err       error
+		transient bool
+	}{
+		{sl.Error{StatusCode: 503}, true},
+		{sl.Error{StatusCode: 500}, true},
+		{sl.Error{StatusCode: 429}, true},
+		{sl.Error{StatusCode: 520, Wrapped: syscall.ECONNRESET}, true},
+		{fmt.Errorf("read: %w", syscall.ECONNRESET), true},
+		{io.ErrUnexpectedEOF, true},
+		{sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_ObjectNotFound"}, false},
+		{sl.Error{StatusCode: 404, Exception: "SoftLayer_Exception_NotFound"}, false},
+		{errors.New("invalid argument"), false},
+	}
+
+	for _, tc := range tests {
+		if actual := IsTransient(tc.err); actual != tc.transient {
+			t.Errorf("Error %#v: expected transient %t, got %t", tc.err, tc.transient, actual)
+		}
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	sess := &Session{Endpoint: server.URL, Telemetry: &Telemetry{}}
+	sess = sess.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond})
+
+	var result struct {
+		Id int `json:"id"`
+	}
+	err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	if err != nil || result.Id != 1 {
+		t.Fatalf("Expected the call to succeed after retries, got %v", err)
+	}
+
+	if requests != 3 || sess.Telemetry.Summary().Retries != 2 {
+		t.Errorf("Expected 3 requests and 2 retries, got %d and %+v", requests, sess.Telemetry.Summary())
+	}
+
+	// Attempts are not exceeded
+	atomic.StoreInt32(&requests, -10)
+	err = sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	if err == nil || requests != -7 {
+		t.Errorf("Expected the call to fail after 3 attempts, got %v after %d", err, requests+10)
+	}
+}
+
+func TestRetryPolicySkipsApiErrors(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"error": "Unable to find object", "code": "SoftLayer_Exception_ObjectNotFound"}`)
+	}))
+	defer server.Close()
+
+	sess := (&Session{Endpoint: server.URL}).SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond})
+
+	var result struct{}
+	err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	if err == nil || requests != 1 {
+		t.Errorf("Expected an API error not to be retried, got %v after %d requests", err, requests)
+	}
+}
+
+func TestRetryPolicySkipsUnsafeMethods(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1)%2 == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		// The connection is closed without a response
+		conn, _, _ := w.(http.Hijacker).Hijack()
+		conn.Close()
+	}))
+	defer server.Close()
+
+	sess := (&Session{Endpoint: server.URL}).SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond})
+
+	var result struct{}
+	for _, failure := range []string{"a 500", "EOF"} {
+		before := atomic.LoadInt32(&requests)
+		err := sess.DoRequest("SoftLayer_Product_Order", "placeOrder", []interface{}{nil}, &sl.Options{}, &result)
+		if sent := atomic.LoadInt32(&requests) - before; err == nil || sent != 1 {
+			t.Errorf("Expected placeOrder not to be retried after %s, got %v after %d requests", failure, err, sent)
+		}
+	}
+
+	if IsUnsent(sl.Error{StatusCode: 500}) || IsUnsent(io.EOF) || !IsUnsent(sl.Error{StatusCode: 429}) {
+		t.Errorf("Expected only errors of calls that did not reach the API to be unsent")
+	}
+}
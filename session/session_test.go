@@ -1,8 +1,14 @@
 package session
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/softlayer/softlayer-go/sl"
 )
 
 func TestSession_WithDefaultUserAgent(t *testing.T) {
@@ -35,3 +41,140 @@ func TestResetUserAgent(t *testing.T) {
 		t.Errorf("UserAgent expected to reset to %s, but found to be %s", getDefaultUserAgent(), s.userAgent)
 	}
 }
+
+func TestSetUserAgent(t *testing.T) {
+	s := &Session{}
+	copied := s.SetUserAgent("my-tool/1.0").SetUserAgent("my-tool/2.0")
+	if s.UserAgent() != getDefaultUserAgent() || copied.UserAgent() != getDefaultUserAgent()+" my-tool/2.0" {
+		t.Errorf("Expected the copy only to identify the application, got %q and %q", s.UserAgent(), copied.UserAgent())
+	}
+
+	// XML-RPC requests carry the user agent in their HTTP headers too
+	var agent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		agent = r.Header.Get("User-Agent")
+	}))
+	defer server.Close()
+
+	request, _ := http.NewRequest("POST", server.URL, nil)
+	response, err := headerRoundTripper{sess: copied}.RoundTrip(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	if agent != copied.UserAgent() {
+		t.Errorf("Expected User-Agent %q, got %q", copied.UserAgent(), agent)
+	}
+}
+
+func TestNewAnonymous(t *testing.T) {
+	s := NewAnonymous()
+	if !s.Anonymous || s.Endpoint != DefaultEndpoint || s.UserName != "" || s.APIKey != "" {
+		t.Errorf("Expected an anonymous session for the default endpoint, got %#v", s)
+	}
+
+	s = NewAnonymous("https://api.service.softlayer.com/rest/v3")
+	if s.Endpoint != "https://api.service.softlayer.com/rest/v3" {
+		t.Errorf("Expected endpoint override, got %s", s.Endpoint)
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	sess := (&Session{Endpoint: server.URL}).SetTimeout(5 * time.Second)
+
+	var result struct{}
+	err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{Timeout: 20 * time.Millisecond}, &result)
+	if err == nil {
+		t.Errorf("Expected the request timeout to override the session timeout")
+	}
+
+	if sess.Timeout != 5*time.Second {
+		t.Errorf("Expected the session timeout to be unchanged, got %s", sess.Timeout)
+	}
+
+	if err = sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+		t.Errorf("Expected the session timeout to apply without a request timeout, got %v", err)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next TransportHandler) TransportHandler {
+			return TransportHandlerFunc(func(sess *Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+				calls = append(calls, name)
+				return next.DoRequest(sess, service, method, args, options, pResult)
+			})
+		}
+	}
+
+	failures := 1
+	faults := func(next TransportHandler) TransportHandler {
+		return TransportHandlerFunc(func(sess *Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+			if failures > 0 {
+				failures--
+				return sl.Error{StatusCode: 503}
+			}
+			return next.DoRequest(sess, service, method, args, options, pResult)
+		})
+	}
+
+	transport := TransportHandlerFunc(func(sess *Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+		calls = append(calls, "transport")
+		return nil
+	})
+
+	base := &Session{TransportHandler: transport}
+	sess := base.AddMiddleware(trace("outer")).AddMiddleware(trace("inner"), faults).
+		SetRetryPolicy(&RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond})
+
+	var result struct{}
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+		t.Fatalf("Expected the injected fault to be retried, got %v", err)
+	}
+
+	expected := "outer,inner,outer,inner,transport"
+	if actual := strings.Join(calls, ","); actual != expected {
+		t.Errorf("Expected calls %s, got %s", expected, actual)
+	}
+
+	if len(base.Middleware) != 0 {
+		t.Errorf("Expected AddMiddleware not to change the original session")
+	}
+}
+
+func TestDefaultLimit(t *testing.T) {
+	s := &Session{DefaultLimit: 50, ServiceLimits: map[string]int{"SoftLayer_Account": 0}}
+
+	var list []struct{}
+	var object struct{}
+
+	options := s.defaultLimit("SoftLayer_Virtual_Guest", &sl.Options{}, &list)
+	if options.Limit == nil || *options.Limit != 50 {
+		t.Errorf("Expected the default limit to apply to list results, got %v", options.Limit)
+	}
+
+	if options = s.defaultLimit("SoftLayer_Virtual_Guest", &sl.Options{}, &object); options.Limit != nil {
+		t.Errorf("Expected no limit for a single object result, got %d", *options.Limit)
+	}
+
+	if options = s.defaultLimit("SoftLayer_Account", &sl.Options{}, &list); options.Limit != nil {
+		t.Errorf("Expected the service override to disable the limit, got %d", *options.Limit)
+	}
+
+	if options = s.defaultLimit("SoftLayer_Virtual_Guest", &sl.Options{Unlimited: true}, &list); options.Limit != nil {
+		t.Errorf("Expected Unlimited to disable the limit, got %d", *options.Limit)
+	}
+
+	options = s.defaultLimit("SoftLayer_Virtual_Guest", &sl.Options{Limit: sl.Int(5)}, &list)
+	if *options.Limit != 5 {
+		t.Errorf("Expected an explicit limit to be kept, got %d", *options.Limit)
+	}
+}
@@ -0,0 +1,235 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// DefaultShrinkLimit is the first page size tried by a ShrinkPolicy for calls
+// that set no result limit
+const DefaultShrinkLimit = 100
+
+// tooLargeExceptions are the exceptions the API raises for responses that are
+// too large
+var tooLargeExceptions = []string{
+	"SoftLayer_Exception_WebService_ResultLimitExceeded",
+}
+
+// tooLargePhrases are found in the messages of the API errors, raised as
+// generic exceptions, for responses that are too large
+var tooLargePhrases = []string{
+	"result limit exceeded",
+	"reduce your mask",
+	"reduce your object mask",
+	"reduce the object mask",
+	"allowed memory size of",
+}
+
+// ShrinkPolicy retries calls returning a list that fail because the response
+// would be too large (e.g., "result limit exceeded, reduce your mask"),
+// fetching the requested results in smaller pages instead. The pages are
+// concatenated, so that the caller receives the results it asked for (all of
+// them, or those in its Limit and Offset) as if the call had succeeded.
+type ShrinkPolicy struct {
+	// InitialLimit is the page size tried first for calls that set no result
+	// limit. Defaults to DefaultShrinkLimit. Calls that set one are retried
+	// with half of it.
+	InitialLimit int
+
+	// MinLimit is the smallest page size tried. The page size is halved, down
+	// to MinLimit, as long as pages are too large. Defaults to 1.
+	MinLimit int
+
+	// ReduceMask, if set, returns a smaller object mask (e.g., without some
+	// relational properties) to retry with when pages of MinLimit results are
+	// still too large
+	ReduceMask func(service string, method string, mask string) string
+
+	// OnShrink, if set, is called with each adjustment made to a call, in
+	// addition to the warning logged by the session
+	OnShrink func(ShrinkAdjustment)
+}
+
+// ShrinkAdjustment describes an adjustment made by a ShrinkPolicy to a call
+// whose response was too large
+type ShrinkAdjustment struct {
+	Service string
+	Method  string
+
+	// Limit is the page size the call is retried with
+	Limit int
+
+	// Mask is the object mask the call is retried with, if MaskReduced
+	Mask        string
+	MaskReduced bool
+
+	// Err is the error that caused the adjustment
+	Err error
+}
+
+// IsTooLarge returns true for API errors reporting that the response to a call
+// would be too large, which can be avoided with a smaller result limit or mask
+func IsTooLarge(err error) bool {
+	var slErr sl.Error
+	if !errors.As(err, &slErr) {
+		return false
+	}
+
+	for _, exception := range tooLargeExceptions {
+		if slErr.Exception == exception {
+			return true
+		}
+	}
+
+	text := strings.ToLower(slErr.Message)
+	for _, phrase := range tooLargePhrases {
+		if strings.Contains(text, phrase) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// shrink fetches, through send, the results of a call that failed with err in
+// smaller pages if err reports that the response was too large. It returns
+// err, or the last error, if the results could not be fetched.
+func (p *ShrinkPolicy) shrink(
+	sess *Session,
+	service string,
+	method string,
+	options *sl.Options,
+	pResult interface{},
+	err error,
+	send func(options *sl.Options, pResult interface{}) error,
+) error {
+	if p == nil || !IsTooLarge(err) {
+		return err
+	}
+
+	result := reflect.ValueOf(pResult)
+	if result.Kind() != reflect.Ptr || result.Elem().Kind() != reflect.Slice ||
+		result.Elem().Type().Elem().Kind() == reflect.Uint8 {
+		return err
+	}
+
+	var paged sl.Options
+	if options != nil {
+		paged = *options
+	}
+
+	// total is the number of results requested, or -1 for all of them
+	total, offset := -1, 0
+	if paged.Limit != nil {
+		total = *paged.Limit
+	}
+	if paged.Offset != nil {
+		offset = *paged.Offset
+	}
+
+	if total == 0 {
+		return err
+	}
+
+	min := p.MinLimit
+	if min < 1 {
+		min = 1
+	}
+
+	reduced := false
+	size := total
+	adjust := func(cause error) bool {
+		switch {
+		case size < 0:
+			size = p.InitialLimit
+			if size <= 0 {
+				size = DefaultShrinkLimit
+			}
+		case size > min:
+			size /= 2
+			if size < min {
+				size = min
+			}
+		case p.ReduceMask != nil && !reduced:
+			paged.Mask = p.ReduceMask(service, method, paged.Mask)
+			reduced = true
+		default:
+			return false
+		}
+
+		p.report(sess, ShrinkAdjustment{
+			Service:     service,
+			Method:      method,
+			Limit:       size,
+			Mask:        paged.Mask,
+			MaskReduced: reduced,
+			Err:         cause,
+		})
+		return true
+	}
+
+	if !adjust(err) {
+		return err
+	}
+
+	results := reflect.MakeSlice(result.Elem().Type(), 0, 0)
+	for total < 0 || results.Len() < total {
+		limit := size
+		if total >= 0 && total-results.Len() < limit {
+			limit = total - results.Len()
+		}
+
+		pageOffset := offset + results.Len()
+		paged.Limit = &limit
+		paged.Offset = &pageOffset
+
+		page := reflect.New(result.Elem().Type())
+		if err = send(&paged, page.Interface()); err != nil {
+			if IsTooLarge(err) && adjust(err) {
+				continue
+			}
+
+			return err
+		}
+
+		results = reflect.AppendSlice(results, page.Elem())
+		if page.Elem().Len() < limit {
+			break
+		}
+	}
+
+	result.Elem().Set(results)
+	return nil
+}
+
+// report logs an adjustment, and passes it to OnShrink
+func (p *ShrinkPolicy) report(sess *Session, adjustment ShrinkAdjustment) {
+	fields := []interface{}{"service", adjustment.Service, "method", adjustment.Method, "limit", adjustment.Limit}
+	if adjustment.MaskReduced {
+		fields = append(fields, "mask", adjustment.Mask)
+	}
+	sess.log(LogWarn, "Response too large, retrying in smaller pages", fields...)
+
+	if p.OnShrink != nil {
+		p.OnShrink(adjustment)
+	}
+}
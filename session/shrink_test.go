@@ -0,0 +1,152 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestIsTooLarge(t *testing.T) {
+	tests := []struct {
+		err      error
+		tooLarge bool
+	}{
+		{sl.Error{Exception: "SoftLayer_Exception_WebService_ResultLimitExceeded"}, true},
+		{sl.Error{Exception: "SoftLayer_Exception", Message: "Result limit exceeded. Please reduce your mask."}, true},
+		{sl.Error{StatusCode: 500, Message: "Allowed memory size of 2147483648 bytes exhausted"}, true},
+		{sl.Error{Exception: "SoftLayer_Exception_ObjectNotFound", Message: "Unable to find object"}, false},
+		{sl.Error{Exception: "SoftLayer_Exception_Public", Message: "The uploaded file is too large."}, false},
+		{sl.Error{Exception: "SoftLayer_Exception_Public", Message: "Disk size is too big for this package."}, false},
+		{sl.Error{Exception: "SoftLayer_Exception_Public", Message: "Invalid resultLimit: offset must not be negative."}, false},
+		{sl.Error{StatusCode: 429, Exception: "SoftLayer_Exception_WebService_RateLimitExceeded", Message: "Rate limit exceeded"}, false},
+		{fmt.Errorf("response too large"), false},
+	}
+
+	for _, tc := range tests {
+		if actual := IsTooLarge(tc.err); actual != tc.tooLarge {
+			t.Errorf("Error %#v: expected too large %t, got %t", tc.err, tc.tooLarge, actual)
+		}
+	}
+}
+
+// newPagingServer serves the ids 1 to count, failing pages of more than max
+// results, or with a mask other than "id" if slimMask is set
+func newPagingServer(count int, max int, slimMask bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, limit := 0, count
+		if resultLimit := r.URL.Query().Get("resultLimit"); resultLimit != "" {
+			fmt.Sscanf(resultLimit, "%d,%d", &offset, &limit)
+		}
+
+		if limit > max || (slimMask && r.URL.Query().Get("objectMask") != "mask[id]") {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"error": "Result limit exceeded. Please reduce your mask.", "code": "SoftLayer_Exception"}`)
+			return
+		}
+
+		ids := []string{}
+		for id := offset + 1; id <= offset+limit && id <= count; id++ {
+			ids = append(ids, fmt.Sprintf(`{"id": %d}`, id))
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(ids, ","))
+	}))
+}
+
+func TestShrinkPolicy(t *testing.T) {
+	server := newPagingServer(25, 10, false)
+	defer server.Close()
+
+	var adjustments []ShrinkAdjustment
+	sess := &Session{Endpoint: server.URL, StructuredLogger: &recordingLogger{}}
+
+	// Without a policy, the error is returned
+	var guests []datatypes.Virtual_Guest
+	err := sess.DoRequest("SoftLayer_Account", "getVirtualGuests", nil, &sl.Options{}, &guests)
+	if !IsTooLarge(err) {
+		t.Fatalf("Expected the response to be too large, got %v", err)
+	}
+
+	sess = sess.SetShrinkPolicy(&ShrinkPolicy{
+		InitialLimit: 16,
+		OnShrink:     func(a ShrinkAdjustment) { adjustments = append(adjustments, a) },
+	})
+
+	// All the results are fetched in pages of 8
+	err = sess.DoRequest("SoftLayer_Account", "getVirtualGuests", nil, &sl.Options{}, &guests)
+	if err != nil || len(guests) != 25 || *guests[0].Id != 1 || *guests[24].Id != 25 {
+		t.Fatalf("Expected the 25 guests, got %d (%v)", len(guests), err)
+	}
+
+	if len(adjustments) != 2 || adjustments[0].Limit != 16 || adjustments[1].Limit != 8 {
+		t.Errorf("Expected adjustments to 16 then 8 results, got %+v", adjustments)
+	}
+
+	logger := sess.StructuredLogger.(*recordingLogger)
+	if len(logger.entries) != 2 || logger.entries[0].level != LogWarn {
+		t.Errorf("Expected the adjustments to be logged, got %+v", logger.entries)
+	}
+
+	// The window requested by the caller is kept
+	limit, offset := 12, 5
+	adjustments = nil
+	guests = nil
+	err = sess.DoRequest("SoftLayer_Account", "getVirtualGuests", nil, &sl.Options{Limit: &limit, Offset: &offset}, &guests)
+	if err != nil || len(guests) != 12 || *guests[0].Id != 6 || *guests[11].Id != 17 {
+		t.Fatalf("Expected guests 6 to 17, got %d (%v)", len(guests), err)
+	}
+
+	if len(adjustments) != 1 || adjustments[0].Limit != 6 {
+		t.Errorf("Expected an adjustment to 6 results, got %+v", adjustments)
+	}
+}
+
+func TestShrinkPolicyReduceMask(t *testing.T) {
+	server := newPagingServer(3, 2, true)
+	defer server.Close()
+
+	var adjustments []ShrinkAdjustment
+	sess := (&Session{Endpoint: server.URL, StructuredLogger: &recordingLogger{}}).SetShrinkPolicy(&ShrinkPolicy{
+		MinLimit:   2,
+		ReduceMask: func(service string, method string, mask string) string { return "mask[id]" },
+		OnShrink:   func(a ShrinkAdjustment) { adjustments = append(adjustments, a) },
+	})
+
+	var guests []datatypes.Virtual_Guest
+	err := sess.DoRequest("SoftLayer_Account", "getVirtualGuests", nil, &sl.Options{Mask: "mask[id,tagReferences]"}, &guests)
+	if err != nil || len(guests) != 3 {
+		t.Fatalf("Expected the 3 guests, got %d (%v)", len(guests), err)
+	}
+
+	last := adjustments[len(adjustments)-1]
+	if !last.MaskReduced || last.Mask != "mask[id]" || last.Limit != 2 {
+		t.Errorf("Expected the mask to be reduced, got %+v", adjustments)
+	}
+
+	// The error is returned once no adjustment is left
+	sess.ShrinkPolicy.ReduceMask = nil
+	err = sess.DoRequest("SoftLayer_Account", "getVirtualGuests", nil, &sl.Options{Mask: "mask[id,tagReferences]"}, &guests)
+	if !IsTooLarge(err) {
+		t.Errorf("Expected the response to be too large, got %v", err)
+	}
+}
@@ -0,0 +1,531 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Telemetry counts the API usage of the sessions it is set on. Session
+// copies (e.g., made with SetTimeout) share the Telemetry of the original
+// session, so a batch job can log a summary of all of its calls at completion.
+type Telemetry struct {
+	mu            sync.Mutex
+	since         time.Time
+	calls         int64
+	errors        int64
+	retries       int64
+	cacheHits     int64
+	bytesSent     int64
+	bytesReceived int64
+	newConns      int64
+	reusedConns   int64
+	openBodies    int64
+	rateLimit     *RateLimit
+	services      map[string]int64
+	methods       map[CallKey]int64
+	latency       map[string]*LatencyHistogram
+}
+
+// LatencyBuckets are the upper bounds, in seconds, of the buckets of the call
+// latency histograms
+var LatencyBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120}
+
+// CallKey identifies the calls to a method which got a given HTTP status. The
+// status is zero for calls which got no response (e.g., a connection error).
+type CallKey struct {
+	Service    string
+	Method     string
+	StatusCode int
+}
+
+// LatencyHistogram counts calls by duration, retries included
+type LatencyHistogram struct {
+	// Counts holds the number of calls which took at most the matching
+	// LatencyBuckets bound (cumulatively, as in Prometheus histograms)
+	Counts []int64
+
+	// Count and Sum are the number of calls and their total duration in
+	// seconds
+	Count int64
+	Sum   float64
+}
+
+func (h *LatencyHistogram) observe(elapsed time.Duration) {
+	if h.Counts == nil {
+		h.Counts = make([]int64, len(LatencyBuckets))
+	}
+
+	seconds := elapsed.Seconds()
+	for i, bound := range LatencyBuckets {
+		if seconds <= bound {
+			h.Counts[i]++
+		}
+	}
+
+	h.Count++
+	h.Sum += seconds
+}
+
+// TelemetrySummary is a snapshot of the counters of a Telemetry
+type TelemetrySummary struct {
+	// Since is when counting started (or was last reset)
+	Since time.Time
+
+	// Calls is the number of API calls made, including failed ones. Retries
+	// of a call are not counted as calls.
+	Calls int64
+
+	// Errors is the number of calls that returned an error
+	Errors int64
+
+	// Retries is the number of times calls were retried
+	Retries int64
+
+	// CacheHits is the number of calls answered from a cache, as reported
+	// with RecordCacheHit by caching transport handlers
+	CacheHits int64
+
+	// BytesSent and BytesReceived are the sizes of the request and response
+	// bodies exchanged with the API
+	BytesSent     int64
+	BytesReceived int64
+
+	// NewConnections and ReusedConnections are the number of requests sent
+	// over a newly established, or an idle pooled, connection
+	NewConnections    int64
+	ReusedConnections int64
+
+	// OpenBodies is the number of response bodies not yet closed. It should
+	// be zero between calls; a growing value indicates leaked connections.
+	OpenBodies int64
+
+	// RateLimit is the request budget last reported by the API, or nil
+	RateLimit *RateLimit
+
+	// Services is the number of calls made to each service
+	Services map[string]int64
+
+	// Methods is the number of calls made to each method, by HTTP status
+	Methods map[CallKey]int64
+
+	// Latency holds the histogram of call durations of each service
+	Latency map[string]LatencyHistogram
+}
+
+// NewTelemetry returns a Telemetry with all counters at zero
+func NewTelemetry() *Telemetry {
+	return &Telemetry{
+		since:    time.Now(),
+		services: map[string]int64{},
+		methods:  map[CallKey]int64{},
+		latency:  map[string]*LatencyHistogram{},
+	}
+}
+
+// Summary returns the current value of the counters. It is safe to call on a
+// nil Telemetry.
+func (t *Telemetry) Summary() TelemetrySummary {
+	if t == nil {
+		return TelemetrySummary{
+			Services: map[string]int64{},
+			Methods:  map[CallKey]int64{},
+			Latency:  map[string]LatencyHistogram{},
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	services := make(map[string]int64, len(t.services))
+	for service, count := range t.services {
+		services[service] = count
+	}
+
+	methods := make(map[CallKey]int64, len(t.methods))
+	for key, count := range t.methods {
+		methods[key] = count
+	}
+
+	latency := make(map[string]LatencyHistogram, len(t.latency))
+	for service, histogram := range t.latency {
+		copied := *histogram
+		copied.Counts = append([]int64{}, histogram.Counts...)
+		latency[service] = copied
+	}
+
+	var rateLimit *RateLimit
+	if t.rateLimit != nil {
+		copied := *t.rateLimit
+		rateLimit = &copied
+	}
+
+	return TelemetrySummary{
+		Since:             t.since,
+		Calls:             t.calls,
+		Errors:            t.errors,
+		Retries:           t.retries,
+		CacheHits:         t.cacheHits,
+		BytesSent:         t.bytesSent,
+		BytesReceived:     t.bytesReceived,
+		NewConnections:    t.newConns,
+		ReusedConnections: t.reusedConns,
+		OpenBodies:        t.openBodies,
+		RateLimit:         rateLimit,
+		Services:          services,
+		Methods:           methods,
+		Latency:           latency,
+	}
+}
+
+// Reset sets all counters back to zero
+func (t *Telemetry) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.since = time.Now()
+	t.calls, t.errors, t.retries, t.cacheHits = 0, 0, 0, 0
+	t.bytesSent, t.bytesReceived = 0, 0
+	t.newConns, t.reusedConns = 0, 0
+	t.services = map[string]int64{}
+	t.methods = map[CallKey]int64{}
+	t.latency = map[string]*LatencyHistogram{}
+}
+
+// RecordCacheHit counts a call answered from a cache. It is meant to be
+// called by transport handlers that cache API responses.
+func (t *Telemetry) RecordCacheHit() {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	t.cacheHits++
+	t.mu.Unlock()
+}
+
+func (t *Telemetry) recordCall(service string, method string, status int, elapsed time.Duration, err error) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.services == nil {
+		t.services = map[string]int64{}
+		t.methods = map[CallKey]int64{}
+		t.latency = map[string]*LatencyHistogram{}
+	}
+
+	t.calls++
+	t.services[service]++
+	t.methods[CallKey{Service: service, Method: method, StatusCode: status}]++
+	if err != nil {
+		t.errors++
+	}
+
+	histogram, ok := t.latency[service]
+	if !ok {
+		histogram = &LatencyHistogram{}
+		t.latency[service] = histogram
+	}
+	histogram.observe(elapsed)
+}
+
+func (t *Telemetry) recordRetry() {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	t.retries++
+	t.mu.Unlock()
+}
+
+func (t *Telemetry) recordBytes(sent int64, received int64) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	t.bytesSent += sent
+	t.bytesReceived += received
+	t.mu.Unlock()
+}
+
+// RateLimit returns the request budget last reported by the API. It is safe to
+// call on a nil Telemetry.
+func (t *Telemetry) RateLimit() (RateLimit, bool) {
+	if t == nil {
+		return RateLimit{}, false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.rateLimit == nil {
+		return RateLimit{}, false
+	}
+
+	return *t.rateLimit, true
+}
+
+func (t *Telemetry) recordRateLimit(header http.Header) {
+	if t == nil {
+		return
+	}
+
+	limit, ok := parseRateLimit(header, time.Now())
+	if !ok {
+		return
+	}
+
+	t.mu.Lock()
+	t.rateLimit = &limit
+	t.mu.Unlock()
+}
+
+// clientTrace returns an httptrace.ClientTrace counting the connections used
+// by requests
+func (t *Telemetry) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			if info.Reused {
+				t.reusedConns++
+			} else {
+				t.newConns++
+			}
+			t.mu.Unlock()
+		},
+	}
+}
+
+// trackBody counts body as open until it is closed, and counts the bytes read
+// from it
+func (t *Telemetry) trackBody(body io.ReadCloser) io.ReadCloser {
+	if t == nil || body == nil {
+		return body
+	}
+
+	t.mu.Lock()
+	t.openBodies++
+	t.mu.Unlock()
+
+	return &countingReader{ReadCloser: body, telemetry: t}
+}
+
+// String formats the summary for logging, with services by descending call
+// count
+func (s TelemetrySummary) String() string {
+	summary := fmt.Sprintf(
+		"%d API calls in %s (%d errors, %d retries, %d cache hits), %d bytes sent, %d bytes received, "+
+			"%d connections (%d reused)",
+		s.Calls, time.Since(s.Since).Round(time.Second), s.Errors, s.Retries, s.CacheHits,
+		s.BytesSent, s.BytesReceived, s.NewConnections+s.ReusedConnections, s.ReusedConnections)
+
+	if len(s.Services) == 0 {
+		return summary
+	}
+
+	services := make([]string, 0, len(s.Services))
+	for service := range s.Services {
+		services = append(services, service)
+	}
+	sort.Slice(services, func(i, j int) bool {
+		if s.Services[services[i]] != s.Services[services[j]] {
+			return s.Services[services[i]] > s.Services[services[j]]
+		}
+		return services[i] < services[j]
+	})
+
+	counts := make([]string, len(services))
+	for i, service := range services {
+		counts[i] = fmt.Sprintf("%s: %d", service, s.Services[service])
+	}
+
+	return summary + "; " + strings.Join(counts, ", ")
+}
+
+// telemetryRoundTripper counts the bytes exchanged over HTTP, for transports
+// which do not expose the request and response bodies
+type telemetryRoundTripper struct {
+	telemetry *Telemetry
+	base      http.RoundTripper
+}
+
+func (t telemetryRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.ContentLength > 0 {
+		t.telemetry.recordBytes(request.ContentLength, 0)
+	}
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	request = request.WithContext(httptrace.WithClientTrace(request.Context(), t.telemetry.clientTrace()))
+	resp, err := base.RoundTrip(request)
+	if err == nil {
+		t.telemetry.recordRateLimit(resp.Header)
+		resp.Body = t.telemetry.trackBody(resp.Body)
+	}
+
+	return resp, err
+}
+
+type countingReader struct {
+	io.ReadCloser
+	telemetry *Telemetry
+	closed    sync.Once
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.telemetry.recordBytes(0, int64(n))
+	return n, err
+}
+
+func (c *countingReader) Close() error {
+	c.closed.Do(func() {
+		c.telemetry.mu.Lock()
+		c.telemetry.openBodies--
+		c.telemetry.mu.Unlock()
+	})
+
+	return c.ReadCloser.Close()
+}
+
+// WritePrometheus writes the summary in the Prometheus text exposition format,
+// for processes that expose their metrics to a Prometheus server
+func (s TelemetrySummary) WritePrometheus(w io.Writer) error {
+	lines := []string{}
+	metric := func(name string, kind string, help string, values ...string) {
+		lines = append(lines,
+			fmt.Sprintf("# HELP softlayer_api_%s %s", name, help),
+			fmt.Sprintf("# TYPE softlayer_api_%s %s", name, kind))
+		for _, value := range values {
+			lines = append(lines, "softlayer_api_"+name+value)
+		}
+	}
+	value := func(v interface{}) string {
+		return fmt.Sprintf(" %v", v)
+	}
+
+	metric("calls_total", "counter", "API calls made.", value(s.Calls))
+	metric("errors_total", "counter", "API calls that returned an error.", value(s.Errors))
+	metric("retries_total", "counter", "API call retries.", value(s.Retries))
+	metric("cache_hits_total", "counter", "API calls answered from a cache.", value(s.CacheHits))
+	metric("sent_bytes_total", "counter", "Bytes of request bodies sent.", value(s.BytesSent))
+	metric("received_bytes_total", "counter", "Bytes of response bodies received.", value(s.BytesReceived))
+	metric("connections_total", "counter", "Requests by connection use.",
+		`{reused="false"}`+value(s.NewConnections), `{reused="true"}`+value(s.ReusedConnections))
+	metric("open_bodies", "gauge", "Response bodies not yet closed.", value(s.OpenBodies))
+
+	services := make([]string, 0, len(s.Services))
+	for service := range s.Services {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	calls := make([]string, len(services))
+	for i, service := range services {
+		calls[i] = fmt.Sprintf(`{service=%q}`, service) + value(s.Services[service])
+	}
+	metric("service_calls_total", "counter", "API calls made, by service.", calls...)
+
+	keys := make([]CallKey, 0, len(s.Methods))
+	for key := range s.Methods {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Service != keys[j].Service {
+			return keys[i].Service < keys[j].Service
+		}
+		if keys[i].Method != keys[j].Method {
+			return keys[i].Method < keys[j].Method
+		}
+		return keys[i].StatusCode < keys[j].StatusCode
+	})
+
+	methodCalls := make([]string, len(keys))
+	for i, key := range keys {
+		code := ""
+		if key.StatusCode != 0 {
+			code = strconv.Itoa(key.StatusCode)
+		}
+		methodCalls[i] = fmt.Sprintf(`{service=%q,method=%q,code=%q}`, key.Service, key.Method, code) +
+			value(s.Methods[key])
+	}
+	metric("method_calls_total", "counter", "API calls made, by method and HTTP status.", methodCalls...)
+
+	durations := []string{}
+	for _, service := range services {
+		histogram, ok := s.Latency[service]
+		if !ok {
+			continue
+		}
+
+		for i, bound := range LatencyBuckets {
+			durations = append(durations, fmt.Sprintf(`_bucket{service=%q,le="%g"}`, service, bound)+
+				value(histogram.Counts[i]))
+		}
+		durations = append(durations,
+			fmt.Sprintf(`_bucket{service=%q,le="+Inf"}`, service)+value(histogram.Count),
+			fmt.Sprintf(`_sum{service=%q}`, service)+value(histogram.Sum),
+			fmt.Sprintf(`_count{service=%q}`, service)+value(histogram.Count))
+	}
+	metric("call_duration_seconds", "histogram", "Duration of API calls, retries included, by service.",
+		durations...)
+
+	if s.RateLimit != nil {
+		if s.RateLimit.Limit >= 0 {
+			metric("ratelimit_limit", "gauge", "Requests allowed in the current rate limit window.",
+				value(s.RateLimit.Limit))
+		}
+		if s.RateLimit.Remaining >= 0 {
+			metric("ratelimit_remaining", "gauge", "Requests left in the current rate limit window.",
+				value(s.RateLimit.Remaining))
+		}
+		if !s.RateLimit.Reset.IsZero() {
+			metric("ratelimit_reset_timestamp_seconds", "gauge", "When the current rate limit window ends.",
+				value(s.RateLimit.Reset.Unix()))
+		}
+	}
+
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+// ServeHTTP writes the current value of the counters in the Prometheus text
+// exposition format, so that the Telemetry can be mounted as a scrape endpoint
+// (e.g., http.Handle("/metrics", sess.Telemetry))
+func (t *Telemetry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	if err := t.Summary().WritePrometheus(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,82 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+)
+
+// TLSOptions describes the TLS configuration built by NewTLSClientConfig
+type TLSOptions struct {
+	// CABundle is the path of a PEM file of CA certificates trusted in
+	// addition to those of the system, e.g. the CA of a corporate gateway
+	// intercepting TLS connections.
+	CABundle string
+
+	// ClientCert and ClientKey are the paths of the PEM encoded certificate
+	// and private key presented to servers requiring mutual TLS. Both or
+	// neither must be set.
+	ClientCert string
+	ClientKey  string
+
+	// MinVersion is the minimum TLS version accepted (e.g., tls.VersionTLS13).
+	// Defaults to tls.VersionTLS12.
+	MinVersion uint16
+}
+
+// NewTLSClientConfig returns a TLS configuration for Session.TLSClientConfig,
+// built from options
+func NewTLSClientConfig(options TLSOptions) (*tls.Config, error) {
+	config := &tls.Config{MinVersion: options.MinVersion}
+	if config.MinVersion == 0 {
+		config.MinVersion = tls.VersionTLS12
+	}
+
+	if options.CABundle != "" {
+		pem, err := ioutil.ReadFile(options.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading CA bundle: %s", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("No certificate found in CA bundle %s", options.CABundle)
+		}
+		config.RootCAs = pool
+	}
+
+	if (options.ClientCert == "") != (options.ClientKey == "") {
+		return nil, fmt.Errorf("Both a client certificate and a client key are required")
+	}
+
+	if options.ClientCert != "" {
+		cert, err := tls.LoadX509KeyPair(options.ClientCert, options.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading client certificate: %s", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
@@ -0,0 +1,106 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestTLSClientConfig(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	bundle := filepath.Join(dir, "ca.pem")
+	block := &pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}
+	if err = ioutil.WriteFile(bundle, pem.EncodeToMemory(block), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var result struct {
+		Id int `json:"id"`
+	}
+
+	// The certificate of the server is not trusted by default
+	sess := &Session{Endpoint: server.URL + "/rest/v3"}
+	if err = sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err == nil {
+		t.Fatalf("Expected an untrusted certificate to fail the call")
+	}
+
+	config, err := NewTLSClientConfig(TLSOptions{CABundle: bundle})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sess = sess.SetTLSClientConfig(config)
+	if err = sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil || result.Id != 1 {
+		t.Errorf("Expected the CA bundle to be trusted, got %v", err)
+	}
+
+	// The server does not support the minimum version
+	config, err = NewTLSClientConfig(TLSOptions{CABundle: bundle, MinVersion: tls.VersionTLS13})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sess = (&Session{Endpoint: server.URL + "/rest/v3"}).SetTLSClientConfig(config)
+	if err = sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err == nil {
+		t.Errorf("Expected the minimum TLS version to be enforced")
+	}
+
+	if _, err = NewTLSClientConfig(TLSOptions{ClientCert: bundle}); err == nil {
+		t.Errorf("Expected a client certificate without a key to be rejected")
+	}
+}
+
+func TestTLSClientConfigTransports(t *testing.T) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	sess := &Session{}
+	if sess.SetTLSClientConfig(config).roundTripper() != sess.SetTLSClientConfig(config).roundTripper() {
+		t.Errorf("Expected sessions sharing a TLS configuration to share a transport")
+	}
+
+	for i := 0; i < 2*MaxCachedTransports; i++ {
+		sess.SetTLSClientConfig(&tls.Config{MinVersion: tls.VersionTLS12}).roundTripper()
+	}
+
+	transports.Lock()
+	cached := len(transports.cache)
+	transports.Unlock()
+	if cached > MaxCachedTransports {
+		t.Errorf("Expected at most %d cached transports, got %d", MaxCachedTransports, cached)
+	}
+}
@@ -0,0 +1,147 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultTokenRefreshMargin is how long before it expires a portal login
+// token is replaced
+const DefaultTokenRefreshMargin = time.Minute
+
+// TokenRefresher keeps the portal login token of a session valid, by
+// authenticating again (e.g., with SoftLayer_User_Customer::getPortalLoginToken)
+// shortly before the current token expires, or after the API rejected it. It
+// is safe for concurrent use, and shared by the copies of a session.
+type TokenRefresher struct {
+	// Refresh returns a new user id and token, and when the token expires. If
+	// the expiry is zero, the token is only replaced once the API rejects it.
+	Refresh func(ctx context.Context) (userId int, token string, expires time.Time, err error)
+
+	// RefreshMargin is how long before it expires a token is replaced.
+	// Defaults to DefaultTokenRefreshMargin.
+	RefreshMargin time.Duration
+
+	mu      sync.Mutex
+	userId  int
+	token   string
+	expires time.Time
+}
+
+// Token returns the user id and a valid token, authenticating again if there
+// is none yet, or the current one is about to expire
+func (t *TokenRefresher) Token(ctx context.Context) (int, string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	margin := t.RefreshMargin
+	if margin <= 0 {
+		margin = DefaultTokenRefreshMargin
+	}
+
+	if t.token != "" && (t.expires.IsZero() || time.Now().Add(margin).Before(t.expires)) {
+		return t.userId, t.token, nil
+	}
+
+	userId, token, expires, err := t.Refresh(ctx)
+	if err != nil {
+		return 0, "", fmt.Errorf("Error refreshing the authentication token: %s", err)
+	}
+
+	t.userId, t.token, t.expires = userId, token, expires
+	return userId, token, nil
+}
+
+// Invalidate discards the current token, so a new one is requested for the
+// next call (e.g., after the API rejected it)
+func (t *TokenRefresher) Invalidate() {
+	t.mu.Lock()
+	t.token = ""
+	t.mu.Unlock()
+}
+
+// SetTokenRefresher creates a copy of the session, authenticated with the
+// portal login tokens returned by refresh, which is called again before each
+// token expires (see TokenRefresher), and returns it. The copies of the
+// returned session share its tokens.
+func (r *Session) SetTokenRefresher(
+	refresh func(ctx context.Context) (userId int, token string, expires time.Time, err error)) *Session {
+
+	var s Session
+	s = *r
+	s.TokenRefresher = &TokenRefresher{Refresh: refresh}
+	s.APIKey = ""
+
+	return &s
+}
+
+// invalidate discards the current token if it is still token, the one the API
+// rejected. A token refreshed meanwhile (e.g., by a concurrent call rejected
+// with the same token) is kept.
+func (t *TokenRefresher) invalidate(token string) {
+	t.mu.Lock()
+	if t.token == token {
+		t.token = ""
+	}
+	t.mu.Unlock()
+}
+
+// authenticate sets the current IAM token and portal login token of the
+// session (if any) into the session of a call
+func (r *Session) authenticate(call *Session) error {
+	if r.Anonymous {
+		return nil
+	}
+
+	if r.IAM != nil {
+		token, err := r.IAM.sessionToken(call.requestContext(), call.iamClient())
+		if err != nil {
+			return err
+		}
+		call.bearerToken = token
+	}
+
+	if r.TokenRefresher != nil {
+		userId, token, err := r.TokenRefresher.Token(call.requestContext())
+		if err != nil {
+			return err
+		}
+		call.UserId, call.AuthToken = userId, token
+	}
+
+	return nil
+}
+
+// invalidateCredentials discards the temporary credentials (IAM bearer token
+// or portal login token) the call was sent with, if they are still those of
+// the session, after the API rejected them. It returns true if new credentials
+// will be used.
+func (r *Session) invalidateCredentials(call *Session) bool {
+	if r.IAM != nil {
+		r.IAM.invalidate(call.bearerToken)
+	}
+
+	if r.TokenRefresher != nil {
+		r.TokenRefresher.invalidate(call.AuthToken)
+	}
+
+	return (r.IAM != nil || r.TokenRefresher != nil) && !r.Anonymous
+}
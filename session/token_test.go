@@ -0,0 +1,145 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestTokenRefresher(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, token, _ := r.BasicAuth(); token == "revoked" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": "Invalid token", "code": "SoftLayer_Exception_InvalidLegacyToken"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	refreshes := 0
+	expires := time.Time{}
+	revoked := true
+	sess := (&Session{Endpoint: server.URL}).SetTokenRefresher(
+		func(ctx context.Context) (int, string, time.Time, error) {
+			refreshes++
+			if revoked {
+				revoked = false
+				return 42, "revoked", expires, nil
+			}
+			return 42, fmt.Sprintf("token-%d", refreshes), expires, nil
+		})
+
+	// The rejected token is replaced, and the request sent again
+	var result struct{}
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+		t.Fatalf("Expected the request to be sent again with a new token, got %s", err)
+	}
+	if refreshes != 2 {
+		t.Errorf("Expected 2 refreshes, got %d", refreshes)
+	}
+
+	// Tokens without an expiry are kept until rejected
+	if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil || refreshes != 2 {
+		t.Errorf("Expected the token to be reused, got %v after %d refreshes", err, refreshes)
+	}
+
+	// Tokens about to expire are replaced before the call
+	expires = time.Now().Add(30 * time.Second)
+	sess.TokenRefresher.Invalidate()
+	for i := 0; i < 2; i++ {
+		if err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if refreshes != 4 {
+		t.Errorf("Expected the expiring token to be replaced before each call, got %d refreshes", refreshes)
+	}
+
+	// Requests are only sent again once
+	sess = (&Session{Endpoint: server.URL}).SetTokenRefresher(
+		func(ctx context.Context) (int, string, time.Time, error) {
+			refreshes++
+			return 42, "revoked", time.Time{}, nil
+		})
+	refreshes = 0
+	err := sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+	if slErr, ok := err.(sl.Error); !ok || slErr.StatusCode != 401 || refreshes != 2 {
+		t.Errorf("Expected an authentication error after one refresh, got %v after %d refreshes", err, refreshes)
+	}
+}
+
+func TestTokenRefresherConcurrentRejections(t *testing.T) {
+	const calls = 5
+
+	var arrived sync.WaitGroup
+	arrived.Add(calls)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, token, _ := r.BasicAuth(); token == "stale" {
+			// Every call is sent with the stale token before any is rejected
+			arrived.Done()
+			arrived.Wait()
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": "Invalid token", "code": "SoftLayer_Exception_InvalidLegacyToken"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	var refreshes int32
+	sess := (&Session{Endpoint: server.URL, TransportHandler: &RestTransport{}}).SetTokenRefresher(
+		func(ctx context.Context) (int, string, time.Time, error) {
+			if atomic.AddInt32(&refreshes, 1) == 1 {
+				return 42, "stale", time.Time{}, nil
+			}
+			return 42, "fresh", time.Time{}, nil
+		})
+
+	var done sync.WaitGroup
+	errs := make(chan error, calls)
+	for i := 0; i < calls; i++ {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			var result struct{}
+			errs <- sess.DoRequest("SoftLayer_Account", "getObject", nil, &sl.Options{}, &result)
+		}()
+	}
+	done.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Expected the calls to succeed with the new token, got %s", err)
+		}
+	}
+
+	// The stale token is replaced once, not once per rejected call
+	if refreshes != 2 {
+		t.Errorf("Expected 2 refreshes, got %d", refreshes)
+	}
+}
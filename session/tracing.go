@@ -0,0 +1,94 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// Tracer starts a span for each call made through a session, e.g. to see the
+// latency of API calls in distributed traces. It is small enough to be
+// implemented over an OpenTelemetry trace.Tracer in a few lines.
+type Tracer interface {
+	// Start starts a span named name as a child of any span in ctx, and
+	// returns a context carrying it, which is used for the requests of the call
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer
+type Span interface {
+	// SetAttribute sets an attribute (string or int) of the span
+	SetAttribute(key string, value interface{})
+
+	// End ends the span, recording err (if not nil) as its error
+	End(err error)
+}
+
+// Span attributes
+const (
+	AttributeService     = "rpc.service"
+	AttributeMethod      = "rpc.method"
+	AttributeObjectId    = "softlayer.object_id"
+	AttributeResultCount = "softlayer.result_count"
+	AttributeStatusCode  = "http.status_code"
+)
+
+// startSpan starts the span of a call, if the session has a Tracer
+func (r *Session) startSpan(ctx context.Context, service string, method string, options *sl.Options) (context.Context, Span) {
+	if r.Tracer == nil {
+		return ctx, nil
+	}
+
+	ctx, span := r.Tracer.Start(ctx, service+"::"+method)
+	span.SetAttribute(AttributeService, service)
+	span.SetAttribute(AttributeMethod, method)
+
+	for key, value := range r.CallLabels() {
+		span.SetAttribute(AttributeLabelPrefix+key, value)
+	}
+
+	if options != nil && options.Id != nil {
+		span.SetAttribute(AttributeObjectId, *options.Id)
+	} else if options != nil && options.GlobalID != nil {
+		span.SetAttribute(AttributeObjectId, *options.GlobalID)
+	}
+
+	return ctx, span
+}
+
+// endSpan ends the span of a call (if any), with the status of the last
+// response and the number of results returned
+func endSpan(ctx context.Context, span Span, pResult interface{}, err error) {
+	if span == nil {
+		return
+	}
+
+	if status := responseStatus(ctx); status != 0 {
+		span.SetAttribute(AttributeStatusCode, status)
+	}
+
+	if err == nil {
+		if result := reflect.ValueOf(pResult); result.Kind() == reflect.Ptr && result.Elem().Kind() == reflect.Slice {
+			span.SetAttribute(AttributeResultCount, result.Elem().Len())
+		}
+	}
+
+	span.End(err)
+}
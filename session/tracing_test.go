@@ -0,0 +1,105 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+type spanKey struct{}
+
+type testSpan struct {
+	name       string
+	attributes map[string]interface{}
+	ended      bool
+	err        error
+}
+
+func (s *testSpan) SetAttribute(key string, value interface{}) {
+	s.attributes[key] = value
+}
+
+func (s *testSpan) End(err error) {
+	s.ended, s.err = true, err
+}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &testSpan{name: name, attributes: map[string]interface{}{}}
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func TestTracer(t *testing.T) {
+	tracer := &testTracer{}
+	propagated := false
+
+	sess := &Session{
+		Tracer: tracer,
+		TransportHandler: TransportHandlerFunc(func(sess *Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+			propagated = sess.Context.Value(spanKey{}) == tracer.spans[0]
+			return (&RestTransport{}).DoRequest(sess, service, method, args, options, pResult)
+		}),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/SoftLayer_Account/getVirtualGuests.json" {
+			fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error": "Unable to find object", "code": "SoftLayer_Exception_ObjectNotFound"}`)
+	}))
+	defer server.Close()
+	sess.Endpoint = server.URL
+
+	var guests []struct{}
+	if err := sess.DoRequest("SoftLayer_Account", "getVirtualGuests", nil, &sl.Options{}, &guests); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	span := tracer.spans[0]
+	if !span.ended || span.err != nil || span.name != "SoftLayer_Account::getVirtualGuests" {
+		t.Errorf("Unexpected span %+v", span)
+	}
+	if span.attributes[AttributeResultCount] != 2 || span.attributes[AttributeStatusCode] != 200 {
+		t.Errorf("Unexpected span attributes %v", span.attributes)
+	}
+	if !propagated {
+		t.Errorf("Expected the call to be made with the context of its span")
+	}
+
+	var guest struct{}
+	err := sess.DoRequest("SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{Id: sl.Int(5)}, &guest)
+
+	span = tracer.spans[1]
+	if err == nil || span.err != err {
+		t.Errorf("Expected the span to record the error, got %v", span.err)
+	}
+	if span.attributes[AttributeObjectId] != 5 || span.attributes[AttributeStatusCode] != 404 {
+		t.Errorf("Unexpected span attributes %v", span.attributes)
+	}
+}
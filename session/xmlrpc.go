@@ -17,11 +17,15 @@
 package session
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
 	"net/http"
 	"net/http/httputil"
+	"regexp"
 	"strings"
 	"time"
 
@@ -29,28 +33,142 @@ import (
 	"github.com/softlayer/softlayer-go/sl"
 )
 
-// Debugging RoundTripper
-type debugRoundTripper struct{}
+// Debugging RoundTripper, logging the requests and responses of a session
+type debugRoundTripper struct {
+	sess *Session
+	base http.RoundTripper
+}
 
 func (mrt debugRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
-	log := Logger
-	log.Println("->>>Request:")
 	dumpedReq, _ := httputil.DumpRequestOut(request, true)
-	log.Println(string(dumpedReq))
+	if !mrt.sess.DebugRaw {
+		dumpedReq = redact(dumpedReq)
+	}
+	mrt.sess.log(LogDebug, "Request", "url", request.URL.String(), "dump", "\n"+string(dumpedReq))
+
+	base := mrt.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
 
-	response, err := http.DefaultTransport.RoundTrip(request)
+	response, err := base.RoundTrip(request)
 	if err != nil {
-		log.Println("Error:", err)
+		mrt.sess.log(LogDebug, "Request failed", "url", request.URL.String(), "error", err)
 		return response, err
 	}
 
-	log.Println("\n\n<<<-Response:")
 	dumpedResp, _ := httputil.DumpResponse(response, true)
-	log.Println(string(dumpedResp))
+	mrt.sess.log(LogDebug, "Response", "status", response.StatusCode, "dump", "\n"+string(dumpedResp))
 
 	return response, err
 }
 
+// redactedHeaders are the request headers carrying credentials
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "X-Auth-Token"}
+
+// redactedMembers matches the values of the members of XML-RPC structs
+// carrying credentials
+var redactedMembers = regexp.MustCompile(
+	`(<name>(?:apiKey|authToken|password)</name>\s*<value>\s*(?:<string>)?)[^<]*`)
+
+// redact replaces the credentials in a request dump with a placeholder
+func redact(dump []byte) []byte {
+	lines := strings.Split(string(dump), "\r\n")
+	for i, line := range lines {
+		if line == "" {
+			break // end of the headers
+		}
+
+		for _, header := range redactedHeaders {
+			if len(line) > len(header) && strings.EqualFold(line[:len(header)+1], header+":") {
+				lines[i] = line[:len(header)+1] + " [REDACTED]"
+			}
+		}
+	}
+
+	return redactedMembers.ReplaceAll([]byte(strings.Join(lines, "\r\n")), []byte("${1}[REDACTED]"))
+}
+
+// headerRoundTripper adds the user agent and the custom headers of a session
+// to each request made by the xmlrpc client
+type headerRoundTripper struct {
+	sess *Session
+	base http.RoundTripper
+}
+
+func (h headerRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the original request
+	request = request.Clone(request.Context())
+	request.Header.Set("User-Agent", h.sess.userAgent)
+
+	err := h.sess.setHeaders(request)
+	if err != nil {
+		return nil, err
+	}
+
+	base := h.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return base.RoundTrip(request)
+}
+
+// contextRoundTripper sends the requests of the xmlrpc client, which does not
+// support contexts, with the context of the session, and records the
+// Retry-After of the responses into it
+type contextRoundTripper struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+func (c contextRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	base := c.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	response, err := base.RoundTrip(request.WithContext(c.ctx))
+	if response != nil {
+		recordResponse(c.ctx, response)
+	}
+
+	return response, err
+}
+
+// drainingRoundTripper reads and closes the body of every response, handing
+// the xmlrpc client an in-memory copy, so connections are released (and can
+// be reused) on every path, including decoding and error paths. It also
+// records whether a successful response had an empty body (e.g., 204 No
+// Content from a void method), which the xmlrpc client cannot decode.
+type drainingRoundTripper struct {
+	base  http.RoundTripper
+	empty *bool
+}
+
+func (d drainingRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	base := d.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	*d.empty = false
+	response, err := base.RoundTrip(request)
+	if err != nil {
+		return response, err
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	*d.empty = len(bytes.TrimSpace(body)) == 0 && response.StatusCode >= 200 && response.StatusCode <= 299
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return response, nil
+}
+
 // XML-RPC Transport
 type XmlRpcTransport struct{}
 
@@ -64,65 +182,86 @@ func (x *XmlRpcTransport) DoRequest(
 ) error {
 
 	var err error
-	serviceUrl := fmt.Sprintf("%s/%s", strings.TrimRight(sess.Endpoint, "/"), service)
+
+	path := service
+	if sess.PathTemplate != "" {
+		path, err = renderPathTemplate(sess, PathTemplateData{Service: service})
+		if err != nil {
+			return err
+		}
+	}
+
+	serviceUrl := fmt.Sprintf("%s/%s", strings.TrimRight(sess.Endpoint, "/"), path)
 
 	timeout := DefaultTimeout
-	if sess.Timeout != 0 {
+	if sess.Timeout < 0 {
+		timeout = 0
+	} else if sess.Timeout != 0 {
 		timeout = sess.Timeout
+	} else if sess.HTTPClient != nil && sess.HTTPClient.Timeout != 0 {
+		timeout = sess.HTTPClient.Timeout
 	}
 
 	// Declaring client outside of the if /else. So we can set the correct http transport based if it is TLS or not
 	var client *xmlrpc.Client
+	roundTripper := sess.roundTripper()
 	if sess.HTTPClient != nil && sess.HTTPClient.Transport != nil {
-		client, err = xmlrpc.NewClient(serviceUrl, sess.HTTPClient.Transport, timeout)
-	} else {
-		var roundTripper http.RoundTripper
-		if sess.Debug {
-			roundTripper = debugRoundTripper{}
-		}
-
-		client, err = xmlrpc.NewClient(serviceUrl, roundTripper, timeout)
-	}
-	//Verify no errors happened in creating the xmlrpc client
-	if err != nil {
-		return fmt.Errorf("Could not create an xmlrpc client for %s: %s", service, err)
+		roundTripper = sess.HTTPClient.Transport
 	}
+	roundTripper = sess.compress(roundTripper)
 
-	authenticate := map[string]interface{}{}
-	if sess.UserName != "" {
-		authenticate["username"] = sess.UserName
+	if sess.Debug {
+		roundTripper = debugRoundTripper{sess: sess, base: roundTripper}
 	}
 
-	if sess.APIKey != "" {
-		authenticate["apiKey"] = sess.APIKey
+	// For cases where session is built from the raw structure and not using New() , the UserAgent would be empty
+	if sess.userAgent == "" {
+		sess.userAgent = getDefaultUserAgent()
 	}
 
-	if sess.UserId != 0 {
-		authenticate["userId"] = sess.UserId
-		authenticate["complexType"] = "PortalLoginToken"
+	roundTripper = headerRoundTripper{sess: sess, base: roundTripper}
+
+	if sess.Telemetry != nil {
+		roundTripper = telemetryRoundTripper{telemetry: sess.Telemetry, base: roundTripper}
 	}
 
-	if sess.AuthToken != "" {
-		authenticate["authToken"] = sess.AuthToken
-		authenticate["complexType"] = "PortalLoginToken"
+	if sess.Context != nil {
+		roundTripper = contextRoundTripper{ctx: sess.Context, base: roundTripper}
 	}
 
-	// For cases where session is built from the raw structure and not using New() , the UserAgent would be empty
-	if sess.userAgent == "" {
-		sess.userAgent = getDefaultUserAgent()
+	empty := false
+	roundTripper = drainingRoundTripper{base: roundTripper, empty: &empty}
+
+	client, err = xmlrpc.NewClient(serviceUrl, roundTripper, timeout)
+	//Verify no errors happened in creating the xmlrpc client
+	if err != nil {
+		return fmt.Errorf("Could not create an xmlrpc client for %s: %s", service, err)
 	}
 
+	authenticate := xmlrpcAuthentication(sess)
+
 	headers := map[string]interface{}{}
 	headers["User-Agent"] = sess.userAgent
 
-	if len(authenticate) > 0 {
+	if len(authenticate) > 0 && !sess.Anonymous {
 		headers["authenticate"] = authenticate
 	}
 
-	if options.Id != nil {
-		headers[fmt.Sprintf("%sInitParameters", service)] = map[string]int{
-			"id": *options.Id,
+	if options.Id != nil || options.GlobalID != nil || options.InitParameters != nil {
+		initParameters := map[string]interface{}{}
+		if options.InitParameters != nil {
+			for name, value := range *options.InitParameters {
+				initParameters[name] = value
+			}
+		}
+
+		if options.Id != nil {
+			initParameters["id"] = *options.Id
+		} else if options.GlobalID != nil {
+			initParameters["globalIdentifier"] = *options.GlobalID
 		}
+
+		headers[fmt.Sprintf("%sInitParameters", service)] = initParameters
 	}
 
 	mask := options.Mask
@@ -183,7 +322,12 @@ func (x *XmlRpcTransport) DoRequest(
 			wait = DefaultRetryWait
 		}
 
-		err = makeXmlRequest(retries, wait, client, method, params, pResult)
+		err = makeXmlRequest(retries, wait, sess, client, method, params, pResult)
+	}
+
+	if err != nil && empty {
+		zeroResult(pResult)
+		return nil
 	}
 
 	if xmlRpcError, ok := err.(*xmlrpc.XmlRpcError); ok {
@@ -196,8 +340,37 @@ func (x *XmlRpcTransport) DoRequest(
 	return err
 }
 
+// xmlrpcAuthentication returns the authenticate header of a session: its
+// username and API key or, failing that, its user id and portal login token
+// (e.g., as returned by SoftLayer_User_Customer::getPortalLoginToken). As with
+// the REST transport, the API key is used when both are set. Sessions using
+// IAM send a bearer token in the HTTP headers instead.
+func xmlrpcAuthentication(sess *Session) map[string]interface{} {
+	authenticate := map[string]interface{}{}
+	if sess.IAM != nil {
+		return authenticate
+	}
+
+	if sess.APIKey == "" && sess.AuthToken != "" {
+		authenticate["userId"] = sess.UserId
+		authenticate["authToken"] = sess.AuthToken
+		authenticate["complexType"] = "PortalLoginToken"
+		return authenticate
+	}
+
+	if sess.UserName != "" {
+		authenticate["username"] = sess.UserName
+	}
+
+	if sess.APIKey != "" {
+		authenticate["apiKey"] = sess.APIKey
+	}
+
+	return authenticate
+}
+
 func makeXmlRequest(
-	retries int, wait time.Duration, client *xmlrpc.Client,
+	retries int, wait time.Duration, sess *Session, client *xmlrpc.Client,
 	method string, params []interface{}, pResult interface{}) error {
 
 	err := client.Call(method, params, pResult)
@@ -218,9 +391,13 @@ func makeXmlRequest(
 		if retries--; retries > 0 {
 			jitter := time.Duration(rand.Int63n(int64(wait)))
 			wait = wait + jitter/2
-			time.Sleep(wait)
+			delay, ok := retryWait(sess.requestContext(), wait, DefaultMaxRetryDelay)
+			if !ok || sess.sleep(delay) != nil {
+				return err
+			}
+			sess.Telemetry.recordRetry()
 			return makeXmlRequest(
-				retries, wait, client, method, params, pResult)
+				retries, wait, sess, client, method, params, pResult)
 		}
 	}
 
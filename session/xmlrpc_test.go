@@ -0,0 +1,125 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDrainingRoundTripper(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		empty  bool
+	}{
+		{200, "", true},
+		{204, "", true},
+		{200, " \n", true},
+		{200, "<methodResponse/>", false},
+		{500, "", false},
+	}
+
+	for _, tc := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tc.status)
+			w.Write([]byte(tc.body))
+		}))
+
+		empty := true
+		rt := drainingRoundTripper{empty: &empty}
+		req, _ := http.NewRequest("POST", server.URL, nil)
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		server.Close()
+
+		if empty != tc.empty || string(body) != tc.body {
+			t.Errorf("Status %d, body %q: expected empty=%t and the body preserved, got %t and %q",
+				tc.status, tc.body, tc.empty, empty, body)
+		}
+	}
+}
+
+func TestRedact(t *testing.T) {
+	dump := "POST /xmlrpc/v3/SoftLayer_Account HTTP/1.1\r\n" +
+		"Host: api.softlayer.com\r\n" +
+		"Authorization: Bearer secret-token\r\n" +
+		"X-Request-Id: 42\r\n" +
+		"\r\n" +
+		"<member><name>username</name><value><string>user</string></value></member>" +
+		"<member><name>apiKey</name><value><string>secret-key</string></value></member>" +
+		"<member><name>authToken</name>\n<value>secret-hash</value></member>"
+
+	redacted := string(redact([]byte(dump)))
+	if strings.Contains(redacted, "secret") {
+		t.Errorf("Expected credentials to be redacted, got:\n%s", redacted)
+	}
+
+	for _, kept := range []string{"X-Request-Id: 42", "<string>user</string>", "Authorization: [REDACTED]",
+		"<name>apiKey</name><value><string>[REDACTED]</string>"} {
+		if !strings.Contains(redacted, kept) {
+			t.Errorf("Expected %q in the redacted dump:\n%s", kept, redacted)
+		}
+	}
+}
+
+func TestXmlRpcAuthentication(t *testing.T) {
+	tests := []struct {
+		sess     *Session
+		expected map[string]interface{}
+	}{
+		{
+			&Session{UserName: "user", APIKey: "key"},
+			map[string]interface{}{"username": "user", "apiKey": "key"},
+		},
+		{
+			(&Session{UserName: "user", APIKey: "key"}).SetAuthToken(123, "token"),
+			map[string]interface{}{"userId": 123, "authToken": "token", "complexType": "PortalLoginToken"},
+		},
+		{
+			&Session{UserName: "user", UserId: 123, AuthToken: "token"},
+			map[string]interface{}{"userId": 123, "authToken": "token", "complexType": "PortalLoginToken"},
+		},
+		{
+			&Session{UserName: "user", APIKey: "key", UserId: 123, AuthToken: "token"},
+			map[string]interface{}{"username": "user", "apiKey": "key"},
+		},
+		{
+			(&Session{UserName: "user", APIKey: "key"}).SetIAMAPIKey("iam-key"),
+			map[string]interface{}{},
+		},
+		{
+			&Session{},
+			map[string]interface{}{},
+		},
+	}
+
+	for _, tc := range tests {
+		if actual := xmlrpcAuthentication(tc.sess); !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("Expected %v, got %v", tc.expected, actual)
+		}
+	}
+}
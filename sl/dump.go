@@ -0,0 +1,206 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sl
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+)
+
+// MaskedValue replaces the value of secret fields in the output of Dump
+const MaskedValue = "********"
+
+// secretFields are the (lower case) names of string fields holding secrets,
+// in addition to any field whose name ends in "password"
+var secretFields = map[string]bool{
+	"apikey":            true,
+	"authenticationkey": true,
+	"authtoken":         true,
+	"privatekey":        true,
+	"secret":            true,
+	"secretkey":         true,
+	"token":             true,
+}
+
+var (
+	datatypesTime = reflect.TypeOf(datatypes.Time{})
+	timeTime      = reflect.TypeOf(time.Time{})
+)
+
+// Dump returns a readable, indented rendering of v, typically a datatype or a
+// slice of datatypes.  Nil pointers, empty slices and empty maps are elided,
+// times are formatted as RFC 3339, and the values of secret fields (such as
+// the passwords of an operating system) are masked.
+func Dump(v interface{}) string {
+	var b strings.Builder
+	d := dumper{w: &b, visiting: map[uintptr]bool{}}
+	d.value(reflect.ValueOf(v), 0)
+
+	return b.String()
+}
+
+// Fdump writes the rendering of v produced by Dump to w, followed by a newline
+func Fdump(w io.Writer, v interface{}) error {
+	_, err := io.WriteString(w, Dump(v)+"\n")
+	return err
+}
+
+type dumper struct {
+	w        *strings.Builder
+	visiting map[uintptr]bool
+}
+
+func (d dumper) printf(format string, args ...interface{}) {
+	fmt.Fprintf(d.w, format, args...)
+}
+
+func (d dumper) indent(depth int) {
+	d.w.WriteString(strings.Repeat("  ", depth))
+}
+
+func (d dumper) value(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		d.printf("nil")
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			d.printf("nil")
+			return
+		}
+
+		// Guard against cycles in hand-built object graphs
+		if d.visiting[v.Pointer()] {
+			d.printf("<cycle>")
+			return
+		}
+		d.visiting[v.Pointer()] = true
+		d.value(v.Elem(), depth)
+		delete(d.visiting, v.Pointer())
+
+	case reflect.Interface:
+		d.value(v.Elem(), depth)
+
+	case reflect.Struct:
+		switch v.Type() {
+		case datatypesTime:
+			d.printf("%s", v.Field(0).Interface().(time.Time).Format(time.RFC3339))
+			return
+		case timeTime:
+			d.printf("%s", v.Interface().(time.Time).Format(time.RFC3339))
+			return
+		}
+
+		d.printf("%s{\n", v.Type().Name())
+		d.fields(v, depth+1)
+		d.indent(depth)
+		d.printf("}")
+
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			d.printf("<%d bytes>", v.Len())
+			return
+		}
+
+		d.printf("[\n")
+		for i := 0; i < v.Len(); i++ {
+			d.indent(depth + 1)
+			d.value(v.Index(i), depth+1)
+			d.printf("\n")
+		}
+		d.indent(depth)
+		d.printf("]")
+
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+
+		d.printf("{\n")
+		for _, key := range keys {
+			d.indent(depth + 1)
+			d.printf("%v: ", key.Interface())
+			d.value(v.MapIndex(key), depth+1)
+			d.printf("\n")
+		}
+		d.indent(depth)
+		d.printf("}")
+
+	case reflect.String:
+		d.printf("%q", v.String())
+
+	default:
+		if v.CanInterface() {
+			d.printf("%v", v.Interface())
+		} else {
+			d.printf("%v", v)
+		}
+	}
+}
+
+// fields writes the exported fields of the struct v, inlining those of
+// embedded (base) types
+func (d dumper) fields(v reflect.Value, depth int) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		value := v.Field(i)
+
+		if field.PkgPath != "" || isEmpty(value) {
+			continue
+		}
+
+		if field.Anonymous && value.Kind() == reflect.Struct {
+			d.fields(value, depth)
+			continue
+		}
+
+		d.indent(depth)
+		d.printf("%s: ", field.Name)
+		if isSecret(field.Name) && reflect.Indirect(value).Kind() == reflect.String {
+			d.printf("%q", MaskedValue)
+		} else {
+			d.value(value, depth)
+		}
+		d.printf("\n")
+	}
+}
+
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+
+	return false
+}
+
+func isSecret(name string) bool {
+	name = strings.ToLower(name)
+	return secretFields[name] || strings.HasSuffix(name, "password")
+}
@@ -0,0 +1,60 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sl
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+)
+
+func TestDump(t *testing.T) {
+	guest := datatypes.Virtual_Guest{
+		Id:         Int(1234),
+		Hostname:   String("web1"),
+		CreateDate: Time(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)),
+		OperatingSystem: &datatypes.Software_Component_OperatingSystem{
+			Software_Component: datatypes.Software_Component{
+				Passwords: []datatypes.Software_Component_Password{
+					{Username: String("root"), Password: String("hunter2")},
+				},
+			},
+		},
+	}
+
+	out := Dump(guest)
+
+	expected := []string{
+		"Virtual_Guest{",
+		`  Hostname: "web1"`,
+		"  Id: 1234",
+		"  CreateDate: 2020-01-02T03:04:05Z",
+		`        Username: "root"`,
+		`        Password: "********"`,
+	}
+	for _, line := range expected {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("Expected line %q in output:\n%s", line, out)
+		}
+	}
+
+	if strings.Contains(out, "hunter2") || strings.Contains(out, "nil") || strings.Contains(out, "Domain") {
+		t.Errorf("Expected secrets and nil fields to be omitted:\n%s", out)
+	}
+}
@@ -16,7 +16,10 @@
 
 package sl
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // Error contains detailed information about an API error, which can be useful
 // for debugging, or when finer error handling is required than just the mere
@@ -47,3 +50,124 @@ func (r Error) Error() string {
 	}
 	return msg
 }
+
+// Unwrap returns the underlying error (e.g., a network error or a cancelled
+// context), if any
+func (r Error) Unwrap() error {
+	return r.Wrapped
+}
+
+// DeprecationError is returned when the API reports that the method called
+// is deprecated or has been removed.  Replacement holds the alternative
+// suggested by the API (e.g., "SoftLayer_Account::getHardware"), if any.
+//
+// The original API error can be retrieved with errors.As or Unwrap.
+type DeprecationError struct {
+	Service     string
+	Method      string
+	Replacement string
+	Err         Error
+}
+
+func (r DeprecationError) Error() string {
+	return r.Err.Error()
+}
+
+// Unwrap returns the original API error
+func (r DeprecationError) Unwrap() error {
+	return r.Err
+}
+
+// RateLimitError is returned when the API throttled the call (HTTP 429, or the
+// SoftLayer_Exception_WebService_RateLimitExceeded fault).  RetryAfter is how
+// long the API asked clients to wait before retrying, when it said so.
+//
+// The original API error can be retrieved with errors.As or Unwrap.
+type RateLimitError struct {
+	Service    string
+	Method     string
+	RetryAfter time.Duration
+	Err        Error
+}
+
+func (r RateLimitError) Error() string {
+	return r.Err.Error()
+}
+
+// Unwrap returns the original API error
+func (r RateLimitError) Unwrap() error {
+	return r.Err
+}
+
+// OptionError is returned, before any request is sent, when an option of the
+// call is invalid.  Option names the offending option (e.g., "Filter").
+type OptionError struct {
+	Option string
+	Value  string
+	Reason string
+}
+
+func (r OptionError) Error() string {
+	return fmt.Sprintf("Invalid %s option %q: %s", r.Option, r.Value, r.Reason)
+}
+
+// CircuitOpenError is returned, without any request being sent, when the
+// circuit breaker of the session is open because too many recent calls
+// failed.  RetryAfter is how long until the breaker lets a call through again.
+type CircuitOpenError struct {
+	Service    string
+	Method     string
+	RetryAfter time.Duration
+}
+
+func (r CircuitOpenError) Error() string {
+	return fmt.Sprintf("Circuit breaker open, %s::%s not called (retry in %s)", r.Service, r.Method, r.RetryAfter)
+}
+
+// CredentialError is returned by Session.Validate when the API rejected the
+// credentials of the session (e.g., a wrong username or API key, or an expired
+// token).
+type CredentialError struct {
+	Err error
+}
+
+func (r CredentialError) Error() string {
+	return fmt.Sprintf("Invalid credentials: %s", r.Err)
+}
+
+// Unwrap returns the original error
+func (r CredentialError) Unwrap() error {
+	return r.Err
+}
+
+// PermissionError is returned by Session.Validate when the credentials of the
+// session are valid, but the user is not allowed to use the API (e.g., from
+// this IP address).
+type PermissionError struct {
+	Err error
+}
+
+func (r PermissionError) Error() string {
+	return fmt.Sprintf("Insufficient permissions: %s", r.Err)
+}
+
+// Unwrap returns the original error
+func (r PermissionError) Unwrap() error {
+	return r.Err
+}
+
+// UnreachableError is returned by Session.Validate when no response could be
+// obtained from the endpoint (e.g., a DNS, connection, TLS or proxy error).
+type UnreachableError struct {
+	Endpoint string
+	Err      error
+}
+
+func (r UnreachableError) Error() string {
+	return fmt.Sprintf("Endpoint %s unreachable: %s", r.Endpoint, r.Err)
+}
+
+// Unwrap returns the original error
+func (r UnreachableError) Unwrap() error {
+	return r.Err
+}
@@ -16,12 +16,269 @@
 
 package sl
 
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
 // Options contains the individual query parameters that can be applied to
 // a request.
+//
+// The fluent methods of the services (Id, Mask, Filter, Limit, ...) return a
+// copy of the service with modified Options, and the session never modifies
+// the Options passed to a call.  They are validated when the call is made; see
+// Validate.
+//
+// Copies of Options share the map of InitParameters: modifying it in place
+// modifies every copy (and every service holding one).  Replace it instead,
+// with WithInitParameter.
 type Options struct {
-	Id     *int
-	Mask   string
-	Filter string
-	Limit  *int
-	Offset *int
+	Id       *int
+	GlobalID *string
+	Mask     string
+	Filter   string
+	Limit    *int
+	Offset   *int
+
+	// InitParameters holds init parameters other than the id and the global
+	// identifier, for services identifying objects by other (or multi-part)
+	// keys, e.g. a username.  Use the InitParameter method of the services, or
+	// WithInitParameter, to set them.  The map is shared by copies of the
+	// Options, and must not be modified in place.  (It is held by pointer so
+	// that Options, and the services, remain comparable.)
+	InitParameters *map[string]interface{}
+
+	// Unlimited opts the request out of the default result limit of the
+	// session, if any
+	Unlimited bool
+
+	// Timeout overrides the timeout of the session for this request only,
+	// e.g. for calls known to be slow.  Zero uses the session timeout.
+	Timeout time.Duration
+}
+
+// Validate checks the options, returning an OptionError naming the first
+// invalid option.
+func (r Options) Validate() error {
+	if r.Id != nil && *r.Id < 0 {
+		return OptionError{Option: "Id", Value: strconv.Itoa(*r.Id), Reason: "must not be negative"}
+	}
+
+	if r.GlobalID != nil && strings.TrimSpace(*r.GlobalID) == "" {
+		return OptionError{Option: "GlobalID", Value: *r.GlobalID, Reason: "must not be empty"}
+	}
+
+	for name := range r.initParameters() {
+		if name == "" {
+			return OptionError{Option: "InitParameters", Value: name, Reason: "parameter names must not be empty"}
+		}
+	}
+
+	if reason := checkBrackets(r.Mask); reason != "" {
+		return OptionError{Option: "Mask", Value: r.Mask, Reason: reason}
+	}
+
+	if err := r.checkFilter(); err != nil {
+		return err
+	}
+
+	if r.Limit != nil && *r.Limit < 0 {
+		return OptionError{Option: "Limit", Value: strconv.Itoa(*r.Limit), Reason: "must not be negative"}
+	}
+
+	if r.Timeout < 0 {
+		return OptionError{Option: "Timeout", Value: r.Timeout.String(), Reason: "must not be negative"}
+	}
+
+	if r.Offset != nil && *r.Offset < 0 {
+		return OptionError{Option: "Offset", Value: strconv.Itoa(*r.Offset), Reason: "must not be negative"}
+	}
+
+	return nil
+}
+
+// checkFilter returns an OptionError if the filter is set but is not a JSON
+// object
+func (r Options) checkFilter() error {
+	if r.Filter == "" {
+		return nil
+	}
+
+	var filter map[string]interface{}
+	if err := json.Unmarshal([]byte(r.Filter), &filter); err != nil {
+		return OptionError{Option: "Filter", Value: r.Filter, Reason: "must be a JSON object: " + err.Error()}
+	}
+
+	return nil
+}
+
+// optionsJSON is the JSON representation of Options, see MarshalJSON
+type optionsJSON struct {
+	Id             *int                   `json:"id,omitempty"`
+	GlobalID       *string                `json:"globalId,omitempty"`
+	Mask           string                 `json:"mask,omitempty"`
+	Filter         json.RawMessage        `json:"filter,omitempty"`
+	Limit          *int                   `json:"limit,omitempty"`
+	Offset         *int                   `json:"offset,omitempty"`
+	InitParameters map[string]interface{} `json:"initParameters,omitempty"`
+	Unlimited      bool                   `json:"unlimited,omitempty"`
+	Timeout        string                 `json:"timeout,omitempty"`
+}
+
+// MarshalJSON encodes the options as a JSON object, so that queries can be
+// saved (e.g., in configuration files or databases) and replayed later, e.g.:
+//
+//	{"mask": "id,hostname", "filter": {"virtualGuests": {...}}, "limit": 50, "timeout": "5m0s"}
+//
+// The filter is embedded as an object, and the timeout is a duration string.
+// Unset options are omitted.
+func (r Options) MarshalJSON() ([]byte, error) {
+	data := optionsJSON{
+		Id:             r.Id,
+		GlobalID:       r.GlobalID,
+		Mask:           r.Mask,
+		Limit:          r.Limit,
+		Offset:         r.Offset,
+		InitParameters: r.initParameters(),
+		Unlimited:      r.Unlimited,
+	}
+
+	if r.Filter != "" {
+		if err := r.checkFilter(); err != nil {
+			return nil, err
+		}
+		data.Filter = json.RawMessage(r.Filter)
+	}
+
+	if r.Timeout != 0 {
+		data.Timeout = r.Timeout.String()
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON decodes options encoded by MarshalJSON, and validates them.
+// The filter may also be given as a string holding the JSON filter. Whole
+// numbers among the init parameters are decoded as ints.
+func (r *Options) UnmarshalJSON(b []byte) error {
+	var data optionsJSON
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return err
+	}
+
+	options := Options{
+		Id:        data.Id,
+		GlobalID:  data.GlobalID,
+		Mask:      data.Mask,
+		Limit:     data.Limit,
+		Offset:    data.Offset,
+		Unlimited: data.Unlimited,
+	}
+
+	if filter := bytes.TrimSpace(data.Filter); len(filter) > 0 && !bytes.Equal(filter, []byte("null")) {
+		var buf bytes.Buffer
+		if filter[0] == '"' {
+			var s string
+			if err := json.Unmarshal(filter, &s); err != nil {
+				return err
+			}
+			options.Filter = s
+		} else if err := json.Compact(&buf, filter); err != nil {
+			return err
+		} else {
+			options.Filter = buf.String()
+		}
+	}
+
+	if len(data.InitParameters) > 0 {
+		params := map[string]interface{}{}
+		for name, value := range data.InitParameters {
+			params[name] = jsonNumber(value)
+		}
+		options.InitParameters = &params
+	}
+
+	if data.Timeout != "" {
+		timeout, err := time.ParseDuration(data.Timeout)
+		if err != nil {
+			return OptionError{Option: "Timeout", Value: data.Timeout, Reason: err.Error()}
+		}
+		options.Timeout = timeout
+	}
+
+	if err := options.Validate(); err != nil {
+		return err
+	}
+
+	*r = options
+	return nil
+}
+
+// jsonNumber converts a json.Number to an int if it is a whole number that
+// fits, or else to a float64. Other values are returned unchanged.
+func jsonNumber(value interface{}) interface{} {
+	n, ok := value.(json.Number)
+	if !ok {
+		return value
+	}
+
+	if i, err := strconv.ParseInt(string(n), 10, 0); err == nil {
+		return int(i)
+	}
+
+	f, err := n.Float64()
+	if err != nil || math.IsInf(f, 0) {
+		return string(n)
+	}
+
+	return f
+}
+
+// WithInitParameter returns a copy of the init parameters with the named
+// parameter set to value
+func (r Options) WithInitParameter(name string, value interface{}) *map[string]interface{} {
+	params := map[string]interface{}{}
+	for k, v := range r.initParameters() {
+		params[k] = v
+	}
+	params[name] = value
+
+	return &params
+}
+
+func (r Options) initParameters() map[string]interface{} {
+	if r.InitParameters == nil {
+		return nil
+	}
+
+	return *r.InitParameters
+}
+
+// checkBrackets returns the reason the brackets of an object mask are
+// unbalanced, or an empty string if they are balanced
+func checkBrackets(mask string) string {
+	depth := 0
+	for _, c := range mask {
+		switch c {
+		case '[', '(':
+			depth++
+		case ']', ')':
+			depth--
+			if depth < 0 {
+				return "unexpected closing bracket"
+			}
+		}
+	}
+
+	if depth > 0 {
+		return "unclosed bracket"
+	}
+
+	return ""
 }
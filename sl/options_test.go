@@ -0,0 +1,112 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sl
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOptionsValidate(t *testing.T) {
+	valid := []Options{
+		{},
+		{Id: Int(1), Mask: "mask[id,datacenter[name]]", Filter: `{"id":{"operation":1}}`},
+		{Mask: "id;hostname", Limit: Int(10), Offset: Int(20)},
+		{Offset: Int(10)},
+	}
+
+	for _, options := range valid {
+		if err := options.Validate(); err != nil {
+			t.Errorf("Expected %+v to be valid, got %s", options, err)
+		}
+	}
+
+	invalid := map[string]Options{
+		"Id":       {Id: Int(-1)},
+		"GlobalID": {GlobalID: String(" ")},
+		"Mask":     {Mask: "mask[id,datacenter[name]"},
+		"Filter":   {Filter: `{"id":`},
+		"Limit":    {Limit: Int(-5)},
+		"Offset":   {Offset: Int(-10)},
+		"Timeout":  {Timeout: -time.Second},
+	}
+
+	for option, options := range invalid {
+		err := options.Validate()
+		optionErr, ok := err.(OptionError)
+		if !ok || optionErr.Option != option {
+			t.Errorf("Expected an OptionError for %s, got %v", option, err)
+		}
+	}
+}
+
+func TestOptionsJSON(t *testing.T) {
+	params := map[string]interface{}{"username": "jdoe", "accountId": 1234, "ratio": 0.5}
+	options := Options{
+		Id:             Int(1),
+		Mask:           "mask[id,hostname]",
+		Filter:         `{"virtualGuests":{"hostname":{"operation":"web*"}}}`,
+		Limit:          Int(50),
+		Offset:         Int(100),
+		InitParameters: &params,
+		Timeout:        5 * time.Minute,
+	}
+
+	data, err := json.Marshal(options)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	var raw map[string]interface{}
+	json.Unmarshal(data, &raw)
+	if _, ok := raw["filter"].(map[string]interface{}); !ok || raw["timeout"] != "5m0s" || raw["unlimited"] != nil {
+		t.Errorf("Expected the filter as an object and the timeout as a duration, got %s", data)
+	}
+
+	var decoded Options
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(*decoded.InitParameters, params) {
+		t.Errorf("Expected the init parameters %v, got %v", params, *decoded.InitParameters)
+	}
+
+	decoded.InitParameters = options.InitParameters
+	if !reflect.DeepEqual(decoded, options) {
+		t.Errorf("Expected %+v, got %+v", options, decoded)
+	}
+
+	// Filters can be given as strings, and the options are validated
+	if err = json.Unmarshal([]byte(`{"filter": "{\"id\": {\"operation\": 1}}", "unlimited": true}`), &decoded); err != nil ||
+		decoded.Filter != `{"id": {"operation": 1}}` || !decoded.Unlimited || decoded.Mask != "" {
+		t.Errorf("Expected a string filter to be decoded, got %+v (%v)", decoded, err)
+	}
+
+	if err = json.Unmarshal([]byte(`{"offset": -10}`), &decoded); err == nil {
+		t.Errorf("Expected invalid options to be rejected")
+	}
+
+	// Filters that are valid JSON but not objects are not encoded
+	for _, filter := range []string{`[1]`, `"x"`, `{"id":`} {
+		if _, err = json.Marshal(Options{Filter: filter}); err == nil {
+			t.Errorf("Expected the filter %s to be rejected", filter)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Properties sent even when unset, as the API requires them
+var requiredProperties = map[string]bool{
+	"Dns_Domain.ResourceRecords": true,
+}
+
+// Unset (nil) properties must be omitted from requests, rather than sent as
+// empty or zero values, so that edit calls only change the properties set by
+// the caller. This holds for both transports: the XML-RPC encoder skips
+// omitempty fields as the JSON one does.
+func TestDatatypesOmitUnsetProperties(t *testing.T) {
+	files, err := parser.ParseDir(token.NewFileSet(), "../datatypes", nil, 0)
+	if err != nil {
+		t.Fatalf("Error parsing datatypes: %s", err)
+	}
+
+	for _, file := range files["datatypes"].Files {
+		for _, decl := range file.Decls {
+			spec, ok := decl.(*ast.GenDecl)
+			if !ok || spec.Tok != token.TYPE {
+				continue
+			}
+
+			for _, s := range spec.Specs {
+				typeSpec := s.(*ast.TypeSpec)
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+
+				for _, field := range structType.Fields.List {
+					if len(field.Names) == 0 || field.Tag == nil {
+						continue
+					}
+
+					name := typeSpec.Name.Name + "." + field.Names[0].Name
+					if requiredProperties[name] {
+						continue
+					}
+
+					tag, _ := strconv.Unquote(field.Tag.Value)
+					for _, key := range []string{"json", "xmlrpc"} {
+						if !strings.HasSuffix(reflect.StructTag(tag).Get(key), ",omitempty") {
+							t.Errorf("Expected the %s tag of %s to be omitempty", key, name)
+						}
+					}
+
+					switch field.Type.(type) {
+					case *ast.StarExpr, *ast.ArrayType, *ast.MapType, *ast.InterfaceType:
+					default:
+						t.Errorf("Expected %s to be nillable, so that it can be left unset", name)
+					}
+				}
+			}
+		}
+	}
+}
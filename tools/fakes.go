@@ -0,0 +1,299 @@
+/**
+ * Copyright 2016 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// defaultFakes lists the services for which fakes are generated when no
+// services are named on the command line
+var defaultFakes = []string{"Account", "Hardware_Server", "Product_Order", "Virtual_Guest"}
+
+// Methods every service implements for setting sl.Options.  These are
+// reproduced on each fake, but are not part of the service interfaces.
+var optionMethods = map[string]bool{
+	"Id": true, "InitParameter": true, "GlobalID": true, "Mask": true,
+	"Filter": true, "Limit": true, "Unlimited": true, "Offset": true,
+	"Timeout": true, "WithContext": true,
+}
+
+type FakeService struct {
+	Name        string
+	HasGlobalID bool
+	Methods     []FakeMethod
+}
+
+type FakeMethod struct {
+	Name    string
+	Params  string
+	Args    []string
+	Results string
+	Void    bool
+
+	// Scoped is the method called by a relational getter variant taking
+	// scoped options (e.g., GetBillingItem for GetBillingItemWith)
+	Scoped string
+}
+
+var fake = fmt.Sprintf(`%s
+
+%s
+
+package fakes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+{{$base := .Name}}// {{$base|desnake}}Service describes the API methods of services.{{$base}}
+type {{$base|desnake}}Service interface {
+	{{range .Methods}}{{.Name}}({{.Params}}) {{.Results}}
+	{{end}}
+}
+
+var _ {{$base|desnake}}Service = services.{{$base}}{}
+var _ {{$base|desnake}}Service = {{$base}}{}
+
+// {{$base}} is a recording fake of services.{{$base}}. Its copies, returned
+// by the fluent methods, share its Recorder.
+type {{$base}} struct {
+	*Recorder
+	Options sl.Options
+}
+
+// New{{$base|desnake}} returns a new fake of services.{{$base}}
+func New{{$base|desnake}}() {{$base}} {
+	return {{$base}}{Recorder: NewRecorder()}
+}
+
+func (r {{$base}}) Id(id int) {{$base}} {
+	r.Options.Id = &id
+	return r
+}
+
+func (r {{$base}}) InitParameter(name string, value interface{}) {{$base}} {
+	r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+	return r
+}
+
+{{if .HasGlobalID}}func (r {{$base}}) GlobalID(globalID string) {{$base}} {
+	r.Options.GlobalID = &globalID
+	return r
+}
+
+{{end}}func (r {{$base}}) Mask(mask string) {{$base}} {
+	if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
+		mask = fmt.Sprintf("mask[%%s]", mask)
+	}
+
+	r.Options.Mask = mask
+	return r
+}
+
+func (r {{$base}}) Filter(filter string) {{$base}} {
+	r.Options.Filter = filter
+	return r
+}
+
+func (r {{$base}}) Limit(limit int) {{$base}} {
+	r.Options.Limit = &limit
+	return r
+}
+
+func (r {{$base}}) Unlimited() {{$base}} {
+	r.Options.Unlimited = true
+	return r
+}
+
+func (r {{$base}}) Offset(offset int) {{$base}} {
+	r.Options.Offset = &offset
+	return r
+}
+
+func (r {{$base}}) Timeout(timeout time.Duration) {{$base}} {
+	r.Options.Timeout = timeout
+	return r
+}
+
+// WithContext is accepted for compatibility with the service; fakes do not
+// use the context
+func (r {{$base}}) WithContext(ctx context.Context) {{$base}} {
+	return r
+}
+
+{{range .Methods}}
+func (r {{$base}}) {{.Name}}({{.Params}}) {{.Results}} {
+	{{if .Scoped}}options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+	r.Options = options
+	return r.{{.Scoped}}()
+	{{else}}err = r.record("{{.Name}}", {{if .Args}}[]interface{}{ {{range .Args}}{{.}}, {{end}} }{{else}}nil{{end}}, r.Options, {{if .Void}}nil{{else}}&resp{{end}})
+	return
+	{{end}}
+}
+{{end}}
+`, license, codegenWarning)
+
+// generateFakes reads the generated services package source, and writes a
+// recording fake and a service interface for each of the requested services
+// into the fakes package.
+func generateFakes() {
+	flagset := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+	outputPath := flagset.String("o", ".", "the root of the go project to be refreshed")
+	flagset.Parse(os.Args[2:])
+
+	names := flagset.Args()
+	if len(names) == 0 {
+		names = defaultFakes
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, *outputPath+"/services", nil, 0)
+	if err != nil {
+		bail(fmt.Errorf("Error parsing services package: %s", err))
+	}
+
+	for _, name := range names {
+		service, err := loadFakeService(fset, pkgs["services"], name)
+		if err != nil {
+			bail(err)
+		}
+
+		err = writeFake(*outputPath, service)
+		if err != nil {
+			bail(err)
+		}
+	}
+}
+
+func loadFakeService(fset *token.FileSet, pkg *ast.Package, name string) (FakeService, error) {
+	service := FakeService{Name: name}
+
+	if pkg == nil {
+		return service, fmt.Errorf("services package not found")
+	}
+
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 {
+				continue
+			}
+
+			recv, ok := fn.Recv.List[0].Type.(*ast.Ident)
+			if !ok || recv.Name != name {
+				continue
+			}
+
+			if optionMethods[fn.Name.Name] {
+				service.HasGlobalID = service.HasGlobalID || fn.Name.Name == "GlobalID"
+				continue
+			}
+
+			method := FakeMethod{
+				Name:    fn.Name.Name,
+				Params:  fieldsString(fset, fn.Type.Params),
+				Results: "(" + fieldsString(fset, fn.Type.Results) + ")",
+				Void:    fn.Type.Results.NumFields() == 1,
+			}
+
+			if strings.HasSuffix(method.Name, "With") && method.Params == "options sl.Options" {
+				method.Scoped = strings.TrimSuffix(method.Name, "With")
+			}
+
+			for _, field := range fn.Type.Params.List {
+				for _, ident := range field.Names {
+					method.Args = append(method.Args, ident.Name)
+				}
+			}
+
+			service.Methods = append(service.Methods, method)
+		}
+	}
+
+	if len(service.Methods) == 0 {
+		return service, fmt.Errorf("No service named %s found", name)
+	}
+
+	sort.Slice(service.Methods, func(i, j int) bool {
+		return service.Methods[i].Name < service.Methods[j].Name
+	})
+
+	return service, nil
+}
+
+// fieldsString renders a parameter or result list as it appears in source,
+// without the enclosing parentheses
+func fieldsString(fset *token.FileSet, fields *ast.FieldList) string {
+	list := []string{}
+	for _, field := range fields.List {
+		var buf bytes.Buffer
+		printer.Fprint(&buf, fset, field.Type)
+
+		names := []string{}
+		for _, ident := range field.Names {
+			names = append(names, ident.Name)
+		}
+
+		list = append(list, strings.Join(names, ", ")+" "+buf.String())
+	}
+
+	return strings.Join(list, ", ")
+}
+
+func writeFake(base string, service FakeService) error {
+	filename := base + "/services/fakes/" + strings.ToLower(service.Name) + ".go"
+
+	var buf bytes.Buffer
+	t := template.New("fakes").Funcs(fMap)
+	err := template.Must(t.Parse(fake)).Execute(&buf, service)
+	if err != nil {
+		return fmt.Errorf("Error generating fake for %s: %s", service.Name, err)
+	}
+
+	pretty, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("Error while formatting source: %s", err)
+	}
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("Error creating file: %s", err)
+	}
+	defer f.Close()
+	fmt.Fprintf(f, "%s", pretty)
+
+	return nil
+}
@@ -42,6 +42,10 @@ type Type struct {
 	ServiceDoc string              `json:"serviceDoc"`
 	Methods    map[string]Method   `json:"methods"`
 	NoService  bool                `json:"noservice"`
+
+	// HasGlobalID is set for services whose objects can be addressed by
+	// their globalIdentifier
+	HasGlobalID bool `json:"-"`
 }
 
 type Property struct {
@@ -63,6 +67,9 @@ type Method struct {
 	Filterable bool        `json:"filterable"`
 	Maskable   bool        `json:"maskable"`
 	Parameters []Parameter `json:"parameters"`
+
+	// Relational is set for the getters created for relational properties
+	Relational bool `json:"-"`
 }
 
 type Parameter struct {
@@ -132,7 +139,17 @@ import (
 		return r
 	}
 
-	func (r {{$base}}) Mask(mask string) {{$base}} {
+	func (r {{$base}}) InitParameter(name string, value interface{}) {{$base}} {
+		r.Options.InitParameters = r.Options.WithInitParameter(name, value)
+		return r
+	}
+
+	{{if .HasGlobalID}}func (r {{$base}}) GlobalID(globalID string) {{$base}} {
+		r.Options.GlobalID = &globalID
+		return r
+	}
+
+	{{end}}func (r {{$base}}) Mask(mask string) {{$base}} {
 		if !strings.HasPrefix(mask, "mask[") && (strings.Contains(mask, "[") || strings.Contains(mask, ",")) {
 			mask = fmt.Sprintf("mask[%%s]", mask)
 		}
@@ -151,11 +168,26 @@ import (
 		return r
 	}
 
+	func (r {{$base}}) Unlimited() {{$base}} {
+		r.Options.Unlimited = true
+		return r
+	}
+
 	func (r {{$base}}) Offset(offset int) {{$base}} {
 		r.Options.Offset = &offset
 		return r
 	}
 
+	func (r {{$base}}) Timeout(timeout time.Duration) {{$base}} {
+		r.Options.Timeout = timeout
+		return r
+	}
+
+	func (r {{$base}}) WithContext(ctx context.Context) {{$base}} {
+		r.Session = r.Session.SetContext(ctx)
+		return r
+	}
+
 	{{$rawBase := .Name}}{{range .Methods}}{{$methodName := .Name}}{{.Doc|goDoc}}
 	func (r {{$base}}) {{.Name|titleCase}}({{range .Parameters}}{{phraseMethodArg $methodName .Name .TypeArray .Type}}{{end}}) ({{if .Type|ne "void"}}resp {{if .TypeArray}}[]{{end}}{{convertType .Type "services"}}, {{end}}err error) {
 		{{if .Type|eq "void"}}var resp datatypes.Void
@@ -170,7 +202,15 @@ import (
 		{{end}}err = r.Session.DoRequest("{{$rawBase}}", "{{.Name}}", {{if len .Parameters | lt 0}}params{{else}}nil{{end}}, &r.Options, &resp)
 	return
 	}
-	{{end}}
+	{{if .Relational}}
+	// {{.Name|titleCase}}With is {{.Name|titleCase}}, with the mask, filter and result limit of options applied to
+	// this call only
+	func (r {{$base}}) {{.Name|titleCase}}With(options sl.Options) (resp {{if .TypeArray}}[]{{end}}{{convertType .Type "services"}}, err error) {
+		options.Id, options.GlobalID, options.InitParameters = r.Options.Id, r.Options.GlobalID, r.Options.InitParameters
+		r.Options = options
+		return r.{{.Name|titleCase}}()
+	}
+	{{end}}{{end}}
 
 {{end}}
 `, license, codegenWarning)
@@ -225,6 +265,7 @@ func generateAPI() {
 	// child service)
 	for i, service := range sortedServices {
 		sortedServices[i].Methods = getBaseMethods(service, meta)
+		sortedServices[i].HasGlobalID = hasBaseProperty(service, meta, "globalIdentifier")
 		fixReturnType(&sortedServices[i])
 	}
 
@@ -349,6 +390,7 @@ func createGetters(service *Type) {
 				TypeArray:  p.TypeArray,
 				Doc:        "Retrieve " + p.Doc, // TODO lowercase the first letter
 				Parameters: []Parameter{},
+				Relational: true,
 			}
 
 			service.Methods[m.Name] = m
@@ -463,6 +505,22 @@ func getBaseMethods(s Type, typeMap map[string]Type) map[string]Method {
 	return methods
 }
 
+// hasBaseProperty returns true if the type, or any of its base types, has the
+// named property
+func hasBaseProperty(t Type, typeMap map[string]Type, property string) bool {
+	for {
+		if _, ok := t.Properties[property]; ok {
+			return true
+		}
+
+		if t.Base == "" || t.Base == "SoftLayer_Entity" {
+			return false
+		}
+
+		t = typeMap[t.Base]
+	}
+}
+
 func getSortedKeys(m map[string]Type) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
@@ -27,6 +27,8 @@ Commands:
 
 	generate: Generate the SDK from the API metadata
 
+	fakes: Generate recording fakes for services (default: the most used ones)
+
 	version: library version management
 `
 
@@ -39,6 +41,8 @@ func main() {
 	switch os.Args[1] {
 	case "generate":
 		generateAPI()
+	case "fakes":
+		generateFakes()
 	case "version":
 		version()
 	default: